@@ -0,0 +1,202 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+// Package apitokens manages the project-scoped API tokens used by automation to call the API without a user JWT.
+// A token is sent as a bearer token like a JWT, and grants a role in the project it was created in, optionally
+// restricted to one site. Only the SHA-256 of the tokens is stored.
+package apitokens
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"sort"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	apitokenv1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/resources/apitoken/v1"
+	commonv1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/resources/common/v1"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/logging"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/tenant"
+)
+
+var zlog = logging.GetLogger("apitokens")
+
+const (
+	// TokenPrefix prefixes every API token, telling them apart from JWTs.
+	TokenPrefix = "eimapi_"
+
+	idPrefix         = "apitoken-"
+	idRandomSize     = 16
+	secretRandomSize = 32
+
+	// ActorPrefix prefixes the name of the tokens in audit logs.
+	ActorPrefix = "apitoken:"
+)
+
+// Manager creates, lists, revokes and verifies the API tokens.
+type Manager struct {
+	store Store
+	now   func() time.Time
+}
+
+// NewManager returns a manager of the tokens kept in the given store.
+func NewManager(store Store) *Manager {
+	return &Manager{store: store, now: time.Now}
+}
+
+// IsAPIToken returns whether the bearer token is an API token rather than a JWT.
+func IsAPIToken(token string) bool {
+	return strings.HasPrefix(token, TokenPrefix)
+}
+
+// Create creates a token described by res in the project of the tenant of ctx, and returns it along with its
+// secret. The secret cannot be retrieved afterward.
+func (m *Manager) Create(ctx context.Context, res *apitokenv1.APITokenResource) (*apitokenv1.APITokenResource, error) {
+	tenantID, ok := tenant.GetTenantIDFromContext(ctx)
+	if !ok || tenantID == "" {
+		return nil, errors.Errorfc(codes.InvalidArgument, "API tokens can only be created in a project")
+	}
+	idBytes := make([]byte, idRandomSize)
+	secret := make([]byte, secretRandomSize)
+	if _, err := rand.Read(idBytes); err != nil {
+		return nil, errors.Wrap(err)
+	}
+	if _, err := rand.Read(secret); err != nil {
+		return nil, errors.Wrap(err)
+	}
+	idHex := hex.EncodeToString(idBytes)
+	token := TokenPrefix + idHex + "_" + hex.EncodeToString(secret)
+
+	now := m.now()
+	expiration := now.Add(time.Duration(res.GetDurationSeconds()) * time.Second)
+	created := &apitokenv1.APITokenResource{
+		ResourceId:          idPrefix + idHex,
+		Name:                res.GetName(),
+		Role:                res.GetRole(),
+		SiteId:              res.GetSiteId(),
+		ExpirationTimestamp: uint32(expiration.Unix()), //nolint:gosec // Unix time.
+		Timestamps:          &commonv1.Timestamps{CreatedAt: timestamppb.New(now), UpdatedAt: timestamppb.New(now)},
+	}
+	// A token whose ID is already taken is rejected rather than replacing the record of another one.
+	if err := m.store.Create(&Record{TenantID: tenantID, Hash: hash(token), Token: created}); err != nil {
+		zlog.InfraErr(err).Msgf("failed to persist API token %s", created.GetResourceId())
+		return nil, err
+	}
+	result := cloneRecord(&Record{Token: created}).Token
+	result.Token = token
+	return result, nil
+}
+
+// List returns a page of the tokens of the project of the tenant of ctx, the most recent first, along with the total
+// number of tokens. The secrets are not returned.
+func (m *Manager) List(ctx context.Context, offset, limit int) ([]*apitokenv1.APITokenResource, int, error) {
+	tenantID, _ := tenant.GetTenantIDFromContext(ctx)
+	records, err := m.store.LoadAll()
+	if err != nil {
+		return nil, 0, err
+	}
+	tokens := make([]*apitokenv1.APITokenResource, 0, len(records))
+	for _, record := range records {
+		if record.TenantID == tenantID {
+			tokens = append(tokens, record.Token)
+		}
+	}
+	sort.Slice(tokens, func(i, j int) bool {
+		ti, tj := tokens[i].GetTimestamps().GetCreatedAt().AsTime(), tokens[j].GetTimestamps().GetCreatedAt().AsTime()
+		if !ti.Equal(tj) {
+			return ti.After(tj)
+		}
+		return tokens[i].GetResourceId() < tokens[j].GetResourceId()
+	})
+
+	total := len(tokens)
+	if offset > total {
+		offset = total
+	}
+	end := min(offset+limit, total)
+	return tokens[offset:end], total, nil
+}
+
+// Revoke deletes the token with the given ID of the project of the tenant of ctx. The token is rejected right away.
+func (m *Manager) Revoke(ctx context.Context, id string) error {
+	tenantID, _ := tenant.GetTenantIDFromContext(ctx)
+	if !validID(id) {
+		return errNotFound(id)
+	}
+	record, err := m.store.Load(id)
+	if err != nil {
+		return err
+	}
+	if record.TenantID != tenantID {
+		return errNotFound(id)
+	}
+	return m.store.Delete(id)
+}
+
+// Verify returns the record of the given token if it is valid, i.e. it exists and is not expired.
+func (m *Manager) Verify(token string) (*Record, error) {
+	idHex, _, ok := strings.Cut(strings.TrimPrefix(token, TokenPrefix), "_")
+	if !IsAPIToken(token) || !ok || !validID(idPrefix+idHex) {
+		return nil, errors.Errorfc(codes.Unauthenticated, "malformed API token")
+	}
+	record, err := m.store.Load(idPrefix + idHex)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, errors.Errorfc(codes.Unauthenticated, "invalid API token")
+		}
+		return nil, err
+	}
+	if subtle.ConstantTimeCompare([]byte(record.Hash), []byte(hash(token))) != 1 {
+		return nil, errors.Errorfc(codes.Unauthenticated, "invalid API token")
+	}
+	if m.now().Unix() >= int64(record.Token.GetExpirationTimestamp()) {
+		return nil, errors.Errorfc(codes.Unauthenticated, "API token %s expired", record.Token.GetResourceId())
+	}
+	return record, nil
+}
+
+// Actor returns the name of the token as recorded in audit logs.
+func (r *Record) Actor() string {
+	return ActorPrefix + r.Token.GetName()
+}
+
+// ReadOnly returns whether the token only grants read access.
+func (r *Record) ReadOnly() bool {
+	return r.Token.GetRole() != apitokenv1.APITokenRole_API_TOKEN_ROLE_READ_WRITE
+}
+
+type contextKey struct{}
+
+// NewContext returns a context carrying the record of the token the call is authenticated with.
+func NewContext(ctx context.Context, record *Record) context.Context {
+	return context.WithValue(ctx, contextKey{}, record)
+}
+
+// FromContext returns the record of the token the call is authenticated with, if any.
+func FromContext(ctx context.Context) (*Record, bool) {
+	record, ok := ctx.Value(contextKey{}).(*Record)
+	return record, ok
+}
+
+// validID returns whether id is a well-formed token ID. The IDs name the files of the records, they must not be
+// anything else.
+func validID(id string) bool {
+	idHex, ok := strings.CutPrefix(id, idPrefix)
+	if !ok || len(idHex) != 2*idRandomSize {
+		return false
+	}
+	_, err := hex.DecodeString(idHex)
+	return err == nil
+}
+
+func hash(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,156 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package apitokens_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/open-edge-platform/infra-core/apiv2/v2/internal/apitokens"
+	apitokenv1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/resources/apitoken/v1"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/tenant"
+)
+
+const (
+	tenantID      = "11111111-1111-1111-1111-111111111111"
+	otherTenantID = "22222222-2222-2222-2222-222222222222"
+)
+
+func newToken(name string, durationSeconds uint32) *apitokenv1.APITokenResource {
+	return &apitokenv1.APITokenResource{
+		Name:            name,
+		Role:            apitokenv1.APITokenRole_API_TOKEN_ROLE_READ,
+		SiteId:          "site-12345678",
+		DurationSeconds: durationSeconds,
+	}
+}
+
+func TestManager_Persistence(t *testing.T) {
+	dir := t.TempDir()
+	store, err := apitokens.NewFileStore(dir)
+	require.NoError(t, err)
+	manager := apitokens.NewManager(store)
+	ctx := tenant.AddTenantIDToContext(context.Background(), tenantID)
+
+	created, err := manager.Create(ctx, newToken("ci", 3600))
+	require.NoError(t, err)
+	assert.True(t, apitokens.IsAPIToken(created.GetToken()))
+	assert.Regexp(t, "^apitoken-[0-9a-f]{32}$", created.GetResourceId())
+	assert.NotZero(t, created.GetExpirationTimestamp())
+
+	// The secret is not stored, only its hash.
+	data, err := os.ReadFile(filepath.Join(dir, created.GetResourceId()+".json"))
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), created.GetToken())
+	secret := created.GetToken()[strings.LastIndex(created.GetToken(), "_")+1:]
+	assert.NotContains(t, string(data), secret)
+
+	// A new manager on the same directory, as after a restart, still accepts the token.
+	restarted := apitokens.NewManager(store)
+	record, err := restarted.Verify(created.GetToken())
+	require.NoError(t, err)
+	assert.Equal(t, tenantID, record.TenantID)
+	assert.Equal(t, "apitoken:ci", record.Actor())
+	assert.True(t, record.ReadOnly())
+	assert.Equal(t, "site-12345678", record.Token.GetSiteId())
+
+	tokens, total, err := restarted.List(ctx, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 1, total)
+	require.Len(t, tokens, 1)
+	assert.Empty(t, tokens[0].GetToken())
+
+	require.NoError(t, restarted.Revoke(ctx, created.GetResourceId()))
+	_, err = manager.Verify(created.GetToken())
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestManager_Verify(t *testing.T) {
+	manager := apitokens.NewManager(apitokens.NewMemoryStore())
+	ctx := tenant.AddTenantIDToContext(context.Background(), tenantID)
+
+	created, err := manager.Create(ctx, newToken("ci", 3600))
+	require.NoError(t, err)
+	expired, err := manager.Create(ctx, newToken("expired", 0))
+	require.NoError(t, err)
+
+	_, err = manager.Verify(created.GetToken())
+	require.NoError(t, err)
+
+	for name, token := range map[string]string{
+		"Expired":   expired.GetToken(),
+		"Malformed": apitokens.TokenPrefix + "invalid",
+		"NotHexID":  apitokens.TokenPrefix + "../../x_" + strings.Repeat("0", 64),
+		"Unknown":   apitokens.TokenPrefix + "00000000_" + strings.Repeat("0", 64),
+		"WrongHash": created.GetToken()[:len(created.GetToken())-1] + "x",
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := manager.Verify(token)
+			assert.Equal(t, codes.Unauthenticated, status.Code(err))
+		})
+	}
+}
+
+func TestManager_Tenants(t *testing.T) {
+	manager := apitokens.NewManager(apitokens.NewMemoryStore())
+	tenantCtx := tenant.AddTenantIDToContext(context.Background(), tenantID)
+	otherCtx := tenant.AddTenantIDToContext(context.Background(), otherTenantID)
+
+	_, err := manager.Create(context.Background(), newToken("ci", 3600))
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	first, err := manager.Create(tenantCtx, newToken("first", 3600))
+	require.NoError(t, err)
+	second, err := manager.Create(tenantCtx, newToken("second", 3600))
+	require.NoError(t, err)
+
+	tokens, total, err := manager.List(otherCtx, 0, 10)
+	require.NoError(t, err)
+	assert.Empty(t, tokens)
+	assert.Zero(t, total)
+	err = manager.Revoke(otherCtx, first.GetResourceId())
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	tokens, total, err = manager.List(tenantCtx, 1, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 2, total)
+	require.Len(t, tokens, 1)
+	ids := []string{first.GetResourceId(), second.GetResourceId()}
+	assert.Contains(t, ids, tokens[0].GetResourceId())
+}
+
+func TestStore_CreateExisting(t *testing.T) {
+	fileStore, err := apitokens.NewFileStore(t.TempDir())
+	require.NoError(t, err)
+	stores := map[string]apitokens.Store{
+		"Memory": apitokens.NewMemoryStore(),
+		"File":   fileStore,
+	}
+	for name, store := range stores {
+		t.Run(name, func(t *testing.T) {
+			record := &apitokens.Record{TenantID: tenantID, Hash: "hash", Token: newToken("ci", 3600)}
+			record.Token.ResourceId = "apitoken-0123456789abcdef0123456789abcdef"
+			require.NoError(t, store.Create(record))
+
+			// A token of another tenant with the same ID does not replace the stored one.
+			other := &apitokens.Record{TenantID: otherTenantID, Hash: "other", Token: newToken("other", 3600)}
+			other.Token.ResourceId = record.Token.GetResourceId()
+			err := store.Create(other)
+			assert.Equal(t, codes.AlreadyExists, status.Code(err))
+
+			stored, err := store.Load(record.Token.GetResourceId())
+			require.NoError(t, err)
+			assert.Equal(t, tenantID, stored.TenantID)
+			assert.Equal(t, "hash", stored.Hash)
+		})
+	}
+}
@@ -0,0 +1,221 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package apitokens
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	apitokenv1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/resources/apitoken/v1"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
+)
+
+const recordSuffix = ".json"
+
+// Record is an API token along with the tenant it belongs to and the hash of its secret.
+// The secret itself is never stored.
+type Record struct {
+	TenantID string
+	// Hash is the hex encoded SHA-256 of the token.
+	Hash  string
+	Token *apitokenv1.APITokenResource
+}
+
+// Store persists the API tokens. The store is the source of truth: tokens are looked up on every request, so that
+// the revocations through any replica of the API sharing the store apply right away.
+type Store interface {
+	// Create stores the given record, or returns an AlreadyExists error if a record with the same ID exists.
+	Create(record *Record) error
+	// Load returns the record of the token with the given ID, or a NotFound error.
+	Load(id string) (*Record, error)
+	// Delete removes the record of the token with the given ID, if any.
+	Delete(id string) error
+	// LoadAll returns all the stored records.
+	LoadAll() ([]*Record, error)
+}
+
+// NewMemoryStore returns a store keeping the tokens in memory only.
+func NewMemoryStore() Store {
+	return &memoryStore{records: make(map[string]*Record)}
+}
+
+type memoryStore struct {
+	mu      sync.Mutex
+	records map[string]*Record
+}
+
+func (s *memoryStore) Create(record *Record) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	id := record.Token.GetResourceId()
+	if _, ok := s.records[id]; ok {
+		return errAlreadyExists(id)
+	}
+	s.records[id] = cloneRecord(record)
+	return nil
+}
+
+func (s *memoryStore) Load(id string) (*Record, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	record, ok := s.records[id]
+	if !ok {
+		return nil, errNotFound(id)
+	}
+	return cloneRecord(record), nil
+}
+
+func (s *memoryStore) Delete(id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.records, id)
+	return nil
+}
+
+func (s *memoryStore) LoadAll() ([]*Record, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	records := make([]*Record, 0, len(s.records))
+	for _, record := range s.records {
+		records = append(records, cloneRecord(record))
+	}
+	return records, nil
+}
+
+// fileRecord is the on-disk representation of a Record.
+type fileRecord struct {
+	TenantID string          `json:"tenant_id"`
+	Hash     string          `json:"hash"`
+	Token    json.RawMessage `json:"token"`
+}
+
+// NewFileStore returns a store keeping each token in a JSON file of the given directory, which is created if
+// missing.
+func NewFileStore(dir string) (Store, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		zlog.InfraErr(err).Msgf("failed to create API tokens directory %s", dir)
+		return nil, errors.Wrap(err)
+	}
+	return &fileStore{dir: dir}, nil
+}
+
+type fileStore struct {
+	mu  sync.Mutex
+	dir string
+}
+
+func (s *fileStore) path(id string) string {
+	return filepath.Join(s.dir, id+recordSuffix)
+}
+
+func (s *fileStore) Create(record *Record) error {
+	token, err := protojson.Marshal(record.Token)
+	if err != nil {
+		return errors.Wrap(err)
+	}
+	data, err := json.Marshal(&fileRecord{TenantID: record.TenantID, Hash: record.Hash, Token: token})
+	if err != nil {
+		return errors.Wrap(err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	// Write to a temporary file first, so that a crash never leaves a truncated record behind. The record is then
+	// linked in place, which fails if it exists, even when created by another replica sharing the directory.
+	id := record.Token.GetResourceId()
+	path := s.path(id)
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return errors.Wrap(err)
+	}
+	defer os.Remove(tmp)
+	if err := os.Link(tmp, path); err != nil {
+		if os.IsExist(err) {
+			return errAlreadyExists(id)
+		}
+		return errors.Wrap(err)
+	}
+	return nil
+}
+
+func (s *fileStore) Load(id string) (*Record, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	path := s.path(id)
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		return nil, errNotFound(id)
+	}
+	return s.load(path)
+}
+
+func (s *fileStore) Delete(id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := os.Remove(s.path(id)); err != nil && !os.IsNotExist(err) {
+		return errors.Wrap(err)
+	}
+	return nil
+}
+
+func (s *fileStore) LoadAll() ([]*Record, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	records := make([]*Record, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), recordSuffix) {
+			continue
+		}
+		record, err := s.load(filepath.Join(s.dir, entry.Name()))
+		if err != nil {
+			// A corrupted record must not prevent listing the others.
+			zlog.InfraErr(err).Msgf("skipping unreadable API token record %s", entry.Name())
+			continue
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+func (s *fileStore) load(path string) (*Record, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	var fr fileRecord
+	if err := json.Unmarshal(data, &fr); err != nil {
+		return nil, errors.Wrap(err)
+	}
+	token := &apitokenv1.APITokenResource{}
+	if err := protojson.Unmarshal(fr.Token, token); err != nil {
+		return nil, errors.Wrap(err)
+	}
+	return &Record{TenantID: fr.TenantID, Hash: fr.Hash, Token: token}, nil
+}
+
+func errNotFound(id string) error {
+	return errors.Errorfc(codes.NotFound, "API token %s not found", id)
+}
+
+func errAlreadyExists(id string) error {
+	return errors.Errorfc(codes.AlreadyExists, "API token %s already exists", id)
+}
+
+func cloneRecord(record *Record) *Record {
+	token, ok := proto.Clone(record.Token).(*apitokenv1.APITokenResource)
+	if !ok {
+		token = &apitokenv1.APITokenResource{}
+	}
+	return &Record{TenantID: record.TenantID, Hash: record.Hash, Token: token}
+}
@@ -7,6 +7,7 @@ import (
 	"flag"
 	"time"
 
+	"github.com/open-edge-platform/infra-core/apiv2/v2/internal/ratelimit"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/client"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/flags"
 	metrics "github.com/open-edge-platform/infra-core/inventory/v2/pkg/metrics"
@@ -37,10 +38,32 @@ const (
 	MaxJobsDescription                  = "The maximum amount of jobs allowed to be queued to attend job requests"
 	WsMaxConnections                    = "wsMaxConnections"
 	WsMaxConnectionsDefault             = 10
-	WstDefaultMaxConnectionsDescription = "The maximum number of concurrent websocket connections"
+	WstDefaultMaxConnectionsDescription = "The maximum number of concurrent watch connections per project"
 	EnableAuditing                      = "enableAuditing"
 	EnableAuditingDescription           = "Flag to enable audit logs for REST API calls."
 	DefaultScenario                     = "fulleim"
+	OperationsDir                       = "operationsDir"
+	OperationsDirDescription            = "Directory persisting the long-running operations, kept in memory only if empty"
+	OperationsRetention                 = "operationsRetention"
+	OperationsRetentionDescription      = "How long the long-running operations are kept once over"
+	DefaultOperationsRetention          = 24 * time.Hour
+	RateLimitWindow                     = "rateLimitWindow"
+	RateLimitWindowDescription          = "Duration of the windows the rate limit budgets apply to"
+	RateLimitSubjectRead                = "rateLimitSubjectRead"
+	RateLimitSubjectReadDescription     = "Cost of the read calls allowed per window to a user, 0 for unlimited"
+	RateLimitSubjectWrite               = "rateLimitSubjectWrite"
+	RateLimitSubjectWriteDescription    = "Cost of the mutating calls allowed per window to a user, 0 for unlimited"
+	RateLimitProjectRead                = "rateLimitProjectRead"
+	RateLimitProjectReadDescription     = "Cost of the read calls allowed per window in a project, 0 for unlimited"
+	RateLimitProjectWrite               = "rateLimitProjectWrite"
+	RateLimitProjectWriteDescription    = "Cost of the mutating calls allowed per window in a project, 0 for unlimited"
+	RateLimitWeights                    = "rateLimitWeights"
+	RateLimitWeightsDescription         = "Comma separated list of route=cost, routes being operation IDs or method names"
+	DefaultRateLimitWeights             = "ListHosts=5,ListInstances=5,GetHostsSummary=5,GetHostsStatistics=5"
+	RateLimitStoreURL                   = "rateLimitStoreURL"
+	RateLimitStoreURLDescription        = "PostgreSQL URL sharing the rate limit counters between replicas, if any"
+	APITokensDir                        = "apiTokensDir"
+	APITokensDirDescription             = "Directory persisting the hashed API tokens, kept in memory only if empty"
 )
 
 type Traces struct {
@@ -86,10 +109,39 @@ type GlobalConfig struct {
 	GRPCAddress    string
 	Inventory      Southbound
 	Websocket      Websocket
+	Operations     Operations
+	APITokens      APITokens
+	RateLimit      RateLimit
 	EnableAuditing bool
 	EIMScenario    string
 }
 
+type Operations struct {
+	// the directory where the operations are persisted, empty to keep them in memory only
+	Dir string
+	// how long an operation is kept once over
+	Retention time.Duration
+}
+
+type RateLimit struct {
+	// the duration of the windows the budgets apply to
+	Window time.Duration
+	// the cost of the calls allowed per window, by user and by project, for reads and mutations
+	SubjectRead  int
+	SubjectWrite int
+	ProjectRead  int
+	ProjectWrite int
+	// the cost of the routes, as a comma separated list of route=cost
+	Weights string
+	// the URL of the store shared by the replicas, empty to keep the counters in memory
+	StoreURL string
+}
+
+type APITokens struct {
+	// the directory where the API tokens are persisted, empty to keep them in memory only
+	Dir string
+}
+
 type Websocket struct {
 	MaxConnections uint
 }
@@ -127,6 +179,22 @@ func DefaultConfig() *GlobalConfig {
 		Websocket: Websocket{
 			MaxConnections: WsMaxConnectionsDefault,
 		},
+		Operations: Operations{
+			Dir:       "",
+			Retention: DefaultOperationsRetention,
+		},
+		APITokens: APITokens{
+			Dir: "",
+		},
+		RateLimit: RateLimit{
+			Window:       ratelimit.DefaultWindow,
+			SubjectRead:  ratelimit.DefaultSubjectRead,
+			SubjectWrite: ratelimit.DefaultSubjectWrite,
+			ProjectRead:  ratelimit.DefaultProjectRead,
+			ProjectWrite: ratelimit.DefaultProjectWrite,
+			Weights:      DefaultRateLimitWeights,
+			StoreURL:     "",
+		},
 		EnableAuditing: true,
 		GRPCAddress:    "0.0.0.0:8090",
 		GRPCEndpoint:   "localhost:8090",
@@ -167,6 +235,21 @@ func Config() (*GlobalConfig, error) {
 		metrics.MetricsAddress, defaultCfg.RestServer.MetricsAddress, metrics.MetricsAddressDescription)
 	enableMetrics := flag.Bool(
 		metrics.EnableMetrics, defaultCfg.RestServer.EnableMetrics, metrics.EnableMetricsDescription)
+	operationsDir := flag.String(OperationsDir, defaultCfg.Operations.Dir, OperationsDirDescription)
+	operationsRetention := flag.Duration(
+		OperationsRetention, defaultCfg.Operations.Retention, OperationsRetentionDescription)
+	apiTokensDir := flag.String(APITokensDir, defaultCfg.APITokens.Dir, APITokensDirDescription)
+	rateLimitWindow := flag.Duration(RateLimitWindow, defaultCfg.RateLimit.Window, RateLimitWindowDescription)
+	rateLimitSubjectRead := flag.Int(
+		RateLimitSubjectRead, defaultCfg.RateLimit.SubjectRead, RateLimitSubjectReadDescription)
+	rateLimitSubjectWrite := flag.Int(
+		RateLimitSubjectWrite, defaultCfg.RateLimit.SubjectWrite, RateLimitSubjectWriteDescription)
+	rateLimitProjectRead := flag.Int(
+		RateLimitProjectRead, defaultCfg.RateLimit.ProjectRead, RateLimitProjectReadDescription)
+	rateLimitProjectWrite := flag.Int(
+		RateLimitProjectWrite, defaultCfg.RateLimit.ProjectWrite, RateLimitProjectWriteDescription)
+	rateLimitWeights := flag.String(RateLimitWeights, defaultCfg.RateLimit.Weights, RateLimitWeightsDescription)
+	rateLimitStoreURL := flag.String(RateLimitStoreURL, defaultCfg.RateLimit.StoreURL, RateLimitStoreURLDescription)
 	enableAuditing := flag.Bool(EnableAuditing, defaultCfg.EnableAuditing, EnableAuditingDescription)
 	gRPCEndpoint := flag.String("grpcEndpoint", defaultCfg.GRPCEndpoint, "The endpoint of the gRPC server")
 	gRPCAddress := flag.String("grpcAddress", defaultCfg.GRPCEndpoint, "The gRPC server address")
@@ -206,6 +289,22 @@ func Config() (*GlobalConfig, error) {
 		Websocket: Websocket{
 			MaxConnections: *wsMaxConnections,
 		},
+		Operations: Operations{
+			Dir:       *operationsDir,
+			Retention: *operationsRetention,
+		},
+		APITokens: APITokens{
+			Dir: *apiTokensDir,
+		},
+		RateLimit: RateLimit{
+			Window:       *rateLimitWindow,
+			SubjectRead:  *rateLimitSubjectRead,
+			SubjectWrite: *rateLimitSubjectWrite,
+			ProjectRead:  *rateLimitProjectRead,
+			ProjectWrite: *rateLimitProjectWrite,
+			Weights:      *rateLimitWeights,
+			StoreURL:     *rateLimitStoreURL,
+		},
 		EnableAuditing: *enableAuditing,
 		GRPCEndpoint:   *gRPCEndpoint,
 		GRPCAddress:    *gRPCAddress,
@@ -0,0 +1,352 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+// Package operations runs the expensive API calls in the background as long-running operations, see
+// https://google.aip.dev/151. The caller gets an operation right away and polls it for progress and outcome.
+package operations
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"sort"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	commonv1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/resources/common/v1"
+	operationv1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/resources/operation/v1"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/logging"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/tenant"
+)
+
+var zlog = logging.GetLogger("operations")
+
+const (
+	idPrefix     = "op-"
+	idRandomSize = 4
+
+	errInterrupted = "interrupted by a restart of the API"
+)
+
+// Func performs the work of an operation, reporting the outcome of each resource to the tracker.
+// It returns an error when the operation as a whole fails, and must return promptly once ctx is cancelled.
+type Func func(ctx context.Context, tracker *Tracker) error
+
+type entry struct {
+	record    *Record
+	cancel    context.CancelFunc
+	cancelled bool
+}
+
+// Manager runs the operations and keeps track of them.
+type Manager struct {
+	mu        sync.Mutex
+	entries   map[string]*entry
+	store     Store
+	slots     chan struct{}
+	retention time.Duration
+	wg        sync.WaitGroup
+}
+
+// NewManager returns a manager running at most maxRunning operations at once. The operations over since longer
+// than retention are discarded, a zero retention keeps them forever.
+// The operations found in the store that were not over are marked as failed, since their work was interrupted.
+func NewManager(store Store, maxRunning int, retention time.Duration) (*Manager, error) {
+	if maxRunning < 1 {
+		return nil, errors.Errorfc(codes.InvalidArgument, "at least one operation must be allowed to run")
+	}
+	m := &Manager{
+		entries:   make(map[string]*entry),
+		store:     store,
+		slots:     make(chan struct{}, maxRunning),
+		retention: retention,
+	}
+	records, err := store.LoadAll()
+	if err != nil {
+		zlog.InfraErr(err).Msg("failed to load operations")
+		return nil, err
+	}
+	for _, record := range records {
+		op := record.Operation
+		if !op.GetDone() {
+			op.State = operationv1.OperationState_OPERATION_STATE_FAILED
+			op.Done = true
+			op.Error = errInterrupted
+			touch(op)
+			m.save(record)
+		}
+		m.entries[op.GetResourceId()] = &entry{record: record}
+	}
+	m.purge()
+	return m, nil
+}
+
+// Start queues an operation of the given kind, performed by fn on behalf of the tenant of ctx, and returns it.
+// The operation outlives ctx: it keeps running after the request that started it is over.
+func (m *Manager) Start(ctx context.Context, kind string, fn Func) (*operationv1.OperationResource, error) {
+	tenantID, _ := tenant.GetTenantIDFromContext(ctx)
+	// Detach from the request, only the tenant is carried over for the inventory calls.
+	opCtx := context.Background()
+	if tenantID != "" {
+		opCtx = tenant.AddTenantIDToContext(opCtx, tenantID)
+	}
+	opCtx, cancel := context.WithCancel(opCtx)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.purgeLocked()
+	id, err := m.newIDLocked()
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	now := timestamppb.Now()
+	e := &entry{
+		record: &Record{
+			TenantID: tenantID,
+			Operation: &operationv1.OperationResource{
+				ResourceId: id,
+				Kind:       kind,
+				State:      operationv1.OperationState_OPERATION_STATE_PENDING,
+				Timestamps: &commonv1.Timestamps{CreatedAt: now, UpdatedAt: now},
+			},
+		},
+		cancel: cancel,
+	}
+	m.entries[id] = e
+	m.save(e.record)
+	zlog.Debug().Msgf("Started operation %s of kind %s", id, kind)
+
+	m.wg.Add(1)
+	go m.run(opCtx, e, fn)
+	return clone(e.record.Operation), nil
+}
+
+func (m *Manager) run(ctx context.Context, e *entry, fn Func) {
+	defer m.wg.Done()
+	defer e.cancel()
+
+	select {
+	case m.slots <- struct{}{}:
+	case <-ctx.Done():
+		m.finish(e, nil)
+		return
+	}
+	defer func() { <-m.slots }()
+	if ctx.Err() != nil {
+		// Cancelled while a slot got released.
+		m.finish(e, nil)
+		return
+	}
+
+	m.update(e, func(op *operationv1.OperationResource) {
+		op.State = operationv1.OperationState_OPERATION_STATE_RUNNING
+	})
+	err := fn(ctx, &Tracker{m: m, e: e})
+	m.finish(e, err)
+}
+
+func (m *Manager) finish(e *entry, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	op := e.record.Operation
+	switch {
+	case e.cancelled:
+		op.State = operationv1.OperationState_OPERATION_STATE_CANCELLED
+	case err != nil:
+		op.State = operationv1.OperationState_OPERATION_STATE_FAILED
+		op.Error = status.Convert(err).Message()
+	default:
+		op.State = operationv1.OperationState_OPERATION_STATE_SUCCEEDED
+		op.ProgressPercent = 100
+	}
+	op.Done = true
+	touch(op)
+	m.save(e.record)
+	zlog.Debug().Msgf("Operation %s is over: %s", op.GetResourceId(), op.GetState())
+}
+
+func (m *Manager) update(e *entry, fn func(op *operationv1.OperationResource)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	fn(e.record.Operation)
+	touch(e.record.Operation)
+	m.save(e.record)
+}
+
+// Get returns the operation with the given ID, started by the tenant of ctx.
+func (m *Manager) Get(ctx context.Context, id string) (*operationv1.OperationResource, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	e, err := m.getLocked(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return clone(e.record.Operation), nil
+}
+
+// List returns a page of the operations started by the tenant of ctx, the most recent first, along with the total
+// number of operations.
+func (m *Manager) List(ctx context.Context, offset, limit int) ([]*operationv1.OperationResource, int) {
+	tenantID, _ := tenant.GetTenantIDFromContext(ctx)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.purgeLocked()
+	ops := make([]*operationv1.OperationResource, 0, len(m.entries))
+	for _, e := range m.entries {
+		if e.record.TenantID == tenantID {
+			ops = append(ops, e.record.Operation)
+		}
+	}
+	sort.Slice(ops, func(i, j int) bool {
+		ti, tj := ops[i].GetTimestamps().GetCreatedAt().AsTime(), ops[j].GetTimestamps().GetCreatedAt().AsTime()
+		if !ti.Equal(tj) {
+			return ti.After(tj)
+		}
+		return ops[i].GetResourceId() < ops[j].GetResourceId()
+	})
+
+	total := len(ops)
+	if offset > total {
+		offset = total
+	}
+	end := min(offset+limit, total)
+	page := make([]*operationv1.OperationResource, 0, end-offset)
+	for _, op := range ops[offset:end] {
+		page = append(page, clone(op))
+	}
+	return page, total
+}
+
+// Cancel requests the cancellation of the operation with the given ID, started by the tenant of ctx, and returns it.
+// Cancelling an operation that is over has no effect. The operation is reported as cancelled once its work stopped.
+func (m *Manager) Cancel(ctx context.Context, id string) (*operationv1.OperationResource, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	e, err := m.getLocked(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !e.record.Operation.GetDone() && !e.cancelled {
+		e.cancelled = true
+		e.cancel()
+		zlog.Debug().Msgf("Cancelling operation %s", id)
+	}
+	return clone(e.record.Operation), nil
+}
+
+// Wait blocks until all the started operations are over.
+func (m *Manager) Wait() {
+	m.wg.Wait()
+}
+
+func (m *Manager) getLocked(ctx context.Context, id string) (*entry, error) {
+	tenantID, _ := tenant.GetTenantIDFromContext(ctx)
+	e, ok := m.entries[id]
+	if !ok || e.record.TenantID != tenantID {
+		return nil, errors.Errorfc(codes.NotFound, "operation %s not found", id)
+	}
+	return e, nil
+}
+
+func (m *Manager) newIDLocked() (string, error) {
+	for {
+		b := make([]byte, idRandomSize)
+		if _, err := rand.Read(b); err != nil {
+			return "", errors.Wrap(err)
+		}
+		id := idPrefix + hex.EncodeToString(b)
+		if _, exists := m.entries[id]; !exists {
+			return id, nil
+		}
+	}
+}
+
+func (m *Manager) purge() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.purgeLocked()
+}
+
+// purgeLocked discards the operations over since longer than the retention.
+func (m *Manager) purgeLocked() {
+	if m.retention == 0 {
+		return
+	}
+	deadline := time.Now().Add(-m.retention)
+	for id, e := range m.entries {
+		op := e.record.Operation
+		if op.GetDone() && op.GetTimestamps().GetUpdatedAt().AsTime().Before(deadline) {
+			if err := m.store.Delete(id); err != nil {
+				zlog.InfraErr(err).Msgf("failed to delete operation %s", id)
+				continue
+			}
+			delete(m.entries, id)
+		}
+	}
+}
+
+// save persists the record. A failure is logged only, the operation is still tracked in memory.
+func (m *Manager) save(record *Record) {
+	if err := m.store.Save(record); err != nil {
+		zlog.InfraErr(err).Msgf("failed to persist operation %s", record.Operation.GetResourceId())
+	}
+}
+
+// Tracker reports the progress of an operation.
+type Tracker struct {
+	m *Manager
+	e *entry
+}
+
+// SetTotal sets the number of resources the operation acts on.
+func (t *Tracker) SetTotal(total int) {
+	t.m.update(t.e, func(op *operationv1.OperationResource) {
+		op.Total = uint32(total) //nolint:gosec // Number of resources.
+		op.ProgressPercent = progress(op)
+	})
+}
+
+// Record reports the outcome of the operation on the given resource, err being nil on success.
+func (t *Tracker) Record(resourceID string, err error) {
+	t.m.update(t.e, func(op *operationv1.OperationResource) {
+		result := &operationv1.OperationResult{ResourceId: resourceID}
+		if err != nil {
+			result.Error = status.Convert(err).Message()
+			op.Failed++
+		} else {
+			op.Succeeded++
+		}
+		op.Results = append(op.Results, result)
+		op.ProgressPercent = progress(op)
+	})
+}
+
+func progress(op *operationv1.OperationResource) uint32 {
+	if op.GetTotal() == 0 {
+		return 0
+	}
+	return min((op.GetSucceeded()+op.GetFailed())*100/op.GetTotal(), 100)
+}
+
+func touch(op *operationv1.OperationResource) {
+	if op.Timestamps == nil {
+		op.Timestamps = &commonv1.Timestamps{CreatedAt: timestamppb.Now()}
+	}
+	op.Timestamps.UpdatedAt = timestamppb.Now()
+}
+
+func clone(op *operationv1.OperationResource) *operationv1.OperationResource {
+	cloned, ok := proto.Clone(op).(*operationv1.OperationResource)
+	if !ok {
+		return &operationv1.OperationResource{}
+	}
+	return cloned
+}
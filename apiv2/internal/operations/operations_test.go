@@ -0,0 +1,117 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package operations_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/open-edge-platform/infra-core/apiv2/v2/internal/operations"
+	operationv1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/resources/operation/v1"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/tenant"
+)
+
+func TestManager_Persistence(t *testing.T) {
+	dir := t.TempDir()
+	store, err := operations.NewFileStore(dir)
+	require.NoError(t, err)
+	manager, err := operations.NewManager(store, 2, 0)
+	require.NoError(t, err)
+
+	done, err := manager.Start(context.Background(), "Done", func(_ context.Context, tracker *operations.Tracker) error {
+		tracker.SetTotal(1)
+		tracker.Record("host-00000001", nil)
+		return nil
+	})
+	require.NoError(t, err)
+	started := make(chan struct{})
+	running, err := manager.Start(context.Background(), "Running", func(ctx context.Context, _ *operations.Tracker) error {
+		close(started)
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	require.NoError(t, err)
+	<-started
+	require.Eventually(t, func() bool {
+		op, err := manager.Get(context.Background(), done.GetResourceId())
+		return err == nil && op.GetDone()
+	}, time.Second, time.Millisecond)
+
+	// A new manager on the same directory, as after a restart, recovers the operations. The operation that was
+	// running is reported as failed, its work being lost.
+	restarted, err := operations.NewManager(store, 2, 0)
+	require.NoError(t, err)
+	op, err := restarted.Get(context.Background(), done.GetResourceId())
+	require.NoError(t, err)
+	assert.Equal(t, operationv1.OperationState_OPERATION_STATE_SUCCEEDED, op.GetState())
+	require.Len(t, op.GetResults(), 1)
+	assert.Equal(t, "host-00000001", op.GetResults()[0].GetResourceId())
+	op, err = restarted.Get(context.Background(), running.GetResourceId())
+	require.NoError(t, err)
+	assert.Equal(t, operationv1.OperationState_OPERATION_STATE_FAILED, op.GetState())
+	assert.True(t, op.GetDone())
+	assert.NotEmpty(t, op.GetError())
+
+	_, err = manager.Cancel(context.Background(), running.GetResourceId())
+	require.NoError(t, err)
+	manager.Wait()
+}
+
+func TestManager_Tenants(t *testing.T) {
+	manager, err := operations.NewManager(operations.NewMemoryStore(), 1, 0)
+	require.NoError(t, err)
+
+	tenantCtx := tenant.AddTenantIDToContext(context.Background(), "11111111-1111-1111-1111-111111111111")
+	otherCtx := tenant.AddTenantIDToContext(context.Background(), "22222222-2222-2222-2222-222222222222")
+	var opTenantID string
+	op, err := manager.Start(tenantCtx, "Test", func(ctx context.Context, _ *operations.Tracker) error {
+		opTenantID, _ = tenant.GetTenantIDFromContext(ctx)
+		return nil
+	})
+	require.NoError(t, err)
+	manager.Wait()
+	assert.Equal(t, "11111111-1111-1111-1111-111111111111", opTenantID)
+
+	_, err = manager.Get(tenantCtx, op.GetResourceId())
+	require.NoError(t, err)
+	_, err = manager.Get(otherCtx, op.GetResourceId())
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = manager.Cancel(otherCtx, op.GetResourceId())
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	ops, total := manager.List(otherCtx, 0, 10)
+	assert.Empty(t, ops)
+	assert.Zero(t, total)
+	ops, total = manager.List(tenantCtx, 0, 10)
+	assert.Len(t, ops, 1)
+	assert.Equal(t, 1, total)
+}
+
+func TestManager_Retention(t *testing.T) {
+	store := operations.NewMemoryStore()
+	manager, err := operations.NewManager(store, 1, time.Millisecond)
+	require.NoError(t, err)
+
+	op, err := manager.Start(context.Background(), "Test", func(_ context.Context, _ *operations.Tracker) error {
+		return errors.New("failure")
+	})
+	require.NoError(t, err)
+	manager.Wait()
+	time.Sleep(10 * time.Millisecond)
+
+	// Expired operations are discarded from the store too.
+	_, total := manager.List(context.Background(), 0, 10)
+	assert.Zero(t, total)
+	_, err = manager.Get(context.Background(), op.GetResourceId())
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	records, err := store.LoadAll()
+	require.NoError(t, err)
+	assert.Empty(t, records)
+}
@@ -0,0 +1,172 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package operations
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	operationv1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/resources/operation/v1"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
+)
+
+const recordSuffix = ".json"
+
+// Record is an operation along with the tenant that started it.
+type Record struct {
+	TenantID  string
+	Operation *operationv1.OperationResource
+}
+
+// Store persists the operations, so that they survive a restart of the API.
+type Store interface {
+	// Save creates or replaces the given record.
+	Save(record *Record) error
+	// Delete removes the record of the operation with the given ID, if any.
+	Delete(id string) error
+	// LoadAll returns all the stored records.
+	LoadAll() ([]*Record, error)
+}
+
+// NewMemoryStore returns a store keeping the operations in memory only.
+func NewMemoryStore() Store {
+	return &memoryStore{records: make(map[string]*Record)}
+}
+
+type memoryStore struct {
+	mu      sync.Mutex
+	records map[string]*Record
+}
+
+func (s *memoryStore) Save(record *Record) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.records[record.Operation.GetResourceId()] = cloneRecord(record)
+	return nil
+}
+
+func (s *memoryStore) Delete(id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.records, id)
+	return nil
+}
+
+func (s *memoryStore) LoadAll() ([]*Record, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	records := make([]*Record, 0, len(s.records))
+	for _, record := range s.records {
+		records = append(records, cloneRecord(record))
+	}
+	return records, nil
+}
+
+// fileRecord is the on-disk representation of a Record.
+type fileRecord struct {
+	TenantID  string          `json:"tenant_id"`
+	Operation json.RawMessage `json:"operation"`
+}
+
+// NewFileStore returns a store keeping each operation in a JSON file of the given directory,
+// which is created if missing.
+func NewFileStore(dir string) (Store, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		zlog.InfraErr(err).Msgf("failed to create operations directory %s", dir)
+		return nil, errors.Wrap(err)
+	}
+	return &fileStore{dir: dir}, nil
+}
+
+type fileStore struct {
+	mu  sync.Mutex
+	dir string
+}
+
+func (s *fileStore) path(id string) string {
+	return filepath.Join(s.dir, id+recordSuffix)
+}
+
+func (s *fileStore) Save(record *Record) error {
+	op, err := protojson.Marshal(record.Operation)
+	if err != nil {
+		return errors.Wrap(err)
+	}
+	data, err := json.Marshal(&fileRecord{TenantID: record.TenantID, Operation: op})
+	if err != nil {
+		return errors.Wrap(err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	// Write to a temporary file first, so that a crash never leaves a truncated record behind.
+	path := s.path(record.Operation.GetResourceId())
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return errors.Wrap(err)
+	}
+	return errors.Wrap(os.Rename(tmp, path))
+}
+
+func (s *fileStore) Delete(id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := os.Remove(s.path(id)); err != nil && !os.IsNotExist(err) {
+		return errors.Wrap(err)
+	}
+	return nil
+}
+
+func (s *fileStore) LoadAll() ([]*Record, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	records := make([]*Record, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), recordSuffix) {
+			continue
+		}
+		record, err := s.load(filepath.Join(s.dir, entry.Name()))
+		if err != nil {
+			// A corrupted record must not prevent the API from starting.
+			zlog.InfraErr(err).Msgf("skipping unreadable operation record %s", entry.Name())
+			continue
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+func (s *fileStore) load(path string) (*Record, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	var fr fileRecord
+	if err := json.Unmarshal(data, &fr); err != nil {
+		return nil, errors.Wrap(err)
+	}
+	op := &operationv1.OperationResource{}
+	if err := protojson.Unmarshal(fr.Operation, op); err != nil {
+		return nil, errors.Wrap(err)
+	}
+	return &Record{TenantID: fr.TenantID, Operation: op}, nil
+}
+
+func cloneRecord(record *Record) *Record {
+	op, ok := proto.Clone(record.Operation).(*operationv1.OperationResource)
+	if !ok {
+		op = &operationv1.OperationResource{}
+	}
+	return &Record{TenantID: record.TenantID, Operation: op}
+}
@@ -0,0 +1,322 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.30.0
+// 	protoc        (unknown)
+// source: resources/apitoken/v1/apitoken.proto
+
+package apitokenv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	v1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/resources/common/v1"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// The role granted to an API token in its project.
+type APITokenRole int32
+
+const (
+	APITokenRole_API_TOKEN_ROLE_UNSPECIFIED APITokenRole = 0
+	// Read access, as the im-r role.
+	APITokenRole_API_TOKEN_ROLE_READ APITokenRole = 1
+	// Read and write access, as the im-rw role.
+	APITokenRole_API_TOKEN_ROLE_READ_WRITE APITokenRole = 2
+)
+
+// Enum value maps for APITokenRole.
+var (
+	APITokenRole_name = map[int32]string{
+		0: "API_TOKEN_ROLE_UNSPECIFIED",
+		1: "API_TOKEN_ROLE_READ",
+		2: "API_TOKEN_ROLE_READ_WRITE",
+	}
+	APITokenRole_value = map[string]int32{
+		"API_TOKEN_ROLE_UNSPECIFIED": 0,
+		"API_TOKEN_ROLE_READ":        1,
+		"API_TOKEN_ROLE_READ_WRITE":  2,
+	}
+)
+
+func (x APITokenRole) Enum() *APITokenRole {
+	p := new(APITokenRole)
+	*p = x
+	return p
+}
+
+func (x APITokenRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (APITokenRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_resources_apitoken_v1_apitoken_proto_enumTypes[0].Descriptor()
+}
+
+func (APITokenRole) Type() protoreflect.EnumType {
+	return &file_resources_apitoken_v1_apitoken_proto_enumTypes[0]
+}
+
+func (x APITokenRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use APITokenRole.Descriptor instead.
+func (APITokenRole) EnumDescriptor() ([]byte, []int) {
+	return file_resources_apitoken_v1_apitoken_proto_rawDescGZIP(), []int{0}
+}
+
+// A project-scoped API token, for automation calling the API without a user JWT.
+// The token is sent as a bearer token, it is only returned when created and stored hashed.
+type APITokenResource struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// resource identifier, empty when the token is created
+	ResourceId string `protobuf:"bytes,1,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	// The name of the token, recorded as the actor in audit logs.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// The role granted to the token.
+	Role APITokenRole `protobuf:"varint,3,opt,name=role,proto3,enum=resources.apitoken.v1.APITokenRole" json:"role,omitempty"`
+	// The site the token is restricted to, if set: only the site and its hosts can be accessed.
+	SiteId string `protobuf:"bytes,4,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	// Validity of the token in seconds, between 1 hour and 1 year.
+	// Used to compute the expiration_timestamp when the token is created.
+	DurationSeconds uint32 `protobuf:"varint,5,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	// UTC timestamp (seconds) after which the token is rejected.
+	ExpirationTimestamp uint32 `protobuf:"varint,6,opt,name=expiration_timestamp,json=expirationTimestamp,proto3" json:"expiration_timestamp,omitempty"`
+	// The secret token, only returned when created.
+	Token string `protobuf:"bytes,7,opt,name=token,proto3" json:"token,omitempty"`
+	// Timestamps associated to the resource.
+	Timestamps *v1.Timestamps `protobuf:"bytes,50100,opt,name=timestamps,proto3" json:"timestamps,omitempty"`
+}
+
+func (x *APITokenResource) Reset() {
+	*x = APITokenResource{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_resources_apitoken_v1_apitoken_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *APITokenResource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*APITokenResource) ProtoMessage() {}
+
+func (x *APITokenResource) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_apitoken_v1_apitoken_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use APITokenResource.ProtoReflect.Descriptor instead.
+func (*APITokenResource) Descriptor() ([]byte, []int) {
+	return file_resources_apitoken_v1_apitoken_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *APITokenResource) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
+	}
+	return ""
+}
+
+func (x *APITokenResource) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *APITokenResource) GetRole() APITokenRole {
+	if x != nil {
+		return x.Role
+	}
+	return APITokenRole_API_TOKEN_ROLE_UNSPECIFIED
+}
+
+func (x *APITokenResource) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+func (x *APITokenResource) GetDurationSeconds() uint32 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+func (x *APITokenResource) GetExpirationTimestamp() uint32 {
+	if x != nil {
+		return x.ExpirationTimestamp
+	}
+	return 0
+}
+
+func (x *APITokenResource) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *APITokenResource) GetTimestamps() *v1.Timestamps {
+	if x != nil {
+		return x.Timestamps
+	}
+	return nil
+}
+
+var File_resources_apitoken_v1_apitoken_proto protoreflect.FileDescriptor
+
+var file_resources_apitoken_v1_apitoken_proto_rawDesc = []byte{
+	0x0a, 0x24, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x70, 0x69, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x15, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x73, 0x2e, 0x61, 0x70, 0x69, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x76, 0x31, 0x1a, 0x1b, 0x62,
+	0x75, 0x66, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x62, 0x65, 0x68,
+	0x61, 0x76, 0x69, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x72, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x76, 0x31,
+	0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xfa, 0x03,
+	0x0a, 0x10, 0x41, 0x50, 0x49, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x12, 0x47, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x26, 0xe0, 0x41, 0x03, 0xba, 0x48, 0x20, 0x72,
+	0x1e, 0x18, 0x29, 0x32, 0x1a, 0x5e, 0x24, 0x7c, 0x5e, 0x61, 0x70, 0x69, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x2d, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x66, 0x5d, 0x7b, 0x33, 0x32, 0x7d, 0x24, 0x52,
+	0x0a, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x12, 0x41, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2d, 0xe0, 0x41, 0x02, 0xba, 0x48,
+	0x27, 0x72, 0x25, 0x18, 0x3f, 0x32, 0x21, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30,
+	0x2d, 0x39, 0x5d, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5f, 0x2e, 0x2d,
+	0x5d, 0x7b, 0x30, 0x2c, 0x36, 0x32, 0x7d, 0x24, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x46,
+	0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x72,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x50, 0x49, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x6f, 0x6c,
+	0x65, 0x42, 0x0d, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x07, 0x82, 0x01, 0x04, 0x10, 0x01, 0x20, 0x00,
+	0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x3a, 0x0a, 0x07, 0x73, 0x69, 0x74, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x21, 0xe0, 0x41, 0x01, 0xba, 0x48, 0x1b, 0x72,
+	0x19, 0x18, 0x0d, 0x32, 0x15, 0x5e, 0x24, 0x7c, 0x5e, 0x73, 0x69, 0x74, 0x65, 0x2d, 0x5b, 0x30,
+	0x2d, 0x39, 0x61, 0x2d, 0x66, 0x5d, 0x7b, 0x38, 0x7d, 0x24, 0x52, 0x06, 0x73, 0x69, 0x74, 0x65,
+	0x49, 0x64, 0x12, 0x3b, 0x0a, 0x10, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x10, 0xe0, 0x41,
+	0x04, 0xba, 0x48, 0x0a, 0x2a, 0x08, 0x18, 0x80, 0xe7, 0x84, 0x0f, 0x28, 0x90, 0x1c, 0x52, 0x0f,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12,
+	0x36, 0x0a, 0x14, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x03, 0xe0,
+	0x41, 0x03, 0x52, 0x13, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x19, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x46, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x73,
+	0x18, 0xb4, 0x87, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x73, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x73, 0x42, 0x03, 0xe0, 0x41, 0x03, 0x52, 0x0a,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x73, 0x2a, 0x66, 0x0a, 0x0c, 0x41, 0x50,
+	0x49, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x1e, 0x0a, 0x1a, 0x41, 0x50,
+	0x49, 0x5f, 0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x41, 0x50,
+	0x49, 0x5f, 0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x52, 0x45, 0x41,
+	0x44, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x41, 0x50, 0x49, 0x5f, 0x54, 0x4f, 0x4b, 0x45, 0x4e,
+	0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45,
+	0x10, 0x02, 0x42, 0x63, 0x5a, 0x61, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x2d, 0x65, 0x64, 0x67, 0x65, 0x2d, 0x70, 0x6c, 0x61, 0x74, 0x66,
+	0x6f, 0x72, 0x6d, 0x2f, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x2d, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x61,
+	0x70, 0x69, 0x76, 0x32, 0x2f, 0x76, 0x32, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x2f, 0x70, 0x62, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73,
+	0x2f, 0x61, 0x70, 0x69, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2f, 0x76, 0x31, 0x3b, 0x61, 0x70, 0x69,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_resources_apitoken_v1_apitoken_proto_rawDescOnce sync.Once
+	file_resources_apitoken_v1_apitoken_proto_rawDescData = file_resources_apitoken_v1_apitoken_proto_rawDesc
+)
+
+func file_resources_apitoken_v1_apitoken_proto_rawDescGZIP() []byte {
+	file_resources_apitoken_v1_apitoken_proto_rawDescOnce.Do(func() {
+		file_resources_apitoken_v1_apitoken_proto_rawDescData = protoimpl.X.CompressGZIP(file_resources_apitoken_v1_apitoken_proto_rawDescData)
+	})
+	return file_resources_apitoken_v1_apitoken_proto_rawDescData
+}
+
+var file_resources_apitoken_v1_apitoken_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_resources_apitoken_v1_apitoken_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_resources_apitoken_v1_apitoken_proto_goTypes = []interface{}{
+	(APITokenRole)(0),        // 0: resources.apitoken.v1.APITokenRole
+	(*APITokenResource)(nil), // 1: resources.apitoken.v1.APITokenResource
+	(*v1.Timestamps)(nil),    // 2: resources.common.v1.Timestamps
+}
+var file_resources_apitoken_v1_apitoken_proto_depIdxs = []int32{
+	0, // 0: resources.apitoken.v1.APITokenResource.role:type_name -> resources.apitoken.v1.APITokenRole
+	2, // 1: resources.apitoken.v1.APITokenResource.timestamps:type_name -> resources.common.v1.Timestamps
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_resources_apitoken_v1_apitoken_proto_init() }
+func file_resources_apitoken_v1_apitoken_proto_init() {
+	if File_resources_apitoken_v1_apitoken_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_resources_apitoken_v1_apitoken_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*APITokenResource); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_resources_apitoken_v1_apitoken_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_resources_apitoken_v1_apitoken_proto_goTypes,
+		DependencyIndexes: file_resources_apitoken_v1_apitoken_proto_depIdxs,
+		EnumInfos:         file_resources_apitoken_v1_apitoken_proto_enumTypes,
+		MessageInfos:      file_resources_apitoken_v1_apitoken_proto_msgTypes,
+	}.Build()
+	File_resources_apitoken_v1_apitoken_proto = out.File
+	file_resources_apitoken_v1_apitoken_proto_rawDesc = nil
+	file_resources_apitoken_v1_apitoken_proto_goTypes = nil
+	file_resources_apitoken_v1_apitoken_proto_depIdxs = nil
+}
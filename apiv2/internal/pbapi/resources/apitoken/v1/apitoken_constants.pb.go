@@ -0,0 +1,17 @@
+// Code generated by protoc-gen-go-const. DO NOT EDIT.
+
+// source: resources/apitoken/v1/apitoken.proto
+
+package apitokenv1
+
+const (
+	// Fields and Edges constants for "APITokenResource"
+	APITokenResourceFieldResourceId          = "resource_id"
+	APITokenResourceFieldName                = "name"
+	APITokenResourceFieldRole                = "role"
+	APITokenResourceFieldSiteId              = "site_id"
+	APITokenResourceFieldDurationSeconds     = "duration_seconds"
+	APITokenResourceFieldExpirationTimestamp = "expiration_timestamp"
+	APITokenResourceFieldToken               = "token"
+	APITokenResourceEdgeTimestamps           = "timestamps"
+)
@@ -87,6 +87,8 @@ const (
 	NetworkInterfaceLinkStateEdgeTimestamps = "timestamps"
 
 	// Fields and Edges constants for "HostnicResource"
+	HostnicResourceFieldResourceId    = "resource_id"
+	HostnicResourceFieldHostId        = "host_id"
 	HostnicResourceFieldDeviceName    = "device_name"
 	HostnicResourceFieldPciIdentifier = "pci_identifier"
 	HostnicResourceFieldMacAddr       = "mac_addr"
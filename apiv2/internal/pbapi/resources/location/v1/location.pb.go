@@ -153,6 +153,123 @@ func (x *RegionResource) GetTimestamps() *v1.Timestamps {
 	return nil
 }
 
+// An organizational unit (OU) resource.
+type OuResource struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// resource ID, generated by the inventory on Create.
+	ResourceId string `protobuf:"bytes,1,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	// The user-provided, human-readable name of the OU.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// The kind of the OU, like "Organization" or "BU".
+	OuKind string `protobuf:"bytes,3,opt,name=ou_kind,json=ouKind,proto3" json:"ou_kind,omitempty"`
+	// The parent OU associated to the OU, when existent.
+	ParentOu *OuResource `protobuf:"bytes,4,opt,name=parent_ou,json=parentOu,proto3" json:"parent_ou,omitempty"`
+	// The metadata associated to the OU,
+	// represented by a list of key:value pairs.
+	Metadata []*v1.MetadataItem `protobuf:"bytes,5002,rep,name=metadata,proto3" json:"metadata,omitempty"`
+	// The rendered metadata from the OU parent(s)
+	// that can be inherited by the OU, represented by a list of key:value pairs.
+	// This field can not be used in filter.
+	InheritedMetadata []*v1.MetadataItem `protobuf:"bytes,5003,rep,name=inherited_metadata,json=inheritedMetadata,proto3" json:"inherited_metadata,omitempty"`
+	// The parent OU unique identifier
+	// that the OU is associated to, when existent.
+	// This field can not be used in filter.
+	ParentId string `protobuf:"bytes,5005,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	// Timestamps associated to the resource.
+	Timestamps *v1.Timestamps `protobuf:"bytes,50100,opt,name=timestamps,proto3" json:"timestamps,omitempty"`
+}
+
+func (x *OuResource) Reset() {
+	*x = OuResource{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_resources_location_v1_location_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OuResource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OuResource) ProtoMessage() {}
+
+func (x *OuResource) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_location_v1_location_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OuResource.ProtoReflect.Descriptor instead.
+func (*OuResource) Descriptor() ([]byte, []int) {
+	return file_resources_location_v1_location_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *OuResource) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
+	}
+	return ""
+}
+
+func (x *OuResource) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *OuResource) GetOuKind() string {
+	if x != nil {
+		return x.OuKind
+	}
+	return ""
+}
+
+func (x *OuResource) GetParentOu() *OuResource {
+	if x != nil {
+		return x.ParentOu
+	}
+	return nil
+}
+
+func (x *OuResource) GetMetadata() []*v1.MetadataItem {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *OuResource) GetInheritedMetadata() []*v1.MetadataItem {
+	if x != nil {
+		return x.InheritedMetadata
+	}
+	return nil
+}
+
+func (x *OuResource) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *OuResource) GetTimestamps() *v1.Timestamps {
+	if x != nil {
+		return x.Timestamps
+	}
+	return nil
+}
+
 // A site resource.
 type SiteResource struct {
 	state         protoimpl.MessageState
@@ -165,6 +282,8 @@ type SiteResource struct {
 	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	// Region this site is located in
 	Region *RegionResource `protobuf:"bytes,4,opt,name=region,proto3" json:"region,omitempty"`
+	// OU this site, and the hosts located in it, are part of
+	Ou *OuResource `protobuf:"bytes,5,opt,name=ou,proto3" json:"ou,omitempty"`
 	// The geolocation latitude of the site.
 	// Points are represented as latitude-longitude pairs in the E7 representation
 	// (degrees are multiplied by 10**7 and rounded to the nearest integer).
@@ -189,6 +308,9 @@ type SiteResource struct {
 	// The region's unique identifier
 	// that the site is associated to. This field cannot be used in filter.
 	RegionId string `protobuf:"bytes,5004,opt,name=region_id,json=regionId,proto3" json:"region_id,omitempty"`
+	// The OU's unique identifier
+	// that the site is associated to. This field cannot be used in filter.
+	OuId string `protobuf:"bytes,5005,opt,name=ou_id,json=ouId,proto3" json:"ou_id,omitempty"`
 	// Timestamps associated to the resource.
 	Timestamps *v1.Timestamps `protobuf:"bytes,50100,opt,name=timestamps,proto3" json:"timestamps,omitempty"`
 }
@@ -196,7 +318,7 @@ type SiteResource struct {
 func (x *SiteResource) Reset() {
 	*x = SiteResource{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_resources_location_v1_location_proto_msgTypes[1]
+		mi := &file_resources_location_v1_location_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -209,7 +331,7 @@ func (x *SiteResource) String() string {
 func (*SiteResource) ProtoMessage() {}
 
 func (x *SiteResource) ProtoReflect() protoreflect.Message {
-	mi := &file_resources_location_v1_location_proto_msgTypes[1]
+	mi := &file_resources_location_v1_location_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -222,7 +344,7 @@ func (x *SiteResource) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SiteResource.ProtoReflect.Descriptor instead.
 func (*SiteResource) Descriptor() ([]byte, []int) {
-	return file_resources_location_v1_location_proto_rawDescGZIP(), []int{1}
+	return file_resources_location_v1_location_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *SiteResource) GetResourceId() string {
@@ -246,6 +368,13 @@ func (x *SiteResource) GetRegion() *RegionResource {
 	return nil
 }
 
+func (x *SiteResource) GetOu() *OuResource {
+	if x != nil {
+		return x.Ou
+	}
+	return nil
+}
+
 func (x *SiteResource) GetSiteLat() int32 {
 	if x != nil {
 		return x.SiteLat
@@ -295,6 +424,13 @@ func (x *SiteResource) GetRegionId() string {
 	return ""
 }
 
+func (x *SiteResource) GetOuId() string {
+	if x != nil {
+		return x.OuId
+	}
+	return ""
+}
+
 func (x *SiteResource) GetTimestamps() *v1.Timestamps {
 	if x != nil {
 		return x.Timestamps
@@ -357,59 +493,101 @@ var file_resources_location_v1_location_proto_rawDesc = []byte{
 	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
 	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x73, 0x42, 0x03,
 	0xe0, 0x41, 0x03, 0x52, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x73, 0x22,
-	0xdf, 0x05, 0x0a, 0x0c, 0x53, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x12, 0x3f, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xe0, 0x41, 0x03, 0xba, 0x48, 0x18, 0x72, 0x16, 0x18,
-	0x0d, 0x32, 0x12, 0x5e, 0x73, 0x69, 0x74, 0x65, 0x2d, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x66,
-	0x5d, 0x7b, 0x38, 0x7d, 0x24, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49,
-	0x64, 0x12, 0x34, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x20, 0xba, 0x48, 0x1d, 0x72, 0x1b, 0x18, 0x32, 0x32, 0x17, 0x5e, 0x24, 0x7c, 0x5e, 0x5b, 0x61,
-	0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x2d, 0x5f, 0x30, 0x2d, 0x39, 0x2e, 0x2f, 0x3a, 0x20, 0x5d, 0x2b,
-	0x24, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x42, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f,
-	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x73, 0x2e, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
-	0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x03,
-	0xe0, 0x41, 0x03, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x0a, 0x08, 0x73,
-	0x69, 0x74, 0x65, 0x5f, 0x6c, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x42, 0x16, 0xba,
-	0x48, 0x13, 0x1a, 0x11, 0x18, 0x80, 0xd2, 0x93, 0xad, 0x03, 0x28, 0x80, 0xae, 0xec, 0xd2, 0xfc,
-	0xff, 0xff, 0xff, 0xff, 0x01, 0x52, 0x07, 0x73, 0x69, 0x74, 0x65, 0x4c, 0x61, 0x74, 0x12, 0x31,
-	0x0a, 0x08, 0x73, 0x69, 0x74, 0x65, 0x5f, 0x6c, 0x6e, 0x67, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05,
-	0x42, 0x16, 0xba, 0x48, 0x13, 0x1a, 0x11, 0x18, 0x80, 0xa4, 0xa7, 0xda, 0x06, 0x28, 0x80, 0xdc,
-	0xd8, 0xa5, 0xf9, 0xff, 0xff, 0xff, 0xff, 0x01, 0x52, 0x07, 0x73, 0x69, 0x74, 0x65, 0x4c, 0x6e,
-	0x67, 0x12, 0x43, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x1e, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2e,
-	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76,
-	0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x08, 0x70, 0x72,
-	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x37, 0x0a, 0x06, 0x73, 0x69, 0x74, 0x65, 0x49, 0x44,
-	0x18, 0x89, 0x27, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xe0, 0x41, 0x03, 0xba, 0x48, 0x18, 0x72,
-	0x16, 0x18, 0x0d, 0x32, 0x12, 0x5e, 0x73, 0x69, 0x74, 0x65, 0x2d, 0x5b, 0x30, 0x2d, 0x39, 0x61,
-	0x2d, 0x66, 0x5d, 0x7b, 0x38, 0x7d, 0x24, 0x52, 0x06, 0x73, 0x69, 0x74, 0x65, 0x49, 0x44, 0x12,
-	0x4d, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x8a, 0x27, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2e, 0x63,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x49, 0x74, 0x65, 0x6d, 0x42, 0x0d, 0xe0, 0x41, 0x01, 0xba, 0x48, 0x07, 0x92, 0x01, 0x04,
-	0x08, 0x00, 0x10, 0x64, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x56,
-	0x0a, 0x12, 0x69, 0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x65, 0x64, 0x5f, 0x6d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0x18, 0x8b, 0x27, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x72, 0x65,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
-	0x31, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x49, 0x74, 0x65, 0x6d, 0x42, 0x03,
-	0xe0, 0x41, 0x03, 0x52, 0x11, 0x69, 0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x65, 0x64, 0x4d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x41, 0x0a, 0x09, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e,
-	0x5f, 0x69, 0x64, 0x18, 0x8c, 0x27, 0x20, 0x01, 0x28, 0x09, 0x42, 0x23, 0xe0, 0x41, 0x04, 0xba,
-	0x48, 0x1d, 0x72, 0x1b, 0x18, 0x0f, 0x32, 0x17, 0x5e, 0x24, 0x7c, 0x5e, 0x72, 0x65, 0x67, 0x69,
-	0x6f, 0x6e, 0x2d, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x66, 0x5d, 0x7b, 0x38, 0x7d, 0x24, 0x52,
-	0x08, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x46, 0x0a, 0x0a, 0x74, 0x69, 0x6d,
+	0xaf, 0x04, 0x0a, 0x0a, 0x4f, 0x75, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x3d,
+	0x0a, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x1c, 0xe0, 0x41, 0x03, 0xba, 0x48, 0x16, 0x72, 0x14, 0x18, 0x0b, 0x32,
+	0x10, 0x5e, 0x6f, 0x75, 0x2d, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x66, 0x5d, 0x7b, 0x38, 0x7d,
+	0x24, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x12, 0x34, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x20, 0xba, 0x48, 0x1d,
+	0x72, 0x1b, 0x18, 0x32, 0x32, 0x17, 0x5e, 0x24, 0x7c, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d,
+	0x5a, 0x2d, 0x5f, 0x30, 0x2d, 0x39, 0x2e, 0x2f, 0x3a, 0x20, 0x5d, 0x2b, 0x24, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x39, 0x0a, 0x07, 0x6f, 0x75, 0x5f, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x20, 0xba, 0x48, 0x1d, 0x72, 0x1b, 0x18, 0x32, 0x32, 0x17, 0x5e,
+	0x24, 0x7c, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x2d, 0x5f, 0x30, 0x2d, 0x39, 0x2e,
+	0x2f, 0x3a, 0x20, 0x5d, 0x2b, 0x24, 0x52, 0x06, 0x6f, 0x75, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x43,
+	0x0a, 0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x6f, 0x75, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x21, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2e, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x75, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x42, 0x03, 0xe0, 0x41, 0x03, 0x52, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e,
+	0x74, 0x4f, 0x75, 0x12, 0x4d, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x8a, 0x27, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x73, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x49, 0x74, 0x65, 0x6d, 0x42, 0x0d, 0xe0, 0x41, 0x01, 0xba, 0x48,
+	0x07, 0x92, 0x01, 0x04, 0x08, 0x00, 0x10, 0x64, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x12, 0x56, 0x0a, 0x12, 0x69, 0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x65, 0x64, 0x5f,
+	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x8b, 0x27, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x21, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x49, 0x74,
+	0x65, 0x6d, 0x42, 0x03, 0xe0, 0x41, 0x03, 0x52, 0x11, 0x69, 0x6e, 0x68, 0x65, 0x72, 0x69, 0x74,
+	0x65, 0x64, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x3d, 0x0a, 0x09, 0x70, 0x61,
+	0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x8d, 0x27, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1f,
+	0xe0, 0x41, 0x04, 0xba, 0x48, 0x19, 0x72, 0x17, 0x18, 0x0b, 0x32, 0x13, 0x5e, 0x24, 0x7c, 0x5e,
+	0x6f, 0x75, 0x2d, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x66, 0x5d, 0x7b, 0x38, 0x7d, 0x24, 0x52,
+	0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x46, 0x0a, 0x0a, 0x74, 0x69, 0x6d,
 	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x73, 0x18, 0xb4, 0x87, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x1f, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
 	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x73,
 	0x42, 0x03, 0xe0, 0x41, 0x03, 0x52, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x73, 0x42, 0x63, 0x5a, 0x61, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x6f, 0x70, 0x65, 0x6e, 0x2d, 0x65, 0x64, 0x67, 0x65, 0x2d, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f,
-	0x72, 0x6d, 0x2f, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x2d, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x61, 0x70,
-	0x69, 0x76, 0x32, 0x2f, 0x76, 0x32, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f,
-	0x70, 0x62, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2f,
-	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x3b, 0x6c, 0x6f, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x73, 0x22, 0xce, 0x06, 0x0a, 0x0c, 0x53, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x12, 0x3f, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xe0, 0x41, 0x03, 0xba, 0x48, 0x18, 0x72,
+	0x16, 0x18, 0x0d, 0x32, 0x12, 0x5e, 0x73, 0x69, 0x74, 0x65, 0x2d, 0x5b, 0x30, 0x2d, 0x39, 0x61,
+	0x2d, 0x66, 0x5d, 0x7b, 0x38, 0x7d, 0x24, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x20, 0xba, 0x48, 0x1d, 0x72, 0x1b, 0x18, 0x32, 0x32, 0x17, 0x5e, 0x24, 0x7c, 0x5e,
+	0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x2d, 0x5f, 0x30, 0x2d, 0x39, 0x2e, 0x2f, 0x3a, 0x20,
+	0x5d, 0x2b, 0x24, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x42, 0x0a, 0x06, 0x72, 0x65, 0x67,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x73, 0x2e, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x42, 0x03, 0xe0, 0x41, 0x03, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a,
+	0x02, 0x6f, 0x75, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x73, 0x2e, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x4f, 0x75, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x03, 0xe0, 0x41,
+	0x03, 0x52, 0x02, 0x6f, 0x75, 0x12, 0x31, 0x0a, 0x08, 0x73, 0x69, 0x74, 0x65, 0x5f, 0x6c, 0x61,
+	0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x42, 0x16, 0xba, 0x48, 0x13, 0x1a, 0x11, 0x18, 0x80,
+	0xd2, 0x93, 0xad, 0x03, 0x28, 0x80, 0xae, 0xec, 0xd2, 0xfc, 0xff, 0xff, 0xff, 0xff, 0x01, 0x52,
+	0x07, 0x73, 0x69, 0x74, 0x65, 0x4c, 0x61, 0x74, 0x12, 0x31, 0x0a, 0x08, 0x73, 0x69, 0x74, 0x65,
+	0x5f, 0x6c, 0x6e, 0x67, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x42, 0x16, 0xba, 0x48, 0x13, 0x1a,
+	0x11, 0x18, 0x80, 0xa4, 0xa7, 0xda, 0x06, 0x28, 0x80, 0xdc, 0xd8, 0xa5, 0xf9, 0xff, 0xff, 0xff,
+	0xff, 0x01, 0x52, 0x07, 0x73, 0x69, 0x74, 0x65, 0x4c, 0x6e, 0x67, 0x12, 0x43, 0x0a, 0x08, 0x70,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e,
+	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x12, 0x37, 0x0a, 0x06, 0x73, 0x69, 0x74, 0x65, 0x49, 0x44, 0x18, 0x89, 0x27, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x1e, 0xe0, 0x41, 0x03, 0xba, 0x48, 0x18, 0x72, 0x16, 0x18, 0x0d, 0x32, 0x12, 0x5e,
+	0x73, 0x69, 0x74, 0x65, 0x2d, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x66, 0x5d, 0x7b, 0x38, 0x7d,
+	0x24, 0x52, 0x06, 0x73, 0x69, 0x74, 0x65, 0x49, 0x44, 0x12, 0x4d, 0x0a, 0x08, 0x6d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x8a, 0x27, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x72,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x49, 0x74, 0x65, 0x6d, 0x42,
+	0x0d, 0xe0, 0x41, 0x01, 0xba, 0x48, 0x07, 0x92, 0x01, 0x04, 0x08, 0x00, 0x10, 0x64, 0x52, 0x08,
+	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x56, 0x0a, 0x12, 0x69, 0x6e, 0x68, 0x65,
+	0x72, 0x69, 0x74, 0x65, 0x64, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x8b,
+	0x27, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x73, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x49, 0x74, 0x65, 0x6d, 0x42, 0x03, 0xe0, 0x41, 0x03, 0x52, 0x11, 0x69,
+	0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x12, 0x41, 0x0a, 0x09, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x8c, 0x27,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x23, 0xe0, 0x41, 0x04, 0xba, 0x48, 0x1d, 0x72, 0x1b, 0x18, 0x0f,
+	0x32, 0x17, 0x5e, 0x24, 0x7c, 0x5e, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x2d, 0x5b, 0x30, 0x2d,
+	0x39, 0x61, 0x2d, 0x66, 0x5d, 0x7b, 0x38, 0x7d, 0x24, 0x52, 0x08, 0x72, 0x65, 0x67, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x05, 0x6f, 0x75, 0x5f, 0x69, 0x64, 0x18, 0x8d, 0x27, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x1f, 0xe0, 0x41, 0x04, 0xba, 0x48, 0x19, 0x72, 0x17, 0x18, 0x0b, 0x32,
+	0x13, 0x5e, 0x24, 0x7c, 0x5e, 0x6f, 0x75, 0x2d, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x66, 0x5d,
+	0x7b, 0x38, 0x7d, 0x24, 0x52, 0x04, 0x6f, 0x75, 0x49, 0x64, 0x12, 0x46, 0x0a, 0x0a, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x73, 0x18, 0xb4, 0x87, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1f, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x73, 0x42, 0x03, 0xe0, 0x41, 0x03, 0x52, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x73, 0x42, 0x63, 0x5a, 0x61, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x2d, 0x65, 0x64, 0x67, 0x65, 0x2d, 0x70, 0x6c, 0x61, 0x74, 0x66,
+	0x6f, 0x72, 0x6d, 0x2f, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x2d, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x61,
+	0x70, 0x69, 0x76, 0x32, 0x2f, 0x76, 0x32, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x2f, 0x70, 0x62, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73,
+	0x2f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x3b, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -424,29 +602,35 @@ func file_resources_location_v1_location_proto_rawDescGZIP() []byte {
 	return file_resources_location_v1_location_proto_rawDescData
 }
 
-var file_resources_location_v1_location_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_resources_location_v1_location_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_resources_location_v1_location_proto_goTypes = []interface{}{
 	(*RegionResource)(nil),       // 0: resources.location.v1.RegionResource
-	(*SiteResource)(nil),         // 1: resources.location.v1.SiteResource
-	(*v1.MetadataItem)(nil),      // 2: resources.common.v1.MetadataItem
-	(*v1.Timestamps)(nil),        // 3: resources.common.v1.Timestamps
-	(*v11.ProviderResource)(nil), // 4: resources.provider.v1.ProviderResource
+	(*OuResource)(nil),           // 1: resources.location.v1.OuResource
+	(*SiteResource)(nil),         // 2: resources.location.v1.SiteResource
+	(*v1.MetadataItem)(nil),      // 3: resources.common.v1.MetadataItem
+	(*v1.Timestamps)(nil),        // 4: resources.common.v1.Timestamps
+	(*v11.ProviderResource)(nil), // 5: resources.provider.v1.ProviderResource
 }
 var file_resources_location_v1_location_proto_depIdxs = []int32{
-	0, // 0: resources.location.v1.RegionResource.parent_region:type_name -> resources.location.v1.RegionResource
-	2, // 1: resources.location.v1.RegionResource.metadata:type_name -> resources.common.v1.MetadataItem
-	2, // 2: resources.location.v1.RegionResource.inherited_metadata:type_name -> resources.common.v1.MetadataItem
-	3, // 3: resources.location.v1.RegionResource.timestamps:type_name -> resources.common.v1.Timestamps
-	0, // 4: resources.location.v1.SiteResource.region:type_name -> resources.location.v1.RegionResource
-	4, // 5: resources.location.v1.SiteResource.provider:type_name -> resources.provider.v1.ProviderResource
-	2, // 6: resources.location.v1.SiteResource.metadata:type_name -> resources.common.v1.MetadataItem
-	2, // 7: resources.location.v1.SiteResource.inherited_metadata:type_name -> resources.common.v1.MetadataItem
-	3, // 8: resources.location.v1.SiteResource.timestamps:type_name -> resources.common.v1.Timestamps
-	9, // [9:9] is the sub-list for method output_type
-	9, // [9:9] is the sub-list for method input_type
-	9, // [9:9] is the sub-list for extension type_name
-	9, // [9:9] is the sub-list for extension extendee
-	0, // [0:9] is the sub-list for field type_name
+	0,  // 0: resources.location.v1.RegionResource.parent_region:type_name -> resources.location.v1.RegionResource
+	3,  // 1: resources.location.v1.RegionResource.metadata:type_name -> resources.common.v1.MetadataItem
+	3,  // 2: resources.location.v1.RegionResource.inherited_metadata:type_name -> resources.common.v1.MetadataItem
+	4,  // 3: resources.location.v1.RegionResource.timestamps:type_name -> resources.common.v1.Timestamps
+	1,  // 4: resources.location.v1.OuResource.parent_ou:type_name -> resources.location.v1.OuResource
+	3,  // 5: resources.location.v1.OuResource.metadata:type_name -> resources.common.v1.MetadataItem
+	3,  // 6: resources.location.v1.OuResource.inherited_metadata:type_name -> resources.common.v1.MetadataItem
+	4,  // 7: resources.location.v1.OuResource.timestamps:type_name -> resources.common.v1.Timestamps
+	0,  // 8: resources.location.v1.SiteResource.region:type_name -> resources.location.v1.RegionResource
+	1,  // 9: resources.location.v1.SiteResource.ou:type_name -> resources.location.v1.OuResource
+	5,  // 10: resources.location.v1.SiteResource.provider:type_name -> resources.provider.v1.ProviderResource
+	3,  // 11: resources.location.v1.SiteResource.metadata:type_name -> resources.common.v1.MetadataItem
+	3,  // 12: resources.location.v1.SiteResource.inherited_metadata:type_name -> resources.common.v1.MetadataItem
+	4,  // 13: resources.location.v1.SiteResource.timestamps:type_name -> resources.common.v1.Timestamps
+	14, // [14:14] is the sub-list for method output_type
+	14, // [14:14] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_resources_location_v1_location_proto_init() }
@@ -468,6 +652,18 @@ func file_resources_location_v1_location_proto_init() {
 			}
 		}
 		file_resources_location_v1_location_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OuResource); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_resources_location_v1_location_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SiteResource); i {
 			case 0:
 				return &v.state
@@ -486,7 +682,7 @@ func file_resources_location_v1_location_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_resources_location_v1_location_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -16,10 +16,21 @@ const (
 	RegionResourceFieldParentId         = "parent_id"
 	RegionResourceEdgeTimestamps        = "timestamps"
 
+	// Fields and Edges constants for "OuResource"
+	OuResourceFieldResourceId       = "resource_id"
+	OuResourceFieldName             = "name"
+	OuResourceFieldOuKind           = "ou_kind"
+	OuResourceEdgeParentOu          = "parent_ou"
+	OuResourceEdgeMetadata          = "metadata"
+	OuResourceEdgeInheritedMetadata = "inherited_metadata"
+	OuResourceFieldParentId         = "parent_id"
+	OuResourceEdgeTimestamps        = "timestamps"
+
 	// Fields and Edges constants for "SiteResource"
 	SiteResourceFieldResourceId       = "resource_id"
 	SiteResourceFieldName             = "name"
 	SiteResourceEdgeRegion            = "region"
+	SiteResourceEdgeOu                = "ou"
 	SiteResourceFieldSiteLat          = "site_lat"
 	SiteResourceFieldSiteLng          = "site_lng"
 	SiteResourceEdgeProvider          = "provider"
@@ -27,5 +38,6 @@ const (
 	SiteResourceEdgeMetadata          = "metadata"
 	SiteResourceEdgeInheritedMetadata = "inherited_metadata"
 	SiteResourceFieldRegionId         = "region_id"
+	SiteResourceFieldOuId             = "ou_id"
 	SiteResourceEdgeTimestamps        = "timestamps"
 )
@@ -11,5 +11,33 @@ const (
 	IPAddressResourceFieldStatus       = "status"
 	IPAddressResourceFieldStatusDetail = "status_detail"
 	IPAddressResourceFieldConfigMethod = "config_method"
+	IPAddressResourceFieldNicId        = "nic_id"
+	IPAddressResourceFieldHostId       = "host_id"
 	IPAddressResourceEdgeTimestamps    = "timestamps"
+
+	// Fields and Edges constants for "NetworkSegmentResource"
+	NetworkSegmentResourceFieldResourceId = "resource_id"
+	NetworkSegmentResourceFieldName       = "name"
+	NetworkSegmentResourceFieldVlanId     = "vlan_id"
+	NetworkSegmentResourceEdgeSite        = "site"
+	NetworkSegmentResourceFieldSiteId     = "site_id"
+	NetworkSegmentResourceEdgeTimestamps  = "timestamps"
+
+	// Fields and Edges constants for "EndpointResource"
+	EndpointResourceFieldResourceId = "resource_id"
+	EndpointResourceFieldKind       = "kind"
+	EndpointResourceFieldName       = "name"
+	EndpointResourceFieldHostId     = "host_id"
+	EndpointResourceEdgeTimestamps  = "timestamps"
+
+	// Fields and Edges constants for "NetlinkResource"
+	NetlinkResourceFieldResourceId     = "resource_id"
+	NetlinkResourceFieldKind           = "kind"
+	NetlinkResourceFieldName           = "name"
+	NetlinkResourceFieldDesiredState   = "desired_state"
+	NetlinkResourceFieldCurrentState   = "current_state"
+	NetlinkResourceFieldProviderStatus = "provider_status"
+	NetlinkResourceEdgeSrc             = "src"
+	NetlinkResourceEdgeDst             = "dst"
+	NetlinkResourceEdgeTimestamps      = "timestamps"
 )
@@ -0,0 +1,24 @@
+// Code generated by protoc-gen-go-const. DO NOT EDIT.
+
+// source: resources/operation/v1/operation.proto
+
+package operationv1
+
+const (
+	// Fields and Edges constants for "OperationResult"
+	OperationResultFieldResourceId = "resource_id"
+	OperationResultFieldError      = "error"
+
+	// Fields and Edges constants for "OperationResource"
+	OperationResourceFieldResourceId      = "resource_id"
+	OperationResourceFieldKind            = "kind"
+	OperationResourceFieldState           = "state"
+	OperationResourceFieldDone            = "done"
+	OperationResourceFieldProgressPercent = "progress_percent"
+	OperationResourceFieldTotal           = "total"
+	OperationResourceFieldSucceeded       = "succeeded"
+	OperationResourceFieldFailed          = "failed"
+	OperationResourceFieldError           = "error"
+	OperationResourceEdgeResults          = "results"
+	OperationResourceEdgeTimestamps       = "timestamps"
+)
@@ -0,0 +1,389 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.30.0
+// 	protoc        (unknown)
+// source: resources/remoteaccess/v1/remoteaccess.proto
+
+package remoteaccessv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	v11 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/resources/common/v1"
+	v1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/resources/status/v1"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// The state of a remote access session.
+type RemoteAccessState int32
+
+const (
+	RemoteAccessState_REMOTE_ACCESS_STATE_UNSPECIFIED RemoteAccessState = 0
+	RemoteAccessState_REMOTE_ACCESS_STATE_DELETED     RemoteAccessState = 1
+	RemoteAccessState_REMOTE_ACCESS_STATE_ERROR       RemoteAccessState = 2
+	RemoteAccessState_REMOTE_ACCESS_STATE_ENABLED     RemoteAccessState = 3
+)
+
+// Enum value maps for RemoteAccessState.
+var (
+	RemoteAccessState_name = map[int32]string{
+		0: "REMOTE_ACCESS_STATE_UNSPECIFIED",
+		1: "REMOTE_ACCESS_STATE_DELETED",
+		2: "REMOTE_ACCESS_STATE_ERROR",
+		3: "REMOTE_ACCESS_STATE_ENABLED",
+	}
+	RemoteAccessState_value = map[string]int32{
+		"REMOTE_ACCESS_STATE_UNSPECIFIED": 0,
+		"REMOTE_ACCESS_STATE_DELETED":     1,
+		"REMOTE_ACCESS_STATE_ERROR":       2,
+		"REMOTE_ACCESS_STATE_ENABLED":     3,
+	}
+)
+
+func (x RemoteAccessState) Enum() *RemoteAccessState {
+	p := new(RemoteAccessState)
+	*p = x
+	return p
+}
+
+func (x RemoteAccessState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RemoteAccessState) Descriptor() protoreflect.EnumDescriptor {
+	return file_resources_remoteaccess_v1_remoteaccess_proto_enumTypes[0].Descriptor()
+}
+
+func (RemoteAccessState) Type() protoreflect.EnumType {
+	return &file_resources_remoteaccess_v1_remoteaccess_proto_enumTypes[0]
+}
+
+func (x RemoteAccessState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RemoteAccessState.Descriptor instead.
+func (RemoteAccessState) EnumDescriptor() ([]byte, []int) {
+	return file_resources_remoteaccess_v1_remoteaccess_proto_rawDescGZIP(), []int{0}
+}
+
+// A time-bounded remote access (reverse SSH) session to an Instance.
+type RemoteAccessResource struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// resource identifier
+	ResourceId string `protobuf:"bytes,1,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	// The Instance to be accessed remotely.
+	InstanceId string `protobuf:"bytes,2,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	// Duration of the session in seconds, between 15 minutes and 24 hours.
+	// Used to compute the expiration_timestamp when the session is created.
+	DurationSeconds uint32 `protobuf:"varint,3,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	// UTC timestamp (seconds) after which the session is revoked.
+	ExpirationTimestamp uint32 `protobuf:"varint,4,opt,name=expiration_timestamp,json=expirationTimestamp,proto3" json:"expiration_timestamp,omitempty"`
+	// Port terminating the reverse SSH tunnel on the orchestrator side.
+	LocalPort uint32 `protobuf:"varint,5,opt,name=local_port,json=localPort,proto3" json:"local_port,omitempty"`
+	// Name of the remote user configured on the SSH server of the Instance.
+	User string `protobuf:"bytes,6,opt,name=user,proto3" json:"user,omitempty"`
+	// The current state of the session. Set by the resource manager.
+	CurrentState RemoteAccessState `protobuf:"varint,7,opt,name=current_state,json=currentState,proto3,enum=resources.remoteaccess.v1.RemoteAccessState" json:"current_state,omitempty"`
+	// The desired state of the session.
+	DesiredState RemoteAccessState `protobuf:"varint,8,opt,name=desired_state,json=desiredState,proto3,enum=resources.remoteaccess.v1.RemoteAccessState" json:"desired_state,omitempty"`
+	// textual message that describes the configuration status of the session. Set by RMs only.
+	ConfigurationStatus string `protobuf:"bytes,9,opt,name=configuration_status,json=configurationStatus,proto3" json:"configuration_status,omitempty"`
+	// Indicates interpretation of configuration_status. Set by RMs only.
+	ConfigurationStatusIndicator v1.StatusIndication `protobuf:"varint,10,opt,name=configuration_status_indicator,json=configurationStatusIndicator,proto3,enum=resources.status.v1.StatusIndication" json:"configuration_status_indicator,omitempty"`
+	// UTC timestamp when configuration_status was last changed. Set by RMs only.
+	ConfigurationStatusTimestamp uint32 `protobuf:"varint,11,opt,name=configuration_status_timestamp,json=configurationStatusTimestamp,proto3" json:"configuration_status_timestamp,omitempty"`
+	// Timestamps associated to the resource.
+	Timestamps *v11.Timestamps `protobuf:"bytes,50100,opt,name=timestamps,proto3" json:"timestamps,omitempty"`
+}
+
+func (x *RemoteAccessResource) Reset() {
+	*x = RemoteAccessResource{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_resources_remoteaccess_v1_remoteaccess_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoteAccessResource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoteAccessResource) ProtoMessage() {}
+
+func (x *RemoteAccessResource) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_remoteaccess_v1_remoteaccess_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoteAccessResource.ProtoReflect.Descriptor instead.
+func (*RemoteAccessResource) Descriptor() ([]byte, []int) {
+	return file_resources_remoteaccess_v1_remoteaccess_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RemoteAccessResource) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
+	}
+	return ""
+}
+
+func (x *RemoteAccessResource) GetInstanceId() string {
+	if x != nil {
+		return x.InstanceId
+	}
+	return ""
+}
+
+func (x *RemoteAccessResource) GetDurationSeconds() uint32 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+func (x *RemoteAccessResource) GetExpirationTimestamp() uint32 {
+	if x != nil {
+		return x.ExpirationTimestamp
+	}
+	return 0
+}
+
+func (x *RemoteAccessResource) GetLocalPort() uint32 {
+	if x != nil {
+		return x.LocalPort
+	}
+	return 0
+}
+
+func (x *RemoteAccessResource) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *RemoteAccessResource) GetCurrentState() RemoteAccessState {
+	if x != nil {
+		return x.CurrentState
+	}
+	return RemoteAccessState_REMOTE_ACCESS_STATE_UNSPECIFIED
+}
+
+func (x *RemoteAccessResource) GetDesiredState() RemoteAccessState {
+	if x != nil {
+		return x.DesiredState
+	}
+	return RemoteAccessState_REMOTE_ACCESS_STATE_UNSPECIFIED
+}
+
+func (x *RemoteAccessResource) GetConfigurationStatus() string {
+	if x != nil {
+		return x.ConfigurationStatus
+	}
+	return ""
+}
+
+func (x *RemoteAccessResource) GetConfigurationStatusIndicator() v1.StatusIndication {
+	if x != nil {
+		return x.ConfigurationStatusIndicator
+	}
+	return v1.StatusIndication(0)
+}
+
+func (x *RemoteAccessResource) GetConfigurationStatusTimestamp() uint32 {
+	if x != nil {
+		return x.ConfigurationStatusTimestamp
+	}
+	return 0
+}
+
+func (x *RemoteAccessResource) GetTimestamps() *v11.Timestamps {
+	if x != nil {
+		return x.Timestamps
+	}
+	return nil
+}
+
+var File_resources_remoteaccess_v1_remoteaccess_proto protoreflect.FileDescriptor
+
+var file_resources_remoteaccess_v1_remoteaccess_proto_rawDesc = []byte{
+	0x0a, 0x2c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2f, 0x72, 0x65, 0x6d, 0x6f,
+	0x74, 0x65, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x6d, 0x6f,
+	0x74, 0x65, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x19,
+	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2e, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65,
+	0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x2e, 0x76, 0x31, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x62, 0x65, 0x68, 0x61,
+	0x76, 0x69, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x73, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x2f,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x72, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2f, 0x76,
+	0x31, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b,
+	0x62, 0x75, 0x66, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xbb, 0x06, 0x0a, 0x14,
+	0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x12, 0x44, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x23, 0xe0, 0x41, 0x03, 0xba, 0x48,
+	0x1d, 0x72, 0x1b, 0x18, 0x12, 0x32, 0x17, 0x5e, 0x72, 0x6d, 0x74, 0x61, 0x63, 0x63, 0x6f, 0x6e,
+	0x66, 0x2d, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x66, 0x5d, 0x7b, 0x38, 0x7d, 0x24, 0x52, 0x0a,
+	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x12, 0x3f, 0x0a, 0x0b, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x1e, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x18, 0x72, 0x16, 0x18, 0x0d, 0x32, 0x12, 0x5e, 0x69, 0x6e,
+	0x73, 0x74, 0x2d, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x66, 0x5d, 0x7b, 0x38, 0x7d, 0x24, 0x52,
+	0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x3a, 0x0a, 0x10, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0f, 0xe0, 0x41, 0x04, 0xba, 0x48, 0x09, 0x2a, 0x07, 0x18,
+	0x80, 0xa3, 0x05, 0x28, 0x84, 0x07, 0x52, 0x0f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x36, 0x0a, 0x14, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x03, 0xe0, 0x41, 0x03, 0x52, 0x13, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12,
+	0x22, 0x0a, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0d, 0x42, 0x03, 0xe0, 0x41, 0x03, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x50,
+	0x6f, 0x72, 0x74, 0x12, 0x17, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x03, 0xe0, 0x41, 0x03, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x56, 0x0a, 0x0d,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x2c, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2e,
+	0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x42, 0x03, 0xe0, 0x41, 0x03, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x56, 0x0a, 0x0d, 0x64, 0x65, 0x73, 0x69, 0x72, 0x65, 0x64, 0x5f,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2c, 0x2e, 0x72, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2e, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x61, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x41, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x42, 0x03, 0xe0, 0x41, 0x03, 0x52, 0x0c,
+	0x64, 0x65, 0x73, 0x69, 0x72, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x36, 0x0a, 0x14,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x03, 0x52,
+	0x13, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x70, 0x0a, 0x1e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x69, 0x6e, 0x64,
+	0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x72,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x49, 0x6e, 0x64, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x42, 0x03, 0xe0, 0x41, 0x03, 0x52, 0x1c, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x49, 0x6e, 0x64,
+	0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x49, 0x0a, 0x1e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x03,
+	0xe0, 0x41, 0x03, 0x52, 0x1c, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x12, 0x46, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x73, 0x18,
+	0xb4, 0x87, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x73, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x73, 0x42, 0x03, 0xe0, 0x41, 0x03, 0x52, 0x0a, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x73, 0x2a, 0x99, 0x01, 0x0a, 0x11, 0x52, 0x65,
+	0x6d, 0x6f, 0x74, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x23, 0x0a, 0x1f, 0x52, 0x45, 0x4d, 0x4f, 0x54, 0x45, 0x5f, 0x41, 0x43, 0x43, 0x45, 0x53, 0x53,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x1f, 0x0a, 0x1b, 0x52, 0x45, 0x4d, 0x4f, 0x54, 0x45, 0x5f, 0x41,
+	0x43, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x44, 0x45, 0x4c, 0x45,
+	0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x52, 0x45, 0x4d, 0x4f, 0x54, 0x45, 0x5f,
+	0x41, 0x43, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x45, 0x52, 0x52,
+	0x4f, 0x52, 0x10, 0x02, 0x12, 0x1f, 0x0a, 0x1b, 0x52, 0x45, 0x4d, 0x4f, 0x54, 0x45, 0x5f, 0x41,
+	0x43, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x45, 0x4e, 0x41, 0x42,
+	0x4c, 0x45, 0x44, 0x10, 0x03, 0x42, 0x6b, 0x5a, 0x69, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x2d, 0x65, 0x64, 0x67, 0x65, 0x2d, 0x70, 0x6c,
+	0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2f, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x2d, 0x63, 0x6f, 0x72,
+	0x65, 0x2f, 0x61, 0x70, 0x69, 0x76, 0x32, 0x2f, 0x76, 0x32, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x2f, 0x70, 0x62, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x73, 0x2f, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x2f, 0x76, 0x31, 0x3b, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_resources_remoteaccess_v1_remoteaccess_proto_rawDescOnce sync.Once
+	file_resources_remoteaccess_v1_remoteaccess_proto_rawDescData = file_resources_remoteaccess_v1_remoteaccess_proto_rawDesc
+)
+
+func file_resources_remoteaccess_v1_remoteaccess_proto_rawDescGZIP() []byte {
+	file_resources_remoteaccess_v1_remoteaccess_proto_rawDescOnce.Do(func() {
+		file_resources_remoteaccess_v1_remoteaccess_proto_rawDescData = protoimpl.X.CompressGZIP(file_resources_remoteaccess_v1_remoteaccess_proto_rawDescData)
+	})
+	return file_resources_remoteaccess_v1_remoteaccess_proto_rawDescData
+}
+
+var file_resources_remoteaccess_v1_remoteaccess_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_resources_remoteaccess_v1_remoteaccess_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_resources_remoteaccess_v1_remoteaccess_proto_goTypes = []interface{}{
+	(RemoteAccessState)(0),       // 0: resources.remoteaccess.v1.RemoteAccessState
+	(*RemoteAccessResource)(nil), // 1: resources.remoteaccess.v1.RemoteAccessResource
+	(v1.StatusIndication)(0),     // 2: resources.status.v1.StatusIndication
+	(*v11.Timestamps)(nil),       // 3: resources.common.v1.Timestamps
+}
+var file_resources_remoteaccess_v1_remoteaccess_proto_depIdxs = []int32{
+	0, // 0: resources.remoteaccess.v1.RemoteAccessResource.current_state:type_name -> resources.remoteaccess.v1.RemoteAccessState
+	0, // 1: resources.remoteaccess.v1.RemoteAccessResource.desired_state:type_name -> resources.remoteaccess.v1.RemoteAccessState
+	2, // 2: resources.remoteaccess.v1.RemoteAccessResource.configuration_status_indicator:type_name -> resources.status.v1.StatusIndication
+	3, // 3: resources.remoteaccess.v1.RemoteAccessResource.timestamps:type_name -> resources.common.v1.Timestamps
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_resources_remoteaccess_v1_remoteaccess_proto_init() }
+func file_resources_remoteaccess_v1_remoteaccess_proto_init() {
+	if File_resources_remoteaccess_v1_remoteaccess_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_resources_remoteaccess_v1_remoteaccess_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoteAccessResource); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_resources_remoteaccess_v1_remoteaccess_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_resources_remoteaccess_v1_remoteaccess_proto_goTypes,
+		DependencyIndexes: file_resources_remoteaccess_v1_remoteaccess_proto_depIdxs,
+		EnumInfos:         file_resources_remoteaccess_v1_remoteaccess_proto_enumTypes,
+		MessageInfos:      file_resources_remoteaccess_v1_remoteaccess_proto_msgTypes,
+	}.Build()
+	File_resources_remoteaccess_v1_remoteaccess_proto = out.File
+	file_resources_remoteaccess_v1_remoteaccess_proto_rawDesc = nil
+	file_resources_remoteaccess_v1_remoteaccess_proto_goTypes = nil
+	file_resources_remoteaccess_v1_remoteaccess_proto_depIdxs = nil
+}
@@ -0,0 +1,21 @@
+// Code generated by protoc-gen-go-const. DO NOT EDIT.
+
+// source: resources/remoteaccess/v1/remoteaccess.proto
+
+package remoteaccessv1
+
+const (
+	// Fields and Edges constants for "RemoteAccessResource"
+	RemoteAccessResourceFieldResourceId                   = "resource_id"
+	RemoteAccessResourceFieldInstanceId                   = "instance_id"
+	RemoteAccessResourceFieldDurationSeconds              = "duration_seconds"
+	RemoteAccessResourceFieldExpirationTimestamp          = "expiration_timestamp"
+	RemoteAccessResourceFieldLocalPort                    = "local_port"
+	RemoteAccessResourceFieldUser                         = "user"
+	RemoteAccessResourceFieldCurrentState                 = "current_state"
+	RemoteAccessResourceFieldDesiredState                 = "desired_state"
+	RemoteAccessResourceFieldConfigurationStatus          = "configuration_status"
+	RemoteAccessResourceFieldConfigurationStatusIndicator = "configuration_status_indicator"
+	RemoteAccessResourceFieldConfigurationStatusTimestamp = "configuration_status_timestamp"
+	RemoteAccessResourceEdgeTimestamps                    = "timestamps"
+)
@@ -0,0 +1,313 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.30.0
+// 	protoc        (unknown)
+// source: resources/rolebinding/v1/rolebinding.proto
+
+package rolebindingv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	v1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/resources/common/v1"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// The role granted by a role binding within its scope.
+type RoleBindingRole int32
+
+const (
+	RoleBindingRole_ROLE_BINDING_ROLE_UNSPECIFIED RoleBindingRole = 0
+	// Read access to the resources in scope.
+	RoleBindingRole_ROLE_BINDING_ROLE_READ RoleBindingRole = 1
+	// Read and write access to the resources in scope.
+	RoleBindingRole_ROLE_BINDING_ROLE_READ_WRITE RoleBindingRole = 2
+)
+
+// Enum value maps for RoleBindingRole.
+var (
+	RoleBindingRole_name = map[int32]string{
+		0: "ROLE_BINDING_ROLE_UNSPECIFIED",
+		1: "ROLE_BINDING_ROLE_READ",
+		2: "ROLE_BINDING_ROLE_READ_WRITE",
+	}
+	RoleBindingRole_value = map[string]int32{
+		"ROLE_BINDING_ROLE_UNSPECIFIED": 0,
+		"ROLE_BINDING_ROLE_READ":        1,
+		"ROLE_BINDING_ROLE_READ_WRITE":  2,
+	}
+)
+
+func (x RoleBindingRole) Enum() *RoleBindingRole {
+	p := new(RoleBindingRole)
+	*p = x
+	return p
+}
+
+func (x RoleBindingRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RoleBindingRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_resources_rolebinding_v1_rolebinding_proto_enumTypes[0].Descriptor()
+}
+
+func (RoleBindingRole) Type() protoreflect.EnumType {
+	return &file_resources_rolebinding_v1_rolebinding_proto_enumTypes[0]
+}
+
+func (x RoleBindingRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RoleBindingRole.Descriptor instead.
+func (RoleBindingRole) EnumDescriptor() ([]byte, []int) {
+	return file_resources_rolebinding_v1_rolebinding_proto_rawDescGZIP(), []int{0}
+}
+
+// Binds a principal to a role on a region, a site or an OU, with everything below it.
+// Principals holding the scoped role of the project (`<project>_im-scoped`) only have access to the locations of
+// their bindings and to the hosts and instances located there; they can read the resources that are not located,
+// such as OSes, but not modify them.
+type RoleBindingResource struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// resource identifier
+	ResourceId string `protobuf:"bytes,1,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	// The principal the role is granted to, matched against the subject (`sub`) claim of its JWT.
+	Subject string `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	// The role granted in the scope.
+	Role RoleBindingRole `protobuf:"varint,3,opt,name=role,proto3,enum=resources.rolebinding.v1.RoleBindingRole" json:"role,omitempty"`
+	// The region the role is granted on. Exactly one of region_id, site_id and ou_id must be set.
+	RegionId string `protobuf:"bytes,4,opt,name=region_id,json=regionId,proto3" json:"region_id,omitempty"`
+	// The site the role is granted on. Exactly one of region_id, site_id and ou_id must be set.
+	SiteId string `protobuf:"bytes,5,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	// The OU the role is granted on. Exactly one of region_id, site_id and ou_id must be set.
+	OuId string `protobuf:"bytes,6,opt,name=ou_id,json=ouId,proto3" json:"ou_id,omitempty"`
+	// Timestamps associated to the resource.
+	Timestamps *v1.Timestamps `protobuf:"bytes,50100,opt,name=timestamps,proto3" json:"timestamps,omitempty"`
+}
+
+func (x *RoleBindingResource) Reset() {
+	*x = RoleBindingResource{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_resources_rolebinding_v1_rolebinding_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RoleBindingResource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleBindingResource) ProtoMessage() {}
+
+func (x *RoleBindingResource) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_rolebinding_v1_rolebinding_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleBindingResource.ProtoReflect.Descriptor instead.
+func (*RoleBindingResource) Descriptor() ([]byte, []int) {
+	return file_resources_rolebinding_v1_rolebinding_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RoleBindingResource) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
+	}
+	return ""
+}
+
+func (x *RoleBindingResource) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *RoleBindingResource) GetRole() RoleBindingRole {
+	if x != nil {
+		return x.Role
+	}
+	return RoleBindingRole_ROLE_BINDING_ROLE_UNSPECIFIED
+}
+
+func (x *RoleBindingResource) GetRegionId() string {
+	if x != nil {
+		return x.RegionId
+	}
+	return ""
+}
+
+func (x *RoleBindingResource) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+func (x *RoleBindingResource) GetOuId() string {
+	if x != nil {
+		return x.OuId
+	}
+	return ""
+}
+
+func (x *RoleBindingResource) GetTimestamps() *v1.Timestamps {
+	if x != nil {
+		return x.Timestamps
+	}
+	return nil
+}
+
+var File_resources_rolebinding_v1_rolebinding_proto protoreflect.FileDescriptor
+
+var file_resources_rolebinding_v1_rolebinding_proto_rawDesc = []byte{
+	0x0a, 0x2a, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2f, 0x72, 0x6f, 0x6c, 0x65,
+	0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x62,
+	0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x18, 0x72, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2e, 0x72, 0x6f, 0x6c, 0x65, 0x62, 0x69, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x1a, 0x1b, 0x62, 0x75, 0x66, 0x2f, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2f,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd0, 0x03, 0x0a, 0x13, 0x52, 0x6f, 0x6c, 0x65, 0x42,
+	0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x46,
+	0x0a, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x25, 0xe0, 0x41, 0x03, 0xba, 0x48, 0x1f, 0x72, 0x1d, 0x18, 0x14, 0x32,
+	0x19, 0x5e, 0x72, 0x6f, 0x6c, 0x65, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x2d, 0x5b, 0x30,
+	0x2d, 0x39, 0x61, 0x2d, 0x66, 0x5d, 0x7b, 0x38, 0x7d, 0x24, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x07, 0x72,
+	0x05, 0x10, 0x01, 0x18, 0x80, 0x02, 0x52, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12,
+	0x4c, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e,
+	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2e, 0x72, 0x6f, 0x6c, 0x65, 0x62, 0x69,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x69, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x0d, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x07,
+	0x82, 0x01, 0x04, 0x10, 0x01, 0x20, 0x00, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x40, 0x0a,
+	0x09, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x23, 0xe0, 0x41, 0x01, 0xba, 0x48, 0x1d, 0x72, 0x1b, 0x18, 0x0f, 0x32, 0x17, 0x5e, 0x24,
+	0x7c, 0x5e, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x2d, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x66,
+	0x5d, 0x7b, 0x38, 0x7d, 0x24, 0x52, 0x08, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12,
+	0x3a, 0x0a, 0x07, 0x73, 0x69, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x21, 0xe0, 0x41, 0x01, 0xba, 0x48, 0x1b, 0x72, 0x19, 0x18, 0x0d, 0x32, 0x15, 0x5e, 0x24,
+	0x7c, 0x5e, 0x73, 0x69, 0x74, 0x65, 0x2d, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x66, 0x5d, 0x7b,
+	0x38, 0x7d, 0x24, 0x52, 0x06, 0x73, 0x69, 0x74, 0x65, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x05, 0x6f,
+	0x75, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1f, 0xe0, 0x41, 0x01, 0xba,
+	0x48, 0x19, 0x72, 0x17, 0x18, 0x0b, 0x32, 0x13, 0x5e, 0x24, 0x7c, 0x5e, 0x6f, 0x75, 0x2d, 0x5b,
+	0x30, 0x2d, 0x39, 0x61, 0x2d, 0x66, 0x5d, 0x7b, 0x38, 0x7d, 0x24, 0x52, 0x04, 0x6f, 0x75, 0x49,
+	0x64, 0x12, 0x46, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x73, 0x18,
+	0xb4, 0x87, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x73, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x73, 0x42, 0x03, 0xe0, 0x41, 0x03, 0x52, 0x0a, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x73, 0x2a, 0x72, 0x0a, 0x0f, 0x52, 0x6f, 0x6c,
+	0x65, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x21, 0x0a, 0x1d,
+	0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x42, 0x49, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x52, 0x4f, 0x4c,
+	0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x1a, 0x0a, 0x16, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x42, 0x49, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f,
+	0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0x01, 0x12, 0x20, 0x0a, 0x1c, 0x52,
+	0x4f, 0x4c, 0x45, 0x5f, 0x42, 0x49, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x52, 0x4f, 0x4c, 0x45,
+	0x5f, 0x52, 0x45, 0x41, 0x44, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0x02, 0x42, 0x69, 0x5a,
+	0x67, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x70, 0x65, 0x6e,
+	0x2d, 0x65, 0x64, 0x67, 0x65, 0x2d, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2f, 0x69,
+	0x6e, 0x66, 0x72, 0x61, 0x2d, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x76, 0x32, 0x2f,
+	0x76, 0x32, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x70, 0x62, 0x61, 0x70,
+	0x69, 0x2f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2f, 0x72, 0x6f, 0x6c, 0x65,
+	0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x31, 0x3b, 0x72, 0x6f, 0x6c, 0x65, 0x62,
+	0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_resources_rolebinding_v1_rolebinding_proto_rawDescOnce sync.Once
+	file_resources_rolebinding_v1_rolebinding_proto_rawDescData = file_resources_rolebinding_v1_rolebinding_proto_rawDesc
+)
+
+func file_resources_rolebinding_v1_rolebinding_proto_rawDescGZIP() []byte {
+	file_resources_rolebinding_v1_rolebinding_proto_rawDescOnce.Do(func() {
+		file_resources_rolebinding_v1_rolebinding_proto_rawDescData = protoimpl.X.CompressGZIP(file_resources_rolebinding_v1_rolebinding_proto_rawDescData)
+	})
+	return file_resources_rolebinding_v1_rolebinding_proto_rawDescData
+}
+
+var file_resources_rolebinding_v1_rolebinding_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_resources_rolebinding_v1_rolebinding_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_resources_rolebinding_v1_rolebinding_proto_goTypes = []interface{}{
+	(RoleBindingRole)(0),        // 0: resources.rolebinding.v1.RoleBindingRole
+	(*RoleBindingResource)(nil), // 1: resources.rolebinding.v1.RoleBindingResource
+	(*v1.Timestamps)(nil),       // 2: resources.common.v1.Timestamps
+}
+var file_resources_rolebinding_v1_rolebinding_proto_depIdxs = []int32{
+	0, // 0: resources.rolebinding.v1.RoleBindingResource.role:type_name -> resources.rolebinding.v1.RoleBindingRole
+	2, // 1: resources.rolebinding.v1.RoleBindingResource.timestamps:type_name -> resources.common.v1.Timestamps
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_resources_rolebinding_v1_rolebinding_proto_init() }
+func file_resources_rolebinding_v1_rolebinding_proto_init() {
+	if File_resources_rolebinding_v1_rolebinding_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_resources_rolebinding_v1_rolebinding_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoleBindingResource); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_resources_rolebinding_v1_rolebinding_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_resources_rolebinding_v1_rolebinding_proto_goTypes,
+		DependencyIndexes: file_resources_rolebinding_v1_rolebinding_proto_depIdxs,
+		EnumInfos:         file_resources_rolebinding_v1_rolebinding_proto_enumTypes,
+		MessageInfos:      file_resources_rolebinding_v1_rolebinding_proto_msgTypes,
+	}.Build()
+	File_resources_rolebinding_v1_rolebinding_proto = out.File
+	file_resources_rolebinding_v1_rolebinding_proto_rawDesc = nil
+	file_resources_rolebinding_v1_rolebinding_proto_goTypes = nil
+	file_resources_rolebinding_v1_rolebinding_proto_depIdxs = nil
+}
@@ -0,0 +1,16 @@
+// Code generated by protoc-gen-go-const. DO NOT EDIT.
+
+// source: resources/rolebinding/v1/rolebinding.proto
+
+package rolebindingv1
+
+const (
+	// Fields and Edges constants for "RoleBindingResource"
+	RoleBindingResourceFieldResourceId = "resource_id"
+	RoleBindingResourceFieldSubject    = "subject"
+	RoleBindingResourceFieldRole       = "role"
+	RoleBindingResourceFieldRegionId   = "region_id"
+	RoleBindingResourceFieldSiteId     = "site_id"
+	RoleBindingResourceFieldOuId       = "ou_id"
+	RoleBindingResourceEdgeTimestamps  = "timestamps"
+)
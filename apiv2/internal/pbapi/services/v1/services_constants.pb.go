@@ -61,6 +61,8 @@ const (
 	GetSiteRequestFieldResourceId  = "resourceId"
 	GetSiteRequestFieldProjectName = "projectName"
 	GetSiteRequestFieldRegionId    = "regionId"
+	GetSiteRequestFieldView        = "view"
+	GetSiteRequestEdgeFields       = "fields"
 
 	// Fields and Edges constants for "GetSiteResponse"
 	GetSiteResponseEdgeSite = "site"
@@ -72,6 +74,8 @@ const (
 	ListSitesRequestFieldOffset      = "offset"
 	ListSitesRequestFieldProjectName = "projectName"
 	ListSitesRequestFieldRegionId    = "regionId"
+	ListSitesRequestFieldView        = "view"
+	ListSitesRequestEdgeFields       = "fields"
 
 	// Fields and Edges constants for "ListSitesResponse"
 	ListSitesResponseEdgeSites          = "sites"
@@ -98,10 +102,48 @@ const (
 
 	// Fields and Edges constants for "DeleteSiteResponse"
 
+	// Fields and Edges constants for "CreateOuRequest"
+	CreateOuRequestEdgeOu           = "ou"
+	CreateOuRequestFieldProjectName = "projectName"
+
+	// Fields and Edges constants for "GetOuRequest"
+	GetOuRequestFieldResourceId  = "resourceId"
+	GetOuRequestFieldProjectName = "projectName"
+
+	// Fields and Edges constants for "ListOusRequest"
+	ListOusRequestFieldOrderBy     = "order_by"
+	ListOusRequestFieldFilter      = "filter"
+	ListOusRequestFieldPageSize    = "page_size"
+	ListOusRequestFieldOffset      = "offset"
+	ListOusRequestFieldProjectName = "projectName"
+
+	// Fields and Edges constants for "ListOusResponse"
+	ListOusResponseEdgeOus            = "ous"
+	ListOusResponseFieldTotalElements = "total_elements"
+	ListOusResponseFieldHasNext       = "has_next"
+
+	// Fields and Edges constants for "UpdateOuRequest"
+	UpdateOuRequestFieldResourceId  = "resourceId"
+	UpdateOuRequestEdgeOu           = "ou"
+	UpdateOuRequestFieldProjectName = "projectName"
+
+	// Fields and Edges constants for "PatchOuRequest"
+	PatchOuRequestFieldResourceId  = "resourceId"
+	PatchOuRequestEdgeOu           = "ou"
+	PatchOuRequestEdgeFieldMask    = "field_mask"
+	PatchOuRequestFieldProjectName = "projectName"
+
+	// Fields and Edges constants for "DeleteOuRequest"
+	DeleteOuRequestFieldResourceId  = "resourceId"
+	DeleteOuRequestFieldProjectName = "projectName"
+
+	// Fields and Edges constants for "DeleteOuResponse"
+
 	// Fields and Edges constants for "ListLocationsRequest"
 	ListLocationsRequestFieldName        = "name"
 	ListLocationsRequestFieldShowSites   = "show_sites"
 	ListLocationsRequestFieldShowRegions = "show_regions"
+	ListLocationsRequestFieldShowOus     = "show_ous"
 	ListLocationsRequestFieldProjectName = "projectName"
 
 	// Fields and Edges constants for "ListLocationsResponse"
@@ -119,6 +161,23 @@ const (
 	GetHostSummaryResponseFieldRunning     = "running"
 	GetHostSummaryResponseFieldUnallocated = "unallocated"
 
+	// Fields and Edges constants for "GetHostsStatisticsRequest"
+	GetHostsStatisticsRequestFieldDimensions  = "dimensions"
+	GetHostsStatisticsRequestFieldFilter      = "filter"
+	GetHostsStatisticsRequestFieldProjectName = "projectName"
+
+	// Fields and Edges constants for "HostsStatisticsBucket"
+	HostsStatisticsBucketFieldValue = "value"
+	HostsStatisticsBucketFieldCount = "count"
+
+	// Fields and Edges constants for "HostsDimensionStatistics"
+	HostsDimensionStatisticsFieldDimension = "dimension"
+	HostsDimensionStatisticsEdgeBuckets    = "buckets"
+
+	// Fields and Edges constants for "GetHostsStatisticsResponse"
+	GetHostsStatisticsResponseFieldTotal     = "total"
+	GetHostsStatisticsResponseEdgeStatistics = "statistics"
+
 	// Fields and Edges constants for "CreateHostRequest"
 	CreateHostRequestEdgeHost         = "host"
 	CreateHostRequestFieldProjectName = "projectName"
@@ -129,6 +188,8 @@ const (
 	// Fields and Edges constants for "GetHostRequest"
 	GetHostRequestFieldResourceId  = "resourceId"
 	GetHostRequestFieldProjectName = "projectName"
+	GetHostRequestFieldView        = "view"
+	GetHostRequestEdgeFields       = "fields"
 
 	// Fields and Edges constants for "GetHostResponse"
 	GetHostResponseEdgeHost = "host"
@@ -139,6 +200,8 @@ const (
 	ListHostsRequestFieldPageSize    = "page_size"
 	ListHostsRequestFieldOffset      = "offset"
 	ListHostsRequestFieldProjectName = "projectName"
+	ListHostsRequestFieldView        = "view"
+	ListHostsRequestEdgeFields       = "fields"
 
 	// Fields and Edges constants for "ListHostsResponse"
 	ListHostsResponseEdgeHosts          = "hosts"
@@ -169,6 +232,27 @@ const (
 
 	// Fields and Edges constants for "InvalidateHostResponse"
 
+	// Fields and Edges constants for "InvalidateHostsRequest"
+	InvalidateHostsRequestFieldFilter      = "filter"
+	InvalidateHostsRequestFieldNote        = "note"
+	InvalidateHostsRequestFieldProjectName = "projectName"
+
+	// Fields and Edges constants for "BulkHostActionRequest"
+	BulkHostActionRequestFieldAction             = "action"
+	BulkHostActionRequestFieldFilter             = "filter"
+	BulkHostActionRequestFieldResourceIds        = "resource_ids"
+	BulkHostActionRequestFieldNote               = "note"
+	BulkHostActionRequestFieldDesiredPowerState  = "desired_power_state"
+	BulkHostActionRequestFieldPowerCommandPolicy = "power_command_policy"
+	BulkHostActionRequestFieldDesiredAmtState    = "desired_amt_state"
+	BulkHostActionRequestFieldAmtControlMode     = "amt_control_mode"
+	BulkHostActionRequestFieldPreview            = "preview"
+	BulkHostActionRequestFieldProjectName        = "projectName"
+
+	// Fields and Edges constants for "BulkHostActionResponse"
+	BulkHostActionResponseFieldResourceIds = "resource_ids"
+	BulkHostActionResponseEdgeOperation    = "operation"
+
 	// Fields and Edges constants for "HostRegister"
 	HostRegisterFieldName         = "name"
 	HostRegisterFieldSerialNumber = "serial_number"
@@ -182,6 +266,22 @@ const (
 	RegisterHostRequestEdgeHost         = "host"
 	RegisterHostRequestFieldProjectName = "projectName"
 
+	// Fields and Edges constants for "HostRegisterEntry"
+	HostRegisterEntryEdgeHost     = "host"
+	HostRegisterEntryFieldSiteId  = "site_id"
+	HostRegisterEntryEdgeMetadata = "metadata"
+
+	// Fields and Edges constants for "RegisterHostsRequest"
+	RegisterHostsRequestEdgeHosts        = "hosts"
+	RegisterHostsRequestFieldCsv         = "csv"
+	RegisterHostsRequestFieldDryRun      = "dry_run"
+	RegisterHostsRequestFieldProjectName = "projectName"
+
+	// Fields and Edges constants for "RegisterHostsResponse"
+	RegisterHostsResponseEdgeResults    = "results"
+	RegisterHostsResponseFieldSucceeded = "succeeded"
+	RegisterHostsResponseFieldFailed    = "failed"
+
 	// Fields and Edges constants for "OnboardHostRequest"
 	OnboardHostRequestFieldResourceId  = "resourceId"
 	OnboardHostRequestFieldProjectName = "projectName"
@@ -198,6 +298,8 @@ const (
 	// Fields and Edges constants for "GetInstanceRequest"
 	GetInstanceRequestFieldResourceId  = "resourceId"
 	GetInstanceRequestFieldProjectName = "projectName"
+	GetInstanceRequestFieldView        = "view"
+	GetInstanceRequestEdgeFields       = "fields"
 
 	// Fields and Edges constants for "GetInstanceResponse"
 	GetInstanceResponseEdgeInstance = "instance"
@@ -208,6 +310,8 @@ const (
 	ListInstancesRequestFieldPageSize    = "page_size"
 	ListInstancesRequestFieldOffset      = "offset"
 	ListInstancesRequestFieldProjectName = "projectName"
+	ListInstancesRequestFieldView        = "view"
+	ListInstancesRequestEdgeFields       = "fields"
 
 	// Fields and Edges constants for "ListInstancesResponse"
 	ListInstancesResponseEdgeInstances      = "instances"
@@ -237,6 +341,17 @@ const (
 
 	// Fields and Edges constants for "InvalidateInstanceResponse"
 
+	// Fields and Edges constants for "BulkInstanceActionRequest"
+	BulkInstanceActionRequestFieldAction      = "action"
+	BulkInstanceActionRequestFieldFilter      = "filter"
+	BulkInstanceActionRequestFieldResourceIds = "resource_ids"
+	BulkInstanceActionRequestFieldPreview     = "preview"
+	BulkInstanceActionRequestFieldProjectName = "projectName"
+
+	// Fields and Edges constants for "BulkInstanceActionResponse"
+	BulkInstanceActionResponseFieldResourceIds = "resource_ids"
+	BulkInstanceActionResponseEdgeOperation    = "operation"
+
 	// Fields and Edges constants for "CreateOperatingSystemRequest"
 	CreateOperatingSystemRequestEdgeOs           = "os"
 	CreateOperatingSystemRequestFieldProjectName = "projectName"
@@ -247,6 +362,8 @@ const (
 	// Fields and Edges constants for "GetOperatingSystemRequest"
 	GetOperatingSystemRequestFieldResourceId  = "resourceId"
 	GetOperatingSystemRequestFieldProjectName = "projectName"
+	GetOperatingSystemRequestFieldView        = "view"
+	GetOperatingSystemRequestEdgeFields       = "fields"
 
 	// Fields and Edges constants for "GetOperatingSystemResponse"
 	GetOperatingSystemResponseEdgeOs = "os"
@@ -257,6 +374,8 @@ const (
 	ListOperatingSystemsRequestFieldPageSize    = "page_size"
 	ListOperatingSystemsRequestFieldOffset      = "offset"
 	ListOperatingSystemsRequestFieldProjectName = "projectName"
+	ListOperatingSystemsRequestFieldView        = "view"
+	ListOperatingSystemsRequestEdgeFields       = "fields"
 
 	// Fields and Edges constants for "ListOperatingSystemsResponse"
 	ListOperatingSystemsResponseEdgeOperatingSystemResources = "Operating_system_resources"
@@ -306,6 +425,17 @@ const (
 	ListProvidersResponseFieldTotalElements = "total_elements"
 	ListProvidersResponseFieldHasNext       = "has_next"
 
+	// Fields and Edges constants for "UpdateProviderRequest"
+	UpdateProviderRequestFieldResourceId  = "resourceId"
+	UpdateProviderRequestEdgeProvider     = "provider"
+	UpdateProviderRequestFieldProjectName = "projectName"
+
+	// Fields and Edges constants for "PatchProviderRequest"
+	PatchProviderRequestFieldResourceId  = "resourceId"
+	PatchProviderRequestEdgeProvider     = "provider"
+	PatchProviderRequestEdgeFieldMask    = "field_mask"
+	PatchProviderRequestFieldProjectName = "projectName"
+
 	// Fields and Edges constants for "DeleteProviderRequest"
 	DeleteProviderRequestFieldResourceId  = "resourceId"
 	DeleteProviderRequestFieldProjectName = "projectName"
@@ -672,6 +802,17 @@ const (
 	ListLocalAccountsResponseFieldTotalElements = "total_elements"
 	ListLocalAccountsResponseFieldHasNext       = "has_next"
 
+	// Fields and Edges constants for "UpdateLocalAccountRequest"
+	UpdateLocalAccountRequestFieldResourceId  = "resourceId"
+	UpdateLocalAccountRequestEdgeLocalAccount = "local_account"
+	UpdateLocalAccountRequestFieldProjectName = "projectName"
+
+	// Fields and Edges constants for "PatchLocalAccountRequest"
+	PatchLocalAccountRequestFieldResourceId  = "resourceId"
+	PatchLocalAccountRequestEdgeLocalAccount = "local_account"
+	PatchLocalAccountRequestEdgeFieldMask    = "field_mask"
+	PatchLocalAccountRequestFieldProjectName = "projectName"
+
 	// Fields and Edges constants for "DeleteLocalAccountRequest"
 	DeleteLocalAccountRequestFieldResourceId  = "resourceId"
 	DeleteLocalAccountRequestFieldProjectName = "projectName"
@@ -704,6 +845,17 @@ const (
 	ListOSUpdatePolicyResponseFieldTotalElements   = "total_elements"
 	ListOSUpdatePolicyResponseFieldHasNext         = "has_next"
 
+	// Fields and Edges constants for "UpdateOSUpdatePolicyRequest"
+	UpdateOSUpdatePolicyRequestFieldResourceId    = "resourceId"
+	UpdateOSUpdatePolicyRequestEdgeOsUpdatePolicy = "os_update_policy"
+	UpdateOSUpdatePolicyRequestFieldProjectName   = "projectName"
+
+	// Fields and Edges constants for "PatchOSUpdatePolicyRequest"
+	PatchOSUpdatePolicyRequestFieldResourceId    = "resourceId"
+	PatchOSUpdatePolicyRequestEdgeOsUpdatePolicy = "os_update_policy"
+	PatchOSUpdatePolicyRequestEdgeFieldMask      = "field_mask"
+	PatchOSUpdatePolicyRequestFieldProjectName   = "projectName"
+
 	// Fields and Edges constants for "DeleteOSUpdatePolicyRequest"
 	DeleteOSUpdatePolicyRequestFieldResourceId  = "resourceId"
 	DeleteOSUpdatePolicyRequestFieldProjectName = "projectName"
@@ -768,10 +920,227 @@ const (
 	ListCustomConfigsResponseFieldTotalElements = "total_elements"
 	ListCustomConfigsResponseFieldHasNext       = "has_next"
 
+	// Fields and Edges constants for "UpdateCustomConfigRequest"
+	UpdateCustomConfigRequestFieldResourceId  = "resourceId"
+	UpdateCustomConfigRequestEdgeCustomConfig = "custom_config"
+	UpdateCustomConfigRequestFieldProjectName = "projectName"
+
+	// Fields and Edges constants for "PatchCustomConfigRequest"
+	PatchCustomConfigRequestFieldResourceId  = "resourceId"
+	PatchCustomConfigRequestEdgeCustomConfig = "custom_config"
+	PatchCustomConfigRequestEdgeFieldMask    = "field_mask"
+	PatchCustomConfigRequestFieldProjectName = "projectName"
+
 	// Fields and Edges constants for "DeleteCustomConfigRequest"
 	DeleteCustomConfigRequestFieldResourceId  = "resourceId"
 	DeleteCustomConfigRequestFieldProjectName = "projectName"
 
-// Fields and Edges constants for "DeleteCustomConfigResponse"
+	// Fields and Edges constants for "DeleteCustomConfigResponse"
+
+	// Fields and Edges constants for "WatchResourcesRequest"
+	WatchResourcesRequestFieldKinds            = "kinds"
+	WatchResourcesRequestFieldFilter           = "filter"
+	WatchResourcesRequestFieldHeartbeatSeconds = "heartbeat_seconds"
+	WatchResourcesRequestFieldProjectName      = "projectName"
+
+	// Fields and Edges constants for "WatchResourcesResponse"
+	WatchResourcesResponseEdgeEvent     = "event"
+	WatchResourcesResponseEdgeHeartbeat = "heartbeat"
+
+	// Fields and Edges constants for "ListIPAddressesRequest"
+	ListIPAddressesRequestFieldOrderBy     = "order_by"
+	ListIPAddressesRequestFieldFilter      = "filter"
+	ListIPAddressesRequestFieldPageSize    = "page_size"
+	ListIPAddressesRequestFieldOffset      = "offset"
+	ListIPAddressesRequestFieldProjectName = "projectName"
+
+	// Fields and Edges constants for "ListIPAddressesResponse"
+	ListIPAddressesResponseEdgeIpAddresses    = "ip_addresses"
+	ListIPAddressesResponseFieldTotalElements = "total_elements"
+	ListIPAddressesResponseFieldHasNext       = "has_next"
+
+	// Fields and Edges constants for "GetIPAddressRequest"
+	GetIPAddressRequestFieldResourceId  = "resourceId"
+	GetIPAddressRequestFieldProjectName = "projectName"
+
+	// Fields and Edges constants for "ListHostnicsRequest"
+	ListHostnicsRequestFieldOrderBy     = "order_by"
+	ListHostnicsRequestFieldFilter      = "filter"
+	ListHostnicsRequestFieldPageSize    = "page_size"
+	ListHostnicsRequestFieldOffset      = "offset"
+	ListHostnicsRequestFieldProjectName = "projectName"
+
+	// Fields and Edges constants for "ListHostnicsResponse"
+	ListHostnicsResponseEdgeNics           = "nics"
+	ListHostnicsResponseFieldTotalElements = "total_elements"
+	ListHostnicsResponseFieldHasNext       = "has_next"
+
+	// Fields and Edges constants for "GetHostnicRequest"
+	GetHostnicRequestFieldResourceId  = "resourceId"
+	GetHostnicRequestFieldProjectName = "projectName"
+
+	// Fields and Edges constants for "CreateNetworkSegmentRequest"
+	CreateNetworkSegmentRequestEdgeNetworkSegment = "network_segment"
+	CreateNetworkSegmentRequestFieldProjectName   = "projectName"
+
+	// Fields and Edges constants for "ListNetworkSegmentsRequest"
+	ListNetworkSegmentsRequestFieldOrderBy     = "order_by"
+	ListNetworkSegmentsRequestFieldFilter      = "filter"
+	ListNetworkSegmentsRequestFieldPageSize    = "page_size"
+	ListNetworkSegmentsRequestFieldOffset      = "offset"
+	ListNetworkSegmentsRequestFieldProjectName = "projectName"
+
+	// Fields and Edges constants for "ListNetworkSegmentsResponse"
+	ListNetworkSegmentsResponseEdgeNetworkSegments = "network_segments"
+	ListNetworkSegmentsResponseFieldTotalElements  = "total_elements"
+	ListNetworkSegmentsResponseFieldHasNext        = "has_next"
+
+	// Fields and Edges constants for "GetNetworkSegmentRequest"
+	GetNetworkSegmentRequestFieldResourceId  = "resourceId"
+	GetNetworkSegmentRequestFieldProjectName = "projectName"
+
+	// Fields and Edges constants for "UpdateNetworkSegmentRequest"
+	UpdateNetworkSegmentRequestFieldResourceId    = "resourceId"
+	UpdateNetworkSegmentRequestEdgeNetworkSegment = "network_segment"
+	UpdateNetworkSegmentRequestFieldProjectName   = "projectName"
+
+	// Fields and Edges constants for "PatchNetworkSegmentRequest"
+	PatchNetworkSegmentRequestFieldResourceId    = "resourceId"
+	PatchNetworkSegmentRequestEdgeNetworkSegment = "network_segment"
+	PatchNetworkSegmentRequestEdgeFieldMask      = "field_mask"
+	PatchNetworkSegmentRequestFieldProjectName   = "projectName"
+
+	// Fields and Edges constants for "DeleteNetworkSegmentRequest"
+	DeleteNetworkSegmentRequestFieldResourceId  = "resourceId"
+	DeleteNetworkSegmentRequestFieldProjectName = "projectName"
+
+	// Fields and Edges constants for "DeleteNetworkSegmentResponse"
+
+	// Fields and Edges constants for "ListEndpointsRequest"
+	ListEndpointsRequestFieldOrderBy     = "order_by"
+	ListEndpointsRequestFieldFilter      = "filter"
+	ListEndpointsRequestFieldPageSize    = "page_size"
+	ListEndpointsRequestFieldOffset      = "offset"
+	ListEndpointsRequestFieldProjectName = "projectName"
+
+	// Fields and Edges constants for "ListEndpointsResponse"
+	ListEndpointsResponseEdgeEndpoints      = "endpoints"
+	ListEndpointsResponseFieldTotalElements = "total_elements"
+	ListEndpointsResponseFieldHasNext       = "has_next"
+
+	// Fields and Edges constants for "GetEndpointRequest"
+	GetEndpointRequestFieldResourceId  = "resourceId"
+	GetEndpointRequestFieldProjectName = "projectName"
+
+	// Fields and Edges constants for "ListNetlinksRequest"
+	ListNetlinksRequestFieldOrderBy     = "order_by"
+	ListNetlinksRequestFieldFilter      = "filter"
+	ListNetlinksRequestFieldPageSize    = "page_size"
+	ListNetlinksRequestFieldOffset      = "offset"
+	ListNetlinksRequestFieldProjectName = "projectName"
+
+	// Fields and Edges constants for "ListNetlinksResponse"
+	ListNetlinksResponseEdgeNetlinks       = "netlinks"
+	ListNetlinksResponseFieldTotalElements = "total_elements"
+	ListNetlinksResponseFieldHasNext       = "has_next"
+
+	// Fields and Edges constants for "GetNetlinkRequest"
+	GetNetlinkRequestFieldResourceId  = "resourceId"
+	GetNetlinkRequestFieldProjectName = "projectName"
+
+	// Fields and Edges constants for "CreateRemoteAccessRequest"
+	CreateRemoteAccessRequestEdgeRemoteAccess = "remote_access"
+	CreateRemoteAccessRequestFieldProjectName = "projectName"
+
+	// Fields and Edges constants for "ListRemoteAccessesRequest"
+	ListRemoteAccessesRequestFieldOrderBy     = "order_by"
+	ListRemoteAccessesRequestFieldFilter      = "filter"
+	ListRemoteAccessesRequestFieldPageSize    = "page_size"
+	ListRemoteAccessesRequestFieldOffset      = "offset"
+	ListRemoteAccessesRequestFieldProjectName = "projectName"
+
+	// Fields and Edges constants for "ListRemoteAccessesResponse"
+	ListRemoteAccessesResponseEdgeRemoteAccesses = "remote_accesses"
+	ListRemoteAccessesResponseFieldTotalElements = "total_elements"
+	ListRemoteAccessesResponseFieldHasNext       = "has_next"
+
+	// Fields and Edges constants for "GetRemoteAccessRequest"
+	GetRemoteAccessRequestFieldResourceId  = "resourceId"
+	GetRemoteAccessRequestFieldProjectName = "projectName"
+
+	// Fields and Edges constants for "DeleteRemoteAccessRequest"
+	DeleteRemoteAccessRequestFieldResourceId  = "resourceId"
+	DeleteRemoteAccessRequestFieldProjectName = "projectName"
+
+	// Fields and Edges constants for "DeleteRemoteAccessResponse"
+
+	// Fields and Edges constants for "ListOperationsRequest"
+	ListOperationsRequestFieldPageSize    = "page_size"
+	ListOperationsRequestFieldOffset      = "offset"
+	ListOperationsRequestFieldProjectName = "projectName"
+
+	// Fields and Edges constants for "ListOperationsResponse"
+	ListOperationsResponseEdgeOperations     = "operations"
+	ListOperationsResponseFieldTotalElements = "total_elements"
+	ListOperationsResponseFieldHasNext       = "has_next"
+
+	// Fields and Edges constants for "GetOperationRequest"
+	GetOperationRequestFieldResourceId  = "resourceId"
+	GetOperationRequestFieldProjectName = "projectName"
+
+	// Fields and Edges constants for "CancelOperationRequest"
+	CancelOperationRequestFieldResourceId  = "resourceId"
+	CancelOperationRequestFieldProjectName = "projectName"
+
+	// Fields and Edges constants for "CreateAPITokenRequest"
+	CreateAPITokenRequestEdgeApiToken     = "api_token"
+	CreateAPITokenRequestFieldProjectName = "projectName"
+
+	// Fields and Edges constants for "ListAPITokensRequest"
+	ListAPITokensRequestFieldPageSize    = "page_size"
+	ListAPITokensRequestFieldOffset      = "offset"
+	ListAPITokensRequestFieldProjectName = "projectName"
+
+	// Fields and Edges constants for "ListAPITokensResponse"
+	ListAPITokensResponseEdgeApiTokens      = "api_tokens"
+	ListAPITokensResponseFieldTotalElements = "total_elements"
+	ListAPITokensResponseFieldHasNext       = "has_next"
+
+	// Fields and Edges constants for "DeleteAPITokenRequest"
+	DeleteAPITokenRequestFieldResourceId  = "resourceId"
+	DeleteAPITokenRequestFieldProjectName = "projectName"
+
+	// Fields and Edges constants for "DeleteAPITokenResponse"
+
+	// Fields and Edges constants for "CreateRoleBindingRequest"
+	CreateRoleBindingRequestEdgeRoleBinding  = "role_binding"
+	CreateRoleBindingRequestFieldProjectName = "projectName"
+
+	// Fields and Edges constants for "ListRoleBindingsRequest"
+	ListRoleBindingsRequestFieldOrderBy     = "order_by"
+	ListRoleBindingsRequestFieldFilter      = "filter"
+	ListRoleBindingsRequestFieldPageSize    = "page_size"
+	ListRoleBindingsRequestFieldOffset      = "offset"
+	ListRoleBindingsRequestFieldProjectName = "projectName"
+
+	// Fields and Edges constants for "ListRoleBindingsResponse"
+	ListRoleBindingsResponseEdgeRoleBindings   = "role_bindings"
+	ListRoleBindingsResponseFieldTotalElements = "total_elements"
+	ListRoleBindingsResponseFieldHasNext       = "has_next"
+
+	// Fields and Edges constants for "GetRoleBindingRequest"
+	GetRoleBindingRequestFieldResourceId  = "resourceId"
+	GetRoleBindingRequestFieldProjectName = "projectName"
+
+	// Fields and Edges constants for "UpdateRoleBindingRequest"
+	UpdateRoleBindingRequestFieldResourceId  = "resourceId"
+	UpdateRoleBindingRequestEdgeRoleBinding  = "role_binding"
+	UpdateRoleBindingRequestFieldProjectName = "projectName"
+
+	// Fields and Edges constants for "DeleteRoleBindingRequest"
+	DeleteRoleBindingRequestFieldResourceId  = "resourceId"
+	DeleteRoleBindingRequestFieldProjectName = "projectName"
+
+// Fields and Edges constants for "DeleteRoleBindingResponse"
 
 )
@@ -8,14 +8,19 @@ package servicesv1
 
 import (
 	context "context"
+	v111 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/resources/apitoken/v1"
 	v11 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/resources/compute/v1"
-	v17 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/resources/customconfig/v1"
-	v16 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/resources/localaccount/v1"
+	v18 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/resources/customconfig/v1"
+	v17 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/resources/localaccount/v1"
 	v1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/resources/location/v1"
-	v12 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/resources/os/v1"
-	v13 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/resources/provider/v1"
-	v14 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/resources/schedule/v1"
-	v15 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/resources/telemetry/v1"
+	v19 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/resources/network/v1"
+	v12 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/resources/operation/v1"
+	v13 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/resources/os/v1"
+	v14 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/resources/provider/v1"
+	v110 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/resources/remoteaccess/v1"
+	v112 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/resources/rolebinding/v1"
+	v15 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/resources/schedule/v1"
+	v16 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/resources/telemetry/v1"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -578,6 +583,282 @@ var SiteService_ServiceDesc = grpc.ServiceDesc{
 	Metadata: "services/v1/services.proto",
 }
 
+// OuServiceClient is the client API for OuService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type OuServiceClient interface {
+	// Create an OU.
+	CreateOu(ctx context.Context, in *CreateOuRequest, opts ...grpc.CallOption) (*v1.OuResource, error)
+	// Get a list of OUs.
+	ListOus(ctx context.Context, in *ListOusRequest, opts ...grpc.CallOption) (*ListOusResponse, error)
+	// Get a specific OU.
+	GetOu(ctx context.Context, in *GetOuRequest, opts ...grpc.CallOption) (*v1.OuResource, error)
+	// Update an OU.
+	UpdateOu(ctx context.Context, in *UpdateOuRequest, opts ...grpc.CallOption) (*v1.OuResource, error)
+	// Patch an OU.
+	PatchOu(ctx context.Context, in *PatchOuRequest, opts ...grpc.CallOption) (*v1.OuResource, error)
+	// Delete an OU.
+	DeleteOu(ctx context.Context, in *DeleteOuRequest, opts ...grpc.CallOption) (*DeleteOuResponse, error)
+}
+
+type ouServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewOuServiceClient(cc grpc.ClientConnInterface) OuServiceClient {
+	return &ouServiceClient{cc}
+}
+
+func (c *ouServiceClient) CreateOu(ctx context.Context, in *CreateOuRequest, opts ...grpc.CallOption) (*v1.OuResource, error) {
+	out := new(v1.OuResource)
+	err := c.cc.Invoke(ctx, "/services.v1.OuService/CreateOu", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ouServiceClient) ListOus(ctx context.Context, in *ListOusRequest, opts ...grpc.CallOption) (*ListOusResponse, error) {
+	out := new(ListOusResponse)
+	err := c.cc.Invoke(ctx, "/services.v1.OuService/ListOus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ouServiceClient) GetOu(ctx context.Context, in *GetOuRequest, opts ...grpc.CallOption) (*v1.OuResource, error) {
+	out := new(v1.OuResource)
+	err := c.cc.Invoke(ctx, "/services.v1.OuService/GetOu", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ouServiceClient) UpdateOu(ctx context.Context, in *UpdateOuRequest, opts ...grpc.CallOption) (*v1.OuResource, error) {
+	out := new(v1.OuResource)
+	err := c.cc.Invoke(ctx, "/services.v1.OuService/UpdateOu", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ouServiceClient) PatchOu(ctx context.Context, in *PatchOuRequest, opts ...grpc.CallOption) (*v1.OuResource, error) {
+	out := new(v1.OuResource)
+	err := c.cc.Invoke(ctx, "/services.v1.OuService/PatchOu", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ouServiceClient) DeleteOu(ctx context.Context, in *DeleteOuRequest, opts ...grpc.CallOption) (*DeleteOuResponse, error) {
+	out := new(DeleteOuResponse)
+	err := c.cc.Invoke(ctx, "/services.v1.OuService/DeleteOu", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// OuServiceServer is the server API for OuService service.
+// All implementations should embed UnimplementedOuServiceServer
+// for forward compatibility
+type OuServiceServer interface {
+	// Create an OU.
+	CreateOu(context.Context, *CreateOuRequest) (*v1.OuResource, error)
+	// Get a list of OUs.
+	ListOus(context.Context, *ListOusRequest) (*ListOusResponse, error)
+	// Get a specific OU.
+	GetOu(context.Context, *GetOuRequest) (*v1.OuResource, error)
+	// Update an OU.
+	UpdateOu(context.Context, *UpdateOuRequest) (*v1.OuResource, error)
+	// Patch an OU.
+	PatchOu(context.Context, *PatchOuRequest) (*v1.OuResource, error)
+	// Delete an OU.
+	DeleteOu(context.Context, *DeleteOuRequest) (*DeleteOuResponse, error)
+}
+
+// UnimplementedOuServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedOuServiceServer struct {
+}
+
+func (UnimplementedOuServiceServer) CreateOu(context.Context, *CreateOuRequest) (*v1.OuResource, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOu not implemented")
+}
+func (UnimplementedOuServiceServer) ListOus(context.Context, *ListOusRequest) (*ListOusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOus not implemented")
+}
+func (UnimplementedOuServiceServer) GetOu(context.Context, *GetOuRequest) (*v1.OuResource, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOu not implemented")
+}
+func (UnimplementedOuServiceServer) UpdateOu(context.Context, *UpdateOuRequest) (*v1.OuResource, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateOu not implemented")
+}
+func (UnimplementedOuServiceServer) PatchOu(context.Context, *PatchOuRequest) (*v1.OuResource, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PatchOu not implemented")
+}
+func (UnimplementedOuServiceServer) DeleteOu(context.Context, *DeleteOuRequest) (*DeleteOuResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteOu not implemented")
+}
+
+// UnsafeOuServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to OuServiceServer will
+// result in compilation errors.
+type UnsafeOuServiceServer interface {
+	mustEmbedUnimplementedOuServiceServer()
+}
+
+func RegisterOuServiceServer(s grpc.ServiceRegistrar, srv OuServiceServer) {
+	s.RegisterService(&OuService_ServiceDesc, srv)
+}
+
+func _OuService_CreateOu_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOuRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OuServiceServer).CreateOu(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/services.v1.OuService/CreateOu",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OuServiceServer).CreateOu(ctx, req.(*CreateOuRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OuService_ListOus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OuServiceServer).ListOus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/services.v1.OuService/ListOus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OuServiceServer).ListOus(ctx, req.(*ListOusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OuService_GetOu_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOuRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OuServiceServer).GetOu(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/services.v1.OuService/GetOu",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OuServiceServer).GetOu(ctx, req.(*GetOuRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OuService_UpdateOu_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateOuRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OuServiceServer).UpdateOu(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/services.v1.OuService/UpdateOu",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OuServiceServer).UpdateOu(ctx, req.(*UpdateOuRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OuService_PatchOu_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PatchOuRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OuServiceServer).PatchOu(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/services.v1.OuService/PatchOu",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OuServiceServer).PatchOu(ctx, req.(*PatchOuRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OuService_DeleteOu_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteOuRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OuServiceServer).DeleteOu(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/services.v1.OuService/DeleteOu",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OuServiceServer).DeleteOu(ctx, req.(*DeleteOuRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// OuService_ServiceDesc is the grpc.ServiceDesc for OuService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var OuService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "services.v1.OuService",
+	HandlerType: (*OuServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateOu",
+			Handler:    _OuService_CreateOu_Handler,
+		},
+		{
+			MethodName: "ListOus",
+			Handler:    _OuService_ListOus_Handler,
+		},
+		{
+			MethodName: "GetOu",
+			Handler:    _OuService_GetOu_Handler,
+		},
+		{
+			MethodName: "UpdateOu",
+			Handler:    _OuService_UpdateOu_Handler,
+		},
+		{
+			MethodName: "PatchOu",
+			Handler:    _OuService_PatchOu_Handler,
+		},
+		{
+			MethodName: "DeleteOu",
+			Handler:    _OuService_DeleteOu_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "services/v1/services.proto",
+}
+
 // LocationServiceClient is the client API for LocationService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
@@ -670,6 +951,8 @@ var LocationService_ServiceDesc = grpc.ServiceDesc{
 type HostServiceClient interface {
 	// Get a summary of the hosts status.
 	GetHostsSummary(ctx context.Context, in *GetHostSummaryRequest, opts ...grpc.CallOption) (*GetHostSummaryResponse, error)
+	// Get the number of hosts, broken down along the requested dimensions.
+	GetHostsStatistics(ctx context.Context, in *GetHostsStatisticsRequest, opts ...grpc.CallOption) (*GetHostsStatisticsResponse, error)
 	// Create a host.
 	CreateHost(ctx context.Context, in *CreateHostRequest, opts ...grpc.CallOption) (*v11.HostResource, error)
 	// Get a list of hosts.
@@ -684,8 +967,17 @@ type HostServiceClient interface {
 	DeleteHost(ctx context.Context, in *DeleteHostRequest, opts ...grpc.CallOption) (*DeleteHostResponse, error)
 	// Invalidate a host.
 	InvalidateHost(ctx context.Context, in *InvalidateHostRequest, opts ...grpc.CallOption) (*InvalidateHostResponse, error)
+	// Invalidate all the hosts matching a filter.
+	// The hosts are invalidated asynchronously, the returned operation reports the outcome of each host.
+	InvalidateHosts(ctx context.Context, in *InvalidateHostsRequest, opts ...grpc.CallOption) (*v12.OperationResource, error)
+	// Apply an action to all the hosts matching a filter or given by resource ID, or preview the hosts it applies to.
+	// The action is applied asynchronously, the returned operation reports the outcome of each host.
+	BulkHostAction(ctx context.Context, in *BulkHostActionRequest, opts ...grpc.CallOption) (*BulkHostActionResponse, error)
 	// Register a host.
 	RegisterHost(ctx context.Context, in *RegisterHostRequest, opts ...grpc.CallOption) (*v11.HostResource, error)
+	// Register multiple hosts at once, from a list or a CSV document.
+	// All the hosts are validated before any of them is registered, the result of each host is reported separately.
+	RegisterHosts(ctx context.Context, in *RegisterHostsRequest, opts ...grpc.CallOption) (*RegisterHostsResponse, error)
 	// Update a host registration.
 	PatchRegisterHost(ctx context.Context, in *RegisterHostRequest, opts ...grpc.CallOption) (*v11.HostResource, error)
 	// Onboard a host.
@@ -709,6 +1001,15 @@ func (c *hostServiceClient) GetHostsSummary(ctx context.Context, in *GetHostSumm
 	return out, nil
 }
 
+func (c *hostServiceClient) GetHostsStatistics(ctx context.Context, in *GetHostsStatisticsRequest, opts ...grpc.CallOption) (*GetHostsStatisticsResponse, error) {
+	out := new(GetHostsStatisticsResponse)
+	err := c.cc.Invoke(ctx, "/services.v1.HostService/GetHostsStatistics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *hostServiceClient) CreateHost(ctx context.Context, in *CreateHostRequest, opts ...grpc.CallOption) (*v11.HostResource, error) {
 	out := new(v11.HostResource)
 	err := c.cc.Invoke(ctx, "/services.v1.HostService/CreateHost", in, out, opts...)
@@ -772,6 +1073,24 @@ func (c *hostServiceClient) InvalidateHost(ctx context.Context, in *InvalidateHo
 	return out, nil
 }
 
+func (c *hostServiceClient) InvalidateHosts(ctx context.Context, in *InvalidateHostsRequest, opts ...grpc.CallOption) (*v12.OperationResource, error) {
+	out := new(v12.OperationResource)
+	err := c.cc.Invoke(ctx, "/services.v1.HostService/InvalidateHosts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hostServiceClient) BulkHostAction(ctx context.Context, in *BulkHostActionRequest, opts ...grpc.CallOption) (*BulkHostActionResponse, error) {
+	out := new(BulkHostActionResponse)
+	err := c.cc.Invoke(ctx, "/services.v1.HostService/BulkHostAction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *hostServiceClient) RegisterHost(ctx context.Context, in *RegisterHostRequest, opts ...grpc.CallOption) (*v11.HostResource, error) {
 	out := new(v11.HostResource)
 	err := c.cc.Invoke(ctx, "/services.v1.HostService/RegisterHost", in, out, opts...)
@@ -781,6 +1100,15 @@ func (c *hostServiceClient) RegisterHost(ctx context.Context, in *RegisterHostRe
 	return out, nil
 }
 
+func (c *hostServiceClient) RegisterHosts(ctx context.Context, in *RegisterHostsRequest, opts ...grpc.CallOption) (*RegisterHostsResponse, error) {
+	out := new(RegisterHostsResponse)
+	err := c.cc.Invoke(ctx, "/services.v1.HostService/RegisterHosts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *hostServiceClient) PatchRegisterHost(ctx context.Context, in *RegisterHostRequest, opts ...grpc.CallOption) (*v11.HostResource, error) {
 	out := new(v11.HostResource)
 	err := c.cc.Invoke(ctx, "/services.v1.HostService/PatchRegisterHost", in, out, opts...)
@@ -805,6 +1133,8 @@ func (c *hostServiceClient) OnboardHost(ctx context.Context, in *OnboardHostRequ
 type HostServiceServer interface {
 	// Get a summary of the hosts status.
 	GetHostsSummary(context.Context, *GetHostSummaryRequest) (*GetHostSummaryResponse, error)
+	// Get the number of hosts, broken down along the requested dimensions.
+	GetHostsStatistics(context.Context, *GetHostsStatisticsRequest) (*GetHostsStatisticsResponse, error)
 	// Create a host.
 	CreateHost(context.Context, *CreateHostRequest) (*v11.HostResource, error)
 	// Get a list of hosts.
@@ -819,8 +1149,17 @@ type HostServiceServer interface {
 	DeleteHost(context.Context, *DeleteHostRequest) (*DeleteHostResponse, error)
 	// Invalidate a host.
 	InvalidateHost(context.Context, *InvalidateHostRequest) (*InvalidateHostResponse, error)
+	// Invalidate all the hosts matching a filter.
+	// The hosts are invalidated asynchronously, the returned operation reports the outcome of each host.
+	InvalidateHosts(context.Context, *InvalidateHostsRequest) (*v12.OperationResource, error)
+	// Apply an action to all the hosts matching a filter or given by resource ID, or preview the hosts it applies to.
+	// The action is applied asynchronously, the returned operation reports the outcome of each host.
+	BulkHostAction(context.Context, *BulkHostActionRequest) (*BulkHostActionResponse, error)
 	// Register a host.
 	RegisterHost(context.Context, *RegisterHostRequest) (*v11.HostResource, error)
+	// Register multiple hosts at once, from a list or a CSV document.
+	// All the hosts are validated before any of them is registered, the result of each host is reported separately.
+	RegisterHosts(context.Context, *RegisterHostsRequest) (*RegisterHostsResponse, error)
 	// Update a host registration.
 	PatchRegisterHost(context.Context, *RegisterHostRequest) (*v11.HostResource, error)
 	// Onboard a host.
@@ -834,6 +1173,9 @@ type UnimplementedHostServiceServer struct {
 func (UnimplementedHostServiceServer) GetHostsSummary(context.Context, *GetHostSummaryRequest) (*GetHostSummaryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetHostsSummary not implemented")
 }
+func (UnimplementedHostServiceServer) GetHostsStatistics(context.Context, *GetHostsStatisticsRequest) (*GetHostsStatisticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHostsStatistics not implemented")
+}
 func (UnimplementedHostServiceServer) CreateHost(context.Context, *CreateHostRequest) (*v11.HostResource, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateHost not implemented")
 }
@@ -855,9 +1197,18 @@ func (UnimplementedHostServiceServer) DeleteHost(context.Context, *DeleteHostReq
 func (UnimplementedHostServiceServer) InvalidateHost(context.Context, *InvalidateHostRequest) (*InvalidateHostResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InvalidateHost not implemented")
 }
+func (UnimplementedHostServiceServer) InvalidateHosts(context.Context, *InvalidateHostsRequest) (*v12.OperationResource, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InvalidateHosts not implemented")
+}
+func (UnimplementedHostServiceServer) BulkHostAction(context.Context, *BulkHostActionRequest) (*BulkHostActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkHostAction not implemented")
+}
 func (UnimplementedHostServiceServer) RegisterHost(context.Context, *RegisterHostRequest) (*v11.HostResource, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RegisterHost not implemented")
 }
+func (UnimplementedHostServiceServer) RegisterHosts(context.Context, *RegisterHostsRequest) (*RegisterHostsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterHosts not implemented")
+}
 func (UnimplementedHostServiceServer) PatchRegisterHost(context.Context, *RegisterHostRequest) (*v11.HostResource, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PatchRegisterHost not implemented")
 }
@@ -894,6 +1245,24 @@ func _HostService_GetHostsSummary_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HostService_GetHostsStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHostsStatisticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HostServiceServer).GetHostsStatistics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/services.v1.HostService/GetHostsStatistics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HostServiceServer).GetHostsStatistics(ctx, req.(*GetHostsStatisticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _HostService_CreateHost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateHostRequest)
 	if err := dec(in); err != nil {
@@ -1020,6 +1389,42 @@ func _HostService_InvalidateHost_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HostService_InvalidateHosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InvalidateHostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HostServiceServer).InvalidateHosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/services.v1.HostService/InvalidateHosts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HostServiceServer).InvalidateHosts(ctx, req.(*InvalidateHostsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HostService_BulkHostAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkHostActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HostServiceServer).BulkHostAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/services.v1.HostService/BulkHostAction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HostServiceServer).BulkHostAction(ctx, req.(*BulkHostActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _HostService_RegisterHost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RegisterHostRequest)
 	if err := dec(in); err != nil {
@@ -1038,6 +1443,24 @@ func _HostService_RegisterHost_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HostService_RegisterHosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterHostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HostServiceServer).RegisterHosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/services.v1.HostService/RegisterHosts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HostServiceServer).RegisterHosts(ctx, req.(*RegisterHostsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _HostService_PatchRegisterHost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RegisterHostRequest)
 	if err := dec(in); err != nil {
@@ -1085,6 +1508,10 @@ var HostService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetHostsSummary",
 			Handler:    _HostService_GetHostsSummary_Handler,
 		},
+		{
+			MethodName: "GetHostsStatistics",
+			Handler:    _HostService_GetHostsStatistics_Handler,
+		},
 		{
 			MethodName: "CreateHost",
 			Handler:    _HostService_CreateHost_Handler,
@@ -1113,10 +1540,22 @@ var HostService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "InvalidateHost",
 			Handler:    _HostService_InvalidateHost_Handler,
 		},
+		{
+			MethodName: "InvalidateHosts",
+			Handler:    _HostService_InvalidateHosts_Handler,
+		},
+		{
+			MethodName: "BulkHostAction",
+			Handler:    _HostService_BulkHostAction_Handler,
+		},
 		{
 			MethodName: "RegisterHost",
 			Handler:    _HostService_RegisterHost_Handler,
 		},
+		{
+			MethodName: "RegisterHosts",
+			Handler:    _HostService_RegisterHosts_Handler,
+		},
 		{
 			MethodName: "PatchRegisterHost",
 			Handler:    _HostService_PatchRegisterHost_Handler,
@@ -1148,6 +1587,9 @@ type InstanceServiceClient interface {
 	DeleteInstance(ctx context.Context, in *DeleteInstanceRequest, opts ...grpc.CallOption) (*DeleteInstanceResponse, error)
 	// Invalidate a instance.
 	InvalidateInstance(ctx context.Context, in *InvalidateInstanceRequest, opts ...grpc.CallOption) (*InvalidateInstanceResponse, error)
+	// Apply an action to all the instances matching a filter or given by resource ID, or preview the instances it
+	// applies to. The action is applied asynchronously, the returned operation reports the outcome of each instance.
+	BulkInstanceAction(ctx context.Context, in *BulkInstanceActionRequest, opts ...grpc.CallOption) (*BulkInstanceActionResponse, error)
 }
 
 type instanceServiceClient struct {
@@ -1221,6 +1663,15 @@ func (c *instanceServiceClient) InvalidateInstance(ctx context.Context, in *Inva
 	return out, nil
 }
 
+func (c *instanceServiceClient) BulkInstanceAction(ctx context.Context, in *BulkInstanceActionRequest, opts ...grpc.CallOption) (*BulkInstanceActionResponse, error) {
+	out := new(BulkInstanceActionResponse)
+	err := c.cc.Invoke(ctx, "/services.v1.InstanceService/BulkInstanceAction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // InstanceServiceServer is the server API for InstanceService service.
 // All implementations should embed UnimplementedInstanceServiceServer
 // for forward compatibility
@@ -1239,6 +1690,9 @@ type InstanceServiceServer interface {
 	DeleteInstance(context.Context, *DeleteInstanceRequest) (*DeleteInstanceResponse, error)
 	// Invalidate a instance.
 	InvalidateInstance(context.Context, *InvalidateInstanceRequest) (*InvalidateInstanceResponse, error)
+	// Apply an action to all the instances matching a filter or given by resource ID, or preview the instances it
+	// applies to. The action is applied asynchronously, the returned operation reports the outcome of each instance.
+	BulkInstanceAction(context.Context, *BulkInstanceActionRequest) (*BulkInstanceActionResponse, error)
 }
 
 // UnimplementedInstanceServiceServer should be embedded to have forward compatible implementations.
@@ -1266,6 +1720,9 @@ func (UnimplementedInstanceServiceServer) DeleteInstance(context.Context, *Delet
 func (UnimplementedInstanceServiceServer) InvalidateInstance(context.Context, *InvalidateInstanceRequest) (*InvalidateInstanceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InvalidateInstance not implemented")
 }
+func (UnimplementedInstanceServiceServer) BulkInstanceAction(context.Context, *BulkInstanceActionRequest) (*BulkInstanceActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkInstanceAction not implemented")
+}
 
 // UnsafeInstanceServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to InstanceServiceServer will
@@ -1404,9 +1861,27 @@ func _InstanceService_InvalidateInstance_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
-// InstanceService_ServiceDesc is the grpc.ServiceDesc for InstanceService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
+func _InstanceService_BulkInstanceAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkInstanceActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstanceServiceServer).BulkInstanceAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/services.v1.InstanceService/BulkInstanceAction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstanceServiceServer).BulkInstanceAction(ctx, req.(*BulkInstanceActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// InstanceService_ServiceDesc is the grpc.ServiceDesc for InstanceService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
 var InstanceService_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "services.v1.InstanceService",
 	HandlerType: (*InstanceServiceServer)(nil),
@@ -1439,6 +1914,10 @@ var InstanceService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "InvalidateInstance",
 			Handler:    _InstanceService_InvalidateInstance_Handler,
 		},
+		{
+			MethodName: "BulkInstanceAction",
+			Handler:    _InstanceService_BulkInstanceAction_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "services/v1/services.proto",
@@ -1449,15 +1928,15 @@ var InstanceService_ServiceDesc = grpc.ServiceDesc{
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type OperatingSystemServiceClient interface {
 	// Create an OS
-	CreateOperatingSystem(ctx context.Context, in *CreateOperatingSystemRequest, opts ...grpc.CallOption) (*v12.OperatingSystemResource, error)
+	CreateOperatingSystem(ctx context.Context, in *CreateOperatingSystemRequest, opts ...grpc.CallOption) (*v13.OperatingSystemResource, error)
 	// Get a list of OSs.
 	ListOperatingSystems(ctx context.Context, in *ListOperatingSystemsRequest, opts ...grpc.CallOption) (*ListOperatingSystemsResponse, error)
 	// Get a specific OS.
-	GetOperatingSystem(ctx context.Context, in *GetOperatingSystemRequest, opts ...grpc.CallOption) (*v12.OperatingSystemResource, error)
+	GetOperatingSystem(ctx context.Context, in *GetOperatingSystemRequest, opts ...grpc.CallOption) (*v13.OperatingSystemResource, error)
 	// Update an OS.
-	UpdateOperatingSystem(ctx context.Context, in *UpdateOperatingSystemRequest, opts ...grpc.CallOption) (*v12.OperatingSystemResource, error)
+	UpdateOperatingSystem(ctx context.Context, in *UpdateOperatingSystemRequest, opts ...grpc.CallOption) (*v13.OperatingSystemResource, error)
 	// Patch an OS.
-	PatchOperatingSystem(ctx context.Context, in *PatchOperatingSystemRequest, opts ...grpc.CallOption) (*v12.OperatingSystemResource, error)
+	PatchOperatingSystem(ctx context.Context, in *PatchOperatingSystemRequest, opts ...grpc.CallOption) (*v13.OperatingSystemResource, error)
 	// Delete an OS.
 	DeleteOperatingSystem(ctx context.Context, in *DeleteOperatingSystemRequest, opts ...grpc.CallOption) (*DeleteOperatingSystemResponse, error)
 }
@@ -1470,8 +1949,8 @@ func NewOperatingSystemServiceClient(cc grpc.ClientConnInterface) OperatingSyste
 	return &operatingSystemServiceClient{cc}
 }
 
-func (c *operatingSystemServiceClient) CreateOperatingSystem(ctx context.Context, in *CreateOperatingSystemRequest, opts ...grpc.CallOption) (*v12.OperatingSystemResource, error) {
-	out := new(v12.OperatingSystemResource)
+func (c *operatingSystemServiceClient) CreateOperatingSystem(ctx context.Context, in *CreateOperatingSystemRequest, opts ...grpc.CallOption) (*v13.OperatingSystemResource, error) {
+	out := new(v13.OperatingSystemResource)
 	err := c.cc.Invoke(ctx, "/services.v1.OperatingSystemService/CreateOperatingSystem", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -1488,8 +1967,8 @@ func (c *operatingSystemServiceClient) ListOperatingSystems(ctx context.Context,
 	return out, nil
 }
 
-func (c *operatingSystemServiceClient) GetOperatingSystem(ctx context.Context, in *GetOperatingSystemRequest, opts ...grpc.CallOption) (*v12.OperatingSystemResource, error) {
-	out := new(v12.OperatingSystemResource)
+func (c *operatingSystemServiceClient) GetOperatingSystem(ctx context.Context, in *GetOperatingSystemRequest, opts ...grpc.CallOption) (*v13.OperatingSystemResource, error) {
+	out := new(v13.OperatingSystemResource)
 	err := c.cc.Invoke(ctx, "/services.v1.OperatingSystemService/GetOperatingSystem", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -1497,8 +1976,8 @@ func (c *operatingSystemServiceClient) GetOperatingSystem(ctx context.Context, i
 	return out, nil
 }
 
-func (c *operatingSystemServiceClient) UpdateOperatingSystem(ctx context.Context, in *UpdateOperatingSystemRequest, opts ...grpc.CallOption) (*v12.OperatingSystemResource, error) {
-	out := new(v12.OperatingSystemResource)
+func (c *operatingSystemServiceClient) UpdateOperatingSystem(ctx context.Context, in *UpdateOperatingSystemRequest, opts ...grpc.CallOption) (*v13.OperatingSystemResource, error) {
+	out := new(v13.OperatingSystemResource)
 	err := c.cc.Invoke(ctx, "/services.v1.OperatingSystemService/UpdateOperatingSystem", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -1506,8 +1985,8 @@ func (c *operatingSystemServiceClient) UpdateOperatingSystem(ctx context.Context
 	return out, nil
 }
 
-func (c *operatingSystemServiceClient) PatchOperatingSystem(ctx context.Context, in *PatchOperatingSystemRequest, opts ...grpc.CallOption) (*v12.OperatingSystemResource, error) {
-	out := new(v12.OperatingSystemResource)
+func (c *operatingSystemServiceClient) PatchOperatingSystem(ctx context.Context, in *PatchOperatingSystemRequest, opts ...grpc.CallOption) (*v13.OperatingSystemResource, error) {
+	out := new(v13.OperatingSystemResource)
 	err := c.cc.Invoke(ctx, "/services.v1.OperatingSystemService/PatchOperatingSystem", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -1529,15 +2008,15 @@ func (c *operatingSystemServiceClient) DeleteOperatingSystem(ctx context.Context
 // for forward compatibility
 type OperatingSystemServiceServer interface {
 	// Create an OS
-	CreateOperatingSystem(context.Context, *CreateOperatingSystemRequest) (*v12.OperatingSystemResource, error)
+	CreateOperatingSystem(context.Context, *CreateOperatingSystemRequest) (*v13.OperatingSystemResource, error)
 	// Get a list of OSs.
 	ListOperatingSystems(context.Context, *ListOperatingSystemsRequest) (*ListOperatingSystemsResponse, error)
 	// Get a specific OS.
-	GetOperatingSystem(context.Context, *GetOperatingSystemRequest) (*v12.OperatingSystemResource, error)
+	GetOperatingSystem(context.Context, *GetOperatingSystemRequest) (*v13.OperatingSystemResource, error)
 	// Update an OS.
-	UpdateOperatingSystem(context.Context, *UpdateOperatingSystemRequest) (*v12.OperatingSystemResource, error)
+	UpdateOperatingSystem(context.Context, *UpdateOperatingSystemRequest) (*v13.OperatingSystemResource, error)
 	// Patch an OS.
-	PatchOperatingSystem(context.Context, *PatchOperatingSystemRequest) (*v12.OperatingSystemResource, error)
+	PatchOperatingSystem(context.Context, *PatchOperatingSystemRequest) (*v13.OperatingSystemResource, error)
 	// Delete an OS.
 	DeleteOperatingSystem(context.Context, *DeleteOperatingSystemRequest) (*DeleteOperatingSystemResponse, error)
 }
@@ -1546,19 +2025,19 @@ type OperatingSystemServiceServer interface {
 type UnimplementedOperatingSystemServiceServer struct {
 }
 
-func (UnimplementedOperatingSystemServiceServer) CreateOperatingSystem(context.Context, *CreateOperatingSystemRequest) (*v12.OperatingSystemResource, error) {
+func (UnimplementedOperatingSystemServiceServer) CreateOperatingSystem(context.Context, *CreateOperatingSystemRequest) (*v13.OperatingSystemResource, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateOperatingSystem not implemented")
 }
 func (UnimplementedOperatingSystemServiceServer) ListOperatingSystems(context.Context, *ListOperatingSystemsRequest) (*ListOperatingSystemsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListOperatingSystems not implemented")
 }
-func (UnimplementedOperatingSystemServiceServer) GetOperatingSystem(context.Context, *GetOperatingSystemRequest) (*v12.OperatingSystemResource, error) {
+func (UnimplementedOperatingSystemServiceServer) GetOperatingSystem(context.Context, *GetOperatingSystemRequest) (*v13.OperatingSystemResource, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetOperatingSystem not implemented")
 }
-func (UnimplementedOperatingSystemServiceServer) UpdateOperatingSystem(context.Context, *UpdateOperatingSystemRequest) (*v12.OperatingSystemResource, error) {
+func (UnimplementedOperatingSystemServiceServer) UpdateOperatingSystem(context.Context, *UpdateOperatingSystemRequest) (*v13.OperatingSystemResource, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateOperatingSystem not implemented")
 }
-func (UnimplementedOperatingSystemServiceServer) PatchOperatingSystem(context.Context, *PatchOperatingSystemRequest) (*v12.OperatingSystemResource, error) {
+func (UnimplementedOperatingSystemServiceServer) PatchOperatingSystem(context.Context, *PatchOperatingSystemRequest) (*v13.OperatingSystemResource, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PatchOperatingSystem not implemented")
 }
 func (UnimplementedOperatingSystemServiceServer) DeleteOperatingSystem(context.Context, *DeleteOperatingSystemRequest) (*DeleteOperatingSystemResponse, error) {
@@ -1725,11 +2204,15 @@ var OperatingSystemService_ServiceDesc = grpc.ServiceDesc{
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type ProviderServiceClient interface {
 	// Create a provider.
-	CreateProvider(ctx context.Context, in *CreateProviderRequest, opts ...grpc.CallOption) (*v13.ProviderResource, error)
+	CreateProvider(ctx context.Context, in *CreateProviderRequest, opts ...grpc.CallOption) (*v14.ProviderResource, error)
 	// Get a list of providers.
 	ListProviders(ctx context.Context, in *ListProvidersRequest, opts ...grpc.CallOption) (*ListProvidersResponse, error)
 	// Get a specific provider.
-	GetProvider(ctx context.Context, in *GetProviderRequest, opts ...grpc.CallOption) (*v13.ProviderResource, error)
+	GetProvider(ctx context.Context, in *GetProviderRequest, opts ...grpc.CallOption) (*v14.ProviderResource, error)
+	// Update a provider.
+	UpdateProvider(ctx context.Context, in *UpdateProviderRequest, opts ...grpc.CallOption) (*v14.ProviderResource, error)
+	// Patch a provider.
+	PatchProvider(ctx context.Context, in *PatchProviderRequest, opts ...grpc.CallOption) (*v14.ProviderResource, error)
 	// Delete a provider.
 	DeleteProvider(ctx context.Context, in *DeleteProviderRequest, opts ...grpc.CallOption) (*DeleteProviderResponse, error)
 }
@@ -1742,8 +2225,8 @@ func NewProviderServiceClient(cc grpc.ClientConnInterface) ProviderServiceClient
 	return &providerServiceClient{cc}
 }
 
-func (c *providerServiceClient) CreateProvider(ctx context.Context, in *CreateProviderRequest, opts ...grpc.CallOption) (*v13.ProviderResource, error) {
-	out := new(v13.ProviderResource)
+func (c *providerServiceClient) CreateProvider(ctx context.Context, in *CreateProviderRequest, opts ...grpc.CallOption) (*v14.ProviderResource, error) {
+	out := new(v14.ProviderResource)
 	err := c.cc.Invoke(ctx, "/services.v1.ProviderService/CreateProvider", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -1760,8 +2243,8 @@ func (c *providerServiceClient) ListProviders(ctx context.Context, in *ListProvi
 	return out, nil
 }
 
-func (c *providerServiceClient) GetProvider(ctx context.Context, in *GetProviderRequest, opts ...grpc.CallOption) (*v13.ProviderResource, error) {
-	out := new(v13.ProviderResource)
+func (c *providerServiceClient) GetProvider(ctx context.Context, in *GetProviderRequest, opts ...grpc.CallOption) (*v14.ProviderResource, error) {
+	out := new(v14.ProviderResource)
 	err := c.cc.Invoke(ctx, "/services.v1.ProviderService/GetProvider", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -1769,6 +2252,24 @@ func (c *providerServiceClient) GetProvider(ctx context.Context, in *GetProvider
 	return out, nil
 }
 
+func (c *providerServiceClient) UpdateProvider(ctx context.Context, in *UpdateProviderRequest, opts ...grpc.CallOption) (*v14.ProviderResource, error) {
+	out := new(v14.ProviderResource)
+	err := c.cc.Invoke(ctx, "/services.v1.ProviderService/UpdateProvider", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerServiceClient) PatchProvider(ctx context.Context, in *PatchProviderRequest, opts ...grpc.CallOption) (*v14.ProviderResource, error) {
+	out := new(v14.ProviderResource)
+	err := c.cc.Invoke(ctx, "/services.v1.ProviderService/PatchProvider", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *providerServiceClient) DeleteProvider(ctx context.Context, in *DeleteProviderRequest, opts ...grpc.CallOption) (*DeleteProviderResponse, error) {
 	out := new(DeleteProviderResponse)
 	err := c.cc.Invoke(ctx, "/services.v1.ProviderService/DeleteProvider", in, out, opts...)
@@ -1783,11 +2284,15 @@ func (c *providerServiceClient) DeleteProvider(ctx context.Context, in *DeletePr
 // for forward compatibility
 type ProviderServiceServer interface {
 	// Create a provider.
-	CreateProvider(context.Context, *CreateProviderRequest) (*v13.ProviderResource, error)
+	CreateProvider(context.Context, *CreateProviderRequest) (*v14.ProviderResource, error)
 	// Get a list of providers.
 	ListProviders(context.Context, *ListProvidersRequest) (*ListProvidersResponse, error)
 	// Get a specific provider.
-	GetProvider(context.Context, *GetProviderRequest) (*v13.ProviderResource, error)
+	GetProvider(context.Context, *GetProviderRequest) (*v14.ProviderResource, error)
+	// Update a provider.
+	UpdateProvider(context.Context, *UpdateProviderRequest) (*v14.ProviderResource, error)
+	// Patch a provider.
+	PatchProvider(context.Context, *PatchProviderRequest) (*v14.ProviderResource, error)
 	// Delete a provider.
 	DeleteProvider(context.Context, *DeleteProviderRequest) (*DeleteProviderResponse, error)
 }
@@ -1796,15 +2301,21 @@ type ProviderServiceServer interface {
 type UnimplementedProviderServiceServer struct {
 }
 
-func (UnimplementedProviderServiceServer) CreateProvider(context.Context, *CreateProviderRequest) (*v13.ProviderResource, error) {
+func (UnimplementedProviderServiceServer) CreateProvider(context.Context, *CreateProviderRequest) (*v14.ProviderResource, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateProvider not implemented")
 }
 func (UnimplementedProviderServiceServer) ListProviders(context.Context, *ListProvidersRequest) (*ListProvidersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListProviders not implemented")
 }
-func (UnimplementedProviderServiceServer) GetProvider(context.Context, *GetProviderRequest) (*v13.ProviderResource, error) {
+func (UnimplementedProviderServiceServer) GetProvider(context.Context, *GetProviderRequest) (*v14.ProviderResource, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetProvider not implemented")
 }
+func (UnimplementedProviderServiceServer) UpdateProvider(context.Context, *UpdateProviderRequest) (*v14.ProviderResource, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProvider not implemented")
+}
+func (UnimplementedProviderServiceServer) PatchProvider(context.Context, *PatchProviderRequest) (*v14.ProviderResource, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PatchProvider not implemented")
+}
 func (UnimplementedProviderServiceServer) DeleteProvider(context.Context, *DeleteProviderRequest) (*DeleteProviderResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteProvider not implemented")
 }
@@ -1874,6 +2385,42 @@ func _ProviderService_GetProvider_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProviderService_UpdateProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProviderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServiceServer).UpdateProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/services.v1.ProviderService/UpdateProvider",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServiceServer).UpdateProvider(ctx, req.(*UpdateProviderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProviderService_PatchProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PatchProviderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServiceServer).PatchProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/services.v1.ProviderService/PatchProvider",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServiceServer).PatchProvider(ctx, req.(*PatchProviderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ProviderService_DeleteProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteProviderRequest)
 	if err := dec(in); err != nil {
@@ -1911,6 +2458,14 @@ var ProviderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetProvider",
 			Handler:    _ProviderService_GetProvider_Handler,
 		},
+		{
+			MethodName: "UpdateProvider",
+			Handler:    _ProviderService_UpdateProvider_Handler,
+		},
+		{
+			MethodName: "PatchProvider",
+			Handler:    _ProviderService_PatchProvider_Handler,
+		},
 		{
 			MethodName: "DeleteProvider",
 			Handler:    _ProviderService_DeleteProvider_Handler,
@@ -2403,27 +2958,27 @@ type ScheduleServiceClient interface {
 	// Get a list of schedules (single/repeated).
 	ListSchedules(ctx context.Context, in *ListSchedulesRequest, opts ...grpc.CallOption) (*ListSchedulesResponse, error)
 	// Create a single_schedule.
-	CreateSingleSchedule(ctx context.Context, in *CreateSingleScheduleRequest, opts ...grpc.CallOption) (*v14.SingleScheduleResource, error)
+	CreateSingleSchedule(ctx context.Context, in *CreateSingleScheduleRequest, opts ...grpc.CallOption) (*v15.SingleScheduleResource, error)
 	// Get a list of singleSchedules.
 	ListSingleSchedules(ctx context.Context, in *ListSingleSchedulesRequest, opts ...grpc.CallOption) (*ListSingleSchedulesResponse, error)
 	// Get a specific single_schedule.
-	GetSingleSchedule(ctx context.Context, in *GetSingleScheduleRequest, opts ...grpc.CallOption) (*v14.SingleScheduleResource, error)
+	GetSingleSchedule(ctx context.Context, in *GetSingleScheduleRequest, opts ...grpc.CallOption) (*v15.SingleScheduleResource, error)
 	// Update a single_schedule.
-	UpdateSingleSchedule(ctx context.Context, in *UpdateSingleScheduleRequest, opts ...grpc.CallOption) (*v14.SingleScheduleResource, error)
+	UpdateSingleSchedule(ctx context.Context, in *UpdateSingleScheduleRequest, opts ...grpc.CallOption) (*v15.SingleScheduleResource, error)
 	// Patch a single_schedule.
-	PatchSingleSchedule(ctx context.Context, in *PatchSingleScheduleRequest, opts ...grpc.CallOption) (*v14.SingleScheduleResource, error)
+	PatchSingleSchedule(ctx context.Context, in *PatchSingleScheduleRequest, opts ...grpc.CallOption) (*v15.SingleScheduleResource, error)
 	// Delete a single_schedule.
 	DeleteSingleSchedule(ctx context.Context, in *DeleteSingleScheduleRequest, opts ...grpc.CallOption) (*DeleteSingleScheduleResponse, error)
 	// Create a repeated_schedule.
-	CreateRepeatedSchedule(ctx context.Context, in *CreateRepeatedScheduleRequest, opts ...grpc.CallOption) (*v14.RepeatedScheduleResource, error)
+	CreateRepeatedSchedule(ctx context.Context, in *CreateRepeatedScheduleRequest, opts ...grpc.CallOption) (*v15.RepeatedScheduleResource, error)
 	// Get a list of repeatedSchedules.
 	ListRepeatedSchedules(ctx context.Context, in *ListRepeatedSchedulesRequest, opts ...grpc.CallOption) (*ListRepeatedSchedulesResponse, error)
 	// Get a specific repeated_schedule.
-	GetRepeatedSchedule(ctx context.Context, in *GetRepeatedScheduleRequest, opts ...grpc.CallOption) (*v14.RepeatedScheduleResource, error)
+	GetRepeatedSchedule(ctx context.Context, in *GetRepeatedScheduleRequest, opts ...grpc.CallOption) (*v15.RepeatedScheduleResource, error)
 	// Update a repeated_schedule.
-	UpdateRepeatedSchedule(ctx context.Context, in *UpdateRepeatedScheduleRequest, opts ...grpc.CallOption) (*v14.RepeatedScheduleResource, error)
+	UpdateRepeatedSchedule(ctx context.Context, in *UpdateRepeatedScheduleRequest, opts ...grpc.CallOption) (*v15.RepeatedScheduleResource, error)
 	// Patch a repeated_schedule.
-	PatchRepeatedSchedule(ctx context.Context, in *PatchRepeatedScheduleRequest, opts ...grpc.CallOption) (*v14.RepeatedScheduleResource, error)
+	PatchRepeatedSchedule(ctx context.Context, in *PatchRepeatedScheduleRequest, opts ...grpc.CallOption) (*v15.RepeatedScheduleResource, error)
 	// Delete a repeated_schedule.
 	DeleteRepeatedSchedule(ctx context.Context, in *DeleteRepeatedScheduleRequest, opts ...grpc.CallOption) (*DeleteRepeatedScheduleResponse, error)
 }
@@ -2445,8 +3000,8 @@ func (c *scheduleServiceClient) ListSchedules(ctx context.Context, in *ListSched
 	return out, nil
 }
 
-func (c *scheduleServiceClient) CreateSingleSchedule(ctx context.Context, in *CreateSingleScheduleRequest, opts ...grpc.CallOption) (*v14.SingleScheduleResource, error) {
-	out := new(v14.SingleScheduleResource)
+func (c *scheduleServiceClient) CreateSingleSchedule(ctx context.Context, in *CreateSingleScheduleRequest, opts ...grpc.CallOption) (*v15.SingleScheduleResource, error) {
+	out := new(v15.SingleScheduleResource)
 	err := c.cc.Invoke(ctx, "/services.v1.ScheduleService/CreateSingleSchedule", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -2463,8 +3018,8 @@ func (c *scheduleServiceClient) ListSingleSchedules(ctx context.Context, in *Lis
 	return out, nil
 }
 
-func (c *scheduleServiceClient) GetSingleSchedule(ctx context.Context, in *GetSingleScheduleRequest, opts ...grpc.CallOption) (*v14.SingleScheduleResource, error) {
-	out := new(v14.SingleScheduleResource)
+func (c *scheduleServiceClient) GetSingleSchedule(ctx context.Context, in *GetSingleScheduleRequest, opts ...grpc.CallOption) (*v15.SingleScheduleResource, error) {
+	out := new(v15.SingleScheduleResource)
 	err := c.cc.Invoke(ctx, "/services.v1.ScheduleService/GetSingleSchedule", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -2472,8 +3027,8 @@ func (c *scheduleServiceClient) GetSingleSchedule(ctx context.Context, in *GetSi
 	return out, nil
 }
 
-func (c *scheduleServiceClient) UpdateSingleSchedule(ctx context.Context, in *UpdateSingleScheduleRequest, opts ...grpc.CallOption) (*v14.SingleScheduleResource, error) {
-	out := new(v14.SingleScheduleResource)
+func (c *scheduleServiceClient) UpdateSingleSchedule(ctx context.Context, in *UpdateSingleScheduleRequest, opts ...grpc.CallOption) (*v15.SingleScheduleResource, error) {
+	out := new(v15.SingleScheduleResource)
 	err := c.cc.Invoke(ctx, "/services.v1.ScheduleService/UpdateSingleSchedule", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -2481,8 +3036,8 @@ func (c *scheduleServiceClient) UpdateSingleSchedule(ctx context.Context, in *Up
 	return out, nil
 }
 
-func (c *scheduleServiceClient) PatchSingleSchedule(ctx context.Context, in *PatchSingleScheduleRequest, opts ...grpc.CallOption) (*v14.SingleScheduleResource, error) {
-	out := new(v14.SingleScheduleResource)
+func (c *scheduleServiceClient) PatchSingleSchedule(ctx context.Context, in *PatchSingleScheduleRequest, opts ...grpc.CallOption) (*v15.SingleScheduleResource, error) {
+	out := new(v15.SingleScheduleResource)
 	err := c.cc.Invoke(ctx, "/services.v1.ScheduleService/PatchSingleSchedule", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -2499,8 +3054,8 @@ func (c *scheduleServiceClient) DeleteSingleSchedule(ctx context.Context, in *De
 	return out, nil
 }
 
-func (c *scheduleServiceClient) CreateRepeatedSchedule(ctx context.Context, in *CreateRepeatedScheduleRequest, opts ...grpc.CallOption) (*v14.RepeatedScheduleResource, error) {
-	out := new(v14.RepeatedScheduleResource)
+func (c *scheduleServiceClient) CreateRepeatedSchedule(ctx context.Context, in *CreateRepeatedScheduleRequest, opts ...grpc.CallOption) (*v15.RepeatedScheduleResource, error) {
+	out := new(v15.RepeatedScheduleResource)
 	err := c.cc.Invoke(ctx, "/services.v1.ScheduleService/CreateRepeatedSchedule", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -2517,8 +3072,8 @@ func (c *scheduleServiceClient) ListRepeatedSchedules(ctx context.Context, in *L
 	return out, nil
 }
 
-func (c *scheduleServiceClient) GetRepeatedSchedule(ctx context.Context, in *GetRepeatedScheduleRequest, opts ...grpc.CallOption) (*v14.RepeatedScheduleResource, error) {
-	out := new(v14.RepeatedScheduleResource)
+func (c *scheduleServiceClient) GetRepeatedSchedule(ctx context.Context, in *GetRepeatedScheduleRequest, opts ...grpc.CallOption) (*v15.RepeatedScheduleResource, error) {
+	out := new(v15.RepeatedScheduleResource)
 	err := c.cc.Invoke(ctx, "/services.v1.ScheduleService/GetRepeatedSchedule", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -2526,8 +3081,8 @@ func (c *scheduleServiceClient) GetRepeatedSchedule(ctx context.Context, in *Get
 	return out, nil
 }
 
-func (c *scheduleServiceClient) UpdateRepeatedSchedule(ctx context.Context, in *UpdateRepeatedScheduleRequest, opts ...grpc.CallOption) (*v14.RepeatedScheduleResource, error) {
-	out := new(v14.RepeatedScheduleResource)
+func (c *scheduleServiceClient) UpdateRepeatedSchedule(ctx context.Context, in *UpdateRepeatedScheduleRequest, opts ...grpc.CallOption) (*v15.RepeatedScheduleResource, error) {
+	out := new(v15.RepeatedScheduleResource)
 	err := c.cc.Invoke(ctx, "/services.v1.ScheduleService/UpdateRepeatedSchedule", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -2535,8 +3090,8 @@ func (c *scheduleServiceClient) UpdateRepeatedSchedule(ctx context.Context, in *
 	return out, nil
 }
 
-func (c *scheduleServiceClient) PatchRepeatedSchedule(ctx context.Context, in *PatchRepeatedScheduleRequest, opts ...grpc.CallOption) (*v14.RepeatedScheduleResource, error) {
-	out := new(v14.RepeatedScheduleResource)
+func (c *scheduleServiceClient) PatchRepeatedSchedule(ctx context.Context, in *PatchRepeatedScheduleRequest, opts ...grpc.CallOption) (*v15.RepeatedScheduleResource, error) {
+	out := new(v15.RepeatedScheduleResource)
 	err := c.cc.Invoke(ctx, "/services.v1.ScheduleService/PatchRepeatedSchedule", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -2560,27 +3115,27 @@ type ScheduleServiceServer interface {
 	// Get a list of schedules (single/repeated).
 	ListSchedules(context.Context, *ListSchedulesRequest) (*ListSchedulesResponse, error)
 	// Create a single_schedule.
-	CreateSingleSchedule(context.Context, *CreateSingleScheduleRequest) (*v14.SingleScheduleResource, error)
+	CreateSingleSchedule(context.Context, *CreateSingleScheduleRequest) (*v15.SingleScheduleResource, error)
 	// Get a list of singleSchedules.
 	ListSingleSchedules(context.Context, *ListSingleSchedulesRequest) (*ListSingleSchedulesResponse, error)
 	// Get a specific single_schedule.
-	GetSingleSchedule(context.Context, *GetSingleScheduleRequest) (*v14.SingleScheduleResource, error)
+	GetSingleSchedule(context.Context, *GetSingleScheduleRequest) (*v15.SingleScheduleResource, error)
 	// Update a single_schedule.
-	UpdateSingleSchedule(context.Context, *UpdateSingleScheduleRequest) (*v14.SingleScheduleResource, error)
+	UpdateSingleSchedule(context.Context, *UpdateSingleScheduleRequest) (*v15.SingleScheduleResource, error)
 	// Patch a single_schedule.
-	PatchSingleSchedule(context.Context, *PatchSingleScheduleRequest) (*v14.SingleScheduleResource, error)
+	PatchSingleSchedule(context.Context, *PatchSingleScheduleRequest) (*v15.SingleScheduleResource, error)
 	// Delete a single_schedule.
 	DeleteSingleSchedule(context.Context, *DeleteSingleScheduleRequest) (*DeleteSingleScheduleResponse, error)
 	// Create a repeated_schedule.
-	CreateRepeatedSchedule(context.Context, *CreateRepeatedScheduleRequest) (*v14.RepeatedScheduleResource, error)
+	CreateRepeatedSchedule(context.Context, *CreateRepeatedScheduleRequest) (*v15.RepeatedScheduleResource, error)
 	// Get a list of repeatedSchedules.
 	ListRepeatedSchedules(context.Context, *ListRepeatedSchedulesRequest) (*ListRepeatedSchedulesResponse, error)
 	// Get a specific repeated_schedule.
-	GetRepeatedSchedule(context.Context, *GetRepeatedScheduleRequest) (*v14.RepeatedScheduleResource, error)
+	GetRepeatedSchedule(context.Context, *GetRepeatedScheduleRequest) (*v15.RepeatedScheduleResource, error)
 	// Update a repeated_schedule.
-	UpdateRepeatedSchedule(context.Context, *UpdateRepeatedScheduleRequest) (*v14.RepeatedScheduleResource, error)
+	UpdateRepeatedSchedule(context.Context, *UpdateRepeatedScheduleRequest) (*v15.RepeatedScheduleResource, error)
 	// Patch a repeated_schedule.
-	PatchRepeatedSchedule(context.Context, *PatchRepeatedScheduleRequest) (*v14.RepeatedScheduleResource, error)
+	PatchRepeatedSchedule(context.Context, *PatchRepeatedScheduleRequest) (*v15.RepeatedScheduleResource, error)
 	// Delete a repeated_schedule.
 	DeleteRepeatedSchedule(context.Context, *DeleteRepeatedScheduleRequest) (*DeleteRepeatedScheduleResponse, error)
 }
@@ -2592,37 +3147,37 @@ type UnimplementedScheduleServiceServer struct {
 func (UnimplementedScheduleServiceServer) ListSchedules(context.Context, *ListSchedulesRequest) (*ListSchedulesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListSchedules not implemented")
 }
-func (UnimplementedScheduleServiceServer) CreateSingleSchedule(context.Context, *CreateSingleScheduleRequest) (*v14.SingleScheduleResource, error) {
+func (UnimplementedScheduleServiceServer) CreateSingleSchedule(context.Context, *CreateSingleScheduleRequest) (*v15.SingleScheduleResource, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateSingleSchedule not implemented")
 }
 func (UnimplementedScheduleServiceServer) ListSingleSchedules(context.Context, *ListSingleSchedulesRequest) (*ListSingleSchedulesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListSingleSchedules not implemented")
 }
-func (UnimplementedScheduleServiceServer) GetSingleSchedule(context.Context, *GetSingleScheduleRequest) (*v14.SingleScheduleResource, error) {
+func (UnimplementedScheduleServiceServer) GetSingleSchedule(context.Context, *GetSingleScheduleRequest) (*v15.SingleScheduleResource, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSingleSchedule not implemented")
 }
-func (UnimplementedScheduleServiceServer) UpdateSingleSchedule(context.Context, *UpdateSingleScheduleRequest) (*v14.SingleScheduleResource, error) {
+func (UnimplementedScheduleServiceServer) UpdateSingleSchedule(context.Context, *UpdateSingleScheduleRequest) (*v15.SingleScheduleResource, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateSingleSchedule not implemented")
 }
-func (UnimplementedScheduleServiceServer) PatchSingleSchedule(context.Context, *PatchSingleScheduleRequest) (*v14.SingleScheduleResource, error) {
+func (UnimplementedScheduleServiceServer) PatchSingleSchedule(context.Context, *PatchSingleScheduleRequest) (*v15.SingleScheduleResource, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PatchSingleSchedule not implemented")
 }
 func (UnimplementedScheduleServiceServer) DeleteSingleSchedule(context.Context, *DeleteSingleScheduleRequest) (*DeleteSingleScheduleResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteSingleSchedule not implemented")
 }
-func (UnimplementedScheduleServiceServer) CreateRepeatedSchedule(context.Context, *CreateRepeatedScheduleRequest) (*v14.RepeatedScheduleResource, error) {
+func (UnimplementedScheduleServiceServer) CreateRepeatedSchedule(context.Context, *CreateRepeatedScheduleRequest) (*v15.RepeatedScheduleResource, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateRepeatedSchedule not implemented")
 }
 func (UnimplementedScheduleServiceServer) ListRepeatedSchedules(context.Context, *ListRepeatedSchedulesRequest) (*ListRepeatedSchedulesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListRepeatedSchedules not implemented")
 }
-func (UnimplementedScheduleServiceServer) GetRepeatedSchedule(context.Context, *GetRepeatedScheduleRequest) (*v14.RepeatedScheduleResource, error) {
+func (UnimplementedScheduleServiceServer) GetRepeatedSchedule(context.Context, *GetRepeatedScheduleRequest) (*v15.RepeatedScheduleResource, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRepeatedSchedule not implemented")
 }
-func (UnimplementedScheduleServiceServer) UpdateRepeatedSchedule(context.Context, *UpdateRepeatedScheduleRequest) (*v14.RepeatedScheduleResource, error) {
+func (UnimplementedScheduleServiceServer) UpdateRepeatedSchedule(context.Context, *UpdateRepeatedScheduleRequest) (*v15.RepeatedScheduleResource, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateRepeatedSchedule not implemented")
 }
-func (UnimplementedScheduleServiceServer) PatchRepeatedSchedule(context.Context, *PatchRepeatedScheduleRequest) (*v14.RepeatedScheduleResource, error) {
+func (UnimplementedScheduleServiceServer) PatchRepeatedSchedule(context.Context, *PatchRepeatedScheduleRequest) (*v15.RepeatedScheduleResource, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PatchRepeatedSchedule not implemented")
 }
 func (UnimplementedScheduleServiceServer) DeleteRepeatedSchedule(context.Context, *DeleteRepeatedScheduleRequest) (*DeleteRepeatedScheduleResponse, error) {
@@ -2943,11 +3498,11 @@ var ScheduleService_ServiceDesc = grpc.ServiceDesc{
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type TelemetryLogsGroupServiceClient interface {
 	// Create a telemetry_logs_group.
-	CreateTelemetryLogsGroup(ctx context.Context, in *CreateTelemetryLogsGroupRequest, opts ...grpc.CallOption) (*v15.TelemetryLogsGroupResource, error)
+	CreateTelemetryLogsGroup(ctx context.Context, in *CreateTelemetryLogsGroupRequest, opts ...grpc.CallOption) (*v16.TelemetryLogsGroupResource, error)
 	// Get a list of telemetry_logs_groups.
 	ListTelemetryLogsGroups(ctx context.Context, in *ListTelemetryLogsGroupsRequest, opts ...grpc.CallOption) (*ListTelemetryLogsGroupsResponse, error)
 	// Get a specific telemetry_logs_group.
-	GetTelemetryLogsGroup(ctx context.Context, in *GetTelemetryLogsGroupRequest, opts ...grpc.CallOption) (*v15.TelemetryLogsGroupResource, error)
+	GetTelemetryLogsGroup(ctx context.Context, in *GetTelemetryLogsGroupRequest, opts ...grpc.CallOption) (*v16.TelemetryLogsGroupResource, error)
 	// Delete a telemetry_logs_group.
 	DeleteTelemetryLogsGroup(ctx context.Context, in *DeleteTelemetryLogsGroupRequest, opts ...grpc.CallOption) (*DeleteTelemetryLogsGroupResponse, error)
 }
@@ -2960,8 +3515,8 @@ func NewTelemetryLogsGroupServiceClient(cc grpc.ClientConnInterface) TelemetryLo
 	return &telemetryLogsGroupServiceClient{cc}
 }
 
-func (c *telemetryLogsGroupServiceClient) CreateTelemetryLogsGroup(ctx context.Context, in *CreateTelemetryLogsGroupRequest, opts ...grpc.CallOption) (*v15.TelemetryLogsGroupResource, error) {
-	out := new(v15.TelemetryLogsGroupResource)
+func (c *telemetryLogsGroupServiceClient) CreateTelemetryLogsGroup(ctx context.Context, in *CreateTelemetryLogsGroupRequest, opts ...grpc.CallOption) (*v16.TelemetryLogsGroupResource, error) {
+	out := new(v16.TelemetryLogsGroupResource)
 	err := c.cc.Invoke(ctx, "/services.v1.TelemetryLogsGroupService/CreateTelemetryLogsGroup", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -2978,8 +3533,8 @@ func (c *telemetryLogsGroupServiceClient) ListTelemetryLogsGroups(ctx context.Co
 	return out, nil
 }
 
-func (c *telemetryLogsGroupServiceClient) GetTelemetryLogsGroup(ctx context.Context, in *GetTelemetryLogsGroupRequest, opts ...grpc.CallOption) (*v15.TelemetryLogsGroupResource, error) {
-	out := new(v15.TelemetryLogsGroupResource)
+func (c *telemetryLogsGroupServiceClient) GetTelemetryLogsGroup(ctx context.Context, in *GetTelemetryLogsGroupRequest, opts ...grpc.CallOption) (*v16.TelemetryLogsGroupResource, error) {
+	out := new(v16.TelemetryLogsGroupResource)
 	err := c.cc.Invoke(ctx, "/services.v1.TelemetryLogsGroupService/GetTelemetryLogsGroup", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -3001,11 +3556,11 @@ func (c *telemetryLogsGroupServiceClient) DeleteTelemetryLogsGroup(ctx context.C
 // for forward compatibility
 type TelemetryLogsGroupServiceServer interface {
 	// Create a telemetry_logs_group.
-	CreateTelemetryLogsGroup(context.Context, *CreateTelemetryLogsGroupRequest) (*v15.TelemetryLogsGroupResource, error)
+	CreateTelemetryLogsGroup(context.Context, *CreateTelemetryLogsGroupRequest) (*v16.TelemetryLogsGroupResource, error)
 	// Get a list of telemetry_logs_groups.
 	ListTelemetryLogsGroups(context.Context, *ListTelemetryLogsGroupsRequest) (*ListTelemetryLogsGroupsResponse, error)
 	// Get a specific telemetry_logs_group.
-	GetTelemetryLogsGroup(context.Context, *GetTelemetryLogsGroupRequest) (*v15.TelemetryLogsGroupResource, error)
+	GetTelemetryLogsGroup(context.Context, *GetTelemetryLogsGroupRequest) (*v16.TelemetryLogsGroupResource, error)
 	// Delete a telemetry_logs_group.
 	DeleteTelemetryLogsGroup(context.Context, *DeleteTelemetryLogsGroupRequest) (*DeleteTelemetryLogsGroupResponse, error)
 }
@@ -3014,13 +3569,13 @@ type TelemetryLogsGroupServiceServer interface {
 type UnimplementedTelemetryLogsGroupServiceServer struct {
 }
 
-func (UnimplementedTelemetryLogsGroupServiceServer) CreateTelemetryLogsGroup(context.Context, *CreateTelemetryLogsGroupRequest) (*v15.TelemetryLogsGroupResource, error) {
+func (UnimplementedTelemetryLogsGroupServiceServer) CreateTelemetryLogsGroup(context.Context, *CreateTelemetryLogsGroupRequest) (*v16.TelemetryLogsGroupResource, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateTelemetryLogsGroup not implemented")
 }
 func (UnimplementedTelemetryLogsGroupServiceServer) ListTelemetryLogsGroups(context.Context, *ListTelemetryLogsGroupsRequest) (*ListTelemetryLogsGroupsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListTelemetryLogsGroups not implemented")
 }
-func (UnimplementedTelemetryLogsGroupServiceServer) GetTelemetryLogsGroup(context.Context, *GetTelemetryLogsGroupRequest) (*v15.TelemetryLogsGroupResource, error) {
+func (UnimplementedTelemetryLogsGroupServiceServer) GetTelemetryLogsGroup(context.Context, *GetTelemetryLogsGroupRequest) (*v16.TelemetryLogsGroupResource, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTelemetryLogsGroup not implemented")
 }
 func (UnimplementedTelemetryLogsGroupServiceServer) DeleteTelemetryLogsGroup(context.Context, *DeleteTelemetryLogsGroupRequest) (*DeleteTelemetryLogsGroupResponse, error) {
@@ -3143,11 +3698,11 @@ var TelemetryLogsGroupService_ServiceDesc = grpc.ServiceDesc{
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type TelemetryMetricsGroupServiceClient interface {
 	// Create a telemetry_metrics_group.
-	CreateTelemetryMetricsGroup(ctx context.Context, in *CreateTelemetryMetricsGroupRequest, opts ...grpc.CallOption) (*v15.TelemetryMetricsGroupResource, error)
+	CreateTelemetryMetricsGroup(ctx context.Context, in *CreateTelemetryMetricsGroupRequest, opts ...grpc.CallOption) (*v16.TelemetryMetricsGroupResource, error)
 	// Get a list of telemetryMetricsGroups.
 	ListTelemetryMetricsGroups(ctx context.Context, in *ListTelemetryMetricsGroupsRequest, opts ...grpc.CallOption) (*ListTelemetryMetricsGroupsResponse, error)
 	// Get a specific telemetry_metrics_group.
-	GetTelemetryMetricsGroup(ctx context.Context, in *GetTelemetryMetricsGroupRequest, opts ...grpc.CallOption) (*v15.TelemetryMetricsGroupResource, error)
+	GetTelemetryMetricsGroup(ctx context.Context, in *GetTelemetryMetricsGroupRequest, opts ...grpc.CallOption) (*v16.TelemetryMetricsGroupResource, error)
 	// Delete a telemetry_metrics_group.
 	DeleteTelemetryMetricsGroup(ctx context.Context, in *DeleteTelemetryMetricsGroupRequest, opts ...grpc.CallOption) (*DeleteTelemetryMetricsGroupResponse, error)
 }
@@ -3160,8 +3715,8 @@ func NewTelemetryMetricsGroupServiceClient(cc grpc.ClientConnInterface) Telemetr
 	return &telemetryMetricsGroupServiceClient{cc}
 }
 
-func (c *telemetryMetricsGroupServiceClient) CreateTelemetryMetricsGroup(ctx context.Context, in *CreateTelemetryMetricsGroupRequest, opts ...grpc.CallOption) (*v15.TelemetryMetricsGroupResource, error) {
-	out := new(v15.TelemetryMetricsGroupResource)
+func (c *telemetryMetricsGroupServiceClient) CreateTelemetryMetricsGroup(ctx context.Context, in *CreateTelemetryMetricsGroupRequest, opts ...grpc.CallOption) (*v16.TelemetryMetricsGroupResource, error) {
+	out := new(v16.TelemetryMetricsGroupResource)
 	err := c.cc.Invoke(ctx, "/services.v1.TelemetryMetricsGroupService/CreateTelemetryMetricsGroup", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -3178,8 +3733,8 @@ func (c *telemetryMetricsGroupServiceClient) ListTelemetryMetricsGroups(ctx cont
 	return out, nil
 }
 
-func (c *telemetryMetricsGroupServiceClient) GetTelemetryMetricsGroup(ctx context.Context, in *GetTelemetryMetricsGroupRequest, opts ...grpc.CallOption) (*v15.TelemetryMetricsGroupResource, error) {
-	out := new(v15.TelemetryMetricsGroupResource)
+func (c *telemetryMetricsGroupServiceClient) GetTelemetryMetricsGroup(ctx context.Context, in *GetTelemetryMetricsGroupRequest, opts ...grpc.CallOption) (*v16.TelemetryMetricsGroupResource, error) {
+	out := new(v16.TelemetryMetricsGroupResource)
 	err := c.cc.Invoke(ctx, "/services.v1.TelemetryMetricsGroupService/GetTelemetryMetricsGroup", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -3201,11 +3756,11 @@ func (c *telemetryMetricsGroupServiceClient) DeleteTelemetryMetricsGroup(ctx con
 // for forward compatibility
 type TelemetryMetricsGroupServiceServer interface {
 	// Create a telemetry_metrics_group.
-	CreateTelemetryMetricsGroup(context.Context, *CreateTelemetryMetricsGroupRequest) (*v15.TelemetryMetricsGroupResource, error)
+	CreateTelemetryMetricsGroup(context.Context, *CreateTelemetryMetricsGroupRequest) (*v16.TelemetryMetricsGroupResource, error)
 	// Get a list of telemetryMetricsGroups.
 	ListTelemetryMetricsGroups(context.Context, *ListTelemetryMetricsGroupsRequest) (*ListTelemetryMetricsGroupsResponse, error)
 	// Get a specific telemetry_metrics_group.
-	GetTelemetryMetricsGroup(context.Context, *GetTelemetryMetricsGroupRequest) (*v15.TelemetryMetricsGroupResource, error)
+	GetTelemetryMetricsGroup(context.Context, *GetTelemetryMetricsGroupRequest) (*v16.TelemetryMetricsGroupResource, error)
 	// Delete a telemetry_metrics_group.
 	DeleteTelemetryMetricsGroup(context.Context, *DeleteTelemetryMetricsGroupRequest) (*DeleteTelemetryMetricsGroupResponse, error)
 }
@@ -3214,13 +3769,13 @@ type TelemetryMetricsGroupServiceServer interface {
 type UnimplementedTelemetryMetricsGroupServiceServer struct {
 }
 
-func (UnimplementedTelemetryMetricsGroupServiceServer) CreateTelemetryMetricsGroup(context.Context, *CreateTelemetryMetricsGroupRequest) (*v15.TelemetryMetricsGroupResource, error) {
+func (UnimplementedTelemetryMetricsGroupServiceServer) CreateTelemetryMetricsGroup(context.Context, *CreateTelemetryMetricsGroupRequest) (*v16.TelemetryMetricsGroupResource, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateTelemetryMetricsGroup not implemented")
 }
 func (UnimplementedTelemetryMetricsGroupServiceServer) ListTelemetryMetricsGroups(context.Context, *ListTelemetryMetricsGroupsRequest) (*ListTelemetryMetricsGroupsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListTelemetryMetricsGroups not implemented")
 }
-func (UnimplementedTelemetryMetricsGroupServiceServer) GetTelemetryMetricsGroup(context.Context, *GetTelemetryMetricsGroupRequest) (*v15.TelemetryMetricsGroupResource, error) {
+func (UnimplementedTelemetryMetricsGroupServiceServer) GetTelemetryMetricsGroup(context.Context, *GetTelemetryMetricsGroupRequest) (*v16.TelemetryMetricsGroupResource, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTelemetryMetricsGroup not implemented")
 }
 func (UnimplementedTelemetryMetricsGroupServiceServer) DeleteTelemetryMetricsGroup(context.Context, *DeleteTelemetryMetricsGroupRequest) (*DeleteTelemetryMetricsGroupResponse, error) {
@@ -3343,15 +3898,15 @@ var TelemetryMetricsGroupService_ServiceDesc = grpc.ServiceDesc{
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type TelemetryLogsProfileServiceClient interface {
 	// Create a telemetry_logs_profile.
-	CreateTelemetryLogsProfile(ctx context.Context, in *CreateTelemetryLogsProfileRequest, opts ...grpc.CallOption) (*v15.TelemetryLogsProfileResource, error)
+	CreateTelemetryLogsProfile(ctx context.Context, in *CreateTelemetryLogsProfileRequest, opts ...grpc.CallOption) (*v16.TelemetryLogsProfileResource, error)
 	// Get a list of telemetryLogsProfiles.
 	ListTelemetryLogsProfiles(ctx context.Context, in *ListTelemetryLogsProfilesRequest, opts ...grpc.CallOption) (*ListTelemetryLogsProfilesResponse, error)
 	// Get a specific telemetry_logs_profile.
-	GetTelemetryLogsProfile(ctx context.Context, in *GetTelemetryLogsProfileRequest, opts ...grpc.CallOption) (*v15.TelemetryLogsProfileResource, error)
+	GetTelemetryLogsProfile(ctx context.Context, in *GetTelemetryLogsProfileRequest, opts ...grpc.CallOption) (*v16.TelemetryLogsProfileResource, error)
 	// Update a telemetry_logs_profile.
-	UpdateTelemetryLogsProfile(ctx context.Context, in *UpdateTelemetryLogsProfileRequest, opts ...grpc.CallOption) (*v15.TelemetryLogsProfileResource, error)
+	UpdateTelemetryLogsProfile(ctx context.Context, in *UpdateTelemetryLogsProfileRequest, opts ...grpc.CallOption) (*v16.TelemetryLogsProfileResource, error)
 	// Patch a telemetry_logs_profile.
-	PatchTelemetryLogsProfile(ctx context.Context, in *PatchTelemetryLogsProfileRequest, opts ...grpc.CallOption) (*v15.TelemetryLogsProfileResource, error)
+	PatchTelemetryLogsProfile(ctx context.Context, in *PatchTelemetryLogsProfileRequest, opts ...grpc.CallOption) (*v16.TelemetryLogsProfileResource, error)
 	// Delete a telemetry_logs_profile.
 	DeleteTelemetryLogsProfile(ctx context.Context, in *DeleteTelemetryLogsProfileRequest, opts ...grpc.CallOption) (*DeleteTelemetryLogsProfileResponse, error)
 }
@@ -3364,8 +3919,8 @@ func NewTelemetryLogsProfileServiceClient(cc grpc.ClientConnInterface) Telemetry
 	return &telemetryLogsProfileServiceClient{cc}
 }
 
-func (c *telemetryLogsProfileServiceClient) CreateTelemetryLogsProfile(ctx context.Context, in *CreateTelemetryLogsProfileRequest, opts ...grpc.CallOption) (*v15.TelemetryLogsProfileResource, error) {
-	out := new(v15.TelemetryLogsProfileResource)
+func (c *telemetryLogsProfileServiceClient) CreateTelemetryLogsProfile(ctx context.Context, in *CreateTelemetryLogsProfileRequest, opts ...grpc.CallOption) (*v16.TelemetryLogsProfileResource, error) {
+	out := new(v16.TelemetryLogsProfileResource)
 	err := c.cc.Invoke(ctx, "/services.v1.TelemetryLogsProfileService/CreateTelemetryLogsProfile", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -3382,8 +3937,8 @@ func (c *telemetryLogsProfileServiceClient) ListTelemetryLogsProfiles(ctx contex
 	return out, nil
 }
 
-func (c *telemetryLogsProfileServiceClient) GetTelemetryLogsProfile(ctx context.Context, in *GetTelemetryLogsProfileRequest, opts ...grpc.CallOption) (*v15.TelemetryLogsProfileResource, error) {
-	out := new(v15.TelemetryLogsProfileResource)
+func (c *telemetryLogsProfileServiceClient) GetTelemetryLogsProfile(ctx context.Context, in *GetTelemetryLogsProfileRequest, opts ...grpc.CallOption) (*v16.TelemetryLogsProfileResource, error) {
+	out := new(v16.TelemetryLogsProfileResource)
 	err := c.cc.Invoke(ctx, "/services.v1.TelemetryLogsProfileService/GetTelemetryLogsProfile", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -3391,8 +3946,8 @@ func (c *telemetryLogsProfileServiceClient) GetTelemetryLogsProfile(ctx context.
 	return out, nil
 }
 
-func (c *telemetryLogsProfileServiceClient) UpdateTelemetryLogsProfile(ctx context.Context, in *UpdateTelemetryLogsProfileRequest, opts ...grpc.CallOption) (*v15.TelemetryLogsProfileResource, error) {
-	out := new(v15.TelemetryLogsProfileResource)
+func (c *telemetryLogsProfileServiceClient) UpdateTelemetryLogsProfile(ctx context.Context, in *UpdateTelemetryLogsProfileRequest, opts ...grpc.CallOption) (*v16.TelemetryLogsProfileResource, error) {
+	out := new(v16.TelemetryLogsProfileResource)
 	err := c.cc.Invoke(ctx, "/services.v1.TelemetryLogsProfileService/UpdateTelemetryLogsProfile", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -3400,8 +3955,8 @@ func (c *telemetryLogsProfileServiceClient) UpdateTelemetryLogsProfile(ctx conte
 	return out, nil
 }
 
-func (c *telemetryLogsProfileServiceClient) PatchTelemetryLogsProfile(ctx context.Context, in *PatchTelemetryLogsProfileRequest, opts ...grpc.CallOption) (*v15.TelemetryLogsProfileResource, error) {
-	out := new(v15.TelemetryLogsProfileResource)
+func (c *telemetryLogsProfileServiceClient) PatchTelemetryLogsProfile(ctx context.Context, in *PatchTelemetryLogsProfileRequest, opts ...grpc.CallOption) (*v16.TelemetryLogsProfileResource, error) {
+	out := new(v16.TelemetryLogsProfileResource)
 	err := c.cc.Invoke(ctx, "/services.v1.TelemetryLogsProfileService/PatchTelemetryLogsProfile", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -3423,15 +3978,15 @@ func (c *telemetryLogsProfileServiceClient) DeleteTelemetryLogsProfile(ctx conte
 // for forward compatibility
 type TelemetryLogsProfileServiceServer interface {
 	// Create a telemetry_logs_profile.
-	CreateTelemetryLogsProfile(context.Context, *CreateTelemetryLogsProfileRequest) (*v15.TelemetryLogsProfileResource, error)
+	CreateTelemetryLogsProfile(context.Context, *CreateTelemetryLogsProfileRequest) (*v16.TelemetryLogsProfileResource, error)
 	// Get a list of telemetryLogsProfiles.
 	ListTelemetryLogsProfiles(context.Context, *ListTelemetryLogsProfilesRequest) (*ListTelemetryLogsProfilesResponse, error)
 	// Get a specific telemetry_logs_profile.
-	GetTelemetryLogsProfile(context.Context, *GetTelemetryLogsProfileRequest) (*v15.TelemetryLogsProfileResource, error)
+	GetTelemetryLogsProfile(context.Context, *GetTelemetryLogsProfileRequest) (*v16.TelemetryLogsProfileResource, error)
 	// Update a telemetry_logs_profile.
-	UpdateTelemetryLogsProfile(context.Context, *UpdateTelemetryLogsProfileRequest) (*v15.TelemetryLogsProfileResource, error)
+	UpdateTelemetryLogsProfile(context.Context, *UpdateTelemetryLogsProfileRequest) (*v16.TelemetryLogsProfileResource, error)
 	// Patch a telemetry_logs_profile.
-	PatchTelemetryLogsProfile(context.Context, *PatchTelemetryLogsProfileRequest) (*v15.TelemetryLogsProfileResource, error)
+	PatchTelemetryLogsProfile(context.Context, *PatchTelemetryLogsProfileRequest) (*v16.TelemetryLogsProfileResource, error)
 	// Delete a telemetry_logs_profile.
 	DeleteTelemetryLogsProfile(context.Context, *DeleteTelemetryLogsProfileRequest) (*DeleteTelemetryLogsProfileResponse, error)
 }
@@ -3440,19 +3995,19 @@ type TelemetryLogsProfileServiceServer interface {
 type UnimplementedTelemetryLogsProfileServiceServer struct {
 }
 
-func (UnimplementedTelemetryLogsProfileServiceServer) CreateTelemetryLogsProfile(context.Context, *CreateTelemetryLogsProfileRequest) (*v15.TelemetryLogsProfileResource, error) {
+func (UnimplementedTelemetryLogsProfileServiceServer) CreateTelemetryLogsProfile(context.Context, *CreateTelemetryLogsProfileRequest) (*v16.TelemetryLogsProfileResource, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateTelemetryLogsProfile not implemented")
 }
 func (UnimplementedTelemetryLogsProfileServiceServer) ListTelemetryLogsProfiles(context.Context, *ListTelemetryLogsProfilesRequest) (*ListTelemetryLogsProfilesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListTelemetryLogsProfiles not implemented")
 }
-func (UnimplementedTelemetryLogsProfileServiceServer) GetTelemetryLogsProfile(context.Context, *GetTelemetryLogsProfileRequest) (*v15.TelemetryLogsProfileResource, error) {
+func (UnimplementedTelemetryLogsProfileServiceServer) GetTelemetryLogsProfile(context.Context, *GetTelemetryLogsProfileRequest) (*v16.TelemetryLogsProfileResource, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTelemetryLogsProfile not implemented")
 }
-func (UnimplementedTelemetryLogsProfileServiceServer) UpdateTelemetryLogsProfile(context.Context, *UpdateTelemetryLogsProfileRequest) (*v15.TelemetryLogsProfileResource, error) {
+func (UnimplementedTelemetryLogsProfileServiceServer) UpdateTelemetryLogsProfile(context.Context, *UpdateTelemetryLogsProfileRequest) (*v16.TelemetryLogsProfileResource, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateTelemetryLogsProfile not implemented")
 }
-func (UnimplementedTelemetryLogsProfileServiceServer) PatchTelemetryLogsProfile(context.Context, *PatchTelemetryLogsProfileRequest) (*v15.TelemetryLogsProfileResource, error) {
+func (UnimplementedTelemetryLogsProfileServiceServer) PatchTelemetryLogsProfile(context.Context, *PatchTelemetryLogsProfileRequest) (*v16.TelemetryLogsProfileResource, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PatchTelemetryLogsProfile not implemented")
 }
 func (UnimplementedTelemetryLogsProfileServiceServer) DeleteTelemetryLogsProfile(context.Context, *DeleteTelemetryLogsProfileRequest) (*DeleteTelemetryLogsProfileResponse, error) {
@@ -3619,15 +4174,15 @@ var TelemetryLogsProfileService_ServiceDesc = grpc.ServiceDesc{
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type TelemetryMetricsProfileServiceClient interface {
 	// Create a telemetry_metrics_profile.
-	CreateTelemetryMetricsProfile(ctx context.Context, in *CreateTelemetryMetricsProfileRequest, opts ...grpc.CallOption) (*v15.TelemetryMetricsProfileResource, error)
+	CreateTelemetryMetricsProfile(ctx context.Context, in *CreateTelemetryMetricsProfileRequest, opts ...grpc.CallOption) (*v16.TelemetryMetricsProfileResource, error)
 	// Get a list of telemetryMetricsProfiles.
 	ListTelemetryMetricsProfiles(ctx context.Context, in *ListTelemetryMetricsProfilesRequest, opts ...grpc.CallOption) (*ListTelemetryMetricsProfilesResponse, error)
 	// Get a specific telemetry_metrics_profile.
-	GetTelemetryMetricsProfile(ctx context.Context, in *GetTelemetryMetricsProfileRequest, opts ...grpc.CallOption) (*v15.TelemetryMetricsProfileResource, error)
+	GetTelemetryMetricsProfile(ctx context.Context, in *GetTelemetryMetricsProfileRequest, opts ...grpc.CallOption) (*v16.TelemetryMetricsProfileResource, error)
 	// Update a telemetry_metrics_profile.
-	UpdateTelemetryMetricsProfile(ctx context.Context, in *UpdateTelemetryMetricsProfileRequest, opts ...grpc.CallOption) (*v15.TelemetryMetricsProfileResource, error)
+	UpdateTelemetryMetricsProfile(ctx context.Context, in *UpdateTelemetryMetricsProfileRequest, opts ...grpc.CallOption) (*v16.TelemetryMetricsProfileResource, error)
 	// Patch a telemetry_metrics_profile.
-	PatchTelemetryMetricsProfile(ctx context.Context, in *PatchTelemetryMetricsProfileRequest, opts ...grpc.CallOption) (*v15.TelemetryMetricsProfileResource, error)
+	PatchTelemetryMetricsProfile(ctx context.Context, in *PatchTelemetryMetricsProfileRequest, opts ...grpc.CallOption) (*v16.TelemetryMetricsProfileResource, error)
 	// Delete a telemetry_metrics_profile.
 	DeleteTelemetryMetricsProfile(ctx context.Context, in *DeleteTelemetryMetricsProfileRequest, opts ...grpc.CallOption) (*DeleteTelemetryMetricsProfileResponse, error)
 }
@@ -3640,8 +4195,8 @@ func NewTelemetryMetricsProfileServiceClient(cc grpc.ClientConnInterface) Teleme
 	return &telemetryMetricsProfileServiceClient{cc}
 }
 
-func (c *telemetryMetricsProfileServiceClient) CreateTelemetryMetricsProfile(ctx context.Context, in *CreateTelemetryMetricsProfileRequest, opts ...grpc.CallOption) (*v15.TelemetryMetricsProfileResource, error) {
-	out := new(v15.TelemetryMetricsProfileResource)
+func (c *telemetryMetricsProfileServiceClient) CreateTelemetryMetricsProfile(ctx context.Context, in *CreateTelemetryMetricsProfileRequest, opts ...grpc.CallOption) (*v16.TelemetryMetricsProfileResource, error) {
+	out := new(v16.TelemetryMetricsProfileResource)
 	err := c.cc.Invoke(ctx, "/services.v1.TelemetryMetricsProfileService/CreateTelemetryMetricsProfile", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -3658,8 +4213,8 @@ func (c *telemetryMetricsProfileServiceClient) ListTelemetryMetricsProfiles(ctx
 	return out, nil
 }
 
-func (c *telemetryMetricsProfileServiceClient) GetTelemetryMetricsProfile(ctx context.Context, in *GetTelemetryMetricsProfileRequest, opts ...grpc.CallOption) (*v15.TelemetryMetricsProfileResource, error) {
-	out := new(v15.TelemetryMetricsProfileResource)
+func (c *telemetryMetricsProfileServiceClient) GetTelemetryMetricsProfile(ctx context.Context, in *GetTelemetryMetricsProfileRequest, opts ...grpc.CallOption) (*v16.TelemetryMetricsProfileResource, error) {
+	out := new(v16.TelemetryMetricsProfileResource)
 	err := c.cc.Invoke(ctx, "/services.v1.TelemetryMetricsProfileService/GetTelemetryMetricsProfile", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -3667,8 +4222,8 @@ func (c *telemetryMetricsProfileServiceClient) GetTelemetryMetricsProfile(ctx co
 	return out, nil
 }
 
-func (c *telemetryMetricsProfileServiceClient) UpdateTelemetryMetricsProfile(ctx context.Context, in *UpdateTelemetryMetricsProfileRequest, opts ...grpc.CallOption) (*v15.TelemetryMetricsProfileResource, error) {
-	out := new(v15.TelemetryMetricsProfileResource)
+func (c *telemetryMetricsProfileServiceClient) UpdateTelemetryMetricsProfile(ctx context.Context, in *UpdateTelemetryMetricsProfileRequest, opts ...grpc.CallOption) (*v16.TelemetryMetricsProfileResource, error) {
+	out := new(v16.TelemetryMetricsProfileResource)
 	err := c.cc.Invoke(ctx, "/services.v1.TelemetryMetricsProfileService/UpdateTelemetryMetricsProfile", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -3676,8 +4231,8 @@ func (c *telemetryMetricsProfileServiceClient) UpdateTelemetryMetricsProfile(ctx
 	return out, nil
 }
 
-func (c *telemetryMetricsProfileServiceClient) PatchTelemetryMetricsProfile(ctx context.Context, in *PatchTelemetryMetricsProfileRequest, opts ...grpc.CallOption) (*v15.TelemetryMetricsProfileResource, error) {
-	out := new(v15.TelemetryMetricsProfileResource)
+func (c *telemetryMetricsProfileServiceClient) PatchTelemetryMetricsProfile(ctx context.Context, in *PatchTelemetryMetricsProfileRequest, opts ...grpc.CallOption) (*v16.TelemetryMetricsProfileResource, error) {
+	out := new(v16.TelemetryMetricsProfileResource)
 	err := c.cc.Invoke(ctx, "/services.v1.TelemetryMetricsProfileService/PatchTelemetryMetricsProfile", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -3699,15 +4254,15 @@ func (c *telemetryMetricsProfileServiceClient) DeleteTelemetryMetricsProfile(ctx
 // for forward compatibility
 type TelemetryMetricsProfileServiceServer interface {
 	// Create a telemetry_metrics_profile.
-	CreateTelemetryMetricsProfile(context.Context, *CreateTelemetryMetricsProfileRequest) (*v15.TelemetryMetricsProfileResource, error)
+	CreateTelemetryMetricsProfile(context.Context, *CreateTelemetryMetricsProfileRequest) (*v16.TelemetryMetricsProfileResource, error)
 	// Get a list of telemetryMetricsProfiles.
 	ListTelemetryMetricsProfiles(context.Context, *ListTelemetryMetricsProfilesRequest) (*ListTelemetryMetricsProfilesResponse, error)
 	// Get a specific telemetry_metrics_profile.
-	GetTelemetryMetricsProfile(context.Context, *GetTelemetryMetricsProfileRequest) (*v15.TelemetryMetricsProfileResource, error)
+	GetTelemetryMetricsProfile(context.Context, *GetTelemetryMetricsProfileRequest) (*v16.TelemetryMetricsProfileResource, error)
 	// Update a telemetry_metrics_profile.
-	UpdateTelemetryMetricsProfile(context.Context, *UpdateTelemetryMetricsProfileRequest) (*v15.TelemetryMetricsProfileResource, error)
+	UpdateTelemetryMetricsProfile(context.Context, *UpdateTelemetryMetricsProfileRequest) (*v16.TelemetryMetricsProfileResource, error)
 	// Patch a telemetry_metrics_profile.
-	PatchTelemetryMetricsProfile(context.Context, *PatchTelemetryMetricsProfileRequest) (*v15.TelemetryMetricsProfileResource, error)
+	PatchTelemetryMetricsProfile(context.Context, *PatchTelemetryMetricsProfileRequest) (*v16.TelemetryMetricsProfileResource, error)
 	// Delete a telemetry_metrics_profile.
 	DeleteTelemetryMetricsProfile(context.Context, *DeleteTelemetryMetricsProfileRequest) (*DeleteTelemetryMetricsProfileResponse, error)
 }
@@ -3716,19 +4271,19 @@ type TelemetryMetricsProfileServiceServer interface {
 type UnimplementedTelemetryMetricsProfileServiceServer struct {
 }
 
-func (UnimplementedTelemetryMetricsProfileServiceServer) CreateTelemetryMetricsProfile(context.Context, *CreateTelemetryMetricsProfileRequest) (*v15.TelemetryMetricsProfileResource, error) {
+func (UnimplementedTelemetryMetricsProfileServiceServer) CreateTelemetryMetricsProfile(context.Context, *CreateTelemetryMetricsProfileRequest) (*v16.TelemetryMetricsProfileResource, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateTelemetryMetricsProfile not implemented")
 }
 func (UnimplementedTelemetryMetricsProfileServiceServer) ListTelemetryMetricsProfiles(context.Context, *ListTelemetryMetricsProfilesRequest) (*ListTelemetryMetricsProfilesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListTelemetryMetricsProfiles not implemented")
 }
-func (UnimplementedTelemetryMetricsProfileServiceServer) GetTelemetryMetricsProfile(context.Context, *GetTelemetryMetricsProfileRequest) (*v15.TelemetryMetricsProfileResource, error) {
+func (UnimplementedTelemetryMetricsProfileServiceServer) GetTelemetryMetricsProfile(context.Context, *GetTelemetryMetricsProfileRequest) (*v16.TelemetryMetricsProfileResource, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTelemetryMetricsProfile not implemented")
 }
-func (UnimplementedTelemetryMetricsProfileServiceServer) UpdateTelemetryMetricsProfile(context.Context, *UpdateTelemetryMetricsProfileRequest) (*v15.TelemetryMetricsProfileResource, error) {
+func (UnimplementedTelemetryMetricsProfileServiceServer) UpdateTelemetryMetricsProfile(context.Context, *UpdateTelemetryMetricsProfileRequest) (*v16.TelemetryMetricsProfileResource, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateTelemetryMetricsProfile not implemented")
 }
-func (UnimplementedTelemetryMetricsProfileServiceServer) PatchTelemetryMetricsProfile(context.Context, *PatchTelemetryMetricsProfileRequest) (*v15.TelemetryMetricsProfileResource, error) {
+func (UnimplementedTelemetryMetricsProfileServiceServer) PatchTelemetryMetricsProfile(context.Context, *PatchTelemetryMetricsProfileRequest) (*v16.TelemetryMetricsProfileResource, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PatchTelemetryMetricsProfile not implemented")
 }
 func (UnimplementedTelemetryMetricsProfileServiceServer) DeleteTelemetryMetricsProfile(context.Context, *DeleteTelemetryMetricsProfileRequest) (*DeleteTelemetryMetricsProfileResponse, error) {
@@ -3895,11 +4450,15 @@ var TelemetryMetricsProfileService_ServiceDesc = grpc.ServiceDesc{
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type LocalAccountServiceClient interface {
 	// Create a localAccount.
-	CreateLocalAccount(ctx context.Context, in *CreateLocalAccountRequest, opts ...grpc.CallOption) (*v16.LocalAccountResource, error)
+	CreateLocalAccount(ctx context.Context, in *CreateLocalAccountRequest, opts ...grpc.CallOption) (*v17.LocalAccountResource, error)
 	// Get a list of providers.
 	ListLocalAccounts(ctx context.Context, in *ListLocalAccountsRequest, opts ...grpc.CallOption) (*ListLocalAccountsResponse, error)
 	// Get a specific provider.
-	GetLocalAccount(ctx context.Context, in *GetLocalAccountRequest, opts ...grpc.CallOption) (*v16.LocalAccountResource, error)
+	GetLocalAccount(ctx context.Context, in *GetLocalAccountRequest, opts ...grpc.CallOption) (*v17.LocalAccountResource, error)
+	// Update a local account.
+	UpdateLocalAccount(ctx context.Context, in *UpdateLocalAccountRequest, opts ...grpc.CallOption) (*v17.LocalAccountResource, error)
+	// Patch a local account.
+	PatchLocalAccount(ctx context.Context, in *PatchLocalAccountRequest, opts ...grpc.CallOption) (*v17.LocalAccountResource, error)
 	// Delete a provider.
 	DeleteLocalAccount(ctx context.Context, in *DeleteLocalAccountRequest, opts ...grpc.CallOption) (*DeleteLocalAccountResponse, error)
 }
@@ -3912,8 +4471,8 @@ func NewLocalAccountServiceClient(cc grpc.ClientConnInterface) LocalAccountServi
 	return &localAccountServiceClient{cc}
 }
 
-func (c *localAccountServiceClient) CreateLocalAccount(ctx context.Context, in *CreateLocalAccountRequest, opts ...grpc.CallOption) (*v16.LocalAccountResource, error) {
-	out := new(v16.LocalAccountResource)
+func (c *localAccountServiceClient) CreateLocalAccount(ctx context.Context, in *CreateLocalAccountRequest, opts ...grpc.CallOption) (*v17.LocalAccountResource, error) {
+	out := new(v17.LocalAccountResource)
 	err := c.cc.Invoke(ctx, "/services.v1.LocalAccountService/CreateLocalAccount", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -3930,8 +4489,8 @@ func (c *localAccountServiceClient) ListLocalAccounts(ctx context.Context, in *L
 	return out, nil
 }
 
-func (c *localAccountServiceClient) GetLocalAccount(ctx context.Context, in *GetLocalAccountRequest, opts ...grpc.CallOption) (*v16.LocalAccountResource, error) {
-	out := new(v16.LocalAccountResource)
+func (c *localAccountServiceClient) GetLocalAccount(ctx context.Context, in *GetLocalAccountRequest, opts ...grpc.CallOption) (*v17.LocalAccountResource, error) {
+	out := new(v17.LocalAccountResource)
 	err := c.cc.Invoke(ctx, "/services.v1.LocalAccountService/GetLocalAccount", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -3939,6 +4498,24 @@ func (c *localAccountServiceClient) GetLocalAccount(ctx context.Context, in *Get
 	return out, nil
 }
 
+func (c *localAccountServiceClient) UpdateLocalAccount(ctx context.Context, in *UpdateLocalAccountRequest, opts ...grpc.CallOption) (*v17.LocalAccountResource, error) {
+	out := new(v17.LocalAccountResource)
+	err := c.cc.Invoke(ctx, "/services.v1.LocalAccountService/UpdateLocalAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *localAccountServiceClient) PatchLocalAccount(ctx context.Context, in *PatchLocalAccountRequest, opts ...grpc.CallOption) (*v17.LocalAccountResource, error) {
+	out := new(v17.LocalAccountResource)
+	err := c.cc.Invoke(ctx, "/services.v1.LocalAccountService/PatchLocalAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *localAccountServiceClient) DeleteLocalAccount(ctx context.Context, in *DeleteLocalAccountRequest, opts ...grpc.CallOption) (*DeleteLocalAccountResponse, error) {
 	out := new(DeleteLocalAccountResponse)
 	err := c.cc.Invoke(ctx, "/services.v1.LocalAccountService/DeleteLocalAccount", in, out, opts...)
@@ -3953,11 +4530,15 @@ func (c *localAccountServiceClient) DeleteLocalAccount(ctx context.Context, in *
 // for forward compatibility
 type LocalAccountServiceServer interface {
 	// Create a localAccount.
-	CreateLocalAccount(context.Context, *CreateLocalAccountRequest) (*v16.LocalAccountResource, error)
+	CreateLocalAccount(context.Context, *CreateLocalAccountRequest) (*v17.LocalAccountResource, error)
 	// Get a list of providers.
 	ListLocalAccounts(context.Context, *ListLocalAccountsRequest) (*ListLocalAccountsResponse, error)
 	// Get a specific provider.
-	GetLocalAccount(context.Context, *GetLocalAccountRequest) (*v16.LocalAccountResource, error)
+	GetLocalAccount(context.Context, *GetLocalAccountRequest) (*v17.LocalAccountResource, error)
+	// Update a local account.
+	UpdateLocalAccount(context.Context, *UpdateLocalAccountRequest) (*v17.LocalAccountResource, error)
+	// Patch a local account.
+	PatchLocalAccount(context.Context, *PatchLocalAccountRequest) (*v17.LocalAccountResource, error)
 	// Delete a provider.
 	DeleteLocalAccount(context.Context, *DeleteLocalAccountRequest) (*DeleteLocalAccountResponse, error)
 }
@@ -3966,15 +4547,21 @@ type LocalAccountServiceServer interface {
 type UnimplementedLocalAccountServiceServer struct {
 }
 
-func (UnimplementedLocalAccountServiceServer) CreateLocalAccount(context.Context, *CreateLocalAccountRequest) (*v16.LocalAccountResource, error) {
+func (UnimplementedLocalAccountServiceServer) CreateLocalAccount(context.Context, *CreateLocalAccountRequest) (*v17.LocalAccountResource, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateLocalAccount not implemented")
 }
 func (UnimplementedLocalAccountServiceServer) ListLocalAccounts(context.Context, *ListLocalAccountsRequest) (*ListLocalAccountsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListLocalAccounts not implemented")
 }
-func (UnimplementedLocalAccountServiceServer) GetLocalAccount(context.Context, *GetLocalAccountRequest) (*v16.LocalAccountResource, error) {
+func (UnimplementedLocalAccountServiceServer) GetLocalAccount(context.Context, *GetLocalAccountRequest) (*v17.LocalAccountResource, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetLocalAccount not implemented")
 }
+func (UnimplementedLocalAccountServiceServer) UpdateLocalAccount(context.Context, *UpdateLocalAccountRequest) (*v17.LocalAccountResource, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateLocalAccount not implemented")
+}
+func (UnimplementedLocalAccountServiceServer) PatchLocalAccount(context.Context, *PatchLocalAccountRequest) (*v17.LocalAccountResource, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PatchLocalAccount not implemented")
+}
 func (UnimplementedLocalAccountServiceServer) DeleteLocalAccount(context.Context, *DeleteLocalAccountRequest) (*DeleteLocalAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteLocalAccount not implemented")
 }
@@ -4044,6 +4631,42 @@ func _LocalAccountService_GetLocalAccount_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LocalAccountService_UpdateLocalAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateLocalAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocalAccountServiceServer).UpdateLocalAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/services.v1.LocalAccountService/UpdateLocalAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocalAccountServiceServer).UpdateLocalAccount(ctx, req.(*UpdateLocalAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocalAccountService_PatchLocalAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PatchLocalAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocalAccountServiceServer).PatchLocalAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/services.v1.LocalAccountService/PatchLocalAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocalAccountServiceServer).PatchLocalAccount(ctx, req.(*PatchLocalAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _LocalAccountService_DeleteLocalAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteLocalAccountRequest)
 	if err := dec(in); err != nil {
@@ -4081,6 +4704,14 @@ var LocalAccountService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetLocalAccount",
 			Handler:    _LocalAccountService_GetLocalAccount_Handler,
 		},
+		{
+			MethodName: "UpdateLocalAccount",
+			Handler:    _LocalAccountService_UpdateLocalAccount_Handler,
+		},
+		{
+			MethodName: "PatchLocalAccount",
+			Handler:    _LocalAccountService_PatchLocalAccount_Handler,
+		},
 		{
 			MethodName: "DeleteLocalAccount",
 			Handler:    _LocalAccountService_DeleteLocalAccount_Handler,
@@ -4095,11 +4726,15 @@ var LocalAccountService_ServiceDesc = grpc.ServiceDesc{
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type CustomConfigServiceClient interface {
 	// Create a custom configuration.
-	CreateCustomConfig(ctx context.Context, in *CreateCustomConfigRequest, opts ...grpc.CallOption) (*v17.CustomConfigResource, error)
+	CreateCustomConfig(ctx context.Context, in *CreateCustomConfigRequest, opts ...grpc.CallOption) (*v18.CustomConfigResource, error)
 	// Get a list of custom configurations.
 	ListCustomConfigs(ctx context.Context, in *ListCustomConfigsRequest, opts ...grpc.CallOption) (*ListCustomConfigsResponse, error)
 	// Get a specific custom configuration.
-	GetCustomConfig(ctx context.Context, in *GetCustomConfigRequest, opts ...grpc.CallOption) (*v17.CustomConfigResource, error)
+	GetCustomConfig(ctx context.Context, in *GetCustomConfigRequest, opts ...grpc.CallOption) (*v18.CustomConfigResource, error)
+	// Update a custom configuration.
+	UpdateCustomConfig(ctx context.Context, in *UpdateCustomConfigRequest, opts ...grpc.CallOption) (*v18.CustomConfigResource, error)
+	// Patch a custom configuration.
+	PatchCustomConfig(ctx context.Context, in *PatchCustomConfigRequest, opts ...grpc.CallOption) (*v18.CustomConfigResource, error)
 	// Delete a custom configuration.
 	DeleteCustomConfig(ctx context.Context, in *DeleteCustomConfigRequest, opts ...grpc.CallOption) (*DeleteCustomConfigResponse, error)
 }
@@ -4112,8 +4747,8 @@ func NewCustomConfigServiceClient(cc grpc.ClientConnInterface) CustomConfigServi
 	return &customConfigServiceClient{cc}
 }
 
-func (c *customConfigServiceClient) CreateCustomConfig(ctx context.Context, in *CreateCustomConfigRequest, opts ...grpc.CallOption) (*v17.CustomConfigResource, error) {
-	out := new(v17.CustomConfigResource)
+func (c *customConfigServiceClient) CreateCustomConfig(ctx context.Context, in *CreateCustomConfigRequest, opts ...grpc.CallOption) (*v18.CustomConfigResource, error) {
+	out := new(v18.CustomConfigResource)
 	err := c.cc.Invoke(ctx, "/services.v1.CustomConfigService/CreateCustomConfig", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -4130,8 +4765,8 @@ func (c *customConfigServiceClient) ListCustomConfigs(ctx context.Context, in *L
 	return out, nil
 }
 
-func (c *customConfigServiceClient) GetCustomConfig(ctx context.Context, in *GetCustomConfigRequest, opts ...grpc.CallOption) (*v17.CustomConfigResource, error) {
-	out := new(v17.CustomConfigResource)
+func (c *customConfigServiceClient) GetCustomConfig(ctx context.Context, in *GetCustomConfigRequest, opts ...grpc.CallOption) (*v18.CustomConfigResource, error) {
+	out := new(v18.CustomConfigResource)
 	err := c.cc.Invoke(ctx, "/services.v1.CustomConfigService/GetCustomConfig", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -4139,6 +4774,24 @@ func (c *customConfigServiceClient) GetCustomConfig(ctx context.Context, in *Get
 	return out, nil
 }
 
+func (c *customConfigServiceClient) UpdateCustomConfig(ctx context.Context, in *UpdateCustomConfigRequest, opts ...grpc.CallOption) (*v18.CustomConfigResource, error) {
+	out := new(v18.CustomConfigResource)
+	err := c.cc.Invoke(ctx, "/services.v1.CustomConfigService/UpdateCustomConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customConfigServiceClient) PatchCustomConfig(ctx context.Context, in *PatchCustomConfigRequest, opts ...grpc.CallOption) (*v18.CustomConfigResource, error) {
+	out := new(v18.CustomConfigResource)
+	err := c.cc.Invoke(ctx, "/services.v1.CustomConfigService/PatchCustomConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *customConfigServiceClient) DeleteCustomConfig(ctx context.Context, in *DeleteCustomConfigRequest, opts ...grpc.CallOption) (*DeleteCustomConfigResponse, error) {
 	out := new(DeleteCustomConfigResponse)
 	err := c.cc.Invoke(ctx, "/services.v1.CustomConfigService/DeleteCustomConfig", in, out, opts...)
@@ -4153,11 +4806,15 @@ func (c *customConfigServiceClient) DeleteCustomConfig(ctx context.Context, in *
 // for forward compatibility
 type CustomConfigServiceServer interface {
 	// Create a custom configuration.
-	CreateCustomConfig(context.Context, *CreateCustomConfigRequest) (*v17.CustomConfigResource, error)
+	CreateCustomConfig(context.Context, *CreateCustomConfigRequest) (*v18.CustomConfigResource, error)
 	// Get a list of custom configurations.
 	ListCustomConfigs(context.Context, *ListCustomConfigsRequest) (*ListCustomConfigsResponse, error)
 	// Get a specific custom configuration.
-	GetCustomConfig(context.Context, *GetCustomConfigRequest) (*v17.CustomConfigResource, error)
+	GetCustomConfig(context.Context, *GetCustomConfigRequest) (*v18.CustomConfigResource, error)
+	// Update a custom configuration.
+	UpdateCustomConfig(context.Context, *UpdateCustomConfigRequest) (*v18.CustomConfigResource, error)
+	// Patch a custom configuration.
+	PatchCustomConfig(context.Context, *PatchCustomConfigRequest) (*v18.CustomConfigResource, error)
 	// Delete a custom configuration.
 	DeleteCustomConfig(context.Context, *DeleteCustomConfigRequest) (*DeleteCustomConfigResponse, error)
 }
@@ -4166,15 +4823,21 @@ type CustomConfigServiceServer interface {
 type UnimplementedCustomConfigServiceServer struct {
 }
 
-func (UnimplementedCustomConfigServiceServer) CreateCustomConfig(context.Context, *CreateCustomConfigRequest) (*v17.CustomConfigResource, error) {
+func (UnimplementedCustomConfigServiceServer) CreateCustomConfig(context.Context, *CreateCustomConfigRequest) (*v18.CustomConfigResource, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateCustomConfig not implemented")
 }
 func (UnimplementedCustomConfigServiceServer) ListCustomConfigs(context.Context, *ListCustomConfigsRequest) (*ListCustomConfigsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListCustomConfigs not implemented")
 }
-func (UnimplementedCustomConfigServiceServer) GetCustomConfig(context.Context, *GetCustomConfigRequest) (*v17.CustomConfigResource, error) {
+func (UnimplementedCustomConfigServiceServer) GetCustomConfig(context.Context, *GetCustomConfigRequest) (*v18.CustomConfigResource, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCustomConfig not implemented")
 }
+func (UnimplementedCustomConfigServiceServer) UpdateCustomConfig(context.Context, *UpdateCustomConfigRequest) (*v18.CustomConfigResource, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCustomConfig not implemented")
+}
+func (UnimplementedCustomConfigServiceServer) PatchCustomConfig(context.Context, *PatchCustomConfigRequest) (*v18.CustomConfigResource, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PatchCustomConfig not implemented")
+}
 func (UnimplementedCustomConfigServiceServer) DeleteCustomConfig(context.Context, *DeleteCustomConfigRequest) (*DeleteCustomConfigResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteCustomConfig not implemented")
 }
@@ -4244,6 +4907,42 @@ func _CustomConfigService_GetCustomConfig_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CustomConfigService_UpdateCustomConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCustomConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomConfigServiceServer).UpdateCustomConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/services.v1.CustomConfigService/UpdateCustomConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomConfigServiceServer).UpdateCustomConfig(ctx, req.(*UpdateCustomConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomConfigService_PatchCustomConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PatchCustomConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomConfigServiceServer).PatchCustomConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/services.v1.CustomConfigService/PatchCustomConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomConfigServiceServer).PatchCustomConfig(ctx, req.(*PatchCustomConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CustomConfigService_DeleteCustomConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteCustomConfigRequest)
 	if err := dec(in); err != nil {
@@ -4281,6 +4980,14 @@ var CustomConfigService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetCustomConfig",
 			Handler:    _CustomConfigService_GetCustomConfig_Handler,
 		},
+		{
+			MethodName: "UpdateCustomConfig",
+			Handler:    _CustomConfigService_UpdateCustomConfig_Handler,
+		},
+		{
+			MethodName: "PatchCustomConfig",
+			Handler:    _CustomConfigService_PatchCustomConfig_Handler,
+		},
 		{
 			MethodName: "DeleteCustomConfig",
 			Handler:    _CustomConfigService_DeleteCustomConfig_Handler,
@@ -4300,6 +5007,10 @@ type OSUpdatePolicyClient interface {
 	ListOSUpdatePolicy(ctx context.Context, in *ListOSUpdatePolicyRequest, opts ...grpc.CallOption) (*ListOSUpdatePolicyResponse, error)
 	// Get a specific OS Update Policy.
 	GetOSUpdatePolicy(ctx context.Context, in *GetOSUpdatePolicyRequest, opts ...grpc.CallOption) (*v11.OSUpdatePolicy, error)
+	// Update an OS Update Policy.
+	UpdateOSUpdatePolicy(ctx context.Context, in *UpdateOSUpdatePolicyRequest, opts ...grpc.CallOption) (*v11.OSUpdatePolicy, error)
+	// Patch an OS Update Policy.
+	PatchOSUpdatePolicy(ctx context.Context, in *PatchOSUpdatePolicyRequest, opts ...grpc.CallOption) (*v11.OSUpdatePolicy, error)
 	// Delete a OS Update Policy.
 	DeleteOSUpdatePolicy(ctx context.Context, in *DeleteOSUpdatePolicyRequest, opts ...grpc.CallOption) (*DeleteOSUpdatePolicyResponse, error)
 }
@@ -4339,6 +5050,24 @@ func (c *oSUpdatePolicyClient) GetOSUpdatePolicy(ctx context.Context, in *GetOSU
 	return out, nil
 }
 
+func (c *oSUpdatePolicyClient) UpdateOSUpdatePolicy(ctx context.Context, in *UpdateOSUpdatePolicyRequest, opts ...grpc.CallOption) (*v11.OSUpdatePolicy, error) {
+	out := new(v11.OSUpdatePolicy)
+	err := c.cc.Invoke(ctx, "/services.v1.OSUpdatePolicy/UpdateOSUpdatePolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oSUpdatePolicyClient) PatchOSUpdatePolicy(ctx context.Context, in *PatchOSUpdatePolicyRequest, opts ...grpc.CallOption) (*v11.OSUpdatePolicy, error) {
+	out := new(v11.OSUpdatePolicy)
+	err := c.cc.Invoke(ctx, "/services.v1.OSUpdatePolicy/PatchOSUpdatePolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *oSUpdatePolicyClient) DeleteOSUpdatePolicy(ctx context.Context, in *DeleteOSUpdatePolicyRequest, opts ...grpc.CallOption) (*DeleteOSUpdatePolicyResponse, error) {
 	out := new(DeleteOSUpdatePolicyResponse)
 	err := c.cc.Invoke(ctx, "/services.v1.OSUpdatePolicy/DeleteOSUpdatePolicy", in, out, opts...)
@@ -4358,6 +5087,10 @@ type OSUpdatePolicyServer interface {
 	ListOSUpdatePolicy(context.Context, *ListOSUpdatePolicyRequest) (*ListOSUpdatePolicyResponse, error)
 	// Get a specific OS Update Policy.
 	GetOSUpdatePolicy(context.Context, *GetOSUpdatePolicyRequest) (*v11.OSUpdatePolicy, error)
+	// Update an OS Update Policy.
+	UpdateOSUpdatePolicy(context.Context, *UpdateOSUpdatePolicyRequest) (*v11.OSUpdatePolicy, error)
+	// Patch an OS Update Policy.
+	PatchOSUpdatePolicy(context.Context, *PatchOSUpdatePolicyRequest) (*v11.OSUpdatePolicy, error)
 	// Delete a OS Update Policy.
 	DeleteOSUpdatePolicy(context.Context, *DeleteOSUpdatePolicyRequest) (*DeleteOSUpdatePolicyResponse, error)
 }
@@ -4375,6 +5108,12 @@ func (UnimplementedOSUpdatePolicyServer) ListOSUpdatePolicy(context.Context, *Li
 func (UnimplementedOSUpdatePolicyServer) GetOSUpdatePolicy(context.Context, *GetOSUpdatePolicyRequest) (*v11.OSUpdatePolicy, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetOSUpdatePolicy not implemented")
 }
+func (UnimplementedOSUpdatePolicyServer) UpdateOSUpdatePolicy(context.Context, *UpdateOSUpdatePolicyRequest) (*v11.OSUpdatePolicy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateOSUpdatePolicy not implemented")
+}
+func (UnimplementedOSUpdatePolicyServer) PatchOSUpdatePolicy(context.Context, *PatchOSUpdatePolicyRequest) (*v11.OSUpdatePolicy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PatchOSUpdatePolicy not implemented")
+}
 func (UnimplementedOSUpdatePolicyServer) DeleteOSUpdatePolicy(context.Context, *DeleteOSUpdatePolicyRequest) (*DeleteOSUpdatePolicyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteOSUpdatePolicy not implemented")
 }
@@ -4444,6 +5183,42 @@ func _OSUpdatePolicy_GetOSUpdatePolicy_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OSUpdatePolicy_UpdateOSUpdatePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateOSUpdatePolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OSUpdatePolicyServer).UpdateOSUpdatePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/services.v1.OSUpdatePolicy/UpdateOSUpdatePolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OSUpdatePolicyServer).UpdateOSUpdatePolicy(ctx, req.(*UpdateOSUpdatePolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OSUpdatePolicy_PatchOSUpdatePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PatchOSUpdatePolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OSUpdatePolicyServer).PatchOSUpdatePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/services.v1.OSUpdatePolicy/PatchOSUpdatePolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OSUpdatePolicyServer).PatchOSUpdatePolicy(ctx, req.(*PatchOSUpdatePolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _OSUpdatePolicy_DeleteOSUpdatePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteOSUpdatePolicyRequest)
 	if err := dec(in); err != nil {
@@ -4481,6 +5256,14 @@ var OSUpdatePolicy_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetOSUpdatePolicy",
 			Handler:    _OSUpdatePolicy_GetOSUpdatePolicy_Handler,
 		},
+		{
+			MethodName: "UpdateOSUpdatePolicy",
+			Handler:    _OSUpdatePolicy_UpdateOSUpdatePolicy_Handler,
+		},
+		{
+			MethodName: "PatchOSUpdatePolicy",
+			Handler:    _OSUpdatePolicy_PatchOSUpdatePolicy_Handler,
+		},
 		{
 			MethodName: "DeleteOSUpdatePolicy",
 			Handler:    _OSUpdatePolicy_DeleteOSUpdatePolicy_Handler,
@@ -4651,3 +5434,1658 @@ var OSUpdateRun_ServiceDesc = grpc.ServiceDesc{
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "services/v1/services.proto",
 }
+
+// WatchServiceClient is the client API for WatchService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type WatchServiceClient interface {
+	// Watch the changes of the resources of the project.
+	// Changes are streamed as they happen, as newline delimited JSON or, if requested with
+	// "Accept: text/event-stream", as Server-Sent Events. A heartbeat is sent once the watch is established,
+	// then whenever no change happens for the heartbeat interval.
+	WatchResources(ctx context.Context, in *WatchResourcesRequest, opts ...grpc.CallOption) (WatchService_WatchResourcesClient, error)
+}
+
+type watchServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWatchServiceClient(cc grpc.ClientConnInterface) WatchServiceClient {
+	return &watchServiceClient{cc}
+}
+
+func (c *watchServiceClient) WatchResources(ctx context.Context, in *WatchResourcesRequest, opts ...grpc.CallOption) (WatchService_WatchResourcesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &WatchService_ServiceDesc.Streams[0], "/services.v1.WatchService/WatchResources", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &watchServiceWatchResourcesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type WatchService_WatchResourcesClient interface {
+	Recv() (*WatchResourcesResponse, error)
+	grpc.ClientStream
+}
+
+type watchServiceWatchResourcesClient struct {
+	grpc.ClientStream
+}
+
+func (x *watchServiceWatchResourcesClient) Recv() (*WatchResourcesResponse, error) {
+	m := new(WatchResourcesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// WatchServiceServer is the server API for WatchService service.
+// All implementations should embed UnimplementedWatchServiceServer
+// for forward compatibility
+type WatchServiceServer interface {
+	// Watch the changes of the resources of the project.
+	// Changes are streamed as they happen, as newline delimited JSON or, if requested with
+	// "Accept: text/event-stream", as Server-Sent Events. A heartbeat is sent once the watch is established,
+	// then whenever no change happens for the heartbeat interval.
+	WatchResources(*WatchResourcesRequest, WatchService_WatchResourcesServer) error
+}
+
+// UnimplementedWatchServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedWatchServiceServer struct {
+}
+
+func (UnimplementedWatchServiceServer) WatchResources(*WatchResourcesRequest, WatchService_WatchResourcesServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchResources not implemented")
+}
+
+// UnsafeWatchServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WatchServiceServer will
+// result in compilation errors.
+type UnsafeWatchServiceServer interface {
+	mustEmbedUnimplementedWatchServiceServer()
+}
+
+func RegisterWatchServiceServer(s grpc.ServiceRegistrar, srv WatchServiceServer) {
+	s.RegisterService(&WatchService_ServiceDesc, srv)
+}
+
+func _WatchService_WatchResources_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchResourcesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WatchServiceServer).WatchResources(m, &watchServiceWatchResourcesServer{stream})
+}
+
+type WatchService_WatchResourcesServer interface {
+	Send(*WatchResourcesResponse) error
+	grpc.ServerStream
+}
+
+type watchServiceWatchResourcesServer struct {
+	grpc.ServerStream
+}
+
+func (x *watchServiceWatchResourcesServer) Send(m *WatchResourcesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// WatchService_ServiceDesc is the grpc.ServiceDesc for WatchService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var WatchService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "services.v1.WatchService",
+	HandlerType: (*WatchServiceServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchResources",
+			Handler:       _WatchService_WatchResources_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "services/v1/services.proto",
+}
+
+// IPAddressServiceClient is the client API for IPAddressService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type IPAddressServiceClient interface {
+	// Get a list of IP addresses.
+	ListIPAddresses(ctx context.Context, in *ListIPAddressesRequest, opts ...grpc.CallOption) (*ListIPAddressesResponse, error)
+	// Get a specific IP address.
+	GetIPAddress(ctx context.Context, in *GetIPAddressRequest, opts ...grpc.CallOption) (*v19.IPAddressResource, error)
+}
+
+type iPAddressServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewIPAddressServiceClient(cc grpc.ClientConnInterface) IPAddressServiceClient {
+	return &iPAddressServiceClient{cc}
+}
+
+func (c *iPAddressServiceClient) ListIPAddresses(ctx context.Context, in *ListIPAddressesRequest, opts ...grpc.CallOption) (*ListIPAddressesResponse, error) {
+	out := new(ListIPAddressesResponse)
+	err := c.cc.Invoke(ctx, "/services.v1.IPAddressService/ListIPAddresses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iPAddressServiceClient) GetIPAddress(ctx context.Context, in *GetIPAddressRequest, opts ...grpc.CallOption) (*v19.IPAddressResource, error) {
+	out := new(v19.IPAddressResource)
+	err := c.cc.Invoke(ctx, "/services.v1.IPAddressService/GetIPAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// IPAddressServiceServer is the server API for IPAddressService service.
+// All implementations should embed UnimplementedIPAddressServiceServer
+// for forward compatibility
+type IPAddressServiceServer interface {
+	// Get a list of IP addresses.
+	ListIPAddresses(context.Context, *ListIPAddressesRequest) (*ListIPAddressesResponse, error)
+	// Get a specific IP address.
+	GetIPAddress(context.Context, *GetIPAddressRequest) (*v19.IPAddressResource, error)
+}
+
+// UnimplementedIPAddressServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedIPAddressServiceServer struct {
+}
+
+func (UnimplementedIPAddressServiceServer) ListIPAddresses(context.Context, *ListIPAddressesRequest) (*ListIPAddressesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListIPAddresses not implemented")
+}
+func (UnimplementedIPAddressServiceServer) GetIPAddress(context.Context, *GetIPAddressRequest) (*v19.IPAddressResource, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIPAddress not implemented")
+}
+
+// UnsafeIPAddressServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to IPAddressServiceServer will
+// result in compilation errors.
+type UnsafeIPAddressServiceServer interface {
+	mustEmbedUnimplementedIPAddressServiceServer()
+}
+
+func RegisterIPAddressServiceServer(s grpc.ServiceRegistrar, srv IPAddressServiceServer) {
+	s.RegisterService(&IPAddressService_ServiceDesc, srv)
+}
+
+func _IPAddressService_ListIPAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListIPAddressesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IPAddressServiceServer).ListIPAddresses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/services.v1.IPAddressService/ListIPAddresses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IPAddressServiceServer).ListIPAddresses(ctx, req.(*ListIPAddressesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IPAddressService_GetIPAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIPAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IPAddressServiceServer).GetIPAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/services.v1.IPAddressService/GetIPAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IPAddressServiceServer).GetIPAddress(ctx, req.(*GetIPAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// IPAddressService_ServiceDesc is the grpc.ServiceDesc for IPAddressService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var IPAddressService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "services.v1.IPAddressService",
+	HandlerType: (*IPAddressServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListIPAddresses",
+			Handler:    _IPAddressService_ListIPAddresses_Handler,
+		},
+		{
+			MethodName: "GetIPAddress",
+			Handler:    _IPAddressService_GetIPAddress_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "services/v1/services.proto",
+}
+
+// HostnicServiceClient is the client API for HostnicService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type HostnicServiceClient interface {
+	// Get a list of host NICs.
+	ListHostnics(ctx context.Context, in *ListHostnicsRequest, opts ...grpc.CallOption) (*ListHostnicsResponse, error)
+	// Get a specific host NIC.
+	GetHostnic(ctx context.Context, in *GetHostnicRequest, opts ...grpc.CallOption) (*v11.HostnicResource, error)
+}
+
+type hostnicServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewHostnicServiceClient(cc grpc.ClientConnInterface) HostnicServiceClient {
+	return &hostnicServiceClient{cc}
+}
+
+func (c *hostnicServiceClient) ListHostnics(ctx context.Context, in *ListHostnicsRequest, opts ...grpc.CallOption) (*ListHostnicsResponse, error) {
+	out := new(ListHostnicsResponse)
+	err := c.cc.Invoke(ctx, "/services.v1.HostnicService/ListHostnics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hostnicServiceClient) GetHostnic(ctx context.Context, in *GetHostnicRequest, opts ...grpc.CallOption) (*v11.HostnicResource, error) {
+	out := new(v11.HostnicResource)
+	err := c.cc.Invoke(ctx, "/services.v1.HostnicService/GetHostnic", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// HostnicServiceServer is the server API for HostnicService service.
+// All implementations should embed UnimplementedHostnicServiceServer
+// for forward compatibility
+type HostnicServiceServer interface {
+	// Get a list of host NICs.
+	ListHostnics(context.Context, *ListHostnicsRequest) (*ListHostnicsResponse, error)
+	// Get a specific host NIC.
+	GetHostnic(context.Context, *GetHostnicRequest) (*v11.HostnicResource, error)
+}
+
+// UnimplementedHostnicServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedHostnicServiceServer struct {
+}
+
+func (UnimplementedHostnicServiceServer) ListHostnics(context.Context, *ListHostnicsRequest) (*ListHostnicsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListHostnics not implemented")
+}
+func (UnimplementedHostnicServiceServer) GetHostnic(context.Context, *GetHostnicRequest) (*v11.HostnicResource, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHostnic not implemented")
+}
+
+// UnsafeHostnicServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to HostnicServiceServer will
+// result in compilation errors.
+type UnsafeHostnicServiceServer interface {
+	mustEmbedUnimplementedHostnicServiceServer()
+}
+
+func RegisterHostnicServiceServer(s grpc.ServiceRegistrar, srv HostnicServiceServer) {
+	s.RegisterService(&HostnicService_ServiceDesc, srv)
+}
+
+func _HostnicService_ListHostnics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListHostnicsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HostnicServiceServer).ListHostnics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/services.v1.HostnicService/ListHostnics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HostnicServiceServer).ListHostnics(ctx, req.(*ListHostnicsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HostnicService_GetHostnic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHostnicRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HostnicServiceServer).GetHostnic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/services.v1.HostnicService/GetHostnic",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HostnicServiceServer).GetHostnic(ctx, req.(*GetHostnicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// HostnicService_ServiceDesc is the grpc.ServiceDesc for HostnicService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var HostnicService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "services.v1.HostnicService",
+	HandlerType: (*HostnicServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListHostnics",
+			Handler:    _HostnicService_ListHostnics_Handler,
+		},
+		{
+			MethodName: "GetHostnic",
+			Handler:    _HostnicService_GetHostnic_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "services/v1/services.proto",
+}
+
+// NetworkSegmentServiceClient is the client API for NetworkSegmentService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type NetworkSegmentServiceClient interface {
+	// Create a network segment.
+	CreateNetworkSegment(ctx context.Context, in *CreateNetworkSegmentRequest, opts ...grpc.CallOption) (*v19.NetworkSegmentResource, error)
+	// Get a list of network segments.
+	ListNetworkSegments(ctx context.Context, in *ListNetworkSegmentsRequest, opts ...grpc.CallOption) (*ListNetworkSegmentsResponse, error)
+	// Get a specific network segment.
+	GetNetworkSegment(ctx context.Context, in *GetNetworkSegmentRequest, opts ...grpc.CallOption) (*v19.NetworkSegmentResource, error)
+	// Update a network segment.
+	UpdateNetworkSegment(ctx context.Context, in *UpdateNetworkSegmentRequest, opts ...grpc.CallOption) (*v19.NetworkSegmentResource, error)
+	// Patch a network segment.
+	PatchNetworkSegment(ctx context.Context, in *PatchNetworkSegmentRequest, opts ...grpc.CallOption) (*v19.NetworkSegmentResource, error)
+	// Delete a network segment.
+	DeleteNetworkSegment(ctx context.Context, in *DeleteNetworkSegmentRequest, opts ...grpc.CallOption) (*DeleteNetworkSegmentResponse, error)
+}
+
+type networkSegmentServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewNetworkSegmentServiceClient(cc grpc.ClientConnInterface) NetworkSegmentServiceClient {
+	return &networkSegmentServiceClient{cc}
+}
+
+func (c *networkSegmentServiceClient) CreateNetworkSegment(ctx context.Context, in *CreateNetworkSegmentRequest, opts ...grpc.CallOption) (*v19.NetworkSegmentResource, error) {
+	out := new(v19.NetworkSegmentResource)
+	err := c.cc.Invoke(ctx, "/services.v1.NetworkSegmentService/CreateNetworkSegment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *networkSegmentServiceClient) ListNetworkSegments(ctx context.Context, in *ListNetworkSegmentsRequest, opts ...grpc.CallOption) (*ListNetworkSegmentsResponse, error) {
+	out := new(ListNetworkSegmentsResponse)
+	err := c.cc.Invoke(ctx, "/services.v1.NetworkSegmentService/ListNetworkSegments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *networkSegmentServiceClient) GetNetworkSegment(ctx context.Context, in *GetNetworkSegmentRequest, opts ...grpc.CallOption) (*v19.NetworkSegmentResource, error) {
+	out := new(v19.NetworkSegmentResource)
+	err := c.cc.Invoke(ctx, "/services.v1.NetworkSegmentService/GetNetworkSegment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *networkSegmentServiceClient) UpdateNetworkSegment(ctx context.Context, in *UpdateNetworkSegmentRequest, opts ...grpc.CallOption) (*v19.NetworkSegmentResource, error) {
+	out := new(v19.NetworkSegmentResource)
+	err := c.cc.Invoke(ctx, "/services.v1.NetworkSegmentService/UpdateNetworkSegment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *networkSegmentServiceClient) PatchNetworkSegment(ctx context.Context, in *PatchNetworkSegmentRequest, opts ...grpc.CallOption) (*v19.NetworkSegmentResource, error) {
+	out := new(v19.NetworkSegmentResource)
+	err := c.cc.Invoke(ctx, "/services.v1.NetworkSegmentService/PatchNetworkSegment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *networkSegmentServiceClient) DeleteNetworkSegment(ctx context.Context, in *DeleteNetworkSegmentRequest, opts ...grpc.CallOption) (*DeleteNetworkSegmentResponse, error) {
+	out := new(DeleteNetworkSegmentResponse)
+	err := c.cc.Invoke(ctx, "/services.v1.NetworkSegmentService/DeleteNetworkSegment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NetworkSegmentServiceServer is the server API for NetworkSegmentService service.
+// All implementations should embed UnimplementedNetworkSegmentServiceServer
+// for forward compatibility
+type NetworkSegmentServiceServer interface {
+	// Create a network segment.
+	CreateNetworkSegment(context.Context, *CreateNetworkSegmentRequest) (*v19.NetworkSegmentResource, error)
+	// Get a list of network segments.
+	ListNetworkSegments(context.Context, *ListNetworkSegmentsRequest) (*ListNetworkSegmentsResponse, error)
+	// Get a specific network segment.
+	GetNetworkSegment(context.Context, *GetNetworkSegmentRequest) (*v19.NetworkSegmentResource, error)
+	// Update a network segment.
+	UpdateNetworkSegment(context.Context, *UpdateNetworkSegmentRequest) (*v19.NetworkSegmentResource, error)
+	// Patch a network segment.
+	PatchNetworkSegment(context.Context, *PatchNetworkSegmentRequest) (*v19.NetworkSegmentResource, error)
+	// Delete a network segment.
+	DeleteNetworkSegment(context.Context, *DeleteNetworkSegmentRequest) (*DeleteNetworkSegmentResponse, error)
+}
+
+// UnimplementedNetworkSegmentServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedNetworkSegmentServiceServer struct {
+}
+
+func (UnimplementedNetworkSegmentServiceServer) CreateNetworkSegment(context.Context, *CreateNetworkSegmentRequest) (*v19.NetworkSegmentResource, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateNetworkSegment not implemented")
+}
+func (UnimplementedNetworkSegmentServiceServer) ListNetworkSegments(context.Context, *ListNetworkSegmentsRequest) (*ListNetworkSegmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNetworkSegments not implemented")
+}
+func (UnimplementedNetworkSegmentServiceServer) GetNetworkSegment(context.Context, *GetNetworkSegmentRequest) (*v19.NetworkSegmentResource, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNetworkSegment not implemented")
+}
+func (UnimplementedNetworkSegmentServiceServer) UpdateNetworkSegment(context.Context, *UpdateNetworkSegmentRequest) (*v19.NetworkSegmentResource, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNetworkSegment not implemented")
+}
+func (UnimplementedNetworkSegmentServiceServer) PatchNetworkSegment(context.Context, *PatchNetworkSegmentRequest) (*v19.NetworkSegmentResource, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PatchNetworkSegment not implemented")
+}
+func (UnimplementedNetworkSegmentServiceServer) DeleteNetworkSegment(context.Context, *DeleteNetworkSegmentRequest) (*DeleteNetworkSegmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteNetworkSegment not implemented")
+}
+
+// UnsafeNetworkSegmentServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to NetworkSegmentServiceServer will
+// result in compilation errors.
+type UnsafeNetworkSegmentServiceServer interface {
+	mustEmbedUnimplementedNetworkSegmentServiceServer()
+}
+
+func RegisterNetworkSegmentServiceServer(s grpc.ServiceRegistrar, srv NetworkSegmentServiceServer) {
+	s.RegisterService(&NetworkSegmentService_ServiceDesc, srv)
+}
+
+func _NetworkSegmentService_CreateNetworkSegment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateNetworkSegmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetworkSegmentServiceServer).CreateNetworkSegment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/services.v1.NetworkSegmentService/CreateNetworkSegment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetworkSegmentServiceServer).CreateNetworkSegment(ctx, req.(*CreateNetworkSegmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NetworkSegmentService_ListNetworkSegments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNetworkSegmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetworkSegmentServiceServer).ListNetworkSegments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/services.v1.NetworkSegmentService/ListNetworkSegments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetworkSegmentServiceServer).ListNetworkSegments(ctx, req.(*ListNetworkSegmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NetworkSegmentService_GetNetworkSegment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNetworkSegmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetworkSegmentServiceServer).GetNetworkSegment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/services.v1.NetworkSegmentService/GetNetworkSegment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetworkSegmentServiceServer).GetNetworkSegment(ctx, req.(*GetNetworkSegmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NetworkSegmentService_UpdateNetworkSegment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateNetworkSegmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetworkSegmentServiceServer).UpdateNetworkSegment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/services.v1.NetworkSegmentService/UpdateNetworkSegment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetworkSegmentServiceServer).UpdateNetworkSegment(ctx, req.(*UpdateNetworkSegmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NetworkSegmentService_PatchNetworkSegment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PatchNetworkSegmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetworkSegmentServiceServer).PatchNetworkSegment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/services.v1.NetworkSegmentService/PatchNetworkSegment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetworkSegmentServiceServer).PatchNetworkSegment(ctx, req.(*PatchNetworkSegmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NetworkSegmentService_DeleteNetworkSegment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteNetworkSegmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetworkSegmentServiceServer).DeleteNetworkSegment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/services.v1.NetworkSegmentService/DeleteNetworkSegment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetworkSegmentServiceServer).DeleteNetworkSegment(ctx, req.(*DeleteNetworkSegmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// NetworkSegmentService_ServiceDesc is the grpc.ServiceDesc for NetworkSegmentService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var NetworkSegmentService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "services.v1.NetworkSegmentService",
+	HandlerType: (*NetworkSegmentServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateNetworkSegment",
+			Handler:    _NetworkSegmentService_CreateNetworkSegment_Handler,
+		},
+		{
+			MethodName: "ListNetworkSegments",
+			Handler:    _NetworkSegmentService_ListNetworkSegments_Handler,
+		},
+		{
+			MethodName: "GetNetworkSegment",
+			Handler:    _NetworkSegmentService_GetNetworkSegment_Handler,
+		},
+		{
+			MethodName: "UpdateNetworkSegment",
+			Handler:    _NetworkSegmentService_UpdateNetworkSegment_Handler,
+		},
+		{
+			MethodName: "PatchNetworkSegment",
+			Handler:    _NetworkSegmentService_PatchNetworkSegment_Handler,
+		},
+		{
+			MethodName: "DeleteNetworkSegment",
+			Handler:    _NetworkSegmentService_DeleteNetworkSegment_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "services/v1/services.proto",
+}
+
+// EndpointServiceClient is the client API for EndpointService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type EndpointServiceClient interface {
+	// Get a list of endpoints.
+	ListEndpoints(ctx context.Context, in *ListEndpointsRequest, opts ...grpc.CallOption) (*ListEndpointsResponse, error)
+	// Get a specific endpoint.
+	GetEndpoint(ctx context.Context, in *GetEndpointRequest, opts ...grpc.CallOption) (*v19.EndpointResource, error)
+}
+
+type endpointServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewEndpointServiceClient(cc grpc.ClientConnInterface) EndpointServiceClient {
+	return &endpointServiceClient{cc}
+}
+
+func (c *endpointServiceClient) ListEndpoints(ctx context.Context, in *ListEndpointsRequest, opts ...grpc.CallOption) (*ListEndpointsResponse, error) {
+	out := new(ListEndpointsResponse)
+	err := c.cc.Invoke(ctx, "/services.v1.EndpointService/ListEndpoints", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *endpointServiceClient) GetEndpoint(ctx context.Context, in *GetEndpointRequest, opts ...grpc.CallOption) (*v19.EndpointResource, error) {
+	out := new(v19.EndpointResource)
+	err := c.cc.Invoke(ctx, "/services.v1.EndpointService/GetEndpoint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// EndpointServiceServer is the server API for EndpointService service.
+// All implementations should embed UnimplementedEndpointServiceServer
+// for forward compatibility
+type EndpointServiceServer interface {
+	// Get a list of endpoints.
+	ListEndpoints(context.Context, *ListEndpointsRequest) (*ListEndpointsResponse, error)
+	// Get a specific endpoint.
+	GetEndpoint(context.Context, *GetEndpointRequest) (*v19.EndpointResource, error)
+}
+
+// UnimplementedEndpointServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedEndpointServiceServer struct {
+}
+
+func (UnimplementedEndpointServiceServer) ListEndpoints(context.Context, *ListEndpointsRequest) (*ListEndpointsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEndpoints not implemented")
+}
+func (UnimplementedEndpointServiceServer) GetEndpoint(context.Context, *GetEndpointRequest) (*v19.EndpointResource, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEndpoint not implemented")
+}
+
+// UnsafeEndpointServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to EndpointServiceServer will
+// result in compilation errors.
+type UnsafeEndpointServiceServer interface {
+	mustEmbedUnimplementedEndpointServiceServer()
+}
+
+func RegisterEndpointServiceServer(s grpc.ServiceRegistrar, srv EndpointServiceServer) {
+	s.RegisterService(&EndpointService_ServiceDesc, srv)
+}
+
+func _EndpointService_ListEndpoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEndpointsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EndpointServiceServer).ListEndpoints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/services.v1.EndpointService/ListEndpoints",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EndpointServiceServer).ListEndpoints(ctx, req.(*ListEndpointsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EndpointService_GetEndpoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEndpointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EndpointServiceServer).GetEndpoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/services.v1.EndpointService/GetEndpoint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EndpointServiceServer).GetEndpoint(ctx, req.(*GetEndpointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// EndpointService_ServiceDesc is the grpc.ServiceDesc for EndpointService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var EndpointService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "services.v1.EndpointService",
+	HandlerType: (*EndpointServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListEndpoints",
+			Handler:    _EndpointService_ListEndpoints_Handler,
+		},
+		{
+			MethodName: "GetEndpoint",
+			Handler:    _EndpointService_GetEndpoint_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "services/v1/services.proto",
+}
+
+// NetlinkServiceClient is the client API for NetlinkService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type NetlinkServiceClient interface {
+	// Get a list of netlinks.
+	ListNetlinks(ctx context.Context, in *ListNetlinksRequest, opts ...grpc.CallOption) (*ListNetlinksResponse, error)
+	// Get a specific netlink.
+	GetNetlink(ctx context.Context, in *GetNetlinkRequest, opts ...grpc.CallOption) (*v19.NetlinkResource, error)
+}
+
+type netlinkServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewNetlinkServiceClient(cc grpc.ClientConnInterface) NetlinkServiceClient {
+	return &netlinkServiceClient{cc}
+}
+
+func (c *netlinkServiceClient) ListNetlinks(ctx context.Context, in *ListNetlinksRequest, opts ...grpc.CallOption) (*ListNetlinksResponse, error) {
+	out := new(ListNetlinksResponse)
+	err := c.cc.Invoke(ctx, "/services.v1.NetlinkService/ListNetlinks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *netlinkServiceClient) GetNetlink(ctx context.Context, in *GetNetlinkRequest, opts ...grpc.CallOption) (*v19.NetlinkResource, error) {
+	out := new(v19.NetlinkResource)
+	err := c.cc.Invoke(ctx, "/services.v1.NetlinkService/GetNetlink", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NetlinkServiceServer is the server API for NetlinkService service.
+// All implementations should embed UnimplementedNetlinkServiceServer
+// for forward compatibility
+type NetlinkServiceServer interface {
+	// Get a list of netlinks.
+	ListNetlinks(context.Context, *ListNetlinksRequest) (*ListNetlinksResponse, error)
+	// Get a specific netlink.
+	GetNetlink(context.Context, *GetNetlinkRequest) (*v19.NetlinkResource, error)
+}
+
+// UnimplementedNetlinkServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedNetlinkServiceServer struct {
+}
+
+func (UnimplementedNetlinkServiceServer) ListNetlinks(context.Context, *ListNetlinksRequest) (*ListNetlinksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNetlinks not implemented")
+}
+func (UnimplementedNetlinkServiceServer) GetNetlink(context.Context, *GetNetlinkRequest) (*v19.NetlinkResource, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNetlink not implemented")
+}
+
+// UnsafeNetlinkServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to NetlinkServiceServer will
+// result in compilation errors.
+type UnsafeNetlinkServiceServer interface {
+	mustEmbedUnimplementedNetlinkServiceServer()
+}
+
+func RegisterNetlinkServiceServer(s grpc.ServiceRegistrar, srv NetlinkServiceServer) {
+	s.RegisterService(&NetlinkService_ServiceDesc, srv)
+}
+
+func _NetlinkService_ListNetlinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNetlinksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetlinkServiceServer).ListNetlinks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/services.v1.NetlinkService/ListNetlinks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetlinkServiceServer).ListNetlinks(ctx, req.(*ListNetlinksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NetlinkService_GetNetlink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNetlinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetlinkServiceServer).GetNetlink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/services.v1.NetlinkService/GetNetlink",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetlinkServiceServer).GetNetlink(ctx, req.(*GetNetlinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// NetlinkService_ServiceDesc is the grpc.ServiceDesc for NetlinkService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var NetlinkService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "services.v1.NetlinkService",
+	HandlerType: (*NetlinkServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListNetlinks",
+			Handler:    _NetlinkService_ListNetlinks_Handler,
+		},
+		{
+			MethodName: "GetNetlink",
+			Handler:    _NetlinkService_GetNetlink_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "services/v1/services.proto",
+}
+
+// RemoteAccessServiceClient is the client API for RemoteAccessService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RemoteAccessServiceClient interface {
+	// Create a remote access session for an instance.
+	CreateRemoteAccess(ctx context.Context, in *CreateRemoteAccessRequest, opts ...grpc.CallOption) (*v110.RemoteAccessResource, error)
+	// Get a list of remote access sessions.
+	ListRemoteAccesses(ctx context.Context, in *ListRemoteAccessesRequest, opts ...grpc.CallOption) (*ListRemoteAccessesResponse, error)
+	// Get a specific remote access session.
+	GetRemoteAccess(ctx context.Context, in *GetRemoteAccessRequest, opts ...grpc.CallOption) (*v110.RemoteAccessResource, error)
+	// Revoke a remote access session.
+	DeleteRemoteAccess(ctx context.Context, in *DeleteRemoteAccessRequest, opts ...grpc.CallOption) (*DeleteRemoteAccessResponse, error)
+}
+
+type remoteAccessServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRemoteAccessServiceClient(cc grpc.ClientConnInterface) RemoteAccessServiceClient {
+	return &remoteAccessServiceClient{cc}
+}
+
+func (c *remoteAccessServiceClient) CreateRemoteAccess(ctx context.Context, in *CreateRemoteAccessRequest, opts ...grpc.CallOption) (*v110.RemoteAccessResource, error) {
+	out := new(v110.RemoteAccessResource)
+	err := c.cc.Invoke(ctx, "/services.v1.RemoteAccessService/CreateRemoteAccess", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *remoteAccessServiceClient) ListRemoteAccesses(ctx context.Context, in *ListRemoteAccessesRequest, opts ...grpc.CallOption) (*ListRemoteAccessesResponse, error) {
+	out := new(ListRemoteAccessesResponse)
+	err := c.cc.Invoke(ctx, "/services.v1.RemoteAccessService/ListRemoteAccesses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *remoteAccessServiceClient) GetRemoteAccess(ctx context.Context, in *GetRemoteAccessRequest, opts ...grpc.CallOption) (*v110.RemoteAccessResource, error) {
+	out := new(v110.RemoteAccessResource)
+	err := c.cc.Invoke(ctx, "/services.v1.RemoteAccessService/GetRemoteAccess", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *remoteAccessServiceClient) DeleteRemoteAccess(ctx context.Context, in *DeleteRemoteAccessRequest, opts ...grpc.CallOption) (*DeleteRemoteAccessResponse, error) {
+	out := new(DeleteRemoteAccessResponse)
+	err := c.cc.Invoke(ctx, "/services.v1.RemoteAccessService/DeleteRemoteAccess", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RemoteAccessServiceServer is the server API for RemoteAccessService service.
+// All implementations should embed UnimplementedRemoteAccessServiceServer
+// for forward compatibility
+type RemoteAccessServiceServer interface {
+	// Create a remote access session for an instance.
+	CreateRemoteAccess(context.Context, *CreateRemoteAccessRequest) (*v110.RemoteAccessResource, error)
+	// Get a list of remote access sessions.
+	ListRemoteAccesses(context.Context, *ListRemoteAccessesRequest) (*ListRemoteAccessesResponse, error)
+	// Get a specific remote access session.
+	GetRemoteAccess(context.Context, *GetRemoteAccessRequest) (*v110.RemoteAccessResource, error)
+	// Revoke a remote access session.
+	DeleteRemoteAccess(context.Context, *DeleteRemoteAccessRequest) (*DeleteRemoteAccessResponse, error)
+}
+
+// UnimplementedRemoteAccessServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedRemoteAccessServiceServer struct {
+}
+
+func (UnimplementedRemoteAccessServiceServer) CreateRemoteAccess(context.Context, *CreateRemoteAccessRequest) (*v110.RemoteAccessResource, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRemoteAccess not implemented")
+}
+func (UnimplementedRemoteAccessServiceServer) ListRemoteAccesses(context.Context, *ListRemoteAccessesRequest) (*ListRemoteAccessesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRemoteAccesses not implemented")
+}
+func (UnimplementedRemoteAccessServiceServer) GetRemoteAccess(context.Context, *GetRemoteAccessRequest) (*v110.RemoteAccessResource, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRemoteAccess not implemented")
+}
+func (UnimplementedRemoteAccessServiceServer) DeleteRemoteAccess(context.Context, *DeleteRemoteAccessRequest) (*DeleteRemoteAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRemoteAccess not implemented")
+}
+
+// UnsafeRemoteAccessServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RemoteAccessServiceServer will
+// result in compilation errors.
+type UnsafeRemoteAccessServiceServer interface {
+	mustEmbedUnimplementedRemoteAccessServiceServer()
+}
+
+func RegisterRemoteAccessServiceServer(s grpc.ServiceRegistrar, srv RemoteAccessServiceServer) {
+	s.RegisterService(&RemoteAccessService_ServiceDesc, srv)
+}
+
+func _RemoteAccessService_CreateRemoteAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRemoteAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RemoteAccessServiceServer).CreateRemoteAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/services.v1.RemoteAccessService/CreateRemoteAccess",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RemoteAccessServiceServer).CreateRemoteAccess(ctx, req.(*CreateRemoteAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RemoteAccessService_ListRemoteAccesses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRemoteAccessesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RemoteAccessServiceServer).ListRemoteAccesses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/services.v1.RemoteAccessService/ListRemoteAccesses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RemoteAccessServiceServer).ListRemoteAccesses(ctx, req.(*ListRemoteAccessesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RemoteAccessService_GetRemoteAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRemoteAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RemoteAccessServiceServer).GetRemoteAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/services.v1.RemoteAccessService/GetRemoteAccess",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RemoteAccessServiceServer).GetRemoteAccess(ctx, req.(*GetRemoteAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RemoteAccessService_DeleteRemoteAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRemoteAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RemoteAccessServiceServer).DeleteRemoteAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/services.v1.RemoteAccessService/DeleteRemoteAccess",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RemoteAccessServiceServer).DeleteRemoteAccess(ctx, req.(*DeleteRemoteAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RemoteAccessService_ServiceDesc is the grpc.ServiceDesc for RemoteAccessService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RemoteAccessService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "services.v1.RemoteAccessService",
+	HandlerType: (*RemoteAccessServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateRemoteAccess",
+			Handler:    _RemoteAccessService_CreateRemoteAccess_Handler,
+		},
+		{
+			MethodName: "ListRemoteAccesses",
+			Handler:    _RemoteAccessService_ListRemoteAccesses_Handler,
+		},
+		{
+			MethodName: "GetRemoteAccess",
+			Handler:    _RemoteAccessService_GetRemoteAccess_Handler,
+		},
+		{
+			MethodName: "DeleteRemoteAccess",
+			Handler:    _RemoteAccessService_DeleteRemoteAccess_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "services/v1/services.proto",
+}
+
+// OperationServiceClient is the client API for OperationService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type OperationServiceClient interface {
+	// Get a list of operations.
+	ListOperations(ctx context.Context, in *ListOperationsRequest, opts ...grpc.CallOption) (*ListOperationsResponse, error)
+	// Get a specific operation.
+	GetOperation(ctx context.Context, in *GetOperationRequest, opts ...grpc.CallOption) (*v12.OperationResource, error)
+	// Cancel an operation. Cancelling an operation that is over has no effect.
+	// The resources already acted on are not reverted.
+	CancelOperation(ctx context.Context, in *CancelOperationRequest, opts ...grpc.CallOption) (*v12.OperationResource, error)
+}
+
+type operationServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewOperationServiceClient(cc grpc.ClientConnInterface) OperationServiceClient {
+	return &operationServiceClient{cc}
+}
+
+func (c *operationServiceClient) ListOperations(ctx context.Context, in *ListOperationsRequest, opts ...grpc.CallOption) (*ListOperationsResponse, error) {
+	out := new(ListOperationsResponse)
+	err := c.cc.Invoke(ctx, "/services.v1.OperationService/ListOperations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *operationServiceClient) GetOperation(ctx context.Context, in *GetOperationRequest, opts ...grpc.CallOption) (*v12.OperationResource, error) {
+	out := new(v12.OperationResource)
+	err := c.cc.Invoke(ctx, "/services.v1.OperationService/GetOperation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *operationServiceClient) CancelOperation(ctx context.Context, in *CancelOperationRequest, opts ...grpc.CallOption) (*v12.OperationResource, error) {
+	out := new(v12.OperationResource)
+	err := c.cc.Invoke(ctx, "/services.v1.OperationService/CancelOperation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// OperationServiceServer is the server API for OperationService service.
+// All implementations should embed UnimplementedOperationServiceServer
+// for forward compatibility
+type OperationServiceServer interface {
+	// Get a list of operations.
+	ListOperations(context.Context, *ListOperationsRequest) (*ListOperationsResponse, error)
+	// Get a specific operation.
+	GetOperation(context.Context, *GetOperationRequest) (*v12.OperationResource, error)
+	// Cancel an operation. Cancelling an operation that is over has no effect.
+	// The resources already acted on are not reverted.
+	CancelOperation(context.Context, *CancelOperationRequest) (*v12.OperationResource, error)
+}
+
+// UnimplementedOperationServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedOperationServiceServer struct {
+}
+
+func (UnimplementedOperationServiceServer) ListOperations(context.Context, *ListOperationsRequest) (*ListOperationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOperations not implemented")
+}
+func (UnimplementedOperationServiceServer) GetOperation(context.Context, *GetOperationRequest) (*v12.OperationResource, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOperation not implemented")
+}
+func (UnimplementedOperationServiceServer) CancelOperation(context.Context, *CancelOperationRequest) (*v12.OperationResource, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelOperation not implemented")
+}
+
+// UnsafeOperationServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to OperationServiceServer will
+// result in compilation errors.
+type UnsafeOperationServiceServer interface {
+	mustEmbedUnimplementedOperationServiceServer()
+}
+
+func RegisterOperationServiceServer(s grpc.ServiceRegistrar, srv OperationServiceServer) {
+	s.RegisterService(&OperationService_ServiceDesc, srv)
+}
+
+func _OperationService_ListOperations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOperationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperationServiceServer).ListOperations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/services.v1.OperationService/ListOperations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperationServiceServer).ListOperations(ctx, req.(*ListOperationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OperationService_GetOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperationServiceServer).GetOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/services.v1.OperationService/GetOperation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperationServiceServer).GetOperation(ctx, req.(*GetOperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OperationService_CancelOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelOperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperationServiceServer).CancelOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/services.v1.OperationService/CancelOperation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperationServiceServer).CancelOperation(ctx, req.(*CancelOperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// OperationService_ServiceDesc is the grpc.ServiceDesc for OperationService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var OperationService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "services.v1.OperationService",
+	HandlerType: (*OperationServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListOperations",
+			Handler:    _OperationService_ListOperations_Handler,
+		},
+		{
+			MethodName: "GetOperation",
+			Handler:    _OperationService_GetOperation_Handler,
+		},
+		{
+			MethodName: "CancelOperation",
+			Handler:    _OperationService_CancelOperation_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "services/v1/services.proto",
+}
+
+// APITokenServiceClient is the client API for APITokenService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type APITokenServiceClient interface {
+	// Create an API token. The secret token is only returned in this response.
+	CreateAPIToken(ctx context.Context, in *CreateAPITokenRequest, opts ...grpc.CallOption) (*v111.APITokenResource, error)
+	// Get a list of API tokens.
+	ListAPITokens(ctx context.Context, in *ListAPITokensRequest, opts ...grpc.CallOption) (*ListAPITokensResponse, error)
+	// Revoke an API token.
+	DeleteAPIToken(ctx context.Context, in *DeleteAPITokenRequest, opts ...grpc.CallOption) (*DeleteAPITokenResponse, error)
+}
+
+type aPITokenServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAPITokenServiceClient(cc grpc.ClientConnInterface) APITokenServiceClient {
+	return &aPITokenServiceClient{cc}
+}
+
+func (c *aPITokenServiceClient) CreateAPIToken(ctx context.Context, in *CreateAPITokenRequest, opts ...grpc.CallOption) (*v111.APITokenResource, error) {
+	out := new(v111.APITokenResource)
+	err := c.cc.Invoke(ctx, "/services.v1.APITokenService/CreateAPIToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPITokenServiceClient) ListAPITokens(ctx context.Context, in *ListAPITokensRequest, opts ...grpc.CallOption) (*ListAPITokensResponse, error) {
+	out := new(ListAPITokensResponse)
+	err := c.cc.Invoke(ctx, "/services.v1.APITokenService/ListAPITokens", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPITokenServiceClient) DeleteAPIToken(ctx context.Context, in *DeleteAPITokenRequest, opts ...grpc.CallOption) (*DeleteAPITokenResponse, error) {
+	out := new(DeleteAPITokenResponse)
+	err := c.cc.Invoke(ctx, "/services.v1.APITokenService/DeleteAPIToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// APITokenServiceServer is the server API for APITokenService service.
+// All implementations should embed UnimplementedAPITokenServiceServer
+// for forward compatibility
+type APITokenServiceServer interface {
+	// Create an API token. The secret token is only returned in this response.
+	CreateAPIToken(context.Context, *CreateAPITokenRequest) (*v111.APITokenResource, error)
+	// Get a list of API tokens.
+	ListAPITokens(context.Context, *ListAPITokensRequest) (*ListAPITokensResponse, error)
+	// Revoke an API token.
+	DeleteAPIToken(context.Context, *DeleteAPITokenRequest) (*DeleteAPITokenResponse, error)
+}
+
+// UnimplementedAPITokenServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedAPITokenServiceServer struct {
+}
+
+func (UnimplementedAPITokenServiceServer) CreateAPIToken(context.Context, *CreateAPITokenRequest) (*v111.APITokenResource, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAPIToken not implemented")
+}
+func (UnimplementedAPITokenServiceServer) ListAPITokens(context.Context, *ListAPITokensRequest) (*ListAPITokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAPITokens not implemented")
+}
+func (UnimplementedAPITokenServiceServer) DeleteAPIToken(context.Context, *DeleteAPITokenRequest) (*DeleteAPITokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAPIToken not implemented")
+}
+
+// UnsafeAPITokenServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to APITokenServiceServer will
+// result in compilation errors.
+type UnsafeAPITokenServiceServer interface {
+	mustEmbedUnimplementedAPITokenServiceServer()
+}
+
+func RegisterAPITokenServiceServer(s grpc.ServiceRegistrar, srv APITokenServiceServer) {
+	s.RegisterService(&APITokenService_ServiceDesc, srv)
+}
+
+func _APITokenService_CreateAPIToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAPITokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APITokenServiceServer).CreateAPIToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/services.v1.APITokenService/CreateAPIToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APITokenServiceServer).CreateAPIToken(ctx, req.(*CreateAPITokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APITokenService_ListAPITokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAPITokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APITokenServiceServer).ListAPITokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/services.v1.APITokenService/ListAPITokens",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APITokenServiceServer).ListAPITokens(ctx, req.(*ListAPITokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APITokenService_DeleteAPIToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAPITokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APITokenServiceServer).DeleteAPIToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/services.v1.APITokenService/DeleteAPIToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APITokenServiceServer).DeleteAPIToken(ctx, req.(*DeleteAPITokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// APITokenService_ServiceDesc is the grpc.ServiceDesc for APITokenService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var APITokenService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "services.v1.APITokenService",
+	HandlerType: (*APITokenServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateAPIToken",
+			Handler:    _APITokenService_CreateAPIToken_Handler,
+		},
+		{
+			MethodName: "ListAPITokens",
+			Handler:    _APITokenService_ListAPITokens_Handler,
+		},
+		{
+			MethodName: "DeleteAPIToken",
+			Handler:    _APITokenService_DeleteAPIToken_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "services/v1/services.proto",
+}
+
+// RoleBindingServiceClient is the client API for RoleBindingService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RoleBindingServiceClient interface {
+	// Create a role binding.
+	CreateRoleBinding(ctx context.Context, in *CreateRoleBindingRequest, opts ...grpc.CallOption) (*v112.RoleBindingResource, error)
+	// Get a list of role bindings.
+	ListRoleBindings(ctx context.Context, in *ListRoleBindingsRequest, opts ...grpc.CallOption) (*ListRoleBindingsResponse, error)
+	// Get a specific role binding.
+	GetRoleBinding(ctx context.Context, in *GetRoleBindingRequest, opts ...grpc.CallOption) (*v112.RoleBindingResource, error)
+	// Update a role binding.
+	UpdateRoleBinding(ctx context.Context, in *UpdateRoleBindingRequest, opts ...grpc.CallOption) (*v112.RoleBindingResource, error)
+	// Delete a role binding.
+	DeleteRoleBinding(ctx context.Context, in *DeleteRoleBindingRequest, opts ...grpc.CallOption) (*DeleteRoleBindingResponse, error)
+}
+
+type roleBindingServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRoleBindingServiceClient(cc grpc.ClientConnInterface) RoleBindingServiceClient {
+	return &roleBindingServiceClient{cc}
+}
+
+func (c *roleBindingServiceClient) CreateRoleBinding(ctx context.Context, in *CreateRoleBindingRequest, opts ...grpc.CallOption) (*v112.RoleBindingResource, error) {
+	out := new(v112.RoleBindingResource)
+	err := c.cc.Invoke(ctx, "/services.v1.RoleBindingService/CreateRoleBinding", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleBindingServiceClient) ListRoleBindings(ctx context.Context, in *ListRoleBindingsRequest, opts ...grpc.CallOption) (*ListRoleBindingsResponse, error) {
+	out := new(ListRoleBindingsResponse)
+	err := c.cc.Invoke(ctx, "/services.v1.RoleBindingService/ListRoleBindings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleBindingServiceClient) GetRoleBinding(ctx context.Context, in *GetRoleBindingRequest, opts ...grpc.CallOption) (*v112.RoleBindingResource, error) {
+	out := new(v112.RoleBindingResource)
+	err := c.cc.Invoke(ctx, "/services.v1.RoleBindingService/GetRoleBinding", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleBindingServiceClient) UpdateRoleBinding(ctx context.Context, in *UpdateRoleBindingRequest, opts ...grpc.CallOption) (*v112.RoleBindingResource, error) {
+	out := new(v112.RoleBindingResource)
+	err := c.cc.Invoke(ctx, "/services.v1.RoleBindingService/UpdateRoleBinding", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleBindingServiceClient) DeleteRoleBinding(ctx context.Context, in *DeleteRoleBindingRequest, opts ...grpc.CallOption) (*DeleteRoleBindingResponse, error) {
+	out := new(DeleteRoleBindingResponse)
+	err := c.cc.Invoke(ctx, "/services.v1.RoleBindingService/DeleteRoleBinding", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RoleBindingServiceServer is the server API for RoleBindingService service.
+// All implementations should embed UnimplementedRoleBindingServiceServer
+// for forward compatibility
+type RoleBindingServiceServer interface {
+	// Create a role binding.
+	CreateRoleBinding(context.Context, *CreateRoleBindingRequest) (*v112.RoleBindingResource, error)
+	// Get a list of role bindings.
+	ListRoleBindings(context.Context, *ListRoleBindingsRequest) (*ListRoleBindingsResponse, error)
+	// Get a specific role binding.
+	GetRoleBinding(context.Context, *GetRoleBindingRequest) (*v112.RoleBindingResource, error)
+	// Update a role binding.
+	UpdateRoleBinding(context.Context, *UpdateRoleBindingRequest) (*v112.RoleBindingResource, error)
+	// Delete a role binding.
+	DeleteRoleBinding(context.Context, *DeleteRoleBindingRequest) (*DeleteRoleBindingResponse, error)
+}
+
+// UnimplementedRoleBindingServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedRoleBindingServiceServer struct {
+}
+
+func (UnimplementedRoleBindingServiceServer) CreateRoleBinding(context.Context, *CreateRoleBindingRequest) (*v112.RoleBindingResource, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRoleBinding not implemented")
+}
+func (UnimplementedRoleBindingServiceServer) ListRoleBindings(context.Context, *ListRoleBindingsRequest) (*ListRoleBindingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRoleBindings not implemented")
+}
+func (UnimplementedRoleBindingServiceServer) GetRoleBinding(context.Context, *GetRoleBindingRequest) (*v112.RoleBindingResource, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRoleBinding not implemented")
+}
+func (UnimplementedRoleBindingServiceServer) UpdateRoleBinding(context.Context, *UpdateRoleBindingRequest) (*v112.RoleBindingResource, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRoleBinding not implemented")
+}
+func (UnimplementedRoleBindingServiceServer) DeleteRoleBinding(context.Context, *DeleteRoleBindingRequest) (*DeleteRoleBindingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRoleBinding not implemented")
+}
+
+// UnsafeRoleBindingServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RoleBindingServiceServer will
+// result in compilation errors.
+type UnsafeRoleBindingServiceServer interface {
+	mustEmbedUnimplementedRoleBindingServiceServer()
+}
+
+func RegisterRoleBindingServiceServer(s grpc.ServiceRegistrar, srv RoleBindingServiceServer) {
+	s.RegisterService(&RoleBindingService_ServiceDesc, srv)
+}
+
+func _RoleBindingService_CreateRoleBinding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRoleBindingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleBindingServiceServer).CreateRoleBinding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/services.v1.RoleBindingService/CreateRoleBinding",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleBindingServiceServer).CreateRoleBinding(ctx, req.(*CreateRoleBindingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoleBindingService_ListRoleBindings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRoleBindingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleBindingServiceServer).ListRoleBindings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/services.v1.RoleBindingService/ListRoleBindings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleBindingServiceServer).ListRoleBindings(ctx, req.(*ListRoleBindingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoleBindingService_GetRoleBinding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRoleBindingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleBindingServiceServer).GetRoleBinding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/services.v1.RoleBindingService/GetRoleBinding",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleBindingServiceServer).GetRoleBinding(ctx, req.(*GetRoleBindingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoleBindingService_UpdateRoleBinding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRoleBindingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleBindingServiceServer).UpdateRoleBinding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/services.v1.RoleBindingService/UpdateRoleBinding",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleBindingServiceServer).UpdateRoleBinding(ctx, req.(*UpdateRoleBindingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoleBindingService_DeleteRoleBinding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRoleBindingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleBindingServiceServer).DeleteRoleBinding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/services.v1.RoleBindingService/DeleteRoleBinding",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleBindingServiceServer).DeleteRoleBinding(ctx, req.(*DeleteRoleBindingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RoleBindingService_ServiceDesc is the grpc.ServiceDesc for RoleBindingService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RoleBindingService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "services.v1.RoleBindingService",
+	HandlerType: (*RoleBindingServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateRoleBinding",
+			Handler:    _RoleBindingService_CreateRoleBinding_Handler,
+		},
+		{
+			MethodName: "ListRoleBindings",
+			Handler:    _RoleBindingService_ListRoleBindings_Handler,
+		},
+		{
+			MethodName: "GetRoleBinding",
+			Handler:    _RoleBindingService_GetRoleBinding_Handler,
+		},
+		{
+			MethodName: "UpdateRoleBinding",
+			Handler:    _RoleBindingService_UpdateRoleBinding_Handler,
+		},
+		{
+			MethodName: "DeleteRoleBinding",
+			Handler:    _RoleBindingService_DeleteRoleBinding_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "services/v1/services.proto",
+}
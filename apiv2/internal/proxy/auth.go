@@ -15,6 +15,7 @@ import (
 	"github.com/labstack/echo/v4"
 	"google.golang.org/grpc/codes"
 
+	"github.com/open-edge-platform/infra-core/apiv2/v2/internal/apitokens"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/policy/rbac"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/tenant"
@@ -28,6 +29,7 @@ type contextKey string
 const (
 	authPairLen                        = 2
 	authKey                 contextKey = "authorization"
+	subjectKey              contextKey = "subject"
 	bearer                             = "bearer"
 	rbacRules                          = "/rego/authz.rego"
 	AllowMissingAuthClients            = "ALLOW_MISSING_AUTH_CLIENTS"
@@ -165,23 +167,28 @@ func AuthenticationAuthorizationInterceptor(next echo.HandlerFunc) echo.HandlerF
 			return err
 		}
 
-		claims, err := validateJWT(authToken)
-		if err != nil {
-			return err
-		}
+		// API tokens are only known to the gRPC server, that authenticates and authorizes them. Being unverified
+		// here, they do not identify the user for the rate limiter, that falls back to the address of the caller.
+		subject := ""
+		if !apitokens.IsAPIToken(authToken) {
+			claims, err := validateJWT(authToken)
+			if err != nil {
+				return err
+			}
 
-		err = validateTenant(claims, c.Request(), p)
-		if err != nil {
-			return err
+			err = validateTenant(claims, c.Request(), p)
+			if err != nil {
+				return err
+			}
+			subject, _ = claims.GetSubject()
 		}
 
 		// including JWT token to the message metadata
 		authValue := strings.ToLower(authScheme) + " " + authToken
-		c.SetRequest(
-			c.Request().WithContext(
-				context.WithValue(c.Request().Context(),
-					authKey,
-					authValue)))
+		ctx := context.WithValue(c.Request().Context(), authKey, authValue)
+		// the subject identifies the user for the rate limiter
+		ctx = context.WithValue(ctx, subjectKey, subject)
+		c.SetRequest(c.Request().WithContext(ctx))
 		return next(c)
 	}
 }
@@ -9,6 +9,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"strings"
 	"testing"
 	"time"
 
@@ -18,6 +19,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/open-edge-platform/infra-core/apiv2/v2/internal/apitokens"
 	"github.com/open-edge-platform/infra-core/apiv2/v2/internal/proxy"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/tenant"
 )
@@ -28,6 +30,7 @@ const (
 	secretKey       = "randomSecretKey"
 	writeRole       = "im-rw"
 	readRole        = "im-r"
+	scopedRole      = "im-scoped"
 )
 
 var (
@@ -101,6 +104,8 @@ func TestAuthenticationAuthorizationInterceptor(t *testing.T) {
 	require.NoError(t, err, "Error signing token for read-only role")
 	jwtReadWrite, err := generateValidJWT(t, tenantUUID, allRoles)
 	require.NoError(t, err, "Error signing token for all roles")
+	jwtScoped, err := generateValidJWT(t, tenantUUID, []string{scopedRole})
+	require.NoError(t, err, "Error signing token for scoped role")
 
 	tests := []struct {
 		name               string
@@ -189,6 +194,25 @@ func TestAuthenticationAuthorizationInterceptor(t *testing.T) {
 			expectedStatus:     http.StatusOK,
 			addTenantToContext: true,
 		},
+		{
+			name:               "Authorization header with Bearer scheme with JWT scoped role",
+			request:            createRequestWithAuthHeader("Bearer", jwtScoped, http.MethodGet),
+			expectedStatus:     http.StatusOK,
+			addTenantToContext: true,
+		},
+		{
+			name:               "Authorization header with Bearer scheme with JWT scoped role for write operation",
+			request:            createRequestWithAuthHeader("Bearer", jwtScoped, http.MethodPut),
+			expectedStatus:     http.StatusOK,
+			addTenantToContext: true,
+		},
+		{
+			name: "Authorization header with Bearer scheme with API token",
+			request: createRequestWithAuthHeader("Bearer",
+				apitokens.TokenPrefix+"0123abcd_"+strings.Repeat("0", 64), http.MethodPut),
+			expectedStatus:     http.StatusOK,
+			addTenantToContext: true,
+		},
 	}
 
 	for _, tt := range tests {
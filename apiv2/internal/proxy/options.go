@@ -15,11 +15,13 @@ import (
 	"time"
 	"unicode"
 
+	"github.com/getkin/kin-openapi/routers/gorillamux"
 	"github.com/labstack/echo-contrib/echoprometheus"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 	"github.com/prometheus/client_golang/prometheus"
 
+	"github.com/open-edge-platform/infra-core/apiv2/v2/internal/ratelimit"
 	api "github.com/open-edge-platform/infra-core/apiv2/v2/pkg/api/v2"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/auditing"
 	metrics "github.com/open-edge-platform/infra-core/inventory/v2/pkg/metrics"
@@ -40,8 +42,6 @@ var allowMethods = []string{
 
 const (
 	corsMaxAge               = 600
-	rateMaxRequestsPerSecond = 200
-	rateExpirePeriod         = 3
 	bodyLimitMax             = "100K"
 	headerLimitMax           = http.DefaultMaxHeaderBytes
 	serverDefaultTimeout     = 15
@@ -151,29 +151,44 @@ func (m *Manager) setSecureConfig(e *echo.Echo, excludePrefixes []string) {
 func (m *Manager) setRateLimiter(e *echo.Echo) {
 	if m.cfg.RestServer.EnableRateLimiter {
 		zlog.InfraSec().Info().Msg("Rate Limiter is enabled")
-		config := middleware.RateLimiterConfig{
-			Skipper: middleware.DefaultSkipper,
-			Store: middleware.NewRateLimiterMemoryStoreWithConfig(
-				middleware.RateLimiterMemoryStoreConfig{
-					Rate:      rateMaxRequestsPerSecond,
-					Burst:     rateMaxRequestsPerSecond,
-					ExpiresIn: rateExpirePeriod * time.Minute,
-				},
-			),
-			IdentifierExtractor: func(ctx echo.Context) (string, error) {
-				id := ctx.RealIP()
-				return id, nil
-			},
-			ErrorHandler: func(context echo.Context, _ error) error {
-				return context.JSON(http.StatusForbidden, nil)
-			},
-			DenyHandler: func(context echo.Context, _ string, _ error) error {
-				return context.JSON(http.StatusTooManyRequests, nil)
-			},
+		limiter, err := m.newRateLimiter()
+		if err != nil {
+			zlog.InfraSec().Fatal().Err(err).Msg("failed to create the rate limiter")
+		}
+		openAPIDefinition, err := api.GetSwagger()
+		if err != nil {
+			zlog.InfraSec().Fatal().Err(err).Msg("failed to load OpenAPI definition")
+		}
+		for _, s := range openAPIDefinition.Servers {
+			s.URL = strings.ReplaceAll(s.URL, "{apiRoot}", "")
+		}
+		router, err := gorillamux.NewRouter(openAPIDefinition)
+		if err != nil {
+			zlog.InfraSec().Fatal().Err(err).Msg("failed to create OpenAPI router")
 		}
+		e.Use(RateLimiter(limiter, router))
+	}
+}
 
-		e.Use(middleware.RateLimiterWithConfig(config))
+func (m *Manager) newRateLimiter() (*ratelimit.Limiter, error) {
+	weights, err := ratelimit.ParseWeights(m.cfg.RateLimit.Weights)
+	if err != nil {
+		return nil, err
 	}
+	store, err := ratelimit.NewStore(m.ctx, m.cfg.RateLimit.StoreURL)
+	if err != nil {
+		return nil, err
+	}
+	return ratelimit.NewLimiter(store, ratelimit.Config{
+		Window: m.cfg.RateLimit.Window,
+		Budgets: ratelimit.Budgets{
+			SubjectRead:  m.cfg.RateLimit.SubjectRead,
+			SubjectWrite: m.cfg.RateLimit.SubjectWrite,
+			ProjectRead:  m.cfg.RateLimit.ProjectRead,
+			ProjectWrite: m.cfg.RateLimit.ProjectWrite,
+		},
+		Weights: weights,
+	})
 }
 
 // setLimits sets the max size of a request body
@@ -190,8 +205,8 @@ func (m *Manager) setTimeout(e *echo.Echo) {
 	e.Use(middleware.ContextTimeoutWithConfig(middleware.ContextTimeoutConfig{
 		Timeout: serverDefaultTimeout * time.Second,
 		Skipper: func(c echo.Context) bool {
-			// Skip timeout for websockets
-			return c.IsWebSocket()
+			// Skip timeout for websockets and watch streams
+			return c.IsWebSocket() || isWatchRequest(c)
 		},
 		ErrorHandler: func(err error, _ echo.Context) error {
 			if err != nil && errors.Is(err, context.DeadlineExceeded) {
@@ -409,6 +424,7 @@ func (m *Manager) setOptions(e *echo.Echo) {
 	m.setRateLimiter(e)
 	m.setLimits(e)
 	m.setTimeout(e)
+	m.setWatch(e)
 	m.setSecureConfig(e, []string{})
 	m.setOapiValidator(e)
 	e.HideBanner = true
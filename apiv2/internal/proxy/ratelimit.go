@@ -0,0 +1,77 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package proxy
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/labstack/echo/v4"
+
+	"github.com/open-edge-platform/infra-core/apiv2/v2/internal/ratelimit"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/tenant"
+)
+
+// Headers reporting the most constraining rate limit budget of the call,
+// see https://datatracker.ietf.org/doc/draft-ietf-httpapi-ratelimit-headers/.
+const (
+	HeaderRateLimitLimit     = "RateLimit-Limit"
+	HeaderRateLimitRemaining = "RateLimit-Remaining"
+	HeaderRateLimitReset     = "RateLimit-Reset"
+)
+
+// RateLimiter rate limits the REST calls per user and per project with the given limiter. The users are identified
+// by the subject of their JWT, set by AuthenticationAuthorizationInterceptor, else by their address. The calls not
+// authenticated here, such as the ones with an API token which is only verified by the API server, are charged to the
+// budget of their address only: the project they claim is not charged until the caller is authenticated.
+// The router resolves the operations of the calls, to weigh them.
+func RateLimiter(limiter *ratelimit.Limiter, router routers.Router) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			req := c.Request()
+			project := ""
+			subject, ok := req.Context().Value(subjectKey).(string)
+			if ok && subject != "" {
+				project, _ = tenant.GetTenantIDFromContext(req.Context())
+			} else {
+				subject = c.RealIP()
+			}
+			operationID := ""
+			if route, _, err := router.FindRoute(req); err == nil && route.Operation != nil {
+				operationID = route.Operation.OperationID
+			}
+
+			result, err := limiter.Allow(req.Context(), ratelimit.Request{
+				Subject:     subject,
+				Project:     project,
+				OperationID: operationID,
+				Write:       !isReadMethod(req.Method),
+			})
+			if err != nil {
+				// Failing to count must not make the API unavailable.
+				zlog.InfraSec().InfraErr(err).Msg("Rate limiter failed, letting the call through")
+				return next(c)
+			}
+			if result.Limit > 0 {
+				reset := strconv.Itoa(int(math.Ceil(result.Reset.Seconds())))
+				header := c.Response().Header()
+				header.Set(HeaderRateLimitLimit, strconv.Itoa(result.Limit))
+				header.Set(HeaderRateLimitRemaining, strconv.Itoa(result.Remaining))
+				header.Set(HeaderRateLimitReset, reset)
+				if !result.Allowed {
+					zlog.InfraSec().Debug().Msgf("Rate limit exceeded by %s in project %s", subject, project)
+					header.Set(echo.HeaderRetryAfter, reset)
+					return c.JSON(http.StatusTooManyRequests, nil)
+				}
+			}
+			return next(c)
+		}
+	}
+}
+
+func isReadMethod(method string) bool {
+	return method == http.MethodGet || method == http.MethodHead || method == http.MethodOptions
+}
@@ -0,0 +1,116 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package proxy_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/getkin/kin-openapi/routers/gorillamux"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-edge-platform/infra-core/apiv2/v2/internal/apitokens"
+	"github.com/open-edge-platform/infra-core/apiv2/v2/internal/proxy"
+	"github.com/open-edge-platform/infra-core/apiv2/v2/internal/ratelimit"
+	api "github.com/open-edge-platform/infra-core/apiv2/v2/pkg/api/v2"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/tenant"
+)
+
+func TestRateLimiter(t *testing.T) {
+	openAPIDefinition, err := api.GetSwagger()
+	require.NoError(t, err)
+	for _, s := range openAPIDefinition.Servers {
+		s.URL = strings.ReplaceAll(s.URL, "{apiRoot}", "")
+	}
+	router, err := gorillamux.NewRouter(openAPIDefinition)
+	require.NoError(t, err)
+	limiter, err := ratelimit.NewLimiter(ratelimit.NewMemoryStore(), ratelimit.Config{
+		Window:  time.Hour,
+		Budgets: ratelimit.Budgets{SubjectRead: 6, ProjectRead: 5},
+		Weights: map[string]int{"ListHosts": 5},
+	})
+	require.NoError(t, err)
+
+	e := echo.New()
+	handler := proxy.RateLimiter(limiter, router)(func(c echo.Context) error {
+		return c.NoContent(http.StatusOK)
+	})
+	call := func(path, realIP string) *httptest.ResponseRecorder {
+		req := httptest.NewRequestWithContext(
+			tenant.AddTenantIDToContext(context.Background(), tenantUUID), http.MethodGet, path, http.NoBody)
+		req.Header.Set(echo.HeaderXRealIP, realIP)
+		rec := httptest.NewRecorder()
+		require.NoError(t, handler(e.NewContext(req, rec)))
+		return rec
+	}
+
+	rec := call("/v1/projects/project/compute/hosts", "10.0.0.1")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "6", rec.Header().Get(proxy.HeaderRateLimitLimit))
+	assert.Equal(t, "1", rec.Header().Get(proxy.HeaderRateLimitRemaining))
+	assert.NotEmpty(t, rec.Header().Get(proxy.HeaderRateLimitReset))
+
+	rec = call("/v1/projects/project/compute/hosts/host-12345678", "10.0.0.1")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "0", rec.Header().Get(proxy.HeaderRateLimitRemaining))
+
+	rec = call("/v1/projects/project/compute/hosts", "10.0.0.1")
+	assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+	assert.NotEmpty(t, rec.Header().Get(echo.HeaderRetryAfter))
+
+	// Without authentication, the callers are told apart by their address.
+	rec = call("/v1/projects/project/compute/hosts", "10.0.0.2")
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
+
+func TestRateLimiter_APITokens(t *testing.T) {
+	t.Setenv(proxy.RbacPolicyEnvVar, "../../rego/authz.rego")
+	openAPIDefinition, err := api.GetSwagger()
+	require.NoError(t, err)
+	for _, s := range openAPIDefinition.Servers {
+		s.URL = strings.ReplaceAll(s.URL, "{apiRoot}", "")
+	}
+	router, err := gorillamux.NewRouter(openAPIDefinition)
+	require.NoError(t, err)
+	limiter, err := ratelimit.NewLimiter(ratelimit.NewMemoryStore(), ratelimit.Config{
+		Window:  time.Hour,
+		Budgets: ratelimit.Budgets{SubjectRead: 6, ProjectRead: 5},
+		Weights: map[string]int{"ListHosts": 5},
+	})
+	require.NoError(t, err)
+
+	e := echo.New()
+	handler := proxy.AuthenticationAuthorizationInterceptor(
+		proxy.RateLimiter(limiter, router)(func(c echo.Context) error {
+			return c.NoContent(http.StatusOK)
+		}))
+	call := func(tokenID, realIP string) *httptest.ResponseRecorder {
+		req := httptest.NewRequestWithContext(tenant.AddTenantIDToContext(context.Background(), tenantUUID),
+			http.MethodGet, "/v1/projects/project/compute/hosts", http.NoBody)
+		req.Header.Set("Authorization", "Bearer "+apitokens.TokenPrefix+tokenID+"_"+strings.Repeat("0", 64))
+		req.Header.Set(echo.HeaderXRealIP, realIP)
+		rec := httptest.NewRecorder()
+		require.NoError(t, handler(e.NewContext(req, rec)))
+		return rec
+	}
+
+	rec := call("0123abcd", "10.0.0.1")
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	// The API tokens are not verified by the gateway, forging a new token ID does not get a new budget.
+	rec = call("4567ef01", "10.0.0.1")
+	assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+
+	// Nor do the calls not authenticated yet draw from the budget of the project they claim.
+	rec = call("4567ef01", "10.0.0.2")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	rec = call("89abcdef", "10.0.0.3")
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
@@ -40,6 +40,7 @@ type serviceClientsSignature func(
 var servicesClients = map[string]serviceClientsSignature{
 	"RegionService":                  restv1.RegisterRegionServiceHandlerFromEndpoint,
 	"SiteService":                    restv1.RegisterSiteServiceHandlerFromEndpoint,
+	"OuService":                      restv1.RegisterOuServiceHandlerFromEndpoint,
 	"LocationService":                restv1.RegisterLocationServiceHandlerFromEndpoint,
 	"HostService":                    restv1.RegisterHostServiceHandlerFromEndpoint,
 	"OperatingSystemService":         restv1.RegisterOperatingSystemServiceHandlerFromEndpoint,
@@ -56,6 +57,16 @@ var servicesClients = map[string]serviceClientsSignature{
 	"CustomConfigService":            restv1.RegisterCustomConfigServiceHandlerFromEndpoint,
 	"OSUpdatePolicy":                 restv1.RegisterOSUpdatePolicyHandlerFromEndpoint,
 	"OSUpdateRun":                    restv1.RegisterOSUpdateRunHandlerFromEndpoint,
+	"WatchService":                   restv1.RegisterWatchServiceHandlerFromEndpoint,
+	"IPAddressService":               restv1.RegisterIPAddressServiceHandlerFromEndpoint,
+	"HostnicService":                 restv1.RegisterHostnicServiceHandlerFromEndpoint,
+	"NetworkSegmentService":          restv1.RegisterNetworkSegmentServiceHandlerFromEndpoint,
+	"EndpointService":                restv1.RegisterEndpointServiceHandlerFromEndpoint,
+	"NetlinkService":                 restv1.RegisterNetlinkServiceHandlerFromEndpoint,
+	"RemoteAccessService":            restv1.RegisterRemoteAccessServiceHandlerFromEndpoint,
+	"OperationService":               restv1.RegisterOperationServiceHandlerFromEndpoint,
+	"APITokenService":                restv1.RegisterAPITokenServiceHandlerFromEndpoint,
+	"RoleBindingService":             restv1.RegisterRoleBindingServiceHandlerFromEndpoint,
 }
 
 const (
@@ -175,6 +186,8 @@ func (m *Manager) Start() error {
 		runtime.WithMetadata(m.metadataExtractor),
 		runtime.WithRoutingErrorHandler(ginutils.HandleRoutingError),
 		runtime.WithErrorHandler(customErrorHandler),
+		// render the streamed watch events as Server-Sent Events when requested.
+		runtime.WithMarshalerOption(mimeEventStream, newSSEMarshaler()),
 	)
 
 	err := m.setupClients(mux)
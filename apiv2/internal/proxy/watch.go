@@ -0,0 +1,79 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package proxy
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/labstack/echo/v4"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	mimeEventStream = "text/event-stream"
+	watchPathSuffix = "/watch"
+)
+
+var (
+	sseDataPrefix = []byte("data: ")
+	sseDelimiter  = []byte("\n\n")
+)
+
+// sseMarshaler renders the messages of the streaming methods as Server-Sent Events, each message being the data
+// of one event. It is selected by the "Accept: text/event-stream" header of the request.
+type sseMarshaler struct {
+	runtime.JSONPb
+}
+
+func newSSEMarshaler() *sseMarshaler {
+	// Same options as the default marshaler of the gateway, used for all other responses.
+	return &sseMarshaler{JSONPb: runtime.JSONPb{
+		MarshalOptions:   protojson.MarshalOptions{EmitUnpopulated: true},
+		UnmarshalOptions: protojson.UnmarshalOptions{DiscardUnknown: true},
+	}}
+}
+
+func (m *sseMarshaler) ContentType(_ any) string {
+	return mimeEventStream
+}
+
+func (m *sseMarshaler) Marshal(v any) ([]byte, error) {
+	data, err := m.JSONPb.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return append(append([]byte{}, sseDataPrefix...), data...), nil
+}
+
+func (m *sseMarshaler) Delimiter() []byte {
+	return sseDelimiter
+}
+
+// isWatchRequest returns whether the request is a long-lived watch of the resources.
+func isWatchRequest(c echo.Context) bool {
+	return c.Request().Method == http.MethodGet && strings.HasSuffix(c.Request().URL.Path, watchPathSuffix)
+}
+
+// setWatch lifts the write deadline of the server for watch requests, which stream responses until the client
+// disconnects, and disables the caching of the Server-Sent Events.
+func (m *Manager) setWatch(e *echo.Echo) {
+	zlog.InfraSec().Info().Msg("Watch streaming is enabled")
+	e.Use(func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if !isWatchRequest(c) {
+				return next(c)
+			}
+			if err := http.NewResponseController(c.Response()).SetWriteDeadline(time.Time{}); err != nil {
+				zlog.InfraErr(err).Msg("failed to lift write deadline of watch request")
+			}
+			if strings.Contains(c.Request().Header.Get(echo.HeaderAccept), mimeEventStream) {
+				c.Response().Header().Set("Cache-Control", "no-cache")
+			}
+			return next(c)
+		}
+	})
+}
@@ -0,0 +1,213 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+// Package ratelimit limits the rate of the API calls per subject and per project, with fixed windows counters.
+// The calls cost the weight of their route, and draw from separate budgets for reads and mutations.
+// The counters are kept in a Store, which can be shared by several replicas of the API.
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/logging"
+)
+
+var zlog = logging.GetLogger("ratelimit")
+
+const (
+	DefaultWindow       = time.Minute
+	DefaultSubjectRead  = 6000
+	DefaultSubjectWrite = 1200
+	DefaultProjectRead  = 24000
+	DefaultProjectWrite = 4800
+)
+
+// Budgets are the costs allowed per window, a zero budget being unlimited.
+type Budgets struct {
+	SubjectRead  int
+	SubjectWrite int
+	ProjectRead  int
+	ProjectWrite int
+}
+
+// Config configures a Limiter.
+type Config struct {
+	// Window is the duration of the windows the budgets apply to.
+	Window  time.Duration
+	Budgets Budgets
+	// Weights are the costs of the routes, by operation ID (e.g. HostService_ListHosts) or method name
+	// (e.g. ListHosts). The routes not listed cost 1.
+	Weights map[string]int
+}
+
+// DefaultConfig returns the default configuration of a Limiter.
+func DefaultConfig() Config {
+	return Config{
+		Window: DefaultWindow,
+		Budgets: Budgets{
+			SubjectRead:  DefaultSubjectRead,
+			SubjectWrite: DefaultSubjectWrite,
+			ProjectRead:  DefaultProjectRead,
+			ProjectWrite: DefaultProjectWrite,
+		},
+	}
+}
+
+// ParseWeights parses route weights given as a comma separated list of route=weight, e.g.
+// "ListHosts=5,HostService_GetHost=1".
+func ParseWeights(s string) (map[string]int, error) {
+	weights := make(map[string]int)
+	for _, entry := range strings.Split(s, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		route, value, found := strings.Cut(entry, "=")
+		weight, err := strconv.Atoi(strings.TrimSpace(value))
+		if !found || strings.TrimSpace(route) == "" || err != nil || weight < 0 {
+			return nil, errors.Errorfc(codes.InvalidArgument, "invalid route weight %q, expected route=weight", entry)
+		}
+		weights[strings.TrimSpace(route)] = weight
+	}
+	return weights, nil
+}
+
+// Request is a call to be rate limited.
+type Request struct {
+	// Subject is who makes the call: the subject of its JWT, or its address when not authenticated.
+	Subject string
+	// Project is the project the call is made in, if any. It is only set for authenticated calls, so that callers
+	// cannot drain the budget of a project by claiming it.
+	Project string
+	// OperationID identifies the route of the call.
+	OperationID string
+	// Write is set for the calls mutating resources.
+	Write bool
+}
+
+// Result is the outcome of the rate limiting of a call, for its most constraining budget.
+type Result struct {
+	Allowed   bool
+	Limit     int
+	Remaining int
+	// Reset is the time left until the budget is renewed.
+	Reset time.Duration
+}
+
+// Limiter rate limits the calls.
+type Limiter struct {
+	store Store
+	cfg   Config
+	now   func() time.Time
+}
+
+// NewLimiter returns a limiter keeping its counters in the given store.
+func NewLimiter(store Store, cfg Config) (*Limiter, error) {
+	if cfg.Window <= 0 {
+		return nil, errors.Errorfc(codes.InvalidArgument, "the rate limit window must be positive")
+	}
+	weights := make(map[string]int, len(cfg.Weights))
+	for route, weight := range cfg.Weights {
+		weights[normalize(route)] = weight
+	}
+	cfg.Weights = weights
+	return &Limiter{store: store, cfg: cfg, now: time.Now}, nil
+}
+
+// Weight returns the cost of the route with the given operation ID.
+func (l *Limiter) Weight(operationID string) int {
+	operationID = normalize(operationID)
+	if weight, ok := l.cfg.Weights[operationID]; ok {
+		return weight
+	}
+	if weight, ok := l.cfg.Weights[methodName(operationID)]; ok {
+		return weight
+	}
+	return 1
+}
+
+// normalize drops the underscores of an operation ID, which the embedded OpenAPI definition does not have, i.e.
+// HostService_ListHosts gives HostServiceListHosts.
+func normalize(operationID string) string {
+	return strings.ReplaceAll(operationID, "_", "")
+}
+
+// methodName returns the method of a normalized operation ID, i.e. HostServiceListHosts2 gives ListHosts. The digits
+// are appended to the operation IDs of the additional bindings of a method.
+func methodName(operationID string) string {
+	_, method, found := strings.Cut(operationID, "Service")
+	if !found {
+		method = operationID
+	}
+	return strings.TrimRight(method, "0123456789")
+}
+
+// Allow counts the call against the budgets of its subject and project, and returns whether it is allowed.
+// Only the calls allowed count: the cost of a call denied by any of its budgets is given back to all of them, so
+// that the calls rejected neither drain the budget of the project nor the one of the subject.
+func (l *Limiter) Allow(ctx context.Context, req Request) (Result, error) {
+	now := l.now()
+	window := now.Truncate(l.cfg.Window)
+	result := Result{Allowed: true, Remaining: -1, Reset: window.Add(l.cfg.Window).Sub(now)}
+	cost := l.Weight(req.OperationID)
+	if cost == 0 {
+		return result, nil
+	}
+
+	class, subjectBudget, projectBudget := "read", l.cfg.Budgets.SubjectRead, l.cfg.Budgets.ProjectRead
+	if req.Write {
+		class, subjectBudget, projectBudget = "write", l.cfg.Budgets.SubjectWrite, l.cfg.Budgets.ProjectWrite
+	}
+	counters := []counter{{key: fmt.Sprintf("subject:%s:%s", class, req.Subject), limit: subjectBudget}}
+	if req.Project != "" {
+		counters = append(counters, counter{key: fmt.Sprintf("project:%s:%s", class, req.Project), limit: projectBudget})
+	}
+
+	var counted []counter
+	for _, c := range counters {
+		if c.limit == 0 {
+			continue
+		}
+		count, err := l.store.Increment(ctx, c.key, window, cost, l.cfg.Window)
+		if err != nil {
+			zlog.InfraErr(err).Msgf("failed to count call against %s", c.key)
+			l.uncount(ctx, counted, window, cost)
+			return Result{}, err
+		}
+		counted = append(counted, c)
+		remaining := max(c.limit-count, 0)
+		if result.Remaining < 0 || remaining < result.Remaining {
+			result.Limit, result.Remaining = c.limit, remaining
+		}
+		if count > c.limit {
+			result.Allowed = false
+			zlog.Debug().Msgf("Rate limit of %s exceeded: %d/%d", c.key, count, c.limit)
+			break
+		}
+	}
+	if !result.Allowed {
+		l.uncount(ctx, counted, window, cost)
+	}
+	return result, nil
+}
+
+// uncount gives the cost of a call back to the counters it was counted against.
+func (l *Limiter) uncount(ctx context.Context, counters []counter, window time.Time, cost int) {
+	for _, c := range counters {
+		if _, err := l.store.Increment(ctx, c.key, window, -cost, l.cfg.Window); err != nil {
+			zlog.InfraErr(err).Msgf("failed to uncount call against %s", c.key)
+		}
+	}
+}
+
+type counter struct {
+	key   string
+	limit int
+}
@@ -0,0 +1,133 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package ratelimit_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-edge-platform/infra-core/apiv2/v2/internal/ratelimit"
+)
+
+func newLimiter(t *testing.T, budgets ratelimit.Budgets, weights map[string]int) *ratelimit.Limiter {
+	t.Helper()
+	limiter, err := ratelimit.NewLimiter(ratelimit.NewMemoryStore(), ratelimit.Config{
+		Window:  time.Hour,
+		Budgets: budgets,
+		Weights: weights,
+	})
+	require.NoError(t, err)
+	return limiter
+}
+
+func allow(t *testing.T, limiter *ratelimit.Limiter, req ratelimit.Request) ratelimit.Result {
+	t.Helper()
+	result, err := limiter.Allow(context.Background(), req)
+	require.NoError(t, err)
+	return result
+}
+
+func TestLimiter_SubjectBudgets(t *testing.T) {
+	limiter := newLimiter(t, ratelimit.Budgets{SubjectRead: 2, SubjectWrite: 1}, nil)
+	alice := ratelimit.Request{Subject: "alice", OperationID: "HostService_GetHost"}
+
+	result := allow(t, limiter, alice)
+	assert.True(t, result.Allowed)
+	assert.Equal(t, 2, result.Limit)
+	assert.Equal(t, 1, result.Remaining)
+	assert.Positive(t, result.Reset)
+	assert.LessOrEqual(t, result.Reset, time.Hour)
+
+	assert.True(t, allow(t, limiter, alice).Allowed)
+	result = allow(t, limiter, alice)
+	assert.False(t, result.Allowed)
+	assert.Zero(t, result.Remaining)
+
+	// Mutations draw from their own budget, and other subjects from theirs.
+	assert.True(t, allow(t, limiter, ratelimit.Request{Subject: "alice", Write: true}).Allowed)
+	assert.False(t, allow(t, limiter, ratelimit.Request{Subject: "alice", Write: true}).Allowed)
+	assert.True(t, allow(t, limiter, ratelimit.Request{Subject: "bob"}).Allowed)
+}
+
+func TestLimiter_ProjectBudget(t *testing.T) {
+	limiter := newLimiter(t, ratelimit.Budgets{SubjectRead: 10, ProjectRead: 3}, nil)
+
+	for _, subject := range []string{"alice", "bob", "carol"} {
+		assert.True(t, allow(t, limiter, ratelimit.Request{Subject: subject, Project: "project-1"}).Allowed)
+	}
+	// The project is out of budget for all its users, but not the other projects.
+	result := allow(t, limiter, ratelimit.Request{Subject: "dave", Project: "project-1"})
+	assert.False(t, result.Allowed)
+	assert.Equal(t, 3, result.Limit)
+	assert.True(t, allow(t, limiter, ratelimit.Request{Subject: "dave", Project: "project-2"}).Allowed)
+}
+
+func TestLimiter_DeniedCallsDoNotCount(t *testing.T) {
+	limiter := newLimiter(t, ratelimit.Budgets{SubjectRead: 2, ProjectRead: 1}, nil)
+
+	assert.True(t, allow(t, limiter, ratelimit.Request{Subject: "alice", Project: "project-1"}).Allowed)
+	// Denied by the budget of the project, the call is not charged to the subject.
+	assert.False(t, allow(t, limiter, ratelimit.Request{Subject: "alice", Project: "project-1"}).Allowed)
+	assert.False(t, allow(t, limiter, ratelimit.Request{Subject: "alice", Project: "project-1"}).Allowed)
+	result := allow(t, limiter, ratelimit.Request{Subject: "alice", Project: "project-2"})
+	assert.True(t, result.Allowed)
+	assert.Zero(t, result.Remaining)
+
+	// Denied by the budget of the subject, the call is not charged to the project.
+	assert.False(t, allow(t, limiter, ratelimit.Request{Subject: "alice", Project: "project-3"}).Allowed)
+	assert.True(t, allow(t, limiter, ratelimit.Request{Subject: "bob", Project: "project-3"}).Allowed)
+}
+
+func TestLimiter_Weights(t *testing.T) {
+	weights, err := ratelimit.ParseWeights("ListHosts=5, HostService_GetHost=2,WatchResources=0")
+	require.NoError(t, err)
+	limiter := newLimiter(t, ratelimit.Budgets{SubjectRead: 10}, weights)
+
+	assert.Equal(t, 5, limiter.Weight("HostService_ListHosts"))
+	assert.Equal(t, 5, limiter.Weight("HostService_ListHosts2"))
+	assert.Equal(t, 2, limiter.Weight("HostService_GetHost"))
+	assert.Equal(t, 2, limiter.Weight("HostServiceGetHost"))
+	assert.Equal(t, 1, limiter.Weight("SiteService_GetSite"))
+	assert.Equal(t, 1, limiter.Weight(""))
+
+	result := allow(t, limiter, ratelimit.Request{Subject: "alice", OperationID: "HostService_ListHosts"})
+	assert.Equal(t, 5, result.Remaining)
+	result = allow(t, limiter, ratelimit.Request{Subject: "alice", OperationID: "HostService_ListHosts2"})
+	assert.True(t, result.Allowed)
+	assert.Zero(t, result.Remaining)
+	// The free routes are never limited.
+	watch := ratelimit.Request{Subject: "alice", OperationID: "WatchService_WatchResources"}
+	assert.True(t, allow(t, limiter, watch).Allowed)
+	assert.False(t, allow(t, limiter, ratelimit.Request{Subject: "alice", OperationID: "SiteService_GetSite"}).Allowed)
+
+	for _, invalid := range []string{"ListHosts", "ListHosts=x", "=5", "ListHosts=-1"} {
+		_, err := ratelimit.ParseWeights(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestLimiter_WindowReset(t *testing.T) {
+	limiter, err := ratelimit.NewLimiter(ratelimit.NewMemoryStore(), ratelimit.Config{
+		Window:  50 * time.Millisecond,
+		Budgets: ratelimit.Budgets{SubjectRead: 1},
+	})
+	require.NoError(t, err)
+
+	assert.True(t, allow(t, limiter, ratelimit.Request{Subject: "alice"}).Allowed)
+	assert.Eventually(t, func() bool {
+		return allow(t, limiter, ratelimit.Request{Subject: "alice"}).Allowed
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestNewStore(t *testing.T) {
+	store, err := ratelimit.NewStore(context.Background(), "")
+	require.NoError(t, err)
+	assert.NotNil(t, store)
+	_, err = ratelimit.NewStore(context.Background(), "redis://localhost")
+	assert.Error(t, err)
+}
@@ -0,0 +1,145 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package ratelimit
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/grpc/codes"
+
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
+)
+
+// Store keeps the counters of the rate limiter.
+type Store interface {
+	// Increment adds cost to the counter of key in the window starting at window, and returns the new count. A negative
+	// cost gives back a cost added before.
+	// The counter can be discarded once expired, i.e. ttl after the start of its window.
+	Increment(ctx context.Context, key string, window time.Time, cost int, ttl time.Duration) (int, error)
+}
+
+// NewStore returns the store at the given URL: a PostgreSQL database for postgres:// URLs, shared by all the
+// replicas using it, else the memory of this replica if the URL is empty.
+func NewStore(ctx context.Context, url string) (Store, error) {
+	switch {
+	case url == "":
+		return NewMemoryStore(), nil
+	case strings.HasPrefix(url, "postgres://"), strings.HasPrefix(url, "postgresql://"):
+		return NewPostgresStore(ctx, url)
+	default:
+		return nil, errors.Errorfc(codes.InvalidArgument, "unsupported rate limit store URL scheme")
+	}
+}
+
+// NewMemoryStore returns a store keeping the counters in memory, for a single replica.
+func NewMemoryStore() Store {
+	return &memoryStore{counters: make(map[string]*memoryCounter)}
+}
+
+type memoryCounter struct {
+	window  time.Time
+	count   int
+	expires time.Time
+}
+
+type memoryStore struct {
+	mu        sync.Mutex
+	counters  map[string]*memoryCounter
+	nextPurge time.Time
+}
+
+func (s *memoryStore) Increment(_ context.Context, key string, window time.Time, cost int, ttl time.Duration) (
+	int, error,
+) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := time.Now()
+	if now.After(s.nextPurge) {
+		for k, c := range s.counters {
+			if now.After(c.expires) {
+				delete(s.counters, k)
+			}
+		}
+		s.nextPurge = now.Add(ttl)
+	}
+
+	c, ok := s.counters[key]
+	if !ok || !c.window.Equal(window) {
+		c = &memoryCounter{window: window, expires: window.Add(ttl)}
+		s.counters[key] = c
+	}
+	c.count += cost
+	return c.count, nil
+}
+
+const (
+	createRateLimitsTable = `CREATE TABLE IF NOT EXISTS api_rate_limits (
+		key TEXT NOT NULL,
+		window_start TIMESTAMPTZ NOT NULL,
+		count BIGINT NOT NULL,
+		expires_at TIMESTAMPTZ NOT NULL,
+		PRIMARY KEY (key, window_start)
+	)`
+	incrementRateLimit = `INSERT INTO api_rate_limits (key, window_start, count, expires_at) VALUES ($1, $2, $3, $4)
+		ON CONFLICT (key, window_start) DO UPDATE SET count = api_rate_limits.count + EXCLUDED.count
+		RETURNING count`
+	purgeRateLimits = `DELETE FROM api_rate_limits WHERE expires_at < now()`
+
+	postgresPurgeInterval = time.Minute
+)
+
+type postgresStore struct {
+	pool *pgxpool.Pool
+	mu   sync.Mutex
+	// nextPurge is when the expired counters are deleted next.
+	nextPurge time.Time
+}
+
+// NewPostgresStore returns a store keeping the counters in the PostgreSQL database at the given URL, so that they are
+// shared by all the replicas using it. The table of the counters is created if missing.
+func NewPostgresStore(ctx context.Context, url string) (Store, error) {
+	pool, err := pgxpool.New(ctx, url)
+	if err != nil {
+		zlog.InfraErr(err).Msg("failed to connect to the rate limit store")
+		return nil, errors.Wrap(err)
+	}
+	if _, err := pool.Exec(ctx, createRateLimitsTable); err != nil {
+		pool.Close()
+		zlog.InfraErr(err).Msg("failed to create the rate limit table")
+		return nil, errors.Wrap(err)
+	}
+	return &postgresStore{pool: pool}, nil
+}
+
+func (s *postgresStore) Increment(ctx context.Context, key string, window time.Time, cost int, ttl time.Duration) (
+	int, error,
+) {
+	s.purge(ctx)
+	var count int64
+	err := s.pool.QueryRow(ctx, incrementRateLimit, key, window, cost, window.Add(ttl)).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err)
+	}
+	return int(count), nil
+}
+
+// purge deletes the expired counters, at most once per purge interval.
+func (s *postgresStore) purge(ctx context.Context) {
+	s.mu.Lock()
+	now := time.Now()
+	if now.Before(s.nextPurge) {
+		s.mu.Unlock()
+		return
+	}
+	s.nextPurge = now.Add(postgresPurgeInterval)
+	s.mu.Unlock()
+
+	if _, err := s.pool.Exec(ctx, purgeRateLimits); err != nil {
+		zlog.InfraErr(err).Msg("failed to purge the expired rate limit counters")
+	}
+}
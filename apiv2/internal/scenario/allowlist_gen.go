@@ -12,38 +12,60 @@ var KnownScenarios = []string{
 
 var Allowlist = map[string][]string{
 	"fulleim": {
+		"APITokenService",
 		"CustomConfigService",
+		"EndpointService",
 		"HostService",
+		"HostnicService",
+		"IPAddressService",
 		"InstanceService",
 		"LocalAccountService",
 		"LocationService",
+		"NetlinkService",
+		"NetworkSegmentService",
 		"OSUpdatePolicy",
 		"OSUpdateRun",
 		"OperatingSystemService",
+		"OperationService",
+		"OuService",
 		"ProviderService",
 		"RegionService",
+		"RemoteAccessService",
+		"RoleBindingService",
 		"ScheduleService",
 		"SiteService",
 		"TelemetryLogsGroupService",
 		"TelemetryLogsProfileService",
 		"TelemetryMetricsGroupService",
 		"TelemetryMetricsProfileService",
+		"WatchService",
 		"WorkloadMemberService",
 		"WorkloadService",
 	},
 	"noobb": {
+		"APITokenService",
 		"CustomConfigService",
+		"EndpointService",
 		"HostService",
+		"HostnicService",
+		"IPAddressService",
 		"InstanceService",
 		"LocalAccountService",
 		"LocationService",
+		"NetlinkService",
+		"NetworkSegmentService",
 		"OSUpdatePolicy",
 		"OSUpdateRun",
 		"OperatingSystemService",
+		"OperationService",
+		"OuService",
 		"ProviderService",
 		"RegionService",
+		"RemoteAccessService",
+		"RoleBindingService",
 		"ScheduleService",
 		"SiteService",
+		"WatchService",
 		"WorkloadMemberService",
 		"WorkloadService",
 	},
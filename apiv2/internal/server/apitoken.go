@@ -0,0 +1,304 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	"github.com/grpc-ecosystem/go-grpc-middleware/util/metautils"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	"github.com/open-edge-platform/infra-core/apiv2/v2/internal/apitokens"
+	apitokenv1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/resources/apitoken/v1"
+	computev1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/resources/compute/v1"
+	restv1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/services/v1"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/auditing"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/tenant"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/validator"
+)
+
+const (
+	// defaultAPITokensPageSize is the page size used when ListAPITokens does not give one.
+	defaultAPITokensPageSize = 20
+
+	activeProjectIDKey = "activeprojectid"
+	authorizationKey   = "authorization"
+
+	getSiteMethod   = "/services.v1.SiteService/GetSite"
+	listHostsMethod = "/services.v1.HostService/ListHosts"
+)
+
+// siteScopedHostMethods are the host methods allowed to the site-scoped API tokens, on the hosts of their site.
+var siteScopedHostMethods = map[string]bool{
+	"/services.v1.HostService/GetHost":        true,
+	"/services.v1.HostService/UpdateHost":     true,
+	"/services.v1.HostService/PatchHost":      true,
+	"/services.v1.HostService/DeleteHost":     true,
+	"/services.v1.HostService/InvalidateHost": true,
+}
+
+// Create an API token.
+func (is *InventorygRPCServer) CreateAPIToken(
+	ctx context.Context,
+	req *restv1.CreateAPITokenRequest,
+) (*apitokenv1.APITokenResource, error) {
+	zlog.Debug().Msg("CreateAPIToken")
+
+	if err := validator.ValidateMessage(req.GetApiToken()); err != nil {
+		zlog.InfraErr(err).Msg("Failed to validate API token")
+		return nil, errors.Wrap(err)
+	}
+	token, err := is.APITokens.Create(ctx, req.GetApiToken())
+	if err != nil {
+		zlog.InfraErr(err).Msg("failed to create API token")
+		return nil, err
+	}
+	auditAPIToken(ctx, "CreateAPIToken", token)
+	zlog.Debug().Msgf("Created API token %s", token.GetResourceId())
+	return token, nil
+}
+
+// Get a list of API tokens.
+func (is *InventorygRPCServer) ListAPITokens(
+	ctx context.Context,
+	req *restv1.ListAPITokensRequest,
+) (*restv1.ListAPITokensResponse, error) {
+	zlog.Debug().Msg("ListAPITokens")
+
+	pageSize := int(req.GetPageSize())
+	if pageSize == 0 {
+		pageSize = defaultAPITokensPageSize
+	}
+	offset := int(req.GetOffset())
+	tokens, total, err := is.APITokens.List(ctx, offset, pageSize)
+	if err != nil {
+		zlog.InfraErr(err).Msg("failed to list API tokens")
+		return nil, err
+	}
+
+	resp := &restv1.ListAPITokensResponse{
+		ApiTokens:     tokens,
+		TotalElements: int32(total), //nolint:gosec // Number of tokens.
+		HasNext:       offset+len(tokens) < total,
+	}
+	zlog.Debug().Msgf("Listed %s", redact(resp))
+	return resp, nil
+}
+
+// Revoke an API token.
+func (is *InventorygRPCServer) DeleteAPIToken(
+	ctx context.Context,
+	req *restv1.DeleteAPITokenRequest,
+) (*restv1.DeleteAPITokenResponse, error) {
+	zlog.Debug().Msg("DeleteAPIToken")
+
+	if err := is.APITokens.Revoke(ctx, req.GetResourceId()); err != nil {
+		zlog.InfraErr(err).Msgf("failed to revoke API token %s", req.GetResourceId())
+		return nil, err
+	}
+	auditAPIToken(ctx, "DeleteAPIToken", &apitokenv1.APITokenResource{ResourceId: req.GetResourceId()})
+	zlog.Debug().Msgf("Revoked API token %s", req.GetResourceId())
+	return &restv1.DeleteAPITokenResponse{}, nil
+}
+
+// auditAPIToken records an audit log for an action taken on an API token.
+func auditAPIToken(ctx context.Context, operation string, token *apitokenv1.APITokenResource) {
+	user, email := auditing.UNKNOWN, auditing.UNKNOWN
+	md := metautils.ExtractIncoming(ctx)
+	if value := md.Get(claimPreferredUsername); value != "" {
+		user = value
+	}
+	if value := md.Get(claimEmail); value != "" {
+		email = value
+	}
+	tenantID, _ := tenant.GetTenantIDFromContext(ctx)
+	zlog.InfraAuditEvent().InfraAuditOperation(operation).InfraAuditUsr(user).InfraAuditEmail(email).Info().
+		Str("tenant_id", tenantID).
+		Str("resource_id", token.GetResourceId()).
+		Str("name", token.GetName()).
+		Str("role", token.GetRole().String()).
+		Str("site_id", token.GetSiteId()).
+		Uint32("expiration_timestamp", token.GetExpirationTimestamp()).
+		Msg("API token")
+}
+
+// apiTokenFromContext returns the bearer token of the call if it is an API token.
+func apiTokenFromContext(ctx context.Context) (string, bool) {
+	scheme, token, found := strings.Cut(metautils.ExtractIncoming(ctx).Get(authorizationKey), " ")
+	if !found || !strings.EqualFold(scheme, "bearer") || !apitokens.IsAPIToken(token) {
+		return "", false
+	}
+	return token, true
+}
+
+// authenticateAPIToken verifies the API token of the call and returns the context of its tenant.
+func (is *InventorygRPCServer) authenticateAPIToken(
+	ctx context.Context,
+	token, fullMethod string,
+) (context.Context, *apitokens.Record, error) {
+	record, err := is.APITokens.Verify(token)
+	if err != nil {
+		zlog.InfraSec().InfraErr(err).Msgf("API token rejected: rejected=%s", fullMethod)
+		return nil, nil, err
+	}
+	// The project the call is made in, when given, must be the one of the token.
+	if projectID := metautils.ExtractIncoming(ctx).Get(activeProjectIDKey); projectID != "" &&
+		projectID != record.TenantID {
+		err := errors.Errorfc(codes.PermissionDenied, "API token %s is not valid in project %s",
+			record.Token.GetResourceId(), projectID)
+		zlog.InfraSec().InfraErr(err).Msgf("API token rejected: rejected=%s", fullMethod)
+		return nil, nil, err
+	}
+	if strings.HasPrefix(fullMethod, "/"+restv1.APITokenService_ServiceDesc.ServiceName+"/") {
+		err := errors.Errorfc(codes.PermissionDenied, "API tokens cannot manage API tokens")
+		zlog.InfraSec().InfraErr(err).Msgf("API token rejected: rejected=%s", fullMethod)
+		return nil, nil, err
+	}
+	if record.ReadOnly() && !isReadMethod(fullMethod) {
+		err := errors.Errorfc(codes.PermissionDenied, "API token %s only grants read access",
+			record.Token.GetResourceId())
+		zlog.InfraSec().InfraErr(err).Msgf("API token rejected: rejected=%s", fullMethod)
+		return nil, nil, err
+	}
+	ctx = tenant.AddTenantIDToContext(ctx, record.TenantID)
+	return apitokens.NewContext(ctx, record), record, nil
+}
+
+// isReadMethod returns whether the method only reads resources, as the GET requests of the REST API.
+func isReadMethod(fullMethod string) bool {
+	method := fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+	return strings.HasPrefix(method, "Get") || strings.HasPrefix(method, "List") || strings.HasPrefix(method, "Watch")
+}
+
+// authorizeSiteScope restricts the calls of the site-scoped API tokens to their site and its hosts.
+// The host listings are narrowed to the hosts of the site.
+func (is *InventorygRPCServer) authorizeSiteScope(
+	ctx context.Context,
+	record *apitokens.Record,
+	fullMethod string,
+	req any,
+) error {
+	siteID := record.Token.GetSiteId()
+	if siteID == "" {
+		return nil
+	}
+	switch {
+	case fullMethod == getSiteMethod:
+		if r, ok := req.(*restv1.GetSiteRequest); ok && r.GetResourceId() == siteID {
+			return nil
+		}
+	case fullMethod == listHostsMethod:
+		if r, ok := req.(*restv1.ListHostsRequest); ok {
+			siteFilter := fmt.Sprintf("site.resource_id = %q", siteID)
+			if r.GetFilter() == "" {
+				r.Filter = siteFilter
+			} else {
+				r.Filter = fmt.Sprintf("(%s) AND %s", r.GetFilter(), siteFilter)
+			}
+			return nil
+		}
+	case siteScopedHostMethods[fullMethod]:
+		r, ok := req.(interface{ GetResourceId() string })
+		if !ok {
+			break
+		}
+		invResp, err := is.InvClient.Get(ctx, r.GetResourceId())
+		if err != nil {
+			zlog.InfraErr(err).Msgf("failed to get inventory resource %s", r.GetResourceId())
+			return err
+		}
+		// The host must be in the site, and stay in it when the update writes its site.
+		newSiteID, writesSite := writtenHostSite(req)
+		if invResp.GetResource().GetHost().GetSite().GetResourceId() == siteID && (!writesSite || newSiteID == siteID) {
+			return nil
+		}
+	}
+	err := errors.Errorfc(codes.PermissionDenied, "API token %s is restricted to site %s",
+		record.Token.GetResourceId(), siteID)
+	zlog.InfraSec().InfraErr(err).Msgf("API token rejected: rejected=%s", fullMethod)
+	return err
+}
+
+// writtenHostSite returns the site a host update sets, and whether it writes the site at all: the PUT requests write
+// every field, the PATCH requests the fields of their mask, or the fields set in the host when the mask is empty.
+func writtenHostSite(req any) (string, bool) {
+	switch r := req.(type) {
+	case *restv1.UpdateHostRequest:
+		return r.GetHost().GetSiteId(), true
+	case *restv1.PatchHostRequest:
+		siteID := r.GetHost().GetSiteId()
+		if len(r.GetFieldMask().GetPaths()) == 0 {
+			return siteID, siteID != ""
+		}
+		return siteID, slices.Contains(r.GetFieldMask().GetPaths(), computev1.HostResourceFieldSiteId)
+	}
+	return "", false
+}
+
+func auditAPITokenCall(record *apitokens.Record, fullMethod string, err error) {
+	event := zlog.InfraAuditEvent().InfraAuditOperation(fullMethod).InfraAuditUsr(record.Actor())
+	if err != nil {
+		event = event.InfraAuditError(err)
+	}
+	event.Info().Str("tenant_id", record.TenantID).Str("token_id", record.Token.GetResourceId()).
+		Msg("Northbound API Operation")
+}
+
+// APITokenUnaryInterceptor authenticates and authorizes the calls bearing an API token, and hands the other ones
+// to next, which authenticates the JWTs.
+func (is *InventorygRPCServer) APITokenUnaryInterceptor(
+	next grpc.UnaryServerInterceptor,
+) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		token, ok := apiTokenFromContext(ctx)
+		if !ok {
+			return next(ctx, req, info, handler)
+		}
+		ctx, record, err := is.authenticateAPIToken(ctx, token, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		if err := is.authorizeSiteScope(ctx, record, info.FullMethod, req); err != nil {
+			auditAPITokenCall(record, info.FullMethod, err)
+			return nil, err
+		}
+		resp, err := handler(ctx, req)
+		auditAPITokenCall(record, info.FullMethod, err)
+		return resp, err
+	}
+}
+
+// APITokenStreamInterceptor is the streaming counterpart of APITokenUnaryInterceptor. The streams are not
+// allowed to the site-scoped API tokens.
+func (is *InventorygRPCServer) APITokenStreamInterceptor(
+	next grpc.StreamServerInterceptor,
+) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		token, ok := apiTokenFromContext(ss.Context())
+		if !ok {
+			return next(srv, ss, info, handler)
+		}
+		ctx, record, err := is.authenticateAPIToken(ss.Context(), token, info.FullMethod)
+		if err != nil {
+			return err
+		}
+		if record.Token.GetSiteId() != "" {
+			err := errors.Errorfc(codes.PermissionDenied, "API token %s is restricted to site %s",
+				record.Token.GetResourceId(), record.Token.GetSiteId())
+			auditAPITokenCall(record, info.FullMethod, err)
+			return err
+		}
+		wrapped := grpc_middleware.WrapServerStream(ss)
+		wrapped.WrappedContext = ctx
+		err = handler(srv, wrapped)
+		auditAPITokenCall(record, info.FullMethod, err)
+		return err
+	}
+}
@@ -0,0 +1,269 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package server_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/open-edge-platform/infra-core/apiv2/v2/internal/apitokens"
+	apitokenv1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/resources/apitoken/v1"
+	computev1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/resources/compute/v1"
+	restv1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/services/v1"
+	inv_server "github.com/open-edge-platform/infra-core/apiv2/v2/internal/server"
+	inv_computev1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/compute/v1"
+	inventory "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/inventory/v1"
+	inv_locationv1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/location/v1"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/client"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/tenant"
+)
+
+const apiTokenTenantID = "11111111-1111-1111-1111-111111111111"
+
+func newAPITokensTestServer(invClient client.InventoryClient) *inv_server.InventorygRPCServer {
+	return &inv_server.InventorygRPCServer{
+		InvClient: invClient,
+		APITokens: apitokens.NewManager(apitokens.NewMemoryStore()),
+	}
+}
+
+func createTestAPIToken(
+	t *testing.T,
+	server *inv_server.InventorygRPCServer,
+	role apitokenv1.APITokenRole,
+	siteID string,
+) *apitokenv1.APITokenResource {
+	t.Helper()
+	ctx := tenant.AddTenantIDToContext(context.Background(), apiTokenTenantID)
+	token, err := server.CreateAPIToken(ctx, &restv1.CreateAPITokenRequest{ApiToken: &apitokenv1.APITokenResource{
+		Name:            "ci",
+		Role:            role,
+		SiteId:          siteID,
+		DurationSeconds: 3600,
+	}})
+	require.NoError(t, err)
+	return token
+}
+
+func TestAPIToken_CRUD(t *testing.T) {
+	server := newAPITokensTestServer(newMockedInventoryTestClient())
+	ctx := tenant.AddTenantIDToContext(context.Background(), apiTokenTenantID)
+
+	_, err := server.CreateAPIToken(ctx, &restv1.CreateAPITokenRequest{ApiToken: &apitokenv1.APITokenResource{
+		Name:            "ci",
+		Role:            apitokenv1.APITokenRole_API_TOKEN_ROLE_READ,
+		DurationSeconds: 60,
+	}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	token := createTestAPIToken(t, server, apitokenv1.APITokenRole_API_TOKEN_ROLE_READ_WRITE, "")
+	assert.NotEmpty(t, token.GetToken())
+
+	resp, err := server.ListAPITokens(ctx, &restv1.ListAPITokensRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), resp.GetTotalElements())
+	assert.False(t, resp.GetHasNext())
+	require.Len(t, resp.GetApiTokens(), 1)
+	assert.Equal(t, token.GetResourceId(), resp.GetApiTokens()[0].GetResourceId())
+	assert.Empty(t, resp.GetApiTokens()[0].GetToken())
+
+	_, err = server.DeleteAPIToken(ctx, &restv1.DeleteAPITokenRequest{ResourceId: token.GetResourceId()})
+	require.NoError(t, err)
+	_, err = server.DeleteAPIToken(ctx, &restv1.DeleteAPITokenRequest{ResourceId: token.GetResourceId()})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func apiTokenContext(token string, projectID string) context.Context {
+	md := metadata.Pairs("authorization", "bearer "+token)
+	if projectID != "" {
+		md.Set("activeprojectid", projectID)
+	}
+	return metadata.NewIncomingContext(context.Background(), md)
+}
+
+//nolint:funlen // length due to test cases
+func TestAPIToken_UnaryInterceptor(t *testing.T) {
+	mockedClient := newMockedInventoryTestClient()
+	server := newAPITokensTestServer(mockedClient)
+	readToken := createTestAPIToken(t, server, apitokenv1.APITokenRole_API_TOKEN_ROLE_READ, "").GetToken()
+	writeToken := createTestAPIToken(t, server, apitokenv1.APITokenRole_API_TOKEN_ROLE_READ_WRITE, "").GetToken()
+	siteToken := createTestAPIToken(t, server, apitokenv1.APITokenRole_API_TOKEN_ROLE_READ_WRITE,
+		"site-12345678").GetToken()
+
+	hostInSite := func(siteID string) *inventory.GetResourceResponse {
+		return &inventory.GetResourceResponse{Resource: &inventory.Resource{Resource: &inventory.Resource_Host{
+			Host: &inv_computev1.HostResource{Site: &inv_locationv1.SiteResource{ResourceId: siteID}},
+		}}}
+	}
+	mockedClient.On("Get", mock.Anything, "host-00000001").Return(hostInSite("site-12345678"), nil)
+	mockedClient.On("Get", mock.Anything, "host-00000002").Return(hostInSite("site-87654321"), nil)
+
+	jwtCalled := false
+	interceptor := server.APITokenUnaryInterceptor(
+		func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+			jwtCalled = true
+			return handler(ctx, req)
+		})
+
+	testcases := map[string]struct {
+		ctx        context.Context
+		method     string
+		req        any
+		code       codes.Code
+		jwt        bool
+		wantFilter string
+	}{
+		"JWT": {
+			ctx:    metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "bearer jwt")),
+			method: "/services.v1.HostService/DeleteHost",
+			jwt:    true,
+		},
+		"ReadTokenRead": {
+			ctx:    apiTokenContext(readToken, apiTokenTenantID),
+			method: "/services.v1.HostService/ListHosts",
+			req:    &restv1.ListHostsRequest{},
+		},
+		"ReadTokenWrite": {
+			ctx:    apiTokenContext(readToken, ""),
+			method: "/services.v1.HostService/DeleteHost",
+			code:   codes.PermissionDenied,
+		},
+		"WriteTokenWrite": {
+			ctx:    apiTokenContext(writeToken, ""),
+			method: "/services.v1.HostService/DeleteHost",
+		},
+		"OtherProject": {
+			ctx:    apiTokenContext(writeToken, "22222222-2222-2222-2222-222222222222"),
+			method: "/services.v1.HostService/ListHosts",
+			code:   codes.PermissionDenied,
+		},
+		"TokenManagement": {
+			ctx:    apiTokenContext(writeToken, ""),
+			method: "/services.v1.APITokenService/CreateAPIToken",
+			code:   codes.PermissionDenied,
+		},
+		"InvalidToken": {
+			ctx:    apiTokenContext(apitokens.TokenPrefix+"invalid", ""),
+			method: "/services.v1.HostService/ListHosts",
+			code:   codes.Unauthenticated,
+		},
+		"SiteTokenListHosts": {
+			ctx:        apiTokenContext(siteToken, ""),
+			method:     "/services.v1.HostService/ListHosts",
+			req:        &restv1.ListHostsRequest{Filter: `name = "edge"`},
+			wantFilter: `(name = "edge") AND site.resource_id = "site-12345678"`,
+		},
+		"SiteTokenGetSite": {
+			ctx:    apiTokenContext(siteToken, ""),
+			method: "/services.v1.SiteService/GetSite",
+			req:    &restv1.GetSiteRequest{ResourceId: "site-12345678"},
+		},
+		"SiteTokenGetOtherSite": {
+			ctx:    apiTokenContext(siteToken, ""),
+			method: "/services.v1.SiteService/GetSite",
+			req:    &restv1.GetSiteRequest{ResourceId: "site-87654321"},
+			code:   codes.PermissionDenied,
+		},
+		"SiteTokenHostInSite": {
+			ctx:    apiTokenContext(siteToken, ""),
+			method: "/services.v1.HostService/PatchHost",
+			req:    &restv1.PatchHostRequest{ResourceId: "host-00000001"},
+		},
+		"SiteTokenUpdateHostInSite": {
+			ctx:    apiTokenContext(siteToken, ""),
+			method: "/services.v1.HostService/UpdateHost",
+			req: &restv1.UpdateHostRequest{
+				ResourceId: "host-00000001",
+				Host:       &computev1.HostResource{Name: "host", SiteId: "site-12345678"},
+			},
+		},
+		"SiteTokenUpdateHostToOtherSite": {
+			ctx:    apiTokenContext(siteToken, ""),
+			method: "/services.v1.HostService/UpdateHost",
+			req: &restv1.UpdateHostRequest{
+				ResourceId: "host-00000001",
+				Host:       &computev1.HostResource{Name: "host", SiteId: "site-87654321"},
+			},
+			code: codes.PermissionDenied,
+		},
+		"SiteTokenUpdateHostOutOfSite": {
+			ctx:    apiTokenContext(siteToken, ""),
+			method: "/services.v1.HostService/UpdateHost",
+			req: &restv1.UpdateHostRequest{
+				ResourceId: "host-00000001",
+				Host:       &computev1.HostResource{Name: "host"},
+			},
+			code: codes.PermissionDenied,
+		},
+		"SiteTokenPatchHostToOtherSite": {
+			ctx:    apiTokenContext(siteToken, ""),
+			method: "/services.v1.HostService/PatchHost",
+			req: &restv1.PatchHostRequest{
+				ResourceId: "host-00000001",
+				Host:       &computev1.HostResource{SiteId: "site-87654321"},
+			},
+			code: codes.PermissionDenied,
+		},
+		"SiteTokenPatchHostMaskedSite": {
+			ctx:    apiTokenContext(siteToken, ""),
+			method: "/services.v1.HostService/PatchHost",
+			req: &restv1.PatchHostRequest{
+				ResourceId: "host-00000001",
+				Host:       &computev1.HostResource{Name: "host"},
+				FieldMask:  &fieldmaskpb.FieldMask{Paths: []string{computev1.HostResourceFieldSiteId}},
+			},
+			code: codes.PermissionDenied,
+		},
+		"SiteTokenPatchHostName": {
+			ctx:    apiTokenContext(siteToken, ""),
+			method: "/services.v1.HostService/PatchHost",
+			req: &restv1.PatchHostRequest{
+				ResourceId: "host-00000001",
+				Host:       &computev1.HostResource{Name: "host", SiteId: "site-87654321"},
+				FieldMask:  &fieldmaskpb.FieldMask{Paths: []string{computev1.HostResourceFieldName}},
+			},
+		},
+		"SiteTokenHostInOtherSite": {
+			ctx:    apiTokenContext(siteToken, ""),
+			method: "/services.v1.HostService/GetHost",
+			req:    &restv1.GetHostRequest{ResourceId: "host-00000002"},
+			code:   codes.PermissionDenied,
+		},
+		"SiteTokenOtherResource": {
+			ctx:    apiTokenContext(siteToken, ""),
+			method: "/services.v1.RegionService/ListRegions",
+			req:    &restv1.ListRegionsRequest{},
+			code:   codes.PermissionDenied,
+		},
+	}
+
+	for tcname, tc := range testcases {
+		t.Run(tcname, func(t *testing.T) {
+			jwtCalled = false
+			var handlerTenantID string
+			_, err := interceptor(tc.ctx, tc.req, &grpc.UnaryServerInfo{FullMethod: tc.method},
+				func(ctx context.Context, _ any) (any, error) {
+					handlerTenantID, _ = tenant.GetTenantIDFromContext(ctx)
+					return nil, nil
+				})
+			assert.Equal(t, tc.code, status.Code(err))
+			assert.Equal(t, tc.jwt, jwtCalled)
+			if tc.code == codes.OK && !tc.jwt {
+				assert.Equal(t, apiTokenTenantID, handlerTenantID)
+			}
+			if tc.wantFilter != "" {
+				assert.Equal(t, tc.wantFilter, tc.req.(*restv1.ListHostsRequest).GetFilter())
+			}
+		})
+	}
+}
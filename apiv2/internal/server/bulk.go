@@ -0,0 +1,222 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/open-edge-platform/infra-core/apiv2/v2/internal/operations"
+	computev1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/resources/compute/v1"
+	operationv1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/resources/operation/v1"
+	restv1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/services/v1"
+	inv_computev1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/compute/v1"
+	inventory "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/inventory/v1"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/validator"
+)
+
+// bulkActionFunc applies a bulk action to a single resource.
+type bulkActionFunc func(ctx context.Context, resourceID string) error
+
+// Apply an action to multiple hosts.
+func (is *InventorygRPCServer) BulkHostAction(
+	ctx context.Context,
+	req *restv1.BulkHostActionRequest,
+) (*restv1.BulkHostActionResponse, error) {
+	zlog.Debug().Msgf("BulkHostAction %s", req.GetAction())
+
+	apply, err := is.hostBulkActionFunc(req)
+	if err != nil {
+		return nil, err
+	}
+	resource := &inventory.Resource{Resource: &inventory.Resource_Host{Host: &inv_computev1.HostResource{}}}
+	ids, err := is.findBulkTargets(ctx, resource, req.GetFilter(), req.GetResourceIds())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &restv1.BulkHostActionResponse{ResourceIds: ids}
+	if req.GetPreview() {
+		zlog.Debug().Msgf("Previewed %s", redact(resp))
+		return resp, nil
+	}
+	resp.Operation, err = is.startBulkAction(ctx, "BulkHostAction", ids, apply)
+	if err != nil {
+		return nil, err
+	}
+	zlog.Debug().Msgf("Started %s", resp.GetOperation())
+	return resp, nil
+}
+
+// hostBulkActionFunc returns the function applying the requested action to a single host. Each host goes through the
+// same checks as when the action is requested for that host alone.
+func (is *InventorygRPCServer) hostBulkActionFunc(req *restv1.BulkHostActionRequest) (bulkActionFunc, error) {
+	switch req.GetAction() {
+	case restv1.HostBulkAction_HOST_BULK_ACTION_ONBOARD:
+		return func(ctx context.Context, resourceID string) error {
+			_, err := is.OnboardHost(ctx, &restv1.OnboardHostRequest{ResourceId: resourceID})
+			return err
+		}, nil
+	case restv1.HostBulkAction_HOST_BULK_ACTION_INVALIDATE:
+		return func(ctx context.Context, resourceID string) error {
+			return is.invalidateHost(ctx, resourceID, req.GetNote())
+		}, nil
+	case restv1.HostBulkAction_HOST_BULK_ACTION_POWER:
+		if req.GetDesiredPowerState() == computev1.PowerState_POWER_STATE_UNSPECIFIED {
+			return nil, errors.Errorfc(codes.InvalidArgument, "desired_power_state is required by the power action")
+		}
+		host := &computev1.HostResource{DesiredPowerState: req.GetDesiredPowerState()}
+		paths := []string{computev1.HostResourceFieldDesiredPowerState}
+		if req.GetPowerCommandPolicy() != computev1.PowerCommandPolicy_POWER_COMMAND_POLICY_UNSPECIFIED {
+			host.PowerCommandPolicy = req.GetPowerCommandPolicy()
+			paths = append(paths, computev1.HostResourceFieldPowerCommandPolicy)
+		}
+		return is.patchHostFunc(host, paths), nil
+	case restv1.HostBulkAction_HOST_BULK_ACTION_AMT:
+		if req.GetDesiredAmtState() == computev1.AmtState_AMT_STATE_UNSPECIFIED {
+			return nil, errors.Errorfc(codes.InvalidArgument, "desired_amt_state is required by the AMT action")
+		}
+		host := &computev1.HostResource{DesiredAmtState: req.GetDesiredAmtState()}
+		paths := []string{computev1.HostResourceFieldDesiredAmtState}
+		if req.GetAmtControlMode() != computev1.AmtControlMode_AMT_CONTROL_MODE_UNSPECIFIED {
+			host.AmtControlMode = req.GetAmtControlMode()
+			paths = append(paths, computev1.HostResourceFieldAmtControlMode)
+		}
+		return is.patchHostFunc(host, paths), nil
+	case restv1.HostBulkAction_HOST_BULK_ACTION_DELETE:
+		return func(ctx context.Context, resourceID string) error {
+			_, err := is.DeleteHost(ctx, &restv1.DeleteHostRequest{ResourceId: resourceID})
+			return err
+		}, nil
+	default:
+		return nil, errors.Errorfc(codes.InvalidArgument, "unsupported host bulk action: %s", req.GetAction())
+	}
+}
+
+// patchHostFunc returns the function patching a single host with the given fields, as PatchHost.
+func (is *InventorygRPCServer) patchHostFunc(host *computev1.HostResource, paths []string) bulkActionFunc {
+	return func(ctx context.Context, resourceID string) error {
+		_, err := is.PatchHost(ctx, &restv1.PatchHostRequest{
+			ResourceId: resourceID,
+			Host:       host,
+			FieldMask:  &fieldmaskpb.FieldMask{Paths: paths},
+		})
+		return err
+	}
+}
+
+// Apply an action to multiple instances.
+func (is *InventorygRPCServer) BulkInstanceAction(
+	ctx context.Context,
+	req *restv1.BulkInstanceActionRequest,
+) (*restv1.BulkInstanceActionResponse, error) {
+	zlog.Debug().Msgf("BulkInstanceAction %s", req.GetAction())
+
+	var apply bulkActionFunc
+	switch req.GetAction() {
+	case restv1.InstanceBulkAction_INSTANCE_BULK_ACTION_INVALIDATE:
+		apply = func(ctx context.Context, resourceID string) error {
+			_, err := is.InvalidateInstance(ctx, &restv1.InvalidateInstanceRequest{ResourceId: resourceID})
+			return err
+		}
+	case restv1.InstanceBulkAction_INSTANCE_BULK_ACTION_DELETE:
+		apply = func(ctx context.Context, resourceID string) error {
+			_, err := is.DeleteInstance(ctx, &restv1.DeleteInstanceRequest{ResourceId: resourceID})
+			return err
+		}
+	default:
+		return nil, errors.Errorfc(codes.InvalidArgument, "unsupported instance bulk action: %s", req.GetAction())
+	}
+	resource := &inventory.Resource{Resource: &inventory.Resource_Instance{Instance: &inv_computev1.InstanceResource{}}}
+	ids, err := is.findBulkTargets(ctx, resource, req.GetFilter(), req.GetResourceIds())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &restv1.BulkInstanceActionResponse{ResourceIds: ids}
+	if req.GetPreview() {
+		zlog.Debug().Msgf("Previewed %s", redact(resp))
+		return resp, nil
+	}
+	resp.Operation, err = is.startBulkAction(ctx, "BulkInstanceAction", ids, apply)
+	if err != nil {
+		return nil, err
+	}
+	zlog.Debug().Msgf("Started %s", resp.GetOperation())
+	return resp, nil
+}
+
+// findBulkTargets returns the IDs of the resources a bulk action applies to, selected either by a filter or
+// explicitly. The explicit IDs are returned as is, an unknown ID is reported as a failure of the action.
+func (is *InventorygRPCServer) findBulkTargets(
+	ctx context.Context,
+	resource *inventory.Resource,
+	filter string,
+	resourceIDs []string,
+) ([]string, error) {
+	switch {
+	case filter != "" && len(resourceIDs) > 0:
+		return nil, errors.Errorfc(codes.InvalidArgument, "filter and resource_ids are mutually exclusive")
+	case filter == "" && len(resourceIDs) == 0:
+		return nil, errors.Errorfc(codes.InvalidArgument, "either filter or resource_ids is required")
+	case len(resourceIDs) > 0:
+		return resourceIDs, nil
+	}
+	return is.findResourceIDs(ctx, resource, filter)
+}
+
+// findResourceIDs returns the IDs of all the resources of the given kind matching the filter.
+func (is *InventorygRPCServer) findResourceIDs(
+	ctx context.Context,
+	resource *inventory.Resource,
+	filter string,
+) ([]string, error) {
+	invFilter := &inventory.ResourceFilter{Resource: resource, Filter: filter}
+	if err := validator.ValidateMessage(invFilter); err != nil {
+		zlog.InfraSec().InfraErr(err).Msg("failed to validate query params")
+		return nil, errors.Wrap(err)
+	}
+	resources, err := is.InvClient.FindAll(ctx, invFilter)
+	if err != nil {
+		zlog.InfraErr(err).Msgf("failed to find inventory resources %s", redact(invFilter))
+		return nil, errors.Wrap(err)
+	}
+	ids := make([]string, 0, len(resources))
+	for _, res := range resources {
+		ids = append(ids, res.GetResourceId())
+	}
+	return ids, nil
+}
+
+// startBulkAction starts an operation applying an action to each of the given resources in turn.
+func (is *InventorygRPCServer) startBulkAction(
+	ctx context.Context,
+	kind string,
+	resourceIDs []string,
+	apply bulkActionFunc,
+) (*operationv1.OperationResource, error) {
+	return is.Operations.Start(ctx, kind, func(ctx context.Context, tracker *operations.Tracker) error {
+		return applyBulkAction(ctx, tracker, resourceIDs, apply)
+	})
+}
+
+// applyBulkAction applies an action to each of the given resources in turn, recording the outcome of each.
+func applyBulkAction(
+	ctx context.Context,
+	tracker *operations.Tracker,
+	resourceIDs []string,
+	apply bulkActionFunc,
+) error {
+	tracker.SetTotal(len(resourceIDs))
+	for _, resourceID := range resourceIDs {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		tracker.Record(resourceID, apply(ctx, resourceID))
+	}
+	return nil
+}
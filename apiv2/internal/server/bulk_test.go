@@ -0,0 +1,225 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package server_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	computev1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/resources/compute/v1"
+	operationv1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/resources/operation/v1"
+	restv1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/services/v1"
+	inv_computev1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/compute/v1"
+	inventory "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/inventory/v1"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/client"
+)
+
+func hostGetResponse(host *inv_computev1.HostResource) *inventory.GetResourceResponse {
+	return &inventory.GetResourceResponse{
+		Resource: &inventory.Resource{Resource: &inventory.Resource_Host{Host: host}},
+	}
+}
+
+func hostUpdateResponse(resourceID string) *inventory.Resource {
+	return &inventory.Resource{
+		Resource: &inventory.Resource_Host{Host: &inv_computev1.HostResource{ResourceId: resourceID}},
+	}
+}
+
+//nolint:funlen // Table-driven test cases.
+func TestHost_BulkAction(t *testing.T) {
+	hostFilter := `site.resource_id = "site-12345678"`
+	isFieldMask := func(paths ...string) any {
+		return mock.MatchedBy(func(fm *fieldmaskpb.FieldMask) bool {
+			return assert.ElementsMatch(t, paths, fm.GetPaths())
+		})
+	}
+
+	testCases := []struct {
+		name        string
+		mocks       func(mockedClient *mock.Mock)
+		req         *restv1.BulkHostActionRequest
+		wantErr     codes.Code
+		wantIDs     []string
+		wantResults map[string]string
+	}{
+		{
+			name: "PreviewByFilter",
+			mocks: func(mockedClient *mock.Mock) {
+				mockedClient.On("FindAll", mock.Anything, mock.MatchedBy(func(filter *inventory.ResourceFilter) bool {
+					return filter.GetFilter() == hostFilter && filter.GetResource().GetHost() != nil
+				})).Return([]*client.ResourceTenantIDCarrier{
+					{ResourceId: "host-00000001"},
+					{ResourceId: "host-00000002"},
+				}, nil).Once()
+			},
+			req: &restv1.BulkHostActionRequest{
+				Action:  restv1.HostBulkAction_HOST_BULK_ACTION_DELETE,
+				Filter:  hostFilter,
+				Preview: true,
+			},
+			wantIDs: []string{"host-00000001", "host-00000002"},
+		},
+		{
+			name: "OnboardByID",
+			mocks: func(mockedClient *mock.Mock) {
+				mockedClient.On("Update", mock.Anything, "host-00000001",
+					isFieldMask(inv_computev1.HostResourceFieldDesiredState), mock.Anything).
+					Return(hostUpdateResponse("host-00000001"), nil).Once()
+				mockedClient.On("Update", mock.Anything, "host-00000002",
+					isFieldMask(inv_computev1.HostResourceFieldDesiredState), mock.Anything).
+					Return(nil, status.Error(codes.NotFound, "host not found")).Once()
+			},
+			req: &restv1.BulkHostActionRequest{
+				Action:      restv1.HostBulkAction_HOST_BULK_ACTION_ONBOARD,
+				ResourceIds: []string{"host-00000001", "host-00000002"},
+			},
+			wantIDs:     []string{"host-00000001", "host-00000002"},
+			wantResults: map[string]string{"host-00000001": "", "host-00000002": "host not found"},
+		},
+		{
+			name: "ConsecutivePowerReset",
+			mocks: func(mockedClient *mock.Mock) {
+				mockedClient.On("Get", mock.Anything, "host-00000001").Return(hostGetResponse(&inv_computev1.HostResource{
+					CurrentPowerState: inv_computev1.PowerState_POWER_STATE_RESET,
+				}), nil).Once()
+				mockedClient.On("Update", mock.Anything, "host-00000001",
+					isFieldMask(inv_computev1.HostResourceFieldDesiredPowerState),
+					mock.MatchedBy(func(res *inventory.Resource) bool {
+						return res.GetHost().GetDesiredPowerState() == inv_computev1.PowerState_POWER_STATE_RESET_REPEAT
+					})).Return(hostUpdateResponse("host-00000001"), nil).Once()
+			},
+			req: &restv1.BulkHostActionRequest{
+				Action:            restv1.HostBulkAction_HOST_BULK_ACTION_POWER,
+				ResourceIds:       []string{"host-00000001"},
+				DesiredPowerState: computev1.PowerState_POWER_STATE_RESET,
+			},
+			wantIDs:     []string{"host-00000001"},
+			wantResults: map[string]string{"host-00000001": ""},
+		},
+		{
+			name: "AmtControlModeOfProvisionedHost",
+			mocks: func(mockedClient *mock.Mock) {
+				mockedClient.On("Get", mock.Anything, "host-00000001").Return(hostGetResponse(&inv_computev1.HostResource{
+					DesiredAmtState: inv_computev1.AmtState_AMT_STATE_PROVISIONED,
+					CurrentAmtState: inv_computev1.AmtState_AMT_STATE_PROVISIONED,
+				}), nil).Once()
+				mockedClient.On("Get", mock.Anything, "host-00000002").Return(hostGetResponse(&inv_computev1.HostResource{
+					DesiredAmtState: inv_computev1.AmtState_AMT_STATE_UNPROVISIONED,
+					CurrentAmtState: inv_computev1.AmtState_AMT_STATE_UNPROVISIONED,
+				}), nil).Once()
+				mockedClient.On("Update", mock.Anything, "host-00000002", isFieldMask(
+					inv_computev1.HostResourceFieldDesiredAmtState, inv_computev1.HostResourceFieldAmtControlMode,
+				), mock.Anything).Return(hostUpdateResponse("host-00000002"), nil).Once()
+			},
+			req: &restv1.BulkHostActionRequest{
+				Action:          restv1.HostBulkAction_HOST_BULK_ACTION_AMT,
+				ResourceIds:     []string{"host-00000001", "host-00000002"},
+				DesiredAmtState: computev1.AmtState_AMT_STATE_PROVISIONED,
+				AmtControlMode:  computev1.AmtControlMode_AMT_CONTROL_MODE_ACM,
+			},
+			wantIDs: []string{"host-00000001", "host-00000002"},
+			wantResults: map[string]string{
+				"host-00000001": "cannot modify amtControlMode when host host-00000001 is already provisioned " +
+					"(desiredAmtState=AMT_STATE_PROVISIONED, currentAmtState=AMT_STATE_PROVISIONED)",
+				"host-00000002": "",
+			},
+		},
+		{
+			name: "FilterAndIDs",
+			req: &restv1.BulkHostActionRequest{
+				Action:      restv1.HostBulkAction_HOST_BULK_ACTION_DELETE,
+				Filter:      hostFilter,
+				ResourceIds: []string{"host-00000001"},
+			},
+			wantErr: codes.InvalidArgument,
+		},
+		{
+			name:    "NoTarget",
+			req:     &restv1.BulkHostActionRequest{Action: restv1.HostBulkAction_HOST_BULK_ACTION_DELETE},
+			wantErr: codes.InvalidArgument,
+		},
+		{
+			name: "PowerWithoutState",
+			req: &restv1.BulkHostActionRequest{
+				Action:      restv1.HostBulkAction_HOST_BULK_ACTION_POWER,
+				ResourceIds: []string{"host-00000001"},
+			},
+			wantErr: codes.InvalidArgument,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockedClient := newMockedInventoryTestClient()
+			if tc.mocks != nil {
+				tc.mocks(&mockedClient.Mock)
+			}
+			server := newOperationsTestServer(t, mockedClient)
+
+			resp, err := server.BulkHostAction(context.Background(), tc.req)
+			if tc.wantErr != codes.OK {
+				assert.Equal(t, tc.wantErr, status.Code(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantIDs, resp.GetResourceIds())
+			if tc.req.GetPreview() {
+				assert.Nil(t, resp.GetOperation())
+			} else {
+				server.Operations.Wait()
+				op, err := server.GetOperation(context.Background(),
+					&restv1.GetOperationRequest{ResourceId: resp.GetOperation().GetResourceId()})
+				require.NoError(t, err)
+				assert.Equal(t, operationv1.OperationState_OPERATION_STATE_SUCCEEDED, op.GetState())
+				results := make(map[string]string)
+				for _, result := range op.GetResults() {
+					results[result.GetResourceId()] = result.GetError()
+				}
+				assert.Equal(t, tc.wantResults, results)
+			}
+			mockedClient.AssertExpectations(t)
+		})
+	}
+}
+
+func TestInstance_BulkAction(t *testing.T) {
+	mockedClient := newMockedInventoryTestClient()
+	server := newOperationsTestServer(t, mockedClient)
+
+	instanceFilter := `host.site.resource_id = "site-12345678"`
+	mockedClient.On("FindAll", mock.Anything, mock.MatchedBy(func(filter *inventory.ResourceFilter) bool {
+		return filter.GetFilter() == instanceFilter && filter.GetResource().GetInstance() != nil
+	})).Return([]*client.ResourceTenantIDCarrier{{ResourceId: "inst-00000001"}}, nil).Once()
+	mockedClient.On("List", mock.Anything, mock.MatchedBy(func(filter *inventory.ResourceFilter) bool {
+		return filter.GetResource().GetOsUpdateRun() != nil
+	})).Return(&inventory.ListResourcesResponse{}, nil).Once()
+	mockedClient.On("Delete", mock.Anything, "inst-00000001").Return(&inventory.DeleteResourceResponse{}, nil).Once()
+
+	resp, err := server.BulkInstanceAction(context.Background(), &restv1.BulkInstanceActionRequest{
+		Action: restv1.InstanceBulkAction_INSTANCE_BULK_ACTION_DELETE,
+		Filter: instanceFilter,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"inst-00000001"}, resp.GetResourceIds())
+	server.Operations.Wait()
+	mockedClient.AssertExpectations(t)
+
+	op, err := server.GetOperation(context.Background(),
+		&restv1.GetOperationRequest{ResourceId: resp.GetOperation().GetResourceId()})
+	require.NoError(t, err)
+	assert.Equal(t, uint32(1), op.GetSucceeded())
+
+	_, err = server.BulkInstanceAction(context.Background(), &restv1.BulkInstanceActionRequest{
+		ResourceIds: []string{"inst-00000001"},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -6,6 +6,9 @@ package server
 import (
 	"context"
 
+	"golang.org/x/exp/maps"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
 	customconfigv1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/resources/customconfig/v1"
 	restv1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/services/v1"
 	inv_computev1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/compute/v1"
@@ -14,6 +17,15 @@ import (
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/validator"
 )
 
+// OpenAPICustomConfigToProto maps OpenAPI fields name to Proto fields name.
+// The key is derived from the json property respectively of the
+// structs CustomConfig defined in edge-infra-manager-openapi-types.gen.go.
+var OpenAPICustomConfigToProto = map[string]string{
+	customconfigv1.CustomConfigResourceFieldName:        inv_computev1.CustomConfigResourceFieldName,
+	customconfigv1.CustomConfigResourceFieldDescription: inv_computev1.CustomConfigResourceFieldDescription,
+	customconfigv1.CustomConfigResourceFieldConfig:      inv_computev1.CustomConfigResourceFieldConfig,
+}
+
 func toInvCustomConfig(customConfig *customconfigv1.CustomConfigResource) (*inv_computev1.CustomConfigResource, error) {
 	if customConfig == nil {
 		return &inv_computev1.CustomConfigResource{}, nil
@@ -73,7 +85,7 @@ func (is *InventorygRPCServer) CreateCustomConfig(
 	}
 
 	customConfigCreated := fromInvCustomConfig(invResp.GetCustomConfig())
-	zlog.Debug().Msgf("Created %s", customConfigCreated)
+	zlog.Debug().Msgf("Created %s", redact(customConfigCreated))
 	return customConfigCreated, nil
 }
 
@@ -115,7 +127,7 @@ func (is *InventorygRPCServer) ListCustomConfigs(
 		TotalElements: invResp.GetTotalElements(),
 		HasNext:       invResp.GetHasNext(),
 	}
-	zlog.Debug().Msgf("Listed %s", resp)
+	zlog.Debug().Msgf("Listed %s", redact(resp))
 	return resp, nil
 }
 
@@ -133,10 +145,76 @@ func (is *InventorygRPCServer) GetCustomConfig(
 
 	invCustomConfig := invResp.GetResource().GetCustomConfig()
 	customConfig := fromInvCustomConfig(invCustomConfig)
-	zlog.Debug().Msgf("Got %s", customConfig)
+	zlog.Debug().Msgf("Got %s", redact(customConfig))
 	return customConfig, nil
 }
 
+// Update a custom config. (PUT).
+func (is *InventorygRPCServer) UpdateCustomConfig(
+	ctx context.Context,
+	req *restv1.UpdateCustomConfigRequest,
+) (*customconfigv1.CustomConfigResource, error) {
+	zlog.Debug().Msg("UpdateCustomConfig")
+
+	invCustomConfig, err := toInvCustomConfig(req.GetCustomConfig())
+	if err != nil {
+		zlog.InfraErr(err).Msg("Failed to convert to inventory custom config")
+		return nil, errors.Wrap(err)
+	}
+
+	fieldmask, err := fieldmaskpb.New(invCustomConfig, maps.Values(OpenAPICustomConfigToProto)...)
+	if err != nil {
+		zlog.InfraErr(err).Msg("Failed to create field mask")
+		return nil, errors.Wrap(err)
+	}
+
+	invRes := &inventory.Resource{
+		Resource: &inventory.Resource_CustomConfig{
+			CustomConfig: invCustomConfig,
+		},
+	}
+	upRes, err := is.InvClient.Update(ctx, req.GetResourceId(), fieldmask, invRes)
+	if err != nil {
+		zlog.InfraErr(err).Msgf("failed to update inventory resource %s %s", req.GetResourceId(), redact(invRes))
+		return nil, errors.Wrap(err)
+	}
+	invUpRes := fromInvCustomConfig(upRes.GetCustomConfig())
+	zlog.Debug().Msgf("Updated %s", redact(invUpRes))
+	return invUpRes, nil
+}
+
+// Update a custom config. (PATCH).
+func (is *InventorygRPCServer) PatchCustomConfig(
+	ctx context.Context,
+	req *restv1.PatchCustomConfigRequest,
+) (*customconfigv1.CustomConfigResource, error) {
+	zlog.Debug().Msg("PatchCustomConfig")
+
+	invCustomConfig, err := toInvCustomConfig(req.GetCustomConfig())
+	if err != nil {
+		zlog.InfraErr(err).Msg("Failed to convert to inventory custom config")
+		return nil, errors.Wrap(err)
+	}
+
+	fieldmask, err := parseFielmask(invCustomConfig, req.GetFieldMask(), OpenAPICustomConfigToProto)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	invRes := &inventory.Resource{
+		Resource: &inventory.Resource_CustomConfig{
+			CustomConfig: invCustomConfig,
+		},
+	}
+	upRes, err := is.InvClient.Update(ctx, req.GetResourceId(), fieldmask, invRes)
+	if err != nil {
+		zlog.InfraErr(err).Msgf("failed to update inventory resource %s %s", req.GetResourceId(), redact(invRes))
+		return nil, errors.Wrap(err)
+	}
+	invUpRes := fromInvCustomConfig(upRes.GetCustomConfig())
+	zlog.Debug().Msgf("Updated %s", redact(invUpRes))
+	return invUpRes, nil
+}
+
 func (is *InventorygRPCServer) DeleteCustomConfig(
 	ctx context.Context,
 	req *restv1.DeleteCustomConfigRequest,
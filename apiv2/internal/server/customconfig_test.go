@@ -6,10 +6,12 @@ package server_test
 import (
 	"context"
 	"errors"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 
 	customconfigv1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/resources/customconfig/v1"
 	restv1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/services/v1"
@@ -291,3 +293,164 @@ func TestCustomConfig_Delete(t *testing.T) {
 		})
 	}
 }
+
+func TestCustomConfig_Update(t *testing.T) {
+	mockedClient := newMockedInventoryTestClient()
+	server := inv_server.InventorygRPCServer{InvClient: mockedClient}
+
+	cases := []struct {
+		name    string
+		mocks   func() []*mock.Call
+		ctx     context.Context
+		req     *restv1.UpdateCustomConfigRequest
+		wantErr bool
+	}{
+		{
+			name: "Update CustomConfig",
+			mocks: func() []*mock.Call {
+				return []*mock.Call{
+					mockedClient.On("Update", mock.Anything, "customconfig-1234",
+						mock.MatchedBy(func(fm *fieldmaskpb.FieldMask) bool {
+							return assert.ElementsMatch(t, []string{"name", "description", "config"}, fm.GetPaths())
+						}), mock.Anything).
+						Return(&inventory.Resource{
+							Resource: &inventory.Resource_CustomConfig{
+								CustomConfig: exampleInvCustomConfig,
+							},
+						}, nil).Once(),
+				}
+			},
+			ctx: context.Background(),
+			req: &restv1.UpdateCustomConfigRequest{
+				ResourceId:   "customconfig-1234",
+				CustomConfig: exampleAPICustomConfig,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Update CustomConfig with invalid resource",
+			ctx:  context.Background(),
+			req: &restv1.UpdateCustomConfigRequest{
+				ResourceId:   "customconfig-1234",
+				CustomConfig: &customconfigv1.CustomConfigResource{Name: strings.Repeat("a", 41)},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Update CustomConfig with error",
+			mocks: func() []*mock.Call {
+				return []*mock.Call{
+					mockedClient.On("Update", mock.Anything, "customconfig-1234", mock.Anything, mock.Anything).
+						Return(nil, errors.New("error")).Once(),
+				}
+			},
+			ctx: context.Background(),
+			req: &restv1.UpdateCustomConfigRequest{
+				ResourceId:   "customconfig-1234",
+				CustomConfig: exampleAPICustomConfig,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.mocks != nil {
+				tc.mocks()
+			}
+
+			reply, err := server.UpdateCustomConfig(tc.ctx, tc.req)
+			if tc.wantErr {
+				if err == nil {
+					t.Errorf("UpdateCustomConfig() got err = nil, want err")
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("UpdateCustomConfig() got err = %v, want nil", err)
+				return
+			}
+			if reply == nil {
+				t.Errorf("UpdateCustomConfig() got reply = nil, want non-nil")
+				return
+			}
+			assert.Equal(t, exampleAPICustomConfig.GetResourceId(), reply.GetResourceId())
+		})
+	}
+}
+
+func TestCustomConfig_Patch(t *testing.T) {
+	mockedClient := newMockedInventoryTestClient()
+	server := inv_server.InventorygRPCServer{InvClient: mockedClient}
+
+	cases := []struct {
+		name    string
+		mocks   func() []*mock.Call
+		ctx     context.Context
+		req     *restv1.PatchCustomConfigRequest
+		wantErr bool
+	}{
+		{
+			name: "Patch CustomConfig",
+			mocks: func() []*mock.Call {
+				return []*mock.Call{
+					mockedClient.On("Update", mock.Anything, "customconfig-1234",
+						mock.MatchedBy(func(fm *fieldmaskpb.FieldMask) bool {
+							return assert.ElementsMatch(t, []string{"config"}, fm.GetPaths())
+						}), mock.Anything).
+						Return(&inventory.Resource{
+							Resource: &inventory.Resource_CustomConfig{
+								CustomConfig: exampleInvCustomConfig,
+							},
+						}, nil).Once(),
+				}
+			},
+			ctx: context.Background(),
+			req: &restv1.PatchCustomConfigRequest{
+				ResourceId:   "customconfig-1234",
+				CustomConfig: exampleAPICustomConfig,
+				FieldMask:    &fieldmaskpb.FieldMask{Paths: []string{"config", "resource_id"}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Patch CustomConfig with error",
+			mocks: func() []*mock.Call {
+				return []*mock.Call{
+					mockedClient.On("Update", mock.Anything, "customconfig-1234", mock.Anything, mock.Anything).
+						Return(nil, errors.New("error")).Once(),
+				}
+			},
+			ctx: context.Background(),
+			req: &restv1.PatchCustomConfigRequest{
+				ResourceId:   "customconfig-1234",
+				CustomConfig: exampleAPICustomConfig,
+				FieldMask:    &fieldmaskpb.FieldMask{Paths: []string{"config", "resource_id"}},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.mocks != nil {
+				tc.mocks()
+			}
+
+			reply, err := server.PatchCustomConfig(tc.ctx, tc.req)
+			if tc.wantErr {
+				if err == nil {
+					t.Errorf("PatchCustomConfig() got err = nil, want err")
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("PatchCustomConfig() got err = %v, want nil", err)
+				return
+			}
+			if reply == nil {
+				t.Errorf("PatchCustomConfig() got reply = nil, want non-nil")
+				return
+			}
+			assert.Equal(t, exampleAPICustomConfig.GetResourceId(), reply.GetResourceId())
+		})
+	}
+}
@@ -0,0 +1,10 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+// Redact exposes redact to the tests of the package.
+var Redact = redact
+
+// ForwardScopedJWTInterceptor exposes forwardScopedJWTInterceptor to the tests of the package.
+var ForwardScopedJWTInterceptor = forwardScopedJWTInterceptor
@@ -0,0 +1,175 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"slices"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	computev1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/resources/compute/v1"
+	locationv1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/resources/location/v1"
+	osv1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/resources/os/v1"
+	restv1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/services/v1"
+	inv_computev1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/compute/v1"
+	inv_locationv1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/location/v1"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
+)
+
+// resourceIDField is returned whatever the requested fields.
+const resourceIDField = "resource_id"
+
+// relatedResources lists, for each resource supporting views, the fields holding other resources. They are left out
+// of the BASIC view, the other fields such as the timestamps or the metadata belong to the resource itself.
+var relatedResources = map[protoreflect.FullName][]string{
+	(&computev1.HostResource{}).ProtoReflect().Descriptor().FullName(): {
+		computev1.HostResourceEdgeSite,
+		computev1.HostResourceEdgeProvider,
+		computev1.HostResourceEdgeHostStorages,
+		computev1.HostResourceEdgeHostNics,
+		computev1.HostResourceEdgeHostUsbs,
+		computev1.HostResourceEdgeHostGpus,
+		computev1.HostResourceEdgeInstance,
+	},
+	(&computev1.InstanceResource{}).ProtoReflect().Descriptor().FullName(): {
+		computev1.InstanceResourceEdgeHost,
+		computev1.InstanceResourceEdgeOs,
+		computev1.InstanceResourceEdgeWorkloadMembers,
+		computev1.InstanceResourceEdgeLocalaccount,
+		computev1.InstanceResourceEdgeUpdatePolicy,
+		computev1.InstanceResourceEdgeCustomConfig,
+	},
+	(&locationv1.SiteResource{}).ProtoReflect().Descriptor().FullName(): {
+		locationv1.SiteResourceEdgeRegion,
+		locationv1.SiteResourceEdgeOu,
+		locationv1.SiteResourceEdgeProvider,
+	},
+	(&osv1.OperatingSystemResource{}).ProtoReflect().Descriptor().FullName(): {},
+}
+
+// invEdges lists, for the resources listed with a read mask sent to Inventory, the edges of the Inventory resource
+// with the fields of the resource they fill. Inventory only loads the edges of the requested fields, see the
+// read_mask of ResourceFilter.
+var invEdges = map[protoreflect.FullName]map[string][]string{
+	(&computev1.HostResource{}).ProtoReflect().Descriptor().FullName(): {
+		inv_computev1.HostResourceEdgeSite:         {computev1.HostResourceEdgeSite, computev1.HostResourceFieldSiteId},
+		inv_computev1.HostResourceEdgeProvider:     {computev1.HostResourceEdgeProvider},
+		inv_computev1.HostResourceEdgeHostStorages: {computev1.HostResourceEdgeHostStorages},
+		inv_computev1.HostResourceEdgeHostNics:     {computev1.HostResourceEdgeHostNics},
+		inv_computev1.HostResourceEdgeHostUsbs:     {computev1.HostResourceEdgeHostUsbs},
+		inv_computev1.HostResourceEdgeHostGpus:     {computev1.HostResourceEdgeHostGpus},
+		inv_computev1.HostResourceEdgeInstance:     {computev1.HostResourceEdgeInstance},
+	},
+	(&computev1.InstanceResource{}).ProtoReflect().Descriptor().FullName(): {
+		inv_computev1.InstanceResourceEdgeHost: {
+			computev1.InstanceResourceEdgeHost,
+			computev1.InstanceResourceFieldHostID,
+		},
+		inv_computev1.InstanceResourceEdgeOs: {
+			computev1.InstanceResourceEdgeOs,
+			computev1.InstanceResourceFieldOsID,
+		},
+		inv_computev1.InstanceResourceEdgeWorkloadMembers: {computev1.InstanceResourceEdgeWorkloadMembers},
+		inv_computev1.InstanceResourceEdgeLocalaccount: {
+			computev1.InstanceResourceEdgeLocalaccount,
+			computev1.InstanceResourceFieldLocalAccountID,
+		},
+		inv_computev1.InstanceResourceEdgeOsUpdatePolicy: {computev1.InstanceResourceEdgeUpdatePolicy},
+	},
+	(&locationv1.SiteResource{}).ProtoReflect().Descriptor().FullName(): {
+		inv_locationv1.SiteResourceEdgeRegion: {locationv1.SiteResourceEdgeRegion, locationv1.SiteResourceFieldRegionId},
+		inv_locationv1.SiteResourceEdgeOu:     {locationv1.SiteResourceEdgeOu, locationv1.SiteResourceFieldOuId},
+	},
+}
+
+// readMask returns the top-level fields of the given resource to return for the requested view and fields, a nil mask
+// meaning all the fields. The paths of the returned mask are field names, the requested fields may also be given by
+// their JSON name.
+func readMask(
+	resource proto.Message,
+	view restv1.ResourceView,
+	fields *fieldmaskpb.FieldMask,
+) (*fieldmaskpb.FieldMask, error) {
+	desc := resource.ProtoReflect().Descriptor().Fields()
+	if len(fields.GetPaths()) > 0 {
+		if view != restv1.ResourceView_RESOURCE_VIEW_UNSPECIFIED {
+			zlog.InfraSec().InfraError("fields and view %s are mutually exclusive", view).Msg("")
+			return nil, errors.Errorfc(codes.InvalidArgument, "fields and view %s are mutually exclusive", view)
+		}
+		mask := &fieldmaskpb.FieldMask{Paths: make([]string, 0, len(fields.GetPaths()))}
+		for _, path := range fields.GetPaths() {
+			field := desc.ByName(protoreflect.Name(path))
+			if field == nil {
+				field = desc.ByJSONName(path)
+			}
+			if field == nil {
+				zlog.InfraSec().InfraError("invalid field %s", path).Msg("")
+				return nil, errors.Errorfc(codes.InvalidArgument, "invalid field %s", path)
+			}
+			mask.Paths = append(mask.Paths, string(field.Name()))
+		}
+		return mask, nil
+	}
+
+	switch view {
+	case restv1.ResourceView_RESOURCE_VIEW_UNSPECIFIED, restv1.ResourceView_RESOURCE_VIEW_FULL:
+		return nil, nil
+	case restv1.ResourceView_RESOURCE_VIEW_BASIC:
+		related, ok := relatedResources[resource.ProtoReflect().Descriptor().FullName()]
+		if !ok {
+			zlog.InfraSec().InfraError("view %s is not supported", view).Msg("")
+			return nil, errors.Errorfc(codes.InvalidArgument, "view %s is not supported", view)
+		}
+		mask := &fieldmaskpb.FieldMask{}
+		for i := range desc.Len() {
+			if name := string(desc.Get(i).Name()); !slices.Contains(related, name) {
+				mask.Paths = append(mask.Paths, name)
+			}
+		}
+		return mask, nil
+	default:
+		zlog.InfraSec().InfraError("unsupported view %s", view).Msg("")
+		return nil, errors.Errorfc(codes.InvalidArgument, "unsupported view %s", view)
+	}
+}
+
+// inReadMask reports whether the given field is requested by the read mask. All the fields are requested when the
+// mask is unset.
+func inReadMask(mask *fieldmaskpb.FieldMask, field string) bool {
+	return mask == nil || field == resourceIDField || slices.Contains(mask.GetPaths(), field)
+}
+
+// applyReadMask clears the top-level fields of the resource that are not requested by the read mask.
+func applyReadMask(resource proto.Message, mask *fieldmaskpb.FieldMask) {
+	if mask == nil {
+		return
+	}
+	msg := resource.ProtoReflect()
+	msg.Range(func(field protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		if !inReadMask(mask, string(field.Name())) {
+			msg.Clear(field)
+		}
+		return true
+	})
+}
+
+// invReadMask returns the read mask to list the Inventory resources of the given resource with, so that Inventory
+// only loads the edges filling the fields requested by the read mask. A nil mask loads all the edges.
+func invReadMask(resource proto.Message, mask *fieldmaskpb.FieldMask) *fieldmaskpb.FieldMask {
+	edges, ok := invEdges[resource.ProtoReflect().Descriptor().FullName()]
+	if mask == nil || !ok {
+		return nil
+	}
+	invMask := &fieldmaskpb.FieldMask{Paths: []string{}}
+	for edge, fields := range edges {
+		if slices.ContainsFunc(fields, func(field string) bool { return inReadMask(mask, field) }) {
+			invMask.Paths = append(invMask.Paths, edge)
+		}
+	}
+	slices.Sort(invMask.Paths)
+	return invMask
+}
@@ -12,10 +12,12 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/protobuf/types/known/fieldmaskpb"
 
+	"github.com/open-edge-platform/infra-core/apiv2/v2/internal/operations"
 	commonv1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/resources/common/v1"
 	computev1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/resources/compute/v1"
 	locationv1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/resources/location/v1"
 	networkv1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/resources/network/v1"
+	operationv1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/resources/operation/v1"
 	providerv1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/resources/provider/v1"
 	statusv1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/resources/status/v1"
 	restv1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/services/v1"
@@ -399,6 +401,8 @@ func fromInvHostNics(
 			Type: computev1.LinkState(nic.GetLinkState()),
 		}
 		hostNics = append(hostNics, &computev1.HostnicResource{
+			ResourceId:    nic.GetResourceId(),
+			HostId:        nic.GetHost().GetResourceId(),
 			DeviceName:    nic.GetDeviceName(),
 			PciIdentifier: nic.GetPciIdentifier(),
 			MacAddr:       nic.GetMacAddr(),
@@ -472,7 +476,7 @@ func (is *InventorygRPCServer) CreateHost(
 
 	invResp, err := is.InvClient.Create(ctx, invRes)
 	if err != nil {
-		zlog.InfraErr(err).Msgf("failed to create inventory resource %s", invRes)
+		zlog.InfraErr(err).Msgf("failed to create inventory resource %s", redact(invRes))
 		return nil, errors.Wrap(err)
 	}
 
@@ -480,7 +484,7 @@ func (is *InventorygRPCServer) CreateHost(
 	if err != nil {
 		return nil, errors.Wrap(err)
 	}
-	zlog.Debug().Msgf("Created %s", hostCreated)
+	zlog.Debug().Msgf("Created %s", redact(hostCreated))
 	return hostCreated, nil
 }
 
@@ -491,12 +495,18 @@ func (is *InventorygRPCServer) ListHosts(
 ) (*restv1.ListHostsResponse, error) {
 	zlog.Debug().Msg("ListHosts")
 
+	mask, err := readMask(&computev1.HostResource{}, req.GetView(), req.GetFields())
+	if err != nil {
+		return nil, err
+	}
+
 	filter := &inventory.ResourceFilter{
 		Resource: &inventory.Resource{Resource: &inventory.Resource_Host{Host: &inv_computev1.HostResource{}}},
 		Offset:   req.GetOffset(),
 		Limit:    req.GetPageSize(),
 		OrderBy:  req.GetOrderBy(),
 		Filter:   req.GetFilter(),
+		ReadMask: invReadMask(&computev1.HostResource{}, mask),
 	}
 
 	if err := validator.ValidateMessage(filter); err != nil {
@@ -506,24 +516,29 @@ func (is *InventorygRPCServer) ListHosts(
 
 	invResp, err := is.InvClient.List(ctx, filter)
 	if err != nil {
-		zlog.InfraErr(err).Msgf("failed to list inventory resources %s", filter)
+		zlog.InfraErr(err).Msgf("failed to list inventory resources %s", redact(filter))
 		return nil, errors.Wrap(err)
 	}
 
 	invResources := invResp.GetResources()
 	hosts := make([]*computev1.HostResource, 0, len(invResources))
 	for _, invRes := range invResources {
-		nicToIPAddresses, err := is.getInterfaceToIPAddresses(ctx, invRes.GetResource().GetHost())
-		if err != nil {
-			zlog.Error().Err(err).Msgf("failed to get IP addresses for host %s",
-				invRes.GetResource().GetHost().GetResourceId())
-			return nil, err
+		// The IP addresses are only looked up when the NICs are requested.
+		var nicToIPAddresses map[string][]*networkv1.IPAddressResource
+		if inReadMask(mask, computev1.HostResourceEdgeHostNics) {
+			nicToIPAddresses, err = is.getInterfaceToIPAddresses(ctx, invRes.GetResource().GetHost())
+			if err != nil {
+				zlog.Error().Err(err).Msgf("failed to get IP addresses for host %s",
+					invRes.GetResource().GetHost().GetResourceId())
+				return nil, err
+			}
 		}
 
 		host, err := fromInvHost(invRes.GetResource().GetHost(), invRes.GetRenderedMetadata(), nicToIPAddresses)
 		if err != nil {
 			return nil, errors.Wrap(err)
 		}
+		applyReadMask(host, mask)
 		hosts = append(hosts, host)
 	}
 
@@ -532,7 +547,7 @@ func (is *InventorygRPCServer) ListHosts(
 		TotalElements: invResp.GetTotalElements(),
 		HasNext:       invResp.GetHasNext(),
 	}
-	zlog.Debug().Msgf("Listed %s", resp)
+	zlog.Debug().Msgf("Listed %s", redact(resp))
 	return resp, nil
 }
 
@@ -540,6 +555,11 @@ func (is *InventorygRPCServer) ListHosts(
 func (is *InventorygRPCServer) GetHost(ctx context.Context, req *restv1.GetHostRequest) (*computev1.HostResource, error) {
 	zlog.Debug().Msg("GetHost")
 
+	mask, err := readMask(&computev1.HostResource{}, req.GetView(), req.GetFields())
+	if err != nil {
+		return nil, err
+	}
+
 	invResp, err := is.InvClient.Get(ctx, req.GetResourceId())
 	if err != nil {
 		zlog.InfraErr(err).Msgf("failed to get inventory resource %s", req.GetResourceId())
@@ -547,18 +567,22 @@ func (is *InventorygRPCServer) GetHost(ctx context.Context, req *restv1.GetHostR
 	}
 
 	invHost := invResp.GetResource().GetHost()
-	nicToIPAddresses, err := is.getInterfaceToIPAddresses(ctx, invHost)
-	if err != nil {
-		zlog.Error().Err(err).Msgf("failed to get IP addresses for host %s",
-			invHost.GetResourceId())
-		return nil, errors.Wrap(err)
+	var nicToIPAddresses map[string][]*networkv1.IPAddressResource
+	if inReadMask(mask, computev1.HostResourceEdgeHostNics) {
+		nicToIPAddresses, err = is.getInterfaceToIPAddresses(ctx, invHost)
+		if err != nil {
+			zlog.Error().Err(err).Msgf("failed to get IP addresses for host %s",
+				invHost.GetResourceId())
+			return nil, errors.Wrap(err)
+		}
 	}
 
 	host, err := fromInvHost(invHost, invResp.GetRenderedMetadata(), nicToIPAddresses)
 	if err != nil {
 		return nil, errors.Wrap(err)
 	}
-	zlog.Debug().Msgf("Got %s", host)
+	applyReadMask(host, mask)
+	zlog.Debug().Msgf("Got %s", redact(host))
 	return host, nil
 }
 
@@ -683,7 +707,7 @@ func (is *InventorygRPCServer) UpdateHost(
 	}
 	upRes, err := is.InvClient.Update(ctx, req.GetResourceId(), fieldmask, invRes)
 	if err != nil {
-		zlog.InfraErr(err).Msgf("failed to update inventory resource %s %s", req.GetResourceId(), invRes)
+		zlog.InfraErr(err).Msgf("failed to update inventory resource %s %s", req.GetResourceId(), redact(invRes))
 		return nil, err
 	}
 	invUp := upRes.GetHost()
@@ -692,7 +716,7 @@ func (is *InventorygRPCServer) UpdateHost(
 		return nil, errors.Wrap(err)
 	}
 
-	zlog.Debug().Msgf("Updated %s", invUpRes)
+	zlog.Debug().Msgf("Updated %s", redact(invUpRes))
 	return invUpRes, nil
 }
 
@@ -729,7 +753,7 @@ func (is *InventorygRPCServer) PatchHost(
 	}
 	upRes, err := is.InvClient.Update(ctx, req.GetResourceId(), fieldmask, invRes)
 	if err != nil {
-		zlog.InfraErr(err).Msgf("failed to update inventory resource %s %s", req.GetResourceId(), invRes)
+		zlog.InfraErr(err).Msgf("failed to update inventory resource %s %s", req.GetResourceId(), redact(invRes))
 		return nil, errors.Wrap(err)
 	}
 	invUp := upRes.GetHost()
@@ -738,7 +762,7 @@ func (is *InventorygRPCServer) PatchHost(
 		return nil, errors.Wrap(err)
 	}
 
-	zlog.Debug().Msgf("Updated %s", invUpRes)
+	zlog.Debug().Msgf("Updated %s", redact(invUpRes))
 	return invUpRes, nil
 }
 
@@ -764,11 +788,49 @@ func (is *InventorygRPCServer) InvalidateHost(
 	req *restv1.InvalidateHostRequest,
 ) (*restv1.InvalidateHostResponse, error) {
 	zlog.Debug().Msg("InvalidateHost")
+	if err := is.invalidateHost(ctx, req.GetResourceId(), req.GetNote()); err != nil {
+		return nil, err
+	}
+	zlog.Debug().Msgf("Invalidated %s", req.GetResourceId())
+	return &restv1.InvalidateHostResponse{}, nil
+}
+
+// Invalidate all the hosts matching a filter, in the background.
+func (is *InventorygRPCServer) InvalidateHosts(
+	ctx context.Context,
+	req *restv1.InvalidateHostsRequest,
+) (*operationv1.OperationResource, error) {
+	zlog.Debug().Msg("InvalidateHosts")
+
+	resource := &inventory.Resource{Resource: &inventory.Resource_Host{Host: &inv_computev1.HostResource{}}}
+	if err := validator.ValidateMessage(&inventory.ResourceFilter{Resource: resource, Filter: req.GetFilter()}); err != nil {
+		zlog.InfraSec().InfraErr(err).Msg("failed to validate query params")
+		return nil, errors.Wrap(err)
+	}
+
+	// The hosts are looked up by the operation, as finding them may be expensive too.
+	op, err := is.Operations.Start(ctx, "InvalidateHosts", func(ctx context.Context, tracker *operations.Tracker) error {
+		ids, err := is.findResourceIDs(ctx, resource, req.GetFilter())
+		if err != nil {
+			return err
+		}
+		return applyBulkAction(ctx, tracker, ids, func(ctx context.Context, resourceID string) error {
+			return is.invalidateHost(ctx, resourceID, req.GetNote())
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	zlog.Debug().Msgf("Started %s", redact(op))
+	return op, nil
+}
+
+func (is *InventorygRPCServer) invalidateHost(ctx context.Context, resourceID, note string) error {
 	res := &inventory.Resource{
 		Resource: &inventory.Resource_Host{
 			Host: &inv_computev1.HostResource{
 				DesiredState: inv_computev1.HostState_HOST_STATE_UNTRUSTED,
-				Note:         req.GetNote(),
+				Note:         note,
 			},
 		},
 	}
@@ -779,35 +841,30 @@ func (is *InventorygRPCServer) InvalidateHost(
 		inv_computev1.HostResourceFieldNote,
 	)
 	if err != nil {
-		return nil, errors.Wrap(err)
+		return errors.Wrap(err)
 	}
 
-	_, err = is.InvClient.Update(ctx, req.GetResourceId(), fm, res)
+	_, err = is.InvClient.Update(ctx, resourceID, fm, res)
 	if err != nil {
-		return nil, errors.Wrap(err)
+		return errors.Wrap(err)
 	}
-	zlog.Debug().Msgf("Invalidated %s", req.GetResourceId())
-	return &restv1.InvalidateHostResponse{}, nil
+	return nil
 }
 
-// Register a host.
-func (is *InventorygRPCServer) RegisterHost(
-	ctx context.Context,
-	req *restv1.RegisterHostRequest,
-) (*computev1.HostResource, error) {
-	zlog.Debug().Msg("RegisterHost")
+// toInvHostRegister returns the inventory host to create to register the given host.
+func toInvHostRegister(hostRegister *restv1.HostRegister) (*inv_computev1.HostResource, error) {
 	hostResource := &inv_computev1.HostResource{
-		Name:              req.GetHost().GetName(),
+		Name:              hostRegister.GetName(),
 		DesiredState:      inv_computev1.HostState_HOST_STATE_REGISTERED,
 		DesiredPowerState: inv_computev1.PowerState_POWER_STATE_ON,
 		DesiredAmtState:   inv_computev1.AmtState_AMT_STATE_UNPROVISIONED,
 	}
 
-	hostUUID := req.GetHost().GetUuid()
+	hostUUID := hostRegister.GetUuid()
 	if isSet(&hostUUID) {
 		hostResource.Uuid = hostUUID
 	}
-	hostSerial := req.GetHost().GetSerialNumber()
+	hostSerial := hostRegister.GetSerialNumber()
 	if isSet(&hostSerial) {
 		hostResource.SerialNumber = hostSerial
 	}
@@ -818,16 +875,29 @@ func (is *InventorygRPCServer) RegisterHost(
 		return nil, err
 	}
 
-	if req.GetHost().GetAutoOnboard() {
+	if hostRegister.GetAutoOnboard() {
 		hostResource.DesiredState = inv_computev1.HostState_HOST_STATE_ONBOARDED
 	}
 
-	if req.GetHost().GetEnableVpro() {
+	if hostRegister.GetEnableVpro() {
 		hostResource.DesiredAmtState = inv_computev1.AmtState_AMT_STATE_PROVISIONED
 	}
 
-	hostUserLvmSize := req.GetHost().GetUserLvmSize()
+	hostUserLvmSize := hostRegister.GetUserLvmSize()
 	hostResource.UserLvmSize = hostUserLvmSize
+	return hostResource, nil
+}
+
+// Register a host.
+func (is *InventorygRPCServer) RegisterHost(
+	ctx context.Context,
+	req *restv1.RegisterHostRequest,
+) (*computev1.HostResource, error) {
+	zlog.Debug().Msg("RegisterHost")
+	hostResource, err := toInvHostRegister(req.GetHost())
+	if err != nil {
+		return nil, err
+	}
 
 	invRes := &inventory.Resource{
 		Resource: &inventory.Resource_Host{
@@ -837,7 +907,7 @@ func (is *InventorygRPCServer) RegisterHost(
 
 	invResp, err := is.InvClient.Create(ctx, invRes)
 	if err != nil {
-		zlog.InfraErr(err).Msgf("failed to create inventory resource %s", invRes)
+		zlog.InfraErr(err).Msgf("failed to create inventory resource %s", redact(invRes))
 		return nil, errors.Wrap(err)
 	}
 
@@ -845,7 +915,7 @@ func (is *InventorygRPCServer) RegisterHost(
 	if err != nil {
 		return nil, errors.Wrap(err)
 	}
-	zlog.Debug().Msgf("Registered %s", hostResp)
+	zlog.Debug().Msgf("Registered %s", redact(hostResp))
 	return hostResp, nil
 }
 
@@ -873,7 +943,7 @@ func (is *InventorygRPCServer) OnboardHost(
 
 	upRes, err := is.InvClient.Update(ctx, req.GetResourceId(), fm, invRes)
 	if err != nil {
-		zlog.InfraErr(err).Msgf("failed to update inventory resource %s %s", req.GetResourceId(), invRes)
+		zlog.InfraErr(err).Msgf("failed to update inventory resource %s %s", req.GetResourceId(), redact(invRes))
 		return nil, errors.Wrap(err)
 	}
 
@@ -883,7 +953,7 @@ func (is *InventorygRPCServer) OnboardHost(
 		return nil, errors.Wrap(err)
 	}
 
-	zlog.Debug().Msgf("Onboarded %s", invUpRes)
+	zlog.Debug().Msgf("Onboarded %s", redact(invUpRes))
 	return &restv1.OnboardHostResponse{}, nil
 }
 
@@ -923,7 +993,7 @@ func (is *InventorygRPCServer) PatchRegisterHost(
 
 	invReply, err := is.InvClient.Update(ctx, req.GetResourceId(), fm, invRes)
 	if err != nil {
-		zlog.InfraErr(err).Msgf("failed to update inventory resource %s %s", req.GetResourceId(), invRes)
+		zlog.InfraErr(err).Msgf("failed to update inventory resource %s %s", req.GetResourceId(), redact(invRes))
 		return nil, err
 	}
 
@@ -931,7 +1001,7 @@ func (is *InventorygRPCServer) PatchRegisterHost(
 	if err != nil {
 		return nil, err
 	}
-	zlog.Debug().Msgf("Updated %s", invHost)
+	zlog.Debug().Msgf("Updated %s", redact(invHost))
 	return invHost, nil
 }
 
@@ -1029,6 +1099,46 @@ func (is *InventorygRPCServer) GetHostsSummary(
 	return hostsSummary, nil
 }
 
+// Get the number of hosts, broken down along the requested dimensions.
+func (is *InventorygRPCServer) GetHostsStatistics(
+	ctx context.Context,
+	req *restv1.GetHostsStatisticsRequest,
+) (*restv1.GetHostsStatisticsResponse, error) {
+	zlog.Debug().Msg("GetHostsStatistics")
+
+	invReq := &inventory.GetFleetStatisticsRequest{
+		Dimensions: make([]inventory.FleetStatisticsDimension, 0, len(req.GetDimensions())),
+		Filter:     req.GetFilter(),
+	}
+	for _, dimension := range req.GetDimensions() {
+		invReq.Dimensions = append(invReq.Dimensions, inventory.FleetStatisticsDimension(dimension))
+	}
+	invResp, err := is.InvClient.GetFleetStatistics(ctx, invReq)
+	if err != nil {
+		zlog.InfraErr(err).Msg("Failed to get the fleet statistics from inventory")
+		return nil, errors.Wrap(err)
+	}
+
+	statistics := make([]*restv1.HostsDimensionStatistics, 0, len(invResp.GetStatistics()))
+	for _, invStatistics := range invResp.GetStatistics() {
+		buckets := make([]*restv1.HostsStatisticsBucket, 0, len(invStatistics.GetBuckets()))
+		for _, invBucket := range invStatistics.GetBuckets() {
+			buckets = append(buckets, &restv1.HostsStatisticsBucket{
+				Value: invBucket.GetValue(),
+				Count: invBucket.GetCount(),
+			})
+		}
+		statistics = append(statistics, &restv1.HostsDimensionStatistics{
+			Dimension: restv1.HostsStatisticsDimension(invStatistics.GetDimension()),
+			Buckets:   buckets,
+		})
+	}
+	return &restv1.GetHostsStatisticsResponse{
+		Total:      invResp.GetTotalHosts(),
+		Statistics: statistics,
+	}, nil
+}
+
 func fromInvIPAddresses(
 	invIPAddresses []*inv_networkv1.IPAddressResource,
 ) []*networkv1.IPAddressResource {
@@ -1039,10 +1149,14 @@ func fromInvIPAddresses(
 		cidrAddress := invIPAddress.GetAddress()
 		statusDetail := invIPAddress.GetStatusDetail()
 		ipAddress := &networkv1.IPAddressResource{
+			ResourceId:   invIPAddress.GetResourceId(),
 			ConfigMethod: configMode,
 			Status:       status,
 			Address:      cidrAddress,
 			StatusDetail: statusDetail,
+			NicId:        invIPAddress.GetNic().GetResourceId(),
+			HostId:       invIPAddress.GetNic().GetHost().GetResourceId(),
+			Timestamps:   GrpcToOpenAPITimestamps(invIPAddress),
 		}
 		IPAddresses = append(IPAddresses, ipAddress)
 	}
@@ -1061,9 +1175,15 @@ func castToIPAddress(resp *inventory.GetResourceResponse) (*inv_networkv1.IPAddr
 func (is *InventorygRPCServer) getInterfaceToIPAddresses(
 	ctx context.Context,
 	host *inv_computev1.HostResource,
+) (map[string][]*networkv1.IPAddressResource, error) {
+	return is.getNicsToIPAddresses(ctx, host.GetHostNics())
+}
+
+func (is *InventorygRPCServer) getNicsToIPAddresses(
+	ctx context.Context,
+	hostInterfaces []*inv_computev1.HostnicResource,
 ) (map[string][]*networkv1.IPAddressResource, error) {
 	nicToIPAddresses := make(map[string][]*networkv1.IPAddressResource)
-	hostInterfaces := host.GetHostNics()
 	for _, hostInterface := range hostInterfaces {
 		ipAddresses := make([]*networkv1.IPAddressResource, 0)
 		req := &inventory.ResourceFilter{
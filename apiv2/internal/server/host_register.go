@@ -0,0 +1,325 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+
+	commonv1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/resources/common/v1"
+	restv1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/services/v1"
+	inv_computev1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/compute/v1"
+	inventory "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/inventory/v1"
+	inv_locationv1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/location/v1"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/validator"
+)
+
+const (
+	// registerHostsMaxRows is the maximum number of hosts registered by a single RegisterHosts request.
+	registerHostsMaxRows = 1000
+	// registerHostsBatchSize is the number of hosts created concurrently in Inventory.
+	registerHostsBatchSize = 20
+	// errNotRegistered is the error reported for the valid hosts when other hosts of the request are invalid.
+	errNotRegistered = "not registered: other hosts failed validation"
+)
+
+// registerHostsCSVColumns maps the columns of the CSV input of RegisterHosts to the setter of the matching field.
+var registerHostsCSVColumns = map[string]func(entry *restv1.HostRegisterEntry, value string) error{
+	"name": func(entry *restv1.HostRegisterEntry, value string) error {
+		entry.Host.Name = value
+		return nil
+	},
+	"serial_number": func(entry *restv1.HostRegisterEntry, value string) error {
+		entry.Host.SerialNumber = value
+		return nil
+	},
+	"uuid": func(entry *restv1.HostRegisterEntry, value string) error {
+		entry.Host.Uuid = value
+		return nil
+	},
+	"site_id": func(entry *restv1.HostRegisterEntry, value string) error {
+		entry.SiteId = value
+		return nil
+	},
+	"metadata": func(entry *restv1.HostRegisterEntry, value string) error {
+		metadata, err := parseCSVMetadata(value)
+		if err != nil {
+			return err
+		}
+		entry.Metadata = metadata
+		return nil
+	},
+	"auto_onboard": func(entry *restv1.HostRegisterEntry, value string) error {
+		autoOnboard, err := parseCSVBool(value)
+		if err != nil {
+			return err
+		}
+		entry.Host.AutoOnboard = autoOnboard
+		return nil
+	},
+	"enable_vpro": func(entry *restv1.HostRegisterEntry, value string) error {
+		enableVpro, err := parseCSVBool(value)
+		if err != nil {
+			return err
+		}
+		entry.Host.EnableVpro = enableVpro
+		return nil
+	},
+	"user_lvm_size": func(entry *restv1.HostRegisterEntry, value string) error {
+		if value == "" {
+			return nil
+		}
+		userLvmSize, err := strconv.ParseUint(value, 10, 32)
+		if err != nil {
+			return fmt.Errorf("invalid user_lvm_size %q", value)
+		}
+		entry.Host.UserLvmSize = uint32(userLvmSize)
+		return nil
+	},
+}
+
+func parseCSVBool(value string) (bool, error) {
+	if value == "" {
+		return false, nil
+	}
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, fmt.Errorf("invalid boolean %q", value)
+	}
+	return b, nil
+}
+
+// parseCSVMetadata parses metadata given as "key=value;key2=value2".
+func parseCSVMetadata(value string) ([]*commonv1.MetadataItem, error) {
+	if value == "" {
+		return nil, nil
+	}
+	pairs := strings.Split(value, ";")
+	metadata := make([]*commonv1.MetadataItem, 0, len(pairs))
+	for _, pair := range pairs {
+		key, val, ok := strings.Cut(pair, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid metadata %q, expected key=value", pair)
+		}
+		metadata = append(metadata, &commonv1.MetadataItem{
+			Key:   strings.TrimSpace(key),
+			Value: strings.TrimSpace(val),
+		})
+	}
+	return metadata, nil
+}
+
+// parseRegisterHostsCSV returns the hosts described by the given CSV. The first record is the header, naming
+// the columns among registerHostsCSVColumns. Rows that cannot be parsed are returned as nil entries, with their
+// error at the same index.
+func parseRegisterHostsCSV(input string) ([]*restv1.HostRegisterEntry, []error, error) {
+	reader := csv.NewReader(strings.NewReader(input))
+	reader.TrimLeadingSpace = true
+	header, err := reader.Read()
+	if err != nil {
+		zlog.InfraErr(err).Msg("failed to read CSV header")
+		return nil, nil, errors.Errorfc(codes.InvalidArgument, "invalid CSV header: %v", err)
+	}
+	setters := make([]func(*restv1.HostRegisterEntry, string) error, len(header))
+	for i, column := range header {
+		setter, ok := registerHostsCSVColumns[strings.ToLower(strings.TrimSpace(column))]
+		if !ok {
+			err := errors.Errorfc(codes.InvalidArgument, "unknown CSV column %q", column)
+			zlog.InfraErr(err).Msg("failed to parse CSV header")
+			return nil, nil, err
+		}
+		setters[i] = setter
+	}
+	reader.FieldsPerRecord = -1
+
+	var entries []*restv1.HostRegisterEntry
+	var rowErrs []error
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if len(entries) == registerHostsMaxRows {
+			err := errors.Errorfc(codes.InvalidArgument, "at most %d hosts can be registered at once", registerHostsMaxRows)
+			zlog.InfraErr(err).Msg("failed to parse CSV")
+			return nil, nil, err
+		}
+		if err != nil {
+			zlog.InfraErr(err).Msg("failed to read CSV")
+			return nil, nil, errors.Errorfc(codes.InvalidArgument, "invalid CSV: %v", err)
+		}
+		if len(record) != len(header) {
+			entries = append(entries, nil)
+			rowErrs = append(rowErrs, fmt.Errorf("expected %d fields, got %d", len(header), len(record)))
+			continue
+		}
+		entry := &restv1.HostRegisterEntry{Host: &restv1.HostRegister{}}
+		var rowErr error
+		for i, value := range record {
+			if rowErr = setters[i](entry, strings.TrimSpace(value)); rowErr != nil {
+				entry = nil
+				break
+			}
+		}
+		entries = append(entries, entry)
+		rowErrs = append(rowErrs, rowErr)
+	}
+	return entries, rowErrs, nil
+}
+
+// toInvHostRegisterEntry returns the inventory host to create to register the given entry, validating it.
+func toInvHostRegisterEntry(entry *restv1.HostRegisterEntry) (*inv_computev1.HostResource, error) {
+	if err := validator.ValidateMessage(entry); err != nil {
+		zlog.InfraErr(err).Msg("failed to validate host register entry")
+		return nil, errors.Wrap(err)
+	}
+	hostResource, err := toInvHostRegister(entry.GetHost())
+	if err != nil {
+		return nil, err
+	}
+	siteID := entry.GetSiteId()
+	if isSet(&siteID) {
+		hostResource.Site = &inv_locationv1.SiteResource{ResourceId: siteID}
+	}
+	hostResource.Metadata, err = toInvMetadata(entry.GetMetadata())
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	if err := validator.ValidateMessage(hostResource); err != nil {
+		zlog.InfraErr(err).Msg("failed to validate inventory resource")
+		return nil, errors.Wrap(err)
+	}
+	return hostResource, nil
+}
+
+// Register multiple hosts at once.
+// All the hosts are validated before any is created: if any is invalid, none is registered. Valid hosts are then
+// created in batches, each host being reported with its resource ID or the error that prevented its creation.
+func (is *InventorygRPCServer) RegisterHosts(
+	ctx context.Context,
+	req *restv1.RegisterHostsRequest,
+) (*restv1.RegisterHostsResponse, error) {
+	zlog.Debug().Msg("RegisterHosts")
+
+	var entries []*restv1.HostRegisterEntry
+	var rowErrs []error
+	switch {
+	case len(req.GetHosts()) > 0 && req.GetCsv() != "":
+		err := errors.Errorfc(codes.InvalidArgument, "only one of hosts or csv must be set")
+		zlog.InfraErr(err).Msg("failed to parse register hosts request")
+		return nil, err
+	case len(req.GetHosts()) > 0:
+		if len(req.GetHosts()) > registerHostsMaxRows {
+			err := errors.Errorfc(codes.InvalidArgument, "at most %d hosts can be registered at once", registerHostsMaxRows)
+			zlog.InfraErr(err).Msg("failed to parse register hosts request")
+			return nil, err
+		}
+		entries = req.GetHosts()
+		rowErrs = make([]error, len(entries))
+	case req.GetCsv() != "":
+		var err error
+		entries, rowErrs, err = parseRegisterHostsCSV(req.GetCsv())
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(entries) == 0 {
+		err := errors.Errorfc(codes.InvalidArgument, "either hosts or csv must be set with at least one host")
+		zlog.InfraErr(err).Msg("failed to parse register hosts request")
+		return nil, err
+	}
+
+	hostResources, valid := validateRegisterHosts(entries, rowErrs)
+	resp := &restv1.RegisterHostsResponse{Results: make([]*restv1.RegisterHostsResponse_Result, len(entries))}
+	for i := range entries {
+		resp.Results[i] = &restv1.RegisterHostsResponse_Result{Index: uint32(i)} //nolint:gosec // Bounded by max rows.
+		if rowErrs[i] != nil {
+			resp.Results[i].Error = errors.ErrorToString(rowErrs[i])
+		} else if !valid {
+			resp.Results[i].Error = errNotRegistered
+		}
+	}
+	if !valid || req.GetDryRun() {
+		return fillRegisterHostsCounts(resp), nil
+	}
+
+	for start := 0; start < len(hostResources); start += registerHostsBatchSize {
+		end := min(start+registerHostsBatchSize, len(hostResources))
+		var wg sync.WaitGroup
+		for i := start; i < end; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				invResp, err := is.InvClient.Create(ctx, &inventory.Resource{
+					Resource: &inventory.Resource_Host{Host: hostResources[i]},
+				})
+				if err != nil {
+					zlog.InfraErr(err).Msgf("failed to register host at index %d", i)
+					resp.Results[i].Error = errors.ErrorToString(err)
+					return
+				}
+				resp.Results[i].ResourceId = invResp.GetHost().GetResourceId()
+			}(i)
+		}
+		wg.Wait()
+	}
+	zlog.Debug().Msgf("Registered hosts %s", redact(resp))
+	return fillRegisterHostsCounts(resp), nil
+}
+
+// validateRegisterHosts converts all the entries into inventory hosts, recording the errors of the invalid ones in
+// rowErrs, including the hosts sharing the UUID or serial number of a previous one. Returns whether all are valid.
+func validateRegisterHosts(
+	entries []*restv1.HostRegisterEntry, rowErrs []error,
+) ([]*inv_computev1.HostResource, bool) {
+	hostResources := make([]*inv_computev1.HostResource, len(entries))
+	uuids := make(map[string]int)
+	serials := make(map[string]int)
+	valid := true
+	for i, entry := range entries {
+		if rowErrs[i] != nil {
+			valid = false
+			continue
+		}
+		hostResource, err := toInvHostRegisterEntry(entry)
+		if err != nil {
+			rowErrs[i] = err
+			valid = false
+			continue
+		}
+		if prev, ok := uuids[strings.ToLower(hostResource.GetUuid())]; ok && hostResource.GetUuid() != "" {
+			rowErrs[i] = errors.Errorfc(codes.InvalidArgument, "duplicate UUID, already set at index %d", prev)
+			valid = false
+			continue
+		}
+		if prev, ok := serials[hostResource.GetSerialNumber()]; ok && hostResource.GetSerialNumber() != "" {
+			rowErrs[i] = errors.Errorfc(codes.InvalidArgument, "duplicate SerialNumber, already set at index %d", prev)
+			valid = false
+			continue
+		}
+		uuids[strings.ToLower(hostResource.GetUuid())] = i
+		serials[hostResource.GetSerialNumber()] = i
+		hostResources[i] = hostResource
+	}
+	return hostResources, valid
+}
+
+func fillRegisterHostsCounts(resp *restv1.RegisterHostsResponse) *restv1.RegisterHostsResponse {
+	for _, result := range resp.GetResults() {
+		if result.GetError() != "" {
+			resp.Failed++
+		} else {
+			resp.Succeeded++
+		}
+	}
+	return resp
+}
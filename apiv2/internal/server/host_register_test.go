@@ -0,0 +1,175 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package server_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	commonv1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/resources/common/v1"
+	restv1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/services/v1"
+	inv_server "github.com/open-edge-platform/infra-core/apiv2/v2/internal/server"
+	inv_computev1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/compute/v1"
+	inventory "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/inventory/v1"
+)
+
+// mockRegisterHost mocks the creation in Inventory of the host with the given serial number.
+func mockRegisterHost(mockedClient *mock.Mock, serial, resourceID string, err error) *mock.Call {
+	call := mockedClient.On("Create", mock.Anything, mock.MatchedBy(func(res *inventory.Resource) bool {
+		return res.GetHost().GetSerialNumber() == serial
+	})).Once()
+	if err != nil {
+		return call.Return(nil, err)
+	}
+	return call.Return(&inventory.Resource{
+		Resource: &inventory.Resource_Host{Host: &inv_computev1.HostResource{ResourceId: resourceID}},
+	}, nil)
+}
+
+//nolint:funlen // Test cases.
+func TestHost_RegisterHosts(t *testing.T) {
+	cases := []struct {
+		name          string
+		mocks         func(mockedClient *mock.Mock)
+		req           *restv1.RegisterHostsRequest
+		wantErr       bool
+		wantResults   []*restv1.RegisterHostsResponse_Result
+		wantSucceeded uint32
+	}{
+		{
+			name: "Register hosts list",
+			mocks: func(mockedClient *mock.Mock) {
+				mockRegisterHost(mockedClient, "SN0001", "host-00000001", nil)
+				mockRegisterHost(mockedClient, "SN0002", "host-00000002", nil)
+			},
+			req: &restv1.RegisterHostsRequest{
+				Hosts: []*restv1.HostRegisterEntry{
+					{
+						Host:     &restv1.HostRegister{Name: "host-1", SerialNumber: "SN0001", AutoOnboard: true},
+						SiteId:   "site-12345678",
+						Metadata: []*commonv1.MetadataItem{{Key: "rack", Value: "r1"}},
+					},
+					{Host: &restv1.HostRegister{Name: "host-2", SerialNumber: "SN0002"}},
+				},
+			},
+			wantResults: []*restv1.RegisterHostsResponse_Result{
+				{Index: 0, ResourceId: "host-00000001"},
+				{Index: 1, ResourceId: "host-00000002"},
+			},
+			wantSucceeded: 2,
+		},
+		{
+			name: "Register hosts CSV",
+			mocks: func(mockedClient *mock.Mock) {
+				mockRegisterHost(mockedClient, "SN0001", "host-00000001", nil)
+				mockRegisterHost(mockedClient, "SN0002", "host-00000002", nil)
+			},
+			req: &restv1.RegisterHostsRequest{
+				Csv: "name,serial_number,uuid,site_id,metadata,auto_onboard,enable_vpro,user_lvm_size\n" +
+					"host-1,SN0001,,site-12345678,rack=r1;row=2,true,false,10\n" +
+					"host-2,SN0002,,,,,,\n",
+			},
+			wantResults: []*restv1.RegisterHostsResponse_Result{
+				{Index: 0, ResourceId: "host-00000001"},
+				{Index: 1, ResourceId: "host-00000002"},
+			},
+			wantSucceeded: 2,
+		},
+		{
+			name: "Invalid hosts register none",
+			req: &restv1.RegisterHostsRequest{
+				Csv: "name,serial_number,auto_onboard\n" +
+					"host-1,SN0001,true\n" +
+					"host-2,,false\n" +
+					"host-3,SN0003,maybe\n" +
+					"host-4,SN0001,false\n",
+			},
+			wantResults: []*restv1.RegisterHostsResponse_Result{
+				{Index: 0, Error: "not registered: other hosts failed validation"},
+				{Index: 1, Error: "either UUID or SerialNumber must be set"},
+				{Index: 2, Error: `invalid boolean "maybe"`},
+				{Index: 3, Error: "duplicate SerialNumber, already set at index 0"},
+			},
+		},
+		{
+			name: "Dry run",
+			req: &restv1.RegisterHostsRequest{
+				Hosts: []*restv1.HostRegisterEntry{
+					{Host: &restv1.HostRegister{Name: "host-1", SerialNumber: "SN0001"}},
+				},
+				DryRun: true,
+			},
+			wantResults:   []*restv1.RegisterHostsResponse_Result{{Index: 0}},
+			wantSucceeded: 1,
+		},
+		{
+			name: "Create errors are reported per host",
+			mocks: func(mockedClient *mock.Mock) {
+				mockRegisterHost(mockedClient, "SN0001", "host-00000001", nil)
+				mockRegisterHost(mockedClient, "SN0002", "", errors.New("already exists"))
+			},
+			req: &restv1.RegisterHostsRequest{
+				Hosts: []*restv1.HostRegisterEntry{
+					{Host: &restv1.HostRegister{Name: "host-1", SerialNumber: "SN0001"}},
+					{Host: &restv1.HostRegister{Name: "host-2", SerialNumber: "SN0002"}},
+				},
+			},
+			wantResults: []*restv1.RegisterHostsResponse_Result{
+				{Index: 0, ResourceId: "host-00000001"},
+				{Index: 1, Error: "already exists"},
+			},
+			wantSucceeded: 1,
+		},
+		{
+			name: "Both hosts and CSV",
+			req: &restv1.RegisterHostsRequest{
+				Hosts: []*restv1.HostRegisterEntry{{Host: &restv1.HostRegister{SerialNumber: "SN0001"}}},
+				Csv:   "serial_number\nSN0002\n",
+			},
+			wantErr: true,
+		},
+		{
+			name:    "No hosts",
+			req:     &restv1.RegisterHostsRequest{},
+			wantErr: true,
+		},
+		{
+			name:    "Unknown CSV column",
+			req:     &restv1.RegisterHostsRequest{Csv: "serial_number,color\nSN0001,blue\n"},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockedClient := newMockedInventoryTestClient()
+			server := inv_server.InventorygRPCServer{InvClient: mockedClient}
+			if tc.mocks != nil {
+				tc.mocks(&mockedClient.Mock)
+			}
+
+			resp, err := server.RegisterHosts(context.Background(), tc.req)
+			mockedClient.AssertExpectations(t)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, resp.GetResults(), len(tc.wantResults))
+			for i, want := range tc.wantResults {
+				got := resp.GetResults()[i]
+				assert.Equal(t, want.GetIndex(), got.GetIndex())
+				assert.Equal(t, want.GetResourceId(), got.GetResourceId())
+				assert.Equal(t, want.GetError(), got.GetError())
+			}
+			assert.Equal(t, tc.wantSucceeded, resp.GetSucceeded())
+			assert.Equal(t, uint32(len(tc.wantResults))-tc.wantSucceeded, resp.GetFailed()) //nolint:gosec // Test.
+		})
+	}
+}
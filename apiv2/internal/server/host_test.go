@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"testing"
 	"time"
 
@@ -14,6 +15,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/fieldmaskpb"
 
 	commonv1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/resources/common/v1"
@@ -543,6 +546,179 @@ func TestHost_List(t *testing.T) {
 	}
 }
 
+func TestHost_ListFields(t *testing.T) {
+	hostsResponse := &inventory.ListResourcesResponse{
+		Resources: []*inventory.GetResourceResponse{{
+			Resource: &inventory.Resource{Resource: &inventory.Resource_Host{Host: exampleInvHostResource}},
+		}},
+		TotalElements: 1,
+	}
+
+	testCases := []struct {
+		name    string
+		req     *restv1.ListHostsRequest
+		wantErr codes.Code
+		// wantEdges are the edges Inventory is asked to load.
+		wantEdges []string
+		check     func(t *testing.T, host *computev1.HostResource)
+	}{
+		{
+			name: "BasicView",
+			req:  &restv1.ListHostsRequest{View: restv1.ResourceView_RESOURCE_VIEW_BASIC},
+			// The site is only loaded for its ID.
+			wantEdges: []string{inv_computev1.HostResourceEdgeSite},
+			check: func(t *testing.T, host *computev1.HostResource) {
+				t.Helper()
+				assert.Equal(t, exampleAPIHostResource.GetName(), host.GetName())
+				assert.Equal(t, exampleAPIHostResource.GetSerialNumber(), host.GetSerialNumber())
+				assert.NotNil(t, host.GetTimestamps())
+				assert.Nil(t, host.GetSite())
+				assert.Empty(t, host.GetHostNics())
+				assert.Empty(t, host.GetHostStorages())
+			},
+		},
+		{
+			name:      "Fields",
+			req:       &restv1.ListHostsRequest{Fields: &fieldmaskpb.FieldMask{Paths: []string{"site", "serialNumber"}}},
+			wantEdges: []string{inv_computev1.HostResourceEdgeSite},
+			check: func(t *testing.T, host *computev1.HostResource) {
+				t.Helper()
+				assert.Equal(t, exampleAPIHostResource.GetResourceId(), host.GetResourceId())
+				assert.Equal(t, exampleAPIHostResource.GetSerialNumber(), host.GetSerialNumber())
+				assert.Equal(t, exampleAPIHostResource.GetSite().GetResourceId(), host.GetSite().GetResourceId())
+				assert.Empty(t, host.GetName())
+				assert.Empty(t, host.GetHostNics())
+			},
+		},
+		{
+			name:      "SiteID",
+			req:       &restv1.ListHostsRequest{Fields: &fieldmaskpb.FieldMask{Paths: []string{"siteId"}}},
+			wantEdges: []string{inv_computev1.HostResourceEdgeSite},
+			check: func(t *testing.T, host *computev1.HostResource) {
+				t.Helper()
+				assert.Equal(t, exampleAPIHostResource.GetSiteId(), host.GetSiteId())
+				assert.Nil(t, host.GetSite())
+			},
+		},
+		{
+			name:    "UnknownField",
+			req:     &restv1.ListHostsRequest{Fields: &fieldmaskpb.FieldMask{Paths: []string{"unknown"}}},
+			wantErr: codes.InvalidArgument,
+		},
+		{
+			name: "FieldsAndView",
+			req: &restv1.ListHostsRequest{
+				View:   restv1.ResourceView_RESOURCE_VIEW_FULL,
+				Fields: &fieldmaskpb.FieldMask{Paths: []string{"site"}},
+			},
+			wantErr: codes.InvalidArgument,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockedClient := newMockedInventoryTestClient()
+			server := inv_server.InventorygRPCServer{InvClient: mockedClient}
+			if tc.wantErr == codes.OK {
+				isHostFilter := mock.MatchedBy(func(filter *inventory.ResourceFilter) bool {
+					return filter.GetResource().GetHost() != nil && filter.GetReadMask() != nil &&
+						slices.Equal(tc.wantEdges, filter.GetReadMask().GetPaths())
+				})
+				// The IP addresses of the NICs are not looked up, the NICs being left out.
+				mockedClient.On("List", mock.Anything, isHostFilter).Return(hostsResponse, nil).Once()
+			}
+
+			reply, err := server.ListHosts(context.Background(), tc.req)
+			if tc.wantErr != codes.OK {
+				assert.Equal(t, tc.wantErr, status.Code(err))
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, reply.GetHosts(), 1)
+			tc.check(t, reply.GetHosts()[0])
+			mockedClient.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHost_GetFields(t *testing.T) {
+	mockedClient := newMockedInventoryTestClient()
+	server := inv_server.InventorygRPCServer{InvClient: mockedClient}
+	mockedClient.On("Get", mock.Anything, "host-12345678").Return(&inventory.GetResourceResponse{
+		Resource: &inventory.Resource{Resource: &inventory.Resource_Host{Host: exampleInvHostResource}},
+	}, nil).Once()
+
+	reply, err := server.GetHost(context.Background(), &restv1.GetHostRequest{
+		ResourceId: "host-12345678",
+		Fields:     &fieldmaskpb.FieldMask{Paths: []string{computev1.HostResourceFieldName}},
+	})
+	require.NoError(t, err)
+	compareProtoMessages(t, &computev1.HostResource{
+		ResourceId: exampleAPIHostResource.GetResourceId(),
+		Name:       exampleAPIHostResource.GetName(),
+	}, reply)
+	mockedClient.AssertExpectations(t)
+}
+
+func TestHost_Statistics(t *testing.T) {
+	mockedClient := newMockedInventoryTestClient()
+	server := inv_server.InventorygRPCServer{InvClient: mockedClient}
+	mockedClient.On("GetFleetStatistics", mock.Anything, &inventory.GetFleetStatisticsRequest{
+		Dimensions: []inventory.FleetStatisticsDimension{
+			inventory.FleetStatisticsDimension_FLEET_STATISTICS_DIMENSION_SITE,
+			inventory.FleetStatisticsDimension_FLEET_STATISTICS_DIMENSION_POWER_STATE,
+		},
+		Filter: `site.region.resource_id = "region-12345678"`,
+	}).Return(&inventory.GetFleetStatisticsResponse{
+		TotalHosts: 3,
+		Statistics: []*inventory.GetFleetStatisticsResponse_DimensionStatistics{
+			{
+				Dimension: inventory.FleetStatisticsDimension_FLEET_STATISTICS_DIMENSION_SITE,
+				Buckets: []*inventory.GetFleetStatisticsResponse_Bucket{
+					{Value: "site-12345678", Count: 2},
+					{Value: "site-87654321", Count: 1},
+				},
+			},
+			{
+				Dimension: inventory.FleetStatisticsDimension_FLEET_STATISTICS_DIMENSION_POWER_STATE,
+				Buckets: []*inventory.GetFleetStatisticsResponse_Bucket{
+					{Value: "POWER_STATE_ON", Count: 3},
+				},
+			},
+		},
+	}, nil).Once()
+
+	reply, err := server.GetHostsStatistics(context.Background(), &restv1.GetHostsStatisticsRequest{
+		Dimensions: []restv1.HostsStatisticsDimension{
+			restv1.HostsStatisticsDimension_HOSTS_STATISTICS_DIMENSION_SITE,
+			restv1.HostsStatisticsDimension_HOSTS_STATISTICS_DIMENSION_POWER_STATE,
+		},
+		Filter: `site.region.resource_id = "region-12345678"`,
+	})
+	require.NoError(t, err)
+	compareProtoMessages(t, &restv1.GetHostsStatisticsResponse{
+		Total: 3,
+		Statistics: []*restv1.HostsDimensionStatistics{
+			{
+				Dimension: restv1.HostsStatisticsDimension_HOSTS_STATISTICS_DIMENSION_SITE,
+				Buckets: []*restv1.HostsStatisticsBucket{
+					{Value: "site-12345678", Count: 2},
+					{Value: "site-87654321", Count: 1},
+				},
+			},
+			{
+				Dimension: restv1.HostsStatisticsDimension_HOSTS_STATISTICS_DIMENSION_POWER_STATE,
+				Buckets:   []*restv1.HostsStatisticsBucket{{Value: "POWER_STATE_ON", Count: 3}},
+			},
+		},
+	}, reply)
+	mockedClient.AssertExpectations(t)
+
+	mockedClient.On("GetFleetStatistics", mock.Anything, mock.Anything).
+		Return(nil, status.Error(codes.InvalidArgument, "invalid filter")).Once()
+	_, err = server.GetHostsStatistics(context.Background(), &restv1.GetHostsStatisticsRequest{Filter: "name ="})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
 func TestHost_Update(t *testing.T) {
 	mockedClient := newMockedInventoryTestClient()
 	server := inv_server.InventorygRPCServer{InvClient: mockedClient}
@@ -0,0 +1,104 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+
+	computev1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/resources/compute/v1"
+	restv1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/services/v1"
+	inv_computev1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/compute/v1"
+	inventory "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/inventory/v1"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/validator"
+)
+
+func castToHostnic(resp *inventory.GetResourceResponse) (*inv_computev1.HostnicResource, error) {
+	if resp.GetResource().GetHostnic() != nil {
+		return resp.GetResource().GetHostnic(), nil
+	}
+	err := errors.Errorfc(codes.Internal, "%s is not a Hostnic", resp.GetResource())
+	zlog.InfraErr(err).Msgf("could not cast inventory resource")
+	return nil, err
+}
+
+// Get a list of host NICs.
+func (is *InventorygRPCServer) ListHostnics(
+	ctx context.Context,
+	req *restv1.ListHostnicsRequest,
+) (*restv1.ListHostnicsResponse, error) {
+	zlog.Debug().Msg("ListHostnics")
+
+	filter := &inventory.ResourceFilter{
+		Resource: &inventory.Resource{Resource: &inventory.Resource_Hostnic{Hostnic: &inv_computev1.HostnicResource{}}},
+		Offset:   req.GetOffset(),
+		Limit:    req.GetPageSize(),
+		OrderBy:  req.GetOrderBy(),
+		Filter:   req.GetFilter(),
+	}
+	if err := validator.ValidateMessage(filter); err != nil {
+		zlog.InfraSec().InfraErr(err).Msg("failed to validate query params")
+		return nil, errors.Wrap(err)
+	}
+
+	invResp, err := is.InvClient.List(ctx, filter)
+	if err != nil {
+		zlog.InfraErr(err).Msg("Failed to list host NICs from inventory")
+		return nil, errors.Wrap(err)
+	}
+
+	invNics := make([]*inv_computev1.HostnicResource, 0, len(invResp.GetResources()))
+	for _, invRes := range invResp.GetResources() {
+		invNic, err := castToHostnic(invRes)
+		if err != nil {
+			return nil, err
+		}
+		invNics = append(invNics, invNic)
+	}
+
+	nicToIPAddresses, err := is.getNicsToIPAddresses(ctx, invNics)
+	if err != nil {
+		zlog.InfraErr(err).Msg("Failed to get IP addresses of host NICs")
+		return nil, errors.Wrap(err)
+	}
+
+	resp := &restv1.ListHostnicsResponse{
+		Nics:          fromInvHostNics(invNics, nicToIPAddresses),
+		TotalElements: invResp.GetTotalElements(),
+		HasNext:       invResp.GetHasNext(),
+	}
+	zlog.Debug().Msgf("Listed %s", redact(resp))
+	return resp, nil
+}
+
+// Get a specific host NIC.
+func (is *InventorygRPCServer) GetHostnic(
+	ctx context.Context,
+	req *restv1.GetHostnicRequest,
+) (*computev1.HostnicResource, error) {
+	zlog.Debug().Msg("GetHostnic")
+
+	invResp, err := is.InvClient.Get(ctx, req.GetResourceId())
+	if err != nil {
+		zlog.InfraErr(err).Msgf("failed to get inventory resource %s", req.GetResourceId())
+		return nil, errors.Wrap(err)
+	}
+
+	invNic, err := castToHostnic(invResp)
+	if err != nil {
+		return nil, err
+	}
+	invNics := []*inv_computev1.HostnicResource{invNic}
+	nicToIPAddresses, err := is.getNicsToIPAddresses(ctx, invNics)
+	if err != nil {
+		zlog.InfraErr(err).Msg("Failed to get IP addresses of host NIC")
+		return nil, errors.Wrap(err)
+	}
+
+	nic := fromInvHostNics(invNics, nicToIPAddresses)[0]
+	zlog.Debug().Msgf("Got %s", redact(nic))
+	return nic, nil
+}
@@ -228,7 +228,7 @@ func (is *InventorygRPCServer) CreateInstance(
 		zlog.InfraErr(err).Msg("Failed to convert from inventory instance")
 		return nil, errors.Wrap(err)
 	}
-	zlog.Debug().Msgf("Created %s", instanceCreated)
+	zlog.Debug().Msgf("Created %s", redact(instanceCreated))
 	return instanceCreated, nil
 }
 
@@ -239,12 +239,18 @@ func (is *InventorygRPCServer) ListInstances(
 ) (*restv1.ListInstancesResponse, error) {
 	zlog.Debug().Msg("ListInstances")
 
+	mask, err := readMask(&computev1.InstanceResource{}, req.GetView(), req.GetFields())
+	if err != nil {
+		return nil, err
+	}
+
 	filter := &inventory.ResourceFilter{
 		Resource: &inventory.Resource{Resource: &inventory.Resource_Instance{Instance: &inv_computev1.InstanceResource{}}},
 		Offset:   req.GetOffset(),
 		Limit:    req.GetPageSize(),
 		OrderBy:  req.GetOrderBy(),
 		Filter:   req.GetFilter(),
+		ReadMask: invReadMask(&computev1.InstanceResource{}, mask),
 	}
 	if err := validator.ValidateMessage(filter); err != nil {
 		zlog.InfraSec().InfraErr(err).Msg("failed to validate query params")
@@ -264,6 +270,7 @@ func (is *InventorygRPCServer) ListInstances(
 			zlog.InfraErr(err).Msg("Failed to convert from inventory instance")
 			return nil, errors.Wrap(err)
 		}
+		applyReadMask(instance, mask)
 		instances = append(instances, instance)
 	}
 
@@ -272,7 +279,7 @@ func (is *InventorygRPCServer) ListInstances(
 		TotalElements: invResp.GetTotalElements(),
 		HasNext:       invResp.GetHasNext(),
 	}
-	zlog.Debug().Msgf("Listed %s", resp)
+	zlog.Debug().Msgf("Listed %s", redact(resp))
 	return resp, nil
 }
 
@@ -283,6 +290,11 @@ func (is *InventorygRPCServer) GetInstance(
 ) (*computev1.InstanceResource, error) {
 	zlog.Debug().Msg("GetInstance")
 
+	mask, err := readMask(&computev1.InstanceResource{}, req.GetView(), req.GetFields())
+	if err != nil {
+		return nil, err
+	}
+
 	invResp, err := is.InvClient.Get(ctx, req.GetResourceId())
 	if err != nil {
 		zlog.InfraErr(err).Msg("Failed to get instance from inventory")
@@ -295,7 +307,8 @@ func (is *InventorygRPCServer) GetInstance(
 		zlog.InfraErr(err).Msg("Failed to convert from inventory instance")
 		return nil, errors.Wrap(err)
 	}
-	zlog.Debug().Msgf("Got %s", instance)
+	applyReadMask(instance, mask)
+	zlog.Debug().Msgf("Got %s", redact(instance))
 	return instance, nil
 }
 
@@ -326,7 +339,7 @@ func (is *InventorygRPCServer) UpdateInstance(
 	}
 	upRes, err := is.InvClient.Update(ctx, req.GetResourceId(), fieldmask, invRes)
 	if err != nil {
-		zlog.InfraErr(err).Msgf("failed to update inventory resource %s %s", req.GetResourceId(), invRes)
+		zlog.InfraErr(err).Msgf("failed to update inventory resource %s %s", req.GetResourceId(), redact(invRes))
 		return nil, errors.Wrap(err)
 	}
 	invUp := upRes.GetInstance()
@@ -335,7 +348,7 @@ func (is *InventorygRPCServer) UpdateInstance(
 		return nil, errors.Wrap(err)
 	}
 
-	zlog.Debug().Msgf("Updated %s", invUpRes)
+	zlog.Debug().Msgf("Updated %s", redact(invUpRes))
 	return invUpRes, nil
 }
 
@@ -364,7 +377,7 @@ func (is *InventorygRPCServer) PatchInstance(
 	}
 	upRes, err := is.InvClient.Update(ctx, req.GetResourceId(), fieldmask, invRes)
 	if err != nil {
-		zlog.InfraErr(err).Msgf("failed to update inventory resource %s %s", req.GetResourceId(), invRes)
+		zlog.InfraErr(err).Msgf("failed to update inventory resource %s %s", req.GetResourceId(), redact(invRes))
 		return nil, errors.Wrap(err)
 	}
 	invUp := upRes.GetInstance()
@@ -373,7 +386,7 @@ func (is *InventorygRPCServer) PatchInstance(
 		return nil, errors.Wrap(err)
 	}
 
-	zlog.Debug().Msgf("Updated %s", invUpRes)
+	zlog.Debug().Msgf("Updated %s", redact(invUpRes))
 	return invUpRes, nil
 }
 
@@ -8,7 +8,10 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
 
 	computev1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/resources/compute/v1"
 	osv1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/resources/os/v1"
@@ -263,6 +266,37 @@ func TestInstance_Get(t *testing.T) {
 	}
 }
 
+func TestInstance_GetBasicView(t *testing.T) {
+	mockedClient := newMockedInventoryTestClient()
+	server := inv_server.InventorygRPCServer{InvClient: mockedClient}
+	invInstance := proto.Clone(exampleInvInstanceResource).(*inv_computev1.InstanceResource)
+	invInstance.WorkloadMembers = []*inv_computev1.WorkloadMember{{
+		ResourceId: "workloadmember-12345678",
+		Workload:   &inv_computev1.WorkloadResource{ResourceId: "workload-12345678"},
+	}}
+	invInstance.OsUpdatePolicy = &inv_computev1.OSUpdatePolicyResource{ResourceId: "osupdatepolicy-12345678"}
+	mockedClient.On("Get", mock.Anything, "inst-12345678").Return(&inventory.GetResourceResponse{
+		Resource: &inventory.Resource{Resource: &inventory.Resource_Instance{Instance: invInstance}},
+	}, nil).Once()
+
+	reply, err := server.GetInstance(context.Background(), &restv1.GetInstanceRequest{
+		ResourceId: "inst-12345678",
+		View:       restv1.ResourceView_RESOURCE_VIEW_BASIC,
+	})
+	require.NoError(t, err)
+	// The related resources are left out, their IDs are kept.
+	assert.Equal(t, exampleAPIInstanceResource.GetName(), reply.GetName())
+	assert.Equal(t, exampleAPIInstanceResource.GetHostID(), reply.GetHostID())
+	assert.Equal(t, exampleAPIInstanceResource.GetOsID(), reply.GetOsID())
+	assert.Equal(t, exampleAPIInstanceResource.GetCustomConfigID(), reply.GetCustomConfigID())
+	assert.Nil(t, reply.GetHost())
+	assert.Nil(t, reply.GetOs())
+	assert.Nil(t, reply.GetUpdatePolicy())
+	assert.Empty(t, reply.GetWorkloadMembers())
+	assert.Empty(t, reply.GetCustomConfig())
+	mockedClient.AssertExpectations(t)
+}
+
 func TestInstance_List(t *testing.T) {
 	mockedClient := newMockedInventoryTestClient()
 	server := inv_server.InventorygRPCServer{InvClient: mockedClient}
@@ -22,12 +22,12 @@ func NewInventoryClient(
 	ctx context.Context,
 	wg *sync.WaitGroup,
 	config *common.GlobalConfig,
+	eventsWatcher chan *client.WatchEvents,
 ) (client.InventoryClient, error) {
 	insecureConnection := true
-	eventsWatcher := make(chan *client.WatchEvents)
 
 	dialOpts := []grpc.DialOption{
-		grpc.WithChainUnaryInterceptor(client.TenantContextExtractingInterceptor()),
+		grpc.WithChainUnaryInterceptor(client.TenantContextExtractingInterceptor(), forwardScopedJWTInterceptor()),
 	}
 
 	clientCfg := client.InventoryClientConfig{
@@ -43,7 +43,7 @@ func NewInventoryClient(
 		EnableRegisterRetry:       false,
 		AbortOnUnknownClientError: true,
 		ClientKind:                inv_v1.ClientKind_CLIENT_KIND_API,
-		ResourceKinds:             []inv_v1.ResourceKind{},
+		ResourceKinds:             WatchedResourceKinds(),
 		Wg:                        wg,
 		EnableTracing:             config.Traces.EnableTracing,
 		EnableMetrics:             config.Inventory.EnableMetrics,
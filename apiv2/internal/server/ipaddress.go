@@ -0,0 +1,80 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+
+	networkv1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/resources/network/v1"
+	restv1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/services/v1"
+	inventory "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/inventory/v1"
+	inv_networkv1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/network/v1"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/validator"
+)
+
+// Get a list of IP addresses.
+func (is *InventorygRPCServer) ListIPAddresses(
+	ctx context.Context,
+	req *restv1.ListIPAddressesRequest,
+) (*restv1.ListIPAddressesResponse, error) {
+	zlog.Debug().Msg("ListIPAddresses")
+
+	filter := &inventory.ResourceFilter{
+		Resource: &inventory.Resource{Resource: &inventory.Resource_Ipaddress{Ipaddress: &inv_networkv1.IPAddressResource{}}},
+		Offset:   req.GetOffset(),
+		Limit:    req.GetPageSize(),
+		OrderBy:  req.GetOrderBy(),
+		Filter:   req.GetFilter(),
+	}
+	if err := validator.ValidateMessage(filter); err != nil {
+		zlog.InfraSec().InfraErr(err).Msg("failed to validate query params")
+		return nil, errors.Wrap(err)
+	}
+
+	invResp, err := is.InvClient.List(ctx, filter)
+	if err != nil {
+		zlog.InfraErr(err).Msg("Failed to list IP addresses from inventory")
+		return nil, errors.Wrap(err)
+	}
+
+	invIPAddresses := make([]*inv_networkv1.IPAddressResource, 0, len(invResp.GetResources()))
+	for _, invRes := range invResp.GetResources() {
+		invIPAddress, err := castToIPAddress(invRes)
+		if err != nil {
+			return nil, err
+		}
+		invIPAddresses = append(invIPAddresses, invIPAddress)
+	}
+
+	resp := &restv1.ListIPAddressesResponse{
+		IpAddresses:   fromInvIPAddresses(invIPAddresses),
+		TotalElements: invResp.GetTotalElements(),
+		HasNext:       invResp.GetHasNext(),
+	}
+	zlog.Debug().Msgf("Listed %s", redact(resp))
+	return resp, nil
+}
+
+// Get a specific IP address.
+func (is *InventorygRPCServer) GetIPAddress(
+	ctx context.Context,
+	req *restv1.GetIPAddressRequest,
+) (*networkv1.IPAddressResource, error) {
+	zlog.Debug().Msg("GetIPAddress")
+
+	invResp, err := is.InvClient.Get(ctx, req.GetResourceId())
+	if err != nil {
+		zlog.InfraErr(err).Msgf("failed to get inventory resource %s", req.GetResourceId())
+		return nil, errors.Wrap(err)
+	}
+
+	invIPAddress, err := castToIPAddress(invResp)
+	if err != nil {
+		return nil, err
+	}
+	ipAddress := fromInvIPAddresses([]*inv_networkv1.IPAddressResource{invIPAddress})[0]
+	zlog.Debug().Msgf("Got %s", redact(ipAddress))
+	return ipAddress, nil
+}
@@ -0,0 +1,176 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package server_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	computev1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/resources/compute/v1"
+	networkv1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/resources/network/v1"
+	restv1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/services/v1"
+	inv_server "github.com/open-edge-platform/infra-core/apiv2/v2/internal/server"
+	inv_computev1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/compute/v1"
+	inventory "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/inventory/v1"
+	inv_networkv1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/network/v1"
+)
+
+var (
+	exampleInvHostnic = &inv_computev1.HostnicResource{
+		ResourceId: "hostnic-12345678",
+		DeviceName: "eth0",
+		MacAddr:    "aa:bb:cc:dd:ee:ff",
+		Host:       &inv_computev1.HostResource{ResourceId: "host-12345678"},
+	}
+	exampleInvIPAddress = &inv_networkv1.IPAddressResource{
+		ResourceId:   "ipaddr-12345678",
+		Address:      "10.1.2.3/24",
+		Status:       inv_networkv1.IPAddressStatus_IP_ADDRESS_STATUS_CONFIGURED,
+		ConfigMethod: inv_networkv1.IPAddressConfigMethod_IP_ADDRESS_CONFIG_METHOD_STATIC,
+		Nic:          exampleInvHostnic,
+	}
+	exampleAPIIPAddress = &networkv1.IPAddressResource{
+		ResourceId:   "ipaddr-12345678",
+		Address:      "10.1.2.3/24",
+		Status:       networkv1.IPAddressStatus_IP_ADDRESS_STATUS_CONFIGURED,
+		ConfigMethod: networkv1.IPAddressConfigMethod_IP_ADDRESS_CONFIG_METHOD_STATIC,
+		NicId:        "hostnic-12345678",
+		HostId:       "host-12345678",
+	}
+)
+
+func ipAddressListResponse(ipAddresses ...*inv_networkv1.IPAddressResource) *inventory.ListResourcesResponse {
+	resp := &inventory.ListResourcesResponse{TotalElements: int32(len(ipAddresses))} //nolint:gosec // Test.
+	for _, ipAddress := range ipAddresses {
+		resp.Resources = append(resp.Resources, &inventory.GetResourceResponse{
+			Resource: &inventory.Resource{Resource: &inventory.Resource_Ipaddress{Ipaddress: ipAddress}},
+		})
+	}
+	return resp
+}
+
+func TestIPAddress_List(t *testing.T) {
+	cases := []struct {
+		name    string
+		mocks   func(mockedClient *mock.Mock)
+		req     *restv1.ListIPAddressesRequest
+		wantErr bool
+	}{
+		{
+			name: "List IP addresses by address",
+			mocks: func(mockedClient *mock.Mock) {
+				mockedClient.On("List", mock.Anything, mock.MatchedBy(func(f *inventory.ResourceFilter) bool {
+					return f.GetResource().GetIpaddress() != nil && f.GetFilter() == `address = "10.1.2.3/24"`
+				})).Return(ipAddressListResponse(exampleInvIPAddress), nil).Once()
+			},
+			req: &restv1.ListIPAddressesRequest{Filter: `address = "10.1.2.3/24"`, PageSize: 10},
+		},
+		{
+			name: "List IP addresses error",
+			mocks: func(mockedClient *mock.Mock) {
+				mockedClient.On("List", mock.Anything, mock.Anything).Return(nil, errors.New("error")).Once()
+			},
+			req:     &restv1.ListIPAddressesRequest{},
+			wantErr: true,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockedClient := newMockedInventoryTestClient()
+			server := inv_server.InventorygRPCServer{InvClient: mockedClient}
+			tc.mocks(&mockedClient.Mock)
+
+			resp, err := server.ListIPAddresses(context.Background(), tc.req)
+			mockedClient.AssertExpectations(t)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, resp.GetIpAddresses(), 1)
+			assert.Equal(t, int32(1), resp.GetTotalElements())
+			compareProtoMessages(t, exampleAPIIPAddress, resp.GetIpAddresses()[0])
+		})
+	}
+}
+
+func TestIPAddress_Get(t *testing.T) {
+	mockedClient := newMockedInventoryTestClient()
+	server := inv_server.InventorygRPCServer{InvClient: mockedClient}
+
+	mockedClient.On("Get", mock.Anything, "ipaddr-12345678").Return(&inventory.GetResourceResponse{
+		Resource: &inventory.Resource{Resource: &inventory.Resource_Ipaddress{Ipaddress: exampleInvIPAddress}},
+	}, nil).Once()
+	mockedClient.On("Get", mock.Anything, "hostnic-12345678").Return(&inventory.GetResourceResponse{
+		Resource: &inventory.Resource{Resource: &inventory.Resource_Hostnic{Hostnic: exampleInvHostnic}},
+	}, nil).Once()
+
+	ipAddress, err := server.GetIPAddress(context.Background(), &restv1.GetIPAddressRequest{ResourceId: "ipaddr-12345678"})
+	require.NoError(t, err)
+	compareProtoMessages(t, exampleAPIIPAddress, ipAddress)
+
+	// Resources of other kinds are not IP addresses.
+	_, err = server.GetIPAddress(context.Background(), &restv1.GetIPAddressRequest{ResourceId: "hostnic-12345678"})
+	assert.Error(t, err)
+	mockedClient.AssertExpectations(t)
+}
+
+func TestHostnic_List(t *testing.T) {
+	mockedClient := newMockedInventoryTestClient()
+	server := inv_server.InventorygRPCServer{InvClient: mockedClient}
+
+	mockedClient.On("List", mock.Anything, mock.MatchedBy(func(f *inventory.ResourceFilter) bool {
+		return f.GetResource().GetHostnic() != nil && f.GetFilter() == `host.resource_id = "host-12345678"`
+	})).Return(&inventory.ListResourcesResponse{
+		Resources: []*inventory.GetResourceResponse{{
+			Resource: &inventory.Resource{Resource: &inventory.Resource_Hostnic{Hostnic: exampleInvHostnic}},
+		}},
+		TotalElements: 1,
+	}, nil).Once()
+	// IP addresses of the NIC.
+	mockedClient.On("List", mock.Anything, mock.MatchedBy(func(f *inventory.ResourceFilter) bool {
+		return f.GetFilter() == `nic.resource_id = "hostnic-12345678"`
+	})).Return(ipAddressListResponse(exampleInvIPAddress), nil).Once()
+
+	resp, err := server.ListHostnics(context.Background(), &restv1.ListHostnicsRequest{
+		Filter: `host.resource_id = "host-12345678"`,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.GetNics(), 1)
+	compareProtoMessages(t, &computev1.HostnicResource{
+		ResourceId: "hostnic-12345678",
+		HostId:     "host-12345678",
+		DeviceName: "eth0",
+		MacAddr:    "aa:bb:cc:dd:ee:ff",
+	}, resp.GetNics()[0])
+	require.Len(t, resp.GetNics()[0].GetIpaddresses(), 1)
+	compareProtoMessages(t, exampleAPIIPAddress, resp.GetNics()[0].GetIpaddresses()[0])
+	mockedClient.AssertExpectations(t)
+}
+
+func TestHostnic_Get(t *testing.T) {
+	mockedClient := newMockedInventoryTestClient()
+	server := inv_server.InventorygRPCServer{InvClient: mockedClient}
+
+	mockedClient.On("Get", mock.Anything, "hostnic-12345678").Return(&inventory.GetResourceResponse{
+		Resource: &inventory.Resource{Resource: &inventory.Resource_Hostnic{Hostnic: exampleInvHostnic}},
+	}, nil).Once()
+	mockedClient.On("List", mock.Anything, mock.Anything).Return(ipAddressListResponse(), nil).Once()
+	mockedClient.On("Get", mock.Anything, "hostnic-87654321").Return(nil, errors.New("not found")).Once()
+
+	nic, err := server.GetHostnic(context.Background(), &restv1.GetHostnicRequest{ResourceId: "hostnic-12345678"})
+	require.NoError(t, err)
+	assert.Equal(t, "hostnic-12345678", nic.GetResourceId())
+	assert.Equal(t, "host-12345678", nic.GetHostId())
+	assert.Empty(t, nic.GetIpaddresses())
+
+	_, err = server.GetHostnic(context.Background(), &restv1.GetHostnicRequest{ResourceId: "hostnic-87654321"})
+	assert.Error(t, err)
+	mockedClient.AssertExpectations(t)
+}
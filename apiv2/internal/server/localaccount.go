@@ -6,6 +6,9 @@ package server
 import (
 	"context"
 
+	"golang.org/x/exp/maps"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
 	localaccountv1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/resources/localaccount/v1"
 	restv1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/services/v1"
 	inventory "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/inventory/v1"
@@ -14,6 +17,14 @@ import (
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/validator"
 )
 
+// OpenAPILocalAccountToProto maps OpenAPI fields name to Proto fields name.
+// The key is derived from the json property respectively of the
+// structs LocalAccount defined in edge-infra-manager-openapi-types.gen.go.
+var OpenAPILocalAccountToProto = map[string]string{
+	localaccountv1.LocalAccountResourceFieldUsername: inv_localaccountv1.LocalAccountResourceFieldUsername,
+	localaccountv1.LocalAccountResourceFieldSshKey:   inv_localaccountv1.LocalAccountResourceFieldSshKey,
+}
+
 func toInvLocalAccount(localaccount *localaccountv1.LocalAccountResource) (*inv_localaccountv1.LocalAccountResource, error) {
 	if localaccount == nil {
 		return &inv_localaccountv1.LocalAccountResource{}, nil
@@ -72,7 +83,7 @@ func (is *InventorygRPCServer) CreateLocalAccount(
 	}
 
 	localaccountCreated := fromInvLocalAccount(invResp.GetLocalAccount())
-	zlog.Debug().Msgf("Created %s", localaccountCreated)
+	zlog.Debug().Msgf("Created %s", redact(localaccountCreated))
 	return localaccountCreated, nil
 }
 
@@ -113,7 +124,7 @@ func (is *InventorygRPCServer) ListLocalAccounts(
 		TotalElements: invResp.GetTotalElements(),
 		HasNext:       invResp.GetHasNext(),
 	}
-	zlog.Debug().Msgf("Listed %s", resp)
+	zlog.Debug().Msgf("Listed %s", redact(resp))
 	return resp, nil
 }
 
@@ -132,10 +143,76 @@ func (is *InventorygRPCServer) GetLocalAccount(
 
 	invLocalAccount := invResp.GetResource().GetLocalAccount()
 	localaccount := fromInvLocalAccount(invLocalAccount)
-	zlog.Debug().Msgf("Got %s", localaccount)
+	zlog.Debug().Msgf("Got %s", redact(localaccount))
 	return localaccount, nil
 }
 
+// Update a localaccount. (PUT).
+func (is *InventorygRPCServer) UpdateLocalAccount(
+	ctx context.Context,
+	req *restv1.UpdateLocalAccountRequest,
+) (*localaccountv1.LocalAccountResource, error) {
+	zlog.Debug().Msg("UpdateLocalAccount")
+
+	invLocalAccount, err := toInvLocalAccount(req.GetLocalAccount())
+	if err != nil {
+		zlog.InfraErr(err).Msg("Failed to convert to inventory localaccount")
+		return nil, errors.Wrap(err)
+	}
+
+	fieldmask, err := fieldmaskpb.New(invLocalAccount, maps.Values(OpenAPILocalAccountToProto)...)
+	if err != nil {
+		zlog.InfraErr(err).Msg("Failed to create field mask")
+		return nil, errors.Wrap(err)
+	}
+
+	invRes := &inventory.Resource{
+		Resource: &inventory.Resource_LocalAccount{
+			LocalAccount: invLocalAccount,
+		},
+	}
+	upRes, err := is.InvClient.Update(ctx, req.GetResourceId(), fieldmask, invRes)
+	if err != nil {
+		zlog.InfraErr(err).Msgf("failed to update inventory resource %s %s", req.GetResourceId(), redact(invRes))
+		return nil, errors.Wrap(err)
+	}
+	invUpRes := fromInvLocalAccount(upRes.GetLocalAccount())
+	zlog.Debug().Msgf("Updated %s", redact(invUpRes))
+	return invUpRes, nil
+}
+
+// Update a localaccount. (PATCH).
+func (is *InventorygRPCServer) PatchLocalAccount(
+	ctx context.Context,
+	req *restv1.PatchLocalAccountRequest,
+) (*localaccountv1.LocalAccountResource, error) {
+	zlog.Debug().Msg("PatchLocalAccount")
+
+	invLocalAccount, err := toInvLocalAccount(req.GetLocalAccount())
+	if err != nil {
+		zlog.InfraErr(err).Msg("Failed to convert to inventory localaccount")
+		return nil, errors.Wrap(err)
+	}
+
+	fieldmask, err := parseFielmask(invLocalAccount, req.GetFieldMask(), OpenAPILocalAccountToProto)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	invRes := &inventory.Resource{
+		Resource: &inventory.Resource_LocalAccount{
+			LocalAccount: invLocalAccount,
+		},
+	}
+	upRes, err := is.InvClient.Update(ctx, req.GetResourceId(), fieldmask, invRes)
+	if err != nil {
+		zlog.InfraErr(err).Msgf("failed to update inventory resource %s %s", req.GetResourceId(), redact(invRes))
+		return nil, errors.Wrap(err)
+	}
+	invUpRes := fromInvLocalAccount(upRes.GetLocalAccount())
+	zlog.Debug().Msgf("Updated %s", redact(invUpRes))
+	return invUpRes, nil
+}
+
 // Delete a localaccount.
 func (is *InventorygRPCServer) DeleteLocalAccount(
 	ctx context.Context,
@@ -0,0 +1,202 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package server_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	localaccountv1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/resources/localaccount/v1"
+	restv1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/services/v1"
+	inv_server "github.com/open-edge-platform/infra-core/apiv2/v2/internal/server"
+	inventory "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/inventory/v1"
+	inv_localaccountv1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/localaccount/v1"
+)
+
+const exampleSSHKey = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIDsE0fXxoFJNOr3gZ8m1Aq6zWBRQnnJLoBOkgRB6RXkE user@example.com"
+
+// Example resources for testing.
+var (
+	// Example local account resource from the API.
+	exampleAPILocalAccountResource = &localaccountv1.LocalAccountResource{
+		ResourceId:     "localaccount-12345678",
+		LocalAccountID: "localaccount-12345678", // Alias of ResourceId
+		Username:       "user",
+		SshKey:         exampleSSHKey,
+	}
+
+	// Example local account resource from the Inventory.
+	exampleInvLocalAccountResource = &inv_localaccountv1.LocalAccountResource{
+		ResourceId: "localaccount-12345678",
+		Username:   "user",
+		SshKey:     exampleSSHKey,
+		TenantId:   "tenant-987654",
+	}
+)
+
+func TestLocalAccount_Update(t *testing.T) {
+	mockedClient := newMockedInventoryTestClient()
+	server := inv_server.InventorygRPCServer{InvClient: mockedClient}
+
+	cases := []struct {
+		name    string
+		mocks   func() []*mock.Call
+		ctx     context.Context
+		req     *restv1.UpdateLocalAccountRequest
+		wantErr bool
+	}{
+		{
+			name: "Update LocalAccount",
+			mocks: func() []*mock.Call {
+				return []*mock.Call{
+					mockedClient.On("Update", mock.Anything, "localaccount-12345678",
+						mock.MatchedBy(func(fm *fieldmaskpb.FieldMask) bool {
+							return assert.ElementsMatch(t, []string{"username", "ssh_key"}, fm.GetPaths())
+						}), mock.Anything).
+						Return(&inventory.Resource{
+							Resource: &inventory.Resource_LocalAccount{
+								LocalAccount: exampleInvLocalAccountResource,
+							},
+						}, nil).Once(),
+				}
+			},
+			ctx: context.Background(),
+			req: &restv1.UpdateLocalAccountRequest{
+				ResourceId:   "localaccount-12345678",
+				LocalAccount: exampleAPILocalAccountResource,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Update LocalAccount with invalid resource",
+			ctx:  context.Background(),
+			req: &restv1.UpdateLocalAccountRequest{
+				ResourceId:   "localaccount-12345678",
+				LocalAccount: &localaccountv1.LocalAccountResource{Username: "user", SshKey: "not a key"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Update LocalAccount with error",
+			mocks: func() []*mock.Call {
+				return []*mock.Call{
+					mockedClient.On("Update", mock.Anything, "localaccount-12345678", mock.Anything, mock.Anything).
+						Return(nil, errors.New("error")).Once(),
+				}
+			},
+			ctx: context.Background(),
+			req: &restv1.UpdateLocalAccountRequest{
+				ResourceId:   "localaccount-12345678",
+				LocalAccount: exampleAPILocalAccountResource,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.mocks != nil {
+				tc.mocks()
+			}
+
+			reply, err := server.UpdateLocalAccount(tc.ctx, tc.req)
+			if tc.wantErr {
+				if err == nil {
+					t.Errorf("UpdateLocalAccount() got err = nil, want err")
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("UpdateLocalAccount() got err = %v, want nil", err)
+				return
+			}
+			if reply == nil {
+				t.Errorf("UpdateLocalAccount() got reply = nil, want non-nil")
+				return
+			}
+			compareProtoMessages(t, exampleAPILocalAccountResource, reply)
+		})
+	}
+}
+
+func TestLocalAccount_Patch(t *testing.T) {
+	mockedClient := newMockedInventoryTestClient()
+	server := inv_server.InventorygRPCServer{InvClient: mockedClient}
+
+	cases := []struct {
+		name    string
+		mocks   func() []*mock.Call
+		ctx     context.Context
+		req     *restv1.PatchLocalAccountRequest
+		wantErr bool
+	}{
+		{
+			name: "Patch LocalAccount",
+			mocks: func() []*mock.Call {
+				return []*mock.Call{
+					mockedClient.On("Update", mock.Anything, "localaccount-12345678",
+						mock.MatchedBy(func(fm *fieldmaskpb.FieldMask) bool {
+							return assert.ElementsMatch(t, []string{"ssh_key"}, fm.GetPaths())
+						}), mock.Anything).
+						Return(&inventory.Resource{
+							Resource: &inventory.Resource_LocalAccount{
+								LocalAccount: exampleInvLocalAccountResource,
+							},
+						}, nil).Once(),
+				}
+			},
+			ctx: context.Background(),
+			req: &restv1.PatchLocalAccountRequest{
+				ResourceId:   "localaccount-12345678",
+				LocalAccount: exampleAPILocalAccountResource,
+				FieldMask:    &fieldmaskpb.FieldMask{Paths: []string{"ssh_key"}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Patch LocalAccount with error",
+			mocks: func() []*mock.Call {
+				return []*mock.Call{
+					mockedClient.On("Update", mock.Anything, "localaccount-12345678", mock.Anything, mock.Anything).
+						Return(nil, errors.New("error")).Once(),
+				}
+			},
+			ctx: context.Background(),
+			req: &restv1.PatchLocalAccountRequest{
+				ResourceId:   "localaccount-12345678",
+				LocalAccount: exampleAPILocalAccountResource,
+				FieldMask:    &fieldmaskpb.FieldMask{Paths: []string{"ssh_key"}},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.mocks != nil {
+				tc.mocks()
+			}
+
+			reply, err := server.PatchLocalAccount(tc.ctx, tc.req)
+			if tc.wantErr {
+				if err == nil {
+					t.Errorf("PatchLocalAccount() got err = nil, want err")
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("PatchLocalAccount() got err = %v, want nil", err)
+				return
+			}
+			if reply == nil {
+				t.Errorf("PatchLocalAccount() got reply = nil, want non-nil")
+				return
+			}
+			compareProtoMessages(t, exampleAPILocalAccountResource, reply)
+		})
+	}
+}
@@ -12,6 +12,7 @@ import (
 	restv1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/services/v1"
 	inventory "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/inventory/v1"
 	inv_locationv1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/location/v1"
+	inv_ouv1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/ou/v1"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/client"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/util"
@@ -31,9 +32,9 @@ func (is *InventorygRPCServer) validateQueryParams(req *restv1.ListLocationsRequ
 		return err
 	}
 
-	if !req.GetShowRegions() && !req.GetShowSites() {
+	if !req.GetShowRegions() && !req.GetShowSites() && !req.GetShowOus() {
 		zlog.InfraSec().InfraErr(err).Msg("failed to validate GetLocationsParams, " +
-			"at least one of showSites or showRegions must be provided")
+			"at least one of showSites, showRegions or showOus must be provided")
 		return err
 	}
 
@@ -51,6 +52,8 @@ func (is *InventorygRPCServer) getLocationIDs(
 		resource = &inventory.Resource{Resource: &inventory.Resource_Region{Region: &inv_locationv1.RegionResource{}}}
 	case inventory.ResourceKind_RESOURCE_KIND_SITE:
 		resource = &inventory.Resource{Resource: &inventory.Resource_Site{Site: &inv_locationv1.SiteResource{}}}
+	case inventory.ResourceKind_RESOURCE_KIND_OU:
+		resource = &inventory.Resource{Resource: &inventory.Resource_Ou{Ou: &inv_ouv1.OuResource{}}}
 	default:
 		err = errors.Errorfc(codes.Internal, "invalid resource type %v", resType)
 		zlog.InfraErr(err).Msg("invalid resource type")
@@ -88,6 +91,7 @@ func getLocationType(invKind inventory.ResourceKind) (restv1.ListLocationsRespon
 	locMap := map[inventory.ResourceKind]restv1.ListLocationsResponse_ResourceKind{
 		inventory.ResourceKind_RESOURCE_KIND_REGION: restv1.ListLocationsResponse_RESOURCE_KIND_REGION,
 		inventory.ResourceKind_RESOURCE_KIND_SITE:   restv1.ListLocationsResponse_RESOURCE_KIND_SITE,
+		inventory.ResourceKind_RESOURCE_KIND_OU:     restv1.ListLocationsResponse_RESOURCE_KIND_OU,
 	}
 
 	apiType, ok := locMap[invKind]
@@ -99,15 +103,23 @@ func getLocationType(invKind inventory.ResourceKind) (restv1.ListLocationsRespon
 	return apiType, nil
 }
 
-func getParentID(locNode *inventory.GetTreeHierarchyResponse_TreeNode) string {
-	// Filters parentIDs (removes possible OU resources)
+func getParentID(locNode *inventory.GetTreeHierarchyResponse_TreeNode, showOus bool) string {
+	// Filters parentIDs (removes possible OU resources, unless requested)
 	parentIDs := []string{}
+	ouParentIDs := []string{}
 	for _, parentNode := range locNode.GetParentNodes() {
-		if parentNode.ResourceKind == inventory.ResourceKind_RESOURCE_KIND_REGION ||
-			parentNode.ResourceKind == inventory.ResourceKind_RESOURCE_KIND_SITE {
+		switch parentNode.GetResourceKind() {
+		case inventory.ResourceKind_RESOURCE_KIND_REGION, inventory.ResourceKind_RESOURCE_KIND_SITE:
 			parentIDs = append(parentIDs, parentNode.GetResourceId())
+		case inventory.ResourceKind_RESOURCE_KIND_OU:
+			if showOus {
+				ouParentIDs = append(ouParentIDs, parentNode.GetResourceId())
+			}
+		default:
 		}
 	}
+	// A site that is part of both a region and an OU is shown under its region.
+	parentIDs = append(parentIDs, ouParentIDs...)
 	var parentID string
 	// Region and Site have only one parentID, when existent.
 	if len(parentIDs) > 0 {
@@ -122,12 +134,14 @@ func getParentID(locNode *inventory.GetTreeHierarchyResponse_TreeNode) string {
 
 func inventoryTreeToAPITree(
 	treeResp []*inventory.GetTreeHierarchyResponse_TreeNode,
+	showOus bool,
 ) ([]*restv1.ListLocationsResponse_LocationNode, error) {
-	// Filters tree response (removes possible OU or Host resources in the response)
+	// Filters tree response (removes possible Host resources, and OU resources unless requested, in the response)
 	locationNodes := []*inventory.GetTreeHierarchyResponse_TreeNode{}
 	for _, node := range treeResp {
 		if node.CurrentNode.ResourceKind == inventory.ResourceKind_RESOURCE_KIND_REGION ||
-			node.CurrentNode.ResourceKind == inventory.ResourceKind_RESOURCE_KIND_SITE {
+			node.CurrentNode.ResourceKind == inventory.ResourceKind_RESOURCE_KIND_SITE ||
+			(showOus && node.CurrentNode.ResourceKind == inventory.ResourceKind_RESOURCE_KIND_OU) {
 			locationNodes = append(locationNodes, node)
 		}
 	}
@@ -140,7 +154,7 @@ func inventoryTreeToAPITree(
 			return nil, err
 		}
 
-		parentID := getParentID(locNode)
+		parentID := getParentID(locNode, showOus)
 		locationNode := &restv1.ListLocationsResponse_LocationNode{
 			ResourceId: resID,
 			Name:       resName,
@@ -156,6 +170,7 @@ func inventoryTreeToAPITree(
 func (is *InventorygRPCServer) getLocationTree(
 	ctx context.Context,
 	locationIDs []string,
+	showOus bool,
 ) (*restv1.ListLocationsResponse, error) {
 	zlog.Debug().Msg("getLocationTree")
 
@@ -177,14 +192,14 @@ func (is *InventorygRPCServer) getLocationTree(
 		return apiLocList, errors.Wrap(err)
 	}
 
-	apiLocNodes, err := inventoryTreeToAPITree(treeResp)
+	apiLocNodes, err := inventoryTreeToAPITree(treeResp, showOus)
 	if err != nil {
 		zlog.InfraErr(err).Msg("failed to convert inventory tree to API tree")
 		return apiLocList, err
 	}
 
 	apiLocList.Nodes = apiLocNodes
-	zlog.Debug().Msgf("LocationTree %s", apiLocList)
+	zlog.Debug().Msgf("LocationTree %s", redact(apiLocList))
 	return apiLocList, nil
 }
 
@@ -215,6 +230,16 @@ func (is *InventorygRPCServer) getAllLocationIDs(
 		outputElements += len(siteIDs)
 	}
 
+	if req.GetShowOus() {
+		ouIDs, totalOus, errOu := is.getLocationIDs(ctx, req, inventory.ResourceKind_RESOURCE_KIND_OU)
+		if errOu != nil {
+			return nil, 0, 0, errOu
+		}
+		allIDs = append(allIDs, ouIDs...)
+		totalElements += totalOus
+		outputElements += len(ouIDs)
+	}
+
 	return allIDs, totalElements, outputElements, nil
 }
 
@@ -236,7 +261,7 @@ func (is *InventorygRPCServer) ListLocations(
 		return nil, err
 	}
 
-	resp, err := is.getLocationTree(ctx, allIDs)
+	resp, err := is.getLocationTree(ctx, allIDs, req.GetShowOus())
 	if err != nil {
 		zlog.InfraErr(err).Msg("failed to get location tree")
 		return nil, err
@@ -249,6 +274,6 @@ func (is *InventorygRPCServer) ListLocations(
 		return nil, errors.Wrap(err)
 	}
 	resp.OutputElements = outElements
-	zlog.Debug().Msgf("Listed %s", resp)
+	zlog.Debug().Msgf("Listed %s", redact(resp))
 	return resp, nil
 }
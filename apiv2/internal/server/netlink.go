@@ -0,0 +1,162 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+
+	networkv1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/resources/network/v1"
+	restv1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/services/v1"
+	inventory "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/inventory/v1"
+	inv_networkv1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/network/v1"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/validator"
+)
+
+func fromInvEndpoint(invEndpoint *inv_networkv1.EndpointResource) *networkv1.EndpointResource {
+	if invEndpoint == nil {
+		return nil
+	}
+	return &networkv1.EndpointResource{
+		ResourceId: invEndpoint.GetResourceId(),
+		Kind:       invEndpoint.GetKind(),
+		Name:       invEndpoint.GetName(),
+		HostId:     invEndpoint.GetHost().GetResourceId(),
+		Timestamps: GrpcToOpenAPITimestamps(invEndpoint),
+	}
+}
+
+func fromInvNetlink(invNetlink *inv_networkv1.NetlinkResource) *networkv1.NetlinkResource {
+	if invNetlink == nil {
+		return &networkv1.NetlinkResource{}
+	}
+	return &networkv1.NetlinkResource{
+		ResourceId:     invNetlink.GetResourceId(),
+		Kind:           invNetlink.GetKind(),
+		Name:           invNetlink.GetName(),
+		DesiredState:   networkv1.NetlinkState(invNetlink.GetDesiredState()),
+		CurrentState:   networkv1.NetlinkState(invNetlink.GetCurrentState()),
+		ProviderStatus: invNetlink.GetProviderStatus(),
+		Src:            fromInvEndpoint(invNetlink.GetSrc()),
+		Dst:            fromInvEndpoint(invNetlink.GetDst()),
+		Timestamps:     GrpcToOpenAPITimestamps(invNetlink),
+	}
+}
+
+// Get a list of endpoints.
+func (is *InventorygRPCServer) ListEndpoints(
+	ctx context.Context,
+	req *restv1.ListEndpointsRequest,
+) (*restv1.ListEndpointsResponse, error) {
+	zlog.Debug().Msg("ListEndpoints")
+
+	filter := &inventory.ResourceFilter{
+		Resource: &inventory.Resource{Resource: &inventory.Resource_Endpoint{Endpoint: &inv_networkv1.EndpointResource{}}},
+		Offset:   req.GetOffset(),
+		Limit:    req.GetPageSize(),
+		OrderBy:  req.GetOrderBy(),
+		Filter:   req.GetFilter(),
+	}
+	if err := validator.ValidateMessage(filter); err != nil {
+		zlog.InfraSec().InfraErr(err).Msg("failed to validate query params")
+		return nil, errors.Wrap(err)
+	}
+
+	invResp, err := is.InvClient.List(ctx, filter)
+	if err != nil {
+		zlog.InfraErr(err).Msg("Failed to list endpoints from inventory")
+		return nil, errors.Wrap(err)
+	}
+
+	endpoints := make([]*networkv1.EndpointResource, 0, len(invResp.GetResources()))
+	for _, invRes := range invResp.GetResources() {
+		endpoints = append(endpoints, fromInvEndpoint(invRes.GetResource().GetEndpoint()))
+	}
+
+	resp := &restv1.ListEndpointsResponse{
+		Endpoints:     endpoints,
+		TotalElements: invResp.GetTotalElements(),
+		HasNext:       invResp.GetHasNext(),
+	}
+	zlog.Debug().Msgf("Listed %s", redact(resp))
+	return resp, nil
+}
+
+// Get a specific endpoint.
+func (is *InventorygRPCServer) GetEndpoint(
+	ctx context.Context,
+	req *restv1.GetEndpointRequest,
+) (*networkv1.EndpointResource, error) {
+	zlog.Debug().Msg("GetEndpoint")
+
+	invResp, err := is.InvClient.Get(ctx, req.GetResourceId())
+	if err != nil {
+		zlog.InfraErr(err).Msgf("failed to get inventory resource %s", req.GetResourceId())
+		return nil, errors.Wrap(err)
+	}
+
+	endpoint := fromInvEndpoint(invResp.GetResource().GetEndpoint())
+	if endpoint == nil {
+		endpoint = &networkv1.EndpointResource{}
+	}
+	zlog.Debug().Msgf("Got %s", redact(endpoint))
+	return endpoint, nil
+}
+
+// Get a list of netlinks.
+func (is *InventorygRPCServer) ListNetlinks(
+	ctx context.Context,
+	req *restv1.ListNetlinksRequest,
+) (*restv1.ListNetlinksResponse, error) {
+	zlog.Debug().Msg("ListNetlinks")
+
+	filter := &inventory.ResourceFilter{
+		Resource: &inventory.Resource{Resource: &inventory.Resource_Netlink{Netlink: &inv_networkv1.NetlinkResource{}}},
+		Offset:   req.GetOffset(),
+		Limit:    req.GetPageSize(),
+		OrderBy:  req.GetOrderBy(),
+		Filter:   req.GetFilter(),
+	}
+	if err := validator.ValidateMessage(filter); err != nil {
+		zlog.InfraSec().InfraErr(err).Msg("failed to validate query params")
+		return nil, errors.Wrap(err)
+	}
+
+	invResp, err := is.InvClient.List(ctx, filter)
+	if err != nil {
+		zlog.InfraErr(err).Msg("Failed to list netlinks from inventory")
+		return nil, errors.Wrap(err)
+	}
+
+	netlinks := make([]*networkv1.NetlinkResource, 0, len(invResp.GetResources()))
+	for _, invRes := range invResp.GetResources() {
+		netlinks = append(netlinks, fromInvNetlink(invRes.GetResource().GetNetlink()))
+	}
+
+	resp := &restv1.ListNetlinksResponse{
+		Netlinks:      netlinks,
+		TotalElements: invResp.GetTotalElements(),
+		HasNext:       invResp.GetHasNext(),
+	}
+	zlog.Debug().Msgf("Listed %s", redact(resp))
+	return resp, nil
+}
+
+// Get a specific netlink.
+func (is *InventorygRPCServer) GetNetlink(
+	ctx context.Context,
+	req *restv1.GetNetlinkRequest,
+) (*networkv1.NetlinkResource, error) {
+	zlog.Debug().Msg("GetNetlink")
+
+	invResp, err := is.InvClient.Get(ctx, req.GetResourceId())
+	if err != nil {
+		zlog.InfraErr(err).Msgf("failed to get inventory resource %s", req.GetResourceId())
+		return nil, errors.Wrap(err)
+	}
+
+	netlink := fromInvNetlink(invResp.GetResource().GetNetlink())
+	zlog.Debug().Msgf("Got %s", redact(netlink))
+	return netlink, nil
+}
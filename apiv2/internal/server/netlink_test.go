@@ -0,0 +1,92 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package server_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	networkv1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/resources/network/v1"
+	restv1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/services/v1"
+	inv_server "github.com/open-edge-platform/infra-core/apiv2/v2/internal/server"
+	inv_computev1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/compute/v1"
+	inventory "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/inventory/v1"
+	inv_networkv1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/network/v1"
+)
+
+var (
+	exampleInvEndpoint = &inv_networkv1.EndpointResource{
+		ResourceId: "endpoint-12345678",
+		Name:       "eth0",
+		Host:       &inv_computev1.HostResource{ResourceId: "host-12345678"},
+	}
+	exampleInvNetlink = &inv_networkv1.NetlinkResource{
+		ResourceId:   "netlink-12345678",
+		Name:         "link",
+		DesiredState: inv_networkv1.NetlinkState_NETLINK_STATE_ONLINE,
+		CurrentState: inv_networkv1.NetlinkState_NETLINK_STATE_OFFLINE,
+		Src:          exampleInvEndpoint,
+	}
+)
+
+func TestEndpoint_ListGet(t *testing.T) {
+	mockedClient := newMockedInventoryTestClient()
+	server := inv_server.InventorygRPCServer{InvClient: mockedClient}
+	endpointRes := &inventory.Resource{Resource: &inventory.Resource_Endpoint{Endpoint: exampleInvEndpoint}}
+
+	mockedClient.On("List", mock.Anything, mock.MatchedBy(func(f *inventory.ResourceFilter) bool {
+		return f.GetResource().GetEndpoint() != nil
+	})).Return(&inventory.ListResourcesResponse{
+		Resources:     []*inventory.GetResourceResponse{{Resource: endpointRes}},
+		TotalElements: 1,
+	}, nil).Once()
+	mockedClient.On("Get", mock.Anything, "endpoint-12345678").
+		Return(&inventory.GetResourceResponse{Resource: endpointRes}, nil).Once()
+
+	listResp, err := server.ListEndpoints(context.Background(), &restv1.ListEndpointsRequest{})
+	require.NoError(t, err)
+	require.Len(t, listResp.GetEndpoints(), 1)
+	assert.Equal(t, "host-12345678", listResp.GetEndpoints()[0].GetHostId())
+
+	endpoint, err := server.GetEndpoint(context.Background(), &restv1.GetEndpointRequest{ResourceId: "endpoint-12345678"})
+	require.NoError(t, err)
+	assert.Equal(t, "eth0", endpoint.GetName())
+	mockedClient.AssertExpectations(t)
+}
+
+func TestNetlink_ListGet(t *testing.T) {
+	mockedClient := newMockedInventoryTestClient()
+	server := inv_server.InventorygRPCServer{InvClient: mockedClient}
+	netlinkRes := &inventory.Resource{Resource: &inventory.Resource_Netlink{Netlink: exampleInvNetlink}}
+
+	mockedClient.On("List", mock.Anything, mock.MatchedBy(func(f *inventory.ResourceFilter) bool {
+		return f.GetResource().GetNetlink() != nil
+	})).Return(&inventory.ListResourcesResponse{
+		Resources:     []*inventory.GetResourceResponse{{Resource: netlinkRes}},
+		TotalElements: 1,
+	}, nil).Once()
+	mockedClient.On("Get", mock.Anything, "netlink-12345678").
+		Return(&inventory.GetResourceResponse{Resource: netlinkRes}, nil).Once()
+	mockedClient.On("Get", mock.Anything, "netlink-87654321").Return(nil, errors.New("not found")).Once()
+
+	listResp, err := server.ListNetlinks(context.Background(), &restv1.ListNetlinksRequest{})
+	require.NoError(t, err)
+	require.Len(t, listResp.GetNetlinks(), 1)
+
+	netlink, err := server.GetNetlink(context.Background(), &restv1.GetNetlinkRequest{ResourceId: "netlink-12345678"})
+	require.NoError(t, err)
+	assert.Equal(t, networkv1.NetlinkState_NETLINK_STATE_ONLINE, netlink.GetDesiredState())
+	assert.Equal(t, networkv1.NetlinkState_NETLINK_STATE_OFFLINE, netlink.GetCurrentState())
+	assert.Equal(t, "endpoint-12345678", netlink.GetSrc().GetResourceId())
+	assert.Nil(t, netlink.GetDst())
+
+	_, err = server.GetNetlink(context.Background(), &restv1.GetNetlinkRequest{ResourceId: "netlink-87654321"})
+	assert.Error(t, err)
+	mockedClient.AssertExpectations(t)
+}
@@ -0,0 +1,262 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+
+	"golang.org/x/exp/maps"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	networkv1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/resources/network/v1"
+	restv1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/services/v1"
+	inventory "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/inventory/v1"
+	inv_locationv1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/location/v1"
+	inv_networkv1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/network/v1"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/validator"
+)
+
+// OpenAPINetworkSegmentToProto maps OpenAPI fields name to Proto fields name.
+var OpenAPINetworkSegmentToProto = map[string]string{
+	networkv1.NetworkSegmentResourceFieldName:   inv_networkv1.NetworkSegmentFieldName,
+	networkv1.NetworkSegmentResourceFieldVlanId: inv_networkv1.NetworkSegmentFieldVlanId,
+	networkv1.NetworkSegmentResourceFieldSiteId: inv_networkv1.NetworkSegmentEdgeSite,
+}
+
+func toInvNetworkSegment(segment *networkv1.NetworkSegmentResource) (*inv_networkv1.NetworkSegment, error) {
+	if segment == nil {
+		return &inv_networkv1.NetworkSegment{}, nil
+	}
+	invSegment := &inv_networkv1.NetworkSegment{
+		ResourceId: segment.GetResourceId(),
+		Name:       segment.GetName(),
+		VlanId:     segment.GetVlanId(),
+	}
+
+	siteID := segment.GetSiteId()
+	if isSet(&siteID) {
+		invSegment.Site = &inv_locationv1.SiteResource{
+			ResourceId: siteID,
+		}
+	}
+
+	err := validator.ValidateMessage(invSegment)
+	if err != nil {
+		zlog.InfraErr(err).Msg("Failed to validate inventory resource")
+		return nil, err
+	}
+	return invSegment, nil
+}
+
+func fromInvNetworkSegment(invSegment *inv_networkv1.NetworkSegment) (*networkv1.NetworkSegmentResource, error) {
+	if invSegment == nil {
+		return &networkv1.NetworkSegmentResource{}, nil
+	}
+
+	site, err := fromInvSite(invSegment.GetSite(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &networkv1.NetworkSegmentResource{
+		ResourceId: invSegment.GetResourceId(),
+		Name:       invSegment.GetName(),
+		VlanId:     invSegment.GetVlanId(),
+		Site:       site,
+		Timestamps: GrpcToOpenAPITimestamps(invSegment),
+	}, nil
+}
+
+// Create a network segment.
+func (is *InventorygRPCServer) CreateNetworkSegment(
+	ctx context.Context,
+	req *restv1.CreateNetworkSegmentRequest,
+) (*networkv1.NetworkSegmentResource, error) {
+	zlog.Debug().Msg("CreateNetworkSegment")
+
+	invSegment, err := toInvNetworkSegment(req.GetNetworkSegment())
+	if err != nil {
+		zlog.InfraErr(err).Msg("Failed to convert to inventory network segment")
+		return nil, errors.Wrap(err)
+	}
+
+	invRes := &inventory.Resource{
+		Resource: &inventory.Resource_NetworkSegment{
+			NetworkSegment: invSegment,
+		},
+	}
+	invResp, err := is.InvClient.Create(ctx, invRes)
+	if err != nil {
+		zlog.InfraErr(err).Msg("Failed to create network segment in inventory")
+		return nil, errors.Wrap(err)
+	}
+
+	segmentCreated, err := fromInvNetworkSegment(invResp.GetNetworkSegment())
+	if err != nil {
+		zlog.InfraErr(err).Msg("Failed to convert from inventory network segment")
+		return nil, errors.Wrap(err)
+	}
+	zlog.Debug().Msgf("Created %s", redact(segmentCreated))
+	return segmentCreated, nil
+}
+
+// Get a list of network segments.
+func (is *InventorygRPCServer) ListNetworkSegments(
+	ctx context.Context,
+	req *restv1.ListNetworkSegmentsRequest,
+) (*restv1.ListNetworkSegmentsResponse, error) {
+	zlog.Debug().Msg("ListNetworkSegments")
+
+	filter := &inventory.ResourceFilter{
+		Resource: &inventory.Resource{
+			Resource: &inventory.Resource_NetworkSegment{NetworkSegment: &inv_networkv1.NetworkSegment{}},
+		},
+		Offset:  req.GetOffset(),
+		Limit:   req.GetPageSize(),
+		OrderBy: req.GetOrderBy(),
+		Filter:  req.GetFilter(),
+	}
+	if err := validator.ValidateMessage(filter); err != nil {
+		zlog.InfraSec().InfraErr(err).Msg("failed to validate query params")
+		return nil, errors.Wrap(err)
+	}
+
+	invResp, err := is.InvClient.List(ctx, filter)
+	if err != nil {
+		zlog.InfraErr(err).Msg("Failed to list network segments from inventory")
+		return nil, errors.Wrap(err)
+	}
+
+	segments := make([]*networkv1.NetworkSegmentResource, 0, len(invResp.GetResources()))
+	for _, invRes := range invResp.GetResources() {
+		segment, err := fromInvNetworkSegment(invRes.GetResource().GetNetworkSegment())
+		if err != nil {
+			zlog.InfraErr(err).Msg("Failed to convert from inventory network segment")
+			return nil, errors.Wrap(err)
+		}
+		segments = append(segments, segment)
+	}
+
+	resp := &restv1.ListNetworkSegmentsResponse{
+		NetworkSegments: segments,
+		TotalElements:   invResp.GetTotalElements(),
+		HasNext:         invResp.GetHasNext(),
+	}
+	zlog.Debug().Msgf("Listed %s", redact(resp))
+	return resp, nil
+}
+
+// Get a specific network segment.
+func (is *InventorygRPCServer) GetNetworkSegment(
+	ctx context.Context,
+	req *restv1.GetNetworkSegmentRequest,
+) (*networkv1.NetworkSegmentResource, error) {
+	zlog.Debug().Msg("GetNetworkSegment")
+
+	invResp, err := is.InvClient.Get(ctx, req.GetResourceId())
+	if err != nil {
+		zlog.InfraErr(err).Msgf("failed to get inventory resource %s", req.GetResourceId())
+		return nil, errors.Wrap(err)
+	}
+
+	segment, err := fromInvNetworkSegment(invResp.GetResource().GetNetworkSegment())
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	zlog.Debug().Msgf("Got %s", redact(segment))
+	return segment, nil
+}
+
+// Update a network segment. (PUT).
+func (is *InventorygRPCServer) UpdateNetworkSegment(
+	ctx context.Context,
+	req *restv1.UpdateNetworkSegmentRequest,
+) (*networkv1.NetworkSegmentResource, error) {
+	zlog.Debug().Msg("UpdateNetworkSegment")
+
+	invSegment, err := toInvNetworkSegment(req.GetNetworkSegment())
+	if err != nil {
+		zlog.InfraErr(err).Msg("Failed to convert to inventory network segment")
+		return nil, errors.Wrap(err)
+	}
+
+	fieldmask, err := fieldmaskpb.New(invSegment, maps.Values(OpenAPINetworkSegmentToProto)...)
+	if err != nil {
+		zlog.InfraErr(err).Msg("Failed to create field mask")
+		return nil, errors.Wrap(err)
+	}
+	return is.updateNetworkSegment(ctx, req.GetResourceId(), fieldmask, invSegment)
+}
+
+// Update a network segment. (PATCH).
+func (is *InventorygRPCServer) PatchNetworkSegment(
+	ctx context.Context,
+	req *restv1.PatchNetworkSegmentRequest,
+) (*networkv1.NetworkSegmentResource, error) {
+	zlog.Debug().Msg("PatchNetworkSegment")
+
+	invSegment, err := toInvNetworkSegment(req.GetNetworkSegment())
+	if err != nil {
+		zlog.InfraErr(err).Msg("Failed to convert to inventory network segment")
+		return nil, errors.Wrap(err)
+	}
+
+	fieldmask, err := parseFielmask(invSegment, req.GetFieldMask(), OpenAPINetworkSegmentToProto)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+
+	// Inventory always replaces the site of the network segment, keep the current one when not patched.
+	if invSegment.GetSite() == nil {
+		invResp, err := is.InvClient.Get(ctx, req.GetResourceId())
+		if err != nil {
+			zlog.InfraErr(err).Msgf("failed to get inventory resource %s", req.GetResourceId())
+			return nil, errors.Wrap(err)
+		}
+		invSegment.Site = invResp.GetResource().GetNetworkSegment().GetSite()
+	}
+	return is.updateNetworkSegment(ctx, req.GetResourceId(), fieldmask, invSegment)
+}
+
+func (is *InventorygRPCServer) updateNetworkSegment(
+	ctx context.Context,
+	resourceID string,
+	fieldmask *fieldmaskpb.FieldMask,
+	invSegment *inv_networkv1.NetworkSegment,
+) (*networkv1.NetworkSegmentResource, error) {
+	invRes := &inventory.Resource{
+		Resource: &inventory.Resource_NetworkSegment{
+			NetworkSegment: invSegment,
+		},
+	}
+	upRes, err := is.InvClient.Update(ctx, resourceID, fieldmask, invRes)
+	if err != nil {
+		zlog.InfraErr(err).Msgf("failed to update inventory resource %s %s", resourceID, invRes)
+		return nil, errors.Wrap(err)
+	}
+	invUpRes, err := fromInvNetworkSegment(upRes.GetNetworkSegment())
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+
+	zlog.Debug().Msgf("Updated %s", redact(invUpRes))
+	return invUpRes, nil
+}
+
+// Delete a network segment.
+func (is *InventorygRPCServer) DeleteNetworkSegment(
+	ctx context.Context,
+	req *restv1.DeleteNetworkSegmentRequest,
+) (*restv1.DeleteNetworkSegmentResponse, error) {
+	zlog.Debug().Msg("DeleteNetworkSegment")
+
+	_, err := is.InvClient.Delete(ctx, req.GetResourceId())
+	if err != nil {
+		zlog.InfraErr(err).Msg("Failed to delete network segment from inventory")
+		return nil, errors.Wrap(err)
+	}
+	zlog.Debug().Msgf("Deleted %s", req.GetResourceId())
+	return &restv1.DeleteNetworkSegmentResponse{}, nil
+}
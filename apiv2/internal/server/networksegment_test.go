@@ -0,0 +1,152 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package server_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	networkv1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/resources/network/v1"
+	restv1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/services/v1"
+	inv_server "github.com/open-edge-platform/infra-core/apiv2/v2/internal/server"
+	inventory "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/inventory/v1"
+	inv_locationv1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/location/v1"
+	inv_networkv1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/network/v1"
+)
+
+var exampleInvNetworkSegment = &inv_networkv1.NetworkSegment{
+	ResourceId: "netseg-12345678",
+	Name:       "segment",
+	VlanId:     100,
+	Site:       &inv_locationv1.SiteResource{ResourceId: "site-12345678"},
+}
+
+func networkSegmentResource(segment *inv_networkv1.NetworkSegment) *inventory.Resource {
+	return &inventory.Resource{Resource: &inventory.Resource_NetworkSegment{NetworkSegment: segment}}
+}
+
+func TestNetworkSegment_Create(t *testing.T) {
+	cases := []struct {
+		name    string
+		mocks   func(mockedClient *mock.Mock)
+		segment *networkv1.NetworkSegmentResource
+		wantErr bool
+	}{
+		{
+			name: "Create network segment",
+			mocks: func(mockedClient *mock.Mock) {
+				mockedClient.On("Create", mock.Anything, mock.MatchedBy(func(res *inventory.Resource) bool {
+					return res.GetNetworkSegment().GetSite().GetResourceId() == "site-12345678" &&
+						res.GetNetworkSegment().GetVlanId() == 100
+				})).Return(networkSegmentResource(exampleInvNetworkSegment), nil).Once()
+			},
+			segment: &networkv1.NetworkSegmentResource{Name: "segment", VlanId: 100, SiteId: "site-12345678"},
+		},
+		{
+			name:    "Invalid VLAN ID",
+			segment: &networkv1.NetworkSegmentResource{Name: "segment", VlanId: 1, SiteId: "site-12345678"},
+			wantErr: true,
+		},
+		{
+			name: "Create error",
+			mocks: func(mockedClient *mock.Mock) {
+				mockedClient.On("Create", mock.Anything, mock.Anything).Return(nil, errors.New("error")).Once()
+			},
+			segment: &networkv1.NetworkSegmentResource{Name: "segment", SiteId: "site-12345678"},
+			wantErr: true,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockedClient := newMockedInventoryTestClient()
+			server := inv_server.InventorygRPCServer{InvClient: mockedClient}
+			if tc.mocks != nil {
+				tc.mocks(&mockedClient.Mock)
+			}
+
+			segment, err := server.CreateNetworkSegment(context.Background(), &restv1.CreateNetworkSegmentRequest{
+				NetworkSegment: tc.segment,
+			})
+			mockedClient.AssertExpectations(t)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "netseg-12345678", segment.GetResourceId())
+			assert.Equal(t, int32(100), segment.GetVlanId())
+			assert.Equal(t, "site-12345678", segment.GetSite().GetResourceId())
+		})
+	}
+}
+
+func TestNetworkSegment_ListGetDelete(t *testing.T) {
+	mockedClient := newMockedInventoryTestClient()
+	server := inv_server.InventorygRPCServer{InvClient: mockedClient}
+
+	mockedClient.On("List", mock.Anything, mock.MatchedBy(func(f *inventory.ResourceFilter) bool {
+		return f.GetResource().GetNetworkSegment() != nil
+	})).Return(&inventory.ListResourcesResponse{
+		Resources:     []*inventory.GetResourceResponse{{Resource: networkSegmentResource(exampleInvNetworkSegment)}},
+		TotalElements: 1,
+	}, nil).Once()
+	mockedClient.On("Get", mock.Anything, "netseg-12345678").Return(&inventory.GetResourceResponse{
+		Resource: networkSegmentResource(exampleInvNetworkSegment),
+	}, nil).Once()
+	mockedClient.On("Delete", mock.Anything, "netseg-12345678").Return(&inventory.DeleteResourceResponse{}, nil).Once()
+
+	listResp, err := server.ListNetworkSegments(context.Background(), &restv1.ListNetworkSegmentsRequest{})
+	require.NoError(t, err)
+	require.Len(t, listResp.GetNetworkSegments(), 1)
+	assert.Equal(t, "segment", listResp.GetNetworkSegments()[0].GetName())
+
+	segment, err := server.GetNetworkSegment(context.Background(), &restv1.GetNetworkSegmentRequest{
+		ResourceId: "netseg-12345678",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "site-12345678", segment.GetSite().GetResourceId())
+
+	_, err = server.DeleteNetworkSegment(context.Background(), &restv1.DeleteNetworkSegmentRequest{
+		ResourceId: "netseg-12345678",
+	})
+	require.NoError(t, err)
+	mockedClient.AssertExpectations(t)
+}
+
+func TestNetworkSegment_Patch(t *testing.T) {
+	mockedClient := newMockedInventoryTestClient()
+	server := inv_server.InventorygRPCServer{InvClient: mockedClient}
+
+	// The current site is kept when not patched.
+	mockedClient.On("Get", mock.Anything, "netseg-12345678").Return(&inventory.GetResourceResponse{
+		Resource: networkSegmentResource(exampleInvNetworkSegment),
+	}, nil).Once()
+	mockedClient.On("Update", mock.Anything, "netseg-12345678",
+		mock.MatchedBy(func(fm *fieldmaskpb.FieldMask) bool {
+			return len(fm.GetPaths()) == 1 && fm.GetPaths()[0] == inv_networkv1.NetworkSegmentFieldVlanId
+		}),
+		mock.MatchedBy(func(res *inventory.Resource) bool {
+			return res.GetNetworkSegment().GetSite().GetResourceId() == "site-12345678"
+		}),
+	).Return(networkSegmentResource(&inv_networkv1.NetworkSegment{
+		ResourceId: "netseg-12345678",
+		VlanId:     200,
+		Site:       exampleInvNetworkSegment.GetSite(),
+	}), nil).Once()
+
+	segment, err := server.PatchNetworkSegment(context.Background(), &restv1.PatchNetworkSegmentRequest{
+		ResourceId:     "netseg-12345678",
+		NetworkSegment: &networkv1.NetworkSegmentResource{VlanId: 200},
+		FieldMask:      &fieldmaskpb.FieldMask{Paths: []string{networkv1.NetworkSegmentResourceFieldVlanId}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int32(200), segment.GetVlanId())
+	mockedClient.AssertExpectations(t)
+}
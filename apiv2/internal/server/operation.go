@@ -0,0 +1,69 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+
+	operationv1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/resources/operation/v1"
+	restv1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/services/v1"
+)
+
+// defaultOperationsPageSize is the page size used when ListOperations does not give one.
+const defaultOperationsPageSize = 20
+
+// Get a list of operations.
+func (is *InventorygRPCServer) ListOperations(
+	ctx context.Context,
+	req *restv1.ListOperationsRequest,
+) (*restv1.ListOperationsResponse, error) {
+	zlog.Debug().Msg("ListOperations")
+
+	pageSize := int(req.GetPageSize())
+	if pageSize == 0 {
+		pageSize = defaultOperationsPageSize
+	}
+	offset := int(req.GetOffset())
+	ops, total := is.Operations.List(ctx, offset, pageSize)
+
+	resp := &restv1.ListOperationsResponse{
+		Operations:    ops,
+		TotalElements: int32(total), //nolint:gosec // Number of operations.
+		HasNext:       offset+len(ops) < total,
+	}
+	zlog.Debug().Msgf("Listed %s", redact(resp))
+	return resp, nil
+}
+
+// Get a specific operation.
+func (is *InventorygRPCServer) GetOperation(
+	ctx context.Context,
+	req *restv1.GetOperationRequest,
+) (*operationv1.OperationResource, error) {
+	zlog.Debug().Msg("GetOperation")
+
+	op, err := is.Operations.Get(ctx, req.GetResourceId())
+	if err != nil {
+		zlog.InfraErr(err).Msgf("failed to get operation %s", req.GetResourceId())
+		return nil, err
+	}
+	zlog.Debug().Msgf("Got %s", redact(op))
+	return op, nil
+}
+
+// Cancel an operation.
+func (is *InventorygRPCServer) CancelOperation(
+	ctx context.Context,
+	req *restv1.CancelOperationRequest,
+) (*operationv1.OperationResource, error) {
+	zlog.Debug().Msg("CancelOperation")
+
+	op, err := is.Operations.Cancel(ctx, req.GetResourceId())
+	if err != nil {
+		zlog.InfraErr(err).Msgf("failed to cancel operation %s", req.GetResourceId())
+		return nil, err
+	}
+	zlog.Debug().Msgf("Cancelled %s", req.GetResourceId())
+	return op, nil
+}
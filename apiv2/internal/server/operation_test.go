@@ -0,0 +1,155 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package server_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/open-edge-platform/infra-core/apiv2/v2/internal/operations"
+	operationv1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/resources/operation/v1"
+	restv1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/services/v1"
+	inv_server "github.com/open-edge-platform/infra-core/apiv2/v2/internal/server"
+	inv_computev1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/compute/v1"
+	inventory "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/inventory/v1"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/client"
+)
+
+func newOperationsTestServer(t *testing.T, invClient client.InventoryClient) *inv_server.InventorygRPCServer {
+	t.Helper()
+	manager, err := operations.NewManager(operations.NewMemoryStore(), 1, 0)
+	require.NoError(t, err)
+	return &inv_server.InventorygRPCServer{InvClient: invClient, Operations: manager}
+}
+
+func TestHost_InvalidateHosts(t *testing.T) {
+	mockedClient := newMockedInventoryTestClient()
+	server := newOperationsTestServer(t, mockedClient)
+
+	hostFilter := `site.resource_id = "site-12345678"`
+	mockedClient.On("FindAll", mock.Anything, mock.MatchedBy(func(filter *inventory.ResourceFilter) bool {
+		return filter.GetFilter() == hostFilter && filter.GetResource().GetHost() != nil
+	})).Return([]*client.ResourceTenantIDCarrier{
+		{ResourceId: "host-00000001"},
+		{ResourceId: "host-00000002"},
+	}, nil).Once()
+	isInvalidation := mock.MatchedBy(func(res *inventory.Resource) bool {
+		return res.GetHost().GetDesiredState() == inv_computev1.HostState_HOST_STATE_UNTRUSTED &&
+			res.GetHost().GetNote() == "decommissioned"
+	})
+	mockedClient.On("Update", mock.Anything, "host-00000001", mock.Anything, isInvalidation).
+		Return(&inventory.Resource{}, nil).Once()
+	mockedClient.On("Update", mock.Anything, "host-00000002", mock.Anything, isInvalidation).
+		Return(nil, status.Error(codes.FailedPrecondition, "host is being deleted")).Once()
+
+	op, err := server.InvalidateHosts(context.Background(), &restv1.InvalidateHostsRequest{
+		Filter: hostFilter,
+		Note:   "decommissioned",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "InvalidateHosts", op.GetKind())
+	assert.False(t, op.GetDone())
+
+	server.Operations.Wait()
+	mockedClient.AssertExpectations(t)
+
+	op, err = server.GetOperation(context.Background(), &restv1.GetOperationRequest{ResourceId: op.GetResourceId()})
+	require.NoError(t, err)
+	assert.Equal(t, operationv1.OperationState_OPERATION_STATE_SUCCEEDED, op.GetState())
+	assert.True(t, op.GetDone())
+	assert.Equal(t, uint32(100), op.GetProgressPercent())
+	assert.Equal(t, uint32(2), op.GetTotal())
+	assert.Equal(t, uint32(1), op.GetSucceeded())
+	assert.Equal(t, uint32(1), op.GetFailed())
+	require.Len(t, op.GetResults(), 2)
+	assert.Equal(t, "host-00000001", op.GetResults()[0].GetResourceId())
+	assert.Empty(t, op.GetResults()[0].GetError())
+	assert.Equal(t, "host-00000002", op.GetResults()[1].GetResourceId())
+	assert.Equal(t, "host is being deleted", op.GetResults()[1].GetError())
+}
+
+func TestHost_InvalidateHostsFailure(t *testing.T) {
+	mockedClient := newMockedInventoryTestClient()
+	server := newOperationsTestServer(t, mockedClient)
+
+	mockedClient.On("FindAll", mock.Anything, mock.Anything).
+		Return(nil, status.Error(codes.InvalidArgument, "invalid filter")).Once()
+
+	op, err := server.InvalidateHosts(context.Background(), &restv1.InvalidateHostsRequest{
+		Filter: `no_such_field = "foo"`,
+		Note:   "decommissioned",
+	})
+	require.NoError(t, err)
+	server.Operations.Wait()
+	mockedClient.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+
+	op, err = server.GetOperation(context.Background(), &restv1.GetOperationRequest{ResourceId: op.GetResourceId()})
+	require.NoError(t, err)
+	assert.Equal(t, operationv1.OperationState_OPERATION_STATE_FAILED, op.GetState())
+	assert.Equal(t, "invalid filter", op.GetError())
+}
+
+func TestOperation_ListGetCancel(t *testing.T) {
+	server := newOperationsTestServer(t, newMockedInventoryTestClient())
+
+	release := make(chan struct{})
+	blocking, err := server.Operations.Start(context.Background(), "Test",
+		func(ctx context.Context, _ *operations.Tracker) error {
+			select {
+			case <-release:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		})
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		op, err := server.Operations.Get(context.Background(), blocking.GetResourceId())
+		return err == nil && op.GetState() == operationv1.OperationState_OPERATION_STATE_RUNNING
+	}, time.Second, time.Millisecond)
+	// A single operation runs at once, this one stays pending until cancelled.
+	pending, err := server.Operations.Start(context.Background(), "Test",
+		func(_ context.Context, _ *operations.Tracker) error { return errors.New("unexpected run") })
+	require.NoError(t, err)
+
+	list, err := server.ListOperations(context.Background(), &restv1.ListOperationsRequest{PageSize: 1})
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), list.GetTotalElements())
+	assert.True(t, list.GetHasNext())
+	require.Len(t, list.GetOperations(), 1)
+
+	_, err = server.GetOperation(context.Background(), &restv1.GetOperationRequest{ResourceId: "op-00000000"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = server.CancelOperation(context.Background(), &restv1.CancelOperationRequest{ResourceId: "op-00000000"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = server.CancelOperation(context.Background(),
+		&restv1.CancelOperationRequest{ResourceId: pending.GetResourceId()})
+	require.NoError(t, err)
+	close(release)
+	server.Operations.Wait()
+
+	op, err := server.GetOperation(context.Background(),
+		&restv1.GetOperationRequest{ResourceId: pending.GetResourceId()})
+	require.NoError(t, err)
+	assert.Equal(t, operationv1.OperationState_OPERATION_STATE_CANCELLED, op.GetState())
+	op, err = server.GetOperation(context.Background(),
+		&restv1.GetOperationRequest{ResourceId: blocking.GetResourceId()})
+	require.NoError(t, err)
+	assert.Equal(t, operationv1.OperationState_OPERATION_STATE_SUCCEEDED, op.GetState())
+
+	// Cancelling an operation that is over has no effect.
+	op, err = server.CancelOperation(context.Background(),
+		&restv1.CancelOperationRequest{ResourceId: blocking.GetResourceId()})
+	require.NoError(t, err)
+	assert.Equal(t, operationv1.OperationState_OPERATION_STATE_SUCCEEDED, op.GetState())
+}
@@ -120,7 +120,7 @@ func (is *InventorygRPCServer) CreateOperatingSystem(
 	}
 
 	osResourceCreated := fromInvOSResource(invResp.GetOs())
-	zlog.Debug().Msgf("Created %s", osResourceCreated)
+	zlog.Debug().Msgf("Created %s", redact(osResourceCreated))
 	return osResourceCreated, nil
 }
 
@@ -131,6 +131,11 @@ func (is *InventorygRPCServer) ListOperatingSystems(
 ) (*restv1.ListOperatingSystemsResponse, error) {
 	zlog.Debug().Msg("ListOSResources")
 
+	mask, err := readMask(&osv1.OperatingSystemResource{}, req.GetView(), req.GetFields())
+	if err != nil {
+		return nil, err
+	}
+
 	filter := &inventory.ResourceFilter{
 		Resource: &inventory.Resource{Resource: &inventory.Resource_Os{Os: &inv_osv1.OperatingSystemResource{}}},
 		Offset:   req.GetOffset(),
@@ -151,6 +156,7 @@ func (is *InventorygRPCServer) ListOperatingSystems(
 	osResources := []*osv1.OperatingSystemResource{}
 	for _, invRes := range invResp.GetResources() {
 		osResource := fromInvOSResource(invRes.GetResource().GetOs())
+		applyReadMask(osResource, mask)
 		osResources = append(osResources, osResource)
 	}
 
@@ -159,7 +165,7 @@ func (is *InventorygRPCServer) ListOperatingSystems(
 		TotalElements:            invResp.GetTotalElements(),
 		HasNext:                  invResp.GetHasNext(),
 	}
-	zlog.Debug().Msgf("Listed %s", resp)
+	zlog.Debug().Msgf("Listed %s", redact(resp))
 	return resp, nil
 }
 
@@ -170,6 +176,11 @@ func (is *InventorygRPCServer) GetOperatingSystem(
 ) (*osv1.OperatingSystemResource, error) {
 	zlog.Debug().Msg("GetOSResource")
 
+	mask, err := readMask(&osv1.OperatingSystemResource{}, req.GetView(), req.GetFields())
+	if err != nil {
+		return nil, err
+	}
+
 	invResp, err := is.InvClient.Get(ctx, req.GetResourceId())
 	if err != nil {
 		zlog.InfraErr(err).Msg("Failed to get OS resource from inventory")
@@ -178,7 +189,8 @@ func (is *InventorygRPCServer) GetOperatingSystem(
 
 	invOSResource := invResp.GetResource().GetOs()
 	osResource := fromInvOSResource(invOSResource)
-	zlog.Debug().Msgf("Got %s", osResource)
+	applyReadMask(osResource, mask)
+	zlog.Debug().Msgf("Got %s", redact(osResource))
 	return osResource, nil
 }
 
@@ -209,12 +221,12 @@ func (is *InventorygRPCServer) UpdateOperatingSystem(
 	}
 	upRes, err := is.InvClient.Update(ctx, req.GetResourceId(), fieldmask, invRes)
 	if err != nil {
-		zlog.InfraErr(err).Msgf("failed to update inventory resource %s %s", req.GetResourceId(), invRes)
+		zlog.InfraErr(err).Msgf("failed to update inventory resource %s %s", req.GetResourceId(), redact(invRes))
 		return nil, errors.Wrap(err)
 	}
 	invUp := upRes.GetOs()
 	invUpRes := fromInvOSResource(invUp)
-	zlog.Debug().Msgf("Updated %s", invUpRes)
+	zlog.Debug().Msgf("Updated %s", redact(invUpRes))
 	return invUpRes, nil
 }
 
@@ -243,12 +255,12 @@ func (is *InventorygRPCServer) PatchOperatingSystem(
 	}
 	upRes, err := is.InvClient.Update(ctx, req.GetResourceId(), fieldmask, invRes)
 	if err != nil {
-		zlog.InfraErr(err).Msgf("failed to update inventory resource %s %s", req.GetResourceId(), invRes)
+		zlog.InfraErr(err).Msgf("failed to update inventory resource %s %s", req.GetResourceId(), redact(invRes))
 		return nil, errors.Wrap(err)
 	}
 	invUp := upRes.GetOs()
 	invUpRes := fromInvOSResource(invUp)
-	zlog.Debug().Msgf("Updated %s", invUpRes)
+	zlog.Debug().Msgf("Updated %s", redact(invUpRes))
 	return invUpRes, nil
 }
 
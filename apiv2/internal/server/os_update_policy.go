@@ -6,6 +6,9 @@ package server
 import (
 	"context"
 
+	"golang.org/x/exp/maps"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
 	computev1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/resources/compute/v1"
 	osv1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/resources/os/v1"
 	restv1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/services/v1"
@@ -17,6 +20,15 @@ import (
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/validator"
 )
 
+// OpenAPIOSUpdatePolicyToProto maps OpenAPI fields name to Proto fields name.
+// The key is derived from the json property respectively of the
+// structs OSUpdatePolicy defined in edge-infra-manager-openapi-types.gen.go.
+// Only the descriptive fields of a policy can be updated, the rest is immutable.
+var OpenAPIOSUpdatePolicyToProto = map[string]string{
+	computev1.OSUpdatePolicyFieldName:        inv_computev1.OSUpdatePolicyResourceFieldName,
+	computev1.OSUpdatePolicyFieldDescription: inv_computev1.OSUpdatePolicyResourceFieldDescription,
+}
+
 func (is *InventorygRPCServer) CreateOSUpdatePolicy(ctx context.Context, req *restv1.CreateOSUpdatePolicyRequest) (
 	*computev1.OSUpdatePolicy, error,
 ) {
@@ -42,7 +54,7 @@ func (is *InventorygRPCServer) CreateOSUpdatePolicy(ctx context.Context, req *re
 	}
 
 	osPolicyCreated := fromInvOSUpdatePolicy(invResp.GetOsUpdatePolicy())
-	zlog.Debug().Msgf("Created OSUpdatePolicy: %+v", osPolicyCreated)
+	zlog.Debug().Msgf("Created OSUpdatePolicy: %+v", redact(osPolicyCreated))
 
 	return osPolicyCreated, nil
 }
@@ -81,7 +93,7 @@ func (is *InventorygRPCServer) ListOSUpdatePolicy(ctx context.Context, req *rest
 		TotalElements:    invResp.GetTotalElements(),
 		HasNext:          invResp.GetHasNext(),
 	}
-	zlog.Debug().Msgf("Listed %s", resp)
+	zlog.Debug().Msgf("Listed %s", redact(resp))
 	return resp, nil
 }
 
@@ -98,10 +110,76 @@ func (is *InventorygRPCServer) GetOSUpdatePolicy(ctx context.Context, req *restv
 
 	invOSUpdatePolicy := invResp.GetResource().GetOsUpdatePolicy()
 	osUpPolicy := fromInvOSUpdatePolicy(invOSUpdatePolicy)
-	zlog.Debug().Msgf("Got %s", osUpPolicy)
+	zlog.Debug().Msgf("Got %s", redact(osUpPolicy))
 	return osUpPolicy, nil
 }
 
+// Update a OSUpdatePolicy. (PUT).
+func (is *InventorygRPCServer) UpdateOSUpdatePolicy(
+	ctx context.Context,
+	req *restv1.UpdateOSUpdatePolicyRequest,
+) (*computev1.OSUpdatePolicy, error) {
+	zlog.Debug().Msg("UpdateOSUpdatePolicy")
+
+	invOSUpdatePolicy, err := toInvOSUpdatePolicy(req.GetOsUpdatePolicy())
+	if err != nil {
+		zlog.InfraErr(err).Msg("Failed to convert to inventory OSUpdatePolicy")
+		return nil, errors.Wrap(err)
+	}
+
+	fieldmask, err := fieldmaskpb.New(invOSUpdatePolicy, maps.Values(OpenAPIOSUpdatePolicyToProto)...)
+	if err != nil {
+		zlog.InfraErr(err).Msg("Failed to create field mask")
+		return nil, errors.Wrap(err)
+	}
+
+	invRes := &inventory.Resource{
+		Resource: &inventory.Resource_OsUpdatePolicy{
+			OsUpdatePolicy: invOSUpdatePolicy,
+		},
+	}
+	upRes, err := is.InvClient.Update(ctx, req.GetResourceId(), fieldmask, invRes)
+	if err != nil {
+		zlog.InfraErr(err).Msgf("failed to update inventory resource %s %s", req.GetResourceId(), redact(invRes))
+		return nil, errors.Wrap(err)
+	}
+	invUpRes := fromInvOSUpdatePolicy(upRes.GetOsUpdatePolicy())
+	zlog.Debug().Msgf("Updated %s", redact(invUpRes))
+	return invUpRes, nil
+}
+
+// Update a OSUpdatePolicy. (PATCH).
+func (is *InventorygRPCServer) PatchOSUpdatePolicy(
+	ctx context.Context,
+	req *restv1.PatchOSUpdatePolicyRequest,
+) (*computev1.OSUpdatePolicy, error) {
+	zlog.Debug().Msg("PatchOSUpdatePolicy")
+
+	invOSUpdatePolicy, err := toInvOSUpdatePolicy(req.GetOsUpdatePolicy())
+	if err != nil {
+		zlog.InfraErr(err).Msg("Failed to convert to inventory OSUpdatePolicy")
+		return nil, errors.Wrap(err)
+	}
+
+	fieldmask, err := parseFielmask(invOSUpdatePolicy, req.GetFieldMask(), OpenAPIOSUpdatePolicyToProto)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	invRes := &inventory.Resource{
+		Resource: &inventory.Resource_OsUpdatePolicy{
+			OsUpdatePolicy: invOSUpdatePolicy,
+		},
+	}
+	upRes, err := is.InvClient.Update(ctx, req.GetResourceId(), fieldmask, invRes)
+	if err != nil {
+		zlog.InfraErr(err).Msgf("failed to update inventory resource %s %s", req.GetResourceId(), redact(invRes))
+		return nil, errors.Wrap(err)
+	}
+	invUpRes := fromInvOSUpdatePolicy(upRes.GetOsUpdatePolicy())
+	zlog.Debug().Msgf("Updated %s", redact(invUpRes))
+	return invUpRes, nil
+}
+
 func (is *InventorygRPCServer) DeleteOSUpdatePolicy(ctx context.Context, req *restv1.DeleteOSUpdatePolicyRequest) (
 	*restv1.DeleteOSUpdatePolicyResponse, error,
 ) {
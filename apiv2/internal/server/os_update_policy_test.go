@@ -8,7 +8,9 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 
 	computev1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/resources/compute/v1"
 	restv1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/services/v1"
@@ -325,3 +327,164 @@ func TestDeleteOSUpdatePolicy(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateOSUpdatePolicy(t *testing.T) {
+	mockedClient := newMockedInventoryTestClient()
+	server := invserver.InventorygRPCServer{InvClient: mockedClient}
+
+	cases := []struct {
+		name    string
+		mocks   func() []*mock.Call
+		ctx     context.Context
+		req     *restv1.UpdateOSUpdatePolicyRequest
+		wantErr bool
+	}{
+		{
+			name: "Update OSUpdatePolicy",
+			mocks: func() []*mock.Call {
+				return []*mock.Call{
+					mockedClient.On("Update", mock.Anything, "osupdatepolicy-12345678",
+						mock.MatchedBy(func(fm *fieldmaskpb.FieldMask) bool {
+							return assert.ElementsMatch(t, []string{"name", "description"}, fm.GetPaths())
+						}), mock.Anything).
+						Return(&inventory.Resource{
+							Resource: &inventory.Resource_OsUpdatePolicy{
+								OsUpdatePolicy: exampleInvOSUpdatePolicyResourceLatest,
+							},
+						}, nil).Once(),
+				}
+			},
+			ctx: context.Background(),
+			req: &restv1.UpdateOSUpdatePolicyRequest{
+				ResourceId:     "osupdatepolicy-12345678",
+				OsUpdatePolicy: exampleAPIOSUpdatePolicyResourceLatest,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Update OSUpdatePolicy with invalid resource",
+			ctx:  context.Background(),
+			req: &restv1.UpdateOSUpdatePolicyRequest{
+				ResourceId:     "osupdatepolicy-12345678",
+				OsUpdatePolicy: &computev1.OSUpdatePolicy{Name: "example-policy", TargetOsId: "os-invalid"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Update OSUpdatePolicy with error",
+			mocks: func() []*mock.Call {
+				return []*mock.Call{
+					mockedClient.On("Update", mock.Anything, "osupdatepolicy-12345678", mock.Anything, mock.Anything).
+						Return(nil, errors.New("error")).Once(),
+				}
+			},
+			ctx: context.Background(),
+			req: &restv1.UpdateOSUpdatePolicyRequest{
+				ResourceId:     "osupdatepolicy-12345678",
+				OsUpdatePolicy: exampleAPIOSUpdatePolicyResourceLatest,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.mocks != nil {
+				tc.mocks()
+			}
+
+			reply, err := server.UpdateOSUpdatePolicy(tc.ctx, tc.req)
+			if tc.wantErr {
+				if err == nil {
+					t.Errorf("UpdateOSUpdatePolicy() got err = nil, want err")
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("UpdateOSUpdatePolicy() got err = %v, want nil", err)
+				return
+			}
+			if reply == nil {
+				t.Errorf("UpdateOSUpdatePolicy() got reply = nil, want non-nil")
+				return
+			}
+			compareProtoMessages(t, exampleAPIOSUpdatePolicyResourceLatest, reply)
+		})
+	}
+}
+
+func TestPatchOSUpdatePolicy(t *testing.T) {
+	mockedClient := newMockedInventoryTestClient()
+	server := invserver.InventorygRPCServer{InvClient: mockedClient}
+
+	cases := []struct {
+		name    string
+		mocks   func() []*mock.Call
+		ctx     context.Context
+		req     *restv1.PatchOSUpdatePolicyRequest
+		wantErr bool
+	}{
+		{
+			name: "Patch OSUpdatePolicy",
+			mocks: func() []*mock.Call {
+				return []*mock.Call{
+					mockedClient.On("Update", mock.Anything, "osupdatepolicy-12345678",
+						mock.MatchedBy(func(fm *fieldmaskpb.FieldMask) bool {
+							return assert.ElementsMatch(t, []string{"description"}, fm.GetPaths())
+						}), mock.Anything).
+						Return(&inventory.Resource{
+							Resource: &inventory.Resource_OsUpdatePolicy{
+								OsUpdatePolicy: exampleInvOSUpdatePolicyResourceLatest,
+							},
+						}, nil).Once(),
+				}
+			},
+			ctx: context.Background(),
+			req: &restv1.PatchOSUpdatePolicyRequest{
+				ResourceId:     "osupdatepolicy-12345678",
+				OsUpdatePolicy: exampleAPIOSUpdatePolicyResourceLatest,
+				FieldMask:      &fieldmaskpb.FieldMask{Paths: []string{"description", "update_policy"}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Patch OSUpdatePolicy with error",
+			mocks: func() []*mock.Call {
+				return []*mock.Call{
+					mockedClient.On("Update", mock.Anything, "osupdatepolicy-12345678", mock.Anything, mock.Anything).
+						Return(nil, errors.New("error")).Once(),
+				}
+			},
+			ctx: context.Background(),
+			req: &restv1.PatchOSUpdatePolicyRequest{
+				ResourceId:     "osupdatepolicy-12345678",
+				OsUpdatePolicy: exampleAPIOSUpdatePolicyResourceLatest,
+				FieldMask:      &fieldmaskpb.FieldMask{Paths: []string{"description", "update_policy"}},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.mocks != nil {
+				tc.mocks()
+			}
+
+			reply, err := server.PatchOSUpdatePolicy(tc.ctx, tc.req)
+			if tc.wantErr {
+				if err == nil {
+					t.Errorf("PatchOSUpdatePolicy() got err = nil, want err")
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("PatchOSUpdatePolicy() got err = %v, want nil", err)
+				return
+			}
+			if reply == nil {
+				t.Errorf("PatchOSUpdatePolicy() got reply = nil, want non-nil")
+				return
+			}
+			compareProtoMessages(t, exampleAPIOSUpdatePolicyResourceLatest, reply)
+		})
+	}
+}
@@ -89,7 +89,7 @@ func (is *InventorygRPCServer) ListOSUpdateRun(ctx context.Context, req *restv1.
 		TotalElements: invResp.GetTotalElements(),
 		HasNext:       invResp.GetHasNext(),
 	}
-	zlog.Debug().Msgf("Listed %s", resp)
+	zlog.Debug().Msgf("Listed %s", redact(resp))
 	return resp, nil
 }
 
@@ -111,7 +111,7 @@ func (is *InventorygRPCServer) GetOSUpdateRun(ctx context.Context, req *restv1.G
 		return nil, errors.Wrap(err)
 	}
 
-	zlog.Debug().Msgf("Got %s", osUpdateRunResource)
+	zlog.Debug().Msgf("Got %s", redact(osUpdateRunResource))
 	return osUpdateRunResource, nil
 }
 
@@ -0,0 +1,281 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+
+	"golang.org/x/exp/maps"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	commonv1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/resources/common/v1"
+	locationv1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/resources/location/v1"
+	restv1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/services/v1"
+	inventory "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/inventory/v1"
+	inv_ouv1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/ou/v1"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/validator"
+)
+
+var OpenAPIOuToProto = map[string]string{
+	locationv1.OuResourceFieldName:     inv_ouv1.OuResourceFieldName,
+	locationv1.OuResourceFieldOuKind:   inv_ouv1.OuResourceFieldOuKind,
+	locationv1.OuResourceFieldParentId: inv_ouv1.OuResourceEdgeParentOu,
+	locationv1.OuResourceEdgeMetadata:  inv_ouv1.OuResourceFieldMetadata,
+}
+
+func toInvOu(ou *locationv1.OuResource) (*inv_ouv1.OuResource, error) {
+	if ou == nil {
+		return &inv_ouv1.OuResource{}, nil
+	}
+	var err error
+	var metadata string
+	if ou.GetMetadata() != nil {
+		metadata, err = toInvMetadata(ou.GetMetadata())
+		if err != nil {
+			return nil, err
+		}
+	}
+	invOu := &inv_ouv1.OuResource{
+		ResourceId: ou.GetResourceId(),
+		Name:       ou.GetName(),
+		OuKind:     ou.GetOuKind(),
+		Metadata:   metadata,
+	}
+
+	parentID := ou.GetParentId()
+	if isSet(&parentID) {
+		invOu.ParentOu = &inv_ouv1.OuResource{
+			ResourceId: parentID,
+		}
+	}
+
+	err = validator.ValidateMessage(invOu)
+	if err != nil {
+		zlog.InfraErr(err).Msg("Failed to validate inventory resource")
+		return nil, err
+	}
+
+	return invOu, nil
+}
+
+func fromInvOu(
+	invOu *inv_ouv1.OuResource,
+	resMeta *inventory.GetResourceResponse_ResourceMetadata,
+) (*locationv1.OuResource, error) {
+	if invOu == nil {
+		return &locationv1.OuResource{}, nil
+	}
+
+	parentOu, err := fromInvOu(invOu.GetParentOu(), nil)
+	if err != nil {
+		return nil, err
+	}
+	metadata, err := fromInvMetadata(invOu.GetMetadata())
+	if err != nil {
+		return nil, err
+	}
+
+	ou := &locationv1.OuResource{
+		ResourceId:        invOu.GetResourceId(),
+		Name:              invOu.GetName(),
+		OuKind:            invOu.GetOuKind(),
+		ParentOu:          parentOu,
+		ParentId:          parentOu.GetResourceId(),
+		Metadata:          metadata,
+		InheritedMetadata: []*commonv1.MetadataItem{},
+		Timestamps:        GrpcToOpenAPITimestamps(invOu),
+	}
+
+	if resMeta != nil {
+		// OUs render the metadata of their parents as logical metadata.
+		inheritedMetadata, err := fromInvMetadata(resMeta.GetLogiMetadata())
+		if err != nil {
+			return nil, err
+		}
+		ou.InheritedMetadata = inheritedMetadata
+	}
+	return ou, nil
+}
+
+// Create an OU.
+func (is *InventorygRPCServer) CreateOu(
+	ctx context.Context,
+	req *restv1.CreateOuRequest,
+) (*locationv1.OuResource, error) {
+	zlog.Debug().Msg("CreateOu")
+
+	invOu, err := toInvOu(req.GetOu())
+	if err != nil {
+		zlog.InfraErr(err).Msg("Failed to convert to inventory OU")
+		return nil, errors.Wrap(err)
+	}
+
+	invRes := &inventory.Resource{
+		Resource: &inventory.Resource_Ou{
+			Ou: invOu,
+		},
+	}
+
+	invResp, err := is.InvClient.Create(ctx, invRes)
+	if err != nil {
+		zlog.InfraErr(err).Msg("Failed to create OU in inventory")
+		return nil, errors.Wrap(err)
+	}
+
+	ouCreated, err := fromInvOu(invResp.GetOu(), nil)
+	if err != nil {
+		zlog.InfraErr(err).Msg("Failed to convert from inventory OU")
+		return nil, errors.Wrap(err)
+	}
+	zlog.Debug().Msgf("Created %s", redact(ouCreated))
+	return ouCreated, nil
+}
+
+// Get a list of OUs.
+func (is *InventorygRPCServer) ListOus(
+	ctx context.Context,
+	req *restv1.ListOusRequest,
+) (*restv1.ListOusResponse, error) {
+	zlog.Debug().Msg("ListOus")
+
+	filter := &inventory.ResourceFilter{
+		Resource: &inventory.Resource{Resource: &inventory.Resource_Ou{Ou: &inv_ouv1.OuResource{}}},
+		Offset:   req.GetOffset(),
+		Limit:    req.GetPageSize(),
+		OrderBy:  req.GetOrderBy(),
+		Filter:   req.GetFilter(),
+	}
+	if err := validator.ValidateMessage(filter); err != nil {
+		zlog.InfraSec().InfraErr(err).Msg("failed to validate query params")
+		return nil, errors.Wrap(err)
+	}
+	invResp, err := is.InvClient.List(ctx, filter)
+	if err != nil {
+		zlog.InfraErr(err).Msg("Failed to list OUs from inventory")
+		return nil, errors.Wrap(err)
+	}
+
+	ous := []*locationv1.OuResource{}
+	for _, invRes := range invResp.GetResources() {
+		ou, err := fromInvOu(invRes.GetResource().GetOu(), invRes.GetRenderedMetadata())
+		if err != nil {
+			zlog.InfraErr(err).Msg("Failed to convert from inventory OU")
+			return nil, errors.Wrap(err)
+		}
+		ous = append(ous, ou)
+	}
+
+	resp := &restv1.ListOusResponse{
+		Ous:           ous,
+		TotalElements: invResp.GetTotalElements(),
+		HasNext:       invResp.GetHasNext(),
+	}
+	zlog.Debug().Msgf("Listed %s", redact(resp))
+	return resp, nil
+}
+
+// Get a specific OU.
+func (is *InventorygRPCServer) GetOu(
+	ctx context.Context,
+	req *restv1.GetOuRequest,
+) (*locationv1.OuResource, error) {
+	zlog.Debug().Msg("GetOu")
+
+	invResp, err := is.InvClient.Get(ctx, req.GetResourceId())
+	if err != nil {
+		zlog.InfraErr(err).Msg("Failed to get OU from inventory")
+		return nil, errors.Wrap(err)
+	}
+
+	ou, err := fromInvOu(invResp.GetResource().GetOu(), invResp.GetRenderedMetadata())
+	if err != nil {
+		zlog.InfraErr(err).Msg("Failed to convert from inventory OU")
+		return nil, errors.Wrap(err)
+	}
+	zlog.Debug().Msgf("Got %s", redact(ou))
+	return ou, nil
+}
+
+// Update an OU. (PUT).
+func (is *InventorygRPCServer) UpdateOu(
+	ctx context.Context,
+	req *restv1.UpdateOuRequest,
+) (*locationv1.OuResource, error) {
+	zlog.Debug().Msg("UpdateOu")
+
+	invOu, err := toInvOu(req.GetOu())
+	if err != nil {
+		zlog.InfraErr(err).Msg("Failed to convert to inventory OU")
+		return nil, errors.Wrap(err)
+	}
+
+	fieldmask, err := fieldmaskpb.New(invOu, maps.Values(OpenAPIOuToProto)...)
+	if err != nil {
+		zlog.InfraErr(err).Msg("Failed to create field mask")
+		return nil, errors.Wrap(err)
+	}
+	return is.updateOu(ctx, req.GetResourceId(), fieldmask, invOu)
+}
+
+// Update an OU. (PATCH).
+func (is *InventorygRPCServer) PatchOu(
+	ctx context.Context,
+	req *restv1.PatchOuRequest,
+) (*locationv1.OuResource, error) {
+	zlog.Debug().Msg("PatchOu")
+
+	invOu, err := toInvOu(req.GetOu())
+	if err != nil {
+		zlog.InfraErr(err).Msg("Failed to convert to inventory OU")
+		return nil, errors.Wrap(err)
+	}
+
+	fieldmask, err := parseFielmask(invOu, req.GetFieldMask(), OpenAPIOuToProto)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	return is.updateOu(ctx, req.GetResourceId(), fieldmask, invOu)
+}
+
+func (is *InventorygRPCServer) updateOu(
+	ctx context.Context,
+	resourceID string,
+	fieldmask *fieldmaskpb.FieldMask,
+	invOu *inv_ouv1.OuResource,
+) (*locationv1.OuResource, error) {
+	invRes := &inventory.Resource{
+		Resource: &inventory.Resource_Ou{
+			Ou: invOu,
+		},
+	}
+	upRes, err := is.InvClient.Update(ctx, resourceID, fieldmask, invRes)
+	if err != nil {
+		zlog.InfraErr(err).Msgf("failed to update inventory resource %s %s", resourceID, invRes)
+		return nil, errors.Wrap(err)
+	}
+	invUpRes, err := fromInvOu(upRes.GetOu(), nil)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+
+	zlog.Debug().Msgf("Updated %s", redact(invUpRes))
+	return invUpRes, nil
+}
+
+// Delete an OU.
+func (is *InventorygRPCServer) DeleteOu(
+	ctx context.Context,
+	req *restv1.DeleteOuRequest,
+) (*restv1.DeleteOuResponse, error) {
+	zlog.Debug().Msg("DeleteOu")
+
+	_, err := is.InvClient.Delete(ctx, req.GetResourceId())
+	if err != nil {
+		zlog.InfraErr(err).Msg("Failed to delete OU from inventory")
+		return nil, errors.Wrap(err)
+	}
+	zlog.Debug().Msgf("Deleted %s", req.GetResourceId())
+	return &restv1.DeleteOuResponse{}, nil
+}
@@ -0,0 +1,251 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package server_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	commonv1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/resources/common/v1"
+	locationv1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/resources/location/v1"
+	restv1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/services/v1"
+	inv_server "github.com/open-edge-platform/infra-core/apiv2/v2/internal/server"
+	inventory "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/inventory/v1"
+	inv_locationv1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/location/v1"
+	inv_ouv1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/ou/v1"
+)
+
+// Example resources for testing.
+var (
+	exampleInvParentOu = &inv_ouv1.OuResource{
+		ResourceId: "ou-12345679",
+		Name:       "parent-ou",
+		OuKind:     "Organization",
+	}
+
+	exampleInvOu = &inv_ouv1.OuResource{
+		ResourceId: "ou-12345678",
+		Name:       "example-ou",
+		OuKind:     "BU",
+		ParentOu:   exampleInvParentOu,
+		Metadata:   `[{"key":"env","value":"test"}]`,
+	}
+
+	exampleAPIOu = &locationv1.OuResource{
+		ResourceId:        "ou-12345678",
+		Name:              "example-ou",
+		OuKind:            "BU",
+		ParentId:          "ou-12345679",
+		Metadata:          []*commonv1.MetadataItem{{Key: "env", Value: "test"}},
+		InheritedMetadata: []*commonv1.MetadataItem{{Key: "org", Value: "engineering"}},
+	}
+)
+
+func ouResource(ou *inv_ouv1.OuResource) *inventory.Resource {
+	return &inventory.Resource{Resource: &inventory.Resource_Ou{Ou: ou}}
+}
+
+func TestOu_Create(t *testing.T) {
+	cases := []struct {
+		name    string
+		mocks   func(mockedClient *mock.Mock)
+		ou      *locationv1.OuResource
+		wantErr bool
+	}{
+		{
+			name: "Create OU with parent",
+			mocks: func(mockedClient *mock.Mock) {
+				mockedClient.On("Create", mock.Anything, mock.MatchedBy(func(res *inventory.Resource) bool {
+					return res.GetOu().GetParentOu().GetResourceId() == "ou-12345679" &&
+						res.GetOu().GetMetadata() == `[{"key":"env","value":"test"}]`
+				})).Return(ouResource(exampleInvOu), nil).Once()
+			},
+			ou: &locationv1.OuResource{
+				Name:     "example-ou",
+				OuKind:   "BU",
+				ParentId: "ou-12345679",
+				Metadata: []*commonv1.MetadataItem{{Key: "env", Value: "test"}},
+			},
+		},
+		{
+			name:    "Invalid parent",
+			ou:      &locationv1.OuResource{Name: "example-ou", ParentId: "region-12345679"},
+			wantErr: true,
+		},
+		{
+			name: "Create error",
+			mocks: func(mockedClient *mock.Mock) {
+				mockedClient.On("Create", mock.Anything, mock.Anything).Return(nil, errors.New("error")).Once()
+			},
+			ou:      &locationv1.OuResource{Name: "example-ou"},
+			wantErr: true,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockedClient := newMockedInventoryTestClient()
+			server := inv_server.InventorygRPCServer{InvClient: mockedClient}
+			if tc.mocks != nil {
+				tc.mocks(&mockedClient.Mock)
+			}
+
+			ou, err := server.CreateOu(context.Background(), &restv1.CreateOuRequest{Ou: tc.ou})
+			mockedClient.AssertExpectations(t)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "ou-12345678", ou.GetResourceId())
+			assert.Equal(t, "ou-12345679", ou.GetParentOu().GetResourceId())
+		})
+	}
+}
+
+func TestOu_GetList(t *testing.T) {
+	mockedClient := newMockedInventoryTestClient()
+	server := inv_server.InventorygRPCServer{InvClient: mockedClient}
+	resMeta := &inventory.GetResourceResponse_ResourceMetadata{LogiMetadata: `[{"key":"org","value":"engineering"}]`}
+
+	mockedClient.On("Get", mock.Anything, "ou-12345678").Return(&inventory.GetResourceResponse{
+		Resource:         ouResource(exampleInvOu),
+		RenderedMetadata: resMeta,
+	}, nil).Once()
+	mockedClient.On("List", mock.Anything, mock.MatchedBy(func(f *inventory.ResourceFilter) bool {
+		return f.GetResource().GetOu() != nil && f.GetFilter() == `ou_kind = "BU"`
+	})).Return(&inventory.ListResourcesResponse{
+		Resources:     []*inventory.GetResourceResponse{{Resource: ouResource(exampleInvOu), RenderedMetadata: resMeta}},
+		TotalElements: 1,
+	}, nil).Once()
+
+	ou, err := server.GetOu(context.Background(), &restv1.GetOuRequest{ResourceId: "ou-12345678"})
+	require.NoError(t, err)
+	compareProtoMessages(t, exampleAPIOu, ou)
+
+	resp, err := server.ListOus(context.Background(), &restv1.ListOusRequest{Filter: `ou_kind = "BU"`})
+	require.NoError(t, err)
+	require.Len(t, resp.GetOus(), 1)
+	assert.Equal(t, int32(1), resp.GetTotalElements())
+	compareProtoMessages(t, exampleAPIOu, resp.GetOus()[0])
+	mockedClient.AssertExpectations(t)
+}
+
+func TestOu_UpdateDelete(t *testing.T) {
+	mockedClient := newMockedInventoryTestClient()
+	server := inv_server.InventorygRPCServer{InvClient: mockedClient}
+
+	// PUT replaces all the fields.
+	mockedClient.On("Update", mock.Anything, "ou-12345678",
+		mock.MatchedBy(func(fm *fieldmaskpb.FieldMask) bool { return len(fm.GetPaths()) == 4 }),
+		mock.Anything,
+	).Return(ouResource(exampleInvOu), nil).Once()
+	// PATCH only the given fields.
+	mockedClient.On("Update", mock.Anything, "ou-12345678",
+		mock.MatchedBy(func(fm *fieldmaskpb.FieldMask) bool {
+			return len(fm.GetPaths()) == 1 && fm.GetPaths()[0] == inv_ouv1.OuResourceEdgeParentOu
+		}),
+		mock.MatchedBy(func(res *inventory.Resource) bool {
+			return res.GetOu().GetParentOu().GetResourceId() == "ou-12345679"
+		}),
+	).Return(ouResource(exampleInvOu), nil).Once()
+	mockedClient.On("Delete", mock.Anything, "ou-12345678").Return(&inventory.DeleteResourceResponse{}, nil).Once()
+
+	_, err := server.UpdateOu(context.Background(), &restv1.UpdateOuRequest{
+		ResourceId: "ou-12345678",
+		Ou:         &locationv1.OuResource{Name: "example-ou"},
+	})
+	require.NoError(t, err)
+
+	ou, err := server.PatchOu(context.Background(), &restv1.PatchOuRequest{
+		ResourceId: "ou-12345678",
+		Ou:         &locationv1.OuResource{ParentId: "ou-12345679"},
+		FieldMask:  &fieldmaskpb.FieldMask{Paths: []string{locationv1.OuResourceFieldParentId}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "ou-12345679", ou.GetParentId())
+
+	_, err = server.DeleteOu(context.Background(), &restv1.DeleteOuRequest{ResourceId: "ou-12345678"})
+	require.NoError(t, err)
+	mockedClient.AssertExpectations(t)
+}
+
+func TestSite_AssignOu(t *testing.T) {
+	mockedClient := newMockedInventoryTestClient()
+	server := inv_server.InventorygRPCServer{InvClient: mockedClient}
+
+	mockedClient.On("Update", mock.Anything, "site-12345678",
+		mock.MatchedBy(func(fm *fieldmaskpb.FieldMask) bool {
+			return len(fm.GetPaths()) == 1 && fm.GetPaths()[0] == inv_locationv1.SiteResourceEdgeOu
+		}),
+		mock.MatchedBy(func(res *inventory.Resource) bool {
+			return res.GetSite().GetOu().GetResourceId() == "ou-12345678"
+		}),
+	).Return(&inventory.Resource{Resource: &inventory.Resource_Site{Site: &inv_locationv1.SiteResource{
+		ResourceId: "site-12345678",
+		Ou:         exampleInvOu,
+	}}}, nil).Once()
+
+	site, err := server.PatchSite(context.Background(), &restv1.PatchSiteRequest{
+		ResourceId: "site-12345678",
+		Site:       &locationv1.SiteResource{OuId: "ou-12345678"},
+		FieldMask:  &fieldmaskpb.FieldMask{Paths: []string{locationv1.SiteResourceFieldOuId}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "ou-12345678", site.GetOuId())
+	assert.Equal(t, "example-ou", site.GetOu().GetName())
+	mockedClient.AssertExpectations(t)
+}
+
+func TestListLocations_Ous(t *testing.T) {
+	mockedClient := newMockedInventoryTestClient()
+	server := inv_server.InventorygRPCServer{InvClient: mockedClient}
+
+	mockedClient.On("Find", mock.Anything, mock.MatchedBy(func(f *inventory.ResourceFilter) bool {
+		return f.GetResource().GetOu() != nil
+	})).Return(&inventory.FindResourcesResponse{
+		Resources: []*inventory.FindResourcesResponse_ResourceTenantIDCarrier{
+			{ResourceId: "ou-12345678"},
+		},
+		TotalElements: 1,
+	}, nil).Once()
+	mockedClient.On("GetTreeHierarchy", mock.Anything, mock.Anything).Return(
+		[]*inventory.GetTreeHierarchyResponse_TreeNode{
+			{
+				CurrentNode: &inventory.GetTreeHierarchyResponse_Node{
+					ResourceId:   "ou-12345678",
+					ResourceKind: inventory.ResourceKind_RESOURCE_KIND_OU,
+				},
+				Name: "example-ou",
+				ParentNodes: []*inventory.GetTreeHierarchyResponse_Node{{
+					ResourceId:   "ou-12345679",
+					ResourceKind: inventory.ResourceKind_RESOURCE_KIND_OU,
+				}},
+			},
+			{
+				CurrentNode: &inventory.GetTreeHierarchyResponse_Node{
+					ResourceId:   "ou-12345679",
+					ResourceKind: inventory.ResourceKind_RESOURCE_KIND_OU,
+				},
+				Name: "parent-ou",
+			},
+		}, nil).Once()
+
+	resp, err := server.ListLocations(context.Background(), &restv1.ListLocationsRequest{
+		Name:    "example-ou",
+		ShowOus: true,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.GetNodes(), 2)
+	assert.Equal(t, "ou-12345678", resp.GetNodes()[0].GetResourceId())
+	assert.Equal(t, "ou-12345679", resp.GetNodes()[0].GetParentId())
+	assert.Equal(t, restv1.ListLocationsResponse_RESOURCE_KIND_OU, resp.GetNodes()[0].GetType())
+	assert.Equal(t, int32(1), resp.GetTotalElements())
+	mockedClient.AssertExpectations(t)
+}
@@ -6,6 +6,9 @@ package server
 import (
 	"context"
 
+	"golang.org/x/exp/maps"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
 	providerv1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/resources/provider/v1"
 	restv1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/services/v1"
 	inventory "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/inventory/v1"
@@ -92,7 +95,7 @@ func (is *InventorygRPCServer) CreateProvider(
 	}
 
 	providerCreated := fromInvProvider(invResp.GetProvider())
-	zlog.Debug().Msgf("Created %s", providerCreated)
+	zlog.Debug().Msgf("Created %s", redact(providerCreated))
 	return providerCreated, nil
 }
 
@@ -131,7 +134,7 @@ func (is *InventorygRPCServer) ListProviders(
 		TotalElements: invResp.GetTotalElements(),
 		HasNext:       invResp.GetHasNext(),
 	}
-	zlog.Debug().Msgf("Listed %s", resp)
+	zlog.Debug().Msgf("Listed %s", redact(resp))
 	return resp, nil
 }
 
@@ -150,10 +153,76 @@ func (is *InventorygRPCServer) GetProvider(
 
 	invProvider := invResp.GetResource().GetProvider()
 	provider := fromInvProvider(invProvider)
-	zlog.Debug().Msgf("Got %s", provider)
+	zlog.Debug().Msgf("Got %s", redact(provider))
 	return provider, nil
 }
 
+// Update a provider. (PUT).
+func (is *InventorygRPCServer) UpdateProvider(
+	ctx context.Context,
+	req *restv1.UpdateProviderRequest,
+) (*providerv1.ProviderResource, error) {
+	zlog.Debug().Msg("UpdateProvider")
+
+	invProvider, err := toInvProvider(req.GetProvider())
+	if err != nil {
+		zlog.InfraErr(err).Msg("Failed to convert to inventory provider")
+		return nil, errors.Wrap(err)
+	}
+
+	fieldmask, err := fieldmaskpb.New(invProvider, maps.Values(OpenAPIProviderToProto)...)
+	if err != nil {
+		zlog.InfraErr(err).Msg("Failed to create field mask")
+		return nil, errors.Wrap(err)
+	}
+
+	invRes := &inventory.Resource{
+		Resource: &inventory.Resource_Provider{
+			Provider: invProvider,
+		},
+	}
+	upRes, err := is.InvClient.Update(ctx, req.GetResourceId(), fieldmask, invRes)
+	if err != nil {
+		zlog.InfraErr(err).Msgf("failed to update inventory resource %s %s", req.GetResourceId(), redact(invRes))
+		return nil, errors.Wrap(err)
+	}
+	invUpRes := fromInvProvider(upRes.GetProvider())
+	zlog.Debug().Msgf("Updated %s", redact(invUpRes))
+	return invUpRes, nil
+}
+
+// Update a provider. (PATCH).
+func (is *InventorygRPCServer) PatchProvider(
+	ctx context.Context,
+	req *restv1.PatchProviderRequest,
+) (*providerv1.ProviderResource, error) {
+	zlog.Debug().Msg("PatchProvider")
+
+	invProvider, err := toInvProvider(req.GetProvider())
+	if err != nil {
+		zlog.InfraErr(err).Msg("Failed to convert to inventory provider")
+		return nil, errors.Wrap(err)
+	}
+
+	fieldmask, err := parseFielmask(invProvider, req.GetFieldMask(), OpenAPIProviderToProto)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	invRes := &inventory.Resource{
+		Resource: &inventory.Resource_Provider{
+			Provider: invProvider,
+		},
+	}
+	upRes, err := is.InvClient.Update(ctx, req.GetResourceId(), fieldmask, invRes)
+	if err != nil {
+		zlog.InfraErr(err).Msgf("failed to update inventory resource %s %s", req.GetResourceId(), redact(invRes))
+		return nil, errors.Wrap(err)
+	}
+	invUpRes := fromInvProvider(upRes.GetProvider())
+	zlog.Debug().Msgf("Updated %s", redact(invUpRes))
+	return invUpRes, nil
+}
+
 // Delete a provider.
 func (is *InventorygRPCServer) DeleteProvider(
 	ctx context.Context,
@@ -8,7 +8,9 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 
 	providerv1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/resources/provider/v1"
 	restv1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/services/v1"
@@ -343,3 +345,164 @@ func TestProvider_Delete(t *testing.T) {
 		})
 	}
 }
+
+func TestProvider_Update(t *testing.T) {
+	mockedClient := newMockedInventoryTestClient()
+	server := inv_server.InventorygRPCServer{InvClient: mockedClient}
+
+	cases := []struct {
+		name    string
+		mocks   func() []*mock.Call
+		ctx     context.Context
+		req     *restv1.UpdateProviderRequest
+		wantErr bool
+	}{
+		{
+			name: "Update Provider",
+			mocks: func() []*mock.Call {
+				return []*mock.Call{
+					mockedClient.On("Update", mock.Anything, "provider-12345678",
+						mock.MatchedBy(func(fm *fieldmaskpb.FieldMask) bool {
+							return assert.ElementsMatch(t, []string{"provider_kind", "provider_vendor", "name", "api_endpoint", "api_credentials", "config"}, fm.GetPaths())
+						}), mock.Anything).
+						Return(&inventory.Resource{
+							Resource: &inventory.Resource_Provider{
+								Provider: exampleInvProviderResource,
+							},
+						}, nil).Once(),
+				}
+			},
+			ctx: context.Background(),
+			req: &restv1.UpdateProviderRequest{
+				ResourceId: "provider-12345678",
+				Provider:   exampleAPIProviderResource,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Update Provider with invalid resource",
+			ctx:  context.Background(),
+			req: &restv1.UpdateProviderRequest{
+				ResourceId: "provider-12345678",
+				Provider:   &providerv1.ProviderResource{Name: "example-provider", ApiEndpoint: "not a url"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Update Provider with error",
+			mocks: func() []*mock.Call {
+				return []*mock.Call{
+					mockedClient.On("Update", mock.Anything, "provider-12345678", mock.Anything, mock.Anything).
+						Return(nil, errors.New("error")).Once(),
+				}
+			},
+			ctx: context.Background(),
+			req: &restv1.UpdateProviderRequest{
+				ResourceId: "provider-12345678",
+				Provider:   exampleAPIProviderResource,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.mocks != nil {
+				tc.mocks()
+			}
+
+			reply, err := server.UpdateProvider(tc.ctx, tc.req)
+			if tc.wantErr {
+				if err == nil {
+					t.Errorf("UpdateProvider() got err = nil, want err")
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("UpdateProvider() got err = %v, want nil", err)
+				return
+			}
+			if reply == nil {
+				t.Errorf("UpdateProvider() got reply = nil, want non-nil")
+				return
+			}
+			compareProtoMessages(t, exampleAPIProviderResource, reply)
+		})
+	}
+}
+
+func TestProvider_Patch(t *testing.T) {
+	mockedClient := newMockedInventoryTestClient()
+	server := inv_server.InventorygRPCServer{InvClient: mockedClient}
+
+	cases := []struct {
+		name    string
+		mocks   func() []*mock.Call
+		ctx     context.Context
+		req     *restv1.PatchProviderRequest
+		wantErr bool
+	}{
+		{
+			name: "Patch Provider",
+			mocks: func() []*mock.Call {
+				return []*mock.Call{
+					mockedClient.On("Update", mock.Anything, "provider-12345678",
+						mock.MatchedBy(func(fm *fieldmaskpb.FieldMask) bool {
+							return assert.ElementsMatch(t, []string{"api_credentials", "config"}, fm.GetPaths())
+						}), mock.Anything).
+						Return(&inventory.Resource{
+							Resource: &inventory.Resource_Provider{
+								Provider: exampleInvProviderResource,
+							},
+						}, nil).Once(),
+				}
+			},
+			ctx: context.Background(),
+			req: &restv1.PatchProviderRequest{
+				ResourceId: "provider-12345678",
+				Provider:   exampleAPIProviderResource,
+				FieldMask:  &fieldmaskpb.FieldMask{Paths: []string{"api_credentials", "config"}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Patch Provider with error",
+			mocks: func() []*mock.Call {
+				return []*mock.Call{
+					mockedClient.On("Update", mock.Anything, "provider-12345678", mock.Anything, mock.Anything).
+						Return(nil, errors.New("error")).Once(),
+				}
+			},
+			ctx: context.Background(),
+			req: &restv1.PatchProviderRequest{
+				ResourceId: "provider-12345678",
+				Provider:   exampleAPIProviderResource,
+				FieldMask:  &fieldmaskpb.FieldMask{Paths: []string{"api_credentials", "config"}},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.mocks != nil {
+				tc.mocks()
+			}
+
+			reply, err := server.PatchProvider(tc.ctx, tc.req)
+			if tc.wantErr {
+				if err == nil {
+					t.Errorf("PatchProvider() got err = nil, want err")
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("PatchProvider() got err = %v, want nil", err)
+				return
+			}
+			if reply == nil {
+				t.Errorf("PatchProvider() got reply = nil, want non-nil")
+				return
+			}
+			compareProtoMessages(t, exampleAPIProviderResource, reply)
+		})
+	}
+}
@@ -0,0 +1,126 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"sync"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	computev1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/resources/compute/v1"
+	customconfigv1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/resources/customconfig/v1"
+	localaccountv1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/resources/localaccount/v1"
+	providerv1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/resources/provider/v1"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/logging"
+)
+
+// redacted replaces the values of the sensitive fields in the logs.
+const redacted = logging.REDACTED
+
+// sensitiveFields are the fields of the API resources holding secrets or credentials, the ones of the Inventory
+// resources they are converted from being marked with the (ent.field).sensitive option.
+var sensitiveFields = map[protoreflect.FullName]bool{
+	fieldName(&computev1.HostResource{}, computev1.HostResourceEdgeMetadata):                          true,
+	fieldName(&customconfigv1.CustomConfigResource{}, customconfigv1.CustomConfigResourceFieldConfig): true,
+	fieldName(&localaccountv1.LocalAccountResource{}, localaccountv1.LocalAccountResourceFieldSshKey): true,
+	fieldName(&providerv1.ProviderResource{}, providerv1.ProviderResourceFieldConfig):                 true,
+}
+
+// sensitiveTypes caches whether the messages of a type can hold sensitive fields, by message full name.
+var sensitiveTypes sync.Map
+
+func fieldName(m proto.Message, name string) protoreflect.FullName {
+	return m.ProtoReflect().Descriptor().FullName().Append(protoreflect.Name(name))
+}
+
+// isSensitive tells whether the field of an API or Inventory resource is sensitive.
+func isSensitive(fd protoreflect.FieldDescriptor) bool {
+	return sensitiveFields[fd.FullName()] || logging.IsSensitive(fd)
+}
+
+// redact returns a copy of v with the values of its sensitive fields, and the ones of the messages it holds, masked
+// with redacted, if v is a protobuf message. Other values are returned as is. It wraps the resources, requests and
+// responses that are logged, such as Msgf("Listed %s", redact(resp)).
+func redact(v interface{}) interface{} {
+	m, ok := v.(proto.Message)
+	if !ok || !m.ProtoReflect().IsValid() || !holdsSensitive(m.ProtoReflect().Descriptor()) {
+		return v
+	}
+	masked := proto.Clone(m)
+	redactMessage(masked.ProtoReflect())
+	return masked
+}
+
+// holdsSensitive tells whether the messages of the type, or the ones they can hold, have sensitive fields.
+func holdsSensitive(md protoreflect.MessageDescriptor) bool {
+	if cached, ok := sensitiveTypes.Load(md.FullName()); ok {
+		return cached.(bool)
+	}
+	sensitive := reachesSensitive(md, make(map[protoreflect.FullName]bool))
+	sensitiveTypes.Store(md.FullName(), sensitive)
+	return sensitive
+}
+
+// reachesSensitive walks the message types reachable from md, the resources referencing each other.
+func reachesSensitive(md protoreflect.MessageDescriptor, seen map[protoreflect.FullName]bool) bool {
+	if seen[md.FullName()] {
+		return false
+	}
+	seen[md.FullName()] = true
+	fields := md.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if isSensitive(fd) {
+			return true
+		}
+		if fd.IsMap() {
+			fd = fd.MapValue()
+		}
+		if fd.Message() != nil && reachesSensitive(fd.Message(), seen) {
+			return true
+		}
+	}
+	return false
+}
+
+// redactMessage masks in place the sensitive fields of m and of the messages it holds.
+func redactMessage(m protoreflect.Message) {
+	var populated []protoreflect.FieldDescriptor
+	m.Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		populated = append(populated, fd)
+		return true
+	})
+	for _, fd := range populated {
+		switch {
+		case isSensitive(fd):
+			maskField(m, fd)
+		case fd.IsMap():
+			if fd.MapValue().Message() != nil {
+				m.Get(fd).Map().Range(func(_ protoreflect.MapKey, v protoreflect.Value) bool {
+					redactMessage(v.Message())
+					return true
+				})
+			}
+		case fd.IsList():
+			if fd.Message() != nil {
+				list := m.Get(fd).List()
+				for i := 0; i < list.Len(); i++ {
+					redactMessage(list.Get(i).Message())
+				}
+			}
+		case fd.Message() != nil:
+			redactMessage(m.Mutable(fd).Message())
+		}
+	}
+}
+
+// maskField replaces the value of a sensitive field with redacted, or clears it when it does not hold a string.
+func maskField(m protoreflect.Message, fd protoreflect.FieldDescriptor) {
+	if fd.Kind() != protoreflect.StringKind || fd.IsList() {
+		m.Clear(fd)
+		return
+	}
+	m.Set(fd, protoreflect.ValueOfString(redacted))
+}
@@ -0,0 +1,62 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package server_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	providerv1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/resources/provider/v1"
+	restv1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/services/v1"
+	inv_server "github.com/open-edge-platform/infra-core/apiv2/v2/internal/server"
+	inv_computev1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/compute/v1"
+	inventory "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/inventory/v1"
+)
+
+func TestRedact(t *testing.T) {
+	t.Run("InventoryHost", func(t *testing.T) {
+		invRes := &inventory.Resource{
+			Resource: &inventory.Resource_Host{
+				Host: &inv_computev1.HostResource{
+					Name:        "host",
+					BmcUsername: "admin",
+					BmcPassword: "bmc-password",
+					Metadata:    `[{"key":"cluster-name","value":"secret"}]`,
+				},
+			},
+		}
+
+		redacted, ok := inv_server.Redact(invRes).(*inventory.Resource)
+		require.True(t, ok)
+		assert.Equal(t, "host", redacted.GetHost().GetName())
+		assert.Equal(t, "admin", redacted.GetHost().GetBmcUsername())
+		assert.Equal(t, "[REDACTED]", redacted.GetHost().GetBmcPassword())
+		assert.Equal(t, "[REDACTED]", redacted.GetHost().GetMetadata())
+		assert.NotContains(t, redacted.String(), "bmc-password")
+		// The logged resource is left as is.
+		assert.Equal(t, "bmc-password", invRes.GetHost().GetBmcPassword())
+	})
+
+	t.Run("ListedProviders", func(t *testing.T) {
+		resp := &restv1.ListProvidersResponse{
+			Providers: []*providerv1.ProviderResource{
+				{Name: "provider", Config: `{"password":"provider-password"}`},
+			},
+		}
+
+		redacted, ok := inv_server.Redact(resp).(*restv1.ListProvidersResponse)
+		require.True(t, ok)
+		assert.Equal(t, "provider", redacted.GetProviders()[0].GetName())
+		assert.Equal(t, "[REDACTED]", redacted.GetProviders()[0].GetConfig())
+		assert.Equal(t, `{"password":"provider-password"}`, resp.GetProviders()[0].GetConfig())
+	})
+
+	t.Run("NotSensitive", func(t *testing.T) {
+		req := &restv1.GetProviderRequest{ResourceId: "provider-12345678"}
+		assert.Same(t, req, inv_server.Redact(req))
+		assert.Equal(t, "provider-12345678", inv_server.Redact("provider-12345678"))
+	})
+}
@@ -128,7 +128,7 @@ func (is *InventorygRPCServer) CreateRegion(
 		zlog.InfraErr(err).Msg("Failed to convert from inventory region")
 		return nil, errors.Wrap(err)
 	}
-	zlog.Debug().Msgf("Created %s", regionCreated)
+	zlog.Debug().Msgf("Created %s", redact(regionCreated))
 	return regionCreated, nil
 }
 
@@ -223,7 +223,7 @@ func (is *InventorygRPCServer) ListRegions(
 		TotalElements: invResp.GetTotalElements(),
 		HasNext:       invResp.GetHasNext(),
 	}
-	zlog.Debug().Msgf("Listed %s", resp)
+	zlog.Debug().Msgf("Listed %s", redact(resp))
 	return resp, nil
 }
 
@@ -246,7 +246,7 @@ func (is *InventorygRPCServer) GetRegion(
 		zlog.InfraErr(err).Msg("Failed to convert from inventory region")
 		return nil, errors.Wrap(err)
 	}
-	zlog.Debug().Msgf("Got %s", region)
+	zlog.Debug().Msgf("Got %s", redact(region))
 	return region, nil
 }
 
@@ -277,7 +277,7 @@ func (is *InventorygRPCServer) UpdateRegion(
 	}
 	upRes, err := is.InvClient.Update(ctx, req.GetResourceId(), fieldmask, invRes)
 	if err != nil {
-		zlog.InfraErr(err).Msgf("failed to update inventory resource %s %s", req.GetResourceId(), invRes)
+		zlog.InfraErr(err).Msgf("failed to update inventory resource %s %s", req.GetResourceId(), redact(invRes))
 		return nil, errors.Wrap(err)
 	}
 	invUp := upRes.GetRegion()
@@ -286,7 +286,7 @@ func (is *InventorygRPCServer) UpdateRegion(
 		return nil, errors.Wrap(err)
 	}
 
-	zlog.Debug().Msgf("Updated %s", invUpRes)
+	zlog.Debug().Msgf("Updated %s", redact(invUpRes))
 	return invUpRes, nil
 }
 
@@ -315,7 +315,7 @@ func (is *InventorygRPCServer) PatchRegion(
 	}
 	upRes, err := is.InvClient.Update(ctx, req.GetResourceId(), fieldmask, invRes)
 	if err != nil {
-		zlog.InfraErr(err).Msgf("failed to update inventory resource %s %s", req.GetResourceId(), invRes)
+		zlog.InfraErr(err).Msgf("failed to update inventory resource %s %s", req.GetResourceId(), redact(invRes))
 		return nil, errors.Wrap(err)
 	}
 	invUp := upRes.GetRegion()
@@ -324,7 +324,7 @@ func (is *InventorygRPCServer) PatchRegion(
 		return nil, errors.Wrap(err)
 	}
 
-	zlog.Debug().Msgf("Updated %s", invUpRes)
+	zlog.Debug().Msgf("Updated %s", redact(invUpRes))
 	return invUpRes, nil
 }
 
@@ -0,0 +1,274 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+
+	remoteaccessv1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/resources/remoteaccess/v1"
+	statusv1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/resources/status/v1"
+	restv1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/services/v1"
+	inv_computev1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/compute/v1"
+	inventory "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/inventory/v1"
+	inv_remoteaccessv1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/remoteaccess/v1"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/auditing"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/tenant"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/validator"
+)
+
+const (
+	// Bounds of the duration of a remote access session. The lower bound leaves
+	// some slack over the 10 minutes enforced by Inventory on the expiration.
+	minRemoteAccessDuration = 15 * time.Minute
+	maxRemoteAccessDuration = 24 * time.Hour
+
+	// Claims of the JWT used to identify the user in the audit logs.
+	claimPreferredUsername = "preferred_username"
+	claimEmail             = "email"
+)
+
+func toInvRemoteAccess(
+	remoteAccess *remoteaccessv1.RemoteAccessResource,
+	now time.Time,
+) (*inv_remoteaccessv1.RemoteAccessConfiguration, error) {
+	duration := time.Duration(remoteAccess.GetDurationSeconds()) * time.Second
+	if duration < minRemoteAccessDuration || duration > maxRemoteAccessDuration {
+		err := errors.Errorfc(codes.InvalidArgument, "remote access duration must be between %s and %s",
+			minRemoteAccessDuration, maxRemoteAccessDuration)
+		zlog.InfraErr(err).Msg("Invalid remote access duration")
+		return nil, err
+	}
+
+	invRemoteAccess := &inv_remoteaccessv1.RemoteAccessConfiguration{
+		Instance: &inv_computev1.InstanceResource{
+			ResourceId: remoteAccess.GetInstanceId(),
+		},
+		ExpirationTimestamp: uint64(now.Add(duration).Unix()), //nolint:gosec // Always positive.
+		DesiredState:        inv_remoteaccessv1.RemoteAccessState_REMOTE_ACCESS_STATE_ENABLED,
+	}
+
+	err := validator.ValidateMessage(invRemoteAccess)
+	if err != nil {
+		zlog.InfraErr(err).Msg("Failed to validate inventory resource")
+		return nil, err
+	}
+	return invRemoteAccess, nil
+}
+
+func fromInvRemoteAccess(
+	invRemoteAccess *inv_remoteaccessv1.RemoteAccessConfiguration,
+) *remoteaccessv1.RemoteAccessResource {
+	if invRemoteAccess == nil {
+		return &remoteaccessv1.RemoteAccessResource{}
+	}
+	return &remoteaccessv1.RemoteAccessResource{
+		ResourceId:          invRemoteAccess.GetResourceId(),
+		InstanceId:          invRemoteAccess.GetInstance().GetResourceId(),
+		ExpirationTimestamp: TruncateUint64ToUint32(invRemoteAccess.GetExpirationTimestamp()),
+		LocalPort:           invRemoteAccess.GetLocalPort(),
+		User:                invRemoteAccess.GetUser(),
+		CurrentState:        remoteaccessv1.RemoteAccessState(invRemoteAccess.GetCurrentState()),
+		DesiredState:        remoteaccessv1.RemoteAccessState(invRemoteAccess.GetDesiredState()),
+		ConfigurationStatus: invRemoteAccess.GetConfigurationStatus(),
+		ConfigurationStatusIndicator: statusv1.StatusIndication(
+			invRemoteAccess.GetConfigurationStatusIndicator()),
+		ConfigurationStatusTimestamp: TruncateUint64ToUint32(invRemoteAccess.GetConfigurationStatusTimestamp()),
+		Timestamps:                   GrpcToOpenAPITimestamps(invRemoteAccess),
+	}
+}
+
+func castToRemoteAccess(resp *inventory.GetResourceResponse) (*inv_remoteaccessv1.RemoteAccessConfiguration, error) {
+	if resp.GetResource().GetRemoteAccess() != nil {
+		return resp.GetResource().GetRemoteAccess(), nil
+	}
+	err := errors.Errorfc(codes.Internal, "%s is not a RemoteAccessConfiguration", resp.GetResource())
+	zlog.InfraErr(err).Msgf("could not cast inventory resource")
+	return nil, err
+}
+
+// auditRemoteAccess records an audit log for an action taken on a remote access session.
+func auditRemoteAccess(
+	ctx context.Context,
+	operation string,
+	invRemoteAccess *inv_remoteaccessv1.RemoteAccessConfiguration,
+) {
+	user, email := auditing.UNKNOWN, auditing.UNKNOWN
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(claimPreferredUsername); len(values) > 0 {
+			user = values[0]
+		}
+		if values := md.Get(claimEmail); len(values) > 0 {
+			email = values[0]
+		}
+	}
+	tenantID, _ := tenant.GetTenantIDFromContext(ctx)
+	zlog.InfraAuditEvent().InfraAuditOperation(operation).InfraAuditUsr(user).InfraAuditEmail(email).Info().
+		Str("tenant_id", tenantID).
+		Str("resource_id", invRemoteAccess.GetResourceId()).
+		Str("instance_id", invRemoteAccess.GetInstance().GetResourceId()).
+		Uint64("expiration_timestamp", invRemoteAccess.GetExpirationTimestamp()).
+		Msg("Remote access session")
+}
+
+// revokeIfExpired enforces the expiration of a remote access session: sessions past their
+// expiration that are not yet revoked are deleted, and returned with the DELETED desired state.
+func (is *InventorygRPCServer) revokeIfExpired(
+	ctx context.Context,
+	invRemoteAccess *inv_remoteaccessv1.RemoteAccessConfiguration,
+	now time.Time,
+) (*inv_remoteaccessv1.RemoteAccessConfiguration, error) {
+	if invRemoteAccess.GetDesiredState() == inv_remoteaccessv1.RemoteAccessState_REMOTE_ACCESS_STATE_DELETED ||
+		invRemoteAccess.GetExpirationTimestamp() == 0 ||
+		invRemoteAccess.GetExpirationTimestamp() > uint64(now.Unix()) { //nolint:gosec // Always positive.
+		return invRemoteAccess, nil
+	}
+
+	if _, err := is.InvClient.Delete(ctx, invRemoteAccess.GetResourceId()); err != nil {
+		zlog.InfraErr(err).Msgf("Failed to revoke expired remote access %s", invRemoteAccess.GetResourceId())
+		return nil, errors.Wrap(err)
+	}
+	auditRemoteAccess(ctx, "ExpireRemoteAccess", invRemoteAccess)
+
+	expired, ok := proto.Clone(invRemoteAccess).(*inv_remoteaccessv1.RemoteAccessConfiguration)
+	if !ok {
+		err := errors.Errorfc(codes.Internal, "failed to clone remote access %s", invRemoteAccess.GetResourceId())
+		zlog.InfraErr(err).Msg("")
+		return nil, err
+	}
+	expired.DesiredState = inv_remoteaccessv1.RemoteAccessState_REMOTE_ACCESS_STATE_DELETED
+	return expired, nil
+}
+
+// Create a remote access session.
+func (is *InventorygRPCServer) CreateRemoteAccess(
+	ctx context.Context,
+	req *restv1.CreateRemoteAccessRequest,
+) (*remoteaccessv1.RemoteAccessResource, error) {
+	zlog.Debug().Msg("CreateRemoteAccess")
+
+	invRemoteAccess, err := toInvRemoteAccess(req.GetRemoteAccess(), time.Now())
+	if err != nil {
+		zlog.InfraErr(err).Msg("Failed to convert to inventory remote access")
+		return nil, errors.Wrap(err)
+	}
+
+	invRes := &inventory.Resource{
+		Resource: &inventory.Resource_RemoteAccess{
+			RemoteAccess: invRemoteAccess,
+		},
+	}
+
+	invResp, err := is.InvClient.Create(ctx, invRes)
+	if err != nil {
+		zlog.InfraErr(err).Msg("Failed to create remote access in inventory")
+		return nil, errors.Wrap(err)
+	}
+	auditRemoteAccess(ctx, "CreateRemoteAccess", invResp.GetRemoteAccess())
+
+	remoteAccessCreated := fromInvRemoteAccess(invResp.GetRemoteAccess())
+	zlog.Debug().Msgf("Created %s", redact(remoteAccessCreated))
+	return remoteAccessCreated, nil
+}
+
+// Get a list of remote access sessions.
+func (is *InventorygRPCServer) ListRemoteAccesses(
+	ctx context.Context,
+	req *restv1.ListRemoteAccessesRequest,
+) (*restv1.ListRemoteAccessesResponse, error) {
+	zlog.Debug().Msg("ListRemoteAccesses")
+
+	filter := &inventory.ResourceFilter{
+		Resource: &inventory.Resource{Resource: &inventory.Resource_RemoteAccess{
+			RemoteAccess: &inv_remoteaccessv1.RemoteAccessConfiguration{},
+		}},
+		Offset:  req.GetOffset(),
+		Limit:   req.GetPageSize(),
+		OrderBy: req.GetOrderBy(),
+		Filter:  req.GetFilter(),
+	}
+	if err := validator.ValidateMessage(filter); err != nil {
+		zlog.InfraSec().InfraErr(err).Msg("failed to validate query params")
+		return nil, errors.Wrap(err)
+	}
+
+	invResp, err := is.InvClient.List(ctx, filter)
+	if err != nil {
+		zlog.InfraErr(err).Msg("Failed to list remote accesses from inventory")
+		return nil, errors.Wrap(err)
+	}
+
+	now := time.Now()
+	remoteAccesses := []*remoteaccessv1.RemoteAccessResource{}
+	for _, invRes := range invResp.GetResources() {
+		invRemoteAccess, err := castToRemoteAccess(invRes)
+		if err != nil {
+			return nil, err
+		}
+		invRemoteAccess, err = is.revokeIfExpired(ctx, invRemoteAccess, now)
+		if err != nil {
+			return nil, err
+		}
+		remoteAccesses = append(remoteAccesses, fromInvRemoteAccess(invRemoteAccess))
+	}
+
+	resp := &restv1.ListRemoteAccessesResponse{
+		RemoteAccesses: remoteAccesses,
+		TotalElements:  invResp.GetTotalElements(),
+		HasNext:        invResp.GetHasNext(),
+	}
+	zlog.Debug().Msgf("Listed %s", redact(resp))
+	return resp, nil
+}
+
+// Get a specific remote access session.
+func (is *InventorygRPCServer) GetRemoteAccess(
+	ctx context.Context,
+	req *restv1.GetRemoteAccessRequest,
+) (*remoteaccessv1.RemoteAccessResource, error) {
+	zlog.Debug().Msg("GetRemoteAccess")
+
+	invResp, err := is.InvClient.Get(ctx, req.GetResourceId())
+	if err != nil {
+		zlog.InfraErr(err).Msgf("failed to get inventory resource %s", req.GetResourceId())
+		return nil, errors.Wrap(err)
+	}
+
+	invRemoteAccess, err := castToRemoteAccess(invResp)
+	if err != nil {
+		return nil, err
+	}
+	invRemoteAccess, err = is.revokeIfExpired(ctx, invRemoteAccess, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	remoteAccess := fromInvRemoteAccess(invRemoteAccess)
+	zlog.Debug().Msgf("Got %s", redact(remoteAccess))
+	return remoteAccess, nil
+}
+
+// Revoke a remote access session.
+func (is *InventorygRPCServer) DeleteRemoteAccess(
+	ctx context.Context,
+	req *restv1.DeleteRemoteAccessRequest,
+) (*restv1.DeleteRemoteAccessResponse, error) {
+	zlog.Debug().Msg("DeleteRemoteAccess")
+
+	_, err := is.InvClient.Delete(ctx, req.GetResourceId())
+	if err != nil {
+		zlog.InfraErr(err).Msg("Failed to delete remote access from inventory")
+		return nil, errors.Wrap(err)
+	}
+	auditRemoteAccess(ctx, "DeleteRemoteAccess", &inv_remoteaccessv1.RemoteAccessConfiguration{
+		ResourceId: req.GetResourceId(),
+	})
+	zlog.Debug().Msgf("Deleted %s", req.GetResourceId())
+	return &restv1.DeleteRemoteAccessResponse{}, nil
+}
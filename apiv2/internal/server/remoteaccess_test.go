@@ -0,0 +1,206 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package server_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	remoteaccessv1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/resources/remoteaccess/v1"
+	statusv1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/resources/status/v1"
+	restv1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/services/v1"
+	inv_server "github.com/open-edge-platform/infra-core/apiv2/v2/internal/server"
+	inv_computev1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/compute/v1"
+	inventory "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/inventory/v1"
+	inv_remoteaccessv1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/remoteaccess/v1"
+	inv_statusv1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/status/v1"
+)
+
+func exampleInvRemoteAccess(resourceID string, expiration time.Time) *inv_remoteaccessv1.RemoteAccessConfiguration {
+	return &inv_remoteaccessv1.RemoteAccessConfiguration{
+		ResourceId:                   resourceID,
+		Instance:                     &inv_computev1.InstanceResource{ResourceId: "inst-12345678"},
+		ExpirationTimestamp:          uint64(expiration.Unix()), //nolint:gosec // Test.
+		LocalPort:                    8022,
+		User:                         "user",
+		DesiredState:                 inv_remoteaccessv1.RemoteAccessState_REMOTE_ACCESS_STATE_ENABLED,
+		CurrentState:                 inv_remoteaccessv1.RemoteAccessState_REMOTE_ACCESS_STATE_ENABLED,
+		ConfigurationStatus:          "Remote access enabled",
+		ConfigurationStatusIndicator: inv_statusv1.StatusIndication_STATUS_INDICATION_IDLE,
+	}
+}
+
+func remoteAccessResource(remoteAccess *inv_remoteaccessv1.RemoteAccessConfiguration) *inventory.Resource {
+	return &inventory.Resource{Resource: &inventory.Resource_RemoteAccess{RemoteAccess: remoteAccess}}
+}
+
+func TestRemoteAccess_Create(t *testing.T) {
+	cases := []struct {
+		name         string
+		mocks        func(mockedClient *mock.Mock)
+		remoteAccess *remoteaccessv1.RemoteAccessResource
+		wantErr      bool
+	}{
+		{
+			name: "Create remote access",
+			mocks: func(mockedClient *mock.Mock) {
+				mockedClient.On("Create", mock.Anything, mock.MatchedBy(func(res *inventory.Resource) bool {
+					expiration := time.Unix(int64(res.GetRemoteAccess().GetExpirationTimestamp()), 0) //nolint:gosec // Test.
+					return res.GetRemoteAccess().GetInstance().GetResourceId() == "inst-12345678" &&
+						res.GetRemoteAccess().GetDesiredState() ==
+							inv_remoteaccessv1.RemoteAccessState_REMOTE_ACCESS_STATE_ENABLED &&
+						time.Until(expiration) > 59*time.Minute && time.Until(expiration) <= time.Hour
+				})).Return(remoteAccessResource(
+					exampleInvRemoteAccess("rmtacconf-12345678", time.Now().Add(time.Hour))), nil).Once()
+			},
+			remoteAccess: &remoteaccessv1.RemoteAccessResource{InstanceId: "inst-12345678", DurationSeconds: 3600},
+		},
+		{
+			name:         "Duration too short",
+			remoteAccess: &remoteaccessv1.RemoteAccessResource{InstanceId: "inst-12345678", DurationSeconds: 60},
+			wantErr:      true,
+		},
+		{
+			name:         "Duration too long",
+			remoteAccess: &remoteaccessv1.RemoteAccessResource{InstanceId: "inst-12345678", DurationSeconds: 86401},
+			wantErr:      true,
+		},
+		{
+			name:         "Invalid instance",
+			remoteAccess: &remoteaccessv1.RemoteAccessResource{InstanceId: "host-12345678", DurationSeconds: 3600},
+			wantErr:      true,
+		},
+		{
+			name: "Create error",
+			mocks: func(mockedClient *mock.Mock) {
+				mockedClient.On("Create", mock.Anything, mock.Anything).Return(nil, errors.New("error")).Once()
+			},
+			remoteAccess: &remoteaccessv1.RemoteAccessResource{InstanceId: "inst-12345678", DurationSeconds: 3600},
+			wantErr:      true,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockedClient := newMockedInventoryTestClient()
+			server := inv_server.InventorygRPCServer{InvClient: mockedClient}
+			if tc.mocks != nil {
+				tc.mocks(&mockedClient.Mock)
+			}
+
+			remoteAccess, err := server.CreateRemoteAccess(context.Background(), &restv1.CreateRemoteAccessRequest{
+				RemoteAccess: tc.remoteAccess,
+			})
+			mockedClient.AssertExpectations(t)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "rmtacconf-12345678", remoteAccess.GetResourceId())
+			assert.Equal(t, "inst-12345678", remoteAccess.GetInstanceId())
+			assert.Equal(t, remoteaccessv1.RemoteAccessState_REMOTE_ACCESS_STATE_ENABLED, remoteAccess.GetDesiredState())
+		})
+	}
+}
+
+func TestRemoteAccess_ListGet(t *testing.T) {
+	mockedClient := newMockedInventoryTestClient()
+	server := inv_server.InventorygRPCServer{InvClient: mockedClient}
+	active := exampleInvRemoteAccess("rmtacconf-12345678", time.Now().Add(time.Hour))
+
+	mockedClient.On("List", mock.Anything, mock.MatchedBy(func(f *inventory.ResourceFilter) bool {
+		return f.GetResource().GetRemoteAccess() != nil && f.GetFilter() == `instance.resource_id = "inst-12345678"`
+	})).Return(&inventory.ListResourcesResponse{
+		Resources:     []*inventory.GetResourceResponse{{Resource: remoteAccessResource(active)}},
+		TotalElements: 1,
+	}, nil).Once()
+	mockedClient.On("Get", mock.Anything, "rmtacconf-12345678").
+		Return(&inventory.GetResourceResponse{Resource: remoteAccessResource(active)}, nil).Once()
+
+	resp, err := server.ListRemoteAccesses(context.Background(), &restv1.ListRemoteAccessesRequest{
+		Filter: `instance.resource_id = "inst-12345678"`,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.GetRemoteAccesses(), 1)
+	assert.Equal(t, int32(1), resp.GetTotalElements())
+
+	remoteAccess, err := server.GetRemoteAccess(context.Background(), &restv1.GetRemoteAccessRequest{
+		ResourceId: "rmtacconf-12345678",
+	})
+	require.NoError(t, err)
+	compareProtoMessages(t, &remoteaccessv1.RemoteAccessResource{
+		ResourceId:                   "rmtacconf-12345678",
+		InstanceId:                   "inst-12345678",
+		ExpirationTimestamp:          uint32(active.GetExpirationTimestamp()), //nolint:gosec // Test.
+		LocalPort:                    8022,
+		User:                         "user",
+		DesiredState:                 remoteaccessv1.RemoteAccessState_REMOTE_ACCESS_STATE_ENABLED,
+		CurrentState:                 remoteaccessv1.RemoteAccessState_REMOTE_ACCESS_STATE_ENABLED,
+		ConfigurationStatus:          "Remote access enabled",
+		ConfigurationStatusIndicator: statusv1.StatusIndication_STATUS_INDICATION_IDLE,
+	}, remoteAccess)
+	mockedClient.AssertExpectations(t)
+}
+
+func TestRemoteAccess_Expiry(t *testing.T) {
+	mockedClient := newMockedInventoryTestClient()
+	server := inv_server.InventorygRPCServer{InvClient: mockedClient}
+	expired := exampleInvRemoteAccess("rmtacconf-12345678", time.Now().Add(-time.Minute))
+	revoked := exampleInvRemoteAccess("rmtacconf-87654321", time.Now().Add(-time.Minute))
+	revoked.DesiredState = inv_remoteaccessv1.RemoteAccessState_REMOTE_ACCESS_STATE_DELETED
+
+	mockedClient.On("List", mock.Anything, mock.Anything).Return(&inventory.ListResourcesResponse{
+		Resources: []*inventory.GetResourceResponse{
+			{Resource: remoteAccessResource(expired)},
+			{Resource: remoteAccessResource(revoked)},
+		},
+		TotalElements: 2,
+	}, nil).Once()
+	// Only the expired session that is still enabled is revoked.
+	mockedClient.On("Delete", mock.Anything, "rmtacconf-12345678").
+		Return(&inventory.DeleteResourceResponse{}, nil).Once()
+	mockedClient.On("Get", mock.Anything, "rmtacconf-12345678").
+		Return(&inventory.GetResourceResponse{Resource: remoteAccessResource(expired)}, nil).Once()
+	mockedClient.On("Delete", mock.Anything, "rmtacconf-12345678").Return(nil, errors.New("error")).Once()
+
+	resp, err := server.ListRemoteAccesses(context.Background(), &restv1.ListRemoteAccessesRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.GetRemoteAccesses(), 2)
+	for _, remoteAccess := range resp.GetRemoteAccesses() {
+		assert.Equal(t, remoteaccessv1.RemoteAccessState_REMOTE_ACCESS_STATE_DELETED, remoteAccess.GetDesiredState())
+	}
+	// The inventory resource is left untouched.
+	assert.Equal(t, inv_remoteaccessv1.RemoteAccessState_REMOTE_ACCESS_STATE_ENABLED, expired.GetDesiredState())
+
+	_, err = server.GetRemoteAccess(context.Background(), &restv1.GetRemoteAccessRequest{
+		ResourceId: "rmtacconf-12345678",
+	})
+	assert.Error(t, err)
+	mockedClient.AssertExpectations(t)
+}
+
+func TestRemoteAccess_Delete(t *testing.T) {
+	mockedClient := newMockedInventoryTestClient()
+	server := inv_server.InventorygRPCServer{InvClient: mockedClient}
+
+	mockedClient.On("Delete", mock.Anything, "rmtacconf-12345678").
+		Return(&inventory.DeleteResourceResponse{}, nil).Once()
+	mockedClient.On("Delete", mock.Anything, "rmtacconf-87654321").Return(nil, errors.New("not found")).Once()
+
+	_, err := server.DeleteRemoteAccess(context.Background(), &restv1.DeleteRemoteAccessRequest{
+		ResourceId: "rmtacconf-12345678",
+	})
+	require.NoError(t, err)
+	_, err = server.DeleteRemoteAccess(context.Background(), &restv1.DeleteRemoteAccessRequest{
+		ResourceId: "rmtacconf-87654321",
+	})
+	assert.Error(t, err)
+	mockedClient.AssertExpectations(t)
+}
@@ -0,0 +1,228 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+
+	"golang.org/x/exp/maps"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	rolebindingv1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/resources/rolebinding/v1"
+	restv1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/services/v1"
+	inventory "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/inventory/v1"
+	inv_locationv1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/location/v1"
+	inv_ouv1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/ou/v1"
+	inv_rolebindingv1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/rolebinding/v1"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/validator"
+)
+
+// OpenAPIRoleBindingToProto maps OpenAPI fields name to Proto fields name.
+// The key is derived from the json property respectively of the
+// structs RoleBinding defined in edge-infra-manager-openapi-types.gen.go.
+var OpenAPIRoleBindingToProto = map[string]string{
+	rolebindingv1.RoleBindingResourceFieldSubject:  inv_rolebindingv1.RoleBindingResourceFieldSubject,
+	rolebindingv1.RoleBindingResourceFieldRole:     inv_rolebindingv1.RoleBindingResourceFieldRole,
+	rolebindingv1.RoleBindingResourceFieldRegionId: inv_rolebindingv1.RoleBindingResourceEdgeRegion,
+	rolebindingv1.RoleBindingResourceFieldSiteId:   inv_rolebindingv1.RoleBindingResourceEdgeSite,
+	rolebindingv1.RoleBindingResourceFieldOuId:     inv_rolebindingv1.RoleBindingResourceEdgeOu,
+}
+
+func toInvRoleBinding(roleBinding *rolebindingv1.RoleBindingResource) (*inv_rolebindingv1.RoleBindingResource, error) {
+	if roleBinding == nil {
+		return &inv_rolebindingv1.RoleBindingResource{}, nil
+	}
+	invRoleBinding := &inv_rolebindingv1.RoleBindingResource{
+		Subject: roleBinding.GetSubject(),
+		Role:    inv_rolebindingv1.ScopedRole(roleBinding.GetRole()),
+	}
+
+	regionID := roleBinding.GetRegionId()
+	if isSet(&regionID) {
+		invRoleBinding.Region = &inv_locationv1.RegionResource{
+			ResourceId: regionID,
+		}
+	}
+	siteID := roleBinding.GetSiteId()
+	if isSet(&siteID) {
+		invRoleBinding.Site = &inv_locationv1.SiteResource{
+			ResourceId: siteID,
+		}
+	}
+	ouID := roleBinding.GetOuId()
+	if isSet(&ouID) {
+		invRoleBinding.Ou = &inv_ouv1.OuResource{
+			ResourceId: ouID,
+		}
+	}
+
+	err := validator.ValidateMessage(invRoleBinding)
+	if err != nil {
+		zlog.InfraErr(err).Msg("Failed to validate inventory resource")
+		return nil, err
+	}
+	return invRoleBinding, nil
+}
+
+func fromInvRoleBinding(invRoleBinding *inv_rolebindingv1.RoleBindingResource) *rolebindingv1.RoleBindingResource {
+	if invRoleBinding == nil {
+		return &rolebindingv1.RoleBindingResource{}
+	}
+	return &rolebindingv1.RoleBindingResource{
+		ResourceId: invRoleBinding.GetResourceId(),
+		Subject:    invRoleBinding.GetSubject(),
+		Role:       rolebindingv1.RoleBindingRole(invRoleBinding.GetRole()),
+		RegionId:   invRoleBinding.GetRegion().GetResourceId(),
+		SiteId:     invRoleBinding.GetSite().GetResourceId(),
+		OuId:       invRoleBinding.GetOu().GetResourceId(),
+		Timestamps: GrpcToOpenAPITimestamps(invRoleBinding),
+	}
+}
+
+// Create a role binding.
+func (is *InventorygRPCServer) CreateRoleBinding(
+	ctx context.Context,
+	req *restv1.CreateRoleBindingRequest,
+) (*rolebindingv1.RoleBindingResource, error) {
+	zlog.Debug().Msg("CreateRoleBinding")
+
+	if err := validator.ValidateMessage(req.GetRoleBinding()); err != nil {
+		zlog.InfraErr(err).Msg("Failed to validate role binding")
+		return nil, errors.Wrap(err)
+	}
+	invRoleBinding, err := toInvRoleBinding(req.GetRoleBinding())
+	if err != nil {
+		zlog.InfraErr(err).Msg("Failed to convert to inventory role binding")
+		return nil, errors.Wrap(err)
+	}
+
+	invRes := &inventory.Resource{
+		Resource: &inventory.Resource_RoleBinding{
+			RoleBinding: invRoleBinding,
+		},
+	}
+	invResp, err := is.InvClient.Create(ctx, invRes)
+	if err != nil {
+		zlog.InfraErr(err).Msg("Failed to create role binding in inventory")
+		return nil, errors.Wrap(err)
+	}
+
+	roleBindingCreated := fromInvRoleBinding(invResp.GetRoleBinding())
+	zlog.Debug().Msgf("Created %s", redact(roleBindingCreated))
+	return roleBindingCreated, nil
+}
+
+// Get a list of role bindings.
+func (is *InventorygRPCServer) ListRoleBindings(
+	ctx context.Context,
+	req *restv1.ListRoleBindingsRequest,
+) (*restv1.ListRoleBindingsResponse, error) {
+	zlog.Debug().Msg("ListRoleBindings")
+
+	filter := &inventory.ResourceFilter{
+		Resource: &inventory.Resource{Resource: &inventory.Resource_RoleBinding{
+			RoleBinding: &inv_rolebindingv1.RoleBindingResource{},
+		}},
+		Offset:  req.GetOffset(),
+		Limit:   req.GetPageSize(),
+		OrderBy: req.GetOrderBy(),
+		Filter:  req.GetFilter(),
+	}
+	if err := validator.ValidateMessage(filter); err != nil {
+		zlog.InfraSec().InfraErr(err).Msg("failed to validate query params")
+		return nil, errors.Wrap(err)
+	}
+	invResp, err := is.InvClient.List(ctx, filter)
+	if err != nil {
+		zlog.InfraErr(err).Msg("Failed to list role bindings from inventory")
+		return nil, errors.Wrap(err)
+	}
+
+	roleBindings := []*rolebindingv1.RoleBindingResource{}
+	for _, invRes := range invResp.GetResources() {
+		roleBindings = append(roleBindings, fromInvRoleBinding(invRes.GetResource().GetRoleBinding()))
+	}
+
+	resp := &restv1.ListRoleBindingsResponse{
+		RoleBindings:  roleBindings,
+		TotalElements: invResp.GetTotalElements(),
+		HasNext:       invResp.GetHasNext(),
+	}
+	zlog.Debug().Msgf("Listed %s", redact(resp))
+	return resp, nil
+}
+
+// Get a specific role binding.
+func (is *InventorygRPCServer) GetRoleBinding(
+	ctx context.Context,
+	req *restv1.GetRoleBindingRequest,
+) (*rolebindingv1.RoleBindingResource, error) {
+	zlog.Debug().Msg("GetRoleBinding")
+
+	invResp, err := is.InvClient.Get(ctx, req.GetResourceId())
+	if err != nil {
+		zlog.InfraErr(err).Msg("Failed to get role binding from inventory")
+		return nil, errors.Wrap(err)
+	}
+
+	roleBinding := fromInvRoleBinding(invResp.GetResource().GetRoleBinding())
+	zlog.Debug().Msgf("Got %s", redact(roleBinding))
+	return roleBinding, nil
+}
+
+// Update a role binding. (PUT).
+func (is *InventorygRPCServer) UpdateRoleBinding(
+	ctx context.Context,
+	req *restv1.UpdateRoleBindingRequest,
+) (*rolebindingv1.RoleBindingResource, error) {
+	zlog.Debug().Msg("UpdateRoleBinding")
+
+	if err := validator.ValidateMessage(req.GetRoleBinding()); err != nil {
+		zlog.InfraErr(err).Msg("Failed to validate role binding")
+		return nil, errors.Wrap(err)
+	}
+	invRoleBinding, err := toInvRoleBinding(req.GetRoleBinding())
+	if err != nil {
+		zlog.InfraErr(err).Msg("Failed to convert to inventory role binding")
+		return nil, errors.Wrap(err)
+	}
+
+	// All the scope edges are in the mask, the ones left unset are cleared.
+	fieldmask, err := fieldmaskpb.New(invRoleBinding, maps.Values(OpenAPIRoleBindingToProto)...)
+	if err != nil {
+		zlog.InfraErr(err).Msg("Failed to create field mask")
+		return nil, errors.Wrap(err)
+	}
+
+	invRes := &inventory.Resource{
+		Resource: &inventory.Resource_RoleBinding{
+			RoleBinding: invRoleBinding,
+		},
+	}
+	upRes, err := is.InvClient.Update(ctx, req.GetResourceId(), fieldmask, invRes)
+	if err != nil {
+		zlog.InfraErr(err).Msgf("failed to update inventory resource %s %s", req.GetResourceId(), redact(invRes))
+		return nil, errors.Wrap(err)
+	}
+	roleBindingUpdated := fromInvRoleBinding(upRes.GetRoleBinding())
+	zlog.Debug().Msgf("Updated %s", redact(roleBindingUpdated))
+	return roleBindingUpdated, nil
+}
+
+// Delete a role binding.
+func (is *InventorygRPCServer) DeleteRoleBinding(
+	ctx context.Context,
+	req *restv1.DeleteRoleBindingRequest,
+) (*restv1.DeleteRoleBindingResponse, error) {
+	zlog.Debug().Msg("DeleteRoleBinding")
+
+	_, err := is.InvClient.Delete(ctx, req.GetResourceId())
+	if err != nil {
+		zlog.InfraErr(err).Msg("Failed to delete role binding from inventory")
+		return nil, errors.Wrap(err)
+	}
+	zlog.Debug().Msgf("Deleted %s", req.GetResourceId())
+	return &restv1.DeleteRoleBindingResponse{}, nil
+}
@@ -0,0 +1,165 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package server_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	rolebindingv1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/resources/rolebinding/v1"
+	restv1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/services/v1"
+	inv_server "github.com/open-edge-platform/infra-core/apiv2/v2/internal/server"
+	inventory "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/inventory/v1"
+	inv_locationv1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/location/v1"
+	inv_rolebindingv1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/rolebinding/v1"
+)
+
+// Example resources for testing.
+var (
+	// Example role binding resource from the API.
+	exampleAPIRoleBindingResource = &rolebindingv1.RoleBindingResource{
+		ResourceId: "rolebinding-12345678",
+		Subject:    "user",
+		Role:       rolebindingv1.RoleBindingRole_ROLE_BINDING_ROLE_READ_WRITE,
+		SiteId:     "site-12345678",
+	}
+
+	// Example role binding resource from the Inventory.
+	exampleInvRoleBindingResource = &inv_rolebindingv1.RoleBindingResource{
+		ResourceId: "rolebinding-12345678",
+		Subject:    "user",
+		Role:       inv_rolebindingv1.ScopedRole_SCOPED_ROLE_READ_WRITE,
+		Site:       &inv_locationv1.SiteResource{ResourceId: "site-12345678"},
+		TenantId:   "tenant-987654",
+	}
+)
+
+// assertExampleRoleBinding checks that the role binding is the example one.
+func assertExampleRoleBinding(t *testing.T, roleBinding *rolebindingv1.RoleBindingResource) {
+	t.Helper()
+	assert.Equal(t, exampleAPIRoleBindingResource.GetResourceId(), roleBinding.GetResourceId())
+	assert.Equal(t, exampleAPIRoleBindingResource.GetSubject(), roleBinding.GetSubject())
+	assert.Equal(t, exampleAPIRoleBindingResource.GetRole(), roleBinding.GetRole())
+	assert.Equal(t, exampleAPIRoleBindingResource.GetSiteId(), roleBinding.GetSiteId())
+	assert.Empty(t, roleBinding.GetRegionId())
+	assert.Empty(t, roleBinding.GetOuId())
+}
+
+func TestRoleBinding_Create(t *testing.T) {
+	mockedClient := newMockedInventoryTestClient()
+	server := inv_server.InventorygRPCServer{InvClient: mockedClient}
+
+	cases := []struct {
+		name    string
+		mocks   func() []*mock.Call
+		req     *restv1.CreateRoleBindingRequest
+		wantErr bool
+	}{
+		{
+			name: "Create RoleBinding",
+			mocks: func() []*mock.Call {
+				return []*mock.Call{
+					mockedClient.On("Create", mock.Anything, mock.MatchedBy(func(res *inventory.Resource) bool {
+						rb := res.GetRoleBinding()
+						return rb.GetSubject() == "user" &&
+							rb.GetRole() == inv_rolebindingv1.ScopedRole_SCOPED_ROLE_READ_WRITE &&
+							rb.GetSite().GetResourceId() == "site-12345678" &&
+							rb.GetRegion() == nil && rb.GetOu() == nil
+					})).Return(&inventory.Resource{
+						Resource: &inventory.Resource_RoleBinding{RoleBinding: exampleInvRoleBindingResource},
+					}, nil).Once(),
+				}
+			},
+			req: &restv1.CreateRoleBindingRequest{RoleBinding: exampleAPIRoleBindingResource},
+		},
+		{
+			name: "Create RoleBinding without role",
+			req: &restv1.CreateRoleBindingRequest{RoleBinding: &rolebindingv1.RoleBindingResource{
+				Subject: "user",
+				SiteId:  "site-12345678",
+			}},
+			wantErr: true,
+		},
+		{
+			name: "Create RoleBinding with error",
+			mocks: func() []*mock.Call {
+				return []*mock.Call{
+					mockedClient.On("Create", mock.Anything, mock.Anything).Return(nil, errors.New("error")).Once(),
+				}
+			},
+			req:     &restv1.CreateRoleBindingRequest{RoleBinding: exampleAPIRoleBindingResource},
+			wantErr: true,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.mocks != nil {
+				tc.mocks()
+			}
+			reply, err := server.CreateRoleBinding(context.Background(), tc.req)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assertExampleRoleBinding(t, reply)
+		})
+	}
+	mockedClient.AssertExpectations(t)
+}
+
+func TestRoleBinding_Update(t *testing.T) {
+	mockedClient := newMockedInventoryTestClient()
+	server := inv_server.InventorygRPCServer{InvClient: mockedClient}
+
+	// All the scope edges are updated, the ones not set are cleared.
+	mockedClient.On("Update", mock.Anything, "rolebinding-12345678",
+		mock.MatchedBy(func(fm *fieldmaskpb.FieldMask) bool {
+			return assert.ElementsMatch(t, []string{"subject", "role", "region", "site", "ou"}, fm.GetPaths())
+		}), mock.Anything).
+		Return(&inventory.Resource{
+			Resource: &inventory.Resource_RoleBinding{RoleBinding: exampleInvRoleBindingResource},
+		}, nil).Once()
+
+	reply, err := server.UpdateRoleBinding(context.Background(), &restv1.UpdateRoleBindingRequest{
+		ResourceId:  "rolebinding-12345678",
+		RoleBinding: exampleAPIRoleBindingResource,
+	})
+	require.NoError(t, err)
+	assertExampleRoleBinding(t, reply)
+	mockedClient.AssertExpectations(t)
+}
+
+func TestRoleBinding_List(t *testing.T) {
+	mockedClient := newMockedInventoryTestClient()
+	server := inv_server.InventorygRPCServer{InvClient: mockedClient}
+
+	mockedClient.On("List", mock.Anything, mock.MatchedBy(func(f *inventory.ResourceFilter) bool {
+		return f.GetResource().GetRoleBinding() != nil && f.GetFilter() == `subject = "user"`
+	})).Return(&inventory.ListResourcesResponse{
+		Resources: []*inventory.GetResourceResponse{{
+			Resource: &inventory.Resource{
+				Resource: &inventory.Resource_RoleBinding{RoleBinding: exampleInvRoleBindingResource},
+			},
+		}},
+		TotalElements: 1,
+	}, nil).Once()
+
+	reply, err := server.ListRoleBindings(context.Background(), &restv1.ListRoleBindingsRequest{
+		Filter:   `subject = "user"`,
+		PageSize: 10,
+	})
+	require.NoError(t, err)
+	require.Len(t, reply.GetRoleBindings(), 1)
+	assertExampleRoleBinding(t, reply.GetRoleBindings()[0])
+	assert.Equal(t, int32(1), reply.GetTotalElements())
+	assert.False(t, reply.GetHasNext())
+	mockedClient.AssertExpectations(t)
+}
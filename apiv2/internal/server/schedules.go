@@ -78,7 +78,7 @@ func (is *InventorygRPCServer) ListSchedules(
 		PageSize:  req.GetPageSize(),
 		Offset:    req.GetOffset(),
 	}
-	zlog.Debug().Msgf("ListSingleSchedules %s", singleReq)
+	zlog.Debug().Msgf("ListSingleSchedules %s", redact(singleReq))
 	singleSchedules, err := is.ListSingleSchedules(ctx, singleReq)
 	if err != nil {
 		return nil, err
@@ -92,7 +92,7 @@ func (is *InventorygRPCServer) ListSchedules(
 		PageSize:  req.GetPageSize(),
 		Offset:    req.GetOffset(),
 	}
-	zlog.Debug().Msgf("ListRepeatedSchedules %s", repeatReq)
+	zlog.Debug().Msgf("ListRepeatedSchedules %s", redact(repeatReq))
 	repeatedSchedules, err := is.ListRepeatedSchedules(ctx, repeatReq)
 	if err != nil {
 		return nil, err
@@ -104,6 +104,6 @@ func (is *InventorygRPCServer) ListSchedules(
 		TotalElements:     singleSchedules.GetTotalElements() + repeatedSchedules.GetTotalElements(),
 		HasNext:           singleSchedules.GetHasNext() || repeatedSchedules.GetHasNext(),
 	}
-	zlog.Debug().Msgf("Listed %s", resp)
+	zlog.Debug().Msgf("Listed %s", redact(resp))
 	return resp, nil
 }
@@ -214,7 +214,7 @@ func (is *InventorygRPCServer) CreateRepeatedSchedule(
 		zlog.InfraErr(err).Msg("Failed to convert from inventory repeated schedule")
 		return nil, errors.Wrap(err)
 	}
-	zlog.Debug().Msgf("Created %s", repeatedScheduleCreated)
+	zlog.Debug().Msgf("Created %s", redact(repeatedScheduleCreated))
 	return repeatedScheduleCreated, nil
 }
 
@@ -278,7 +278,7 @@ func (is *InventorygRPCServer) ListRepeatedSchedules(
 		TotalElements:     totalElements,
 		HasNext:           hasNext,
 	}
-	zlog.Debug().Msgf("Listed %s", resp)
+	zlog.Debug().Msgf("Listed %s", redact(resp))
 	return resp, nil
 }
 
@@ -307,7 +307,7 @@ func (is *InventorygRPCServer) GetRepeatedSchedule(
 		zlog.InfraErr(err).Msg("Failed to convert from inventory repeated schedule")
 		return nil, errors.Wrap(err)
 	}
-	zlog.Debug().Msgf("Got %s", repeatedSchedule)
+	zlog.Debug().Msgf("Got %s", redact(repeatedSchedule))
 	return repeatedSchedule, nil
 }
 
@@ -344,7 +344,7 @@ func (is *InventorygRPCServer) UpdateRepeatedSchedule(
 	}
 	upRes, err := is.InvClient.Update(ctx, req.GetResourceId(), fieldmask, invRes)
 	if err != nil {
-		zlog.InfraErr(err).Msgf("failed to update inventory resource %s %s", req.GetResourceId(), invRes)
+		zlog.InfraErr(err).Msgf("failed to update inventory resource %s %s", req.GetResourceId(), redact(invRes))
 		return nil, errors.Wrap(err)
 	}
 	is.InvHCacheClient.InvalidateCache(
@@ -358,7 +358,7 @@ func (is *InventorygRPCServer) UpdateRepeatedSchedule(
 		return nil, errors.Wrap(err)
 	}
 
-	zlog.Debug().Msgf("Updated %s", invUpRes)
+	zlog.Debug().Msgf("Updated %s", redact(invUpRes))
 	return invUpRes, nil
 }
 
@@ -393,7 +393,7 @@ func (is *InventorygRPCServer) PatchRepeatedSchedule(
 	}
 	upRes, err := is.InvClient.Update(ctx, req.GetResourceId(), fieldmask, invRes)
 	if err != nil {
-		zlog.InfraErr(err).Msgf("failed to update inventory resource %s %s", req.GetResourceId(), invRes)
+		zlog.InfraErr(err).Msgf("failed to update inventory resource %s %s", req.GetResourceId(), redact(invRes))
 		return nil, errors.Wrap(err)
 	}
 	is.InvHCacheClient.InvalidateCache(
@@ -407,7 +407,7 @@ func (is *InventorygRPCServer) PatchRepeatedSchedule(
 		return nil, errors.Wrap(err)
 	}
 
-	zlog.Debug().Msgf("Updated %s", invUpRes)
+	zlog.Debug().Msgf("Updated %s", redact(invUpRes))
 	return invUpRes, nil
 }
 
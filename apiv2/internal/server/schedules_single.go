@@ -224,7 +224,7 @@ func (is *InventorygRPCServer) CreateSingleSchedule(
 		zlog.InfraErr(err).Msg("Failed to convert from inventory single schedule")
 		return nil, errors.Wrap(err)
 	}
-	zlog.Debug().Msgf("Created %s", invSinglescheduleCreated)
+	zlog.Debug().Msgf("Created %s", redact(invSinglescheduleCreated))
 	return invSinglescheduleCreated, nil
 }
 
@@ -286,7 +286,7 @@ func (is *InventorygRPCServer) ListSingleSchedules(
 		TotalElements:   totalElements,
 		HasNext:         hasNext,
 	}
-	zlog.Debug().Msgf("Listed %s", resp)
+	zlog.Debug().Msgf("Listed %s", redact(resp))
 	return resp, nil
 }
 
@@ -315,7 +315,7 @@ func (is *InventorygRPCServer) GetSingleSchedule(
 		zlog.InfraErr(err).Msg("Failed to convert from inventory single schedule")
 		return nil, errors.Wrap(err)
 	}
-	zlog.Debug().Msgf("Got %s", singleSchedule)
+	zlog.Debug().Msgf("Got %s", redact(singleSchedule))
 	return singleSchedule, nil
 }
 
@@ -353,7 +353,7 @@ func (is *InventorygRPCServer) UpdateSingleSchedule(
 	}
 	upRes, err := is.InvClient.Update(ctx, req.GetResourceId(), fieldmask, invRes)
 	if err != nil {
-		zlog.InfraErr(err).Msgf("failed to update inventory resource %s %s", req.GetResourceId(), invRes)
+		zlog.InfraErr(err).Msgf("failed to update inventory resource %s %s", req.GetResourceId(), redact(invRes))
 		return nil, errors.Wrap(err)
 	}
 	is.InvHCacheClient.InvalidateCache(
@@ -368,7 +368,7 @@ func (is *InventorygRPCServer) UpdateSingleSchedule(
 		return nil, errors.Wrap(err)
 	}
 
-	zlog.Debug().Msgf("Updated %s", invUpRes)
+	zlog.Debug().Msgf("Updated %s", redact(invUpRes))
 	return invUpRes, nil
 }
 
@@ -404,7 +404,7 @@ func (is *InventorygRPCServer) PatchSingleSchedule(
 	}
 	upRes, err := is.InvClient.Update(ctx, req.GetResourceId(), fieldmask, invRes)
 	if err != nil {
-		zlog.InfraErr(err).Msgf("failed to update inventory resource %s %s", req.GetResourceId(), invRes)
+		zlog.InfraErr(err).Msgf("failed to update inventory resource %s %s", req.GetResourceId(), redact(invRes))
 		return nil, errors.Wrap(err)
 	}
 	is.InvHCacheClient.InvalidateCache(
@@ -419,7 +419,7 @@ func (is *InventorygRPCServer) PatchSingleSchedule(
 		return nil, errors.Wrap(err)
 	}
 
-	zlog.Debug().Msgf("Updated %s", invUpRes)
+	zlog.Debug().Msgf("Updated %s", redact(invUpRes))
 	return invUpRes, nil
 }
 
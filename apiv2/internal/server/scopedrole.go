@@ -0,0 +1,137 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	"github.com/grpc-ecosystem/go-grpc-middleware/util/metautils"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/open-edge-platform/infra-core/apiv2/v2/internal/apitokens"
+	restv1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/services/v1"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/policy/rbac"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/tenant"
+)
+
+const (
+	// scopedRole grants access to the locations of the role bindings of the principal only.
+	scopedRole = "im-scoped"
+	rolesClaim = "realm_access/roles"
+)
+
+// scopedDeniedServices and scopedDeniedMethods are served by the API itself, or in the background, rather than by
+// inventory calls made with the JWT of the caller: the scope of the principals holding the scoped role cannot be
+// applied to them.
+var (
+	scopedDeniedServices = []string{
+		restv1.APITokenService_ServiceDesc.ServiceName,
+		restv1.OperationService_ServiceDesc.ServiceName,
+		restv1.ScheduleService_ServiceDesc.ServiceName,
+	}
+	scopedDeniedMethods = map[string]bool{
+		"/services.v1.HostService/BulkHostAction":         true,
+		"/services.v1.HostService/InvalidateHosts":        true,
+		"/services.v1.InstanceService/BulkInstanceAction": true,
+	}
+)
+
+type scopedJWTKey struct{}
+
+// scopedJWTFromContext returns the JWT of the principal holding the scoped role, set by the scoped role
+// interceptors, if the call is made by such a principal.
+func scopedJWTFromContext(ctx context.Context) (string, bool) {
+	jwt, ok := ctx.Value(scopedJWTKey{}).(string)
+	return jwt, ok
+}
+
+// scopedJWT returns the JWT of the call if it grants the scoped role in the project of the call, and neither the
+// read nor the read-write role that give access to the whole project.
+func scopedJWT(ctx context.Context) (string, bool) {
+	tenantID, ok := tenant.GetTenantIDFromContext(ctx)
+	if !ok {
+		return "", false
+	}
+	scheme, token, found := strings.Cut(metautils.ExtractIncoming(ctx).Get(authorizationKey), " ")
+	if !found || !strings.EqualFold(scheme, "bearer") || apitokens.IsAPIToken(token) {
+		return "", false
+	}
+	// The JWT has already been validated by the authentication interceptor.
+	claims, err := rbac.ExtractClaimsFromContext(ctx, false)
+	if err != nil {
+		return "", false
+	}
+	scoped := false
+	for _, role := range claims[rolesClaim] {
+		switch role {
+		case tenantID + "_im-r", tenantID + "_im-rw":
+			return "", false
+		case tenantID + "_" + scopedRole:
+			scoped = true
+		}
+	}
+	return token, scoped
+}
+
+// authorizeScopedRole rejects the calls of the principals holding the scoped role to the methods their scope cannot
+// be applied to, and returns the context of the other ones, carrying the JWT forwarded to the inventory.
+func authorizeScopedRole(ctx context.Context, fullMethod string) (context.Context, error) {
+	jwt, ok := scopedJWT(ctx)
+	if !ok {
+		return ctx, nil
+	}
+	service, _, _ := strings.Cut(strings.TrimPrefix(fullMethod, "/"), "/")
+	if scopedDeniedMethods[fullMethod] || slices.Contains(scopedDeniedServices, service) {
+		err := errors.Errorfc(codes.PermissionDenied, "%s is not allowed with the scoped role", fullMethod)
+		zlog.InfraSec().InfraErr(err).Msgf("Rejected scoped call: rejected=%s", fullMethod)
+		return nil, err
+	}
+	return context.WithValue(ctx, scopedJWTKey{}, jwt), nil
+}
+
+// ScopedRoleUnaryInterceptor restricts the calls of the principals holding the scoped role to the locations of their
+// role bindings: their JWT is forwarded to the inventory, which applies their scope, see forwardScopedJWTInterceptor.
+// It must run after the authentication and the extraction of the tenant.
+func ScopedRoleUnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, err := authorizeScopedRole(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// ScopedRoleStreamInterceptor is the streaming counterpart of ScopedRoleUnaryInterceptor.
+func ScopedRoleStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := authorizeScopedRole(ss.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+		wrapped := grpc_middleware.WrapServerStream(ss)
+		wrapped.WrappedContext = ctx
+		return handler(srv, wrapped)
+	}
+}
+
+// forwardScopedJWTInterceptor forwards the JWT of the principals holding the scoped role with their inventory calls.
+// The inventory restricts the calls bearing it to the locations of their role bindings.
+func forwardScopedJWTInterceptor() grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		if jwt, ok := scopedJWTFromContext(ctx); ok {
+			ctx = metadata.AppendToOutgoingContext(ctx, authorizationKey, "Bearer "+jwt)
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
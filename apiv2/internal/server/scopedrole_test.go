@@ -0,0 +1,110 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package server_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	inv_server "github.com/open-edge-platform/infra-core/apiv2/v2/internal/server"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/tenant"
+)
+
+// scopedCallContext returns the context of a call of the tenant authenticated with a JWT granting the given roles.
+func scopedCallContext(t *testing.T, roles ...string) (context.Context, string) {
+	t.Helper()
+	tenantRoles := make([]string, 0, len(roles))
+	for _, role := range roles {
+		tenantRoles = append(tenantRoles, watchTenantID+"_"+role)
+	}
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"sub": "user",
+		"exp": time.Now().Add(time.Hour).Unix(),
+		"realm_access": map[string]any{
+			"roles": tenantRoles,
+		},
+	}).SignedString([]byte("secret"))
+	require.NoError(t, err)
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+	return tenant.AddTenantIDToContext(ctx, watchTenantID), token
+}
+
+func TestScopedRole_Interceptor(t *testing.T) {
+	cases := []struct {
+		name       string
+		roles      []string
+		method     string
+		code       codes.Code
+		wantJWTOut bool
+	}{
+		{
+			name:       "Scoped role, the JWT is forwarded to the inventory",
+			roles:      []string{"im-scoped"},
+			method:     "/services.v1.HostService/ListHosts",
+			wantJWTOut: true,
+		},
+		{
+			name:   "Scoped and read roles, the whole project is readable",
+			roles:  []string{"im-scoped", "im-r"},
+			method: "/services.v1.HostService/ListHosts",
+		},
+		{
+			name:   "Read-write role",
+			roles:  []string{"im-rw"},
+			method: "/services.v1.APITokenService/CreateAPIToken",
+		},
+		{
+			name:   "Scoped role managing API tokens",
+			roles:  []string{"im-scoped"},
+			method: "/services.v1.APITokenService/CreateAPIToken",
+			code:   codes.PermissionDenied,
+		},
+		{
+			name:   "Scoped role listing schedules",
+			roles:  []string{"im-scoped"},
+			method: "/services.v1.ScheduleService/ListSchedules",
+			code:   codes.PermissionDenied,
+		},
+		{
+			name:   "Scoped role starting a bulk action",
+			roles:  []string{"im-scoped"},
+			method: "/services.v1.HostService/BulkHostAction",
+			code:   codes.PermissionDenied,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, token := scopedCallContext(t, tc.roles...)
+			var outgoing []string
+			// The handler calls the inventory through the client interceptor forwarding the JWT.
+			handler := func(ctx context.Context, _ any) (any, error) {
+				invoker := func(ctx context.Context, _ string, _, _ any, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+					md, _ := metadata.FromOutgoingContext(ctx)
+					outgoing = md.Get("authorization")
+					return nil
+				}
+				return nil, inv_server.ForwardScopedJWTInterceptor()(ctx, "/inventory.v1.InventoryService/ListResources",
+					nil, nil, nil, invoker)
+			}
+
+			_, err := inv_server.ScopedRoleUnaryInterceptor()(ctx, nil,
+				&grpc.UnaryServerInfo{FullMethod: tc.method}, handler)
+			assert.Equal(t, tc.code, status.Code(err))
+			if tc.wantJWTOut {
+				assert.Equal(t, []string{"Bearer " + token}, outgoing)
+			} else {
+				assert.Empty(t, outgoing)
+			}
+		})
+	}
+}
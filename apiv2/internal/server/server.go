@@ -15,7 +15,9 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
 
+	"github.com/open-edge-platform/infra-core/apiv2/v2/internal/apitokens"
 	"github.com/open-edge-platform/infra-core/apiv2/v2/internal/common"
+	"github.com/open-edge-platform/infra-core/apiv2/v2/internal/operations"
 	restv1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/services/v1"
 	"github.com/open-edge-platform/infra-core/apiv2/v2/internal/scenario"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/cert"
@@ -38,6 +40,7 @@ type serviceServersSignature func(*grpc.Server, *InventorygRPCServer)
 var servicesServers = map[string]serviceServersSignature{
 	"RegionService":   func(s *grpc.Server, is *InventorygRPCServer) { restv1.RegisterRegionServiceServer(s, is) },
 	"SiteService":     func(s *grpc.Server, is *InventorygRPCServer) { restv1.RegisterSiteServiceServer(s, is) },
+	"OuService":       func(s *grpc.Server, is *InventorygRPCServer) { restv1.RegisterOuServiceServer(s, is) },
 	"LocationService": func(s *grpc.Server, is *InventorygRPCServer) { restv1.RegisterLocationServiceServer(s, is) },
 	"HostService":     func(s *grpc.Server, is *InventorygRPCServer) { restv1.RegisterHostServiceServer(s, is) },
 	"OperatingSystemService": func(s *grpc.Server, is *InventorygRPCServer) {
@@ -66,11 +69,28 @@ var servicesServers = map[string]serviceServersSignature{
 	"CustomConfigService": func(s *grpc.Server, is *InventorygRPCServer) { restv1.RegisterCustomConfigServiceServer(s, is) },
 	"OSUpdatePolicy":      func(s *grpc.Server, is *InventorygRPCServer) { restv1.RegisterOSUpdatePolicyServer(s, is) },
 	"OSUpdateRun":         func(s *grpc.Server, is *InventorygRPCServer) { restv1.RegisterOSUpdateRunServer(s, is) },
+	"WatchService":        func(s *grpc.Server, is *InventorygRPCServer) { restv1.RegisterWatchServiceServer(s, is) },
+	"IPAddressService":    func(s *grpc.Server, is *InventorygRPCServer) { restv1.RegisterIPAddressServiceServer(s, is) },
+	"HostnicService":      func(s *grpc.Server, is *InventorygRPCServer) { restv1.RegisterHostnicServiceServer(s, is) },
+	"NetworkSegmentService": func(s *grpc.Server, is *InventorygRPCServer) {
+		restv1.RegisterNetworkSegmentServiceServer(s, is)
+	},
+	"EndpointService": func(s *grpc.Server, is *InventorygRPCServer) { restv1.RegisterEndpointServiceServer(s, is) },
+	"NetlinkService":  func(s *grpc.Server, is *InventorygRPCServer) { restv1.RegisterNetlinkServiceServer(s, is) },
+	"RemoteAccessService": func(s *grpc.Server, is *InventorygRPCServer) {
+		restv1.RegisterRemoteAccessServiceServer(s, is)
+	},
+	"OperationService":   func(s *grpc.Server, is *InventorygRPCServer) { restv1.RegisterOperationServiceServer(s, is) },
+	"APITokenService":    func(s *grpc.Server, is *InventorygRPCServer) { restv1.RegisterAPITokenServiceServer(s, is) },
+	"RoleBindingService": func(s *grpc.Server, is *InventorygRPCServer) { restv1.RegisterRoleBindingServiceServer(s, is) },
 }
 
 type InventorygRPCServer struct {
 	InvClient       client.InventoryClient
 	InvHCacheClient *schedule_cache.HScheduleCacheClient
+	WatchHub        *WatchHub
+	Operations      *operations.Manager
+	APITokens       *apitokens.Manager
 }
 
 // GetAPIRoles helper function to get role used by API component.
@@ -79,6 +99,7 @@ func GetAPIRoles() []string {
 	return []string{
 		"im-rw",
 		"im-r",
+		"im-scoped",
 	}
 }
 
@@ -87,25 +108,80 @@ func NewInventoryServer(
 	wg *sync.WaitGroup,
 	config *common.GlobalConfig,
 ) (*InventorygRPCServer, error) {
-	InvClient, err := NewInventoryClient(ctx, wg, config)
+	eventsWatcher := make(chan *client.WatchEvents, watchQueueSize)
+	InvClient, err := NewInventoryClient(ctx, wg, config, eventsWatcher)
 	if err != nil {
 		return nil, err
 	}
+	watchHub := NewWatchHub(config.Websocket.MaxConnections)
+	go watchHub.Run(ctx, eventsWatcher)
 	invHCacheClient, err := NewInventoryHCacheClient(ctx, config)
 	if err != nil {
 		return nil, err
 	}
+	operationsManager, err := NewOperationsManager(config)
+	if err != nil {
+		return nil, err
+	}
+	apiTokensManager, err := NewAPITokensManager(config)
+	if err != nil {
+		return nil, err
+	}
 	return &InventorygRPCServer{
 		InvClient:       InvClient,
 		InvHCacheClient: invHCacheClient,
+		WatchHub:        watchHub,
+		Operations:      operationsManager,
+		APITokens:       apiTokensManager,
 	}, nil
 }
 
+// NewOperationsManager creates the manager of the long-running operations, persisting them in the configured
+// directory if any. At most as many operations as workers run at once.
+func NewOperationsManager(config *common.GlobalConfig) (*operations.Manager, error) {
+	store := operations.NewMemoryStore()
+	if config.Operations.Dir != "" {
+		var err error
+		store, err = operations.NewFileStore(config.Operations.Dir)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return operations.NewManager(store, config.Worker.MaxWorkers, config.Operations.Retention)
+}
+
+// NewAPITokensManager creates the manager of the API tokens, persisting them in the configured directory if any.
+func NewAPITokensManager(config *common.GlobalConfig) (*apitokens.Manager, error) {
+	store := apitokens.NewMemoryStore()
+	if config.APITokens.Dir != "" {
+		var err error
+		store, err = apitokens.NewFileStore(config.APITokens.Dir)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return apitokens.NewManager(store), nil
+}
+
+// getExtractTenantIDStreamInterceptor returns the streaming counterpart of tenant.GetExtractTenantIDInterceptor.
+func getExtractTenantIDStreamInterceptor(expectedRoles []string) grpc.StreamServerInterceptor {
+	unaryInterceptor := tenant.GetExtractTenantIDInterceptor(expectedRoles)
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		_, err := unaryInterceptor(ss.Context(), nil, &grpc.UnaryServerInfo{Server: srv, FullMethod: info.FullMethod},
+			func(ctx context.Context, _ any) (any, error) {
+				wrapped := grpc_middleware.WrapServerStream(ss)
+				wrapped.WrappedContext = ctx
+				return nil, handler(srv, wrapped)
+			})
+		return err
+	}
+}
+
 func invalidSecureConfig(caCertPath, tlsCertPath, tlsKeyPath string) bool {
 	return caCertPath == "" || tlsCertPath == "" || tlsKeyPath == ""
 }
 
-func getServerOpts(enableTracing, enableAuth, insecureGrpc bool,
+func (is *InventorygRPCServer) getServerOpts(ctx context.Context, enableTracing, enableAuth, insecureGrpc bool,
 	caCertPath, tlsCertPath, tlsKeyPath string,
 ) ([]grpc.ServerOption, error) {
 	var srvOpts []grpc.ServerOption
@@ -120,7 +196,7 @@ func getServerOpts(enableTracing, enableAuth, insecureGrpc bool,
 				caCertPath, tlsCertPath, tlsKeyPath,
 			)
 		}
-		creds, err := cert.HandleCertPaths(caCertPath, tlsKeyPath, tlsCertPath, true)
+		creds, err := cert.HandleCertPaths(ctx, caCertPath, tlsKeyPath, tlsCertPath, true)
 		if err != nil {
 			zlog.InfraSec().Fatal().Err(err).Msgf("an error occurred while loading credentials to server %v, %v, %v: %v\n",
 				caCertPath, tlsCertPath, tlsKeyPath, err,
@@ -136,10 +212,15 @@ func getServerOpts(enableTracing, enableAuth, insecureGrpc bool,
 	if enableAuth {
 		zlog.InfraSec().Info().Msgf("Authentication is enabled")
 		// Adds tenantID interceptor before Authenticator
-		unaryInter = append(unaryInter,
+		jwtUnaryInter := grpc_middleware.ChainUnaryServer(
 			tenant.GetExtractTenantIDInterceptor(GetAPIRoles()),
 			grpc_auth.UnaryServerInterceptor(auth.AuthenticationInterceptor))
-		streamInter = append(streamInter, grpc_auth.StreamServerInterceptor(auth.AuthenticationInterceptor))
+		jwtStreamInter := grpc_middleware.ChainStreamServer(
+			getExtractTenantIDStreamInterceptor(GetAPIRoles()),
+			grpc_auth.StreamServerInterceptor(auth.AuthenticationInterceptor))
+		// The calls bearing an API token are authenticated with it instead of a JWT.
+		unaryInter = append(unaryInter, is.APITokenUnaryInterceptor(jwtUnaryInter), ScopedRoleUnaryInterceptor())
+		streamInter = append(streamInter, is.APITokenStreamInterceptor(jwtStreamInter), ScopedRoleStreamInterceptor())
 	}
 
 	if enableTracing {
@@ -195,7 +276,11 @@ func (is *InventorygRPCServer) Start(
 	enableAuth bool,
 	scenarioName string,
 ) {
-	srvOpts, err := getServerOpts(enableTracing, enableAuth, insecureGrpc, caCertPath, tlsCertPath, tlsKeyPath)
+	// The certificates are reloaded until the server stops.
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	srvOpts, err := is.getServerOpts(ctx, enableTracing, enableAuth, insecureGrpc, caCertPath, tlsCertPath,
+		tlsKeyPath)
 	if err != nil {
 		zlog.Fatal().Err(err).Msg("failed to get server opts")
 	}
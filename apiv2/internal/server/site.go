@@ -14,6 +14,7 @@ import (
 	restv1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/services/v1"
 	inventory "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/inventory/v1"
 	inv_locationv1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/location/v1"
+	inv_ouv1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/ou/v1"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/validator"
 )
@@ -27,6 +28,7 @@ var OpenAPISiteToProto = map[string]string{
 	locationv1.SiteResourceEdgeMetadata:  inv_locationv1.SiteResourceFieldMetadata,
 	locationv1.SiteResourceFieldName:     inv_locationv1.SiteResourceFieldName,
 	locationv1.SiteResourceFieldRegionId: inv_locationv1.SiteResourceEdgeRegion,
+	locationv1.SiteResourceFieldOuId:     inv_locationv1.SiteResourceEdgeOu,
 }
 
 func toInvSite(site *locationv1.SiteResource) (*inv_locationv1.SiteResource, error) {
@@ -54,6 +56,13 @@ func toInvSite(site *locationv1.SiteResource) (*inv_locationv1.SiteResource, err
 		}
 	}
 
+	ouID := site.GetOuId()
+	if isSet(&ouID) {
+		invSite.Ou = &inv_ouv1.OuResource{
+			ResourceId: ouID,
+		}
+	}
+
 	err = validator.ValidateMessage(invSite)
 	if err != nil {
 		zlog.InfraErr(err).Msg("Failed to validate inventory resource")
@@ -75,6 +84,11 @@ func fromInvSite(invSite *inv_locationv1.SiteResource,
 		return nil, err
 	}
 
+	ou, err := fromInvOu(invSite.GetOu(), nil)
+	if err != nil {
+		return nil, err
+	}
+
 	metadata, err := fromInvMetadata(invSite.GetMetadata())
 	if err != nil {
 		return nil, err
@@ -86,6 +100,8 @@ func fromInvSite(invSite *inv_locationv1.SiteResource,
 		Name:              invSite.GetName(),
 		Region:            region,
 		RegionId:          region.GetResourceId(),
+		Ou:                ou,
+		OuId:              ou.GetResourceId(),
 		SiteLat:           invSite.GetSiteLat(),
 		SiteLng:           invSite.GetSiteLng(),
 		Metadata:          metadata,
@@ -123,7 +139,7 @@ func (is *InventorygRPCServer) CreateSite(
 
 	invResp, err := is.InvClient.Create(ctx, invRes)
 	if err != nil {
-		zlog.InfraErr(err).Msgf("failed to create inventory resource %s", invRes)
+		zlog.InfraErr(err).Msgf("failed to create inventory resource %s", redact(invRes))
 		return nil, errors.Wrap(err)
 	}
 
@@ -131,7 +147,7 @@ func (is *InventorygRPCServer) CreateSite(
 	if err != nil {
 		return nil, errors.Wrap(err)
 	}
-	zlog.Debug().Msgf("Created %s", siteCreated)
+	zlog.Debug().Msgf("Created %s", redact(siteCreated))
 	return siteCreated, nil
 }
 
@@ -142,12 +158,18 @@ func (is *InventorygRPCServer) ListSites(
 ) (*restv1.ListSitesResponse, error) {
 	zlog.Debug().Msg("ListSites")
 
+	mask, err := readMask(&locationv1.SiteResource{}, req.GetView(), req.GetFields())
+	if err != nil {
+		return nil, err
+	}
+
 	filter := &inventory.ResourceFilter{
 		Resource: &inventory.Resource{Resource: &inventory.Resource_Site{Site: &inv_locationv1.SiteResource{}}},
 		Offset:   req.GetOffset(),
 		Limit:    req.GetPageSize(),
 		OrderBy:  req.GetOrderBy(),
 		Filter:   req.GetFilter(),
+		ReadMask: invReadMask(&locationv1.SiteResource{}, mask),
 	}
 	if err := validator.ValidateMessage(filter); err != nil {
 		zlog.InfraSec().InfraErr(err).Msg("failed to validate query params")
@@ -155,7 +177,7 @@ func (is *InventorygRPCServer) ListSites(
 	}
 	invResp, err := is.InvClient.List(ctx, filter)
 	if err != nil {
-		zlog.InfraErr(err).Msgf("failed to list inventory resources %s", filter)
+		zlog.InfraErr(err).Msgf("failed to list inventory resources %s", redact(filter))
 		return nil, errors.Wrap(err)
 	}
 
@@ -165,6 +187,7 @@ func (is *InventorygRPCServer) ListSites(
 		if err != nil {
 			return nil, errors.Wrap(err)
 		}
+		applyReadMask(site, mask)
 		sites = append(sites, site)
 	}
 
@@ -173,7 +196,7 @@ func (is *InventorygRPCServer) ListSites(
 		TotalElements: invResp.GetTotalElements(),
 		HasNext:       invResp.GetHasNext(),
 	}
-	zlog.Debug().Msgf("Listed %s", resp)
+	zlog.Debug().Msgf("Listed %s", redact(resp))
 	return resp, nil
 }
 
@@ -181,6 +204,11 @@ func (is *InventorygRPCServer) ListSites(
 func (is *InventorygRPCServer) GetSite(ctx context.Context, req *restv1.GetSiteRequest) (*locationv1.SiteResource, error) {
 	zlog.Debug().Msg("GetSite")
 
+	mask, err := readMask(&locationv1.SiteResource{}, req.GetView(), req.GetFields())
+	if err != nil {
+		return nil, err
+	}
+
 	invResp, err := is.InvClient.Get(ctx, req.GetResourceId())
 	if err != nil {
 		zlog.InfraErr(err).Msgf("failed to get inventory resource %s", req.GetResourceId())
@@ -192,7 +220,8 @@ func (is *InventorygRPCServer) GetSite(ctx context.Context, req *restv1.GetSiteR
 	if err != nil {
 		return nil, errors.Wrap(err)
 	}
-	zlog.Debug().Msgf("Got %s", site)
+	applyReadMask(site, mask)
+	zlog.Debug().Msgf("Got %s", redact(site))
 	return site, nil
 }
 
@@ -222,7 +251,7 @@ func (is *InventorygRPCServer) UpdateSite(
 
 	upRes, err := is.InvClient.Update(ctx, req.GetResourceId(), fieldmask, invRes)
 	if err != nil {
-		zlog.InfraErr(err).Msgf("failed to update inventory resource %s %s", req.GetResourceId(), invRes)
+		zlog.InfraErr(err).Msgf("failed to update inventory resource %s %s", req.GetResourceId(), redact(invRes))
 		return nil, errors.Wrap(err)
 	}
 	invUp := upRes.GetSite()
@@ -231,7 +260,7 @@ func (is *InventorygRPCServer) UpdateSite(
 		return nil, errors.Wrap(err)
 	}
 
-	zlog.Debug().Msgf("Updated %s", invUpRes)
+	zlog.Debug().Msgf("Updated %s", redact(invUpRes))
 	return invUpRes, nil
 }
 
@@ -260,7 +289,7 @@ func (is *InventorygRPCServer) PatchSite(
 
 	upRes, err := is.InvClient.Update(ctx, req.GetResourceId(), fieldmask, invRes)
 	if err != nil {
-		zlog.InfraErr(err).Msgf("failed to update inventory resource %s %s", req.GetResourceId(), invRes)
+		zlog.InfraErr(err).Msgf("failed to update inventory resource %s %s", req.GetResourceId(), redact(invRes))
 		return nil, errors.Wrap(err)
 	}
 	invUp := upRes.GetSite()
@@ -269,7 +298,7 @@ func (is *InventorygRPCServer) PatchSite(
 		return nil, errors.Wrap(err)
 	}
 
-	zlog.Debug().Msgf("Updated %s", invUpRes)
+	zlog.Debug().Msgf("Updated %s", redact(invUpRes))
 	return invUpRes, nil
 }
 
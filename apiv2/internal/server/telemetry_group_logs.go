@@ -67,7 +67,7 @@ func (is *InventorygRPCServer) CreateTelemetryLogsGroup(
 		return nil, errors.Wrap(err)
 	}
 	telemetryLogsGroupCreated := TelemetryLogsGroupResourcetoAPI(invResp.GetTelemetryGroup())
-	zlog.Debug().Msgf("Created %s", telemetryLogsGroupCreated)
+	zlog.Debug().Msgf("Created %s", redact(telemetryLogsGroupCreated))
 	return telemetryLogsGroupCreated, nil
 }
 
@@ -114,7 +114,7 @@ func (is *InventorygRPCServer) ListTelemetryLogsGroups(
 		TotalElements:       invResp.GetTotalElements(),
 		HasNext:             invResp.GetHasNext(),
 	}
-	zlog.Debug().Msgf("Listed %s", resp)
+	zlog.Debug().Msgf("Listed %s", redact(resp))
 	return resp, nil
 }
 
@@ -133,7 +133,7 @@ func (is *InventorygRPCServer) GetTelemetryLogsGroup(
 
 	telemetryGroup := invResp.GetResource().GetTelemetryGroup()
 	telemetryLogsGroup := TelemetryLogsGroupResourcetoAPI(telemetryGroup)
-	zlog.Debug().Msgf("Got %s", telemetryLogsGroup)
+	zlog.Debug().Msgf("Got %s", redact(telemetryLogsGroup))
 	return telemetryLogsGroup, nil
 }
 
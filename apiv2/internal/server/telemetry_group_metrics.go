@@ -68,7 +68,7 @@ func (is *InventorygRPCServer) CreateTelemetryMetricsGroup(
 	}
 
 	telemetryMetricsGroupCreated := TelemetryMetricsGroupResourcetoAPI(invResp.GetTelemetryGroup())
-	zlog.Debug().Msgf("Created %s", telemetryMetricsGroupCreated)
+	zlog.Debug().Msgf("Created %s", redact(telemetryMetricsGroupCreated))
 	return telemetryMetricsGroupCreated, nil
 }
 
@@ -115,7 +115,7 @@ func (is *InventorygRPCServer) ListTelemetryMetricsGroups(
 		TotalElements:          invResp.GetTotalElements(),
 		HasNext:                invResp.GetHasNext(),
 	}
-	zlog.Debug().Msgf("Listed %s", resp)
+	zlog.Debug().Msgf("Listed %s", redact(resp))
 	return resp, nil
 }
 
@@ -134,7 +134,7 @@ func (is *InventorygRPCServer) GetTelemetryMetricsGroup(
 
 	telemetryGroup := invResp.GetResource().GetTelemetryGroup()
 	telemetryMetricsGroup := TelemetryMetricsGroupResourcetoAPI(telemetryGroup)
-	zlog.Debug().Msgf("Got %s", telemetryMetricsGroup)
+	zlog.Debug().Msgf("Got %s", redact(telemetryMetricsGroup))
 	return telemetryMetricsGroup, nil
 }
 
@@ -124,7 +124,7 @@ func (is *InventorygRPCServer) CreateTelemetryLogsProfile(
 	}
 
 	telemetryLogsProfileCreated := TelemetryLogsProfileResourcetoAPI(invResp.GetTelemetryProfile())
-	zlog.Debug().Msgf("Created %s", telemetryLogsProfileCreated)
+	zlog.Debug().Msgf("Created %s", redact(telemetryLogsProfileCreated))
 	return telemetryLogsProfileCreated, nil
 }
 
@@ -191,7 +191,7 @@ func (is *InventorygRPCServer) ListTelemetryLogsProfiles(
 		TotalElements:         totalElems,
 		HasNext:               hasNext,
 	}
-	zlog.Debug().Msgf("Listed %s", resp)
+	zlog.Debug().Msgf("Listed %s", redact(resp))
 	return resp, nil
 }
 
@@ -210,7 +210,7 @@ func (is *InventorygRPCServer) GetTelemetryLogsProfile(
 
 	telemetryProfile := invResp.GetResource().GetTelemetryProfile()
 	telemetryLogsProfile := TelemetryLogsProfileResourcetoAPI(telemetryProfile)
-	zlog.Debug().Msgf("Got %s", telemetryLogsProfile)
+	zlog.Debug().Msgf("Got %s", redact(telemetryLogsProfile))
 	return telemetryLogsProfile, nil
 }
 
@@ -257,12 +257,12 @@ func (is *InventorygRPCServer) UpdateTelemetryLogsProfile(
 	}
 	upRes, err := is.InvClient.Update(ctx, req.GetResourceId(), fieldmask, invRes)
 	if err != nil {
-		zlog.InfraErr(err).Msgf("failed to update inventory resource %s %s", req.GetResourceId(), invRes)
+		zlog.InfraErr(err).Msgf("failed to update inventory resource %s %s", req.GetResourceId(), redact(invRes))
 		return nil, errors.Wrap(err)
 	}
 	invUp := upRes.GetTelemetryProfile()
 	invUpRes := TelemetryLogsProfileResourcetoAPI(invUp)
-	zlog.Debug().Msgf("Updated %s", invUpRes)
+	zlog.Debug().Msgf("Updated %s", redact(invUpRes))
 	return invUpRes, nil
 }
 
@@ -291,12 +291,12 @@ func (is *InventorygRPCServer) PatchTelemetryLogsProfile(
 	}
 	upRes, err := is.InvClient.Update(ctx, req.GetResourceId(), fieldmask, invRes)
 	if err != nil {
-		zlog.InfraErr(err).Msgf("failed to update inventory resource %s %s", req.GetResourceId(), invRes)
+		zlog.InfraErr(err).Msgf("failed to update inventory resource %s %s", req.GetResourceId(), redact(invRes))
 		return nil, errors.Wrap(err)
 	}
 	invUp := upRes.GetTelemetryProfile()
 	invUpRes := TelemetryLogsProfileResourcetoAPI(invUp)
-	zlog.Debug().Msgf("Updated %s", invUpRes)
+	zlog.Debug().Msgf("Updated %s", redact(invUpRes))
 	return invUpRes, nil
 }
 
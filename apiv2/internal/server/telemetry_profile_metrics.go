@@ -135,7 +135,7 @@ func (is *InventorygRPCServer) CreateTelemetryMetricsProfile(
 	}
 
 	telemetryMetricsProfileCreated := TelemetryMetricsProfileResourcetoAPI(invResp.GetTelemetryProfile())
-	zlog.Debug().Msgf("Created %s", telemetryMetricsProfileCreated)
+	zlog.Debug().Msgf("Created %s", redact(telemetryMetricsProfileCreated))
 	return telemetryMetricsProfileCreated, nil
 }
 
@@ -202,7 +202,7 @@ func (is *InventorygRPCServer) ListTelemetryMetricsProfiles(
 		TotalElements:            totalElems,
 		HasNext:                  hasNext,
 	}
-	zlog.Debug().Msgf("Listed %s", resp)
+	zlog.Debug().Msgf("Listed %s", redact(resp))
 	return resp, nil
 }
 
@@ -221,7 +221,7 @@ func (is *InventorygRPCServer) GetTelemetryMetricsProfile(
 
 	telemetryProfile := invResp.GetResource().GetTelemetryProfile()
 	telemetryMetricsProfile := TelemetryMetricsProfileResourcetoAPI(telemetryProfile)
-	zlog.Debug().Msgf("Got %s", telemetryMetricsProfile)
+	zlog.Debug().Msgf("Got %s", redact(telemetryMetricsProfile))
 	return telemetryMetricsProfile, nil
 }
 
@@ -270,12 +270,12 @@ func (is *InventorygRPCServer) UpdateTelemetryMetricsProfile(
 	}
 	upRes, err := is.InvClient.Update(ctx, req.GetResourceId(), fieldmask, invRes)
 	if err != nil {
-		zlog.InfraErr(err).Msgf("failed to update inventory resource %s %s", req.GetResourceId(), invRes)
+		zlog.InfraErr(err).Msgf("failed to update inventory resource %s %s", req.GetResourceId(), redact(invRes))
 		return nil, errors.Wrap(err)
 	}
 	invUp := upRes.GetTelemetryProfile()
 	invUpRes := TelemetryMetricsProfileResourcetoAPI(invUp)
-	zlog.Debug().Msgf("Updated %s", invUpRes)
+	zlog.Debug().Msgf("Updated %s", redact(invUpRes))
 	return invUpRes, nil
 }
 
@@ -304,12 +304,12 @@ func (is *InventorygRPCServer) PatchTelemetryMetricsProfile(
 	}
 	upRes, err := is.InvClient.Update(ctx, req.GetResourceId(), fieldmask, invRes)
 	if err != nil {
-		zlog.InfraErr(err).Msgf("failed to update inventory resource %s %s", req.GetResourceId(), invRes)
+		zlog.InfraErr(err).Msgf("failed to update inventory resource %s %s", req.GetResourceId(), redact(invRes))
 		return nil, errors.Wrap(err)
 	}
 	invUp := upRes.GetTelemetryProfile()
 	invUpRes := TelemetryMetricsProfileResourcetoAPI(invUp)
-	zlog.Debug().Msgf("Updated %s", invUpRes)
+	zlog.Debug().Msgf("Updated %s", redact(invUpRes))
 	return invUpRes, nil
 }
 
@@ -25,7 +25,7 @@ func fromInvMetadata(metadata string) ([]*commonv1.MetadataItem, error) {
 	if metadata != "" {
 		err := json.Unmarshal([]byte(metadata), &apiMetadata)
 		if err != nil {
-			zlog.InfraErr(err).Msgf("failed to unmarshal metadata: %s", metadata)
+			zlog.InfraErr(err).Msg("failed to unmarshal metadata")
 			return nil, err
 		}
 	}
@@ -37,7 +37,7 @@ func toInvMetadata(apiMetadata []*commonv1.MetadataItem) (string, error) {
 	if apiMetadata != nil {
 		invMetadataBytes, err := json.Marshal(apiMetadata)
 		if err != nil {
-			zlog.InfraErr(err).Msgf("failed to marshal metadata: %v", apiMetadata)
+			zlog.InfraErr(err).Msg("failed to marshal metadata")
 			return "", err
 		}
 		invMetadata = string(invMetadataBytes)
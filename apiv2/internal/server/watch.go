@@ -0,0 +1,401 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	restv1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/services/v1"
+	inventory "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/inventory/v1"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/client"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/tenant"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/util"
+)
+
+const (
+	// defaultWatchHeartbeat is the interval between heartbeats when the request does not set one.
+	defaultWatchHeartbeat = 30 * time.Second
+	// watchQueueSize is the number of events buffered for each watcher, a watcher that falls further behind is
+	// terminated so that it can resynchronize.
+	watchQueueSize = 100
+)
+
+// watchResourceKinds maps the watchable kinds to the inventory resource kinds.
+var watchResourceKinds = map[restv1.WatchResourceKind]inventory.ResourceKind{
+	restv1.WatchResourceKind_WATCH_RESOURCE_KIND_HOST:              inventory.ResourceKind_RESOURCE_KIND_HOST,
+	restv1.WatchResourceKind_WATCH_RESOURCE_KIND_INSTANCE:          inventory.ResourceKind_RESOURCE_KIND_INSTANCE,
+	restv1.WatchResourceKind_WATCH_RESOURCE_KIND_SINGLE_SCHEDULE:   inventory.ResourceKind_RESOURCE_KIND_SINGLESCHEDULE,
+	restv1.WatchResourceKind_WATCH_RESOURCE_KIND_REPEATED_SCHEDULE: inventory.ResourceKind_RESOURCE_KIND_REPEATEDSCHEDULE,
+	restv1.WatchResourceKind_WATCH_RESOURCE_KIND_OS_UPDATE_RUN:     inventory.ResourceKind_RESOURCE_KIND_OSUPDATERUN,
+}
+
+// scopedWatchResourceKinds are the kinds the principals holding the scoped role can watch, the located ones.
+var scopedWatchResourceKinds = []inventory.ResourceKind{
+	inventory.ResourceKind_RESOURCE_KIND_HOST,
+	inventory.ResourceKind_RESOURCE_KIND_INSTANCE,
+}
+
+// WatchedResourceKinds returns the inventory resource kinds whose events are relayed by WatchResources.
+func WatchedResourceKinds() []inventory.ResourceKind {
+	kinds := make([]inventory.ResourceKind, 0, len(watchResourceKinds))
+	for _, kind := range watchResourceKinds {
+		kinds = append(kinds, kind)
+	}
+	return kinds
+}
+
+type watcher struct {
+	tenantID string
+	kinds    map[inventory.ResourceKind]bool
+	events   chan *inventory.SubscribeEventsResponse
+	// overflow is closed when the watcher missed events because its queue was full.
+	overflow     chan struct{}
+	overflowOnce sync.Once
+}
+
+// WatchHub fans out the inventory events received by the API to the watchers of the project owning the resources.
+type WatchHub struct {
+	mu                  sync.Mutex
+	watchers            map[*watcher]struct{}
+	tenantWatchers      map[string]uint
+	maxWatchersByTenant uint
+}
+
+// NewWatchHub returns a hub accepting at most maxWatchersByTenant concurrent watchers per project.
+func NewWatchHub(maxWatchersByTenant uint) *WatchHub {
+	return &WatchHub{
+		watchers:            make(map[*watcher]struct{}),
+		tenantWatchers:      make(map[string]uint),
+		maxWatchersByTenant: maxWatchersByTenant,
+	}
+}
+
+// Run dispatches the events to the watchers until the channel is closed or the context is done.
+func (h *WatchHub) Run(ctx context.Context, events <-chan *client.WatchEvents) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case ev, ok := <-events:
+			if !ok {
+				zlog.InfraSec().Info().Msg("inventory events channel closed, stopping watch hub")
+				return
+			}
+			h.dispatch(ev.Event)
+		}
+	}
+}
+
+func (h *WatchHub) dispatch(event *inventory.SubscribeEventsResponse) {
+	tenantID, err := getResourceTenantID(event.GetResource())
+	if err != nil {
+		zlog.InfraErr(err).Msgf("dropping event of resource %s", event.GetResourceId())
+		return
+	}
+	kind := util.GetResourceKindFromResource(event.GetResource())
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for w := range h.watchers {
+		if w.tenantID != tenantID || !w.kinds[kind] {
+			continue
+		}
+		select {
+		case w.events <- event:
+		default:
+			w.overflowOnce.Do(func() { close(w.overflow) })
+		}
+	}
+}
+
+func (h *WatchHub) subscribe(tenantID string, kinds []inventory.ResourceKind) (*watcher, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.tenantWatchers[tenantID] >= h.maxWatchersByTenant {
+		err := errors.Errorfc(codes.ResourceExhausted,
+			"at most %d concurrent watches are allowed per project", h.maxWatchersByTenant)
+		zlog.InfraErr(err).Msgf("rejecting watch of tenant %s", tenantID)
+		return nil, err
+	}
+	w := &watcher{
+		tenantID: tenantID,
+		kinds:    make(map[inventory.ResourceKind]bool, len(kinds)),
+		events:   make(chan *inventory.SubscribeEventsResponse, watchQueueSize),
+		overflow: make(chan struct{}),
+	}
+	for _, kind := range kinds {
+		w.kinds[kind] = true
+	}
+	h.watchers[w] = struct{}{}
+	h.tenantWatchers[tenantID]++
+	return w, nil
+}
+
+func (h *WatchHub) unsubscribe(w *watcher) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	delete(h.watchers, w)
+	h.tenantWatchers[w.tenantID]--
+	if h.tenantWatchers[w.tenantID] == 0 {
+		delete(h.tenantWatchers, w.tenantID)
+	}
+}
+
+func getResourceTenantID(res *inventory.Resource) (string, error) {
+	switch util.GetResourceKindFromResource(res) {
+	case inventory.ResourceKind_RESOURCE_KIND_HOST:
+		return res.GetHost().GetTenantId(), nil
+	case inventory.ResourceKind_RESOURCE_KIND_INSTANCE:
+		return res.GetInstance().GetTenantId(), nil
+	case inventory.ResourceKind_RESOURCE_KIND_SINGLESCHEDULE:
+		return res.GetSingleschedule().GetTenantId(), nil
+	case inventory.ResourceKind_RESOURCE_KIND_REPEATEDSCHEDULE:
+		return res.GetRepeatedschedule().GetTenantId(), nil
+	case inventory.ResourceKind_RESOURCE_KIND_OSUPDATERUN:
+		return res.GetOsUpdateRun().GetTenantId(), nil
+	default:
+		return "", errors.Errorfc(codes.InvalidArgument, "unsupported resource kind %T", res.GetResource())
+	}
+}
+
+// fromInvWatchEvent converts the inventory event into its API representation.
+func fromInvWatchEvent(event *inventory.SubscribeEventsResponse) (*restv1.WatchResourcesResponse_Event, error) {
+	apiEvent := &restv1.WatchResourcesResponse_Event{
+		ResourceId: event.GetResourceId(),
+	}
+	switch event.GetEventKind() {
+	case inventory.SubscribeEventsResponse_EVENT_KIND_CREATED:
+		apiEvent.Kind = restv1.WatchResourcesResponse_EVENT_KIND_CREATED
+	case inventory.SubscribeEventsResponse_EVENT_KIND_UPDATED:
+		apiEvent.Kind = restv1.WatchResourcesResponse_EVENT_KIND_UPDATED
+	case inventory.SubscribeEventsResponse_EVENT_KIND_DELETED:
+		apiEvent.Kind = restv1.WatchResourcesResponse_EVENT_KIND_DELETED
+	default:
+		return nil, errors.Errorfc(codes.Internal, "unsupported event kind %s", event.GetEventKind())
+	}
+
+	res := event.GetResource()
+	switch util.GetResourceKindFromResource(res) {
+	case inventory.ResourceKind_RESOURCE_KIND_HOST:
+		host, err := fromInvHost(res.GetHost(), nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		apiEvent.ResourceKind = restv1.WatchResourceKind_WATCH_RESOURCE_KIND_HOST
+		apiEvent.Resource = &restv1.WatchResourcesResponse_Event_Host{Host: host}
+	case inventory.ResourceKind_RESOURCE_KIND_INSTANCE:
+		instance, err := fromInvInstance(res.GetInstance())
+		if err != nil {
+			return nil, err
+		}
+		apiEvent.ResourceKind = restv1.WatchResourceKind_WATCH_RESOURCE_KIND_INSTANCE
+		apiEvent.Resource = &restv1.WatchResourcesResponse_Event_Instance{Instance: instance}
+	case inventory.ResourceKind_RESOURCE_KIND_SINGLESCHEDULE:
+		singleSchedule, err := fromInvSingleschedule(res.GetSingleschedule())
+		if err != nil {
+			return nil, err
+		}
+		apiEvent.ResourceKind = restv1.WatchResourceKind_WATCH_RESOURCE_KIND_SINGLE_SCHEDULE
+		apiEvent.Resource = &restv1.WatchResourcesResponse_Event_SingleSchedule{SingleSchedule: singleSchedule}
+	case inventory.ResourceKind_RESOURCE_KIND_REPEATEDSCHEDULE:
+		repeatedSchedule, err := fromInvRepeatedSchedule(res.GetRepeatedschedule())
+		if err != nil {
+			return nil, err
+		}
+		apiEvent.ResourceKind = restv1.WatchResourceKind_WATCH_RESOURCE_KIND_REPEATED_SCHEDULE
+		apiEvent.Resource = &restv1.WatchResourcesResponse_Event_RepeatedSchedule{RepeatedSchedule: repeatedSchedule}
+	case inventory.ResourceKind_RESOURCE_KIND_OSUPDATERUN:
+		osUpdateRun, err := fromInvOSUpdateRunResource(res.GetOsUpdateRun())
+		if err != nil {
+			return nil, err
+		}
+		apiEvent.ResourceKind = restv1.WatchResourceKind_WATCH_RESOURCE_KIND_OS_UPDATE_RUN
+		apiEvent.Resource = &restv1.WatchResourcesResponse_Event_OsUpdateRun{OsUpdateRun: osUpdateRun}
+	default:
+		return nil, errors.Errorfc(codes.Internal, "unsupported resource kind %T", res.GetResource())
+	}
+	return apiEvent, nil
+}
+
+// matchesWatchFilter returns whether the created or updated resource of the event matches the filter, by looking it
+// up in Inventory restricted to the filter. For the principals holding the scoped role, the resource is always looked
+// up so that Inventory applies their scope; as deleted resources cannot be looked up, their location is instead.
+func (is *InventorygRPCServer) matchesWatchFilter(
+	ctx context.Context, event *inventory.SubscribeEventsResponse, filter string,
+) (bool, error) {
+	_, scoped := scopedJWTFromContext(ctx)
+	if event.GetEventKind() == inventory.SubscribeEventsResponse_EVENT_KIND_DELETED {
+		if !scoped {
+			return true, nil
+		}
+		return is.deletedInScope(ctx, event.GetResource())
+	}
+	if filter == "" && !scoped {
+		return true, nil
+	}
+	query := fmt.Sprintf("resource_id = %q", event.GetResourceId())
+	if filter != "" {
+		query = fmt.Sprintf("(%s) AND %s", filter, query)
+	}
+	return is.existsInInventory(ctx, util.GetResourceKindFromResource(event.GetResource()), query)
+}
+
+// deletedInScope returns whether the location of the deleted host or instance, its site or host, is in the scope of
+// the context.
+func (is *InventorygRPCServer) deletedInScope(ctx context.Context, res *inventory.Resource) (bool, error) {
+	var kind inventory.ResourceKind
+	var locationID string
+	switch util.GetResourceKindFromResource(res) {
+	case inventory.ResourceKind_RESOURCE_KIND_HOST:
+		kind, locationID = inventory.ResourceKind_RESOURCE_KIND_SITE, res.GetHost().GetSite().GetResourceId()
+	case inventory.ResourceKind_RESOURCE_KIND_INSTANCE:
+		kind, locationID = inventory.ResourceKind_RESOURCE_KIND_HOST, res.GetInstance().GetHost().GetResourceId()
+	}
+	if locationID == "" {
+		return false, nil
+	}
+	return is.existsInInventory(ctx, kind, fmt.Sprintf("resource_id = %q", locationID))
+}
+
+// existsInInventory returns whether a resource of the kind matches the filter.
+func (is *InventorygRPCServer) existsInInventory(
+	ctx context.Context, kind inventory.ResourceKind, filter string,
+) (bool, error) {
+	resource, err := util.GetResourceFromKind(kind)
+	if err != nil {
+		return false, err
+	}
+	resp, err := is.InvClient.Find(ctx, &inventory.ResourceFilter{
+		Resource: resource,
+		Filter:   filter,
+	})
+	if err != nil {
+		return false, err
+	}
+	return len(resp.GetResources()) > 0, nil
+}
+
+// Watch the changes of the resources of the project.
+func (is *InventorygRPCServer) WatchResources(
+	req *restv1.WatchResourcesRequest,
+	stream restv1.WatchService_WatchResourcesServer,
+) error {
+	zlog.Debug().Msg("WatchResources")
+	ctx := stream.Context()
+
+	if is.WatchHub == nil {
+		return errors.Errorfc(codes.Unavailable, "watching resources is not enabled")
+	}
+	tenantID, ok := tenant.GetTenantIDFromContext(ctx)
+	if !ok {
+		err := errors.Errorfc(codes.Unauthenticated, "tenantID not found in context")
+		zlog.InfraSec().InfraErr(err).Msg("failed to watch resources")
+		return err
+	}
+
+	kinds := make([]inventory.ResourceKind, 0, len(req.GetKinds()))
+	for _, kind := range req.GetKinds() {
+		kinds = append(kinds, watchResourceKinds[kind])
+	}
+	_, scoped := scopedJWTFromContext(ctx)
+	if len(kinds) == 0 {
+		kinds = WatchedResourceKinds()
+		if scoped {
+			kinds = scopedWatchResourceKinds
+		}
+	}
+	for _, kind := range kinds {
+		if scoped && !slices.Contains(scopedWatchResourceKinds, kind) {
+			err := errors.Errorfc(codes.PermissionDenied, "%s cannot be watched with the scoped role", kind)
+			zlog.InfraSec().InfraErr(err).Msg("failed to validate watch request")
+			return err
+		}
+	}
+	if req.GetFilter() != "" && len(kinds) != 1 {
+		err := errors.Errorfc(codes.InvalidArgument, "filter requires exactly one kind to be watched")
+		zlog.InfraErr(err).Msg("failed to validate watch request")
+		return err
+	}
+	if req.GetFilter() != "" {
+		// Validates the filter upfront, rather than on the first event.
+		resource, err := util.GetResourceFromKind(kinds[0])
+		if err != nil {
+			return errors.Wrap(err)
+		}
+		if _, err := is.InvClient.Find(ctx, &inventory.ResourceFilter{
+			Resource: resource,
+			Filter:   req.GetFilter(),
+			Limit:    1,
+		}); err != nil {
+			zlog.InfraErr(err).Msgf("invalid watch filter %s", req.GetFilter())
+			return errors.Wrap(err)
+		}
+	}
+	heartbeat := defaultWatchHeartbeat
+	if req.GetHeartbeatSeconds() != 0 {
+		heartbeat = time.Duration(req.GetHeartbeatSeconds()) * time.Second
+	}
+
+	w, err := is.WatchHub.subscribe(tenantID, kinds)
+	if err != nil {
+		return err
+	}
+	defer is.WatchHub.unsubscribe(w)
+
+	sendHeartbeat := func() error {
+		return stream.Send(&restv1.WatchResourcesResponse{
+			Payload: &restv1.WatchResourcesResponse_Heartbeat{Heartbeat: timestamppb.Now()},
+		})
+	}
+	// The first heartbeat tells the client that it will receive all the changes from now on.
+	if err := sendHeartbeat(); err != nil {
+		return errors.Wrap(err)
+	}
+
+	ticker := time.NewTicker(heartbeat)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			zlog.Debug().Msgf("watch of tenant %s closed", tenantID)
+			return nil
+		case <-w.overflow:
+			err := errors.Errorfc(codes.Aborted, "watch fell behind and missed events, resources must be listed again")
+			zlog.InfraErr(err).Msgf("terminating watch of tenant %s", tenantID)
+			return err
+		case <-ticker.C:
+			if err := sendHeartbeat(); err != nil {
+				return errors.Wrap(err)
+			}
+		case event := <-w.events:
+			matches, err := is.matchesWatchFilter(ctx, event, req.GetFilter())
+			if err != nil {
+				zlog.InfraErr(err).Msgf("failed to filter event of resource %s", event.GetResourceId())
+				return errors.Wrap(err)
+			}
+			if !matches {
+				continue
+			}
+			apiEvent, err := fromInvWatchEvent(event)
+			if err != nil {
+				zlog.InfraErr(err).Msgf("failed to convert event of resource %s", event.GetResourceId())
+				return errors.Wrap(err)
+			}
+			if err := stream.Send(&restv1.WatchResourcesResponse{
+				Payload: &restv1.WatchResourcesResponse_Event_{Event: apiEvent},
+			}); err != nil {
+				return errors.Wrap(err)
+			}
+			ticker.Reset(heartbeat)
+		}
+	}
+}
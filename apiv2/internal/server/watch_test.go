@@ -0,0 +1,306 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package server_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	restv1 "github.com/open-edge-platform/infra-core/apiv2/v2/internal/pbapi/services/v1"
+	inv_server "github.com/open-edge-platform/infra-core/apiv2/v2/internal/server"
+	inv_computev1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/compute/v1"
+	inventory "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/inventory/v1"
+	inv_locationv1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/location/v1"
+	inv_schedulev1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/schedule/v1"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/client"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/tenant"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/util"
+)
+
+const (
+	watchTenantID      = "11111111-1111-1111-1111-111111111111"
+	otherWatchTenantID = "22222222-2222-2222-2222-222222222222"
+	watchProject       = "project"
+)
+
+// watchStream is a server stream collecting the sent messages.
+type watchStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent chan *restv1.WatchResourcesResponse
+}
+
+func (s *watchStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *watchStream) Send(resp *restv1.WatchResourcesResponse) error {
+	s.sent <- resp
+	return nil
+}
+
+func (s *watchStream) next(t *testing.T) *restv1.WatchResourcesResponse {
+	t.Helper()
+	select {
+	case resp := <-s.sent:
+		return resp
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "no message received from the watch")
+		return nil
+	}
+}
+
+func hostEvent(
+	kind inventory.SubscribeEventsResponse_EventKind, resourceID, tenantID string,
+) *client.WatchEvents {
+	return &client.WatchEvents{
+		Ctx: context.Background(),
+		Event: &inventory.SubscribeEventsResponse{
+			EventKind:  kind,
+			ResourceId: resourceID,
+			Resource: &inventory.Resource{Resource: &inventory.Resource_Host{Host: &inv_computev1.HostResource{
+				ResourceId: resourceID,
+				Name:       resourceID,
+				TenantId:   tenantID,
+			}}},
+		},
+	}
+}
+
+// startWatch starts watching with the given request and returns the stream once the watch is established.
+func startWatch(
+	t *testing.T, server *inv_server.InventorygRPCServer, req *restv1.WatchResourcesRequest,
+) (*watchStream, context.CancelFunc, chan error) {
+	t.Helper()
+	return startWatchWith(t, tenant.AddTenantIDToContext(context.Background(), watchTenantID),
+		func(stream *watchStream) error { return server.WatchResources(req, stream) })
+}
+
+// startWatchWith starts watching with watch in the given context, see startWatch.
+func startWatchWith(
+	t *testing.T, ctx context.Context, watch func(stream *watchStream) error,
+) (*watchStream, context.CancelFunc, chan error) {
+	t.Helper()
+	ctx, cancel := context.WithCancel(ctx)
+	stream := &watchStream{ctx: ctx, sent: make(chan *restv1.WatchResourcesResponse, 10)}
+	done := make(chan error, 1)
+	go func() {
+		done <- watch(stream)
+	}()
+	select {
+	case resp := <-stream.sent:
+		require.NotNil(t, resp.GetHeartbeat(), "the watch must start with a heartbeat")
+	case err := <-done:
+		cancel()
+		return nil, nil, chanWithErr(err)
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "watch not established")
+	}
+	return stream, cancel, done
+}
+
+func chanWithErr(err error) chan error {
+	errCh := make(chan error, 1)
+	errCh <- err
+	return errCh
+}
+
+func TestWatch_Resources(t *testing.T) {
+	mockedClient := newMockedInventoryTestClient()
+	hub := inv_server.NewWatchHub(1)
+	server := &inv_server.InventorygRPCServer{InvClient: mockedClient, WatchHub: hub}
+	events := make(chan *client.WatchEvents)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go hub.Run(ctx, events)
+
+	stream, stop, done := startWatch(t, server, &restv1.WatchResourcesRequest{
+		ProjectName: watchProject,
+		Kinds:       []restv1.WatchResourceKind{restv1.WatchResourceKind_WATCH_RESOURCE_KIND_HOST},
+	})
+	require.NotNil(t, stream)
+
+	// Only one watch is allowed per project.
+	_, _, rejected := startWatch(t, server, &restv1.WatchResourcesRequest{ProjectName: watchProject})
+	assert.Equal(t, codes.ResourceExhausted, status.Code(<-rejected))
+
+	// Events of other projects and of unwatched kinds are not relayed.
+	events <- hostEvent(inventory.SubscribeEventsResponse_EVENT_KIND_CREATED, "host-00000001", otherWatchTenantID)
+	events <- &client.WatchEvents{Event: &inventory.SubscribeEventsResponse{
+		EventKind:  inventory.SubscribeEventsResponse_EVENT_KIND_CREATED,
+		ResourceId: "singlesche-00000001",
+		Resource: &inventory.Resource{Resource: &inventory.Resource_Singleschedule{
+			Singleschedule: &inv_schedulev1.SingleScheduleResource{TenantId: watchTenantID},
+		}},
+	}}
+	events <- hostEvent(inventory.SubscribeEventsResponse_EVENT_KIND_UPDATED, "host-00000002", watchTenantID)
+
+	resp := stream.next(t)
+	require.NotNil(t, resp.GetEvent())
+	assert.Equal(t, restv1.WatchResourcesResponse_EVENT_KIND_UPDATED, resp.GetEvent().GetKind())
+	assert.Equal(t, restv1.WatchResourceKind_WATCH_RESOURCE_KIND_HOST, resp.GetEvent().GetResourceKind())
+	assert.Equal(t, "host-00000002", resp.GetEvent().GetResourceId())
+	assert.Equal(t, "host-00000002", resp.GetEvent().GetHost().GetName())
+
+	stop()
+	require.NoError(t, <-done)
+}
+
+func TestWatch_Filter(t *testing.T) {
+	mockedClient := newMockedInventoryTestClient()
+	hub := inv_server.NewWatchHub(1)
+	server := &inv_server.InventorygRPCServer{InvClient: mockedClient, WatchHub: hub}
+	events := make(chan *client.WatchEvents)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go hub.Run(ctx, events)
+
+	filter := `name = "match"`
+	// Validation of the filter.
+	mockedClient.On("Find", mock.Anything, mock.MatchedBy(func(f *inventory.ResourceFilter) bool {
+		return f.GetFilter() == filter
+	})).Return(&inventory.FindResourcesResponse{}, nil).Once()
+	mockedClient.On("Find", mock.Anything, mock.MatchedBy(func(f *inventory.ResourceFilter) bool {
+		return f.GetFilter() == `(name = "match") AND resource_id = "host-00000001"`
+	})).Return(&inventory.FindResourcesResponse{}, nil).Once()
+	mockedClient.On("Find", mock.Anything, mock.MatchedBy(func(f *inventory.ResourceFilter) bool {
+		return f.GetFilter() == `(name = "match") AND resource_id = "host-00000002"`
+	})).Return(&inventory.FindResourcesResponse{
+		Resources: []*inventory.FindResourcesResponse_ResourceTenantIDCarrier{{ResourceId: "host-00000002"}},
+	}, nil).Once()
+
+	stream, stop, done := startWatch(t, server, &restv1.WatchResourcesRequest{
+		ProjectName: watchProject,
+		Kinds:       []restv1.WatchResourceKind{restv1.WatchResourceKind_WATCH_RESOURCE_KIND_HOST},
+		Filter:      filter,
+	})
+	require.NotNil(t, stream)
+
+	events <- hostEvent(inventory.SubscribeEventsResponse_EVENT_KIND_UPDATED, "host-00000001", watchTenantID)
+	events <- hostEvent(inventory.SubscribeEventsResponse_EVENT_KIND_UPDATED, "host-00000002", watchTenantID)
+	// Deletions are relayed without filtering.
+	events <- hostEvent(inventory.SubscribeEventsResponse_EVENT_KIND_DELETED, "host-00000003", watchTenantID)
+
+	assert.Equal(t, "host-00000002", stream.next(t).GetEvent().GetResourceId())
+	resp := stream.next(t)
+	assert.Equal(t, "host-00000003", resp.GetEvent().GetResourceId())
+	assert.Equal(t, restv1.WatchResourcesResponse_EVENT_KIND_DELETED, resp.GetEvent().GetKind())
+
+	stop()
+	require.NoError(t, <-done)
+	mockedClient.AssertExpectations(t)
+}
+
+func TestWatch_ScopedRole(t *testing.T) {
+	mockedClient := newMockedInventoryTestClient()
+	hub := inv_server.NewWatchHub(2)
+	server := &inv_server.InventorygRPCServer{InvClient: mockedClient, WatchHub: hub}
+	events := make(chan *client.WatchEvents)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go hub.Run(ctx, events)
+
+	// The principals holding the scoped role are served through the scoped role interceptor.
+	scopedWatch := func(req *restv1.WatchResourcesRequest) func(stream *watchStream) error {
+		info := &grpc.StreamServerInfo{FullMethod: "/services.v1.WatchService/WatchResources"}
+		return func(stream *watchStream) error {
+			return inv_server.ScopedRoleStreamInterceptor()(server, stream, info, func(_ any, ss grpc.ServerStream) error {
+				return server.WatchResources(req, &watchStream{ctx: ss.Context(), sent: stream.sent})
+			})
+		}
+	}
+	scopedCtx, _ := scopedCallContext(t, "im-scoped")
+
+	// Only the located kinds can be watched.
+	_, _, rejected := startWatchWith(t, scopedCtx, scopedWatch(&restv1.WatchResourcesRequest{
+		ProjectName: watchProject,
+		Kinds:       []restv1.WatchResourceKind{restv1.WatchResourceKind_WATCH_RESOURCE_KIND_SINGLE_SCHEDULE},
+	}))
+	assert.Equal(t, codes.PermissionDenied, status.Code(<-rejected))
+
+	// The resources of the events are looked up in Inventory, which only returns the ones in scope.
+	findIDs := func(kind inventory.ResourceKind, filter string, ids ...string) {
+		resp := &inventory.FindResourcesResponse{}
+		for _, id := range ids {
+			resp.Resources = append(resp.Resources,
+				&inventory.FindResourcesResponse_ResourceTenantIDCarrier{ResourceId: id})
+		}
+		mockedClient.On("Find", mock.Anything, mock.MatchedBy(func(f *inventory.ResourceFilter) bool {
+			return util.GetResourceKindFromResource(f.GetResource()) == kind && f.GetFilter() == filter
+		})).Return(resp, nil).Once()
+	}
+	findIDs(inventory.ResourceKind_RESOURCE_KIND_HOST, `resource_id = "host-00000001"`)
+	findIDs(inventory.ResourceKind_RESOURCE_KIND_HOST, `resource_id = "host-00000002"`, "host-00000002")
+	// The deleted hosts are relayed if their site is in scope.
+	findIDs(inventory.ResourceKind_RESOURCE_KIND_SITE, `resource_id = "site-00000001"`)
+	findIDs(inventory.ResourceKind_RESOURCE_KIND_SITE, `resource_id = "site-00000002"`, "site-00000002")
+
+	stream, stop, done := startWatchWith(t, scopedCtx, scopedWatch(&restv1.WatchResourcesRequest{
+		ProjectName: watchProject,
+	}))
+	require.NotNil(t, stream)
+
+	deletedHostEvent := func(resourceID, siteID string) *client.WatchEvents {
+		event := hostEvent(inventory.SubscribeEventsResponse_EVENT_KIND_DELETED, resourceID, watchTenantID)
+		if siteID != "" {
+			event.Event.GetResource().GetHost().Site = &inv_locationv1.SiteResource{ResourceId: siteID}
+		}
+		return event
+	}
+	events <- hostEvent(inventory.SubscribeEventsResponse_EVENT_KIND_UPDATED, "host-00000001", watchTenantID)
+	events <- hostEvent(inventory.SubscribeEventsResponse_EVENT_KIND_UPDATED, "host-00000002", watchTenantID)
+	events <- deletedHostEvent("host-00000003", "site-00000001")
+	events <- deletedHostEvent("host-00000004", "")
+	events <- deletedHostEvent("host-00000005", "site-00000002")
+
+	assert.Equal(t, "host-00000002", stream.next(t).GetEvent().GetResourceId())
+	resp := stream.next(t)
+	assert.Equal(t, "host-00000005", resp.GetEvent().GetResourceId())
+	assert.Equal(t, restv1.WatchResourcesResponse_EVENT_KIND_DELETED, resp.GetEvent().GetKind())
+
+	stop()
+	require.NoError(t, <-done)
+	mockedClient.AssertExpectations(t)
+}
+
+func TestWatch_InvalidRequests(t *testing.T) {
+	server := &inv_server.InventorygRPCServer{
+		InvClient: newMockedInventoryTestClient(),
+		WatchHub:  inv_server.NewWatchHub(1),
+	}
+	cases := []struct {
+		name string
+		ctx  context.Context
+		req  *restv1.WatchResourcesRequest
+		code codes.Code
+	}{
+		{
+			name: "Filter without kind",
+			ctx:  tenant.AddTenantIDToContext(context.Background(), watchTenantID),
+			req:  &restv1.WatchResourcesRequest{ProjectName: watchProject, Filter: `name = "x"`},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "Missing tenant",
+			ctx:  context.Background(),
+			req:  &restv1.WatchResourcesRequest{ProjectName: watchProject},
+			code: codes.Unauthenticated,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			stream := &watchStream{ctx: tc.ctx, sent: make(chan *restv1.WatchResourcesResponse, 1)}
+			err := server.WatchResources(tc.req, stream)
+			assert.Equal(t, tc.code, status.Code(err))
+		})
+	}
+}
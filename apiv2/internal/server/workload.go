@@ -114,7 +114,7 @@ func (is *InventorygRPCServer) CreateWorkload(
 		return nil, errors.Wrap(err)
 	}
 
-	zlog.Debug().Msgf("Created %s", workloadCreated)
+	zlog.Debug().Msgf("Created %s", redact(workloadCreated))
 	return workloadCreated, nil
 }
 
@@ -157,7 +157,7 @@ func (is *InventorygRPCServer) ListWorkloads(
 		TotalElements: invResp.GetTotalElements(),
 		HasNext:       invResp.GetHasNext(),
 	}
-	zlog.Debug().Msgf("Listed %s", resp)
+	zlog.Debug().Msgf("Listed %s", redact(resp))
 	return resp, nil
 }
 
@@ -180,7 +180,7 @@ func (is *InventorygRPCServer) GetWorkload(
 		zlog.InfraErr(err).Msg("Failed to convert from inventory workload")
 		return nil, errors.Wrap(err)
 	}
-	zlog.Debug().Msgf("Got %s", workload)
+	zlog.Debug().Msgf("Got %s", redact(workload))
 	return workload, nil
 }
 
@@ -211,7 +211,7 @@ func (is *InventorygRPCServer) UpdateWorkload(
 	}
 	upRes, err := is.InvClient.Update(ctx, req.GetResourceId(), fieldmask, invRes)
 	if err != nil {
-		zlog.InfraErr(err).Msgf("failed to update inventory resource %s %s", req.GetResourceId(), invRes)
+		zlog.InfraErr(err).Msgf("failed to update inventory resource %s %s", req.GetResourceId(), redact(invRes))
 		return nil, errors.Wrap(err)
 	}
 	invUp := upRes.GetWorkload()
@@ -220,7 +220,7 @@ func (is *InventorygRPCServer) UpdateWorkload(
 		return nil, errors.Wrap(err)
 	}
 
-	zlog.Debug().Msgf("Updated %s", invUpRes)
+	zlog.Debug().Msgf("Updated %s", redact(invUpRes))
 	return invUpRes, nil
 }
 
@@ -249,7 +249,7 @@ func (is *InventorygRPCServer) PatchWorkload(
 	}
 	upRes, err := is.InvClient.Update(ctx, req.GetResourceId(), fieldmask, invRes)
 	if err != nil {
-		zlog.InfraErr(err).Msgf("failed to update inventory resource %s %s", req.GetResourceId(), invRes)
+		zlog.InfraErr(err).Msgf("failed to update inventory resource %s %s", req.GetResourceId(), redact(invRes))
 		return nil, errors.Wrap(err)
 	}
 	invUp := upRes.GetWorkload()
@@ -258,7 +258,7 @@ func (is *InventorygRPCServer) PatchWorkload(
 		return nil, errors.Wrap(err)
 	}
 
-	zlog.Debug().Msgf("Updated %s", invUpRes)
+	zlog.Debug().Msgf("Updated %s", redact(invUpRes))
 	return invUpRes, nil
 }
 
@@ -114,7 +114,7 @@ func (is *InventorygRPCServer) CreateWorkloadMember(
 		return nil, errors.Wrap(err)
 	}
 
-	zlog.Debug().Msgf("Created %s", workloadMemberCreated)
+	zlog.Debug().Msgf("Created %s", redact(workloadMemberCreated))
 	return workloadMemberCreated, nil
 }
 
@@ -159,7 +159,7 @@ func (is *InventorygRPCServer) ListWorkloadMembers(
 		TotalElements:   invResp.GetTotalElements(),
 		HasNext:         invResp.GetHasNext(),
 	}
-	zlog.Debug().Msgf("Listed %s", resp)
+	zlog.Debug().Msgf("Listed %s", redact(resp))
 	return resp, nil
 }
 
@@ -182,7 +182,7 @@ func (is *InventorygRPCServer) GetWorkloadMember(
 		zlog.InfraErr(err).Msg("Failed to convert from inventory workload member")
 		return nil, errors.Wrap(err)
 	}
-	zlog.Debug().Msgf("Got %s", workloadMember)
+	zlog.Debug().Msgf("Got %s", redact(workloadMember))
 	return workloadMember, nil
 }
 
@@ -193,6 +193,65 @@ func (_c *MockInventoryClient_Delete_Call) RunAndReturn(run func(context.Context
 	return _c
 }
 
+// DiffEffectiveTelemetryProfiles provides a mock function with given fields: _a0, _a1
+func (_m *MockInventoryClient) DiffEffectiveTelemetryProfiles(_a0 context.Context, _a1 *inventoryv1.DiffEffectiveTelemetryProfilesRequest) (*inventoryv1.DiffEffectiveTelemetryProfilesResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DiffEffectiveTelemetryProfiles")
+	}
+
+	var r0 *inventoryv1.DiffEffectiveTelemetryProfilesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *inventoryv1.DiffEffectiveTelemetryProfilesRequest) (*inventoryv1.DiffEffectiveTelemetryProfilesResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *inventoryv1.DiffEffectiveTelemetryProfilesRequest) *inventoryv1.DiffEffectiveTelemetryProfilesResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*inventoryv1.DiffEffectiveTelemetryProfilesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *inventoryv1.DiffEffectiveTelemetryProfilesRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockInventoryClient_DiffEffectiveTelemetryProfiles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DiffEffectiveTelemetryProfiles'
+type MockInventoryClient_DiffEffectiveTelemetryProfiles_Call struct {
+	*mock.Call
+}
+
+// DiffEffectiveTelemetryProfiles is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *inventoryv1.DiffEffectiveTelemetryProfilesRequest
+func (_e *MockInventoryClient_Expecter) DiffEffectiveTelemetryProfiles(_a0 interface{}, _a1 interface{}) *MockInventoryClient_DiffEffectiveTelemetryProfiles_Call {
+	return &MockInventoryClient_DiffEffectiveTelemetryProfiles_Call{Call: _e.mock.On("DiffEffectiveTelemetryProfiles", _a0, _a1)}
+}
+
+func (_c *MockInventoryClient_DiffEffectiveTelemetryProfiles_Call) Run(run func(_a0 context.Context, _a1 *inventoryv1.DiffEffectiveTelemetryProfilesRequest)) *MockInventoryClient_DiffEffectiveTelemetryProfiles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*inventoryv1.DiffEffectiveTelemetryProfilesRequest))
+	})
+	return _c
+}
+
+func (_c *MockInventoryClient_DiffEffectiveTelemetryProfiles_Call) Return(_a0 *inventoryv1.DiffEffectiveTelemetryProfilesResponse, _a1 error) *MockInventoryClient_DiffEffectiveTelemetryProfiles_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockInventoryClient_DiffEffectiveTelemetryProfiles_Call) RunAndReturn(run func(context.Context, *inventoryv1.DiffEffectiveTelemetryProfilesRequest) (*inventoryv1.DiffEffectiveTelemetryProfilesResponse, error)) *MockInventoryClient_DiffEffectiveTelemetryProfiles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Find provides a mock function with given fields: _a0, _a1
 func (_m *MockInventoryClient) Find(_a0 context.Context, _a1 *inventoryv1.ResourceFilter) (*inventoryv1.FindResourcesResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -370,6 +429,124 @@ func (_c *MockInventoryClient_Get_Call) RunAndReturn(run func(context.Context, s
 	return _c
 }
 
+// GetEffectiveTelemetryProfiles provides a mock function with given fields: _a0, _a1
+func (_m *MockInventoryClient) GetEffectiveTelemetryProfiles(_a0 context.Context, _a1 *inventoryv1.GetEffectiveTelemetryProfilesRequest) (*inventoryv1.GetEffectiveTelemetryProfilesResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEffectiveTelemetryProfiles")
+	}
+
+	var r0 *inventoryv1.GetEffectiveTelemetryProfilesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *inventoryv1.GetEffectiveTelemetryProfilesRequest) (*inventoryv1.GetEffectiveTelemetryProfilesResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *inventoryv1.GetEffectiveTelemetryProfilesRequest) *inventoryv1.GetEffectiveTelemetryProfilesResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*inventoryv1.GetEffectiveTelemetryProfilesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *inventoryv1.GetEffectiveTelemetryProfilesRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockInventoryClient_GetEffectiveTelemetryProfiles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEffectiveTelemetryProfiles'
+type MockInventoryClient_GetEffectiveTelemetryProfiles_Call struct {
+	*mock.Call
+}
+
+// GetEffectiveTelemetryProfiles is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *inventoryv1.GetEffectiveTelemetryProfilesRequest
+func (_e *MockInventoryClient_Expecter) GetEffectiveTelemetryProfiles(_a0 interface{}, _a1 interface{}) *MockInventoryClient_GetEffectiveTelemetryProfiles_Call {
+	return &MockInventoryClient_GetEffectiveTelemetryProfiles_Call{Call: _e.mock.On("GetEffectiveTelemetryProfiles", _a0, _a1)}
+}
+
+func (_c *MockInventoryClient_GetEffectiveTelemetryProfiles_Call) Run(run func(_a0 context.Context, _a1 *inventoryv1.GetEffectiveTelemetryProfilesRequest)) *MockInventoryClient_GetEffectiveTelemetryProfiles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*inventoryv1.GetEffectiveTelemetryProfilesRequest))
+	})
+	return _c
+}
+
+func (_c *MockInventoryClient_GetEffectiveTelemetryProfiles_Call) Return(_a0 *inventoryv1.GetEffectiveTelemetryProfilesResponse, _a1 error) *MockInventoryClient_GetEffectiveTelemetryProfiles_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockInventoryClient_GetEffectiveTelemetryProfiles_Call) RunAndReturn(run func(context.Context, *inventoryv1.GetEffectiveTelemetryProfilesRequest) (*inventoryv1.GetEffectiveTelemetryProfilesResponse, error)) *MockInventoryClient_GetEffectiveTelemetryProfiles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFleetStatistics provides a mock function with given fields: _a0, _a1
+func (_m *MockInventoryClient) GetFleetStatistics(_a0 context.Context, _a1 *inventoryv1.GetFleetStatisticsRequest) (*inventoryv1.GetFleetStatisticsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFleetStatistics")
+	}
+
+	var r0 *inventoryv1.GetFleetStatisticsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *inventoryv1.GetFleetStatisticsRequest) (*inventoryv1.GetFleetStatisticsResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *inventoryv1.GetFleetStatisticsRequest) *inventoryv1.GetFleetStatisticsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*inventoryv1.GetFleetStatisticsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *inventoryv1.GetFleetStatisticsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockInventoryClient_GetFleetStatistics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFleetStatistics'
+type MockInventoryClient_GetFleetStatistics_Call struct {
+	*mock.Call
+}
+
+// GetFleetStatistics is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *inventoryv1.GetFleetStatisticsRequest
+func (_e *MockInventoryClient_Expecter) GetFleetStatistics(_a0 interface{}, _a1 interface{}) *MockInventoryClient_GetFleetStatistics_Call {
+	return &MockInventoryClient_GetFleetStatistics_Call{Call: _e.mock.On("GetFleetStatistics", _a0, _a1)}
+}
+
+func (_c *MockInventoryClient_GetFleetStatistics_Call) Run(run func(_a0 context.Context, _a1 *inventoryv1.GetFleetStatisticsRequest)) *MockInventoryClient_GetFleetStatistics_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*inventoryv1.GetFleetStatisticsRequest))
+	})
+	return _c
+}
+
+func (_c *MockInventoryClient_GetFleetStatistics_Call) Return(_a0 *inventoryv1.GetFleetStatisticsResponse, _a1 error) *MockInventoryClient_GetFleetStatistics_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockInventoryClient_GetFleetStatistics_Call) RunAndReturn(run func(context.Context, *inventoryv1.GetFleetStatisticsRequest) (*inventoryv1.GetFleetStatisticsResponse, error)) *MockInventoryClient_GetFleetStatistics_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetHostByUUID provides a mock function with given fields: ctx, uuid
 func (_m *MockInventoryClient) GetHostByUUID(ctx context.Context, uuid string) (*computev1.HostResource, error) {
 	ret := _m.Called(ctx, uuid)
@@ -775,6 +952,65 @@ func (_c *MockInventoryClient_ListInheritedTelemetryProfiles_Call) RunAndReturn(
 	return _c
 }
 
+// SearchResources provides a mock function with given fields: _a0, _a1
+func (_m *MockInventoryClient) SearchResources(_a0 context.Context, _a1 *inventoryv1.SearchResourcesRequest) (*inventoryv1.SearchResourcesResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchResources")
+	}
+
+	var r0 *inventoryv1.SearchResourcesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *inventoryv1.SearchResourcesRequest) (*inventoryv1.SearchResourcesResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *inventoryv1.SearchResourcesRequest) *inventoryv1.SearchResourcesResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*inventoryv1.SearchResourcesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *inventoryv1.SearchResourcesRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockInventoryClient_SearchResources_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchResources'
+type MockInventoryClient_SearchResources_Call struct {
+	*mock.Call
+}
+
+// SearchResources is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *inventoryv1.SearchResourcesRequest
+func (_e *MockInventoryClient_Expecter) SearchResources(_a0 interface{}, _a1 interface{}) *MockInventoryClient_SearchResources_Call {
+	return &MockInventoryClient_SearchResources_Call{Call: _e.mock.On("SearchResources", _a0, _a1)}
+}
+
+func (_c *MockInventoryClient_SearchResources_Call) Run(run func(_a0 context.Context, _a1 *inventoryv1.SearchResourcesRequest)) *MockInventoryClient_SearchResources_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*inventoryv1.SearchResourcesRequest))
+	})
+	return _c
+}
+
+func (_c *MockInventoryClient_SearchResources_Call) Return(_a0 *inventoryv1.SearchResourcesResponse, _a1 error) *MockInventoryClient_SearchResources_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockInventoryClient_SearchResources_Call) RunAndReturn(run func(context.Context, *inventoryv1.SearchResourcesRequest) (*inventoryv1.SearchResourcesResponse, error)) *MockInventoryClient_SearchResources_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // TestGetClientCache provides a mock function with no fields
 func (_m *MockInventoryClient) TestGetClientCache() *cache.InventoryCache {
 	ret := _m.Called()
@@ -20,6 +20,15 @@ import (
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// ListAPITokens
+	// (GET /edge-infra.orchestrator.apis/v2/api-tokens)
+	APITokenServiceListAPITokens2(ctx echo.Context, params APITokenServiceListAPITokens2Params) error
+	// CreateAPIToken
+	// (POST /edge-infra.orchestrator.apis/v2/api-tokens)
+	APITokenServiceCreateAPIToken2(ctx echo.Context, params APITokenServiceCreateAPIToken2Params) error
+	// DeleteAPIToken
+	// (DELETE /edge-infra.orchestrator.apis/v2/api-tokens/{resourceId})
+	APITokenServiceDeleteAPIToken2(ctx echo.Context, resourceId string, params APITokenServiceDeleteAPIToken2Params) error
 	// ListCustomConfigs
 	// (GET /edge-infra.orchestrator.apis/v2/customConfigs)
 	CustomConfigServiceListCustomConfigs2(ctx echo.Context, params CustomConfigServiceListCustomConfigs2Params) error
@@ -32,15 +41,30 @@ type ServerInterface interface {
 	// GetCustomConfig
 	// (GET /edge-infra.orchestrator.apis/v2/customConfigs/{resourceId})
 	CustomConfigServiceGetCustomConfig2(ctx echo.Context, resourceId string, params CustomConfigServiceGetCustomConfig2Params) error
+	// PatchCustomConfig
+	// (PATCH /edge-infra.orchestrator.apis/v2/customConfigs/{resourceId})
+	CustomConfigServicePatchCustomConfig2(ctx echo.Context, resourceId string, params CustomConfigServicePatchCustomConfig2Params) error
+	// UpdateCustomConfig
+	// (PUT /edge-infra.orchestrator.apis/v2/customConfigs/{resourceId})
+	CustomConfigServiceUpdateCustomConfig2(ctx echo.Context, resourceId string, params CustomConfigServiceUpdateCustomConfig2Params) error
 	// ListHosts
 	// (GET /edge-infra.orchestrator.apis/v2/hosts)
 	HostServiceListHosts2(ctx echo.Context, params HostServiceListHosts2Params) error
 	// CreateHost
 	// (POST /edge-infra.orchestrator.apis/v2/hosts)
 	HostServiceCreateHost2(ctx echo.Context, params HostServiceCreateHost2Params) error
+	// BulkHostAction
+	// (POST /edge-infra.orchestrator.apis/v2/hosts/bulk_action)
+	HostServiceBulkHostAction2(ctx echo.Context) error
+	// InvalidateHosts
+	// (POST /edge-infra.orchestrator.apis/v2/hosts/invalidate_bulk)
+	HostServiceInvalidateHosts2(ctx echo.Context) error
 	// RegisterHost
 	// (POST /edge-infra.orchestrator.apis/v2/hosts/register)
 	HostServiceRegisterHost2(ctx echo.Context, params HostServiceRegisterHost2Params) error
+	// RegisterHosts
+	// (POST /edge-infra.orchestrator.apis/v2/hosts/register_bulk)
+	HostServiceRegisterHosts2(ctx echo.Context) error
 	// DeleteHost
 	// (DELETE /edge-infra.orchestrator.apis/v2/hosts/{resourceId})
 	HostServiceDeleteHost2(ctx echo.Context, resourceId string, params HostServiceDeleteHost2Params) error
@@ -71,6 +95,9 @@ type ServerInterface interface {
 	// CreateInstance
 	// (POST /edge-infra.orchestrator.apis/v2/instances)
 	InstanceServiceCreateInstance2(ctx echo.Context, params InstanceServiceCreateInstance2Params) error
+	// BulkInstanceAction
+	// (POST /edge-infra.orchestrator.apis/v2/instances/bulk_action)
+	InstanceServiceBulkInstanceAction2(ctx echo.Context) error
 	// DeleteInstance
 	// (DELETE /edge-infra.orchestrator.apis/v2/instances/{resourceId})
 	InstanceServiceDeleteInstance2(ctx echo.Context, resourceId string, params InstanceServiceDeleteInstance2Params) error
@@ -98,9 +125,57 @@ type ServerInterface interface {
 	// GetLocalAccount
 	// (GET /edge-infra.orchestrator.apis/v2/localAccounts/{resourceId})
 	LocalAccountServiceGetLocalAccount2(ctx echo.Context, resourceId string, params LocalAccountServiceGetLocalAccount2Params) error
+	// PatchLocalAccount
+	// (PATCH /edge-infra.orchestrator.apis/v2/localAccounts/{resourceId})
+	LocalAccountServicePatchLocalAccount2(ctx echo.Context, resourceId string, params LocalAccountServicePatchLocalAccount2Params) error
+	// UpdateLocalAccount
+	// (PUT /edge-infra.orchestrator.apis/v2/localAccounts/{resourceId})
+	LocalAccountServiceUpdateLocalAccount2(ctx echo.Context, resourceId string, params LocalAccountServiceUpdateLocalAccount2Params) error
 	// ListLocations
 	// (GET /edge-infra.orchestrator.apis/v2/locations)
 	LocationServiceListLocations2(ctx echo.Context, params LocationServiceListLocations2Params) error
+	// ListEndpoints
+	// (GET /edge-infra.orchestrator.apis/v2/network/endpoints)
+	EndpointServiceListEndpoints2(ctx echo.Context, params EndpointServiceListEndpoints2Params) error
+	// GetEndpoint
+	// (GET /edge-infra.orchestrator.apis/v2/network/endpoints/{resourceId})
+	EndpointServiceGetEndpoint2(ctx echo.Context, resourceId string, params EndpointServiceGetEndpoint2Params) error
+	// ListIPAddresses
+	// (GET /edge-infra.orchestrator.apis/v2/network/ipaddresses)
+	IPAddressServiceListIPAddresses2(ctx echo.Context, params IPAddressServiceListIPAddresses2Params) error
+	// GetIPAddress
+	// (GET /edge-infra.orchestrator.apis/v2/network/ipaddresses/{resourceId})
+	IPAddressServiceGetIPAddress2(ctx echo.Context, resourceId string, params IPAddressServiceGetIPAddress2Params) error
+	// ListNetlinks
+	// (GET /edge-infra.orchestrator.apis/v2/network/netlinks)
+	NetlinkServiceListNetlinks2(ctx echo.Context, params NetlinkServiceListNetlinks2Params) error
+	// GetNetlink
+	// (GET /edge-infra.orchestrator.apis/v2/network/netlinks/{resourceId})
+	NetlinkServiceGetNetlink2(ctx echo.Context, resourceId string, params NetlinkServiceGetNetlink2Params) error
+	// ListHostnics
+	// (GET /edge-infra.orchestrator.apis/v2/network/nics)
+	HostnicServiceListHostnics2(ctx echo.Context, params HostnicServiceListHostnics2Params) error
+	// GetHostnic
+	// (GET /edge-infra.orchestrator.apis/v2/network/nics/{resourceId})
+	HostnicServiceGetHostnic2(ctx echo.Context, resourceId string, params HostnicServiceGetHostnic2Params) error
+	// ListNetworkSegments
+	// (GET /edge-infra.orchestrator.apis/v2/network/segments)
+	NetworkSegmentServiceListNetworkSegments2(ctx echo.Context, params NetworkSegmentServiceListNetworkSegments2Params) error
+	// CreateNetworkSegment
+	// (POST /edge-infra.orchestrator.apis/v2/network/segments)
+	NetworkSegmentServiceCreateNetworkSegment2(ctx echo.Context, params NetworkSegmentServiceCreateNetworkSegment2Params) error
+	// DeleteNetworkSegment
+	// (DELETE /edge-infra.orchestrator.apis/v2/network/segments/{resourceId})
+	NetworkSegmentServiceDeleteNetworkSegment2(ctx echo.Context, resourceId string, params NetworkSegmentServiceDeleteNetworkSegment2Params) error
+	// GetNetworkSegment
+	// (GET /edge-infra.orchestrator.apis/v2/network/segments/{resourceId})
+	NetworkSegmentServiceGetNetworkSegment2(ctx echo.Context, resourceId string, params NetworkSegmentServiceGetNetworkSegment2Params) error
+	// PatchNetworkSegment
+	// (PATCH /edge-infra.orchestrator.apis/v2/network/segments/{resourceId})
+	NetworkSegmentServicePatchNetworkSegment2(ctx echo.Context, resourceId string, params NetworkSegmentServicePatchNetworkSegment2Params) error
+	// UpdateNetworkSegment
+	// (PUT /edge-infra.orchestrator.apis/v2/network/segments/{resourceId})
+	NetworkSegmentServiceUpdateNetworkSegment2(ctx echo.Context, resourceId string, params NetworkSegmentServiceUpdateNetworkSegment2Params) error
 	// ListOperatingSystems
 	// (GET /edge-infra.orchestrator.apis/v2/operating_systems)
 	OperatingSystemServiceListOperatingSystems2(ctx echo.Context, params OperatingSystemServiceListOperatingSystems2Params) error
@@ -119,6 +194,15 @@ type ServerInterface interface {
 	// UpdateOperatingSystem
 	// (PUT /edge-infra.orchestrator.apis/v2/operating_systems/{resourceId})
 	OperatingSystemServiceUpdateOperatingSystem2(ctx echo.Context, resourceId string, params OperatingSystemServiceUpdateOperatingSystem2Params) error
+	// ListOperations
+	// (GET /edge-infra.orchestrator.apis/v2/operations)
+	OperationServiceListOperations2(ctx echo.Context, params OperationServiceListOperations2Params) error
+	// GetOperation
+	// (GET /edge-infra.orchestrator.apis/v2/operations/{resourceId})
+	OperationServiceGetOperation2(ctx echo.Context, resourceId string, params OperationServiceGetOperation2Params) error
+	// CancelOperation
+	// (POST /edge-infra.orchestrator.apis/v2/operations/{resourceId}/cancel)
+	OperationServiceCancelOperation2(ctx echo.Context, resourceId string, params OperationServiceCancelOperation2Params) error
 	// ListOSUpdatePolicy
 	// (GET /edge-infra.orchestrator.apis/v2/os_update_policy)
 	OSUpdatePolicyListOSUpdatePolicy2(ctx echo.Context, params OSUpdatePolicyListOSUpdatePolicy2Params) error
@@ -131,6 +215,12 @@ type ServerInterface interface {
 	// GetOSUpdatePolicy
 	// (GET /edge-infra.orchestrator.apis/v2/os_update_policy/{resourceId})
 	OSUpdatePolicyGetOSUpdatePolicy2(ctx echo.Context, resourceId string, params OSUpdatePolicyGetOSUpdatePolicy2Params) error
+	// PatchOSUpdatePolicy
+	// (PATCH /edge-infra.orchestrator.apis/v2/os_update_policy/{resourceId})
+	OSUpdatePolicyPatchOSUpdatePolicy2(ctx echo.Context, resourceId string, params OSUpdatePolicyPatchOSUpdatePolicy2Params) error
+	// UpdateOSUpdatePolicy
+	// (PUT /edge-infra.orchestrator.apis/v2/os_update_policy/{resourceId})
+	OSUpdatePolicyUpdateOSUpdatePolicy2(ctx echo.Context, resourceId string, params OSUpdatePolicyUpdateOSUpdatePolicy2Params) error
 	// ListOSUpdateRun
 	// (GET /edge-infra.orchestrator.apis/v2/os_update_run)
 	OSUpdateRunListOSUpdateRun2(ctx echo.Context, params OSUpdateRunListOSUpdateRun2Params) error
@@ -140,6 +230,24 @@ type ServerInterface interface {
 	// GetOSUpdateRun
 	// (GET /edge-infra.orchestrator.apis/v2/os_update_run/{resourceId})
 	OSUpdateRunGetOSUpdateRun2(ctx echo.Context, resourceId string, params OSUpdateRunGetOSUpdateRun2Params) error
+	// ListOus
+	// (GET /edge-infra.orchestrator.apis/v2/ous)
+	OuServiceListOus2(ctx echo.Context, params OuServiceListOus2Params) error
+	// CreateOu
+	// (POST /edge-infra.orchestrator.apis/v2/ous)
+	OuServiceCreateOu2(ctx echo.Context, params OuServiceCreateOu2Params) error
+	// DeleteOu
+	// (DELETE /edge-infra.orchestrator.apis/v2/ous/{resourceId})
+	OuServiceDeleteOu2(ctx echo.Context, resourceId string, params OuServiceDeleteOu2Params) error
+	// GetOu
+	// (GET /edge-infra.orchestrator.apis/v2/ous/{resourceId})
+	OuServiceGetOu2(ctx echo.Context, resourceId string, params OuServiceGetOu2Params) error
+	// PatchOu
+	// (PATCH /edge-infra.orchestrator.apis/v2/ous/{resourceId})
+	OuServicePatchOu2(ctx echo.Context, resourceId string, params OuServicePatchOu2Params) error
+	// UpdateOu
+	// (PUT /edge-infra.orchestrator.apis/v2/ous/{resourceId})
+	OuServiceUpdateOu2(ctx echo.Context, resourceId string, params OuServiceUpdateOu2Params) error
 	// ListProviders
 	// (GET /edge-infra.orchestrator.apis/v2/providers)
 	ProviderServiceListProviders2(ctx echo.Context, params ProviderServiceListProviders2Params) error
@@ -152,6 +260,12 @@ type ServerInterface interface {
 	// GetProvider
 	// (GET /edge-infra.orchestrator.apis/v2/providers/{resourceId})
 	ProviderServiceGetProvider2(ctx echo.Context, resourceId string, params ProviderServiceGetProvider2Params) error
+	// PatchProvider
+	// (PATCH /edge-infra.orchestrator.apis/v2/providers/{resourceId})
+	ProviderServicePatchProvider2(ctx echo.Context, resourceId string, params ProviderServicePatchProvider2Params) error
+	// UpdateProvider
+	// (PUT /edge-infra.orchestrator.apis/v2/providers/{resourceId})
+	ProviderServiceUpdateProvider2(ctx echo.Context, resourceId string, params ProviderServiceUpdateProvider2Params) error
 	// ListRegions
 	// (GET /edge-infra.orchestrator.apis/v2/regions)
 	RegionServiceListRegions2(ctx echo.Context, params RegionServiceListRegions2Params) error
@@ -170,6 +284,33 @@ type ServerInterface interface {
 	// UpdateRegion
 	// (PUT /edge-infra.orchestrator.apis/v2/regions/{resourceId})
 	RegionServiceUpdateRegion2(ctx echo.Context, resourceId string, params RegionServiceUpdateRegion2Params) error
+	// ListRemoteAccesses
+	// (GET /edge-infra.orchestrator.apis/v2/remote-access/sessions)
+	RemoteAccessServiceListRemoteAccesses2(ctx echo.Context, params RemoteAccessServiceListRemoteAccesses2Params) error
+	// CreateRemoteAccess
+	// (POST /edge-infra.orchestrator.apis/v2/remote-access/sessions)
+	RemoteAccessServiceCreateRemoteAccess2(ctx echo.Context, params RemoteAccessServiceCreateRemoteAccess2Params) error
+	// DeleteRemoteAccess
+	// (DELETE /edge-infra.orchestrator.apis/v2/remote-access/sessions/{resourceId})
+	RemoteAccessServiceDeleteRemoteAccess2(ctx echo.Context, resourceId string, params RemoteAccessServiceDeleteRemoteAccess2Params) error
+	// GetRemoteAccess
+	// (GET /edge-infra.orchestrator.apis/v2/remote-access/sessions/{resourceId})
+	RemoteAccessServiceGetRemoteAccess2(ctx echo.Context, resourceId string, params RemoteAccessServiceGetRemoteAccess2Params) error
+	// ListRoleBindings
+	// (GET /edge-infra.orchestrator.apis/v2/role-bindings)
+	RoleBindingServiceListRoleBindings2(ctx echo.Context, params RoleBindingServiceListRoleBindings2Params) error
+	// CreateRoleBinding
+	// (POST /edge-infra.orchestrator.apis/v2/role-bindings)
+	RoleBindingServiceCreateRoleBinding2(ctx echo.Context, params RoleBindingServiceCreateRoleBinding2Params) error
+	// DeleteRoleBinding
+	// (DELETE /edge-infra.orchestrator.apis/v2/role-bindings/{resourceId})
+	RoleBindingServiceDeleteRoleBinding2(ctx echo.Context, resourceId string, params RoleBindingServiceDeleteRoleBinding2Params) error
+	// GetRoleBinding
+	// (GET /edge-infra.orchestrator.apis/v2/role-bindings/{resourceId})
+	RoleBindingServiceGetRoleBinding2(ctx echo.Context, resourceId string, params RoleBindingServiceGetRoleBinding2Params) error
+	// UpdateRoleBinding
+	// (PUT /edge-infra.orchestrator.apis/v2/role-bindings/{resourceId})
+	RoleBindingServiceUpdateRoleBinding2(ctx echo.Context, resourceId string, params RoleBindingServiceUpdateRoleBinding2Params) error
 	// ListSchedules
 	// (GET /edge-infra.orchestrator.apis/v2/schedules)
 	ScheduleServiceListSchedules2(ctx echo.Context, params ScheduleServiceListSchedules2Params) error
@@ -287,6 +428,9 @@ type ServerInterface interface {
 	// UpdateTelemetryMetricsProfile
 	// (PUT /edge-infra.orchestrator.apis/v2/telemetry/profiles/metrics/{resourceId})
 	TelemetryMetricsProfileServiceUpdateTelemetryMetricsProfile2(ctx echo.Context, resourceId string, params TelemetryMetricsProfileServiceUpdateTelemetryMetricsProfile2Params) error
+	// WatchResources
+	// (GET /edge-infra.orchestrator.apis/v2/watch)
+	WatchServiceWatchResources2(ctx echo.Context, params WatchServiceWatchResources2Params) error
 	// ListWorkloadMembers
 	// (GET /edge-infra.orchestrator.apis/v2/workload_members)
 	WorkloadMemberServiceListWorkloadMembers2(ctx echo.Context, params WorkloadMemberServiceListWorkloadMembers2Params) error
@@ -317,15 +461,33 @@ type ServerInterface interface {
 	// UpdateWorkload
 	// (PUT /edge-infra.orchestrator.apis/v2/workloads/{resourceId})
 	WorkloadServiceUpdateWorkload2(ctx echo.Context, resourceId string, params WorkloadServiceUpdateWorkload2Params) error
+	// ListAPITokens
+	// (GET /v1/projects/{projectName}/api-tokens)
+	APITokenServiceListAPITokens(ctx echo.Context, projectName string, params APITokenServiceListAPITokensParams) error
+	// CreateAPIToken
+	// (POST /v1/projects/{projectName}/api-tokens)
+	APITokenServiceCreateAPIToken(ctx echo.Context, projectName string) error
+	// DeleteAPIToken
+	// (DELETE /v1/projects/{projectName}/api-tokens/{resourceId})
+	APITokenServiceDeleteAPIToken(ctx echo.Context, projectName string, resourceId string) error
 	// ListHosts
 	// (GET /v1/projects/{projectName}/compute/hosts)
 	HostServiceListHosts(ctx echo.Context, projectName string, params HostServiceListHostsParams) error
 	// CreateHost
 	// (POST /v1/projects/{projectName}/compute/hosts)
 	HostServiceCreateHost(ctx echo.Context, projectName string) error
+	// BulkHostAction
+	// (POST /v1/projects/{projectName}/compute/hosts/bulk_action)
+	HostServiceBulkHostAction(ctx echo.Context, projectName string) error
+	// InvalidateHosts
+	// (POST /v1/projects/{projectName}/compute/hosts/invalidate_bulk)
+	HostServiceInvalidateHosts(ctx echo.Context, projectName string) error
 	// RegisterHost
 	// (POST /v1/projects/{projectName}/compute/hosts/register)
 	HostServiceRegisterHost(ctx echo.Context, projectName string, params HostServiceRegisterHostParams) error
+	// RegisterHosts
+	// (POST /v1/projects/{projectName}/compute/hosts/register_bulk)
+	HostServiceRegisterHosts(ctx echo.Context, projectName string) error
 	// GetHostsSummary
 	// (GET /v1/projects/{projectName}/compute/hosts/summary)
 	HostServiceGetHostsSummary(ctx echo.Context, projectName string, params HostServiceGetHostsSummaryParams) error
@@ -334,7 +496,7 @@ type ServerInterface interface {
 	HostServiceDeleteHost(ctx echo.Context, projectName string, resourceId string) error
 	// GetHost
 	// (GET /v1/projects/{projectName}/compute/hosts/{resourceId})
-	HostServiceGetHost(ctx echo.Context, projectName string, resourceId string) error
+	HostServiceGetHost(ctx echo.Context, projectName string, resourceId string, params HostServiceGetHostParams) error
 	// PatchHost
 	// (PATCH /v1/projects/{projectName}/compute/hosts/{resourceId})
 	HostServicePatchHost(ctx echo.Context, projectName string, resourceId string, params HostServicePatchHostParams) error
@@ -350,6 +512,9 @@ type ServerInterface interface {
 	// PatchRegisterHost
 	// (PATCH /v1/projects/{projectName}/compute/hosts/{resourceId}/register)
 	HostServicePatchRegisterHost(ctx echo.Context, projectName string, resourceId string) error
+	// GetHostsStatistics
+	// (GET /v1/projects/{projectName}/compute/hosts_statistics)
+	HostServiceGetHostsStatistics(ctx echo.Context, projectName string, params HostServiceGetHostsStatisticsParams) error
 	// GetHostsSummary
 	// (GET /v1/projects/{projectName}/compute/hosts_summary)
 	HostServiceGetHostsSummary3(ctx echo.Context, projectName string, params HostServiceGetHostsSummary3Params) error
@@ -359,12 +524,15 @@ type ServerInterface interface {
 	// CreateInstance
 	// (POST /v1/projects/{projectName}/compute/instances)
 	InstanceServiceCreateInstance(ctx echo.Context, projectName string) error
+	// BulkInstanceAction
+	// (POST /v1/projects/{projectName}/compute/instances/bulk_action)
+	InstanceServiceBulkInstanceAction(ctx echo.Context, projectName string) error
 	// DeleteInstance
 	// (DELETE /v1/projects/{projectName}/compute/instances/{resourceId})
 	InstanceServiceDeleteInstance(ctx echo.Context, projectName string, resourceId string) error
 	// GetInstance
 	// (GET /v1/projects/{projectName}/compute/instances/{resourceId})
-	InstanceServiceGetInstance(ctx echo.Context, projectName string, resourceId string) error
+	InstanceServiceGetInstance(ctx echo.Context, projectName string, resourceId string, params InstanceServiceGetInstanceParams) error
 	// PatchInstance
 	// (PATCH /v1/projects/{projectName}/compute/instances/{resourceId})
 	InstanceServicePatchInstance(ctx echo.Context, projectName string, resourceId string, params InstanceServicePatchInstanceParams) error
@@ -385,7 +553,7 @@ type ServerInterface interface {
 	OperatingSystemServiceDeleteOperatingSystem3(ctx echo.Context, projectName string, resourceId string) error
 	// GetOperatingSystem
 	// (GET /v1/projects/{projectName}/compute/operating_systems/{resourceId})
-	OperatingSystemServiceGetOperatingSystem3(ctx echo.Context, projectName string, resourceId string) error
+	OperatingSystemServiceGetOperatingSystem3(ctx echo.Context, projectName string, resourceId string, params OperatingSystemServiceGetOperatingSystem3Params) error
 	// PatchOperatingSystem
 	// (PATCH /v1/projects/{projectName}/compute/operating_systems/{resourceId})
 	OperatingSystemServicePatchOperatingSystem3(ctx echo.Context, projectName string, resourceId string, params OperatingSystemServicePatchOperatingSystem3Params) error
@@ -403,7 +571,7 @@ type ServerInterface interface {
 	OperatingSystemServiceDeleteOperatingSystem(ctx echo.Context, projectName string, resourceId string) error
 	// GetOperatingSystem
 	// (GET /v1/projects/{projectName}/compute/os/{resourceId})
-	OperatingSystemServiceGetOperatingSystem(ctx echo.Context, projectName string, resourceId string) error
+	OperatingSystemServiceGetOperatingSystem(ctx echo.Context, projectName string, resourceId string, params OperatingSystemServiceGetOperatingSystemParams) error
 	// PatchOperatingSystem
 	// (PATCH /v1/projects/{projectName}/compute/os/{resourceId})
 	OperatingSystemServicePatchOperatingSystem(ctx echo.Context, projectName string, resourceId string, params OperatingSystemServicePatchOperatingSystemParams) error
@@ -479,6 +647,12 @@ type ServerInterface interface {
 	// GetCustomConfig
 	// (GET /v1/projects/{projectName}/customConfigs/{resourceId})
 	CustomConfigServiceGetCustomConfig(ctx echo.Context, projectName string, resourceId string) error
+	// PatchCustomConfig
+	// (PATCH /v1/projects/{projectName}/customConfigs/{resourceId})
+	CustomConfigServicePatchCustomConfig(ctx echo.Context, projectName string, resourceId string, params CustomConfigServicePatchCustomConfigParams) error
+	// UpdateCustomConfig
+	// (PUT /v1/projects/{projectName}/customConfigs/{resourceId})
+	CustomConfigServiceUpdateCustomConfig(ctx echo.Context, projectName string, resourceId string) error
 	// ListLocalAccounts
 	// (GET /v1/projects/{projectName}/localAccounts)
 	LocalAccountServiceListLocalAccounts(ctx echo.Context, projectName string, params LocalAccountServiceListLocalAccountsParams) error
@@ -491,9 +665,66 @@ type ServerInterface interface {
 	// GetLocalAccount
 	// (GET /v1/projects/{projectName}/localAccounts/{resourceId})
 	LocalAccountServiceGetLocalAccount(ctx echo.Context, projectName string, resourceId string) error
+	// PatchLocalAccount
+	// (PATCH /v1/projects/{projectName}/localAccounts/{resourceId})
+	LocalAccountServicePatchLocalAccount(ctx echo.Context, projectName string, resourceId string, params LocalAccountServicePatchLocalAccountParams) error
+	// UpdateLocalAccount
+	// (PUT /v1/projects/{projectName}/localAccounts/{resourceId})
+	LocalAccountServiceUpdateLocalAccount(ctx echo.Context, projectName string, resourceId string) error
 	// ListLocations
 	// (GET /v1/projects/{projectName}/locations)
 	LocationServiceListLocations(ctx echo.Context, projectName string, params LocationServiceListLocationsParams) error
+	// ListEndpoints
+	// (GET /v1/projects/{projectName}/network/endpoints)
+	EndpointServiceListEndpoints(ctx echo.Context, projectName string, params EndpointServiceListEndpointsParams) error
+	// GetEndpoint
+	// (GET /v1/projects/{projectName}/network/endpoints/{resourceId})
+	EndpointServiceGetEndpoint(ctx echo.Context, projectName string, resourceId string) error
+	// ListIPAddresses
+	// (GET /v1/projects/{projectName}/network/ipaddresses)
+	IPAddressServiceListIPAddresses(ctx echo.Context, projectName string, params IPAddressServiceListIPAddressesParams) error
+	// GetIPAddress
+	// (GET /v1/projects/{projectName}/network/ipaddresses/{resourceId})
+	IPAddressServiceGetIPAddress(ctx echo.Context, projectName string, resourceId string) error
+	// ListNetlinks
+	// (GET /v1/projects/{projectName}/network/netlinks)
+	NetlinkServiceListNetlinks(ctx echo.Context, projectName string, params NetlinkServiceListNetlinksParams) error
+	// GetNetlink
+	// (GET /v1/projects/{projectName}/network/netlinks/{resourceId})
+	NetlinkServiceGetNetlink(ctx echo.Context, projectName string, resourceId string) error
+	// ListHostnics
+	// (GET /v1/projects/{projectName}/network/nics)
+	HostnicServiceListHostnics(ctx echo.Context, projectName string, params HostnicServiceListHostnicsParams) error
+	// GetHostnic
+	// (GET /v1/projects/{projectName}/network/nics/{resourceId})
+	HostnicServiceGetHostnic(ctx echo.Context, projectName string, resourceId string) error
+	// ListNetworkSegments
+	// (GET /v1/projects/{projectName}/network/segments)
+	NetworkSegmentServiceListNetworkSegments(ctx echo.Context, projectName string, params NetworkSegmentServiceListNetworkSegmentsParams) error
+	// CreateNetworkSegment
+	// (POST /v1/projects/{projectName}/network/segments)
+	NetworkSegmentServiceCreateNetworkSegment(ctx echo.Context, projectName string) error
+	// DeleteNetworkSegment
+	// (DELETE /v1/projects/{projectName}/network/segments/{resourceId})
+	NetworkSegmentServiceDeleteNetworkSegment(ctx echo.Context, projectName string, resourceId string) error
+	// GetNetworkSegment
+	// (GET /v1/projects/{projectName}/network/segments/{resourceId})
+	NetworkSegmentServiceGetNetworkSegment(ctx echo.Context, projectName string, resourceId string) error
+	// PatchNetworkSegment
+	// (PATCH /v1/projects/{projectName}/network/segments/{resourceId})
+	NetworkSegmentServicePatchNetworkSegment(ctx echo.Context, projectName string, resourceId string, params NetworkSegmentServicePatchNetworkSegmentParams) error
+	// UpdateNetworkSegment
+	// (PUT /v1/projects/{projectName}/network/segments/{resourceId})
+	NetworkSegmentServiceUpdateNetworkSegment(ctx echo.Context, projectName string, resourceId string) error
+	// ListOperations
+	// (GET /v1/projects/{projectName}/operations)
+	OperationServiceListOperations(ctx echo.Context, projectName string, params OperationServiceListOperationsParams) error
+	// GetOperation
+	// (GET /v1/projects/{projectName}/operations/{resourceId})
+	OperationServiceGetOperation(ctx echo.Context, projectName string, resourceId string) error
+	// CancelOperation
+	// (POST /v1/projects/{projectName}/operations/{resourceId}/cancel)
+	OperationServiceCancelOperation(ctx echo.Context, projectName string, resourceId string) error
 	// ListOSUpdatePolicy
 	// (GET /v1/projects/{projectName}/os-update-policies)
 	OSUpdatePolicyListOSUpdatePolicy(ctx echo.Context, projectName string, params OSUpdatePolicyListOSUpdatePolicyParams) error
@@ -506,6 +737,12 @@ type ServerInterface interface {
 	// GetOSUpdatePolicy
 	// (GET /v1/projects/{projectName}/os-update-policies/{resourceId})
 	OSUpdatePolicyGetOSUpdatePolicy(ctx echo.Context, projectName string, resourceId string) error
+	// PatchOSUpdatePolicy
+	// (PATCH /v1/projects/{projectName}/os-update-policies/{resourceId})
+	OSUpdatePolicyPatchOSUpdatePolicy(ctx echo.Context, projectName string, resourceId string, params OSUpdatePolicyPatchOSUpdatePolicyParams) error
+	// UpdateOSUpdatePolicy
+	// (PUT /v1/projects/{projectName}/os-update-policies/{resourceId})
+	OSUpdatePolicyUpdateOSUpdatePolicy(ctx echo.Context, projectName string, resourceId string) error
 	// ListOSUpdateRun
 	// (GET /v1/projects/{projectName}/os-update-runs)
 	OSUpdateRunListOSUpdateRun(ctx echo.Context, projectName string, params OSUpdateRunListOSUpdateRunParams) error
@@ -527,6 +764,12 @@ type ServerInterface interface {
 	// GetOSUpdatePolicy
 	// (GET /v1/projects/{projectName}/os_update_policy/{resourceId})
 	OSUpdatePolicyGetOSUpdatePolicy3(ctx echo.Context, projectName string, resourceId string) error
+	// PatchOSUpdatePolicy
+	// (PATCH /v1/projects/{projectName}/os_update_policy/{resourceId})
+	OSUpdatePolicyPatchOSUpdatePolicy3(ctx echo.Context, projectName string, resourceId string, params OSUpdatePolicyPatchOSUpdatePolicy3Params) error
+	// UpdateOSUpdatePolicy
+	// (PUT /v1/projects/{projectName}/os_update_policy/{resourceId})
+	OSUpdatePolicyUpdateOSUpdatePolicy3(ctx echo.Context, projectName string, resourceId string) error
 	// ListOSUpdateRun
 	// (GET /v1/projects/{projectName}/os_update_run)
 	OSUpdateRunListOSUpdateRun3(ctx echo.Context, projectName string, params OSUpdateRunListOSUpdateRun3Params) error
@@ -536,6 +779,24 @@ type ServerInterface interface {
 	// GetOSUpdateRun
 	// (GET /v1/projects/{projectName}/os_update_run/{resourceId})
 	OSUpdateRunGetOSUpdateRun3(ctx echo.Context, projectName string, resourceId string) error
+	// ListOus
+	// (GET /v1/projects/{projectName}/ous)
+	OuServiceListOus(ctx echo.Context, projectName string, params OuServiceListOusParams) error
+	// CreateOu
+	// (POST /v1/projects/{projectName}/ous)
+	OuServiceCreateOu(ctx echo.Context, projectName string) error
+	// DeleteOu
+	// (DELETE /v1/projects/{projectName}/ous/{resourceId})
+	OuServiceDeleteOu(ctx echo.Context, projectName string, resourceId string) error
+	// GetOu
+	// (GET /v1/projects/{projectName}/ous/{resourceId})
+	OuServiceGetOu(ctx echo.Context, projectName string, resourceId string) error
+	// PatchOu
+	// (PATCH /v1/projects/{projectName}/ous/{resourceId})
+	OuServicePatchOu(ctx echo.Context, projectName string, resourceId string, params OuServicePatchOuParams) error
+	// UpdateOu
+	// (PUT /v1/projects/{projectName}/ous/{resourceId})
+	OuServiceUpdateOu(ctx echo.Context, projectName string, resourceId string) error
 	// ListProviders
 	// (GET /v1/projects/{projectName}/providers)
 	ProviderServiceListProviders(ctx echo.Context, projectName string, params ProviderServiceListProvidersParams) error
@@ -548,6 +809,12 @@ type ServerInterface interface {
 	// GetProvider
 	// (GET /v1/projects/{projectName}/providers/{resourceId})
 	ProviderServiceGetProvider(ctx echo.Context, projectName string, resourceId string) error
+	// PatchProvider
+	// (PATCH /v1/projects/{projectName}/providers/{resourceId})
+	ProviderServicePatchProvider(ctx echo.Context, projectName string, resourceId string, params ProviderServicePatchProviderParams) error
+	// UpdateProvider
+	// (PUT /v1/projects/{projectName}/providers/{resourceId})
+	ProviderServiceUpdateProvider(ctx echo.Context, projectName string, resourceId string) error
 	// ListRegions
 	// (GET /v1/projects/{projectName}/regions)
 	RegionServiceListRegions(ctx echo.Context, projectName string, params RegionServiceListRegionsParams) error
@@ -565,7 +832,7 @@ type ServerInterface interface {
 	SiteServiceDeleteSite(ctx echo.Context, projectName string, regionId string, resourceId string) error
 	// GetSite
 	// (GET /v1/projects/{projectName}/regions/{regionId}/sites/{resourceId})
-	SiteServiceGetSite(ctx echo.Context, projectName string, regionId string, resourceId string) error
+	SiteServiceGetSite(ctx echo.Context, projectName string, regionId string, resourceId string, params SiteServiceGetSiteParams) error
 	// PatchSite
 	// (PATCH /v1/projects/{projectName}/regions/{regionId}/sites/{resourceId})
 	SiteServicePatchSite(ctx echo.Context, projectName string, regionId string, resourceId string, params SiteServicePatchSiteParams) error
@@ -584,6 +851,33 @@ type ServerInterface interface {
 	// UpdateRegion
 	// (PUT /v1/projects/{projectName}/regions/{resourceId})
 	RegionServiceUpdateRegion(ctx echo.Context, projectName string, resourceId string) error
+	// ListRemoteAccesses
+	// (GET /v1/projects/{projectName}/remote-access/sessions)
+	RemoteAccessServiceListRemoteAccesses(ctx echo.Context, projectName string, params RemoteAccessServiceListRemoteAccessesParams) error
+	// CreateRemoteAccess
+	// (POST /v1/projects/{projectName}/remote-access/sessions)
+	RemoteAccessServiceCreateRemoteAccess(ctx echo.Context, projectName string) error
+	// DeleteRemoteAccess
+	// (DELETE /v1/projects/{projectName}/remote-access/sessions/{resourceId})
+	RemoteAccessServiceDeleteRemoteAccess(ctx echo.Context, projectName string, resourceId string) error
+	// GetRemoteAccess
+	// (GET /v1/projects/{projectName}/remote-access/sessions/{resourceId})
+	RemoteAccessServiceGetRemoteAccess(ctx echo.Context, projectName string, resourceId string) error
+	// ListRoleBindings
+	// (GET /v1/projects/{projectName}/role-bindings)
+	RoleBindingServiceListRoleBindings(ctx echo.Context, projectName string, params RoleBindingServiceListRoleBindingsParams) error
+	// CreateRoleBinding
+	// (POST /v1/projects/{projectName}/role-bindings)
+	RoleBindingServiceCreateRoleBinding(ctx echo.Context, projectName string) error
+	// DeleteRoleBinding
+	// (DELETE /v1/projects/{projectName}/role-bindings/{resourceId})
+	RoleBindingServiceDeleteRoleBinding(ctx echo.Context, projectName string, resourceId string) error
+	// GetRoleBinding
+	// (GET /v1/projects/{projectName}/role-bindings/{resourceId})
+	RoleBindingServiceGetRoleBinding(ctx echo.Context, projectName string, resourceId string) error
+	// UpdateRoleBinding
+	// (PUT /v1/projects/{projectName}/role-bindings/{resourceId})
+	RoleBindingServiceUpdateRoleBinding(ctx echo.Context, projectName string, resourceId string) error
 	// ListSchedules
 	// (GET /v1/projects/{projectName}/schedules)
 	ScheduleServiceListSchedules3(ctx echo.Context, projectName string, params ScheduleServiceListSchedules3Params) error
@@ -725,6 +1019,9 @@ type ServerInterface interface {
 	// UpdateTelemetryMetricsProfile
 	// (PUT /v1/projects/{projectName}/telemetry/profiles/metrics/{resourceId})
 	TelemetryMetricsProfileServiceUpdateTelemetryMetricsProfile(ctx echo.Context, projectName string, resourceId string) error
+	// WatchResources
+	// (GET /v1/projects/{projectName}/watch)
+	WatchServiceWatchResources(ctx echo.Context, projectName string, params WatchServiceWatchResourcesParams) error
 	// ListWorkloadMembers
 	// (GET /v1/projects/{projectName}/workload_members)
 	WorkloadMemberServiceListWorkloadMembers(ctx echo.Context, projectName string, params WorkloadMemberServiceListWorkloadMembersParams) error
@@ -762,6 +1059,81 @@ type ServerInterfaceWrapper struct {
 	Handler ServerInterface
 }
 
+// APITokenServiceListAPITokens2 converts echo context to params.
+func (w *ServerInterfaceWrapper) APITokenServiceListAPITokens2(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params APITokenServiceListAPITokens2Params
+	// ------------- Optional query parameter "pageSize" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pageSize", ctx.QueryParams(), &params.PageSize)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pageSize: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Required query parameter "projectName" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.APITokenServiceListAPITokens2(ctx, params)
+	return err
+}
+
+// APITokenServiceCreateAPIToken2 converts echo context to params.
+func (w *ServerInterfaceWrapper) APITokenServiceCreateAPIToken2(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params APITokenServiceCreateAPIToken2Params
+	// ------------- Required query parameter "projectName" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.APITokenServiceCreateAPIToken2(ctx, params)
+	return err
+}
+
+// APITokenServiceDeleteAPIToken2 converts echo context to params.
+func (w *ServerInterfaceWrapper) APITokenServiceDeleteAPIToken2(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "resourceId" -------------
+	var resourceId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", ctx.Param("resourceId"), &resourceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params APITokenServiceDeleteAPIToken2Params
+	// ------------- Required query parameter "projectName" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.APITokenServiceDeleteAPIToken2(ctx, resourceId, params)
+	return err
+}
+
 // CustomConfigServiceListCustomConfigs2 converts echo context to params.
 func (w *ServerInterfaceWrapper) CustomConfigServiceListCustomConfigs2(ctx echo.Context) error {
 	var err error
@@ -876,6 +1248,63 @@ func (w *ServerInterfaceWrapper) CustomConfigServiceGetCustomConfig2(ctx echo.Co
 	return err
 }
 
+// CustomConfigServicePatchCustomConfig2 converts echo context to params.
+func (w *ServerInterfaceWrapper) CustomConfigServicePatchCustomConfig2(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "resourceId" -------------
+	var resourceId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", ctx.Param("resourceId"), &resourceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CustomConfigServicePatchCustomConfig2Params
+	// ------------- Optional query parameter "fieldMask" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fieldMask", ctx.QueryParams(), &params.FieldMask)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fieldMask: %s", err))
+	}
+
+	// ------------- Required query parameter "projectName" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CustomConfigServicePatchCustomConfig2(ctx, resourceId, params)
+	return err
+}
+
+// CustomConfigServiceUpdateCustomConfig2 converts echo context to params.
+func (w *ServerInterfaceWrapper) CustomConfigServiceUpdateCustomConfig2(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "resourceId" -------------
+	var resourceId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", ctx.Param("resourceId"), &resourceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CustomConfigServiceUpdateCustomConfig2Params
+	// ------------- Required query parameter "projectName" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CustomConfigServiceUpdateCustomConfig2(ctx, resourceId, params)
+	return err
+}
+
 // HostServiceListHosts2 converts echo context to params.
 func (w *ServerInterfaceWrapper) HostServiceListHosts2(ctx echo.Context) error {
 	var err error
@@ -917,6 +1346,20 @@ func (w *ServerInterfaceWrapper) HostServiceListHosts2(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
 	}
 
+	// ------------- Optional query parameter "view" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "view", ctx.QueryParams(), &params.View)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter view: %s", err))
+	}
+
+	// ------------- Optional query parameter "fields" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fields", ctx.QueryParams(), &params.Fields)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fields: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.HostServiceListHosts2(ctx, params)
 	return err
@@ -940,6 +1383,24 @@ func (w *ServerInterfaceWrapper) HostServiceCreateHost2(ctx echo.Context) error
 	return err
 }
 
+// HostServiceBulkHostAction2 converts echo context to params.
+func (w *ServerInterfaceWrapper) HostServiceBulkHostAction2(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.HostServiceBulkHostAction2(ctx)
+	return err
+}
+
+// HostServiceInvalidateHosts2 converts echo context to params.
+func (w *ServerInterfaceWrapper) HostServiceInvalidateHosts2(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.HostServiceInvalidateHosts2(ctx)
+	return err
+}
+
 // HostServiceRegisterHost2 converts echo context to params.
 func (w *ServerInterfaceWrapper) HostServiceRegisterHost2(ctx echo.Context) error {
 	var err error
@@ -965,6 +1426,15 @@ func (w *ServerInterfaceWrapper) HostServiceRegisterHost2(ctx echo.Context) erro
 	return err
 }
 
+// HostServiceRegisterHosts2 converts echo context to params.
+func (w *ServerInterfaceWrapper) HostServiceRegisterHosts2(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.HostServiceRegisterHosts2(ctx)
+	return err
+}
+
 // HostServiceDeleteHost2 converts echo context to params.
 func (w *ServerInterfaceWrapper) HostServiceDeleteHost2(ctx echo.Context) error {
 	var err error
@@ -1010,6 +1480,20 @@ func (w *ServerInterfaceWrapper) HostServiceGetHost2(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
 	}
 
+	// ------------- Optional query parameter "view" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "view", ctx.QueryParams(), &params.View)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter view: %s", err))
+	}
+
+	// ------------- Optional query parameter "fields" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fields", ctx.QueryParams(), &params.Fields)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fields: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.HostServiceGetHost2(ctx, resourceId, params)
 	return err
@@ -1220,6 +1704,20 @@ func (w *ServerInterfaceWrapper) InstanceServiceListInstances2(ctx echo.Context)
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
 	}
 
+	// ------------- Optional query parameter "view" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "view", ctx.QueryParams(), &params.View)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter view: %s", err))
+	}
+
+	// ------------- Optional query parameter "fields" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fields", ctx.QueryParams(), &params.Fields)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fields: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.InstanceServiceListInstances2(ctx, params)
 	return err
@@ -1243,6 +1741,15 @@ func (w *ServerInterfaceWrapper) InstanceServiceCreateInstance2(ctx echo.Context
 	return err
 }
 
+// InstanceServiceBulkInstanceAction2 converts echo context to params.
+func (w *ServerInterfaceWrapper) InstanceServiceBulkInstanceAction2(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.InstanceServiceBulkInstanceAction2(ctx)
+	return err
+}
+
 // InstanceServiceDeleteInstance2 converts echo context to params.
 func (w *ServerInterfaceWrapper) InstanceServiceDeleteInstance2(ctx echo.Context) error {
 	var err error
@@ -1288,6 +1795,20 @@ func (w *ServerInterfaceWrapper) InstanceServiceGetInstance2(ctx echo.Context) e
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
 	}
 
+	// ------------- Optional query parameter "view" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "view", ctx.QueryParams(), &params.View)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter view: %s", err))
+	}
+
+	// ------------- Optional query parameter "fields" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fields", ctx.QueryParams(), &params.Fields)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fields: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.InstanceServiceGetInstance2(ctx, resourceId, params)
 	return err
@@ -1489,6 +2010,63 @@ func (w *ServerInterfaceWrapper) LocalAccountServiceGetLocalAccount2(ctx echo.Co
 	return err
 }
 
+// LocalAccountServicePatchLocalAccount2 converts echo context to params.
+func (w *ServerInterfaceWrapper) LocalAccountServicePatchLocalAccount2(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "resourceId" -------------
+	var resourceId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", ctx.Param("resourceId"), &resourceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params LocalAccountServicePatchLocalAccount2Params
+	// ------------- Optional query parameter "fieldMask" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fieldMask", ctx.QueryParams(), &params.FieldMask)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fieldMask: %s", err))
+	}
+
+	// ------------- Required query parameter "projectName" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.LocalAccountServicePatchLocalAccount2(ctx, resourceId, params)
+	return err
+}
+
+// LocalAccountServiceUpdateLocalAccount2 converts echo context to params.
+func (w *ServerInterfaceWrapper) LocalAccountServiceUpdateLocalAccount2(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "resourceId" -------------
+	var resourceId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", ctx.Param("resourceId"), &resourceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params LocalAccountServiceUpdateLocalAccount2Params
+	// ------------- Required query parameter "projectName" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.LocalAccountServiceUpdateLocalAccount2(ctx, resourceId, params)
+	return err
+}
+
 // LocationServiceListLocations2 converts echo context to params.
 func (w *ServerInterfaceWrapper) LocationServiceListLocations2(ctx echo.Context) error {
 	var err error
@@ -1516,6 +2094,13 @@ func (w *ServerInterfaceWrapper) LocationServiceListLocations2(ctx echo.Context)
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter showRegions: %s", err))
 	}
 
+	// ------------- Optional query parameter "showOus" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "showOus", ctx.QueryParams(), &params.ShowOus)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter showOus: %s", err))
+	}
+
 	// ------------- Required query parameter "projectName" -------------
 
 	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
@@ -1528,12 +2113,12 @@ func (w *ServerInterfaceWrapper) LocationServiceListLocations2(ctx echo.Context)
 	return err
 }
 
-// OperatingSystemServiceListOperatingSystems2 converts echo context to params.
-func (w *ServerInterfaceWrapper) OperatingSystemServiceListOperatingSystems2(ctx echo.Context) error {
+// EndpointServiceListEndpoints2 converts echo context to params.
+func (w *ServerInterfaceWrapper) EndpointServiceListEndpoints2(ctx echo.Context) error {
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params OperatingSystemServiceListOperatingSystems2Params
+	var params EndpointServiceListEndpoints2Params
 	// ------------- Optional query parameter "orderBy" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "orderBy", ctx.QueryParams(), &params.OrderBy)
@@ -1570,30 +2155,12 @@ func (w *ServerInterfaceWrapper) OperatingSystemServiceListOperatingSystems2(ctx
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.OperatingSystemServiceListOperatingSystems2(ctx, params)
-	return err
-}
-
-// OperatingSystemServiceCreateOperatingSystem2 converts echo context to params.
-func (w *ServerInterfaceWrapper) OperatingSystemServiceCreateOperatingSystem2(ctx echo.Context) error {
-	var err error
-
-	// Parameter object where we will unmarshal all parameters from the context
-	var params OperatingSystemServiceCreateOperatingSystem2Params
-	// ------------- Required query parameter "projectName" -------------
-
-	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
-	}
-
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.OperatingSystemServiceCreateOperatingSystem2(ctx, params)
+	err = w.Handler.EndpointServiceListEndpoints2(ctx, params)
 	return err
 }
 
-// OperatingSystemServiceDeleteOperatingSystem2 converts echo context to params.
-func (w *ServerInterfaceWrapper) OperatingSystemServiceDeleteOperatingSystem2(ctx echo.Context) error {
+// EndpointServiceGetEndpoint2 converts echo context to params.
+func (w *ServerInterfaceWrapper) EndpointServiceGetEndpoint2(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "resourceId" -------------
 	var resourceId string
@@ -1604,7 +2171,7 @@ func (w *ServerInterfaceWrapper) OperatingSystemServiceDeleteOperatingSystem2(ct
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params OperatingSystemServiceDeleteOperatingSystem2Params
+	var params EndpointServiceGetEndpoint2Params
 	// ------------- Required query parameter "projectName" -------------
 
 	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
@@ -1613,53 +2180,42 @@ func (w *ServerInterfaceWrapper) OperatingSystemServiceDeleteOperatingSystem2(ct
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.OperatingSystemServiceDeleteOperatingSystem2(ctx, resourceId, params)
+	err = w.Handler.EndpointServiceGetEndpoint2(ctx, resourceId, params)
 	return err
 }
 
-// OperatingSystemServiceGetOperatingSystem2 converts echo context to params.
-func (w *ServerInterfaceWrapper) OperatingSystemServiceGetOperatingSystem2(ctx echo.Context) error {
+// IPAddressServiceListIPAddresses2 converts echo context to params.
+func (w *ServerInterfaceWrapper) IPAddressServiceListIPAddresses2(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "resourceId" -------------
-	var resourceId string
-
-	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", ctx.Param("resourceId"), &resourceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
-	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params OperatingSystemServiceGetOperatingSystem2Params
-	// ------------- Required query parameter "projectName" -------------
+	var params IPAddressServiceListIPAddresses2Params
+	// ------------- Optional query parameter "orderBy" -------------
 
-	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
+	err = runtime.BindQueryParameter("form", true, false, "orderBy", ctx.QueryParams(), &params.OrderBy)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderBy: %s", err))
 	}
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.OperatingSystemServiceGetOperatingSystem2(ctx, resourceId, params)
-	return err
-}
+	// ------------- Optional query parameter "filter" -------------
 
-// OperatingSystemServicePatchOperatingSystem2 converts echo context to params.
-func (w *ServerInterfaceWrapper) OperatingSystemServicePatchOperatingSystem2(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "resourceId" -------------
-	var resourceId string
+	err = runtime.BindQueryParameter("form", true, false, "filter", ctx.QueryParams(), &params.Filter)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter filter: %s", err))
+	}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", ctx.Param("resourceId"), &resourceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	// ------------- Optional query parameter "pageSize" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pageSize", ctx.QueryParams(), &params.PageSize)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pageSize: %s", err))
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params OperatingSystemServicePatchOperatingSystem2Params
-	// ------------- Optional query parameter "fieldMask" -------------
+	// ------------- Optional query parameter "offset" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "fieldMask", ctx.QueryParams(), &params.FieldMask)
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fieldMask: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
 	}
 
 	// ------------- Required query parameter "projectName" -------------
@@ -1670,12 +2226,12 @@ func (w *ServerInterfaceWrapper) OperatingSystemServicePatchOperatingSystem2(ctx
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.OperatingSystemServicePatchOperatingSystem2(ctx, resourceId, params)
+	err = w.Handler.IPAddressServiceListIPAddresses2(ctx, params)
 	return err
 }
 
-// OperatingSystemServiceUpdateOperatingSystem2 converts echo context to params.
-func (w *ServerInterfaceWrapper) OperatingSystemServiceUpdateOperatingSystem2(ctx echo.Context) error {
+// IPAddressServiceGetIPAddress2 converts echo context to params.
+func (w *ServerInterfaceWrapper) IPAddressServiceGetIPAddress2(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "resourceId" -------------
 	var resourceId string
@@ -1686,7 +2242,7 @@ func (w *ServerInterfaceWrapper) OperatingSystemServiceUpdateOperatingSystem2(ct
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params OperatingSystemServiceUpdateOperatingSystem2Params
+	var params IPAddressServiceGetIPAddress2Params
 	// ------------- Required query parameter "projectName" -------------
 
 	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
@@ -1695,16 +2251,16 @@ func (w *ServerInterfaceWrapper) OperatingSystemServiceUpdateOperatingSystem2(ct
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.OperatingSystemServiceUpdateOperatingSystem2(ctx, resourceId, params)
+	err = w.Handler.IPAddressServiceGetIPAddress2(ctx, resourceId, params)
 	return err
 }
 
-// OSUpdatePolicyListOSUpdatePolicy2 converts echo context to params.
-func (w *ServerInterfaceWrapper) OSUpdatePolicyListOSUpdatePolicy2(ctx echo.Context) error {
+// NetlinkServiceListNetlinks2 converts echo context to params.
+func (w *ServerInterfaceWrapper) NetlinkServiceListNetlinks2(ctx echo.Context) error {
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params OSUpdatePolicyListOSUpdatePolicy2Params
+	var params NetlinkServiceListNetlinks2Params
 	// ------------- Optional query parameter "orderBy" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "orderBy", ctx.QueryParams(), &params.OrderBy)
@@ -1741,16 +2297,23 @@ func (w *ServerInterfaceWrapper) OSUpdatePolicyListOSUpdatePolicy2(ctx echo.Cont
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.OSUpdatePolicyListOSUpdatePolicy2(ctx, params)
+	err = w.Handler.NetlinkServiceListNetlinks2(ctx, params)
 	return err
 }
 
-// OSUpdatePolicyCreateOSUpdatePolicy2 converts echo context to params.
-func (w *ServerInterfaceWrapper) OSUpdatePolicyCreateOSUpdatePolicy2(ctx echo.Context) error {
+// NetlinkServiceGetNetlink2 converts echo context to params.
+func (w *ServerInterfaceWrapper) NetlinkServiceGetNetlink2(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "resourceId" -------------
+	var resourceId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", ctx.Param("resourceId"), &resourceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
+	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params OSUpdatePolicyCreateOSUpdatePolicy2Params
+	var params NetlinkServiceGetNetlink2Params
 	// ------------- Required query parameter "projectName" -------------
 
 	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
@@ -1759,23 +2322,44 @@ func (w *ServerInterfaceWrapper) OSUpdatePolicyCreateOSUpdatePolicy2(ctx echo.Co
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.OSUpdatePolicyCreateOSUpdatePolicy2(ctx, params)
+	err = w.Handler.NetlinkServiceGetNetlink2(ctx, resourceId, params)
 	return err
 }
 
-// OSUpdatePolicyDeleteOSUpdatePolicy2 converts echo context to params.
-func (w *ServerInterfaceWrapper) OSUpdatePolicyDeleteOSUpdatePolicy2(ctx echo.Context) error {
+// HostnicServiceListHostnics2 converts echo context to params.
+func (w *ServerInterfaceWrapper) HostnicServiceListHostnics2(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "resourceId" -------------
-	var resourceId string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", ctx.Param("resourceId"), &resourceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	// Parameter object where we will unmarshal all parameters from the context
+	var params HostnicServiceListHostnics2Params
+	// ------------- Optional query parameter "orderBy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "orderBy", ctx.QueryParams(), &params.OrderBy)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderBy: %s", err))
+	}
+
+	// ------------- Optional query parameter "filter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filter", ctx.QueryParams(), &params.Filter)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter filter: %s", err))
+	}
+
+	// ------------- Optional query parameter "pageSize" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pageSize", ctx.QueryParams(), &params.PageSize)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pageSize: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params OSUpdatePolicyDeleteOSUpdatePolicy2Params
 	// ------------- Required query parameter "projectName" -------------
 
 	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
@@ -1784,12 +2368,12 @@ func (w *ServerInterfaceWrapper) OSUpdatePolicyDeleteOSUpdatePolicy2(ctx echo.Co
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.OSUpdatePolicyDeleteOSUpdatePolicy2(ctx, resourceId, params)
+	err = w.Handler.HostnicServiceListHostnics2(ctx, params)
 	return err
 }
 
-// OSUpdatePolicyGetOSUpdatePolicy2 converts echo context to params.
-func (w *ServerInterfaceWrapper) OSUpdatePolicyGetOSUpdatePolicy2(ctx echo.Context) error {
+// HostnicServiceGetHostnic2 converts echo context to params.
+func (w *ServerInterfaceWrapper) HostnicServiceGetHostnic2(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "resourceId" -------------
 	var resourceId string
@@ -1800,7 +2384,7 @@ func (w *ServerInterfaceWrapper) OSUpdatePolicyGetOSUpdatePolicy2(ctx echo.Conte
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params OSUpdatePolicyGetOSUpdatePolicy2Params
+	var params HostnicServiceGetHostnic2Params
 	// ------------- Required query parameter "projectName" -------------
 
 	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
@@ -1809,16 +2393,16 @@ func (w *ServerInterfaceWrapper) OSUpdatePolicyGetOSUpdatePolicy2(ctx echo.Conte
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.OSUpdatePolicyGetOSUpdatePolicy2(ctx, resourceId, params)
+	err = w.Handler.HostnicServiceGetHostnic2(ctx, resourceId, params)
 	return err
 }
 
-// OSUpdateRunListOSUpdateRun2 converts echo context to params.
-func (w *ServerInterfaceWrapper) OSUpdateRunListOSUpdateRun2(ctx echo.Context) error {
+// NetworkSegmentServiceListNetworkSegments2 converts echo context to params.
+func (w *ServerInterfaceWrapper) NetworkSegmentServiceListNetworkSegments2(ctx echo.Context) error {
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params OSUpdateRunListOSUpdateRun2Params
+	var params NetworkSegmentServiceListNetworkSegments2Params
 	// ------------- Optional query parameter "orderBy" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "orderBy", ctx.QueryParams(), &params.OrderBy)
@@ -1855,23 +2439,16 @@ func (w *ServerInterfaceWrapper) OSUpdateRunListOSUpdateRun2(ctx echo.Context) e
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.OSUpdateRunListOSUpdateRun2(ctx, params)
+	err = w.Handler.NetworkSegmentServiceListNetworkSegments2(ctx, params)
 	return err
 }
 
-// OSUpdateRunDeleteOSUpdateRun2 converts echo context to params.
-func (w *ServerInterfaceWrapper) OSUpdateRunDeleteOSUpdateRun2(ctx echo.Context) error {
+// NetworkSegmentServiceCreateNetworkSegment2 converts echo context to params.
+func (w *ServerInterfaceWrapper) NetworkSegmentServiceCreateNetworkSegment2(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "resourceId" -------------
-	var resourceId string
-
-	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", ctx.Param("resourceId"), &resourceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
-	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params OSUpdateRunDeleteOSUpdateRun2Params
+	var params NetworkSegmentServiceCreateNetworkSegment2Params
 	// ------------- Required query parameter "projectName" -------------
 
 	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
@@ -1880,12 +2457,12 @@ func (w *ServerInterfaceWrapper) OSUpdateRunDeleteOSUpdateRun2(ctx echo.Context)
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.OSUpdateRunDeleteOSUpdateRun2(ctx, resourceId, params)
+	err = w.Handler.NetworkSegmentServiceCreateNetworkSegment2(ctx, params)
 	return err
 }
 
-// OSUpdateRunGetOSUpdateRun2 converts echo context to params.
-func (w *ServerInterfaceWrapper) OSUpdateRunGetOSUpdateRun2(ctx echo.Context) error {
+// NetworkSegmentServiceDeleteNetworkSegment2 converts echo context to params.
+func (w *ServerInterfaceWrapper) NetworkSegmentServiceDeleteNetworkSegment2(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "resourceId" -------------
 	var resourceId string
@@ -1896,7 +2473,7 @@ func (w *ServerInterfaceWrapper) OSUpdateRunGetOSUpdateRun2(ctx echo.Context) er
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params OSUpdateRunGetOSUpdateRun2Params
+	var params NetworkSegmentServiceDeleteNetworkSegment2Params
 	// ------------- Required query parameter "projectName" -------------
 
 	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
@@ -1905,62 +2482,23 @@ func (w *ServerInterfaceWrapper) OSUpdateRunGetOSUpdateRun2(ctx echo.Context) er
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.OSUpdateRunGetOSUpdateRun2(ctx, resourceId, params)
+	err = w.Handler.NetworkSegmentServiceDeleteNetworkSegment2(ctx, resourceId, params)
 	return err
 }
 
-// ProviderServiceListProviders2 converts echo context to params.
-func (w *ServerInterfaceWrapper) ProviderServiceListProviders2(ctx echo.Context) error {
+// NetworkSegmentServiceGetNetworkSegment2 converts echo context to params.
+func (w *ServerInterfaceWrapper) NetworkSegmentServiceGetNetworkSegment2(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "resourceId" -------------
+	var resourceId string
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ProviderServiceListProviders2Params
-	// ------------- Optional query parameter "orderBy" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "orderBy", ctx.QueryParams(), &params.OrderBy)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderBy: %s", err))
-	}
-
-	// ------------- Optional query parameter "filter" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "filter", ctx.QueryParams(), &params.Filter)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter filter: %s", err))
-	}
-
-	// ------------- Optional query parameter "pageSize" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "pageSize", ctx.QueryParams(), &params.PageSize)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pageSize: %s", err))
-	}
-
-	// ------------- Optional query parameter "offset" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
-	}
-
-	// ------------- Required query parameter "projectName" -------------
-
-	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
+	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", ctx.Param("resourceId"), &resourceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
 	}
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ProviderServiceListProviders2(ctx, params)
-	return err
-}
-
-// ProviderServiceCreateProvider2 converts echo context to params.
-func (w *ServerInterfaceWrapper) ProviderServiceCreateProvider2(ctx echo.Context) error {
-	var err error
-
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ProviderServiceCreateProvider2Params
+	var params NetworkSegmentServiceGetNetworkSegment2Params
 	// ------------- Required query parameter "projectName" -------------
 
 	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
@@ -1969,12 +2507,12 @@ func (w *ServerInterfaceWrapper) ProviderServiceCreateProvider2(ctx echo.Context
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ProviderServiceCreateProvider2(ctx, params)
+	err = w.Handler.NetworkSegmentServiceGetNetworkSegment2(ctx, resourceId, params)
 	return err
 }
 
-// ProviderServiceDeleteProvider2 converts echo context to params.
-func (w *ServerInterfaceWrapper) ProviderServiceDeleteProvider2(ctx echo.Context) error {
+// NetworkSegmentServicePatchNetworkSegment2 converts echo context to params.
+func (w *ServerInterfaceWrapper) NetworkSegmentServicePatchNetworkSegment2(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "resourceId" -------------
 	var resourceId string
@@ -1985,7 +2523,14 @@ func (w *ServerInterfaceWrapper) ProviderServiceDeleteProvider2(ctx echo.Context
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ProviderServiceDeleteProvider2Params
+	var params NetworkSegmentServicePatchNetworkSegment2Params
+	// ------------- Optional query parameter "fieldMask" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fieldMask", ctx.QueryParams(), &params.FieldMask)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fieldMask: %s", err))
+	}
+
 	// ------------- Required query parameter "projectName" -------------
 
 	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
@@ -1994,12 +2539,12 @@ func (w *ServerInterfaceWrapper) ProviderServiceDeleteProvider2(ctx echo.Context
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ProviderServiceDeleteProvider2(ctx, resourceId, params)
+	err = w.Handler.NetworkSegmentServicePatchNetworkSegment2(ctx, resourceId, params)
 	return err
 }
 
-// ProviderServiceGetProvider2 converts echo context to params.
-func (w *ServerInterfaceWrapper) ProviderServiceGetProvider2(ctx echo.Context) error {
+// NetworkSegmentServiceUpdateNetworkSegment2 converts echo context to params.
+func (w *ServerInterfaceWrapper) NetworkSegmentServiceUpdateNetworkSegment2(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "resourceId" -------------
 	var resourceId string
@@ -2010,7 +2555,7 @@ func (w *ServerInterfaceWrapper) ProviderServiceGetProvider2(ctx echo.Context) e
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ProviderServiceGetProvider2Params
+	var params NetworkSegmentServiceUpdateNetworkSegment2Params
 	// ------------- Required query parameter "projectName" -------------
 
 	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
@@ -2019,16 +2564,16 @@ func (w *ServerInterfaceWrapper) ProviderServiceGetProvider2(ctx echo.Context) e
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ProviderServiceGetProvider2(ctx, resourceId, params)
+	err = w.Handler.NetworkSegmentServiceUpdateNetworkSegment2(ctx, resourceId, params)
 	return err
 }
 
-// RegionServiceListRegions2 converts echo context to params.
-func (w *ServerInterfaceWrapper) RegionServiceListRegions2(ctx echo.Context) error {
+// OperatingSystemServiceListOperatingSystems2 converts echo context to params.
+func (w *ServerInterfaceWrapper) OperatingSystemServiceListOperatingSystems2(ctx echo.Context) error {
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params RegionServiceListRegions2Params
+	var params OperatingSystemServiceListOperatingSystems2Params
 	// ------------- Optional query parameter "orderBy" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "orderBy", ctx.QueryParams(), &params.OrderBy)
@@ -2057,31 +2602,38 @@ func (w *ServerInterfaceWrapper) RegionServiceListRegions2(ctx echo.Context) err
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
 	}
 
-	// ------------- Optional query parameter "showTotalSites" -------------
+	// ------------- Required query parameter "projectName" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "showTotalSites", ctx.QueryParams(), &params.ShowTotalSites)
+	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter showTotalSites: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
 	}
 
-	// ------------- Required query parameter "projectName" -------------
+	// ------------- Optional query parameter "view" -------------
 
-	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
+	err = runtime.BindQueryParameter("form", true, false, "view", ctx.QueryParams(), &params.View)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter view: %s", err))
+	}
+
+	// ------------- Optional query parameter "fields" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fields", ctx.QueryParams(), &params.Fields)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fields: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.RegionServiceListRegions2(ctx, params)
+	err = w.Handler.OperatingSystemServiceListOperatingSystems2(ctx, params)
 	return err
 }
 
-// RegionServiceCreateRegion2 converts echo context to params.
-func (w *ServerInterfaceWrapper) RegionServiceCreateRegion2(ctx echo.Context) error {
+// OperatingSystemServiceCreateOperatingSystem2 converts echo context to params.
+func (w *ServerInterfaceWrapper) OperatingSystemServiceCreateOperatingSystem2(ctx echo.Context) error {
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params RegionServiceCreateRegion2Params
+	var params OperatingSystemServiceCreateOperatingSystem2Params
 	// ------------- Required query parameter "projectName" -------------
 
 	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
@@ -2090,12 +2642,12 @@ func (w *ServerInterfaceWrapper) RegionServiceCreateRegion2(ctx echo.Context) er
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.RegionServiceCreateRegion2(ctx, params)
+	err = w.Handler.OperatingSystemServiceCreateOperatingSystem2(ctx, params)
 	return err
 }
 
-// RegionServiceDeleteRegion2 converts echo context to params.
-func (w *ServerInterfaceWrapper) RegionServiceDeleteRegion2(ctx echo.Context) error {
+// OperatingSystemServiceDeleteOperatingSystem2 converts echo context to params.
+func (w *ServerInterfaceWrapper) OperatingSystemServiceDeleteOperatingSystem2(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "resourceId" -------------
 	var resourceId string
@@ -2106,7 +2658,7 @@ func (w *ServerInterfaceWrapper) RegionServiceDeleteRegion2(ctx echo.Context) er
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params RegionServiceDeleteRegion2Params
+	var params OperatingSystemServiceDeleteOperatingSystem2Params
 	// ------------- Required query parameter "projectName" -------------
 
 	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
@@ -2115,12 +2667,12 @@ func (w *ServerInterfaceWrapper) RegionServiceDeleteRegion2(ctx echo.Context) er
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.RegionServiceDeleteRegion2(ctx, resourceId, params)
+	err = w.Handler.OperatingSystemServiceDeleteOperatingSystem2(ctx, resourceId, params)
 	return err
 }
 
-// RegionServiceGetRegion2 converts echo context to params.
-func (w *ServerInterfaceWrapper) RegionServiceGetRegion2(ctx echo.Context) error {
+// OperatingSystemServiceGetOperatingSystem2 converts echo context to params.
+func (w *ServerInterfaceWrapper) OperatingSystemServiceGetOperatingSystem2(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "resourceId" -------------
 	var resourceId string
@@ -2131,7 +2683,7 @@ func (w *ServerInterfaceWrapper) RegionServiceGetRegion2(ctx echo.Context) error
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params RegionServiceGetRegion2Params
+	var params OperatingSystemServiceGetOperatingSystem2Params
 	// ------------- Required query parameter "projectName" -------------
 
 	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
@@ -2139,13 +2691,27 @@ func (w *ServerInterfaceWrapper) RegionServiceGetRegion2(ctx echo.Context) error
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
 	}
 
+	// ------------- Optional query parameter "view" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "view", ctx.QueryParams(), &params.View)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter view: %s", err))
+	}
+
+	// ------------- Optional query parameter "fields" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fields", ctx.QueryParams(), &params.Fields)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fields: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.RegionServiceGetRegion2(ctx, resourceId, params)
+	err = w.Handler.OperatingSystemServiceGetOperatingSystem2(ctx, resourceId, params)
 	return err
 }
 
-// RegionServicePatchRegion2 converts echo context to params.
-func (w *ServerInterfaceWrapper) RegionServicePatchRegion2(ctx echo.Context) error {
+// OperatingSystemServicePatchOperatingSystem2 converts echo context to params.
+func (w *ServerInterfaceWrapper) OperatingSystemServicePatchOperatingSystem2(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "resourceId" -------------
 	var resourceId string
@@ -2156,7 +2722,7 @@ func (w *ServerInterfaceWrapper) RegionServicePatchRegion2(ctx echo.Context) err
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params RegionServicePatchRegion2Params
+	var params OperatingSystemServicePatchOperatingSystem2Params
 	// ------------- Optional query parameter "fieldMask" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "fieldMask", ctx.QueryParams(), &params.FieldMask)
@@ -2172,12 +2738,12 @@ func (w *ServerInterfaceWrapper) RegionServicePatchRegion2(ctx echo.Context) err
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.RegionServicePatchRegion2(ctx, resourceId, params)
+	err = w.Handler.OperatingSystemServicePatchOperatingSystem2(ctx, resourceId, params)
 	return err
 }
 
-// RegionServiceUpdateRegion2 converts echo context to params.
-func (w *ServerInterfaceWrapper) RegionServiceUpdateRegion2(ctx echo.Context) error {
+// OperatingSystemServiceUpdateOperatingSystem2 converts echo context to params.
+func (w *ServerInterfaceWrapper) OperatingSystemServiceUpdateOperatingSystem2(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "resourceId" -------------
 	var resourceId string
@@ -2188,7 +2754,7 @@ func (w *ServerInterfaceWrapper) RegionServiceUpdateRegion2(ctx echo.Context) er
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params RegionServiceUpdateRegion2Params
+	var params OperatingSystemServiceUpdateOperatingSystem2Params
 	// ------------- Required query parameter "projectName" -------------
 
 	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
@@ -2197,16 +2763,16 @@ func (w *ServerInterfaceWrapper) RegionServiceUpdateRegion2(ctx echo.Context) er
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.RegionServiceUpdateRegion2(ctx, resourceId, params)
+	err = w.Handler.OperatingSystemServiceUpdateOperatingSystem2(ctx, resourceId, params)
 	return err
 }
 
-// ScheduleServiceListSchedules2 converts echo context to params.
-func (w *ServerInterfaceWrapper) ScheduleServiceListSchedules2(ctx echo.Context) error {
+// OperationServiceListOperations2 converts echo context to params.
+func (w *ServerInterfaceWrapper) OperationServiceListOperations2(ctx echo.Context) error {
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ScheduleServiceListSchedules2Params
+	var params OperationServiceListOperations2Params
 	// ------------- Optional query parameter "pageSize" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "pageSize", ctx.QueryParams(), &params.PageSize)
@@ -2221,34 +2787,56 @@ func (w *ServerInterfaceWrapper) ScheduleServiceListSchedules2(ctx echo.Context)
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
 	}
 
-	// ------------- Optional query parameter "hostId" -------------
+	// ------------- Required query parameter "projectName" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "hostId", ctx.QueryParams(), &params.HostId)
+	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter hostId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
 	}
 
-	// ------------- Optional query parameter "siteId" -------------
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.OperationServiceListOperations2(ctx, params)
+	return err
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "siteId", ctx.QueryParams(), &params.SiteId)
+// OperationServiceGetOperation2 converts echo context to params.
+func (w *ServerInterfaceWrapper) OperationServiceGetOperation2(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "resourceId" -------------
+	var resourceId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", ctx.Param("resourceId"), &resourceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter siteId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
 	}
 
-	// ------------- Optional query parameter "regionId" -------------
+	// Parameter object where we will unmarshal all parameters from the context
+	var params OperationServiceGetOperation2Params
+	// ------------- Required query parameter "projectName" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "regionId", ctx.QueryParams(), &params.RegionId)
+	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter regionId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
 	}
 
-	// ------------- Optional query parameter "unixEpoch" -------------
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.OperationServiceGetOperation2(ctx, resourceId, params)
+	return err
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "unixEpoch", ctx.QueryParams(), &params.UnixEpoch)
+// OperationServiceCancelOperation2 converts echo context to params.
+func (w *ServerInterfaceWrapper) OperationServiceCancelOperation2(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "resourceId" -------------
+	var resourceId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", ctx.Param("resourceId"), &resourceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter unixEpoch: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params OperationServiceCancelOperation2Params
 	// ------------- Required query parameter "projectName" -------------
 
 	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
@@ -2257,56 +2845,42 @@ func (w *ServerInterfaceWrapper) ScheduleServiceListSchedules2(ctx echo.Context)
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ScheduleServiceListSchedules2(ctx, params)
+	err = w.Handler.OperationServiceCancelOperation2(ctx, resourceId, params)
 	return err
 }
 
-// ScheduleServiceListRepeatedSchedules2 converts echo context to params.
-func (w *ServerInterfaceWrapper) ScheduleServiceListRepeatedSchedules2(ctx echo.Context) error {
+// OSUpdatePolicyListOSUpdatePolicy2 converts echo context to params.
+func (w *ServerInterfaceWrapper) OSUpdatePolicyListOSUpdatePolicy2(ctx echo.Context) error {
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ScheduleServiceListRepeatedSchedules2Params
-	// ------------- Optional query parameter "pageSize" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "pageSize", ctx.QueryParams(), &params.PageSize)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pageSize: %s", err))
-	}
-
-	// ------------- Optional query parameter "offset" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
-	}
-
-	// ------------- Optional query parameter "hostId" -------------
+	var params OSUpdatePolicyListOSUpdatePolicy2Params
+	// ------------- Optional query parameter "orderBy" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "hostId", ctx.QueryParams(), &params.HostId)
+	err = runtime.BindQueryParameter("form", true, false, "orderBy", ctx.QueryParams(), &params.OrderBy)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter hostId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderBy: %s", err))
 	}
 
-	// ------------- Optional query parameter "siteId" -------------
+	// ------------- Optional query parameter "filter" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "siteId", ctx.QueryParams(), &params.SiteId)
+	err = runtime.BindQueryParameter("form", true, false, "filter", ctx.QueryParams(), &params.Filter)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter siteId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter filter: %s", err))
 	}
 
-	// ------------- Optional query parameter "regionId" -------------
+	// ------------- Optional query parameter "pageSize" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "regionId", ctx.QueryParams(), &params.RegionId)
+	err = runtime.BindQueryParameter("form", true, false, "pageSize", ctx.QueryParams(), &params.PageSize)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter regionId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pageSize: %s", err))
 	}
 
-	// ------------- Optional query parameter "unixEpoch" -------------
+	// ------------- Optional query parameter "offset" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "unixEpoch", ctx.QueryParams(), &params.UnixEpoch)
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter unixEpoch: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
 	}
 
 	// ------------- Required query parameter "projectName" -------------
@@ -2317,16 +2891,16 @@ func (w *ServerInterfaceWrapper) ScheduleServiceListRepeatedSchedules2(ctx echo.
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ScheduleServiceListRepeatedSchedules2(ctx, params)
+	err = w.Handler.OSUpdatePolicyListOSUpdatePolicy2(ctx, params)
 	return err
 }
 
-// ScheduleServiceCreateRepeatedSchedule2 converts echo context to params.
-func (w *ServerInterfaceWrapper) ScheduleServiceCreateRepeatedSchedule2(ctx echo.Context) error {
+// OSUpdatePolicyCreateOSUpdatePolicy2 converts echo context to params.
+func (w *ServerInterfaceWrapper) OSUpdatePolicyCreateOSUpdatePolicy2(ctx echo.Context) error {
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ScheduleServiceCreateRepeatedSchedule2Params
+	var params OSUpdatePolicyCreateOSUpdatePolicy2Params
 	// ------------- Required query parameter "projectName" -------------
 
 	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
@@ -2335,12 +2909,12 @@ func (w *ServerInterfaceWrapper) ScheduleServiceCreateRepeatedSchedule2(ctx echo
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ScheduleServiceCreateRepeatedSchedule2(ctx, params)
+	err = w.Handler.OSUpdatePolicyCreateOSUpdatePolicy2(ctx, params)
 	return err
 }
 
-// ScheduleServiceDeleteRepeatedSchedule2 converts echo context to params.
-func (w *ServerInterfaceWrapper) ScheduleServiceDeleteRepeatedSchedule2(ctx echo.Context) error {
+// OSUpdatePolicyDeleteOSUpdatePolicy2 converts echo context to params.
+func (w *ServerInterfaceWrapper) OSUpdatePolicyDeleteOSUpdatePolicy2(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "resourceId" -------------
 	var resourceId string
@@ -2351,7 +2925,7 @@ func (w *ServerInterfaceWrapper) ScheduleServiceDeleteRepeatedSchedule2(ctx echo
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ScheduleServiceDeleteRepeatedSchedule2Params
+	var params OSUpdatePolicyDeleteOSUpdatePolicy2Params
 	// ------------- Required query parameter "projectName" -------------
 
 	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
@@ -2360,12 +2934,12 @@ func (w *ServerInterfaceWrapper) ScheduleServiceDeleteRepeatedSchedule2(ctx echo
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ScheduleServiceDeleteRepeatedSchedule2(ctx, resourceId, params)
+	err = w.Handler.OSUpdatePolicyDeleteOSUpdatePolicy2(ctx, resourceId, params)
 	return err
 }
 
-// ScheduleServiceGetRepeatedSchedule2 converts echo context to params.
-func (w *ServerInterfaceWrapper) ScheduleServiceGetRepeatedSchedule2(ctx echo.Context) error {
+// OSUpdatePolicyGetOSUpdatePolicy2 converts echo context to params.
+func (w *ServerInterfaceWrapper) OSUpdatePolicyGetOSUpdatePolicy2(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "resourceId" -------------
 	var resourceId string
@@ -2376,7 +2950,7 @@ func (w *ServerInterfaceWrapper) ScheduleServiceGetRepeatedSchedule2(ctx echo.Co
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ScheduleServiceGetRepeatedSchedule2Params
+	var params OSUpdatePolicyGetOSUpdatePolicy2Params
 	// ------------- Required query parameter "projectName" -------------
 
 	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
@@ -2385,12 +2959,12 @@ func (w *ServerInterfaceWrapper) ScheduleServiceGetRepeatedSchedule2(ctx echo.Co
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ScheduleServiceGetRepeatedSchedule2(ctx, resourceId, params)
+	err = w.Handler.OSUpdatePolicyGetOSUpdatePolicy2(ctx, resourceId, params)
 	return err
 }
 
-// ScheduleServicePatchRepeatedSchedule2 converts echo context to params.
-func (w *ServerInterfaceWrapper) ScheduleServicePatchRepeatedSchedule2(ctx echo.Context) error {
+// OSUpdatePolicyPatchOSUpdatePolicy2 converts echo context to params.
+func (w *ServerInterfaceWrapper) OSUpdatePolicyPatchOSUpdatePolicy2(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "resourceId" -------------
 	var resourceId string
@@ -2401,7 +2975,7 @@ func (w *ServerInterfaceWrapper) ScheduleServicePatchRepeatedSchedule2(ctx echo.
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ScheduleServicePatchRepeatedSchedule2Params
+	var params OSUpdatePolicyPatchOSUpdatePolicy2Params
 	// ------------- Optional query parameter "fieldMask" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "fieldMask", ctx.QueryParams(), &params.FieldMask)
@@ -2417,12 +2991,12 @@ func (w *ServerInterfaceWrapper) ScheduleServicePatchRepeatedSchedule2(ctx echo.
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ScheduleServicePatchRepeatedSchedule2(ctx, resourceId, params)
+	err = w.Handler.OSUpdatePolicyPatchOSUpdatePolicy2(ctx, resourceId, params)
 	return err
 }
 
-// ScheduleServiceUpdateRepeatedSchedule2 converts echo context to params.
-func (w *ServerInterfaceWrapper) ScheduleServiceUpdateRepeatedSchedule2(ctx echo.Context) error {
+// OSUpdatePolicyUpdateOSUpdatePolicy2 converts echo context to params.
+func (w *ServerInterfaceWrapper) OSUpdatePolicyUpdateOSUpdatePolicy2(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "resourceId" -------------
 	var resourceId string
@@ -2433,7 +3007,7 @@ func (w *ServerInterfaceWrapper) ScheduleServiceUpdateRepeatedSchedule2(ctx echo
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ScheduleServiceUpdateRepeatedSchedule2Params
+	var params OSUpdatePolicyUpdateOSUpdatePolicy2Params
 	// ------------- Required query parameter "projectName" -------------
 
 	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
@@ -2442,16 +3016,30 @@ func (w *ServerInterfaceWrapper) ScheduleServiceUpdateRepeatedSchedule2(ctx echo
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ScheduleServiceUpdateRepeatedSchedule2(ctx, resourceId, params)
+	err = w.Handler.OSUpdatePolicyUpdateOSUpdatePolicy2(ctx, resourceId, params)
 	return err
 }
 
-// ScheduleServiceListSingleSchedules2 converts echo context to params.
-func (w *ServerInterfaceWrapper) ScheduleServiceListSingleSchedules2(ctx echo.Context) error {
+// OSUpdateRunListOSUpdateRun2 converts echo context to params.
+func (w *ServerInterfaceWrapper) OSUpdateRunListOSUpdateRun2(ctx echo.Context) error {
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ScheduleServiceListSingleSchedules2Params
+	var params OSUpdateRunListOSUpdateRun2Params
+	// ------------- Optional query parameter "orderBy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "orderBy", ctx.QueryParams(), &params.OrderBy)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderBy: %s", err))
+	}
+
+	// ------------- Optional query parameter "filter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filter", ctx.QueryParams(), &params.Filter)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter filter: %s", err))
+	}
+
 	// ------------- Optional query parameter "pageSize" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "pageSize", ctx.QueryParams(), &params.PageSize)
@@ -2466,34 +3054,56 @@ func (w *ServerInterfaceWrapper) ScheduleServiceListSingleSchedules2(ctx echo.Co
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
 	}
 
-	// ------------- Optional query parameter "hostId" -------------
+	// ------------- Required query parameter "projectName" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "hostId", ctx.QueryParams(), &params.HostId)
+	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter hostId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
 	}
 
-	// ------------- Optional query parameter "siteId" -------------
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.OSUpdateRunListOSUpdateRun2(ctx, params)
+	return err
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "siteId", ctx.QueryParams(), &params.SiteId)
+// OSUpdateRunDeleteOSUpdateRun2 converts echo context to params.
+func (w *ServerInterfaceWrapper) OSUpdateRunDeleteOSUpdateRun2(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "resourceId" -------------
+	var resourceId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", ctx.Param("resourceId"), &resourceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter siteId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
 	}
 
-	// ------------- Optional query parameter "regionId" -------------
+	// Parameter object where we will unmarshal all parameters from the context
+	var params OSUpdateRunDeleteOSUpdateRun2Params
+	// ------------- Required query parameter "projectName" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "regionId", ctx.QueryParams(), &params.RegionId)
+	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter regionId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
 	}
 
-	// ------------- Optional query parameter "unixEpoch" -------------
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.OSUpdateRunDeleteOSUpdateRun2(ctx, resourceId, params)
+	return err
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "unixEpoch", ctx.QueryParams(), &params.UnixEpoch)
+// OSUpdateRunGetOSUpdateRun2 converts echo context to params.
+func (w *ServerInterfaceWrapper) OSUpdateRunGetOSUpdateRun2(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "resourceId" -------------
+	var resourceId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", ctx.Param("resourceId"), &resourceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter unixEpoch: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params OSUpdateRunGetOSUpdateRun2Params
 	// ------------- Required query parameter "projectName" -------------
 
 	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
@@ -2502,16 +3112,44 @@ func (w *ServerInterfaceWrapper) ScheduleServiceListSingleSchedules2(ctx echo.Co
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ScheduleServiceListSingleSchedules2(ctx, params)
+	err = w.Handler.OSUpdateRunGetOSUpdateRun2(ctx, resourceId, params)
 	return err
 }
 
-// ScheduleServiceCreateSingleSchedule2 converts echo context to params.
-func (w *ServerInterfaceWrapper) ScheduleServiceCreateSingleSchedule2(ctx echo.Context) error {
+// OuServiceListOus2 converts echo context to params.
+func (w *ServerInterfaceWrapper) OuServiceListOus2(ctx echo.Context) error {
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ScheduleServiceCreateSingleSchedule2Params
+	var params OuServiceListOus2Params
+	// ------------- Optional query parameter "orderBy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "orderBy", ctx.QueryParams(), &params.OrderBy)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderBy: %s", err))
+	}
+
+	// ------------- Optional query parameter "filter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filter", ctx.QueryParams(), &params.Filter)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter filter: %s", err))
+	}
+
+	// ------------- Optional query parameter "pageSize" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pageSize", ctx.QueryParams(), &params.PageSize)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pageSize: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
 	// ------------- Required query parameter "projectName" -------------
 
 	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
@@ -2520,12 +3158,30 @@ func (w *ServerInterfaceWrapper) ScheduleServiceCreateSingleSchedule2(ctx echo.C
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ScheduleServiceCreateSingleSchedule2(ctx, params)
+	err = w.Handler.OuServiceListOus2(ctx, params)
 	return err
 }
 
-// ScheduleServiceDeleteSingleSchedule2 converts echo context to params.
-func (w *ServerInterfaceWrapper) ScheduleServiceDeleteSingleSchedule2(ctx echo.Context) error {
+// OuServiceCreateOu2 converts echo context to params.
+func (w *ServerInterfaceWrapper) OuServiceCreateOu2(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params OuServiceCreateOu2Params
+	// ------------- Required query parameter "projectName" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.OuServiceCreateOu2(ctx, params)
+	return err
+}
+
+// OuServiceDeleteOu2 converts echo context to params.
+func (w *ServerInterfaceWrapper) OuServiceDeleteOu2(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "resourceId" -------------
 	var resourceId string
@@ -2536,7 +3192,7 @@ func (w *ServerInterfaceWrapper) ScheduleServiceDeleteSingleSchedule2(ctx echo.C
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ScheduleServiceDeleteSingleSchedule2Params
+	var params OuServiceDeleteOu2Params
 	// ------------- Required query parameter "projectName" -------------
 
 	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
@@ -2545,12 +3201,12 @@ func (w *ServerInterfaceWrapper) ScheduleServiceDeleteSingleSchedule2(ctx echo.C
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ScheduleServiceDeleteSingleSchedule2(ctx, resourceId, params)
+	err = w.Handler.OuServiceDeleteOu2(ctx, resourceId, params)
 	return err
 }
 
-// ScheduleServiceGetSingleSchedule2 converts echo context to params.
-func (w *ServerInterfaceWrapper) ScheduleServiceGetSingleSchedule2(ctx echo.Context) error {
+// OuServiceGetOu2 converts echo context to params.
+func (w *ServerInterfaceWrapper) OuServiceGetOu2(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "resourceId" -------------
 	var resourceId string
@@ -2561,7 +3217,7 @@ func (w *ServerInterfaceWrapper) ScheduleServiceGetSingleSchedule2(ctx echo.Cont
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ScheduleServiceGetSingleSchedule2Params
+	var params OuServiceGetOu2Params
 	// ------------- Required query parameter "projectName" -------------
 
 	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
@@ -2570,12 +3226,12 @@ func (w *ServerInterfaceWrapper) ScheduleServiceGetSingleSchedule2(ctx echo.Cont
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ScheduleServiceGetSingleSchedule2(ctx, resourceId, params)
+	err = w.Handler.OuServiceGetOu2(ctx, resourceId, params)
 	return err
 }
 
-// ScheduleServicePatchSingleSchedule2 converts echo context to params.
-func (w *ServerInterfaceWrapper) ScheduleServicePatchSingleSchedule2(ctx echo.Context) error {
+// OuServicePatchOu2 converts echo context to params.
+func (w *ServerInterfaceWrapper) OuServicePatchOu2(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "resourceId" -------------
 	var resourceId string
@@ -2586,7 +3242,7 @@ func (w *ServerInterfaceWrapper) ScheduleServicePatchSingleSchedule2(ctx echo.Co
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ScheduleServicePatchSingleSchedule2Params
+	var params OuServicePatchOu2Params
 	// ------------- Optional query parameter "fieldMask" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "fieldMask", ctx.QueryParams(), &params.FieldMask)
@@ -2602,12 +3258,12 @@ func (w *ServerInterfaceWrapper) ScheduleServicePatchSingleSchedule2(ctx echo.Co
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ScheduleServicePatchSingleSchedule2(ctx, resourceId, params)
+	err = w.Handler.OuServicePatchOu2(ctx, resourceId, params)
 	return err
 }
 
-// ScheduleServiceUpdateSingleSchedule2 converts echo context to params.
-func (w *ServerInterfaceWrapper) ScheduleServiceUpdateSingleSchedule2(ctx echo.Context) error {
+// OuServiceUpdateOu2 converts echo context to params.
+func (w *ServerInterfaceWrapper) OuServiceUpdateOu2(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "resourceId" -------------
 	var resourceId string
@@ -2618,7 +3274,7 @@ func (w *ServerInterfaceWrapper) ScheduleServiceUpdateSingleSchedule2(ctx echo.C
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ScheduleServiceUpdateSingleSchedule2Params
+	var params OuServiceUpdateOu2Params
 	// ------------- Required query parameter "projectName" -------------
 
 	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
@@ -2627,16 +3283,16 @@ func (w *ServerInterfaceWrapper) ScheduleServiceUpdateSingleSchedule2(ctx echo.C
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ScheduleServiceUpdateSingleSchedule2(ctx, resourceId, params)
+	err = w.Handler.OuServiceUpdateOu2(ctx, resourceId, params)
 	return err
 }
 
-// SiteServiceListSites2 converts echo context to params.
-func (w *ServerInterfaceWrapper) SiteServiceListSites2(ctx echo.Context) error {
+// ProviderServiceListProviders2 converts echo context to params.
+func (w *ServerInterfaceWrapper) ProviderServiceListProviders2(ctx echo.Context) error {
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params SiteServiceListSites2Params
+	var params ProviderServiceListProviders2Params
 	// ------------- Optional query parameter "orderBy" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "orderBy", ctx.QueryParams(), &params.OrderBy)
@@ -2672,24 +3328,17 @@ func (w *ServerInterfaceWrapper) SiteServiceListSites2(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
 	}
 
-	// ------------- Optional query parameter "regionId" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "regionId", ctx.QueryParams(), &params.RegionId)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter regionId: %s", err))
-	}
-
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.SiteServiceListSites2(ctx, params)
+	err = w.Handler.ProviderServiceListProviders2(ctx, params)
 	return err
 }
 
-// SiteServiceCreateSite2 converts echo context to params.
-func (w *ServerInterfaceWrapper) SiteServiceCreateSite2(ctx echo.Context) error {
+// ProviderServiceCreateProvider2 converts echo context to params.
+func (w *ServerInterfaceWrapper) ProviderServiceCreateProvider2(ctx echo.Context) error {
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params SiteServiceCreateSite2Params
+	var params ProviderServiceCreateProvider2Params
 	// ------------- Required query parameter "projectName" -------------
 
 	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
@@ -2697,20 +3346,13 @@ func (w *ServerInterfaceWrapper) SiteServiceCreateSite2(ctx echo.Context) error
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
 	}
 
-	// ------------- Optional query parameter "regionId" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "regionId", ctx.QueryParams(), &params.RegionId)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter regionId: %s", err))
-	}
-
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.SiteServiceCreateSite2(ctx, params)
+	err = w.Handler.ProviderServiceCreateProvider2(ctx, params)
 	return err
 }
 
-// SiteServiceDeleteSite2 converts echo context to params.
-func (w *ServerInterfaceWrapper) SiteServiceDeleteSite2(ctx echo.Context) error {
+// ProviderServiceDeleteProvider2 converts echo context to params.
+func (w *ServerInterfaceWrapper) ProviderServiceDeleteProvider2(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "resourceId" -------------
 	var resourceId string
@@ -2721,7 +3363,7 @@ func (w *ServerInterfaceWrapper) SiteServiceDeleteSite2(ctx echo.Context) error
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params SiteServiceDeleteSite2Params
+	var params ProviderServiceDeleteProvider2Params
 	// ------------- Required query parameter "projectName" -------------
 
 	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
@@ -2729,20 +3371,13 @@ func (w *ServerInterfaceWrapper) SiteServiceDeleteSite2(ctx echo.Context) error
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
 	}
 
-	// ------------- Optional query parameter "regionId" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "regionId", ctx.QueryParams(), &params.RegionId)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter regionId: %s", err))
-	}
-
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.SiteServiceDeleteSite2(ctx, resourceId, params)
+	err = w.Handler.ProviderServiceDeleteProvider2(ctx, resourceId, params)
 	return err
 }
 
-// SiteServiceGetSite2 converts echo context to params.
-func (w *ServerInterfaceWrapper) SiteServiceGetSite2(ctx echo.Context) error {
+// ProviderServiceGetProvider2 converts echo context to params.
+func (w *ServerInterfaceWrapper) ProviderServiceGetProvider2(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "resourceId" -------------
 	var resourceId string
@@ -2753,7 +3388,7 @@ func (w *ServerInterfaceWrapper) SiteServiceGetSite2(ctx echo.Context) error {
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params SiteServiceGetSite2Params
+	var params ProviderServiceGetProvider2Params
 	// ------------- Required query parameter "projectName" -------------
 
 	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
@@ -2761,20 +3396,13 @@ func (w *ServerInterfaceWrapper) SiteServiceGetSite2(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
 	}
 
-	// ------------- Optional query parameter "regionId" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "regionId", ctx.QueryParams(), &params.RegionId)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter regionId: %s", err))
-	}
-
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.SiteServiceGetSite2(ctx, resourceId, params)
+	err = w.Handler.ProviderServiceGetProvider2(ctx, resourceId, params)
 	return err
 }
 
-// SiteServicePatchSite2 converts echo context to params.
-func (w *ServerInterfaceWrapper) SiteServicePatchSite2(ctx echo.Context) error {
+// ProviderServicePatchProvider2 converts echo context to params.
+func (w *ServerInterfaceWrapper) ProviderServicePatchProvider2(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "resourceId" -------------
 	var resourceId string
@@ -2785,7 +3413,7 @@ func (w *ServerInterfaceWrapper) SiteServicePatchSite2(ctx echo.Context) error {
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params SiteServicePatchSite2Params
+	var params ProviderServicePatchProvider2Params
 	// ------------- Optional query parameter "fieldMask" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "fieldMask", ctx.QueryParams(), &params.FieldMask)
@@ -2793,13 +3421,6 @@ func (w *ServerInterfaceWrapper) SiteServicePatchSite2(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fieldMask: %s", err))
 	}
 
-	// ------------- Optional query parameter "regionId" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "regionId", ctx.QueryParams(), &params.RegionId)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter regionId: %s", err))
-	}
-
 	// ------------- Required query parameter "projectName" -------------
 
 	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
@@ -2808,12 +3429,12 @@ func (w *ServerInterfaceWrapper) SiteServicePatchSite2(ctx echo.Context) error {
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.SiteServicePatchSite2(ctx, resourceId, params)
+	err = w.Handler.ProviderServicePatchProvider2(ctx, resourceId, params)
 	return err
 }
 
-// SiteServiceUpdateSite2 converts echo context to params.
-func (w *ServerInterfaceWrapper) SiteServiceUpdateSite2(ctx echo.Context) error {
+// ProviderServiceUpdateProvider2 converts echo context to params.
+func (w *ServerInterfaceWrapper) ProviderServiceUpdateProvider2(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "resourceId" -------------
 	var resourceId string
@@ -2824,7 +3445,7 @@ func (w *ServerInterfaceWrapper) SiteServiceUpdateSite2(ctx echo.Context) error
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params SiteServiceUpdateSite2Params
+	var params ProviderServiceUpdateProvider2Params
 	// ------------- Required query parameter "projectName" -------------
 
 	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
@@ -2832,24 +3453,31 @@ func (w *ServerInterfaceWrapper) SiteServiceUpdateSite2(ctx echo.Context) error
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
 	}
 
-	// ------------- Optional query parameter "regionId" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "regionId", ctx.QueryParams(), &params.RegionId)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter regionId: %s", err))
-	}
-
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.SiteServiceUpdateSite2(ctx, resourceId, params)
+	err = w.Handler.ProviderServiceUpdateProvider2(ctx, resourceId, params)
 	return err
 }
 
-// TelemetryLogsGroupServiceListTelemetryLogsGroups2 converts echo context to params.
-func (w *ServerInterfaceWrapper) TelemetryLogsGroupServiceListTelemetryLogsGroups2(ctx echo.Context) error {
+// RegionServiceListRegions2 converts echo context to params.
+func (w *ServerInterfaceWrapper) RegionServiceListRegions2(ctx echo.Context) error {
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params TelemetryLogsGroupServiceListTelemetryLogsGroups2Params
+	var params RegionServiceListRegions2Params
+	// ------------- Optional query parameter "orderBy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "orderBy", ctx.QueryParams(), &params.OrderBy)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderBy: %s", err))
+	}
+
+	// ------------- Optional query parameter "filter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filter", ctx.QueryParams(), &params.Filter)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter filter: %s", err))
+	}
+
 	// ------------- Optional query parameter "pageSize" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "pageSize", ctx.QueryParams(), &params.PageSize)
@@ -2864,11 +3492,11 @@ func (w *ServerInterfaceWrapper) TelemetryLogsGroupServiceListTelemetryLogsGroup
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
 	}
 
-	// ------------- Optional query parameter "orderBy" -------------
+	// ------------- Optional query parameter "showTotalSites" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "orderBy", ctx.QueryParams(), &params.OrderBy)
+	err = runtime.BindQueryParameter("form", true, false, "showTotalSites", ctx.QueryParams(), &params.ShowTotalSites)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderBy: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter showTotalSites: %s", err))
 	}
 
 	// ------------- Required query parameter "projectName" -------------
@@ -2879,16 +3507,16 @@ func (w *ServerInterfaceWrapper) TelemetryLogsGroupServiceListTelemetryLogsGroup
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.TelemetryLogsGroupServiceListTelemetryLogsGroups2(ctx, params)
+	err = w.Handler.RegionServiceListRegions2(ctx, params)
 	return err
 }
 
-// TelemetryLogsGroupServiceCreateTelemetryLogsGroup2 converts echo context to params.
-func (w *ServerInterfaceWrapper) TelemetryLogsGroupServiceCreateTelemetryLogsGroup2(ctx echo.Context) error {
+// RegionServiceCreateRegion2 converts echo context to params.
+func (w *ServerInterfaceWrapper) RegionServiceCreateRegion2(ctx echo.Context) error {
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params TelemetryLogsGroupServiceCreateTelemetryLogsGroup2Params
+	var params RegionServiceCreateRegion2Params
 	// ------------- Required query parameter "projectName" -------------
 
 	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
@@ -2897,12 +3525,12 @@ func (w *ServerInterfaceWrapper) TelemetryLogsGroupServiceCreateTelemetryLogsGro
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.TelemetryLogsGroupServiceCreateTelemetryLogsGroup2(ctx, params)
+	err = w.Handler.RegionServiceCreateRegion2(ctx, params)
 	return err
 }
 
-// TelemetryLogsGroupServiceDeleteTelemetryLogsGroup2 converts echo context to params.
-func (w *ServerInterfaceWrapper) TelemetryLogsGroupServiceDeleteTelemetryLogsGroup2(ctx echo.Context) error {
+// RegionServiceDeleteRegion2 converts echo context to params.
+func (w *ServerInterfaceWrapper) RegionServiceDeleteRegion2(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "resourceId" -------------
 	var resourceId string
@@ -2913,7 +3541,7 @@ func (w *ServerInterfaceWrapper) TelemetryLogsGroupServiceDeleteTelemetryLogsGro
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params TelemetryLogsGroupServiceDeleteTelemetryLogsGroup2Params
+	var params RegionServiceDeleteRegion2Params
 	// ------------- Required query parameter "projectName" -------------
 
 	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
@@ -2922,12 +3550,12 @@ func (w *ServerInterfaceWrapper) TelemetryLogsGroupServiceDeleteTelemetryLogsGro
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.TelemetryLogsGroupServiceDeleteTelemetryLogsGroup2(ctx, resourceId, params)
+	err = w.Handler.RegionServiceDeleteRegion2(ctx, resourceId, params)
 	return err
 }
 
-// TelemetryLogsGroupServiceGetTelemetryLogsGroup2 converts echo context to params.
-func (w *ServerInterfaceWrapper) TelemetryLogsGroupServiceGetTelemetryLogsGroup2(ctx echo.Context) error {
+// RegionServiceGetRegion2 converts echo context to params.
+func (w *ServerInterfaceWrapper) RegionServiceGetRegion2(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "resourceId" -------------
 	var resourceId string
@@ -2938,64 +3566,7 @@ func (w *ServerInterfaceWrapper) TelemetryLogsGroupServiceGetTelemetryLogsGroup2
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params TelemetryLogsGroupServiceGetTelemetryLogsGroup2Params
-	// ------------- Required query parameter "projectName" -------------
-
-	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
-	}
-
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.TelemetryLogsGroupServiceGetTelemetryLogsGroup2(ctx, resourceId, params)
-	return err
-}
-
-// TelemetryMetricsGroupServiceListTelemetryMetricsGroups2 converts echo context to params.
-func (w *ServerInterfaceWrapper) TelemetryMetricsGroupServiceListTelemetryMetricsGroups2(ctx echo.Context) error {
-	var err error
-
-	// Parameter object where we will unmarshal all parameters from the context
-	var params TelemetryMetricsGroupServiceListTelemetryMetricsGroups2Params
-	// ------------- Optional query parameter "pageSize" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "pageSize", ctx.QueryParams(), &params.PageSize)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pageSize: %s", err))
-	}
-
-	// ------------- Optional query parameter "offset" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
-	}
-
-	// ------------- Optional query parameter "orderBy" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "orderBy", ctx.QueryParams(), &params.OrderBy)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderBy: %s", err))
-	}
-
-	// ------------- Required query parameter "projectName" -------------
-
-	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
-	}
-
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.TelemetryMetricsGroupServiceListTelemetryMetricsGroups2(ctx, params)
-	return err
-}
-
-// TelemetryMetricsGroupServiceCreateTelemetryMetricsGroup2 converts echo context to params.
-func (w *ServerInterfaceWrapper) TelemetryMetricsGroupServiceCreateTelemetryMetricsGroup2(ctx echo.Context) error {
-	var err error
-
-	// Parameter object where we will unmarshal all parameters from the context
-	var params TelemetryMetricsGroupServiceCreateTelemetryMetricsGroup2Params
+	var params RegionServiceGetRegion2Params
 	// ------------- Required query parameter "projectName" -------------
 
 	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
@@ -3004,12 +3575,12 @@ func (w *ServerInterfaceWrapper) TelemetryMetricsGroupServiceCreateTelemetryMetr
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.TelemetryMetricsGroupServiceCreateTelemetryMetricsGroup2(ctx, params)
+	err = w.Handler.RegionServiceGetRegion2(ctx, resourceId, params)
 	return err
 }
 
-// TelemetryMetricsGroupServiceDeleteTelemetryMetricsGroup2 converts echo context to params.
-func (w *ServerInterfaceWrapper) TelemetryMetricsGroupServiceDeleteTelemetryMetricsGroup2(ctx echo.Context) error {
+// RegionServicePatchRegion2 converts echo context to params.
+func (w *ServerInterfaceWrapper) RegionServicePatchRegion2(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "resourceId" -------------
 	var resourceId string
@@ -3020,7 +3591,14 @@ func (w *ServerInterfaceWrapper) TelemetryMetricsGroupServiceDeleteTelemetryMetr
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params TelemetryMetricsGroupServiceDeleteTelemetryMetricsGroup2Params
+	var params RegionServicePatchRegion2Params
+	// ------------- Optional query parameter "fieldMask" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fieldMask", ctx.QueryParams(), &params.FieldMask)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fieldMask: %s", err))
+	}
+
 	// ------------- Required query parameter "projectName" -------------
 
 	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
@@ -3029,12 +3607,12 @@ func (w *ServerInterfaceWrapper) TelemetryMetricsGroupServiceDeleteTelemetryMetr
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.TelemetryMetricsGroupServiceDeleteTelemetryMetricsGroup2(ctx, resourceId, params)
+	err = w.Handler.RegionServicePatchRegion2(ctx, resourceId, params)
 	return err
 }
 
-// TelemetryMetricsGroupServiceGetTelemetryMetricsGroup2 converts echo context to params.
-func (w *ServerInterfaceWrapper) TelemetryMetricsGroupServiceGetTelemetryMetricsGroup2(ctx echo.Context) error {
+// RegionServiceUpdateRegion2 converts echo context to params.
+func (w *ServerInterfaceWrapper) RegionServiceUpdateRegion2(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "resourceId" -------------
 	var resourceId string
@@ -3045,7 +3623,7 @@ func (w *ServerInterfaceWrapper) TelemetryMetricsGroupServiceGetTelemetryMetrics
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params TelemetryMetricsGroupServiceGetTelemetryMetricsGroup2Params
+	var params RegionServiceUpdateRegion2Params
 	// ------------- Required query parameter "projectName" -------------
 
 	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
@@ -3054,30 +3632,16 @@ func (w *ServerInterfaceWrapper) TelemetryMetricsGroupServiceGetTelemetryMetrics
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.TelemetryMetricsGroupServiceGetTelemetryMetricsGroup2(ctx, resourceId, params)
+	err = w.Handler.RegionServiceUpdateRegion2(ctx, resourceId, params)
 	return err
 }
 
-// TelemetryLogsProfileServiceListTelemetryLogsProfiles2 converts echo context to params.
-func (w *ServerInterfaceWrapper) TelemetryLogsProfileServiceListTelemetryLogsProfiles2(ctx echo.Context) error {
+// RemoteAccessServiceListRemoteAccesses2 converts echo context to params.
+func (w *ServerInterfaceWrapper) RemoteAccessServiceListRemoteAccesses2(ctx echo.Context) error {
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params TelemetryLogsProfileServiceListTelemetryLogsProfiles2Params
-	// ------------- Optional query parameter "pageSize" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "pageSize", ctx.QueryParams(), &params.PageSize)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pageSize: %s", err))
-	}
-
-	// ------------- Optional query parameter "offset" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
-	}
-
+	var params RemoteAccessServiceListRemoteAccesses2Params
 	// ------------- Optional query parameter "orderBy" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "orderBy", ctx.QueryParams(), &params.OrderBy)
@@ -3085,32 +3649,25 @@ func (w *ServerInterfaceWrapper) TelemetryLogsProfileServiceListTelemetryLogsPro
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderBy: %s", err))
 	}
 
-	// ------------- Optional query parameter "instanceId" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "instanceId", ctx.QueryParams(), &params.InstanceId)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter instanceId: %s", err))
-	}
-
-	// ------------- Optional query parameter "siteId" -------------
+	// ------------- Optional query parameter "filter" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "siteId", ctx.QueryParams(), &params.SiteId)
+	err = runtime.BindQueryParameter("form", true, false, "filter", ctx.QueryParams(), &params.Filter)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter siteId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter filter: %s", err))
 	}
 
-	// ------------- Optional query parameter "regionId" -------------
+	// ------------- Optional query parameter "pageSize" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "regionId", ctx.QueryParams(), &params.RegionId)
+	err = runtime.BindQueryParameter("form", true, false, "pageSize", ctx.QueryParams(), &params.PageSize)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter regionId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pageSize: %s", err))
 	}
 
-	// ------------- Optional query parameter "showInherited" -------------
+	// ------------- Optional query parameter "offset" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "showInherited", ctx.QueryParams(), &params.ShowInherited)
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter showInherited: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
 	}
 
 	// ------------- Required query parameter "projectName" -------------
@@ -3121,16 +3678,16 @@ func (w *ServerInterfaceWrapper) TelemetryLogsProfileServiceListTelemetryLogsPro
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.TelemetryLogsProfileServiceListTelemetryLogsProfiles2(ctx, params)
+	err = w.Handler.RemoteAccessServiceListRemoteAccesses2(ctx, params)
 	return err
 }
 
-// TelemetryLogsProfileServiceCreateTelemetryLogsProfile2 converts echo context to params.
-func (w *ServerInterfaceWrapper) TelemetryLogsProfileServiceCreateTelemetryLogsProfile2(ctx echo.Context) error {
+// RemoteAccessServiceCreateRemoteAccess2 converts echo context to params.
+func (w *ServerInterfaceWrapper) RemoteAccessServiceCreateRemoteAccess2(ctx echo.Context) error {
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params TelemetryLogsProfileServiceCreateTelemetryLogsProfile2Params
+	var params RemoteAccessServiceCreateRemoteAccess2Params
 	// ------------- Required query parameter "projectName" -------------
 
 	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
@@ -3139,12 +3696,12 @@ func (w *ServerInterfaceWrapper) TelemetryLogsProfileServiceCreateTelemetryLogsP
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.TelemetryLogsProfileServiceCreateTelemetryLogsProfile2(ctx, params)
+	err = w.Handler.RemoteAccessServiceCreateRemoteAccess2(ctx, params)
 	return err
 }
 
-// TelemetryLogsProfileServiceDeleteTelemetryLogsProfile2 converts echo context to params.
-func (w *ServerInterfaceWrapper) TelemetryLogsProfileServiceDeleteTelemetryLogsProfile2(ctx echo.Context) error {
+// RemoteAccessServiceDeleteRemoteAccess2 converts echo context to params.
+func (w *ServerInterfaceWrapper) RemoteAccessServiceDeleteRemoteAccess2(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "resourceId" -------------
 	var resourceId string
@@ -3155,7 +3712,7 @@ func (w *ServerInterfaceWrapper) TelemetryLogsProfileServiceDeleteTelemetryLogsP
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params TelemetryLogsProfileServiceDeleteTelemetryLogsProfile2Params
+	var params RemoteAccessServiceDeleteRemoteAccess2Params
 	// ------------- Required query parameter "projectName" -------------
 
 	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
@@ -3164,12 +3721,12 @@ func (w *ServerInterfaceWrapper) TelemetryLogsProfileServiceDeleteTelemetryLogsP
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.TelemetryLogsProfileServiceDeleteTelemetryLogsProfile2(ctx, resourceId, params)
+	err = w.Handler.RemoteAccessServiceDeleteRemoteAccess2(ctx, resourceId, params)
 	return err
 }
 
-// TelemetryLogsProfileServiceGetTelemetryLogsProfile2 converts echo context to params.
-func (w *ServerInterfaceWrapper) TelemetryLogsProfileServiceGetTelemetryLogsProfile2(ctx echo.Context) error {
+// RemoteAccessServiceGetRemoteAccess2 converts echo context to params.
+func (w *ServerInterfaceWrapper) RemoteAccessServiceGetRemoteAccess2(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "resourceId" -------------
 	var resourceId string
@@ -3180,7 +3737,7 @@ func (w *ServerInterfaceWrapper) TelemetryLogsProfileServiceGetTelemetryLogsProf
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params TelemetryLogsProfileServiceGetTelemetryLogsProfile2Params
+	var params RemoteAccessServiceGetRemoteAccess2Params
 	// ------------- Required query parameter "projectName" -------------
 
 	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
@@ -3189,12 +3746,76 @@ func (w *ServerInterfaceWrapper) TelemetryLogsProfileServiceGetTelemetryLogsProf
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.TelemetryLogsProfileServiceGetTelemetryLogsProfile2(ctx, resourceId, params)
+	err = w.Handler.RemoteAccessServiceGetRemoteAccess2(ctx, resourceId, params)
 	return err
 }
 
-// TelemetryLogsProfileServicePatchTelemetryLogsProfile2 converts echo context to params.
-func (w *ServerInterfaceWrapper) TelemetryLogsProfileServicePatchTelemetryLogsProfile2(ctx echo.Context) error {
+// RoleBindingServiceListRoleBindings2 converts echo context to params.
+func (w *ServerInterfaceWrapper) RoleBindingServiceListRoleBindings2(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RoleBindingServiceListRoleBindings2Params
+	// ------------- Optional query parameter "orderBy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "orderBy", ctx.QueryParams(), &params.OrderBy)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderBy: %s", err))
+	}
+
+	// ------------- Optional query parameter "filter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filter", ctx.QueryParams(), &params.Filter)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter filter: %s", err))
+	}
+
+	// ------------- Optional query parameter "pageSize" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pageSize", ctx.QueryParams(), &params.PageSize)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pageSize: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Required query parameter "projectName" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RoleBindingServiceListRoleBindings2(ctx, params)
+	return err
+}
+
+// RoleBindingServiceCreateRoleBinding2 converts echo context to params.
+func (w *ServerInterfaceWrapper) RoleBindingServiceCreateRoleBinding2(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RoleBindingServiceCreateRoleBinding2Params
+	// ------------- Required query parameter "projectName" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RoleBindingServiceCreateRoleBinding2(ctx, params)
+	return err
+}
+
+// RoleBindingServiceDeleteRoleBinding2 converts echo context to params.
+func (w *ServerInterfaceWrapper) RoleBindingServiceDeleteRoleBinding2(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "resourceId" -------------
 	var resourceId string
@@ -3205,14 +3826,32 @@ func (w *ServerInterfaceWrapper) TelemetryLogsProfileServicePatchTelemetryLogsPr
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params TelemetryLogsProfileServicePatchTelemetryLogsProfile2Params
-	// ------------- Optional query parameter "fieldMask" -------------
+	var params RoleBindingServiceDeleteRoleBinding2Params
+	// ------------- Required query parameter "projectName" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "fieldMask", ctx.QueryParams(), &params.FieldMask)
+	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fieldMask: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
 	}
 
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RoleBindingServiceDeleteRoleBinding2(ctx, resourceId, params)
+	return err
+}
+
+// RoleBindingServiceGetRoleBinding2 converts echo context to params.
+func (w *ServerInterfaceWrapper) RoleBindingServiceGetRoleBinding2(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "resourceId" -------------
+	var resourceId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", ctx.Param("resourceId"), &resourceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RoleBindingServiceGetRoleBinding2Params
 	// ------------- Required query parameter "projectName" -------------
 
 	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
@@ -3221,12 +3860,12 @@ func (w *ServerInterfaceWrapper) TelemetryLogsProfileServicePatchTelemetryLogsPr
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.TelemetryLogsProfileServicePatchTelemetryLogsProfile2(ctx, resourceId, params)
+	err = w.Handler.RoleBindingServiceGetRoleBinding2(ctx, resourceId, params)
 	return err
 }
 
-// TelemetryLogsProfileServiceUpdateTelemetryLogsProfile2 converts echo context to params.
-func (w *ServerInterfaceWrapper) TelemetryLogsProfileServiceUpdateTelemetryLogsProfile2(ctx echo.Context) error {
+// RoleBindingServiceUpdateRoleBinding2 converts echo context to params.
+func (w *ServerInterfaceWrapper) RoleBindingServiceUpdateRoleBinding2(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "resourceId" -------------
 	var resourceId string
@@ -3237,7 +3876,7 @@ func (w *ServerInterfaceWrapper) TelemetryLogsProfileServiceUpdateTelemetryLogsP
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params TelemetryLogsProfileServiceUpdateTelemetryLogsProfile2Params
+	var params RoleBindingServiceUpdateRoleBinding2Params
 	// ------------- Required query parameter "projectName" -------------
 
 	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
@@ -3246,16 +3885,16 @@ func (w *ServerInterfaceWrapper) TelemetryLogsProfileServiceUpdateTelemetryLogsP
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.TelemetryLogsProfileServiceUpdateTelemetryLogsProfile2(ctx, resourceId, params)
+	err = w.Handler.RoleBindingServiceUpdateRoleBinding2(ctx, resourceId, params)
 	return err
 }
 
-// TelemetryMetricsProfileServiceListTelemetryMetricsProfiles2 converts echo context to params.
-func (w *ServerInterfaceWrapper) TelemetryMetricsProfileServiceListTelemetryMetricsProfiles2(ctx echo.Context) error {
+// ScheduleServiceListSchedules2 converts echo context to params.
+func (w *ServerInterfaceWrapper) ScheduleServiceListSchedules2(ctx echo.Context) error {
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params TelemetryMetricsProfileServiceListTelemetryMetricsProfiles2Params
+	var params ScheduleServiceListSchedules2Params
 	// ------------- Optional query parameter "pageSize" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "pageSize", ctx.QueryParams(), &params.PageSize)
@@ -3270,18 +3909,71 @@ func (w *ServerInterfaceWrapper) TelemetryMetricsProfileServiceListTelemetryMetr
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
 	}
 
-	// ------------- Optional query parameter "orderBy" -------------
+	// ------------- Optional query parameter "hostId" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "orderBy", ctx.QueryParams(), &params.OrderBy)
+	err = runtime.BindQueryParameter("form", true, false, "hostId", ctx.QueryParams(), &params.HostId)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderBy: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter hostId: %s", err))
 	}
 
-	// ------------- Optional query parameter "instanceId" -------------
+	// ------------- Optional query parameter "siteId" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "instanceId", ctx.QueryParams(), &params.InstanceId)
+	err = runtime.BindQueryParameter("form", true, false, "siteId", ctx.QueryParams(), &params.SiteId)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter instanceId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter siteId: %s", err))
+	}
+
+	// ------------- Optional query parameter "regionId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "regionId", ctx.QueryParams(), &params.RegionId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter regionId: %s", err))
+	}
+
+	// ------------- Optional query parameter "unixEpoch" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "unixEpoch", ctx.QueryParams(), &params.UnixEpoch)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter unixEpoch: %s", err))
+	}
+
+	// ------------- Required query parameter "projectName" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ScheduleServiceListSchedules2(ctx, params)
+	return err
+}
+
+// ScheduleServiceListRepeatedSchedules2 converts echo context to params.
+func (w *ServerInterfaceWrapper) ScheduleServiceListRepeatedSchedules2(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ScheduleServiceListRepeatedSchedules2Params
+	// ------------- Optional query parameter "pageSize" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pageSize", ctx.QueryParams(), &params.PageSize)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pageSize: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "hostId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "hostId", ctx.QueryParams(), &params.HostId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter hostId: %s", err))
 	}
 
 	// ------------- Optional query parameter "siteId" -------------
@@ -3298,11 +3990,11 @@ func (w *ServerInterfaceWrapper) TelemetryMetricsProfileServiceListTelemetryMetr
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter regionId: %s", err))
 	}
 
-	// ------------- Optional query parameter "showInherited" -------------
+	// ------------- Optional query parameter "unixEpoch" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "showInherited", ctx.QueryParams(), &params.ShowInherited)
+	err = runtime.BindQueryParameter("form", true, false, "unixEpoch", ctx.QueryParams(), &params.UnixEpoch)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter showInherited: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter unixEpoch: %s", err))
 	}
 
 	// ------------- Required query parameter "projectName" -------------
@@ -3313,16 +4005,16 @@ func (w *ServerInterfaceWrapper) TelemetryMetricsProfileServiceListTelemetryMetr
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.TelemetryMetricsProfileServiceListTelemetryMetricsProfiles2(ctx, params)
+	err = w.Handler.ScheduleServiceListRepeatedSchedules2(ctx, params)
 	return err
 }
 
-// TelemetryMetricsProfileServiceCreateTelemetryMetricsProfile2 converts echo context to params.
-func (w *ServerInterfaceWrapper) TelemetryMetricsProfileServiceCreateTelemetryMetricsProfile2(ctx echo.Context) error {
+// ScheduleServiceCreateRepeatedSchedule2 converts echo context to params.
+func (w *ServerInterfaceWrapper) ScheduleServiceCreateRepeatedSchedule2(ctx echo.Context) error {
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params TelemetryMetricsProfileServiceCreateTelemetryMetricsProfile2Params
+	var params ScheduleServiceCreateRepeatedSchedule2Params
 	// ------------- Required query parameter "projectName" -------------
 
 	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
@@ -3331,12 +4023,12 @@ func (w *ServerInterfaceWrapper) TelemetryMetricsProfileServiceCreateTelemetryMe
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.TelemetryMetricsProfileServiceCreateTelemetryMetricsProfile2(ctx, params)
+	err = w.Handler.ScheduleServiceCreateRepeatedSchedule2(ctx, params)
 	return err
 }
 
-// TelemetryMetricsProfileServiceDeleteTelemetryMetricsProfile2 converts echo context to params.
-func (w *ServerInterfaceWrapper) TelemetryMetricsProfileServiceDeleteTelemetryMetricsProfile2(ctx echo.Context) error {
+// ScheduleServiceDeleteRepeatedSchedule2 converts echo context to params.
+func (w *ServerInterfaceWrapper) ScheduleServiceDeleteRepeatedSchedule2(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "resourceId" -------------
 	var resourceId string
@@ -3347,7 +4039,7 @@ func (w *ServerInterfaceWrapper) TelemetryMetricsProfileServiceDeleteTelemetryMe
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params TelemetryMetricsProfileServiceDeleteTelemetryMetricsProfile2Params
+	var params ScheduleServiceDeleteRepeatedSchedule2Params
 	// ------------- Required query parameter "projectName" -------------
 
 	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
@@ -3356,12 +4048,12 @@ func (w *ServerInterfaceWrapper) TelemetryMetricsProfileServiceDeleteTelemetryMe
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.TelemetryMetricsProfileServiceDeleteTelemetryMetricsProfile2(ctx, resourceId, params)
+	err = w.Handler.ScheduleServiceDeleteRepeatedSchedule2(ctx, resourceId, params)
 	return err
 }
 
-// TelemetryMetricsProfileServiceGetTelemetryMetricsProfile2 converts echo context to params.
-func (w *ServerInterfaceWrapper) TelemetryMetricsProfileServiceGetTelemetryMetricsProfile2(ctx echo.Context) error {
+// ScheduleServiceGetRepeatedSchedule2 converts echo context to params.
+func (w *ServerInterfaceWrapper) ScheduleServiceGetRepeatedSchedule2(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "resourceId" -------------
 	var resourceId string
@@ -3372,7 +4064,7 @@ func (w *ServerInterfaceWrapper) TelemetryMetricsProfileServiceGetTelemetryMetri
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params TelemetryMetricsProfileServiceGetTelemetryMetricsProfile2Params
+	var params ScheduleServiceGetRepeatedSchedule2Params
 	// ------------- Required query parameter "projectName" -------------
 
 	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
@@ -3381,12 +4073,12 @@ func (w *ServerInterfaceWrapper) TelemetryMetricsProfileServiceGetTelemetryMetri
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.TelemetryMetricsProfileServiceGetTelemetryMetricsProfile2(ctx, resourceId, params)
+	err = w.Handler.ScheduleServiceGetRepeatedSchedule2(ctx, resourceId, params)
 	return err
 }
 
-// TelemetryMetricsProfileServicePatchTelemetryMetricsProfile2 converts echo context to params.
-func (w *ServerInterfaceWrapper) TelemetryMetricsProfileServicePatchTelemetryMetricsProfile2(ctx echo.Context) error {
+// ScheduleServicePatchRepeatedSchedule2 converts echo context to params.
+func (w *ServerInterfaceWrapper) ScheduleServicePatchRepeatedSchedule2(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "resourceId" -------------
 	var resourceId string
@@ -3397,7 +4089,7 @@ func (w *ServerInterfaceWrapper) TelemetryMetricsProfileServicePatchTelemetryMet
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params TelemetryMetricsProfileServicePatchTelemetryMetricsProfile2Params
+	var params ScheduleServicePatchRepeatedSchedule2Params
 	// ------------- Optional query parameter "fieldMask" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "fieldMask", ctx.QueryParams(), &params.FieldMask)
@@ -3413,12 +4105,12 @@ func (w *ServerInterfaceWrapper) TelemetryMetricsProfileServicePatchTelemetryMet
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.TelemetryMetricsProfileServicePatchTelemetryMetricsProfile2(ctx, resourceId, params)
+	err = w.Handler.ScheduleServicePatchRepeatedSchedule2(ctx, resourceId, params)
 	return err
 }
 
-// TelemetryMetricsProfileServiceUpdateTelemetryMetricsProfile2 converts echo context to params.
-func (w *ServerInterfaceWrapper) TelemetryMetricsProfileServiceUpdateTelemetryMetricsProfile2(ctx echo.Context) error {
+// ScheduleServiceUpdateRepeatedSchedule2 converts echo context to params.
+func (w *ServerInterfaceWrapper) ScheduleServiceUpdateRepeatedSchedule2(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "resourceId" -------------
 	var resourceId string
@@ -3429,7 +4121,7 @@ func (w *ServerInterfaceWrapper) TelemetryMetricsProfileServiceUpdateTelemetryMe
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params TelemetryMetricsProfileServiceUpdateTelemetryMetricsProfile2Params
+	var params ScheduleServiceUpdateRepeatedSchedule2Params
 	// ------------- Required query parameter "projectName" -------------
 
 	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
@@ -3438,42 +4130,56 @@ func (w *ServerInterfaceWrapper) TelemetryMetricsProfileServiceUpdateTelemetryMe
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.TelemetryMetricsProfileServiceUpdateTelemetryMetricsProfile2(ctx, resourceId, params)
+	err = w.Handler.ScheduleServiceUpdateRepeatedSchedule2(ctx, resourceId, params)
 	return err
 }
 
-// WorkloadMemberServiceListWorkloadMembers2 converts echo context to params.
-func (w *ServerInterfaceWrapper) WorkloadMemberServiceListWorkloadMembers2(ctx echo.Context) error {
+// ScheduleServiceListSingleSchedules2 converts echo context to params.
+func (w *ServerInterfaceWrapper) ScheduleServiceListSingleSchedules2(ctx echo.Context) error {
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params WorkloadMemberServiceListWorkloadMembers2Params
-	// ------------- Optional query parameter "orderBy" -------------
+	var params ScheduleServiceListSingleSchedules2Params
+	// ------------- Optional query parameter "pageSize" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "orderBy", ctx.QueryParams(), &params.OrderBy)
+	err = runtime.BindQueryParameter("form", true, false, "pageSize", ctx.QueryParams(), &params.PageSize)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderBy: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pageSize: %s", err))
 	}
 
-	// ------------- Optional query parameter "filter" -------------
+	// ------------- Optional query parameter "offset" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "filter", ctx.QueryParams(), &params.Filter)
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter filter: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
 	}
 
-	// ------------- Optional query parameter "pageSize" -------------
+	// ------------- Optional query parameter "hostId" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "pageSize", ctx.QueryParams(), &params.PageSize)
+	err = runtime.BindQueryParameter("form", true, false, "hostId", ctx.QueryParams(), &params.HostId)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pageSize: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter hostId: %s", err))
 	}
 
-	// ------------- Optional query parameter "offset" -------------
+	// ------------- Optional query parameter "siteId" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	err = runtime.BindQueryParameter("form", true, false, "siteId", ctx.QueryParams(), &params.SiteId)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter siteId: %s", err))
+	}
+
+	// ------------- Optional query parameter "regionId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "regionId", ctx.QueryParams(), &params.RegionId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter regionId: %s", err))
+	}
+
+	// ------------- Optional query parameter "unixEpoch" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "unixEpoch", ctx.QueryParams(), &params.UnixEpoch)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter unixEpoch: %s", err))
 	}
 
 	// ------------- Required query parameter "projectName" -------------
@@ -3484,16 +4190,16 @@ func (w *ServerInterfaceWrapper) WorkloadMemberServiceListWorkloadMembers2(ctx e
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.WorkloadMemberServiceListWorkloadMembers2(ctx, params)
+	err = w.Handler.ScheduleServiceListSingleSchedules2(ctx, params)
 	return err
 }
 
-// WorkloadMemberServiceCreateWorkloadMember2 converts echo context to params.
-func (w *ServerInterfaceWrapper) WorkloadMemberServiceCreateWorkloadMember2(ctx echo.Context) error {
+// ScheduleServiceCreateSingleSchedule2 converts echo context to params.
+func (w *ServerInterfaceWrapper) ScheduleServiceCreateSingleSchedule2(ctx echo.Context) error {
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params WorkloadMemberServiceCreateWorkloadMember2Params
+	var params ScheduleServiceCreateSingleSchedule2Params
 	// ------------- Required query parameter "projectName" -------------
 
 	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
@@ -3502,12 +4208,12 @@ func (w *ServerInterfaceWrapper) WorkloadMemberServiceCreateWorkloadMember2(ctx
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.WorkloadMemberServiceCreateWorkloadMember2(ctx, params)
+	err = w.Handler.ScheduleServiceCreateSingleSchedule2(ctx, params)
 	return err
 }
 
-// WorkloadMemberServiceDeleteWorkloadMember2 converts echo context to params.
-func (w *ServerInterfaceWrapper) WorkloadMemberServiceDeleteWorkloadMember2(ctx echo.Context) error {
+// ScheduleServiceDeleteSingleSchedule2 converts echo context to params.
+func (w *ServerInterfaceWrapper) ScheduleServiceDeleteSingleSchedule2(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "resourceId" -------------
 	var resourceId string
@@ -3518,7 +4224,7 @@ func (w *ServerInterfaceWrapper) WorkloadMemberServiceDeleteWorkloadMember2(ctx
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params WorkloadMemberServiceDeleteWorkloadMember2Params
+	var params ScheduleServiceDeleteSingleSchedule2Params
 	// ------------- Required query parameter "projectName" -------------
 
 	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
@@ -3527,12 +4233,12 @@ func (w *ServerInterfaceWrapper) WorkloadMemberServiceDeleteWorkloadMember2(ctx
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.WorkloadMemberServiceDeleteWorkloadMember2(ctx, resourceId, params)
+	err = w.Handler.ScheduleServiceDeleteSingleSchedule2(ctx, resourceId, params)
 	return err
 }
 
-// WorkloadMemberServiceGetWorkloadMember2 converts echo context to params.
-func (w *ServerInterfaceWrapper) WorkloadMemberServiceGetWorkloadMember2(ctx echo.Context) error {
+// ScheduleServiceGetSingleSchedule2 converts echo context to params.
+func (w *ServerInterfaceWrapper) ScheduleServiceGetSingleSchedule2(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "resourceId" -------------
 	var resourceId string
@@ -3543,7 +4249,7 @@ func (w *ServerInterfaceWrapper) WorkloadMemberServiceGetWorkloadMember2(ctx ech
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params WorkloadMemberServiceGetWorkloadMember2Params
+	var params ScheduleServiceGetSingleSchedule2Params
 	// ------------- Required query parameter "projectName" -------------
 
 	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
@@ -3552,16 +4258,73 @@ func (w *ServerInterfaceWrapper) WorkloadMemberServiceGetWorkloadMember2(ctx ech
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.WorkloadMemberServiceGetWorkloadMember2(ctx, resourceId, params)
+	err = w.Handler.ScheduleServiceGetSingleSchedule2(ctx, resourceId, params)
 	return err
 }
 
-// WorkloadServiceListWorkloads2 converts echo context to params.
-func (w *ServerInterfaceWrapper) WorkloadServiceListWorkloads2(ctx echo.Context) error {
+// ScheduleServicePatchSingleSchedule2 converts echo context to params.
+func (w *ServerInterfaceWrapper) ScheduleServicePatchSingleSchedule2(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "resourceId" -------------
+	var resourceId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", ctx.Param("resourceId"), &resourceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
+	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params WorkloadServiceListWorkloads2Params
+	var params ScheduleServicePatchSingleSchedule2Params
+	// ------------- Optional query parameter "fieldMask" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fieldMask", ctx.QueryParams(), &params.FieldMask)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fieldMask: %s", err))
+	}
+
+	// ------------- Required query parameter "projectName" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ScheduleServicePatchSingleSchedule2(ctx, resourceId, params)
+	return err
+}
+
+// ScheduleServiceUpdateSingleSchedule2 converts echo context to params.
+func (w *ServerInterfaceWrapper) ScheduleServiceUpdateSingleSchedule2(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "resourceId" -------------
+	var resourceId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", ctx.Param("resourceId"), &resourceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ScheduleServiceUpdateSingleSchedule2Params
+	// ------------- Required query parameter "projectName" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ScheduleServiceUpdateSingleSchedule2(ctx, resourceId, params)
+	return err
+}
+
+// SiteServiceListSites2 converts echo context to params.
+func (w *ServerInterfaceWrapper) SiteServiceListSites2(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SiteServiceListSites2Params
 	// ------------- Optional query parameter "orderBy" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "orderBy", ctx.QueryParams(), &params.OrderBy)
@@ -3597,17 +4360,38 @@ func (w *ServerInterfaceWrapper) WorkloadServiceListWorkloads2(ctx echo.Context)
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
 	}
 
+	// ------------- Optional query parameter "regionId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "regionId", ctx.QueryParams(), &params.RegionId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter regionId: %s", err))
+	}
+
+	// ------------- Optional query parameter "view" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "view", ctx.QueryParams(), &params.View)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter view: %s", err))
+	}
+
+	// ------------- Optional query parameter "fields" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fields", ctx.QueryParams(), &params.Fields)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fields: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.WorkloadServiceListWorkloads2(ctx, params)
+	err = w.Handler.SiteServiceListSites2(ctx, params)
 	return err
 }
 
-// WorkloadServiceCreateWorkload2 converts echo context to params.
-func (w *ServerInterfaceWrapper) WorkloadServiceCreateWorkload2(ctx echo.Context) error {
+// SiteServiceCreateSite2 converts echo context to params.
+func (w *ServerInterfaceWrapper) SiteServiceCreateSite2(ctx echo.Context) error {
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params WorkloadServiceCreateWorkload2Params
+	var params SiteServiceCreateSite2Params
 	// ------------- Required query parameter "projectName" -------------
 
 	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
@@ -3615,13 +4399,20 @@ func (w *ServerInterfaceWrapper) WorkloadServiceCreateWorkload2(ctx echo.Context
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
 	}
 
+	// ------------- Optional query parameter "regionId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "regionId", ctx.QueryParams(), &params.RegionId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter regionId: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.WorkloadServiceCreateWorkload2(ctx, params)
+	err = w.Handler.SiteServiceCreateSite2(ctx, params)
 	return err
 }
 
-// WorkloadServiceDeleteWorkload2 converts echo context to params.
-func (w *ServerInterfaceWrapper) WorkloadServiceDeleteWorkload2(ctx echo.Context) error {
+// SiteServiceDeleteSite2 converts echo context to params.
+func (w *ServerInterfaceWrapper) SiteServiceDeleteSite2(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "resourceId" -------------
 	var resourceId string
@@ -3632,7 +4423,7 @@ func (w *ServerInterfaceWrapper) WorkloadServiceDeleteWorkload2(ctx echo.Context
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params WorkloadServiceDeleteWorkload2Params
+	var params SiteServiceDeleteSite2Params
 	// ------------- Required query parameter "projectName" -------------
 
 	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
@@ -3640,13 +4431,20 @@ func (w *ServerInterfaceWrapper) WorkloadServiceDeleteWorkload2(ctx echo.Context
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
 	}
 
+	// ------------- Optional query parameter "regionId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "regionId", ctx.QueryParams(), &params.RegionId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter regionId: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.WorkloadServiceDeleteWorkload2(ctx, resourceId, params)
+	err = w.Handler.SiteServiceDeleteSite2(ctx, resourceId, params)
 	return err
 }
 
-// WorkloadServiceGetWorkload2 converts echo context to params.
-func (w *ServerInterfaceWrapper) WorkloadServiceGetWorkload2(ctx echo.Context) error {
+// SiteServiceGetSite2 converts echo context to params.
+func (w *ServerInterfaceWrapper) SiteServiceGetSite2(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "resourceId" -------------
 	var resourceId string
@@ -3657,7 +4455,7 @@ func (w *ServerInterfaceWrapper) WorkloadServiceGetWorkload2(ctx echo.Context) e
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params WorkloadServiceGetWorkload2Params
+	var params SiteServiceGetSite2Params
 	// ------------- Required query parameter "projectName" -------------
 
 	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
@@ -3665,13 +4463,34 @@ func (w *ServerInterfaceWrapper) WorkloadServiceGetWorkload2(ctx echo.Context) e
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
 	}
 
+	// ------------- Optional query parameter "regionId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "regionId", ctx.QueryParams(), &params.RegionId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter regionId: %s", err))
+	}
+
+	// ------------- Optional query parameter "view" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "view", ctx.QueryParams(), &params.View)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter view: %s", err))
+	}
+
+	// ------------- Optional query parameter "fields" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fields", ctx.QueryParams(), &params.Fields)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fields: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.WorkloadServiceGetWorkload2(ctx, resourceId, params)
+	err = w.Handler.SiteServiceGetSite2(ctx, resourceId, params)
 	return err
 }
 
-// WorkloadServicePatchWorkload2 converts echo context to params.
-func (w *ServerInterfaceWrapper) WorkloadServicePatchWorkload2(ctx echo.Context) error {
+// SiteServicePatchSite2 converts echo context to params.
+func (w *ServerInterfaceWrapper) SiteServicePatchSite2(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "resourceId" -------------
 	var resourceId string
@@ -3682,7 +4501,7 @@ func (w *ServerInterfaceWrapper) WorkloadServicePatchWorkload2(ctx echo.Context)
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params WorkloadServicePatchWorkload2Params
+	var params SiteServicePatchSite2Params
 	// ------------- Optional query parameter "fieldMask" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "fieldMask", ctx.QueryParams(), &params.FieldMask)
@@ -3690,6 +4509,13 @@ func (w *ServerInterfaceWrapper) WorkloadServicePatchWorkload2(ctx echo.Context)
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fieldMask: %s", err))
 	}
 
+	// ------------- Optional query parameter "regionId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "regionId", ctx.QueryParams(), &params.RegionId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter regionId: %s", err))
+	}
+
 	// ------------- Required query parameter "projectName" -------------
 
 	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
@@ -3698,12 +4524,12 @@ func (w *ServerInterfaceWrapper) WorkloadServicePatchWorkload2(ctx echo.Context)
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.WorkloadServicePatchWorkload2(ctx, resourceId, params)
+	err = w.Handler.SiteServicePatchSite2(ctx, resourceId, params)
 	return err
 }
 
-// WorkloadServiceUpdateWorkload2 converts echo context to params.
-func (w *ServerInterfaceWrapper) WorkloadServiceUpdateWorkload2(ctx echo.Context) error {
+// SiteServiceUpdateSite2 converts echo context to params.
+func (w *ServerInterfaceWrapper) SiteServiceUpdateSite2(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "resourceId" -------------
 	var resourceId string
@@ -3714,7 +4540,7 @@ func (w *ServerInterfaceWrapper) WorkloadServiceUpdateWorkload2(ctx echo.Context
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params WorkloadServiceUpdateWorkload2Params
+	var params SiteServiceUpdateSite2Params
 	// ------------- Required query parameter "projectName" -------------
 
 	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
@@ -3722,24 +4548,38 @@ func (w *ServerInterfaceWrapper) WorkloadServiceUpdateWorkload2(ctx echo.Context
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
 	}
 
+	// ------------- Optional query parameter "regionId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "regionId", ctx.QueryParams(), &params.RegionId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter regionId: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.WorkloadServiceUpdateWorkload2(ctx, resourceId, params)
+	err = w.Handler.SiteServiceUpdateSite2(ctx, resourceId, params)
 	return err
 }
 
-// HostServiceListHosts converts echo context to params.
-func (w *ServerInterfaceWrapper) HostServiceListHosts(ctx echo.Context) error {
+// TelemetryLogsGroupServiceListTelemetryLogsGroups2 converts echo context to params.
+func (w *ServerInterfaceWrapper) TelemetryLogsGroupServiceListTelemetryLogsGroups2(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "projectName" -------------
-	var projectName string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "projectName", ctx.Param("projectName"), &projectName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	// Parameter object where we will unmarshal all parameters from the context
+	var params TelemetryLogsGroupServiceListTelemetryLogsGroups2Params
+	// ------------- Optional query parameter "pageSize" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pageSize", ctx.QueryParams(), &params.PageSize)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pageSize: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params HostServiceListHostsParams
 	// ------------- Optional query parameter "orderBy" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "orderBy", ctx.QueryParams(), &params.OrderBy)
@@ -3747,50 +4587,2523 @@ func (w *ServerInterfaceWrapper) HostServiceListHosts(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderBy: %s", err))
 	}
 
-	// ------------- Optional query parameter "filter" -------------
+	// ------------- Required query parameter "projectName" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "filter", ctx.QueryParams(), &params.Filter)
+	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter filter: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
 	}
 
-	// ------------- Optional query parameter "pageSize" -------------
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TelemetryLogsGroupServiceListTelemetryLogsGroups2(ctx, params)
+	return err
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "pageSize", ctx.QueryParams(), &params.PageSize)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pageSize: %s", err))
-	}
+// TelemetryLogsGroupServiceCreateTelemetryLogsGroup2 converts echo context to params.
+func (w *ServerInterfaceWrapper) TelemetryLogsGroupServiceCreateTelemetryLogsGroup2(ctx echo.Context) error {
+	var err error
 
-	// ------------- Optional query parameter "offset" -------------
+	// Parameter object where we will unmarshal all parameters from the context
+	var params TelemetryLogsGroupServiceCreateTelemetryLogsGroup2Params
+	// ------------- Required query parameter "projectName" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.HostServiceListHosts(ctx, projectName, params)
+	err = w.Handler.TelemetryLogsGroupServiceCreateTelemetryLogsGroup2(ctx, params)
 	return err
 }
 
-// HostServiceCreateHost converts echo context to params.
-func (w *ServerInterfaceWrapper) HostServiceCreateHost(ctx echo.Context) error {
+// TelemetryLogsGroupServiceDeleteTelemetryLogsGroup2 converts echo context to params.
+func (w *ServerInterfaceWrapper) TelemetryLogsGroupServiceDeleteTelemetryLogsGroup2(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "projectName" -------------
-	var projectName string
+	// ------------- Path parameter "resourceId" -------------
+	var resourceId string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "projectName", ctx.Param("projectName"), &projectName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", ctx.Param("resourceId"), &resourceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params TelemetryLogsGroupServiceDeleteTelemetryLogsGroup2Params
+	// ------------- Required query parameter "projectName" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.HostServiceCreateHost(ctx, projectName)
+	err = w.Handler.TelemetryLogsGroupServiceDeleteTelemetryLogsGroup2(ctx, resourceId, params)
 	return err
 }
 
-// HostServiceRegisterHost converts echo context to params.
-func (w *ServerInterfaceWrapper) HostServiceRegisterHost(ctx echo.Context) error {
+// TelemetryLogsGroupServiceGetTelemetryLogsGroup2 converts echo context to params.
+func (w *ServerInterfaceWrapper) TelemetryLogsGroupServiceGetTelemetryLogsGroup2(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "resourceId" -------------
+	var resourceId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", ctx.Param("resourceId"), &resourceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params TelemetryLogsGroupServiceGetTelemetryLogsGroup2Params
+	// ------------- Required query parameter "projectName" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TelemetryLogsGroupServiceGetTelemetryLogsGroup2(ctx, resourceId, params)
+	return err
+}
+
+// TelemetryMetricsGroupServiceListTelemetryMetricsGroups2 converts echo context to params.
+func (w *ServerInterfaceWrapper) TelemetryMetricsGroupServiceListTelemetryMetricsGroups2(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params TelemetryMetricsGroupServiceListTelemetryMetricsGroups2Params
+	// ------------- Optional query parameter "pageSize" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pageSize", ctx.QueryParams(), &params.PageSize)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pageSize: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "orderBy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "orderBy", ctx.QueryParams(), &params.OrderBy)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderBy: %s", err))
+	}
+
+	// ------------- Required query parameter "projectName" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TelemetryMetricsGroupServiceListTelemetryMetricsGroups2(ctx, params)
+	return err
+}
+
+// TelemetryMetricsGroupServiceCreateTelemetryMetricsGroup2 converts echo context to params.
+func (w *ServerInterfaceWrapper) TelemetryMetricsGroupServiceCreateTelemetryMetricsGroup2(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params TelemetryMetricsGroupServiceCreateTelemetryMetricsGroup2Params
+	// ------------- Required query parameter "projectName" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TelemetryMetricsGroupServiceCreateTelemetryMetricsGroup2(ctx, params)
+	return err
+}
+
+// TelemetryMetricsGroupServiceDeleteTelemetryMetricsGroup2 converts echo context to params.
+func (w *ServerInterfaceWrapper) TelemetryMetricsGroupServiceDeleteTelemetryMetricsGroup2(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "resourceId" -------------
+	var resourceId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", ctx.Param("resourceId"), &resourceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params TelemetryMetricsGroupServiceDeleteTelemetryMetricsGroup2Params
+	// ------------- Required query parameter "projectName" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TelemetryMetricsGroupServiceDeleteTelemetryMetricsGroup2(ctx, resourceId, params)
+	return err
+}
+
+// TelemetryMetricsGroupServiceGetTelemetryMetricsGroup2 converts echo context to params.
+func (w *ServerInterfaceWrapper) TelemetryMetricsGroupServiceGetTelemetryMetricsGroup2(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "resourceId" -------------
+	var resourceId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", ctx.Param("resourceId"), &resourceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params TelemetryMetricsGroupServiceGetTelemetryMetricsGroup2Params
+	// ------------- Required query parameter "projectName" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TelemetryMetricsGroupServiceGetTelemetryMetricsGroup2(ctx, resourceId, params)
+	return err
+}
+
+// TelemetryLogsProfileServiceListTelemetryLogsProfiles2 converts echo context to params.
+func (w *ServerInterfaceWrapper) TelemetryLogsProfileServiceListTelemetryLogsProfiles2(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params TelemetryLogsProfileServiceListTelemetryLogsProfiles2Params
+	// ------------- Optional query parameter "pageSize" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pageSize", ctx.QueryParams(), &params.PageSize)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pageSize: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "orderBy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "orderBy", ctx.QueryParams(), &params.OrderBy)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderBy: %s", err))
+	}
+
+	// ------------- Optional query parameter "instanceId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "instanceId", ctx.QueryParams(), &params.InstanceId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter instanceId: %s", err))
+	}
+
+	// ------------- Optional query parameter "siteId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "siteId", ctx.QueryParams(), &params.SiteId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter siteId: %s", err))
+	}
+
+	// ------------- Optional query parameter "regionId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "regionId", ctx.QueryParams(), &params.RegionId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter regionId: %s", err))
+	}
+
+	// ------------- Optional query parameter "showInherited" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "showInherited", ctx.QueryParams(), &params.ShowInherited)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter showInherited: %s", err))
+	}
+
+	// ------------- Required query parameter "projectName" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TelemetryLogsProfileServiceListTelemetryLogsProfiles2(ctx, params)
+	return err
+}
+
+// TelemetryLogsProfileServiceCreateTelemetryLogsProfile2 converts echo context to params.
+func (w *ServerInterfaceWrapper) TelemetryLogsProfileServiceCreateTelemetryLogsProfile2(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params TelemetryLogsProfileServiceCreateTelemetryLogsProfile2Params
+	// ------------- Required query parameter "projectName" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TelemetryLogsProfileServiceCreateTelemetryLogsProfile2(ctx, params)
+	return err
+}
+
+// TelemetryLogsProfileServiceDeleteTelemetryLogsProfile2 converts echo context to params.
+func (w *ServerInterfaceWrapper) TelemetryLogsProfileServiceDeleteTelemetryLogsProfile2(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "resourceId" -------------
+	var resourceId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", ctx.Param("resourceId"), &resourceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params TelemetryLogsProfileServiceDeleteTelemetryLogsProfile2Params
+	// ------------- Required query parameter "projectName" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TelemetryLogsProfileServiceDeleteTelemetryLogsProfile2(ctx, resourceId, params)
+	return err
+}
+
+// TelemetryLogsProfileServiceGetTelemetryLogsProfile2 converts echo context to params.
+func (w *ServerInterfaceWrapper) TelemetryLogsProfileServiceGetTelemetryLogsProfile2(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "resourceId" -------------
+	var resourceId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", ctx.Param("resourceId"), &resourceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params TelemetryLogsProfileServiceGetTelemetryLogsProfile2Params
+	// ------------- Required query parameter "projectName" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TelemetryLogsProfileServiceGetTelemetryLogsProfile2(ctx, resourceId, params)
+	return err
+}
+
+// TelemetryLogsProfileServicePatchTelemetryLogsProfile2 converts echo context to params.
+func (w *ServerInterfaceWrapper) TelemetryLogsProfileServicePatchTelemetryLogsProfile2(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "resourceId" -------------
+	var resourceId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", ctx.Param("resourceId"), &resourceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params TelemetryLogsProfileServicePatchTelemetryLogsProfile2Params
+	// ------------- Optional query parameter "fieldMask" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fieldMask", ctx.QueryParams(), &params.FieldMask)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fieldMask: %s", err))
+	}
+
+	// ------------- Required query parameter "projectName" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TelemetryLogsProfileServicePatchTelemetryLogsProfile2(ctx, resourceId, params)
+	return err
+}
+
+// TelemetryLogsProfileServiceUpdateTelemetryLogsProfile2 converts echo context to params.
+func (w *ServerInterfaceWrapper) TelemetryLogsProfileServiceUpdateTelemetryLogsProfile2(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "resourceId" -------------
+	var resourceId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", ctx.Param("resourceId"), &resourceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params TelemetryLogsProfileServiceUpdateTelemetryLogsProfile2Params
+	// ------------- Required query parameter "projectName" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TelemetryLogsProfileServiceUpdateTelemetryLogsProfile2(ctx, resourceId, params)
+	return err
+}
+
+// TelemetryMetricsProfileServiceListTelemetryMetricsProfiles2 converts echo context to params.
+func (w *ServerInterfaceWrapper) TelemetryMetricsProfileServiceListTelemetryMetricsProfiles2(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params TelemetryMetricsProfileServiceListTelemetryMetricsProfiles2Params
+	// ------------- Optional query parameter "pageSize" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pageSize", ctx.QueryParams(), &params.PageSize)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pageSize: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "orderBy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "orderBy", ctx.QueryParams(), &params.OrderBy)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderBy: %s", err))
+	}
+
+	// ------------- Optional query parameter "instanceId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "instanceId", ctx.QueryParams(), &params.InstanceId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter instanceId: %s", err))
+	}
+
+	// ------------- Optional query parameter "siteId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "siteId", ctx.QueryParams(), &params.SiteId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter siteId: %s", err))
+	}
+
+	// ------------- Optional query parameter "regionId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "regionId", ctx.QueryParams(), &params.RegionId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter regionId: %s", err))
+	}
+
+	// ------------- Optional query parameter "showInherited" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "showInherited", ctx.QueryParams(), &params.ShowInherited)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter showInherited: %s", err))
+	}
+
+	// ------------- Required query parameter "projectName" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TelemetryMetricsProfileServiceListTelemetryMetricsProfiles2(ctx, params)
+	return err
+}
+
+// TelemetryMetricsProfileServiceCreateTelemetryMetricsProfile2 converts echo context to params.
+func (w *ServerInterfaceWrapper) TelemetryMetricsProfileServiceCreateTelemetryMetricsProfile2(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params TelemetryMetricsProfileServiceCreateTelemetryMetricsProfile2Params
+	// ------------- Required query parameter "projectName" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TelemetryMetricsProfileServiceCreateTelemetryMetricsProfile2(ctx, params)
+	return err
+}
+
+// TelemetryMetricsProfileServiceDeleteTelemetryMetricsProfile2 converts echo context to params.
+func (w *ServerInterfaceWrapper) TelemetryMetricsProfileServiceDeleteTelemetryMetricsProfile2(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "resourceId" -------------
+	var resourceId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", ctx.Param("resourceId"), &resourceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params TelemetryMetricsProfileServiceDeleteTelemetryMetricsProfile2Params
+	// ------------- Required query parameter "projectName" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TelemetryMetricsProfileServiceDeleteTelemetryMetricsProfile2(ctx, resourceId, params)
+	return err
+}
+
+// TelemetryMetricsProfileServiceGetTelemetryMetricsProfile2 converts echo context to params.
+func (w *ServerInterfaceWrapper) TelemetryMetricsProfileServiceGetTelemetryMetricsProfile2(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "resourceId" -------------
+	var resourceId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", ctx.Param("resourceId"), &resourceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params TelemetryMetricsProfileServiceGetTelemetryMetricsProfile2Params
+	// ------------- Required query parameter "projectName" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TelemetryMetricsProfileServiceGetTelemetryMetricsProfile2(ctx, resourceId, params)
+	return err
+}
+
+// TelemetryMetricsProfileServicePatchTelemetryMetricsProfile2 converts echo context to params.
+func (w *ServerInterfaceWrapper) TelemetryMetricsProfileServicePatchTelemetryMetricsProfile2(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "resourceId" -------------
+	var resourceId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", ctx.Param("resourceId"), &resourceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params TelemetryMetricsProfileServicePatchTelemetryMetricsProfile2Params
+	// ------------- Optional query parameter "fieldMask" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fieldMask", ctx.QueryParams(), &params.FieldMask)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fieldMask: %s", err))
+	}
+
+	// ------------- Required query parameter "projectName" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TelemetryMetricsProfileServicePatchTelemetryMetricsProfile2(ctx, resourceId, params)
+	return err
+}
+
+// TelemetryMetricsProfileServiceUpdateTelemetryMetricsProfile2 converts echo context to params.
+func (w *ServerInterfaceWrapper) TelemetryMetricsProfileServiceUpdateTelemetryMetricsProfile2(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "resourceId" -------------
+	var resourceId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", ctx.Param("resourceId"), &resourceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params TelemetryMetricsProfileServiceUpdateTelemetryMetricsProfile2Params
+	// ------------- Required query parameter "projectName" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TelemetryMetricsProfileServiceUpdateTelemetryMetricsProfile2(ctx, resourceId, params)
+	return err
+}
+
+// WatchServiceWatchResources2 converts echo context to params.
+func (w *ServerInterfaceWrapper) WatchServiceWatchResources2(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params WatchServiceWatchResources2Params
+	// ------------- Optional query parameter "kinds" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "kinds", ctx.QueryParams(), &params.Kinds)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kinds: %s", err))
+	}
+
+	// ------------- Optional query parameter "filter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filter", ctx.QueryParams(), &params.Filter)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter filter: %s", err))
+	}
+
+	// ------------- Optional query parameter "heartbeatSeconds" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "heartbeatSeconds", ctx.QueryParams(), &params.HeartbeatSeconds)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter heartbeatSeconds: %s", err))
+	}
+
+	// ------------- Required query parameter "projectName" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WatchServiceWatchResources2(ctx, params)
+	return err
+}
+
+// WorkloadMemberServiceListWorkloadMembers2 converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkloadMemberServiceListWorkloadMembers2(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params WorkloadMemberServiceListWorkloadMembers2Params
+	// ------------- Optional query parameter "orderBy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "orderBy", ctx.QueryParams(), &params.OrderBy)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderBy: %s", err))
+	}
+
+	// ------------- Optional query parameter "filter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filter", ctx.QueryParams(), &params.Filter)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter filter: %s", err))
+	}
+
+	// ------------- Optional query parameter "pageSize" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pageSize", ctx.QueryParams(), &params.PageSize)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pageSize: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Required query parameter "projectName" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkloadMemberServiceListWorkloadMembers2(ctx, params)
+	return err
+}
+
+// WorkloadMemberServiceCreateWorkloadMember2 converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkloadMemberServiceCreateWorkloadMember2(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params WorkloadMemberServiceCreateWorkloadMember2Params
+	// ------------- Required query parameter "projectName" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkloadMemberServiceCreateWorkloadMember2(ctx, params)
+	return err
+}
+
+// WorkloadMemberServiceDeleteWorkloadMember2 converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkloadMemberServiceDeleteWorkloadMember2(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "resourceId" -------------
+	var resourceId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", ctx.Param("resourceId"), &resourceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params WorkloadMemberServiceDeleteWorkloadMember2Params
+	// ------------- Required query parameter "projectName" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkloadMemberServiceDeleteWorkloadMember2(ctx, resourceId, params)
+	return err
+}
+
+// WorkloadMemberServiceGetWorkloadMember2 converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkloadMemberServiceGetWorkloadMember2(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "resourceId" -------------
+	var resourceId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", ctx.Param("resourceId"), &resourceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params WorkloadMemberServiceGetWorkloadMember2Params
+	// ------------- Required query parameter "projectName" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkloadMemberServiceGetWorkloadMember2(ctx, resourceId, params)
+	return err
+}
+
+// WorkloadServiceListWorkloads2 converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkloadServiceListWorkloads2(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params WorkloadServiceListWorkloads2Params
+	// ------------- Optional query parameter "orderBy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "orderBy", ctx.QueryParams(), &params.OrderBy)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderBy: %s", err))
+	}
+
+	// ------------- Optional query parameter "filter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filter", ctx.QueryParams(), &params.Filter)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter filter: %s", err))
+	}
+
+	// ------------- Optional query parameter "pageSize" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pageSize", ctx.QueryParams(), &params.PageSize)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pageSize: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Required query parameter "projectName" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkloadServiceListWorkloads2(ctx, params)
+	return err
+}
+
+// WorkloadServiceCreateWorkload2 converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkloadServiceCreateWorkload2(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params WorkloadServiceCreateWorkload2Params
+	// ------------- Required query parameter "projectName" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkloadServiceCreateWorkload2(ctx, params)
+	return err
+}
+
+// WorkloadServiceDeleteWorkload2 converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkloadServiceDeleteWorkload2(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "resourceId" -------------
+	var resourceId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", ctx.Param("resourceId"), &resourceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params WorkloadServiceDeleteWorkload2Params
+	// ------------- Required query parameter "projectName" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkloadServiceDeleteWorkload2(ctx, resourceId, params)
+	return err
+}
+
+// WorkloadServiceGetWorkload2 converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkloadServiceGetWorkload2(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "resourceId" -------------
+	var resourceId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", ctx.Param("resourceId"), &resourceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params WorkloadServiceGetWorkload2Params
+	// ------------- Required query parameter "projectName" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkloadServiceGetWorkload2(ctx, resourceId, params)
+	return err
+}
+
+// WorkloadServicePatchWorkload2 converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkloadServicePatchWorkload2(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "resourceId" -------------
+	var resourceId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", ctx.Param("resourceId"), &resourceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params WorkloadServicePatchWorkload2Params
+	// ------------- Optional query parameter "fieldMask" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fieldMask", ctx.QueryParams(), &params.FieldMask)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fieldMask: %s", err))
+	}
+
+	// ------------- Required query parameter "projectName" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkloadServicePatchWorkload2(ctx, resourceId, params)
+	return err
+}
+
+// WorkloadServiceUpdateWorkload2 converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkloadServiceUpdateWorkload2(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "resourceId" -------------
+	var resourceId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", ctx.Param("resourceId"), &resourceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params WorkloadServiceUpdateWorkload2Params
+	// ------------- Required query parameter "projectName" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "projectName", ctx.QueryParams(), &params.ProjectName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkloadServiceUpdateWorkload2(ctx, resourceId, params)
+	return err
+}
+
+// APITokenServiceListAPITokens converts echo context to params.
+func (w *ServerInterfaceWrapper) APITokenServiceListAPITokens(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectName" -------------
+	var projectName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectName", ctx.Param("projectName"), &projectName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params APITokenServiceListAPITokensParams
+	// ------------- Optional query parameter "pageSize" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pageSize", ctx.QueryParams(), &params.PageSize)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pageSize: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.APITokenServiceListAPITokens(ctx, projectName, params)
+	return err
+}
+
+// APITokenServiceCreateAPIToken converts echo context to params.
+func (w *ServerInterfaceWrapper) APITokenServiceCreateAPIToken(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectName" -------------
+	var projectName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectName", ctx.Param("projectName"), &projectName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.APITokenServiceCreateAPIToken(ctx, projectName)
+	return err
+}
+
+// APITokenServiceDeleteAPIToken converts echo context to params.
+func (w *ServerInterfaceWrapper) APITokenServiceDeleteAPIToken(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectName" -------------
+	var projectName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectName", ctx.Param("projectName"), &projectName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// ------------- Path parameter "resourceId" -------------
+	var resourceId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", ctx.Param("resourceId"), &resourceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.APITokenServiceDeleteAPIToken(ctx, projectName, resourceId)
+	return err
+}
+
+// HostServiceListHosts converts echo context to params.
+func (w *ServerInterfaceWrapper) HostServiceListHosts(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectName" -------------
+	var projectName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectName", ctx.Param("projectName"), &projectName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params HostServiceListHostsParams
+	// ------------- Optional query parameter "orderBy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "orderBy", ctx.QueryParams(), &params.OrderBy)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderBy: %s", err))
+	}
+
+	// ------------- Optional query parameter "filter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filter", ctx.QueryParams(), &params.Filter)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter filter: %s", err))
+	}
+
+	// ------------- Optional query parameter "pageSize" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pageSize", ctx.QueryParams(), &params.PageSize)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pageSize: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "view" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "view", ctx.QueryParams(), &params.View)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter view: %s", err))
+	}
+
+	// ------------- Optional query parameter "fields" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fields", ctx.QueryParams(), &params.Fields)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fields: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.HostServiceListHosts(ctx, projectName, params)
+	return err
+}
+
+// HostServiceCreateHost converts echo context to params.
+func (w *ServerInterfaceWrapper) HostServiceCreateHost(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectName" -------------
+	var projectName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectName", ctx.Param("projectName"), &projectName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.HostServiceCreateHost(ctx, projectName)
+	return err
+}
+
+// HostServiceBulkHostAction converts echo context to params.
+func (w *ServerInterfaceWrapper) HostServiceBulkHostAction(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectName" -------------
+	var projectName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectName", ctx.Param("projectName"), &projectName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.HostServiceBulkHostAction(ctx, projectName)
+	return err
+}
+
+// HostServiceInvalidateHosts converts echo context to params.
+func (w *ServerInterfaceWrapper) HostServiceInvalidateHosts(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectName" -------------
+	var projectName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectName", ctx.Param("projectName"), &projectName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.HostServiceInvalidateHosts(ctx, projectName)
+	return err
+}
+
+// HostServiceRegisterHost converts echo context to params.
+func (w *ServerInterfaceWrapper) HostServiceRegisterHost(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectName" -------------
+	var projectName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectName", ctx.Param("projectName"), &projectName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params HostServiceRegisterHostParams
+	// ------------- Optional query parameter "resourceId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "resourceId", ctx.QueryParams(), &params.ResourceId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.HostServiceRegisterHost(ctx, projectName, params)
+	return err
+}
+
+// HostServiceRegisterHosts converts echo context to params.
+func (w *ServerInterfaceWrapper) HostServiceRegisterHosts(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectName" -------------
+	var projectName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectName", ctx.Param("projectName"), &projectName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.HostServiceRegisterHosts(ctx, projectName)
+	return err
+}
+
+// HostServiceGetHostsSummary converts echo context to params.
+func (w *ServerInterfaceWrapper) HostServiceGetHostsSummary(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectName" -------------
+	var projectName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectName", ctx.Param("projectName"), &projectName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params HostServiceGetHostsSummaryParams
+	// ------------- Optional query parameter "filter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filter", ctx.QueryParams(), &params.Filter)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter filter: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.HostServiceGetHostsSummary(ctx, projectName, params)
+	return err
+}
+
+// HostServiceDeleteHost converts echo context to params.
+func (w *ServerInterfaceWrapper) HostServiceDeleteHost(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectName" -------------
+	var projectName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectName", ctx.Param("projectName"), &projectName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// ------------- Path parameter "resourceId" -------------
+	var resourceId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", ctx.Param("resourceId"), &resourceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.HostServiceDeleteHost(ctx, projectName, resourceId)
+	return err
+}
+
+// HostServiceGetHost converts echo context to params.
+func (w *ServerInterfaceWrapper) HostServiceGetHost(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectName" -------------
+	var projectName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectName", ctx.Param("projectName"), &projectName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// ------------- Path parameter "resourceId" -------------
+	var resourceId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", ctx.Param("resourceId"), &resourceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params HostServiceGetHostParams
+	// ------------- Optional query parameter "view" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "view", ctx.QueryParams(), &params.View)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter view: %s", err))
+	}
+
+	// ------------- Optional query parameter "fields" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fields", ctx.QueryParams(), &params.Fields)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fields: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.HostServiceGetHost(ctx, projectName, resourceId, params)
+	return err
+}
+
+// HostServicePatchHost converts echo context to params.
+func (w *ServerInterfaceWrapper) HostServicePatchHost(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectName" -------------
+	var projectName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectName", ctx.Param("projectName"), &projectName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// ------------- Path parameter "resourceId" -------------
+	var resourceId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", ctx.Param("resourceId"), &resourceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params HostServicePatchHostParams
+	// ------------- Optional query parameter "fieldMask" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fieldMask", ctx.QueryParams(), &params.FieldMask)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fieldMask: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.HostServicePatchHost(ctx, projectName, resourceId, params)
+	return err
+}
+
+// HostServiceUpdateHost converts echo context to params.
+func (w *ServerInterfaceWrapper) HostServiceUpdateHost(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectName" -------------
+	var projectName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectName", ctx.Param("projectName"), &projectName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// ------------- Path parameter "resourceId" -------------
+	var resourceId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", ctx.Param("resourceId"), &resourceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.HostServiceUpdateHost(ctx, projectName, resourceId)
+	return err
+}
+
+// HostServiceInvalidateHost converts echo context to params.
+func (w *ServerInterfaceWrapper) HostServiceInvalidateHost(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectName" -------------
+	var projectName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectName", ctx.Param("projectName"), &projectName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// ------------- Path parameter "resourceId" -------------
+	var resourceId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", ctx.Param("resourceId"), &resourceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params HostServiceInvalidateHostParams
+	// ------------- Optional query parameter "note" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "note", ctx.QueryParams(), &params.Note)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter note: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.HostServiceInvalidateHost(ctx, projectName, resourceId, params)
+	return err
+}
+
+// HostServiceOnboardHost converts echo context to params.
+func (w *ServerInterfaceWrapper) HostServiceOnboardHost(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectName" -------------
+	var projectName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectName", ctx.Param("projectName"), &projectName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// ------------- Path parameter "resourceId" -------------
+	var resourceId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", ctx.Param("resourceId"), &resourceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.HostServiceOnboardHost(ctx, projectName, resourceId)
+	return err
+}
+
+// HostServicePatchRegisterHost converts echo context to params.
+func (w *ServerInterfaceWrapper) HostServicePatchRegisterHost(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectName" -------------
+	var projectName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectName", ctx.Param("projectName"), &projectName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// ------------- Path parameter "resourceId" -------------
+	var resourceId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", ctx.Param("resourceId"), &resourceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.HostServicePatchRegisterHost(ctx, projectName, resourceId)
+	return err
+}
+
+// HostServiceGetHostsStatistics converts echo context to params.
+func (w *ServerInterfaceWrapper) HostServiceGetHostsStatistics(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectName" -------------
+	var projectName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectName", ctx.Param("projectName"), &projectName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params HostServiceGetHostsStatisticsParams
+	// ------------- Optional query parameter "dimensions" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dimensions", ctx.QueryParams(), &params.Dimensions)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter dimensions: %s", err))
+	}
+
+	// ------------- Optional query parameter "filter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filter", ctx.QueryParams(), &params.Filter)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter filter: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.HostServiceGetHostsStatistics(ctx, projectName, params)
+	return err
+}
+
+// HostServiceGetHostsSummary3 converts echo context to params.
+func (w *ServerInterfaceWrapper) HostServiceGetHostsSummary3(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectName" -------------
+	var projectName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectName", ctx.Param("projectName"), &projectName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params HostServiceGetHostsSummary3Params
+	// ------------- Optional query parameter "filter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filter", ctx.QueryParams(), &params.Filter)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter filter: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.HostServiceGetHostsSummary3(ctx, projectName, params)
+	return err
+}
+
+// InstanceServiceListInstances converts echo context to params.
+func (w *ServerInterfaceWrapper) InstanceServiceListInstances(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectName" -------------
+	var projectName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectName", ctx.Param("projectName"), &projectName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params InstanceServiceListInstancesParams
+	// ------------- Optional query parameter "orderBy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "orderBy", ctx.QueryParams(), &params.OrderBy)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderBy: %s", err))
+	}
+
+	// ------------- Optional query parameter "filter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filter", ctx.QueryParams(), &params.Filter)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter filter: %s", err))
+	}
+
+	// ------------- Optional query parameter "pageSize" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pageSize", ctx.QueryParams(), &params.PageSize)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pageSize: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "view" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "view", ctx.QueryParams(), &params.View)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter view: %s", err))
+	}
+
+	// ------------- Optional query parameter "fields" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fields", ctx.QueryParams(), &params.Fields)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fields: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.InstanceServiceListInstances(ctx, projectName, params)
+	return err
+}
+
+// InstanceServiceCreateInstance converts echo context to params.
+func (w *ServerInterfaceWrapper) InstanceServiceCreateInstance(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectName" -------------
+	var projectName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectName", ctx.Param("projectName"), &projectName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.InstanceServiceCreateInstance(ctx, projectName)
+	return err
+}
+
+// InstanceServiceBulkInstanceAction converts echo context to params.
+func (w *ServerInterfaceWrapper) InstanceServiceBulkInstanceAction(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectName" -------------
+	var projectName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectName", ctx.Param("projectName"), &projectName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.InstanceServiceBulkInstanceAction(ctx, projectName)
+	return err
+}
+
+// InstanceServiceDeleteInstance converts echo context to params.
+func (w *ServerInterfaceWrapper) InstanceServiceDeleteInstance(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectName" -------------
+	var projectName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectName", ctx.Param("projectName"), &projectName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// ------------- Path parameter "resourceId" -------------
+	var resourceId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", ctx.Param("resourceId"), &resourceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.InstanceServiceDeleteInstance(ctx, projectName, resourceId)
+	return err
+}
+
+// InstanceServiceGetInstance converts echo context to params.
+func (w *ServerInterfaceWrapper) InstanceServiceGetInstance(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectName" -------------
+	var projectName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectName", ctx.Param("projectName"), &projectName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// ------------- Path parameter "resourceId" -------------
+	var resourceId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", ctx.Param("resourceId"), &resourceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params InstanceServiceGetInstanceParams
+	// ------------- Optional query parameter "view" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "view", ctx.QueryParams(), &params.View)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter view: %s", err))
+	}
+
+	// ------------- Optional query parameter "fields" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fields", ctx.QueryParams(), &params.Fields)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fields: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.InstanceServiceGetInstance(ctx, projectName, resourceId, params)
+	return err
+}
+
+// InstanceServicePatchInstance converts echo context to params.
+func (w *ServerInterfaceWrapper) InstanceServicePatchInstance(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectName" -------------
+	var projectName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectName", ctx.Param("projectName"), &projectName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// ------------- Path parameter "resourceId" -------------
+	var resourceId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", ctx.Param("resourceId"), &resourceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params InstanceServicePatchInstanceParams
+	// ------------- Optional query parameter "fieldMask" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fieldMask", ctx.QueryParams(), &params.FieldMask)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fieldMask: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.InstanceServicePatchInstance(ctx, projectName, resourceId, params)
+	return err
+}
+
+// InstanceServiceUpdateInstance converts echo context to params.
+func (w *ServerInterfaceWrapper) InstanceServiceUpdateInstance(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectName" -------------
+	var projectName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectName", ctx.Param("projectName"), &projectName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// ------------- Path parameter "resourceId" -------------
+	var resourceId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", ctx.Param("resourceId"), &resourceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.InstanceServiceUpdateInstance(ctx, projectName, resourceId)
+	return err
+}
+
+// InstanceServiceInvalidateInstance converts echo context to params.
+func (w *ServerInterfaceWrapper) InstanceServiceInvalidateInstance(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectName" -------------
+	var projectName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectName", ctx.Param("projectName"), &projectName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// ------------- Path parameter "resourceId" -------------
+	var resourceId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", ctx.Param("resourceId"), &resourceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.InstanceServiceInvalidateInstance(ctx, projectName, resourceId)
+	return err
+}
+
+// OperatingSystemServiceListOperatingSystems3 converts echo context to params.
+func (w *ServerInterfaceWrapper) OperatingSystemServiceListOperatingSystems3(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectName" -------------
+	var projectName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectName", ctx.Param("projectName"), &projectName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params OperatingSystemServiceListOperatingSystems3Params
+	// ------------- Optional query parameter "orderBy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "orderBy", ctx.QueryParams(), &params.OrderBy)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderBy: %s", err))
+	}
+
+	// ------------- Optional query parameter "filter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filter", ctx.QueryParams(), &params.Filter)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter filter: %s", err))
+	}
+
+	// ------------- Optional query parameter "pageSize" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pageSize", ctx.QueryParams(), &params.PageSize)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pageSize: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "view" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "view", ctx.QueryParams(), &params.View)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter view: %s", err))
+	}
+
+	// ------------- Optional query parameter "fields" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fields", ctx.QueryParams(), &params.Fields)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fields: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.OperatingSystemServiceListOperatingSystems3(ctx, projectName, params)
+	return err
+}
+
+// OperatingSystemServiceCreateOperatingSystem3 converts echo context to params.
+func (w *ServerInterfaceWrapper) OperatingSystemServiceCreateOperatingSystem3(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectName" -------------
+	var projectName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectName", ctx.Param("projectName"), &projectName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.OperatingSystemServiceCreateOperatingSystem3(ctx, projectName)
+	return err
+}
+
+// OperatingSystemServiceDeleteOperatingSystem3 converts echo context to params.
+func (w *ServerInterfaceWrapper) OperatingSystemServiceDeleteOperatingSystem3(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectName" -------------
+	var projectName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectName", ctx.Param("projectName"), &projectName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// ------------- Path parameter "resourceId" -------------
+	var resourceId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", ctx.Param("resourceId"), &resourceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.OperatingSystemServiceDeleteOperatingSystem3(ctx, projectName, resourceId)
+	return err
+}
+
+// OperatingSystemServiceGetOperatingSystem3 converts echo context to params.
+func (w *ServerInterfaceWrapper) OperatingSystemServiceGetOperatingSystem3(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectName" -------------
+	var projectName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectName", ctx.Param("projectName"), &projectName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// ------------- Path parameter "resourceId" -------------
+	var resourceId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", ctx.Param("resourceId"), &resourceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params OperatingSystemServiceGetOperatingSystem3Params
+	// ------------- Optional query parameter "view" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "view", ctx.QueryParams(), &params.View)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter view: %s", err))
+	}
+
+	// ------------- Optional query parameter "fields" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fields", ctx.QueryParams(), &params.Fields)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fields: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.OperatingSystemServiceGetOperatingSystem3(ctx, projectName, resourceId, params)
+	return err
+}
+
+// OperatingSystemServicePatchOperatingSystem3 converts echo context to params.
+func (w *ServerInterfaceWrapper) OperatingSystemServicePatchOperatingSystem3(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectName" -------------
+	var projectName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectName", ctx.Param("projectName"), &projectName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// ------------- Path parameter "resourceId" -------------
+	var resourceId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", ctx.Param("resourceId"), &resourceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params OperatingSystemServicePatchOperatingSystem3Params
+	// ------------- Optional query parameter "fieldMask" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fieldMask", ctx.QueryParams(), &params.FieldMask)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fieldMask: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.OperatingSystemServicePatchOperatingSystem3(ctx, projectName, resourceId, params)
+	return err
+}
+
+// OperatingSystemServiceUpdateOperatingSystem3 converts echo context to params.
+func (w *ServerInterfaceWrapper) OperatingSystemServiceUpdateOperatingSystem3(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectName" -------------
+	var projectName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectName", ctx.Param("projectName"), &projectName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// ------------- Path parameter "resourceId" -------------
+	var resourceId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", ctx.Param("resourceId"), &resourceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.OperatingSystemServiceUpdateOperatingSystem3(ctx, projectName, resourceId)
+	return err
+}
+
+// OperatingSystemServiceListOperatingSystems converts echo context to params.
+func (w *ServerInterfaceWrapper) OperatingSystemServiceListOperatingSystems(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectName" -------------
+	var projectName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectName", ctx.Param("projectName"), &projectName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params OperatingSystemServiceListOperatingSystemsParams
+	// ------------- Optional query parameter "orderBy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "orderBy", ctx.QueryParams(), &params.OrderBy)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderBy: %s", err))
+	}
+
+	// ------------- Optional query parameter "filter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filter", ctx.QueryParams(), &params.Filter)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter filter: %s", err))
+	}
+
+	// ------------- Optional query parameter "pageSize" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pageSize", ctx.QueryParams(), &params.PageSize)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pageSize: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "view" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "view", ctx.QueryParams(), &params.View)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter view: %s", err))
+	}
+
+	// ------------- Optional query parameter "fields" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fields", ctx.QueryParams(), &params.Fields)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fields: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.OperatingSystemServiceListOperatingSystems(ctx, projectName, params)
+	return err
+}
+
+// OperatingSystemServiceCreateOperatingSystem converts echo context to params.
+func (w *ServerInterfaceWrapper) OperatingSystemServiceCreateOperatingSystem(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectName" -------------
+	var projectName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectName", ctx.Param("projectName"), &projectName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.OperatingSystemServiceCreateOperatingSystem(ctx, projectName)
+	return err
+}
+
+// OperatingSystemServiceDeleteOperatingSystem converts echo context to params.
+func (w *ServerInterfaceWrapper) OperatingSystemServiceDeleteOperatingSystem(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectName" -------------
+	var projectName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectName", ctx.Param("projectName"), &projectName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// ------------- Path parameter "resourceId" -------------
+	var resourceId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", ctx.Param("resourceId"), &resourceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.OperatingSystemServiceDeleteOperatingSystem(ctx, projectName, resourceId)
+	return err
+}
+
+// OperatingSystemServiceGetOperatingSystem converts echo context to params.
+func (w *ServerInterfaceWrapper) OperatingSystemServiceGetOperatingSystem(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectName" -------------
+	var projectName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectName", ctx.Param("projectName"), &projectName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// ------------- Path parameter "resourceId" -------------
+	var resourceId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", ctx.Param("resourceId"), &resourceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params OperatingSystemServiceGetOperatingSystemParams
+	// ------------- Optional query parameter "view" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "view", ctx.QueryParams(), &params.View)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter view: %s", err))
+	}
+
+	// ------------- Optional query parameter "fields" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fields", ctx.QueryParams(), &params.Fields)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fields: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.OperatingSystemServiceGetOperatingSystem(ctx, projectName, resourceId, params)
+	return err
+}
+
+// OperatingSystemServicePatchOperatingSystem converts echo context to params.
+func (w *ServerInterfaceWrapper) OperatingSystemServicePatchOperatingSystem(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectName" -------------
+	var projectName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectName", ctx.Param("projectName"), &projectName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// ------------- Path parameter "resourceId" -------------
+	var resourceId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", ctx.Param("resourceId"), &resourceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params OperatingSystemServicePatchOperatingSystemParams
+	// ------------- Optional query parameter "fieldMask" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fieldMask", ctx.QueryParams(), &params.FieldMask)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fieldMask: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.OperatingSystemServicePatchOperatingSystem(ctx, projectName, resourceId, params)
+	return err
+}
+
+// OperatingSystemServiceUpdateOperatingSystem converts echo context to params.
+func (w *ServerInterfaceWrapper) OperatingSystemServiceUpdateOperatingSystem(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectName" -------------
+	var projectName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectName", ctx.Param("projectName"), &projectName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// ------------- Path parameter "resourceId" -------------
+	var resourceId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", ctx.Param("resourceId"), &resourceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.OperatingSystemServiceUpdateOperatingSystem(ctx, projectName, resourceId)
+	return err
+}
+
+// ScheduleServiceListSchedules converts echo context to params.
+func (w *ServerInterfaceWrapper) ScheduleServiceListSchedules(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectName" -------------
+	var projectName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectName", ctx.Param("projectName"), &projectName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ScheduleServiceListSchedulesParams
+	// ------------- Optional query parameter "pageSize" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pageSize", ctx.QueryParams(), &params.PageSize)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pageSize: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "hostId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "hostId", ctx.QueryParams(), &params.HostId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter hostId: %s", err))
+	}
+
+	// ------------- Optional query parameter "siteId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "siteId", ctx.QueryParams(), &params.SiteId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter siteId: %s", err))
+	}
+
+	// ------------- Optional query parameter "regionId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "regionId", ctx.QueryParams(), &params.RegionId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter regionId: %s", err))
+	}
+
+	// ------------- Optional query parameter "unixEpoch" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "unixEpoch", ctx.QueryParams(), &params.UnixEpoch)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter unixEpoch: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ScheduleServiceListSchedules(ctx, projectName, params)
+	return err
+}
+
+// ScheduleServiceListRepeatedSchedules3 converts echo context to params.
+func (w *ServerInterfaceWrapper) ScheduleServiceListRepeatedSchedules3(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectName" -------------
+	var projectName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectName", ctx.Param("projectName"), &projectName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ScheduleServiceListRepeatedSchedules3Params
+	// ------------- Optional query parameter "pageSize" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pageSize", ctx.QueryParams(), &params.PageSize)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pageSize: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "hostId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "hostId", ctx.QueryParams(), &params.HostId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter hostId: %s", err))
+	}
+
+	// ------------- Optional query parameter "siteId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "siteId", ctx.QueryParams(), &params.SiteId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter siteId: %s", err))
+	}
+
+	// ------------- Optional query parameter "regionId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "regionId", ctx.QueryParams(), &params.RegionId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter regionId: %s", err))
+	}
+
+	// ------------- Optional query parameter "unixEpoch" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "unixEpoch", ctx.QueryParams(), &params.UnixEpoch)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter unixEpoch: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ScheduleServiceListRepeatedSchedules3(ctx, projectName, params)
+	return err
+}
+
+// ScheduleServiceCreateRepeatedSchedule3 converts echo context to params.
+func (w *ServerInterfaceWrapper) ScheduleServiceCreateRepeatedSchedule3(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectName" -------------
+	var projectName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectName", ctx.Param("projectName"), &projectName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ScheduleServiceCreateRepeatedSchedule3(ctx, projectName)
+	return err
+}
+
+// ScheduleServiceDeleteRepeatedSchedule3 converts echo context to params.
+func (w *ServerInterfaceWrapper) ScheduleServiceDeleteRepeatedSchedule3(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectName" -------------
+	var projectName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectName", ctx.Param("projectName"), &projectName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// ------------- Path parameter "resourceId" -------------
+	var resourceId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", ctx.Param("resourceId"), &resourceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ScheduleServiceDeleteRepeatedSchedule3(ctx, projectName, resourceId)
+	return err
+}
+
+// ScheduleServiceGetRepeatedSchedule3 converts echo context to params.
+func (w *ServerInterfaceWrapper) ScheduleServiceGetRepeatedSchedule3(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectName" -------------
+	var projectName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectName", ctx.Param("projectName"), &projectName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// ------------- Path parameter "resourceId" -------------
+	var resourceId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", ctx.Param("resourceId"), &resourceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ScheduleServiceGetRepeatedSchedule3(ctx, projectName, resourceId)
+	return err
+}
+
+// ScheduleServicePatchRepeatedSchedule3 converts echo context to params.
+func (w *ServerInterfaceWrapper) ScheduleServicePatchRepeatedSchedule3(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectName" -------------
+	var projectName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectName", ctx.Param("projectName"), &projectName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// ------------- Path parameter "resourceId" -------------
+	var resourceId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", ctx.Param("resourceId"), &resourceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ScheduleServicePatchRepeatedSchedule3Params
+	// ------------- Optional query parameter "fieldMask" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fieldMask", ctx.QueryParams(), &params.FieldMask)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fieldMask: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ScheduleServicePatchRepeatedSchedule3(ctx, projectName, resourceId, params)
+	return err
+}
+
+// ScheduleServiceUpdateRepeatedSchedule3 converts echo context to params.
+func (w *ServerInterfaceWrapper) ScheduleServiceUpdateRepeatedSchedule3(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectName" -------------
+	var projectName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectName", ctx.Param("projectName"), &projectName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// ------------- Path parameter "resourceId" -------------
+	var resourceId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", ctx.Param("resourceId"), &resourceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ScheduleServiceUpdateRepeatedSchedule3(ctx, projectName, resourceId)
+	return err
+}
+
+// ScheduleServiceListSingleSchedules3 converts echo context to params.
+func (w *ServerInterfaceWrapper) ScheduleServiceListSingleSchedules3(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectName" -------------
+	var projectName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectName", ctx.Param("projectName"), &projectName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ScheduleServiceListSingleSchedules3Params
+	// ------------- Optional query parameter "pageSize" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pageSize", ctx.QueryParams(), &params.PageSize)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pageSize: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "hostId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "hostId", ctx.QueryParams(), &params.HostId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter hostId: %s", err))
+	}
+
+	// ------------- Optional query parameter "siteId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "siteId", ctx.QueryParams(), &params.SiteId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter siteId: %s", err))
+	}
+
+	// ------------- Optional query parameter "regionId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "regionId", ctx.QueryParams(), &params.RegionId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter regionId: %s", err))
+	}
+
+	// ------------- Optional query parameter "unixEpoch" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "unixEpoch", ctx.QueryParams(), &params.UnixEpoch)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter unixEpoch: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ScheduleServiceListSingleSchedules3(ctx, projectName, params)
+	return err
+}
+
+// ScheduleServiceCreateSingleSchedule3 converts echo context to params.
+func (w *ServerInterfaceWrapper) ScheduleServiceCreateSingleSchedule3(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectName" -------------
+	var projectName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectName", ctx.Param("projectName"), &projectName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ScheduleServiceCreateSingleSchedule3(ctx, projectName)
+	return err
+}
+
+// ScheduleServiceDeleteSingleSchedule3 converts echo context to params.
+func (w *ServerInterfaceWrapper) ScheduleServiceDeleteSingleSchedule3(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectName" -------------
+	var projectName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectName", ctx.Param("projectName"), &projectName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// ------------- Path parameter "resourceId" -------------
+	var resourceId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", ctx.Param("resourceId"), &resourceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ScheduleServiceDeleteSingleSchedule3(ctx, projectName, resourceId)
+	return err
+}
+
+// ScheduleServiceGetSingleSchedule3 converts echo context to params.
+func (w *ServerInterfaceWrapper) ScheduleServiceGetSingleSchedule3(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectName" -------------
+	var projectName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectName", ctx.Param("projectName"), &projectName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// ------------- Path parameter "resourceId" -------------
+	var resourceId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", ctx.Param("resourceId"), &resourceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ScheduleServiceGetSingleSchedule3(ctx, projectName, resourceId)
+	return err
+}
+
+// ScheduleServicePatchSingleSchedule3 converts echo context to params.
+func (w *ServerInterfaceWrapper) ScheduleServicePatchSingleSchedule3(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectName" -------------
+	var projectName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectName", ctx.Param("projectName"), &projectName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// ------------- Path parameter "resourceId" -------------
+	var resourceId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", ctx.Param("resourceId"), &resourceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ScheduleServicePatchSingleSchedule3Params
+	// ------------- Optional query parameter "fieldMask" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fieldMask", ctx.QueryParams(), &params.FieldMask)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fieldMask: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ScheduleServicePatchSingleSchedule3(ctx, projectName, resourceId, params)
+	return err
+}
+
+// ScheduleServiceUpdateSingleSchedule3 converts echo context to params.
+func (w *ServerInterfaceWrapper) ScheduleServiceUpdateSingleSchedule3(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectName" -------------
+	var projectName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectName", ctx.Param("projectName"), &projectName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// ------------- Path parameter "resourceId" -------------
+	var resourceId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", ctx.Param("resourceId"), &resourceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ScheduleServiceUpdateSingleSchedule3(ctx, projectName, resourceId)
+	return err
+}
+
+// WorkloadServiceListWorkloads converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkloadServiceListWorkloads(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectName" -------------
+	var projectName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectName", ctx.Param("projectName"), &projectName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params WorkloadServiceListWorkloadsParams
+	// ------------- Optional query parameter "orderBy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "orderBy", ctx.QueryParams(), &params.OrderBy)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderBy: %s", err))
+	}
+
+	// ------------- Optional query parameter "filter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filter", ctx.QueryParams(), &params.Filter)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter filter: %s", err))
+	}
+
+	// ------------- Optional query parameter "pageSize" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pageSize", ctx.QueryParams(), &params.PageSize)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pageSize: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkloadServiceListWorkloads(ctx, projectName, params)
+	return err
+}
+
+// WorkloadServiceCreateWorkload converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkloadServiceCreateWorkload(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectName" -------------
+	var projectName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectName", ctx.Param("projectName"), &projectName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkloadServiceCreateWorkload(ctx, projectName)
+	return err
+}
+
+// WorkloadServiceDeleteWorkload converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkloadServiceDeleteWorkload(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "projectName" -------------
 	var projectName string
@@ -3800,22 +7113,21 @@ func (w *ServerInterfaceWrapper) HostServiceRegisterHost(ctx echo.Context) error
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params HostServiceRegisterHostParams
-	// ------------- Optional query parameter "resourceId" -------------
+	// ------------- Path parameter "resourceId" -------------
+	var resourceId string
 
-	err = runtime.BindQueryParameter("form", true, false, "resourceId", ctx.QueryParams(), &params.ResourceId)
+	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", ctx.Param("resourceId"), &resourceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.HostServiceRegisterHost(ctx, projectName, params)
+	err = w.Handler.WorkloadServiceDeleteWorkload(ctx, projectName, resourceId)
 	return err
 }
 
-// HostServiceGetHostsSummary converts echo context to params.
-func (w *ServerInterfaceWrapper) HostServiceGetHostsSummary(ctx echo.Context) error {
+// WorkloadServiceGetWorkload converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkloadServiceGetWorkload(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "projectName" -------------
 	var projectName string
@@ -3825,22 +7137,21 @@ func (w *ServerInterfaceWrapper) HostServiceGetHostsSummary(ctx echo.Context) er
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params HostServiceGetHostsSummaryParams
-	// ------------- Optional query parameter "filter" -------------
+	// ------------- Path parameter "resourceId" -------------
+	var resourceId string
 
-	err = runtime.BindQueryParameter("form", true, false, "filter", ctx.QueryParams(), &params.Filter)
+	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", ctx.Param("resourceId"), &resourceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter filter: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.HostServiceGetHostsSummary(ctx, projectName, params)
+	err = w.Handler.WorkloadServiceGetWorkload(ctx, projectName, resourceId)
 	return err
 }
 
-// HostServiceDeleteHost converts echo context to params.
-func (w *ServerInterfaceWrapper) HostServiceDeleteHost(ctx echo.Context) error {
+// WorkloadServicePatchWorkload converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkloadServicePatchWorkload(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "projectName" -------------
 	var projectName string
@@ -3858,13 +7169,22 @@ func (w *ServerInterfaceWrapper) HostServiceDeleteHost(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params WorkloadServicePatchWorkloadParams
+	// ------------- Optional query parameter "fieldMask" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fieldMask", ctx.QueryParams(), &params.FieldMask)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fieldMask: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.HostServiceDeleteHost(ctx, projectName, resourceId)
+	err = w.Handler.WorkloadServicePatchWorkload(ctx, projectName, resourceId, params)
 	return err
 }
 
-// HostServiceGetHost converts echo context to params.
-func (w *ServerInterfaceWrapper) HostServiceGetHost(ctx echo.Context) error {
+// WorkloadServiceUpdateWorkload converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkloadServiceUpdateWorkload(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "projectName" -------------
 	var projectName string
@@ -3883,12 +7203,12 @@ func (w *ServerInterfaceWrapper) HostServiceGetHost(ctx echo.Context) error {
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.HostServiceGetHost(ctx, projectName, resourceId)
+	err = w.Handler.WorkloadServiceUpdateWorkload(ctx, projectName, resourceId)
 	return err
 }
 
-// HostServicePatchHost converts echo context to params.
-func (w *ServerInterfaceWrapper) HostServicePatchHost(ctx echo.Context) error {
+// CustomConfigServiceListCustomConfigs converts echo context to params.
+func (w *ServerInterfaceWrapper) CustomConfigServiceListCustomConfigs(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "projectName" -------------
 	var projectName string
@@ -3898,30 +7218,43 @@ func (w *ServerInterfaceWrapper) HostServicePatchHost(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
 	}
 
-	// ------------- Path parameter "resourceId" -------------
-	var resourceId string
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CustomConfigServiceListCustomConfigsParams
+	// ------------- Optional query parameter "orderBy" -------------
 
-	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", ctx.Param("resourceId"), &resourceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindQueryParameter("form", true, false, "orderBy", ctx.QueryParams(), &params.OrderBy)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderBy: %s", err))
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params HostServicePatchHostParams
-	// ------------- Optional query parameter "fieldMask" -------------
+	// ------------- Optional query parameter "filter" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "fieldMask", ctx.QueryParams(), &params.FieldMask)
+	err = runtime.BindQueryParameter("form", true, false, "filter", ctx.QueryParams(), &params.Filter)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fieldMask: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter filter: %s", err))
+	}
+
+	// ------------- Optional query parameter "pageSize" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pageSize", ctx.QueryParams(), &params.PageSize)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pageSize: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.HostServicePatchHost(ctx, projectName, resourceId, params)
+	err = w.Handler.CustomConfigServiceListCustomConfigs(ctx, projectName, params)
 	return err
 }
 
-// HostServiceUpdateHost converts echo context to params.
-func (w *ServerInterfaceWrapper) HostServiceUpdateHost(ctx echo.Context) error {
+// CustomConfigServiceCreateCustomConfig converts echo context to params.
+func (w *ServerInterfaceWrapper) CustomConfigServiceCreateCustomConfig(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "projectName" -------------
 	var projectName string
@@ -3931,21 +7264,13 @@ func (w *ServerInterfaceWrapper) HostServiceUpdateHost(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
 	}
 
-	// ------------- Path parameter "resourceId" -------------
-	var resourceId string
-
-	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", ctx.Param("resourceId"), &resourceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
-	}
-
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.HostServiceUpdateHost(ctx, projectName, resourceId)
+	err = w.Handler.CustomConfigServiceCreateCustomConfig(ctx, projectName)
 	return err
 }
 
-// HostServiceInvalidateHost converts echo context to params.
-func (w *ServerInterfaceWrapper) HostServiceInvalidateHost(ctx echo.Context) error {
+// CustomConfigServiceDeleteCustomConfig converts echo context to params.
+func (w *ServerInterfaceWrapper) CustomConfigServiceDeleteCustomConfig(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "projectName" -------------
 	var projectName string
@@ -3963,22 +7288,13 @@ func (w *ServerInterfaceWrapper) HostServiceInvalidateHost(ctx echo.Context) err
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params HostServiceInvalidateHostParams
-	// ------------- Optional query parameter "note" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "note", ctx.QueryParams(), &params.Note)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter note: %s", err))
-	}
-
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.HostServiceInvalidateHost(ctx, projectName, resourceId, params)
+	err = w.Handler.CustomConfigServiceDeleteCustomConfig(ctx, projectName, resourceId)
 	return err
 }
 
-// HostServiceOnboardHost converts echo context to params.
-func (w *ServerInterfaceWrapper) HostServiceOnboardHost(ctx echo.Context) error {
+// CustomConfigServiceGetCustomConfig converts echo context to params.
+func (w *ServerInterfaceWrapper) CustomConfigServiceGetCustomConfig(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "projectName" -------------
 	var projectName string
@@ -3997,12 +7313,12 @@ func (w *ServerInterfaceWrapper) HostServiceOnboardHost(ctx echo.Context) error
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.HostServiceOnboardHost(ctx, projectName, resourceId)
+	err = w.Handler.CustomConfigServiceGetCustomConfig(ctx, projectName, resourceId)
 	return err
 }
 
-// HostServicePatchRegisterHost converts echo context to params.
-func (w *ServerInterfaceWrapper) HostServicePatchRegisterHost(ctx echo.Context) error {
+// CustomConfigServicePatchCustomConfig converts echo context to params.
+func (w *ServerInterfaceWrapper) CustomConfigServicePatchCustomConfig(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "projectName" -------------
 	var projectName string
@@ -4020,13 +7336,22 @@ func (w *ServerInterfaceWrapper) HostServicePatchRegisterHost(ctx echo.Context)
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CustomConfigServicePatchCustomConfigParams
+	// ------------- Optional query parameter "fieldMask" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fieldMask", ctx.QueryParams(), &params.FieldMask)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fieldMask: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.HostServicePatchRegisterHost(ctx, projectName, resourceId)
+	err = w.Handler.CustomConfigServicePatchCustomConfig(ctx, projectName, resourceId, params)
 	return err
 }
 
-// HostServiceGetHostsSummary3 converts echo context to params.
-func (w *ServerInterfaceWrapper) HostServiceGetHostsSummary3(ctx echo.Context) error {
+// CustomConfigServiceUpdateCustomConfig converts echo context to params.
+func (w *ServerInterfaceWrapper) CustomConfigServiceUpdateCustomConfig(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "projectName" -------------
 	var projectName string
@@ -4036,22 +7361,21 @@ func (w *ServerInterfaceWrapper) HostServiceGetHostsSummary3(ctx echo.Context) e
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params HostServiceGetHostsSummary3Params
-	// ------------- Optional query parameter "filter" -------------
+	// ------------- Path parameter "resourceId" -------------
+	var resourceId string
 
-	err = runtime.BindQueryParameter("form", true, false, "filter", ctx.QueryParams(), &params.Filter)
+	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", ctx.Param("resourceId"), &resourceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter filter: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.HostServiceGetHostsSummary3(ctx, projectName, params)
+	err = w.Handler.CustomConfigServiceUpdateCustomConfig(ctx, projectName, resourceId)
 	return err
 }
 
-// InstanceServiceListInstances converts echo context to params.
-func (w *ServerInterfaceWrapper) InstanceServiceListInstances(ctx echo.Context) error {
+// LocalAccountServiceListLocalAccounts converts echo context to params.
+func (w *ServerInterfaceWrapper) LocalAccountServiceListLocalAccounts(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "projectName" -------------
 	var projectName string
@@ -4062,7 +7386,7 @@ func (w *ServerInterfaceWrapper) InstanceServiceListInstances(ctx echo.Context)
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params InstanceServiceListInstancesParams
+	var params LocalAccountServiceListLocalAccountsParams
 	// ------------- Optional query parameter "orderBy" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "orderBy", ctx.QueryParams(), &params.OrderBy)
@@ -4092,12 +7416,12 @@ func (w *ServerInterfaceWrapper) InstanceServiceListInstances(ctx echo.Context)
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.InstanceServiceListInstances(ctx, projectName, params)
+	err = w.Handler.LocalAccountServiceListLocalAccounts(ctx, projectName, params)
 	return err
 }
 
-// InstanceServiceCreateInstance converts echo context to params.
-func (w *ServerInterfaceWrapper) InstanceServiceCreateInstance(ctx echo.Context) error {
+// LocalAccountServiceCreateLocalAccount converts echo context to params.
+func (w *ServerInterfaceWrapper) LocalAccountServiceCreateLocalAccount(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "projectName" -------------
 	var projectName string
@@ -4108,12 +7432,12 @@ func (w *ServerInterfaceWrapper) InstanceServiceCreateInstance(ctx echo.Context)
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.InstanceServiceCreateInstance(ctx, projectName)
+	err = w.Handler.LocalAccountServiceCreateLocalAccount(ctx, projectName)
 	return err
 }
 
-// InstanceServiceDeleteInstance converts echo context to params.
-func (w *ServerInterfaceWrapper) InstanceServiceDeleteInstance(ctx echo.Context) error {
+// LocalAccountServiceDeleteLocalAccount converts echo context to params.
+func (w *ServerInterfaceWrapper) LocalAccountServiceDeleteLocalAccount(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "projectName" -------------
 	var projectName string
@@ -4132,12 +7456,12 @@ func (w *ServerInterfaceWrapper) InstanceServiceDeleteInstance(ctx echo.Context)
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.InstanceServiceDeleteInstance(ctx, projectName, resourceId)
+	err = w.Handler.LocalAccountServiceDeleteLocalAccount(ctx, projectName, resourceId)
 	return err
 }
 
-// InstanceServiceGetInstance converts echo context to params.
-func (w *ServerInterfaceWrapper) InstanceServiceGetInstance(ctx echo.Context) error {
+// LocalAccountServiceGetLocalAccount converts echo context to params.
+func (w *ServerInterfaceWrapper) LocalAccountServiceGetLocalAccount(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "projectName" -------------
 	var projectName string
@@ -4156,12 +7480,12 @@ func (w *ServerInterfaceWrapper) InstanceServiceGetInstance(ctx echo.Context) er
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.InstanceServiceGetInstance(ctx, projectName, resourceId)
+	err = w.Handler.LocalAccountServiceGetLocalAccount(ctx, projectName, resourceId)
 	return err
 }
 
-// InstanceServicePatchInstance converts echo context to params.
-func (w *ServerInterfaceWrapper) InstanceServicePatchInstance(ctx echo.Context) error {
+// LocalAccountServicePatchLocalAccount converts echo context to params.
+func (w *ServerInterfaceWrapper) LocalAccountServicePatchLocalAccount(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "projectName" -------------
 	var projectName string
@@ -4180,7 +7504,7 @@ func (w *ServerInterfaceWrapper) InstanceServicePatchInstance(ctx echo.Context)
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params InstanceServicePatchInstanceParams
+	var params LocalAccountServicePatchLocalAccountParams
 	// ------------- Optional query parameter "fieldMask" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "fieldMask", ctx.QueryParams(), &params.FieldMask)
@@ -4189,12 +7513,82 @@ func (w *ServerInterfaceWrapper) InstanceServicePatchInstance(ctx echo.Context)
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.InstanceServicePatchInstance(ctx, projectName, resourceId, params)
+	err = w.Handler.LocalAccountServicePatchLocalAccount(ctx, projectName, resourceId, params)
 	return err
 }
 
-// InstanceServiceUpdateInstance converts echo context to params.
-func (w *ServerInterfaceWrapper) InstanceServiceUpdateInstance(ctx echo.Context) error {
+// LocalAccountServiceUpdateLocalAccount converts echo context to params.
+func (w *ServerInterfaceWrapper) LocalAccountServiceUpdateLocalAccount(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectName" -------------
+	var projectName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectName", ctx.Param("projectName"), &projectName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// ------------- Path parameter "resourceId" -------------
+	var resourceId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", ctx.Param("resourceId"), &resourceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.LocalAccountServiceUpdateLocalAccount(ctx, projectName, resourceId)
+	return err
+}
+
+// LocationServiceListLocations converts echo context to params.
+func (w *ServerInterfaceWrapper) LocationServiceListLocations(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectName" -------------
+	var projectName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectName", ctx.Param("projectName"), &projectName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params LocationServiceListLocationsParams
+	// ------------- Optional query parameter "name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "name", ctx.QueryParams(), &params.Name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// ------------- Optional query parameter "showSites" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "showSites", ctx.QueryParams(), &params.ShowSites)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter showSites: %s", err))
+	}
+
+	// ------------- Optional query parameter "showRegions" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "showRegions", ctx.QueryParams(), &params.ShowRegions)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter showRegions: %s", err))
+	}
+
+	// ------------- Optional query parameter "showOus" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "showOus", ctx.QueryParams(), &params.ShowOus)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter showOus: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.LocationServiceListLocations(ctx, projectName, params)
+	return err
+}
+
+// EndpointServiceListEndpoints converts echo context to params.
+func (w *ServerInterfaceWrapper) EndpointServiceListEndpoints(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "projectName" -------------
 	var projectName string
@@ -4204,21 +7598,43 @@ func (w *ServerInterfaceWrapper) InstanceServiceUpdateInstance(ctx echo.Context)
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
 	}
 
-	// ------------- Path parameter "resourceId" -------------
-	var resourceId string
+	// Parameter object where we will unmarshal all parameters from the context
+	var params EndpointServiceListEndpointsParams
+	// ------------- Optional query parameter "orderBy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "orderBy", ctx.QueryParams(), &params.OrderBy)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderBy: %s", err))
+	}
+
+	// ------------- Optional query parameter "filter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filter", ctx.QueryParams(), &params.Filter)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter filter: %s", err))
+	}
+
+	// ------------- Optional query parameter "pageSize" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pageSize", ctx.QueryParams(), &params.PageSize)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pageSize: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
 
-	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", ctx.Param("resourceId"), &resourceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.InstanceServiceUpdateInstance(ctx, projectName, resourceId)
+	err = w.Handler.EndpointServiceListEndpoints(ctx, projectName, params)
 	return err
 }
 
-// InstanceServiceInvalidateInstance converts echo context to params.
-func (w *ServerInterfaceWrapper) InstanceServiceInvalidateInstance(ctx echo.Context) error {
+// EndpointServiceGetEndpoint converts echo context to params.
+func (w *ServerInterfaceWrapper) EndpointServiceGetEndpoint(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "projectName" -------------
 	var projectName string
@@ -4237,12 +7653,12 @@ func (w *ServerInterfaceWrapper) InstanceServiceInvalidateInstance(ctx echo.Cont
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.InstanceServiceInvalidateInstance(ctx, projectName, resourceId)
+	err = w.Handler.EndpointServiceGetEndpoint(ctx, projectName, resourceId)
 	return err
 }
 
-// OperatingSystemServiceListOperatingSystems3 converts echo context to params.
-func (w *ServerInterfaceWrapper) OperatingSystemServiceListOperatingSystems3(ctx echo.Context) error {
+// IPAddressServiceListIPAddresses converts echo context to params.
+func (w *ServerInterfaceWrapper) IPAddressServiceListIPAddresses(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "projectName" -------------
 	var projectName string
@@ -4253,7 +7669,7 @@ func (w *ServerInterfaceWrapper) OperatingSystemServiceListOperatingSystems3(ctx
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params OperatingSystemServiceListOperatingSystems3Params
+	var params IPAddressServiceListIPAddressesParams
 	// ------------- Optional query parameter "orderBy" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "orderBy", ctx.QueryParams(), &params.OrderBy)
@@ -4283,12 +7699,12 @@ func (w *ServerInterfaceWrapper) OperatingSystemServiceListOperatingSystems3(ctx
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.OperatingSystemServiceListOperatingSystems3(ctx, projectName, params)
+	err = w.Handler.IPAddressServiceListIPAddresses(ctx, projectName, params)
 	return err
 }
 
-// OperatingSystemServiceCreateOperatingSystem3 converts echo context to params.
-func (w *ServerInterfaceWrapper) OperatingSystemServiceCreateOperatingSystem3(ctx echo.Context) error {
+// IPAddressServiceGetIPAddress converts echo context to params.
+func (w *ServerInterfaceWrapper) IPAddressServiceGetIPAddress(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "projectName" -------------
 	var projectName string
@@ -4298,13 +7714,21 @@ func (w *ServerInterfaceWrapper) OperatingSystemServiceCreateOperatingSystem3(ct
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
 	}
 
+	// ------------- Path parameter "resourceId" -------------
+	var resourceId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", ctx.Param("resourceId"), &resourceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.OperatingSystemServiceCreateOperatingSystem3(ctx, projectName)
+	err = w.Handler.IPAddressServiceGetIPAddress(ctx, projectName, resourceId)
 	return err
 }
 
-// OperatingSystemServiceDeleteOperatingSystem3 converts echo context to params.
-func (w *ServerInterfaceWrapper) OperatingSystemServiceDeleteOperatingSystem3(ctx echo.Context) error {
+// NetlinkServiceListNetlinks converts echo context to params.
+func (w *ServerInterfaceWrapper) NetlinkServiceListNetlinks(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "projectName" -------------
 	var projectName string
@@ -4314,21 +7738,43 @@ func (w *ServerInterfaceWrapper) OperatingSystemServiceDeleteOperatingSystem3(ct
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
 	}
 
-	// ------------- Path parameter "resourceId" -------------
-	var resourceId string
+	// Parameter object where we will unmarshal all parameters from the context
+	var params NetlinkServiceListNetlinksParams
+	// ------------- Optional query parameter "orderBy" -------------
 
-	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", ctx.Param("resourceId"), &resourceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindQueryParameter("form", true, false, "orderBy", ctx.QueryParams(), &params.OrderBy)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderBy: %s", err))
+	}
+
+	// ------------- Optional query parameter "filter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filter", ctx.QueryParams(), &params.Filter)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter filter: %s", err))
+	}
+
+	// ------------- Optional query parameter "pageSize" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pageSize", ctx.QueryParams(), &params.PageSize)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pageSize: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.OperatingSystemServiceDeleteOperatingSystem3(ctx, projectName, resourceId)
+	err = w.Handler.NetlinkServiceListNetlinks(ctx, projectName, params)
 	return err
 }
 
-// OperatingSystemServiceGetOperatingSystem3 converts echo context to params.
-func (w *ServerInterfaceWrapper) OperatingSystemServiceGetOperatingSystem3(ctx echo.Context) error {
+// NetlinkServiceGetNetlink converts echo context to params.
+func (w *ServerInterfaceWrapper) NetlinkServiceGetNetlink(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "projectName" -------------
 	var projectName string
@@ -4347,12 +7793,12 @@ func (w *ServerInterfaceWrapper) OperatingSystemServiceGetOperatingSystem3(ctx e
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.OperatingSystemServiceGetOperatingSystem3(ctx, projectName, resourceId)
+	err = w.Handler.NetlinkServiceGetNetlink(ctx, projectName, resourceId)
 	return err
 }
 
-// OperatingSystemServicePatchOperatingSystem3 converts echo context to params.
-func (w *ServerInterfaceWrapper) OperatingSystemServicePatchOperatingSystem3(ctx echo.Context) error {
+// HostnicServiceListHostnics converts echo context to params.
+func (w *ServerInterfaceWrapper) HostnicServiceListHostnics(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "projectName" -------------
 	var projectName string
@@ -4362,30 +7808,43 @@ func (w *ServerInterfaceWrapper) OperatingSystemServicePatchOperatingSystem3(ctx
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
 	}
 
-	// ------------- Path parameter "resourceId" -------------
-	var resourceId string
+	// Parameter object where we will unmarshal all parameters from the context
+	var params HostnicServiceListHostnicsParams
+	// ------------- Optional query parameter "orderBy" -------------
 
-	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", ctx.Param("resourceId"), &resourceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindQueryParameter("form", true, false, "orderBy", ctx.QueryParams(), &params.OrderBy)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderBy: %s", err))
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params OperatingSystemServicePatchOperatingSystem3Params
-	// ------------- Optional query parameter "fieldMask" -------------
+	// ------------- Optional query parameter "filter" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "fieldMask", ctx.QueryParams(), &params.FieldMask)
+	err = runtime.BindQueryParameter("form", true, false, "filter", ctx.QueryParams(), &params.Filter)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fieldMask: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter filter: %s", err))
+	}
+
+	// ------------- Optional query parameter "pageSize" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pageSize", ctx.QueryParams(), &params.PageSize)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pageSize: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.OperatingSystemServicePatchOperatingSystem3(ctx, projectName, resourceId, params)
+	err = w.Handler.HostnicServiceListHostnics(ctx, projectName, params)
 	return err
 }
 
-// OperatingSystemServiceUpdateOperatingSystem3 converts echo context to params.
-func (w *ServerInterfaceWrapper) OperatingSystemServiceUpdateOperatingSystem3(ctx echo.Context) error {
+// HostnicServiceGetHostnic converts echo context to params.
+func (w *ServerInterfaceWrapper) HostnicServiceGetHostnic(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "projectName" -------------
 	var projectName string
@@ -4404,12 +7863,12 @@ func (w *ServerInterfaceWrapper) OperatingSystemServiceUpdateOperatingSystem3(ct
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.OperatingSystemServiceUpdateOperatingSystem3(ctx, projectName, resourceId)
+	err = w.Handler.HostnicServiceGetHostnic(ctx, projectName, resourceId)
 	return err
 }
 
-// OperatingSystemServiceListOperatingSystems converts echo context to params.
-func (w *ServerInterfaceWrapper) OperatingSystemServiceListOperatingSystems(ctx echo.Context) error {
+// NetworkSegmentServiceListNetworkSegments converts echo context to params.
+func (w *ServerInterfaceWrapper) NetworkSegmentServiceListNetworkSegments(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "projectName" -------------
 	var projectName string
@@ -4420,7 +7879,7 @@ func (w *ServerInterfaceWrapper) OperatingSystemServiceListOperatingSystems(ctx
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params OperatingSystemServiceListOperatingSystemsParams
+	var params NetworkSegmentServiceListNetworkSegmentsParams
 	// ------------- Optional query parameter "orderBy" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "orderBy", ctx.QueryParams(), &params.OrderBy)
@@ -4450,12 +7909,12 @@ func (w *ServerInterfaceWrapper) OperatingSystemServiceListOperatingSystems(ctx
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.OperatingSystemServiceListOperatingSystems(ctx, projectName, params)
+	err = w.Handler.NetworkSegmentServiceListNetworkSegments(ctx, projectName, params)
 	return err
 }
 
-// OperatingSystemServiceCreateOperatingSystem converts echo context to params.
-func (w *ServerInterfaceWrapper) OperatingSystemServiceCreateOperatingSystem(ctx echo.Context) error {
+// NetworkSegmentServiceCreateNetworkSegment converts echo context to params.
+func (w *ServerInterfaceWrapper) NetworkSegmentServiceCreateNetworkSegment(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "projectName" -------------
 	var projectName string
@@ -4466,12 +7925,12 @@ func (w *ServerInterfaceWrapper) OperatingSystemServiceCreateOperatingSystem(ctx
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.OperatingSystemServiceCreateOperatingSystem(ctx, projectName)
+	err = w.Handler.NetworkSegmentServiceCreateNetworkSegment(ctx, projectName)
 	return err
 }
 
-// OperatingSystemServiceDeleteOperatingSystem converts echo context to params.
-func (w *ServerInterfaceWrapper) OperatingSystemServiceDeleteOperatingSystem(ctx echo.Context) error {
+// NetworkSegmentServiceDeleteNetworkSegment converts echo context to params.
+func (w *ServerInterfaceWrapper) NetworkSegmentServiceDeleteNetworkSegment(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "projectName" -------------
 	var projectName string
@@ -4490,12 +7949,12 @@ func (w *ServerInterfaceWrapper) OperatingSystemServiceDeleteOperatingSystem(ctx
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.OperatingSystemServiceDeleteOperatingSystem(ctx, projectName, resourceId)
+	err = w.Handler.NetworkSegmentServiceDeleteNetworkSegment(ctx, projectName, resourceId)
 	return err
 }
 
-// OperatingSystemServiceGetOperatingSystem converts echo context to params.
-func (w *ServerInterfaceWrapper) OperatingSystemServiceGetOperatingSystem(ctx echo.Context) error {
+// NetworkSegmentServiceGetNetworkSegment converts echo context to params.
+func (w *ServerInterfaceWrapper) NetworkSegmentServiceGetNetworkSegment(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "projectName" -------------
 	var projectName string
@@ -4514,12 +7973,12 @@ func (w *ServerInterfaceWrapper) OperatingSystemServiceGetOperatingSystem(ctx ec
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.OperatingSystemServiceGetOperatingSystem(ctx, projectName, resourceId)
+	err = w.Handler.NetworkSegmentServiceGetNetworkSegment(ctx, projectName, resourceId)
 	return err
 }
 
-// OperatingSystemServicePatchOperatingSystem converts echo context to params.
-func (w *ServerInterfaceWrapper) OperatingSystemServicePatchOperatingSystem(ctx echo.Context) error {
+// NetworkSegmentServicePatchNetworkSegment converts echo context to params.
+func (w *ServerInterfaceWrapper) NetworkSegmentServicePatchNetworkSegment(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "projectName" -------------
 	var projectName string
@@ -4538,7 +7997,7 @@ func (w *ServerInterfaceWrapper) OperatingSystemServicePatchOperatingSystem(ctx
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params OperatingSystemServicePatchOperatingSystemParams
+	var params NetworkSegmentServicePatchNetworkSegmentParams
 	// ------------- Optional query parameter "fieldMask" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "fieldMask", ctx.QueryParams(), &params.FieldMask)
@@ -4547,12 +8006,12 @@ func (w *ServerInterfaceWrapper) OperatingSystemServicePatchOperatingSystem(ctx
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.OperatingSystemServicePatchOperatingSystem(ctx, projectName, resourceId, params)
+	err = w.Handler.NetworkSegmentServicePatchNetworkSegment(ctx, projectName, resourceId, params)
 	return err
 }
 
-// OperatingSystemServiceUpdateOperatingSystem converts echo context to params.
-func (w *ServerInterfaceWrapper) OperatingSystemServiceUpdateOperatingSystem(ctx echo.Context) error {
+// NetworkSegmentServiceUpdateNetworkSegment converts echo context to params.
+func (w *ServerInterfaceWrapper) NetworkSegmentServiceUpdateNetworkSegment(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "projectName" -------------
 	var projectName string
@@ -4571,12 +8030,12 @@ func (w *ServerInterfaceWrapper) OperatingSystemServiceUpdateOperatingSystem(ctx
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.OperatingSystemServiceUpdateOperatingSystem(ctx, projectName, resourceId)
+	err = w.Handler.NetworkSegmentServiceUpdateNetworkSegment(ctx, projectName, resourceId)
 	return err
 }
 
-// ScheduleServiceListSchedules converts echo context to params.
-func (w *ServerInterfaceWrapper) ScheduleServiceListSchedules(ctx echo.Context) error {
+// OperationServiceListOperations converts echo context to params.
+func (w *ServerInterfaceWrapper) OperationServiceListOperations(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "projectName" -------------
 	var projectName string
@@ -4587,7 +8046,7 @@ func (w *ServerInterfaceWrapper) ScheduleServiceListSchedules(ctx echo.Context)
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ScheduleServiceListSchedulesParams
+	var params OperationServiceListOperationsParams
 	// ------------- Optional query parameter "pageSize" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "pageSize", ctx.QueryParams(), &params.PageSize)
@@ -4602,41 +8061,13 @@ func (w *ServerInterfaceWrapper) ScheduleServiceListSchedules(ctx echo.Context)
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
 	}
 
-	// ------------- Optional query parameter "hostId" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "hostId", ctx.QueryParams(), &params.HostId)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter hostId: %s", err))
-	}
-
-	// ------------- Optional query parameter "siteId" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "siteId", ctx.QueryParams(), &params.SiteId)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter siteId: %s", err))
-	}
-
-	// ------------- Optional query parameter "regionId" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "regionId", ctx.QueryParams(), &params.RegionId)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter regionId: %s", err))
-	}
-
-	// ------------- Optional query parameter "unixEpoch" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "unixEpoch", ctx.QueryParams(), &params.UnixEpoch)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter unixEpoch: %s", err))
-	}
-
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ScheduleServiceListSchedules(ctx, projectName, params)
+	err = w.Handler.OperationServiceListOperations(ctx, projectName, params)
 	return err
 }
 
-// ScheduleServiceListRepeatedSchedules3 converts echo context to params.
-func (w *ServerInterfaceWrapper) ScheduleServiceListRepeatedSchedules3(ctx echo.Context) error {
+// OperationServiceGetOperation converts echo context to params.
+func (w *ServerInterfaceWrapper) OperationServiceGetOperation(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "projectName" -------------
 	var projectName string
@@ -4646,57 +8077,45 @@ func (w *ServerInterfaceWrapper) ScheduleServiceListRepeatedSchedules3(ctx echo.
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ScheduleServiceListRepeatedSchedules3Params
-	// ------------- Optional query parameter "pageSize" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "pageSize", ctx.QueryParams(), &params.PageSize)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pageSize: %s", err))
-	}
-
-	// ------------- Optional query parameter "offset" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
-	}
-
-	// ------------- Optional query parameter "hostId" -------------
+	// ------------- Path parameter "resourceId" -------------
+	var resourceId string
 
-	err = runtime.BindQueryParameter("form", true, false, "hostId", ctx.QueryParams(), &params.HostId)
+	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", ctx.Param("resourceId"), &resourceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter hostId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
 	}
 
-	// ------------- Optional query parameter "siteId" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "siteId", ctx.QueryParams(), &params.SiteId)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter siteId: %s", err))
-	}
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.OperationServiceGetOperation(ctx, projectName, resourceId)
+	return err
+}
 
-	// ------------- Optional query parameter "regionId" -------------
+// OperationServiceCancelOperation converts echo context to params.
+func (w *ServerInterfaceWrapper) OperationServiceCancelOperation(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectName" -------------
+	var projectName string
 
-	err = runtime.BindQueryParameter("form", true, false, "regionId", ctx.QueryParams(), &params.RegionId)
+	err = runtime.BindStyledParameterWithOptions("simple", "projectName", ctx.Param("projectName"), &projectName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter regionId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
 	}
 
-	// ------------- Optional query parameter "unixEpoch" -------------
+	// ------------- Path parameter "resourceId" -------------
+	var resourceId string
 
-	err = runtime.BindQueryParameter("form", true, false, "unixEpoch", ctx.QueryParams(), &params.UnixEpoch)
+	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", ctx.Param("resourceId"), &resourceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter unixEpoch: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ScheduleServiceListRepeatedSchedules3(ctx, projectName, params)
+	err = w.Handler.OperationServiceCancelOperation(ctx, projectName, resourceId)
 	return err
 }
 
-// ScheduleServiceCreateRepeatedSchedule3 converts echo context to params.
-func (w *ServerInterfaceWrapper) ScheduleServiceCreateRepeatedSchedule3(ctx echo.Context) error {
+// OSUpdatePolicyListOSUpdatePolicy converts echo context to params.
+func (w *ServerInterfaceWrapper) OSUpdatePolicyListOSUpdatePolicy(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "projectName" -------------
 	var projectName string
@@ -4706,37 +8125,43 @@ func (w *ServerInterfaceWrapper) ScheduleServiceCreateRepeatedSchedule3(ctx echo
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
 	}
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ScheduleServiceCreateRepeatedSchedule3(ctx, projectName)
-	return err
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params OSUpdatePolicyListOSUpdatePolicyParams
+	// ------------- Optional query parameter "orderBy" -------------
 
-// ScheduleServiceDeleteRepeatedSchedule3 converts echo context to params.
-func (w *ServerInterfaceWrapper) ScheduleServiceDeleteRepeatedSchedule3(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "projectName" -------------
-	var projectName string
+	err = runtime.BindQueryParameter("form", true, false, "orderBy", ctx.QueryParams(), &params.OrderBy)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderBy: %s", err))
+	}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "projectName", ctx.Param("projectName"), &projectName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	// ------------- Optional query parameter "filter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filter", ctx.QueryParams(), &params.Filter)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter filter: %s", err))
 	}
 
-	// ------------- Path parameter "resourceId" -------------
-	var resourceId string
+	// ------------- Optional query parameter "pageSize" -------------
 
-	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", ctx.Param("resourceId"), &resourceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindQueryParameter("form", true, false, "pageSize", ctx.QueryParams(), &params.PageSize)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pageSize: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ScheduleServiceDeleteRepeatedSchedule3(ctx, projectName, resourceId)
+	err = w.Handler.OSUpdatePolicyListOSUpdatePolicy(ctx, projectName, params)
 	return err
 }
 
-// ScheduleServiceGetRepeatedSchedule3 converts echo context to params.
-func (w *ServerInterfaceWrapper) ScheduleServiceGetRepeatedSchedule3(ctx echo.Context) error {
+// OSUpdatePolicyCreateOSUpdatePolicy converts echo context to params.
+func (w *ServerInterfaceWrapper) OSUpdatePolicyCreateOSUpdatePolicy(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "projectName" -------------
 	var projectName string
@@ -4746,21 +8171,13 @@ func (w *ServerInterfaceWrapper) ScheduleServiceGetRepeatedSchedule3(ctx echo.Co
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
 	}
 
-	// ------------- Path parameter "resourceId" -------------
-	var resourceId string
-
-	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", ctx.Param("resourceId"), &resourceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
-	}
-
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ScheduleServiceGetRepeatedSchedule3(ctx, projectName, resourceId)
+	err = w.Handler.OSUpdatePolicyCreateOSUpdatePolicy(ctx, projectName)
 	return err
 }
 
-// ScheduleServicePatchRepeatedSchedule3 converts echo context to params.
-func (w *ServerInterfaceWrapper) ScheduleServicePatchRepeatedSchedule3(ctx echo.Context) error {
+// OSUpdatePolicyDeleteOSUpdatePolicy converts echo context to params.
+func (w *ServerInterfaceWrapper) OSUpdatePolicyDeleteOSUpdatePolicy(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "projectName" -------------
 	var projectName string
@@ -4778,22 +8195,13 @@ func (w *ServerInterfaceWrapper) ScheduleServicePatchRepeatedSchedule3(ctx echo.
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ScheduleServicePatchRepeatedSchedule3Params
-	// ------------- Optional query parameter "fieldMask" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "fieldMask", ctx.QueryParams(), &params.FieldMask)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fieldMask: %s", err))
-	}
-
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ScheduleServicePatchRepeatedSchedule3(ctx, projectName, resourceId, params)
+	err = w.Handler.OSUpdatePolicyDeleteOSUpdatePolicy(ctx, projectName, resourceId)
 	return err
 }
 
-// ScheduleServiceUpdateRepeatedSchedule3 converts echo context to params.
-func (w *ServerInterfaceWrapper) ScheduleServiceUpdateRepeatedSchedule3(ctx echo.Context) error {
+// OSUpdatePolicyGetOSUpdatePolicy converts echo context to params.
+func (w *ServerInterfaceWrapper) OSUpdatePolicyGetOSUpdatePolicy(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "projectName" -------------
 	var projectName string
@@ -4812,12 +8220,12 @@ func (w *ServerInterfaceWrapper) ScheduleServiceUpdateRepeatedSchedule3(ctx echo
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ScheduleServiceUpdateRepeatedSchedule3(ctx, projectName, resourceId)
+	err = w.Handler.OSUpdatePolicyGetOSUpdatePolicy(ctx, projectName, resourceId)
 	return err
 }
 
-// ScheduleServiceListSingleSchedules3 converts echo context to params.
-func (w *ServerInterfaceWrapper) ScheduleServiceListSingleSchedules3(ctx echo.Context) error {
+// OSUpdatePolicyPatchOSUpdatePolicy converts echo context to params.
+func (w *ServerInterfaceWrapper) OSUpdatePolicyPatchOSUpdatePolicy(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "projectName" -------------
 	var projectName string
@@ -4827,57 +8235,30 @@ func (w *ServerInterfaceWrapper) ScheduleServiceListSingleSchedules3(ctx echo.Co
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ScheduleServiceListSingleSchedules3Params
-	// ------------- Optional query parameter "pageSize" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "pageSize", ctx.QueryParams(), &params.PageSize)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pageSize: %s", err))
-	}
-
-	// ------------- Optional query parameter "offset" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
-	}
-
-	// ------------- Optional query parameter "hostId" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "hostId", ctx.QueryParams(), &params.HostId)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter hostId: %s", err))
-	}
-
-	// ------------- Optional query parameter "siteId" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "siteId", ctx.QueryParams(), &params.SiteId)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter siteId: %s", err))
-	}
-
-	// ------------- Optional query parameter "regionId" -------------
+	// ------------- Path parameter "resourceId" -------------
+	var resourceId string
 
-	err = runtime.BindQueryParameter("form", true, false, "regionId", ctx.QueryParams(), &params.RegionId)
+	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", ctx.Param("resourceId"), &resourceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter regionId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
 	}
 
-	// ------------- Optional query parameter "unixEpoch" -------------
+	// Parameter object where we will unmarshal all parameters from the context
+	var params OSUpdatePolicyPatchOSUpdatePolicyParams
+	// ------------- Optional query parameter "fieldMask" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "unixEpoch", ctx.QueryParams(), &params.UnixEpoch)
+	err = runtime.BindQueryParameter("form", true, false, "fieldMask", ctx.QueryParams(), &params.FieldMask)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter unixEpoch: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fieldMask: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ScheduleServiceListSingleSchedules3(ctx, projectName, params)
+	err = w.Handler.OSUpdatePolicyPatchOSUpdatePolicy(ctx, projectName, resourceId, params)
 	return err
 }
 
-// ScheduleServiceCreateSingleSchedule3 converts echo context to params.
-func (w *ServerInterfaceWrapper) ScheduleServiceCreateSingleSchedule3(ctx echo.Context) error {
+// OSUpdatePolicyUpdateOSUpdatePolicy converts echo context to params.
+func (w *ServerInterfaceWrapper) OSUpdatePolicyUpdateOSUpdatePolicy(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "projectName" -------------
 	var projectName string
@@ -4887,13 +8268,21 @@ func (w *ServerInterfaceWrapper) ScheduleServiceCreateSingleSchedule3(ctx echo.C
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
 	}
 
+	// ------------- Path parameter "resourceId" -------------
+	var resourceId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", ctx.Param("resourceId"), &resourceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ScheduleServiceCreateSingleSchedule3(ctx, projectName)
+	err = w.Handler.OSUpdatePolicyUpdateOSUpdatePolicy(ctx, projectName, resourceId)
 	return err
 }
 
-// ScheduleServiceDeleteSingleSchedule3 converts echo context to params.
-func (w *ServerInterfaceWrapper) ScheduleServiceDeleteSingleSchedule3(ctx echo.Context) error {
+// OSUpdateRunListOSUpdateRun converts echo context to params.
+func (w *ServerInterfaceWrapper) OSUpdateRunListOSUpdateRun(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "projectName" -------------
 	var projectName string
@@ -4903,45 +8292,43 @@ func (w *ServerInterfaceWrapper) ScheduleServiceDeleteSingleSchedule3(ctx echo.C
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
 	}
 
-	// ------------- Path parameter "resourceId" -------------
-	var resourceId string
+	// Parameter object where we will unmarshal all parameters from the context
+	var params OSUpdateRunListOSUpdateRunParams
+	// ------------- Optional query parameter "orderBy" -------------
 
-	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", ctx.Param("resourceId"), &resourceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindQueryParameter("form", true, false, "orderBy", ctx.QueryParams(), &params.OrderBy)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderBy: %s", err))
 	}
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ScheduleServiceDeleteSingleSchedule3(ctx, projectName, resourceId)
-	return err
-}
+	// ------------- Optional query parameter "filter" -------------
 
-// ScheduleServiceGetSingleSchedule3 converts echo context to params.
-func (w *ServerInterfaceWrapper) ScheduleServiceGetSingleSchedule3(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "projectName" -------------
-	var projectName string
+	err = runtime.BindQueryParameter("form", true, false, "filter", ctx.QueryParams(), &params.Filter)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter filter: %s", err))
+	}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "projectName", ctx.Param("projectName"), &projectName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	// ------------- Optional query parameter "pageSize" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pageSize", ctx.QueryParams(), &params.PageSize)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pageSize: %s", err))
 	}
 
-	// ------------- Path parameter "resourceId" -------------
-	var resourceId string
+	// ------------- Optional query parameter "offset" -------------
 
-	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", ctx.Param("resourceId"), &resourceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ScheduleServiceGetSingleSchedule3(ctx, projectName, resourceId)
+	err = w.Handler.OSUpdateRunListOSUpdateRun(ctx, projectName, params)
 	return err
 }
 
-// ScheduleServicePatchSingleSchedule3 converts echo context to params.
-func (w *ServerInterfaceWrapper) ScheduleServicePatchSingleSchedule3(ctx echo.Context) error {
+// OSUpdateRunDeleteOSUpdateRun converts echo context to params.
+func (w *ServerInterfaceWrapper) OSUpdateRunDeleteOSUpdateRun(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "projectName" -------------
 	var projectName string
@@ -4959,22 +8346,13 @@ func (w *ServerInterfaceWrapper) ScheduleServicePatchSingleSchedule3(ctx echo.Co
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ScheduleServicePatchSingleSchedule3Params
-	// ------------- Optional query parameter "fieldMask" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "fieldMask", ctx.QueryParams(), &params.FieldMask)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fieldMask: %s", err))
-	}
-
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ScheduleServicePatchSingleSchedule3(ctx, projectName, resourceId, params)
+	err = w.Handler.OSUpdateRunDeleteOSUpdateRun(ctx, projectName, resourceId)
 	return err
 }
 
-// ScheduleServiceUpdateSingleSchedule3 converts echo context to params.
-func (w *ServerInterfaceWrapper) ScheduleServiceUpdateSingleSchedule3(ctx echo.Context) error {
+// OSUpdateRunGetOSUpdateRun converts echo context to params.
+func (w *ServerInterfaceWrapper) OSUpdateRunGetOSUpdateRun(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "projectName" -------------
 	var projectName string
@@ -4993,12 +8371,12 @@ func (w *ServerInterfaceWrapper) ScheduleServiceUpdateSingleSchedule3(ctx echo.C
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ScheduleServiceUpdateSingleSchedule3(ctx, projectName, resourceId)
+	err = w.Handler.OSUpdateRunGetOSUpdateRun(ctx, projectName, resourceId)
 	return err
 }
 
-// WorkloadServiceListWorkloads converts echo context to params.
-func (w *ServerInterfaceWrapper) WorkloadServiceListWorkloads(ctx echo.Context) error {
+// OSUpdatePolicyListOSUpdatePolicy3 converts echo context to params.
+func (w *ServerInterfaceWrapper) OSUpdatePolicyListOSUpdatePolicy3(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "projectName" -------------
 	var projectName string
@@ -5009,7 +8387,7 @@ func (w *ServerInterfaceWrapper) WorkloadServiceListWorkloads(ctx echo.Context)
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params WorkloadServiceListWorkloadsParams
+	var params OSUpdatePolicyListOSUpdatePolicy3Params
 	// ------------- Optional query parameter "orderBy" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "orderBy", ctx.QueryParams(), &params.OrderBy)
@@ -5039,12 +8417,12 @@ func (w *ServerInterfaceWrapper) WorkloadServiceListWorkloads(ctx echo.Context)
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.WorkloadServiceListWorkloads(ctx, projectName, params)
+	err = w.Handler.OSUpdatePolicyListOSUpdatePolicy3(ctx, projectName, params)
 	return err
 }
 
-// WorkloadServiceCreateWorkload converts echo context to params.
-func (w *ServerInterfaceWrapper) WorkloadServiceCreateWorkload(ctx echo.Context) error {
+// OSUpdatePolicyCreateOSUpdatePolicy3 converts echo context to params.
+func (w *ServerInterfaceWrapper) OSUpdatePolicyCreateOSUpdatePolicy3(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "projectName" -------------
 	var projectName string
@@ -5055,12 +8433,12 @@ func (w *ServerInterfaceWrapper) WorkloadServiceCreateWorkload(ctx echo.Context)
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.WorkloadServiceCreateWorkload(ctx, projectName)
+	err = w.Handler.OSUpdatePolicyCreateOSUpdatePolicy3(ctx, projectName)
 	return err
 }
 
-// WorkloadServiceDeleteWorkload converts echo context to params.
-func (w *ServerInterfaceWrapper) WorkloadServiceDeleteWorkload(ctx echo.Context) error {
+// OSUpdatePolicyDeleteOSUpdatePolicy3 converts echo context to params.
+func (w *ServerInterfaceWrapper) OSUpdatePolicyDeleteOSUpdatePolicy3(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "projectName" -------------
 	var projectName string
@@ -5079,12 +8457,12 @@ func (w *ServerInterfaceWrapper) WorkloadServiceDeleteWorkload(ctx echo.Context)
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.WorkloadServiceDeleteWorkload(ctx, projectName, resourceId)
+	err = w.Handler.OSUpdatePolicyDeleteOSUpdatePolicy3(ctx, projectName, resourceId)
 	return err
 }
 
-// WorkloadServiceGetWorkload converts echo context to params.
-func (w *ServerInterfaceWrapper) WorkloadServiceGetWorkload(ctx echo.Context) error {
+// OSUpdatePolicyGetOSUpdatePolicy3 converts echo context to params.
+func (w *ServerInterfaceWrapper) OSUpdatePolicyGetOSUpdatePolicy3(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "projectName" -------------
 	var projectName string
@@ -5103,12 +8481,12 @@ func (w *ServerInterfaceWrapper) WorkloadServiceGetWorkload(ctx echo.Context) er
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.WorkloadServiceGetWorkload(ctx, projectName, resourceId)
+	err = w.Handler.OSUpdatePolicyGetOSUpdatePolicy3(ctx, projectName, resourceId)
 	return err
 }
 
-// WorkloadServicePatchWorkload converts echo context to params.
-func (w *ServerInterfaceWrapper) WorkloadServicePatchWorkload(ctx echo.Context) error {
+// OSUpdatePolicyPatchOSUpdatePolicy3 converts echo context to params.
+func (w *ServerInterfaceWrapper) OSUpdatePolicyPatchOSUpdatePolicy3(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "projectName" -------------
 	var projectName string
@@ -5127,7 +8505,7 @@ func (w *ServerInterfaceWrapper) WorkloadServicePatchWorkload(ctx echo.Context)
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params WorkloadServicePatchWorkloadParams
+	var params OSUpdatePolicyPatchOSUpdatePolicy3Params
 	// ------------- Optional query parameter "fieldMask" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "fieldMask", ctx.QueryParams(), &params.FieldMask)
@@ -5136,12 +8514,12 @@ func (w *ServerInterfaceWrapper) WorkloadServicePatchWorkload(ctx echo.Context)
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.WorkloadServicePatchWorkload(ctx, projectName, resourceId, params)
+	err = w.Handler.OSUpdatePolicyPatchOSUpdatePolicy3(ctx, projectName, resourceId, params)
 	return err
 }
 
-// WorkloadServiceUpdateWorkload converts echo context to params.
-func (w *ServerInterfaceWrapper) WorkloadServiceUpdateWorkload(ctx echo.Context) error {
+// OSUpdatePolicyUpdateOSUpdatePolicy3 converts echo context to params.
+func (w *ServerInterfaceWrapper) OSUpdatePolicyUpdateOSUpdatePolicy3(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "projectName" -------------
 	var projectName string
@@ -5160,12 +8538,12 @@ func (w *ServerInterfaceWrapper) WorkloadServiceUpdateWorkload(ctx echo.Context)
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.WorkloadServiceUpdateWorkload(ctx, projectName, resourceId)
+	err = w.Handler.OSUpdatePolicyUpdateOSUpdatePolicy3(ctx, projectName, resourceId)
 	return err
 }
 
-// CustomConfigServiceListCustomConfigs converts echo context to params.
-func (w *ServerInterfaceWrapper) CustomConfigServiceListCustomConfigs(ctx echo.Context) error {
+// OSUpdateRunListOSUpdateRun3 converts echo context to params.
+func (w *ServerInterfaceWrapper) OSUpdateRunListOSUpdateRun3(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "projectName" -------------
 	var projectName string
@@ -5176,7 +8554,7 @@ func (w *ServerInterfaceWrapper) CustomConfigServiceListCustomConfigs(ctx echo.C
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params CustomConfigServiceListCustomConfigsParams
+	var params OSUpdateRunListOSUpdateRun3Params
 	// ------------- Optional query parameter "orderBy" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "orderBy", ctx.QueryParams(), &params.OrderBy)
@@ -5206,28 +8584,12 @@ func (w *ServerInterfaceWrapper) CustomConfigServiceListCustomConfigs(ctx echo.C
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.CustomConfigServiceListCustomConfigs(ctx, projectName, params)
-	return err
-}
-
-// CustomConfigServiceCreateCustomConfig converts echo context to params.
-func (w *ServerInterfaceWrapper) CustomConfigServiceCreateCustomConfig(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "projectName" -------------
-	var projectName string
-
-	err = runtime.BindStyledParameterWithOptions("simple", "projectName", ctx.Param("projectName"), &projectName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
-	}
-
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.CustomConfigServiceCreateCustomConfig(ctx, projectName)
+	err = w.Handler.OSUpdateRunListOSUpdateRun3(ctx, projectName, params)
 	return err
 }
 
-// CustomConfigServiceDeleteCustomConfig converts echo context to params.
-func (w *ServerInterfaceWrapper) CustomConfigServiceDeleteCustomConfig(ctx echo.Context) error {
+// OSUpdateRunDeleteOSUpdateRun3 converts echo context to params.
+func (w *ServerInterfaceWrapper) OSUpdateRunDeleteOSUpdateRun3(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "projectName" -------------
 	var projectName string
@@ -5246,12 +8608,12 @@ func (w *ServerInterfaceWrapper) CustomConfigServiceDeleteCustomConfig(ctx echo.
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.CustomConfigServiceDeleteCustomConfig(ctx, projectName, resourceId)
+	err = w.Handler.OSUpdateRunDeleteOSUpdateRun3(ctx, projectName, resourceId)
 	return err
 }
 
-// CustomConfigServiceGetCustomConfig converts echo context to params.
-func (w *ServerInterfaceWrapper) CustomConfigServiceGetCustomConfig(ctx echo.Context) error {
+// OSUpdateRunGetOSUpdateRun3 converts echo context to params.
+func (w *ServerInterfaceWrapper) OSUpdateRunGetOSUpdateRun3(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "projectName" -------------
 	var projectName string
@@ -5270,12 +8632,12 @@ func (w *ServerInterfaceWrapper) CustomConfigServiceGetCustomConfig(ctx echo.Con
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.CustomConfigServiceGetCustomConfig(ctx, projectName, resourceId)
+	err = w.Handler.OSUpdateRunGetOSUpdateRun3(ctx, projectName, resourceId)
 	return err
 }
 
-// LocalAccountServiceListLocalAccounts converts echo context to params.
-func (w *ServerInterfaceWrapper) LocalAccountServiceListLocalAccounts(ctx echo.Context) error {
+// OuServiceListOus converts echo context to params.
+func (w *ServerInterfaceWrapper) OuServiceListOus(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "projectName" -------------
 	var projectName string
@@ -5286,7 +8648,7 @@ func (w *ServerInterfaceWrapper) LocalAccountServiceListLocalAccounts(ctx echo.C
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params LocalAccountServiceListLocalAccountsParams
+	var params OuServiceListOusParams
 	// ------------- Optional query parameter "orderBy" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "orderBy", ctx.QueryParams(), &params.OrderBy)
@@ -5316,12 +8678,12 @@ func (w *ServerInterfaceWrapper) LocalAccountServiceListLocalAccounts(ctx echo.C
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.LocalAccountServiceListLocalAccounts(ctx, projectName, params)
+	err = w.Handler.OuServiceListOus(ctx, projectName, params)
 	return err
 }
 
-// LocalAccountServiceCreateLocalAccount converts echo context to params.
-func (w *ServerInterfaceWrapper) LocalAccountServiceCreateLocalAccount(ctx echo.Context) error {
+// OuServiceCreateOu converts echo context to params.
+func (w *ServerInterfaceWrapper) OuServiceCreateOu(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "projectName" -------------
 	var projectName string
@@ -5332,12 +8694,12 @@ func (w *ServerInterfaceWrapper) LocalAccountServiceCreateLocalAccount(ctx echo.
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.LocalAccountServiceCreateLocalAccount(ctx, projectName)
+	err = w.Handler.OuServiceCreateOu(ctx, projectName)
 	return err
 }
 
-// LocalAccountServiceDeleteLocalAccount converts echo context to params.
-func (w *ServerInterfaceWrapper) LocalAccountServiceDeleteLocalAccount(ctx echo.Context) error {
+// OuServiceDeleteOu converts echo context to params.
+func (w *ServerInterfaceWrapper) OuServiceDeleteOu(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "projectName" -------------
 	var projectName string
@@ -5356,12 +8718,12 @@ func (w *ServerInterfaceWrapper) LocalAccountServiceDeleteLocalAccount(ctx echo.
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.LocalAccountServiceDeleteLocalAccount(ctx, projectName, resourceId)
+	err = w.Handler.OuServiceDeleteOu(ctx, projectName, resourceId)
 	return err
 }
 
-// LocalAccountServiceGetLocalAccount converts echo context to params.
-func (w *ServerInterfaceWrapper) LocalAccountServiceGetLocalAccount(ctx echo.Context) error {
+// OuServiceGetOu converts echo context to params.
+func (w *ServerInterfaceWrapper) OuServiceGetOu(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "projectName" -------------
 	var projectName string
@@ -5380,12 +8742,12 @@ func (w *ServerInterfaceWrapper) LocalAccountServiceGetLocalAccount(ctx echo.Con
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.LocalAccountServiceGetLocalAccount(ctx, projectName, resourceId)
+	err = w.Handler.OuServiceGetOu(ctx, projectName, resourceId)
 	return err
 }
 
-// LocationServiceListLocations converts echo context to params.
-func (w *ServerInterfaceWrapper) LocationServiceListLocations(ctx echo.Context) error {
+// OuServicePatchOu converts echo context to params.
+func (w *ServerInterfaceWrapper) OuServicePatchOu(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "projectName" -------------
 	var projectName string
@@ -5395,36 +8757,54 @@ func (w *ServerInterfaceWrapper) LocationServiceListLocations(ctx echo.Context)
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
 	}
 
+	// ------------- Path parameter "resourceId" -------------
+	var resourceId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", ctx.Param("resourceId"), &resourceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
+	}
+
 	// Parameter object where we will unmarshal all parameters from the context
-	var params LocationServiceListLocationsParams
-	// ------------- Optional query parameter "name" -------------
+	var params OuServicePatchOuParams
+	// ------------- Optional query parameter "fieldMask" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "name", ctx.QueryParams(), &params.Name)
+	err = runtime.BindQueryParameter("form", true, false, "fieldMask", ctx.QueryParams(), &params.FieldMask)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fieldMask: %s", err))
 	}
 
-	// ------------- Optional query parameter "showSites" -------------
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.OuServicePatchOu(ctx, projectName, resourceId, params)
+	return err
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "showSites", ctx.QueryParams(), &params.ShowSites)
+// OuServiceUpdateOu converts echo context to params.
+func (w *ServerInterfaceWrapper) OuServiceUpdateOu(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectName" -------------
+	var projectName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectName", ctx.Param("projectName"), &projectName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter showSites: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
 	}
 
-	// ------------- Optional query parameter "showRegions" -------------
+	// ------------- Path parameter "resourceId" -------------
+	var resourceId string
 
-	err = runtime.BindQueryParameter("form", true, false, "showRegions", ctx.QueryParams(), &params.ShowRegions)
+	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", ctx.Param("resourceId"), &resourceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter showRegions: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.LocationServiceListLocations(ctx, projectName, params)
+	err = w.Handler.OuServiceUpdateOu(ctx, projectName, resourceId)
 	return err
 }
 
-// OSUpdatePolicyListOSUpdatePolicy converts echo context to params.
-func (w *ServerInterfaceWrapper) OSUpdatePolicyListOSUpdatePolicy(ctx echo.Context) error {
+// ProviderServiceListProviders converts echo context to params.
+func (w *ServerInterfaceWrapper) ProviderServiceListProviders(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "projectName" -------------
 	var projectName string
@@ -5435,7 +8815,7 @@ func (w *ServerInterfaceWrapper) OSUpdatePolicyListOSUpdatePolicy(ctx echo.Conte
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params OSUpdatePolicyListOSUpdatePolicyParams
+	var params ProviderServiceListProvidersParams
 	// ------------- Optional query parameter "orderBy" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "orderBy", ctx.QueryParams(), &params.OrderBy)
@@ -5465,12 +8845,12 @@ func (w *ServerInterfaceWrapper) OSUpdatePolicyListOSUpdatePolicy(ctx echo.Conte
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.OSUpdatePolicyListOSUpdatePolicy(ctx, projectName, params)
+	err = w.Handler.ProviderServiceListProviders(ctx, projectName, params)
 	return err
 }
 
-// OSUpdatePolicyCreateOSUpdatePolicy converts echo context to params.
-func (w *ServerInterfaceWrapper) OSUpdatePolicyCreateOSUpdatePolicy(ctx echo.Context) error {
+// ProviderServiceCreateProvider converts echo context to params.
+func (w *ServerInterfaceWrapper) ProviderServiceCreateProvider(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "projectName" -------------
 	var projectName string
@@ -5481,12 +8861,12 @@ func (w *ServerInterfaceWrapper) OSUpdatePolicyCreateOSUpdatePolicy(ctx echo.Con
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.OSUpdatePolicyCreateOSUpdatePolicy(ctx, projectName)
+	err = w.Handler.ProviderServiceCreateProvider(ctx, projectName)
 	return err
 }
 
-// OSUpdatePolicyDeleteOSUpdatePolicy converts echo context to params.
-func (w *ServerInterfaceWrapper) OSUpdatePolicyDeleteOSUpdatePolicy(ctx echo.Context) error {
+// ProviderServiceDeleteProvider converts echo context to params.
+func (w *ServerInterfaceWrapper) ProviderServiceDeleteProvider(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "projectName" -------------
 	var projectName string
@@ -5505,12 +8885,12 @@ func (w *ServerInterfaceWrapper) OSUpdatePolicyDeleteOSUpdatePolicy(ctx echo.Con
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.OSUpdatePolicyDeleteOSUpdatePolicy(ctx, projectName, resourceId)
+	err = w.Handler.ProviderServiceDeleteProvider(ctx, projectName, resourceId)
 	return err
 }
 
-// OSUpdatePolicyGetOSUpdatePolicy converts echo context to params.
-func (w *ServerInterfaceWrapper) OSUpdatePolicyGetOSUpdatePolicy(ctx echo.Context) error {
+// ProviderServiceGetProvider converts echo context to params.
+func (w *ServerInterfaceWrapper) ProviderServiceGetProvider(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "projectName" -------------
 	var projectName string
@@ -5529,58 +8909,12 @@ func (w *ServerInterfaceWrapper) OSUpdatePolicyGetOSUpdatePolicy(ctx echo.Contex
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.OSUpdatePolicyGetOSUpdatePolicy(ctx, projectName, resourceId)
-	return err
-}
-
-// OSUpdateRunListOSUpdateRun converts echo context to params.
-func (w *ServerInterfaceWrapper) OSUpdateRunListOSUpdateRun(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "projectName" -------------
-	var projectName string
-
-	err = runtime.BindStyledParameterWithOptions("simple", "projectName", ctx.Param("projectName"), &projectName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
-	}
-
-	// Parameter object where we will unmarshal all parameters from the context
-	var params OSUpdateRunListOSUpdateRunParams
-	// ------------- Optional query parameter "orderBy" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "orderBy", ctx.QueryParams(), &params.OrderBy)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderBy: %s", err))
-	}
-
-	// ------------- Optional query parameter "filter" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "filter", ctx.QueryParams(), &params.Filter)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter filter: %s", err))
-	}
-
-	// ------------- Optional query parameter "pageSize" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "pageSize", ctx.QueryParams(), &params.PageSize)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pageSize: %s", err))
-	}
-
-	// ------------- Optional query parameter "offset" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
-	}
-
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.OSUpdateRunListOSUpdateRun(ctx, projectName, params)
+	err = w.Handler.ProviderServiceGetProvider(ctx, projectName, resourceId)
 	return err
 }
 
-// OSUpdateRunDeleteOSUpdateRun converts echo context to params.
-func (w *ServerInterfaceWrapper) OSUpdateRunDeleteOSUpdateRun(ctx echo.Context) error {
+// ProviderServicePatchProvider converts echo context to params.
+func (w *ServerInterfaceWrapper) ProviderServicePatchProvider(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "projectName" -------------
 	var projectName string
@@ -5598,13 +8932,22 @@ func (w *ServerInterfaceWrapper) OSUpdateRunDeleteOSUpdateRun(ctx echo.Context)
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ProviderServicePatchProviderParams
+	// ------------- Optional query parameter "fieldMask" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fieldMask", ctx.QueryParams(), &params.FieldMask)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fieldMask: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.OSUpdateRunDeleteOSUpdateRun(ctx, projectName, resourceId)
+	err = w.Handler.ProviderServicePatchProvider(ctx, projectName, resourceId, params)
 	return err
 }
 
-// OSUpdateRunGetOSUpdateRun converts echo context to params.
-func (w *ServerInterfaceWrapper) OSUpdateRunGetOSUpdateRun(ctx echo.Context) error {
+// ProviderServiceUpdateProvider converts echo context to params.
+func (w *ServerInterfaceWrapper) ProviderServiceUpdateProvider(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "projectName" -------------
 	var projectName string
@@ -5623,12 +8966,12 @@ func (w *ServerInterfaceWrapper) OSUpdateRunGetOSUpdateRun(ctx echo.Context) err
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.OSUpdateRunGetOSUpdateRun(ctx, projectName, resourceId)
+	err = w.Handler.ProviderServiceUpdateProvider(ctx, projectName, resourceId)
 	return err
 }
 
-// OSUpdatePolicyListOSUpdatePolicy3 converts echo context to params.
-func (w *ServerInterfaceWrapper) OSUpdatePolicyListOSUpdatePolicy3(ctx echo.Context) error {
+// RegionServiceListRegions converts echo context to params.
+func (w *ServerInterfaceWrapper) RegionServiceListRegions(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "projectName" -------------
 	var projectName string
@@ -5639,7 +8982,7 @@ func (w *ServerInterfaceWrapper) OSUpdatePolicyListOSUpdatePolicy3(ctx echo.Cont
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params OSUpdatePolicyListOSUpdatePolicy3Params
+	var params RegionServiceListRegionsParams
 	// ------------- Optional query parameter "orderBy" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "orderBy", ctx.QueryParams(), &params.OrderBy)
@@ -5668,29 +9011,20 @@ func (w *ServerInterfaceWrapper) OSUpdatePolicyListOSUpdatePolicy3(ctx echo.Cont
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
 	}
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.OSUpdatePolicyListOSUpdatePolicy3(ctx, projectName, params)
-	return err
-}
-
-// OSUpdatePolicyCreateOSUpdatePolicy3 converts echo context to params.
-func (w *ServerInterfaceWrapper) OSUpdatePolicyCreateOSUpdatePolicy3(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "projectName" -------------
-	var projectName string
+	// ------------- Optional query parameter "showTotalSites" -------------
 
-	err = runtime.BindStyledParameterWithOptions("simple", "projectName", ctx.Param("projectName"), &projectName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindQueryParameter("form", true, false, "showTotalSites", ctx.QueryParams(), &params.ShowTotalSites)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter showTotalSites: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.OSUpdatePolicyCreateOSUpdatePolicy3(ctx, projectName)
+	err = w.Handler.RegionServiceListRegions(ctx, projectName, params)
 	return err
 }
 
-// OSUpdatePolicyDeleteOSUpdatePolicy3 converts echo context to params.
-func (w *ServerInterfaceWrapper) OSUpdatePolicyDeleteOSUpdatePolicy3(ctx echo.Context) error {
+// RegionServiceCreateRegion converts echo context to params.
+func (w *ServerInterfaceWrapper) RegionServiceCreateRegion(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "projectName" -------------
 	var projectName string
@@ -5700,21 +9034,13 @@ func (w *ServerInterfaceWrapper) OSUpdatePolicyDeleteOSUpdatePolicy3(ctx echo.Co
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
 	}
 
-	// ------------- Path parameter "resourceId" -------------
-	var resourceId string
-
-	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", ctx.Param("resourceId"), &resourceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
-	}
-
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.OSUpdatePolicyDeleteOSUpdatePolicy3(ctx, projectName, resourceId)
+	err = w.Handler.RegionServiceCreateRegion(ctx, projectName)
 	return err
 }
 
-// OSUpdatePolicyGetOSUpdatePolicy3 converts echo context to params.
-func (w *ServerInterfaceWrapper) OSUpdatePolicyGetOSUpdatePolicy3(ctx echo.Context) error {
+// SiteServiceListSites converts echo context to params.
+func (w *ServerInterfaceWrapper) SiteServiceListSites(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "projectName" -------------
 	var projectName string
@@ -5724,32 +9050,16 @@ func (w *ServerInterfaceWrapper) OSUpdatePolicyGetOSUpdatePolicy3(ctx echo.Conte
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
 	}
 
-	// ------------- Path parameter "resourceId" -------------
-	var resourceId string
-
-	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", ctx.Param("resourceId"), &resourceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
-	}
-
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.OSUpdatePolicyGetOSUpdatePolicy3(ctx, projectName, resourceId)
-	return err
-}
-
-// OSUpdateRunListOSUpdateRun3 converts echo context to params.
-func (w *ServerInterfaceWrapper) OSUpdateRunListOSUpdateRun3(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "projectName" -------------
-	var projectName string
+	// ------------- Path parameter "regionId" -------------
+	var regionId string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "projectName", ctx.Param("projectName"), &projectName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "regionId", ctx.Param("regionId"), &regionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter regionId: %s", err))
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params OSUpdateRunListOSUpdateRun3Params
+	var params SiteServiceListSitesParams
 	// ------------- Optional query parameter "orderBy" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "orderBy", ctx.QueryParams(), &params.OrderBy)
@@ -5778,13 +9088,27 @@ func (w *ServerInterfaceWrapper) OSUpdateRunListOSUpdateRun3(ctx echo.Context) e
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
 	}
 
+	// ------------- Optional query parameter "view" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "view", ctx.QueryParams(), &params.View)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter view: %s", err))
+	}
+
+	// ------------- Optional query parameter "fields" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fields", ctx.QueryParams(), &params.Fields)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fields: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.OSUpdateRunListOSUpdateRun3(ctx, projectName, params)
+	err = w.Handler.SiteServiceListSites(ctx, projectName, regionId, params)
 	return err
 }
 
-// OSUpdateRunDeleteOSUpdateRun3 converts echo context to params.
-func (w *ServerInterfaceWrapper) OSUpdateRunDeleteOSUpdateRun3(ctx echo.Context) error {
+// SiteServiceCreateSite converts echo context to params.
+func (w *ServerInterfaceWrapper) SiteServiceCreateSite(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "projectName" -------------
 	var projectName string
@@ -5794,21 +9118,21 @@ func (w *ServerInterfaceWrapper) OSUpdateRunDeleteOSUpdateRun3(ctx echo.Context)
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
 	}
 
-	// ------------- Path parameter "resourceId" -------------
-	var resourceId string
+	// ------------- Path parameter "regionId" -------------
+	var regionId string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", ctx.Param("resourceId"), &resourceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "regionId", ctx.Param("regionId"), &regionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter regionId: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.OSUpdateRunDeleteOSUpdateRun3(ctx, projectName, resourceId)
+	err = w.Handler.SiteServiceCreateSite(ctx, projectName, regionId)
 	return err
 }
 
-// OSUpdateRunGetOSUpdateRun3 converts echo context to params.
-func (w *ServerInterfaceWrapper) OSUpdateRunGetOSUpdateRun3(ctx echo.Context) error {
+// SiteServiceDeleteSite converts echo context to params.
+func (w *ServerInterfaceWrapper) SiteServiceDeleteSite(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "projectName" -------------
 	var projectName string
@@ -5818,6 +9142,14 @@ func (w *ServerInterfaceWrapper) OSUpdateRunGetOSUpdateRun3(ctx echo.Context) er
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
 	}
 
+	// ------------- Path parameter "regionId" -------------
+	var regionId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "regionId", ctx.Param("regionId"), &regionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter regionId: %s", err))
+	}
+
 	// ------------- Path parameter "resourceId" -------------
 	var resourceId string
 
@@ -5827,12 +9159,12 @@ func (w *ServerInterfaceWrapper) OSUpdateRunGetOSUpdateRun3(ctx echo.Context) er
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.OSUpdateRunGetOSUpdateRun3(ctx, projectName, resourceId)
+	err = w.Handler.SiteServiceDeleteSite(ctx, projectName, regionId, resourceId)
 	return err
 }
 
-// ProviderServiceListProviders converts echo context to params.
-func (w *ServerInterfaceWrapper) ProviderServiceListProviders(ctx echo.Context) error {
+// SiteServiceGetSite converts echo context to params.
+func (w *ServerInterfaceWrapper) SiteServiceGetSite(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "projectName" -------------
 	var projectName string
@@ -5842,43 +9174,45 @@ func (w *ServerInterfaceWrapper) ProviderServiceListProviders(ctx echo.Context)
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ProviderServiceListProvidersParams
-	// ------------- Optional query parameter "orderBy" -------------
+	// ------------- Path parameter "regionId" -------------
+	var regionId string
 
-	err = runtime.BindQueryParameter("form", true, false, "orderBy", ctx.QueryParams(), &params.OrderBy)
+	err = runtime.BindStyledParameterWithOptions("simple", "regionId", ctx.Param("regionId"), &regionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderBy: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter regionId: %s", err))
 	}
 
-	// ------------- Optional query parameter "filter" -------------
+	// ------------- Path parameter "resourceId" -------------
+	var resourceId string
 
-	err = runtime.BindQueryParameter("form", true, false, "filter", ctx.QueryParams(), &params.Filter)
+	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", ctx.Param("resourceId"), &resourceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter filter: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
 	}
 
-	// ------------- Optional query parameter "pageSize" -------------
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SiteServiceGetSiteParams
+	// ------------- Optional query parameter "view" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "pageSize", ctx.QueryParams(), &params.PageSize)
+	err = runtime.BindQueryParameter("form", true, false, "view", ctx.QueryParams(), &params.View)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pageSize: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter view: %s", err))
 	}
 
-	// ------------- Optional query parameter "offset" -------------
+	// ------------- Optional query parameter "fields" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	err = runtime.BindQueryParameter("form", true, false, "fields", ctx.QueryParams(), &params.Fields)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fields: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ProviderServiceListProviders(ctx, projectName, params)
+	err = w.Handler.SiteServiceGetSite(ctx, projectName, regionId, resourceId, params)
 	return err
 }
 
-// ProviderServiceCreateProvider converts echo context to params.
-func (w *ServerInterfaceWrapper) ProviderServiceCreateProvider(ctx echo.Context) error {
+// SiteServicePatchSite converts echo context to params.
+func (w *ServerInterfaceWrapper) SiteServicePatchSite(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "projectName" -------------
 	var projectName string
@@ -5888,13 +9222,38 @@ func (w *ServerInterfaceWrapper) ProviderServiceCreateProvider(ctx echo.Context)
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
 	}
 
+	// ------------- Path parameter "regionId" -------------
+	var regionId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "regionId", ctx.Param("regionId"), &regionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter regionId: %s", err))
+	}
+
+	// ------------- Path parameter "resourceId" -------------
+	var resourceId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", ctx.Param("resourceId"), &resourceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SiteServicePatchSiteParams
+	// ------------- Optional query parameter "fieldMask" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fieldMask", ctx.QueryParams(), &params.FieldMask)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fieldMask: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ProviderServiceCreateProvider(ctx, projectName)
+	err = w.Handler.SiteServicePatchSite(ctx, projectName, regionId, resourceId, params)
 	return err
 }
-
-// ProviderServiceDeleteProvider converts echo context to params.
-func (w *ServerInterfaceWrapper) ProviderServiceDeleteProvider(ctx echo.Context) error {
+
+// SiteServiceUpdateSite converts echo context to params.
+func (w *ServerInterfaceWrapper) SiteServiceUpdateSite(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "projectName" -------------
 	var projectName string
@@ -5904,6 +9263,14 @@ func (w *ServerInterfaceWrapper) ProviderServiceDeleteProvider(ctx echo.Context)
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
 	}
 
+	// ------------- Path parameter "regionId" -------------
+	var regionId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "regionId", ctx.Param("regionId"), &regionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter regionId: %s", err))
+	}
+
 	// ------------- Path parameter "resourceId" -------------
 	var resourceId string
 
@@ -5913,12 +9280,12 @@ func (w *ServerInterfaceWrapper) ProviderServiceDeleteProvider(ctx echo.Context)
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ProviderServiceDeleteProvider(ctx, projectName, resourceId)
+	err = w.Handler.SiteServiceUpdateSite(ctx, projectName, regionId, resourceId)
 	return err
 }
 
-// ProviderServiceGetProvider converts echo context to params.
-func (w *ServerInterfaceWrapper) ProviderServiceGetProvider(ctx echo.Context) error {
+// RegionServiceDeleteRegion converts echo context to params.
+func (w *ServerInterfaceWrapper) RegionServiceDeleteRegion(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "projectName" -------------
 	var projectName string
@@ -5937,12 +9304,12 @@ func (w *ServerInterfaceWrapper) ProviderServiceGetProvider(ctx echo.Context) er
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ProviderServiceGetProvider(ctx, projectName, resourceId)
+	err = w.Handler.RegionServiceDeleteRegion(ctx, projectName, resourceId)
 	return err
 }
 
-// RegionServiceListRegions converts echo context to params.
-func (w *ServerInterfaceWrapper) RegionServiceListRegions(ctx echo.Context) error {
+// RegionServiceGetRegion converts echo context to params.
+func (w *ServerInterfaceWrapper) RegionServiceGetRegion(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "projectName" -------------
 	var projectName string
@@ -5952,50 +9319,54 @@ func (w *ServerInterfaceWrapper) RegionServiceListRegions(ctx echo.Context) erro
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params RegionServiceListRegionsParams
-	// ------------- Optional query parameter "orderBy" -------------
+	// ------------- Path parameter "resourceId" -------------
+	var resourceId string
 
-	err = runtime.BindQueryParameter("form", true, false, "orderBy", ctx.QueryParams(), &params.OrderBy)
+	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", ctx.Param("resourceId"), &resourceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderBy: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
 	}
 
-	// ------------- Optional query parameter "filter" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "filter", ctx.QueryParams(), &params.Filter)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter filter: %s", err))
-	}
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RegionServiceGetRegion(ctx, projectName, resourceId)
+	return err
+}
 
-	// ------------- Optional query parameter "pageSize" -------------
+// RegionServicePatchRegion converts echo context to params.
+func (w *ServerInterfaceWrapper) RegionServicePatchRegion(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectName" -------------
+	var projectName string
 
-	err = runtime.BindQueryParameter("form", true, false, "pageSize", ctx.QueryParams(), &params.PageSize)
+	err = runtime.BindStyledParameterWithOptions("simple", "projectName", ctx.Param("projectName"), &projectName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pageSize: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
 	}
 
-	// ------------- Optional query parameter "offset" -------------
+	// ------------- Path parameter "resourceId" -------------
+	var resourceId string
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", ctx.Param("resourceId"), &resourceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
 	}
 
-	// ------------- Optional query parameter "showTotalSites" -------------
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RegionServicePatchRegionParams
+	// ------------- Optional query parameter "fieldMask" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "showTotalSites", ctx.QueryParams(), &params.ShowTotalSites)
+	err = runtime.BindQueryParameter("form", true, false, "fieldMask", ctx.QueryParams(), &params.FieldMask)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter showTotalSites: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fieldMask: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.RegionServiceListRegions(ctx, projectName, params)
+	err = w.Handler.RegionServicePatchRegion(ctx, projectName, resourceId, params)
 	return err
 }
 
-// RegionServiceCreateRegion converts echo context to params.
-func (w *ServerInterfaceWrapper) RegionServiceCreateRegion(ctx echo.Context) error {
+// RegionServiceUpdateRegion converts echo context to params.
+func (w *ServerInterfaceWrapper) RegionServiceUpdateRegion(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "projectName" -------------
 	var projectName string
@@ -6005,13 +9376,21 @@ func (w *ServerInterfaceWrapper) RegionServiceCreateRegion(ctx echo.Context) err
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
 	}
 
+	// ------------- Path parameter "resourceId" -------------
+	var resourceId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", ctx.Param("resourceId"), &resourceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.RegionServiceCreateRegion(ctx, projectName)
+	err = w.Handler.RegionServiceUpdateRegion(ctx, projectName, resourceId)
 	return err
 }
 
-// SiteServiceListSites converts echo context to params.
-func (w *ServerInterfaceWrapper) SiteServiceListSites(ctx echo.Context) error {
+// RemoteAccessServiceListRemoteAccesses converts echo context to params.
+func (w *ServerInterfaceWrapper) RemoteAccessServiceListRemoteAccesses(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "projectName" -------------
 	var projectName string
@@ -6021,16 +9400,8 @@ func (w *ServerInterfaceWrapper) SiteServiceListSites(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
 	}
 
-	// ------------- Path parameter "regionId" -------------
-	var regionId string
-
-	err = runtime.BindStyledParameterWithOptions("simple", "regionId", ctx.Param("regionId"), &regionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter regionId: %s", err))
-	}
-
 	// Parameter object where we will unmarshal all parameters from the context
-	var params SiteServiceListSitesParams
+	var params RemoteAccessServiceListRemoteAccessesParams
 	// ------------- Optional query parameter "orderBy" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "orderBy", ctx.QueryParams(), &params.OrderBy)
@@ -6060,12 +9431,12 @@ func (w *ServerInterfaceWrapper) SiteServiceListSites(ctx echo.Context) error {
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.SiteServiceListSites(ctx, projectName, regionId, params)
+	err = w.Handler.RemoteAccessServiceListRemoteAccesses(ctx, projectName, params)
 	return err
 }
 
-// SiteServiceCreateSite converts echo context to params.
-func (w *ServerInterfaceWrapper) SiteServiceCreateSite(ctx echo.Context) error {
+// RemoteAccessServiceCreateRemoteAccess converts echo context to params.
+func (w *ServerInterfaceWrapper) RemoteAccessServiceCreateRemoteAccess(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "projectName" -------------
 	var projectName string
@@ -6075,21 +9446,13 @@ func (w *ServerInterfaceWrapper) SiteServiceCreateSite(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
 	}
 
-	// ------------- Path parameter "regionId" -------------
-	var regionId string
-
-	err = runtime.BindStyledParameterWithOptions("simple", "regionId", ctx.Param("regionId"), &regionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter regionId: %s", err))
-	}
-
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.SiteServiceCreateSite(ctx, projectName, regionId)
+	err = w.Handler.RemoteAccessServiceCreateRemoteAccess(ctx, projectName)
 	return err
 }
 
-// SiteServiceDeleteSite converts echo context to params.
-func (w *ServerInterfaceWrapper) SiteServiceDeleteSite(ctx echo.Context) error {
+// RemoteAccessServiceDeleteRemoteAccess converts echo context to params.
+func (w *ServerInterfaceWrapper) RemoteAccessServiceDeleteRemoteAccess(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "projectName" -------------
 	var projectName string
@@ -6099,14 +9462,6 @@ func (w *ServerInterfaceWrapper) SiteServiceDeleteSite(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
 	}
 
-	// ------------- Path parameter "regionId" -------------
-	var regionId string
-
-	err = runtime.BindStyledParameterWithOptions("simple", "regionId", ctx.Param("regionId"), &regionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter regionId: %s", err))
-	}
-
 	// ------------- Path parameter "resourceId" -------------
 	var resourceId string
 
@@ -6116,12 +9471,12 @@ func (w *ServerInterfaceWrapper) SiteServiceDeleteSite(ctx echo.Context) error {
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.SiteServiceDeleteSite(ctx, projectName, regionId, resourceId)
+	err = w.Handler.RemoteAccessServiceDeleteRemoteAccess(ctx, projectName, resourceId)
 	return err
 }
 
-// SiteServiceGetSite converts echo context to params.
-func (w *ServerInterfaceWrapper) SiteServiceGetSite(ctx echo.Context) error {
+// RemoteAccessServiceGetRemoteAccess converts echo context to params.
+func (w *ServerInterfaceWrapper) RemoteAccessServiceGetRemoteAccess(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "projectName" -------------
 	var projectName string
@@ -6131,14 +9486,6 @@ func (w *ServerInterfaceWrapper) SiteServiceGetSite(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
 	}
 
-	// ------------- Path parameter "regionId" -------------
-	var regionId string
-
-	err = runtime.BindStyledParameterWithOptions("simple", "regionId", ctx.Param("regionId"), &regionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter regionId: %s", err))
-	}
-
 	// ------------- Path parameter "resourceId" -------------
 	var resourceId string
 
@@ -6148,12 +9495,12 @@ func (w *ServerInterfaceWrapper) SiteServiceGetSite(ctx echo.Context) error {
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.SiteServiceGetSite(ctx, projectName, regionId, resourceId)
+	err = w.Handler.RemoteAccessServiceGetRemoteAccess(ctx, projectName, resourceId)
 	return err
 }
 
-// SiteServicePatchSite converts echo context to params.
-func (w *ServerInterfaceWrapper) SiteServicePatchSite(ctx echo.Context) error {
+// RoleBindingServiceListRoleBindings converts echo context to params.
+func (w *ServerInterfaceWrapper) RoleBindingServiceListRoleBindings(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "projectName" -------------
 	var projectName string
@@ -6163,70 +9510,43 @@ func (w *ServerInterfaceWrapper) SiteServicePatchSite(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
 	}
 
-	// ------------- Path parameter "regionId" -------------
-	var regionId string
-
-	err = runtime.BindStyledParameterWithOptions("simple", "regionId", ctx.Param("regionId"), &regionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter regionId: %s", err))
-	}
-
-	// ------------- Path parameter "resourceId" -------------
-	var resourceId string
-
-	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", ctx.Param("resourceId"), &resourceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
-	}
-
 	// Parameter object where we will unmarshal all parameters from the context
-	var params SiteServicePatchSiteParams
-	// ------------- Optional query parameter "fieldMask" -------------
+	var params RoleBindingServiceListRoleBindingsParams
+	// ------------- Optional query parameter "orderBy" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "fieldMask", ctx.QueryParams(), &params.FieldMask)
+	err = runtime.BindQueryParameter("form", true, false, "orderBy", ctx.QueryParams(), &params.OrderBy)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fieldMask: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderBy: %s", err))
 	}
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.SiteServicePatchSite(ctx, projectName, regionId, resourceId, params)
-	return err
-}
-
-// SiteServiceUpdateSite converts echo context to params.
-func (w *ServerInterfaceWrapper) SiteServiceUpdateSite(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "projectName" -------------
-	var projectName string
+	// ------------- Optional query parameter "filter" -------------
 
-	err = runtime.BindStyledParameterWithOptions("simple", "projectName", ctx.Param("projectName"), &projectName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindQueryParameter("form", true, false, "filter", ctx.QueryParams(), &params.Filter)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter filter: %s", err))
 	}
 
-	// ------------- Path parameter "regionId" -------------
-	var regionId string
+	// ------------- Optional query parameter "pageSize" -------------
 
-	err = runtime.BindStyledParameterWithOptions("simple", "regionId", ctx.Param("regionId"), &regionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindQueryParameter("form", true, false, "pageSize", ctx.QueryParams(), &params.PageSize)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter regionId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pageSize: %s", err))
 	}
 
-	// ------------- Path parameter "resourceId" -------------
-	var resourceId string
+	// ------------- Optional query parameter "offset" -------------
 
-	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", ctx.Param("resourceId"), &resourceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.SiteServiceUpdateSite(ctx, projectName, regionId, resourceId)
+	err = w.Handler.RoleBindingServiceListRoleBindings(ctx, projectName, params)
 	return err
 }
 
-// RegionServiceDeleteRegion converts echo context to params.
-func (w *ServerInterfaceWrapper) RegionServiceDeleteRegion(ctx echo.Context) error {
+// RoleBindingServiceCreateRoleBinding converts echo context to params.
+func (w *ServerInterfaceWrapper) RoleBindingServiceCreateRoleBinding(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "projectName" -------------
 	var projectName string
@@ -6236,21 +9556,13 @@ func (w *ServerInterfaceWrapper) RegionServiceDeleteRegion(ctx echo.Context) err
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
 	}
 
-	// ------------- Path parameter "resourceId" -------------
-	var resourceId string
-
-	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", ctx.Param("resourceId"), &resourceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
-	}
-
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.RegionServiceDeleteRegion(ctx, projectName, resourceId)
+	err = w.Handler.RoleBindingServiceCreateRoleBinding(ctx, projectName)
 	return err
 }
 
-// RegionServiceGetRegion converts echo context to params.
-func (w *ServerInterfaceWrapper) RegionServiceGetRegion(ctx echo.Context) error {
+// RoleBindingServiceDeleteRoleBinding converts echo context to params.
+func (w *ServerInterfaceWrapper) RoleBindingServiceDeleteRoleBinding(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "projectName" -------------
 	var projectName string
@@ -6269,12 +9581,12 @@ func (w *ServerInterfaceWrapper) RegionServiceGetRegion(ctx echo.Context) error
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.RegionServiceGetRegion(ctx, projectName, resourceId)
+	err = w.Handler.RoleBindingServiceDeleteRoleBinding(ctx, projectName, resourceId)
 	return err
 }
 
-// RegionServicePatchRegion converts echo context to params.
-func (w *ServerInterfaceWrapper) RegionServicePatchRegion(ctx echo.Context) error {
+// RoleBindingServiceGetRoleBinding converts echo context to params.
+func (w *ServerInterfaceWrapper) RoleBindingServiceGetRoleBinding(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "projectName" -------------
 	var projectName string
@@ -6292,22 +9604,13 @@ func (w *ServerInterfaceWrapper) RegionServicePatchRegion(ctx echo.Context) erro
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params RegionServicePatchRegionParams
-	// ------------- Optional query parameter "fieldMask" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "fieldMask", ctx.QueryParams(), &params.FieldMask)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fieldMask: %s", err))
-	}
-
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.RegionServicePatchRegion(ctx, projectName, resourceId, params)
+	err = w.Handler.RoleBindingServiceGetRoleBinding(ctx, projectName, resourceId)
 	return err
 }
 
-// RegionServiceUpdateRegion converts echo context to params.
-func (w *ServerInterfaceWrapper) RegionServiceUpdateRegion(ctx echo.Context) error {
+// RoleBindingServiceUpdateRoleBinding converts echo context to params.
+func (w *ServerInterfaceWrapper) RoleBindingServiceUpdateRoleBinding(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "projectName" -------------
 	var projectName string
@@ -6326,7 +9629,7 @@ func (w *ServerInterfaceWrapper) RegionServiceUpdateRegion(ctx echo.Context) err
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.RegionServiceUpdateRegion(ctx, projectName, resourceId)
+	err = w.Handler.RoleBindingServiceUpdateRoleBinding(ctx, projectName, resourceId)
 	return err
 }
 
@@ -6800,6 +10103,20 @@ func (w *ServerInterfaceWrapper) SiteServiceListSites3(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter regionId: %s", err))
 	}
 
+	// ------------- Optional query parameter "view" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "view", ctx.QueryParams(), &params.View)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter view: %s", err))
+	}
+
+	// ------------- Optional query parameter "fields" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fields", ctx.QueryParams(), &params.Fields)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fields: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.SiteServiceListSites3(ctx, projectName, params)
 	return err
@@ -6891,6 +10208,20 @@ func (w *ServerInterfaceWrapper) SiteServiceGetSite3(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter regionId: %s", err))
 	}
 
+	// ------------- Optional query parameter "view" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "view", ctx.QueryParams(), &params.View)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter view: %s", err))
+	}
+
+	// ------------- Optional query parameter "fields" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fields", ctx.QueryParams(), &params.Fields)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fields: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.SiteServiceGetSite3(ctx, projectName, resourceId, params)
 	return err
@@ -7757,6 +11088,45 @@ func (w *ServerInterfaceWrapper) TelemetryMetricsProfileServiceUpdateTelemetryMe
 	return err
 }
 
+// WatchServiceWatchResources converts echo context to params.
+func (w *ServerInterfaceWrapper) WatchServiceWatchResources(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectName" -------------
+	var projectName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectName", ctx.Param("projectName"), &projectName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params WatchServiceWatchResourcesParams
+	// ------------- Optional query parameter "kinds" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "kinds", ctx.QueryParams(), &params.Kinds)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kinds: %s", err))
+	}
+
+	// ------------- Optional query parameter "filter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filter", ctx.QueryParams(), &params.Filter)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter filter: %s", err))
+	}
+
+	// ------------- Optional query parameter "heartbeatSeconds" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "heartbeatSeconds", ctx.QueryParams(), &params.HeartbeatSeconds)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter heartbeatSeconds: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WatchServiceWatchResources(ctx, projectName, params)
+	return err
+}
+
 // WorkloadMemberServiceListWorkloadMembers converts echo context to params.
 func (w *ServerInterfaceWrapper) WorkloadMemberServiceListWorkloadMembers(ctx echo.Context) error {
 	var err error
@@ -8062,13 +11432,21 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 		Handler: si,
 	}
 
+	router.GET(baseURL+"/edge-infra.orchestrator.apis/v2/api-tokens", wrapper.APITokenServiceListAPITokens2)
+	router.POST(baseURL+"/edge-infra.orchestrator.apis/v2/api-tokens", wrapper.APITokenServiceCreateAPIToken2)
+	router.DELETE(baseURL+"/edge-infra.orchestrator.apis/v2/api-tokens/:resourceId", wrapper.APITokenServiceDeleteAPIToken2)
 	router.GET(baseURL+"/edge-infra.orchestrator.apis/v2/customConfigs", wrapper.CustomConfigServiceListCustomConfigs2)
 	router.POST(baseURL+"/edge-infra.orchestrator.apis/v2/customConfigs", wrapper.CustomConfigServiceCreateCustomConfig2)
 	router.DELETE(baseURL+"/edge-infra.orchestrator.apis/v2/customConfigs/:resourceId", wrapper.CustomConfigServiceDeleteCustomConfig2)
 	router.GET(baseURL+"/edge-infra.orchestrator.apis/v2/customConfigs/:resourceId", wrapper.CustomConfigServiceGetCustomConfig2)
+	router.PATCH(baseURL+"/edge-infra.orchestrator.apis/v2/customConfigs/:resourceId", wrapper.CustomConfigServicePatchCustomConfig2)
+	router.PUT(baseURL+"/edge-infra.orchestrator.apis/v2/customConfigs/:resourceId", wrapper.CustomConfigServiceUpdateCustomConfig2)
 	router.GET(baseURL+"/edge-infra.orchestrator.apis/v2/hosts", wrapper.HostServiceListHosts2)
 	router.POST(baseURL+"/edge-infra.orchestrator.apis/v2/hosts", wrapper.HostServiceCreateHost2)
+	router.POST(baseURL+"/edge-infra.orchestrator.apis/v2/hosts/bulk_action", wrapper.HostServiceBulkHostAction2)
+	router.POST(baseURL+"/edge-infra.orchestrator.apis/v2/hosts/invalidate_bulk", wrapper.HostServiceInvalidateHosts2)
 	router.POST(baseURL+"/edge-infra.orchestrator.apis/v2/hosts/register", wrapper.HostServiceRegisterHost2)
+	router.POST(baseURL+"/edge-infra.orchestrator.apis/v2/hosts/register_bulk", wrapper.HostServiceRegisterHosts2)
 	router.DELETE(baseURL+"/edge-infra.orchestrator.apis/v2/hosts/:resourceId", wrapper.HostServiceDeleteHost2)
 	router.GET(baseURL+"/edge-infra.orchestrator.apis/v2/hosts/:resourceId", wrapper.HostServiceGetHost2)
 	router.PATCH(baseURL+"/edge-infra.orchestrator.apis/v2/hosts/:resourceId", wrapper.HostServicePatchHost2)
@@ -8079,6 +11457,7 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.GET(baseURL+"/edge-infra.orchestrator.apis/v2/hosts_summary", wrapper.HostServiceGetHostsSummary2)
 	router.GET(baseURL+"/edge-infra.orchestrator.apis/v2/instances", wrapper.InstanceServiceListInstances2)
 	router.POST(baseURL+"/edge-infra.orchestrator.apis/v2/instances", wrapper.InstanceServiceCreateInstance2)
+	router.POST(baseURL+"/edge-infra.orchestrator.apis/v2/instances/bulk_action", wrapper.InstanceServiceBulkInstanceAction2)
 	router.DELETE(baseURL+"/edge-infra.orchestrator.apis/v2/instances/:resourceId", wrapper.InstanceServiceDeleteInstance2)
 	router.GET(baseURL+"/edge-infra.orchestrator.apis/v2/instances/:resourceId", wrapper.InstanceServiceGetInstance2)
 	router.PATCH(baseURL+"/edge-infra.orchestrator.apis/v2/instances/:resourceId", wrapper.InstanceServicePatchInstance2)
@@ -8088,30 +11467,68 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.POST(baseURL+"/edge-infra.orchestrator.apis/v2/localAccounts", wrapper.LocalAccountServiceCreateLocalAccount2)
 	router.DELETE(baseURL+"/edge-infra.orchestrator.apis/v2/localAccounts/:resourceId", wrapper.LocalAccountServiceDeleteLocalAccount2)
 	router.GET(baseURL+"/edge-infra.orchestrator.apis/v2/localAccounts/:resourceId", wrapper.LocalAccountServiceGetLocalAccount2)
+	router.PATCH(baseURL+"/edge-infra.orchestrator.apis/v2/localAccounts/:resourceId", wrapper.LocalAccountServicePatchLocalAccount2)
+	router.PUT(baseURL+"/edge-infra.orchestrator.apis/v2/localAccounts/:resourceId", wrapper.LocalAccountServiceUpdateLocalAccount2)
 	router.GET(baseURL+"/edge-infra.orchestrator.apis/v2/locations", wrapper.LocationServiceListLocations2)
+	router.GET(baseURL+"/edge-infra.orchestrator.apis/v2/network/endpoints", wrapper.EndpointServiceListEndpoints2)
+	router.GET(baseURL+"/edge-infra.orchestrator.apis/v2/network/endpoints/:resourceId", wrapper.EndpointServiceGetEndpoint2)
+	router.GET(baseURL+"/edge-infra.orchestrator.apis/v2/network/ipaddresses", wrapper.IPAddressServiceListIPAddresses2)
+	router.GET(baseURL+"/edge-infra.orchestrator.apis/v2/network/ipaddresses/:resourceId", wrapper.IPAddressServiceGetIPAddress2)
+	router.GET(baseURL+"/edge-infra.orchestrator.apis/v2/network/netlinks", wrapper.NetlinkServiceListNetlinks2)
+	router.GET(baseURL+"/edge-infra.orchestrator.apis/v2/network/netlinks/:resourceId", wrapper.NetlinkServiceGetNetlink2)
+	router.GET(baseURL+"/edge-infra.orchestrator.apis/v2/network/nics", wrapper.HostnicServiceListHostnics2)
+	router.GET(baseURL+"/edge-infra.orchestrator.apis/v2/network/nics/:resourceId", wrapper.HostnicServiceGetHostnic2)
+	router.GET(baseURL+"/edge-infra.orchestrator.apis/v2/network/segments", wrapper.NetworkSegmentServiceListNetworkSegments2)
+	router.POST(baseURL+"/edge-infra.orchestrator.apis/v2/network/segments", wrapper.NetworkSegmentServiceCreateNetworkSegment2)
+	router.DELETE(baseURL+"/edge-infra.orchestrator.apis/v2/network/segments/:resourceId", wrapper.NetworkSegmentServiceDeleteNetworkSegment2)
+	router.GET(baseURL+"/edge-infra.orchestrator.apis/v2/network/segments/:resourceId", wrapper.NetworkSegmentServiceGetNetworkSegment2)
+	router.PATCH(baseURL+"/edge-infra.orchestrator.apis/v2/network/segments/:resourceId", wrapper.NetworkSegmentServicePatchNetworkSegment2)
+	router.PUT(baseURL+"/edge-infra.orchestrator.apis/v2/network/segments/:resourceId", wrapper.NetworkSegmentServiceUpdateNetworkSegment2)
 	router.GET(baseURL+"/edge-infra.orchestrator.apis/v2/operating_systems", wrapper.OperatingSystemServiceListOperatingSystems2)
 	router.POST(baseURL+"/edge-infra.orchestrator.apis/v2/operating_systems", wrapper.OperatingSystemServiceCreateOperatingSystem2)
 	router.DELETE(baseURL+"/edge-infra.orchestrator.apis/v2/operating_systems/:resourceId", wrapper.OperatingSystemServiceDeleteOperatingSystem2)
 	router.GET(baseURL+"/edge-infra.orchestrator.apis/v2/operating_systems/:resourceId", wrapper.OperatingSystemServiceGetOperatingSystem2)
 	router.PATCH(baseURL+"/edge-infra.orchestrator.apis/v2/operating_systems/:resourceId", wrapper.OperatingSystemServicePatchOperatingSystem2)
 	router.PUT(baseURL+"/edge-infra.orchestrator.apis/v2/operating_systems/:resourceId", wrapper.OperatingSystemServiceUpdateOperatingSystem2)
+	router.GET(baseURL+"/edge-infra.orchestrator.apis/v2/operations", wrapper.OperationServiceListOperations2)
+	router.GET(baseURL+"/edge-infra.orchestrator.apis/v2/operations/:resourceId", wrapper.OperationServiceGetOperation2)
+	router.POST(baseURL+"/edge-infra.orchestrator.apis/v2/operations/:resourceId/cancel", wrapper.OperationServiceCancelOperation2)
 	router.GET(baseURL+"/edge-infra.orchestrator.apis/v2/os_update_policy", wrapper.OSUpdatePolicyListOSUpdatePolicy2)
 	router.POST(baseURL+"/edge-infra.orchestrator.apis/v2/os_update_policy", wrapper.OSUpdatePolicyCreateOSUpdatePolicy2)
 	router.DELETE(baseURL+"/edge-infra.orchestrator.apis/v2/os_update_policy/:resourceId", wrapper.OSUpdatePolicyDeleteOSUpdatePolicy2)
 	router.GET(baseURL+"/edge-infra.orchestrator.apis/v2/os_update_policy/:resourceId", wrapper.OSUpdatePolicyGetOSUpdatePolicy2)
+	router.PATCH(baseURL+"/edge-infra.orchestrator.apis/v2/os_update_policy/:resourceId", wrapper.OSUpdatePolicyPatchOSUpdatePolicy2)
+	router.PUT(baseURL+"/edge-infra.orchestrator.apis/v2/os_update_policy/:resourceId", wrapper.OSUpdatePolicyUpdateOSUpdatePolicy2)
 	router.GET(baseURL+"/edge-infra.orchestrator.apis/v2/os_update_run", wrapper.OSUpdateRunListOSUpdateRun2)
 	router.DELETE(baseURL+"/edge-infra.orchestrator.apis/v2/os_update_run/:resourceId", wrapper.OSUpdateRunDeleteOSUpdateRun2)
 	router.GET(baseURL+"/edge-infra.orchestrator.apis/v2/os_update_run/:resourceId", wrapper.OSUpdateRunGetOSUpdateRun2)
+	router.GET(baseURL+"/edge-infra.orchestrator.apis/v2/ous", wrapper.OuServiceListOus2)
+	router.POST(baseURL+"/edge-infra.orchestrator.apis/v2/ous", wrapper.OuServiceCreateOu2)
+	router.DELETE(baseURL+"/edge-infra.orchestrator.apis/v2/ous/:resourceId", wrapper.OuServiceDeleteOu2)
+	router.GET(baseURL+"/edge-infra.orchestrator.apis/v2/ous/:resourceId", wrapper.OuServiceGetOu2)
+	router.PATCH(baseURL+"/edge-infra.orchestrator.apis/v2/ous/:resourceId", wrapper.OuServicePatchOu2)
+	router.PUT(baseURL+"/edge-infra.orchestrator.apis/v2/ous/:resourceId", wrapper.OuServiceUpdateOu2)
 	router.GET(baseURL+"/edge-infra.orchestrator.apis/v2/providers", wrapper.ProviderServiceListProviders2)
 	router.POST(baseURL+"/edge-infra.orchestrator.apis/v2/providers", wrapper.ProviderServiceCreateProvider2)
 	router.DELETE(baseURL+"/edge-infra.orchestrator.apis/v2/providers/:resourceId", wrapper.ProviderServiceDeleteProvider2)
 	router.GET(baseURL+"/edge-infra.orchestrator.apis/v2/providers/:resourceId", wrapper.ProviderServiceGetProvider2)
+	router.PATCH(baseURL+"/edge-infra.orchestrator.apis/v2/providers/:resourceId", wrapper.ProviderServicePatchProvider2)
+	router.PUT(baseURL+"/edge-infra.orchestrator.apis/v2/providers/:resourceId", wrapper.ProviderServiceUpdateProvider2)
 	router.GET(baseURL+"/edge-infra.orchestrator.apis/v2/regions", wrapper.RegionServiceListRegions2)
 	router.POST(baseURL+"/edge-infra.orchestrator.apis/v2/regions", wrapper.RegionServiceCreateRegion2)
 	router.DELETE(baseURL+"/edge-infra.orchestrator.apis/v2/regions/:resourceId", wrapper.RegionServiceDeleteRegion2)
 	router.GET(baseURL+"/edge-infra.orchestrator.apis/v2/regions/:resourceId", wrapper.RegionServiceGetRegion2)
 	router.PATCH(baseURL+"/edge-infra.orchestrator.apis/v2/regions/:resourceId", wrapper.RegionServicePatchRegion2)
 	router.PUT(baseURL+"/edge-infra.orchestrator.apis/v2/regions/:resourceId", wrapper.RegionServiceUpdateRegion2)
+	router.GET(baseURL+"/edge-infra.orchestrator.apis/v2/remote-access/sessions", wrapper.RemoteAccessServiceListRemoteAccesses2)
+	router.POST(baseURL+"/edge-infra.orchestrator.apis/v2/remote-access/sessions", wrapper.RemoteAccessServiceCreateRemoteAccess2)
+	router.DELETE(baseURL+"/edge-infra.orchestrator.apis/v2/remote-access/sessions/:resourceId", wrapper.RemoteAccessServiceDeleteRemoteAccess2)
+	router.GET(baseURL+"/edge-infra.orchestrator.apis/v2/remote-access/sessions/:resourceId", wrapper.RemoteAccessServiceGetRemoteAccess2)
+	router.GET(baseURL+"/edge-infra.orchestrator.apis/v2/role-bindings", wrapper.RoleBindingServiceListRoleBindings2)
+	router.POST(baseURL+"/edge-infra.orchestrator.apis/v2/role-bindings", wrapper.RoleBindingServiceCreateRoleBinding2)
+	router.DELETE(baseURL+"/edge-infra.orchestrator.apis/v2/role-bindings/:resourceId", wrapper.RoleBindingServiceDeleteRoleBinding2)
+	router.GET(baseURL+"/edge-infra.orchestrator.apis/v2/role-bindings/:resourceId", wrapper.RoleBindingServiceGetRoleBinding2)
+	router.PUT(baseURL+"/edge-infra.orchestrator.apis/v2/role-bindings/:resourceId", wrapper.RoleBindingServiceUpdateRoleBinding2)
 	router.GET(baseURL+"/edge-infra.orchestrator.apis/v2/schedules", wrapper.ScheduleServiceListSchedules2)
 	router.GET(baseURL+"/edge-infra.orchestrator.apis/v2/schedules/repeated", wrapper.ScheduleServiceListRepeatedSchedules2)
 	router.POST(baseURL+"/edge-infra.orchestrator.apis/v2/schedules/repeated", wrapper.ScheduleServiceCreateRepeatedSchedule2)
@@ -8151,6 +11568,7 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.GET(baseURL+"/edge-infra.orchestrator.apis/v2/telemetry/profiles/metrics/:resourceId", wrapper.TelemetryMetricsProfileServiceGetTelemetryMetricsProfile2)
 	router.PATCH(baseURL+"/edge-infra.orchestrator.apis/v2/telemetry/profiles/metrics/:resourceId", wrapper.TelemetryMetricsProfileServicePatchTelemetryMetricsProfile2)
 	router.PUT(baseURL+"/edge-infra.orchestrator.apis/v2/telemetry/profiles/metrics/:resourceId", wrapper.TelemetryMetricsProfileServiceUpdateTelemetryMetricsProfile2)
+	router.GET(baseURL+"/edge-infra.orchestrator.apis/v2/watch", wrapper.WatchServiceWatchResources2)
 	router.GET(baseURL+"/edge-infra.orchestrator.apis/v2/workload_members", wrapper.WorkloadMemberServiceListWorkloadMembers2)
 	router.POST(baseURL+"/edge-infra.orchestrator.apis/v2/workload_members", wrapper.WorkloadMemberServiceCreateWorkloadMember2)
 	router.DELETE(baseURL+"/edge-infra.orchestrator.apis/v2/workload_members/:resourceId", wrapper.WorkloadMemberServiceDeleteWorkloadMember2)
@@ -8161,9 +11579,15 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.GET(baseURL+"/edge-infra.orchestrator.apis/v2/workloads/:resourceId", wrapper.WorkloadServiceGetWorkload2)
 	router.PATCH(baseURL+"/edge-infra.orchestrator.apis/v2/workloads/:resourceId", wrapper.WorkloadServicePatchWorkload2)
 	router.PUT(baseURL+"/edge-infra.orchestrator.apis/v2/workloads/:resourceId", wrapper.WorkloadServiceUpdateWorkload2)
+	router.GET(baseURL+"/v1/projects/:projectName/api-tokens", wrapper.APITokenServiceListAPITokens)
+	router.POST(baseURL+"/v1/projects/:projectName/api-tokens", wrapper.APITokenServiceCreateAPIToken)
+	router.DELETE(baseURL+"/v1/projects/:projectName/api-tokens/:resourceId", wrapper.APITokenServiceDeleteAPIToken)
 	router.GET(baseURL+"/v1/projects/:projectName/compute/hosts", wrapper.HostServiceListHosts)
 	router.POST(baseURL+"/v1/projects/:projectName/compute/hosts", wrapper.HostServiceCreateHost)
+	router.POST(baseURL+"/v1/projects/:projectName/compute/hosts/bulk_action", wrapper.HostServiceBulkHostAction)
+	router.POST(baseURL+"/v1/projects/:projectName/compute/hosts/invalidate_bulk", wrapper.HostServiceInvalidateHosts)
 	router.POST(baseURL+"/v1/projects/:projectName/compute/hosts/register", wrapper.HostServiceRegisterHost)
+	router.POST(baseURL+"/v1/projects/:projectName/compute/hosts/register_bulk", wrapper.HostServiceRegisterHosts)
 	router.GET(baseURL+"/v1/projects/:projectName/compute/hosts/summary", wrapper.HostServiceGetHostsSummary)
 	router.DELETE(baseURL+"/v1/projects/:projectName/compute/hosts/:resourceId", wrapper.HostServiceDeleteHost)
 	router.GET(baseURL+"/v1/projects/:projectName/compute/hosts/:resourceId", wrapper.HostServiceGetHost)
@@ -8172,9 +11596,11 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.PUT(baseURL+"/v1/projects/:projectName/compute/hosts/:resourceId/invalidate", wrapper.HostServiceInvalidateHost)
 	router.PATCH(baseURL+"/v1/projects/:projectName/compute/hosts/:resourceId/onboard", wrapper.HostServiceOnboardHost)
 	router.PATCH(baseURL+"/v1/projects/:projectName/compute/hosts/:resourceId/register", wrapper.HostServicePatchRegisterHost)
+	router.GET(baseURL+"/v1/projects/:projectName/compute/hosts_statistics", wrapper.HostServiceGetHostsStatistics)
 	router.GET(baseURL+"/v1/projects/:projectName/compute/hosts_summary", wrapper.HostServiceGetHostsSummary3)
 	router.GET(baseURL+"/v1/projects/:projectName/compute/instances", wrapper.InstanceServiceListInstances)
 	router.POST(baseURL+"/v1/projects/:projectName/compute/instances", wrapper.InstanceServiceCreateInstance)
+	router.POST(baseURL+"/v1/projects/:projectName/compute/instances/bulk_action", wrapper.InstanceServiceBulkInstanceAction)
 	router.DELETE(baseURL+"/v1/projects/:projectName/compute/instances/:resourceId", wrapper.InstanceServiceDeleteInstance)
 	router.GET(baseURL+"/v1/projects/:projectName/compute/instances/:resourceId", wrapper.InstanceServiceGetInstance)
 	router.PATCH(baseURL+"/v1/projects/:projectName/compute/instances/:resourceId", wrapper.InstanceServicePatchInstance)
@@ -8215,15 +11641,38 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.POST(baseURL+"/v1/projects/:projectName/customConfigs", wrapper.CustomConfigServiceCreateCustomConfig)
 	router.DELETE(baseURL+"/v1/projects/:projectName/customConfigs/:resourceId", wrapper.CustomConfigServiceDeleteCustomConfig)
 	router.GET(baseURL+"/v1/projects/:projectName/customConfigs/:resourceId", wrapper.CustomConfigServiceGetCustomConfig)
+	router.PATCH(baseURL+"/v1/projects/:projectName/customConfigs/:resourceId", wrapper.CustomConfigServicePatchCustomConfig)
+	router.PUT(baseURL+"/v1/projects/:projectName/customConfigs/:resourceId", wrapper.CustomConfigServiceUpdateCustomConfig)
 	router.GET(baseURL+"/v1/projects/:projectName/localAccounts", wrapper.LocalAccountServiceListLocalAccounts)
 	router.POST(baseURL+"/v1/projects/:projectName/localAccounts", wrapper.LocalAccountServiceCreateLocalAccount)
 	router.DELETE(baseURL+"/v1/projects/:projectName/localAccounts/:resourceId", wrapper.LocalAccountServiceDeleteLocalAccount)
 	router.GET(baseURL+"/v1/projects/:projectName/localAccounts/:resourceId", wrapper.LocalAccountServiceGetLocalAccount)
+	router.PATCH(baseURL+"/v1/projects/:projectName/localAccounts/:resourceId", wrapper.LocalAccountServicePatchLocalAccount)
+	router.PUT(baseURL+"/v1/projects/:projectName/localAccounts/:resourceId", wrapper.LocalAccountServiceUpdateLocalAccount)
 	router.GET(baseURL+"/v1/projects/:projectName/locations", wrapper.LocationServiceListLocations)
+	router.GET(baseURL+"/v1/projects/:projectName/network/endpoints", wrapper.EndpointServiceListEndpoints)
+	router.GET(baseURL+"/v1/projects/:projectName/network/endpoints/:resourceId", wrapper.EndpointServiceGetEndpoint)
+	router.GET(baseURL+"/v1/projects/:projectName/network/ipaddresses", wrapper.IPAddressServiceListIPAddresses)
+	router.GET(baseURL+"/v1/projects/:projectName/network/ipaddresses/:resourceId", wrapper.IPAddressServiceGetIPAddress)
+	router.GET(baseURL+"/v1/projects/:projectName/network/netlinks", wrapper.NetlinkServiceListNetlinks)
+	router.GET(baseURL+"/v1/projects/:projectName/network/netlinks/:resourceId", wrapper.NetlinkServiceGetNetlink)
+	router.GET(baseURL+"/v1/projects/:projectName/network/nics", wrapper.HostnicServiceListHostnics)
+	router.GET(baseURL+"/v1/projects/:projectName/network/nics/:resourceId", wrapper.HostnicServiceGetHostnic)
+	router.GET(baseURL+"/v1/projects/:projectName/network/segments", wrapper.NetworkSegmentServiceListNetworkSegments)
+	router.POST(baseURL+"/v1/projects/:projectName/network/segments", wrapper.NetworkSegmentServiceCreateNetworkSegment)
+	router.DELETE(baseURL+"/v1/projects/:projectName/network/segments/:resourceId", wrapper.NetworkSegmentServiceDeleteNetworkSegment)
+	router.GET(baseURL+"/v1/projects/:projectName/network/segments/:resourceId", wrapper.NetworkSegmentServiceGetNetworkSegment)
+	router.PATCH(baseURL+"/v1/projects/:projectName/network/segments/:resourceId", wrapper.NetworkSegmentServicePatchNetworkSegment)
+	router.PUT(baseURL+"/v1/projects/:projectName/network/segments/:resourceId", wrapper.NetworkSegmentServiceUpdateNetworkSegment)
+	router.GET(baseURL+"/v1/projects/:projectName/operations", wrapper.OperationServiceListOperations)
+	router.GET(baseURL+"/v1/projects/:projectName/operations/:resourceId", wrapper.OperationServiceGetOperation)
+	router.POST(baseURL+"/v1/projects/:projectName/operations/:resourceId/cancel", wrapper.OperationServiceCancelOperation)
 	router.GET(baseURL+"/v1/projects/:projectName/os-update-policies", wrapper.OSUpdatePolicyListOSUpdatePolicy)
 	router.POST(baseURL+"/v1/projects/:projectName/os-update-policies", wrapper.OSUpdatePolicyCreateOSUpdatePolicy)
 	router.DELETE(baseURL+"/v1/projects/:projectName/os-update-policies/:resourceId", wrapper.OSUpdatePolicyDeleteOSUpdatePolicy)
 	router.GET(baseURL+"/v1/projects/:projectName/os-update-policies/:resourceId", wrapper.OSUpdatePolicyGetOSUpdatePolicy)
+	router.PATCH(baseURL+"/v1/projects/:projectName/os-update-policies/:resourceId", wrapper.OSUpdatePolicyPatchOSUpdatePolicy)
+	router.PUT(baseURL+"/v1/projects/:projectName/os-update-policies/:resourceId", wrapper.OSUpdatePolicyUpdateOSUpdatePolicy)
 	router.GET(baseURL+"/v1/projects/:projectName/os-update-runs", wrapper.OSUpdateRunListOSUpdateRun)
 	router.DELETE(baseURL+"/v1/projects/:projectName/os-update-runs/:resourceId", wrapper.OSUpdateRunDeleteOSUpdateRun)
 	router.GET(baseURL+"/v1/projects/:projectName/os-update-runs/:resourceId", wrapper.OSUpdateRunGetOSUpdateRun)
@@ -8231,13 +11680,23 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.POST(baseURL+"/v1/projects/:projectName/os_update_policy", wrapper.OSUpdatePolicyCreateOSUpdatePolicy3)
 	router.DELETE(baseURL+"/v1/projects/:projectName/os_update_policy/:resourceId", wrapper.OSUpdatePolicyDeleteOSUpdatePolicy3)
 	router.GET(baseURL+"/v1/projects/:projectName/os_update_policy/:resourceId", wrapper.OSUpdatePolicyGetOSUpdatePolicy3)
+	router.PATCH(baseURL+"/v1/projects/:projectName/os_update_policy/:resourceId", wrapper.OSUpdatePolicyPatchOSUpdatePolicy3)
+	router.PUT(baseURL+"/v1/projects/:projectName/os_update_policy/:resourceId", wrapper.OSUpdatePolicyUpdateOSUpdatePolicy3)
 	router.GET(baseURL+"/v1/projects/:projectName/os_update_run", wrapper.OSUpdateRunListOSUpdateRun3)
 	router.DELETE(baseURL+"/v1/projects/:projectName/os_update_run/:resourceId", wrapper.OSUpdateRunDeleteOSUpdateRun3)
 	router.GET(baseURL+"/v1/projects/:projectName/os_update_run/:resourceId", wrapper.OSUpdateRunGetOSUpdateRun3)
+	router.GET(baseURL+"/v1/projects/:projectName/ous", wrapper.OuServiceListOus)
+	router.POST(baseURL+"/v1/projects/:projectName/ous", wrapper.OuServiceCreateOu)
+	router.DELETE(baseURL+"/v1/projects/:projectName/ous/:resourceId", wrapper.OuServiceDeleteOu)
+	router.GET(baseURL+"/v1/projects/:projectName/ous/:resourceId", wrapper.OuServiceGetOu)
+	router.PATCH(baseURL+"/v1/projects/:projectName/ous/:resourceId", wrapper.OuServicePatchOu)
+	router.PUT(baseURL+"/v1/projects/:projectName/ous/:resourceId", wrapper.OuServiceUpdateOu)
 	router.GET(baseURL+"/v1/projects/:projectName/providers", wrapper.ProviderServiceListProviders)
 	router.POST(baseURL+"/v1/projects/:projectName/providers", wrapper.ProviderServiceCreateProvider)
 	router.DELETE(baseURL+"/v1/projects/:projectName/providers/:resourceId", wrapper.ProviderServiceDeleteProvider)
 	router.GET(baseURL+"/v1/projects/:projectName/providers/:resourceId", wrapper.ProviderServiceGetProvider)
+	router.PATCH(baseURL+"/v1/projects/:projectName/providers/:resourceId", wrapper.ProviderServicePatchProvider)
+	router.PUT(baseURL+"/v1/projects/:projectName/providers/:resourceId", wrapper.ProviderServiceUpdateProvider)
 	router.GET(baseURL+"/v1/projects/:projectName/regions", wrapper.RegionServiceListRegions)
 	router.POST(baseURL+"/v1/projects/:projectName/regions", wrapper.RegionServiceCreateRegion)
 	router.GET(baseURL+"/v1/projects/:projectName/regions/:regionId/sites", wrapper.SiteServiceListSites)
@@ -8250,6 +11709,15 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.GET(baseURL+"/v1/projects/:projectName/regions/:resourceId", wrapper.RegionServiceGetRegion)
 	router.PATCH(baseURL+"/v1/projects/:projectName/regions/:resourceId", wrapper.RegionServicePatchRegion)
 	router.PUT(baseURL+"/v1/projects/:projectName/regions/:resourceId", wrapper.RegionServiceUpdateRegion)
+	router.GET(baseURL+"/v1/projects/:projectName/remote-access/sessions", wrapper.RemoteAccessServiceListRemoteAccesses)
+	router.POST(baseURL+"/v1/projects/:projectName/remote-access/sessions", wrapper.RemoteAccessServiceCreateRemoteAccess)
+	router.DELETE(baseURL+"/v1/projects/:projectName/remote-access/sessions/:resourceId", wrapper.RemoteAccessServiceDeleteRemoteAccess)
+	router.GET(baseURL+"/v1/projects/:projectName/remote-access/sessions/:resourceId", wrapper.RemoteAccessServiceGetRemoteAccess)
+	router.GET(baseURL+"/v1/projects/:projectName/role-bindings", wrapper.RoleBindingServiceListRoleBindings)
+	router.POST(baseURL+"/v1/projects/:projectName/role-bindings", wrapper.RoleBindingServiceCreateRoleBinding)
+	router.DELETE(baseURL+"/v1/projects/:projectName/role-bindings/:resourceId", wrapper.RoleBindingServiceDeleteRoleBinding)
+	router.GET(baseURL+"/v1/projects/:projectName/role-bindings/:resourceId", wrapper.RoleBindingServiceGetRoleBinding)
+	router.PUT(baseURL+"/v1/projects/:projectName/role-bindings/:resourceId", wrapper.RoleBindingServiceUpdateRoleBinding)
 	router.GET(baseURL+"/v1/projects/:projectName/schedules", wrapper.ScheduleServiceListSchedules3)
 	router.GET(baseURL+"/v1/projects/:projectName/schedules/repeated", wrapper.ScheduleServiceListRepeatedSchedules)
 	router.POST(baseURL+"/v1/projects/:projectName/schedules/repeated", wrapper.ScheduleServiceCreateRepeatedSchedule)
@@ -8297,6 +11765,7 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.GET(baseURL+"/v1/projects/:projectName/telemetry/profiles/metrics/:resourceId", wrapper.TelemetryMetricsProfileServiceGetTelemetryMetricsProfile)
 	router.PATCH(baseURL+"/v1/projects/:projectName/telemetry/profiles/metrics/:resourceId", wrapper.TelemetryMetricsProfileServicePatchTelemetryMetricsProfile)
 	router.PUT(baseURL+"/v1/projects/:projectName/telemetry/profiles/metrics/:resourceId", wrapper.TelemetryMetricsProfileServiceUpdateTelemetryMetricsProfile)
+	router.GET(baseURL+"/v1/projects/:projectName/watch", wrapper.WatchServiceWatchResources)
 	router.GET(baseURL+"/v1/projects/:projectName/workload_members", wrapper.WorkloadMemberServiceListWorkloadMembers)
 	router.POST(baseURL+"/v1/projects/:projectName/workload_members", wrapper.WorkloadMemberServiceCreateWorkloadMember)
 	router.DELETE(baseURL+"/v1/projects/:projectName/workload_members/:resourceId", wrapper.WorkloadMemberServiceDeleteWorkloadMember)
@@ -8313,531 +11782,747 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+z9+3bbOLIojL8Kjmdmtd0jy5d0Mh336jXbsRVHu23LP0lO75koo8AiJHGHIjUEaMed",
-	"8W99b/b9cV7sW7iRIAmSICU5ToJZ5/SORVwKhSpUFeqCz1uTYLEMfOQTvHX0eQtP5mgB2T+PF+Qk8EkY",
-	"eBeBg+gvyI8WW0fvto4vhuOT3uWw3zsfX/ROO+Pry8FV56T7uts53WrlPx+fXOh+Pjm52Hrf2iIu8dDW",
-	"UXa+1ha5X9LfMQldf7b10KItBh+jLCSD3641ANBfjy+Gyl/dQXY6OljBNAQStmQH4UnoLokb+FtHW8M5",
-	"Aph+AsEUkDkCxxdDsH08Ie4tAhfQhzO0QD4BQzSZ+4EXzO53QIzf9lYrDffweKhDHf/9qt972x10e5eZ",
-	"368vi76cdgcnvcvLzsmwc5pdKFuOZqmvYIgWiEBPYN5D4W+u7+hXTnvThb+6OFHX8uq437noDI/P5e6e",
-	"d/rj37qXp5m1Fbe77F12Sht0ry66pQ3eXvV7pQ2uTq9VnBStW4OikwiTYHES+FN31kc4iMIJowzoOC5F",
-	"DvSuwmCJQuIivHU0hR5Gra2l8hNlMdo5j1Q+KJgEPkE+2WptLeCnc+TPyHzr6ODFs59/SgAWQ2jgSw2Z",
-	"nWG7dzXs9i6Pz3eAmEz9nprw8PmL1tbC9WMAWltLSAgK6Tj/+vN//vUO7v5xvPvP3fH+7sv23tEvv/7X",
-	"3//Pn1qjaH//2YT9F/24vTPaAu//+uetBPD0fDnofbhAhYhZhsGt6yAH3NwD6CzcDMQ/7adApPC9p//Z",
-	"3325+/7zfuvZwYMKCZtKA0IoNrWrIXv5DbgO8ok7dVGYwVoaTRNGLHyzdt/t776Eu9P3n39mcIQIOj3f",
-	"u986ImGEErjkHGNXS3/EXSBM4GLJSOnPIZpuHW39aS85tvfEmb03TFo+sHX9O3JD5FAeFWsXVKQwgpa6",
-	"YyiCm/9FE0KhOEUeIijTehn4uJoX0hiV3cACYQxnCEyDEORHbysbVzJ3IaRvAkwaQ1gEIB00D1hqqkKA",
-	"uj4m0J+g9aNNjpyHLDdnIXTnwQR6x5NJEPlk/RCqo+eh1M5dCGlvcL10IEFXgedO7tcPa2+JQkhcfza4",
-	"xwQt8uAWAFAJcD/yvyC06uzFoKZH+xLgFkBQCPIVFxHh+mGVI+eBzM1ZCF0fzdxgA9vOx81DlpmvBK4l",
-	"ggQ5g8kcOZGHNgFhegYdrAUwFEI9cP2ZhzYHc3r8PMQF85fASzYCJdHCRgwgGiKPqr3h/Xkww2dhEC3X",
-	"D19+jjy0JXCYwX4VBlN3EzSgm6UC/iws1Su4QCR0JxvfAHWakjVooTFexOZ3Ij1R9UKM9+P3IPzoBdC5",
-	"QIubTUiQ9Ph5wAvmr4R3c5AWw1gG3RkiVAceRIsFDJuqZKK3vFiZB5hgdtcSYQpV2qBGYRiEBZcUAYEe",
-	"8COKUjoYH2gZIkwNOH8GoA86tHu7xCTj48dLdX2CZihkxmLk+9QwqzG364M+78XvjsomlsPrpmaj15i4",
-	"bB4+lm6WyIeeF0yoZK6zyDuXzIOIAAiwW75EdYI8AA9JwwKy0hAgt8BmLiYorEl2F4IXSABCMQKAQBp6",
-	"aaqDEQl6/k0AQw1qXntwRkfB7syHHv0Xbb6AxJ1Az7sHAe8YE7fKarTlWDRI1ncTBB6CPl0g8uGNh94u",
-	"w8BkYt4a3F71eyDwtTPyJuNbOqJuQv21zFCMBOjnduYiZL/ivihzL1R0G4NR6ELvkpFXCQS8mSBDtlXJ",
-	"3Nvvjnf/ye+B3n9+3jrcf9j5uzo17zvmfXUwRBiF57eLgfuHBgnnby8Adv9AlK/PXinD0l5j73Yxpl/1",
-	"vBW5Tsmarq+7pxmsPnuRwyq/VDreff3+888Pu+qfP9X58+AwdTnGQMuhQuHGFI8V8qDh9WgaAceM34C8",
-	"B9MwXs4TUXYNlvEjPLRo/1MfD6Lp1P1UekdK0CcSQS+WkGQOibgxvUEYOD4eYzYKk53HJxdgEThZTjjY",
-	"P1QubuGCjJN+OmqDsVejYlW0lWjP5GJ+KZNgQcXMNAwWwEG37oRSKQkDvEQT2qQNBoiAwPfuwc09OxhO",
-	"L0D/QruAovObLojL5aLFsI9d33EnkHAxXbawVHO6DHWU+Eozv9br4QmI70bB3Rz5IAEN3EEMPIgJmMyh",
-	"P0MOX/nNPV0w9B3Qo+tmmCiTVcmA43gqLWffuAHuIw9BjE61nqNX3d4AiBbgtEILoKONQ9547BS4bmij",
-	"t8h3dIoQm41/rJznlo9ROEOItZ4FMQX7ajAHH0U3yWLS1ezuq4sT0L0C0HFChHEL4GgyBxCD0dbBy8P2",
-	"fnu/fTDaKp13MRm7y4IZpY+rjC6LXEQPra3JMjoOJ3OXoAmJQs1uq1+lRntydc1OC68FUHvWBp9+fjF+",
-	"8VPZEibLaAzVeTSLmSyjE7iEN67nytMyo1ezpsBzMaGQQM9jkEyUTmB7GWDs3nj34L8HvcudKpjUvkUw",
-	"BaEOmMtYa2Qw0EaVk7GRdDw3WUb0gNeoxDGqJe6lPlc2EetQsJxBMPmISOmClvN7TFU9tjLM21dNKZoV",
-	"rW44p501sw6ZCp7MTXhDgKPlMggJd5gJmquCQfQthCFYMj92HghKKmDqIs8BmASMyCSZE9GpBUI0RSHV",
-	"Sd+cHu+96V8AJ5hgJjxpWzYE57dKMCUcug2KwhD5RHXbV0lS1i7p+tvtwqhr3C7pehXcodCos9Iy6T4I",
-	"PKPOcTulq0k/ZkHJjg7CboicJogSXZsgSnRtiijRvQmiZNfaiKLq+NlSp2G9gpOPu4yqkT9hZiMkBE7m",
-	"yOE6/NnVdazIMv53CVpgk8lnyyjWnx+KmYHOMp4tVe0LhiG8l2BfupMGYF92TxqC7bsTY7B9Cpse7CKN",
-	"tkIjp6dIGPlUOROXRfQ8ZMe9VPj6FzjW84yVXAZusZabQLySmpsMU0/PVaArVnTVdRsss0LB5aAGIZyh",
-	"BuSFec+GJCZ6G5MZlnDqSe0a3zRYwvXgVUPwI3xjDHpEYdODrb+SeSO+VO1y0XWL689R6BLkXCACHUig",
-	"/n5iIb6CuL1UMejgVM6LG1YRrBPrmx/R/dEt9CIEltANMW3pOyjkzdg1oBv4zCLzghnTnzAJI6bummNZ",
-	"wt4laFGG4hj4sVyPBteuCJqomlQJroj39uPtYoAwNXWKzrM30QL6uxRAdkf329sLgHkPeX6Jky7m44+3",
-	"i90FCy0M659jH28XYzF+yXH2kUvrCBuK9QjTXgu0CML7V/dEdyL8/yLoE5ew+3zeEGz3jy92gMsvJDHz",
-	"79O/bugAZeTLu49ZOx30i0LKVW52UkQMMQ4mLqTEeueSeQM6bkyaC/ipy/sd7O+rayykx/pXsc/XdBXr",
-	"B0UxsPRLIRaz0BwcrjOusIhKGLCaRYhrddefraBiJIOsVctIhi1hzuwCVtI4soPV0zty8K6mfeSGq9BB",
-	"ltQuOAkWC+g7PPrKyJJI95Dj9Hy6dqNlS8Jmq2WdkQPkZSq/UjS4S2Qdx4HPFlm8vrUrwhziAmCNSZVD",
-	"X0ylCuwrEagyTj3aVAEsvf013iozmgwDJ5qQS+0hzYPYwBVvAy4r9DQx1rjoPBbh0JV4VeLTFK1z5mIS",
-	"Mjw/vYNQha6EyPKLWInW8sPVIzkN1KsdiJoBK8ivLHZdbj/onrbADPkoZLI68MFJiIQHQt2hZynBTA+8",
-	"NUWwl7t0BxfMlSDcVTm/btGclU5c7BpcFvGouZhLaJ9ugYuWfqP7HqJEILgYiFCGcmT++T//ot2z+IzX",
-	"4hIt7lpbd9RcSZZPQQy8eibGoHdeZWLgwGtuYuDAMzAxML+WqzYxBnHDxrkPtRz4paEqDT36Gbsjdu4r",
-	"WSS/iB8wiHz33xFiJrC7WESEbtqTiwLI5ZK8z0YGFKeOJDerGucUJAirMV9qYtmb3kCfJad8OO2cd4bZ",
-	"H3uXr3rH/dPsz9eXw/71INe63znrDoadfjprLoFaQ83Z+9p68Q7sNEHcHXcLXY+xaXx7PIGhowl5m5R6",
-	"+eiQUwTZtQlHp4vZaPxYbYEJVYIBRksYyuMqtl9z5J+XuwWOGa0jMLZcSzPTGGOkT6oEXjVLrYxBK5LL",
-	"+GB63YxORikZQEyt/tht1huUT0hHLNbQJm6R+FAWd3XSVU6CUo1w4hbIVKErVs7FvJoGSuf6Ms9aW7cF",
-	"UQkZ0G4r4xOKQhMyYUkqJxacP6qXYl3MSsVAOIXiOjjNrTeLSVd+ziPi9zkicxRyLnUxgOBmMUnGA0EI",
-	"/IBURjfEE+gi+Mpon65JbAJjge3eIM61bAHP/YgAIvP9FkD+8gDT/0sm7Z0V+MJdikCOorMrXssPWAn7",
-	"YLdvxldt3atj3s3ktl2FKH9yea7/0chreInIXRB+jPf6PO7I7vomFKKKFYOL4xO54NJrUDgZ02baK1AS",
-	"5We5gJ/cRbQAJIQ+XrhcA4x8l0h5G4NQOi2J9IYvPerinNjc5OkzDnBQhePfxcDneDMDgZ+CSv5tXrsM",
-	"3eC2w2JaNcdvN7NcMIcYDPrd3lsRKuuUGhp07LFoqGU11uLtFF9StUV7XRpHarx9jYFw33v3nOfotnCz",
-	"LAVkC7hTMOjvUihdJbSjGtTbKaZmkXbXJKjD4ljyhSCbNNBpBApo8GpAFgehN855VsWCeuYXiIWsZ3Fd",
-	"okH6O1UFSa/STVxS5ELhxSb4QOK0PhE9wDa1SHaM3CdylmIHSpWoyABRITmwA1sAOzdCajQXGgt9cJcG",
-	"okolpzC2i98iGE0i7LSaFxSPpVcN0sA2161aW3d3RVHqeoyYqbJs1CpNLsuPBWyretQbs6zeq58JPNeK",
-	"b9rpVDABPyQhxu7MNzEg0hJcjSDWRhtFWDmHBdonge+jiXS7lWqJUUFcnwexLnqc/gwcNHXFUq4Hr3a7",
-	"r0sPFzZSzTOFypLeYDeu7qEcKKvomM5VkU30Bn2CDpq4iyRxKXb0UrWkeypVIrq1AhxhGJVB5DrjEvPJ",
-	"dYrCsxvBU83SrlMSzF100g3U8yyhssc82TKHgMrdmgMgVvN5KZALROaBo9aE6l6Nj09P+53BYHzSu3zd",
-	"PRtfdIZvetlyRIXNBsPjYfekrMXpPy6PL7on6nWRHioNivJWSr1aQsJS0AQ9d0/7CRnxuBpBRYlFVXU0",
-	"IT03TzKoNjLDUpho5qu4uQdd/xb5JAjvE8dF5nb6eepylBt26/JcGF1ZxytOLq55x1NEoKvhuWuMwt1p",
-	"6CLf8e7lpTwJpCYFHNYPA3gTRIS7diV+sLiSLORN7jXiI2yERfMEXMakyaW/hj0pp10PivlSfD8eDLpn",
-	"lxedy+G40+/3+iWNCkbg7HvdPx52e5clg8h2BcP0O+ed40HBRz6q7kgYFHpFZAxZcc012QJ8dH1HvRzv",
-	"Xg6Gx5cnHV2ltfQ3VhAtBZg6awlUDXWsbHfFhQx9wMPrCI8b6g34357XAiL1mbI5ctn1GBzxUFcQhGB+",
-	"v0ThrYu5BMzYUTXi4SVwSjB9UlZKvwUyBIy3BPws1EY/uUolJqMrK231rbILd9Z+nC0GF99bqYvpnm5k",
-	"Oc1qn1X7NRuOq0NM9zSLGt2EdZIDclSDPrmYqmont0WW+8nbDuYRFDBEqTsfJp/lfY8cuQWQPwmoNgwx",
-	"gDwzRt5+pkL76P8aRND99f+/vTPaGo1Go//7/+z+3/8X0H/5o9Hn0ehhNHo3Gr0vj66T6x1PblFhRLxJ",
-	"MLRK5bRPEZXSbz/gvIlZSaj1IhrUEGmVbEoIVU6mg/yUql8sHqAFhgpgupW0wbHnQuatizWj0/IV0OFM",
-	"NRsFTG3Yd0LQDUOBBEnHa5TFOlYKBJKDlUQSpEEvUrGGugXM3FuEhXaFHCWCiaT3CgdTcsfYNiZi7Soq",
-	"uPDv/5WPY62IYs2sv0SPS6NhpTio9FD1YqAyAK8W/5RdfXns00eDJN6UqvPQ2vKUWoNFR0/+zAmmLMzH",
-	"A5D3BHeu54EbVOcwygg1Np4YruhQYm3GMIbW5HRSx61CTqbwYnwuF0ecKzyS8d1vMAw9qFSj8lUD47UE",
-	"uNY29waJqcWOjEWECd1poaaqjhqxzeyg53mwLgYRRg415ZzQveWBYg683wcBhzDwcSsd6QMCdnQibuZN",
-	"3RATxmLZ8yZNPQEuohm2XhNCCTAvDHksPRc6aaaaob0B4D2w4u2IHXpxEVLQnQK0WJL7Fl1RiJj64wcg",
-	"yvYt0GwKg8XxmA8xjkfQE4tanZPvvcqFzzJ45GMuWetinMqpl3JUEwzH3r3Vcg/UYRSRlSB8FXmrDl4W",
-	"3Z1bympB3rnhasZ656FeTfBoBvxigbd1lLyK6yuREHAFJx/1eZv06FqKrwpXZ2wTAInMLUhnSG3aVKlr",
-	"pQggx3JJ+nvwSRS65P41j5arJN9M8xXcdySMMEHOMSH0xxUD8cVgY5iMtvbDQTNHyRlRtLyVToqiQeud",
-	"F8ULWe3YKB634vSIFBlVqdyk6k0nvVcgHj7A2ulFCMliElEBX4ks1IHqkUIKxtV2PzVUxYbfpUp9FpzE",
-	"shFY8Fbl5gW4yWWs0++yYCe4yA/CmijKmuk1ZbpQadkFpVzCWCwhd0f5kL+GLvUjpC7eym/IeTC59o5c",
-	"F0Se+di/vrzsXp7lPyQR5rnhZDi55m69MHS8699Cz6WUs9ITBZpSrumR1WqPBXNq8S1bbuK9gmR0oHu0",
-	"oGRyDahqKZr03GpSvedO0eR+4gnP2S/gt7cX45Pe5aBzORz3Oyed7tvOKTPJ6Id+57Tb75wwL1HyMWQu",
-	"DDdEzi4rncfywFOERvvqaCz5fTA87g8zv/SuUj8c/37cHXYvzyR4qY/SZ6UDX/ysA14lzRhhGqr8Ta0A",
-	"kMGm/ARCNPXQhGB6knIHDatfEKJFQKWJwLirRjhC/jRSpgppFm05H6Dy4fhk2H17PMz+fNpJPuTXqBc/",
-	"52pUb8XDTtoAVQn3ZWf4e6//27h7Oez0Xx+fdMbn3cvftBRQ3vSqqsVp7/dLdX3JErTrw0T1KOF18i5F",
-	"Sm4CsGDefZ1LTmmlCTrh6RaU7aauR1hCddovxT05eGVPmtZBpC2xAvEl+qQJHur60yBcAHeq3GcsghAB",
-	"Vnbc5+XbpBsB4rFPR9GFCbNw147spHnzKPIZAth6ZZ0M5BM35E47anvNYOh4CDOlbQlnrg9ligwFEhKu",
-	"dTw73MqUeh6rwOZLLqvpXemty4KdICpFlUWEpzm3aWMW67cJ6mQDF1Ll5veYFcOuR+9xrW7jgkI6+uYT",
-	"58n6KyG6GHwzYkvTTwGRSSViI4QWD/4FiU2aAjUJLu5mnlmjKXWU9dl8xcSXWoIZAeZpq4AIVR/LRggx",
-	"NcEXJEbVs1aTIFWflTlRFnmvtN6zr5g604g1plA94ZVQKfNObYpC2eCF1OkHTt1DLGQvXdWgFt0q2/KX",
-	"S1EoPikm5WgPtCAiy4gU04yS+H+80NJPiEgU+mIhpXTC5yojlEoSTr3++TSomWNWQ6tp8jOl0/QO1n12",
-	"gAIjURHXIiQh0jxBUOwTZ4MwbzjIecOrXNtLSO3kopRx5eYuVF1KwkTlnZNPMu1fooRB1h75oMtXOIGY",
-	"NYKCeVrAJWASRJ4DbhD32YIgjD93HW1f7BJU3VNZOwezyENV4kbLoIDhOSyME3fzceIqFMpEOt8F+6HJ",
-	"CSIlDw8wyRB7atJ4r1spclBZQaVlYw5IQaDEWfc7g951Xx8gnP7W75x1e5e5nwfdYUcFLzVRwfXD5l7n",
-	"lNIkPcMXVHhSkQZuXfkVR1MA2d9YlOUdQgWBCu7XrJTn0Gus+Zg/0Kq2XvPzrFly7Uf+E6DVfuTXpNMA",
-	"S29hGPn1abQf+XoCpaN9/cTZF6uoR5gVb/GypumIto3o5dk5CumzILyuNiXVIJ/igD6J0LjJmJcSHsdp",
-	"vPZyt5hwC7fSnIiLKLOAkmUxyo2QcDz4FzxbZVXOmuwQdzNmCl1Zz1QAm6NzuH81pJlaghkt5mmrgAj5",
-	"u9gbIUEx9BckQHEVstn7k/hl8Rzpyem/WsJTFmBGdllqKiS69APnGyK/zCRflBAzsNQlSd59jGX/GtSZ",
-	"e0teQ6fZ4b9mks1iugbxFlFlARlvlHxzg1vyXYV8sevPPNRwAbxzA/AHqVl1wGeH/no5L4vh1iq8aM6D",
-	"6Vk3wonpKb4gH1oifnwiNiXYAjosJFuyKWIlX5hESX3CJLWokRTQIPm6CY/UIzdSTWRDNj0J78+DGT4L",
-	"g2i5EZLTTPMFCZDkoalHjvEAYy+Y4fGMDWFMnnlk6IhVO8fXS7w6nBuTchmVmhD2VRhM3Q2Jfu1ET4W4",
-	"JTwrkfdSDNKMwAUIBiQu5/lGiPxKWU4DMs/RbBWhXyASupPHOMRTMz0FUk8B1JTWF3yQxqe5CkQ5tadn",
-	"+gbIPY3/+vSuJ11Dgn+Uwz0z1xMi+lWPeEmMzU/5NCBmpP8NnfXZfWhM/qYnfjqhdCN0n5niS5L7E6UG",
-	"g6zkMv7Lpvq2m2cT18keVgk4C78x3RbRXwW5bpRQLYmWkWhD4qxPlbrDPwHCkCAbkGI5EepyHerVja6q",
-	"fJYtXZgueCaDa0wLGTrNq58VlRaoqoZWEU0cRw6nXpJZL4hV1aTx/Dd0ryHkwRtwFd147gT8htiL6Z3L",
-	"NGw/ZwrpbGM830XO4fPnBy//gyYOhrt4Dg93fReT5fPDgx2w/e54959w94/93Zd/3fv1/V93wN+32z/u",
-	"/D317CSejz+i+3U+URFhFOpD5a/Fl/i5EPa+urNw/cxbh4eplb6Du3+8f8cXsvv+837r2UH6xUI5YdWr",
-	"hUpDsQ+ZyOscf2n4MPWae91sg/j5eXoeaZ6aTz8xnxcDH3W0k3rX/iPKFmx5dvBCV4Gp/f6ve8o/BX5j",
-	"PI/b73+Uv2maiZ/k/y3sqWxTAZmx5VYsirXJLOvFM92qFNgUmNrjXT1MfPIquuGQ87YKxaQoQUMpxQ+D",
-	"1Tu2GxebMspoiB8rU4RdMeSaZWZi0eutrfR9SFYjP3laKFNuMvM+pHqMG9QcKynMaviyZPEZVwzzBktk",
-	"Ggm+XMoMLyeUT5sZ+Xzk9gJ+4q9GgV/B4bORv1WjeGJDGUlgOEOkt1KEsBiiKKdIW/iTdwK9QWlt1yRr",
-	"gy+6aZVOPts4wKavPjeWxwza31DoI+8kWCxg0TsDSuHTHzD4yDoA0QOcuz4CPdYct0c+OF4uPRfxClmA",
-	"BOBC1DLtDRDOVwFsUAJwe2e0lSF9kTfAIRtPxFoKi4wV1zx8HSLEzCaCPpEW6A12HbREPqWGNjhO4nP5",
-	"AIxrcQsEPgJLFAKPomLbR3fsH/F7ujttcBFhAvyAgEngE+j64BaFvCCOzw0gelbVRp6uhGKs2bXHWvSN",
-	"Rru8iCIrmfj5AYxGvhaZZXUS65SoKyhQV5QloL5CoBbclWXpxGtcIx8M5jLJ0PXBD6edVz8fHgI+LnjN",
-	"kPoDs6VP0Y0LfYDJPUOkEZHGVmEaOM3Rd0B3IXv6GdaylLsw2lJ2IffSHfzU5dAc0O3OFpgrSGuoeJY7",
-	"I5pLhHc/8us+QsRw6zQtYFgq+N88kqRv+KY08h16xFYVG0yl+NHTpfRNTOQ7rICg9gpElqdoUqVDr6e8",
-	"qVRMftoIar+k+rJfpL6Ekb++16JCUps4WK+KJ1Npk2ICwQWV2wbzICRFdUDv6J9zuFwiHznAidj7vCkd",
-	"p1btz+Kin+pTWLiqyHgDqMrLhqfeUSiBb6VypLheIdLs9vPaegYv55pUGl35fS9VKuiEhn8TwNBZR/FK",
-	"Vk+ADgT6aOZiwp5B/HeE0sUrdRPq4CowCGre0viqRqJ5EDSczF2CJrJ0dJUifXJ1DdQ+ORG2HuU4BZb2",
-	"Nc6UwM3wTykMBSB8fqD/PHzxlz9/+Ndo9NfRaLeeAb22B5TifQd847/2h5RUxFyHmpdttrsXF9fD41fn",
-	"nR1w3T+X1UCmroek6UGxoVgdyjuCcnSO3QIUpnw2Ueju0kMkb9VplzSOQu1zNVP3E3JMNnsObxG4QcgH",
-	"rAsX9C6mfBXX1ReW1WpbPRotP58/0P9esv9esf8O2H//+VBrUxmkhTsaL33929kIbTW3N1lc0d66CzjT",
-	"6m7HBXct/Jhk3Tj0E+hTIy9EJHTRLXLANAwWvF6SeBWQv6q8louF0ShzVDFACnQ69k27cZRs6YZRyY1d",
-	"roOm17ZpiIu2Qz5VU3wHcq6WAmSv2sirgE0fn0x4jdj/fIOnsChspdcUucVWMpnct2SzFtB3pwhT7dOd",
-	"zCXXYYmd5IUMoUW2wZCylrwolE/+TOnfcYn2FOT8faCRHzv+oXPLapZHWNRWIgGAnhfcUWAi6IEJtWXE",
-	"E769QRy0xWrY42jJlUXOJjdw8hHxx0HL2fpQZes8kEX0JD0xebT2lpByNyMS/gISJgFT2WPvjab6vKpg",
-	"gc6nJX/LnATs0pUPxtU6fu/KAQEf0T1mvn5efbvFCk75oviUMPiM+G1/92WrPRrtjo/21FdGPqdOeoml",
-	"eO3GPoC8Dqgxrw8Of666+N/eMX0dS2bBV97DxC3la2hB/IavSURAelWNwgAqL8hLXn9KHknUo2Fo4PHq",
-	"8VYPra2lBwnllleR7+jeviom7fjFBfZks3hFVXCTeIeFjbONd5hwG3GGFYEgjPtvKMczVwOZg8Q6Ab3B",
-	"rodukQfkjfTERXiP16yOxPthbTDy029sAQdNYeQR5TVdDod6QJW+fiRwMb7hyNA/AEUPIP17/vRXZswx",
-	"40k05YKdpOkG3CAv8GcUc21wzSjIu09oiEqcKVy43n3mahjX5h9jUSrAHReW6OPf33LdKb948UEAbLr4",
-	"8reoGEBCW9Pfly0DrZxLWLadaFjX/fO2KvGC0J25lNzSRBtbvJvw3lCIi+RLs3fhC+r9reOwqbrmW/kF",
-	"KTyHh89faC7s3hwfPn8BJnM0+YijhaKiJNFLPpijT9BBE3cBvcx7/9koiZ9aWwvXV/9MR9ZM93dfpvdJ",
-	"QLbGiCDi4RN4gkJN+GKUdpcPzwfg5BjQtu7UneQf+tepnNt/P1Ijnd5//ulh58fcj4cPv/76n/RPzx5+",
-	"TUdDEQ+PJ3A8oaBWBYUINL3XlKPKXD/pbqrScjgvelLflSvb0VZw56NwtJUQRoufNOyk509O8kMofs1O",
-	"fbaiNxhf9Xtvu6edvq4oZO5z9/J1/1j34bxz2XvbSy0/vSYN/fRiAZ1dLf1dfaCK/hlM5ZOZ/gzg+FZC",
-	"WcnwH1ed/ALYr0LVV36J1f80zAwiDaxXwR0KhfM78XFpns7jNSipUj+HvuNRYJe0LxD+6dQjMVe93zv9",
-	"8Unv4uL48nR81TvvnvwjswJtk+7FRee0ezzsFDXo9U87/fQ7KJoVFK2z5EUU9vg+XxB7HCW/Gt2TJ+oX",
-	"VlY09cPr15lfBuedzlXmtzfdV53+pbpk8UhTZ9AZZn4TKPnHybm29bjfueocD3PIKXxFpZw5GUsq5btS",
-	"KClhrvS3V8f9zkVneHyeAquCg3L1v+oGIEqYy+63l+5JiJg+Br2iByOzw/yAk8dbks4pH3sNnWI0KvIu",
-	"fn4QBls9NzpcumMFLk2+Dly6Hd9ZBq5PzJd8fNUFSPRqoDYZxKBRwOUMuvVyw6DQdomBThkQeSf6urZD",
-	"vrTDoaplMOvw28QrbRguJ6czsXp1oDWzff+WAl6Oa6qUKjCXrOg3gzfRs7cAsu9b5DvV/s+rdOvG+nuR",
-	"936tSKsKNmzqK9XVLRTHtqA59UjRnPFl+uFVbj+yFif9Pa4CXyaI3nYuT3v9IlEkvnJtbnz+PyfHJV97",
-	"J8e6hQggNcjNVAysK6x4Vb4SUeX6cxS6BDkXhfeTQ/bggu+w1KD4SjL2LXAIRR39bbwz8lPeiHgCSa59",
-	"UTY/F7kvpV86gp9FobGbYn6BRIf1AxJfGbu+yFsyzlFKBZ0/lF2dC8jH+fvLWOgVX+sqz0akIvGzd7kx",
-	"Rka+OU6arrVAuJcssFjcRJVx2kmJzM0EbJc//CBedhD0mZM2kk75eyIz8ehianda3FPAXLPIJ3UoUT19",
-	"n2dXy6crCmsufupB+z4+a80XWb/8qHhqwkCCF6HRSGinMaBffrH0ESBuPORtrUBvQGSKbMiBvmwW3ST2",
-	"HfjR4ob7iFmlqOTRnFlRKunC9d0FlXolMWA8zTRTOktNik9euEhRmUY2F1ZqrC/e+EC7spxciaSbhIF/",
-	"Cu8vAp/ubhZ79KuIfHYguzhf0IZg+2D32cEOf6qFCzTxUIvv3Yu3sAXvQ/CacX86PnL73Y/v/7P97mD3",
-	"Jfs/h+8pAb3f+c+zd/sH73e2t1uF33Z+3NlJWQRh4I8deD9mgGktGb7C3xH6aLLAO4Q+gu393RerL29/",
-	"94VYDPtXIeh0yiLI3wSRLnlegXtOW1CID5+tA+SX7/9zwP/P4bv93WfxAnK/a5fDgClay4XrR1omVVaz",
-	"4G3oep6/rLWedvGCKDU9F3SkrCf1s3Y5AprCBVVyTcwuB4drYpeDd/sHhyqPiB/08BcxhSMs9gGaBL5T",
-	"cG7KRhQwzBtKy0AeMUAeMS2WwSL/0h+n8BM/Tn9+8RNVs+Lj9UCJ7BNTjsV82jDUYuVLzq838deXEZc+",
-	"qE0UhRzC1pTtLselw5qL4Uy53/pqxKq291pWUe5JE2tLnh4vdaSlW8eZfm8CTGo/mxv3LNLBRUKg0K+7",
-	"p5KrYvYZ+YAumWWGicbi4Igz3FPsllPBVQ0c4gINPJfoPA8wqUgrpE2MEwtZl6ZKuNr7iSOypikjUMkb",
-	"1UMm1XJr13eNe1agkWqxT4gaKTgVKKRNNpvmmnXOps+JvCBNazqqDtdK69qqBpFWUlPP4hWYBBrzYZA7",
-	"8HQXVqpLXzy6mAgkngSi3vYNTt50Tq/PO8zrdT3I3PZlv14cdy+Hncvjy5OO5mtvML6+Oj1OP/w3yCI0",
-	"d5AP8iERmciGdAPV3UupWHwFE7iEN67nEpa4yZ+qTKyieMGdk+t+d/iP8evO8fC6n/U/5j5f9i47ut/Z",
-	"D53xq15vOD6+PB2/vj4/H592B7+NO5cn/X+wS7AUHjKr1CLiFtEm5+gWaeJzzoMZ4BFejM9kNGhciE24",
-	"ajLcuYgw4XroDQv2cn2qiE7BR9d3APp3BD0WijbsnHcuOsP+P8YnvcvX3TPhq++dDdLIe9thWDjvvO2c",
-	"51CX+njS7w67J8fn+S+dfr/Xz//8+3H/Mv9r9/J1L//raefV9VkavSrudMjVl2iva3rz6ufAwPBGvlOq",
-	"fCPfYWldivIdv86amYVvKeLRq7RNMjbfX2pw0K2kOxmE4MadzRClDsivQOJ+LBdQ9GyrGvtPhy9/evni",
-	"b4cvn+vVduQ7T1xj36Ab6WUKRr43T0iRTRfkN7FVioi4sXdyVQxlXkfQmysq8RbQGm1hzFR16J8n0ZZx",
-	"wCOYEyyixhoT1piwxsRXakykjjBVe9ErJzojQN2u+soLQdYpbp3i63eKU8r6AT9i0bKlYd6S7t3TcDWv",
-	"sVPEFvSrToVSXe6MBbMO93bJCbgu93o9+bFRD/OzjLZI1qZJU6llpP8S1FDTbQ67AK4A7HNYED06Q4EX",
-	"8GohwIPEJZGDEo2WVawBV4HrE8xKHainBsRxj10v8Ge8Lzs0pHO887fMldHIB9sOmoUI8fEWkUdcVq+J",
-	"bvDB/o8//o0lUoZB5DvJseUjGCJMgFCIdyhUYlmxhSr98dCfsRX8dW8XvNwHYrZyj9LLffE/RT3fVX5U",
-	"kTz2INGXuqEA+TMDPMfI+noQ7c9KEX3wc4xpsO36Ey/C7i3aKcf6wc86tKu/pvHuzzZTVSal9OiUosAr",
-	"SEQY9M4BRpgl2XnuFE3uJx4vm4N+AYPeuQj2P+ldDjqXw3G/c9Lpvu2cMswnn/ud026/c0JFuNKE30RS",
-	"XW+X3a/x3OLUdVk8QuamLP59MDzuDzO/9K5SPxz/ftwddi/PJJCpj/FVWuFSUh91C0npoRKRugs0/k1b",
-	"K0p+AiGaemhCWK45maMQQIpGEKJFQFC8Ey5WSrLACXFvkSwnQi1GHQrzV9PJh+OTYfft8TD782kn+ZBf",
-	"Y8FddLZAU9E9A12sK8s+pQDmk3cvT7snxwzRGbhz3+M9zH3pXo6v+r2zfmcw0H8/TScl5aDXrDB+deMk",
-	"8OhWBQW5KnSdE9mE3Rarq1Svi8/POyfDnjZxpbDZm95gWPb95Px6MOz01cUVAF62xPwDc/UspuKBNHFW",
-	"WXQavR2TXstDa2tW8IZTUlvUC2aAt2Ip5HyAonwZ0wpNmfRmw+SYwgecjCsHxl6MeFUae0FJQz3Y/HUx",
-	"zyxXbjYSB4sOwMO0Rhg3Zm3XFTeZo0Md4KqWW7mIvK47dp1NLE33juMmMyrElk8yh4QgVd15kj8lNCpG",
-	"6Zt+de9hks2gZC/T/ikrQDB3UQjDyfxe1DHJnzReMIs9hOW546pL7KFFO/KFrvZCp1dGhMU1slNEWMTu",
-	"tUguedyjgrAEhquYhsKu4xjRPQvqcz2oonWN1CxWsUEPedlB9UXANarA3lWKzpbBLIvTJtcjuVWIuxJ3",
-	"5vObkpEPTqAfO7QDHyUF2OW0LZBcYrNSP6pzoPIimQJVcZEcF9UtxEDixihbv/BE3LH3l77M8ldxRhQv",
-	"XzoeyhbPLmG+5NKbuhA2Jrrigz19xhbJrKwUKpNa2sc5GyrDurGsPmz14S+gD6uk+DWqxKmXcJ+WVqw9",
-	"MQyOmPXpxhw5tdXjhQL4yo8XLyoIzFDdFUtZm8ZrQjeiDXuK6RZq4gn5mtlNMm0AtpOYmR1NdKFUK83C",
-	"C1nf+yWqDDGkv3RPBuX5eQf5pUuwtTfOVuG3Cr9V+K3C/8QV/uwRnZM3JYLZRPtPwVjjhR5WPxg5x5Wh",
-	"jLMgmHmovQwDEtxE03byikX8kNNqoyhOQGU1mrVmXxLMOKgIJCjObcz6mHjSgr6oWvrb+fGwwxwX6Z+H",
-	"x/2zTqpKmP71pIR85MO9et9LX3qKcSJHlXeJVdh/7/V/O+8dn+q8L+lvGpdKCooSKMVj2/VUOUbVC+S4",
-	"UAk6o3pA7AdnGRqigugNIncI+QDGq2Q+WAgovUTqCNtuG7VbsSjZ0cWxNX/zSPatrezJjuWHTpmskSOY",
-	"xuh8NDCs0zsojepFvJ910bPRlNE0qiQdcGC/cAUKCUyTh8Bl3yKSigk+H5FUWs9K9iuip/hBfEN6Sj+I",
-	"b6I7l0Bukui8jv3OvPqv3fbso7z8tFO2JcX2mvNRnH8asaPhL9PTnKgI5NBrD/aLzsUrfVlIbRNxzI8v",
-	"e6ed4rVUnfiNzXfG9+4E3EH2biIzUHNnODMHgxv20CVMibVMCtUnShzQqzaGZMt4ilb8soLn+h+52JGT",
-	"BxHBroOERg49Viu548wQ6PrTEGISRuwxJHABfTjLsWHdQoIStoIjqc4xnj7AcdHj1+xjlsaM44QzVF8S",
-	"FS3BWMeFpwrmk3/kuNG53PxhQG3QVQaZIiZJs+nrry1a/F7fqvJ181Jnxc0qE6oFgkZyieYwLrPeiq0i",
-	"jTMk/pho1xhAwEpIsnhQnhMXP1bMX1y457//wUzrEHjBBHojH0ygh3wHhpnnfCZBxDvKUjFUQZ+GcMKe",
-	"lkh9ICMf+NAP+A9sM7yIFZAFPK6MjuRiof7f8hdsfICWwWQOIAHXwxOwcB3fnc0JYLGy/w39CIb34KAF",
-	"Dl7+bb8lg1yXYeChJXEn4CxEsyB0YQK/eJKHnr8UKjJHI1/X7AZOPt7B0GFi6R7BEAQ+ao/otMeeF1cs",
-	"giECL/bjRXqBP2uDcwSXybpDBEZbeIFgiJzRFsABv+jyA+AhuBzJ+1XAHxN2MfARckQuN7vQXIaIhwdT",
-	"+eX6MwDBu8OfdudBxF+PhiEdhE7wfntOyBIf7e056BZ5VGzitqCZSbDYozu7x1ru8KUM45BgF/OMnf39",
-	"/YNd9v+G+/tH7P/9k6Lg5cuXL3cPDnefHQwPnx09f3n0/GX7pfzfP9vg1T1LT6FUP2E11olYJxu+Be4Q",
-	"QD6OQnHNd4dYos4k8G9RSPhWi9eH3vVfn4x88OzZs5fJeu7u7touItN2EM72wumE/n/aok0+kR0g3r2k",
-	"DIf5wv4EOp/gYukhzP4Uf4CDI3Ai9I6EO9isV71B93/AB4qh7Z0PfBD6v6RZfIz9Ij7FP7QxivNAt9kI",
-	"l9fn5zs7+oaMB7b36VcFssMqyGaI0HGCqQPvUxBy1YRNcgs9QG7ltKke/HFFctsCDLZfVljgbZvc0r9K",
-	"18dbRRhNwI/svevMep8Vrvd31392CD6cIcJfPqCfj/Fr10PD7Oa87p53ht2LDpgSCUtRN77+KYmBvu5e",
-	"Dl/8BIg7+YjBr2B7e5v/sjMlbefujTubn0LC+u4ARfJNwLPDHfAfwFqdB3eyUYLQvT1wTBfhBHeYDU95",
-	"62B/Xzn8cDtuwI+3gxd5rkuGo/0PXvz0009/e/ZiPzlsbtA0CBG49t1PcpiXf9vPDtNuus/bHB1ge5vj",
-	"aI8/W063EuyqAFWROh2IIk0O9BdlIEYbOxna+KmQNv4b3kLwQTwqKcLFaZML1/NcnKINehSDBfsd/AqK",
-	"u5RyAvg1+bXto7tXkes5KNzeocsTOZvbYhKOnx0xGP0fbXTJUeD6hCJANOUYEKtniNhp39ChtzOYeF6B",
-	"CX4dQ9p+cJdavPgd+MEd+BWkWlWsVwG/euV+cNeeIdKhxMd/295REZBGgmhN/9guWvCLwgWLrYsz+a/u",
-	"yTzwkyVr92x7J0eXZ4icJFRAGzBxwd7luoDLpevP2E9dX7zVxRyQ/F0sBWPUYHdxWhkSD8txLWTkM0lW",
-	"S47xuag+BKkq1BLj8J/pdKOtz1QXedj9zIr8Pex+duD9w/Az1QUejj4vXP/h6DNGk4d37c9UC6PM/PD+",
-	"n6OtkXQw8f7MreLdwXsM0CeqG1K7mCsXU6pWOO7MZQkbroeAmKsF2GStkQ/4fC3AJmwx0c1mZcrOHygM",
-	"dpfQkQlKd4EcDsHJnCt7UkNkqmVck0G8lgh9MAtAtGRqh+zLbnjFjwd6PXKHgkYhCJZ8bD7XaOufVO2K",
-	"plP3k0zPEGWCKFUwRXd7tHU9PBlt7fyS+n3kc4WUq+9twF68IMEzThkYhS703D9QCPA8iDxHojTCiGmr",
-	"2zDOB2Gm48jnwOzw8o/L0PWJfI48Q1r8nludbQlDnMx0Q2FjCiNVnSYTtCTgJiBzNi/tHLBMG7kQnIOF",
-	"qtbBdIoREarg6yAEiHNhC4y2DvcP/kZFycHz4f7B0bP9o4Pn7f0DiklO8hiwH2JhtISYKvisLQMiUPTz",
-	"5y1Ax2vHjAVv4YDZKi3mwlNVQQioSJUPNsonHgULcBLlXEHPNAeGDmU0EnQHvQFjv+0djRbcXgR/uJ4H",
-	"Gdshf/d6sOcEE7z3O7rZS4DZ68uX+PbOvOAGeuMegwLvUZD2lEnosbJAZB44bboefha12BHAgQIfqE5K",
-	"0d+W//gg1yQS38WCEV2Abpl0XR8wCaess7KoYILbS3760eUc7nnuTQjDe6bet+dk4f2J/Uv23WHPAo78",
-	"mLTlNHiJJuCHv/xj9y+L3b84w7+8OfrLxdFfBu2/TP/5Qxucux/RnYsRM6sokpK9ijASw/134EBGuz9g",
-	"8O5Dd9CT+tBrfpQ54s/tnQ/vt0c+oIs42tv738CBuxxIZutOg3CG2j4ie3Dpsp2hK6OtuM3CAd7Lj89W",
-	"m0wy8sFO6h6RdyQo5JXAPHbLKq5zGCnFNw782I73gF27ChOC2u2H+4fPNPyw1aJffqKW0eHz4cGhULqo",
-	"XR9HrlDw2GI1lwPMuzQNeHCkT+CEOUb5TdlWb4l8fgd5JR5UBGcueRPdgF44g777h0zoikJv62hLEsjM",
-	"JfPohhl8wRL5u8iZoV35JGPu8fatkktOcHzV3Wptee4EiVf5BWTHSziZI3DY3s/NTiUcZJ8ZdYq+eO+8",
-	"e9K5HHR2D9v7bNe2FDduCQhbrS35buHR1n6bzvjQ2qLrgkt362jrWfuAAbGEZM7ul/bYcl06WDsIJ3Nq",
-	"krJ8PLh08d7t4R5/YPOE1UVjPWZIk2Z9hohSXkE8ypl5OHOLAcL/6jpbR1snytADFN66E3TuYqL+jA8Z",
-	"sCFcIMJua9/lH9sRj3eyJ78ARrQ1PbQlMCzIit1LUAZ2p/f03AlCRs9B6KCQqvwDhEBMEPwGALrLtoNu",
-	"9w6eHbLLBfn4MHN1bB1t/TtC4b18cORoiw31iv7Ar+dKK1h8CaDrhPC2MreVbMrxzb3uvq5wQ3jNBe43",
-	"IFEowlBcghb86WuCQoRJ1UJe7JtgfyorQtdB/qYArIXpo71fxVtOP2SQHq+pEuWnLGiQK2lwIe8YmadC",
-	"vOss3tSWBbRFHa8lnLFgxFPxgG0wBYf7RSimjQfuH8gMyWsHKcmoL6qLTbuOMYcw/8jA51xshYM+Ja/9",
-	"h5jwnY+pQVQUYa+CY4A/uszY4UsoPASYimiGoZUBUDGSwokSTh8DVI2QqzBg+pZw/2hJgDe55C2Se3p+",
-	"v1+05szAShCnMliWxt8zf9MyoMKQDnm4vy+lPuKvxMHl0hNJ2nv/i3ngYAJCmd8kJ2T6YiauYWTinqLJ",
-	"BGEeeIajxQKG9+IB/9QQdAlwxnQfjVjbev/Q2lqKwnbp8bk7DECt0DSSmXwE9UOl0HyCe/3vCGHyKnDu",
-	"17bNKkaSYJL8DjN3igb5LBdGOCsfNkiOpnDqKTG/+5Wk+NCqqfbtfU58gQ980z2ki1Y9Zb+vQsx8hFrE",
-	"LJ8/J/E+8mmFnOGwOvGRTVXfhMwVp7Y5lZvPmHVQdx0Tef79HMX57a5/FufHMDiMS6wYrnG7k+ZEzC5N",
-	"G1KwOAmRUzj9Jqi4elZLyZs8xTMUs5YjfB5gYmqxs7Z52n4TYKJY5vRPa5Fbi9xa5NYitxb5xi1y8fx1",
-	"LOeSIsHX/XNANYD2WmWq2XxpXsqm0j8N654JqmZWPeuqyF9FBJpY8TIxqlCO8pb0h0pB+o3v//ot/vSz",
-	"AHpLn70A8EiWfRU8ZRY9ewahiAyN1b+9EM1cTHimkp50+6KFCfHKtgXkqyPFlL1QRHmcMNoL+Gl8c08Q",
-	"Br+Cg2cjP/3CY9ljCfWsEstYjQhZUNLDw8PTYxqVMtfANrUvvCpZh7c0OvdVw5wdV/LM2vxtVsl0ltUe",
-	"+1pMcEKj67BSPjC7/qok6TP+5E7D6y05/Gavs+Qslnofj3qbneCCmspUb0gmmqekr+jPJkcwa2hEsWq5",
-	"kSTr/gYBUZBhnXRrMlcz6n3NywtB/FGMyLYUObJQOMOnvP0rvsdBnnMB8ccS9e3Da9noQzp5CiOe7XS/",
-	"uAk8dyIKHrHgoxa7oxExY0dxXDL7dgRGW9M2ZHG4mR9v2g79eeSDNyhE4MM0MyOfwfUBDhYIhEFAwAJh",
-	"DGeoBT7ADyzY88PNh5EvL/REEpRoBKZB5LP+H6YfeKjuB+dDPG78lcdUyk6seftGRJQnaOfpTDKLVrk3",
-	"jG4EZuSt4hySVKwqv2+Qz9bMaGNJ1SAIwSJw3KkbR6ZySkmatLNQeDgAc3ireOZYkCyLTXX9GdjGCIEb",
-	"5AV3OzIhiPe/YP1dH4j7djfweYrQ7+qL7MwPRk+OTzwrTp5hLo/ylAdNjK+ApWBFN7sxAuUbVUnO6PFV",
-	"l4Um+t69vJgCZB5gJHEGMR2EC6sEDLrgdjo6V5TSYnwQp7yhWzeI6AiiGQvxFuxBAh3QEINpwG5+Emqd",
-	"gs9x/D4ER+DwMP7zRvkEgAOOwIHy9yfaOP77If7XPW33TPwpf/7jCPyc5J8hHHkE3Lmexx5ukriJxTZ/",
-	"Q4Fxl8DUp9Y9o+Q/Rj7YJnPkhuI5SzbIDWJsKh7HcPgtHyf9YOESwnHLYqsB3eGRD4KILCOyw/CwGiYe",
-	"kqWOfHCcPAEv+BizNbILN+QA9IlFbou6Vx7EBCwD7Mbv9I58flSI1AIRQE0JMj6gZFSxGFmWUWEXnik2",
-	"k9kECd9x8mCXpdDzJHJZpHhmiqnSYOSDOaRcjRRqFVx2GRCR4CcPGEakToA4dD6ichKGrnfPZr8Xu8RC",
-	"oJe7/CXbLKGyEOsJxIizYr8zGOpXpswol+a4IZqQeJ547Ba4iRiS0uOOfOEeSQ0tOQ0TBB0VawhO5iyq",
-	"/tZ1IuhJgEd+/NyKPPViSUiH1y1InGI8qLxFt4kF8LPqeS5Ppl3Ae/kCVJseV6JmVmq9ggFGPph4CIbe",
-	"PXCCSbTgT9KQYMYf4LhzyRy4BAMHTTwoqEGAfHzVbQPQpbsJ/XsGJkcCmk4pnQUFK9tLaikoORMjH9yg",
-	"Obx1KfMGLKAYFxzIvDwS6EnUY8FByq66ecmAYxrEIn9EkDFXQShhsaJd6h5QGTYLRJKqopew44ge0+kF",
-	"xIf2nGXKxo/n4uRmXZzf+tObYdIBHqLyiv7IthaDyCdBNJnTibvqy9R0+iXEUhQFIz9+3Zp1F+AKIFqx",
-	"cHFnfhAKf0gCIPS8RDfg5+utKpaYfEnOlfx5layJVdvMb0EL+OhOzkgJkG06JUmkvlKEPrmYORezU4jE",
-	"BPkQpygenJwlFH8auNieyINUDJI/QcEHdoB+yJ+gGXGd27rcWPFWc2Lk2Bjp8EGoLhEjJZZKCxTO2PhZ",
-	"jCigJGdHFiH5LJ2Ze4tSbcUYenGekVoZ8a2X3pMj8O7gfVqk+Y5CBDVn3C+Y4zA9B8Ofm8O4KxUVoT6/",
-	"Y/rzaKvFFOnJaOt90ptkNIubBjCWo6UFDrOYAS7dmEX81D49scWjhCIpjVe5uUVhyIvNuFgqKKlzkj/1",
-	"yAmenh2xMs/YOM6jYj5zUccGxTL3ByzoLqsBKeiMWLZW8i4YFyK+o9GcxGBJbQX+XqtCl+ANYmUX3TxE",
-	"iVqReny/FaMFymkY4YtKoFnzUQKmDKfAzWo8OvSY5AqQHJivCceHp8MNAuUISNFWSn0KJIVzrOl0Jnos",
-	"abQmZcyU2gTmEGuUJkVlSlR5jAQWuH0K0K1IcWxxkqF0tUDQl8djbHQhMPFcCn+scX30gzuGPzo9lTs8",
-	"aZx+mrqeJ46jka/eMsUHFt9ETg5uKHeKiSsGh0uF2B30SUrQt9Q7N8xvKhjIQqZSm41DyRRPljIpTEl2",
-	"1CcYZGTiRBNW9MIHKAyDkGLZD4goxYG5LpNSB4VIjF/uy1ITUxMUoYo1UjVR4DhGJAPyYilYaEEpbZo9",
-	"h0W5Uq82aU4zxuVczRCayDygIlw1GYWy9CdwEvjYdWKth0qfN8PhlVBbhS3FfqGA8FouOdEkVKQII5wm",
-	"1eRAEDt+dTw8eRPrvMEUXF0PUwyOIXHx9J5PidEC+sSd0CVt05apEtDMqmbmW+R5AiYc2+XMcO9Iwz2Y",
-	"qnqhmondyjFsOvlavOTOc7B5sjO3nWDI0kXjACh2A8Giotp8LsyvJl2fK/S0F9MR0xmae+zqkqob4e4E",
-	"LpBHe9G5WDOfp4RJqoR+IqYnYuc4ATCLm3ZLSCrWwRVDXH4VJV0VKXWNUUhRGoJfwcEv8c9Xc2rPLtl/",
-	"fwWHv2RMbjke650MJtDluHjpwfsxw0NqWNEAOk6IMM6MzDeHW9Iwqxt9EKB/YLzvBQE7jHE0mSurpI0T",
-	"aOJrMbq8tgpUfHOmNGJrjT88pGmFFRemq5HEknmbk4mCBJAEBto+C8IlXKCWbrq0EUOP2Z6PJAnj3OUZ",
-	"CREkigQLaGMM/pcyC6QgziIPyluOdszycRmADOnkqWXASO5C/JUsik0ECMJkzP+pKj1ii8Xe//SL8mkQ",
-	"3cjBcHQzltP8Cl7+UnDjMUwfcjzXuXrDU3vMR+qF1d0UoDK9E7Eq1n6/5MXlMNgebSWYGG3Fib/0zN7J",
-	"P7Us72DikzjZcvAWhVTKicdbxfIzSqCoqkUtNG7di0N4zo6t5JKFQaiaREI8UPEjblJv6XSyAt3Ei5zY",
-	"HhI30OxxWB5oyEyTD93Lt8fn3dPxcf/s+qJzOfyQCFEKFDvqmBRfwCXLj25bL9IXiOO5Tisfsv4hd2M8",
-	"vdiE2O9U5tuKNA5Zccdj4NriLVeOLtiAe8twOuuftZxVn7MSsl9zzM+e699Cz3XEi9ha7uzGTUw4NGlt",
-	"xKW0EVCqqq6RI7NDj/xHDr+juuKucjMCccCqNskrY/ZK0TREiNXwYBK7gIv9gJRFvzebSC32enBY660w",
-	"/vJZtvTraCtbYTfQvYhhw0/Wc5SkOa1+AFW6/7oPlsC/CWDIavQWxLP0eAuTQ0U0/e5PFMsoTRhFoZ76",
-	"XKJ0XjeLpGLW9TyS0owB71CUoJuNADMJYl8DU1gWsDHta7Ab1xvYPo5HL00LFq1Uuw2L8vgmEcF4wPub",
-	"pwrbzNTHyky1orIJAwvCFnRdX1pmGGMlRpZ+X9Pc/rh9nnXl+1RKjr/8yeb52zx/e5raPH+b52/z/J9s",
-	"nn8srJrl+sfdFXmcEYkmOf/qu5Wl8pX3kD/a/P91m3T55zb1NQCSN7Qfpw6ACVxltQC6ytvZhWRaS3Ws",
-	"n+BsTOS8hzGRq/7BeF/UDdp80rPBtPZO5rGTnxWeaZQAbcQzZonQxqR/lryq3jApWp1qs4nR6kyWuh/T",
-	"O9VUFijUVaWvlCdKG9Mz62BM0d9Z0nSOVW3itE2ctonTNnHaJk7bxGmbOG0Tp23itE2ctonTNnHaJk7b",
-	"xGmbOG0Tp23itE2ctonTNnHaJk7bxGmbOG09TF8oAKIgxTNxZzzNSIiUL6rK/1WaTG3s/uI9vjP/l+U5",
-	"y3Pp9OoNRx/VTrU25t+klzkPy6uBzSRK6oY3TJakq7aZYk8hq7h5ZFJ+jJV5ygsm0DueTILIN37sUVxd",
-	"hZqEkHNlOCUpRP3ZJobYxBCbGGITQ2xiyMYTQ76f15NzQqZZQkdqCEW2asSaSWKHKlyNZCXvqX6oFJZP",
-	"cI/XbyOpGKlKzmBIh7ztYyVomMJXlqShjlFJerVVu/oJG1LLMyJc3qsW4aph5dk923zORvmM9rF6kxSL",
-	"DNU3SrOoRfVm6Ra1CPcMkcZUmyRDqNS0+dSL7GyWWjd5OmcoZG1HM3OkGlrccXs9TdM/MtY2a11Jza+5",
-	"uRQPD27uy+hBfDLQhEsGVsvj7VfUw8vWvdPTTiv/JDGz/bBLkvAKXLAmPA/uBi5hObwGCysaWoJIhxtj",
-	"MZ4A9CYIPAT9EkhDNHMD3wjWPmtaD1rN8Cl4w3jMaoi/P5uCoay5PRFjXDk1FIY1PDEE0/uzMb7H1Fw0",
-	"PDl6A82Z0ZODDdhYytGR+WLv6uxdnb2rs3d19q7O3tWtVa5m5Uwz8ZodRZGyehFncGnng97AUGDyLplv",
-	"9sKOlYZNI6Xqzi7Aj3VTVwOwssu6zDAmhNdIy6t9bUfJ11Th433q0q96JxFgsXWbv7ArmMrefZjc1OWp",
-	"vtFlXQOqN7uyM6fZM0RWIdjkEi3Am7+o43NYEt3wkZynCUNFoLTMSZ2TlHWpS5ffWb2TANtKJ7bSia10",
-	"Yiud2EonttKJrXRiK53YSie20omtdGIrndhKJ7bSia10Yiud2EonttKJrXRiK53YSie20omtdFKr0okN",
-	"KjAqmBDgJx5doHMkGTqzymqW1PFl8T7rigrYgC/LYKpvxuVqmWjRtOzIpkJ08JgT2ngZeO7k3jgOW16n",
-	"XtFuru41zd6AN2Et7lmAW+oXG4xtg7FtMLYNxrbB2DYYe63B2Ckp0zAUOzWGKmzTH8wCsFOy8r5KUoqQ",
-	"3Hqy8vtQ9tLIL4i+TvDNVZpHi8WuhK40BNuY4pqodfXrJdSlWhFSW49qVdsnO+HjxGFXz2rjXY1Cslc8",
-	"dHWjlB+7ZoHY9Yj4DJEVKNhGYz/BaOyGh3KOEtZ3IoeRvwErux/5quLSj3xrX1v72trX1r629rW1rzdh",
-	"X/cjfzXjuh/5GqlKf20gUlexcPqRXypY08qpiWjNOHVEmEwY+Y+WXVo2pVUa65g0jeg8N0Qhpde1ZKqI",
-	"VdFc61KqNWCergFDiaWJ9bLqIRtXR1+9mvqV+KQUaJI/2cpM1lixxoo1VqyxYo2VtRorsYBpZqrE3RUZ",
-	"mhFjJpXTi2v4ZgbjPeSP1utHt1Fio6rqkkTyY/n7TOAq8/jJ/qWkVUtFW2dV9AwovIcxYaoaf7wv6gZt",
-	"3gY2mNbaHiZ2sELnjYxgIzpftRZ6ZsQzRBrRamKdqlNt1g5WZ7IUuakzV6GIlQ9cWWDazCIWrfM0y2tf",
-	"K9awKIZtbWFrC1tb2NrC1hbetC382oOsRgt2Z5Q5REUIEhDoKTvIXkdweZEQ9vJAXHcsrlLTLnniYEiH",
-	"q/Emw1qBSj2MwMao8ZzD93VVIKRvs4uC5B0LqVqkpLvJJQHfxwo1gbfmP9nrAbp1HBdVlwOCSR7paqAa",
-	"prKLAd67hJRq6Kj1rwSMyJC3NiTDtNnD9iHZjs1fBFRMaU0uk0uAmKIbXQFUUrSZ+W9EmmeINKJLaY4n",
-	"k2zW7E/msRS4mdM0poRyqVxaNtmM5lhjQ6r7zuokZ9jJ1kq2tZJtrWRbK9nWSra1km2tZFsr2dZKtrWS",
-	"ba1kWyvZ1kq2tZJtrWRbK9nWSra1km2tZFsr2dZKtrWSba1kW+bV2AVUUN1Vuh+eordf8RqVe6jKaiGb",
-	"OahE1ud6/PUbLoJcMaUthvx9cYlKuivGxFBQnMhDppHbcXuwzTXaPXkRsJPnt4ForMRzy5+qI7ptNKuN",
-	"Zl05mpXqO/MAE9A9lZeQAriYkNl1AVWp4nuPloCVgZk0pLZjcqXJ/R8MVYDRMd5hI2lGSU3Hlb45vEXi",
-	"UiO52JWAKm5RMkeLFnDbqN2S8HPNinkHmJ41R6HLakf4HMQlDJFPRDAsX5kfeR6Hjv4rvbw0cHcumQcR",
-	"ZfgYGCwmLiIP2pAJHAPysNuxvu0Y+cJlsICfxjf3BFHr9+DZyN9KZRrQjrvv9ndfwt3p+88/P6ipBfTb",
-	"2DVSFujOsTV8DTsnAW2+c21+BWu+P/GUcn+Kot9dgupwi8V5DZwbsgTtV8QS9FsNlhAaea0NiqONMltU",
-	"tUEcPeZblNmgBNTsFo18802KDzQxXOpIq7uBCkhVUoY3rcM5dmvWvTV69nqeZS/etYjB+FdDFnvNU/Nu",
-	"IE7CKIm7QJjAxbIF0KclmpDYO3F92f0fgJbBZA6uhydJQxadiCaB7xTquJHvfurQnob5SJuAS0EhxV06",
-	"C5ACOEYCQhsIncpSim3KZnlKcXfFlM5YrnWN6dgoNs6H5s1jUIxs6X62l7WprU1tbWprU9vtsDa1tamt",
-	"TW1tamu4WZva2tTWprY2de3KHxnbsmkNkMwwpTa2QT0QPtxYslelnSzLR6TBsGVCePhBGivVBUMy2H+8",
-	"2iHmgJZXEUmPs4ELnybFRerStCwgUZOm0wFF2a3Ubu5jVCJpAIitDmFWnyTHNQ0rldTgGtOqJXVpnhWu",
-	"WIngkyIjmrk3XddEM6Ul4k0f+hqaqVI/qgqf1CVbEc9ak3C/u3ooRRxpS6PY0ii2NIotjWJLo9jSKLY0",
-	"ii2NYkuj2NIotjSKLY1iS6PY0ii2NIotjWJLo9jSKLY0ii2NYkuj2NIotjSKLfrQwHVUWP4h55N42hEO",
-	"Wk9TlburoopKXW+XrEvxKIEJGy+50gAQW4jF8qSmTsvGoo645mxauoU1rpdiNkj3sQlmNsHMJpjZBDO7",
-	"HTbBzCaY2QQzm2Bms5hsgplNMLMJZjbBrG7RlrRl2bB0S3qQ1ZLLuN1XN7UsDYJNLKP7m8ZJVVpZBu+P",
-	"lVRmDmRZSll6lLVf7dRPJ6tLxbxjTSpWL0qz26fZzs2nkdUGwmbfmKSQ5XikUQJZDR4xSx+rS+NniKxA",
-	"4Ekel2bezSaOaSa0hLvZYz1HK6uljNUlVdavJrF+Z+liRVxok8VssphNFrPJYjZZzCaL2WQxmyxmk8Vs",
-	"sphNFrPJYjZZzCaL2WQxmyxmk8VssphNFrPJYjZZzCaL2WQxm5hS22FUkJaS80c85agFjX9ppSSxuv4t",
-	"3nHj4QYbTg6rDYRNDLP8p6SErTtqyCXmD3jTtho2dUk674sYZHv12D+gx7VsRfWWk0mDNeVDDEJ2GcYs",
-	"DGoZDxACc0KW+GhvbxYEMw+1obtsO+h27+DZIdtmBxHoesXJTnSoV/dmYaBfAugF/HSO/BmZ8xSpVNjo",
-	"n//zr3dw94/j3X/ujvd3X7ZbIB1EyqYc39ybHBjx2rhTMsng4jYay+oKpsD1CQoRJlULebFvgn0+V03k",
-	"bwrAWpg+2vv1x+2dzw+jrR8ySI/XVIlym/FoMx5Xznh8WoK++FhJEgwo281dFMJwMncn0OMmBo6WyyAk",
-	"a0kHaTBpOl1Bq2NtPqydNA9mJ+kQ9kQkm4WvE1Qq12XAMvnaotS/eXrchFJNDOLuySMG25MVQuwJKmQL",
-	"Y/W4SSR9BUPJ6GZS14IlCfIfIzS+cLpvwSq1wmq1AH+yQlh/CV+aBvJXsBiLxibN4/XJowTpE2S56fvm",
-	"pmbyTVB3mc5XlV1QwT/iupfYJIJcEgGxmQM2c8BmDtjMAZs5YDMHbOaAzRywmQM2c8BmDtjMAZs5YDMH",
-	"bOaAzRywmQM2c8BmDtjMAZs5YDMHbOZAvcyBb8ht9L1GZZNmsdhPM5Ik9oOV+doqEh0qXG0ysnvFaJCN",
-	"Zy4UTmf91zZU7Ovn9IQNV4oZI4hKaBLe783CIFriPS+YmaZYxH3HtNOYD5A/O4ay2Xkww2e0kZKAkf9o",
-	"H9+xoeiPEIpuc3qeWE7P91UWXnPuNYum1wykCITCs9ck0l53vtc43vk4+c+2djwlgjxequLYddvxWHHt",
-	"9aAti3LPj2RIro21mfoR8SsSPh+nAeGrBox+twtIYPPR9SuAYyt5m0SoazmsUbx6Yw4zi2VfkTnOEFmR",
-	"M5KI9CJQNhsHXzSrpfPNyxAt9WxSgNA/3Elti/iCdzurMonVdjqrODWONYytYWwNY2sYfx+GceroW9E2",
-	"To2lExeac7iehSzkRKU6pJkoYxepLaypnFIcVNSYW8uprXl0g9kUZiObWR3MnIxXUXxWMZ5X4ImMJVOL",
-	"J/Rma4YKiqnjMW3phkBZS6OWRZ1hwdWM6mYsWNe0XoF1VBOpMd/orN0cTI9lZucmtvT/KMKniJI2JXmW",
-	"PEyymQ+aGvwizrLKBy2aFXmh5SjW3LbmtjW3vztzuy8eoGcx+yl1DcgDSiT2hghAjN0ZZSaWfEFb81S0",
-	"OIHHdZBP3KmLwiLEy6amQVcbg0//gv6z7Av6tGvR+/lyWMMX9NeyFOwS1D0twi77+piYFeAYIpO2LkIm",
-	"/faYiBQheYWorBcbuF6Q9Oh8nkUnb1+EUP7VEKVd36HKF2LJbPRYU1XSZAlxZQ+APhGe5cvXIJuO/KRx",
-	"SBuEyAEsgUaGSd4LiTP14CxO52Uh99AL/BkfL/BZjhUv6NJ1WhI1DghCkBwhLCOtvInIAvIDAjAiIo9O",
-	"zs0zp51CbpoHd11/jkKXIMdY3n7feIzZeR7cjV0FeYIEb4LAQ9C3N79aJXwNQVFyqCI3YdocaBAYJeiy",
-	"ltWhCYYRDeydb85ZLDBTM0BKbMsXCZEygNg4SEqMZUy+KxjdK4dKNWEFTdSKKSuURCjFu19IFI8eNFUX",
-	"JHvNVTtwKuG71UOn6vJdo/CpJgyTDYJpwi2F4UwKQI8aRqXMa+n+MSRNARXV0ZLKi3augdLZUI1o/Tsr",
-	"81nBxLbwpy38aQt/2sKftvCnLfxpC3/awp+28Kct/GkLf9rCn7bwpy38aQt/2sKftvCnLfxpC3/awp+2",
-	"8Kct/GkLf9rimA0dTQUl9IqcE08/GqPQ/1THS1ZabnMNTjI+1heJn9hwEc8VQfpm/MiWs9fO2cVMs+k4",
-	"q9UqihikOKVbllQVsYlONtHJJjrZRCeb6GQTnWyik010solONtHJJjo9fomrNeU6ZUYrqdKwSsaTLMZR",
-	"fVOhnUxf0MimPpVU76id/ZTZoi9V8mpdOVDp4eqQ9WoW+jpKXzXkEn3NodVu9XJUUUYvX6IIVgPAbKZI",
-	"k0JY60qSas6YTcthNWQnTSGjdeVMaSB77LJYNnPq8UVUMUXVVLtMU6hW5IC0I6smD3y3uVRFzG3TqWw6",
-	"lU2nsulUNp3KplPZdCqbTmXTqWw6lU2nsulUNp3KplPZdCqbTmXTqWw6lU2nsulUNp3KplPZdCqbdNHc",
-	"+1SZd5FzUXwVER5l7qiaDjTD7KoV/WeZdJEvEpDxaMlWKwBmU64s99fNvdpsfNddEH70AuiMF2hxw9jT",
-	"KO8q2y1/YPwuWlywBkqaVfpDdXaVTYx5Yokx8dq4wzXJmOL2J8uiCqbA9QkKESZVC3mxb4J9PldN5G8K",
-	"wFqYPtr79cftnc8Po60fMkiP11SJcpthaDMMV84w/L6yCDJiplnyQGYQRfhqxZtJqkBGchoKTt49/cmm",
-	"A9C9TuOkKPo/g/XHivmvBq4sxD/Tu5r4mih89cP4m1Ew716TglUDLLuFmi3dfFh+bSBsyLFJCH6aLJpG",
-	"3tfmF7M4+2b0fobICsSeBLRrZt9sBL1mQkvEmznmczSy5jO+rjVfYsZrDHhrulvT3Zru1nS3prs13Tdi",
-	"uq9otOvM9QaGeqVMTBtr1ipXNaKqrHyJ5Mc2yJtm3cv+paRVS0Vrbn9XEmbaKmpkbKc26PHM65JprS1S",
-	"x6Be1ZSuOELrmM+V5KpYQyvayo9nJFuK3OSZq1BElSwvTxQ3pkHWwZgKv7PM7xx72URvm+htE71tordN",
-	"9LaJ3jbR2yZ620Rvm+htE71tordN9LaJ3jbR2yZ620Rvm+htE71tordN9LaJ3jbR26Z61nAIFeR2Ji6I",
-	"pxklkPIfVfmsSnOzjV1WvMdavfwbTqU2mNYmSn+P3JMm5coYm9uDPbEDeO+zshcPDKqIoL15gIlpEDRr",
-	"m+e2NwEmSvDzGzZiBY8NuTkfkwx/n4juwHX/nImGAtZqRp1m06UjX1lIYvy3KUnbVwtthLeN8LYR3jbC",
-	"e8UI702HUDMx1Sx8Wko4KXsVAWgSNk3FaKkU5S3pD9+tGN2QZkhxWhVwTbfnsYKtq+ApC7QW9KEnwhrK",
-	"316IZi6mJ/nR5wLS7YsWJsQr237X5Nv6XPAurGKrFUFp9Bou3Ybix1tLLMKNMpagowdGyU+MaTJ0uTrb",
-	"xEOX2k6ilZSjrCvABJKo3JI6Q1zMDMQs1p6y6vsjqe+b1LwEWQuqrq9+5dlidUaunV5UKQN5y+9dAhZf",
-	"bzIVS+pZm8+ZKpnOXGZuNBlJiLRGiUilAs0sAamSoAXbWWouz3mSeNxsZpWc5YvTbjNFLKGlQvu5PFWp",
-	"klpZQ0uv33EeVooRbQ6WzcGyOVg2B8vmYNkcLJuDZXOwbA6WzcGyOVg2B8vmYNkcLJuDZXOwbA6WzcGy",
-	"OVg2B8vmYNkcLJuD9XSC8QpSNPjd/tMLMFK9ToWerdKEpkrHFm9pPVumjv4NZ2mVTPflY/G+Gb5KEf16",
-	"o332XP8Wei4dnwW/6rizGzcx4dCkteVSdWqKjcQC656ukSOzQ4/8zUbQ5tZGNcVd5V4E4sBnXCUujIOQ",
-	"Kschopb3gsvrAte0H5Cy9JVmEyn7+fzgMLufJbGDe0e//Ppff/8/f2qNov39ZxP2X/Tj9s5oKxNJKMB+",
-	"zNCTNJ/VD53K8emaD5bAvwlg6LBTRR/N0uMtTA4V0dSeKN/oibJJRlFopz6XpAlvzSySSjvR80hKMwa8",
-	"gwgIqYz/snkoSh7KGpjCpqVszmpcd27K+FFzU55ZoWSTU2xyyrqTU6Q31LQ6R9w+z7td8Ump0tGNR7fM",
-	"ayt12EodtlKHrdRhK3U8yUodsahqVq1DlXRSHmcEoknVDileK6Ur7yF/tBU81mvRSbxWVfGIY8keqZKH",
-	"CVxl1TwUeikm0pqKY/3MZmMi5z2+eyIv9UbGJKjS4uaznQ2mfSJZzwrPNMp8NuIZswxoY8I/Q8RSvVme",
-	"sorTzWZEqzN9cdpuLgnStFWqq5RnSBtTM+tg6fk7z5bOMarNmLYZ0zZj2mZM24xpmzFtM6ZtxrTNmLYZ",
-	"0zZj2mZM24xpmzFtM6ZtxrTNmLYZ0zZj2mZM24xpmzFtM6afVvBDQXZncsf/NKMgsp6oUu9XaRa1sfOL",
-	"97Der2/C+2U5rlle9cbjjmrnWBvzb9LL8nAeo+JiYDMZkrrhDbMk6fY+uSzJPCmtklK8Rp4SDODPxvge",
-	"E7QwTQbpDTRpID052ICNpWSDZL7gypQu3TvC62CZRs8I20wOm8lhMzlsJofN5HjimRxZMdMsoSM7iiJm",
-	"9RLOIL3DB72BobzkXTLfvi6BuSFjKYOTqhSNAD9WckYNwMpyNDLDmNBdQy2vduYGpV9ThY/3+aoJuDQC",
-	"PcCCtDafa1Ew1RPJr8iTfaM0iwZkb5Z0YU6yZ4h8u/Sa5DEEePO5EnyOL1+XaNUjOU8ShnpAaepEnXOU",
-	"dfm2yPI7S3wIsE15sCkPNuXBpjzYlAeb8mBTHmzKg015sCkPNuXBpjzYlAeb8mBTHmzKg015sCkPNuXB",
-	"pjzYlAeb8mBTHr5AykMNP1FBHHaAn3hwgc6PZOjLKkuEqOPK4n2+j5CADT8KVzDVl0xi+A54SEvAawzQ",
-	"eaS4axt2bcOubdi1Dbu2Ydc27NqGXVeHXduoaxt1vYJS9/hh1jbK2kZZf0VR1jbI2gZZP70gaxtjbWOs",
-	"bYy1jbG2MdY2xtrGWNsYaxtjbWOsbYy1jbG2MdY2xtrGWNsYaxtjbWOsbYy1jbG2MdY2xtrGWNsYaxtj",
-	"bWOsn2iMtQ2xtiHWNsQ6IojB6UQeMo20jtuDba5x7klDfSfPkQPRWAm9HsTzfdUsaONNbbxp3XjTlu6h",
-	"gnmACeieyhtMAVzMZeyugepj8aVJS8DKwEwaUsMzuQ/lzhOGKsC4DO+wkTSjpKbjGuMc3iJxI5LcCktA",
-	"FZ8qmaNFC7ht1G5J+LlaxlwLTEmbo9BlkTk+B3EJQ+QTgF2CxEUG8CPP49DRf6WXlwbuziXzIKKnUQwM",
-	"FhMXkQdtyASSAXnY7Vjfdhi+SkE7Fr1KQb+NXaOoGLpzbA1fw85JQJvvXJvf35rvTzyl3J8CbqHt6nCL",
-	"xXkNnBuyBO1XxBL0Ww2WCNGMGuC1NigOVcpsUdUGcfSYb1FmgxJQs1s08s03KT7QxHCpI63uBiogVUkZ",
-	"3rQO59itWffW6NnreZa9eNfil5DoV0MWe82T524gTuIvibtAmMDFsgXQpyWakNi1cX3Z/R+AlsFkDq6H",
-	"J0lDFtqIJoHvFOq4ke9+6tCeZvS1EbgUFFLcpfP0KIBjJCB8zEjrlEXZLL9KNUilTZ2xW+sb07FRbGhV",
-	"y+YxMEa2dD/b65k1qq1RbY1qa8VZo9oa1daotka1NaqtUW2Nars11qi2RnUtozpnWjYzrnPDlBrZVWVL",
-	"YjN5LOm30kzmXbNg2GcjId7LIqWqgkkO+Y9V0KQOoGUVTbLjbOTCp3ZtkwZEzbt+3URdUTUiS2pa4tt8",
-	"CZRGgDyRAikatmlUIaUW25jVRqlP8meIfNP0LiuYaDGz2aIp2im/OA2vfuhrSKZK+ygtn9KAalnPb4xu",
-	"v7M6KsUMacuq2LIqtqyKLatiy6rYsiq2rIotq2LLqtiyKrasii2rYsuq2LIqtqyKLatiy6rYsiq2rIot",
-	"q2LLqtiyKrasytOMaygoCqG59H/aAQ5aR1OVt6uswkoDZxfvaqMSNl+IpREgX7JMy/fIknp2WGvMEddN",
-	"TQu3sMb1EswG6T42vcyml9n0MpvPZNPLbHqZTS+z6WU2vcyml9n0Mrs1Nr3MppfVrNmSNiwbVm5JD7Ja",
-	"ahk3rOomlqVBsGllEO+lUVKVVJZB+2OllJkDWZZQlh5lA1c79ZPJ6pIx7/g1k3HplW2WvDTktvkkstpA",
-	"PJEEshyTNEofq8EkZsljdUn8DJFvlr6TLC4NVjabNqaZ8IvT7arHeo5UVksYq0uprN83RavfWbJYERPa",
-	"VDGbKmZTxWyqmE0Vs6liNlXMporZVDGbKmZTxWyqmE0Vs6liNlXMporZVDGbKmZTxWyqmE0Vs6liNlXs",
-	"KcYqFGSl5C78n3LQgsa9tFKKWF33Fu/4nccabDg1rDYQXzIt7PtiPx35ryFm6C4IP3oBdEwf8I7b5/n1",
-	"d/FJyQD7PR79q+bTHvsH9LjSrWjiEivSfk25FIOQ3Y0xg4MaygOEwJyQJT7a25sFwcxDbegu2w663Tt4",
-	"dsio0kEEul5x5hMd6tW9WUzolwB6AT+dI39G5jxfKhVD+uf//Osd3P3jePefu+P93ZftFkhHlLIpxzf3",
-	"tTaE+yiTdC5usrEUr2AKXJ+gEGFStZAX+ybY53PVRP6mAKyF6aO9X3/c3vn8MNr6IYP0eE02/dGmP649",
-	"/XHTceCxeGkWAa5KJylHM0LMJPZbisRKich7yB9ttDfek7ioivOWKH6sAG8TuMpCu5U9Liasmgpa/Whu",
-	"Y8LkPb5Kwiy1qWKyUeln8xHbBtM+kRhthc4bRWcb0blZXLYxsZ4h8u1RahIYreJhsyHY6kxfnB6bn7hp",
-	"eiiV4+Xx1sYUyDp8GzT4nUVY55jLhlbb0GobWm1Dq21otQ2ttqHVNrTahlbb0GobWm1Dq21otQ2ttqHV",
-	"NrTahlbb0GobWm1Dq21otQ2ttqHVTyswoCCqM7njf5oRAlnvUanHqjSC2thhxXt8P/79DUdJG0z7JeOi",
-	"v2HeyRFy4+gadqV6EvhTd2Ya+iyuYSesUyS0sjzvnShDK6HQJ6kZbTi0DYe24dA2HNqGQ9tw6LWFQ6dE",
-	"TLOQ6KyUkvJVI9RMQqN1ItNIYvIR1A82XBrvqfioCpnWof6xwqdN4SwLoc7sfTkh1lD26odRNyZiPsJX",
-	"S8SlphdHCsfJ40RVl8/4RAKqM5TfKKi6FuWbBVc3JuEzRL5N+k3CoItws9ng66JZvzgdr3Z25+lllYPb",
-	"CybQO55MqC5raqULH2+osczPleEUy/w8NYu1zK1lbi1za5lby9xa5muzzFMippllnpVSUq5qhJqJZa6K",
-	"ViNJyXuqH6xFjvdUfFRZ5AzlkLd9LEvcFL4ySzyz5+WEV0Ohq2+JS93OiGB5r6+WYEutlywpbd76Lp/x",
-	"iVjfGWpvZH3XonYz67sW2Z4h8m3SbGL7qrS0eUs7O9sXp9XVzuQ8fax6IDM/tqF1HbfX0zL9I2NZ89G/",
-	"aioWr6vGawc39ylIM3qv+GT+bKtuYMVsel5uNO0dZUwl33BZfW7nsVerPWWntM+Ez4O7gUvYM6kGCysa",
-	"On7Veh7cjbEYTwB6EwQegn4JpOINYBNY+6xpPWg1w6fgDeMxcxA/hv3AYGpuO8RLUk4LhV0rT4oA7/L4",
-	"pt1l4LkTF5keGb2BTN+7Eh3zZ0dvwJuwFvcU5PQv9lbO3srZWzl7K2dv5eyt3PqkalrGNBOtOTkl5Wvm",
-	"Q/WVnJ+VlPdVcpL3/Iol5YYu5TIYKbiOS7DNFJr7x7qSq4au7DLOnN7qqnP1r+Pq0ivv+C1pduqNQxYb",
-	"j3MvVz3rE7mbW/WwLSCekuPW5GquLgmfIfKt0m9yYxbgzd/K8Tm+OG02PYx1dLDySRxG/iaM6n7kq5pK",
-	"P/KtOW3NaWtOW3PamtPWnF6/Od2P/NVsaS6hsuKU/lpPlq5i0fQjv1SiptXRr16mqkpagGVtmDDyH8eK",
-	"qZjyiZkwjQhcRzB6Eq9ruVSRqqKrflN0+l0bLHQnm1grq5ytY86jY35f9ojuv2fWYLEGizVYrMFiDRZr",
-	"sFj/X5n/75l1AFoHoNm1c1qd+zLuv2fW/2f9f1+z/++ZtaetA3BdJ3EY+Y/i/7P2tLWnrT1t7WlrT1t7",
-	"+ltzACqy9FH9f8+sA9A6AL8GB6C1WKwHsNnhGpfWWr0U15X4pCQLX8WjW+PEGifWOLHGiTVOrHGyNuMk",
-	"Fi/NTBNVOknZmRFiJmW3ikvBZAbjPeSP1qmH9yQuqspsSRQ/ljvPBK4yh56yx8WEZaiYrbOkVgYI3uOr",
-	"JMhSGyImF5VuNm/wGkz7RIxehb4bWbxG9L1qIa3MiGeIfHuUmliiKh42a/OqM31xemx+0qbpoekxKwsA",
-	"mVm/onWeVnltIsXyTYoVWbvX2r3W7rV2r7V7vxu7N19t0IPsfRPszihzuBwwEhDoKTvISuuxDRNl68Qz",
-	"sOxldgq1orpq6uMN6XA1CvqtFahUVT02RnEtwE1fCwjZ2+xSIBHcUqVIyXaTCwGOqAolgbfmP9mrALzH",
-	"MVF1ESBo8JGuAaphKrsEiPe2iJCMNFNq/s8YBT3scY4yU1VZ2zwN0vNBUVPlcfEtKKmCNrqnTGeYuyiE",
-	"4WTuTqDH373G0XIZhKTIvuJIrgG+Tu0xhyGxvOKJrT5u9XGrj1t93OrjX5kfiknRZuqmFMBSR1Dks4mq",
-	"SaV8qZDnLekPVso/TSm/IX2abnmVNs1su0fSpavgKdOkBfnqeaSZFl3fq1bJarylZbWvSKFWHSSMGSRH",
-	"bN5ZWDLdE3ESCo5t5CAs5Vgzx2Alv50hYpntK2W2xBspt3mzPk85yxdnrWZiMCH1Qj0RkslcQ6T0ZxPh",
-	"xRpadvqK2Kl7mpA5pychSXjSxVoFl8lcRqyleYcJeQ5YQPxRjMhYCzkg8HlMDSNgcZ9ZfCOCPOcC4o8l",
-	"1uiH17LRBxCiZYgw5UvKGojfl94vbgLPnfDrKLZBuMU2Dn2Ci6WHjkb+yAf0f+zbERhtTdtwtJX/8abt",
-	"0J9HPniDQgQ+TDMz8hlcH+BggUAYBAQsEMZwhlrgA/wAoO+ADzcfRr68GnM5LkQjMA0in/X/MP3Q4q2d",
-	"D/G48VcyRyM/7sSat28+tBlgCdoxgCECEUZO5gYuuhGYkfdzc0gS58/Ij90/4Ia2n9HG8nQBQQgWgeNO",
-	"XfHZl6lAcZN2FgoPB2AOb6mqLR5S/+9B7xIgfxI4rj8D2xghcIO84G6Hr+FPov8F6+/6QBwhVN9nDX6f",
-	"I5+vTCCQneCf2KJYlJxo3WI+G37gx/gKwpFPkbAbIxCLSzS+JDre8VWXIo1dpYkrHkDmAUYSZxDTQbhG",
-	"k4BBF9wGrxPSaknnG+MD0WoZols3iOgIohkFQbIHCXRAQwymAbtDSah1Cj6LfwEAwRE4PIz/vFE+AeCA",
-	"I3Cg/P2JNo7/foj/dU/bPRN/yp//OAI/sym5lwZHHgF3rucBPyAxbmLd7hZ6EcKMuwSmPrXuGSX/MfLB",
-	"NpkjN+SN+CA3iLEpCRhmHH5fxkk/WLiEcNwuw4C2QJ/IyAdBRJYR2WF4WA0TD8lSRz44pryM2AW24GPM",
-	"1siurpAD0KcJWhIACX9iE2IClgF2OVdMARz5/KgA/EzkpNylBBkfUCC4YaJQjCxODn51mGKzluDxhO84",
-	"ebBrR+h5ErnbEFMKS00xVRqMfDCHlKuRQq2Cyy4DaqJQ3pcHDCNSJ0AcOh9RfQWGrnfPZr8XuzTyAQmW",
-	"ux66RV6OUNug64MJxIizYr8zGOpXpswol+a4IZqQeJ547Ba4iRiS0uOOfOFoSA0tOQ0TBB0VawhO5sD1",
-	"HffWdSLoSYBHvmTL+NSLJSEdXrcgcYotEJkHTotuE5mjkFKv67v8bcAFvGdSFCOnTY+rECXASaAEA4x8",
-	"MPEQDL174ASTaIF8wo6BGWKj3rlkDlyCgYMmHhTUIEA+vuq2AejS3YT+PQOTIwFNp5TOgoKV7cl/YEqK",
-	"UlcY+eAGzeGtS5k3COnouOBAFrmMPYl6LDhI2VU3LxlwTIMY3M3dyVySMVdBKGHBcEaN5WQPqAybBVRM",
-	"pPUSdhzRYzq9gPjQnkN/xnEujqT4jlqc3/rTm2HSAR6i8orlutJNwCDySRBN5nRixtIxfC4GS4ilKApG",
-	"PuDayQ3vLsAVQLRi4eLO/CAUnoUEQOh5iW7Az9dbVSwx+ZKcK/nzKlkT3UCNcG4BH93JGSkBsk2nJIl8",
-	"h0sfRj+fXMzcdNkpOKb4NsVIaCdnycjXwsX2RB6kYpD8CQo+sAP0Q/4EzYjr3Nblxoq3mhMjx8ZIhw9C",
-	"dYkYKbFUWqBwxsbPYkQBJTk7sgjhqpiqB8zcW5RqK8bQi/OM1MqIb730nhyBdwfv0yLNdxQiqDnjfsEc",
-	"h+k5GP7cHMZdqagI9fkd059HWy2mSE9GW++T3iSjWdw0gLEcLS1wmMUMcOnG0NOWH6n0xBYB1CBY8uOK",
-	"nia3KAzpb2TuYqmgpM5JpjALgqdnR6zMMzYWROxzhzf3i2EUy9wfsKC7rAakoDPChHOpck6xmfKakxgs",
-	"VjTZchyVLsEb5E+oXpqHKFErAvWIa8VogXIaRviYQH+CcuajBEwZToG7RQ0Ihx6TXAGSA/M14fjwdLhB",
-	"oBwBKdpKqU+BpHCONZ3ORI8ljdakjJlSm8AcYo3SpKhMiSqPkcACt08Bug28SB4tLtcEFgj68niMjS4E",
-	"Jp5L4Y81ro9+cMfwR6encgdNgxCxT1PX88RxNPLVK7D4wOKb+P+xd/3NbdvY9qtw8t5Mk45/pM1rp2km",
-	"f+SlXdczSeqJnXZn665CS7DFrURqSCiONuvvvoNfJEASJEiKEiWdfzqNBZAgcC+Ag3vOhTCHIFYjxZcr",
-	"3o6ALWL3PttcaAv9kXEwK06MeJPlmsowm2gl33je+ys1+674VJ/1IDeTyXLM5kg/9EgcRzHr5TCiYhRf",
-	"JWIvY2wH5ZKorvEtWBPfJmiLalKyqmYbONEjygEnhC1qidwFGbvpv4Jwwnd25dumktmMe7nYZsidyDRi",
-	"S7gOGeVm6X+811GYBJN018NWn1+uri7ktlViKf4X1hBue8WlSW6RlglJTFPNJgQ54hevrl7/ku55o1vv",
-	"4sOV4eCJT4PkdiVemZC5H9JgzD7pMSvJnidWaLljFfBtOZvJNiUpLufA/WcF3KNbfV+oJjpW5qjgsBzt",
-	"sxkyyWgSYpn37vn2WGAnP2YDlVGJ+AkE5xediHcl/ByRfR3f0PPjNrZHHEfhJxJTuQk8vY2juce2G/Hx",
-	"2J+TGavF3sWLheJSdGWVfpgt02M5csIAOOJm1TKTSvfgGhBXv17E0W0wI9oq9SEhMevS2HvpffMi/fPF",
-	"lOHZBf/vS+/bFznIrZ7Ha2cPk901CZLFzF+NeD8Yj5UF/MkkJkmSe7IYHIGk/fze6KNs+kfu+7Mo4pNx",
-	"shxPta9khbPWpMdi7PNO9EalJ2daIf6t6Q8Ppq3wqBn7GmUs6aGaMBi+FGQNydrAyueb8M6fk6Oy15kg",
-	"hk2zv4ZEmXBSODyjMfGptoJFrHDi/Ys5i8+aeLec+eqU4yR1eQVv86ZTtJZLbnJv5b+yj+Iv8ihJ6Ej8",
-	"r77pkUMsx/7/Xmg/XS5v1MOS5c1Iveal9/yF5cTjypzkxn5obL1sA26MsXjSr3F9Na1RudrZsiq/fbUg",
-	"/BMT7/H1o6wnrh+JqT5I+Jz9hLWYLY5q2gpCdQaTzsTZkHu/kZitctx508/PbQL5TLziCE2gezkJT/m0",
-	"lR2y8BbqkEguD2z5kSepn9jrxNIQhOPZcpLiIXkCzSxQUvY4NPl4/u63V2/Ofxq9en/24e3P764+Zoso",
-	"axSf6vgqPvcXC/9mJuJewyCdfDBXZDanqFj4ENknelDMGnhbloSy5cGHQ9xNlETgbU9IIz3E3hxf5x7Z",
-	"htvXuL3hkx1JZ804Zk7KIVF6B5VDNYwQ7rOZwKd/ClbNKwdCw0pFSK2IWLUiJDcylpNhnhG6f1apGERZ",
-	"D/TLVMres3X7ayt/0+2gQkRZzVhysjheeB9s7sDIPDlnAp0HdB7QeUDnAZ0HdB7QeUDnAZ0HdB7QeUDn",
-	"AZ0HdB7QeUDnAZ0HdB7QeUDnAZ0HdB7QeYaUk9ES2Vfn+0NMzmjGjCriU5XEHqfwlLyG6wAi9T3zXmpe",
-	"uU3uy176SM5w2yUwZY2YLGfuGUtVee+x2CqeKoT9pIRCJwvruUxV/R2/DREZDpHhsHPGcbbNmUYJ9c5/",
-	"UmePikOo3ISfErCdVHrccSTbypuZFWSQMTvJFGEP3lUe97LkCX9SyVOM14m93tT/RORZRnaeqxqqRUPp",
-	"lMyPvOCEnByp9osNFQ8K8O3VlMQBv8MzFE1c+DEJqUxYLr4sXM5monXs/8zPMxt3H9BptGTTUdqYRL7Y",
-	"Zh6sIF9pHMwDw7G+4bgOZaRg7n8e3awoYaD3m2fXYouVZp9lFY//eHr83D++/fPLDw96uln22yhw4rSk",
-	"eRJ3YeRUQ9uP3Ik4eXUfn/SVanxsNxQElDTxFvR5gz53dAlWz+YS7LcGLpFR7N0HKCUZ5YaoboBE97gP",
-	"UW6Asqbmh+g6dB+kdEKTjzOmtKYDqDWpbpXRlAmOnoOhWffQlLvXd3n3ElVtDiZ+dXSxv4l07Td+krEn",
-	"aTAnCfXniyOPfF6QMU2DEh/enf/dI4toPPU+XL3OCnJSIhlH4cS6x12GweefWU3HO2P6aJfWhazvzMzw",
-	"rIEjIlu4SfazASlbJvdOEbCmIzGBqzuKTtGw8111onjaCCcQ/T5fC1gaWBpYGuANWBpYGlgaWBpYGlga",
-	"WBpDAywNLN3wVtYcsmx7P2sRoNqxtcNdreJxI2W/tShZXe5pNgNXuCan+T6pv8w11/ebu9fVvaHVN7wW",
-	"jGB9xzxtsoc0tWWVI2KHbbmGqpS3sFKb20SqkRYNGUwCkoK3tExF0sBbXNOSNLV4nptif809yyJS0jF9",
-	"Jy4peeUAcph0nerLLaZyy1GX2aSp0UrK6j6Z7cElPLH5I3KfIPcJcp8g9wlynyD3CXKfIPcJcp8g9wly",
-	"nyD3CXKfIPcJcp8g9wlynyD3CXKfIPcJcp8g9wlynwyTzGDN8FA49B82q8EWZ6oMdtWkSWka61KpJ0BJ",
-	"6D2nSouGbDfTyuF5pNUbuvOMxJbUNTcLL9xMSnZp1oGQDEIyCMmgXIKQDEIyCMkgJIOQDEIyCMkwNBCS",
-	"QUjWLCmLiStbpmYpgNMOIjIBrJpKyMwmQECWnJo9Uicfy/X6psRj7o2sko4VBn9dBzrNZWNNrVdU3GHr",
-	"rbnR3bSqEivrXy7WuBEDkYoVfKOVUKyBb7jJxJpa+Bmh+2remV6rpFP6FYiVvHDrZtt1Mi+zlA7SsKaG",
-	"yuvtk6kemCzM5oMQhUEUBlEYRGEQhUEUBlEYRGEQhUEUBlEYRGEQhUEUBlEYRGEQhUEUBlEYRGEQhUEU",
-	"BlHYEAkKFgFK4cB/yEyF8uhSezFY0+iWqHjYRIOeRWCNG7FNAdhheZ/F+lvzhALqfhM3K1vingE19V10",
-	"5+/a/pX/jz8Tm2ttx626QuFUI3QYxfwMjAMLBogvCfGmlC6SH09P76LobkZO/GBxMiGfTr959i03vwmh",
-	"fjCzq5rYo/5/5cb33Eaj5/7nNyS8o1OhhTL4of/7n3/+4R//+9XxP45HT4+fnxx5JluUv3J0s2o0ICIW",
-	"mUm1BDTj8q3o1gtCSmKS0LoP+f6pS++LdzXs/L4a2Kinfzx9+fXjJ18erh99lev09JsgbYS0sX9pY+oW",
-	"GVWf2fU0ILEfj6fB2J+JrXuyXCyimK5FWNHipSbxf+N8s3TdbMtapyZXPVuR3XjqlFQu64qbTMm+rOt7",
-	"Yo59bKapA8OebpBWTzuQ6SmxeoXDtrgNZ77GkxSTeec9yUSLNDOKTbDgra9rx8vEItWIy087MPgrHNKV",
-	"s1/jYZx6vVfupVPl6UYI+ZTAmXpXGNC2uoJKH6pVEtS4jzzc3XkHOjjFAIVMADIByAQgE4BMADIByAQg",
-	"E4BMADIByAQgE4BMADIByAQgE4BMADIByAQgE4BMADIByAQqZQLgjaw9nmWlXg+TQJKGwapCbTWqhppI",
-	"myJy7zUVpHedgvV1iF7D0RvIKVozxShhax+NV6d3cbRcJKez6M5VUJHWHbFKI/GA4qRxpYq9ie6SM1ZI",
-	"k1sUf9x18QWI5yCer494DgXPVhQ8fbPlS6a9dtz5kgdpK4F16nXh1ZdN7w1md/Gc4s/PkBM+OS12Sx1r",
-	"vWw0NsVib9baKk578UmO1tpiF9Oc/97R4sVzdt3iKwFSuRVaTLN/Ln2H5gwkt3ypa7Vip7d2LTfmekff",
-	"OCN0rx0j45/bOqpf1rvtrVs383WsHaXG08/Cwf4RjBsj4Lei2lkdBNbLlaFg4zkAwgDCAMIAwgcBhI2Z",
-	"ryMWNp5Vtk6UTMPNELFcJmq3QSUvygEhvQSgsb5h0HvGHR0bI7NxgOzaZieMrD/M3YrbbXi6gOUOzpCD",
-	"LrvrDI6oOWeddqvdJHZu2aihIeic73UD0e18rymU7uA5OibaU7cpQ7eFHtsUrC68eDjIutuiYzOk9a84",
-	"s+hOLDrbjS8DVQNVA1UDVSO8vI/hZUBoRJd7CxKkO5jBxJYRWkZoGaHlffYLRJZ3PLIsTg7aId9e4soA",
-	"wADAAMAAwAgrH0ZYGZAYUeVNRZX1zc7wYsoIKSOkjJBy05AyIsqIKA8zorwQKWvaqZYZpJc5b+qiyrKY",
-	"La6sngJgDWANYA1gPaSrB99zK5DpV41NmqdmT5kBOiaenyTBHXMmnqWPlRY5S9NMj8GEhDS4DUhs63hV",
-	"1DV7SG/tuw5lCtm5/3l0s6Ik8V7y1OaPjL5mVY//eHr83D++/fPLDw96P6vHjoLJxro6CSg5/8nWu/zX",
-	"TfasbI5jZ7LSts5kv22yI2VuGWtXNktys94mlXfnd/nuFOVtHSp+dezS83DC9oSEZz1l05q+Fc0+Ib0C",
-	"wiOfqUgHLb5BFb0Os8IxKxCTicczLap8Pyu54tzO/Ls07zPPzebPovBOPC8KeTJOcfPH+eRIdc3Ei2Iv",
-	"m0J46tLqIjJdZBhRLyFUJlxV7xYptidWb5pG9+fhlMQBJRPn9faw+zF152l0Pwq0zpMmeBNFM+KHmyY5",
-	"qS34GmhO2m6+NAxogoEWVCc58I0wRwnBRRbA6W4uFCz7pSHlSQ7KVkhPDi12pj1lVuFmvK2gdmf6UxsX",
-	"KOGi7KILNCFBpUZptdWNE6GaNmmIZKjM37rToZr6WytKVBt3yVNb9s5XrBQlrbc2So3S3jssclTr9cVu",
-	"Q847o+oLHddg5/xR+2fpB3YDZI0L405I3AmJOyFxJyTuhMSdkLgTEndC4k5I3AmJOyFxJyTuhMSdkLgT",
-	"EndC4k5I3AmJOyFxJyTuhMSdkLgTcrjEC8v1arbT/+EzMKqiT84xssqbGNcQIhPPAnMiT1Po+XrHjk1y",
-	"jyHDr9fu15Uu0xOzqtvdEw5SJrNkRZ4QCJogaIKgCYImCJogaIKgCYImCJogaIKgadNJq9akaSru6W1Z",
-	"GLoom1Q2i/rzidKXlScpgsTJmpujscopN0DbSmK1Lq1TwUKcjbotLl9HOquW3lGeSWiPz/AKxlplxttI",
-	"bNWiYQNNbrUuMVR7h2yb4qqlM5WkJzoIbVRJt2061dUgFVJdF6ZKe2qy1XKVSnW0fzNktU8ecLCaKZtr",
-	"QzYF2RRkU5BNQTYF2RRkU5BNQTYF2RRkU5BNQTYF2RRkU5BNQTYF2RRkU5BNQTYF2RRkU4Mmc9QqLAox",
-	"gJ1gddQEo5qEzxxVVB2jZzlhCKgYWxNVdWjYIKRVB+/7da60JkbXfRT/NYv8yWhO5jfcL530VflqxYni",
-	"d1niLS+gyanMH3ZcRQUBzMAEMOm3iTBrpowSqJOrpaJbLwgpiUlC6z7k+6cuvS/e1bDz+2pgo57+8fTl",
-	"14+ffHm4fvRVrtPTb4KSEErCtSsJ+1YL5BaZdiKB4kqlFt3Sxc1FEpBbNx2XTVHd/Am0/+Q01yMWln+u",
-	"zzfF7a9vXBWVvzDYdabXbJvXnK7fznJF9R223EocmLesEkvrn37fuBEDodqbRtGWYd/YT9z49O2s/YzQ",
-	"fTX1jLhe0jX9MuVLXrh1E247uZdZyHpm9qbIvQKyl4D15BlwOnA6cDpwOnA6cPr6cXpHhF6GzVug8tol",
-	"0cRmz4DBs51QndZe9fGm4XdbLb2qX2lZjluz9mi71iJNFPRs/7C1YTibQ9MVrx0Yfu6KnGumziZoudZa",
-	"NfjzbJ+x8eZA8aDQcCt5uKMd1gjAnU2QV9gTIzwwSXfBu6DghoIbCm4ouKHghoIbCm4ouKHghoIbCm4o",
-	"uKHghoIbCm4ouKHghoIbCm4ouKHghoIbCu5hcQIsss3sjH+Y5AAjfFQXsqoUXTtHrESNAwrx9yySdnjt",
-	"NiXQe+w8piVXM2se0l+L90XdBVF4klmE+IOqW7S5y4ASrTj7p73wGwkutArqT/ZKv0QJ1Sqwf9oLn0sw",
-	"rFVQf7JXkoen4d3lKqFkrtXN/WJ/xIXAz7FWV/3JXul3bZaSlfKDZa/0NiXs56qaFPeSERtPyWQp7+tU",
-	"wyb/Zq9l3Dh6FkfLhVa9+KPDg6Sm3vos/XfHdl1kmTXKWpaT79e3reJ55RkByk1+9mo8jpYhzZm9+rO9",
-	"8mse3ngdhbfBnVZZ/3OFVV+q4MBFNAvGK92sL8Uv4ofKuu+XYUnF98vw0cOfD/8NAAD//5y7i0GZoAcA",
+	"H4sIAAAAAAAC/+z9+3bbOLIvjr8Kjvee1XaPLF/SSU/cq9ccxVYSTduSf5KcPjOtbAUmIYnbFKghQDvq",
+	"jH/rvNn5Y7/Yd+FGgiR4k6VcpjFr75lYxKUAFAqFwqeqPu05wXIVYIQp2Tv7tEecBVpC/s/OdW8c3CE8",
+	"RCSIQgex36DretQLMPSvw2CFQuohsnc2gz5BrT0XESf0Vuz73tleB6zC4L+RQw+JE6yQCzrXPUBZgy0w",
+	"C0IAIxosISsMHOj7Hp4DukC81INHF0FEAQQRQSH426/j9gSD8QKJ+sAjgCBMASQAglsEQxSqlj3KvgbY",
+	"X4MQ0SjEyAUPC4SBEyJIkQsgdgGhQYhcsIBkgdz2XmtvpQ3m054bhZyuEXIC7IqfUkN7B33P9egaBDNO",
+	"s6QKAyJqtMAtog8IYXACFkEU8k5PwBrBkA3khiAX0ACwmY8o4k2gjytP9Dql3hIRCpcrQTjVhy1HwWhe",
+	"wo/eMlrunT07ef7sxfHxcWtv6WH50wv2J/Woj/bO4vFMJXl7rT26XrEvHqZojsK91t5D6FE0wP5674yG",
+	"EXps7SUUjRVB+Zm4GZ+DhN592cEBgDOKQvCw8JxFegAhYiwhRhAi6CZdJvSa5iJP82NrD8MlytPE+IR9",
+	"Sa1OC4TICUKXcQDhP0OHBiFbNBi5HgV+MCdyWi8RntPF3tmLZ629FaQUhazZ//oNHv7eOfzH8eHL98k/",
+	"p+3D95+OWy9OH/9zLxkBJyymmNDQw3NGcCi3Us/Nk62+Ac9FmHozD4UtgJYrui7mgzS9P5yk6P3Pf/0X",
+	"XHm8yuFvx4cv4eHs/adngtCiqVdETD3XSH/g8wn/zxDN9s72/uMoER5HUnIcxWKDlX1s7RGPGse7P7ge",
+	"9wb9zuUB39msWJZVWMeMWQANWsCbAYLomdjbVNVgO8ujBCwCQglwIAa3bGkdREi8TdT8nDzLzg9rIpmb",
+	"v6TWkH0rmIaYK0nVZIyTkqweG5qZYQlyQkQVrxbLr7J9I5rP0cv57p+RFyJ37+w3xZt8Kd8nlXPSPm4n",
+	"uGVbltGfWlrjMFirYB5CLFYNQJyIfbbX2FLJY4GNBGEmrX5jDU/Hg1+6/elwcNmd3vRH193z3ute92Kv",
+	"lf047HYKfp3+OuyNu8ZBMYINC9lZ0vMA0zDwrwKXDymm6Go8PR/0x8PB5fRqcJGjKfu5c35l+vn8/CpF",
+	"T7o/M0WjuyhLyeiXGwMB7NfO1Vj7qzfKdscaK+iGQlqwioR9UuKzczUG+x2HevcIXEEM52jJzt4xchY4",
+	"8IP5+gDEjJ9aU0bTuDM2TZ34/Xo4eNcb9Qb9zO83/aIvF73R+aDf756PuxfZgfLhGIb6CoZoiSj05cz7",
+	"KPzFw6555Kw2G/irq3N9LK86w+5Vd9y5VKt72R1Of+n1LzJjKy7XH/S7pQV611e90gLvroeD0gLXFzf6",
+	"nBSN2zRFkX/3NiCUrXKAh+ifESK0obona/FNv1r5a7b1IW+P/bSMfOqtfARYNySvcomSVdKUVWa0CjoZ",
+	"5TC3gUuPpnTpRz4GJhf17VDRgCiXVL0OHlBYq7JW8rG1N/N8isI8F77mvwOCfORQpRTz060FlhGNoO+v",
+	"Afro+BFhO5KpykA7tQlTMEcIgQWlK3J2dDQPgrmP2tBbtV10f3Ty4phr3y6i0POzGs8JVyPTZ6TUdQ6n",
+	"x4cv262zo5+/3z/49DjZ+w68/7N+XsoBGdgLByZBw1T7w1UY3HtMKQsRJAHmpDkLiOcIsCsCmIUIzYJw",
+	"CWYe8l1xcWAT0uu/61z2LjrjrmSy9Dien5yWDuPo7Kef//df/9d/tCbR8fEzh/83+n7/YLKXGRQn3TCk",
+	"FVvL82C5hNi9DnzPWdda/XQN1k6I7j30kJ+dIT/8k8VXKivbT2x/eYhwrSi+KrE9x9jF40JY0a/aj4dw",
+	"GwQ+glj0zc/hfqESLQsIZXoWBktOxM3wEqwgXeRZh19A4r91IpKOSpViYpoHqRX3Log6kSp2g2BERp5H",
+	"0dLQpui6vYQfp7drigj4GZw8mzAW0liGdZJTDbOkL+HHnuhD7ByDGq3dtmAYwvVeay/C3j8jJCvy21ZG",
+	"RYtZWp85XbQb5bVBYcsWJKsAE9RYsotqYIkIgXPEN2G65bxAZ/+GdWT6QBWMFc+NWcK8R1KMkFOd88xY",
+	"eTHKrejjY/HSyBkvWJseJhRiB32Gg1d1tfHhqxpIH8CNjjFP0fDNHmV15HU8Siuzkw1aY+m3I7dZR1+z",
+	"3DZv+ZryYbvyO936F5fhFfvm88vxgtk3rNV5RGiwPA/wzJvXNpenJ9vhlfMzJRoFToApwjSzf188+8sP",
+	"Cc2yCcNspJosMcTJzvTvqQ5Pn7/ICoxtaNnp/vL3B6O0k7TGN4jbNYDu0stQ/MNxzobLrbfHhy+54fbZ",
+	"yS4Mt5lZS0+Tw5lFLFZWUm1ol93MIGk2DEou0sSWkbsNu+AC+YgizZC4NWmVblk/LAv6LKQuM5YtU6i3",
+	"nqfS2HchpUyL3JjCIgJZo3nCUl0VEqTE4fanTbWcpyzXZyF1l4ED/Y7jBBGm26dQbz1PpbHvQkr7iD4E",
+	"4d0IzZdoF7Sm289TW9B/Ib2D0c3KhRQJq8X26ZXaA56P1oSiZZ7gAgIqCR5G+AtSq/deTGq6tS9BbgEF",
+	"xSRHO6AyMhAWVdNyLQ7/cPsUqZbzdOX6LKRuiOZb1tr1dvOUZforoWsZUNThj6S7oC5p3USjoe8SSlf8",
+	"1XPkLJAb+WgX1KZ7MFFcQEMx1YGPXnnY9fAOVAytcQOthp4LyRx5eO6j3U1tuv08sQX9l9BLd0IlNdJG",
+	"a1A0Rj5aIhquL4M5eRMG0Wr79OX7yFNbQkc92q/DYObtggdMvVTQn6WlegRXiIaes/MF0LspGYORmtqD",
+	"2P1KpDuqHkjt9fg1CO/8ALpXaHm7iyM53X6e8IL+K+ndHaXFNJZR18XuKvC4Zr4R7hIDJFuIrektEGAE",
+	"iOdycAMEGFHfw3d5k98iILRXgI0Q5k/NyqE/vgC68EjS8S3yAzxXZrsiqwarWGDRuDNCNH7xsKt6VX21",
+	"wCxE/4wQpv4aUE/gjqgwhccqXBEFdwWIiGJkoer2OwIW0RLiQ9Y0vPUF5rCsr01sS5qdtAXmCDNdXdi6",
+	"evgeYRqEaxBgcM7BYVnr/o8ps5Mi/EubnFT7OTY3bIU3iL4NCB1FyyUMN716ytrpp0JCIY0Mb2EoDIOw",
+	"ABsUUOgDHDHpwhoTDa1CRNiGwHMAMeiy6qXYVt6+CcwaRhizuW3Qt4fBUNQSkK2yjlXzpq556w06Lgch",
+	"srZMvUQY+n7gcNxqg0EmYHDilQ9R7yBPgMZ6BWxVzIBkRCH1CPWcrV6YGDvmewBLRBeBAZdO4iL5+dOq",
+	"BzOAoLMAoXhbQi5wvSXCxAtwi7EM6zUI3UR+y4KpB5YqGBa5UG0mPZe9u2i0Z19dCvmvn+GEJaTOQr0l",
+	"J6+FTTkxzwim5TUwQwZ+ZuTh1LMVP4r4WrCqTGrnYRMKYvh2MBpPX91c/jLtnI97g34GWZj7POi/GnSG",
+	"xk8JOMr09Xrwa3do+iCgpLmfL7qX3TSqNjMPhsNBGJTnHpHYgAYb5UruDxqAULYAIFB26wxwIaLBAN8G",
+	"MDRIlNc+nLNWiDfH0OfwCOlx4vDX50BUjM8EXVljJaeygPEdHmF25L9bhUGdjkVpcH89HLDT2tSjKDK9",
+	"Zy2aOixWSLj2pXQP/dXpuOJxLgt1K1BPCAo96Iu9WEKBKCaldzv9Jr//W+fwH+LR7f2n563T48eDv6ag",
+	"9rzuVNQ10RARFF7eL0fe74ZJuHx3BYj3O2Ky7c0rrVlWa+rfL6fsq/lIijy3ZEw3N72LzKw+e5GbVaFO",
+	"dQ5fM43qUP/zhyZ/nqRdSDhpJiy/vgvjPVYgrdT3LqbhemsbEUACVjCk4i5xG/l3soQAAZhvFXUOlXg4",
+	"j629JaLQhRSal0d9BZCQwPG4UsxBJGpvtUCIpGom34aB7xFO8h1an91DP0JgBb2Q1D72rmSfPYqWORBJ",
+	"si4x4flzrsgVJvZ/eVigECV3Ko8Aqc/sxpMl8wDMl+m9mcMEBxWy2YYegpyb1EXDIN+fiO6GS3qBySia",
+	"zbyPpbgHij7SCPqxckYXkEoUxC0iwMVkSngrXG3rnF+BZeDm7lvHpxoYAy7pNKlnEmowdvWogpzfRao8",
+	"v7Xkh+IES6YXcbyXi+49hwlDGgZkhYSiAUaICq+iW+E8dXEFhlfGARSpU2xA4tZUNBj+sYddz4FUXKLK",
+	"BpYqnsD5xc+1XQ65b1RCGniABPiQUIkid8XIb9dswBC7YMDGzWeiTHVMGqzwQLz1AjJEPoIEXRjdaV71",
+	"BiMgS4CLijsaa20aisJTt8CfhRV6h7Bruqby3sTHyn7uRRuFPYTEqOTKLvjXGn2IVkydLJ2eYXVfXZ2D",
+	"3jWArhsiQlqARM6CnTeTvZOXp+3j9nH7ZLJX2u/SmXqrgh6V408ZXxb5zTy29pxV1AmdhUeRQ6PQsNr6",
+	"V3W7Or++4dLCbwHUnrfBx7+8mL74oWwIziqaQr0fw2CcVXQOV/DW873YbTlzIWRF40MP+j6nxNEqgf1V",
+	"QIh366/B30aD/kEVTXrdIpqC0ERMcpPjNLBClZ3xlkx7zllFTMAbLozxVKu5V9eGso54hYLhjALnDtHS",
+	"Aa0Wa8JuFHxkRJSv6lIWKxrdeMEqG3odcwNJ0jcVBQGJVqsglIqO5LkqGmTdQhqCFXfuyxPBWEW443A/",
+	"enUfZ8OnshJTvmbcJx+8vegcvR1eATdwSGzz4E2I/VZJpqLDtEBRGCJMN3HeklV/uV/WqhqXS6pu6vcl",
+	"q48Cv1bluJxWtU49bt/KuKo9wcttk4l6ooOcrL7JRKmqjSeKacBvViYN6xV07g45VyPs8EsRpBQ6C+QK",
+	"Vf3N9U2syJJGlrT5KkrDpkveSuaryGQ/Y9/6RrNgFdn93vmGZGPPqU02Npv9FnLqTfNdoZFzs2WEmXIm",
+	"TflMHnJxrxS+4RWJ9bzaSi4nt1jLTSh+kpqbNNNMz9WoK1Z09XHXGGaFgitIDUI4RxuwFxE1N2QxWbs2",
+	"mxFFp5nVbsjtBkO4Gb3akPyI3NYmPWK0mck2W/7eyi9Vq1xk1fPwAoUeRe5VPTtLXF6pGM2MLKwkdlEo",
+	"inGjBjeTYxf4wZzrT4SGEVd3N7fIFE1ETPy0xDij3E/q+sHpa3t3vxwhol5DTPLsbfqt+Jd3V4CIGkp+",
+	"SUkX7+O7++XhksdbCJvLsbv75VS2XyLO7sRpHZGax3pEhGVuGYTrV2tqkgj/vwhiKkMjiYJgf9i5OlAP",
+	"T4SjTNlf3IWrjH1FdeHqZXTiKuTcTGiZb9NY2Nzi/3xLFn+zvz4PqxRQVDiLbYMP/vZ8hQpRFQUe+vL1",
+	"xsPzJ6gYSSNb1TKSZks2Z3YAT9I4so010zty9D5N+8g1V6GDbC3YAvt1wMOZ1Rp2bIdno+WVkQuUMVWY",
+	"FGvYEnnFqYxlVjy+rSvCguICYmuzqqC+mEs12p/EoFo7zXhTJ7DU+lt7qerxZBi4UZEjtnClANeiDOhX",
+	"6GmyrWmRPFZAtkquTzwTNK0zeZj7+gShTl0Jk+UH8SReyzfXjOUMVD9NIBoarGC/DTCDhTDBZ5VxTzaE",
+	"CJYjB0ZX/ClBPlfl4AOFQKIqrADxahiLBLw/3iVf2cOsKRwnCfxmV4zR4LLqikECf/MrBgn8GlcMIsxy",
+	"1VeMUVzwCQEWG+BESoGEGwJHMveOGEOiYaZ/kj8QBafmASyXy4iyRfvqwCY5//AcPKAYvJtYVo1oRZQK",
+	"3pODwplCB2ofBCot86PExGV/vumPhzejXOlh901vNO4O06EEE6oLcG26vbYZ3kHE+RTPcffQ8/k2ja3H",
+	"DgxdAyDZKX3lY03OEORmEzGdHuGtCbHaAg5TggFBKxgqcfX0IBrmh8D45loabYJvjLSkSujVI0+UbdCK",
+	"gBGiMbNuxjrjwXEgYbf++NlsMCrvkLVYrKE5XtHxoQ3u+rynSYJSjdDxCs5UqStW9sVfNWsondsMb3tf",
+	"gErIkHZfiU8ogiZk0G/6TiyQP/orxbY2KzsGwhk0htK6XTo99Tk/Eb8uEF2gUOxSjwcvXzpJeyAIAQ5o",
+	"Jboh7sAEFC3jfTYmuQh8C+wPRnH8lBbwvTsEEF0ctwDCqxPC/pc67YMn7IunuBMh/jjFJgoTCn0fucDD",
+	"G3oTeSsJKCmSofGcfkc0+Am3AtY2+fWuO6JaHau/TlFegvoevqv1eiljOcQ8dxlX5DZHh1FUMWJw1TlX",
+	"Ay41x0JnyooZTbE0yvdyJQLUAxpCTJae0EQj7NEk4JMkobRbGpkv4EzkxvF2cp2nZS0QpEoAgkcAFvNW",
+	"jwQhjbXYPp/PhetF7m6GPWdb17PQC+67HHduILuXWSOwgASMhr3BOwlnLw1FztueyoJGOcVLvJuRPtP5",
+	"jLbmGOby7jUBEvvgr4XAYrwk7rQpInmU+NHwkFHpabiYalLvZ4TdKY2spkgdF7tJyWQMGaLTEyipIU8j",
+	"sti/6skeedkDs+BMNbr+NDtcX4UI3rnBA0475UE/wHMAE38lwwEbFSCyXokPwrNJ7C8XOSGChG18Hpqn",
+	"xbrC7At/0WkDNtMAB/hQpFmQbQMYojj4frMH62RCBDllJ4AaiUF/VsNv2Ge8LrlVNS5Z0fLmBtFsbbNe",
+	"WysUivkW7gIla8sXqdoNLH50EqtYBiDjDZr2Cq9qTOwSxcBNzWuOAzeFA2IMBgC9CxGjmluNpQMm6HJO",
+	"UkA3STA3HonMNDigTK1sTXAMbU27OfI+gjD+29NiYxXqy3w0VepyfmGrOeBCZ8X0XMWf5LYVyV4M7oy3",
+	"bLMTwLd7PCnZ+/6IX8p7o3HvfDS96F11+yOzE1xBwVEv9nYrKMGu+4N+eZnBaHo9HLzuXVa0JQ0Nvf4b",
+	"/v1mVF6cu9wJq0N5wTjTQXmx2IhR2bHKo1CX0l5/NO70z7vTQUXBm+uLzrg77bzr9C47ry5z/oFGHiqw",
+	"qGRxPtu6qCn0kW6uMBtYHI8WARpEPgzRkLw7ncsaYJ94v6ODWmAG1UsxnKHq4pYhouIeR1zYAsS9lXe4",
+	"za9wSzPU2kBRpcmhEGktbPq1OpG3xobPBZ/LyjFKE7u5paO19/BQ5JponpF6hiXeauVBkdmPBceEjm/b",
+	"eMuaMXYZNzDjJZZVupCbQGgIkBBvjuuY89L3WN2fx4j9jYim2MtpdwKMeSYzflKX2myiApS9D4nJl4v9",
+	"DFw08+RQbkavDnuvS4ULb6mhTGFH8WCUZODQBMoTLD6ee11koXyLPkIXOd4yCfIQw66Yjs70KaF5saWV",
+	"5EgzZRlFnjstMWZ6bpGz1Eb0VG9pzy1xrSqSdCNdniVc9jklW0YI6LvbIABiY5cIZ3vFQ1boaat619PO",
+	"xcWwOxpNzwf9170306vu+O0gmzGpsBjXOs7LSlz8vd+56p3rmoeZKsMU5W11zaJ1S3uZwQWpdzFM2Eig",
+	"XCUXJXbFKtGEzLvZyUx1LWNkaia2YpLNDefp0Z6w5zSmiZGSocMjyRlQTgv2nKIUh9sy5WUsec/TWQq4",
+	"+XdbhrxaD+wxRyTP7KLiBc+fYThgCQoPZ6GHsOuvFYSABkrTVHk3ALxl91QRSEbOD5EPqGXRYCIyFS3s",
+	"RITlN3iZEEsgCgbxJe5NxXJLfu+MRr03/atufzztDoeDYUmhghaEeLsZdnjcleJGVLmCZobdy25nVPBR",
+	"tGoSmaNCDIch80uT2Dequop/k087EU+6un0Wx8ExFkkFvDGWyAexMQyqZOjFGQPj0d152DUOxpAnMP2N",
+	"p/Mz0VaUry/ngtBM/c5W17B+EEuDExUA78FIPb+1gIwgxiQc8vg7JpwInyQQhGCxXqHw3iNCOcpcsRs4",
+	"LiriNK/HJGq+eQkUVl+UBOKYNMLUdWNaLauuMfVBGTKCl59mM3HEpl19ML2LnQxns8QT1QC0Dds1TUzv",
+	"Ip9cJ99hEy/OHNegjx5hWvz5fZFR5/xdlwioKwxR6n2Jq27qbUm13AIIO4HMHw2FC7N6Hk75YBxXJZ4y",
+	"uzr8+f+/fzDZm0wmk//5v4f/8/8A+xeeTD5NJo+TyW+TyftyNwg13qlzjwpdF+vF4Em4nOuKF8VOKN8R",
+	"g3pWxajNoKe68qizTQmjqs5MlF8wzZwDN1tgrBFmGkkbdHwPclhVrBRelI/AlHyq2CktJtPon5cw9IaY",
+	"bcnS8RhVzMsnIbZVYyWQzzTpRdrl2DSAuXePiFQskatBzWl6rUgwow9828ZMbBxFxS786//OOxxVuBtl",
+	"xl+iwqan4UmA9XRTzcDqGYKfBlTPjr4cpH5XI9pKStV5bO35WiqVItFjvBLyigCKmuDB832eCb6+MMoc",
+	"arw92VyRUOJlpjCmto500tutmpxMXplYLhe7Bmp7pCA68Q78BQNSM0GclmQkHktAGi3zYJTcMrnIWEaE",
+	"spVOUGJBOg1jmwt6EbDEIyAi4mriht69QPS7cH0M4lx3pJWGZIOAi05EZdTRkFC+xbLyJs09ASniGT7e",
+	"OowSEJFHpqMetUynmX4DH4yAqEG0h7AY8RTnWAK9GeB4Bw6HCBFXf3AAomzdAs2m0KuPTEUT07gFM7Po",
+	"yXzE2uu78FlmHkWbK166eE5V1yvVap0ZjpFET3MS1ZvRjqxkwp9y3uqNl7nh5YbyNG+8XHMNnfLyVD/t",
+	"4DE0+MU8pJooeRWWO+m5eQ2dO3OADZ7SVX7VdnXmbgIgVU6gaVf2XV9Vmt5SJJFTNSTzE4kThR5dvxZu",
+	"DZXsmyn+hJddGkaEIrdDKSL0qR6TsrEpTFrbunAw9FEiI4qG9yRJUdRoM3lRPJCniY3idiukR6SdUZXK",
+	"TSo9XVL7CcwjGtg6v8hDsphFdMKfxBZ6Q81YIUXj01Y/1VTFgj+kkscUSGJVCCxFqfLrBbjNhRZi31UK",
+	"GHCVb4QX0ZS1umbKdOqbMgOlGsJUDqEsB3LOzGx6QkkZ3sot5MLrz2gjN3n7ZT4Ob/r9Xv9N/kPiCphr",
+	"Tvn9GWzrhT5+PXwPfY9xzpMysBrSPaRb1qO/F/RpnG+9JFH5w5tSx2sxdvPi9o4izMUlDxWqgmdqSReg",
+	"lnIhbd6X6fLzgfD574AgHzlUxYkUaNdUz1vI818YZ+bpKf/N0XAigsIEOxMiSALMqRSCSiCDZyFCsyBc",
+	"itvnTsPjVIXD0TPSm7VMUUAACnkgaY58GV6CFRT4pvSkZ4nXNPW4oyqP4njSC/LlFzB76bbYRZbipHVg",
+	"SlVc0rmBVD2UZia7lRYUzPdmyFk7vnxL/wn88u5qej7oj7r98XTYPe/23nUvuKWCfRh2L3rDrnjqTD6G",
+	"/GWPTfYhD/3NYeIp+cvqmkRv8vto3BmOM78MrlM/dH7t9Ma9/htFXuqjesU2kS9/NhGvc0E8YQa2/kWP",
+	"YJaZTfUJhGjGJBAH4ot3Sx5/LeSpSOMZ93QnI+hQ7x5lk3Vkpy2HCtA+dM7HvXedcfbni27yIT9Gs1Z2",
+	"qXsDmvCgjDGlkdzo2Kbo7nfHvw6Gv0x7/XF3+Lpz3p1e9vq/GDmgvOh1VYmLwa99fXzJEIzjI1RlcN96",
+	"fqVU44WpleDKEyUMYSPkc2znugcoL8MNZ2Apchc4CFNhFhSGRoKcEFFZUtrWaHN3Ii2jfWwqjSFiK28q",
+	"2jeFjISkjz4a4Jc9zI8ib6aZ/ZZBiADP94iFJ5J6bYNkilkrJs897oHWVZXyCDhugA9mgI9Txf1DmHqh",
+	"eNtugRDNYej6iPC7zQrOPRxnDmFEQiqU82ene5l0TlOd2HxeJ/1wSZY0S3IySSkONTGhQX6zgjosYCcc",
+	"m+qgkGt1NIEpBLwIbsAOCXHSIjcDLhDP8eTJcAjjK7/lzhLuTC9dbQ41M14Bl6oMizvh0LjxQu5UCScb",
+	"cmZcrTZX5jJJPup5tRQRlhkLmTE1SfUYMc9bBUwonYp3woOq7UIW3P0KY3M2xhL+VnHXnxRuXcMxFyZU",
+	"/Pr5TlFfj+VynFTCcTtjty/Ja9x00pzZmjGaictEx98sm8Xk1+ezSiaLYdNoJ6ymNf8FGc5bdYrD5pSx",
+	"XeKT0SCauzF+ThIvZ1oWMOcb4UR9Qmvzo4nVirhS2p92w5Oq8S/JkYqGZvwYV6vPjIYo/1kU3LfMiPoQ",
+	"arJhjrcKmFBHre2EEVMdfEFm1LGKDRlSRwHWZ8oiPKARj/gNc2d6YmtzqJnxSriU4/12xaG88ULuxIHb",
+	"VIiFaM6arM8tplG21S99mSM1eThyjQItiOgqosU8o8W87SyN/KNsnzEGqZBPRF9ljFLJwho5X80th8+s",
+	"gVfT7FeXT9Mr2DTjLiNGTUWchoeGyJB9txhlzBvh+GKQwxdXgYVXMES40ANZw0KEOkhPvm6IysknFfFW",
+	"TQmnrD3BoCdG6EAiw06JzdMCHgVOEPkuuEUCBSteasXnnmusSzyKDDVbWj0QhC2BXxncqABi/LESuS0A",
+	"MQgic9usfBFRspY2p2L4GzguZ6aWr19Y6MzsmeMSZjGNPSMZ4odNJJM60YQrQGYTpTqNeaiVYjN9i+l7",
+	"pPbOSlGgOQMPu6PBzdDsypn+FgeZSv8so1Olfxzc6BSn+i54JOsj6nv4bidHlmr7S9rSJAnNjkVVq/a5",
+	"KEdqNKcpCr5dk5o2gnpaU46pCraLDDU7QnPe4I54UO/iy7KiTkljjuRv8ETWbsKZWq8FDMpKTFXT3zSj",
+	"pma4Cb8a+bCAbdPo3F1wbbqHL8i0Kdcar+n1InYfAqp+bb7NI6ALPHO8b9lmkpve2ixbwIMVHDuM8C7Z",
+	"dRjhr4BXhxFuyKcBUfD4MMLNeXQYYTODsta+feYcRngDxtRZrYgr0y6cOzn/s30U8meBP2ljTmrAPsUe",
+	"rGpC4yJTkeR0Goc0tNiDYsYtXMr6TFzEmeWcvCPTX9L6l5SuMRHFeEbd49mMZ2y4NwIjWFEj5dsVrqkx",
+	"NOLKGha+QbQbRoy+KAdGTdXPmwbCODJyWvQts1jUhLeiSqZSuUB3wlpx41+QwVRS1IZsFlerzWymrKop",
+	"t3TX5Eb3zTBeagj12C/PWwVMOBQvVrtgQdn0F2RA+Ry32zc8MUwT66nuv1nG0wZQj+2y3FTIdMuAoo7j",
+	"7Aycle7hi7KgTkhTTuTeT5BXVk5QTRgz6drMnuz7FCravmE2Tc1xA241smEh064QpMgdOQvkRv6u+DbT",
+	"yRdl3QwtTblXVJ8SVb8B56Z7NnNvtvlvmYGzM92Ah4u4soiNAx+98rDr4d24Rentf0nm1choyLeBj8Ct",
+	"rFqfZZP+jNwa+GiqGv2GGVWf1fo8amK5AvbcqXTNNW6l61OkK/Hw3EcbDkBU3oD8UapXE/HZpr/d/Zad",
+	"4dZTjoraR0R6hnezE9NdfMF9aJn48zNxXYYt4MNCtqW7Ylb6hVmUNmdM2ogbaQEP0m+b8WgzdqPVTDbm",
+	"3dNwfRnMyZswiFY7YTlDN1+QAWmemmbsGDcw9YM5mc55E7XZMz8ZJmY19vHtMq9pzmuzchmX1mHs6zCY",
+	"eTs6+o0dfS3Mreh5EnuvZCObMbgkoQaLq37+TZj8WhvOBmye49kqRr9CNPSczyHEUz19DayeImhTXl+K",
+	"RjaW5joR5dye7unfgN3T89+c382sW5PhP4twz/T1FTH9U0W8YsbNpXyakHqs/28k67PrsDH715X46dC7",
+	"O+H7TBdfkt2/Um6oEb+5bP9lgyK3N4+73CTOss7AWfpr820R/1Ww604Z1bJoGYtuyJzNudIk/BMiajLk",
+	"BqxYzoSmGAbNki9X5YjKJnlLp4ZSqOy6Kd/czfNEFQVhr8obVeHNG3vuJoRvncSqlMNk8QtaGxh59BZc",
+	"R7e+54Bf0JrNY7efpu0vmZQj+4QsDpF7+vz5yct/Iccl8JAs4Okh9ghdPT89OQD7v3UO/wEPfz8+fPnn",
+	"o5/f//kA/HW//f3BX/Ww14Qspndovb1Uwi0e4dvsAn8jv4A4/vftGkB36eH0UJ+d5kKRv/9NDOTw/afj",
+	"1rOTVBajuMOqsNlaQbkOGc/n3P4y7MMrRKELKexRtGwcRWApK3Mpmk45w6YC3KH1GY82DVbQM0SMvzPx",
+	"DtuqccN3KJva4tnJC1OI9Pb7Px9p/5TzG8/ztP3+e/WboZj8Sf1vYU1tmQrYjA+3YlC8TGZYL56ZRqXR",
+	"ptHUnh6aaRKdV/GNoFyU1TgmxQkGTsn6SzcOOSHqxxK1BWDsIss/3CL6gBAG9CFIR//cPIuwpDlOB9sk",
+	"l2yubnXiVFME0jtjyuhfPOxqsbpZRy0w48EieOBx6qk0ILF4CctynNwVhAsoCd4hei3OEFiYM78ooock",
+	"sygQu4pRnmTyVBzBzqTQ5Zkh6o9YlSlJY7O1HP7ZtAdpCSSHsa0jNXQ2C3T7xEz52e1dLAGqYtATES5F",
+	"Tksm8HxZpHk9cHyczCX9+6B/2et38z+/fm38PZfmPjUEw+xLr/KeCpufirnfQDfdOPdYrbApsVRKr18B",
+	"5eaVNEUY2ECk6xEOYtHeKIhQuo3PmrF0ZwLieVZAEDTflnzwqo+u7Fs7q1MUD4h9Mybw5vnIaH6FgJfJ",
+	"VdWeYDBeeEQmV3UgxgHPxcpzrHpYSxhUnE2RkVGU15N9M82GKZnnphvv3oe4aIreXXb6oHeRza0h56MF",
+	"joE34+kdCDIHNlvCj96SicAfjl/+wDPmiD+PeVohLcTQyXtjrDOl3vkQp+ZBN4Nm5EB2axuEQCZcQzMB",
+	"l5ol080ozorUym5orWz2Tl0jD2VJsm41BToxtXWicpq/kBAqDs4lUszlBdEEi5bbS/hxertmp/HP4PTZ",
+	"BO81SKi7oWiiMJwjOniSE71somgrGpNBi0pgMCrN950ENhGD3jRzs+htGpBdiySxQr+gECP/PFguIS6Y",
+	"FC0Z9ncE3PEKQNYAlx5GYMCLEyapO6uV7yGRNZHp21cyv/VghEg+M+x2spHJ0BqCsqkjx1KYeLI4D+5r",
+	"lUmNoo+0BQajQxetEGbc0AadxLtTNMB3LWmBACOwQiG7ZSKwj9ED/wdBK8i300EbXEWEcgnuBJhCD4N7",
+	"FIpsUFgIcyarGk+eKa1ubMNqT43TN5kcisS6PI3up0cwmWDjZJblzm2StrQgaWlRIA1uSE1CBcUCSqUq",
+	"FfV4Kr+FimXoYfDdRffVX05PgWgXvOaT+h1/NbhAtx7EgNA1n8haTBrbv9PEGUTfCVuFrPSrmd9YrcJk",
+	"T1uF7Fwv4ceeoIZnw8smHS2I/JGNgpXJeJc5mksO72GEG57ckM+tu2lS29KD/+1nOumLDqiKkx9hl4nY",
+	"qgS0qShYTLq4ZYYIhF2eVNb42KMCbG8SZ9ysp7ytVEx+2MnUfkn15bhIfQkjvK1rFYUhbcwcvFY5e/Ai",
+	"xQxCCqxlo0UQ0qLc0A/szwVcrRBGLnCjUGVVjSlrlA+62IImvlyIjKumFzb+AcDbIKKbUCWOyHK6pjLh",
+	"azF9T0pRTZolp84uv0gsuQpqsEF19uknG/H0U8F0aODbAIbuNhIacxMfawgM0dwjFIUq1LEeI9jUoYmu",
+	"ggtBQ3sU1jUSQ3LH0Fl4FDk0ClEdRfr8+gbodXJH2HaU4xRZBh7PHLi5nMclNBSQ8OmR/fP0xZ/+88N/",
+	"TSZ/nkwOm12g0UePsNU6vy/SEM/fdYkQWjBEWj5V+T6oEqrG6w7EwrcAwk7gIhdAAiD422jQj6PFV6vW",
+	"NTQ6pljvTSaTyf/838P/+X+A/QtPJp8mk0eh6ZUfgWrcU+ferHfrE3MT+oaw9L2rq5tx59Vl94DnVJYW",
+	"pZnnI3X1YLOh3TqkbOXoFNm6mN2CKUzZn6LQO2RCJH+rMw5pGoW+aVgz7yNy6yz2At4jcIsQBryKOOg9",
+	"wvZVbE6VN6unLfVksvp0+cj+u8//+5r/94j/9z8eGy0qp7RwReOhb385N5q2hsubDK5obb0lnBt1t06B",
+	"rUWISV5NUO9AzC55IaKhh+6Rm2QNDyPMxz8Ybc2wMJlkRBUnpECn49+MCzeWOc3ZyU08oYOmx7ZriouW",
+	"g90DfB+5xTaQSz2ZESuqzB1k1+KTH14T/h9cvqli2krNFLnBVm4ytW7JYi0h9maIMO3TcxZq1xE1O/Hc",
+	"AKlFtsVThTIU8icKGrAt68tLSZZy8awxwTHEEbr37KrG6ooMDjQA0PeDB0ZMBH3gsLsM2+xcWYzh6Twv",
+	"BIlWQlkU2+QWOncIu9Xb+lTf1nkii/hJYU7y0zpYQba7OZOIhxtCA66yxziV1CtPLJJiBQt0P66QI7/e",
+	"IsVxQq0TdldBCLhDa8JRjSL1fEvmtRA5LuSFr9Z+Oz582WpPJofTsyNxjosj/FNK0qtZisfeCBeR1gEN",
+	"1+uT079UGf73D+qZ/gOiYqhV2mHikiItBqurzoY62Mf0qDYCPFYayIuEQUDiELhmkGNAxjWevQei1GNr",
+	"b+VDynbLqwi7PmrC2iGaoRDx5HU0kMmv1ZYXW1a0s08O+OE2ERtWQl757r9lO54/NdAFSG4nYDA69NE9",
+	"8oGySDseIkciBXYkQ4C2wQSD3kzljWHNuWgGI58/XqTo0AVUKQxGzsX0VkyGGZjDBFDfyPLsV5WCZgRk",
+	"0eTtVzfx3iI/wHM2c21wwznIXyc8xE6cGVx6/jpjGiaN90/to1SSOy2BJLHv74TulB+8/CAJrjv4clAS",
+	"J0hqa2Z72SownnPJlm0nGtbN8LKtn3hB6M09xm5ppo1vvLt4vWEUF50vmyEovGoExabCpsrMh5wo9Oj6",
+	"NYLKDFBqMMoUZy0s4OnzFwaD3dvO6fMXwFkg545ES01FSXDaGCzQR+gix1tCP4HLQoOB/IXACeh/pjHE",
+	"s+PDl+l1kpRtEftMfXIOz1FocNSI0s/l48sROO8AVtabeY6AHWVNfVl23P/rmY7pfv/ph8eD73M/nj7+",
+	"/PO/0j89e/w5jfumPpk6cOowUqvgr3Ka3hsitmfMT8WWKi0WZ1PMFBMjh+pmFEeUboFZwFRI9uOC0hU5",
+	"OzqaB8HcR23ordouuj86eX7SnmCgBRYX+cVkVj1pVHeg72umFxoEAH1cIUy8e66osjX3EUX8/PIwT2uG",
+	"5z7SrXcZpEeADefGrwtEF0goxPEogEdAcI/CFkAe/0oix0GIc8gMeuyuEoTswuggpr2WSVLeq8mxB4Wh",
+	"MPRmhQ0kQpLzGzj0/ChEsdSMCeQXo4dF4KMWIIgqx5/Xnd5l90LAF/mjY+mDD6fAZCzgQzScsdHyVtye",
+	"4zMxQ5acHHaRcCgQwqDQYCF6MdmPzVhjiT1fBK7gC/lokSahBVB73gY9fA99z4UUvVUpw5tCjldhMA8R",
+	"IdcodBCmJt8rzoJeslwaFQoEfszvCyfHxxXHLe9qupJ9mSaluQvNhmdLiEjkm5zNBhF1AqFmIegskgMh",
+	"w5v8LsWzE2KmjDiIEC4kQgmFbhyFP/KpIKxwPJxgQ9S1OgD5uKcYIh9v9032gBq+EBqEzCK/fB8mvW3v",
+	"LUU6uW1IP6nYuqLtUvhe/oCpOIXYGjc7g5g8CBKWhFgbRJA6EYpeUprI4BaIMJO0ycJuJFtrKpqq84ot",
+	"1t50lxesFFuEsnWqgsyL5zSzaqAD6AfX3WFn3Bv0jRD67Nfrbv+i139j+DK86ffNX0Y35+fd7oWxPXFK",
+	"Gj6cd/rn3Uv27b1hfgrB9hmjRl5upr5r79+TveABo3Cyl2jZLXG28WuzGzJlR9zo7j12EZMGpngaR9Pr",
+	"4eBd76I7NOXyzH3u9V8PO6YPl93+4N0gNer0mIyjVtaO7GjZ79ooWU0tQwueAxI/8WgjGf/9upsfAP9V",
+	"2k21X2JbappmTpGJ1mjzt9ggnEPs/Q6lfSTCHgX7g5uDErni4QUKPYrcq0Kz5Zhvbuxy3+jYUhk/OQxu",
+	"ZHLgfXIwwakHirhxpSoPbgwuiwrblnZdJHmMOygEudc6plPedo9llnRJ9TRvzoyP62Irr5YHO+WCmDXt",
+	"8tmY4Przsek4C+ByJYMrNtVGlZDtWD7c7Ai8HUS/FOrcd5qPH+M137tDYLI30PYFk2AhmOy9upnsPZHC",
+	"IJoWauSlubZlMu3BTd4srHaQ3FlZxw+Z6pq/ISNMm+yRYlPPf/7rv4KoCHtdnPnaBLwWpQdRszxC2wW0",
+	"lZu1om0h758MHIrK9M3r4AGFElCe4EYNrCRSn86CECwgdn12Zq1YXSAx30Q/u64Hv3aH0/PB1VWnfzG9",
+	"Hlz2zv+eOciMRXpXV92LXoen0jYWGAwvusO0MmIYgWEaeakSXW0RECoHxNW2/GhMapn+hScFT/3w+nXm",
+	"l9Flt3ud+e1t71V32NeHLPW47qg7zvwmp+Tv55fG0tNh97rbGecmp1BLK9fRlCOz7rIbT0mJjpX+9qoz",
+	"7F51x53LFFkVilQuI1NTU5yiuQwztvLOQ8QFIvQL8C+5Zr4j8bHpJJVTR2YDO/1kUoTY/fQoH0GbQdPh",
+	"yptqdBmOXLjylI9x/SF3rnux2/4GTxE1/LoY4aoH03jFY1vhe2BMdOpRLg9M39ZySKolVY0eoU3zuwnS",
+	"u6YWo7qr85JsIm2z9+QfU8SrduueiBrNJSNSQqtOZjf1sq7qvkPYrcYUX6dLb/wmVlOBeNqk7UqNMGWS",
+	"k2Jb8pwuUgwyvlT7yK1H9hWX/a407dKD6F23fzEYFh1F8qu41E8v/895p+Tr4LxjGogk0jC5mRxuTQ8r",
+	"kSdtp5dnQWH9C7Qoby/RySVazsi/50VacOBu7tG1rqiSP0uvqXKb7Oiq+jw7WtHdNq+rYpDNE0IKSuqc",
+	"4EXTWOvQTs+AefjFp48kceduZFslegdHpnxmGpmTbrBF4t8Bjl+deJ4J9VwtRmAONaHFlih9hppmEm+Y",
+	"HqMyXGY4m5XzD38tHgoIQWOXIl6LidBQuRItI596Kx+B+BU6EwOM3Bff0EmqKf7ifz56B9zAiUS8jmVE",
+	"I+j7a4A+On7EkREczccrt/ke4ZnPhcM4EyVggSDTeTFcSqe2CQZO4EdLTFoALgM8P+NysgUICj3oT8XK",
+	"tUAUeW4LyAgmrfjsaAEY0WAaCP+oFkCYidrp/SoM+MM3k8hT/345Jd7viEmrq/jQCRGYe/eIYxkIWnpO",
+	"4Af4MPZmZwfMz9oB0wIzH86ZOASMC0AQAr4MBg9B/RxhM2xyRgrX0uM4o4NJ1ABnT7EIbEqDiMYrISdu",
+	"qfuHueF6Guq+ahrQg7dSc5Xb4KpgTR1yX/uIFW5qosUupuE6d85qEyTIy5+yqzBgm6NfdrfjAGl+rMa6",
+	"183wEqwgXeRdJFM4sJPUBSjuqArzpJd9n97fud1bvcu3FzE41XB+m1cDaiQnsNNfYmgkfoU/ZoeSQZKb",
+	"/gagmkJYh3j3jVEdjJSWktAcs5E8RsfQqtrZnnPT3X4SnqMOMEPMpdpSTFsIZBSoFSQkPbUeBm64Pgwj",
+	"DJaBizaFaTwW8mKxs2jp7DTmSbmC6lRVvCIe5CUQgk3MVwGC8LCLPua7vA6Ip8OpuJk6VhU477UE6ovJ",
+	"YC5yjtvgdRCmzkUiAOnypFNHnwiKEuEK92ZBWVP4lRG4odhPbqh44hSmjx2PDdhP678MzVEM4jCmuG56",
+	"e2dq4uFtEGEXuZmc2/shukchQWA0enugMnBzKCAGKiyEQQPSbZhFITcp+shOxaIwAhwvqreTic8paWmD",
+	"EaJM2x5ekUpwZKq9kticBvqf5MZvaK+2Tz+/GpoIBw+QAB8SCpwFxHPkPnkqKgIANIltq/PlRgFuzQ2o",
+	"KUROgE0x6i+iREJqTMK2JBF1EhDnyXOw9HDEbi5s157+ABZBJOxAN9LxjtEVSb0RfVx5crYyi5PqiAgH",
+	"O6TupuK+85cXP0hdSfz9UlfW3HgV5LByk2+6kCf01GalfdnBAYAzdvsQXokZ8kN0H9xVBJUxTEVpgJki",
+	"04mSIMpHTySiVzLIX5dHZWStFxk3VM8FF2Mebf46MPkOsF8BReGSZ1SQQUI0KQhohDHylYt7EDoLxA/m",
+	"IATEKxf3Iqb+Kgi3BQbWJyftuBQuKXTYJv/CpgR2Vyx27BJzyw8cVjCWcwJry76yGScovE8UVv3YKRoM",
+	"77Xq3qFxZ+raYThRK07eegjK9Mmqzi/tJWDYvRqMu9PO+Xl3NDK+1ptKJNGITV9FkOGCb/3OqwwuMj8q",
+	"42NBQf7s5s8GoqFDleS35AXBCQN8AddXAWbcnp1q9lVGaXMhd/JbsoJg/+Tw2clBC3jxQ4Hw6+Wx27j0",
+	"VjZVCF5zq2o6ltP+b9+//9f+byeHL/n/nL5nu+n9wb+e/XZ88v5gf79V+O3g+4OD1EtrGOCpC9dTTphR",
+	"6xAj/BWhuzoDfEDoDuwfH754+vCOD1/IwfB/FZLOuiyi/C07PEvp5scro/j02TZIfvn+Xyfif05/Oz58",
+	"Fg8g97txOJyYorFcCb2gdDRKd9g/Pnz+stF42sUDYtz0XPKRNp7Uz8bhSGoKB1S5a+LtcnK6pe1y8tvx",
+	"yam+R+QPZvqLNkWlyseErCqk6XnJ6SJEDFAipsWjbaq/yiMiZ9W2kyZKW+mjlurfDJ3YXvTetKCu8wCT",
+	"m7At5SBS7bJm6+skok6cBL7588xTMQ1bGUW5168cW3JPLr1epkvHUYnfBtUZQGSks0w8Y/ZrkYIugxfL",
+	"d8vEEBJvnwkGbMg8iq0sLAVHnHcotd1Ko7FDUisa+3/+678WQbHqL0MgsyK1gyDzKps+buq1v/KJbPhE",
+	"LKdSFGo2maMNMgEkNSumkXgUfUXcWJYeQE7h7rMEZB3J03Iif5CmNR1dh2uldW1dg0grqal7Q8GVwHhz",
+	"Et/eeejBvMx8PUjKffwNokBEDCdUOgwTFa+I3dLvPfQgIjsjVOyk/iN/2FHRllJXr9HgZnjenb7rdX/N",
+	"Xbr0b686o9557tfXN5eX6fnQxmi6QgU+euVh18PzDW9PrDYBEKxCDzveCvrcKgvCwEfCUTKUACgotouI",
+	"ajS4aYmnR3SPwjVdsKm7RX7wADyOO7lWrRGwCHxX2T+IE6yQKxuPoWz8pXD/g0Cayr8F4HTqLQ9FnQ8H",
+	"QnnkYeTk1VfikfzAkbECghlHySAvBLdiUoRNThYUbz/CtC6u7ERUFn7iIfqJ/c+a73V2CKecLLV4A2x3",
+	"y3otHjzHWbBdPhgh0gK3kQiyvgxcb7aOH4PTl9AgMkmmDARrcCMIYJPlETAPIZaOnaD7ETpUCqgYtDTV",
+	"HuH5KIOI/WsZES6MZN6ODZ1SeFtmpanovMoMR55ZOx5Sw5Op+Eh6cl7KtO7Nhix5ckv6HmuxUsnQxAMr",
+	"XpImJ7NafK/veK0aHYBFJx9/+BVnQgESIZZrhtHQoAWWkLLTBsA5ZHJBCCrRJNj/QKLbDwfA8aG3FKl0",
+	"Cfjbr+Osdv/8RRGCQRG3KyRy0j5nCP3sMJwNpmM0wyNmQC2bNzVpHOrJf5EcreKssMnh4jp1JA4uu9NX",
+	"Pe6lPeV/ZI7F3Pdht1P8YfrrsDfuFo0zMEcHG+XuRibMsB6pSL6NJ3dX8bSlD2x0/rZ7cXPZ5VbQm1Fm",
+	"WNmvV51ef9ztd/rnXcPXwWh6c33RSQ9slNW98uPKR3rKBGxKF8i8i6pAUcCBK3jr+R71VGI73YAaD7h7",
+	"fjPsjf8+fd3tjG+G2YXMfe4PeLa63O/8h+701WAwnnb6F1zpmV70Rr9Mu/3z4d+5HErNQ2aUxom4R6zI",
+	"JbpHhsAWl8EciMB1XCVXQS7jTPrywSCjyHPRxbWOWx7DzmM6pDcTPq/onxHTbmgAxt3L7lV3PPz79HzQ",
+	"f917I73mB29G6cl71+WzcNl9173MTV3q4/mwN+6ddy7zX5QZPvPzr51hP/9rr/96kP/1ovvq5k16evW5",
+	"M00uR4g82UovgSY1bPQIu6V2OsR0Om+JUu+x6hKX7kUsKRJBOTmMKW47Ppr4UrKVDJjeOJ+LvHBCVYnr",
+	"cWiJrJl6k/3h9OUPL1/8ePryudnCh7D7lRv3dujJ8zKT/46tzVdk8yIpzq5j1ixi4o0dxJ46Q6JGhWVT",
+	"Z97C98WQ1t5UTfhf5AYp2wGfwfLI0WLW7mjtjtbu+I3aHVMiTNdezMqJ4aKTWq7mygtF1i/R+iVu3y9R",
+	"5ib+fLlYg4axYszGSmGhNKk9uqci3za5XMqbZFJ+sq3S6I1YMzJ9zoM7Nnxu7MPoFkkI9vXzTevW7KWm",
+	"6d2pv2N1au0nJB+vdxWgaEOlf3PaJXEFZF/CAivoHAXqmQT4kHo0clGi3POchOA68DBVAZcTAcrR4aLG",
+	"oR/guajL5afyv+j+mLGeTTDYd9E8REi0J10dPbHAJ8fff/8jNxGH0llASnCMYIi4Wwe/GxwwquSw4su6",
+	"cvmAeM5H8OejQ/DyGMjeynE4L4/lf7SbyqH2Y8rW7EMzupYThOc15jmerG9novG8dKJP/hLPNNj3sHRC",
+	"PCif9ZO/mKZd/zU973i+m7yBKf3PpB8GfgEAdzS4jKHtvjdDztrxJST3JzAaXKoIoYP+qNsfT4fd827v",
+	"XfeCz3zyedi96A275zykaFJEGGWZ2nvITY0ie0zKchi3kDEaxr+Pxp3hOPPL4Dr1Q+fXTm/c679RRKY+",
+	"xlbFwqGkPpoGklLJ1USabInimzEbqPoEQjTzkUN5NiEeYh2yaVTAZ91HIk66Bx3q3SOF9lbObNkpzFvp",
+	"kw+d83HvXWec/fmim3zIj7HALJ/13SmEdEdsa0uPoBTBovNe/6J3LoLQZujOfY/XMPel159eDwdvht3R",
+	"yPz9Ih0pNUe9YYRjZTk/D3y2VEFYHCLSUUW44VwfpW45v7zsno8HxjBqhcXeDkbjsu/nlzejcXeoD66A",
+	"8LIhXgZz8iYMotWGl8fihkweb5npLJVz5rE8tvbmrBdiynenbkJ+MAeilHBQ4g0URW+rm4Mzk8CmZqg2",
+	"SWzdO5MhN3T8oBOPynB1Sj/P7tpyLnIHaUae5K3JROBpWiOMC/Oy23K9yfGhiXBdy60cRF7XnXruLoYW",
+	"15r6wZxMed1dxveSS+5khIRkVZM8yUsJg4qRKi1z5m3ubhsvBmN7ldiJbQUIFh4KYegs1jJTXV7S+ME8",
+	"fiwtzw6kvw5ynzc50NriKT81ejNFN+HCzJzpcT95NyVOdRWMJWe4atNwh0TDjpHVc8gRM6mydINAgTwn",
+	"V3Mg0xcht0pScaOzctCrotmLvT+VeSQ3Cmkr8eZYWEomGJxDHL/tSxxTutsWSOz5IkqE9k5SaVMvcyqV",
+	"NnVFd/EMJC86ZeOXjzIPCxSiLzT8p7zLFA9fvcGUDZ4bYb7k0Dd9TdnZ0RUL9rSMLTqzsqdQ2al1hWjo",
+	"OdtRhk1tWX3Y6sNfQB/WWfFbVImXgv6vUSs2SowaImZ7urGYnMbq8VIjvLbcMY5WvG6WMlhNdVcOZWsa",
+	"bx2+kWV6mKLw3pQzTIyZW5JZAbCfwIcODEBLpVbWQ1ryuusVqkRbsl9656PyaJEn+aErso0WZ6vwW4Xf",
+	"KvxW4f/KFf6siM6dNyUHcx3tP0Vj6SmcjbTCXTk7lahO6V65CgMa3EazdhKI6rG1F63cJ7eiPQJqozGM",
+	"9YZ3VpT9hz9qET0Aof6YIfw3zCl+0t8uO+Muf7hI/zzuDN90UzlrUuQY2OdXSJ2FWrvyHF0km9UzgZY9",
+	"CP8nfSy/dsbnb6exa6rhTcZUQj7HmD71+qOxdH0xfR71+m8uu1PlFVNQSiT16V5UlYudaabDm5QjSX6+",
+	"qiZ1q4FgGdekW5ceyC0RXFWV9YhKLQ1lTD4gtFYEQ3qLIGe7AKPBbO/st09Z34l7mY+4bK+Yx9ju8rpZ",
+	"+SJa1KZR/PDYynYd0/e0rar3nTSp9Z/8+Fi0uKXBVUtHX7XO0PeN835Xw0BR1rEyVdRX3WS4xhQqtU5A",
+	"0KSPX5rSLIh8ZEtfxH+LxjD+7JKzBt6nY1AbmM3TlMWyvtQZX9hf3ND7fNg/Q78BEVJZRggvhVGOkqLZ",
+	"XvVmtI4DMhUHHo8Ynu89G4mnGn5YEE8hS0+u4fcl4XMMhKU9aaqR80bQdg4Enm70faHXy97jeyYKslYA",
+	"JTyzDo16HzKfZJg+FbT9o/UqJERDiaI2mTpbu++6/bHpSNU+nA+7Eomil+ZnWuZHFbUvS2Xh6RaEd34A",
+	"XbO2MFTgMpJcvYMZeJCVUirCYPjL5aBzYVQOUt8MKIwUFSVUXqHlrUDnNjh7uSK8RK4HqZ4jO0igc5wd",
+	"fIHSU2FcYTxKDtuCcdDWuIV9r43arfj2eWDyAthUJlXHOC20D3laHMvtBzk1wXprHXWpFVSH2zJez6bT",
+	"s9PYXOmpUnwgiP3CcU8VMXXnW58zVbeIpWKGz4OYSxOyqXpF/KS+1+WnhxSv1DG3lVBeJ6LcNtY7HqSo",
+	"VpRI33DUaMvSKggem5F/phMnv7/qSnOqT6Cg3ijYr7pXr8x5TY1FpJif9gcX3eKxVEn8jS3+fN97DniA",
+	"aybruU07J8O5BTm4pdDTBb7BAf0jYw7oVyvhqmTcRUs4XNAA+B6+E8eO6jyIKPFcGVcFQZ9HFOm6cwR6",
+	"eBZCQsPI4WEariCG89w2bJoJU9FWIJKaiPG0AC/wIZYfszxW28sqw/UlPmWKjG28kepkfmb3+nC7iTKL",
+	"5PKmfjAFOO3MZKbTKRTO5laS4xanWnjq+br7U+eJi1V2qBYcNGqXGIRxmcG32DpjwE/EHxPtmkeTCzws",
+	"0rOIiAIuWiHMpotfxvBa/P47t8aHPJiaP8HAgT7CLgxbAGEncIVPDBSpWXgMDhm4gynosxA6Kupb8oFO",
+	"MMAQB+IHvhh+xPMiAQFFZy3x7ARM/b9HMgUJWgXOAkAKbsbnYOm52JsvKODuNX+DOILhGpy0wMnLH4/j",
+	"5EerMPDRinoOeBOieRB6MKFfZkRg8pdRJTKpGYrdQufuAYYuP5bWCIYgwKg9Yd12fD9JKxEi8OI4HqQf",
+	"4HkbXCK4SsYdIjDZI0sEQ+RO9gAJhIUXB8BHcDVRT7KA8i3rEYB5tiIuBPkb6CpEwqOInV8engMIfjv9",
+	"4XARRCJNDgxZI6yD9/sqLKKL7pHPjk3SljzjBMsjtrJHvOSBGMo49iLyiEzMc3x8csj/b3x8fMb/7x9s",
+	"Cl6+fPny8OT08NnJ+PTZ2fOXZ89ftl+q//yjDV6tuXMv43pH5FSQ4+TNt8ADAgiTKJQvgw+Im7idAN+j",
+	"kIqldgUFvw1fn08wePbs2ctkPA8PD20P0Vk7COdH4cxh/89KtOlHegB45jex4YgY2H+A7ke4XPmI8D/l",
+	"H+DkDJxLvSPZHbzX68Go93/ABzZD+wcfRCPsP0mxWIz9JD/FP7QJiqNo7PMW+jeXlwcH5oJ8D+wfs68a",
+	"ZadVlM0RZe0EMxeuUxQK1YR3cg99QO9Vt6kaTGyfvqD3LcBp++kJA7xv03v2V+n4RKmIIAd8D06Oj7Pj",
+	"fVY43l89/OwUfHiD6GhNKFqyzx3y2vPROLs4r3uX3XHvqgtmVNFSVE2Mf0Zjom96/fGLHwD1nDsCfgb7",
+	"+/vil4MZbbsPb7354gJSXvcAaCefA56dHoB/AV7qMnhQhZIJPToCHTYIN3ggvHm2t06OjzXhR9pxASHe",
+	"Tl7kd13SHKt/8uKHH3748dmL40TY3KJZECJwg72PqpmXPx5nm2lvus77YjrA/r6YoyO+jOw/B+BQJ6iK",
+	"1VlDbNJUQ3/SGuK8cZDhjR8KeeNv8B6CD2KB29LDjBW58nzfIyneYKIYLPnv4GdQXKV0J4Cfk1/bGD28",
+	"ijzfReH+ARuejHixLzsR83MgG2P/YYX6Ygo8TNkEyKJiBuTo+UQctG9Z0/uZmXheMRPCHEPbOHhIDV7+",
+	"DnDwAH4GqVIV49XIrx45Dh7ac0S7jPnEb/sH+gSkJ0GWZn/sFw34ReGA5dLFcZCu13QR4GTIxjXbP8jx",
+	"5RtEzxMuYAX4cfG30aAPruBq5eE5/6mHxW8CsyRy02kzxi7sHkkrQ+IEklrIBPOTrNE5Jvpi+hBkqlBL",
+	"tiN+Zt1N9j4xXeTx8BPPpvB4+MmF68fxJ6YLPJ59Wnr48ewTQc7jb+1PTAtjm/nx/T8mexOFSRH1ORLD",
+	"f4BrAtBHphuye7FQLmZMrXC9ucd9PD0fAdlXC/DOWhMMRH8twDts8aOb98qVnd9RGByuoKt8mh8C1RyC",
+	"zkKmt5UaIlct44hWQjVjesE8ANGKqx2qLrfwyh9PzHrkASONURCsRNuir8neP5jaFc1m3kfl0SmDLDKu",
+	"4Iru/mTvZnw+2Tv4KfX7RKbLEup7G3QA17yfCc4QmXa931EIyCKIfFdNaUQQ11b3YexCyq+OEyyIORB5",
+	"Nlahh+P0UxnWEnZuvbcVDEnS0y2jjSuMTHVyHLSi4DagC94vj/DKH6nVQEiOFqZaB7MZQVSqgq+DECCx",
+	"C1tgsnd6fPIjO0pOno+PT86eHZ+dPG8fn7CZFCxPAP8hPoxWkDAFn5flRASafv68BVh77XhjwXs44neV",
+	"Fkf96KogBBc8nZII8sq1SKabii0gWFTsCibTXBi6bKPRoDcajPj22z8waMHtZfC75/uQbzuED29GR27g",
+	"kKNf0e1RQszREM1QiLCDjt74wS30pwNOBTliJB1pnTCxIvABbTYeIYt4HG5JFPjAdFI2/W31jw9qTBLb",
+	"IQeM2ABMw2Tj+kBoOOOVtUEFDmmvhPRjwzk98r3bEIZrrt63F3Tp/wf/l6p7wGPAivDbQnzKbsgKOeC7",
+	"P/398E/Lwz+54z+9PfvT1dmfRu0/zf7xXRtcenfowSOIX6vYJCVrFREkm/tb4ELOu98R8NuH3mig9KHX",
+	"QpS58s/9gw/v9yeYh20/Ozr678CFh4JIftedBeEctTGiR3Dl8ZVhI2OlxJ1FEHyUb5+PNulkgsFByo4o",
+	"KlIUijiqIra5NOdwVootDkJsx2vAza7yCsHu7afHp88M+2Gvxb78wG5Gp8/HJ6dS6WL3+hjsysjjgzUY",
+	"B/jr0iyQGTUpFKGShaVsb7BCWNggr31IWXvgjUffRrdgEM4h9n5XPuBR6O+d7cUh8T26iG75hS9YIXyI",
+	"3Dk6XMkWZJJGzVBQYuQEneveXmvP9xwkcTaSss4KOgsETtvHud7ZCQf5Z86dsi45uuydd/uj7uFp+5iv",
+	"2p6G/CohYa+1d49CIkg9brMeH1t7bFxw5e2d7T1rn3AiVpAuuH3piA/XY4219cx9bbjyyNH9KeOwQxrc",
+	"IcyLz5EhLAvPRBD7n3Sue0BU4OCeFRJZFnoum4jr3ph9GqHw3nPQpUeo+omccrJCuESU22V/yxuxZh6W",
+	"RxFcKksKt8fKdImcJwTiGif5gFdwzlHaF2gGZf7g02NuymWt/jNC4XpPmVv3WOGR9ztjPmFmK43jtXWS",
+	"klAjRWmWWFWeZt6Yn/lT7gXZRR/j7MY8Sz6jThjzaRRiGWoJ+j67XpE7j6t0YghFcyQOwnoz9GQC9BlJ",
+	"zYnmZxQTVD0h11o69yIWSGVqT6yRwopZNOZMw/XSvr/nVnUOseDb6/T4WMk2heBarXwZveLov4lAVCck",
+	"lFmHU5srBpFxGZoBg4oU19z2SqLlEobrvbO9VHVGOpxzyZ7ZwnvvH1t7K4mUSrcrDP1Mc4pFglAxCXJC",
+	"RMUvTGPkiOY4tYk6WNTEVEoR0Y/6sVKMfIUcwHN+vwrc9dYWX82GBkjKrTtbinhluK+gfJl53CFX1iHM",
+	"zJDpZS7lyMdWk3Pt6FPy0vEoltVHJvj+kCcCTjN0FXNe8LZqM6eeBja1NrdIz0PMmZad4QnPpnCSdVm2",
+	"Znf1krP/cUVtepGby9p0/SezthMRGizPeSKAulqbqJNOq25Q4M61pjUlTv+5WpEbSIMDcILlEgKCWGl2",
+	"z1bEyGxXNOB3Lm+2ZpqTTGsVhC4Kq9JaPTvNprUy6jGsqVfreorMlyC6iaN2K/PAzLuc3q7r7NR4bCKy",
+	"ZqKciaOZK2xMr8UUhYjQqoG8OK4z+zOVLbXJ5O+KwEYzfXb08/f7B58eJ3vfZSY9HlPllNvLjL3M2MtM",
+	"o8tM6pDZ7EKTakI7Zw3HWo2LjfHQrHVmihb0D/bawpZZn5Gqq4tp8j/XLaYunWU3Gb2NSlZsrPbVvtQI",
+	"3fMpzCxaaMTM+r1D9Cu6leeMoHVnN53yHu1lp85lJ7MDNrrwNNoBrbJbjNC4PWdzJubv3BtysJSEyC3s",
+	"fhdcXN2r5eRdSvEMx9TRJiB1FoZ5YT8/RQDzBhpxrx6BI/Equ0VAxijYJs/W6WszTn0tIu5Acidb5KzA",
+	"M7sKYCuf2ALjRvG1FPnuFSR3JVeCD69VoQ9psDBBAt27Xt4GvufImED8sa3Fr5zyjfQsxuHwb2dgsjdr",
+	"Q447yfx423bZzxMM3qIQgQ+zTI+iBw8DEiwRCIOAKuf7FvgAP3Bww4fbDxOs7BMS9Jt480c8izRrWUBT",
+	"Prgf4nbjrwJDELv1s+LtW4mgSpZBwHeV14hmBolu5cwoI8kC0hQ2Q09sDsGcFVZcD4JQpKD2YiSG4Jyk",
+	"SDtLhU8CmV9brT0HhXAsBruQ7ROERJbvAwWAFfWveH0PAymsvAALSOyvC4TjBCRciWAS6KNAgavU3zK7",
+	"eJgNmRBwyHF0e6jFRRCmgsRHQphfhT1D3rMBXQQkzv0OCWtEHHQJGWzA7TQaRUab4vsihnijey+IWAuy",
+	"GIc0ye0i0qRniYYEzAJ+kU24dQY+xXg1CM7A6Wn85632CQAXnIET7e+PrHD892P8rzUr90z+qX7+/Qz8",
+	"JcFbIxL5FDx4vs/TPKm5iY98kWaA7y45Ux9ba87Jv08w2BdJ1EWqJN6IyOCs8ke4wmghWD9YepSKueVY",
+	"IsBWeIJBENFVRA/4PDxtJh6ToU4w6ADlkK72MeFj5PYD5AL0kSOVZGgoHxIKVgHx4qy+EyxEhYTSScAQ",
+	"Y8hYQCkUjWxZuQ1z+01qmyn0XLLvBHtw2w/0fTW5HBmV6WKmFZhgsIBsVyONW+Uu6wdUAtqVgOFM6gZI",
+	"UIcRO2dh6Plr3vtarhKH/KwORd7bLKNySJEDifSOH3ZHY/PItB7V0FwvRDz/t+QG1bZIfu9l251gae1N",
+	"Na12GqEIuvqs8VAoHna9e8+NoK8InuA4I4mSevHJyJo3DUhKMRlkhS0TB6zxAHOecB5ZwrVKktRm4kqG",
+	"lUqNV26ACQaOj2Dor4EbONESyQzic5Gj4sGjC54B20WODyU3SJI71702AD22mhCvOZliEtBsxvgsKBjZ",
+	"UeI7qGEEJxjcogW899jmDTiAhhQIZBFkAgzU1BO5g7RV9fInA4l5kEi8pGRjoZIwxuJxrfQ1YGfYPJBO",
+	"GZqeAtSDaXoAsdAWIRriVLskMRRK+W2W3nwmXeAjdl6xH/nSEhBhGkQ8nJHY0kmefg4fVEdRMMFxLmxe",
+	"XZIriWjFh4s3x0EozbsJgdD3E91AyNd7/Vji50siV/LyKhkTD0iZX4IWwOhB9cgYkC86Y0mkJ/JBHz3C",
+	"30qyXUggnkrbKWNfJLKEzZ+BLr4mSpDKRvISFHzgAvRDXoJmjuvc0uXaipdaMKOYjYlpPijTJeJJiU+l",
+	"JQrnvP3sjGikJLIjOyF5VOrcu0epsrIN83GeObUyx7f59HbOwG8n79NHGnY1JmjY43FBH6fpPvj8ebkZ",
+	"95SiItXn37j+PNlrcUXamey9T2rTjGZxuwGN5dPSAqfZmQEeW5hlnJifSWyZt0+CsIVX9z0KQ+Fc7RGl",
+	"oKTkpEgMKRieyY5YmefbOMYN8ydA6beN4jP3OyL5LqsBadMZcXRykjpLHCLYNWhOsrHEl1Bkd9X4ErxF",
+	"PDKhl6coUStSkW1a8bRA1Q1nfBksM3udVIRpzWl08zCILhOTQgFSDYsxkVh4uuJCoImAFG+l1KdAcbiY",
+	"NZPOxMSSQWvS2kypTWABiUFp0lSmRJUnSM6CuJ8CdC8h/S3BMoyvlghiJR7jSxcCju8x+mON6w4HD3z+",
+	"WPfs3BFOUuzTzPN9KY4mWLc4xQJLLKJgBy9UK8WPK06Hxw6xB4hp6qBv6fY6IiwYnGR5prI7m6CSK57c",
+	"RUBeJbmoT2aQs4kbOdzJEwMUhkHIZhkHVLqeEqHLpNRBeSTGye2y3MTVBO1QJYZTNVHgxIyoDSicg4nU",
+	"glLaNM8YxXalWW0ySDO+y4WaITWRRcCOcP3KKJWl/wDnASaeG2s97PR5Ox5fS7VV3qX4L4wQ4bucO5qk",
+	"ihQRRNKsmggEueLXnfH521jnDWbg+mac2uAEUo/M1qJLgpYQU89hQ9pnJVNRkvmtml/fIt+XNJH4Xs4v",
+	"7l11cQ9mul6oex61chs27Wwk874LnyPh3CPuTjDk7hExnoNbIDjIoy36IiLKgoeFQs9qcR0x7ZFwxN2u",
+	"mLoRHjpwiXxWi/XFi2GBp1FcCXFyTDty5QQD8Bs3q5awVKyDaxdx9VVGPdVOqRuCQjalIfgZnPwU/3y9",
+	"YPfZFf/vn8HpT5krt2qP104ak9PlemTlw/WUz0OqWVkAum6ICMm0LBZH3KRhVjf6IEn/wPe+HwRcGJPI",
+	"WWijZIUTamKzGBteWycqtpxphfhY4w+PaV7h8XfZaBSzZNJX8qMgISShgZXPktCHS9QydZe+xDAxO8BI",
+	"sTDJGc9oiCDVTrCAFSbgv9lmgYzEeeRDZeVox1s+dnvLsE6eW0ac5a7kX8mgeEeAIkKn4p+60iOXWK79",
+	"Dz9pn0bRrWqMRLdT1c3P4OVPBRaPcVrICd+e6gVPrbFoaRBWV9OIytROjlU59vVKBFMhYH+yl8zEZC/G",
+	"IzOZfZDPRqxsMLEkTpYcvEMhO+VkflM5/IwSKKNIsBuauN1LIbzgYisxsnAK9SuRPB7Y8SMtqfesOxVx",
+	"xfEjN74PSQs0z58qcFP8avKh13/XuexdTDvDNzdX3f74Q3KIMqK4qOOn+BKuuD9Q+xt9QfpyUIWbtOKg",
+	"YvWYnyS+XsxC7o2pzntXZHiwlXacJzx3iRaeiDfI40Z28/rVvOd/m1dbu+eeuOfyjL4VnNAiILQuLJyX",
+	"ze/KtwGhGvyb/Wlh3xb2bWHfFvZtYd87h32PhaE1PpZFxBdG3M3wkivt7a2qAPX6S++lbFa+enpDsYi6",
+	"99BDYqrLPC6yq43vpx7bQpTClCjbZMG8sNZTvFAeiV70+o5VKqM5ebNOPToa6U+ZIHsXWsQZVZrttauI",
+	"RtD31wB9dPyIePdIGDihnB8RxWQBMYjziLzrdX/Vg95WCcnnP9aT4mxAFiJlIVIWImUhUhYiZSFSFiJl",
+	"IVIWImUhUhYiZSFSFiJlIVIWImUhUhYiZSFSFiJlIVIWImUhUn9oiNSuQ+PwB/jNQuLwqhquQHvarxMC",
+	"R+WOL8QHiJLsh0qAwL/vu9aO8DCZ7NPGMDlsgT5XWJwqesrC4bC6hWxYG9ZydBv5d1ORLYFRa+beDjec",
+	"QgxEQWW+pXK62PWAOguRO0pCD4JQGgtu1yCV7C4IxdMBetDqe1S75rSlVJOdaa8KkKyxswgDHETEX7fS",
+	"ZsFE5QzRKghl6ssgok4gIGVc1arcf68i/4792eG9n+7thhPTvQxFD4IF0rtxl+yXJaKpOEzX3wIzevge",
+	"+h5Pgs74spghe3HBKkZUzCQ+Mx076eTzMFRCawz72gVHZbr5QiwV29Kbi7XMALbATiGae4SKrNNmPhrK",
+	"EnUOZlW24Gg2HbMpSGrRqSrNxEv4cXq7pojdeE6eTfBeClzFqCtKutwM+GqVho0OaclJj3I/fVUKgc6Z",
+	"W9w2FTI43jvLyKfeyo9lLLsAOaglFlpBY9kWOx+9ix8H2zLTJU3J5kQyy+xz7MogDFlLcaUWfSK3pdvb",
+	"dVksb95ByFpRJhh/XXtr70xApzr5QuI5Q0PTAz9VfQuc1jjmYqWQFiVr3Z50TwPOOErz331AxZLurFD/",
+	"3JEZpczdKCJjqcStF4GxkqXfINqYn5NYh6r53UZUVL1Y7rX4XIvPtfhci8+1+FyLz7X4XIvPtfhci8+1",
+	"+FyLz7X4XIvPtfhci8+1+FyLz7X4XIvPtfhci8+1+FyLz7X43O1gIOQrWRkwtzybVOU7HC9Y6yXuD5Yt",
+	"KvXEaLND2acV+7Rin1bs04p9WrFPK/ZpxT6t2KcV+7Rin1bs04p9WrFPK/ZpxT6t2KcV+7Rin1bs04p9",
+	"WrFPK9a36wtE+SjIdiOeMb4+79743ansbas0c1Tl05Yo+WSvyR2ngyrpzvqd2Z21ab6oLXnN677MWiAT",
+	"7j8fVYQwaRpEpHKXskK6+90Wd2S26Qn+zAEsmK54qFlGIAkwZzVpMuahBmYhYnfvpTixC3YxDmhZzp/N",
+	"OtK27/OT0+z2LcmXdHT208//+6//6z9ak+j4+JnD/xt9v38w2ctkT5JkW6fwnYiS9E5r7hierr9twRLg",
+	"2wCGLpcqZjzLQJSoI1Rk0T+8RLEbZaNQTwn3NN8lWuVtb5FU1CfzHklpxjKoTFFC1SwCrE4YqC1sCrsF",
+	"bFSoLdwbtxsaahq3XpoMVZbS720EEAppROpEOiEjUb9+glSbj/Nz5eO0R+UmG1gytuTr5qdlZmM8aSOr",
+	"d9+6GY3j8vmt25OftMzG6ieb3dhmN7bS1GY3ttmNbXZjGz3NRk+zLj7Wxce6+FgXH+viY118rIuPdfGx",
+	"Lj7Wxce6+FgXH+viY118rIuPdfGxLj7Wxce6+FgXH+viY118bPS0J2Y3jh/hN8twHFfXcAaZp/46mY6V",
+	"9lmJGxA11I826/H2M4OKma3KfBxfFz5T9uM6dJVlQFb1S9m0ESTmydmQ45aekBE5acPjppokL/LusiLX",
+	"3quvIv9O/bT7DMnpnr5gluQsIZtkSk63sUWmbZxFsfZqixq1JbPurBcLE12q7D6zYo1uLUD6c2dY1AT9",
+	"RlkWawn6etkWa7P+G0Q34vskJ6Le1W6zL+o9We62GCKLIbIYIoshshgiiyGyGCKLIbIYIoshshgiiyGy",
+	"GCKLIbIYIoshshgiiyGyGCKLIbIYIoshshgiiyGyGKLtATO0V7Mq8FB5Nsba73S8Qu2Xuj9YZsbcE6TN",
+	"zmifXeyzi312sc8u9tnFPrvYZxf77GKfXeyzi312sc8u9tnFPrvYZxf77GKfXeyzi312sc8u9tnFPrtY",
+	"v7Av5I1ckEcuec74Ot2SU29RVe9fpRkbaz9/iRp/sPcvu+fsnkvncNxBKIAn5XOsvX+TWvX3sDIN7CYb",
+	"m6n5mhnZ2KhtOqqvIXXh5h7X+TaevKf8wIF+x3GCCNO6WWek6So0ZJ251JrTMs/oP9vsMzb7jM0+Y7PP",
+	"2OwzO88+c62dmls9kjMNf/nTNXfIbBZdLdWEdrYajrU6Udb0w7XWWSlq6h8qD8uvcI23f0fSZ6QqUhqf",
+	"dCjKfq5oaXXpK4uYprdRyXqNVbvmgaiUlleLcUWtRoyrh8vJrtnuY1GV97jZxeiPI27zy71p+KhGXF8v",
+	"jFQjxn2D6MZcmwR50rlp9yGlsr1Zbt2ldM5wSB2toNx9gi8fgA3UAl6zEZv+wXwpclNqHSqsQ4V1qLAO",
+	"FdahwjpUWIcK61BhHSqsQ4V1qLAOFdahwjpUWIcK61BhHSqsQ4V1qLAOFdahwjpUWIeK2g4VFmxQDcrO",
+	"vEV8vbCD3KtSnaetUs+I5i9bourTcAMg/Yy/gxeuBl3+27zF2n216b7K8/TW4DxcV6yJ0o7Lmzci+yOD",
+	"0OalK/ffawGxjZtnanUJ38pPNdCTJQ1r8Nvn5eDbo7MM5BbXTD01FKcn8WhygyQFYyKL4GHkUUTqDayo",
+	"aUUia25KZHuS0Nsg8BHEJZSGaM60jDq0DnnRZtQamk/RG8Zt1qZ4cFOL2kHUjNJMsykqg6gehX88pCyf",
+	"rs1RsvFsa3JNEyk1ZRpG9CEI744QdleBV98DJS6fl21d+UmTbeon63liPU+s54n1PLGeJ9bzZKvnaXzA",
+	"bHaextW18zRzjG16nuZg/3UA1Kp25eH6BsW0bwic1rvaLWha78kCpst5Wi3qRmBpVXlrzOyt5CMEqqse",
+	"9q5BXMXg5X/dER81FTH+DVkl0SqJqYGkwTfgQ/IiNtk7OW6ftE/bz45Of5jsfQBBCD5gz2kvAkLbSrhM",
+	"PZeXZT8enpw+++H5ix//Mtn78PVrn7scuVVrrVpr1dqvW63VDsXNFFutAT1QSeb83Vwd2Ei7TZSDatXg",
+	"DUrGsKGGm+5utzpuui+r5VZE5FEru1kqJVV7e5yNEfU9fFdXy1XF82zcF180/Vb+YpVbq9xaC6hVFa2q",
+	"aFXFraqK6nzZTE9UtbWjNH2EbXiQbqQfyspVp+obpMjeUDHU+tmtVqh1ZFXCclaWK7qRQijrbo2HPaeu",
+	"IrgICAX93rlBE3wbEIo9R9ME5S9WE7SaYKmxbwmdKbtMcgsehGe3t2eOc+a6ZwidzWbK5vdvaunc8uCt",
+	"Bms1WKvBft0arDoXN9NgVW3t9E8fvRuc/htpr0oXqFIF3iBF84bqq97RbvVXvSerwJZzslzTjRRYWXdb",
+	"LEzQfInqQzplNaCqGe9frMRIFEgbN7UPVrO1mq21cVoN0WqIVkPcto1TP2Y2NnXqjaStRfnjrU6U8czJ",
+	"WfPgFNXTn2ywcWkE1OakKtx4ZvY/V8Tx+kSWxRxPt1KDGTdRAJuHHt+Mo0X1hhytXzkMS7n7QOSVndor",
+	"T51Y5Ln9sFE08sb7oVX3SWkDfhZvCpsyc+r9J9v7zh+csh1aJt6tOM/xSj3FojxQ+WZsy2s3ZNw/WLjy",
+	"oh1pA5bbgOU2YLkNWG4DltuA5TZguQ1YbgOW24DlNmC5DVhuA5bbgOU2YLkNWG4DltuA5TZguQ1YbgOW",
+	"24DlNmC5Dazc+PGoILRy7j3ia0YuGN6X6j12lYYu3+ytS1TfOuRgxyHMKzu1QcztXtNCme8EJSR3F55P",
+	"yZpQtKyLEx+MDNDwgWpsxNvSsOGZLxYcbsHhFhxuweEWHP4HA4cXi5V7Dz0klrfMWyG7qfh+6u0sRCmI",
+	"iDI1FgyRtZ5av7LjWh3Q71ilMpqTJ+jUG6KR/pRFsXfB0RbClKlKs/1xFdEI+v4aoI+OHxHvHgl7JZTz",
+	"w19j6QJiMOyOBjfD8+70Xa/76/SmP7runvde97oXVYLt+Y/1JC8bkEU8WcSTRTxZxJNFPFnEk0U8WcST",
+	"RTxZxJNFPFnEk0U8WcSTRTxZxJNFPFnEk0U8WcSTRTxZxNMfGvG066A32ffzzaLeZFvRMATmp/sacW8w",
+	"GIxqAgFElcw3G+yGrXFmUqqi3QTkcwW4aUBYWYSbTDN1GG8j9ErjIDeMfesCWUSdpvyrQ74CIpdu9wFt",
+	"CrqyEUDqhLHJc/1GcWw24Pp6kWzq8+wbRJ/CsEk0meAzJM4K/m0SZlmcg8U5WJyDxTlYnIPFOVicg8U5",
+	"WJyDxTlYnIPFOVicg8U5WJyDxTlYnIPFOVicg8U5WJyDxTlYnIPFOVicwxaemvNvXTUBDqXx95u8EPMq",
+	"Td/b/mBR9wNiA+3b5xj7HGOfY+xzjH2Osc8x9jnGPsfY5xj7HGOfY+xzjH2Osc8x9jnGPsfY5xj7HGOf",
+	"Y+xzjH2Osc8xNtD+Ex6KCqJ/B+Qr95o0PSTVfMwqi6/f5C1L1NmWt+OOY+kXdGUj6P+RN5GRg7fsehzg",
+	"uhHzkwqFOzDA+ZD5Aa4Olm8DhdtA4f9mgcI/U0CNAD8tlAbb/jmBEm/jxqIkF76gjjN48hZfKVcShEyA",
+	"N/UD13vbsTu43pUNXFDrtAzwU3BTAd4ZNx85EDvIZ8MoCCjDvzMdNVl3IH70pZkxsZYJsyzhBnt+X8OB",
+	"tOe1E/CCfPSEfoiguwbQoQKOBKWZNUTCiFS9bwQZm20djWhx7okh7VAXLuvQbqOdbaMMk2xhJ5GpuMtM",
+	"V4HvOevaqZnUi/01q+Yhk8I5EkV4iTU/0FK/2PxMNj+Tzc9k8zPZa5e9dm312pU6ZTa8eqXa0I/Z9Id6",
+	"sQtTZ+W66qSU0eyanZV/DHtievILAhcm8y1Ums8WxrCSutLohbU5bhO1rnHMwsZcK6PRNeNa/UqR7fDz",
+	"hDCs7tXeZmpFM3yi0DW1Ui5268UwbMbEbxB9AgfbQIZf4V17Q6Gc44QKHaDSva8ZI4q32Was+Afz8TNN",
+	"qfX4sx5/1uPPevxZjz/r8Wc9/qzHn/X4sx5/1uPPevxZjz/r8Wc9/qzHn/X4sx5/1uPPevxZjz/r8Wc9",
+	"/qzHX7OXogIfpfxDxNeIMjC8KFU8aVU5+TV70ZKOUlvHB+zY6a+6V+v/90fdUiaW3h5wJ4zwDsDYwwjr",
+	"+LZhhC0M28KwLQzbwrAtDNvCsHcBwx5G+GkY7GGEDacq+3WDI/UpQNhhhEsP1jSGsc7RmgkvIc13YYQ/",
+	"W/7usi4ttrAJ8nUjPs81UcjpTQGvVcyqARybcqrFuX69OFfGLJuAXJ8sZKO6MUoGN6brSaRHJYmIvZTY",
+	"S4m9lNhLib2U2EvJVi8l0aaxeKJUEJ4oFQ6vygH0puTAk95/kfXw5GpMVBYTg3t33nw2f85og/gccjUL",
+	"eKWmJtX4klrBY/KWETV7b7n5TB6YN/bmueHNM9r4whkVCrNat8wyZmOXimjD+6RoeLf3SdGH5bDtCz++",
+	"8sWnZJWLZBlTidfryPpAZnwgb6zXo/V6tF6P1uvRej1ar0fr9Wi9Hq3Xo/V6tF6P1uvRej1ar0fr9Wi9",
+	"Hq3Xo/V6tF6P1uvRej1ar0fr9Wi9Hpu98xS5Z918fS/v8oWo+Pmpwp2x7PVJOnpt+H6+aw/FG+uT+Edk",
+	"eMWUT4CayFtkWBe6G5fP75Vr+UmD8aqfLJjXgnktmNeCeS2Y14J5twrmjQ+YzSC9cXXtBM0cYzXgvfGh",
+	"WHkmihrqR4v3ZcuoZqMK9asm+XNhf+vQVYYAVvVLWauRitbccbU2Y4oatRlTv3zE66Iv0O4xwzW6tQDP",
+	"OhBijc83AhLX4vN6oOLa7PoG0Y14NYEA613tFmys92Q5clcyV+OIqrO8FIRcnwd5hdpc+AdDJee2l8Um",
+	"W2yyxSZbbLLFJltsssUmW2yyxSZbbLLFJltsssUmW2yyxSZbbLLFJltsssUmW2yyxSZbbLLFJluoZoMH",
+	"oQLAZvIE8XWiBFLvR1VvVmXI5fpPVjIZxZNe+T8LoLm4Nwtr/iPulTTjPhlRE6I5U/RqQp5l6fzuGvIP",
+	"GtxZ/GDBzhbsbMHOFuxswc47Bzu/9iF/PyLenG0Oaa2mAYW+toLEo0gsmBDlCSYifkErmhyyCP4/9r79",
+	"OW4bW/Nf4XqnauJsS3Ki60zirdSuYyuK9iaxS7KSWxNleqhuSM0ym+xLovUYj//3LbxIgE+AbLb68f2S",
+	"itV4ETgHOAfn+w7uP7DmLgJKUrtJWumg1KSwgYx5G+NUDkVOz3Uch8SPgAWXp283JLisrJkWxulugwIX",
+	"69hiJojS4k/Af7OlE3PRhv6WSrIm7Hf7mJqQ36J2gyg52KjumG8rMRSlLcXQxLXydciXY3ikd0uXwNTa",
+	"oLwzie6E8W6VaDt8t5VonhLaSS4V3jrvZFhcd94PJHCY3TSThOZTuRnPbSVzvLCl1O0ZkrugTsBxA8cN",
+	"HDdw3MBxA8cNHDdw3MBxA8cNHDdw3MBxA8cNHDdw3MBxA8cNHDdw3MBxA8cNHDewqdYhoBpkqgo/bGK0",
+	"X4saNUeoGtHbVgEqUXpF8fqBwdstXQLBvV9aootub0zMPKbkwOfdHKUkTZ1g3KyyJyp7qnKV3rFyr3kx",
+	"A9ud/5kA4g2INyDegHgD4o181ivGMOunTFcos96GceSWTjY7XHPFwaniV+qa2eocVfDY/AdAoIVplc9I",
+	"OxC6ajXWhou2G2gzOjpvo1U4u1uF1sDpc3IXf6yVcyu5ViBZB7k2HaaaJeVRAza6AX01q66BcLXDWBva",
+	"0RFp7aAdtqjrrnLNAbidhToHStf0PzQ+u6ZbCPOQm3xBZlazw8chObgOomkQ3Vq7+3FIPFWnQtrjkPwg",
+	"ftWd/PyvcPHh4sPFh4sPFx8u/mpdfO2M6ejgay3o52vpSLPy7rVz0uaYlE5c/ne48Nxoyiek1YPXZnxt",
+	"jrvd8Br99ryJNqFztuk6sJwdxVZ6Vw5ia3gVhSVbA+m5sUe4MFb+uCH03dxxe6G3dMYd5Za5U12FNneF",
+	"C50O7HgXeoOwDrg3m/JhYQ00Y1EcpVPG9le3qw4NTWnsEciUvjJaB08xxGpj7ZySNK/CzmEjmy5DYntv",
+	"lZX3vhCO6ZEirzwva+SFLKxdYak/td9fwXeH797bd2cOzSxOqXf2VhHn5OAyQeYUlyimOVdnJMfKh5kX",
+	"vIq8L3IanuDs8qnyuBynz3lLFa0Y3Qmg8sy/I5KIk5MR1UA1Kj+dkfnICw7J4UiNX6CB+QnBscEzkgSU",
+	"Z8YQQ1z4CYmoTOAmvixahqEYHfs/8/PMwd0HdBYvmcJng0llx3XiwQryo8hCPLAcq1uOq0jSXOf+w/j6",
+	"kZLU+56nX3hm3Kuyigd/vDj4zj+4+fPTt5/1i1T22ziwMiPYyvFv2IaVUwPtvnKHgjZovz5Zl2p96jI2",
+	"BpS4aAvm3GHOLVWC1atTCfabg0pIFLnTAmUZcgpL1LZAYnrsl6iwQPlQi0t0FdkvUrahyeaMLc11AbUh",
+	"tZ0yoqiL5mBpVr001er1sqheomqdgolfLVXsRxGIvPbTPPUXDeYkpf58MfLIw4JMaMaovfz17L88sogn",
+	"M+/yw5u8IM+oRSZxNK21cZdR8HDCalrm0B1iXNoUsrkzY55sgGMiR4jbKSNklfmU3eJVWXXNny54rq7O",
+	"dOYUW5M/RPFsKFa+9HmxFnxq+NTwqeFTYzngU8Onhk8NnxqOG3xq+NTwqeFTOzM9C75lV7JnoZlGH9uC",
+	"6ymaGyv1avWTFanPHAZAoYJJY85KO7ezMPvr43XaD7SZ22m2M8CFT5cHcVxlWlHxHGXahMMVl7Jycdfx",
+	"ek6HgQCyZ8f3LGlNR86ng9bYcj5dZZ7z9noJfE68rOh7aK5nRZcQ4qE3/QqZaTM/2h7rcRVbmYPNUXD3",
+	"7g2fOo3Ecz54zgfP+eA5Hzzng+d88JwPnvPBcz54zgfP+eA5Hzzng+d88JwPnvPBcz54zgfP+eA5Hzzn",
+	"g+d88JwP0kF0CB3VPllSiklsNsKhMtLUFu5qefnHNdql3lJZCzBh8GeCOgwEKVqgkxVvCw2GOhKWs23q",
+	"Fl7YjWJ2YdYBwQwEMxDMQDDDcoBgBoIZCGYgmIHFBIIZCGYgmIFg5pq0xfQsO6ZuMRvpRy4Tfp8rtcwc",
+	"AohlbH3NOWmjlRXmfV2kMvtBNlHKzFZWfrXjTidzlWJR0VGK9YvS4vJVLOfwNDLnQYB9Y0MhK+lIJwKZ",
+	"g47Y0cdcZfyU0B4CnvO4KvodljhW0SEEd9htvSQr/ShjrqLK6zkK657Rxeq0EGQxkMVAFgNZDGQxkMVA",
+	"FgNZDGQxkMVAFgNZDGQxkMVAFgNZDGQxkMVAFgNZDGQxkMVAFgMxxTlgVENLKcUjNhm1UBFf6kUSc41v",
+	"iYqDww0GJoc5DwLEMOifRglbNWoooPYPeLOyFWoaUJP3RS3YXu/4//ihsLI101t1phxWI4YYJ/wyjHsY",
+	"zDO+IMSbUbpIXx0d3cbxbUgO/WBxOCV3R18df82XeUqoH4T1ZCfW1A+PdjDQpxj03H/4mUS3dCYoUgZs",
+	"9C///scf/sG/Xh/8/WD84uC7w5Fngkh5l+PrR5sNI/s2EZTMGVzCR+OsrvjGCyJKEpLStg/55oXN7Iu+",
+	"HCd/qAE6zfSro++//OL5p89Xz/5amPTsm1qnHIxHMB57Mx4366Cv31ZyggFTu1lAEj+ZzIKJHwoXI10u",
+	"FnFCV0IH6dCpSVews7Gybu4Ccp/fIRainsznksQLFQVMiAF2UZemNd/OWje+u5l5Lnr9jVVqGnMeTDei",
+	"oZXjN+5Gz95y3Ii4lFWlme7/sqRLPwwfPfIwCZdpcEfEzasv54fHlenMj7zzk4t3l+dvTsa/nZ38Pr78",
+	"9eL9yZuzH89O3rZt2i//ZneqsA8CdgvYLWC3gN0CdgvYLWC3gN0CdgvYLWC3gN0CdgvYLWC3gN0CdgvY",
+	"LWC3gN0CdgvYrb3Gbg2frod2T9JDzdQ8OdTALi0PJY14BZWIhW5b9p1djrMNBhaiFvmE6BqTCNEeqYMo",
+	"qVULa9hPlwxBLQqlsrZQV2QezSd/HSl/arvbBbTdzm8OAycuoj3SFTXopW2CohYV41lmaPc8RHQtyYco",
+	"gTYB0gJICyAtgLQA0gJICyAtgLQA0gJICyAtgLQA0gJICyAtgLQA0gJICyAtgLQA0gJICyAtgLQA0rIN",
+	"kJZucXsZtWvCsrS9BtESF5TpeSgefSg9+kDx0gNCKwitILSC0ApCKwitILSC0ApCKwitILSC0ApCKwit",
+	"ILSC0ApCKwitILSC0ApCKwituL30sMt0mD3Jok+75c7fTIZsFgdrirW1PEzREmpTmfh7slwHf2mitjvw",
+	"8kCB335Nz9WwFxeeEnZC0+Tx6DaJl4v0KIxvbZ/EyOqOWaWxaKC8d3xQxX6Ob9NTVkh7MKP8Y/vzGXg6",
+	"AE8H9H46AG+wbNgbLBtobg6YF6hi3+uWJaiiIe1AqN17bTIIVe3vDtu7aKf885ZlGxrIHCnPS1t+nqrl",
+	"WFe+HrfRNmXvKbdkKa6drRn3TD89BV+000HwdQemerVrRGD4rEE9hrMzb/gNmnmnUsM65eHprGF2OXp6",
+	"KscpoT01I8+0UzeUYfP71PUKOR/+DKmUniEPEPaPYOLsEf8iqp22ucR6uSqv2GgHjjEcYzjGcIz3wzE2",
+	"tr6evrHRVtVxUbEPu3nI8pxoNYcqOir4RXoJuMqG4aBPjb23bCzN2h1m2zFb+cx6Y/Zi3Mfw6eM899CJ",
+	"gifjpBPVbmtBCuqlY52+dMdBwdNw8qgLKtjPqe6mgq6udQ/V0V2kznpT5e2WxrQuN7vUMeR/LYdPnSQN",
+	"dfIsBEyyWwyaOfwSZ9kWg5bF6qLQqhW423C34W7vnbt9zqVAsuoMc81TG5Qk9ibE89M0uGXKxMkXrLSg",
+	"omUEnmBKIhrcBCSpm3hV1BZ0Ndj4riLJDJz7D+PrR0pS73vOWH9mzDWrevDHi4Pv/IObPz99+1mfZ9Xs",
+	"OJiubarTgJKzt3Wzy39d58zK4VhOJitdN5nst3VOpITk1U6lGzZwtUOqns6XxekU5esmVPxqOaVn0ZQZ",
+	"X4ST2di2ppuk+SdkmT088kAFy1d8gyp6FeWFE1YgIVOPE2gUTPJRnjg3oX+b0Xk55N4P4+hWtBdHnGMl",
+	"ErqcTUdqaqZenHj5FsIZac1FJAsoiqmXEip5dKpvwZye1mrTLL4/i2YkCSiZWp+3+z2PmTrP4vtxoE2e",
+	"FMHrOA6JH+Hmt9IIXwEoSjVVFyY03YEOwCgpl05eRwUYRhbAnW8pWCxnxhEgJZflSSBSFiO2BknJtqzF",
+	"t4fT3Rsq1UUVKlArtqrQgFDKVr9WKNYOmnIdEq65nIFTud71h0656l0n+FQXhSmCYLpoSy2cSRvQWmFU",
+	"Wr+Q+3WcNDVS5GIlNSftXIGk86Y6yfqepflsUWIk/kTiTyT+ROJPJP5E4k8k/kTiTyT+ROJPJP5E4k8k",
+	"/kTiTyT+ROJPJP5E4k8k/kTiTyT+ROJPJP5EcsyOgaaaFHp1wYnNR2PUxp9comSN6TZXECQTbT0JfmLg",
+	"JJ49h7QzcWRo9so1u15phsZZ9csoYkFxMks2ZBUB0QlEJxCdQHQC0QlEJxCdQHQC0QlEJxCd1p/iakVc",
+	"p0JrDVka+jCeVDKO9puKys6qExqB+tSQvcOZ/VRYoqdKebUqDpTZnItY9/PQV5H6qqOWVOcc6nerV5KK",
+	"Jnl5iiRYHQYGpkiXRFirIkl1V8yu6bA6qlNFIqNVcaYqRrbutFhgTq3/iKqXKEezy5ZC1VMDzECWow7s",
+	"LZeqTrlBpwKdCnQq0KlApwKdCnQq0KlApwKdCnQq0KlApwKdCnQq0KlApwKdCnQq0KlApwKdCnQqkC66",
+	"R59aeRelEMVWIDyawlGOATRLdlXP+FmBLvIkgIy1ka16DAyUK2i/K/dqWHzXvYqwVyJOfudBX+7m8rvY",
+	"tOjbZX+QMsMc7DeyKLPoU2aBzYUFSGfk0ZvxW8oR+3dE7sMg4oClYB6wheSeCfNngxsN0sEczqvIu3r2",
+	"ejIhC/qKx5COCHMBDkT7V894g+zLSXJwwTzdE/Zzeui99mbET+g1swOD1JN3DRNxscs/njs2KfWvwyCd",
+	"ER4K4Bda9zMSkTuSeFGsbqLF4HNJ05qOKEnu/LC8cfIplGvC/18JRDuv7D+DaFox4zQWAx/xyw7220de",
+	"kM03/4FZUTcemS/oY11MntewA26vahSUzNM2HTImiHXMNHbuP5yJyi/zPUp9gNyd/CTx2ecto+C/l0QW",
+	"Z5tfE9NKBIzF5dmEBHckJyk0iTtfa5LSkRdQdZ8g48A88hVHYi7k9i8n49Dj8DB+l8CpDuIeRvY8beNB",
+	"ffPChrwlvsiRu7Xd0+BEB3t19P2XXzz/9Pnq2V8LzLBs5lpP4wsyiZmgXxN6T0iUbwJyd7nnsYjinnGo",
+	"UyqPa1meWWOyF7ulXNmQMk7jscFo1PQua3qcZiNsJzd+yE8I4fbyqx0mUpfnP3On6HClho1df6bo8O/N",
+	"/r0JcDzzuHDHo5r1NctBP5Ns7YQ4+RjG/nQ8J/NrflxZ8bOL1SrOR1niF15Ao2ObP7SfliDQbhiBtvKA",
+	"4Vcl/HzhbGvtHNmQE3CVA1z72YRMBMhE0DsTwX6xDQvHTDeSYaER/aitOt5sKIWFk9Py4BTVzZ9AG+TG",
+	"lDEndSzBwqyvixvYPrgmKmChdrvwdTH43Ol+3SRYVHeUYP2itriEFUs6PH3PeRCgJtlQ9Uyx6MrQc9YX",
+	"Oz5eN3k/JbSHsGu3pOXeh2XaVXQIIR5mmy/JyIr3eFdvvsGNr3Dg4brDdYfrDtcdrjtc90Fc955Oe5W7",
+	"3sFRbz0TTWcNXrluEbVl71GTvG6HvGt2HlW/UbScTLTu/nerYJpeUSdn21ig9bnXDd3CF3FxqPu60i1b",
+	"qIv73CqumjfU01den5MMiRxyz9Ukou0sb04oYy2DvIK1FO5ZhpiSeiEhDBLCICEMEsIgIQwSwiAhDBLC",
+	"ICEMEsIgIQwSwiAhDBLCICEMEsIgIQwSwiAhDBLCICEMEsIgIQxSQjgEhGpyQOQhiM1ECRjxo7aYVWMO",
+	"F+uQlaix0ij/wClXLLpFQpV91B5TlFsxNndfHckVSI8+aWvx+chfBAc0/kgiWwS0CCGxCmV9e/3+7AP7",
+	"SYNAqz+lXcBepjatUSCBTQU2tTc2dWjwZ6Za3cCfumaq3aOgwBbgzyjfEETsIiWThLvvH0mUXZFnkRpl",
+	"56qJad1DRD/qj1u1iQx0qqm5aAOMZuuyLsSozcCaEKPaItfLo+1pZg0WPSd38ceCILcJpUD+baVQjprM",
+	"TUNk+N0rm5vBrNuG7qys2uHBoZpIdwKHrkCk2diWlBzN4pTa2mi8bFmKf4pTqplmP/EWW4TXIW/RKmR6",
+	"fWmLRqDggYIHCh7cHLg5K6bgZWpxF5D7PPJZwGqledpMhV1KiAHRVaHemilirRsT1HScK5v0N1apacw5",
+	"BNDAcFWO34jonr3laFeVzFGUZgL4y5Iu/TB89MjDJFymwR0R8WJfzg9Hw9GZH3nnJxfvLs/fnIx/Ozv5",
+	"fXz568X7kzdnP56dvG3bOV7+zW5rYx8ExDkQ50CcA3EOxDkQ50CcA3EOxDkQ50CcA3EOxDkQ50CcA3EO",
+	"xDkQ50CcA3EOxDkQ53uNOB8avsXD792gWypyrzAFWmDfJl/fLE5pIzpAlGR/2Ft4wEDgLTanbcAttjzr",
+	"wmy1jacJryXlo1oIHUAtR9fL8OPYn4guP9VI72t+cepHniiorm+pnDDmHtDJjJ/aKh4fJ/Ky4PpRj9Nx",
+	"z4mHDsi9Vj+gmptzKHc12ZkWVfDTx2gyS+IoXqbh48i8FsxNzoQs4oQKIzte0kksYE/c1GrVvx+W4Uf2",
+	"z9diTqCDzjroT6eBiOi+T9g004Dpzo0fpmRUAgCK04YJVFnG5suQBouQeD8pTNVCa/DTs1xs29SMLapc",
+	"0M+jZ/6cvokjmsThL/GUtEIrzdJCTdlivZ7TC+pTmwZEubzqe2bTW1XWSn4eKTTJq3JyNK50KQnJhN/v",
+	"Zao18uY1MXClleOA3+9sFjxm9CyKqxCjzEY+0G6E/DSO+NDkVXmcsC0oIeQmTubCUhllxBJpmrz+cCKF",
+	"zPyOl1993fgZR6/+9/f/9//8j/85ulq+eHE84f8lX37x/OpZ4aP40Cs+acHW8g1zA6Pp+zgMJo9Wq2/W",
+	"YO2I/bMKT8sNsXxfpfk2mu+vI77+8VLsuY8CjnJooFiJBHjIT7iO45D4kTwNJSg0reo/2+izUEWLFAoB",
+	"MJ5oNduUt+hz/2F8/UgJcwi/Or4SG3O2VKyTgz9eHHznH9z8+enbz3+pmv78JVchsUWcK1MEuzddtf36",
+	"D7UL/Zk3aB4hco/LWxbhU3Himxv/kNZGcVCutm/pXOxvewTRnR8GU5+SMTND6u2Ps6xgm92hbAfxs58w",
+	"t0XVXY/9kI91vzG+azcg8oU+WkY04TccUlh+qhGWkkXhfr6aPa/gHDXnWtvjNuhIrTg3a4e94lO0sPnK",
+	"j9U234L+bcjum2EM3B2+8obSf+dNyG2QSkmv3nLPZQmbKwtVdq8vLUafKoGfBommbpTdbRxrqs5Q1ylS",
+	"jj5Lfdqoq5KCXK5ObVrMlUx3MgdWmiPUi3nYmguhogwxFXtz8VsGm2JnyGvDymFmTG7EXIt4qh89ShN7",
+	"LoINok8yHelIBN1skTGJOGGtqOBU+Git2rBl1mbLJCUJqrkCmaR31ROTGSdZU/zyXxe0eqdMcNhkZCVJ",
+	"qRcGkYD3ejPiT0miApEiZjCJw+U8SkeeP4+j21d8MUZeSpLAD8fRcn5NkpG3XAbTkZcGlPlZI29OqD/1",
+	"qT/y/CWNx3F0HfvJdOSRyL8OyfhukcQ8tsCsk3F4N+ecEA7plxUFpo5fMfqpl5J5MInDODrIo64fyeP3",
+	"Akmy8IMkHXk3oX/LQXNMrpji8WUoCcnX/6F7h2yGK2ypafJ4vozKc/+bclRy9VXetloJOXFz3eWeJo/j",
+	"ZBlVutwZ+9BilQ9rKQ+T9M7wsm239pOIJo9N7vNMWSW638y2VVXA2EM2xB4rjMnVGS5ui/2PlqztRpKp",
+	"LGXcrXgp9emymXJ6SkTc6kL2AuIpeI5r4jkOGcqVYi2l2l2Ny2rRX5GdH8pqda9EyX13rupTGXDbVgVu",
+	"h3/9q6G7DcmcIL2lTlkTGn0lu6e0WgVaqh2kufn1LjWPw74RpnrplssOfGPwjcE3Bt8YfGPwjcE3Bt8Y",
+	"fGPwjcE3Bt8YfGPwjcE3Bt8YfGPwjcE3Bt8YfGPwjcE3Bt94D/nG3bCLeYyslmjs08msIjM9+7NNWJkX",
+	"RBzO4Jm8LZlSgz01ZdNXt5hcvh/LFtUlubzY4oKjUtU3BpCY1iKGhBgSYkiIISGGhBgSYkiIISGGhBgS",
+	"YkiIISGGhBgSYkiIISGGhBgSYkiIISGGhBgSYkjrz1p6aZ7IKteduNvfvJwcetSpNrK1rCCZyYsPi8CW",
+	"KInIli2BcYDglmV3T5++Zmf0yhD61bKYtTx9POfNsiVDn2uOPGip6prNhs4yXKFGFpu+ioZNOjVaTZq5",
+	"qtC0TAxX96Vbls9uSOiJqWfulPCSnq54Y5FZf/iuUo1meSdK2Gwqsih2lB3dUYZUFE123LXEFLwVq4iR",
+	"qbFaRwzLWGaCkoCQVvwXUjdqqRtXoBTI5Dic17jqdI7jlPo0SGkwSRvTbjGRE1nsFGguHXnXSfyRRN40",
+	"vo88P4xlSuA8rcY0mJMo5bedVom58qHg7FIZkrIp5J5jQvyPWtazfOYPvXdRKO57aEz9sLhY4l5TAjGC",
+	"G4/MF/SxDveYr5tdfq7hB2mbsE+ToWxQz7Q0fMaXWSS5b0uVFkdmFmx/MomXEWWm9tOnSVvp4HYgRZom",
+	"HD3SpOmb1Aq233WmPDzGvoqch8h5uOqchwqMkrZoscCL3mTglQrdPZM/ySH8HKT0LGsdyltUXh6a1+L1",
+	"aoYVysUgHsQJR9BxWEKbuhx/baPPvKkfHh0Veo2DdtLxgmLzLsfXj9hNN2E3LTsG5CaIJCDJn3O7jn0A",
+	"s15ljEmyIwRMNUO9LPxbDsR7KyF78Y339Yu6KWaFL4J/EUs3YNVDmvsPwXw5z7cO+S9t4/BvCc+Knk8Z",
+	"W8Nb7sOXqW3RlDzk+KokpWLlM2k49D7MeFLDML5PvfRjsFiIl8HIvH4TuLlJCbWbod4D0GfEmBMtF3k2",
+	"oPYJQeZKZK4E6xCsQ7AOwToE6xCsQ7AOwToE6xCsQ7AOwToE6xCsQ7AOwToE6xCsQ7AOwToE6xCswz3M",
+	"XGmE4N2RBsUIvsIZFAL9gvJX+ZD2G6aTzCZR1mcrakDUUH/Eg9WrBQqreW0C8HItU84Cjb0JX5FhKXM2",
+	"46qW0ZK81AupIyDm6HoZfhz7E9F93VPxr/lFqx95oqC67qXaJDKXgk5m/KTP4I2JvGC4ftRje9zb4uEG",
+	"cl9oI+BXNZmDJO7TZKdaNMJPH6PJLImjeJmGjyPzOjE3VcUz8sI4j5d0Egu6p7xztdTVH5bhR/Wn12Ke",
+	"oK9remDeL8td9tT8mY7RMp+bz8XZRh3ZAsuF/TxS+I5X5XS1XKRTEpIJlW+j54Jb/0i9kvtxwG9dNgu0",
+	"wiaOq2H5e8+FDWCqJ821MVfSUfZ0PF8ugYbQedOqj6pX43MiSVo1hmzPyG7KLaZcfK2BSu9Af2Edlegv",
+	"xemre21eX3U7HLum7n8oAf4zb7C8C23IE/VVA3M1wSq32NUdcc5vXVufDaLG3ttxjXkcMitLN7eGf//a",
+	"otsNeQdbMws7vYVtZRbavYltLfinhELq7V6u1ud02Dey9Z7wTjbQhkAbAm0ItCHQhkAbAm0ItCHQhkAb",
+	"Am0ItCHQhkAbAm0ItCHQhkAbAm0ItCHQhkAbAm0ItOHgIC4zZtYIM2x+M9s6SscrIE635+9nlwKQeEMb",
+	"8SXElxBfQnwJ8SXElxBfQnwJ8SXElxBfQnwJ8SXElxBfQnwJ8SXElxBfQnwJ8SXElzYrb0HNe7/5Hf9m",
+	"JjAoRqIao1+N72pbB79EDUS/diL6BY3r9tL2IClDer26ba2/eS3ocHlG5cXAMG/mVjVv+W5uZdaEp+ZZ",
+	"l0WpzyPTK9QpqQDR7Th9TFWuCov3qd5dVLxM9U41dsHb0h6oKvyStr4y914T61WqTKFdPC6Fx6XwuBQe",
+	"l8LjUnhcCukekO4BcDzA8QDHAxwPcDzA8QDHAxwPcDzA8QDHAxwPcDzA8QDHAxwPcDzA8QDHAxwPcDzA",
+	"8db4uFQxfN7tjaliKxp8oDpyb/HiVOS9u7DEAYgqhd+2CwgwEAisMCdtr0bF6brei3IYWNOzUYVmbOSu",
+	"I3rF+aUNJr+2QBZRZ6sFuPHFgDiVojX82xg1XW3Iexhlse/0LEYHsbd7JMNeZE8J3V15zd+diNPh37YQ",
+	"feBVC8AcAHMAzAEwB8AcAHMAzAEwB8AcAHMAzAEwB8AcAHMAzAEwB8AcAHMAzAEwB8AcAHMAzAEwh3WF",
+	"msuhLkt8Q+NTFy7xYV5lt8Jte/ZQRZziiQoEixAsQrAIwSIEixAsQrAIwSIEixAsQrAIwSIEixAsQrAI",
+	"wSIEixAsQrAIwSIEixAseoInKhziRDV58+N0w0mTVXEky1hW08MVLqEsUWc/qI4DhLIsunrKRyf2QIcq",
+	"BXiFxOM15clHmnykyUeafKTJR5p8pMkHfxz8cUCCAAkCJAiQIECCAAkCJAiQIECCAAkCJAiQIECCAAkC",
+	"JAiQIECCAAkCJAiQIECCAAlCmvzdSZOPLPnIkt8DrLL+tPjIio+s+FuUFR9J8ZEUH6AGgBoAagCoAaAG",
+	"gBoAagCoAaAGgBoAagCoAaAGgBoAagCoAaAGgBoAagCoAaAGgBoAagCoAUnxuybFR0585MRHrAixIsSK",
+	"ECtCrAixIsSKECtCrAixIsSKECtCrAixIsSKECtCrAixIsSKECtCrAixIuTER078Dc2Jj5T4SImPlPhL",
+	"Svg4p8uQ2GbGz8p7XwiL80g56s/LGnkhC2up8i+y/rZaBZEfHPnBe+cHZ8bSLE6pd/ZW3WDKwWVaxu8a",
+	"mD2WXZqM5Fj5MPOCzPHM70NF8IRPlce1LH3OW6poxehOWIwz/47IG5H8VlgNVIup0hmZj7zgkByO1PiF",
+	"WcZDC9xIm5Ek4IzjSAxx4Sckol4aUCIvMrxoGYZidOz/zM8zB3cf0Fm8ZLtRNphUdlwnHqwgP5AsxAPL",
+	"sbrluIpkvGHuP4yvHylhrvNXx1fRM+PtBlbx4I8XB9/5Bzd/fvr2s/5YA/ttHFihYtjK8W/YhpVTA+2+",
+	"cofi/tZ+fbIu1frUaAsr56ItmHOHObdUCVavTiXYbw4qkZBb5oA7LVAGVSosUdsCiemxX6LCAuVDLS7R",
+	"VWS/SNmGJpsztjTXBdSG1HbKiKIumoOlWfXSVKvXy6J6iap1CiZ+tVSxH8VjR9d+muMvaTAnKfXni5FH",
+	"HhZkQrPQxuWvZ//lkUU8mXmXH97kBTm0kUziaFpr4y6j4OGE1bSTr0HGpU0hmzvzXSU2wDGRI1x3+rjM",
+	"o+yWN053SJVPXfBb3Z3pzCm29KpV8WwwVr70ebHWMZxqONVwquHFwamGUw2nGk41nGo41XCqsTRwquFU",
+	"OznVJdeym3NdaqbRyW5Lx565yWMlv61usqhaHMYxErOnR8VJacvMXpr8dSVqdxloU6b2YjuDXPg452zv",
+	"INSi6nYLdUs27KKoVQrf8KndOw1kQxK/V6hNp8zvTmpjl/PdXeRPCd1peVeZ2StnZthk8JVdPrkM99/0",
+	"K0SmzfpoTJ/SQWp5zR2T2z3Lo1KvkEirgrQqSKuCtCpIq4K0KkirgrQqSKuCtCpIq4K0KkirgrQqSKuC",
+	"tCpIq4K0KkirgrQqSKuCtCpIq7KZuIaapBAVl/6bDXCoDDS1RbuaMqx0CHaJqkAlDJ+IpdNAnjJNyz6q",
+	"ZLU6rBRzJGxT28QtvLAbwezCrAN6GehloJeBzwR6GehloJeBXgZ6GehloJdhaUAvA73MMWeL6Vh2zNxi",
+	"NtKPWiYcK1dimTkE0Mr89MickjZSWWHa10Upsx9kE6HMbGWAqx13MpmrGIuK2yzGjVe2RfGqELfhSWTO",
+	"g9gQAllJSTrRxxyUxI485irip4TurHznLK6KWRmWNlbR4ZPLbd9tvSQq/QhjrpLK6+2UrO4ZWaxOCUEV",
+	"A1UMVDFQxUAVA1UMVDFQxUAVA1UMVDFQxUAVA1UMVDFQxUAVA1UMVDFQxUAVA1UMVLFNxCrUsFJKF/6b",
+	"DFqoCC/1ooi5hrdExT3HGgxMDXMexFPSwvZL/arEfwWYofs4+RjG/tT2Ae+sfFlff5c/aQyw37PWt1pP",
+	"3/H/8UNhdGuWuJoV5b8aIcU44Xdj3OFgjvIFId6M0kX66ujoNo5vQ3LoB4vDKbk7+ur4ay6VU0L9IKxn",
+	"PrGmfni0w4Q+xaDn/sPPJLqlM8GXMjCkf/n3P/7wD/71+uDvB+MXB98djjwTUcq7HF8/Oi2IiFHmdC7h",
+	"snGKV3zjBRElCUlp24d888Jm9kVfjpM/1ACdZvrV0fdffvH80+erZ38tTHr2TaA/gv64cvrj0Djw7Hjp",
+	"hgDXTyd1jhYOMRvstzoSW09EUUP9EWjv9EjNRRvOW03xugDeNuNqgnZra1wvWI4Gmjua21owRY2tFMxG",
+	"nyoTG11+hkdsW3S7IRhtTc47obOt5NwOl20trKeE7p6k5sBofR6GhWDrPT25PHbfcU15aDzHm/HW1hLI",
+	"K+yGDO4ZwrqkXIBWA1oNaDWg1YBWA1oNaDWg1YBWA1oNaDWg1YBWA1oNaDWg1YBWA1oNaDWg1YBWA1oN",
+	"aPVmAQNqUJ35Hf9mIgSK0aPGiFUjgto6YCVq7E98f2CUtEW3T4mL3mHdKQlyZ3QNv1J9E0c3wa0t9Fle",
+	"w054paW0ysq690ZrWoNCvzF6BBwacGjAoQGHBhwacOiVwaGNI6YbJLp4SqnzteJQs4FGVx2ZViemaEH/",
+	"AXDp9EifjzbIdNXUrws+bTvOJgh1Ye2bBdHB2HOHUXcWYtHC1gpxo+slJkXMyXpQ1c09bgiguiD5nUDV",
+	"TpJvB67uLMKnhO6m/OYw6Lq5GRZ8Xdfrk8txv727LC+tFkQzKLuz5PIGdkd29wyo3aiUAG0DtA3QNkDb",
+	"AG0DtA3QNkDbAG0DtA3QNkDbAG0DtA3QNkDbAG0DtA3QNkDbAG0DtA3Q9ubBE2rAp9V3/puLU6iKMLVG",
+	"uxoB3Z2DXaKFXUYalIEsw+O87Xp+Sqj3nmlcpZj3QQYxlyJ8PZnEy4jawsClP5pUQL9/1prToN8/G70A",
+	"+g3oN6DfgH4D+g3o98qg38YR0w36XTyl1LlacajZQL/1o9XqpBQ19R8A+U6P9Plog3zzKfdF2XVBvW3H",
+	"1wT1Lqx5s+A5GHTuUG9l21kJrKi1tQLb6HQVRWl4eHdzjxsC7y5Ieyd4t5O028G7ncT2lNDdlNkcXK3L",
+	"0vBQ7mJvTy6r/fbksny0WgLNEG4+P57vYArwmrsjpHuG3S6tN0DbAG0DtA3QNkDbAG0DtA3QNkDbAG0D",
+	"tA3QNkDbAG0DtA3QNkDbAG0DtA3QNkDbAG0DtL1m0LZtsKgGQlq47N9cqEFVTKk1sNWI1naPa4mqO4wY",
+	"8MwA/sD47JYunxKYvS9aVSnRfQE83AizRGNn5asVkP2jgMQWrW+13v0ooLTZtzNjWh9pIdYqf7JASTY0",
+	"rMFsXzaDbI9eFaC1keVnnYszMw1o7jemNd+UzuL7i4CS1O7D6ppWQ2TNjVPZnhzodRyHxI8aRpqQW2Zb",
+	"2Iz1nBd1G21F88Z4k6xN6xG/u7Qa7bul20gLzRqjjJeVI1wHIpaPpzsaNvscbT/TNpTWvSwi9D5OPh6R",
+	"aLqIA3uGSVa+vKedyJ+0Pe0kax3MEjBLwCwBswTMEjBLVnaOZsdLt3NUP53UOVo4xNzP0RKw3wYkrWq3",
+	"HqqnJBv1LoKj9XkYFhit9/TkoGi1pJ0A0Zo89BXiYCFv8YmtOXj23suqlIX37P1r8aNmEmZ/IzAKYRRu",
+	"j1FoQmu8f+bxrqtnX704/Orw68Pjo6//4+rZP7048f4ZBZPDWZzSQ7WpjIMpL8v+ePDV18f/8fKbv317",
+	"9eyfm29tDvnlMGNhxu69Gasdid0MWfNMVVZA8fTtYgZ0smZzo6DdJDgl+eh30aI152JYm9bs68mt2mxd",
+	"O5m1ulT0luiI0DCIPtpatap4WXx/Fb9o9uyvqmkYszBmccOJG06YhjANV2YaqtOlm12onU3qCDUPMOcD",
+	"tJM9KCu3naanRA14Fw1BbRKGtQK1jp7cBJTr2ckAzGWhr+wGE1vDbxan1Pv17E2F5fdTnNIomGiWn/wL",
+	"LD9Yftt7jTn3J2PmNPIbOt9/dX39ajJ5NZ2+IuTVzY2609vRm8wVfzwsVlise2+xqlOxm8Wqnanq1DcP",
+	"XqdTv5O1qmyANhPglKjR7qK5qs/CsPaq3tOTG6xyRTsZrLk09BTdlNzOiT0kU1bzVLVKP4uVuBAFzMtL",
+	"7QdYsrBkcYeJO0xYhLAIV3uHqR8yna8yCyeVditUPtxssoAXzk3LY1NUN39CMnB+1afNSFs68MLcrysj",
+	"uP0gm3KClxa/TRTdzD731ODdJFlU32JJbnRlKiRs+EThrZ1uSK7wkh50yhburAcj23BRB2kWEYOdFGUj",
+	"tlOcmsGDScUONyGo1GsTr5IUC2OiOZF4N6HltXdJbPcsnXidPiKhOBKKI6E4EoojoTgSiiOhOBKKI6E4",
+	"EoojoTgSiiOhOBKKI6E4EoojoTgSiiOhOBKKI6E4EoojofgmghRqkh+XLvw3Ga1QHV2yCHU1phbvFukS",
+	"1fcLbDBwivHWTp8yyfh+aVqNdPfDBeXehSUQPK9QVsrsjlNDf+f3ntutiQC9AvS6aaDXXLe64V0N3VT7",
+	"SFGJHbaQTlSoPBjeup+cknzIu4i8MqZiWMyV0dWTo62yVe0EtNJlYtVSfDTxowkJ2QfUIL35756vXZkd",
+	"euKPobzfy6+pxH1oym/KuaMUxfIi7TBHDchoox8mxJ8+ev6EClCQL+83EyJub9r1RQxjB1VGm1Fxzon5",
+	"Hs4Mbuxwm9WnLCLdNSg9EH7BwSIOg0lgnd/33YUKkr+XFStE+0IU4SUe+eFl/AWsQrAKwSoEqxAOFhys",
+	"FTpYxhnT0ckqnlPZAWv+0E4mjIon5WPbOSlqbvFJOdDFYWFGaviD+Wxzg+ZxXQTC9tE1EQft5c3VnHPn",
+	"DLrKq6i4S5ad7kQUZ2M9dMH2XjeEL9h3s60Rnobt1uZ6ylWETwndVfnVbo/WkGg83owE41034yo5aDr5",
+	"G5l/7ic/r7hLgrhnpL+q9QbrD6w/sP7A+gPrD6w/sP7A+gPrD6w/sP7A+gPrD6w/sP7A+gPrD6w/sP7A",
+	"+gPrD6w/sP7WzPprjxPVcJDKN/2biC2ojic1BbSa+H3u8Szx/3uGDBiY29fe61OS+3ZcoWoEujdYJ1lG",
+	"Q+Cuz5eRDmY7X0ZAXANxDcQ1ENdAXANxvXrE9fky6ge3FidU8Thlf3U7S/uAXs+XUeOJaiIWt/5MNQhz",
+	"qbquS5bReoCuLV1uGMq1k4BXCUy1iLuCW9tEVYMz7pSc7jWmla1kF0Brn711LHR0LCgVa2SIHsNhgcMC",
+	"hwUOCxwWOCygiDZRRI/BEQVH1O7a2TTnnoYhegyKKCii20wRPYY/DY7oRnBEj0ESBUkUJFGQREESBUkU",
+	"JFGQREESBUkUJFGQREESBUkUJFGQREESBUkUJFGQREESBUkUJFGQREESBadto0mix2CJgiUKlmgBrpMs",
+	"o7WQRAG6BugaoGuArgG6Buh611ii2lm6VpLoMViiYIluA0sUsFbQRDturkvrHDaXVf7IUn+AfpnCCYET",
+	"AicETgicEDghK3RClmlH54OfSNm5uMwf2Lagd142HHeS3LcEfTM9erdsegydUzcv10bWXHZ4mD1fyypJ",
+	"abWfnF3SFtmSTsVyh8Ipl2tiV15urpu57OxdLmu3MCuXsknUmAux3EXfUXz1sL6j6OPpfccOW55a95qT",
+	"sY392CRSIjK9BLtxm9iNl+Azgs8IPiP4jOAzgs8IPiP4jOAzgs8IPiP4jOAzgs8IPiP4jOAzgs8IPiP4",
+	"jOAzgs8IPuNT8Bkbozx1zKvLzYu25/GhmuBTC1GxKfYkOVy7GDkfmnp4uWFkw10Rd00k3cEl0j1LbCG6",
+	"WfmyjryXP2lw3fdZ6wDtArQL0C5AuwDtArS7MtBudrx0g+7qp5M6OQuHmAWMNzsSW09EUUP9Ebje9EjN",
+	"RRu6V03xujC+NuNqQvpqa1wvWJaGmTsd1VogRY2tFMhGlyYTF11uhscGW3S7IVBhTb47AYat5NsOPGwt",
+	"rKeE7p6k5lBffR6GBRXrPT25PHbfaU15aDy/G8HG9hLIK+yGDO4Z+rikXMAgA4MMDDIwyMAgA4MMDDIw",
+	"yMAgA4MMDDIwyMAgA4MMDDIwyMAgA4MMDDIwyMAgA4MMDPJmAQJqoJn5Hf9mIgOK0aPGiFUTQtk+YCXf",
+	"k9jd+P5agMv1vT0lfHmHNaUktl0xNAm5ZRaUJbRZli5r1Tn/QYM1n8t2AWoGqBmgZoCaAWreI1BzGYUS",
+	"+jxqlAa3TDnkHTWNqR9qK5gGlIgFE+dMjoTI4mZ1k5PO4vsPrLmLgJLUbpJWOig1KWwgY97GOJVDkdNz",
+	"Hcch8aM1YL7l2dsN8Z0f3MqkMM52G7S3mKgWI0GUFn8Czjs9EjPRhvKWMrgmjHf7mJoQ3tna1gmSlWV6",
+	"9En8z9n085HQKDtTlZctyyDbHzQzVW0Xu2CkStk4e8tthllAEj+ZzIKJH4oLi3S5WMQJrXMBxSQ7DL/K",
+	"7LEfQ+4YZh3DHoc9Dnsc9jjs8aHt8Uwt7gJyn0d6C9i0dMQD8TpWKyEGJFmFtmumiLVuTFCzsSF6/Y1V",
+	"ahpzDnk0MGuV4zci2GdvObpXhM4z4/0q8n5Z0qUfho8eeZiEyzS4IyI+7sv54eg/OvMj7/zk4t3l+ZuT",
+	"8W9nJ7+PL3+9eH/y5uzHs5O3bTvHy7/ZbW3sg4CwB8IeCHsg7IGwB8IeCHsg7IGwB8IeCHsg7IGwB8Ie",
+	"CHsg7IGwB8IeCHsg7IGwB8IeCPsBgTQcHdANRqOABQr7oOEObCA0aUBJI3hBlGR/AHphM9ELA+GE2JK3",
+	"oYQ4Zm1NGKG28TQhhKT4VutIN3SQeyrIVlUTJaFqWwQU0rkpXBmURgyf4bKhuw3JbCk1tlNWy0aNtctm",
+	"2apvp4RC2bZU2fIUmmqZh03UqXrplhwRMBjAYACDAQwGMBjAYACDAQwGMBjAYACDAQwGMBjAYACDAQwG",
+	"MBjAYACDAQwGMBjAYACDAQxmD2Ew3cL7eQivFv/S/OBYa5CQF0SYcIvChHv2npoR/8RbaghxIcSFEBdC",
+	"XAhxIcSFEBdCXAhxIcSFEBdCXAhxIcSFEBdCXAhxIcSFEBdCXAhxIcS1OWTamtehxN3+5rFq9aBYbeCt",
+	"8d201ribKInA246QYQd+F66hu6d8GG7H1N7QyZ5kejfuvNVLL6L0Fr700sJ05TqbP8gyPLW8pcsNoZdn",
+	"j8Z0Ipi3PhpjRzK3EsxTQndPKhUzOp+BYRnYeT9PLn9dnyvS5aDh0atmxJKVxPHCuyBzewbmKSgT4DyA",
+	"8wDOAzgP4DyA8wDOAzgP4DyA8wDOAzgP4DyA8wDOAzgP4DyA8wDOAzgP4DyA8wDOs764fnsAqCayr+73",
+	"P29gdMqMGTXEpxqBPVbhKVF6HyL1A+NeWrp8SuzLTupIQXDrlKQNBTOPKTnweQdHKUlTZkCxITcgDsTl",
+	"7o0nKnuisqcqV+kbK/eaF5MD+zlIqf5n/m7MLkDruEWsmclqrpRzacT74oRfXHFvoC2L+PHXNlnEeVM/",
+	"PD5zw+KtcdBz/+FnEt3S2bNXX7148YJDDChJ2Mj+8Zd//+MP/+Bfrw/+fjB+cfDd4cj783/9RVsT3uX4",
+	"+tFpQUQAUVym8COd+1MBJXP2hUFESUJS2vYh37ywy+HO+nKc/KEG6DTTr46+//KL558+Xz37a2HSs29q",
+	"nfK35CaI5D2AP4+XEZch9iGpPA5kUFLcDmfO5sK/5fdfb+VNWXzjff2ibopZ4YvgX8Ruklc+pLn/EMyX",
+	"cz6lo2fzIJL/0jYO/5aMUzFCOWVsDW9JUokpiabkIb/WSFIqVj6ThkPvw4y/XBDG96mXfgwWC6Z9/BNq",
+	"N4Gbm5RQuxnqPQB9Row5eaEprhpQaUKGfs3MPGO6PWtWOqfyo7Z0rtm8c1Z5bKqwkLq9tTpFRZP6D1t1",
+	"ig5m7eXz0fZqWPVarOkZMduBNj0nVlj8ZtHsYgtaA6TPyV38sVa+reRZwWG3VJ5bPLIaSeMRAjZ1A3pm",
+	"Vl1vDJba0IqOiGoHrbBFV3eVag613U2RzgHRNZMzNA67ptsNgGX32drLEtNrX49DcnAdRNMgurV27eOQ",
+	"eKpOhZTHIflB/Ko79Plf4c7DnYc7D3ce7jzc+VW689oJ09GZN8+o7FwtHWhWnrx2StocktJly/8Odz09",
+	"0qaj1VvX5nttTrrd8Bp9dGPBG0XOwZLrwFx2FFfpS22puDa7LgVJWgORubHHTfG9DWHv5nrbC7ul4+0o",
+	"tcx52kmRzd3ewowM7GQXent637rPjlySjjYLoBlh4iibMma/Jzvq0ICTxh6fFG9iJ6F1oBNDqDbWtqmS",
+	"5R62DRvTdBkS2xuqrLz3hfDyjhTX43lFMidZWLusUn9Kj7dbB+Gmw013ddNLE8Kcq1mcUu/srWLBqWxW",
+	"Sk04XyWKaU68Gcmx8mHmBa8i74ucUycIuHyqPK5l6XPeUkUrRncCdTzz74hk1eTMQjVQjZdPZ2Q+8oJD",
+	"cjhS4xfQXn40cKDvjCQB5WkuxBAXfkIiKhJ1CTKMFy3DUIyO/Z/5eebg7gM6i5dsO8oGk8qO68SDFeRn",
+	"kIV4YDlWtxxXkeSszv2H8fUjJan3Pc+l8My4QmUVD/54cfCdf3Dz56dvP+t3puy3cWCVXeWDSgC3DSun",
+	"Btp95Q4FB9B+fbIu1frUaAsr56ItmHOHObdUCVavTiXYbw4qkSd7tF+gLN1NYYnaFkhMj/0SFRYoH2px",
+	"ia4i+0XKNjTZnLGluS6gNqS2U0bLkWmpOViaVS9NtXq9LKqXqFqnYOJXSxX7UcQcr/00z+NFgzlJqT9f",
+	"jDzysCATmtFjL389+y+PLOLJzLv88CYvyNNjkUkcTWtt3GUUPJywmnbyNci4tClkc2eGN9kAx0SOcJ2X",
+	"UoZL2S00lVXXM5qajqu9F515w9ZcDlE8G4SVE31erAVfGr40fGk4b/Cl4UvDl4YvDV8avjR8aSwNfGn4",
+	"0o7EzYJn2ZW7WXZQ631rC+qmaG6s5LfVS1YsPXMYwH2mR8U5aadqFuZ+fTRN+4E2UzVLQrC6a54u79i4",
+	"yrLi1m2xLLcg7IoSVilz63j0psNANoa+WdKWjhROB22xpXC6Sjwn4u2uuOc8yoqJGZq6WdHlBtA2+271",
+	"1RLTaHK0vbHjKrQyedouie3ePb1Tp494hQev8OAVHrzCg1d48AoPXuHBKzx4hQev8OAVHrzCg1d48AoP",
+	"XuHBKzx4hQev8OAVHrzCg1d48AoPXuHZTDBD7VsjpUv/zUY11MWZGoNdLQ/2uMa61CMogCQM/rpPh4E8",
+	"7Zs/+6eRtdrQH2ckTFLb3Cy8sBuV7MKsAyIZiGQgkoG5BCIZiGQgkoFIBiIZiGQgkmFpQCQDkcwtKYvp",
+	"V3ZMzVJyTnuQyIRj5UohM4cAAll6ZM5IG32sMOvrIo/ZD7KJOlZa/FVd6LjTxlylV1TcYultvJ8tSlWF",
+	"lA1PF3MexIZQxUq60Yko5qAbdjQxVwk/JXRXxTvna1VMyrAEsYoOn1xs+27mVZLSgxrmKqi83i6J6p7R",
+	"wup0EKQwkMJACgMpDKQwkMJACgMpDKQwkMJACgMpDKQwkMJACgMpDKQwkMJACgMpDKQwkMJACttEgEIN",
+	"AaV04b/JSIXq6FJ3MphrdEtU3G+gwcAkMOdBPCUBbL+0r0b6O+OEAmr/EjcrW6GeATX5XXTr39p+x//H",
+	"D4VxrVncaiqUn2qEDuOE34Fxx4I5xBeEeDNKF+mro6PbOL4NyaEfLA6n5O7oq+OvufhNCfWDsJ7VxJr6",
+	"4dEO7/kUg577Dz+T6JbOBBfKwIf+5d//+MM/+Nfrg78fjF8cfHc48ky0KO9yfP3otCAiFplTtYRrxulb",
+	"8Y0XRJQkJKVtH/LNC5vZF305Tv5QA3Sa6VdH33/5xfNPn6+e/bUw6dk3gdoIauPw1MZMLXKoPpPrWUAS",
+	"P5nMgokfCtM9XS4WcUJXQqzo0KkJ/LeD82Td3AXkPr+bK0QTmS8jKQwqupYQA0SiLiNrvp21bnx3M7Vc",
+	"9Pobq9Q05jxIbUQZK8dv3DmeveV4DHHZqUoz5fplSZd+GD565GESLtPgjogbTV/OD4/X0pkfeecnF+8u",
+	"z9+cjH87O/l9fPnrxfuTN2c/np28bdsVX/7NbttmHwRMFDBRwEQBEwVMFDBRwEQBEwVMFDBRwEQBEwVM",
+	"FDBRwEQBEwVMFDBRwEQBEwVMFDBRwEQNmpeHds/GQ80cPDnSwC7/DiWNcAWVc4WSXcErbH+YbTCQELXI",
+	"HETXmC6I9kgSREmtVljAfbrkAmrRJJWhZes1yUTB0Vwo1pHdp7a7bvkmdm1XGDhHEe2RmahBIW1zEbVo",
+	"GE8ps1PqpacAomtJNEQJlAlIFiBZgGQBkgVIFiBZgGQBkgVIFiBZgGQBkgVIFiBZgGQBkgVIFiBZgGQB",
+	"kgVIFiBZgGQBkgVIlh7xehm0a4KwtL380BIWlMl4tj4wuHcvPFA864DADwI/CPwg8IPADwI/CPwg8IPA",
+	"DwI/CPwg8IPADwI/CPwg8IPADwI/CPwg8IPADwI/jYEf8GFXHs+qTZW/mcTYLAzWFGpreYWiJdKmEu/v",
+	"NMV18HclarsDxRWK7vD8RWcGPCXs7KPJ49FtEi8X6VEY39o+gJHVHbNKY9FAedP4oIr9HN+mp6yQ9jxG",
+	"+cdtfywDDwXgoYDVPRSAF1ee5MWVobMAVWx73XICVTSknQS1W69NvqCq7d1hdxftlH/eru19IDOkPC1t",
+	"2XiqVmNd2XncRtuUq6fckqW0drBi3PP69JR40c62S3yjg1QthTWiOXyOoB7DsX+ib9A8O5Wq1SnrTmfV",
+	"ssvI01M3TgndacXI8+rUTdSw2Xzqen1yMV/F2VEpPMMcHOwfwcTZA/5FVDttc4H1clVesNEOHGE4wnCE",
+	"4QjvhSNs7Hw9fWGjrapzomIbdvOI5THRagZVdFRwhPQScI11g0GfGXvv2FiZtTvItmO28pH1xuyluJvB",
+	"08dZ7qEMBddle5XB0msuSGe91K7Td+44qE3zoAu618+J7qZ7rq50D83RfaIdVZsq77Y0Y+tyq0sdb45n",
+	"3e/QqROk1Z84YXwrDp2njS/Dq4ZXDa8aXjXCy7sYXoYLjejyYEGCzILZmNgyQssILSO0vMt6gcjylkeW",
+	"xc1BN893kLgyHGA4wHCA4QAjrLwfYWW4xIgqryuqrBs7mxdTRkgZIWWElF1DyogoI6K8mRHlhUhZ0421",
+	"zFx6mfOmLaosi9XFlVUrcKzhWMOxhmP9hI51aUHOuRTI9KuGkeap3VNmgE6I56dpcMuUiWfpY6VFztIs",
+	"02MwJRENbgKS1E28KmqbPWSw8V1FMoXs3H8YXz9Sknrf89Tmz4y5ZlUP/nhx8J1/cPPnp28/6/Osmh0H",
+	"07VNdRpQcva2bnb5r+ucWTkcy8lkpesmk/22zomUuWVqp9Ityc1qh1Q9nS+L0ynK102o+NVySs+iKbMJ",
+	"Cc96yrY13RTNPyF7AsIjD1SkgxbfoIpeRXnhhBVIyNTjmRZVvp9HeeLchP5tlveZ52bzwzi6Fe3FEU/G",
+	"KV7+OJuO1NRMvTjx8i2Epy5tLiLTRUYx9VJCZcJV1bdIsT2t1aZZfH8WzUgSUDK1Pm/3ex4zdZ7F9+NA",
+	"mzwpgtdxHBI/WjfISZngK4A5adZ8ZRjQdAY6QJ3kwjv5HBUAF1kAt7uFULCcF0fIk1yUJwE9WYzYGvaU",
+	"S4Wd8HZytXvDn7qoQAUWZRtVwAUElQllrayuHQjlOqRNBEPl+tYfDuWqb50gUV3UpQht2TldqYUoabO1",
+	"VmiU1u9mgaM6ny/1MmRtGTU/6LgCOedN7Z6k79kLkC0qjDch8SYk3oTEm5B4ExJvQuJNSLwJiTch8SYk",
+	"3oTEm5B4ExJvQuJNSLwJiTch8SYk3oTEm5B4ExJvQuJNyM0FXtQ8r1Z3+7/5CIym6JN1jKzxJcYVhMhE",
+	"W0BOFGEKAz/v2HNI9jFk6PXK9bpRZQZCVvV7e8KCymSWbMgTAkITCE0gNIHQBEITCE0gNIHQBEITCE0g",
+	"NK07adWKOE1lm74uC0MfZpPKZtF+P1HZWXWSIlCcanNzOLOcCgv0VEmsVsV1KkmItVB39ctXkc6qo3ZU",
+	"ZxLa4Tu8krA2ifFTJLbqMLANTW61KjJUd4XsmuKqozJVpCfaC25UxbStO9XVRjKk+h5MjfLkYmrZUqV6",
+	"yr8ZstolDdhbzlSdaoM2BdoUaFOgTYE2BdoUaFOgTYE2BdoUaFOgTYE2BdoUaFOgTYE2BdoUaFOgTYE2",
+	"BdoUaFMbDeZoZViUYgBbgepoCUa5hM8sWVQ9o2cFYgigGE9GquoxsI2gVu297rep0ooQXfcqrl6JMvmd",
+	"R1O5/8gvOdOi05T9QTbKPNc3sigzlVNm2syFaUVn5NGb8eu/Eft3RO7DIOIooGAesCXkJj9zFIMbDSnB",
+	"PLmryLt69noyIQv6igdnjgizrQ9E+1fPeIPsm0lycMFcyBP2c3rovfZmxE/oNTOwgtSTTvxE3Jjyj+ce",
+	"Q0r96zBIZ4TfsfObovsZicgdSbwoVle8YvC5jGlNR5Qkd35Y3jD5FMrV4P+vRKGVP/Yhn1ZhhHFHg/V7",
+	"ef4zP6IPV7lN2nVnElk4gSj7d+e99T+DaFohWjQWKzTi1yXst4+8IBMs/gOzw248Ml/Qx7qoPq9hB/Ze",
+	"1Sgomadt24QhCaxjtinN/YczUfllPpXqA+Qk+knis89bRsF/L4kszha2iZ0lQs7i+m1CgjuSExua9JoL",
+	"NUnpyAuoupGQkWQeO4sjMRfyCJGTcehx7Bu/jeD0CHGTI3uetnGnvnlhQ/gSX+TI99ruaXCikL06+v7L",
+	"L55/+nz17K8FNlk2c61aeUEmMRP0a0LvCYny3U5uo/c8mlHcHA91GuZxLTM0a0z2YreUKxtSxoM8NliQ",
+	"mt5lTY/TbIQlQuSQEDzzsHBHoJYOG2Ux6CdSu30QJx/D2J+O52R+zc8pK/51sVrFuShL/MILaHRr84ct",
+	"Z1mDILthBNnKw4BfjPCzgLOptT1/Q06rVQ5w7ecIMg0g04BrpoGh2YSFQ6YbibB8UmVHbNXhZkMZLJyb",
+	"lsemqG7+BFpgelSYkRoWYGHO18X9ax9cE9WvtNhtoudm5rnT+bpJrqi+xZLbeE9clKwKSRuenuc8iA2h",
+	"4plC0ZWB56wndny7btJ+Suiuirp2XVuemmGZdBUdPrkId93cqyRkNTu7q+fe4LJXOOvpMfx0+Onw0+Gn",
+	"w0+Hn756P72nh17lm3fwyluPRNM3O4YPnltCbbl41Byv2/3ummtH1W+ULEvTrLu33SqRphd0vHu+tSE4",
+	"6/OmG7rdMP+5r+fcsnW6eMut0qq5P8e77BuvzyneKG+4U/oYSzlsSRBjLYK8wo4I4Z6lfClpFzK8IMML",
+	"MrwgwwsyvCDDCzK8IMMLMrwgwwsyvCDDCzK8IMMLMrwgwwsyvCDDCzK8IMMLMrwgwwsyvGwWJqAmrUN+",
+	"x7+Z4AAjfNQWsmpMymIdsRI19ijEP3ASFYtunzJFyg4rjynJzciaz9mv5fckb4M4OswlQvxB1a0gugeU",
+	"aMXZP+sLv7vUir5b1hf8WXohWnH1p/pKP8Up1Sqwf9YXPpNes1ZB/alh/GIriW4vHlNK5vrHmL/UN/Fe",
+	"ONqJVlf9qb7S79p2JisVV7W+0i8Zsr9Q1cTCVyztZEamS/nwt1pf+bf6WsbT5adJvFxo1cs/WjQkk/PU",
+	"tqX/bjmu93mKrqqRFfIAtY+tob3q1ELVIh++nkziZUQLYq/+XF/5DY+DvImjm+BWq6z/uUGqL1QU4X0c",
+	"BpNHXawvxC/ih8a658uoouL5MuK1lOjp6RMqlPK991q4TLpavpd/a9Z879ezNwXtj4JJfZ1fCWVbundB",
+	"bpnVqVWVv8gf6ls4iaaLODCqqj81dhsG0UezO/aX+irnZM7Mcp/v+t4XCbkjSUq8i4ufnnspSVN1Qar2",
+	"r9TYvlnd17xqk+xFtwfJMor4XYIWJKJ+QvOLFfKwIFEa3BFv4odhOvLSBlD+y6/K22PT7i1NoIN0Ei/I",
+	"VMb9P6qMUf6SxnMZJ/B5KivlkPoJv1nhJflzr6m4IybC2/9/v39gPojeXsFXobE39yPmoOVltKG/fn/2",
+	"gf2tYX3ikHjXQTQNotvUu038iIcnxK1kEE2ChR+m3iwOp+rP8hsTVlEuK7/8vRX3cGlAifSLL43FjEPy",
+	"g+gnG82fn/9/AAAA//8hvfUUidYMAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
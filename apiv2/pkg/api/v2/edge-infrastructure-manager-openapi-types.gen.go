@@ -4,7 +4,18 @@
 package api
 
 import (
+	"encoding/json"
+	"fmt"
 	"time"
+
+	"github.com/oapi-codegen/runtime"
+)
+
+// Defines values for APITokenRole.
+const (
+	APITOKENROLEREAD        APITokenRole = "API_TOKEN_ROLE_READ"
+	APITOKENROLEREADWRITE   APITokenRole = "API_TOKEN_ROLE_READ_WRITE"
+	APITOKENROLEUNSPECIFIED APITokenRole = "API_TOKEN_ROLE_UNSPECIFIED"
 )
 
 // Defines values for AmtControlMode.
@@ -38,6 +49,16 @@ const (
 	BAREMETALCONTROLLERKINDVPRO        BaremetalControllerKind = "BAREMETAL_CONTROLLER_KIND_VPRO"
 )
 
+// Defines values for HostBulkAction.
+const (
+	HOSTBULKACTIONAMT         HostBulkAction = "HOST_BULK_ACTION_AMT"
+	HOSTBULKACTIONDELETE      HostBulkAction = "HOST_BULK_ACTION_DELETE"
+	HOSTBULKACTIONINVALIDATE  HostBulkAction = "HOST_BULK_ACTION_INVALIDATE"
+	HOSTBULKACTIONONBOARD     HostBulkAction = "HOST_BULK_ACTION_ONBOARD"
+	HOSTBULKACTIONPOWER       HostBulkAction = "HOST_BULK_ACTION_POWER"
+	HOSTBULKACTIONUNSPECIFIED HostBulkAction = "HOST_BULK_ACTION_UNSPECIFIED"
+)
+
 // Defines values for HostComponentState.
 const (
 	HOSTCOMPONENTSTATEDELETED     HostComponentState = "HOST_COMPONENT_STATE_DELETED"
@@ -55,6 +76,21 @@ const (
 	HOSTSTATEUNTRUSTED   HostState = "HOST_STATE_UNTRUSTED"
 )
 
+// Defines values for HostsStatisticsDimension.
+const (
+	HOSTSSTATISTICSDIMENSIONAMTSTATE           HostsStatisticsDimension = "HOSTS_STATISTICS_DIMENSION_AMT_STATE"
+	HOSTSSTATISTICSDIMENSIONHOSTSTATUS         HostsStatisticsDimension = "HOSTS_STATISTICS_DIMENSION_HOST_STATUS"
+	HOSTSSTATISTICSDIMENSIONINSTANCEOS         HostsStatisticsDimension = "HOSTS_STATISTICS_DIMENSION_INSTANCE_OS"
+	HOSTSSTATISTICSDIMENSIONONBOARDINGSTATUS   HostsStatisticsDimension = "HOSTS_STATISTICS_DIMENSION_ONBOARDING_STATUS"
+	HOSTSSTATISTICSDIMENSIONOSPROFILE          HostsStatisticsDimension = "HOSTS_STATISTICS_DIMENSION_OS_PROFILE"
+	HOSTSSTATISTICSDIMENSIONPOWERSTATE         HostsStatisticsDimension = "HOSTS_STATISTICS_DIMENSION_POWER_STATE"
+	HOSTSSTATISTICSDIMENSIONPROVISIONINGSTATUS HostsStatisticsDimension = "HOSTS_STATISTICS_DIMENSION_PROVISIONING_STATUS"
+	HOSTSSTATISTICSDIMENSIONREGION             HostsStatisticsDimension = "HOSTS_STATISTICS_DIMENSION_REGION"
+	HOSTSSTATISTICSDIMENSIONSITE               HostsStatisticsDimension = "HOSTS_STATISTICS_DIMENSION_SITE"
+	HOSTSSTATISTICSDIMENSIONUNSPECIFIED        HostsStatisticsDimension = "HOSTS_STATISTICS_DIMENSION_UNSPECIFIED"
+	HOSTSSTATISTICSDIMENSIONUPDATEAVAILABLE    HostsStatisticsDimension = "HOSTS_STATISTICS_DIMENSION_UPDATE_AVAILABLE"
+)
+
 // Defines values for IPAddressConfigMethod.
 const (
 	IPADDRESSCONFIGMETHODDYNAMIC     IPAddressConfigMethod = "IP_ADDRESS_CONFIG_METHOD_DYNAMIC"
@@ -83,6 +119,13 @@ const (
 	IPADDRESSSTATUSUNSPECIFIED        IPAddressStatus = "IP_ADDRESS_STATUS_UNSPECIFIED"
 )
 
+// Defines values for InstanceBulkAction.
+const (
+	INSTANCEBULKACTIONDELETE      InstanceBulkAction = "INSTANCE_BULK_ACTION_DELETE"
+	INSTANCEBULKACTIONINVALIDATE  InstanceBulkAction = "INSTANCE_BULK_ACTION_INVALIDATE"
+	INSTANCEBULKACTIONUNSPECIFIED InstanceBulkAction = "INSTANCE_BULK_ACTION_UNSPECIFIED"
+)
+
 // Defines values for InstanceKind.
 const (
 	INSTANCEKINDMETAL       InstanceKind = "INSTANCE_KIND_METAL"
@@ -124,11 +167,31 @@ const (
 
 // Defines values for ListLocationsResponseResourceKind.
 const (
+	RESOURCEKINDOU          ListLocationsResponseResourceKind = "RESOURCE_KIND_OU"
 	RESOURCEKINDREGION      ListLocationsResponseResourceKind = "RESOURCE_KIND_REGION"
 	RESOURCEKINDSITE        ListLocationsResponseResourceKind = "RESOURCE_KIND_SITE"
 	RESOURCEKINDUNSPECIFIED ListLocationsResponseResourceKind = "RESOURCE_KIND_UNSPECIFIED"
 )
 
+// Defines values for NetlinkState.
+const (
+	NETLINKSTATEDELETED     NetlinkState = "NETLINK_STATE_DELETED"
+	NETLINKSTATEERROR       NetlinkState = "NETLINK_STATE_ERROR"
+	NETLINKSTATEOFFLINE     NetlinkState = "NETLINK_STATE_OFFLINE"
+	NETLINKSTATEONLINE      NetlinkState = "NETLINK_STATE_ONLINE"
+	NETLINKSTATEUNSPECIFIED NetlinkState = "NETLINK_STATE_UNSPECIFIED"
+)
+
+// Defines values for OperationState.
+const (
+	OPERATIONSTATECANCELLED   OperationState = "OPERATION_STATE_CANCELLED"
+	OPERATIONSTATEFAILED      OperationState = "OPERATION_STATE_FAILED"
+	OPERATIONSTATEPENDING     OperationState = "OPERATION_STATE_PENDING"
+	OPERATIONSTATERUNNING     OperationState = "OPERATION_STATE_RUNNING"
+	OPERATIONSTATESUCCEEDED   OperationState = "OPERATION_STATE_SUCCEEDED"
+	OPERATIONSTATEUNSPECIFIED OperationState = "OPERATION_STATE_UNSPECIFIED"
+)
+
 // Defines values for OsProviderKind.
 const (
 	OSPROVIDERKINDINFRA       OsProviderKind = "OS_PROVIDER_KIND_INFRA"
@@ -175,6 +238,28 @@ const (
 	PROVIDERVENDORUNSPECIFIED ProviderVendor = "PROVIDER_VENDOR_UNSPECIFIED"
 )
 
+// Defines values for RemoteAccessState.
+const (
+	REMOTEACCESSSTATEDELETED     RemoteAccessState = "REMOTE_ACCESS_STATE_DELETED"
+	REMOTEACCESSSTATEENABLED     RemoteAccessState = "REMOTE_ACCESS_STATE_ENABLED"
+	REMOTEACCESSSTATEERROR       RemoteAccessState = "REMOTE_ACCESS_STATE_ERROR"
+	REMOTEACCESSSTATEUNSPECIFIED RemoteAccessState = "REMOTE_ACCESS_STATE_UNSPECIFIED"
+)
+
+// Defines values for ResourceView.
+const (
+	RESOURCEVIEWBASIC       ResourceView = "RESOURCE_VIEW_BASIC"
+	RESOURCEVIEWFULL        ResourceView = "RESOURCE_VIEW_FULL"
+	RESOURCEVIEWUNSPECIFIED ResourceView = "RESOURCE_VIEW_UNSPECIFIED"
+)
+
+// Defines values for RoleBindingRole.
+const (
+	ROLEBINDINGROLEREAD        RoleBindingRole = "ROLE_BINDING_ROLE_READ"
+	ROLEBINDINGROLEREADWRITE   RoleBindingRole = "ROLE_BINDING_ROLE_READ_WRITE"
+	ROLEBINDINGROLEUNSPECIFIED RoleBindingRole = "ROLE_BINDING_ROLE_UNSPECIFIED"
+)
+
 // Defines values for ScheduleStatus.
 const (
 	SCHEDULESTATUSMAINTENANCE ScheduleStatus = "SCHEDULE_STATUS_MAINTENANCE"
@@ -246,6 +331,24 @@ const (
 	UPDATEPOLICYUNSPECIFIED UpdatePolicy = "UPDATE_POLICY_UNSPECIFIED"
 )
 
+// Defines values for WatchResourceKind.
+const (
+	WATCHRESOURCEKINDHOST             WatchResourceKind = "WATCH_RESOURCE_KIND_HOST"
+	WATCHRESOURCEKINDINSTANCE         WatchResourceKind = "WATCH_RESOURCE_KIND_INSTANCE"
+	WATCHRESOURCEKINDOSUPDATERUN      WatchResourceKind = "WATCH_RESOURCE_KIND_OS_UPDATE_RUN"
+	WATCHRESOURCEKINDREPEATEDSCHEDULE WatchResourceKind = "WATCH_RESOURCE_KIND_REPEATED_SCHEDULE"
+	WATCHRESOURCEKINDSINGLESCHEDULE   WatchResourceKind = "WATCH_RESOURCE_KIND_SINGLE_SCHEDULE"
+	WATCHRESOURCEKINDUNSPECIFIED      WatchResourceKind = "WATCH_RESOURCE_KIND_UNSPECIFIED"
+)
+
+// Defines values for WatchResourcesResponseEventKind.
+const (
+	EVENTKINDCREATED     WatchResourcesResponseEventKind = "EVENT_KIND_CREATED"
+	EVENTKINDDELETED     WatchResourcesResponseEventKind = "EVENT_KIND_DELETED"
+	EVENTKINDUNSPECIFIED WatchResourcesResponseEventKind = "EVENT_KIND_UNSPECIFIED"
+	EVENTKINDUPDATED     WatchResourcesResponseEventKind = "EVENT_KIND_UPDATED"
+)
+
 // Defines values for WorkloadKind.
 const (
 	WORKLOADKINDCLUSTER     WorkloadKind = "WORKLOAD_KIND_CLUSTER"
@@ -267,6 +370,37 @@ const (
 	WORKLOADSTATEUNSPECIFIED WorkloadState = "WORKLOAD_STATE_UNSPECIFIED"
 )
 
+// APITokenResource A project-scoped API token, for automation calling the API without a user JWT.
+//
+//	The token is sent as a bearer token, it is only returned when created and stored hashed.
+type APITokenResource struct {
+	// DurationSeconds Validity of the token in seconds, between 1 hour and 1 year.
+	//  Used to compute the expiration_timestamp when the token is created.
+	DurationSeconds *int `json:"durationSeconds,omitempty"`
+
+	// ExpirationTimestamp UTC timestamp (seconds) after which the token is rejected.
+	ExpirationTimestamp *int `json:"expirationTimestamp,omitempty"`
+
+	// Name The name of the token, recorded as the actor in audit logs.
+	Name string `json:"name"`
+
+	// ResourceId resource identifier, empty when the token is created
+	ResourceId *string `json:"resourceId,omitempty"`
+
+	// Role The role granted to an API token in its project.
+	Role APITokenRole `json:"role"`
+
+	// SiteId (OPTIONAL) The site the token is restricted to, if set: only the site and its hosts can be accessed.
+	SiteId     *string     `json:"siteId,omitempty"`
+	Timestamps *Timestamps `json:"timestamps,omitempty"`
+
+	// Token The secret token, only returned when created.
+	Token *string `json:"token,omitempty"`
+}
+
+// APITokenRole The role granted to an API token in its project.
+type APITokenRole string
+
 // AmtControlMode defines model for AmtControlMode.
 type AmtControlMode string
 
@@ -279,6 +413,96 @@ type AmtState string
 // BaremetalControllerKind The type of BMC.
 type BaremetalControllerKind string
 
+// BulkHostActionRequest Request to apply an action to multiple Hosts.
+type BulkHostActionRequest struct {
+	// Action The action applied to each Host by BulkHostAction.
+	Action         HostBulkAction  `json:"action"`
+	AmtControlMode *AmtControlMode `json:"amtControlMode,omitempty"`
+
+	// DesiredAmtState The state of the AMT (Active Management Technology) component.
+	DesiredAmtState *AmtState `json:"desiredAmtState,omitempty"`
+
+	// DesiredPowerState The host power state.
+	DesiredPowerState *PowerState `json:"desiredPowerState,omitempty"`
+
+	// Filter Filter selecting the hosts, mutually exclusive with resource_ids.
+	//  See https://google.aip.dev/160 for details.
+	Filter *string `json:"filter,omitempty"`
+
+	// Note user-provided reason for change or a freeform field, for the INVALIDATE action
+	Note *string `json:"note,omitempty"`
+
+	// PowerCommandPolicy The policy for handling power commands.
+	PowerCommandPolicy *PowerCommandPolicy `json:"powerCommandPolicy,omitempty"`
+
+	// Preview Return the hosts the action applies to, without applying it.
+	Preview *bool `json:"preview,omitempty"`
+
+	// ProjectName The project name from the URL path.
+	ProjectName string `json:"projectName"`
+
+	// ResourceIds Resource IDs of the hosts, mutually exclusive with filter.
+	ResourceIds *[]string `json:"resourceIds,omitempty"`
+}
+
+// BulkHostActionResponse Response message for BulkHostAction.
+type BulkHostActionResponse struct {
+	// Operation A long-running operation, following https://google.aip.dev/151.
+	//  Operations are returned by the calls that are too expensive to complete within a single request.
+	Operation *OperationResource `json:"operation,omitempty"`
+
+	// ResourceIds Resource IDs of the hosts the action applies to.
+	ResourceIds *[]string `json:"resourceIds,omitempty"`
+}
+
+// BulkInstanceActionRequest Request to apply an action to multiple Instances.
+type BulkInstanceActionRequest struct {
+	// Action The action applied to each Instance by BulkInstanceAction.
+	Action InstanceBulkAction `json:"action"`
+
+	// Filter Filter selecting the instances, mutually exclusive with resource_ids.
+	//  See https://google.aip.dev/160 for details.
+	Filter *string `json:"filter,omitempty"`
+
+	// Preview Return the instances the action applies to, without applying it.
+	Preview *bool `json:"preview,omitempty"`
+
+	// ProjectName The project name from the URL path.
+	ProjectName string `json:"projectName"`
+
+	// ResourceIds Resource IDs of the instances, mutually exclusive with filter.
+	ResourceIds *[]string `json:"resourceIds,omitempty"`
+}
+
+// BulkInstanceActionResponse Response message for BulkInstanceAction.
+type BulkInstanceActionResponse struct {
+	// Operation A long-running operation, following https://google.aip.dev/151.
+	//  Operations are returned by the calls that are too expensive to complete within a single request.
+	Operation *OperationResource `json:"operation,omitempty"`
+
+	// ResourceIds Resource IDs of the instances the action applies to.
+	ResourceIds *[]string `json:"resourceIds,omitempty"`
+}
+
+// CancelOperationRequest Request message for the CancelOperation method.
+type CancelOperationRequest struct {
+	// ProjectName Project name
+	ProjectName string `json:"projectName"`
+
+	// ResourceId Name of the operation to be cancelled.
+	ResourceId string `json:"resourceId"`
+}
+
+// CreateAPITokenRequest Request message for the CreateAPIToken method.
+type CreateAPITokenRequest struct {
+	// ApiToken A project-scoped API token, for automation calling the API without a user JWT.
+	//  The token is sent as a bearer token, it is only returned when created and stored hashed.
+	ApiToken APITokenResource `json:"apiToken"`
+
+	// ProjectName Project name
+	ProjectName string `json:"projectName"`
+}
+
 // CreateCustomConfigRequest Request message for the CreateCustomConfig method.
 type CreateCustomConfigRequest struct {
 	CustomConfig CustomConfigResource `json:"customConfig"`
@@ -337,6 +561,15 @@ type CreateLocalAccountResponse struct {
 	LocalAccount LocalAccountResource `json:"localAccount"`
 }
 
+// CreateNetworkSegmentRequest Request message for the CreateNetworkSegment method.
+type CreateNetworkSegmentRequest struct {
+	// NetworkSegment A network segment resource.
+	NetworkSegment NetworkSegmentResource `json:"networkSegment"`
+
+	// ProjectName Project name
+	ProjectName string `json:"projectName"`
+}
+
 // CreateOSUpdatePolicyRequest Request message for the CreateOSUpdatePolicy method.
 type CreateOSUpdatePolicyRequest struct {
 	OsUpdatePolicy OSUpdatePolicy `json:"osUpdatePolicy"`
@@ -378,6 +611,15 @@ type CreateOperatingSystemResponse struct {
 	Os OperatingSystemResource `json:"os"`
 }
 
+// CreateOuRequest Request message for the CreateOu method.
+type CreateOuRequest struct {
+	// Ou An organizational unit (OU) resource.
+	Ou OuResource `json:"ou"`
+
+	// ProjectName Project name
+	ProjectName string `json:"projectName"`
+}
+
 // CreateProviderRequest Request message for the CreateProvider method.
 type CreateProviderRequest struct {
 	// ProjectName Project name
@@ -408,6 +650,15 @@ type CreateRegionResponse struct {
 	Region RegionResource `json:"region"`
 }
 
+// CreateRemoteAccessRequest Request message for the CreateRemoteAccess method.
+type CreateRemoteAccessRequest struct {
+	// ProjectName Project name
+	ProjectName string `json:"projectName"`
+
+	// RemoteAccess A time-bounded remote access (reverse SSH) session to an Instance.
+	RemoteAccess RemoteAccessResource `json:"remoteAccess"`
+}
+
 // CreateRepeatedScheduleRequest Request message for the CreateRepeatedSchedule method.
 type CreateRepeatedScheduleRequest struct {
 	// ProjectName Project name
@@ -423,6 +674,18 @@ type CreateRepeatedScheduleResponse struct {
 	RepeatedSchedule RepeatedScheduleResource `json:"repeatedSchedule"`
 }
 
+// CreateRoleBindingRequest Request message for the CreateRoleBinding method.
+type CreateRoleBindingRequest struct {
+	// ProjectName Project name
+	ProjectName string `json:"projectName"`
+
+	// RoleBinding Binds a principal to a role on a region, a site or an OU, with everything below it.
+	//  Principals holding the scoped role of the project (`<project>_im-scoped`) only have access to the locations of
+	//  their bindings and to the hosts and instances located there; they can read the resources that are not located,
+	//  such as OSes, but not modify them.
+	RoleBinding RoleBindingResource `json:"roleBinding"`
+}
+
 // CreateSingleScheduleRequest Request message for the CreateSingleSchedule method.
 type CreateSingleScheduleRequest struct {
 	// ProjectName Project name
@@ -562,6 +825,18 @@ type CustomConfigResource struct {
 	Timestamps *Timestamps `json:"timestamps,omitempty"`
 }
 
+// DeleteAPITokenRequest Request message for the DeleteAPIToken method.
+type DeleteAPITokenRequest struct {
+	// ProjectName Project name
+	ProjectName string `json:"projectName"`
+
+	// ResourceId Name of the API token to be revoked.
+	ResourceId string `json:"resourceId"`
+}
+
+// DeleteAPITokenResponse Response message for DeleteAPIToken.
+type DeleteAPITokenResponse = map[string]interface{}
+
 // DeleteCustomConfigRequest Request message for DeleteCustomConfig.
 type DeleteCustomConfigRequest struct {
 	// ProjectName Project name
@@ -610,6 +885,18 @@ type DeleteLocalAccountRequest struct {
 // DeleteLocalAccountResponse Response message for DeleteLocalAccount.
 type DeleteLocalAccountResponse = map[string]interface{}
 
+// DeleteNetworkSegmentRequest Request message for DeleteNetworkSegment.
+type DeleteNetworkSegmentRequest struct {
+	// ProjectName Project name
+	ProjectName string `json:"projectName"`
+
+	// ResourceId Name of the network segment to be deleted.
+	ResourceId string `json:"resourceId"`
+}
+
+// DeleteNetworkSegmentResponse Response message for DeleteNetworkSegment.
+type DeleteNetworkSegmentResponse = map[string]interface{}
+
 // DeleteOSUpdatePolicyRequest Request message for DeleteOperatingSystem.
 type DeleteOSUpdatePolicyRequest struct {
 	// ProjectName Project name
@@ -646,6 +933,18 @@ type DeleteOperatingSystemRequest struct {
 // DeleteOperatingSystemResponse Response message for DeleteOperatingSystem.
 type DeleteOperatingSystemResponse = map[string]interface{}
 
+// DeleteOuRequest Request message for DeleteOu.
+type DeleteOuRequest struct {
+	// ProjectName Project name
+	ProjectName string `json:"projectName"`
+
+	// ResourceId Name of the OU to be deleted.
+	ResourceId string `json:"resourceId"`
+}
+
+// DeleteOuResponse Response message for DeleteOu.
+type DeleteOuResponse = map[string]interface{}
+
 // DeleteProviderRequest Request message for DeleteProvider.
 type DeleteProviderRequest struct {
 	// ProjectName Project name
@@ -670,6 +969,18 @@ type DeleteRegionRequest struct {
 // DeleteRegionResponse Response message for DeleteRegion.
 type DeleteRegionResponse = map[string]interface{}
 
+// DeleteRemoteAccessRequest Request message for DeleteRemoteAccess.
+type DeleteRemoteAccessRequest struct {
+	// ProjectName Project name
+	ProjectName string `json:"projectName"`
+
+	// ResourceId Name of the remote access session to be revoked.
+	ResourceId string `json:"resourceId"`
+}
+
+// DeleteRemoteAccessResponse Response message for DeleteRemoteAccess.
+type DeleteRemoteAccessResponse = map[string]interface{}
+
 // DeleteRepeatedScheduleRequest Request message for DeleteRepeatedSchedule.
 type DeleteRepeatedScheduleRequest struct {
 	// ProjectName Project name
@@ -682,6 +993,18 @@ type DeleteRepeatedScheduleRequest struct {
 // DeleteRepeatedScheduleResponse Response message for DeleteRepeatedSchedule.
 type DeleteRepeatedScheduleResponse = map[string]interface{}
 
+// DeleteRoleBindingRequest Request message for the DeleteRoleBinding method.
+type DeleteRoleBindingRequest struct {
+	// ProjectName Project name
+	ProjectName string `json:"projectName"`
+
+	// ResourceId Name of the role binding to be deleted.
+	ResourceId string `json:"resourceId"`
+}
+
+// DeleteRoleBindingResponse Response message for DeleteRoleBinding.
+type DeleteRoleBindingResponse = map[string]interface{}
+
 // DeleteSingleScheduleRequest Request message for DeleteSingleSchedule.
 type DeleteSingleScheduleRequest struct {
 	// ProjectName Project name
@@ -781,6 +1104,22 @@ type DeleteWorkloadRequest struct {
 // DeleteWorkloadResponse Response message for DeleteWorkload.
 type DeleteWorkloadResponse = map[string]interface{}
 
+// EndpointResource An endpoint resource, one side of a netlink.
+type EndpointResource struct {
+	// HostId The unique identifier of the host this endpoint belongs to.
+	HostId *string `json:"hostId,omitempty"`
+
+	// Kind Kind of the endpoint, frequently tied to the provider.
+	Kind *string `json:"kind,omitempty"`
+
+	// Name The endpoint's human-readable name.
+	Name *string `json:"name,omitempty"`
+
+	// ResourceId Resource ID, generated by Inventory on Create.
+	ResourceId *string     `json:"resourceId,omitempty"`
+	Timestamps *Timestamps `json:"timestamps,omitempty"`
+}
+
 // GetCustomConfigRequest Request message for the GetCustomConfig method.
 type GetCustomConfigRequest struct {
 	// ProjectName Project name
@@ -795,1239 +1134,1772 @@ type GetCustomConfigResponse struct {
 	CustomConfig CustomConfigResource `json:"customConfig"`
 }
 
-// GetHostRequest Request message for the GetHost method.
-type GetHostRequest struct {
-	// ProjectName The project name from the URL path.
-	ProjectName string `json:"projectName"`
-
-	// ResourceId Name of the requested host.
-	ResourceId string `json:"resourceId"`
-}
-
-// GetHostResponse Response message for the GetHost method.
-type GetHostResponse struct {
-	// Host A Host resource.
-	Host HostResource `json:"host"`
-}
-
-// GetHostSummaryRequest Request the summary of Hosts resources.
-type GetHostSummaryRequest struct {
-	// Filter (OPTIONAL) Optional filter to return only item of interest.
-	//  See https://google.aip.dev/160 for details.
-	Filter *string `json:"filter,omitempty"`
-
-	// ProjectName The project name from the URL path.
-	ProjectName string `json:"projectName"`
-}
-
-// GetHostSummaryResponse Summary of the hosts status.
-type GetHostSummaryResponse struct {
-	// Error The total number of hosts presenting an Error.
-	Error *int `json:"error,omitempty"`
-
-	// Running The total number of hosts in Running state.
-	Running *int `json:"running,omitempty"`
-
-	// Total The total number of hosts.
-	Total *int `json:"total,omitempty"`
-
-	// Unallocated The total number of hosts without a site.
-	Unallocated *int `json:"unallocated,omitempty"`
-}
-
-// GetInstanceRequest Request message for the GetInstance method.
-type GetInstanceRequest struct {
-	// ProjectName The project name from the URL path.
-	ProjectName string `json:"projectName"`
-
-	// ResourceId Name of the requested instance.
-	ResourceId string `json:"resourceId"`
-}
-
-// GetInstanceResponse Response message for the GetInstance method.
-type GetInstanceResponse struct {
-	// Instance InstanceResource describes an instantiated OS install, running on either a
-	//  host or hypervisor.
-	Instance InstanceResource `json:"instance"`
-}
-
-// GetLocalAccountRequest Request message for the GetLocalAccount method.
-type GetLocalAccountRequest struct {
-	// ProjectName Project name
-	ProjectName string `json:"projectName"`
-
-	// ResourceId Name of the requested localaccount.
-	ResourceId string `json:"resourceId"`
-}
-
-// GetLocalAccountResponse Response message for the GetLocalAccount method.
-type GetLocalAccountResponse struct {
-	LocalAccount LocalAccountResource `json:"localAccount"`
-}
-
-// GetOSUpdatePolicyRequest Request message for the GetOSUpdatePolicy method.
-type GetOSUpdatePolicyRequest struct {
-	// ProjectName Project name
-	ProjectName string `json:"projectName"`
-
-	// ResourceId Name of the requested os.
-	ResourceId string `json:"resourceId"`
-}
-
-// GetOSUpdatePolicyResponse Response message for the GetOSUpdatePolicy method.
-type GetOSUpdatePolicyResponse struct {
-	OsUpdatePolicy OSUpdatePolicy `json:"osUpdatePolicy"`
-}
-
-// GetOSUpdateRunRequest Request message for the GetOSUpdateRun method.
-type GetOSUpdateRunRequest struct {
+// GetEndpointRequest Request message for the GetEndpoint method.
+type GetEndpointRequest struct {
 	// ProjectName Project name
 	ProjectName string `json:"projectName"`
 
-	// ResourceId Name of the requested os.
+	// ResourceId Name of the requested endpoint.
 	ResourceId string `json:"resourceId"`
 }
 
-// GetOSUpdateRunResponse Response message for the GetOSUpdateRun method.
-type GetOSUpdateRunResponse struct {
-	OsUpdateRun OSUpdateRun `json:"osUpdateRun"`
-}
-
-// GetOperatingSystemRequest Request message for the GetOperatingSystem method.
-type GetOperatingSystemRequest struct {
-	// ProjectName Project name
-	ProjectName string `json:"projectName"`
+// GetHostRequest Request message for the GetHost method.
+type GetHostRequest struct {
+	// Fields `FieldMask` represents a set of symbolic field paths, for example:
+	//
+	//      paths: "f.a"
+	//      paths: "f.b.d"
+	//
+	//  Here `f` represents a field in some root message, `a` and `b`
+	//  fields in the message found in `f`, and `d` a field found in the
+	//  message in `f.b`.
+	//
+	//  Field masks are used to specify a subset of fields that should be
+	//  returned by a get operation or modified by an update operation.
+	//  Field masks also have a custom JSON encoding (see below).
+	//
+	//  # Field Masks in Projections
+	//
+	//  When used in the context of a projection, a response message or
+	//  sub-message is filtered by the API to only contain those fields as
+	//  specified in the mask. For example, if the mask in the previous
+	//  example is applied to a response message as follows:
+	//
+	//      f {
+	//        a : 22
+	//        b {
+	//          d : 1
+	//          x : 2
+	//        }
+	//        y : 13
+	//      }
+	//      z: 8
+	//
+	//  The result will not contain specific values for fields x,y and z
+	//  (their value will be set to the default, and omitted in proto text
+	//  output):
+	//
+	//
+	//      f {
+	//        a : 22
+	//        b {
+	//          d : 1
+	//        }
+	//      }
+	//
+	//  A repeated field is not allowed except at the last position of a
+	//  paths string.
+	//
+	//  If a FieldMask object is not present in a get operation, the
+	//  operation applies to all fields (as if a FieldMask of all fields
+	//  had been specified).
+	//
+	//  Note that a field mask does not necessarily apply to the
+	//  top-level response message. In case of a REST get operation, the
+	//  field mask applies directly to the response, but in case of a REST
+	//  list operation, the mask instead applies to each individual message
+	//  in the returned resource list. In case of a REST custom method,
+	//  other definitions may be used. Where the mask applies will be
+	//  clearly documented together with its declaration in the API.  In
+	//  any case, the effect on the returned resource/resources is required
+	//  behavior for APIs.
+	//
+	//  # Field Masks in Update Operations
+	//
+	//  A field mask in update operations specifies which fields of the
+	//  targeted resource are going to be updated. The API is required
+	//  to only change the values of the fields as specified in the mask
+	//  and leave the others untouched. If a resource is passed in to
+	//  describe the updated values, the API ignores the values of all
+	//  fields not covered by the mask.
+	//
+	//  If a repeated field is specified for an update operation, new values will
+	//  be appended to the existing repeated field in the target resource. Note that
+	//  a repeated field is only allowed in the last position of a `paths` string.
+	//
+	//  If a sub-message is specified in the last position of the field mask for an
+	//  update operation, then new value will be merged into the existing sub-message
+	//  in the target resource.
+	//
+	//  For example, given the target message:
+	//
+	//      f {
+	//        b {
+	//          d: 1
+	//          x: 2
+	//        }
+	//        c: [1]
+	//      }
+	//
+	//  And an update message:
+	//
+	//      f {
+	//        b {
+	//          d: 10
+	//        }
+	//        c: [2]
+	//      }
+	//
+	//  then if the field mask is:
+	//
+	//   paths: ["f.b", "f.c"]
+	//
+	//  then the result will be:
+	//
+	//      f {
+	//        b {
+	//          d: 10
+	//          x: 2
+	//        }
+	//        c: [1, 2]
+	//      }
+	//
+	//  An implementation may provide options to override this default behavior for
+	//  repeated and message fields.
+	//
+	//  In order to reset a field's value to the default, the field must
+	//  be in the mask and set to the default value in the provided resource.
+	//  Hence, in order to reset all fields of a resource, provide a default
+	//  instance of the resource and set all fields in the mask, or do
+	//  not provide a mask as described below.
+	//
+	//  If a field mask is not present on update, the operation applies to
+	//  all fields (as if a field mask of all fields has been specified).
+	//  Note that in the presence of schema evolution, this may mean that
+	//  fields the client does not know and has therefore not filled into
+	//  the request will be reset to their default. If this is unwanted
+	//  behavior, a specific service may require a client to always specify
+	//  a field mask, producing an error if not.
+	//
+	//  As with get operations, the location of the resource which
+	//  describes the updated values in the request message depends on the
+	//  operation kind. In any case, the effect of the field mask is
+	//  required to be honored by the API.
+	//
+	//  ## Considerations for HTTP REST
+	//
+	//  The HTTP kind of an update operation which uses a field mask must
+	//  be set to PATCH instead of PUT in order to satisfy HTTP semantics
+	//  (PUT must only be used for full updates).
+	//
+	//  # JSON Encoding of Field Masks
+	//
+	//  In JSON, a field mask is encoded as a single string where paths are
+	//  separated by a comma. Fields name in each path are converted
+	//  to/from lower-camel naming conventions.
+	//
+	//  As an example, consider the following message declarations:
+	//
+	//      message Profile {
+	//        User user = 1;
+	//        Photo photo = 2;
+	//      }
+	//      message User {
+	//        string display_name = 1;
+	//        string address = 2;
+	//      }
+	//
+	//  In proto a field mask for `Profile` may look as such:
+	//
+	//      mask {
+	//        paths: "user.display_name"
+	//        paths: "photo"
+	//      }
+	//
+	//  In JSON, the same mask is represented as below:
+	//
+	//      {
+	//        mask: "user.displayName,photo"
+	//      }
+	//
+	//  # Field Masks and Oneof Fields
+	//
+	//  Field masks treat fields in oneofs just as regular fields. Consider the
+	//  following message:
+	//
+	//      message SampleMessage {
+	//        oneof test_oneof {
+	//          string name = 4;
+	//          SubMessage sub_message = 9;
+	//        }
+	//      }
+	//
+	//  The field mask can be:
+	//
+	//      mask {
+	//        paths: "name"
+	//      }
+	//
+	//  Or:
+	//
+	//      mask {
+	//        paths: "sub_message"
+	//      }
+	//
+	//  Note that oneof type names ("test_oneof" in this case) cannot be used in
+	//  paths.
+	//
+	//  ## Field Mask Verification
+	//
+	//  The implementation of any API method which has a FieldMask type field in the
+	//  request should verify the included field paths, and return an
+	//  `INVALID_ARGUMENT` error if any path is unmappable.
+	Fields *GoogleProtobufFieldMask `json:"fields,omitempty"`
 
-	// ResourceId Name of the requested os.
+	// ProjectName The project name from the URL path.
+	ProjectName string `json:"projectName"`
+
+	// ResourceId Name of the requested host.
 	ResourceId string `json:"resourceId"`
+
+	// View The fields returned by Get and List methods supporting views.
+	//  See https://google.aip.dev/157 for details.
+	View *ResourceView `json:"view,omitempty"`
 }
 
-// GetOperatingSystemResponse Response message for the GetOperatingSystem method.
-type GetOperatingSystemResponse struct {
-	// Os An OS resource.
-	Os OperatingSystemResource `json:"os"`
+// GetHostResponse Response message for the GetHost method.
+type GetHostResponse struct {
+	// Host A Host resource.
+	Host HostResource `json:"host"`
 }
 
-// GetProviderRequest Request message for the GetProvider method.
-type GetProviderRequest struct {
-	// ProjectName Project name
-	ProjectName string `json:"projectName"`
+// GetHostSummaryRequest Request the summary of Hosts resources.
+type GetHostSummaryRequest struct {
+	// Filter (OPTIONAL) Optional filter to return only item of interest.
+	//  See https://google.aip.dev/160 for details.
+	Filter *string `json:"filter,omitempty"`
 
-	// ResourceId Name of the requested provider.
-	ResourceId string `json:"resourceId"`
+	// ProjectName The project name from the URL path.
+	ProjectName string `json:"projectName"`
 }
 
-// GetProviderResponse Response message for the GetProvider method.
-type GetProviderResponse struct {
-	// Provider A provider resource.
-	Provider ProviderResource `json:"provider"`
-}
+// GetHostSummaryResponse Summary of the hosts status.
+type GetHostSummaryResponse struct {
+	// Error The total number of hosts presenting an Error.
+	Error *int `json:"error,omitempty"`
 
-// GetRegionRequest Request message for the GetRegion method.
-type GetRegionRequest struct {
-	// ProjectName Project name
-	ProjectName string `json:"projectName"`
+	// Running The total number of hosts in Running state.
+	Running *int `json:"running,omitempty"`
 
-	// ResourceId Name of the requested region.
-	ResourceId string `json:"resourceId"`
-}
+	// Total The total number of hosts.
+	Total *int `json:"total,omitempty"`
 
-// GetRegionResponse Response message for the GetRegion method.
-type GetRegionResponse struct {
-	// Region A region resource.
-	Region RegionResource `json:"region"`
+	// Unallocated The total number of hosts without a site.
+	Unallocated *int `json:"unallocated,omitempty"`
 }
 
-// GetRepeatedScheduleRequest Request message for the GetRepeatedSchedule method.
-type GetRepeatedScheduleRequest struct {
+// GetHostnicRequest Request message for the GetHostnic method.
+type GetHostnicRequest struct {
 	// ProjectName Project name
 	ProjectName string `json:"projectName"`
 
-	// ResourceId Name of the requested repeated_schedule.
+	// ResourceId Name of the requested host NIC.
 	ResourceId string `json:"resourceId"`
 }
 
-// GetRepeatedScheduleResponse Response message for the GetRepeatedSchedule method.
-type GetRepeatedScheduleResponse struct {
-	// RepeatedSchedule A repeated-schedule resource.
-	RepeatedSchedule RepeatedScheduleResource `json:"repeatedSchedule"`
+// GetHostsStatisticsRequest Request message for the GetHostsStatistics method.
+type GetHostsStatisticsRequest struct {
+	// Dimensions (OPTIONAL) Dimensions to break the hosts down along. Only the total number of hosts is returned if empty.
+	Dimensions *[]HostsStatisticsDimension `json:"dimensions,omitempty"`
+
+	// Filter (OPTIONAL) Optional filter on the hosts to account for.
+	//  See https://google.aip.dev/160 for details.
+	Filter *string `json:"filter,omitempty"`
+
+	// ProjectName The project name from the URL path.
+	ProjectName string `json:"projectName"`
 }
 
-// GetSingleScheduleRequest Request message for the GetSingleSchedule method.
-type GetSingleScheduleRequest struct {
+// GetHostsStatisticsResponse Response message for the GetHostsStatistics method.
+type GetHostsStatisticsResponse struct {
+	// Statistics Statistics of each requested dimension, in the order of the request.
+	Statistics *[]HostsDimensionStatistics `json:"statistics,omitempty"`
+
+	// Total Number of hosts matching the filter.
+	Total *int `json:"total,omitempty"`
+}
+
+// GetIPAddressRequest Request message for the GetIPAddress method.
+type GetIPAddressRequest struct {
 	// ProjectName Project name
 	ProjectName string `json:"projectName"`
 
-	// ResourceId Name of the requested single_schedule.
+	// ResourceId Name of the requested IP address.
 	ResourceId string `json:"resourceId"`
 }
 
-// GetSingleScheduleResponse Response message for the GetSingleSchedule method.
-type GetSingleScheduleResponse struct {
-	// SingleSchedule A single schedule resource.
-	SingleSchedule SingleScheduleResource `json:"singleSchedule"`
-}
+// GetInstanceRequest Request message for the GetInstance method.
+type GetInstanceRequest struct {
+	// Fields `FieldMask` represents a set of symbolic field paths, for example:
+	//
+	//      paths: "f.a"
+	//      paths: "f.b.d"
+	//
+	//  Here `f` represents a field in some root message, `a` and `b`
+	//  fields in the message found in `f`, and `d` a field found in the
+	//  message in `f.b`.
+	//
+	//  Field masks are used to specify a subset of fields that should be
+	//  returned by a get operation or modified by an update operation.
+	//  Field masks also have a custom JSON encoding (see below).
+	//
+	//  # Field Masks in Projections
+	//
+	//  When used in the context of a projection, a response message or
+	//  sub-message is filtered by the API to only contain those fields as
+	//  specified in the mask. For example, if the mask in the previous
+	//  example is applied to a response message as follows:
+	//
+	//      f {
+	//        a : 22
+	//        b {
+	//          d : 1
+	//          x : 2
+	//        }
+	//        y : 13
+	//      }
+	//      z: 8
+	//
+	//  The result will not contain specific values for fields x,y and z
+	//  (their value will be set to the default, and omitted in proto text
+	//  output):
+	//
+	//
+	//      f {
+	//        a : 22
+	//        b {
+	//          d : 1
+	//        }
+	//      }
+	//
+	//  A repeated field is not allowed except at the last position of a
+	//  paths string.
+	//
+	//  If a FieldMask object is not present in a get operation, the
+	//  operation applies to all fields (as if a FieldMask of all fields
+	//  had been specified).
+	//
+	//  Note that a field mask does not necessarily apply to the
+	//  top-level response message. In case of a REST get operation, the
+	//  field mask applies directly to the response, but in case of a REST
+	//  list operation, the mask instead applies to each individual message
+	//  in the returned resource list. In case of a REST custom method,
+	//  other definitions may be used. Where the mask applies will be
+	//  clearly documented together with its declaration in the API.  In
+	//  any case, the effect on the returned resource/resources is required
+	//  behavior for APIs.
+	//
+	//  # Field Masks in Update Operations
+	//
+	//  A field mask in update operations specifies which fields of the
+	//  targeted resource are going to be updated. The API is required
+	//  to only change the values of the fields as specified in the mask
+	//  and leave the others untouched. If a resource is passed in to
+	//  describe the updated values, the API ignores the values of all
+	//  fields not covered by the mask.
+	//
+	//  If a repeated field is specified for an update operation, new values will
+	//  be appended to the existing repeated field in the target resource. Note that
+	//  a repeated field is only allowed in the last position of a `paths` string.
+	//
+	//  If a sub-message is specified in the last position of the field mask for an
+	//  update operation, then new value will be merged into the existing sub-message
+	//  in the target resource.
+	//
+	//  For example, given the target message:
+	//
+	//      f {
+	//        b {
+	//          d: 1
+	//          x: 2
+	//        }
+	//        c: [1]
+	//      }
+	//
+	//  And an update message:
+	//
+	//      f {
+	//        b {
+	//          d: 10
+	//        }
+	//        c: [2]
+	//      }
+	//
+	//  then if the field mask is:
+	//
+	//   paths: ["f.b", "f.c"]
+	//
+	//  then the result will be:
+	//
+	//      f {
+	//        b {
+	//          d: 10
+	//          x: 2
+	//        }
+	//        c: [1, 2]
+	//      }
+	//
+	//  An implementation may provide options to override this default behavior for
+	//  repeated and message fields.
+	//
+	//  In order to reset a field's value to the default, the field must
+	//  be in the mask and set to the default value in the provided resource.
+	//  Hence, in order to reset all fields of a resource, provide a default
+	//  instance of the resource and set all fields in the mask, or do
+	//  not provide a mask as described below.
+	//
+	//  If a field mask is not present on update, the operation applies to
+	//  all fields (as if a field mask of all fields has been specified).
+	//  Note that in the presence of schema evolution, this may mean that
+	//  fields the client does not know and has therefore not filled into
+	//  the request will be reset to their default. If this is unwanted
+	//  behavior, a specific service may require a client to always specify
+	//  a field mask, producing an error if not.
+	//
+	//  As with get operations, the location of the resource which
+	//  describes the updated values in the request message depends on the
+	//  operation kind. In any case, the effect of the field mask is
+	//  required to be honored by the API.
+	//
+	//  ## Considerations for HTTP REST
+	//
+	//  The HTTP kind of an update operation which uses a field mask must
+	//  be set to PATCH instead of PUT in order to satisfy HTTP semantics
+	//  (PUT must only be used for full updates).
+	//
+	//  # JSON Encoding of Field Masks
+	//
+	//  In JSON, a field mask is encoded as a single string where paths are
+	//  separated by a comma. Fields name in each path are converted
+	//  to/from lower-camel naming conventions.
+	//
+	//  As an example, consider the following message declarations:
+	//
+	//      message Profile {
+	//        User user = 1;
+	//        Photo photo = 2;
+	//      }
+	//      message User {
+	//        string display_name = 1;
+	//        string address = 2;
+	//      }
+	//
+	//  In proto a field mask for `Profile` may look as such:
+	//
+	//      mask {
+	//        paths: "user.display_name"
+	//        paths: "photo"
+	//      }
+	//
+	//  In JSON, the same mask is represented as below:
+	//
+	//      {
+	//        mask: "user.displayName,photo"
+	//      }
+	//
+	//  # Field Masks and Oneof Fields
+	//
+	//  Field masks treat fields in oneofs just as regular fields. Consider the
+	//  following message:
+	//
+	//      message SampleMessage {
+	//        oneof test_oneof {
+	//          string name = 4;
+	//          SubMessage sub_message = 9;
+	//        }
+	//      }
+	//
+	//  The field mask can be:
+	//
+	//      mask {
+	//        paths: "name"
+	//      }
+	//
+	//  Or:
+	//
+	//      mask {
+	//        paths: "sub_message"
+	//      }
+	//
+	//  Note that oneof type names ("test_oneof" in this case) cannot be used in
+	//  paths.
+	//
+	//  ## Field Mask Verification
+	//
+	//  The implementation of any API method which has a FieldMask type field in the
+	//  request should verify the included field paths, and return an
+	//  `INVALID_ARGUMENT` error if any path is unmappable.
+	Fields *GoogleProtobufFieldMask `json:"fields,omitempty"`
 
-// GetSiteRequest Request message for the GetSite method.
-type GetSiteRequest struct {
-	// ProjectName Project name
+	// ProjectName The project name from the URL path.
 	ProjectName string `json:"projectName"`
 
-	// RegionId (OPTIONAL) Optional region ID for hierarchical path support
-	RegionId *string `json:"regionId,omitempty"`
-
-	// ResourceId Name of the requested site.
+	// ResourceId Name of the requested instance.
 	ResourceId string `json:"resourceId"`
+
+	// View The fields returned by Get and List methods supporting views.
+	//  See https://google.aip.dev/157 for details.
+	View *ResourceView `json:"view,omitempty"`
 }
 
-// GetSiteResponse Response message for the GetSite method.
-type GetSiteResponse struct {
-	// Site A site resource.
-	Site SiteResource `json:"site"`
+// GetInstanceResponse Response message for the GetInstance method.
+type GetInstanceResponse struct {
+	// Instance InstanceResource describes an instantiated OS install, running on either a
+	//  host or hypervisor.
+	Instance InstanceResource `json:"instance"`
 }
 
-// GetTelemetryLogsGroupRequest Request message for the GetTelemetryLogsGroup method.
-type GetTelemetryLogsGroupRequest struct {
+// GetLocalAccountRequest Request message for the GetLocalAccount method.
+type GetLocalAccountRequest struct {
 	// ProjectName Project name
 	ProjectName string `json:"projectName"`
 
-	// ResourceId Name of the requested telemetry_logs_group.
+	// ResourceId Name of the requested localaccount.
 	ResourceId string `json:"resourceId"`
 }
 
-// GetTelemetryLogsGroupResponse Response message for the GetTelemetryLogsGroup method.
-type GetTelemetryLogsGroupResponse struct {
-	// TelemetryLogsGroup TelemetryLogsGroupResource.
-	TelemetryLogsGroup TelemetryLogsGroupResource `json:"telemetryLogsGroup"`
+// GetLocalAccountResponse Response message for the GetLocalAccount method.
+type GetLocalAccountResponse struct {
+	LocalAccount LocalAccountResource `json:"localAccount"`
 }
 
-// GetTelemetryLogsProfileRequest Request message for the GetTelemetryLogsProfile method.
-type GetTelemetryLogsProfileRequest struct {
+// GetNetlinkRequest Request message for the GetNetlink method.
+type GetNetlinkRequest struct {
 	// ProjectName Project name
 	ProjectName string `json:"projectName"`
 
-	// ResourceId Name of the requested telemetry_logs_profile.
+	// ResourceId Name of the requested netlink.
 	ResourceId string `json:"resourceId"`
 }
 
-// GetTelemetryLogsProfileResponse Response message for the GetTelemetryLogsProfile method.
-type GetTelemetryLogsProfileResponse struct {
-	// TelemetryLogsProfile A telemetry log profile for a hierarchy object.
-	TelemetryLogsProfile TelemetryLogsProfileResource `json:"telemetryLogsProfile"`
+// GetNetworkSegmentRequest Request message for the GetNetworkSegment method.
+type GetNetworkSegmentRequest struct {
+	// ProjectName Project name
+	ProjectName string `json:"projectName"`
+
+	// ResourceId Name of the requested network segment.
+	ResourceId string `json:"resourceId"`
 }
 
-// GetTelemetryMetricsGroupRequest Request message for the GetTelemetryMetricsGroup method.
-type GetTelemetryMetricsGroupRequest struct {
+// GetOSUpdatePolicyRequest Request message for the GetOSUpdatePolicy method.
+type GetOSUpdatePolicyRequest struct {
 	// ProjectName Project name
 	ProjectName string `json:"projectName"`
 
-	// ResourceId Name of the requested telemetry_metrics_group.
+	// ResourceId Name of the requested os.
 	ResourceId string `json:"resourceId"`
 }
 
-// GetTelemetryMetricsGroupResponse Response message for the GetTelemetryMetricsGroup method.
-type GetTelemetryMetricsGroupResponse struct {
-	// TelemetryMetricsGroup TelemetryMetricsGroupResource.
-	TelemetryMetricsGroup TelemetryMetricsGroupResource `json:"telemetryMetricsGroup"`
+// GetOSUpdatePolicyResponse Response message for the GetOSUpdatePolicy method.
+type GetOSUpdatePolicyResponse struct {
+	OsUpdatePolicy OSUpdatePolicy `json:"osUpdatePolicy"`
 }
 
-// GetTelemetryMetricsProfileRequest Request message for the GetTelemetryMetricsProfile method.
-type GetTelemetryMetricsProfileRequest struct {
+// GetOSUpdateRunRequest Request message for the GetOSUpdateRun method.
+type GetOSUpdateRunRequest struct {
 	// ProjectName Project name
 	ProjectName string `json:"projectName"`
 
-	// ResourceId Name of the requested telemetry_metrics_profile.
+	// ResourceId Name of the requested os.
 	ResourceId string `json:"resourceId"`
 }
 
-// GetTelemetryMetricsProfileResponse Response message for the GetTelemetryMetricsProfile method.
-type GetTelemetryMetricsProfileResponse struct {
-	// TelemetryMetricsProfile A telemetry metric profile for a hierarchy object.
-	TelemetryMetricsProfile TelemetryMetricsProfileResource `json:"telemetryMetricsProfile"`
+// GetOSUpdateRunResponse Response message for the GetOSUpdateRun method.
+type GetOSUpdateRunResponse struct {
+	OsUpdateRun OSUpdateRun `json:"osUpdateRun"`
 }
 
-// GetWorkloadMemberRequest Request message for the GetWorkloadMember method.
-type GetWorkloadMemberRequest struct {
+// GetOperatingSystemRequest Request message for the GetOperatingSystem method.
+type GetOperatingSystemRequest struct {
+	// Fields `FieldMask` represents a set of symbolic field paths, for example:
+	//
+	//      paths: "f.a"
+	//      paths: "f.b.d"
+	//
+	//  Here `f` represents a field in some root message, `a` and `b`
+	//  fields in the message found in `f`, and `d` a field found in the
+	//  message in `f.b`.
+	//
+	//  Field masks are used to specify a subset of fields that should be
+	//  returned by a get operation or modified by an update operation.
+	//  Field masks also have a custom JSON encoding (see below).
+	//
+	//  # Field Masks in Projections
+	//
+	//  When used in the context of a projection, a response message or
+	//  sub-message is filtered by the API to only contain those fields as
+	//  specified in the mask. For example, if the mask in the previous
+	//  example is applied to a response message as follows:
+	//
+	//      f {
+	//        a : 22
+	//        b {
+	//          d : 1
+	//          x : 2
+	//        }
+	//        y : 13
+	//      }
+	//      z: 8
+	//
+	//  The result will not contain specific values for fields x,y and z
+	//  (their value will be set to the default, and omitted in proto text
+	//  output):
+	//
+	//
+	//      f {
+	//        a : 22
+	//        b {
+	//          d : 1
+	//        }
+	//      }
+	//
+	//  A repeated field is not allowed except at the last position of a
+	//  paths string.
+	//
+	//  If a FieldMask object is not present in a get operation, the
+	//  operation applies to all fields (as if a FieldMask of all fields
+	//  had been specified).
+	//
+	//  Note that a field mask does not necessarily apply to the
+	//  top-level response message. In case of a REST get operation, the
+	//  field mask applies directly to the response, but in case of a REST
+	//  list operation, the mask instead applies to each individual message
+	//  in the returned resource list. In case of a REST custom method,
+	//  other definitions may be used. Where the mask applies will be
+	//  clearly documented together with its declaration in the API.  In
+	//  any case, the effect on the returned resource/resources is required
+	//  behavior for APIs.
+	//
+	//  # Field Masks in Update Operations
+	//
+	//  A field mask in update operations specifies which fields of the
+	//  targeted resource are going to be updated. The API is required
+	//  to only change the values of the fields as specified in the mask
+	//  and leave the others untouched. If a resource is passed in to
+	//  describe the updated values, the API ignores the values of all
+	//  fields not covered by the mask.
+	//
+	//  If a repeated field is specified for an update operation, new values will
+	//  be appended to the existing repeated field in the target resource. Note that
+	//  a repeated field is only allowed in the last position of a `paths` string.
+	//
+	//  If a sub-message is specified in the last position of the field mask for an
+	//  update operation, then new value will be merged into the existing sub-message
+	//  in the target resource.
+	//
+	//  For example, given the target message:
+	//
+	//      f {
+	//        b {
+	//          d: 1
+	//          x: 2
+	//        }
+	//        c: [1]
+	//      }
+	//
+	//  And an update message:
+	//
+	//      f {
+	//        b {
+	//          d: 10
+	//        }
+	//        c: [2]
+	//      }
+	//
+	//  then if the field mask is:
+	//
+	//   paths: ["f.b", "f.c"]
+	//
+	//  then the result will be:
+	//
+	//      f {
+	//        b {
+	//          d: 10
+	//          x: 2
+	//        }
+	//        c: [1, 2]
+	//      }
+	//
+	//  An implementation may provide options to override this default behavior for
+	//  repeated and message fields.
+	//
+	//  In order to reset a field's value to the default, the field must
+	//  be in the mask and set to the default value in the provided resource.
+	//  Hence, in order to reset all fields of a resource, provide a default
+	//  instance of the resource and set all fields in the mask, or do
+	//  not provide a mask as described below.
+	//
+	//  If a field mask is not present on update, the operation applies to
+	//  all fields (as if a field mask of all fields has been specified).
+	//  Note that in the presence of schema evolution, this may mean that
+	//  fields the client does not know and has therefore not filled into
+	//  the request will be reset to their default. If this is unwanted
+	//  behavior, a specific service may require a client to always specify
+	//  a field mask, producing an error if not.
+	//
+	//  As with get operations, the location of the resource which
+	//  describes the updated values in the request message depends on the
+	//  operation kind. In any case, the effect of the field mask is
+	//  required to be honored by the API.
+	//
+	//  ## Considerations for HTTP REST
+	//
+	//  The HTTP kind of an update operation which uses a field mask must
+	//  be set to PATCH instead of PUT in order to satisfy HTTP semantics
+	//  (PUT must only be used for full updates).
+	//
+	//  # JSON Encoding of Field Masks
+	//
+	//  In JSON, a field mask is encoded as a single string where paths are
+	//  separated by a comma. Fields name in each path are converted
+	//  to/from lower-camel naming conventions.
+	//
+	//  As an example, consider the following message declarations:
+	//
+	//      message Profile {
+	//        User user = 1;
+	//        Photo photo = 2;
+	//      }
+	//      message User {
+	//        string display_name = 1;
+	//        string address = 2;
+	//      }
+	//
+	//  In proto a field mask for `Profile` may look as such:
+	//
+	//      mask {
+	//        paths: "user.display_name"
+	//        paths: "photo"
+	//      }
+	//
+	//  In JSON, the same mask is represented as below:
+	//
+	//      {
+	//        mask: "user.displayName,photo"
+	//      }
+	//
+	//  # Field Masks and Oneof Fields
+	//
+	//  Field masks treat fields in oneofs just as regular fields. Consider the
+	//  following message:
+	//
+	//      message SampleMessage {
+	//        oneof test_oneof {
+	//          string name = 4;
+	//          SubMessage sub_message = 9;
+	//        }
+	//      }
+	//
+	//  The field mask can be:
+	//
+	//      mask {
+	//        paths: "name"
+	//      }
+	//
+	//  Or:
+	//
+	//      mask {
+	//        paths: "sub_message"
+	//      }
+	//
+	//  Note that oneof type names ("test_oneof" in this case) cannot be used in
+	//  paths.
+	//
+	//  ## Field Mask Verification
+	//
+	//  The implementation of any API method which has a FieldMask type field in the
+	//  request should verify the included field paths, and return an
+	//  `INVALID_ARGUMENT` error if any path is unmappable.
+	Fields *GoogleProtobufFieldMask `json:"fields,omitempty"`
+
 	// ProjectName Project name
 	ProjectName string `json:"projectName"`
 
-	// ResourceId Name of the requested workload_member.
+	// ResourceId Name of the requested os.
 	ResourceId string `json:"resourceId"`
+
+	// View The fields returned by Get and List methods supporting views.
+	//  See https://google.aip.dev/157 for details.
+	View *ResourceView `json:"view,omitempty"`
 }
 
-// GetWorkloadMemberResponse Response message for the GetWorkloadMember method.
-type GetWorkloadMemberResponse struct {
-	// WorkloadMember Intermediate resource to represent a relation between a workload and a compute resource (i.e., instance).
-	WorkloadMember WorkloadMember `json:"workloadMember"`
+// GetOperatingSystemResponse Response message for the GetOperatingSystem method.
+type GetOperatingSystemResponse struct {
+	// Os An OS resource.
+	Os OperatingSystemResource `json:"os"`
 }
 
-// GetWorkloadRequest Request message for the GetWorkload method.
-type GetWorkloadRequest struct {
+// GetOperationRequest Request message for the GetOperation method.
+type GetOperationRequest struct {
 	// ProjectName Project name
 	ProjectName string `json:"projectName"`
 
-	// ResourceId Name of the requested workload.
+	// ResourceId Name of the requested operation.
 	ResourceId string `json:"resourceId"`
 }
 
-// GetWorkloadResponse Response message for the GetWorkload method.
-type GetWorkloadResponse struct {
-	// Workload A generic way to group compute resources to obtain a workload.
-	Workload WorkloadResource `json:"workload"`
-}
-
-// HostComponentState The state of the Host component.
-type HostComponentState string
-
-// HostRegister Message to register a Host.
-type HostRegister struct {
-	// AutoOnboard Flag to signal to automatically onboard the host.
-	AutoOnboard *bool `json:"autoOnboard,omitempty"`
-
-	// EnableVpro Flag to signal to enable vPRO on the host.
-	EnableVpro *bool `json:"enableVpro,omitempty"`
-
-	// Name The host name.
-	Name *string `json:"name,omitempty"`
-
-	// SerialNumber The host serial number.
-	SerialNumber *string `json:"serialNumber,omitempty"`
-
-	// UserLvmSize LVM size in GB
-	UserLvmSize *int `json:"userLvmSize,omitempty"`
+// GetOuRequest Request message for the GetOu method.
+type GetOuRequest struct {
+	// ProjectName Project name
+	ProjectName string `json:"projectName"`
 
-	// Uuid The host UUID.
-	Uuid *string `json:"uuid,omitempty"`
+	// ResourceId Name of the requested OU.
+	ResourceId string `json:"resourceId"`
 }
 
-// HostResource A Host resource.
-type HostResource struct {
-	AmtControlMode *AmtControlMode `json:"amtControlMode,omitempty"`
-
-	// AmtDnsSuffix (OPTIONAL) textual message that describes dns_suffix for ACM mode.
-	AmtDnsSuffix *string `json:"amtDnsSuffix,omitempty"`
-	AmtSku       *AmtSku `json:"amtSku,omitempty"`
-
-	// AmtStatus coming from device introspection. Set only by the DM RM.
-	AmtStatus *string `json:"amtStatus,omitempty"`
-
-	// AmtStatusIndicator The status indicator.
-	AmtStatusIndicator *StatusIndication `json:"amtStatusIndicator,omitempty"`
-
-	// AmtStatusTimestamp UTC timestamp when amt_status was last changed. Set by DM and OM RM only.
-	AmtStatusTimestamp *int `json:"amtStatusTimestamp,omitempty"`
-
-	// BiosReleaseDate BIOS Release Date.
-	BiosReleaseDate *string `json:"biosReleaseDate,omitempty"`
-
-	// BiosVendor BIOS Vendor.
-	BiosVendor *string `json:"biosVendor,omitempty"`
-
-	// BiosVersion BIOS Version.
-	BiosVersion *string `json:"biosVersion,omitempty"`
-
-	// BmcIp BMC IP address, such as "192.0.0.1".
-	BmcIp *string `json:"bmcIp,omitempty"`
-
-	// BmcKind The type of BMC.
-	BmcKind *BaremetalControllerKind `json:"bmcKind,omitempty"`
-
-	// CpuArchitecture Architecture of the CPU model, e.g. x86_64.
-	CpuArchitecture *string `json:"cpuArchitecture,omitempty"`
-
-	// CpuCapabilities String list of all CPU capabilities (possibly JSON).
-	CpuCapabilities *string `json:"cpuCapabilities,omitempty"`
-
-	// CpuCores Number of CPU cores.
-	CpuCores *int `json:"cpuCores,omitempty"`
-
-	// CpuModel CPU model of the Host.
-	CpuModel *string `json:"cpuModel,omitempty"`
-
-	// CpuSockets Number of physical CPU sockets.
-	CpuSockets *int `json:"cpuSockets,omitempty"`
-
-	// CpuThreads Total Number of threads supported by the CPU.
-	CpuThreads *int `json:"cpuThreads,omitempty"`
-
-	// CpuTopology JSON field storing the CPU topology, refer to HDA/HRM docs for the JSON schema.
-	CpuTopology *string `json:"cpuTopology,omitempty"`
-
-	// CurrentAmtState The state of the AMT (Active Management Technology) component.
-	CurrentAmtState *AmtState `json:"currentAmtState,omitempty"`
-
-	// CurrentKvmState KVM session lifecycle state; KVM_CONSENT_RECEIVED and KVM_REDIRECTION_RECEIVED are desired-only values.
-	CurrentKvmState *KvmState `json:"currentKvmState,omitempty"`
-
-	// CurrentPowerState The host power state.
-	CurrentPowerState *PowerState `json:"currentPowerState,omitempty"`
-
-	// CurrentSolState SOL session lifecycle state; SOL_STATE_CONSENT_RECEIVED and SOL_STATE_REDIRECTION_RECEIVED are desired-only values.
-	CurrentSolState *SolState `json:"currentSolState,omitempty"`
-
-	// CurrentState States of the host.
-	CurrentState *HostState `json:"currentState,omitempty"`
-
-	// DesiredAmtState The state of the AMT (Active Management Technology) component.
-	DesiredAmtState *AmtState `json:"desiredAmtState,omitempty"`
-
-	// DesiredKvmState KVM session lifecycle state; KVM_CONSENT_RECEIVED and KVM_REDIRECTION_RECEIVED are desired-only values.
-	DesiredKvmState *KvmState `json:"desiredKvmState,omitempty"`
-
-	// DesiredPowerState The host power state.
-	DesiredPowerState *PowerState `json:"desiredPowerState,omitempty"`
-
-	// DesiredSolState SOL session lifecycle state; SOL_STATE_CONSENT_RECEIVED and SOL_STATE_REDIRECTION_RECEIVED are desired-only values.
-	DesiredSolState *SolState `json:"desiredSolState,omitempty"`
-
-	// DesiredState States of the host.
-	DesiredState *HostState `json:"desiredState,omitempty"`
-
-	// HostGpus Back-reference to attached host GPU resources.
-	HostGpus *[]HostgpuResource `json:"hostGpus,omitempty"`
-
-	// HostNics Back-reference to attached host NIC resources.
-	HostNics *[]HostnicResource `json:"hostNics,omitempty"`
-
-	// HostStatus textual message that describes the runtime status of Host. Set by RMs only.
-	HostStatus *string `json:"hostStatus,omitempty"`
-
-	// HostStatusIndicator The status indicator.
-	HostStatusIndicator *StatusIndication `json:"hostStatusIndicator,omitempty"`
-
-	// HostStatusTimestamp UTC timestamp when host_status was last changed. Set by RMs only.
-	HostStatusTimestamp *int `json:"hostStatusTimestamp,omitempty"`
-
-	// HostStorages Back-reference to attached host storage resources.
-	HostStorages *[]HoststorageResource `json:"hostStorages,omitempty"`
-
-	// HostUsbs Back-reference to attached host USB resources.
-	HostUsbs *[]HostusbResource `json:"hostUsbs,omitempty"`
-
-	// Hostname Hostname.
-	Hostname *string `json:"hostname,omitempty"`
-
-	// InheritedMetadata The metadata inherited by the host, represented by a list of key:value pairs, rendered by location and logical structures.
-	InheritedMetadata *[]MetadataItem `json:"inheritedMetadata,omitempty"`
-
-	// Instance InstanceResource describes an instantiated OS install, running on either a
-	//  host or hypervisor.
-	Instance *InstanceResource `json:"instance,omitempty"`
-
-	// KvmSessionStatus Human-readable KVM session status message. Set by kvm-manager only.
-	KvmSessionStatus *string `json:"kvmSessionStatus,omitempty"`
-
-	// KvmStatus KvmStatus reflects whether a KVM remote session is currently active on the host.
-	KvmStatus *KvmStatus `json:"kvmStatus,omitempty"`
-
-	// MemoryBytes Quantity of memory (RAM) in the system in bytes.
-	MemoryBytes *string `json:"memoryBytes,omitempty"`
-
-	// Metadata (OPTIONAL) The metadata associated with the host, represented by a list of key:value pairs.
-	Metadata *[]MetadataItem `json:"metadata,omitempty"`
-
-	// Name The host name.
-	Name string `json:"name"`
-
-	// Note The note associated with the host.
-	Note *string `json:"note,omitempty"`
-
-	// OnboardingStatus textual message that describes the onboarding status of Host. Set by RMs only.
-	OnboardingStatus *string `json:"onboardingStatus,omitempty"`
-
-	// OnboardingStatusIndicator The status indicator.
-	OnboardingStatusIndicator *StatusIndication `json:"onboardingStatusIndicator,omitempty"`
-
-	// OnboardingStatusTimestamp UTC timestamp when onboarding_status was last changed. Set by RMs only.
-	OnboardingStatusTimestamp *int `json:"onboardingStatusTimestamp,omitempty"`
-
-	// PowerCommandPolicy The policy for handling power commands.
-	PowerCommandPolicy *PowerCommandPolicy `json:"powerCommandPolicy,omitempty"`
-
-	// PowerOnTime UTC timestamp when the host was powered on. Set by DM RM only.
-	PowerOnTime *int `json:"powerOnTime,omitempty"`
-
-	// PowerStatus textual message that describes the runtime status of Host power. Set by DM RM only.
-	PowerStatus *string `json:"powerStatus,omitempty"`
-
-	// PowerStatusIndicator The status indicator.
-	PowerStatusIndicator *StatusIndication `json:"powerStatusIndicator,omitempty"`
-
-	// PowerStatusTimestamp UTC timestamp when power_status was last changed. Set by DM RM only.
-	PowerStatusTimestamp *int `json:"powerStatusTimestamp,omitempty"`
+// GetProviderRequest Request message for the GetProvider method.
+type GetProviderRequest struct {
+	// ProjectName Project name
+	ProjectName string `json:"projectName"`
 
-	// ProductName System Product Name.
-	ProductName *string `json:"productName,omitempty"`
+	// ResourceId Name of the requested provider.
+	ResourceId string `json:"resourceId"`
+}
 
+// GetProviderResponse Response message for the GetProvider method.
+type GetProviderResponse struct {
 	// Provider A provider resource.
-	Provider *ProviderResource `json:"provider,omitempty"`
-
-	// RegistrationStatus textual message that describes the onboarding status of Host. Set by RMs only.
-	RegistrationStatus *string `json:"registrationStatus,omitempty"`
+	Provider ProviderResource `json:"provider"`
+}
 
-	// RegistrationStatusIndicator The status indicator.
-	RegistrationStatusIndicator *StatusIndication `json:"registrationStatusIndicator,omitempty"`
+// GetRegionRequest Request message for the GetRegion method.
+type GetRegionRequest struct {
+	// ProjectName Project name
+	ProjectName string `json:"projectName"`
 
-	// RegistrationStatusTimestamp UTC timestamp when registration_status was last changed. Set by RMs only.
-	RegistrationStatusTimestamp *int `json:"registrationStatusTimestamp,omitempty"`
+	// ResourceId Name of the requested region.
+	ResourceId string `json:"resourceId"`
+}
 
-	// ResourceId Resource ID, generated on Create.
-	ResourceId *string `json:"resourceId,omitempty"`
+// GetRegionResponse Response message for the GetRegion method.
+type GetRegionResponse struct {
+	// Region A region resource.
+	Region RegionResource `json:"region"`
+}
 
-	// SerialNumber SMBIOS device serial number.
-	SerialNumber *string `json:"serialNumber,omitempty"`
+// GetRemoteAccessRequest Request message for the GetRemoteAccess method.
+type GetRemoteAccessRequest struct {
+	// ProjectName Project name
+	ProjectName string `json:"projectName"`
 
-	// Site A site resource.
-	Site *SiteResource `json:"site,omitempty"`
+	// ResourceId Name of the requested remote access session.
+	ResourceId string `json:"resourceId"`
+}
 
-	// SiteId The site where the host is located.
-	SiteId *string `json:"siteId,omitempty"`
+// GetRepeatedScheduleRequest Request message for the GetRepeatedSchedule method.
+type GetRepeatedScheduleRequest struct {
+	// ProjectName Project name
+	ProjectName string `json:"projectName"`
 
-	// SolSessionStatus Human-readable SOL session status message. Set by sol-manager only.
-	SolSessionStatus *string `json:"solSessionStatus,omitempty"`
+	// ResourceId Name of the requested repeated_schedule.
+	ResourceId string `json:"resourceId"`
+}
 
-	// SolStatus SolStatus reflects whether a SOL remote session is currently active on the host.
-	SolStatus  *SolStatus  `json:"solStatus,omitempty"`
-	Timestamps *Timestamps `json:"timestamps,omitempty"`
+// GetRepeatedScheduleResponse Response message for the GetRepeatedSchedule method.
+type GetRepeatedScheduleResponse struct {
+	// RepeatedSchedule A repeated-schedule resource.
+	RepeatedSchedule RepeatedScheduleResource `json:"repeatedSchedule"`
+}
 
-	// UserLvmSize LVM size in GB.
-	UserLvmSize *int `json:"userLvmSize,omitempty"`
+// GetRoleBindingRequest Request message for the GetRoleBinding method.
+type GetRoleBindingRequest struct {
+	// ProjectName Project name
+	ProjectName string `json:"projectName"`
 
-	// Uuid (OPTIONAL) The host UUID identifier; UUID is unique and immutable.
-	Uuid *string `json:"uuid,omitempty"`
+	// ResourceId Name of the requested role binding.
+	ResourceId string `json:"resourceId"`
 }
 
-// HostState States of the host.
-type HostState string
+// GetSingleScheduleRequest Request message for the GetSingleSchedule method.
+type GetSingleScheduleRequest struct {
+	// ProjectName Project name
+	ProjectName string `json:"projectName"`
 
-// HostgpuResource The set of available host GPU cards.
-type HostgpuResource struct {
-	// Capabilities The features of this GPU device, comma separated.
-	Capabilities *[]string `json:"capabilities,omitempty"`
+	// ResourceId Name of the requested single_schedule.
+	ResourceId string `json:"resourceId"`
+}
 
-	// Description The human-readable GPU device description.
-	Description *string `json:"description,omitempty"`
+// GetSingleScheduleResponse Response message for the GetSingleSchedule method.
+type GetSingleScheduleResponse struct {
+	// SingleSchedule A single schedule resource.
+	SingleSchedule SingleScheduleResource `json:"singleSchedule"`
+}
 
-	// DeviceName GPU name as reported by OS.
-	DeviceName *string `json:"deviceName,omitempty"`
+// GetSiteRequest Request message for the GetSite method.
+type GetSiteRequest struct {
+	// Fields `FieldMask` represents a set of symbolic field paths, for example:
+	//
+	//      paths: "f.a"
+	//      paths: "f.b.d"
+	//
+	//  Here `f` represents a field in some root message, `a` and `b`
+	//  fields in the message found in `f`, and `d` a field found in the
+	//  message in `f.b`.
+	//
+	//  Field masks are used to specify a subset of fields that should be
+	//  returned by a get operation or modified by an update operation.
+	//  Field masks also have a custom JSON encoding (see below).
+	//
+	//  # Field Masks in Projections
+	//
+	//  When used in the context of a projection, a response message or
+	//  sub-message is filtered by the API to only contain those fields as
+	//  specified in the mask. For example, if the mask in the previous
+	//  example is applied to a response message as follows:
+	//
+	//      f {
+	//        a : 22
+	//        b {
+	//          d : 1
+	//          x : 2
+	//        }
+	//        y : 13
+	//      }
+	//      z: 8
+	//
+	//  The result will not contain specific values for fields x,y and z
+	//  (their value will be set to the default, and omitted in proto text
+	//  output):
+	//
+	//
+	//      f {
+	//        a : 22
+	//        b {
+	//          d : 1
+	//        }
+	//      }
+	//
+	//  A repeated field is not allowed except at the last position of a
+	//  paths string.
+	//
+	//  If a FieldMask object is not present in a get operation, the
+	//  operation applies to all fields (as if a FieldMask of all fields
+	//  had been specified).
+	//
+	//  Note that a field mask does not necessarily apply to the
+	//  top-level response message. In case of a REST get operation, the
+	//  field mask applies directly to the response, but in case of a REST
+	//  list operation, the mask instead applies to each individual message
+	//  in the returned resource list. In case of a REST custom method,
+	//  other definitions may be used. Where the mask applies will be
+	//  clearly documented together with its declaration in the API.  In
+	//  any case, the effect on the returned resource/resources is required
+	//  behavior for APIs.
+	//
+	//  # Field Masks in Update Operations
+	//
+	//  A field mask in update operations specifies which fields of the
+	//  targeted resource are going to be updated. The API is required
+	//  to only change the values of the fields as specified in the mask
+	//  and leave the others untouched. If a resource is passed in to
+	//  describe the updated values, the API ignores the values of all
+	//  fields not covered by the mask.
+	//
+	//  If a repeated field is specified for an update operation, new values will
+	//  be appended to the existing repeated field in the target resource. Note that
+	//  a repeated field is only allowed in the last position of a `paths` string.
+	//
+	//  If a sub-message is specified in the last position of the field mask for an
+	//  update operation, then new value will be merged into the existing sub-message
+	//  in the target resource.
+	//
+	//  For example, given the target message:
+	//
+	//      f {
+	//        b {
+	//          d: 1
+	//          x: 2
+	//        }
+	//        c: [1]
+	//      }
+	//
+	//  And an update message:
+	//
+	//      f {
+	//        b {
+	//          d: 10
+	//        }
+	//        c: [2]
+	//      }
+	//
+	//  then if the field mask is:
+	//
+	//   paths: ["f.b", "f.c"]
+	//
+	//  then the result will be:
+	//
+	//      f {
+	//        b {
+	//          d: 10
+	//          x: 2
+	//        }
+	//        c: [1, 2]
+	//      }
+	//
+	//  An implementation may provide options to override this default behavior for
+	//  repeated and message fields.
+	//
+	//  In order to reset a field's value to the default, the field must
+	//  be in the mask and set to the default value in the provided resource.
+	//  Hence, in order to reset all fields of a resource, provide a default
+	//  instance of the resource and set all fields in the mask, or do
+	//  not provide a mask as described below.
+	//
+	//  If a field mask is not present on update, the operation applies to
+	//  all fields (as if a field mask of all fields has been specified).
+	//  Note that in the presence of schema evolution, this may mean that
+	//  fields the client does not know and has therefore not filled into
+	//  the request will be reset to their default. If this is unwanted
+	//  behavior, a specific service may require a client to always specify
+	//  a field mask, producing an error if not.
+	//
+	//  As with get operations, the location of the resource which
+	//  describes the updated values in the request message depends on the
+	//  operation kind. In any case, the effect of the field mask is
+	//  required to be honored by the API.
+	//
+	//  ## Considerations for HTTP REST
+	//
+	//  The HTTP kind of an update operation which uses a field mask must
+	//  be set to PATCH instead of PUT in order to satisfy HTTP semantics
+	//  (PUT must only be used for full updates).
+	//
+	//  # JSON Encoding of Field Masks
+	//
+	//  In JSON, a field mask is encoded as a single string where paths are
+	//  separated by a comma. Fields name in each path are converted
+	//  to/from lower-camel naming conventions.
+	//
+	//  As an example, consider the following message declarations:
+	//
+	//      message Profile {
+	//        User user = 1;
+	//        Photo photo = 2;
+	//      }
+	//      message User {
+	//        string display_name = 1;
+	//        string address = 2;
+	//      }
+	//
+	//  In proto a field mask for `Profile` may look as such:
+	//
+	//      mask {
+	//        paths: "user.display_name"
+	//        paths: "photo"
+	//      }
+	//
+	//  In JSON, the same mask is represented as below:
+	//
+	//      {
+	//        mask: "user.displayName,photo"
+	//      }
+	//
+	//  # Field Masks and Oneof Fields
+	//
+	//  Field masks treat fields in oneofs just as regular fields. Consider the
+	//  following message:
+	//
+	//      message SampleMessage {
+	//        oneof test_oneof {
+	//          string name = 4;
+	//          SubMessage sub_message = 9;
+	//        }
+	//      }
+	//
+	//  The field mask can be:
+	//
+	//      mask {
+	//        paths: "name"
+	//      }
+	//
+	//  Or:
+	//
+	//      mask {
+	//        paths: "sub_message"
+	//      }
+	//
+	//  Note that oneof type names ("test_oneof" in this case) cannot be used in
+	//  paths.
+	//
+	//  ## Field Mask Verification
+	//
+	//  The implementation of any API method which has a FieldMask type field in the
+	//  request should verify the included field paths, and return an
+	//  `INVALID_ARGUMENT` error if any path is unmappable.
+	Fields *GoogleProtobufFieldMask `json:"fields,omitempty"`
 
-	// PciId The GPU device PCI identifier.
-	PciId *string `json:"pciId,omitempty"`
+	// ProjectName Project name
+	ProjectName string `json:"projectName"`
 
-	// Product The GPU device model.
-	Product    *string     `json:"product,omitempty"`
-	Timestamps *Timestamps `json:"timestamps,omitempty"`
+	// RegionId (OPTIONAL) Optional region ID for hierarchical path support
+	RegionId *string `json:"regionId,omitempty"`
 
-	// Vendor The GPU device vendor.
-	Vendor *string `json:"vendor,omitempty"`
-}
+	// ResourceId Name of the requested site.
+	ResourceId string `json:"resourceId"`
 
-// HostnicResource The set of available host interfaces.
-type HostnicResource struct {
-	// BmcInterface Whether this is a bmc interface or not.
-	BmcInterface *bool `json:"bmcInterface,omitempty"`
+	// View The fields returned by Get and List methods supporting views.
+	//  See https://google.aip.dev/157 for details.
+	View *ResourceView `json:"view,omitempty"`
+}
 
-	// DeviceName The device name (OS provided, like eth0, enp1s0, etc.).
-	DeviceName *string `json:"deviceName,omitempty"`
+// GetSiteResponse Response message for the GetSite method.
+type GetSiteResponse struct {
+	// Site A site resource.
+	Site SiteResource `json:"site"`
+}
 
-	// Ipaddresses The interface's IP address list.
-	Ipaddresses *[]IPAddressResource       `json:"ipaddresses,omitempty"`
-	LinkState   *NetworkInterfaceLinkState `json:"linkState,omitempty"`
+// GetTelemetryLogsGroupRequest Request message for the GetTelemetryLogsGroup method.
+type GetTelemetryLogsGroupRequest struct {
+	// ProjectName Project name
+	ProjectName string `json:"projectName"`
 
-	// MacAddr The interface MAC address.
-	MacAddr *string `json:"macAddr,omitempty"`
+	// ResourceId Name of the requested telemetry_logs_group.
+	ResourceId string `json:"resourceId"`
+}
 
-	// Mtu Maximum transmission unit of the interface.
-	Mtu *int `json:"mtu,omitempty"`
-
-	// PciIdentifier PCI identifier string for this network interface.
-	PciIdentifier *string `json:"pciIdentifier,omitempty"`
-
-	// SriovEnabled If the interface has SRIOV enabled.
-	SriovEnabled *bool `json:"sriovEnabled,omitempty"`
+// GetTelemetryLogsGroupResponse Response message for the GetTelemetryLogsGroup method.
+type GetTelemetryLogsGroupResponse struct {
+	// TelemetryLogsGroup TelemetryLogsGroupResource.
+	TelemetryLogsGroup TelemetryLogsGroupResource `json:"telemetryLogsGroup"`
+}
 
-	// SriovVfsNum The number of VFs currently provisioned on the interface, if SR-IOV is supported.
-	SriovVfsNum *int `json:"sriovVfsNum,omitempty"`
+// GetTelemetryLogsProfileRequest Request message for the GetTelemetryLogsProfile method.
+type GetTelemetryLogsProfileRequest struct {
+	// ProjectName Project name
+	ProjectName string `json:"projectName"`
 
-	// SriovVfsTotal The maximum number of VFs the interface supports, if SR-IOV is supported.
-	SriovVfsTotal *int        `json:"sriovVfsTotal,omitempty"`
-	Timestamps    *Timestamps `json:"timestamps,omitempty"`
+	// ResourceId Name of the requested telemetry_logs_profile.
+	ResourceId string `json:"resourceId"`
 }
 
-// HoststorageResource The set of available host storage capabilities.
-type HoststorageResource struct {
-	// CapacityBytes The storage device Capacity (size) in bytes.
-	CapacityBytes *string `json:"capacityBytes,omitempty"`
+// GetTelemetryLogsProfileResponse Response message for the GetTelemetryLogsProfile method.
+type GetTelemetryLogsProfileResponse struct {
+	// TelemetryLogsProfile A telemetry log profile for a hierarchy object.
+	TelemetryLogsProfile TelemetryLogsProfileResource `json:"telemetryLogsProfile"`
+}
 
-	// DeviceName The storage device device name (OS provided, like sda, sdb, etc.)
-	DeviceName *string `json:"deviceName,omitempty"`
+// GetTelemetryMetricsGroupRequest Request message for the GetTelemetryMetricsGroup method.
+type GetTelemetryMetricsGroupRequest struct {
+	// ProjectName Project name
+	ProjectName string `json:"projectName"`
 
-	// Model The storage device model.
-	Model *string `json:"model,omitempty"`
+	// ResourceId Name of the requested telemetry_metrics_group.
+	ResourceId string `json:"resourceId"`
+}
 
-	// Serial The storage device unique serial number.
-	Serial     *string     `json:"serial,omitempty"`
-	Timestamps *Timestamps `json:"timestamps,omitempty"`
+// GetTelemetryMetricsGroupResponse Response message for the GetTelemetryMetricsGroup method.
+type GetTelemetryMetricsGroupResponse struct {
+	// TelemetryMetricsGroup TelemetryMetricsGroupResource.
+	TelemetryMetricsGroup TelemetryMetricsGroupResource `json:"telemetryMetricsGroup"`
+}
 
-	// Vendor The Storage device vendor.
-	Vendor *string `json:"vendor,omitempty"`
+// GetTelemetryMetricsProfileRequest Request message for the GetTelemetryMetricsProfile method.
+type GetTelemetryMetricsProfileRequest struct {
+	// ProjectName Project name
+	ProjectName string `json:"projectName"`
 
-	// Wwid The storage device unique identifier.
-	Wwid *string `json:"wwid,omitempty"`
+	// ResourceId Name of the requested telemetry_metrics_profile.
+	ResourceId string `json:"resourceId"`
 }
 
-// HostusbResource The set of host USB resources.
-type HostusbResource struct {
-	// Addr USB Device number assigned by OS.
-	Addr *int `json:"addr,omitempty"`
+// GetTelemetryMetricsProfileResponse Response message for the GetTelemetryMetricsProfile method.
+type GetTelemetryMetricsProfileResponse struct {
+	// TelemetryMetricsProfile A telemetry metric profile for a hierarchy object.
+	TelemetryMetricsProfile TelemetryMetricsProfileResource `json:"telemetryMetricsProfile"`
+}
 
-	// Bus Bus number of device connected with.
-	Bus *int `json:"bus,omitempty"`
+// GetWorkloadMemberRequest Request message for the GetWorkloadMember method.
+type GetWorkloadMemberRequest struct {
+	// ProjectName Project name
+	ProjectName string `json:"projectName"`
 
-	// Class class defined by USB-IF.
-	Class *string `json:"class,omitempty"`
+	// ResourceId Name of the requested workload_member.
+	ResourceId string `json:"resourceId"`
+}
 
-	// DeviceName the OS-provided device name.
-	DeviceName *string `json:"deviceName,omitempty"`
+// GetWorkloadMemberResponse Response message for the GetWorkloadMember method.
+type GetWorkloadMemberResponse struct {
+	// WorkloadMember Intermediate resource to represent a relation between a workload and a compute resource (i.e., instance).
+	WorkloadMember WorkloadMember `json:"workloadMember"`
+}
 
-	// IdProduct Hexadecimal number representing ID of the USB device product.
-	IdProduct *string `json:"idProduct,omitempty"`
+// GetWorkloadRequest Request message for the GetWorkload method.
+type GetWorkloadRequest struct {
+	// ProjectName Project name
+	ProjectName string `json:"projectName"`
 
-	// IdVendor Hexadecimal number representing ID of the USB device vendor.
-	IdVendor *string `json:"idVendor,omitempty"`
+	// ResourceId Name of the requested workload.
+	ResourceId string `json:"resourceId"`
+}
 
-	// Serial Serial number of device.
-	Serial     *string     `json:"serial,omitempty"`
-	Timestamps *Timestamps `json:"timestamps,omitempty"`
+// GetWorkloadResponse Response message for the GetWorkload method.
+type GetWorkloadResponse struct {
+	// Workload A generic way to group compute resources to obtain a workload.
+	Workload WorkloadResource `json:"workload"`
 }
 
-// IPAddressConfigMethod defines model for IPAddressConfigMethod.
-type IPAddressConfigMethod string
+// HostBulkAction The action applied to each Host by BulkHostAction.
+type HostBulkAction string
 
-// IPAddressResource defines model for IPAddressResource.
-type IPAddressResource struct {
-	// Address CIDR representation of the IP address.
-	Address      *string                `json:"address,omitempty"`
-	ConfigMethod *IPAddressConfigMethod `json:"configMethod,omitempty"`
+// HostComponentState The state of the Host component.
+type HostComponentState string
 
-	// ResourceId Resource ID, generated by Inventory on Create
-	ResourceId *string          `json:"resourceId,omitempty"`
-	Status     *IPAddressStatus `json:"status,omitempty"`
+// HostRegister Message to register a Host.
+type HostRegister struct {
+	// AutoOnboard Flag to signal to automatically onboard the host.
+	AutoOnboard *bool `json:"autoOnboard,omitempty"`
 
-	// StatusDetail User-friendly status to provide details about the resource state
-	StatusDetail *string     `json:"statusDetail,omitempty"`
-	Timestamps   *Timestamps `json:"timestamps,omitempty"`
-}
+	// EnableVpro Flag to signal to enable vPRO on the host.
+	EnableVpro *bool `json:"enableVpro,omitempty"`
 
-// IPAddressState defines model for IPAddressState.
-type IPAddressState string
+	// Name The host name.
+	Name *string `json:"name,omitempty"`
 
-// IPAddressStatus defines model for IPAddressStatus.
-type IPAddressStatus string
+	// SerialNumber The host serial number.
+	SerialNumber *string `json:"serialNumber,omitempty"`
 
-// InstanceKind The Instance kind.
-type InstanceKind string
+	// UserLvmSize LVM size in GB
+	UserLvmSize *int `json:"userLvmSize,omitempty"`
 
-// InstanceResource InstanceResource describes an instantiated OS install, running on either a
-//
-//	host or hypervisor.
-type InstanceResource struct {
-	// CurrentState The Instance States.
-	CurrentState *InstanceState `json:"currentState,omitempty"`
+	// Uuid The host UUID.
+	Uuid *string `json:"uuid,omitempty"`
+}
 
-	// CustomConfig The list of custom config associated with the instance.
-	CustomConfig *[]CustomConfigResource `json:"customConfig,omitempty"`
+// HostRegisterEntry Message to register a Host as part of a bulk registration.
+type HostRegisterEntry struct {
+	// Host Message to register a Host.
+	Host HostRegister `json:"host"`
 
-	// CustomConfigID The list of custom config associated with the instance.
-	CustomConfigID *[]string `json:"customConfigID,omitempty"`
+	// Metadata The metadata associated with the host, represented by a list of key:value pairs.
+	Metadata *[]MetadataItem `json:"metadata,omitempty"`
 
-	// DesiredState The Instance States.
-	DesiredState *InstanceState `json:"desiredState,omitempty"`
+	// SiteId The site where the host is located.
+	SiteId *string `json:"siteId,omitempty"`
+}
 
-	// ExistingCves The CVEs that are currently present on the Instance, encoded as a JSON list.
-	ExistingCves *string `json:"existingCves,omitempty"`
+// HostResource A Host resource.
+type HostResource struct {
+	AmtControlMode *AmtControlMode `json:"amtControlMode,omitempty"`
 
-	// Host A Host resource.
-	Host *HostResource `json:"host,omitempty"`
+	// AmtDnsSuffix (OPTIONAL) textual message that describes dns_suffix for ACM mode.
+	AmtDnsSuffix *string `json:"amtDnsSuffix,omitempty"`
+	AmtSku       *AmtSku `json:"amtSku,omitempty"`
 
-	// HostID The host's unique identifier associated with the instance.
-	HostID *string `json:"hostID,omitempty"`
+	// AmtStatus coming from device introspection. Set only by the DM RM.
+	AmtStatus *string `json:"amtStatus,omitempty"`
 
-	// InstanceID Deprecated, The instance's unique identifier. Alias of resourceID.
-	InstanceID *string `json:"instanceID,omitempty"`
+	// AmtStatusIndicator The status indicator.
+	AmtStatusIndicator *StatusIndication `json:"amtStatusIndicator,omitempty"`
 
-	// InstanceStatus textual message that describes the current instance status. Set by RMs only.
-	InstanceStatus *string `json:"instanceStatus,omitempty"`
+	// AmtStatusTimestamp UTC timestamp when amt_status was last changed. Set by DM and OM RM only.
+	AmtStatusTimestamp *int `json:"amtStatusTimestamp,omitempty"`
 
-	// InstanceStatusDetail Textual message that gives detailed status of the instance's software components.
-	InstanceStatusDetail *string `json:"instanceStatusDetail,omitempty"`
+	// BiosReleaseDate BIOS Release Date.
+	BiosReleaseDate *string `json:"biosReleaseDate,omitempty"`
 
-	// InstanceStatusIndicator The status indicator.
-	InstanceStatusIndicator *StatusIndication `json:"instanceStatusIndicator,omitempty"`
+	// BiosVendor BIOS Vendor.
+	BiosVendor *string `json:"biosVendor,omitempty"`
 
-	// InstanceStatusTimestamp UTC timestamp when instance_status was last changed. Set by RMs only.
-	InstanceStatusTimestamp *int `json:"instanceStatusTimestamp,omitempty"`
+	// BiosVersion BIOS Version.
+	BiosVersion *string `json:"biosVersion,omitempty"`
 
-	// Kind The Instance kind.
-	Kind *InstanceKind `json:"kind,omitempty"`
+	// BmcIp BMC IP address, such as "192.0.0.1".
+	BmcIp *string `json:"bmcIp,omitempty"`
 
-	// LocalAccountID The unique identifier of local account will be associated with the instance.
-	LocalAccountID *string               `json:"localAccountID,omitempty"`
-	Localaccount   *LocalAccountResource `json:"localaccount,omitempty"`
+	// BmcKind The type of BMC.
+	BmcKind *BaremetalControllerKind `json:"bmcKind,omitempty"`
 
-	// Name The instance's human-readable name.
-	Name *string `json:"name,omitempty"`
+	// CpuArchitecture Architecture of the CPU model, e.g. x86_64.
+	CpuArchitecture *string `json:"cpuArchitecture,omitempty"`
 
-	// Os An OS resource.
-	Os *OperatingSystemResource `json:"os,omitempty"`
+	// CpuCapabilities String list of all CPU capabilities (possibly JSON).
+	CpuCapabilities *string `json:"cpuCapabilities,omitempty"`
 
-	// OsID The unique identifier of OS resource that must be installed on the instance. The field is used to drive the day0 operations, and immutable once set the first time.
-	OsID *string `json:"osID,omitempty"`
+	// CpuCores Number of CPU cores.
+	CpuCores *int `json:"cpuCores,omitempty"`
 
-	// OsUpdateAvailable Details about OS Updates available for this Instance. If empty, there are no updates available.
-	OsUpdateAvailable *string `json:"osUpdateAvailable,omitempty"`
-	OsUpdatePolicyID  *string `json:"osUpdatePolicyID,omitempty"`
+	// CpuModel CPU model of the Host.
+	CpuModel *string `json:"cpuModel,omitempty"`
 
-	// ProvisioningStatus textual message that describes the provisioning status of Instance. Set by RMs only.
-	ProvisioningStatus *string `json:"provisioningStatus,omitempty"`
+	// CpuSockets Number of physical CPU sockets.
+	CpuSockets *int `json:"cpuSockets,omitempty"`
 
-	// ProvisioningStatusIndicator The status indicator.
-	ProvisioningStatusIndicator *StatusIndication `json:"provisioningStatusIndicator,omitempty"`
+	// CpuThreads Total Number of threads supported by the CPU.
+	CpuThreads *int `json:"cpuThreads,omitempty"`
 
-	// ProvisioningStatusTimestamp UTC timestamp when provisioning_status was last changed. Set by RMs only.
-	ProvisioningStatusTimestamp *int `json:"provisioningStatusTimestamp,omitempty"`
+	// CpuTopology JSON field storing the CPU topology, refer to HDA/HRM docs for the JSON schema.
+	CpuTopology *string `json:"cpuTopology,omitempty"`
 
-	// ResourceId Resource ID, generated on Create.
-	ResourceId *string `json:"resourceId,omitempty"`
+	// CurrentAmtState The state of the AMT (Active Management Technology) component.
+	CurrentAmtState *AmtState `json:"currentAmtState,omitempty"`
 
-	// RuntimePackages The packages available on the Instance at runtime, represented as a JSON list.
-	RuntimePackages *string `json:"runtimePackages,omitempty"`
+	// CurrentKvmState KVM session lifecycle state; KVM_CONSENT_RECEIVED and KVM_REDIRECTION_RECEIVED are desired-only values.
+	CurrentKvmState *KvmState `json:"currentKvmState,omitempty"`
 
-	// SecurityFeature SecurityFeature describes the security capabilities of a resource.
-	SecurityFeature *SecurityFeature `json:"securityFeature,omitempty"`
-	Timestamps      *Timestamps      `json:"timestamps,omitempty"`
+	// CurrentPowerState The host power state.
+	CurrentPowerState *PowerState `json:"currentPowerState,omitempty"`
 
-	// TrustedAttestationStatus textual message that describes the trusted_attestation status of Instance. Set by RMs only.
-	TrustedAttestationStatus *string `json:"trustedAttestationStatus,omitempty"`
+	// CurrentSolState SOL session lifecycle state; SOL_STATE_CONSENT_RECEIVED and SOL_STATE_REDIRECTION_RECEIVED are desired-only values.
+	CurrentSolState *SolState `json:"currentSolState,omitempty"`
 
-	// TrustedAttestationStatusIndicator The status indicator.
-	TrustedAttestationStatusIndicator *StatusIndication `json:"trustedAttestationStatusIndicator,omitempty"`
+	// CurrentState States of the host.
+	CurrentState *HostState `json:"currentState,omitempty"`
 
-	// TrustedAttestationStatusTimestamp UTC timestamp when trusted_attestation_status was last changed. Set by RMs only.
-	TrustedAttestationStatusTimestamp *int            `json:"trustedAttestationStatusTimestamp,omitempty"`
-	UpdatePolicy                      *OSUpdatePolicy `json:"updatePolicy,omitempty"`
-
-	// UpdateStatus textual message that describes the update status of Instance. Set by RMs only.
-	UpdateStatus *string `json:"updateStatus,omitempty"`
-
-	// UpdateStatusIndicator The status indicator.
-	UpdateStatusIndicator *StatusIndication `json:"updateStatusIndicator,omitempty"`
-
-	// UpdateStatusTimestamp UTC timestamp when update_status was last changed. Set by RMs only.
-	UpdateStatusTimestamp *int `json:"updateStatusTimestamp,omitempty"`
+	// DesiredAmtState The state of the AMT (Active Management Technology) component.
+	DesiredAmtState *AmtState `json:"desiredAmtState,omitempty"`
 
-	// WorkloadMembers The workload members associated with the instance. back-reference to the Workload Members associated to this Instance
-	WorkloadMembers *[]WorkloadMember `json:"workloadMembers,omitempty"`
-}
+	// DesiredKvmState KVM session lifecycle state; KVM_CONSENT_RECEIVED and KVM_REDIRECTION_RECEIVED are desired-only values.
+	DesiredKvmState *KvmState `json:"desiredKvmState,omitempty"`
 
-// InstanceState The Instance States.
-type InstanceState string
+	// DesiredPowerState The host power state.
+	DesiredPowerState *PowerState `json:"desiredPowerState,omitempty"`
 
-// InvalidateHostRequest Request to invalidate/untrust a Host.
-type InvalidateHostRequest struct {
-	// Note user-provided reason for change or a freeform field
-	Note *string `json:"note,omitempty"`
+	// DesiredSolState SOL session lifecycle state; SOL_STATE_CONSENT_RECEIVED and SOL_STATE_REDIRECTION_RECEIVED are desired-only values.
+	DesiredSolState *SolState `json:"desiredSolState,omitempty"`
 
-	// ProjectName The project name from the URL path.
-	ProjectName string `json:"projectName"`
+	// DesiredState States of the host.
+	DesiredState *HostState `json:"desiredState,omitempty"`
 
-	// ResourceId Host resource ID
-	// string.max_bytes = 13
-	ResourceId *string `json:"resourceId,omitempty"`
-}
+	// HostGpus Back-reference to attached host GPU resources.
+	HostGpus *[]HostgpuResource `json:"hostGpus,omitempty"`
 
-// InvalidateHostResponse Response message for InvalidateHost.
-type InvalidateHostResponse = map[string]interface{}
+	// HostNics Back-reference to attached host NIC resources.
+	HostNics *[]HostnicResource `json:"hostNics,omitempty"`
 
-// InvalidateInstanceRequest Request message for Invalidate Instance.
-type InvalidateInstanceRequest struct {
-	// ProjectName The project name from the URL path.
-	ProjectName string `json:"projectName"`
+	// HostStatus textual message that describes the runtime status of Host. Set by RMs only.
+	HostStatus *string `json:"hostStatus,omitempty"`
 
-	// ResourceId Instance resource ID
-	// string.max_bytes = 13
-	ResourceId *string `json:"resourceId,omitempty"`
-}
+	// HostStatusIndicator The status indicator.
+	HostStatusIndicator *StatusIndication `json:"hostStatusIndicator,omitempty"`
 
-// InvalidateInstanceResponse Response message for Invalidate Instance.
-type InvalidateInstanceResponse = map[string]interface{}
+	// HostStatusTimestamp UTC timestamp when host_status was last changed. Set by RMs only.
+	HostStatusTimestamp *int `json:"hostStatusTimestamp,omitempty"`
 
-// KvmState KVM session lifecycle state; KVM_CONSENT_RECEIVED and KVM_REDIRECTION_RECEIVED are desired-only values.
-type KvmState string
+	// HostStorages Back-reference to attached host storage resources.
+	HostStorages *[]HoststorageResource `json:"hostStorages,omitempty"`
 
-// KvmStatus KvmStatus reflects whether a KVM remote session is currently active on the host.
-type KvmStatus string
+	// HostUsbs Back-reference to attached host USB resources.
+	HostUsbs *[]HostusbResource `json:"hostUsbs,omitempty"`
 
-// LinkState The state of the network interface.
-type LinkState string
+	// Hostname Hostname.
+	Hostname *string `json:"hostname,omitempty"`
 
-// ListCustomConfigsRequest Request message for the ListCustomConfigs method.
-type ListCustomConfigsRequest struct {
-	// Filter (OPTIONAL) Optional filter to return only item of interest.
-	//  See https://google.aip.dev/160 for details.
-	Filter *string `json:"filter,omitempty"`
+	// InheritedMetadata The metadata inherited by the host, represented by a list of key:value pairs, rendered by location and logical structures.
+	InheritedMetadata *[]MetadataItem `json:"inheritedMetadata,omitempty"`
 
-	// Offset (OPTIONAL) Index of the first item to return. This allows skipping items.
-	Offset *int `json:"offset,omitempty"`
+	// Instance InstanceResource describes an instantiated OS install, running on either a
+	//  host or hypervisor.
+	Instance *InstanceResource `json:"instance,omitempty"`
 
-	// OrderBy (OPTIONAL) Optional comma separated list of fields to specify a sorting order.
-	//  See https://google.aip.dev/132 for details.
-	OrderBy *string `json:"orderBy,omitempty"`
+	// KvmSessionStatus Human-readable KVM session status message. Set by kvm-manager only.
+	KvmSessionStatus *string `json:"kvmSessionStatus,omitempty"`
 
-	// PageSize (OPTIONAL) Defines the amount of items to be contained in a single page.
-	//  Default of 20.
-	PageSize *int `json:"pageSize,omitempty"`
+	// KvmStatus KvmStatus reflects whether a KVM remote session is currently active on the host.
+	KvmStatus *KvmStatus `json:"kvmStatus,omitempty"`
 
-	// ProjectName Project name
-	ProjectName string `json:"projectName"`
-}
+	// MemoryBytes Quantity of memory (RAM) in the system in bytes.
+	MemoryBytes *string `json:"memoryBytes,omitempty"`
 
-// ListCustomConfigsResponse Response message for the ListCustomConfigs method.
-type ListCustomConfigsResponse struct {
-	// CustomConfigs Sorted and filtered list of customconfigs.
-	CustomConfigs []CustomConfigResource `json:"customConfigs"`
+	// Metadata (OPTIONAL) The metadata associated with the host, represented by a list of key:value pairs.
+	Metadata *[]MetadataItem `json:"metadata,omitempty"`
 
-	// HasNext Inform if there are more elements
-	HasNext bool `json:"hasNext"`
+	// Name The host name.
+	Name string `json:"name"`
 
-	// TotalElements Count of items in the entire list, regardless of pagination.
-	TotalElements int32 `json:"totalElements"`
-}
+	// Note The note associated with the host.
+	Note *string `json:"note,omitempty"`
 
-// ListHostsRequest Request message for the ListHosts method.
-type ListHostsRequest struct {
-	// Filter (OPTIONAL) Optional filter to return only item of interest.
-	//  See https://google.aip.dev/160 for details.
-	Filter *string `json:"filter,omitempty"`
+	// OnboardingStatus textual message that describes the onboarding status of Host. Set by RMs only.
+	OnboardingStatus *string `json:"onboardingStatus,omitempty"`
 
-	// Offset (OPTIONAL) Index of the first item to return. This allows skipping items.
-	Offset *int `json:"offset,omitempty"`
+	// OnboardingStatusIndicator The status indicator.
+	OnboardingStatusIndicator *StatusIndication `json:"onboardingStatusIndicator,omitempty"`
 
-	// OrderBy (OPTIONAL) Optional comma separated list of fields to specify a sorting order.
-	//  See https://google.aip.dev/132 for details.
-	OrderBy *string `json:"orderBy,omitempty"`
+	// OnboardingStatusTimestamp UTC timestamp when onboarding_status was last changed. Set by RMs only.
+	OnboardingStatusTimestamp *int `json:"onboardingStatusTimestamp,omitempty"`
 
-	// PageSize (OPTIONAL) Defines the amount of items to be contained in a single page.
-	//  Default of 20.
-	PageSize *int `json:"pageSize,omitempty"`
+	// PowerCommandPolicy The policy for handling power commands.
+	PowerCommandPolicy *PowerCommandPolicy `json:"powerCommandPolicy,omitempty"`
 
-	// ProjectName The project name from the URL path.
-	ProjectName string `json:"projectName"`
-}
+	// PowerOnTime UTC timestamp when the host was powered on. Set by DM RM only.
+	PowerOnTime *int `json:"powerOnTime,omitempty"`
 
-// ListHostsResponse Response message for the ListHosts method.
-type ListHostsResponse struct {
-	// HasNext Inform if there are more elements
-	HasNext bool `json:"hasNext"`
+	// PowerStatus textual message that describes the runtime status of Host power. Set by DM RM only.
+	PowerStatus *string `json:"powerStatus,omitempty"`
 
-	// Hosts Sorted and filtered list of hosts.
-	Hosts []HostResource `json:"hosts"`
+	// PowerStatusIndicator The status indicator.
+	PowerStatusIndicator *StatusIndication `json:"powerStatusIndicator,omitempty"`
 
-	// TotalElements Count of items in the entire list, regardless of pagination.
-	TotalElements int32 `json:"totalElements"`
-}
+	// PowerStatusTimestamp UTC timestamp when power_status was last changed. Set by DM RM only.
+	PowerStatusTimestamp *int `json:"powerStatusTimestamp,omitempty"`
 
-// ListInstancesRequest Request message for the ListInstances method.
-type ListInstancesRequest struct {
-	// Filter (OPTIONAL) Optional filter to return only item of interest.
-	//  See https://google.aip.dev/160 for details.
-	Filter *string `json:"filter,omitempty"`
+	// ProductName System Product Name.
+	ProductName *string `json:"productName,omitempty"`
 
-	// Offset (OPTIONAL) Index of the first item to return. This allows skipping items.
-	Offset *int `json:"offset,omitempty"`
+	// Provider A provider resource.
+	Provider *ProviderResource `json:"provider,omitempty"`
 
-	// OrderBy (OPTIONAL) Optional comma separated list of fields to specify a sorting order.
-	//  See https://google.aip.dev/132 for details.
-	OrderBy *string `json:"orderBy,omitempty"`
+	// RegistrationStatus textual message that describes the onboarding status of Host. Set by RMs only.
+	RegistrationStatus *string `json:"registrationStatus,omitempty"`
 
-	// PageSize (OPTIONAL) Defines the amount of items to be contained in a single page.
-	//  Default of 20.
-	PageSize *int `json:"pageSize,omitempty"`
+	// RegistrationStatusIndicator The status indicator.
+	RegistrationStatusIndicator *StatusIndication `json:"registrationStatusIndicator,omitempty"`
 
-	// ProjectName The project name from the URL path.
-	ProjectName string `json:"projectName"`
-}
+	// RegistrationStatusTimestamp UTC timestamp when registration_status was last changed. Set by RMs only.
+	RegistrationStatusTimestamp *int `json:"registrationStatusTimestamp,omitempty"`
 
-// ListInstancesResponse Response message for the ListInstances method.
-type ListInstancesResponse struct {
-	// HasNext Inform if there are more elements
-	HasNext bool `json:"hasNext"`
+	// ResourceId Resource ID, generated on Create.
+	ResourceId *string `json:"resourceId,omitempty"`
 
-	// Instances Sorted and filtered list of instances.
-	Instances []InstanceResource `json:"instances"`
+	// SerialNumber SMBIOS device serial number.
+	SerialNumber *string `json:"serialNumber,omitempty"`
 
-	// TotalElements Count of items in the entire list, regardless of pagination.
-	TotalElements int32 `json:"totalElements"`
-}
+	// Site A site resource.
+	Site *SiteResource `json:"site,omitempty"`
 
-// ListLocalAccountsRequest Request message for the ListLocalAccounts method.
-type ListLocalAccountsRequest struct {
-	// Filter (OPTIONAL) Optional filter to return only item of interest.
-	//  See https://google.aip.dev/160 for details.
-	Filter *string `json:"filter,omitempty"`
+	// SiteId The site where the host is located.
+	SiteId *string `json:"siteId,omitempty"`
 
-	// Offset (OPTIONAL) Index of the first item to return. This allows skipping items.
-	Offset *int `json:"offset,omitempty"`
+	// SolSessionStatus Human-readable SOL session status message. Set by sol-manager only.
+	SolSessionStatus *string `json:"solSessionStatus,omitempty"`
 
-	// OrderBy (OPTIONAL) Optional comma separated list of fields to specify a sorting order.
-	//  See https://google.aip.dev/132 for details.
-	OrderBy *string `json:"orderBy,omitempty"`
+	// SolStatus SolStatus reflects whether a SOL remote session is currently active on the host.
+	SolStatus  *SolStatus  `json:"solStatus,omitempty"`
+	Timestamps *Timestamps `json:"timestamps,omitempty"`
 
-	// PageSize (OPTIONAL) Defines the amount of items to be contained in a single page.
-	//  Default of 20.
-	PageSize *int `json:"pageSize,omitempty"`
+	// UserLvmSize LVM size in GB.
+	UserLvmSize *int `json:"userLvmSize,omitempty"`
 
-	// ProjectName Project name
-	ProjectName string `json:"projectName"`
+	// Uuid (OPTIONAL) The host UUID identifier; UUID is unique and immutable.
+	Uuid *string `json:"uuid,omitempty"`
 }
 
-// ListLocalAccountsResponse Response message for the ListLocalAccounts method.
-type ListLocalAccountsResponse struct {
-	// HasNext Inform if there are more elements
-	HasNext bool `json:"hasNext"`
+// HostState States of the host.
+type HostState string
 
-	// LocalAccounts Sorted and filtered list of localaccounts.
-	LocalAccounts []LocalAccountResource `json:"localAccounts"`
+// HostgpuResource The set of available host GPU cards.
+type HostgpuResource struct {
+	// Capabilities The features of this GPU device, comma separated.
+	Capabilities *[]string `json:"capabilities,omitempty"`
 
-	// TotalElements Count of items in the entire list, regardless of pagination.
-	TotalElements int32 `json:"totalElements"`
-}
+	// Description The human-readable GPU device description.
+	Description *string `json:"description,omitempty"`
 
-// ListLocationsRequest Request message for the ListLocations method.
-type ListLocationsRequest struct {
-	// Name (OPTIONAL) Filter locations by name
-	Name *string `json:"name,omitempty"`
+	// DeviceName GPU name as reported by OS.
+	DeviceName *string `json:"deviceName,omitempty"`
 
-	// ProjectName Project name
-	ProjectName string `json:"projectName"`
+	// PciId The GPU device PCI identifier.
+	PciId *string `json:"pciId,omitempty"`
 
-	// ShowRegions (OPTIONAL) Return region locations
-	ShowRegions *bool `json:"showRegions,omitempty"`
+	// Product The GPU device model.
+	Product    *string     `json:"product,omitempty"`
+	Timestamps *Timestamps `json:"timestamps,omitempty"`
 
-	// ShowSites (OPTIONAL) Return site locations
-	ShowSites *bool `json:"showSites,omitempty"`
+	// Vendor The GPU device vendor.
+	Vendor *string `json:"vendor,omitempty"`
 }
 
-// ListLocationsResponse Response message for the ListLocations method.
-type ListLocationsResponse struct {
-	// Nodes Sorted and filtered list of regions.
-	Nodes []ListLocationsResponseLocationNode `json:"nodes"`
-
-	// OutputElements (OPTIONAL) Amount of items in the returned list.
-	OutputElements *int32 `json:"outputElements,omitempty"`
+// HostnicResource The set of available host interfaces.
+type HostnicResource struct {
+	// BmcInterface Whether this is a bmc interface or not.
+	BmcInterface *bool `json:"bmcInterface,omitempty"`
 
-	// TotalElements (OPTIONAL) Count of items in the entire list, regardless of pagination.
-	TotalElements *int32 `json:"totalElements,omitempty"`
-}
+	// DeviceName The device name (OS provided, like eth0, enp1s0, etc.).
+	DeviceName *string `json:"deviceName,omitempty"`
 
-// ListLocationsResponseLocationNode A node in the location tree.
-type ListLocationsResponseLocationNode struct {
-	// Name The node human readable name.
-	Name string `json:"name"`
+	// HostId The unique identifier of the host the NIC is installed in.
+	HostId *string `json:"hostId,omitempty"`
 
-	// ParentId The associated resource ID, of the parent resource of this Location node.
-	//  In the case of a region, it could be empty or a regionId.
-	//  In the case of a site, it could be empty or a regionId.
-	ParentId string `json:"parentId"`
+	// Ipaddresses The interface's IP address list.
+	Ipaddresses *[]IPAddressResource       `json:"ipaddresses,omitempty"`
+	LinkState   *NetworkInterfaceLinkState `json:"linkState,omitempty"`
 
-	// ResourceId The associated node resource ID, generated by inventory on Create.
-	ResourceId string                            `json:"resourceId"`
-	Type       ListLocationsResponseResourceKind `json:"type"`
-}
+	// MacAddr The interface MAC address.
+	MacAddr *string `json:"macAddr,omitempty"`
 
-// ListLocationsResponseResourceKind defines model for ListLocationsResponse.ResourceKind.
-type ListLocationsResponseResourceKind string
+	// Mtu Maximum transmission unit of the interface.
+	Mtu *int `json:"mtu,omitempty"`
 
-// ListOSUpdatePolicyRequest Request message for the ListOSUpdatePolicy method.
-type ListOSUpdatePolicyRequest struct {
-	// Filter (OPTIONAL) Optional filter to return only item of interest.
-	//  See https://google.aip.dev/160 for details.
-	Filter *string `json:"filter,omitempty"`
+	// PciIdentifier PCI identifier string for this network interface.
+	PciIdentifier *string `json:"pciIdentifier,omitempty"`
 
-	// Offset (OPTIONAL) Index of the first item to return. This allows skipping items.
-	Offset *int `json:"offset,omitempty"`
+	// ResourceId Resource ID, generated by Inventory on Create.
+	ResourceId *string `json:"resourceId,omitempty"`
 
-	// OrderBy (OPTIONAL) Optional comma separated list of fields to specify a sorting order.
-	//  See https://google.aip.dev/132 for details.
-	OrderBy *string `json:"orderBy,omitempty"`
+	// SriovEnabled If the interface has SRIOV enabled.
+	SriovEnabled *bool `json:"sriovEnabled,omitempty"`
 
-	// PageSize (OPTIONAL) Defines the amount of items to be contained in a single page.
-	//  Default of 20.
-	PageSize *int `json:"pageSize,omitempty"`
+	// SriovVfsNum The number of VFs currently provisioned on the interface, if SR-IOV is supported.
+	SriovVfsNum *int `json:"sriovVfsNum,omitempty"`
 
-	// ProjectName Project name
-	ProjectName string `json:"projectName"`
+	// SriovVfsTotal The maximum number of VFs the interface supports, if SR-IOV is supported.
+	SriovVfsTotal *int        `json:"sriovVfsTotal,omitempty"`
+	Timestamps    *Timestamps `json:"timestamps,omitempty"`
 }
 
-// ListOSUpdatePolicyResponse Response message for the ListOSUpdatePolicy method.
-type ListOSUpdatePolicyResponse struct {
-	// HasNext Inform if there are more elements
-	HasNext bool `json:"hasNext"`
-
-	// OsUpdatePolicies Sorted and filtered list of OS Update Policies.
-	OsUpdatePolicies []OSUpdatePolicy `json:"osUpdatePolicies"`
+// HostsDimensionStatistics Breakdown of the hosts along a dimension.
+type HostsDimensionStatistics struct {
+	// Buckets Buckets ordered by decreasing count, then by value. Only non-empty buckets are returned.
+	Buckets *[]HostsStatisticsBucket `json:"buckets,omitempty"`
 
-	// TotalElements Count of items in the entire list, regardless of pagination.
-	TotalElements int32 `json:"totalElements"`
+	// Dimension Dimension along which GetHostsStatistics breaks down the hosts.
+	Dimension *HostsStatisticsDimension `json:"dimension,omitempty"`
 }
 
-// ListOSUpdateRunRequest Request message for the ListOSUpdateRun method.
-type ListOSUpdateRunRequest struct {
-	// Filter (OPTIONAL) Optional filter to return only item of interest.
-	//  See https://google.aip.dev/160 for details.
-	Filter *string `json:"filter,omitempty"`
+// HostsStatisticsBucket Number of hosts per value of a dimension.
+type HostsStatisticsBucket struct {
+	// Count Number of hosts with the value.
+	Count *int `json:"count,omitempty"`
 
-	// Offset (OPTIONAL) Index of the first item to return. This allows skipping items.
-	Offset *int `json:"offset,omitempty"`
+	// Value Value of the dimension, e.g. a site resource ID or a power state. Empty for the hosts where it is not set,
+	//  such as hosts without site or without instance.
+	Value *string `json:"value,omitempty"`
+}
 
-	// OrderBy (OPTIONAL) Optional comma separated list of fields to specify a sorting order.
-	//  See https://google.aip.dev/132 for details.
-	OrderBy *string `json:"orderBy,omitempty"`
+// HostsStatisticsDimension Dimension along which GetHostsStatistics breaks down the hosts.
+type HostsStatisticsDimension string
 
-	// PageSize (OPTIONAL) Defines the amount of items to be contained in a single page.
-	//  Default of 20.
-	PageSize *int `json:"pageSize,omitempty"`
+// HoststorageResource The set of available host storage capabilities.
+type HoststorageResource struct {
+	// CapacityBytes The storage device Capacity (size) in bytes.
+	CapacityBytes *string `json:"capacityBytes,omitempty"`
 
-	// ProjectName Project name
-	ProjectName string `json:"projectName"`
-}
+	// DeviceName The storage device device name (OS provided, like sda, sdb, etc.)
+	DeviceName *string `json:"deviceName,omitempty"`
 
-// ListOSUpdateRunResponse Response message for the ListOSUpdateRun method.
-type ListOSUpdateRunResponse struct {
-	// HasNext Inform if there are more elements
-	HasNext bool `json:"hasNext"`
+	// Model The storage device model.
+	Model *string `json:"model,omitempty"`
 
-	// OsUpdateRuns Sorted and filtered list of os update runs.
-	OsUpdateRuns []OSUpdateRun `json:"osUpdateRuns"`
+	// Serial The storage device unique serial number.
+	Serial     *string     `json:"serial,omitempty"`
+	Timestamps *Timestamps `json:"timestamps,omitempty"`
 
-	// TotalElements Count of items in the entire list, regardless of pagination.
-	TotalElements int32 `json:"totalElements"`
-}
+	// Vendor The Storage device vendor.
+	Vendor *string `json:"vendor,omitempty"`
 
-// ListOperatingSystemsRequest Request message for the ListOperatingSystems method.
-type ListOperatingSystemsRequest struct {
-	// Filter (OPTIONAL) Optional filter to return only item of interest.
-	//  See https://google.aip.dev/160 for details.
-	Filter *string `json:"filter,omitempty"`
+	// Wwid The storage device unique identifier.
+	Wwid *string `json:"wwid,omitempty"`
+}
 
-	// Offset (OPTIONAL) Index of the first item to return. This allows skipping items.
-	Offset *int `json:"offset,omitempty"`
+// HostusbResource The set of host USB resources.
+type HostusbResource struct {
+	// Addr USB Device number assigned by OS.
+	Addr *int `json:"addr,omitempty"`
 
-	// OrderBy (OPTIONAL) Optional comma separated list of fields to specify a sorting order.
-	//  See https://google.aip.dev/132 for details.
-	OrderBy *string `json:"orderBy,omitempty"`
+	// Bus Bus number of device connected with.
+	Bus *int `json:"bus,omitempty"`
 
-	// PageSize (OPTIONAL) Defines the amount of items to be contained in a single page.
-	//  Default of 20.
-	PageSize *int `json:"pageSize,omitempty"`
+	// Class class defined by USB-IF.
+	Class *string `json:"class,omitempty"`
 
-	// ProjectName Project name
-	ProjectName string `json:"projectName"`
-}
+	// DeviceName the OS-provided device name.
+	DeviceName *string `json:"deviceName,omitempty"`
 
-// ListOperatingSystemsResponse Response message for the ListOperatingSystems method.
-type ListOperatingSystemsResponse struct {
-	// OperatingSystemResources Sorted and filtered list of oss.
-	OperatingSystemResources []OperatingSystemResource `json:"OperatingSystemResources"`
+	// IdProduct Hexadecimal number representing ID of the USB device product.
+	IdProduct *string `json:"idProduct,omitempty"`
 
-	// HasNext Inform if there are more elements
-	HasNext bool `json:"hasNext"`
+	// IdVendor Hexadecimal number representing ID of the USB device vendor.
+	IdVendor *string `json:"idVendor,omitempty"`
 
-	// TotalElements Count of items in the entire list, regardless of pagination.
-	TotalElements int32 `json:"totalElements"`
+	// Serial Serial number of device.
+	Serial     *string     `json:"serial,omitempty"`
+	Timestamps *Timestamps `json:"timestamps,omitempty"`
 }
 
-// ListProvidersRequest Request message for the ListProviders method.
-type ListProvidersRequest struct {
-	// Filter (OPTIONAL) Optional filter to return only item of interest.
-	//  See https://google.aip.dev/160 for details.
-	Filter *string `json:"filter,omitempty"`
+// IPAddressConfigMethod defines model for IPAddressConfigMethod.
+type IPAddressConfigMethod string
 
-	// Offset (OPTIONAL) Index of the first item to return. This allows skipping items.
-	Offset *int `json:"offset,omitempty"`
+// IPAddressResource defines model for IPAddressResource.
+type IPAddressResource struct {
+	// Address CIDR representation of the IP address.
+	Address      *string                `json:"address,omitempty"`
+	ConfigMethod *IPAddressConfigMethod `json:"configMethod,omitempty"`
 
-	// OrderBy (OPTIONAL) Optional comma separated list of fields to specify a sorting order.
-	//  See https://google.aip.dev/132 for details.
-	OrderBy *string `json:"orderBy,omitempty"`
+	// HostId The unique identifier of the host the NIC of the IP address belongs to.
+	HostId *string `json:"hostId,omitempty"`
 
-	// PageSize (OPTIONAL) Defines the amount of items to be contained in a single page.
-	//  Default of 20.
-	PageSize *int `json:"pageSize,omitempty"`
+	// NicId The unique identifier of the NIC the IP address is assigned to.
+	NicId *string `json:"nicId,omitempty"`
 
-	// ProjectName Project name
-	ProjectName string `json:"projectName"`
+	// ResourceId Resource ID, generated by Inventory on Create
+	ResourceId *string          `json:"resourceId,omitempty"`
+	Status     *IPAddressStatus `json:"status,omitempty"`
+
+	// StatusDetail User-friendly status to provide details about the resource state
+	StatusDetail *string     `json:"statusDetail,omitempty"`
+	Timestamps   *Timestamps `json:"timestamps,omitempty"`
 }
 
-// ListProvidersResponse Response message for the ListProviders method.
-type ListProvidersResponse struct {
-	// HasNext Inform if there are more elements
-	HasNext bool `json:"hasNext"`
+// IPAddressState defines model for IPAddressState.
+type IPAddressState string
 
-	// Providers Sorted and filtered list of providers.
-	Providers []ProviderResource `json:"providers"`
+// IPAddressStatus defines model for IPAddressStatus.
+type IPAddressStatus string
 
-	// TotalElements Count of items in the entire list, regardless of pagination.
-	TotalElements int32 `json:"totalElements"`
-}
+// InstanceBulkAction The action applied to each Instance by BulkInstanceAction.
+type InstanceBulkAction string
 
-// ListRegionsRequest Request message for the ListRegions method.
-type ListRegionsRequest struct {
-	// Filter (OPTIONAL) Optional filter to return only item of interest.
-	//  See https://google.aip.dev/160 for details.
-	Filter *string `json:"filter,omitempty"`
+// InstanceKind The Instance kind.
+type InstanceKind string
 
-	// Offset (OPTIONAL) Index of the first item to return. This allows skipping items.
-	Offset *int `json:"offset,omitempty"`
+// InstanceResource InstanceResource describes an instantiated OS install, running on either a
+//
+//	host or hypervisor.
+type InstanceResource struct {
+	// CurrentState The Instance States.
+	CurrentState *InstanceState `json:"currentState,omitempty"`
 
-	// OrderBy (OPTIONAL) Optional comma separated list of fields to specify a sorting order.
-	//  See https://google.aip.dev/132 for details.
-	OrderBy *string `json:"orderBy,omitempty"`
+	// CustomConfig The list of custom config associated with the instance.
+	CustomConfig *[]CustomConfigResource `json:"customConfig,omitempty"`
 
-	// PageSize (OPTIONAL) Defines the amount of items to be contained in a single page.
-	//  Default of 20.
-	PageSize *int `json:"pageSize,omitempty"`
+	// CustomConfigID The list of custom config associated with the instance.
+	CustomConfigID *[]string `json:"customConfigID,omitempty"`
 
-	// ProjectName Project name
-	ProjectName string `json:"projectName"`
+	// DesiredState The Instance States.
+	DesiredState *InstanceState `json:"desiredState,omitempty"`
 
-	// ShowTotalSites (OPTIONAL) Flag to signal if the total amount of site in a region should be returned.
-	ShowTotalSites *bool `json:"showTotalSites,omitempty"`
-}
+	// ExistingCves The CVEs that are currently present on the Instance, encoded as a JSON list.
+	ExistingCves *string `json:"existingCves,omitempty"`
 
-// ListRegionsResponse Response message for the ListRegions method.
-type ListRegionsResponse struct {
-	// HasNext Inform if there are more elements
-	HasNext bool `json:"hasNext"`
+	// Host A Host resource.
+	Host *HostResource `json:"host,omitempty"`
 
-	// Regions Sorted and filtered list of regions.
-	Regions []RegionResource `json:"regions"`
+	// HostID The host's unique identifier associated with the instance.
+	HostID *string `json:"hostID,omitempty"`
 
-	// TotalElements Count of items in the entire list, regardless of pagination.
-	TotalElements int32 `json:"totalElements"`
-}
+	// InstanceID Deprecated, The instance's unique identifier. Alias of resourceID.
+	InstanceID *string `json:"instanceID,omitempty"`
 
-// ListRepeatedSchedulesRequest Request message for the ListRepeatedSchedules method.
-type ListRepeatedSchedulesRequest struct {
-	// HostId (OPTIONAL) The host ID target of the schedules. If not specified, returns all schedules
-	//  (given the other query params). If specified, returns the schedules that have
-	//  the specified host ID applied to them, i.e., target including the inherited ones
-	//  (parent site if not null). If null, returns all the schedules without a host ID as target.
-	// string.max_bytes = 13
-	HostId *string `json:"hostId,omitempty"`
+	// InstanceStatus textual message that describes the current instance status. Set by RMs only.
+	InstanceStatus *string `json:"instanceStatus,omitempty"`
 
-	// Offset (OPTIONAL) Index of the first item to return. This allows skipping items.
-	Offset *int `json:"offset,omitempty"`
+	// InstanceStatusDetail Textual message that gives detailed status of the instance's software components.
+	InstanceStatusDetail *string `json:"instanceStatusDetail,omitempty"`
 
-	// PageSize (OPTIONAL) Defines the amount of items to be contained in a single page.
-	//  Default of 20.
-	PageSize *int `json:"pageSize,omitempty"`
+	// InstanceStatusIndicator The status indicator.
+	InstanceStatusIndicator *StatusIndication `json:"instanceStatusIndicator,omitempty"`
 
-	// ProjectName Project name
-	ProjectName string `json:"projectName"`
+	// InstanceStatusTimestamp UTC timestamp when instance_status was last changed. Set by RMs only.
+	InstanceStatusTimestamp *int `json:"instanceStatusTimestamp,omitempty"`
 
-	// RegionId (OPTIONAL) The region ID target of the schedules. If not specified,
-	//  returns all schedules (given the other query params).
-	//  If specified, returns the schedules that have the specified region ID applied to them,
-	//  i.e., target including the inherited ones (parent region if not null).
-	//  If null, returns all the schedules without a region ID as target.
-	// string.max_bytes = 15
-	RegionId *string `json:"regionId,omitempty"`
+	// Kind The Instance kind.
+	Kind *InstanceKind `json:"kind,omitempty"`
 
-	// SiteId (OPTIONAL) The site ID target of the schedules. If not specified, returns all schedules
-	//  (given the other query params). If specified, returns the schedules that have
-	//  the specified site ID applied to them, i.e., target including the inherited ones.
-	//  If null, returns all the schedules without a site ID as target
-	// string.max_bytes = 13
-	SiteId *string `json:"siteId,omitempty"`
+	// LocalAccountID The unique identifier of local account will be associated with the instance.
+	LocalAccountID *string               `json:"localAccountID,omitempty"`
+	Localaccount   *LocalAccountResource `json:"localaccount,omitempty"`
 
-	// UnixEpoch (OPTIONAL) Filter based on the timestamp, expected to be UNIX epoch UTC timestamp in seconds.
-	UnixEpoch *string `json:"unixEpoch,omitempty"`
-}
+	// Name The instance's human-readable name.
+	Name *string `json:"name,omitempty"`
 
-// ListRepeatedSchedulesResponse Response message for the ListRepeatedSchedules method.
-type ListRepeatedSchedulesResponse struct {
-	// HasNext Inform if there are more elements
-	HasNext bool `json:"hasNext"`
+	// Os An OS resource.
+	Os *OperatingSystemResource `json:"os,omitempty"`
 
-	// RepeatedSchedules Sorted and filtered list of repeated_schedules.
-	RepeatedSchedules []RepeatedScheduleResource `json:"repeatedSchedules"`
+	// OsID The unique identifier of OS resource that must be installed on the instance. The field is used to drive the day0 operations, and immutable once set the first time.
+	OsID *string `json:"osID,omitempty"`
 
-	// TotalElements Count of items in the entire list, regardless of pagination.
-	TotalElements int32 `json:"totalElements"`
-}
+	// OsUpdateAvailable Details about OS Updates available for this Instance. If empty, there are no updates available.
+	OsUpdateAvailable *string `json:"osUpdateAvailable,omitempty"`
+	OsUpdatePolicyID  *string `json:"osUpdatePolicyID,omitempty"`
 
-// ListSchedulesRequest Request message for the ListSchedules method.
-type ListSchedulesRequest struct {
-	// HostId (OPTIONAL) The host ID target of the schedules. If not specified, returns all schedules
-	//  (given the other query params). If specified, returns the schedules that have
-	//  the specified host ID applied to them, i.e., target including the inherited ones
-	//  (parent site if not null). If null, returns all the schedules without a host ID as target.
-	// string.max_bytes = 13
-	HostId *string `json:"hostId,omitempty"`
+	// ProvisioningStatus textual message that describes the provisioning status of Instance. Set by RMs only.
+	ProvisioningStatus *string `json:"provisioningStatus,omitempty"`
 
-	// Offset (OPTIONAL) Index of the first item to return. This allows skipping items.
-	Offset *int `json:"offset,omitempty"`
+	// ProvisioningStatusIndicator The status indicator.
+	ProvisioningStatusIndicator *StatusIndication `json:"provisioningStatusIndicator,omitempty"`
 
-	// PageSize (OPTIONAL) Defines the amount of items to be contained in a single page.
-	//  Default of 20.
-	PageSize *int `json:"pageSize,omitempty"`
+	// ProvisioningStatusTimestamp UTC timestamp when provisioning_status was last changed. Set by RMs only.
+	ProvisioningStatusTimestamp *int `json:"provisioningStatusTimestamp,omitempty"`
 
-	// ProjectName Project name
-	ProjectName string `json:"projectName"`
+	// ResourceId Resource ID, generated on Create.
+	ResourceId *string `json:"resourceId,omitempty"`
 
-	// RegionId (OPTIONAL) The region ID target of the schedules. If not specified,
-	//  returns all schedules (given the other query params).
-	//  If specified, returns the schedules that have the specified region ID applied to them,
-	//  i.e., target including the inherited ones (parent region if not null).
-	//  If null, returns all the schedules without a region ID as target.
-	// string.max_bytes = 15
-	RegionId *string `json:"regionId,omitempty"`
+	// RuntimePackages The packages available on the Instance at runtime, represented as a JSON list.
+	RuntimePackages *string `json:"runtimePackages,omitempty"`
 
-	// SiteId (OPTIONAL) The site ID target of the schedules. If not specified, returns all schedules
-	//  (given the other query params). If specified, returns the schedules that have
-	//  the specified site ID applied to them, i.e., target including the inherited ones.
-	//  If null, returns all the schedules without a site ID as target
-	// string.max_bytes = 13
-	SiteId *string `json:"siteId,omitempty"`
+	// SecurityFeature SecurityFeature describes the security capabilities of a resource.
+	SecurityFeature *SecurityFeature `json:"securityFeature,omitempty"`
+	Timestamps      *Timestamps      `json:"timestamps,omitempty"`
 
-	// UnixEpoch (OPTIONAL) Filter based on the timestamp, expected to be UNIX epoch UTC timestamp in seconds.
-	UnixEpoch *string `json:"unixEpoch,omitempty"`
+	// TrustedAttestationStatus textual message that describes the trusted_attestation status of Instance. Set by RMs only.
+	TrustedAttestationStatus *string `json:"trustedAttestationStatus,omitempty"`
+
+	// TrustedAttestationStatusIndicator The status indicator.
+	TrustedAttestationStatusIndicator *StatusIndication `json:"trustedAttestationStatusIndicator,omitempty"`
+
+	// TrustedAttestationStatusTimestamp UTC timestamp when trusted_attestation_status was last changed. Set by RMs only.
+	TrustedAttestationStatusTimestamp *int            `json:"trustedAttestationStatusTimestamp,omitempty"`
+	UpdatePolicy                      *OSUpdatePolicy `json:"updatePolicy,omitempty"`
+
+	// UpdateStatus textual message that describes the update status of Instance. Set by RMs only.
+	UpdateStatus *string `json:"updateStatus,omitempty"`
+
+	// UpdateStatusIndicator The status indicator.
+	UpdateStatusIndicator *StatusIndication `json:"updateStatusIndicator,omitempty"`
+
+	// UpdateStatusTimestamp UTC timestamp when update_status was last changed. Set by RMs only.
+	UpdateStatusTimestamp *int `json:"updateStatusTimestamp,omitempty"`
+
+	// WorkloadMembers The workload members associated with the instance. back-reference to the Workload Members associated to this Instance
+	WorkloadMembers *[]WorkloadMember `json:"workloadMembers,omitempty"`
 }
 
-// ListSchedulesResponse Response message for the ListSchedulesResponse method.
-type ListSchedulesResponse struct {
-	// HasNext Inform if there are more elements
-	HasNext bool `json:"hasNext"`
+// InstanceState The Instance States.
+type InstanceState string
 
-	// RepeatedSchedules Sorted and filtered list of repeated_schedules.
-	RepeatedSchedules []RepeatedScheduleResource `json:"repeatedSchedules"`
+// InvalidateHostRequest Request to invalidate/untrust a Host.
+type InvalidateHostRequest struct {
+	// Note user-provided reason for change or a freeform field
+	Note *string `json:"note,omitempty"`
 
-	// SingleSchedules Sorted and filtered list of single_schedules.
-	SingleSchedules []SingleScheduleResource `json:"singleSchedules"`
+	// ProjectName The project name from the URL path.
+	ProjectName string `json:"projectName"`
 
-	// TotalElements Count of items in the entire list, regardless of pagination.
-	TotalElements int32 `json:"totalElements"`
+	// ResourceId Host resource ID
+	// string.max_bytes = 13
+	ResourceId *string `json:"resourceId,omitempty"`
 }
 
-// ListSingleSchedulesRequest Request message for the ListSingleSchedules method.
-type ListSingleSchedulesRequest struct {
-	// HostId (OPTIONAL) The host ID target of the schedules. If not specified, returns all schedules
-	//  (given the other query params). If specified, returns the schedules that have
-	//  the specified host ID applied to them, i.e., target including the inherited ones
-	//  (parent site if not null). If null, returns all the schedules without a host ID as target.
+// InvalidateHostResponse Response message for InvalidateHost.
+type InvalidateHostResponse = map[string]interface{}
+
+// InvalidateHostsRequest Request to invalidate/untrust all the Hosts matching a filter.
+type InvalidateHostsRequest struct {
+	// Filter Filter selecting the hosts to invalidate.
+	//  See https://google.aip.dev/160 for details.
+	Filter string `json:"filter"`
+
+	// Note user-provided reason for change or a freeform field
+	Note *string `json:"note,omitempty"`
+
+	// ProjectName The project name from the URL path.
+	ProjectName string `json:"projectName"`
+}
+
+// InvalidateInstanceRequest Request message for Invalidate Instance.
+type InvalidateInstanceRequest struct {
+	// ProjectName The project name from the URL path.
+	ProjectName string `json:"projectName"`
+
+	// ResourceId Instance resource ID
 	// string.max_bytes = 13
-	HostId *string `json:"hostId,omitempty"`
+	ResourceId *string `json:"resourceId,omitempty"`
+}
+
+// InvalidateInstanceResponse Response message for Invalidate Instance.
+type InvalidateInstanceResponse = map[string]interface{}
+
+// KvmState KVM session lifecycle state; KVM_CONSENT_RECEIVED and KVM_REDIRECTION_RECEIVED are desired-only values.
+type KvmState string
 
+// KvmStatus KvmStatus reflects whether a KVM remote session is currently active on the host.
+type KvmStatus string
+
+// LinkState The state of the network interface.
+type LinkState string
+
+// ListAPITokensRequest Request message for the ListAPITokens method.
+type ListAPITokensRequest struct {
 	// Offset (OPTIONAL) Index of the first item to return. This allows skipping items.
 	Offset *int `json:"offset,omitempty"`
 
@@ -2037,40 +2909,22 @@ type ListSingleSchedulesRequest struct {
 
 	// ProjectName Project name
 	ProjectName string `json:"projectName"`
-
-	// RegionId (OPTIONAL) The region ID target of the schedules. If not specified,
-	//  returns all schedules (given the other query params).
-	//  If specified, returns the schedules that have the specified region ID applied to them,
-	//  i.e., target including the inherited ones (parent region if not null).
-	//  If null, returns all the schedules without a region ID as target.
-	// string.max_bytes = 15
-	RegionId *string `json:"regionId,omitempty"`
-
-	// SiteId (OPTIONAL) The site ID target of the schedules. If not specified, returns all schedules
-	//  (given the other query params). If specified, returns the schedules that have
-	//  the specified site ID applied to them, i.e., target including the inherited ones.
-	//  If null, returns all the schedules without a site ID as target
-	// string.max_bytes = 13
-	SiteId *string `json:"siteId,omitempty"`
-
-	// UnixEpoch (OPTIONAL) Filter based on the timestamp, expected to be UNIX epoch UTC timestamp in seconds.
-	UnixEpoch *string `json:"unixEpoch,omitempty"`
 }
 
-// ListSingleSchedulesResponse Response message for the ListSingleSchedules method.
-type ListSingleSchedulesResponse struct {
+// ListAPITokensResponse Response message for the ListAPITokens method.
+type ListAPITokensResponse struct {
+	// ApiTokens List of API tokens, the most recent first. The secret tokens are not returned.
+	ApiTokens []APITokenResource `json:"apiTokens"`
+
 	// HasNext Inform if there are more elements
 	HasNext bool `json:"hasNext"`
 
-	// SingleSchedules Sorted and filtered list of single_schedules.
-	SingleSchedules []SingleScheduleResource `json:"singleSchedules"`
-
 	// TotalElements Count of items in the entire list, regardless of pagination.
 	TotalElements int32 `json:"totalElements"`
 }
 
-// ListSitesRequest Request message for the ListSites method.
-type ListSitesRequest struct {
+// ListCustomConfigsRequest Request message for the ListCustomConfigs method.
+type ListCustomConfigsRequest struct {
 	// Filter (OPTIONAL) Optional filter to return only item of interest.
 	//  See https://google.aip.dev/160 for details.
 	Filter *string `json:"filter,omitempty"`
@@ -2088,25 +2942,26 @@ type ListSitesRequest struct {
 
 	// ProjectName Project name
 	ProjectName string `json:"projectName"`
-
-	// RegionId (OPTIONAL) Optional region ID for hierarchical path support
-	RegionId *string `json:"regionId,omitempty"`
 }
 
-// ListSitesResponse Response message for the ListSites method.
-type ListSitesResponse struct {
+// ListCustomConfigsResponse Response message for the ListCustomConfigs method.
+type ListCustomConfigsResponse struct {
+	// CustomConfigs Sorted and filtered list of customconfigs.
+	CustomConfigs []CustomConfigResource `json:"customConfigs"`
+
 	// HasNext Inform if there are more elements
 	HasNext bool `json:"hasNext"`
 
-	// Sites Sorted and filtered list of sites.
-	Sites []SiteResource `json:"sites"`
-
 	// TotalElements Count of items in the entire list, regardless of pagination.
 	TotalElements int32 `json:"totalElements"`
 }
 
-// ListTelemetryLogsGroupsRequest Request message for the ListTelemetryLogsGroups method.
-type ListTelemetryLogsGroupsRequest struct {
+// ListEndpointsRequest Request message for the ListEndpoints method.
+type ListEndpointsRequest struct {
+	// Filter (OPTIONAL) Optional filter to return only item of interest.
+	//  See https://google.aip.dev/160 for details.
+	Filter *string `json:"filter,omitempty"`
+
 	// Offset (OPTIONAL) Index of the first item to return. This allows skipping items.
 	Offset *int `json:"offset,omitempty"`
 
@@ -2122,23 +2977,24 @@ type ListTelemetryLogsGroupsRequest struct {
 	ProjectName string `json:"projectName"`
 }
 
-// ListTelemetryLogsGroupsResponse Response message for the ListTelemetryLogsGroups method.
-type ListTelemetryLogsGroupsResponse struct {
+// ListEndpointsResponse Response message for the ListEndpoints method.
+type ListEndpointsResponse struct {
+	// Endpoints Sorted and filtered list of endpoints.
+	Endpoints []EndpointResource `json:"endpoints"`
+
 	// HasNext Inform if there are more elements
 	HasNext bool `json:"hasNext"`
 
-	// TelemetryLogsGroups Sorted and filtered list of telemetry_logs_groups.
-	TelemetryLogsGroups []TelemetryLogsGroupResource `json:"telemetryLogsGroups"`
-
 	// TotalElements Count of items in the entire list, regardless of pagination.
 	TotalElements int32 `json:"totalElements"`
 }
 
-// ListTelemetryLogsProfilesRequest Request message for the ListTelemetryLogsProfiles method.
-type ListTelemetryLogsProfilesRequest struct {
-	// InstanceId (OPTIONAL) Returns only the telemetry profiles that are assigned with the given instance identifier.
-	// string.max_bytes = 13
-	InstanceId *string `json:"instanceId,omitempty"`
+// ListHostnicsRequest Request message for the ListHostnics method.
+type ListHostnicsRequest struct {
+	// Filter (OPTIONAL) Optional filter to return only item of interest.
+	//  See https://google.aip.dev/160 for details.
+	//  For example `mac_addr = "aa:bb:cc:dd:ee:ff"` or `host.resource_id = "host-12345678"`.
+	Filter *string `json:"filter,omitempty"`
 
 	// Offset (OPTIONAL) Index of the first item to return. This allows skipping items.
 	Offset *int `json:"offset,omitempty"`
@@ -2153,37 +3009,228 @@ type ListTelemetryLogsProfilesRequest struct {
 
 	// ProjectName Project name
 	ProjectName string `json:"projectName"`
-
-	// RegionId (OPTIONAL) Returns only the telemetry profiles that are assigned with the given regionID.
-	// string.max_bytes = 15
-	RegionId *string `json:"regionId,omitempty"`
-
-	// ShowInherited (OPTIONAL) Indicates if listed telemetry profiles should be extended with telemetry
-	//  profiles rendered from hierarchy. This flag is only used along with one
-	//  of siteId, regionId or instanceId. If siteId, regionId or instanceId are
-	//  not set, this flag is ignored.
-	ShowInherited *bool `json:"showInherited,omitempty"`
-
-	// SiteId (OPTIONAL) Returns only the telemetry profiles that are assigned with the given siteID.
-	// string.max_bytes = 13
-	SiteId *string `json:"siteId,omitempty"`
 }
 
-// ListTelemetryLogsProfilesResponse Response message for the ListTelemetryLogsProfiles method.
-type ListTelemetryLogsProfilesResponse struct {
+// ListHostnicsResponse Response message for the ListHostnics method.
+type ListHostnicsResponse struct {
 	// HasNext Inform if there are more elements
 	HasNext bool `json:"hasNext"`
 
-	// TelemetryLogsProfiles Sorted and filtered list of telemetry_logs_profiles.
-	TelemetryLogsProfiles []TelemetryLogsProfileResource `json:"telemetryLogsProfiles"`
+	// Nics Sorted and filtered list of host NICs.
+	Nics []HostnicResource `json:"nics"`
 
 	// TotalElements Count of items in the entire list, regardless of pagination.
 	TotalElements int32 `json:"totalElements"`
 }
 
-// ListTelemetryMetricsGroupsRequest Request message for the ListTelemetryMetricsGroups method.
-type ListTelemetryMetricsGroupsRequest struct {
-	// Offset (OPTIONAL) Index of the first item to return. This allows skipping items.
+// ListHostsRequest Request message for the ListHosts method.
+type ListHostsRequest struct {
+	// Fields `FieldMask` represents a set of symbolic field paths, for example:
+	//
+	//      paths: "f.a"
+	//      paths: "f.b.d"
+	//
+	//  Here `f` represents a field in some root message, `a` and `b`
+	//  fields in the message found in `f`, and `d` a field found in the
+	//  message in `f.b`.
+	//
+	//  Field masks are used to specify a subset of fields that should be
+	//  returned by a get operation or modified by an update operation.
+	//  Field masks also have a custom JSON encoding (see below).
+	//
+	//  # Field Masks in Projections
+	//
+	//  When used in the context of a projection, a response message or
+	//  sub-message is filtered by the API to only contain those fields as
+	//  specified in the mask. For example, if the mask in the previous
+	//  example is applied to a response message as follows:
+	//
+	//      f {
+	//        a : 22
+	//        b {
+	//          d : 1
+	//          x : 2
+	//        }
+	//        y : 13
+	//      }
+	//      z: 8
+	//
+	//  The result will not contain specific values for fields x,y and z
+	//  (their value will be set to the default, and omitted in proto text
+	//  output):
+	//
+	//
+	//      f {
+	//        a : 22
+	//        b {
+	//          d : 1
+	//        }
+	//      }
+	//
+	//  A repeated field is not allowed except at the last position of a
+	//  paths string.
+	//
+	//  If a FieldMask object is not present in a get operation, the
+	//  operation applies to all fields (as if a FieldMask of all fields
+	//  had been specified).
+	//
+	//  Note that a field mask does not necessarily apply to the
+	//  top-level response message. In case of a REST get operation, the
+	//  field mask applies directly to the response, but in case of a REST
+	//  list operation, the mask instead applies to each individual message
+	//  in the returned resource list. In case of a REST custom method,
+	//  other definitions may be used. Where the mask applies will be
+	//  clearly documented together with its declaration in the API.  In
+	//  any case, the effect on the returned resource/resources is required
+	//  behavior for APIs.
+	//
+	//  # Field Masks in Update Operations
+	//
+	//  A field mask in update operations specifies which fields of the
+	//  targeted resource are going to be updated. The API is required
+	//  to only change the values of the fields as specified in the mask
+	//  and leave the others untouched. If a resource is passed in to
+	//  describe the updated values, the API ignores the values of all
+	//  fields not covered by the mask.
+	//
+	//  If a repeated field is specified for an update operation, new values will
+	//  be appended to the existing repeated field in the target resource. Note that
+	//  a repeated field is only allowed in the last position of a `paths` string.
+	//
+	//  If a sub-message is specified in the last position of the field mask for an
+	//  update operation, then new value will be merged into the existing sub-message
+	//  in the target resource.
+	//
+	//  For example, given the target message:
+	//
+	//      f {
+	//        b {
+	//          d: 1
+	//          x: 2
+	//        }
+	//        c: [1]
+	//      }
+	//
+	//  And an update message:
+	//
+	//      f {
+	//        b {
+	//          d: 10
+	//        }
+	//        c: [2]
+	//      }
+	//
+	//  then if the field mask is:
+	//
+	//   paths: ["f.b", "f.c"]
+	//
+	//  then the result will be:
+	//
+	//      f {
+	//        b {
+	//          d: 10
+	//          x: 2
+	//        }
+	//        c: [1, 2]
+	//      }
+	//
+	//  An implementation may provide options to override this default behavior for
+	//  repeated and message fields.
+	//
+	//  In order to reset a field's value to the default, the field must
+	//  be in the mask and set to the default value in the provided resource.
+	//  Hence, in order to reset all fields of a resource, provide a default
+	//  instance of the resource and set all fields in the mask, or do
+	//  not provide a mask as described below.
+	//
+	//  If a field mask is not present on update, the operation applies to
+	//  all fields (as if a field mask of all fields has been specified).
+	//  Note that in the presence of schema evolution, this may mean that
+	//  fields the client does not know and has therefore not filled into
+	//  the request will be reset to their default. If this is unwanted
+	//  behavior, a specific service may require a client to always specify
+	//  a field mask, producing an error if not.
+	//
+	//  As with get operations, the location of the resource which
+	//  describes the updated values in the request message depends on the
+	//  operation kind. In any case, the effect of the field mask is
+	//  required to be honored by the API.
+	//
+	//  ## Considerations for HTTP REST
+	//
+	//  The HTTP kind of an update operation which uses a field mask must
+	//  be set to PATCH instead of PUT in order to satisfy HTTP semantics
+	//  (PUT must only be used for full updates).
+	//
+	//  # JSON Encoding of Field Masks
+	//
+	//  In JSON, a field mask is encoded as a single string where paths are
+	//  separated by a comma. Fields name in each path are converted
+	//  to/from lower-camel naming conventions.
+	//
+	//  As an example, consider the following message declarations:
+	//
+	//      message Profile {
+	//        User user = 1;
+	//        Photo photo = 2;
+	//      }
+	//      message User {
+	//        string display_name = 1;
+	//        string address = 2;
+	//      }
+	//
+	//  In proto a field mask for `Profile` may look as such:
+	//
+	//      mask {
+	//        paths: "user.display_name"
+	//        paths: "photo"
+	//      }
+	//
+	//  In JSON, the same mask is represented as below:
+	//
+	//      {
+	//        mask: "user.displayName,photo"
+	//      }
+	//
+	//  # Field Masks and Oneof Fields
+	//
+	//  Field masks treat fields in oneofs just as regular fields. Consider the
+	//  following message:
+	//
+	//      message SampleMessage {
+	//        oneof test_oneof {
+	//          string name = 4;
+	//          SubMessage sub_message = 9;
+	//        }
+	//      }
+	//
+	//  The field mask can be:
+	//
+	//      mask {
+	//        paths: "name"
+	//      }
+	//
+	//  Or:
+	//
+	//      mask {
+	//        paths: "sub_message"
+	//      }
+	//
+	//  Note that oneof type names ("test_oneof" in this case) cannot be used in
+	//  paths.
+	//
+	//  ## Field Mask Verification
+	//
+	//  The implementation of any API method which has a FieldMask type field in the
+	//  request should verify the included field paths, and return an
+	//  `INVALID_ARGUMENT` error if any path is unmappable.
+	Fields *GoogleProtobufFieldMask `json:"fields,omitempty"`
+
+	// Filter (OPTIONAL) Optional filter to return only item of interest.
+	//  See https://google.aip.dev/160 for details.
+	Filter *string `json:"filter,omitempty"`
+
+	// Offset (OPTIONAL) Index of the first item to return. This allows skipping items.
 	Offset *int `json:"offset,omitempty"`
 
 	// OrderBy (OPTIONAL) Optional comma separated list of fields to specify a sorting order.
@@ -2194,27 +3241,32 @@ type ListTelemetryMetricsGroupsRequest struct {
 	//  Default of 20.
 	PageSize *int `json:"pageSize,omitempty"`
 
-	// ProjectName Project name
+	// ProjectName The project name from the URL path.
 	ProjectName string `json:"projectName"`
+
+	// View The fields returned by Get and List methods supporting views.
+	//  See https://google.aip.dev/157 for details.
+	View *ResourceView `json:"view,omitempty"`
 }
 
-// ListTelemetryMetricsGroupsResponse Response message for the ListTelemetryMetricsGroups method.
-type ListTelemetryMetricsGroupsResponse struct {
+// ListHostsResponse Response message for the ListHosts method.
+type ListHostsResponse struct {
 	// HasNext Inform if there are more elements
 	HasNext bool `json:"hasNext"`
 
-	// TelemetryMetricsGroups Sorted and filtered list of telemetry_metrics_groups.
-	TelemetryMetricsGroups []TelemetryMetricsGroupResource `json:"telemetryMetricsGroups"`
+	// Hosts Sorted and filtered list of hosts.
+	Hosts []HostResource `json:"hosts"`
 
 	// TotalElements Count of items in the entire list, regardless of pagination.
 	TotalElements int32 `json:"totalElements"`
 }
 
-// ListTelemetryMetricsProfilesRequest Request message for the ListTelemetryMetricsProfiles method.
-type ListTelemetryMetricsProfilesRequest struct {
-	// InstanceId (OPTIONAL) Returns only the telemetry profiles that are assigned with the given instance identifier.
-	// string.max_bytes = 13
-	InstanceId *string `json:"instanceId,omitempty"`
+// ListIPAddressesRequest Request message for the ListIPAddresses method.
+type ListIPAddressesRequest struct {
+	// Filter (OPTIONAL) Optional filter to return only item of interest.
+	//  See https://google.aip.dev/160 for details.
+	//  For example `address = "10.1.2.3/24"` or `nic.host.resource_id = "host-12345678"`.
+	Filter *string `json:"filter,omitempty"`
 
 	// Offset (OPTIONAL) Index of the first item to return. This allows skipping items.
 	Offset *int `json:"offset,omitempty"`
@@ -2229,69 +3281,260 @@ type ListTelemetryMetricsProfilesRequest struct {
 
 	// ProjectName Project name
 	ProjectName string `json:"projectName"`
-
-	// RegionId (OPTIONAL) Returns only the telemetry profiles that are assigned with the given regionID.
-	// string.max_bytes = 15
-	RegionId *string `json:"regionId,omitempty"`
-
-	// ShowInherited (OPTIONAL) Indicates if listed telemetry profiles should be extended with telemetry
-	//  profiles rendered from hierarchy. This flag is only used along with one
-	//  of siteId, regionId or instanceId. If siteId, regionId or instanceId are
-	//  not set, this flag is ignored.
-	ShowInherited *bool `json:"showInherited,omitempty"`
-
-	// SiteId (OPTIONAL) Returns only the telemetry profiles that are assigned with the given siteID.
-	// string.max_bytes = 13
-	SiteId *string `json:"siteId,omitempty"`
 }
 
-// ListTelemetryMetricsProfilesResponse Response message for the ListTelemetryMetricsProfiles method.
-type ListTelemetryMetricsProfilesResponse struct {
+// ListIPAddressesResponse Response message for the ListIPAddresses method.
+type ListIPAddressesResponse struct {
 	// HasNext Inform if there are more elements
 	HasNext bool `json:"hasNext"`
 
-	// TelemetryMetricsProfiles Sorted and filtered list of telemetry_metrics_profiles.
-	TelemetryMetricsProfiles []TelemetryMetricsProfileResource `json:"telemetryMetricsProfiles"`
+	// IpAddresses Sorted and filtered list of IP addresses.
+	IpAddresses []IPAddressResource `json:"ipAddresses"`
 
 	// TotalElements Count of items in the entire list, regardless of pagination.
 	TotalElements int32 `json:"totalElements"`
 }
 
-// ListWorkloadMembersRequest Request message for the ListWorkloadMembers method.
-type ListWorkloadMembersRequest struct {
-	// Filter (OPTIONAL) Optional filter to return only item of interest.
-	//  See https://google.aip.dev/160 for details.
-	Filter *string `json:"filter,omitempty"`
-
-	// Offset (OPTIONAL) Index of the first item to return. This allows skipping items.
-	Offset *int `json:"offset,omitempty"`
-
-	// OrderBy (OPTIONAL) Optional comma separated list of fields to specify a sorting order.
-	//  See https://google.aip.dev/132 for details.
-	OrderBy *string `json:"orderBy,omitempty"`
-
+// ListInstancesRequest Request message for the ListInstances method.
+type ListInstancesRequest struct {
+	// Fields `FieldMask` represents a set of symbolic field paths, for example:
+	//
+	//      paths: "f.a"
+	//      paths: "f.b.d"
+	//
+	//  Here `f` represents a field in some root message, `a` and `b`
+	//  fields in the message found in `f`, and `d` a field found in the
+	//  message in `f.b`.
+	//
+	//  Field masks are used to specify a subset of fields that should be
+	//  returned by a get operation or modified by an update operation.
+	//  Field masks also have a custom JSON encoding (see below).
+	//
+	//  # Field Masks in Projections
+	//
+	//  When used in the context of a projection, a response message or
+	//  sub-message is filtered by the API to only contain those fields as
+	//  specified in the mask. For example, if the mask in the previous
+	//  example is applied to a response message as follows:
+	//
+	//      f {
+	//        a : 22
+	//        b {
+	//          d : 1
+	//          x : 2
+	//        }
+	//        y : 13
+	//      }
+	//      z: 8
+	//
+	//  The result will not contain specific values for fields x,y and z
+	//  (their value will be set to the default, and omitted in proto text
+	//  output):
+	//
+	//
+	//      f {
+	//        a : 22
+	//        b {
+	//          d : 1
+	//        }
+	//      }
+	//
+	//  A repeated field is not allowed except at the last position of a
+	//  paths string.
+	//
+	//  If a FieldMask object is not present in a get operation, the
+	//  operation applies to all fields (as if a FieldMask of all fields
+	//  had been specified).
+	//
+	//  Note that a field mask does not necessarily apply to the
+	//  top-level response message. In case of a REST get operation, the
+	//  field mask applies directly to the response, but in case of a REST
+	//  list operation, the mask instead applies to each individual message
+	//  in the returned resource list. In case of a REST custom method,
+	//  other definitions may be used. Where the mask applies will be
+	//  clearly documented together with its declaration in the API.  In
+	//  any case, the effect on the returned resource/resources is required
+	//  behavior for APIs.
+	//
+	//  # Field Masks in Update Operations
+	//
+	//  A field mask in update operations specifies which fields of the
+	//  targeted resource are going to be updated. The API is required
+	//  to only change the values of the fields as specified in the mask
+	//  and leave the others untouched. If a resource is passed in to
+	//  describe the updated values, the API ignores the values of all
+	//  fields not covered by the mask.
+	//
+	//  If a repeated field is specified for an update operation, new values will
+	//  be appended to the existing repeated field in the target resource. Note that
+	//  a repeated field is only allowed in the last position of a `paths` string.
+	//
+	//  If a sub-message is specified in the last position of the field mask for an
+	//  update operation, then new value will be merged into the existing sub-message
+	//  in the target resource.
+	//
+	//  For example, given the target message:
+	//
+	//      f {
+	//        b {
+	//          d: 1
+	//          x: 2
+	//        }
+	//        c: [1]
+	//      }
+	//
+	//  And an update message:
+	//
+	//      f {
+	//        b {
+	//          d: 10
+	//        }
+	//        c: [2]
+	//      }
+	//
+	//  then if the field mask is:
+	//
+	//   paths: ["f.b", "f.c"]
+	//
+	//  then the result will be:
+	//
+	//      f {
+	//        b {
+	//          d: 10
+	//          x: 2
+	//        }
+	//        c: [1, 2]
+	//      }
+	//
+	//  An implementation may provide options to override this default behavior for
+	//  repeated and message fields.
+	//
+	//  In order to reset a field's value to the default, the field must
+	//  be in the mask and set to the default value in the provided resource.
+	//  Hence, in order to reset all fields of a resource, provide a default
+	//  instance of the resource and set all fields in the mask, or do
+	//  not provide a mask as described below.
+	//
+	//  If a field mask is not present on update, the operation applies to
+	//  all fields (as if a field mask of all fields has been specified).
+	//  Note that in the presence of schema evolution, this may mean that
+	//  fields the client does not know and has therefore not filled into
+	//  the request will be reset to their default. If this is unwanted
+	//  behavior, a specific service may require a client to always specify
+	//  a field mask, producing an error if not.
+	//
+	//  As with get operations, the location of the resource which
+	//  describes the updated values in the request message depends on the
+	//  operation kind. In any case, the effect of the field mask is
+	//  required to be honored by the API.
+	//
+	//  ## Considerations for HTTP REST
+	//
+	//  The HTTP kind of an update operation which uses a field mask must
+	//  be set to PATCH instead of PUT in order to satisfy HTTP semantics
+	//  (PUT must only be used for full updates).
+	//
+	//  # JSON Encoding of Field Masks
+	//
+	//  In JSON, a field mask is encoded as a single string where paths are
+	//  separated by a comma. Fields name in each path are converted
+	//  to/from lower-camel naming conventions.
+	//
+	//  As an example, consider the following message declarations:
+	//
+	//      message Profile {
+	//        User user = 1;
+	//        Photo photo = 2;
+	//      }
+	//      message User {
+	//        string display_name = 1;
+	//        string address = 2;
+	//      }
+	//
+	//  In proto a field mask for `Profile` may look as such:
+	//
+	//      mask {
+	//        paths: "user.display_name"
+	//        paths: "photo"
+	//      }
+	//
+	//  In JSON, the same mask is represented as below:
+	//
+	//      {
+	//        mask: "user.displayName,photo"
+	//      }
+	//
+	//  # Field Masks and Oneof Fields
+	//
+	//  Field masks treat fields in oneofs just as regular fields. Consider the
+	//  following message:
+	//
+	//      message SampleMessage {
+	//        oneof test_oneof {
+	//          string name = 4;
+	//          SubMessage sub_message = 9;
+	//        }
+	//      }
+	//
+	//  The field mask can be:
+	//
+	//      mask {
+	//        paths: "name"
+	//      }
+	//
+	//  Or:
+	//
+	//      mask {
+	//        paths: "sub_message"
+	//      }
+	//
+	//  Note that oneof type names ("test_oneof" in this case) cannot be used in
+	//  paths.
+	//
+	//  ## Field Mask Verification
+	//
+	//  The implementation of any API method which has a FieldMask type field in the
+	//  request should verify the included field paths, and return an
+	//  `INVALID_ARGUMENT` error if any path is unmappable.
+	Fields *GoogleProtobufFieldMask `json:"fields,omitempty"`
+
+	// Filter (OPTIONAL) Optional filter to return only item of interest.
+	//  See https://google.aip.dev/160 for details.
+	Filter *string `json:"filter,omitempty"`
+
+	// Offset (OPTIONAL) Index of the first item to return. This allows skipping items.
+	Offset *int `json:"offset,omitempty"`
+
+	// OrderBy (OPTIONAL) Optional comma separated list of fields to specify a sorting order.
+	//  See https://google.aip.dev/132 for details.
+	OrderBy *string `json:"orderBy,omitempty"`
+
 	// PageSize (OPTIONAL) Defines the amount of items to be contained in a single page.
 	//  Default of 20.
 	PageSize *int `json:"pageSize,omitempty"`
 
-	// ProjectName Project name
+	// ProjectName The project name from the URL path.
 	ProjectName string `json:"projectName"`
+
+	// View The fields returned by Get and List methods supporting views.
+	//  See https://google.aip.dev/157 for details.
+	View *ResourceView `json:"view,omitempty"`
 }
 
-// ListWorkloadMembersResponse Response message for the ListWorkloadMembers method.
-type ListWorkloadMembersResponse struct {
+// ListInstancesResponse Response message for the ListInstances method.
+type ListInstancesResponse struct {
 	// HasNext Inform if there are more elements
 	HasNext bool `json:"hasNext"`
 
+	// Instances Sorted and filtered list of instances.
+	Instances []InstanceResource `json:"instances"`
+
 	// TotalElements Count of items in the entire list, regardless of pagination.
 	TotalElements int32 `json:"totalElements"`
-
-	// WorkloadMembers Sorted and filtered list of workload_members.
-	WorkloadMembers []WorkloadMember `json:"workloadMembers"`
 }
 
-// ListWorkloadsRequest Request message for the ListWorkloads method.
-type ListWorkloadsRequest struct {
+// ListLocalAccountsRequest Request message for the ListLocalAccounts method.
+type ListLocalAccountsRequest struct {
 	// Filter (OPTIONAL) Optional filter to return only item of interest.
 	//  See https://google.aip.dev/160 for details.
 	Filter *string `json:"filter,omitempty"`
@@ -2311,220 +3554,2847 @@ type ListWorkloadsRequest struct {
 	ProjectName string `json:"projectName"`
 }
 
-// ListWorkloadsResponse Response message for the ListWorkloads method.
-type ListWorkloadsResponse struct {
+// ListLocalAccountsResponse Response message for the ListLocalAccounts method.
+type ListLocalAccountsResponse struct {
 	// HasNext Inform if there are more elements
 	HasNext bool `json:"hasNext"`
 
+	// LocalAccounts Sorted and filtered list of localaccounts.
+	LocalAccounts []LocalAccountResource `json:"localAccounts"`
+
 	// TotalElements Count of items in the entire list, regardless of pagination.
 	TotalElements int32 `json:"totalElements"`
-
-	// Workloads Sorted and filtered list of workloads.
-	Workloads []WorkloadResource `json:"workloads"`
 }
 
-// LocalAccountResource defines model for LocalAccountResource.
-type LocalAccountResource struct {
-	// LocalAccountID Deprecated, The local account resource's unique identifier. Alias of resourceId.
-	LocalAccountID *string `json:"localAccountID,omitempty"`
+// ListLocationsRequest Request message for the ListLocations method.
+type ListLocationsRequest struct {
+	// Name (OPTIONAL) Filter locations by name
+	Name *string `json:"name,omitempty"`
 
-	// ResourceId resource identifier
-	ResourceId *string `json:"resourceId,omitempty"`
+	// ProjectName Project name
+	ProjectName string `json:"projectName"`
 
-	// SshKey SSH Public Key of EN
-	SshKey     string      `json:"sshKey"`
-	Timestamps *Timestamps `json:"timestamps,omitempty"`
+	// ShowOus (OPTIONAL) Return OU locations
+	ShowOus *bool `json:"showOus,omitempty"`
 
-	// Username Username provided by admin
-	Username string `json:"username"`
+	// ShowRegions (OPTIONAL) Return region locations
+	ShowRegions *bool `json:"showRegions,omitempty"`
+
+	// ShowSites (OPTIONAL) Return site locations
+	ShowSites *bool `json:"showSites,omitempty"`
 }
 
-// MetadataItem A metadata item, represented by a key:value pair.
-type MetadataItem struct {
-	// Key The metadata key.
-	Key string `json:"key"`
+// ListLocationsResponse Response message for the ListLocations method.
+type ListLocationsResponse struct {
+	// Nodes Sorted and filtered list of regions.
+	Nodes []ListLocationsResponseLocationNode `json:"nodes"`
 
-	// Value The metadata value.
-	Value string `json:"value"`
+	// OutputElements (OPTIONAL) Amount of items in the returned list.
+	OutputElements *int32 `json:"outputElements,omitempty"`
+
+	// TotalElements (OPTIONAL) Count of items in the entire list, regardless of pagination.
+	TotalElements *int32 `json:"totalElements,omitempty"`
 }
 
-// NetworkInterfaceLinkState defines model for NetworkInterfaceLinkState.
-type NetworkInterfaceLinkState struct {
-	Timestamps *Timestamps `json:"timestamps,omitempty"`
+// ListLocationsResponseLocationNode A node in the location tree.
+type ListLocationsResponseLocationNode struct {
+	// Name The node human readable name.
+	Name string `json:"name"`
 
-	// Type The state of the network interface.
-	Type *LinkState `json:"type,omitempty"`
+	// ParentId The associated resource ID, of the parent resource of this Location node.
+	//  In the case of a region, it could be empty or a regionId.
+	//  In the case of a site, it could be empty, a regionId or, when OUs are requested, an ouId.
+	//  In the case of an OU, it could be empty or an ouId.
+	ParentId string `json:"parentId"`
+
+	// ResourceId The associated node resource ID, generated by inventory on Create.
+	ResourceId string                            `json:"resourceId"`
+	Type       ListLocationsResponseResourceKind `json:"type"`
 }
 
-// OSUpdatePolicy defines model for OSUpdatePolicy.
-type OSUpdatePolicy struct {
-	// Description User-provided, human-readable description.
-	Description *string `json:"description,omitempty"`
+// ListLocationsResponseResourceKind defines model for ListLocationsResponse.ResourceKind.
+type ListLocationsResponseResourceKind string
 
-	// Name User-provided, human-readable name.
-	Name string `json:"name"`
+// ListNetlinksRequest Request message for the ListNetlinks method.
+type ListNetlinksRequest struct {
+	// Filter (OPTIONAL) Optional filter to return only item of interest.
+	//  See https://google.aip.dev/160 for details.
+	Filter *string `json:"filter,omitempty"`
 
-	// ResourceId resource ID, generated by the inventory on Create.
-	// string.max_bytes = 23
-	ResourceId *string `json:"resourceId,omitempty"`
+	// Offset (OPTIONAL) Index of the first item to return. This allows skipping items.
+	Offset *int `json:"offset,omitempty"`
 
-	// TargetOs An OS resource.
-	TargetOs *OperatingSystemResource `json:"targetOs,omitempty"`
+	// OrderBy (OPTIONAL) Optional comma separated list of fields to specify a sorting order.
+	//  See https://google.aip.dev/132 for details.
+	OrderBy *string `json:"orderBy,omitempty"`
 
-	// TargetOsId The unique identifier of target OS will be associated with the OS Update policy.
-	TargetOsId *string     `json:"targetOsId,omitempty"`
-	Timestamps *Timestamps `json:"timestamps,omitempty"`
+	// PageSize (OPTIONAL) Defines the amount of items to be contained in a single page.
+	//  Default of 20.
+	PageSize *int `json:"pageSize,omitempty"`
 
-	// UpdateKernelCommand The OS resource's kernel Command Line Options.
-	//  Applies only to Mutable OSes.
-	UpdateKernelCommand *string `json:"updateKernelCommand,omitempty"`
+	// ProjectName Project name
+	ProjectName string `json:"projectName"`
+}
 
-	// UpdatePackages Freeform text, OS-dependent. A list of package names, one per line (newline separated). Must not contain version information.
-	//  Applies only to Mutable OSes.
-	UpdatePackages *string `json:"updatePackages,omitempty"`
+// ListNetlinksResponse Response message for the ListNetlinks method.
+type ListNetlinksResponse struct {
+	// HasNext Inform if there are more elements
+	HasNext bool `json:"hasNext"`
 
-	// UpdatePolicy States of the host.
-	UpdatePolicy *UpdatePolicy `json:"updatePolicy,omitempty"`
+	// Netlinks Sorted and filtered list of netlinks.
+	Netlinks []NetlinkResource `json:"netlinks"`
 
-	// UpdateSources The list of OS resource update sources.
-	//  Should be in 'DEB822 Source Format' for Debian style OSs.
-	//  Applies only to Mutable OSes.
-	UpdateSources *[]string `json:"updateSources,omitempty"`
+	// TotalElements Count of items in the entire list, regardless of pagination.
+	TotalElements int32 `json:"totalElements"`
 }
 
-// OSUpdateRun defines model for OSUpdateRun.
-type OSUpdateRun struct {
-	AppliedPolicy *OSUpdatePolicy `json:"appliedPolicy,omitempty"`
+// ListNetworkSegmentsRequest Request message for the ListNetworkSegments method.
+type ListNetworkSegmentsRequest struct {
+	// Filter (OPTIONAL) Optional filter to return only item of interest.
+	//  See https://google.aip.dev/160 for details.
+	Filter *string `json:"filter,omitempty"`
 
-	// Description Human-readable description.
-	Description *string `json:"description,omitempty"`
+	// Offset (OPTIONAL) Index of the first item to return. This allows skipping items.
+	Offset *int `json:"offset,omitempty"`
 
-	// EndTime UTC timestamp of OS Update ended.
-	EndTime *int `json:"endTime,omitempty"`
+	// OrderBy (OPTIONAL) Optional comma separated list of fields to specify a sorting order.
+	//  See https://google.aip.dev/132 for details.
+	OrderBy *string `json:"orderBy,omitempty"`
 
-	// Instance InstanceResource describes an instantiated OS install, running on either a
-	//  host or hypervisor.
-	Instance *InstanceResource `json:"instance,omitempty"`
+	// PageSize (OPTIONAL) Defines the amount of items to be contained in a single page.
+	//  Default of 20.
+	PageSize *int `json:"pageSize,omitempty"`
 
-	// Name Human-readable name.
-	Name *string `json:"name,omitempty"`
+	// ProjectName Project name
+	ProjectName string `json:"projectName"`
+}
 
-	// ResourceId resource ID, generated by the inventory on Create.
-	// string.max_bytes = 20
-	ResourceId *string `json:"resourceId,omitempty"`
+// ListNetworkSegmentsResponse Response message for the ListNetworkSegments method.
+type ListNetworkSegmentsResponse struct {
+	// HasNext Inform if there are more elements
+	HasNext bool `json:"hasNext"`
 
-	// StartTime UTC timestamp of OS Update started.
-	StartTime *int `json:"startTime,omitempty"`
+	// NetworkSegments Sorted and filtered list of network segments.
+	NetworkSegments []NetworkSegmentResource `json:"networkSegments"`
 
-	// Status Short message that describes what happened during the OS Update.
-	Status *string `json:"status,omitempty"`
+	// TotalElements Count of items in the entire list, regardless of pagination.
+	TotalElements int32 `json:"totalElements"`
+}
 
-	// StatusDetails Details about what happened during the OS Update.
-	StatusDetails *string `json:"statusDetails,omitempty"`
+// ListOSUpdatePolicyRequest Request message for the ListOSUpdatePolicy method.
+type ListOSUpdatePolicyRequest struct {
+	// Filter (OPTIONAL) Optional filter to return only item of interest.
+	//  See https://google.aip.dev/160 for details.
+	Filter *string `json:"filter,omitempty"`
 
-	// StatusIndicator The status indicator.
-	StatusIndicator *StatusIndication `json:"statusIndicator,omitempty"`
+	// Offset (OPTIONAL) Index of the first item to return. This allows skipping items.
+	Offset *int `json:"offset,omitempty"`
 
-	// StatusTimestamp UTC timestamp of OS Update status reported.
-	StatusTimestamp *int        `json:"statusTimestamp,omitempty"`
-	Timestamps      *Timestamps `json:"timestamps,omitempty"`
+	// OrderBy (OPTIONAL) Optional comma separated list of fields to specify a sorting order.
+	//  See https://google.aip.dev/132 for details.
+	OrderBy *string `json:"orderBy,omitempty"`
+
+	// PageSize (OPTIONAL) Defines the amount of items to be contained in a single page.
+	//  Default of 20.
+	PageSize *int `json:"pageSize,omitempty"`
+
+	// ProjectName Project name
+	ProjectName string `json:"projectName"`
+}
+
+// ListOSUpdatePolicyResponse Response message for the ListOSUpdatePolicy method.
+type ListOSUpdatePolicyResponse struct {
+	// HasNext Inform if there are more elements
+	HasNext bool `json:"hasNext"`
+
+	// OsUpdatePolicies Sorted and filtered list of OS Update Policies.
+	OsUpdatePolicies []OSUpdatePolicy `json:"osUpdatePolicies"`
+
+	// TotalElements Count of items in the entire list, regardless of pagination.
+	TotalElements int32 `json:"totalElements"`
+}
+
+// ListOSUpdateRunRequest Request message for the ListOSUpdateRun method.
+type ListOSUpdateRunRequest struct {
+	// Filter (OPTIONAL) Optional filter to return only item of interest.
+	//  See https://google.aip.dev/160 for details.
+	Filter *string `json:"filter,omitempty"`
+
+	// Offset (OPTIONAL) Index of the first item to return. This allows skipping items.
+	Offset *int `json:"offset,omitempty"`
+
+	// OrderBy (OPTIONAL) Optional comma separated list of fields to specify a sorting order.
+	//  See https://google.aip.dev/132 for details.
+	OrderBy *string `json:"orderBy,omitempty"`
+
+	// PageSize (OPTIONAL) Defines the amount of items to be contained in a single page.
+	//  Default of 20.
+	PageSize *int `json:"pageSize,omitempty"`
+
+	// ProjectName Project name
+	ProjectName string `json:"projectName"`
+}
+
+// ListOSUpdateRunResponse Response message for the ListOSUpdateRun method.
+type ListOSUpdateRunResponse struct {
+	// HasNext Inform if there are more elements
+	HasNext bool `json:"hasNext"`
+
+	// OsUpdateRuns Sorted and filtered list of os update runs.
+	OsUpdateRuns []OSUpdateRun `json:"osUpdateRuns"`
+
+	// TotalElements Count of items in the entire list, regardless of pagination.
+	TotalElements int32 `json:"totalElements"`
 }
 
-// OnboardHostRequest Request to onboard a Host.
-type OnboardHostRequest struct {
-	// ProjectName The project name from the URL path.
+// ListOperatingSystemsRequest Request message for the ListOperatingSystems method.
+type ListOperatingSystemsRequest struct {
+	// Fields `FieldMask` represents a set of symbolic field paths, for example:
+	//
+	//      paths: "f.a"
+	//      paths: "f.b.d"
+	//
+	//  Here `f` represents a field in some root message, `a` and `b`
+	//  fields in the message found in `f`, and `d` a field found in the
+	//  message in `f.b`.
+	//
+	//  Field masks are used to specify a subset of fields that should be
+	//  returned by a get operation or modified by an update operation.
+	//  Field masks also have a custom JSON encoding (see below).
+	//
+	//  # Field Masks in Projections
+	//
+	//  When used in the context of a projection, a response message or
+	//  sub-message is filtered by the API to only contain those fields as
+	//  specified in the mask. For example, if the mask in the previous
+	//  example is applied to a response message as follows:
+	//
+	//      f {
+	//        a : 22
+	//        b {
+	//          d : 1
+	//          x : 2
+	//        }
+	//        y : 13
+	//      }
+	//      z: 8
+	//
+	//  The result will not contain specific values for fields x,y and z
+	//  (their value will be set to the default, and omitted in proto text
+	//  output):
+	//
+	//
+	//      f {
+	//        a : 22
+	//        b {
+	//          d : 1
+	//        }
+	//      }
+	//
+	//  A repeated field is not allowed except at the last position of a
+	//  paths string.
+	//
+	//  If a FieldMask object is not present in a get operation, the
+	//  operation applies to all fields (as if a FieldMask of all fields
+	//  had been specified).
+	//
+	//  Note that a field mask does not necessarily apply to the
+	//  top-level response message. In case of a REST get operation, the
+	//  field mask applies directly to the response, but in case of a REST
+	//  list operation, the mask instead applies to each individual message
+	//  in the returned resource list. In case of a REST custom method,
+	//  other definitions may be used. Where the mask applies will be
+	//  clearly documented together with its declaration in the API.  In
+	//  any case, the effect on the returned resource/resources is required
+	//  behavior for APIs.
+	//
+	//  # Field Masks in Update Operations
+	//
+	//  A field mask in update operations specifies which fields of the
+	//  targeted resource are going to be updated. The API is required
+	//  to only change the values of the fields as specified in the mask
+	//  and leave the others untouched. If a resource is passed in to
+	//  describe the updated values, the API ignores the values of all
+	//  fields not covered by the mask.
+	//
+	//  If a repeated field is specified for an update operation, new values will
+	//  be appended to the existing repeated field in the target resource. Note that
+	//  a repeated field is only allowed in the last position of a `paths` string.
+	//
+	//  If a sub-message is specified in the last position of the field mask for an
+	//  update operation, then new value will be merged into the existing sub-message
+	//  in the target resource.
+	//
+	//  For example, given the target message:
+	//
+	//      f {
+	//        b {
+	//          d: 1
+	//          x: 2
+	//        }
+	//        c: [1]
+	//      }
+	//
+	//  And an update message:
+	//
+	//      f {
+	//        b {
+	//          d: 10
+	//        }
+	//        c: [2]
+	//      }
+	//
+	//  then if the field mask is:
+	//
+	//   paths: ["f.b", "f.c"]
+	//
+	//  then the result will be:
+	//
+	//      f {
+	//        b {
+	//          d: 10
+	//          x: 2
+	//        }
+	//        c: [1, 2]
+	//      }
+	//
+	//  An implementation may provide options to override this default behavior for
+	//  repeated and message fields.
+	//
+	//  In order to reset a field's value to the default, the field must
+	//  be in the mask and set to the default value in the provided resource.
+	//  Hence, in order to reset all fields of a resource, provide a default
+	//  instance of the resource and set all fields in the mask, or do
+	//  not provide a mask as described below.
+	//
+	//  If a field mask is not present on update, the operation applies to
+	//  all fields (as if a field mask of all fields has been specified).
+	//  Note that in the presence of schema evolution, this may mean that
+	//  fields the client does not know and has therefore not filled into
+	//  the request will be reset to their default. If this is unwanted
+	//  behavior, a specific service may require a client to always specify
+	//  a field mask, producing an error if not.
+	//
+	//  As with get operations, the location of the resource which
+	//  describes the updated values in the request message depends on the
+	//  operation kind. In any case, the effect of the field mask is
+	//  required to be honored by the API.
+	//
+	//  ## Considerations for HTTP REST
+	//
+	//  The HTTP kind of an update operation which uses a field mask must
+	//  be set to PATCH instead of PUT in order to satisfy HTTP semantics
+	//  (PUT must only be used for full updates).
+	//
+	//  # JSON Encoding of Field Masks
+	//
+	//  In JSON, a field mask is encoded as a single string where paths are
+	//  separated by a comma. Fields name in each path are converted
+	//  to/from lower-camel naming conventions.
+	//
+	//  As an example, consider the following message declarations:
+	//
+	//      message Profile {
+	//        User user = 1;
+	//        Photo photo = 2;
+	//      }
+	//      message User {
+	//        string display_name = 1;
+	//        string address = 2;
+	//      }
+	//
+	//  In proto a field mask for `Profile` may look as such:
+	//
+	//      mask {
+	//        paths: "user.display_name"
+	//        paths: "photo"
+	//      }
+	//
+	//  In JSON, the same mask is represented as below:
+	//
+	//      {
+	//        mask: "user.displayName,photo"
+	//      }
+	//
+	//  # Field Masks and Oneof Fields
+	//
+	//  Field masks treat fields in oneofs just as regular fields. Consider the
+	//  following message:
+	//
+	//      message SampleMessage {
+	//        oneof test_oneof {
+	//          string name = 4;
+	//          SubMessage sub_message = 9;
+	//        }
+	//      }
+	//
+	//  The field mask can be:
+	//
+	//      mask {
+	//        paths: "name"
+	//      }
+	//
+	//  Or:
+	//
+	//      mask {
+	//        paths: "sub_message"
+	//      }
+	//
+	//  Note that oneof type names ("test_oneof" in this case) cannot be used in
+	//  paths.
+	//
+	//  ## Field Mask Verification
+	//
+	//  The implementation of any API method which has a FieldMask type field in the
+	//  request should verify the included field paths, and return an
+	//  `INVALID_ARGUMENT` error if any path is unmappable.
+	Fields *GoogleProtobufFieldMask `json:"fields,omitempty"`
+
+	// Filter (OPTIONAL) Optional filter to return only item of interest.
+	//  See https://google.aip.dev/160 for details.
+	Filter *string `json:"filter,omitempty"`
+
+	// Offset (OPTIONAL) Index of the first item to return. This allows skipping items.
+	Offset *int `json:"offset,omitempty"`
+
+	// OrderBy (OPTIONAL) Optional comma separated list of fields to specify a sorting order.
+	//  See https://google.aip.dev/132 for details.
+	OrderBy *string `json:"orderBy,omitempty"`
+
+	// PageSize (OPTIONAL) Defines the amount of items to be contained in a single page.
+	//  Default of 20.
+	PageSize *int `json:"pageSize,omitempty"`
+
+	// ProjectName Project name
+	ProjectName string `json:"projectName"`
+
+	// View The fields returned by Get and List methods supporting views.
+	//  See https://google.aip.dev/157 for details.
+	View *ResourceView `json:"view,omitempty"`
+}
+
+// ListOperatingSystemsResponse Response message for the ListOperatingSystems method.
+type ListOperatingSystemsResponse struct {
+	// OperatingSystemResources Sorted and filtered list of oss.
+	OperatingSystemResources []OperatingSystemResource `json:"OperatingSystemResources"`
+
+	// HasNext Inform if there are more elements
+	HasNext bool `json:"hasNext"`
+
+	// TotalElements Count of items in the entire list, regardless of pagination.
+	TotalElements int32 `json:"totalElements"`
+}
+
+// ListOperationsRequest Request message for the ListOperations method.
+type ListOperationsRequest struct {
+	// Offset (OPTIONAL) Index of the first item to return. This allows skipping items.
+	Offset *int `json:"offset,omitempty"`
+
+	// PageSize (OPTIONAL) Defines the amount of items to be contained in a single page.
+	//  Default of 20.
+	PageSize *int `json:"pageSize,omitempty"`
+
+	// ProjectName Project name
+	ProjectName string `json:"projectName"`
+}
+
+// ListOperationsResponse Response message for the ListOperations method.
+type ListOperationsResponse struct {
+	// HasNext Inform if there are more elements
+	HasNext bool `json:"hasNext"`
+
+	// Operations List of operations, the most recent first.
+	Operations []OperationResource `json:"operations"`
+
+	// TotalElements Count of items in the entire list, regardless of pagination.
+	TotalElements int32 `json:"totalElements"`
+}
+
+// ListOusRequest Request message for the ListOus method.
+type ListOusRequest struct {
+	// Filter (OPTIONAL) Optional filter to return only item of interest.
+	//  See https://google.aip.dev/160 for details.
+	Filter *string `json:"filter,omitempty"`
+
+	// Offset (OPTIONAL) Index of the first item to return. This allows skipping items.
+	Offset *int `json:"offset,omitempty"`
+
+	// OrderBy (OPTIONAL) Optional comma separated list of fields to specify a sorting order.
+	//  See https://google.aip.dev/132 for details.
+	OrderBy *string `json:"orderBy,omitempty"`
+
+	// PageSize (OPTIONAL) Defines the amount of items to be contained in a single page.
+	//  Default of 20.
+	PageSize *int `json:"pageSize,omitempty"`
+
+	// ProjectName Project name
+	ProjectName string `json:"projectName"`
+}
+
+// ListOusResponse Response message for the ListOus method.
+type ListOusResponse struct {
+	// HasNext Inform if there are more elements
+	HasNext bool `json:"hasNext"`
+
+	// Ous Sorted and filtered list of OUs.
+	Ous []OuResource `json:"ous"`
+
+	// TotalElements Count of items in the entire list, regardless of pagination.
+	TotalElements int32 `json:"totalElements"`
+}
+
+// ListProvidersRequest Request message for the ListProviders method.
+type ListProvidersRequest struct {
+	// Filter (OPTIONAL) Optional filter to return only item of interest.
+	//  See https://google.aip.dev/160 for details.
+	Filter *string `json:"filter,omitempty"`
+
+	// Offset (OPTIONAL) Index of the first item to return. This allows skipping items.
+	Offset *int `json:"offset,omitempty"`
+
+	// OrderBy (OPTIONAL) Optional comma separated list of fields to specify a sorting order.
+	//  See https://google.aip.dev/132 for details.
+	OrderBy *string `json:"orderBy,omitempty"`
+
+	// PageSize (OPTIONAL) Defines the amount of items to be contained in a single page.
+	//  Default of 20.
+	PageSize *int `json:"pageSize,omitempty"`
+
+	// ProjectName Project name
+	ProjectName string `json:"projectName"`
+}
+
+// ListProvidersResponse Response message for the ListProviders method.
+type ListProvidersResponse struct {
+	// HasNext Inform if there are more elements
+	HasNext bool `json:"hasNext"`
+
+	// Providers Sorted and filtered list of providers.
+	Providers []ProviderResource `json:"providers"`
+
+	// TotalElements Count of items in the entire list, regardless of pagination.
+	TotalElements int32 `json:"totalElements"`
+}
+
+// ListRegionsRequest Request message for the ListRegions method.
+type ListRegionsRequest struct {
+	// Filter (OPTIONAL) Optional filter to return only item of interest.
+	//  See https://google.aip.dev/160 for details.
+	Filter *string `json:"filter,omitempty"`
+
+	// Offset (OPTIONAL) Index of the first item to return. This allows skipping items.
+	Offset *int `json:"offset,omitempty"`
+
+	// OrderBy (OPTIONAL) Optional comma separated list of fields to specify a sorting order.
+	//  See https://google.aip.dev/132 for details.
+	OrderBy *string `json:"orderBy,omitempty"`
+
+	// PageSize (OPTIONAL) Defines the amount of items to be contained in a single page.
+	//  Default of 20.
+	PageSize *int `json:"pageSize,omitempty"`
+
+	// ProjectName Project name
+	ProjectName string `json:"projectName"`
+
+	// ShowTotalSites (OPTIONAL) Flag to signal if the total amount of site in a region should be returned.
+	ShowTotalSites *bool `json:"showTotalSites,omitempty"`
+}
+
+// ListRegionsResponse Response message for the ListRegions method.
+type ListRegionsResponse struct {
+	// HasNext Inform if there are more elements
+	HasNext bool `json:"hasNext"`
+
+	// Regions Sorted and filtered list of regions.
+	Regions []RegionResource `json:"regions"`
+
+	// TotalElements Count of items in the entire list, regardless of pagination.
+	TotalElements int32 `json:"totalElements"`
+}
+
+// ListRemoteAccessesRequest Request message for the ListRemoteAccesses method.
+type ListRemoteAccessesRequest struct {
+	// Filter (OPTIONAL) Optional filter to return only item of interest.
+	//  See https://google.aip.dev/160 for details.
+	Filter *string `json:"filter,omitempty"`
+
+	// Offset (OPTIONAL) Index of the first item to return. This allows skipping items.
+	Offset *int `json:"offset,omitempty"`
+
+	// OrderBy (OPTIONAL) Optional comma separated list of fields to specify a sorting order.
+	//  See https://google.aip.dev/132 for details.
+	OrderBy *string `json:"orderBy,omitempty"`
+
+	// PageSize (OPTIONAL) Defines the amount of items to be contained in a single page.
+	//  Default of 20.
+	PageSize *int `json:"pageSize,omitempty"`
+
+	// ProjectName Project name
+	ProjectName string `json:"projectName"`
+}
+
+// ListRemoteAccessesResponse Response message for the ListRemoteAccesses method.
+type ListRemoteAccessesResponse struct {
+	// HasNext Inform if there are more elements
+	HasNext bool `json:"hasNext"`
+
+	// RemoteAccesses Sorted and filtered list of remote access sessions.
+	RemoteAccesses []RemoteAccessResource `json:"remoteAccesses"`
+
+	// TotalElements Count of items in the entire list, regardless of pagination.
+	TotalElements int32 `json:"totalElements"`
+}
+
+// ListRepeatedSchedulesRequest Request message for the ListRepeatedSchedules method.
+type ListRepeatedSchedulesRequest struct {
+	// HostId (OPTIONAL) The host ID target of the schedules. If not specified, returns all schedules
+	//  (given the other query params). If specified, returns the schedules that have
+	//  the specified host ID applied to them, i.e., target including the inherited ones
+	//  (parent site if not null). If null, returns all the schedules without a host ID as target.
+	// string.max_bytes = 13
+	HostId *string `json:"hostId,omitempty"`
+
+	// Offset (OPTIONAL) Index of the first item to return. This allows skipping items.
+	Offset *int `json:"offset,omitempty"`
+
+	// PageSize (OPTIONAL) Defines the amount of items to be contained in a single page.
+	//  Default of 20.
+	PageSize *int `json:"pageSize,omitempty"`
+
+	// ProjectName Project name
+	ProjectName string `json:"projectName"`
+
+	// RegionId (OPTIONAL) The region ID target of the schedules. If not specified,
+	//  returns all schedules (given the other query params).
+	//  If specified, returns the schedules that have the specified region ID applied to them,
+	//  i.e., target including the inherited ones (parent region if not null).
+	//  If null, returns all the schedules without a region ID as target.
+	// string.max_bytes = 15
+	RegionId *string `json:"regionId,omitempty"`
+
+	// SiteId (OPTIONAL) The site ID target of the schedules. If not specified, returns all schedules
+	//  (given the other query params). If specified, returns the schedules that have
+	//  the specified site ID applied to them, i.e., target including the inherited ones.
+	//  If null, returns all the schedules without a site ID as target
+	// string.max_bytes = 13
+	SiteId *string `json:"siteId,omitempty"`
+
+	// UnixEpoch (OPTIONAL) Filter based on the timestamp, expected to be UNIX epoch UTC timestamp in seconds.
+	UnixEpoch *string `json:"unixEpoch,omitempty"`
+}
+
+// ListRepeatedSchedulesResponse Response message for the ListRepeatedSchedules method.
+type ListRepeatedSchedulesResponse struct {
+	// HasNext Inform if there are more elements
+	HasNext bool `json:"hasNext"`
+
+	// RepeatedSchedules Sorted and filtered list of repeated_schedules.
+	RepeatedSchedules []RepeatedScheduleResource `json:"repeatedSchedules"`
+
+	// TotalElements Count of items in the entire list, regardless of pagination.
+	TotalElements int32 `json:"totalElements"`
+}
+
+// ListRoleBindingsRequest Request message for the ListRoleBindings method.
+type ListRoleBindingsRequest struct {
+	// Filter (OPTIONAL) Optional filter to return only item of interest.
+	//  See https://google.aip.dev/160 for details.
+	Filter *string `json:"filter,omitempty"`
+
+	// Offset (OPTIONAL) Index of the first item to return. This allows skipping items.
+	Offset *int `json:"offset,omitempty"`
+
+	// OrderBy (OPTIONAL) Optional comma separated list of fields to specify a sorting order.
+	//  See https://google.aip.dev/132 for details.
+	OrderBy *string `json:"orderBy,omitempty"`
+
+	// PageSize (OPTIONAL) Defines the amount of items to be contained in a single page.
+	//  Default of 20.
+	PageSize *int `json:"pageSize,omitempty"`
+
+	// ProjectName Project name
+	ProjectName string `json:"projectName"`
+}
+
+// ListRoleBindingsResponse Response message for the ListRoleBindings method.
+type ListRoleBindingsResponse struct {
+	// HasNext Inform if there are more elements
+	HasNext bool `json:"hasNext"`
+
+	// RoleBindings Sorted and filtered list of role bindings.
+	RoleBindings []RoleBindingResource `json:"roleBindings"`
+
+	// TotalElements Count of items in the entire list, regardless of pagination.
+	TotalElements int32 `json:"totalElements"`
+}
+
+// ListSchedulesRequest Request message for the ListSchedules method.
+type ListSchedulesRequest struct {
+	// HostId (OPTIONAL) The host ID target of the schedules. If not specified, returns all schedules
+	//  (given the other query params). If specified, returns the schedules that have
+	//  the specified host ID applied to them, i.e., target including the inherited ones
+	//  (parent site if not null). If null, returns all the schedules without a host ID as target.
+	// string.max_bytes = 13
+	HostId *string `json:"hostId,omitempty"`
+
+	// Offset (OPTIONAL) Index of the first item to return. This allows skipping items.
+	Offset *int `json:"offset,omitempty"`
+
+	// PageSize (OPTIONAL) Defines the amount of items to be contained in a single page.
+	//  Default of 20.
+	PageSize *int `json:"pageSize,omitempty"`
+
+	// ProjectName Project name
+	ProjectName string `json:"projectName"`
+
+	// RegionId (OPTIONAL) The region ID target of the schedules. If not specified,
+	//  returns all schedules (given the other query params).
+	//  If specified, returns the schedules that have the specified region ID applied to them,
+	//  i.e., target including the inherited ones (parent region if not null).
+	//  If null, returns all the schedules without a region ID as target.
+	// string.max_bytes = 15
+	RegionId *string `json:"regionId,omitempty"`
+
+	// SiteId (OPTIONAL) The site ID target of the schedules. If not specified, returns all schedules
+	//  (given the other query params). If specified, returns the schedules that have
+	//  the specified site ID applied to them, i.e., target including the inherited ones.
+	//  If null, returns all the schedules without a site ID as target
+	// string.max_bytes = 13
+	SiteId *string `json:"siteId,omitempty"`
+
+	// UnixEpoch (OPTIONAL) Filter based on the timestamp, expected to be UNIX epoch UTC timestamp in seconds.
+	UnixEpoch *string `json:"unixEpoch,omitempty"`
+}
+
+// ListSchedulesResponse Response message for the ListSchedulesResponse method.
+type ListSchedulesResponse struct {
+	// HasNext Inform if there are more elements
+	HasNext bool `json:"hasNext"`
+
+	// RepeatedSchedules Sorted and filtered list of repeated_schedules.
+	RepeatedSchedules []RepeatedScheduleResource `json:"repeatedSchedules"`
+
+	// SingleSchedules Sorted and filtered list of single_schedules.
+	SingleSchedules []SingleScheduleResource `json:"singleSchedules"`
+
+	// TotalElements Count of items in the entire list, regardless of pagination.
+	TotalElements int32 `json:"totalElements"`
+}
+
+// ListSingleSchedulesRequest Request message for the ListSingleSchedules method.
+type ListSingleSchedulesRequest struct {
+	// HostId (OPTIONAL) The host ID target of the schedules. If not specified, returns all schedules
+	//  (given the other query params). If specified, returns the schedules that have
+	//  the specified host ID applied to them, i.e., target including the inherited ones
+	//  (parent site if not null). If null, returns all the schedules without a host ID as target.
+	// string.max_bytes = 13
+	HostId *string `json:"hostId,omitempty"`
+
+	// Offset (OPTIONAL) Index of the first item to return. This allows skipping items.
+	Offset *int `json:"offset,omitempty"`
+
+	// PageSize (OPTIONAL) Defines the amount of items to be contained in a single page.
+	//  Default of 20.
+	PageSize *int `json:"pageSize,omitempty"`
+
+	// ProjectName Project name
+	ProjectName string `json:"projectName"`
+
+	// RegionId (OPTIONAL) The region ID target of the schedules. If not specified,
+	//  returns all schedules (given the other query params).
+	//  If specified, returns the schedules that have the specified region ID applied to them,
+	//  i.e., target including the inherited ones (parent region if not null).
+	//  If null, returns all the schedules without a region ID as target.
+	// string.max_bytes = 15
+	RegionId *string `json:"regionId,omitempty"`
+
+	// SiteId (OPTIONAL) The site ID target of the schedules. If not specified, returns all schedules
+	//  (given the other query params). If specified, returns the schedules that have
+	//  the specified site ID applied to them, i.e., target including the inherited ones.
+	//  If null, returns all the schedules without a site ID as target
+	// string.max_bytes = 13
+	SiteId *string `json:"siteId,omitempty"`
+
+	// UnixEpoch (OPTIONAL) Filter based on the timestamp, expected to be UNIX epoch UTC timestamp in seconds.
+	UnixEpoch *string `json:"unixEpoch,omitempty"`
+}
+
+// ListSingleSchedulesResponse Response message for the ListSingleSchedules method.
+type ListSingleSchedulesResponse struct {
+	// HasNext Inform if there are more elements
+	HasNext bool `json:"hasNext"`
+
+	// SingleSchedules Sorted and filtered list of single_schedules.
+	SingleSchedules []SingleScheduleResource `json:"singleSchedules"`
+
+	// TotalElements Count of items in the entire list, regardless of pagination.
+	TotalElements int32 `json:"totalElements"`
+}
+
+// ListSitesRequest Request message for the ListSites method.
+type ListSitesRequest struct {
+	// Fields `FieldMask` represents a set of symbolic field paths, for example:
+	//
+	//      paths: "f.a"
+	//      paths: "f.b.d"
+	//
+	//  Here `f` represents a field in some root message, `a` and `b`
+	//  fields in the message found in `f`, and `d` a field found in the
+	//  message in `f.b`.
+	//
+	//  Field masks are used to specify a subset of fields that should be
+	//  returned by a get operation or modified by an update operation.
+	//  Field masks also have a custom JSON encoding (see below).
+	//
+	//  # Field Masks in Projections
+	//
+	//  When used in the context of a projection, a response message or
+	//  sub-message is filtered by the API to only contain those fields as
+	//  specified in the mask. For example, if the mask in the previous
+	//  example is applied to a response message as follows:
+	//
+	//      f {
+	//        a : 22
+	//        b {
+	//          d : 1
+	//          x : 2
+	//        }
+	//        y : 13
+	//      }
+	//      z: 8
+	//
+	//  The result will not contain specific values for fields x,y and z
+	//  (their value will be set to the default, and omitted in proto text
+	//  output):
+	//
+	//
+	//      f {
+	//        a : 22
+	//        b {
+	//          d : 1
+	//        }
+	//      }
+	//
+	//  A repeated field is not allowed except at the last position of a
+	//  paths string.
+	//
+	//  If a FieldMask object is not present in a get operation, the
+	//  operation applies to all fields (as if a FieldMask of all fields
+	//  had been specified).
+	//
+	//  Note that a field mask does not necessarily apply to the
+	//  top-level response message. In case of a REST get operation, the
+	//  field mask applies directly to the response, but in case of a REST
+	//  list operation, the mask instead applies to each individual message
+	//  in the returned resource list. In case of a REST custom method,
+	//  other definitions may be used. Where the mask applies will be
+	//  clearly documented together with its declaration in the API.  In
+	//  any case, the effect on the returned resource/resources is required
+	//  behavior for APIs.
+	//
+	//  # Field Masks in Update Operations
+	//
+	//  A field mask in update operations specifies which fields of the
+	//  targeted resource are going to be updated. The API is required
+	//  to only change the values of the fields as specified in the mask
+	//  and leave the others untouched. If a resource is passed in to
+	//  describe the updated values, the API ignores the values of all
+	//  fields not covered by the mask.
+	//
+	//  If a repeated field is specified for an update operation, new values will
+	//  be appended to the existing repeated field in the target resource. Note that
+	//  a repeated field is only allowed in the last position of a `paths` string.
+	//
+	//  If a sub-message is specified in the last position of the field mask for an
+	//  update operation, then new value will be merged into the existing sub-message
+	//  in the target resource.
+	//
+	//  For example, given the target message:
+	//
+	//      f {
+	//        b {
+	//          d: 1
+	//          x: 2
+	//        }
+	//        c: [1]
+	//      }
+	//
+	//  And an update message:
+	//
+	//      f {
+	//        b {
+	//          d: 10
+	//        }
+	//        c: [2]
+	//      }
+	//
+	//  then if the field mask is:
+	//
+	//   paths: ["f.b", "f.c"]
+	//
+	//  then the result will be:
+	//
+	//      f {
+	//        b {
+	//          d: 10
+	//          x: 2
+	//        }
+	//        c: [1, 2]
+	//      }
+	//
+	//  An implementation may provide options to override this default behavior for
+	//  repeated and message fields.
+	//
+	//  In order to reset a field's value to the default, the field must
+	//  be in the mask and set to the default value in the provided resource.
+	//  Hence, in order to reset all fields of a resource, provide a default
+	//  instance of the resource and set all fields in the mask, or do
+	//  not provide a mask as described below.
+	//
+	//  If a field mask is not present on update, the operation applies to
+	//  all fields (as if a field mask of all fields has been specified).
+	//  Note that in the presence of schema evolution, this may mean that
+	//  fields the client does not know and has therefore not filled into
+	//  the request will be reset to their default. If this is unwanted
+	//  behavior, a specific service may require a client to always specify
+	//  a field mask, producing an error if not.
+	//
+	//  As with get operations, the location of the resource which
+	//  describes the updated values in the request message depends on the
+	//  operation kind. In any case, the effect of the field mask is
+	//  required to be honored by the API.
+	//
+	//  ## Considerations for HTTP REST
+	//
+	//  The HTTP kind of an update operation which uses a field mask must
+	//  be set to PATCH instead of PUT in order to satisfy HTTP semantics
+	//  (PUT must only be used for full updates).
+	//
+	//  # JSON Encoding of Field Masks
+	//
+	//  In JSON, a field mask is encoded as a single string where paths are
+	//  separated by a comma. Fields name in each path are converted
+	//  to/from lower-camel naming conventions.
+	//
+	//  As an example, consider the following message declarations:
+	//
+	//      message Profile {
+	//        User user = 1;
+	//        Photo photo = 2;
+	//      }
+	//      message User {
+	//        string display_name = 1;
+	//        string address = 2;
+	//      }
+	//
+	//  In proto a field mask for `Profile` may look as such:
+	//
+	//      mask {
+	//        paths: "user.display_name"
+	//        paths: "photo"
+	//      }
+	//
+	//  In JSON, the same mask is represented as below:
+	//
+	//      {
+	//        mask: "user.displayName,photo"
+	//      }
+	//
+	//  # Field Masks and Oneof Fields
+	//
+	//  Field masks treat fields in oneofs just as regular fields. Consider the
+	//  following message:
+	//
+	//      message SampleMessage {
+	//        oneof test_oneof {
+	//          string name = 4;
+	//          SubMessage sub_message = 9;
+	//        }
+	//      }
+	//
+	//  The field mask can be:
+	//
+	//      mask {
+	//        paths: "name"
+	//      }
+	//
+	//  Or:
+	//
+	//      mask {
+	//        paths: "sub_message"
+	//      }
+	//
+	//  Note that oneof type names ("test_oneof" in this case) cannot be used in
+	//  paths.
+	//
+	//  ## Field Mask Verification
+	//
+	//  The implementation of any API method which has a FieldMask type field in the
+	//  request should verify the included field paths, and return an
+	//  `INVALID_ARGUMENT` error if any path is unmappable.
+	Fields *GoogleProtobufFieldMask `json:"fields,omitempty"`
+
+	// Filter (OPTIONAL) Optional filter to return only item of interest.
+	//  See https://google.aip.dev/160 for details.
+	Filter *string `json:"filter,omitempty"`
+
+	// Offset (OPTIONAL) Index of the first item to return. This allows skipping items.
+	Offset *int `json:"offset,omitempty"`
+
+	// OrderBy (OPTIONAL) Optional comma separated list of fields to specify a sorting order.
+	//  See https://google.aip.dev/132 for details.
+	OrderBy *string `json:"orderBy,omitempty"`
+
+	// PageSize (OPTIONAL) Defines the amount of items to be contained in a single page.
+	//  Default of 20.
+	PageSize *int `json:"pageSize,omitempty"`
+
+	// ProjectName Project name
+	ProjectName string `json:"projectName"`
+
+	// RegionId (OPTIONAL) Optional region ID for hierarchical path support
+	RegionId *string `json:"regionId,omitempty"`
+
+	// View The fields returned by Get and List methods supporting views.
+	//  See https://google.aip.dev/157 for details.
+	View *ResourceView `json:"view,omitempty"`
+}
+
+// ListSitesResponse Response message for the ListSites method.
+type ListSitesResponse struct {
+	// HasNext Inform if there are more elements
+	HasNext bool `json:"hasNext"`
+
+	// Sites Sorted and filtered list of sites.
+	Sites []SiteResource `json:"sites"`
+
+	// TotalElements Count of items in the entire list, regardless of pagination.
+	TotalElements int32 `json:"totalElements"`
+}
+
+// ListTelemetryLogsGroupsRequest Request message for the ListTelemetryLogsGroups method.
+type ListTelemetryLogsGroupsRequest struct {
+	// Offset (OPTIONAL) Index of the first item to return. This allows skipping items.
+	Offset *int `json:"offset,omitempty"`
+
+	// OrderBy (OPTIONAL) Optional comma separated list of fields to specify a sorting order.
+	//  See https://google.aip.dev/132 for details.
+	OrderBy *string `json:"orderBy,omitempty"`
+
+	// PageSize (OPTIONAL) Defines the amount of items to be contained in a single page.
+	//  Default of 20.
+	PageSize *int `json:"pageSize,omitempty"`
+
+	// ProjectName Project name
+	ProjectName string `json:"projectName"`
+}
+
+// ListTelemetryLogsGroupsResponse Response message for the ListTelemetryLogsGroups method.
+type ListTelemetryLogsGroupsResponse struct {
+	// HasNext Inform if there are more elements
+	HasNext bool `json:"hasNext"`
+
+	// TelemetryLogsGroups Sorted and filtered list of telemetry_logs_groups.
+	TelemetryLogsGroups []TelemetryLogsGroupResource `json:"telemetryLogsGroups"`
+
+	// TotalElements Count of items in the entire list, regardless of pagination.
+	TotalElements int32 `json:"totalElements"`
+}
+
+// ListTelemetryLogsProfilesRequest Request message for the ListTelemetryLogsProfiles method.
+type ListTelemetryLogsProfilesRequest struct {
+	// InstanceId (OPTIONAL) Returns only the telemetry profiles that are assigned with the given instance identifier.
+	// string.max_bytes = 13
+	InstanceId *string `json:"instanceId,omitempty"`
+
+	// Offset (OPTIONAL) Index of the first item to return. This allows skipping items.
+	Offset *int `json:"offset,omitempty"`
+
+	// OrderBy (OPTIONAL) Optional comma separated list of fields to specify a sorting order.
+	//  See https://google.aip.dev/132 for details.
+	OrderBy *string `json:"orderBy,omitempty"`
+
+	// PageSize (OPTIONAL) Defines the amount of items to be contained in a single page.
+	//  Default of 20.
+	PageSize *int `json:"pageSize,omitempty"`
+
+	// ProjectName Project name
+	ProjectName string `json:"projectName"`
+
+	// RegionId (OPTIONAL) Returns only the telemetry profiles that are assigned with the given regionID.
+	// string.max_bytes = 15
+	RegionId *string `json:"regionId,omitempty"`
+
+	// ShowInherited (OPTIONAL) Indicates if listed telemetry profiles should be extended with telemetry
+	//  profiles rendered from hierarchy. This flag is only used along with one
+	//  of siteId, regionId or instanceId. If siteId, regionId or instanceId are
+	//  not set, this flag is ignored.
+	ShowInherited *bool `json:"showInherited,omitempty"`
+
+	// SiteId (OPTIONAL) Returns only the telemetry profiles that are assigned with the given siteID.
+	// string.max_bytes = 13
+	SiteId *string `json:"siteId,omitempty"`
+}
+
+// ListTelemetryLogsProfilesResponse Response message for the ListTelemetryLogsProfiles method.
+type ListTelemetryLogsProfilesResponse struct {
+	// HasNext Inform if there are more elements
+	HasNext bool `json:"hasNext"`
+
+	// TelemetryLogsProfiles Sorted and filtered list of telemetry_logs_profiles.
+	TelemetryLogsProfiles []TelemetryLogsProfileResource `json:"telemetryLogsProfiles"`
+
+	// TotalElements Count of items in the entire list, regardless of pagination.
+	TotalElements int32 `json:"totalElements"`
+}
+
+// ListTelemetryMetricsGroupsRequest Request message for the ListTelemetryMetricsGroups method.
+type ListTelemetryMetricsGroupsRequest struct {
+	// Offset (OPTIONAL) Index of the first item to return. This allows skipping items.
+	Offset *int `json:"offset,omitempty"`
+
+	// OrderBy (OPTIONAL) Optional comma separated list of fields to specify a sorting order.
+	//  See https://google.aip.dev/132 for details.
+	OrderBy *string `json:"orderBy,omitempty"`
+
+	// PageSize (OPTIONAL) Defines the amount of items to be contained in a single page.
+	//  Default of 20.
+	PageSize *int `json:"pageSize,omitempty"`
+
+	// ProjectName Project name
+	ProjectName string `json:"projectName"`
+}
+
+// ListTelemetryMetricsGroupsResponse Response message for the ListTelemetryMetricsGroups method.
+type ListTelemetryMetricsGroupsResponse struct {
+	// HasNext Inform if there are more elements
+	HasNext bool `json:"hasNext"`
+
+	// TelemetryMetricsGroups Sorted and filtered list of telemetry_metrics_groups.
+	TelemetryMetricsGroups []TelemetryMetricsGroupResource `json:"telemetryMetricsGroups"`
+
+	// TotalElements Count of items in the entire list, regardless of pagination.
+	TotalElements int32 `json:"totalElements"`
+}
+
+// ListTelemetryMetricsProfilesRequest Request message for the ListTelemetryMetricsProfiles method.
+type ListTelemetryMetricsProfilesRequest struct {
+	// InstanceId (OPTIONAL) Returns only the telemetry profiles that are assigned with the given instance identifier.
+	// string.max_bytes = 13
+	InstanceId *string `json:"instanceId,omitempty"`
+
+	// Offset (OPTIONAL) Index of the first item to return. This allows skipping items.
+	Offset *int `json:"offset,omitempty"`
+
+	// OrderBy (OPTIONAL) Optional comma separated list of fields to specify a sorting order.
+	//  See https://google.aip.dev/132 for details.
+	OrderBy *string `json:"orderBy,omitempty"`
+
+	// PageSize (OPTIONAL) Defines the amount of items to be contained in a single page.
+	//  Default of 20.
+	PageSize *int `json:"pageSize,omitempty"`
+
+	// ProjectName Project name
+	ProjectName string `json:"projectName"`
+
+	// RegionId (OPTIONAL) Returns only the telemetry profiles that are assigned with the given regionID.
+	// string.max_bytes = 15
+	RegionId *string `json:"regionId,omitempty"`
+
+	// ShowInherited (OPTIONAL) Indicates if listed telemetry profiles should be extended with telemetry
+	//  profiles rendered from hierarchy. This flag is only used along with one
+	//  of siteId, regionId or instanceId. If siteId, regionId or instanceId are
+	//  not set, this flag is ignored.
+	ShowInherited *bool `json:"showInherited,omitempty"`
+
+	// SiteId (OPTIONAL) Returns only the telemetry profiles that are assigned with the given siteID.
+	// string.max_bytes = 13
+	SiteId *string `json:"siteId,omitempty"`
+}
+
+// ListTelemetryMetricsProfilesResponse Response message for the ListTelemetryMetricsProfiles method.
+type ListTelemetryMetricsProfilesResponse struct {
+	// HasNext Inform if there are more elements
+	HasNext bool `json:"hasNext"`
+
+	// TelemetryMetricsProfiles Sorted and filtered list of telemetry_metrics_profiles.
+	TelemetryMetricsProfiles []TelemetryMetricsProfileResource `json:"telemetryMetricsProfiles"`
+
+	// TotalElements Count of items in the entire list, regardless of pagination.
+	TotalElements int32 `json:"totalElements"`
+}
+
+// ListWorkloadMembersRequest Request message for the ListWorkloadMembers method.
+type ListWorkloadMembersRequest struct {
+	// Filter (OPTIONAL) Optional filter to return only item of interest.
+	//  See https://google.aip.dev/160 for details.
+	Filter *string `json:"filter,omitempty"`
+
+	// Offset (OPTIONAL) Index of the first item to return. This allows skipping items.
+	Offset *int `json:"offset,omitempty"`
+
+	// OrderBy (OPTIONAL) Optional comma separated list of fields to specify a sorting order.
+	//  See https://google.aip.dev/132 for details.
+	OrderBy *string `json:"orderBy,omitempty"`
+
+	// PageSize (OPTIONAL) Defines the amount of items to be contained in a single page.
+	//  Default of 20.
+	PageSize *int `json:"pageSize,omitempty"`
+
+	// ProjectName Project name
+	ProjectName string `json:"projectName"`
+}
+
+// ListWorkloadMembersResponse Response message for the ListWorkloadMembers method.
+type ListWorkloadMembersResponse struct {
+	// HasNext Inform if there are more elements
+	HasNext bool `json:"hasNext"`
+
+	// TotalElements Count of items in the entire list, regardless of pagination.
+	TotalElements int32 `json:"totalElements"`
+
+	// WorkloadMembers Sorted and filtered list of workload_members.
+	WorkloadMembers []WorkloadMember `json:"workloadMembers"`
+}
+
+// ListWorkloadsRequest Request message for the ListWorkloads method.
+type ListWorkloadsRequest struct {
+	// Filter (OPTIONAL) Optional filter to return only item of interest.
+	//  See https://google.aip.dev/160 for details.
+	Filter *string `json:"filter,omitempty"`
+
+	// Offset (OPTIONAL) Index of the first item to return. This allows skipping items.
+	Offset *int `json:"offset,omitempty"`
+
+	// OrderBy (OPTIONAL) Optional comma separated list of fields to specify a sorting order.
+	//  See https://google.aip.dev/132 for details.
+	OrderBy *string `json:"orderBy,omitempty"`
+
+	// PageSize (OPTIONAL) Defines the amount of items to be contained in a single page.
+	//  Default of 20.
+	PageSize *int `json:"pageSize,omitempty"`
+
+	// ProjectName Project name
+	ProjectName string `json:"projectName"`
+}
+
+// ListWorkloadsResponse Response message for the ListWorkloads method.
+type ListWorkloadsResponse struct {
+	// HasNext Inform if there are more elements
+	HasNext bool `json:"hasNext"`
+
+	// TotalElements Count of items in the entire list, regardless of pagination.
+	TotalElements int32 `json:"totalElements"`
+
+	// Workloads Sorted and filtered list of workloads.
+	Workloads []WorkloadResource `json:"workloads"`
+}
+
+// LocalAccountResource defines model for LocalAccountResource.
+type LocalAccountResource struct {
+	// LocalAccountID Deprecated, The local account resource's unique identifier. Alias of resourceId.
+	LocalAccountID *string `json:"localAccountID,omitempty"`
+
+	// ResourceId resource identifier
+	ResourceId *string `json:"resourceId,omitempty"`
+
+	// SshKey SSH Public Key of EN
+	SshKey     string      `json:"sshKey"`
+	Timestamps *Timestamps `json:"timestamps,omitempty"`
+
+	// Username Username provided by admin
+	Username string `json:"username"`
+}
+
+// MetadataItem A metadata item, represented by a key:value pair.
+type MetadataItem struct {
+	// Key The metadata key.
+	Key string `json:"key"`
+
+	// Value The metadata value.
+	Value string `json:"value"`
+}
+
+// NetlinkResource A netlink resource, a network link between two endpoints.
+type NetlinkResource struct {
+	// CurrentState The states of a netlink.
+	CurrentState *NetlinkState `json:"currentState,omitempty"`
+
+	// DesiredState The states of a netlink.
+	DesiredState *NetlinkState `json:"desiredState,omitempty"`
+
+	// Dst An endpoint resource, one side of a netlink.
+	Dst *EndpointResource `json:"dst,omitempty"`
+
+	// Kind Kind of the netlink, frequently tied to the provider.
+	Kind *string `json:"kind,omitempty"`
+
+	// Name The netlink's human-readable name.
+	Name *string `json:"name,omitempty"`
+
+	// ProviderStatus The status of the netlink according to the provider.
+	ProviderStatus *string `json:"providerStatus,omitempty"`
+
+	// ResourceId Resource ID, generated by Inventory on Create.
+	ResourceId *string `json:"resourceId,omitempty"`
+
+	// Src An endpoint resource, one side of a netlink.
+	Src        *EndpointResource `json:"src,omitempty"`
+	Timestamps *Timestamps       `json:"timestamps,omitempty"`
+}
+
+// NetlinkState The states of a netlink.
+type NetlinkState string
+
+// NetworkInterfaceLinkState defines model for NetworkInterfaceLinkState.
+type NetworkInterfaceLinkState struct {
+	Timestamps *Timestamps `json:"timestamps,omitempty"`
+
+	// Type The state of the network interface.
+	Type *LinkState `json:"type,omitempty"`
+}
+
+// NetworkSegmentResource A network segment resource.
+type NetworkSegmentResource struct {
+	// Name The network segment's human-readable name.
+	Name *string `json:"name,omitempty"`
+
+	// ResourceId Resource ID, generated by Inventory on Create.
+	ResourceId *string `json:"resourceId,omitempty"`
+
+	// Site A site resource.
+	Site *SiteResource `json:"site,omitempty"`
+
+	// SiteId The site's unique identifier that the network segment is associated to.
+	//  This field cannot be used in filter.
+	SiteId     *string     `json:"siteId,omitempty"`
+	Timestamps *Timestamps `json:"timestamps,omitempty"`
+
+	// VlanId The VLAN ID of the network segment, 0 if not set.
+	VlanId *int32 `json:"vlanId,omitempty"`
+}
+
+// OSUpdatePolicy defines model for OSUpdatePolicy.
+type OSUpdatePolicy struct {
+	// Description User-provided, human-readable description.
+	Description *string `json:"description,omitempty"`
+
+	// Name User-provided, human-readable name.
+	Name string `json:"name"`
+
+	// ResourceId resource ID, generated by the inventory on Create.
+	// string.max_bytes = 23
+	ResourceId *string `json:"resourceId,omitempty"`
+
+	// TargetOs An OS resource.
+	TargetOs *OperatingSystemResource `json:"targetOs,omitempty"`
+
+	// TargetOsId The unique identifier of target OS will be associated with the OS Update policy.
+	TargetOsId *string     `json:"targetOsId,omitempty"`
+	Timestamps *Timestamps `json:"timestamps,omitempty"`
+
+	// UpdateKernelCommand The OS resource's kernel Command Line Options.
+	//  Applies only to Mutable OSes.
+	UpdateKernelCommand *string `json:"updateKernelCommand,omitempty"`
+
+	// UpdatePackages Freeform text, OS-dependent. A list of package names, one per line (newline separated). Must not contain version information.
+	//  Applies only to Mutable OSes.
+	UpdatePackages *string `json:"updatePackages,omitempty"`
+
+	// UpdatePolicy States of the host.
+	UpdatePolicy *UpdatePolicy `json:"updatePolicy,omitempty"`
+
+	// UpdateSources The list of OS resource update sources.
+	//  Should be in 'DEB822 Source Format' for Debian style OSs.
+	//  Applies only to Mutable OSes.
+	UpdateSources *[]string `json:"updateSources,omitempty"`
+}
+
+// OSUpdateRun defines model for OSUpdateRun.
+type OSUpdateRun struct {
+	AppliedPolicy *OSUpdatePolicy `json:"appliedPolicy,omitempty"`
+
+	// Description Human-readable description.
+	Description *string `json:"description,omitempty"`
+
+	// EndTime UTC timestamp of OS Update ended.
+	EndTime *int `json:"endTime,omitempty"`
+
+	// Instance InstanceResource describes an instantiated OS install, running on either a
+	//  host or hypervisor.
+	Instance *InstanceResource `json:"instance,omitempty"`
+
+	// Name Human-readable name.
+	Name *string `json:"name,omitempty"`
+
+	// ResourceId resource ID, generated by the inventory on Create.
+	// string.max_bytes = 20
+	ResourceId *string `json:"resourceId,omitempty"`
+
+	// StartTime UTC timestamp of OS Update started.
+	StartTime *int `json:"startTime,omitempty"`
+
+	// Status Short message that describes what happened during the OS Update.
+	Status *string `json:"status,omitempty"`
+
+	// StatusDetails Details about what happened during the OS Update.
+	StatusDetails *string `json:"statusDetails,omitempty"`
+
+	// StatusIndicator The status indicator.
+	StatusIndicator *StatusIndication `json:"statusIndicator,omitempty"`
+
+	// StatusTimestamp UTC timestamp of OS Update status reported.
+	StatusTimestamp *int        `json:"statusTimestamp,omitempty"`
+	Timestamps      *Timestamps `json:"timestamps,omitempty"`
+}
+
+// OnboardHostRequest Request to onboard a Host.
+type OnboardHostRequest struct {
+	// ProjectName The project name from the URL path.
+	ProjectName string `json:"projectName"`
+
+	// ResourceId Host resource ID
+	// string.max_bytes = 13
+	ResourceId *string `json:"resourceId,omitempty"`
+}
+
+// OnboardHostResponse Response of a Host Register request.
+type OnboardHostResponse = map[string]interface{}
+
+// OperatingSystemResource An OS resource.
+type OperatingSystemResource struct {
+	// Architecture The OS resource's CPU architecture.
+	Architecture *string `json:"architecture,omitempty"`
+	Description  *string `json:"description,omitempty"`
+
+	// ExistingCves The CVEs that are currently present on the Operating System, encoded as a JSON list.
+	ExistingCves *string `json:"existingCves,omitempty"`
+
+	// ExistingCvesUrl (IMMUTABLE) URL of the file containing information about the existing CVEs on the Operating System.
+	ExistingCvesUrl *string `json:"existingCvesUrl,omitempty"`
+
+	// FixedCves The CVEs that have been fixed by this OS Resource version, encoded as a JSON list.
+	FixedCves *string `json:"fixedCves,omitempty"`
+
+	// FixedCvesUrl (IMMUTABLE) URL of the file containing information about the CVEs that have been fixed by this OS Resource version.
+	FixedCvesUrl *string `json:"fixedCvesUrl,omitempty"`
+
+	// ImageId A unique identifier of the OS image that can be retrieved from the running OS.
+	ImageId *string `json:"imageId,omitempty"`
+
+	// ImageUrl The URL repository of the OS image.
+	ImageUrl *string `json:"imageUrl,omitempty"`
+
+	// InstalledPackages List of installed packages, encoded as a JSON list.
+	InstalledPackages *string `json:"installedPackages,omitempty"`
+
+	// InstalledPackagesUrl (IMMUTABLE) The URL of the OS manifest which contains install packages details. This will be used to fill the installed_packages field
+	//  for the advance use case to allow manual creation of OSProfiles when supported from backend.
+	InstalledPackagesUrl *string `json:"installedPackagesUrl,omitempty"`
+
+	// Metadata Opaque JSON field storing metadata associated to this OS resource. Expected to be a JSON object with string keys and values, or an empty string.
+	Metadata *string `json:"metadata,omitempty"`
+
+	// Name The OS resource's name.
+	Name *string `json:"name,omitempty"`
+
+	// OsProvider OsProviderKind describes "owner" of the OS, that will drive OS provisioning.
+	OsProvider *OsProviderKind `json:"osProvider,omitempty"`
+
+	// OsResourceID Deprecated, The OS resource's unique identifier. Alias of resourceId.
+	OsResourceID *string `json:"osResourceID,omitempty"`
+
+	// OsType OsType describes type of operating system.
+	OsType *OsType `json:"osType,omitempty"`
+
+	// PlatformBundle Opaque JSON field storing references to custom installation script(s) that
+	//  supplements the base OS with additional OS-level dependencies/configurations.
+	//  If empty, the default OS installation will be used.
+	PlatformBundle *string `json:"platformBundle,omitempty"`
+
+	// ProfileName Name of an OS profile that the OS resource belongs to. Uniquely identifies a family of OS resources.
+	ProfileName *string `json:"profileName,omitempty"`
+
+	// ProfileVersion Version of OS profile that the OS resource belongs to.
+	ProfileVersion *string `json:"profileVersion,omitempty"`
+
+	// RepoUrl Deprecated. OS image URL. URL of the original installation source.
+	RepoUrl *string `json:"repoUrl,omitempty"`
+
+	// ResourceId Resource ID, generated by inventory on Create.
+	ResourceId *string `json:"resourceId,omitempty"`
+
+	// SecurityFeature SecurityFeature describes the security capabilities of a resource.
+	SecurityFeature *SecurityFeature `json:"securityFeature,omitempty"`
+
+	// Sha256 SHA256 checksum of the OS resource in hexadecimal representation.
+	Sha256     string      `json:"sha256"`
+	Timestamps *Timestamps `json:"timestamps,omitempty"`
+
+	// TlsCaCert user-provided, TLS CA Certificate
+	TlsCaCert *string `json:"tlsCaCert,omitempty"`
+}
+
+// OperationResource A long-running operation, following https://google.aip.dev/151.
+//
+//	Operations are returned by the calls that are too expensive to complete within a single request.
+type OperationResource struct {
+	// Done Whether the operation is over, either succeeded, failed or cancelled.
+	Done *bool `json:"done,omitempty"`
+
+	// Error Reason of the failure of the operation as a whole, set in the FAILED state only.
+	Error *string `json:"error,omitempty"`
+
+	// Failed Number of resources the operation failed to act on.
+	Failed *int `json:"failed,omitempty"`
+
+	// Kind The method that started the operation, e.g. InvalidateHosts.
+	Kind *string `json:"kind,omitempty"`
+
+	// ProgressPercent Completion of the operation, between 0 and 100.
+	ProgressPercent *int `json:"progressPercent,omitempty"`
+
+	// ResourceId resource identifier
+	ResourceId *string `json:"resourceId,omitempty"`
+
+	// Results Outcome of each resource the operation acted on, in processing order.
+	Results *[]OperationResult `json:"results,omitempty"`
+
+	// State The state of a long-running operation.
+	State *OperationState `json:"state,omitempty"`
+
+	// Succeeded Number of resources the operation acted on successfully.
+	Succeeded  *int        `json:"succeeded,omitempty"`
+	Timestamps *Timestamps `json:"timestamps,omitempty"`
+
+	// Total Number of resources the operation acts on.
+	Total *int `json:"total,omitempty"`
+}
+
+// OperationResult The outcome of an operation on a single resource.
+type OperationResult struct {
+	// Error Reason of the failure, unset on success.
+	Error *string `json:"error,omitempty"`
+
+	// ResourceId Resource ID of the resource the operation acted on.
+	ResourceId *string `json:"resourceId,omitempty"`
+}
+
+// OperationState The state of a long-running operation.
+type OperationState string
+
+// OsProviderKind OsProviderKind describes "owner" of the OS, that will drive OS provisioning.
+type OsProviderKind string
+
+// OsType OsType describes type of operating system.
+type OsType string
+
+// OuResource An organizational unit (OU) resource.
+type OuResource struct {
+	// InheritedMetadata The rendered metadata from the OU parent(s)
+	//  that can be inherited by the OU, represented by a list of key:value pairs.
+	//  This field can not be used in filter.
+	InheritedMetadata *[]MetadataItem `json:"inheritedMetadata,omitempty"`
+
+	// Metadata (OPTIONAL) The metadata associated to the OU,
+	//  represented by a list of key:value pairs.
+	Metadata *[]MetadataItem `json:"metadata,omitempty"`
+
+	// Name The user-provided, human-readable name of the OU.
+	Name *string `json:"name,omitempty"`
+
+	// OuKind The kind of the OU, like "Organization" or "BU".
+	OuKind *string `json:"ouKind,omitempty"`
+
+	// ParentId The parent OU unique identifier
+	//  that the OU is associated to, when existent.
+	//  This field can not be used in filter.
+	ParentId *string `json:"parentId,omitempty"`
+
+	// ParentOu An organizational unit (OU) resource.
+	ParentOu *OuResource `json:"parentOu,omitempty"`
+
+	// ResourceId resource ID, generated by the inventory on Create.
+	ResourceId *string     `json:"resourceId,omitempty"`
+	Timestamps *Timestamps `json:"timestamps,omitempty"`
+}
+
+// PatchCustomConfigRequest Request message for the PatchCustomConfig method.
+type PatchCustomConfigRequest struct {
+	CustomConfig CustomConfigResource `json:"customConfig"`
+
+	// FieldMask `FieldMask` represents a set of symbolic field paths, for example:
+	//
+	//      paths: "f.a"
+	//      paths: "f.b.d"
+	//
+	//  Here `f` represents a field in some root message, `a` and `b`
+	//  fields in the message found in `f`, and `d` a field found in the
+	//  message in `f.b`.
+	//
+	//  Field masks are used to specify a subset of fields that should be
+	//  returned by a get operation or modified by an update operation.
+	//  Field masks also have a custom JSON encoding (see below).
+	//
+	//  # Field Masks in Projections
+	//
+	//  When used in the context of a projection, a response message or
+	//  sub-message is filtered by the API to only contain those fields as
+	//  specified in the mask. For example, if the mask in the previous
+	//  example is applied to a response message as follows:
+	//
+	//      f {
+	//        a : 22
+	//        b {
+	//          d : 1
+	//          x : 2
+	//        }
+	//        y : 13
+	//      }
+	//      z: 8
+	//
+	//  The result will not contain specific values for fields x,y and z
+	//  (their value will be set to the default, and omitted in proto text
+	//  output):
+	//
+	//
+	//      f {
+	//        a : 22
+	//        b {
+	//          d : 1
+	//        }
+	//      }
+	//
+	//  A repeated field is not allowed except at the last position of a
+	//  paths string.
+	//
+	//  If a FieldMask object is not present in a get operation, the
+	//  operation applies to all fields (as if a FieldMask of all fields
+	//  had been specified).
+	//
+	//  Note that a field mask does not necessarily apply to the
+	//  top-level response message. In case of a REST get operation, the
+	//  field mask applies directly to the response, but in case of a REST
+	//  list operation, the mask instead applies to each individual message
+	//  in the returned resource list. In case of a REST custom method,
+	//  other definitions may be used. Where the mask applies will be
+	//  clearly documented together with its declaration in the API.  In
+	//  any case, the effect on the returned resource/resources is required
+	//  behavior for APIs.
+	//
+	//  # Field Masks in Update Operations
+	//
+	//  A field mask in update operations specifies which fields of the
+	//  targeted resource are going to be updated. The API is required
+	//  to only change the values of the fields as specified in the mask
+	//  and leave the others untouched. If a resource is passed in to
+	//  describe the updated values, the API ignores the values of all
+	//  fields not covered by the mask.
+	//
+	//  If a repeated field is specified for an update operation, new values will
+	//  be appended to the existing repeated field in the target resource. Note that
+	//  a repeated field is only allowed in the last position of a `paths` string.
+	//
+	//  If a sub-message is specified in the last position of the field mask for an
+	//  update operation, then new value will be merged into the existing sub-message
+	//  in the target resource.
+	//
+	//  For example, given the target message:
+	//
+	//      f {
+	//        b {
+	//          d: 1
+	//          x: 2
+	//        }
+	//        c: [1]
+	//      }
+	//
+	//  And an update message:
+	//
+	//      f {
+	//        b {
+	//          d: 10
+	//        }
+	//        c: [2]
+	//      }
+	//
+	//  then if the field mask is:
+	//
+	//   paths: ["f.b", "f.c"]
+	//
+	//  then the result will be:
+	//
+	//      f {
+	//        b {
+	//          d: 10
+	//          x: 2
+	//        }
+	//        c: [1, 2]
+	//      }
+	//
+	//  An implementation may provide options to override this default behavior for
+	//  repeated and message fields.
+	//
+	//  In order to reset a field's value to the default, the field must
+	//  be in the mask and set to the default value in the provided resource.
+	//  Hence, in order to reset all fields of a resource, provide a default
+	//  instance of the resource and set all fields in the mask, or do
+	//  not provide a mask as described below.
+	//
+	//  If a field mask is not present on update, the operation applies to
+	//  all fields (as if a field mask of all fields has been specified).
+	//  Note that in the presence of schema evolution, this may mean that
+	//  fields the client does not know and has therefore not filled into
+	//  the request will be reset to their default. If this is unwanted
+	//  behavior, a specific service may require a client to always specify
+	//  a field mask, producing an error if not.
+	//
+	//  As with get operations, the location of the resource which
+	//  describes the updated values in the request message depends on the
+	//  operation kind. In any case, the effect of the field mask is
+	//  required to be honored by the API.
+	//
+	//  ## Considerations for HTTP REST
+	//
+	//  The HTTP kind of an update operation which uses a field mask must
+	//  be set to PATCH instead of PUT in order to satisfy HTTP semantics
+	//  (PUT must only be used for full updates).
+	//
+	//  # JSON Encoding of Field Masks
+	//
+	//  In JSON, a field mask is encoded as a single string where paths are
+	//  separated by a comma. Fields name in each path are converted
+	//  to/from lower-camel naming conventions.
+	//
+	//  As an example, consider the following message declarations:
+	//
+	//      message Profile {
+	//        User user = 1;
+	//        Photo photo = 2;
+	//      }
+	//      message User {
+	//        string display_name = 1;
+	//        string address = 2;
+	//      }
+	//
+	//  In proto a field mask for `Profile` may look as such:
+	//
+	//      mask {
+	//        paths: "user.display_name"
+	//        paths: "photo"
+	//      }
+	//
+	//  In JSON, the same mask is represented as below:
+	//
+	//      {
+	//        mask: "user.displayName,photo"
+	//      }
+	//
+	//  # Field Masks and Oneof Fields
+	//
+	//  Field masks treat fields in oneofs just as regular fields. Consider the
+	//  following message:
+	//
+	//      message SampleMessage {
+	//        oneof test_oneof {
+	//          string name = 4;
+	//          SubMessage sub_message = 9;
+	//        }
+	//      }
+	//
+	//  The field mask can be:
+	//
+	//      mask {
+	//        paths: "name"
+	//      }
+	//
+	//  Or:
+	//
+	//      mask {
+	//        paths: "sub_message"
+	//      }
+	//
+	//  Note that oneof type names ("test_oneof" in this case) cannot be used in
+	//  paths.
+	//
+	//  ## Field Mask Verification
+	//
+	//  The implementation of any API method which has a FieldMask type field in the
+	//  request should verify the included field paths, and return an
+	//  `INVALID_ARGUMENT` error if any path is unmappable.
+	FieldMask *GoogleProtobufFieldMask `json:"fieldMask,omitempty"`
+
+	// ProjectName Project name
+	ProjectName string `json:"projectName"`
+
+	// ResourceId ID of the resource to be updated.
+	ResourceId string `json:"resourceId"`
+}
+
+// PatchHostRequest Request message for the PatchHost method.
+type PatchHostRequest struct {
+	// FieldMask `FieldMask` represents a set of symbolic field paths, for example:
+	//
+	//      paths: "f.a"
+	//      paths: "f.b.d"
+	//
+	//  Here `f` represents a field in some root message, `a` and `b`
+	//  fields in the message found in `f`, and `d` a field found in the
+	//  message in `f.b`.
+	//
+	//  Field masks are used to specify a subset of fields that should be
+	//  returned by a get operation or modified by an update operation.
+	//  Field masks also have a custom JSON encoding (see below).
+	//
+	//  # Field Masks in Projections
+	//
+	//  When used in the context of a projection, a response message or
+	//  sub-message is filtered by the API to only contain those fields as
+	//  specified in the mask. For example, if the mask in the previous
+	//  example is applied to a response message as follows:
+	//
+	//      f {
+	//        a : 22
+	//        b {
+	//          d : 1
+	//          x : 2
+	//        }
+	//        y : 13
+	//      }
+	//      z: 8
+	//
+	//  The result will not contain specific values for fields x,y and z
+	//  (their value will be set to the default, and omitted in proto text
+	//  output):
+	//
+	//
+	//      f {
+	//        a : 22
+	//        b {
+	//          d : 1
+	//        }
+	//      }
+	//
+	//  A repeated field is not allowed except at the last position of a
+	//  paths string.
+	//
+	//  If a FieldMask object is not present in a get operation, the
+	//  operation applies to all fields (as if a FieldMask of all fields
+	//  had been specified).
+	//
+	//  Note that a field mask does not necessarily apply to the
+	//  top-level response message. In case of a REST get operation, the
+	//  field mask applies directly to the response, but in case of a REST
+	//  list operation, the mask instead applies to each individual message
+	//  in the returned resource list. In case of a REST custom method,
+	//  other definitions may be used. Where the mask applies will be
+	//  clearly documented together with its declaration in the API.  In
+	//  any case, the effect on the returned resource/resources is required
+	//  behavior for APIs.
+	//
+	//  # Field Masks in Update Operations
+	//
+	//  A field mask in update operations specifies which fields of the
+	//  targeted resource are going to be updated. The API is required
+	//  to only change the values of the fields as specified in the mask
+	//  and leave the others untouched. If a resource is passed in to
+	//  describe the updated values, the API ignores the values of all
+	//  fields not covered by the mask.
+	//
+	//  If a repeated field is specified for an update operation, new values will
+	//  be appended to the existing repeated field in the target resource. Note that
+	//  a repeated field is only allowed in the last position of a `paths` string.
+	//
+	//  If a sub-message is specified in the last position of the field mask for an
+	//  update operation, then new value will be merged into the existing sub-message
+	//  in the target resource.
+	//
+	//  For example, given the target message:
+	//
+	//      f {
+	//        b {
+	//          d: 1
+	//          x: 2
+	//        }
+	//        c: [1]
+	//      }
+	//
+	//  And an update message:
+	//
+	//      f {
+	//        b {
+	//          d: 10
+	//        }
+	//        c: [2]
+	//      }
+	//
+	//  then if the field mask is:
+	//
+	//   paths: ["f.b", "f.c"]
+	//
+	//  then the result will be:
+	//
+	//      f {
+	//        b {
+	//          d: 10
+	//          x: 2
+	//        }
+	//        c: [1, 2]
+	//      }
+	//
+	//  An implementation may provide options to override this default behavior for
+	//  repeated and message fields.
+	//
+	//  In order to reset a field's value to the default, the field must
+	//  be in the mask and set to the default value in the provided resource.
+	//  Hence, in order to reset all fields of a resource, provide a default
+	//  instance of the resource and set all fields in the mask, or do
+	//  not provide a mask as described below.
+	//
+	//  If a field mask is not present on update, the operation applies to
+	//  all fields (as if a field mask of all fields has been specified).
+	//  Note that in the presence of schema evolution, this may mean that
+	//  fields the client does not know and has therefore not filled into
+	//  the request will be reset to their default. If this is unwanted
+	//  behavior, a specific service may require a client to always specify
+	//  a field mask, producing an error if not.
+	//
+	//  As with get operations, the location of the resource which
+	//  describes the updated values in the request message depends on the
+	//  operation kind. In any case, the effect of the field mask is
+	//  required to be honored by the API.
+	//
+	//  ## Considerations for HTTP REST
+	//
+	//  The HTTP kind of an update operation which uses a field mask must
+	//  be set to PATCH instead of PUT in order to satisfy HTTP semantics
+	//  (PUT must only be used for full updates).
+	//
+	//  # JSON Encoding of Field Masks
+	//
+	//  In JSON, a field mask is encoded as a single string where paths are
+	//  separated by a comma. Fields name in each path are converted
+	//  to/from lower-camel naming conventions.
+	//
+	//  As an example, consider the following message declarations:
+	//
+	//      message Profile {
+	//        User user = 1;
+	//        Photo photo = 2;
+	//      }
+	//      message User {
+	//        string display_name = 1;
+	//        string address = 2;
+	//      }
+	//
+	//  In proto a field mask for `Profile` may look as such:
+	//
+	//      mask {
+	//        paths: "user.display_name"
+	//        paths: "photo"
+	//      }
+	//
+	//  In JSON, the same mask is represented as below:
+	//
+	//      {
+	//        mask: "user.displayName,photo"
+	//      }
+	//
+	//  # Field Masks and Oneof Fields
+	//
+	//  Field masks treat fields in oneofs just as regular fields. Consider the
+	//  following message:
+	//
+	//      message SampleMessage {
+	//        oneof test_oneof {
+	//          string name = 4;
+	//          SubMessage sub_message = 9;
+	//        }
+	//      }
+	//
+	//  The field mask can be:
+	//
+	//      mask {
+	//        paths: "name"
+	//      }
+	//
+	//  Or:
+	//
+	//      mask {
+	//        paths: "sub_message"
+	//      }
+	//
+	//  Note that oneof type names ("test_oneof" in this case) cannot be used in
+	//  paths.
+	//
+	//  ## Field Mask Verification
+	//
+	//  The implementation of any API method which has a FieldMask type field in the
+	//  request should verify the included field paths, and return an
+	//  `INVALID_ARGUMENT` error if any path is unmappable.
+	FieldMask *GoogleProtobufFieldMask `json:"fieldMask,omitempty"`
+
+	// Host A Host resource.
+	Host HostResource `json:"host"`
+
+	// ProjectName The project name from the URL path.
+	ProjectName string `json:"projectName"`
+
+	// ResourceId ID of the resource to be updated.
+	ResourceId string `json:"resourceId"`
+}
+
+// PatchInstanceRequest Request message for the PatchInstance method.
+type PatchInstanceRequest struct {
+	// FieldMask `FieldMask` represents a set of symbolic field paths, for example:
+	//
+	//      paths: "f.a"
+	//      paths: "f.b.d"
+	//
+	//  Here `f` represents a field in some root message, `a` and `b`
+	//  fields in the message found in `f`, and `d` a field found in the
+	//  message in `f.b`.
+	//
+	//  Field masks are used to specify a subset of fields that should be
+	//  returned by a get operation or modified by an update operation.
+	//  Field masks also have a custom JSON encoding (see below).
+	//
+	//  # Field Masks in Projections
+	//
+	//  When used in the context of a projection, a response message or
+	//  sub-message is filtered by the API to only contain those fields as
+	//  specified in the mask. For example, if the mask in the previous
+	//  example is applied to a response message as follows:
+	//
+	//      f {
+	//        a : 22
+	//        b {
+	//          d : 1
+	//          x : 2
+	//        }
+	//        y : 13
+	//      }
+	//      z: 8
+	//
+	//  The result will not contain specific values for fields x,y and z
+	//  (their value will be set to the default, and omitted in proto text
+	//  output):
+	//
+	//
+	//      f {
+	//        a : 22
+	//        b {
+	//          d : 1
+	//        }
+	//      }
+	//
+	//  A repeated field is not allowed except at the last position of a
+	//  paths string.
+	//
+	//  If a FieldMask object is not present in a get operation, the
+	//  operation applies to all fields (as if a FieldMask of all fields
+	//  had been specified).
+	//
+	//  Note that a field mask does not necessarily apply to the
+	//  top-level response message. In case of a REST get operation, the
+	//  field mask applies directly to the response, but in case of a REST
+	//  list operation, the mask instead applies to each individual message
+	//  in the returned resource list. In case of a REST custom method,
+	//  other definitions may be used. Where the mask applies will be
+	//  clearly documented together with its declaration in the API.  In
+	//  any case, the effect on the returned resource/resources is required
+	//  behavior for APIs.
+	//
+	//  # Field Masks in Update Operations
+	//
+	//  A field mask in update operations specifies which fields of the
+	//  targeted resource are going to be updated. The API is required
+	//  to only change the values of the fields as specified in the mask
+	//  and leave the others untouched. If a resource is passed in to
+	//  describe the updated values, the API ignores the values of all
+	//  fields not covered by the mask.
+	//
+	//  If a repeated field is specified for an update operation, new values will
+	//  be appended to the existing repeated field in the target resource. Note that
+	//  a repeated field is only allowed in the last position of a `paths` string.
+	//
+	//  If a sub-message is specified in the last position of the field mask for an
+	//  update operation, then new value will be merged into the existing sub-message
+	//  in the target resource.
+	//
+	//  For example, given the target message:
+	//
+	//      f {
+	//        b {
+	//          d: 1
+	//          x: 2
+	//        }
+	//        c: [1]
+	//      }
+	//
+	//  And an update message:
+	//
+	//      f {
+	//        b {
+	//          d: 10
+	//        }
+	//        c: [2]
+	//      }
+	//
+	//  then if the field mask is:
+	//
+	//   paths: ["f.b", "f.c"]
+	//
+	//  then the result will be:
+	//
+	//      f {
+	//        b {
+	//          d: 10
+	//          x: 2
+	//        }
+	//        c: [1, 2]
+	//      }
+	//
+	//  An implementation may provide options to override this default behavior for
+	//  repeated and message fields.
+	//
+	//  In order to reset a field's value to the default, the field must
+	//  be in the mask and set to the default value in the provided resource.
+	//  Hence, in order to reset all fields of a resource, provide a default
+	//  instance of the resource and set all fields in the mask, or do
+	//  not provide a mask as described below.
+	//
+	//  If a field mask is not present on update, the operation applies to
+	//  all fields (as if a field mask of all fields has been specified).
+	//  Note that in the presence of schema evolution, this may mean that
+	//  fields the client does not know and has therefore not filled into
+	//  the request will be reset to their default. If this is unwanted
+	//  behavior, a specific service may require a client to always specify
+	//  a field mask, producing an error if not.
+	//
+	//  As with get operations, the location of the resource which
+	//  describes the updated values in the request message depends on the
+	//  operation kind. In any case, the effect of the field mask is
+	//  required to be honored by the API.
+	//
+	//  ## Considerations for HTTP REST
+	//
+	//  The HTTP kind of an update operation which uses a field mask must
+	//  be set to PATCH instead of PUT in order to satisfy HTTP semantics
+	//  (PUT must only be used for full updates).
+	//
+	//  # JSON Encoding of Field Masks
+	//
+	//  In JSON, a field mask is encoded as a single string where paths are
+	//  separated by a comma. Fields name in each path are converted
+	//  to/from lower-camel naming conventions.
+	//
+	//  As an example, consider the following message declarations:
+	//
+	//      message Profile {
+	//        User user = 1;
+	//        Photo photo = 2;
+	//      }
+	//      message User {
+	//        string display_name = 1;
+	//        string address = 2;
+	//      }
+	//
+	//  In proto a field mask for `Profile` may look as such:
+	//
+	//      mask {
+	//        paths: "user.display_name"
+	//        paths: "photo"
+	//      }
+	//
+	//  In JSON, the same mask is represented as below:
+	//
+	//      {
+	//        mask: "user.displayName,photo"
+	//      }
+	//
+	//  # Field Masks and Oneof Fields
+	//
+	//  Field masks treat fields in oneofs just as regular fields. Consider the
+	//  following message:
+	//
+	//      message SampleMessage {
+	//        oneof test_oneof {
+	//          string name = 4;
+	//          SubMessage sub_message = 9;
+	//        }
+	//      }
+	//
+	//  The field mask can be:
+	//
+	//      mask {
+	//        paths: "name"
+	//      }
+	//
+	//  Or:
+	//
+	//      mask {
+	//        paths: "sub_message"
+	//      }
+	//
+	//  Note that oneof type names ("test_oneof" in this case) cannot be used in
+	//  paths.
+	//
+	//  ## Field Mask Verification
+	//
+	//  The implementation of any API method which has a FieldMask type field in the
+	//  request should verify the included field paths, and return an
+	//  `INVALID_ARGUMENT` error if any path is unmappable.
+	FieldMask *GoogleProtobufFieldMask `json:"fieldMask,omitempty"`
+
+	// Instance InstanceResource describes an instantiated OS install, running on either a
+	//  host or hypervisor.
+	Instance InstanceResource `json:"instance"`
+
+	// ProjectName The project name from the URL path.
+	ProjectName string `json:"projectName"`
+
+	// ResourceId ID of the resource to be updated.
+	ResourceId string `json:"resourceId"`
+}
+
+// PatchLocalAccountRequest Request message for the PatchLocalAccount method.
+type PatchLocalAccountRequest struct {
+	// FieldMask `FieldMask` represents a set of symbolic field paths, for example:
+	//
+	//      paths: "f.a"
+	//      paths: "f.b.d"
+	//
+	//  Here `f` represents a field in some root message, `a` and `b`
+	//  fields in the message found in `f`, and `d` a field found in the
+	//  message in `f.b`.
+	//
+	//  Field masks are used to specify a subset of fields that should be
+	//  returned by a get operation or modified by an update operation.
+	//  Field masks also have a custom JSON encoding (see below).
+	//
+	//  # Field Masks in Projections
+	//
+	//  When used in the context of a projection, a response message or
+	//  sub-message is filtered by the API to only contain those fields as
+	//  specified in the mask. For example, if the mask in the previous
+	//  example is applied to a response message as follows:
+	//
+	//      f {
+	//        a : 22
+	//        b {
+	//          d : 1
+	//          x : 2
+	//        }
+	//        y : 13
+	//      }
+	//      z: 8
+	//
+	//  The result will not contain specific values for fields x,y and z
+	//  (their value will be set to the default, and omitted in proto text
+	//  output):
+	//
+	//
+	//      f {
+	//        a : 22
+	//        b {
+	//          d : 1
+	//        }
+	//      }
+	//
+	//  A repeated field is not allowed except at the last position of a
+	//  paths string.
+	//
+	//  If a FieldMask object is not present in a get operation, the
+	//  operation applies to all fields (as if a FieldMask of all fields
+	//  had been specified).
+	//
+	//  Note that a field mask does not necessarily apply to the
+	//  top-level response message. In case of a REST get operation, the
+	//  field mask applies directly to the response, but in case of a REST
+	//  list operation, the mask instead applies to each individual message
+	//  in the returned resource list. In case of a REST custom method,
+	//  other definitions may be used. Where the mask applies will be
+	//  clearly documented together with its declaration in the API.  In
+	//  any case, the effect on the returned resource/resources is required
+	//  behavior for APIs.
+	//
+	//  # Field Masks in Update Operations
+	//
+	//  A field mask in update operations specifies which fields of the
+	//  targeted resource are going to be updated. The API is required
+	//  to only change the values of the fields as specified in the mask
+	//  and leave the others untouched. If a resource is passed in to
+	//  describe the updated values, the API ignores the values of all
+	//  fields not covered by the mask.
+	//
+	//  If a repeated field is specified for an update operation, new values will
+	//  be appended to the existing repeated field in the target resource. Note that
+	//  a repeated field is only allowed in the last position of a `paths` string.
+	//
+	//  If a sub-message is specified in the last position of the field mask for an
+	//  update operation, then new value will be merged into the existing sub-message
+	//  in the target resource.
+	//
+	//  For example, given the target message:
+	//
+	//      f {
+	//        b {
+	//          d: 1
+	//          x: 2
+	//        }
+	//        c: [1]
+	//      }
+	//
+	//  And an update message:
+	//
+	//      f {
+	//        b {
+	//          d: 10
+	//        }
+	//        c: [2]
+	//      }
+	//
+	//  then if the field mask is:
+	//
+	//   paths: ["f.b", "f.c"]
+	//
+	//  then the result will be:
+	//
+	//      f {
+	//        b {
+	//          d: 10
+	//          x: 2
+	//        }
+	//        c: [1, 2]
+	//      }
+	//
+	//  An implementation may provide options to override this default behavior for
+	//  repeated and message fields.
+	//
+	//  In order to reset a field's value to the default, the field must
+	//  be in the mask and set to the default value in the provided resource.
+	//  Hence, in order to reset all fields of a resource, provide a default
+	//  instance of the resource and set all fields in the mask, or do
+	//  not provide a mask as described below.
+	//
+	//  If a field mask is not present on update, the operation applies to
+	//  all fields (as if a field mask of all fields has been specified).
+	//  Note that in the presence of schema evolution, this may mean that
+	//  fields the client does not know and has therefore not filled into
+	//  the request will be reset to their default. If this is unwanted
+	//  behavior, a specific service may require a client to always specify
+	//  a field mask, producing an error if not.
+	//
+	//  As with get operations, the location of the resource which
+	//  describes the updated values in the request message depends on the
+	//  operation kind. In any case, the effect of the field mask is
+	//  required to be honored by the API.
+	//
+	//  ## Considerations for HTTP REST
+	//
+	//  The HTTP kind of an update operation which uses a field mask must
+	//  be set to PATCH instead of PUT in order to satisfy HTTP semantics
+	//  (PUT must only be used for full updates).
+	//
+	//  # JSON Encoding of Field Masks
+	//
+	//  In JSON, a field mask is encoded as a single string where paths are
+	//  separated by a comma. Fields name in each path are converted
+	//  to/from lower-camel naming conventions.
+	//
+	//  As an example, consider the following message declarations:
+	//
+	//      message Profile {
+	//        User user = 1;
+	//        Photo photo = 2;
+	//      }
+	//      message User {
+	//        string display_name = 1;
+	//        string address = 2;
+	//      }
+	//
+	//  In proto a field mask for `Profile` may look as such:
+	//
+	//      mask {
+	//        paths: "user.display_name"
+	//        paths: "photo"
+	//      }
+	//
+	//  In JSON, the same mask is represented as below:
+	//
+	//      {
+	//        mask: "user.displayName,photo"
+	//      }
+	//
+	//  # Field Masks and Oneof Fields
+	//
+	//  Field masks treat fields in oneofs just as regular fields. Consider the
+	//  following message:
+	//
+	//      message SampleMessage {
+	//        oneof test_oneof {
+	//          string name = 4;
+	//          SubMessage sub_message = 9;
+	//        }
+	//      }
+	//
+	//  The field mask can be:
+	//
+	//      mask {
+	//        paths: "name"
+	//      }
+	//
+	//  Or:
+	//
+	//      mask {
+	//        paths: "sub_message"
+	//      }
+	//
+	//  Note that oneof type names ("test_oneof" in this case) cannot be used in
+	//  paths.
+	//
+	//  ## Field Mask Verification
+	//
+	//  The implementation of any API method which has a FieldMask type field in the
+	//  request should verify the included field paths, and return an
+	//  `INVALID_ARGUMENT` error if any path is unmappable.
+	FieldMask    *GoogleProtobufFieldMask `json:"fieldMask,omitempty"`
+	LocalAccount LocalAccountResource     `json:"localAccount"`
+
+	// ProjectName Project name
+	ProjectName string `json:"projectName"`
+
+	// ResourceId ID of the resource to be updated.
+	ResourceId string `json:"resourceId"`
+}
+
+// PatchNetworkSegmentRequest Request message for the PatchNetworkSegment method.
+type PatchNetworkSegmentRequest struct {
+	// FieldMask `FieldMask` represents a set of symbolic field paths, for example:
+	//
+	//      paths: "f.a"
+	//      paths: "f.b.d"
+	//
+	//  Here `f` represents a field in some root message, `a` and `b`
+	//  fields in the message found in `f`, and `d` a field found in the
+	//  message in `f.b`.
+	//
+	//  Field masks are used to specify a subset of fields that should be
+	//  returned by a get operation or modified by an update operation.
+	//  Field masks also have a custom JSON encoding (see below).
+	//
+	//  # Field Masks in Projections
+	//
+	//  When used in the context of a projection, a response message or
+	//  sub-message is filtered by the API to only contain those fields as
+	//  specified in the mask. For example, if the mask in the previous
+	//  example is applied to a response message as follows:
+	//
+	//      f {
+	//        a : 22
+	//        b {
+	//          d : 1
+	//          x : 2
+	//        }
+	//        y : 13
+	//      }
+	//      z: 8
+	//
+	//  The result will not contain specific values for fields x,y and z
+	//  (their value will be set to the default, and omitted in proto text
+	//  output):
+	//
+	//
+	//      f {
+	//        a : 22
+	//        b {
+	//          d : 1
+	//        }
+	//      }
+	//
+	//  A repeated field is not allowed except at the last position of a
+	//  paths string.
+	//
+	//  If a FieldMask object is not present in a get operation, the
+	//  operation applies to all fields (as if a FieldMask of all fields
+	//  had been specified).
+	//
+	//  Note that a field mask does not necessarily apply to the
+	//  top-level response message. In case of a REST get operation, the
+	//  field mask applies directly to the response, but in case of a REST
+	//  list operation, the mask instead applies to each individual message
+	//  in the returned resource list. In case of a REST custom method,
+	//  other definitions may be used. Where the mask applies will be
+	//  clearly documented together with its declaration in the API.  In
+	//  any case, the effect on the returned resource/resources is required
+	//  behavior for APIs.
+	//
+	//  # Field Masks in Update Operations
+	//
+	//  A field mask in update operations specifies which fields of the
+	//  targeted resource are going to be updated. The API is required
+	//  to only change the values of the fields as specified in the mask
+	//  and leave the others untouched. If a resource is passed in to
+	//  describe the updated values, the API ignores the values of all
+	//  fields not covered by the mask.
+	//
+	//  If a repeated field is specified for an update operation, new values will
+	//  be appended to the existing repeated field in the target resource. Note that
+	//  a repeated field is only allowed in the last position of a `paths` string.
+	//
+	//  If a sub-message is specified in the last position of the field mask for an
+	//  update operation, then new value will be merged into the existing sub-message
+	//  in the target resource.
+	//
+	//  For example, given the target message:
+	//
+	//      f {
+	//        b {
+	//          d: 1
+	//          x: 2
+	//        }
+	//        c: [1]
+	//      }
+	//
+	//  And an update message:
+	//
+	//      f {
+	//        b {
+	//          d: 10
+	//        }
+	//        c: [2]
+	//      }
+	//
+	//  then if the field mask is:
+	//
+	//   paths: ["f.b", "f.c"]
+	//
+	//  then the result will be:
+	//
+	//      f {
+	//        b {
+	//          d: 10
+	//          x: 2
+	//        }
+	//        c: [1, 2]
+	//      }
+	//
+	//  An implementation may provide options to override this default behavior for
+	//  repeated and message fields.
+	//
+	//  In order to reset a field's value to the default, the field must
+	//  be in the mask and set to the default value in the provided resource.
+	//  Hence, in order to reset all fields of a resource, provide a default
+	//  instance of the resource and set all fields in the mask, or do
+	//  not provide a mask as described below.
+	//
+	//  If a field mask is not present on update, the operation applies to
+	//  all fields (as if a field mask of all fields has been specified).
+	//  Note that in the presence of schema evolution, this may mean that
+	//  fields the client does not know and has therefore not filled into
+	//  the request will be reset to their default. If this is unwanted
+	//  behavior, a specific service may require a client to always specify
+	//  a field mask, producing an error if not.
+	//
+	//  As with get operations, the location of the resource which
+	//  describes the updated values in the request message depends on the
+	//  operation kind. In any case, the effect of the field mask is
+	//  required to be honored by the API.
+	//
+	//  ## Considerations for HTTP REST
+	//
+	//  The HTTP kind of an update operation which uses a field mask must
+	//  be set to PATCH instead of PUT in order to satisfy HTTP semantics
+	//  (PUT must only be used for full updates).
+	//
+	//  # JSON Encoding of Field Masks
+	//
+	//  In JSON, a field mask is encoded as a single string where paths are
+	//  separated by a comma. Fields name in each path are converted
+	//  to/from lower-camel naming conventions.
+	//
+	//  As an example, consider the following message declarations:
+	//
+	//      message Profile {
+	//        User user = 1;
+	//        Photo photo = 2;
+	//      }
+	//      message User {
+	//        string display_name = 1;
+	//        string address = 2;
+	//      }
+	//
+	//  In proto a field mask for `Profile` may look as such:
+	//
+	//      mask {
+	//        paths: "user.display_name"
+	//        paths: "photo"
+	//      }
+	//
+	//  In JSON, the same mask is represented as below:
+	//
+	//      {
+	//        mask: "user.displayName,photo"
+	//      }
+	//
+	//  # Field Masks and Oneof Fields
+	//
+	//  Field masks treat fields in oneofs just as regular fields. Consider the
+	//  following message:
+	//
+	//      message SampleMessage {
+	//        oneof test_oneof {
+	//          string name = 4;
+	//          SubMessage sub_message = 9;
+	//        }
+	//      }
+	//
+	//  The field mask can be:
+	//
+	//      mask {
+	//        paths: "name"
+	//      }
+	//
+	//  Or:
+	//
+	//      mask {
+	//        paths: "sub_message"
+	//      }
+	//
+	//  Note that oneof type names ("test_oneof" in this case) cannot be used in
+	//  paths.
+	//
+	//  ## Field Mask Verification
+	//
+	//  The implementation of any API method which has a FieldMask type field in the
+	//  request should verify the included field paths, and return an
+	//  `INVALID_ARGUMENT` error if any path is unmappable.
+	FieldMask *GoogleProtobufFieldMask `json:"fieldMask,omitempty"`
+
+	// NetworkSegment A network segment resource.
+	NetworkSegment NetworkSegmentResource `json:"networkSegment"`
+
+	// ProjectName Project name
+	ProjectName string `json:"projectName"`
+
+	// ResourceId ID of the resource to be updated.
+	ResourceId string `json:"resourceId"`
+}
+
+// PatchOSUpdatePolicyRequest Request message for the PatchOSUpdatePolicy method.
+type PatchOSUpdatePolicyRequest struct {
+	// FieldMask `FieldMask` represents a set of symbolic field paths, for example:
+	//
+	//      paths: "f.a"
+	//      paths: "f.b.d"
+	//
+	//  Here `f` represents a field in some root message, `a` and `b`
+	//  fields in the message found in `f`, and `d` a field found in the
+	//  message in `f.b`.
+	//
+	//  Field masks are used to specify a subset of fields that should be
+	//  returned by a get operation or modified by an update operation.
+	//  Field masks also have a custom JSON encoding (see below).
+	//
+	//  # Field Masks in Projections
+	//
+	//  When used in the context of a projection, a response message or
+	//  sub-message is filtered by the API to only contain those fields as
+	//  specified in the mask. For example, if the mask in the previous
+	//  example is applied to a response message as follows:
+	//
+	//      f {
+	//        a : 22
+	//        b {
+	//          d : 1
+	//          x : 2
+	//        }
+	//        y : 13
+	//      }
+	//      z: 8
+	//
+	//  The result will not contain specific values for fields x,y and z
+	//  (their value will be set to the default, and omitted in proto text
+	//  output):
+	//
+	//
+	//      f {
+	//        a : 22
+	//        b {
+	//          d : 1
+	//        }
+	//      }
+	//
+	//  A repeated field is not allowed except at the last position of a
+	//  paths string.
+	//
+	//  If a FieldMask object is not present in a get operation, the
+	//  operation applies to all fields (as if a FieldMask of all fields
+	//  had been specified).
+	//
+	//  Note that a field mask does not necessarily apply to the
+	//  top-level response message. In case of a REST get operation, the
+	//  field mask applies directly to the response, but in case of a REST
+	//  list operation, the mask instead applies to each individual message
+	//  in the returned resource list. In case of a REST custom method,
+	//  other definitions may be used. Where the mask applies will be
+	//  clearly documented together with its declaration in the API.  In
+	//  any case, the effect on the returned resource/resources is required
+	//  behavior for APIs.
+	//
+	//  # Field Masks in Update Operations
+	//
+	//  A field mask in update operations specifies which fields of the
+	//  targeted resource are going to be updated. The API is required
+	//  to only change the values of the fields as specified in the mask
+	//  and leave the others untouched. If a resource is passed in to
+	//  describe the updated values, the API ignores the values of all
+	//  fields not covered by the mask.
+	//
+	//  If a repeated field is specified for an update operation, new values will
+	//  be appended to the existing repeated field in the target resource. Note that
+	//  a repeated field is only allowed in the last position of a `paths` string.
+	//
+	//  If a sub-message is specified in the last position of the field mask for an
+	//  update operation, then new value will be merged into the existing sub-message
+	//  in the target resource.
+	//
+	//  For example, given the target message:
+	//
+	//      f {
+	//        b {
+	//          d: 1
+	//          x: 2
+	//        }
+	//        c: [1]
+	//      }
+	//
+	//  And an update message:
+	//
+	//      f {
+	//        b {
+	//          d: 10
+	//        }
+	//        c: [2]
+	//      }
+	//
+	//  then if the field mask is:
+	//
+	//   paths: ["f.b", "f.c"]
+	//
+	//  then the result will be:
+	//
+	//      f {
+	//        b {
+	//          d: 10
+	//          x: 2
+	//        }
+	//        c: [1, 2]
+	//      }
+	//
+	//  An implementation may provide options to override this default behavior for
+	//  repeated and message fields.
+	//
+	//  In order to reset a field's value to the default, the field must
+	//  be in the mask and set to the default value in the provided resource.
+	//  Hence, in order to reset all fields of a resource, provide a default
+	//  instance of the resource and set all fields in the mask, or do
+	//  not provide a mask as described below.
+	//
+	//  If a field mask is not present on update, the operation applies to
+	//  all fields (as if a field mask of all fields has been specified).
+	//  Note that in the presence of schema evolution, this may mean that
+	//  fields the client does not know and has therefore not filled into
+	//  the request will be reset to their default. If this is unwanted
+	//  behavior, a specific service may require a client to always specify
+	//  a field mask, producing an error if not.
+	//
+	//  As with get operations, the location of the resource which
+	//  describes the updated values in the request message depends on the
+	//  operation kind. In any case, the effect of the field mask is
+	//  required to be honored by the API.
+	//
+	//  ## Considerations for HTTP REST
+	//
+	//  The HTTP kind of an update operation which uses a field mask must
+	//  be set to PATCH instead of PUT in order to satisfy HTTP semantics
+	//  (PUT must only be used for full updates).
+	//
+	//  # JSON Encoding of Field Masks
+	//
+	//  In JSON, a field mask is encoded as a single string where paths are
+	//  separated by a comma. Fields name in each path are converted
+	//  to/from lower-camel naming conventions.
+	//
+	//  As an example, consider the following message declarations:
+	//
+	//      message Profile {
+	//        User user = 1;
+	//        Photo photo = 2;
+	//      }
+	//      message User {
+	//        string display_name = 1;
+	//        string address = 2;
+	//      }
+	//
+	//  In proto a field mask for `Profile` may look as such:
+	//
+	//      mask {
+	//        paths: "user.display_name"
+	//        paths: "photo"
+	//      }
+	//
+	//  In JSON, the same mask is represented as below:
+	//
+	//      {
+	//        mask: "user.displayName,photo"
+	//      }
+	//
+	//  # Field Masks and Oneof Fields
+	//
+	//  Field masks treat fields in oneofs just as regular fields. Consider the
+	//  following message:
+	//
+	//      message SampleMessage {
+	//        oneof test_oneof {
+	//          string name = 4;
+	//          SubMessage sub_message = 9;
+	//        }
+	//      }
+	//
+	//  The field mask can be:
+	//
+	//      mask {
+	//        paths: "name"
+	//      }
+	//
+	//  Or:
+	//
+	//      mask {
+	//        paths: "sub_message"
+	//      }
+	//
+	//  Note that oneof type names ("test_oneof" in this case) cannot be used in
+	//  paths.
+	//
+	//  ## Field Mask Verification
+	//
+	//  The implementation of any API method which has a FieldMask type field in the
+	//  request should verify the included field paths, and return an
+	//  `INVALID_ARGUMENT` error if any path is unmappable.
+	FieldMask      *GoogleProtobufFieldMask `json:"fieldMask,omitempty"`
+	OsUpdatePolicy OSUpdatePolicy           `json:"osUpdatePolicy"`
+
+	// ProjectName Project name
 	ProjectName string `json:"projectName"`
 
-	// ResourceId Host resource ID
-	// string.max_bytes = 13
-	ResourceId *string `json:"resourceId,omitempty"`
-}
-
-// OnboardHostResponse Response of a Host Register request.
-type OnboardHostResponse = map[string]interface{}
-
-// OperatingSystemResource An OS resource.
-type OperatingSystemResource struct {
-	// Architecture The OS resource's CPU architecture.
-	Architecture *string `json:"architecture,omitempty"`
-	Description  *string `json:"description,omitempty"`
-
-	// ExistingCves The CVEs that are currently present on the Operating System, encoded as a JSON list.
-	ExistingCves *string `json:"existingCves,omitempty"`
-
-	// ExistingCvesUrl (IMMUTABLE) URL of the file containing information about the existing CVEs on the Operating System.
-	ExistingCvesUrl *string `json:"existingCvesUrl,omitempty"`
-
-	// FixedCves The CVEs that have been fixed by this OS Resource version, encoded as a JSON list.
-	FixedCves *string `json:"fixedCves,omitempty"`
-
-	// FixedCvesUrl (IMMUTABLE) URL of the file containing information about the CVEs that have been fixed by this OS Resource version.
-	FixedCvesUrl *string `json:"fixedCvesUrl,omitempty"`
-
-	// ImageId A unique identifier of the OS image that can be retrieved from the running OS.
-	ImageId *string `json:"imageId,omitempty"`
-
-	// ImageUrl The URL repository of the OS image.
-	ImageUrl *string `json:"imageUrl,omitempty"`
-
-	// InstalledPackages List of installed packages, encoded as a JSON list.
-	InstalledPackages *string `json:"installedPackages,omitempty"`
-
-	// InstalledPackagesUrl (IMMUTABLE) The URL of the OS manifest which contains install packages details. This will be used to fill the installed_packages field
-	//  for the advance use case to allow manual creation of OSProfiles when supported from backend.
-	InstalledPackagesUrl *string `json:"installedPackagesUrl,omitempty"`
-
-	// Metadata Opaque JSON field storing metadata associated to this OS resource. Expected to be a JSON object with string keys and values, or an empty string.
-	Metadata *string `json:"metadata,omitempty"`
-
-	// Name The OS resource's name.
-	Name *string `json:"name,omitempty"`
-
-	// OsProvider OsProviderKind describes "owner" of the OS, that will drive OS provisioning.
-	OsProvider *OsProviderKind `json:"osProvider,omitempty"`
-
-	// OsResourceID Deprecated, The OS resource's unique identifier. Alias of resourceId.
-	OsResourceID *string `json:"osResourceID,omitempty"`
-
-	// OsType OsType describes type of operating system.
-	OsType *OsType `json:"osType,omitempty"`
-
-	// PlatformBundle Opaque JSON field storing references to custom installation script(s) that
-	//  supplements the base OS with additional OS-level dependencies/configurations.
-	//  If empty, the default OS installation will be used.
-	PlatformBundle *string `json:"platformBundle,omitempty"`
-
-	// ProfileName Name of an OS profile that the OS resource belongs to. Uniquely identifies a family of OS resources.
-	ProfileName *string `json:"profileName,omitempty"`
-
-	// ProfileVersion Version of OS profile that the OS resource belongs to.
-	ProfileVersion *string `json:"profileVersion,omitempty"`
-
-	// RepoUrl Deprecated. OS image URL. URL of the original installation source.
-	RepoUrl *string `json:"repoUrl,omitempty"`
-
-	// ResourceId Resource ID, generated by inventory on Create.
-	ResourceId *string `json:"resourceId,omitempty"`
-
-	// SecurityFeature SecurityFeature describes the security capabilities of a resource.
-	SecurityFeature *SecurityFeature `json:"securityFeature,omitempty"`
-
-	// Sha256 SHA256 checksum of the OS resource in hexadecimal representation.
-	Sha256     string      `json:"sha256"`
-	Timestamps *Timestamps `json:"timestamps,omitempty"`
-
-	// TlsCaCert user-provided, TLS CA Certificate
-	TlsCaCert *string `json:"tlsCaCert,omitempty"`
+	// ResourceId ID of the resource to be updated.
+	ResourceId string `json:"resourceId"`
 }
 
-// OsProviderKind OsProviderKind describes "owner" of the OS, that will drive OS provisioning.
-type OsProviderKind string
-
-// OsType OsType describes type of operating system.
-type OsType string
-
-// PatchHostRequest Request message for the PatchHost method.
-type PatchHostRequest struct {
+// PatchOperatingSystemRequest Request message for the PatchOperatingSystem method.
+type PatchOperatingSystemRequest struct {
 	// FieldMask `FieldMask` represents a set of symbolic field paths, for example:
 	//
 	//      paths: "f.a"
@@ -2726,18 +6596,18 @@ type PatchHostRequest struct {
 	//  `INVALID_ARGUMENT` error if any path is unmappable.
 	FieldMask *GoogleProtobufFieldMask `json:"fieldMask,omitempty"`
 
-	// Host A Host resource.
-	Host HostResource `json:"host"`
+	// Os An OS resource.
+	Os OperatingSystemResource `json:"os"`
 
-	// ProjectName The project name from the URL path.
+	// ProjectName Project name
 	ProjectName string `json:"projectName"`
 
 	// ResourceId ID of the resource to be updated.
 	ResourceId string `json:"resourceId"`
 }
 
-// PatchInstanceRequest Request message for the PatchInstance method.
-type PatchInstanceRequest struct {
+// PatchOuRequest Request message for the PatchOu method.
+type PatchOuRequest struct {
 	// FieldMask `FieldMask` represents a set of symbolic field paths, for example:
 	//
 	//      paths: "f.a"
@@ -2939,19 +6809,18 @@ type PatchInstanceRequest struct {
 	//  `INVALID_ARGUMENT` error if any path is unmappable.
 	FieldMask *GoogleProtobufFieldMask `json:"fieldMask,omitempty"`
 
-	// Instance InstanceResource describes an instantiated OS install, running on either a
-	//  host or hypervisor.
-	Instance InstanceResource `json:"instance"`
+	// Ou An organizational unit (OU) resource.
+	Ou OuResource `json:"ou"`
 
-	// ProjectName The project name from the URL path.
+	// ProjectName Project name
 	ProjectName string `json:"projectName"`
 
 	// ResourceId ID of the resource to be updated.
 	ResourceId string `json:"resourceId"`
 }
 
-// PatchOperatingSystemRequest Request message for the PatchOperatingSystem method.
-type PatchOperatingSystemRequest struct {
+// PatchProviderRequest Request message for the PatchProvider method.
+type PatchProviderRequest struct {
 	// FieldMask `FieldMask` represents a set of symbolic field paths, for example:
 	//
 	//      paths: "f.a"
@@ -3153,12 +7022,12 @@ type PatchOperatingSystemRequest struct {
 	//  `INVALID_ARGUMENT` error if any path is unmappable.
 	FieldMask *GoogleProtobufFieldMask `json:"fieldMask,omitempty"`
 
-	// Os An OS resource.
-	Os OperatingSystemResource `json:"os"`
-
 	// ProjectName Project name
 	ProjectName string `json:"projectName"`
 
+	// Provider A provider resource.
+	Provider ProviderResource `json:"provider"`
+
 	// ResourceId ID of the resource to be updated.
 	ResourceId string `json:"resourceId"`
 }
@@ -4716,31 +8585,113 @@ type RegionResource struct {
 	//  This field can not be used in filter.
 	ParentId *string `json:"parentId,omitempty"`
 
-	// ParentRegion A region resource.
-	ParentRegion *RegionResource `json:"parentRegion,omitempty"`
+	// ParentRegion A region resource.
+	ParentRegion *RegionResource `json:"parentRegion,omitempty"`
+
+	// RegionID Deprecated, The Region unique identifier. Alias of resourceId.
+	RegionID *string `json:"regionID,omitempty"`
+
+	// ResourceId resource ID, generated by the inventory on Create.
+	ResourceId *string     `json:"resourceId,omitempty"`
+	Timestamps *Timestamps `json:"timestamps,omitempty"`
+
+	// TotalSites The total number of sites in the region.
+	TotalSites *int32 `json:"totalSites,omitempty"`
+}
+
+// RegisterHostRequest Request to register a Host.
+type RegisterHostRequest struct {
+	// Host Message to register a Host.
+	Host HostRegister `json:"host"`
+
+	// ProjectName The project name from the URL path.
+	ProjectName string `json:"projectName"`
+
+	// ResourceId string.max_bytes = 13
+	ResourceId *string `json:"resourceId,omitempty"`
+}
+
+// RegisterHostsRequest Request to register multiple Hosts.
+type RegisterHostsRequest struct {
+	// Csv The hosts to register as a CSV document, mutually exclusive with hosts. The first line is a header naming the
+	//  columns, among: name, serial_number, uuid, site_id, metadata, auto_onboard, enable_vpro and user_lvm_size.
+	//  Metadata are given as semicolon-separated key=value pairs, flags as true or false.
+	Csv *string `json:"csv,omitempty"`
+
+	// DryRun Validate the hosts without registering them.
+	DryRun *bool `json:"dryRun,omitempty"`
+
+	// Hosts The hosts to register. Mutually exclusive with csv.
+	Hosts *[]HostRegisterEntry `json:"hosts,omitempty"`
+
+	// ProjectName The project name from the URL path.
+	ProjectName string `json:"projectName"`
+}
+
+// RegisterHostsResponse Response message for RegisterHosts.
+type RegisterHostsResponse struct {
+	// Failed Number of hosts that failed validation or registration.
+	Failed *int `json:"failed,omitempty"`
+
+	// Results Result of each host, in the order of the request.
+	Results *[]RegisterHostsResponseResult `json:"results,omitempty"`
+
+	// Succeeded Number of hosts registered, or that passed validation in dry-run mode.
+	Succeeded *int `json:"succeeded,omitempty"`
+}
+
+// RegisterHostsResponseResult Result of the registration of a single host.
+type RegisterHostsResponseResult struct {
+	// Error Reason of the failure, unset on success.
+	Error *string `json:"error,omitempty"`
+
+	// Index Position of the host in the request, starting from 0. For CSV documents, the header line is not counted.
+	Index *int `json:"index,omitempty"`
+
+	// ResourceId Resource ID of the registered host, unset on failure and in dry-run mode.
+	ResourceId *string `json:"resourceId,omitempty"`
+}
+
+// RemoteAccessResource A time-bounded remote access (reverse SSH) session to an Instance.
+type RemoteAccessResource struct {
+	// ConfigurationStatus textual message that describes the configuration status of the session. Set by RMs only.
+	ConfigurationStatus *string `json:"configurationStatus,omitempty"`
+
+	// ConfigurationStatusIndicator The status indicator.
+	ConfigurationStatusIndicator *StatusIndication `json:"configurationStatusIndicator,omitempty"`
+
+	// ConfigurationStatusTimestamp UTC timestamp when configuration_status was last changed. Set by RMs only.
+	ConfigurationStatusTimestamp *int `json:"configurationStatusTimestamp,omitempty"`
+
+	// CurrentState The state of a remote access session.
+	CurrentState *RemoteAccessState `json:"currentState,omitempty"`
+
+	// DesiredState The state of a remote access session.
+	DesiredState *RemoteAccessState `json:"desiredState,omitempty"`
+
+	// DurationSeconds Duration of the session in seconds, between 15 minutes and 24 hours.
+	//  Used to compute the expiration_timestamp when the session is created.
+	DurationSeconds *int `json:"durationSeconds,omitempty"`
+
+	// ExpirationTimestamp UTC timestamp (seconds) after which the session is revoked.
+	ExpirationTimestamp *int `json:"expirationTimestamp,omitempty"`
+
+	// InstanceId The Instance to be accessed remotely.
+	InstanceId string `json:"instanceId"`
 
-	// RegionID Deprecated, The Region unique identifier. Alias of resourceId.
-	RegionID *string `json:"regionID,omitempty"`
+	// LocalPort Port terminating the reverse SSH tunnel on the orchestrator side.
+	LocalPort *int `json:"localPort,omitempty"`
 
-	// ResourceId resource ID, generated by the inventory on Create.
+	// ResourceId resource identifier
 	ResourceId *string     `json:"resourceId,omitempty"`
 	Timestamps *Timestamps `json:"timestamps,omitempty"`
 
-	// TotalSites The total number of sites in the region.
-	TotalSites *int32 `json:"totalSites,omitempty"`
+	// User Name of the remote user configured on the SSH server of the Instance.
+	User *string `json:"user,omitempty"`
 }
 
-// RegisterHostRequest Request to register a Host.
-type RegisterHostRequest struct {
-	// Host Message to register a Host.
-	Host HostRegister `json:"host"`
-
-	// ProjectName The project name from the URL path.
-	ProjectName string `json:"projectName"`
-
-	// ResourceId string.max_bytes = 13
-	ResourceId *string `json:"resourceId,omitempty"`
-}
+// RemoteAccessState The state of a remote access session.
+type RemoteAccessState string
 
 // RepeatedScheduleResource A repeated-schedule resource.
 type RepeatedScheduleResource struct {
@@ -4800,6 +8751,40 @@ type RepeatedScheduleResource struct {
 	Timestamps   *Timestamps `json:"timestamps,omitempty"`
 }
 
+// ResourceView The fields returned by Get and List methods supporting views.
+//
+//	See https://google.aip.dev/157 for details.
+type ResourceView string
+
+// RoleBindingResource Binds a principal to a role on a region, a site or an OU, with everything below it.
+//
+//	Principals holding the scoped role of the project (`<project>_im-scoped`) only have access to the locations of
+//	their bindings and to the hosts and instances located there; they can read the resources that are not located,
+//	such as OSes, but not modify them.
+type RoleBindingResource struct {
+	// OuId (OPTIONAL) The OU the role is granted on. Exactly one of region_id, site_id and ou_id must be set.
+	OuId *string `json:"ouId,omitempty"`
+
+	// RegionId (OPTIONAL) The region the role is granted on. Exactly one of region_id, site_id and ou_id must be set.
+	RegionId *string `json:"regionId,omitempty"`
+
+	// ResourceId resource identifier
+	ResourceId *string `json:"resourceId,omitempty"`
+
+	// Role The role granted by a role binding within its scope.
+	Role RoleBindingRole `json:"role"`
+
+	// SiteId (OPTIONAL) The site the role is granted on. Exactly one of region_id, site_id and ou_id must be set.
+	SiteId *string `json:"siteId,omitempty"`
+
+	// Subject The principal the role is granted to, matched against the subject (`sub`) claim of its JWT.
+	Subject    string      `json:"subject"`
+	Timestamps *Timestamps `json:"timestamps,omitempty"`
+}
+
+// RoleBindingRole The role granted by a role binding within its scope.
+type RoleBindingRole string
+
 // ScheduleStatus The representation of a schedule's status.
 type ScheduleStatus string
 
@@ -4872,6 +8857,13 @@ type SiteResource struct {
 	// Name The site's human-readable name.
 	Name *string `json:"name,omitempty"`
 
+	// Ou An organizational unit (OU) resource.
+	Ou *OuResource `json:"ou,omitempty"`
+
+	// OuId The OU's unique identifier
+	//  that the site is associated to. This field cannot be used in filter.
+	OuId *string `json:"ouId,omitempty"`
+
 	// Provider A provider resource.
 	Provider *ProviderResource `json:"provider,omitempty"`
 
@@ -5204,6 +9196,17 @@ type Timestamps struct {
 	UpdatedAt *GoogleProtobufTimestamp `json:"updatedAt,omitempty"`
 }
 
+// UpdateCustomConfigRequest Request message for the UpdateCustomConfig method.
+type UpdateCustomConfigRequest struct {
+	CustomConfig CustomConfigResource `json:"customConfig"`
+
+	// ProjectName Project name
+	ProjectName string `json:"projectName"`
+
+	// ResourceId Name of the custom configuration to be updated.
+	ResourceId string `json:"resourceId"`
+}
+
 // UpdateHostRequest Request message for the UpdateHost method.
 type UpdateHostRequest struct {
 	// Host A Host resource.
@@ -5229,6 +9232,40 @@ type UpdateInstanceRequest struct {
 	ResourceId string `json:"resourceId"`
 }
 
+// UpdateLocalAccountRequest Request message for the UpdateLocalAccount method.
+type UpdateLocalAccountRequest struct {
+	LocalAccount LocalAccountResource `json:"localAccount"`
+
+	// ProjectName Project name
+	ProjectName string `json:"projectName"`
+
+	// ResourceId Name of the local account to be updated.
+	ResourceId string `json:"resourceId"`
+}
+
+// UpdateNetworkSegmentRequest Request message for the UpdateNetworkSegment method.
+type UpdateNetworkSegmentRequest struct {
+	// NetworkSegment A network segment resource.
+	NetworkSegment NetworkSegmentResource `json:"networkSegment"`
+
+	// ProjectName Project name
+	ProjectName string `json:"projectName"`
+
+	// ResourceId Name of the network segment to be updated.
+	ResourceId string `json:"resourceId"`
+}
+
+// UpdateOSUpdatePolicyRequest Request message for the UpdateOSUpdatePolicy method.
+type UpdateOSUpdatePolicyRequest struct {
+	OsUpdatePolicy OSUpdatePolicy `json:"osUpdatePolicy"`
+
+	// ProjectName Project name
+	ProjectName string `json:"projectName"`
+
+	// ResourceId Name of the OS Update Policy to be updated.
+	ResourceId string `json:"resourceId"`
+}
+
 // UpdateOperatingSystemRequest Request message for the UpdateOperatingSystem method.
 type UpdateOperatingSystemRequest struct {
 	// Os An OS resource.
@@ -5241,9 +9278,33 @@ type UpdateOperatingSystemRequest struct {
 	ResourceId string `json:"resourceId"`
 }
 
+// UpdateOuRequest Request message for the UpdateOu method.
+type UpdateOuRequest struct {
+	// Ou An organizational unit (OU) resource.
+	Ou OuResource `json:"ou"`
+
+	// ProjectName Project name
+	ProjectName string `json:"projectName"`
+
+	// ResourceId Name of the OU to be updated.
+	ResourceId string `json:"resourceId"`
+}
+
 // UpdatePolicy States of the host.
 type UpdatePolicy string
 
+// UpdateProviderRequest Request message for the UpdateProvider method.
+type UpdateProviderRequest struct {
+	// ProjectName Project name
+	ProjectName string `json:"projectName"`
+
+	// Provider A provider resource.
+	Provider ProviderResource `json:"provider"`
+
+	// ResourceId Name of the provider to be updated.
+	ResourceId string `json:"resourceId"`
+}
+
 // UpdateRegionRequest Request message for the UpdateRegion method.
 type UpdateRegionRequest struct {
 	// ProjectName Project name
@@ -5268,69 +9329,254 @@ type UpdateRepeatedScheduleRequest struct {
 	ResourceId string `json:"resourceId"`
 }
 
-// UpdateSingleScheduleRequest Request message for the UpdateSingleSchedule method.
-type UpdateSingleScheduleRequest struct {
-	// ProjectName Project name
-	ProjectName string `json:"projectName"`
+// UpdateRoleBindingRequest Request message for the UpdateRoleBinding method.
+type UpdateRoleBindingRequest struct {
+	// ProjectName Project name
+	ProjectName string `json:"projectName"`
+
+	// ResourceId Name of the role binding to be updated.
+	ResourceId string `json:"resourceId"`
+
+	// RoleBinding Binds a principal to a role on a region, a site or an OU, with everything below it.
+	//  Principals holding the scoped role of the project (`<project>_im-scoped`) only have access to the locations of
+	//  their bindings and to the hosts and instances located there; they can read the resources that are not located,
+	//  such as OSes, but not modify them.
+	RoleBinding RoleBindingResource `json:"roleBinding"`
+}
+
+// UpdateSingleScheduleRequest Request message for the UpdateSingleSchedule method.
+type UpdateSingleScheduleRequest struct {
+	// ProjectName Project name
+	ProjectName string `json:"projectName"`
+
+	// ResourceId Name of the single_schedule single_schedule to be updated.
+	ResourceId string `json:"resourceId"`
+
+	// SingleSchedule A single schedule resource.
+	SingleSchedule SingleScheduleResource `json:"singleSchedule"`
+}
+
+// UpdateSiteRequest Request message for the UpdateSite method.
+type UpdateSiteRequest struct {
+	// ProjectName Project name
+	ProjectName string `json:"projectName"`
+
+	// RegionId (OPTIONAL) Optional region ID for hierarchical path support
+	RegionId *string `json:"regionId,omitempty"`
+
+	// ResourceId Name of the site site to be updated.
+	ResourceId string `json:"resourceId"`
+
+	// Site A site resource.
+	Site SiteResource `json:"site"`
+}
+
+// UpdateTelemetryLogsProfileRequest Request message for the UpdateTelemetryLogsProfile method.
+type UpdateTelemetryLogsProfileRequest struct {
+	// ProjectName Project name
+	ProjectName string `json:"projectName"`
+
+	// ResourceId Name of the telemetry_logs_profile telemetry_logs_profile to be updated.
+	ResourceId string `json:"resourceId"`
+
+	// TelemetryLogsProfile A telemetry log profile for a hierarchy object.
+	TelemetryLogsProfile TelemetryLogsProfileResource `json:"telemetryLogsProfile"`
+}
+
+// UpdateTelemetryMetricsProfileRequest Request message for the UpdateTelemetryMetricsProfile method.
+type UpdateTelemetryMetricsProfileRequest struct {
+	// ProjectName Project name
+	ProjectName string `json:"projectName"`
+
+	// ResourceId Name of the telemetry_metrics_profile telemetry_metrics_profile to be updated.
+	ResourceId string `json:"resourceId"`
+
+	// TelemetryMetricsProfile A telemetry metric profile for a hierarchy object.
+	TelemetryMetricsProfile TelemetryMetricsProfileResource `json:"telemetryMetricsProfile"`
+}
+
+// UpdateWorkloadRequest Request message for the UpdateWorkload method.
+type UpdateWorkloadRequest struct {
+	// ProjectName Project name
+	ProjectName string `json:"projectName"`
+
+	// ResourceId Name of the workload workload to be updated.
+	ResourceId string `json:"resourceId"`
+
+	// Workload A generic way to group compute resources to obtain a workload.
+	Workload WorkloadResource `json:"workload"`
+}
+
+// WatchResourceKind The kinds of resources that can be watched.
+type WatchResourceKind string
+
+// WatchResourcesRequest Request message for the WatchResources method.
+type WatchResourcesRequest struct {
+	// Filter (OPTIONAL) Optional filter to receive only the changes of the resources of interest, it requires
+	//  exactly one kind to be watched. Deletions are always received.
+	//  See https://google.aip.dev/160 for details.
+	Filter *string `json:"filter,omitempty"`
+
+	// HeartbeatSeconds (OPTIONAL) Seconds between heartbeats sent when no change happens. Default of 30.
+	HeartbeatSeconds *int `json:"heartbeatSeconds,omitempty"`
+
+	// Kinds (OPTIONAL) Kinds of the resources to watch, all the kinds are watched if empty.
+	Kinds *[]WatchResourceKind `json:"kinds,omitempty"`
+
+	// ProjectName The project name from the URL path.
+	ProjectName string `json:"projectName"`
+}
+
+// WatchResourcesResponse Response message for the WatchResources method, each message is either a change or a heartbeat.
+type WatchResourcesResponse struct {
+	union json.RawMessage
+}
+
+// WatchResourcesResponse0 defines model for .
+type WatchResourcesResponse0 struct {
+	// Event A change of a resource.
+	Event WatchResourcesResponseEvent `json:"event"`
+}
+
+// WatchResourcesResponse1 defines model for .
+type WatchResourcesResponse1 struct {
+	// Heartbeat A Timestamp represents a point in time independent of any time zone or local
+	//  calendar, encoded as a count of seconds and fractions of seconds at
+	//  nanosecond resolution. The count is relative to an epoch at UTC midnight on
+	//  January 1, 1970, in the proleptic Gregorian calendar which extends the
+	//  Gregorian calendar backwards to year one.
+	//
+	//  All minutes are 60 seconds long. Leap seconds are "smeared" so that no leap
+	//  second table is needed for interpretation, using a [24-hour linear
+	//  smear](https://developers.google.com/time/smear).
+	//
+	//  The range is from 0001-01-01T00:00:00Z to 9999-12-31T23:59:59.999999999Z. By
+	//  restricting to that range, we ensure that we can convert to and from [RFC
+	//  3339](https://www.ietf.org/rfc/rfc3339.txt) date strings.
+	//
+	//  # Examples
+	//
+	//  Example 1: Compute Timestamp from POSIX `time()`.
+	//
+	//      Timestamp timestamp;
+	//      timestamp.set_seconds(time(NULL));
+	//      timestamp.set_nanos(0);
+	//
+	//  Example 2: Compute Timestamp from POSIX `gettimeofday()`.
+	//
+	//      struct timeval tv;
+	//      gettimeofday(&tv, NULL);
+	//
+	//      Timestamp timestamp;
+	//      timestamp.set_seconds(tv.tv_sec);
+	//      timestamp.set_nanos(tv.tv_usec * 1000);
+	//
+	//  Example 3: Compute Timestamp from Win32 `GetSystemTimeAsFileTime()`.
+	//
+	//      FILETIME ft;
+	//      GetSystemTimeAsFileTime(&ft);
+	//      UINT64 ticks = (((UINT64)ft.dwHighDateTime) << 32) | ft.dwLowDateTime;
+	//
+	//      // A Windows tick is 100 nanoseconds. Windows epoch 1601-01-01T00:00:00Z
+	//      // is 11644473600 seconds before Unix epoch 1970-01-01T00:00:00Z.
+	//      Timestamp timestamp;
+	//      timestamp.set_seconds((INT64) ((ticks / 10000000) - 11644473600LL));
+	//      timestamp.set_nanos((INT32) ((ticks % 10000000) * 100));
+	//
+	//  Example 4: Compute Timestamp from Java `System.currentTimeMillis()`.
+	//
+	//      long millis = System.currentTimeMillis();
+	//
+	//      Timestamp timestamp = Timestamp.newBuilder().setSeconds(millis / 1000)
+	//          .setNanos((int) ((millis % 1000) * 1000000)).build();
+	//
+	//  Example 5: Compute Timestamp from Java `Instant.now()`.
+	//
+	//      Instant now = Instant.now();
+	//
+	//      Timestamp timestamp =
+	//          Timestamp.newBuilder().setSeconds(now.getEpochSecond())
+	//              .setNanos(now.getNano()).build();
+	//
+	//  Example 6: Compute Timestamp from current time in Python.
+	//
+	//      timestamp = Timestamp()
+	//      timestamp.GetCurrentTime()
+	//
+	//  # JSON Mapping
+	//
+	//  In JSON format, the Timestamp type is encoded as a string in the
+	//  [RFC 3339](https://www.ietf.org/rfc/rfc3339.txt) format. That is, the
+	//  format is "{year}-{month}-{day}T{hour}:{min}:{sec}[.{frac_sec}]Z"
+	//  where {year} is always expressed using four digits while {month}, {day},
+	//  {hour}, {min}, and {sec} are zero-padded to two digits each. The fractional
+	//  seconds, which can go up to 9 digits (i.e. up to 1 nanosecond resolution),
+	//  are optional. The "Z" suffix indicates the timezone ("UTC"); the timezone
+	//  is required. A proto3 JSON serializer should always use UTC (as indicated by
+	//  "Z") when printing the Timestamp type and a proto3 JSON parser should be
+	//  able to accept both UTC and other timezones (as indicated by an offset).
+	//
+	//  For example, "2017-01-15T01:30:15.01Z" encodes 15.01 seconds past
+	//  01:30 UTC on January 15, 2017.
+	//
+	//  In JavaScript, one can convert a Date object to this format using the
+	//  standard
+	//  [toISOString()](https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/Date/toISOString)
+	//  method. In Python, a standard `datetime.datetime` object can be converted
+	//  to this format using
+	//  [`strftime`](https://docs.python.org/2/library/time.html#time.strftime) with
+	//  the time format spec '%Y-%m-%dT%H:%M:%S.%fZ'. Likewise, in Java, one can use
+	//  the Joda Time's [`ISODateTimeFormat.dateTime()`](
+	//  http://joda-time.sourceforge.net/apidocs/org/joda/time/format/ISODateTimeFormat.html#dateTime()
+	//  ) to obtain a formatter capable of generating timestamps in this format.
+	Heartbeat GoogleProtobufTimestamp `json:"heartbeat"`
+}
+
+// WatchResourcesResponseEvent defines model for WatchResourcesResponse.Event.
+type WatchResourcesResponseEvent struct {
+	Kind *WatchResourcesResponseEventKind `json:"kind,omitempty"`
 
-	// ResourceId Name of the single_schedule single_schedule to be updated.
-	ResourceId string `json:"resourceId"`
+	// ResourceId The ID of the changed resource.
+	ResourceId *string `json:"resourceId,omitempty"`
 
-	// SingleSchedule A single schedule resource.
-	SingleSchedule SingleScheduleResource `json:"singleSchedule"`
+	// ResourceKind The kinds of resources that can be watched.
+	ResourceKind *WatchResourceKind `json:"resourceKind,omitempty"`
+	union        json.RawMessage
 }
 
-// UpdateSiteRequest Request message for the UpdateSite method.
-type UpdateSiteRequest struct {
-	// ProjectName Project name
-	ProjectName string `json:"projectName"`
-
-	// RegionId (OPTIONAL) Optional region ID for hierarchical path support
-	RegionId *string `json:"regionId,omitempty"`
-
-	// ResourceId Name of the site site to be updated.
-	ResourceId string `json:"resourceId"`
-
-	// Site A site resource.
-	Site SiteResource `json:"site"`
+// WatchResourcesResponseEvent0 defines model for .
+type WatchResourcesResponseEvent0 struct {
+	// Host A Host resource.
+	Host HostResource `json:"host"`
 }
 
-// UpdateTelemetryLogsProfileRequest Request message for the UpdateTelemetryLogsProfile method.
-type UpdateTelemetryLogsProfileRequest struct {
-	// ProjectName Project name
-	ProjectName string `json:"projectName"`
-
-	// ResourceId Name of the telemetry_logs_profile telemetry_logs_profile to be updated.
-	ResourceId string `json:"resourceId"`
-
-	// TelemetryLogsProfile A telemetry log profile for a hierarchy object.
-	TelemetryLogsProfile TelemetryLogsProfileResource `json:"telemetryLogsProfile"`
+// WatchResourcesResponseEvent1 defines model for .
+type WatchResourcesResponseEvent1 struct {
+	// Instance InstanceResource describes an instantiated OS install, running on either a
+	//  host or hypervisor.
+	Instance InstanceResource `json:"instance"`
 }
 
-// UpdateTelemetryMetricsProfileRequest Request message for the UpdateTelemetryMetricsProfile method.
-type UpdateTelemetryMetricsProfileRequest struct {
-	// ProjectName Project name
-	ProjectName string `json:"projectName"`
-
-	// ResourceId Name of the telemetry_metrics_profile telemetry_metrics_profile to be updated.
-	ResourceId string `json:"resourceId"`
-
-	// TelemetryMetricsProfile A telemetry metric profile for a hierarchy object.
-	TelemetryMetricsProfile TelemetryMetricsProfileResource `json:"telemetryMetricsProfile"`
+// WatchResourcesResponseEvent2 defines model for .
+type WatchResourcesResponseEvent2 struct {
+	OsUpdateRun OSUpdateRun `json:"osUpdateRun"`
 }
 
-// UpdateWorkloadRequest Request message for the UpdateWorkload method.
-type UpdateWorkloadRequest struct {
-	// ProjectName Project name
-	ProjectName string `json:"projectName"`
-
-	// ResourceId Name of the workload workload to be updated.
-	ResourceId string `json:"resourceId"`
+// WatchResourcesResponseEvent3 defines model for .
+type WatchResourcesResponseEvent3 struct {
+	// RepeatedSchedule A repeated-schedule resource.
+	RepeatedSchedule RepeatedScheduleResource `json:"repeatedSchedule"`
+}
 
-	// Workload A generic way to group compute resources to obtain a workload.
-	Workload WorkloadResource `json:"workload"`
+// WatchResourcesResponseEvent4 defines model for .
+type WatchResourcesResponseEvent4 struct {
+	// SingleSchedule A single schedule resource.
+	SingleSchedule SingleScheduleResource `json:"singleSchedule"`
 }
 
+// WatchResourcesResponseEventKind defines model for WatchResourcesResponse.EventKind.
+type WatchResourcesResponseEventKind string
+
 // WorkloadKind Represents the type of workload.
 type WorkloadKind string
 
@@ -5688,6 +9934,31 @@ type GoogleProtobufFieldMask = string
 //	) to obtain a formatter capable of generating timestamps in this format.
 type GoogleProtobufTimestamp = time.Time
 
+// APITokenServiceListAPITokens2Params defines parameters for APITokenServiceListAPITokens2.
+type APITokenServiceListAPITokens2Params struct {
+	// PageSize Defines the amount of items to be contained in a single page.
+	//  Default of 20.
+	PageSize *int `form:"pageSize,omitempty" json:"pageSize,omitempty"`
+
+	// Offset Index of the first item to return. This allows skipping items.
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// ProjectName Project name
+	ProjectName string `form:"projectName" json:"projectName"`
+}
+
+// APITokenServiceCreateAPIToken2Params defines parameters for APITokenServiceCreateAPIToken2.
+type APITokenServiceCreateAPIToken2Params struct {
+	// ProjectName Project name
+	ProjectName string `form:"projectName" json:"projectName"`
+}
+
+// APITokenServiceDeleteAPIToken2Params defines parameters for APITokenServiceDeleteAPIToken2.
+type APITokenServiceDeleteAPIToken2Params struct {
+	// ProjectName Project name
+	ProjectName string `form:"projectName" json:"projectName"`
+}
+
 // CustomConfigServiceListCustomConfigs2Params defines parameters for CustomConfigServiceListCustomConfigs2.
 type CustomConfigServiceListCustomConfigs2Params struct {
 	// OrderBy Optional comma separated list of fields to specify a sorting order.
@@ -5727,6 +9998,21 @@ type CustomConfigServiceGetCustomConfig2Params struct {
 	ProjectName string `form:"projectName" json:"projectName"`
 }
 
+// CustomConfigServicePatchCustomConfig2Params defines parameters for CustomConfigServicePatchCustomConfig2.
+type CustomConfigServicePatchCustomConfig2Params struct {
+	// FieldMask Field mask to be applied on the patch of custom configuration.
+	FieldMask *string `form:"fieldMask,omitempty" json:"fieldMask,omitempty"`
+
+	// ProjectName Project name
+	ProjectName string `form:"projectName" json:"projectName"`
+}
+
+// CustomConfigServiceUpdateCustomConfig2Params defines parameters for CustomConfigServiceUpdateCustomConfig2.
+type CustomConfigServiceUpdateCustomConfig2Params struct {
+	// ProjectName Project name
+	ProjectName string `form:"projectName" json:"projectName"`
+}
+
 // HostServiceListHosts2Params defines parameters for HostServiceListHosts2.
 type HostServiceListHosts2Params struct {
 	// OrderBy Optional comma separated list of fields to specify a sorting order.
@@ -5746,6 +10032,14 @@ type HostServiceListHosts2Params struct {
 
 	// ProjectName The project name from the URL path.
 	ProjectName string `form:"projectName" json:"projectName"`
+
+	// View Optional view of the returned resources, all the fields are returned by default.
+	View *ResourceView `form:"view,omitempty" json:"view,omitempty"`
+
+	// Fields Optional top-level fields of the returned resources, the resource ID is always returned.
+	//  Mutually exclusive with a view other than RESOURCE_VIEW_UNSPECIFIED.
+	//  See https://google.aip.dev/157 for details.
+	Fields *string `form:"fields,omitempty" json:"fields,omitempty"`
 }
 
 // HostServiceCreateHost2Params defines parameters for HostServiceCreateHost2.
@@ -5772,6 +10066,14 @@ type HostServiceDeleteHost2Params struct {
 type HostServiceGetHost2Params struct {
 	// ProjectName The project name from the URL path.
 	ProjectName string `form:"projectName" json:"projectName"`
+
+	// View Optional view of the returned resources, all the fields are returned by default.
+	View *ResourceView `form:"view,omitempty" json:"view,omitempty"`
+
+	// Fields Optional top-level fields of the returned resources, the resource ID is always returned.
+	//  Mutually exclusive with a view other than RESOURCE_VIEW_UNSPECIFIED.
+	//  See https://google.aip.dev/157 for details.
+	Fields *string `form:"fields,omitempty" json:"fields,omitempty"`
 }
 
 // HostServicePatchHost2Params defines parameters for HostServicePatchHost2.
@@ -5839,6 +10141,14 @@ type InstanceServiceListInstances2Params struct {
 
 	// ProjectName The project name from the URL path.
 	ProjectName string `form:"projectName" json:"projectName"`
+
+	// View Optional view of the returned resources, all the fields are returned by default.
+	View *ResourceView `form:"view,omitempty" json:"view,omitempty"`
+
+	// Fields Optional top-level fields of the returned resources, the resource ID is always returned.
+	//  Mutually exclusive with a view other than RESOURCE_VIEW_UNSPECIFIED.
+	//  See https://google.aip.dev/157 for details.
+	Fields *string `form:"fields,omitempty" json:"fields,omitempty"`
 }
 
 // InstanceServiceCreateInstance2Params defines parameters for InstanceServiceCreateInstance2.
@@ -5857,6 +10167,14 @@ type InstanceServiceDeleteInstance2Params struct {
 type InstanceServiceGetInstance2Params struct {
 	// ProjectName The project name from the URL path.
 	ProjectName string `form:"projectName" json:"projectName"`
+
+	// View Optional view of the returned resources, all the fields are returned by default.
+	View *ResourceView `form:"view,omitempty" json:"view,omitempty"`
+
+	// Fields Optional top-level fields of the returned resources, the resource ID is always returned.
+	//  Mutually exclusive with a view other than RESOURCE_VIEW_UNSPECIFIED.
+	//  See https://google.aip.dev/157 for details.
+	Fields *string `form:"fields,omitempty" json:"fields,omitempty"`
 }
 
 // InstanceServicePatchInstance2Params defines parameters for InstanceServicePatchInstance2.
@@ -5880,8 +10198,190 @@ type InstanceServiceInvalidateInstance2Params struct {
 	ProjectName string `form:"projectName" json:"projectName"`
 }
 
-// LocalAccountServiceListLocalAccounts2Params defines parameters for LocalAccountServiceListLocalAccounts2.
-type LocalAccountServiceListLocalAccounts2Params struct {
+// LocalAccountServiceListLocalAccounts2Params defines parameters for LocalAccountServiceListLocalAccounts2.
+type LocalAccountServiceListLocalAccounts2Params struct {
+	// OrderBy Optional comma separated list of fields to specify a sorting order.
+	//  See https://google.aip.dev/132 for details.
+	OrderBy *string `form:"orderBy,omitempty" json:"orderBy,omitempty"`
+
+	// Filter Optional filter to return only item of interest.
+	//  See https://google.aip.dev/160 for details.
+	Filter *string `form:"filter,omitempty" json:"filter,omitempty"`
+
+	// PageSize Defines the amount of items to be contained in a single page.
+	//  Default of 20.
+	PageSize *int `form:"pageSize,omitempty" json:"pageSize,omitempty"`
+
+	// Offset Index of the first item to return. This allows skipping items.
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// ProjectName Project name
+	ProjectName string `form:"projectName" json:"projectName"`
+}
+
+// LocalAccountServiceCreateLocalAccount2Params defines parameters for LocalAccountServiceCreateLocalAccount2.
+type LocalAccountServiceCreateLocalAccount2Params struct {
+	// ProjectName Project name
+	ProjectName string `form:"projectName" json:"projectName"`
+}
+
+// LocalAccountServiceDeleteLocalAccount2Params defines parameters for LocalAccountServiceDeleteLocalAccount2.
+type LocalAccountServiceDeleteLocalAccount2Params struct {
+	// ProjectName Project name
+	ProjectName string `form:"projectName" json:"projectName"`
+}
+
+// LocalAccountServiceGetLocalAccount2Params defines parameters for LocalAccountServiceGetLocalAccount2.
+type LocalAccountServiceGetLocalAccount2Params struct {
+	// ProjectName Project name
+	ProjectName string `form:"projectName" json:"projectName"`
+}
+
+// LocalAccountServicePatchLocalAccount2Params defines parameters for LocalAccountServicePatchLocalAccount2.
+type LocalAccountServicePatchLocalAccount2Params struct {
+	// FieldMask Field mask to be applied on the patch of local account.
+	FieldMask *string `form:"fieldMask,omitempty" json:"fieldMask,omitempty"`
+
+	// ProjectName Project name
+	ProjectName string `form:"projectName" json:"projectName"`
+}
+
+// LocalAccountServiceUpdateLocalAccount2Params defines parameters for LocalAccountServiceUpdateLocalAccount2.
+type LocalAccountServiceUpdateLocalAccount2Params struct {
+	// ProjectName Project name
+	ProjectName string `form:"projectName" json:"projectName"`
+}
+
+// LocationServiceListLocations2Params defines parameters for LocationServiceListLocations2.
+type LocationServiceListLocations2Params struct {
+	// Name Filter locations by name
+	Name *string `form:"name,omitempty" json:"name,omitempty"`
+
+	// ShowSites Return site locations
+	ShowSites *bool `form:"showSites,omitempty" json:"showSites,omitempty"`
+
+	// ShowRegions Return region locations
+	ShowRegions *bool `form:"showRegions,omitempty" json:"showRegions,omitempty"`
+
+	// ShowOus Return OU locations
+	ShowOus *bool `form:"showOus,omitempty" json:"showOus,omitempty"`
+
+	// ProjectName Project name
+	ProjectName string `form:"projectName" json:"projectName"`
+}
+
+// EndpointServiceListEndpoints2Params defines parameters for EndpointServiceListEndpoints2.
+type EndpointServiceListEndpoints2Params struct {
+	// OrderBy Optional comma separated list of fields to specify a sorting order.
+	//  See https://google.aip.dev/132 for details.
+	OrderBy *string `form:"orderBy,omitempty" json:"orderBy,omitempty"`
+
+	// Filter Optional filter to return only item of interest.
+	//  See https://google.aip.dev/160 for details.
+	Filter *string `form:"filter,omitempty" json:"filter,omitempty"`
+
+	// PageSize Defines the amount of items to be contained in a single page.
+	//  Default of 20.
+	PageSize *int `form:"pageSize,omitempty" json:"pageSize,omitempty"`
+
+	// Offset Index of the first item to return. This allows skipping items.
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// ProjectName Project name
+	ProjectName string `form:"projectName" json:"projectName"`
+}
+
+// EndpointServiceGetEndpoint2Params defines parameters for EndpointServiceGetEndpoint2.
+type EndpointServiceGetEndpoint2Params struct {
+	// ProjectName Project name
+	ProjectName string `form:"projectName" json:"projectName"`
+}
+
+// IPAddressServiceListIPAddresses2Params defines parameters for IPAddressServiceListIPAddresses2.
+type IPAddressServiceListIPAddresses2Params struct {
+	// OrderBy Optional comma separated list of fields to specify a sorting order.
+	//  See https://google.aip.dev/132 for details.
+	OrderBy *string `form:"orderBy,omitempty" json:"orderBy,omitempty"`
+
+	// Filter Optional filter to return only item of interest.
+	//  See https://google.aip.dev/160 for details.
+	//  For example `address = "10.1.2.3/24"` or `nic.host.resource_id = "host-12345678"`.
+	Filter *string `form:"filter,omitempty" json:"filter,omitempty"`
+
+	// PageSize Defines the amount of items to be contained in a single page.
+	//  Default of 20.
+	PageSize *int `form:"pageSize,omitempty" json:"pageSize,omitempty"`
+
+	// Offset Index of the first item to return. This allows skipping items.
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// ProjectName Project name
+	ProjectName string `form:"projectName" json:"projectName"`
+}
+
+// IPAddressServiceGetIPAddress2Params defines parameters for IPAddressServiceGetIPAddress2.
+type IPAddressServiceGetIPAddress2Params struct {
+	// ProjectName Project name
+	ProjectName string `form:"projectName" json:"projectName"`
+}
+
+// NetlinkServiceListNetlinks2Params defines parameters for NetlinkServiceListNetlinks2.
+type NetlinkServiceListNetlinks2Params struct {
+	// OrderBy Optional comma separated list of fields to specify a sorting order.
+	//  See https://google.aip.dev/132 for details.
+	OrderBy *string `form:"orderBy,omitempty" json:"orderBy,omitempty"`
+
+	// Filter Optional filter to return only item of interest.
+	//  See https://google.aip.dev/160 for details.
+	Filter *string `form:"filter,omitempty" json:"filter,omitempty"`
+
+	// PageSize Defines the amount of items to be contained in a single page.
+	//  Default of 20.
+	PageSize *int `form:"pageSize,omitempty" json:"pageSize,omitempty"`
+
+	// Offset Index of the first item to return. This allows skipping items.
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// ProjectName Project name
+	ProjectName string `form:"projectName" json:"projectName"`
+}
+
+// NetlinkServiceGetNetlink2Params defines parameters for NetlinkServiceGetNetlink2.
+type NetlinkServiceGetNetlink2Params struct {
+	// ProjectName Project name
+	ProjectName string `form:"projectName" json:"projectName"`
+}
+
+// HostnicServiceListHostnics2Params defines parameters for HostnicServiceListHostnics2.
+type HostnicServiceListHostnics2Params struct {
+	// OrderBy Optional comma separated list of fields to specify a sorting order.
+	//  See https://google.aip.dev/132 for details.
+	OrderBy *string `form:"orderBy,omitempty" json:"orderBy,omitempty"`
+
+	// Filter Optional filter to return only item of interest.
+	//  See https://google.aip.dev/160 for details.
+	//  For example `mac_addr = "aa:bb:cc:dd:ee:ff"` or `host.resource_id = "host-12345678"`.
+	Filter *string `form:"filter,omitempty" json:"filter,omitempty"`
+
+	// PageSize Defines the amount of items to be contained in a single page.
+	//  Default of 20.
+	PageSize *int `form:"pageSize,omitempty" json:"pageSize,omitempty"`
+
+	// Offset Index of the first item to return. This allows skipping items.
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// ProjectName Project name
+	ProjectName string `form:"projectName" json:"projectName"`
+}
+
+// HostnicServiceGetHostnic2Params defines parameters for HostnicServiceGetHostnic2.
+type HostnicServiceGetHostnic2Params struct {
+	// ProjectName Project name
+	ProjectName string `form:"projectName" json:"projectName"`
+}
+
+// NetworkSegmentServiceListNetworkSegments2Params defines parameters for NetworkSegmentServiceListNetworkSegments2.
+type NetworkSegmentServiceListNetworkSegments2Params struct {
 	// OrderBy Optional comma separated list of fields to specify a sorting order.
 	//  See https://google.aip.dev/132 for details.
 	OrderBy *string `form:"orderBy,omitempty" json:"orderBy,omitempty"`
@@ -5901,35 +10401,35 @@ type LocalAccountServiceListLocalAccounts2Params struct {
 	ProjectName string `form:"projectName" json:"projectName"`
 }
 
-// LocalAccountServiceCreateLocalAccount2Params defines parameters for LocalAccountServiceCreateLocalAccount2.
-type LocalAccountServiceCreateLocalAccount2Params struct {
+// NetworkSegmentServiceCreateNetworkSegment2Params defines parameters for NetworkSegmentServiceCreateNetworkSegment2.
+type NetworkSegmentServiceCreateNetworkSegment2Params struct {
 	// ProjectName Project name
 	ProjectName string `form:"projectName" json:"projectName"`
 }
 
-// LocalAccountServiceDeleteLocalAccount2Params defines parameters for LocalAccountServiceDeleteLocalAccount2.
-type LocalAccountServiceDeleteLocalAccount2Params struct {
+// NetworkSegmentServiceDeleteNetworkSegment2Params defines parameters for NetworkSegmentServiceDeleteNetworkSegment2.
+type NetworkSegmentServiceDeleteNetworkSegment2Params struct {
 	// ProjectName Project name
 	ProjectName string `form:"projectName" json:"projectName"`
 }
 
-// LocalAccountServiceGetLocalAccount2Params defines parameters for LocalAccountServiceGetLocalAccount2.
-type LocalAccountServiceGetLocalAccount2Params struct {
+// NetworkSegmentServiceGetNetworkSegment2Params defines parameters for NetworkSegmentServiceGetNetworkSegment2.
+type NetworkSegmentServiceGetNetworkSegment2Params struct {
 	// ProjectName Project name
 	ProjectName string `form:"projectName" json:"projectName"`
 }
 
-// LocationServiceListLocations2Params defines parameters for LocationServiceListLocations2.
-type LocationServiceListLocations2Params struct {
-	// Name Filter locations by name
-	Name *string `form:"name,omitempty" json:"name,omitempty"`
-
-	// ShowSites Return site locations
-	ShowSites *bool `form:"showSites,omitempty" json:"showSites,omitempty"`
+// NetworkSegmentServicePatchNetworkSegment2Params defines parameters for NetworkSegmentServicePatchNetworkSegment2.
+type NetworkSegmentServicePatchNetworkSegment2Params struct {
+	// FieldMask Field mask to be applied on the patch of network segment.
+	FieldMask *string `form:"fieldMask,omitempty" json:"fieldMask,omitempty"`
 
-	// ShowRegions Return region locations
-	ShowRegions *bool `form:"showRegions,omitempty" json:"showRegions,omitempty"`
+	// ProjectName Project name
+	ProjectName string `form:"projectName" json:"projectName"`
+}
 
+// NetworkSegmentServiceUpdateNetworkSegment2Params defines parameters for NetworkSegmentServiceUpdateNetworkSegment2.
+type NetworkSegmentServiceUpdateNetworkSegment2Params struct {
 	// ProjectName Project name
 	ProjectName string `form:"projectName" json:"projectName"`
 }
@@ -5953,6 +10453,14 @@ type OperatingSystemServiceListOperatingSystems2Params struct {
 
 	// ProjectName Project name
 	ProjectName string `form:"projectName" json:"projectName"`
+
+	// View Optional view of the returned resources, all the fields are returned by default.
+	View *ResourceView `form:"view,omitempty" json:"view,omitempty"`
+
+	// Fields Optional top-level fields of the returned resources, the resource ID is always returned.
+	//  Mutually exclusive with a view other than RESOURCE_VIEW_UNSPECIFIED.
+	//  See https://google.aip.dev/157 for details.
+	Fields *string `form:"fields,omitempty" json:"fields,omitempty"`
 }
 
 // OperatingSystemServiceCreateOperatingSystem2Params defines parameters for OperatingSystemServiceCreateOperatingSystem2.
@@ -5971,6 +10479,14 @@ type OperatingSystemServiceDeleteOperatingSystem2Params struct {
 type OperatingSystemServiceGetOperatingSystem2Params struct {
 	// ProjectName Project name
 	ProjectName string `form:"projectName" json:"projectName"`
+
+	// View Optional view of the returned resources, all the fields are returned by default.
+	View *ResourceView `form:"view,omitempty" json:"view,omitempty"`
+
+	// Fields Optional top-level fields of the returned resources, the resource ID is always returned.
+	//  Mutually exclusive with a view other than RESOURCE_VIEW_UNSPECIFIED.
+	//  See https://google.aip.dev/157 for details.
+	Fields *string `form:"fields,omitempty" json:"fields,omitempty"`
 }
 
 // OperatingSystemServicePatchOperatingSystem2Params defines parameters for OperatingSystemServicePatchOperatingSystem2.
@@ -5988,6 +10504,31 @@ type OperatingSystemServiceUpdateOperatingSystem2Params struct {
 	ProjectName string `form:"projectName" json:"projectName"`
 }
 
+// OperationServiceListOperations2Params defines parameters for OperationServiceListOperations2.
+type OperationServiceListOperations2Params struct {
+	// PageSize Defines the amount of items to be contained in a single page.
+	//  Default of 20.
+	PageSize *int `form:"pageSize,omitempty" json:"pageSize,omitempty"`
+
+	// Offset Index of the first item to return. This allows skipping items.
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// ProjectName Project name
+	ProjectName string `form:"projectName" json:"projectName"`
+}
+
+// OperationServiceGetOperation2Params defines parameters for OperationServiceGetOperation2.
+type OperationServiceGetOperation2Params struct {
+	// ProjectName Project name
+	ProjectName string `form:"projectName" json:"projectName"`
+}
+
+// OperationServiceCancelOperation2Params defines parameters for OperationServiceCancelOperation2.
+type OperationServiceCancelOperation2Params struct {
+	// ProjectName Project name
+	ProjectName string `form:"projectName" json:"projectName"`
+}
+
 // OSUpdatePolicyListOSUpdatePolicy2Params defines parameters for OSUpdatePolicyListOSUpdatePolicy2.
 type OSUpdatePolicyListOSUpdatePolicy2Params struct {
 	// OrderBy Optional comma separated list of fields to specify a sorting order.
@@ -6027,6 +10568,21 @@ type OSUpdatePolicyGetOSUpdatePolicy2Params struct {
 	ProjectName string `form:"projectName" json:"projectName"`
 }
 
+// OSUpdatePolicyPatchOSUpdatePolicy2Params defines parameters for OSUpdatePolicyPatchOSUpdatePolicy2.
+type OSUpdatePolicyPatchOSUpdatePolicy2Params struct {
+	// FieldMask Field mask to be applied on the patch of OS Update Policy.
+	FieldMask *string `form:"fieldMask,omitempty" json:"fieldMask,omitempty"`
+
+	// ProjectName Project name
+	ProjectName string `form:"projectName" json:"projectName"`
+}
+
+// OSUpdatePolicyUpdateOSUpdatePolicy2Params defines parameters for OSUpdatePolicyUpdateOSUpdatePolicy2.
+type OSUpdatePolicyUpdateOSUpdatePolicy2Params struct {
+	// ProjectName Project name
+	ProjectName string `form:"projectName" json:"projectName"`
+}
+
 // OSUpdateRunListOSUpdateRun2Params defines parameters for OSUpdateRunListOSUpdateRun2.
 type OSUpdateRunListOSUpdateRun2Params struct {
 	// OrderBy Optional comma separated list of fields to specify a sorting order.
@@ -6060,6 +10616,60 @@ type OSUpdateRunGetOSUpdateRun2Params struct {
 	ProjectName string `form:"projectName" json:"projectName"`
 }
 
+// OuServiceListOus2Params defines parameters for OuServiceListOus2.
+type OuServiceListOus2Params struct {
+	// OrderBy Optional comma separated list of fields to specify a sorting order.
+	//  See https://google.aip.dev/132 for details.
+	OrderBy *string `form:"orderBy,omitempty" json:"orderBy,omitempty"`
+
+	// Filter Optional filter to return only item of interest.
+	//  See https://google.aip.dev/160 for details.
+	Filter *string `form:"filter,omitempty" json:"filter,omitempty"`
+
+	// PageSize Defines the amount of items to be contained in a single page.
+	//  Default of 20.
+	PageSize *int `form:"pageSize,omitempty" json:"pageSize,omitempty"`
+
+	// Offset Index of the first item to return. This allows skipping items.
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// ProjectName Project name
+	ProjectName string `form:"projectName" json:"projectName"`
+}
+
+// OuServiceCreateOu2Params defines parameters for OuServiceCreateOu2.
+type OuServiceCreateOu2Params struct {
+	// ProjectName Project name
+	ProjectName string `form:"projectName" json:"projectName"`
+}
+
+// OuServiceDeleteOu2Params defines parameters for OuServiceDeleteOu2.
+type OuServiceDeleteOu2Params struct {
+	// ProjectName Project name
+	ProjectName string `form:"projectName" json:"projectName"`
+}
+
+// OuServiceGetOu2Params defines parameters for OuServiceGetOu2.
+type OuServiceGetOu2Params struct {
+	// ProjectName Project name
+	ProjectName string `form:"projectName" json:"projectName"`
+}
+
+// OuServicePatchOu2Params defines parameters for OuServicePatchOu2.
+type OuServicePatchOu2Params struct {
+	// FieldMask Field mask to be applied on the patch of OU.
+	FieldMask *string `form:"fieldMask,omitempty" json:"fieldMask,omitempty"`
+
+	// ProjectName Project name
+	ProjectName string `form:"projectName" json:"projectName"`
+}
+
+// OuServiceUpdateOu2Params defines parameters for OuServiceUpdateOu2.
+type OuServiceUpdateOu2Params struct {
+	// ProjectName Project name
+	ProjectName string `form:"projectName" json:"projectName"`
+}
+
 // ProviderServiceListProviders2Params defines parameters for ProviderServiceListProviders2.
 type ProviderServiceListProviders2Params struct {
 	// OrderBy Optional comma separated list of fields to specify a sorting order.
@@ -6099,6 +10709,21 @@ type ProviderServiceGetProvider2Params struct {
 	ProjectName string `form:"projectName" json:"projectName"`
 }
 
+// ProviderServicePatchProvider2Params defines parameters for ProviderServicePatchProvider2.
+type ProviderServicePatchProvider2Params struct {
+	// FieldMask Field mask to be applied on the patch of provider.
+	FieldMask *string `form:"fieldMask,omitempty" json:"fieldMask,omitempty"`
+
+	// ProjectName Project name
+	ProjectName string `form:"projectName" json:"projectName"`
+}
+
+// ProviderServiceUpdateProvider2Params defines parameters for ProviderServiceUpdateProvider2.
+type ProviderServiceUpdateProvider2Params struct {
+	// ProjectName Project name
+	ProjectName string `form:"projectName" json:"projectName"`
+}
+
 // RegionServiceListRegions2Params defines parameters for RegionServiceListRegions2.
 type RegionServiceListRegions2Params struct {
 	// OrderBy Optional comma separated list of fields to specify a sorting order.
@@ -6156,6 +10781,90 @@ type RegionServiceUpdateRegion2Params struct {
 	ProjectName string `form:"projectName" json:"projectName"`
 }
 
+// RemoteAccessServiceListRemoteAccesses2Params defines parameters for RemoteAccessServiceListRemoteAccesses2.
+type RemoteAccessServiceListRemoteAccesses2Params struct {
+	// OrderBy Optional comma separated list of fields to specify a sorting order.
+	//  See https://google.aip.dev/132 for details.
+	OrderBy *string `form:"orderBy,omitempty" json:"orderBy,omitempty"`
+
+	// Filter Optional filter to return only item of interest.
+	//  See https://google.aip.dev/160 for details.
+	Filter *string `form:"filter,omitempty" json:"filter,omitempty"`
+
+	// PageSize Defines the amount of items to be contained in a single page.
+	//  Default of 20.
+	PageSize *int `form:"pageSize,omitempty" json:"pageSize,omitempty"`
+
+	// Offset Index of the first item to return. This allows skipping items.
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// ProjectName Project name
+	ProjectName string `form:"projectName" json:"projectName"`
+}
+
+// RemoteAccessServiceCreateRemoteAccess2Params defines parameters for RemoteAccessServiceCreateRemoteAccess2.
+type RemoteAccessServiceCreateRemoteAccess2Params struct {
+	// ProjectName Project name
+	ProjectName string `form:"projectName" json:"projectName"`
+}
+
+// RemoteAccessServiceDeleteRemoteAccess2Params defines parameters for RemoteAccessServiceDeleteRemoteAccess2.
+type RemoteAccessServiceDeleteRemoteAccess2Params struct {
+	// ProjectName Project name
+	ProjectName string `form:"projectName" json:"projectName"`
+}
+
+// RemoteAccessServiceGetRemoteAccess2Params defines parameters for RemoteAccessServiceGetRemoteAccess2.
+type RemoteAccessServiceGetRemoteAccess2Params struct {
+	// ProjectName Project name
+	ProjectName string `form:"projectName" json:"projectName"`
+}
+
+// RoleBindingServiceListRoleBindings2Params defines parameters for RoleBindingServiceListRoleBindings2.
+type RoleBindingServiceListRoleBindings2Params struct {
+	// OrderBy Optional comma separated list of fields to specify a sorting order.
+	//  See https://google.aip.dev/132 for details.
+	OrderBy *string `form:"orderBy,omitempty" json:"orderBy,omitempty"`
+
+	// Filter Optional filter to return only item of interest.
+	//  See https://google.aip.dev/160 for details.
+	Filter *string `form:"filter,omitempty" json:"filter,omitempty"`
+
+	// PageSize Defines the amount of items to be contained in a single page.
+	//  Default of 20.
+	PageSize *int `form:"pageSize,omitempty" json:"pageSize,omitempty"`
+
+	// Offset Index of the first item to return. This allows skipping items.
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// ProjectName Project name
+	ProjectName string `form:"projectName" json:"projectName"`
+}
+
+// RoleBindingServiceCreateRoleBinding2Params defines parameters for RoleBindingServiceCreateRoleBinding2.
+type RoleBindingServiceCreateRoleBinding2Params struct {
+	// ProjectName Project name
+	ProjectName string `form:"projectName" json:"projectName"`
+}
+
+// RoleBindingServiceDeleteRoleBinding2Params defines parameters for RoleBindingServiceDeleteRoleBinding2.
+type RoleBindingServiceDeleteRoleBinding2Params struct {
+	// ProjectName Project name
+	ProjectName string `form:"projectName" json:"projectName"`
+}
+
+// RoleBindingServiceGetRoleBinding2Params defines parameters for RoleBindingServiceGetRoleBinding2.
+type RoleBindingServiceGetRoleBinding2Params struct {
+	// ProjectName Project name
+	ProjectName string `form:"projectName" json:"projectName"`
+}
+
+// RoleBindingServiceUpdateRoleBinding2Params defines parameters for RoleBindingServiceUpdateRoleBinding2.
+type RoleBindingServiceUpdateRoleBinding2Params struct {
+	// ProjectName Project name
+	ProjectName string `form:"projectName" json:"projectName"`
+}
+
 // ScheduleServiceListSchedules2Params defines parameters for ScheduleServiceListSchedules2.
 type ScheduleServiceListSchedules2Params struct {
 	// PageSize Defines the amount of items to be contained in a single page.
@@ -6349,6 +11058,14 @@ type SiteServiceListSites2Params struct {
 
 	// RegionId Optional region ID for hierarchical path support
 	RegionId *string `form:"regionId,omitempty" json:"regionId,omitempty"`
+
+	// View Optional view of the returned resources, all the fields are returned by default.
+	View *ResourceView `form:"view,omitempty" json:"view,omitempty"`
+
+	// Fields Optional top-level fields of the returned resources, the resource ID is always returned.
+	//  Mutually exclusive with a view other than RESOURCE_VIEW_UNSPECIFIED.
+	//  See https://google.aip.dev/157 for details.
+	Fields *string `form:"fields,omitempty" json:"fields,omitempty"`
 }
 
 // SiteServiceCreateSite2Params defines parameters for SiteServiceCreateSite2.
@@ -6376,6 +11093,14 @@ type SiteServiceGetSite2Params struct {
 
 	// RegionId Optional region ID for hierarchical path support
 	RegionId *string `form:"regionId,omitempty" json:"regionId,omitempty"`
+
+	// View Optional view of the returned resources, all the fields are returned by default.
+	View *ResourceView `form:"view,omitempty" json:"view,omitempty"`
+
+	// Fields Optional top-level fields of the returned resources, the resource ID is always returned.
+	//  Mutually exclusive with a view other than RESOURCE_VIEW_UNSPECIFIED.
+	//  See https://google.aip.dev/157 for details.
+	Fields *string `form:"fields,omitempty" json:"fields,omitempty"`
 }
 
 // SiteServicePatchSite2Params defines parameters for SiteServicePatchSite2.
@@ -6599,6 +11324,23 @@ type TelemetryMetricsProfileServiceUpdateTelemetryMetricsProfile2Params struct {
 	ProjectName string `form:"projectName" json:"projectName"`
 }
 
+// WatchServiceWatchResources2Params defines parameters for WatchServiceWatchResources2.
+type WatchServiceWatchResources2Params struct {
+	// Kinds Kinds of the resources to watch, all the kinds are watched if empty.
+	Kinds *[]WatchResourceKind `form:"kinds,omitempty" json:"kinds,omitempty"`
+
+	// Filter Optional filter to receive only the changes of the resources of interest, it requires
+	//  exactly one kind to be watched. Deletions are always received.
+	//  See https://google.aip.dev/160 for details.
+	Filter *string `form:"filter,omitempty" json:"filter,omitempty"`
+
+	// HeartbeatSeconds Seconds between heartbeats sent when no change happens. Default of 30.
+	HeartbeatSeconds *int `form:"heartbeatSeconds,omitempty" json:"heartbeatSeconds,omitempty"`
+
+	// ProjectName The project name from the URL path.
+	ProjectName string `form:"projectName" json:"projectName"`
+}
+
 // WorkloadMemberServiceListWorkloadMembers2Params defines parameters for WorkloadMemberServiceListWorkloadMembers2.
 type WorkloadMemberServiceListWorkloadMembers2Params struct {
 	// OrderBy Optional comma separated list of fields to specify a sorting order.
@@ -6692,6 +11434,16 @@ type WorkloadServiceUpdateWorkload2Params struct {
 	ProjectName string `form:"projectName" json:"projectName"`
 }
 
+// APITokenServiceListAPITokensParams defines parameters for APITokenServiceListAPITokens.
+type APITokenServiceListAPITokensParams struct {
+	// PageSize Defines the amount of items to be contained in a single page.
+	//  Default of 20.
+	PageSize *int `form:"pageSize,omitempty" json:"pageSize,omitempty"`
+
+	// Offset Index of the first item to return. This allows skipping items.
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
 // HostServiceListHostsParams defines parameters for HostServiceListHosts.
 type HostServiceListHostsParams struct {
 	// OrderBy Optional comma separated list of fields to specify a sorting order.
@@ -6708,6 +11460,53 @@ type HostServiceListHostsParams struct {
 
 	// Offset Index of the first item to return. This allows skipping items.
 	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// View Optional view of the returned resources, all the fields are returned by default.
+	View *ResourceView `form:"view,omitempty" json:"view,omitempty"`
+
+	// Fields Optional top-level fields of the returned resources, the resource ID is always returned.
+	//  Mutually exclusive with a view other than RESOURCE_VIEW_UNSPECIFIED.
+	//  See https://google.aip.dev/157 for details.
+	Fields *string `form:"fields,omitempty" json:"fields,omitempty"`
+}
+
+// HostServiceBulkHostActionJSONBody defines parameters for HostServiceBulkHostAction.
+type HostServiceBulkHostActionJSONBody struct {
+	// Action The action applied to each Host by BulkHostAction.
+	Action         HostBulkAction  `json:"action"`
+	AmtControlMode *AmtControlMode `json:"amtControlMode,omitempty"`
+
+	// DesiredAmtState The state of the AMT (Active Management Technology) component.
+	DesiredAmtState *AmtState `json:"desiredAmtState,omitempty"`
+
+	// DesiredPowerState The host power state.
+	DesiredPowerState *PowerState `json:"desiredPowerState,omitempty"`
+
+	// Filter Filter selecting the hosts, mutually exclusive with resource_ids.
+	//  See https://google.aip.dev/160 for details.
+	Filter *string `json:"filter,omitempty"`
+
+	// Note user-provided reason for change or a freeform field, for the INVALIDATE action
+	Note *string `json:"note,omitempty"`
+
+	// PowerCommandPolicy The policy for handling power commands.
+	PowerCommandPolicy *PowerCommandPolicy `json:"powerCommandPolicy,omitempty"`
+
+	// Preview Return the hosts the action applies to, without applying it.
+	Preview *bool `json:"preview,omitempty"`
+
+	// ResourceIds Resource IDs of the hosts, mutually exclusive with filter.
+	ResourceIds *[]string `json:"resourceIds,omitempty"`
+}
+
+// HostServiceInvalidateHostsJSONBody defines parameters for HostServiceInvalidateHosts.
+type HostServiceInvalidateHostsJSONBody struct {
+	// Filter Filter selecting the hosts to invalidate.
+	//  See https://google.aip.dev/160 for details.
+	Filter string `json:"filter"`
+
+	// Note user-provided reason for change or a freeform field
+	Note *string `json:"note,omitempty"`
 }
 
 // HostServiceRegisterHostParams defines parameters for HostServiceRegisterHost.
@@ -6715,6 +11514,20 @@ type HostServiceRegisterHostParams struct {
 	ResourceId *string `form:"resourceId,omitempty" json:"resourceId,omitempty"`
 }
 
+// HostServiceRegisterHostsJSONBody defines parameters for HostServiceRegisterHosts.
+type HostServiceRegisterHostsJSONBody struct {
+	// Csv The hosts to register as a CSV document, mutually exclusive with hosts. The first line is a header naming the
+	//  columns, among: name, serial_number, uuid, site_id, metadata, auto_onboard, enable_vpro and user_lvm_size.
+	//  Metadata are given as semicolon-separated key=value pairs, flags as true or false.
+	Csv *string `json:"csv,omitempty"`
+
+	// DryRun Validate the hosts without registering them.
+	DryRun *bool `json:"dryRun,omitempty"`
+
+	// Hosts The hosts to register. Mutually exclusive with csv.
+	Hosts *[]HostRegisterEntry `json:"hosts,omitempty"`
+}
+
 // HostServiceGetHostsSummaryParams defines parameters for HostServiceGetHostsSummary.
 type HostServiceGetHostsSummaryParams struct {
 	// Filter Optional filter to return only item of interest.
@@ -6722,6 +11535,17 @@ type HostServiceGetHostsSummaryParams struct {
 	Filter *string `form:"filter,omitempty" json:"filter,omitempty"`
 }
 
+// HostServiceGetHostParams defines parameters for HostServiceGetHost.
+type HostServiceGetHostParams struct {
+	// View Optional view of the returned resources, all the fields are returned by default.
+	View *ResourceView `form:"view,omitempty" json:"view,omitempty"`
+
+	// Fields Optional top-level fields of the returned resources, the resource ID is always returned.
+	//  Mutually exclusive with a view other than RESOURCE_VIEW_UNSPECIFIED.
+	//  See https://google.aip.dev/157 for details.
+	Fields *string `form:"fields,omitempty" json:"fields,omitempty"`
+}
+
 // HostServicePatchHostParams defines parameters for HostServicePatchHost.
 type HostServicePatchHostParams struct {
 	// FieldMask Field mask to be applied on the patch of host.
@@ -6734,6 +11558,16 @@ type HostServiceInvalidateHostParams struct {
 	Note *string `form:"note,omitempty" json:"note,omitempty"`
 }
 
+// HostServiceGetHostsStatisticsParams defines parameters for HostServiceGetHostsStatistics.
+type HostServiceGetHostsStatisticsParams struct {
+	// Dimensions Dimensions to break the hosts down along. Only the total number of hosts is returned if empty.
+	Dimensions *[]HostsStatisticsDimension `form:"dimensions,omitempty" json:"dimensions,omitempty"`
+
+	// Filter Optional filter on the hosts to account for.
+	//  See https://google.aip.dev/160 for details.
+	Filter *string `form:"filter,omitempty" json:"filter,omitempty"`
+}
+
 // HostServiceGetHostsSummary3Params defines parameters for HostServiceGetHostsSummary3.
 type HostServiceGetHostsSummary3Params struct {
 	// Filter Optional filter to return only item of interest.
@@ -6757,6 +11591,41 @@ type InstanceServiceListInstancesParams struct {
 
 	// Offset Index of the first item to return. This allows skipping items.
 	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// View Optional view of the returned resources, all the fields are returned by default.
+	View *ResourceView `form:"view,omitempty" json:"view,omitempty"`
+
+	// Fields Optional top-level fields of the returned resources, the resource ID is always returned.
+	//  Mutually exclusive with a view other than RESOURCE_VIEW_UNSPECIFIED.
+	//  See https://google.aip.dev/157 for details.
+	Fields *string `form:"fields,omitempty" json:"fields,omitempty"`
+}
+
+// InstanceServiceBulkInstanceActionJSONBody defines parameters for InstanceServiceBulkInstanceAction.
+type InstanceServiceBulkInstanceActionJSONBody struct {
+	// Action The action applied to each Instance by BulkInstanceAction.
+	Action InstanceBulkAction `json:"action"`
+
+	// Filter Filter selecting the instances, mutually exclusive with resource_ids.
+	//  See https://google.aip.dev/160 for details.
+	Filter *string `json:"filter,omitempty"`
+
+	// Preview Return the instances the action applies to, without applying it.
+	Preview *bool `json:"preview,omitempty"`
+
+	// ResourceIds Resource IDs of the instances, mutually exclusive with filter.
+	ResourceIds *[]string `json:"resourceIds,omitempty"`
+}
+
+// InstanceServiceGetInstanceParams defines parameters for InstanceServiceGetInstance.
+type InstanceServiceGetInstanceParams struct {
+	// View Optional view of the returned resources, all the fields are returned by default.
+	View *ResourceView `form:"view,omitempty" json:"view,omitempty"`
+
+	// Fields Optional top-level fields of the returned resources, the resource ID is always returned.
+	//  Mutually exclusive with a view other than RESOURCE_VIEW_UNSPECIFIED.
+	//  See https://google.aip.dev/157 for details.
+	Fields *string `form:"fields,omitempty" json:"fields,omitempty"`
 }
 
 // InstanceServicePatchInstanceParams defines parameters for InstanceServicePatchInstance.
@@ -6781,6 +11650,25 @@ type OperatingSystemServiceListOperatingSystems3Params struct {
 
 	// Offset Index of the first item to return. This allows skipping items.
 	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// View Optional view of the returned resources, all the fields are returned by default.
+	View *ResourceView `form:"view,omitempty" json:"view,omitempty"`
+
+	// Fields Optional top-level fields of the returned resources, the resource ID is always returned.
+	//  Mutually exclusive with a view other than RESOURCE_VIEW_UNSPECIFIED.
+	//  See https://google.aip.dev/157 for details.
+	Fields *string `form:"fields,omitempty" json:"fields,omitempty"`
+}
+
+// OperatingSystemServiceGetOperatingSystem3Params defines parameters for OperatingSystemServiceGetOperatingSystem3.
+type OperatingSystemServiceGetOperatingSystem3Params struct {
+	// View Optional view of the returned resources, all the fields are returned by default.
+	View *ResourceView `form:"view,omitempty" json:"view,omitempty"`
+
+	// Fields Optional top-level fields of the returned resources, the resource ID is always returned.
+	//  Mutually exclusive with a view other than RESOURCE_VIEW_UNSPECIFIED.
+	//  See https://google.aip.dev/157 for details.
+	Fields *string `form:"fields,omitempty" json:"fields,omitempty"`
 }
 
 // OperatingSystemServicePatchOperatingSystem3Params defines parameters for OperatingSystemServicePatchOperatingSystem3.
@@ -6805,6 +11693,25 @@ type OperatingSystemServiceListOperatingSystemsParams struct {
 
 	// Offset Index of the first item to return. This allows skipping items.
 	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// View Optional view of the returned resources, all the fields are returned by default.
+	View *ResourceView `form:"view,omitempty" json:"view,omitempty"`
+
+	// Fields Optional top-level fields of the returned resources, the resource ID is always returned.
+	//  Mutually exclusive with a view other than RESOURCE_VIEW_UNSPECIFIED.
+	//  See https://google.aip.dev/157 for details.
+	Fields *string `form:"fields,omitempty" json:"fields,omitempty"`
+}
+
+// OperatingSystemServiceGetOperatingSystemParams defines parameters for OperatingSystemServiceGetOperatingSystem.
+type OperatingSystemServiceGetOperatingSystemParams struct {
+	// View Optional view of the returned resources, all the fields are returned by default.
+	View *ResourceView `form:"view,omitempty" json:"view,omitempty"`
+
+	// Fields Optional top-level fields of the returned resources, the resource ID is always returned.
+	//  Mutually exclusive with a view other than RESOURCE_VIEW_UNSPECIFIED.
+	//  See https://google.aip.dev/157 for details.
+	Fields *string `form:"fields,omitempty" json:"fields,omitempty"`
 }
 
 // OperatingSystemServicePatchOperatingSystemParams defines parameters for OperatingSystemServicePatchOperatingSystem.
@@ -6963,6 +11870,12 @@ type CustomConfigServiceListCustomConfigsParams struct {
 	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
 }
 
+// CustomConfigServicePatchCustomConfigParams defines parameters for CustomConfigServicePatchCustomConfig.
+type CustomConfigServicePatchCustomConfigParams struct {
+	// FieldMask Field mask to be applied on the patch of custom configuration.
+	FieldMask *string `form:"fieldMask,omitempty" json:"fieldMask,omitempty"`
+}
+
 // LocalAccountServiceListLocalAccountsParams defines parameters for LocalAccountServiceListLocalAccounts.
 type LocalAccountServiceListLocalAccountsParams struct {
 	// OrderBy Optional comma separated list of fields to specify a sorting order.
@@ -6981,6 +11894,12 @@ type LocalAccountServiceListLocalAccountsParams struct {
 	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
 }
 
+// LocalAccountServicePatchLocalAccountParams defines parameters for LocalAccountServicePatchLocalAccount.
+type LocalAccountServicePatchLocalAccountParams struct {
+	// FieldMask Field mask to be applied on the patch of local account.
+	FieldMask *string `form:"fieldMask,omitempty" json:"fieldMask,omitempty"`
+}
+
 // LocationServiceListLocationsParams defines parameters for LocationServiceListLocations.
 type LocationServiceListLocationsParams struct {
 	// Name Filter locations by name
@@ -6991,6 +11910,117 @@ type LocationServiceListLocationsParams struct {
 
 	// ShowRegions Return region locations
 	ShowRegions *bool `form:"showRegions,omitempty" json:"showRegions,omitempty"`
+
+	// ShowOus Return OU locations
+	ShowOus *bool `form:"showOus,omitempty" json:"showOus,omitempty"`
+}
+
+// EndpointServiceListEndpointsParams defines parameters for EndpointServiceListEndpoints.
+type EndpointServiceListEndpointsParams struct {
+	// OrderBy Optional comma separated list of fields to specify a sorting order.
+	//  See https://google.aip.dev/132 for details.
+	OrderBy *string `form:"orderBy,omitempty" json:"orderBy,omitempty"`
+
+	// Filter Optional filter to return only item of interest.
+	//  See https://google.aip.dev/160 for details.
+	Filter *string `form:"filter,omitempty" json:"filter,omitempty"`
+
+	// PageSize Defines the amount of items to be contained in a single page.
+	//  Default of 20.
+	PageSize *int `form:"pageSize,omitempty" json:"pageSize,omitempty"`
+
+	// Offset Index of the first item to return. This allows skipping items.
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// IPAddressServiceListIPAddressesParams defines parameters for IPAddressServiceListIPAddresses.
+type IPAddressServiceListIPAddressesParams struct {
+	// OrderBy Optional comma separated list of fields to specify a sorting order.
+	//  See https://google.aip.dev/132 for details.
+	OrderBy *string `form:"orderBy,omitempty" json:"orderBy,omitempty"`
+
+	// Filter Optional filter to return only item of interest.
+	//  See https://google.aip.dev/160 for details.
+	//  For example `address = "10.1.2.3/24"` or `nic.host.resource_id = "host-12345678"`.
+	Filter *string `form:"filter,omitempty" json:"filter,omitempty"`
+
+	// PageSize Defines the amount of items to be contained in a single page.
+	//  Default of 20.
+	PageSize *int `form:"pageSize,omitempty" json:"pageSize,omitempty"`
+
+	// Offset Index of the first item to return. This allows skipping items.
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// NetlinkServiceListNetlinksParams defines parameters for NetlinkServiceListNetlinks.
+type NetlinkServiceListNetlinksParams struct {
+	// OrderBy Optional comma separated list of fields to specify a sorting order.
+	//  See https://google.aip.dev/132 for details.
+	OrderBy *string `form:"orderBy,omitempty" json:"orderBy,omitempty"`
+
+	// Filter Optional filter to return only item of interest.
+	//  See https://google.aip.dev/160 for details.
+	Filter *string `form:"filter,omitempty" json:"filter,omitempty"`
+
+	// PageSize Defines the amount of items to be contained in a single page.
+	//  Default of 20.
+	PageSize *int `form:"pageSize,omitempty" json:"pageSize,omitempty"`
+
+	// Offset Index of the first item to return. This allows skipping items.
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// HostnicServiceListHostnicsParams defines parameters for HostnicServiceListHostnics.
+type HostnicServiceListHostnicsParams struct {
+	// OrderBy Optional comma separated list of fields to specify a sorting order.
+	//  See https://google.aip.dev/132 for details.
+	OrderBy *string `form:"orderBy,omitempty" json:"orderBy,omitempty"`
+
+	// Filter Optional filter to return only item of interest.
+	//  See https://google.aip.dev/160 for details.
+	//  For example `mac_addr = "aa:bb:cc:dd:ee:ff"` or `host.resource_id = "host-12345678"`.
+	Filter *string `form:"filter,omitempty" json:"filter,omitempty"`
+
+	// PageSize Defines the amount of items to be contained in a single page.
+	//  Default of 20.
+	PageSize *int `form:"pageSize,omitempty" json:"pageSize,omitempty"`
+
+	// Offset Index of the first item to return. This allows skipping items.
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// NetworkSegmentServiceListNetworkSegmentsParams defines parameters for NetworkSegmentServiceListNetworkSegments.
+type NetworkSegmentServiceListNetworkSegmentsParams struct {
+	// OrderBy Optional comma separated list of fields to specify a sorting order.
+	//  See https://google.aip.dev/132 for details.
+	OrderBy *string `form:"orderBy,omitempty" json:"orderBy,omitempty"`
+
+	// Filter Optional filter to return only item of interest.
+	//  See https://google.aip.dev/160 for details.
+	Filter *string `form:"filter,omitempty" json:"filter,omitempty"`
+
+	// PageSize Defines the amount of items to be contained in a single page.
+	//  Default of 20.
+	PageSize *int `form:"pageSize,omitempty" json:"pageSize,omitempty"`
+
+	// Offset Index of the first item to return. This allows skipping items.
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// NetworkSegmentServicePatchNetworkSegmentParams defines parameters for NetworkSegmentServicePatchNetworkSegment.
+type NetworkSegmentServicePatchNetworkSegmentParams struct {
+	// FieldMask Field mask to be applied on the patch of network segment.
+	FieldMask *string `form:"fieldMask,omitempty" json:"fieldMask,omitempty"`
+}
+
+// OperationServiceListOperationsParams defines parameters for OperationServiceListOperations.
+type OperationServiceListOperationsParams struct {
+	// PageSize Defines the amount of items to be contained in a single page.
+	//  Default of 20.
+	PageSize *int `form:"pageSize,omitempty" json:"pageSize,omitempty"`
+
+	// Offset Index of the first item to return. This allows skipping items.
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
 }
 
 // OSUpdatePolicyListOSUpdatePolicyParams defines parameters for OSUpdatePolicyListOSUpdatePolicy.
@@ -7011,6 +12041,12 @@ type OSUpdatePolicyListOSUpdatePolicyParams struct {
 	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
 }
 
+// OSUpdatePolicyPatchOSUpdatePolicyParams defines parameters for OSUpdatePolicyPatchOSUpdatePolicy.
+type OSUpdatePolicyPatchOSUpdatePolicyParams struct {
+	// FieldMask Field mask to be applied on the patch of OS Update Policy.
+	FieldMask *string `form:"fieldMask,omitempty" json:"fieldMask,omitempty"`
+}
+
 // OSUpdateRunListOSUpdateRunParams defines parameters for OSUpdateRunListOSUpdateRun.
 type OSUpdateRunListOSUpdateRunParams struct {
 	// OrderBy Optional comma separated list of fields to specify a sorting order.
@@ -7047,6 +12083,12 @@ type OSUpdatePolicyListOSUpdatePolicy3Params struct {
 	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
 }
 
+// OSUpdatePolicyPatchOSUpdatePolicy3Params defines parameters for OSUpdatePolicyPatchOSUpdatePolicy3.
+type OSUpdatePolicyPatchOSUpdatePolicy3Params struct {
+	// FieldMask Field mask to be applied on the patch of OS Update Policy.
+	FieldMask *string `form:"fieldMask,omitempty" json:"fieldMask,omitempty"`
+}
+
 // OSUpdateRunListOSUpdateRun3Params defines parameters for OSUpdateRunListOSUpdateRun3.
 type OSUpdateRunListOSUpdateRun3Params struct {
 	// OrderBy Optional comma separated list of fields to specify a sorting order.
@@ -7065,6 +12107,30 @@ type OSUpdateRunListOSUpdateRun3Params struct {
 	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
 }
 
+// OuServiceListOusParams defines parameters for OuServiceListOus.
+type OuServiceListOusParams struct {
+	// OrderBy Optional comma separated list of fields to specify a sorting order.
+	//  See https://google.aip.dev/132 for details.
+	OrderBy *string `form:"orderBy,omitempty" json:"orderBy,omitempty"`
+
+	// Filter Optional filter to return only item of interest.
+	//  See https://google.aip.dev/160 for details.
+	Filter *string `form:"filter,omitempty" json:"filter,omitempty"`
+
+	// PageSize Defines the amount of items to be contained in a single page.
+	//  Default of 20.
+	PageSize *int `form:"pageSize,omitempty" json:"pageSize,omitempty"`
+
+	// Offset Index of the first item to return. This allows skipping items.
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// OuServicePatchOuParams defines parameters for OuServicePatchOu.
+type OuServicePatchOuParams struct {
+	// FieldMask Field mask to be applied on the patch of OU.
+	FieldMask *string `form:"fieldMask,omitempty" json:"fieldMask,omitempty"`
+}
+
 // ProviderServiceListProvidersParams defines parameters for ProviderServiceListProviders.
 type ProviderServiceListProvidersParams struct {
 	// OrderBy Optional comma separated list of fields to specify a sorting order.
@@ -7083,6 +12149,12 @@ type ProviderServiceListProvidersParams struct {
 	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
 }
 
+// ProviderServicePatchProviderParams defines parameters for ProviderServicePatchProvider.
+type ProviderServicePatchProviderParams struct {
+	// FieldMask Field mask to be applied on the patch of provider.
+	FieldMask *string `form:"fieldMask,omitempty" json:"fieldMask,omitempty"`
+}
+
 // RegionServiceListRegionsParams defines parameters for RegionServiceListRegions.
 type RegionServiceListRegionsParams struct {
 	// OrderBy Optional comma separated list of fields to specify a sorting order.
@@ -7120,6 +12192,25 @@ type SiteServiceListSitesParams struct {
 
 	// Offset Index of the first item to return. This allows skipping items.
 	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// View Optional view of the returned resources, all the fields are returned by default.
+	View *ResourceView `form:"view,omitempty" json:"view,omitempty"`
+
+	// Fields Optional top-level fields of the returned resources, the resource ID is always returned.
+	//  Mutually exclusive with a view other than RESOURCE_VIEW_UNSPECIFIED.
+	//  See https://google.aip.dev/157 for details.
+	Fields *string `form:"fields,omitempty" json:"fields,omitempty"`
+}
+
+// SiteServiceGetSiteParams defines parameters for SiteServiceGetSite.
+type SiteServiceGetSiteParams struct {
+	// View Optional view of the returned resources, all the fields are returned by default.
+	View *ResourceView `form:"view,omitempty" json:"view,omitempty"`
+
+	// Fields Optional top-level fields of the returned resources, the resource ID is always returned.
+	//  Mutually exclusive with a view other than RESOURCE_VIEW_UNSPECIFIED.
+	//  See https://google.aip.dev/157 for details.
+	Fields *string `form:"fields,omitempty" json:"fields,omitempty"`
 }
 
 // SiteServicePatchSiteParams defines parameters for SiteServicePatchSite.
@@ -7134,6 +12225,42 @@ type RegionServicePatchRegionParams struct {
 	FieldMask *string `form:"fieldMask,omitempty" json:"fieldMask,omitempty"`
 }
 
+// RemoteAccessServiceListRemoteAccessesParams defines parameters for RemoteAccessServiceListRemoteAccesses.
+type RemoteAccessServiceListRemoteAccessesParams struct {
+	// OrderBy Optional comma separated list of fields to specify a sorting order.
+	//  See https://google.aip.dev/132 for details.
+	OrderBy *string `form:"orderBy,omitempty" json:"orderBy,omitempty"`
+
+	// Filter Optional filter to return only item of interest.
+	//  See https://google.aip.dev/160 for details.
+	Filter *string `form:"filter,omitempty" json:"filter,omitempty"`
+
+	// PageSize Defines the amount of items to be contained in a single page.
+	//  Default of 20.
+	PageSize *int `form:"pageSize,omitempty" json:"pageSize,omitempty"`
+
+	// Offset Index of the first item to return. This allows skipping items.
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// RoleBindingServiceListRoleBindingsParams defines parameters for RoleBindingServiceListRoleBindings.
+type RoleBindingServiceListRoleBindingsParams struct {
+	// OrderBy Optional comma separated list of fields to specify a sorting order.
+	//  See https://google.aip.dev/132 for details.
+	OrderBy *string `form:"orderBy,omitempty" json:"orderBy,omitempty"`
+
+	// Filter Optional filter to return only item of interest.
+	//  See https://google.aip.dev/160 for details.
+	Filter *string `form:"filter,omitempty" json:"filter,omitempty"`
+
+	// PageSize Defines the amount of items to be contained in a single page.
+	//  Default of 20.
+	PageSize *int `form:"pageSize,omitempty" json:"pageSize,omitempty"`
+
+	// Offset Index of the first item to return. This allows skipping items.
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
 // ScheduleServiceListSchedules3Params defines parameters for ScheduleServiceListSchedules3.
 type ScheduleServiceListSchedules3Params struct {
 	// PageSize Defines the amount of items to be contained in a single page.
@@ -7261,6 +12388,14 @@ type SiteServiceListSites3Params struct {
 
 	// RegionId Optional region ID for hierarchical path support
 	RegionId *string `form:"regionId,omitempty" json:"regionId,omitempty"`
+
+	// View Optional view of the returned resources, all the fields are returned by default.
+	View *ResourceView `form:"view,omitempty" json:"view,omitempty"`
+
+	// Fields Optional top-level fields of the returned resources, the resource ID is always returned.
+	//  Mutually exclusive with a view other than RESOURCE_VIEW_UNSPECIFIED.
+	//  See https://google.aip.dev/157 for details.
+	Fields *string `form:"fields,omitempty" json:"fields,omitempty"`
 }
 
 // SiteServiceCreateSite3Params defines parameters for SiteServiceCreateSite3.
@@ -7279,6 +12414,14 @@ type SiteServiceDeleteSite3Params struct {
 type SiteServiceGetSite3Params struct {
 	// RegionId Optional region ID for hierarchical path support
 	RegionId *string `form:"regionId,omitempty" json:"regionId,omitempty"`
+
+	// View Optional view of the returned resources, all the fields are returned by default.
+	View *ResourceView `form:"view,omitempty" json:"view,omitempty"`
+
+	// Fields Optional top-level fields of the returned resources, the resource ID is always returned.
+	//  Mutually exclusive with a view other than RESOURCE_VIEW_UNSPECIFIED.
+	//  See https://google.aip.dev/157 for details.
+	Fields *string `form:"fields,omitempty" json:"fields,omitempty"`
 }
 
 // SiteServicePatchSite3Params defines parameters for SiteServicePatchSite3.
@@ -7422,6 +12565,20 @@ type TelemetryMetricsProfileServicePatchTelemetryMetricsProfileParams struct {
 	FieldMask *string `form:"fieldMask,omitempty" json:"fieldMask,omitempty"`
 }
 
+// WatchServiceWatchResourcesParams defines parameters for WatchServiceWatchResources.
+type WatchServiceWatchResourcesParams struct {
+	// Kinds Kinds of the resources to watch, all the kinds are watched if empty.
+	Kinds *[]WatchResourceKind `form:"kinds,omitempty" json:"kinds,omitempty"`
+
+	// Filter Optional filter to receive only the changes of the resources of interest, it requires
+	//  exactly one kind to be watched. Deletions are always received.
+	//  See https://google.aip.dev/160 for details.
+	Filter *string `form:"filter,omitempty" json:"filter,omitempty"`
+
+	// HeartbeatSeconds Seconds between heartbeats sent when no change happens. Default of 30.
+	HeartbeatSeconds *int `form:"heartbeatSeconds,omitempty" json:"heartbeatSeconds,omitempty"`
+}
+
 // WorkloadMemberServiceListWorkloadMembersParams defines parameters for WorkloadMemberServiceListWorkloadMembers.
 type WorkloadMemberServiceListWorkloadMembersParams struct {
 	// OrderBy Optional comma separated list of fields to specify a sorting order.
@@ -7464,15 +12621,33 @@ type WorkloadServicePatchWorkload3Params struct {
 	FieldMask *string `form:"fieldMask,omitempty" json:"fieldMask,omitempty"`
 }
 
+// APITokenServiceCreateAPIToken2JSONRequestBody defines body for APITokenServiceCreateAPIToken2 for application/json ContentType.
+type APITokenServiceCreateAPIToken2JSONRequestBody = APITokenResource
+
 // CustomConfigServiceCreateCustomConfig2JSONRequestBody defines body for CustomConfigServiceCreateCustomConfig2 for application/json ContentType.
 type CustomConfigServiceCreateCustomConfig2JSONRequestBody = CustomConfigResource
 
+// CustomConfigServicePatchCustomConfig2JSONRequestBody defines body for CustomConfigServicePatchCustomConfig2 for application/json ContentType.
+type CustomConfigServicePatchCustomConfig2JSONRequestBody = CustomConfigResource
+
+// CustomConfigServiceUpdateCustomConfig2JSONRequestBody defines body for CustomConfigServiceUpdateCustomConfig2 for application/json ContentType.
+type CustomConfigServiceUpdateCustomConfig2JSONRequestBody = CustomConfigResource
+
 // HostServiceCreateHost2JSONRequestBody defines body for HostServiceCreateHost2 for application/json ContentType.
 type HostServiceCreateHost2JSONRequestBody = HostResource
 
+// HostServiceBulkHostAction2JSONRequestBody defines body for HostServiceBulkHostAction2 for application/json ContentType.
+type HostServiceBulkHostAction2JSONRequestBody = BulkHostActionRequest
+
+// HostServiceInvalidateHosts2JSONRequestBody defines body for HostServiceInvalidateHosts2 for application/json ContentType.
+type HostServiceInvalidateHosts2JSONRequestBody = InvalidateHostsRequest
+
 // HostServiceRegisterHost2JSONRequestBody defines body for HostServiceRegisterHost2 for application/json ContentType.
 type HostServiceRegisterHost2JSONRequestBody = HostRegister
 
+// HostServiceRegisterHosts2JSONRequestBody defines body for HostServiceRegisterHosts2 for application/json ContentType.
+type HostServiceRegisterHosts2JSONRequestBody = RegisterHostsRequest
+
 // HostServicePatchHost2JSONRequestBody defines body for HostServicePatchHost2 for application/json ContentType.
 type HostServicePatchHost2JSONRequestBody = HostResource
 
@@ -7485,6 +12660,9 @@ type HostServicePatchRegisterHost2JSONRequestBody = HostRegister
 // InstanceServiceCreateInstance2JSONRequestBody defines body for InstanceServiceCreateInstance2 for application/json ContentType.
 type InstanceServiceCreateInstance2JSONRequestBody = InstanceResource
 
+// InstanceServiceBulkInstanceAction2JSONRequestBody defines body for InstanceServiceBulkInstanceAction2 for application/json ContentType.
+type InstanceServiceBulkInstanceAction2JSONRequestBody = BulkInstanceActionRequest
+
 // InstanceServicePatchInstance2JSONRequestBody defines body for InstanceServicePatchInstance2 for application/json ContentType.
 type InstanceServicePatchInstance2JSONRequestBody = InstanceResource
 
@@ -7494,6 +12672,21 @@ type InstanceServiceUpdateInstance2JSONRequestBody = InstanceResource
 // LocalAccountServiceCreateLocalAccount2JSONRequestBody defines body for LocalAccountServiceCreateLocalAccount2 for application/json ContentType.
 type LocalAccountServiceCreateLocalAccount2JSONRequestBody = LocalAccountResource
 
+// LocalAccountServicePatchLocalAccount2JSONRequestBody defines body for LocalAccountServicePatchLocalAccount2 for application/json ContentType.
+type LocalAccountServicePatchLocalAccount2JSONRequestBody = LocalAccountResource
+
+// LocalAccountServiceUpdateLocalAccount2JSONRequestBody defines body for LocalAccountServiceUpdateLocalAccount2 for application/json ContentType.
+type LocalAccountServiceUpdateLocalAccount2JSONRequestBody = LocalAccountResource
+
+// NetworkSegmentServiceCreateNetworkSegment2JSONRequestBody defines body for NetworkSegmentServiceCreateNetworkSegment2 for application/json ContentType.
+type NetworkSegmentServiceCreateNetworkSegment2JSONRequestBody = NetworkSegmentResource
+
+// NetworkSegmentServicePatchNetworkSegment2JSONRequestBody defines body for NetworkSegmentServicePatchNetworkSegment2 for application/json ContentType.
+type NetworkSegmentServicePatchNetworkSegment2JSONRequestBody = NetworkSegmentResource
+
+// NetworkSegmentServiceUpdateNetworkSegment2JSONRequestBody defines body for NetworkSegmentServiceUpdateNetworkSegment2 for application/json ContentType.
+type NetworkSegmentServiceUpdateNetworkSegment2JSONRequestBody = NetworkSegmentResource
+
 // OperatingSystemServiceCreateOperatingSystem2JSONRequestBody defines body for OperatingSystemServiceCreateOperatingSystem2 for application/json ContentType.
 type OperatingSystemServiceCreateOperatingSystem2JSONRequestBody = OperatingSystemResource
 
@@ -7506,9 +12699,30 @@ type OperatingSystemServiceUpdateOperatingSystem2JSONRequestBody = OperatingSyst
 // OSUpdatePolicyCreateOSUpdatePolicy2JSONRequestBody defines body for OSUpdatePolicyCreateOSUpdatePolicy2 for application/json ContentType.
 type OSUpdatePolicyCreateOSUpdatePolicy2JSONRequestBody = OSUpdatePolicy
 
+// OSUpdatePolicyPatchOSUpdatePolicy2JSONRequestBody defines body for OSUpdatePolicyPatchOSUpdatePolicy2 for application/json ContentType.
+type OSUpdatePolicyPatchOSUpdatePolicy2JSONRequestBody = OSUpdatePolicy
+
+// OSUpdatePolicyUpdateOSUpdatePolicy2JSONRequestBody defines body for OSUpdatePolicyUpdateOSUpdatePolicy2 for application/json ContentType.
+type OSUpdatePolicyUpdateOSUpdatePolicy2JSONRequestBody = OSUpdatePolicy
+
+// OuServiceCreateOu2JSONRequestBody defines body for OuServiceCreateOu2 for application/json ContentType.
+type OuServiceCreateOu2JSONRequestBody = OuResource
+
+// OuServicePatchOu2JSONRequestBody defines body for OuServicePatchOu2 for application/json ContentType.
+type OuServicePatchOu2JSONRequestBody = OuResource
+
+// OuServiceUpdateOu2JSONRequestBody defines body for OuServiceUpdateOu2 for application/json ContentType.
+type OuServiceUpdateOu2JSONRequestBody = OuResource
+
 // ProviderServiceCreateProvider2JSONRequestBody defines body for ProviderServiceCreateProvider2 for application/json ContentType.
 type ProviderServiceCreateProvider2JSONRequestBody = ProviderResource
 
+// ProviderServicePatchProvider2JSONRequestBody defines body for ProviderServicePatchProvider2 for application/json ContentType.
+type ProviderServicePatchProvider2JSONRequestBody = ProviderResource
+
+// ProviderServiceUpdateProvider2JSONRequestBody defines body for ProviderServiceUpdateProvider2 for application/json ContentType.
+type ProviderServiceUpdateProvider2JSONRequestBody = ProviderResource
+
 // RegionServiceCreateRegion2JSONRequestBody defines body for RegionServiceCreateRegion2 for application/json ContentType.
 type RegionServiceCreateRegion2JSONRequestBody = RegionResource
 
@@ -7518,6 +12732,15 @@ type RegionServicePatchRegion2JSONRequestBody = RegionResource
 // RegionServiceUpdateRegion2JSONRequestBody defines body for RegionServiceUpdateRegion2 for application/json ContentType.
 type RegionServiceUpdateRegion2JSONRequestBody = RegionResource
 
+// RemoteAccessServiceCreateRemoteAccess2JSONRequestBody defines body for RemoteAccessServiceCreateRemoteAccess2 for application/json ContentType.
+type RemoteAccessServiceCreateRemoteAccess2JSONRequestBody = RemoteAccessResource
+
+// RoleBindingServiceCreateRoleBinding2JSONRequestBody defines body for RoleBindingServiceCreateRoleBinding2 for application/json ContentType.
+type RoleBindingServiceCreateRoleBinding2JSONRequestBody = RoleBindingResource
+
+// RoleBindingServiceUpdateRoleBinding2JSONRequestBody defines body for RoleBindingServiceUpdateRoleBinding2 for application/json ContentType.
+type RoleBindingServiceUpdateRoleBinding2JSONRequestBody = RoleBindingResource
+
 // ScheduleServiceCreateRepeatedSchedule2JSONRequestBody defines body for ScheduleServiceCreateRepeatedSchedule2 for application/json ContentType.
 type ScheduleServiceCreateRepeatedSchedule2JSONRequestBody = RepeatedScheduleResource
 
@@ -7581,12 +12804,24 @@ type WorkloadServicePatchWorkload2JSONRequestBody = WorkloadResource
 // WorkloadServiceUpdateWorkload2JSONRequestBody defines body for WorkloadServiceUpdateWorkload2 for application/json ContentType.
 type WorkloadServiceUpdateWorkload2JSONRequestBody = WorkloadResource
 
+// APITokenServiceCreateAPITokenJSONRequestBody defines body for APITokenServiceCreateAPIToken for application/json ContentType.
+type APITokenServiceCreateAPITokenJSONRequestBody = APITokenResource
+
 // HostServiceCreateHostJSONRequestBody defines body for HostServiceCreateHost for application/json ContentType.
 type HostServiceCreateHostJSONRequestBody = HostResource
 
+// HostServiceBulkHostActionJSONRequestBody defines body for HostServiceBulkHostAction for application/json ContentType.
+type HostServiceBulkHostActionJSONRequestBody HostServiceBulkHostActionJSONBody
+
+// HostServiceInvalidateHostsJSONRequestBody defines body for HostServiceInvalidateHosts for application/json ContentType.
+type HostServiceInvalidateHostsJSONRequestBody HostServiceInvalidateHostsJSONBody
+
 // HostServiceRegisterHostJSONRequestBody defines body for HostServiceRegisterHost for application/json ContentType.
 type HostServiceRegisterHostJSONRequestBody = HostRegister
 
+// HostServiceRegisterHostsJSONRequestBody defines body for HostServiceRegisterHosts for application/json ContentType.
+type HostServiceRegisterHostsJSONRequestBody HostServiceRegisterHostsJSONBody
+
 // HostServicePatchHostJSONRequestBody defines body for HostServicePatchHost for application/json ContentType.
 type HostServicePatchHostJSONRequestBody = HostResource
 
@@ -7599,6 +12834,9 @@ type HostServicePatchRegisterHostJSONRequestBody = HostRegister
 // InstanceServiceCreateInstanceJSONRequestBody defines body for InstanceServiceCreateInstance for application/json ContentType.
 type InstanceServiceCreateInstanceJSONRequestBody = InstanceResource
 
+// InstanceServiceBulkInstanceActionJSONRequestBody defines body for InstanceServiceBulkInstanceAction for application/json ContentType.
+type InstanceServiceBulkInstanceActionJSONRequestBody InstanceServiceBulkInstanceActionJSONBody
+
 // InstanceServicePatchInstanceJSONRequestBody defines body for InstanceServicePatchInstance for application/json ContentType.
 type InstanceServicePatchInstanceJSONRequestBody = InstanceResource
 
@@ -7653,18 +12891,66 @@ type WorkloadServiceUpdateWorkloadJSONRequestBody = WorkloadResource
 // CustomConfigServiceCreateCustomConfigJSONRequestBody defines body for CustomConfigServiceCreateCustomConfig for application/json ContentType.
 type CustomConfigServiceCreateCustomConfigJSONRequestBody = CustomConfigResource
 
+// CustomConfigServicePatchCustomConfigJSONRequestBody defines body for CustomConfigServicePatchCustomConfig for application/json ContentType.
+type CustomConfigServicePatchCustomConfigJSONRequestBody = CustomConfigResource
+
+// CustomConfigServiceUpdateCustomConfigJSONRequestBody defines body for CustomConfigServiceUpdateCustomConfig for application/json ContentType.
+type CustomConfigServiceUpdateCustomConfigJSONRequestBody = CustomConfigResource
+
 // LocalAccountServiceCreateLocalAccountJSONRequestBody defines body for LocalAccountServiceCreateLocalAccount for application/json ContentType.
 type LocalAccountServiceCreateLocalAccountJSONRequestBody = LocalAccountResource
 
+// LocalAccountServicePatchLocalAccountJSONRequestBody defines body for LocalAccountServicePatchLocalAccount for application/json ContentType.
+type LocalAccountServicePatchLocalAccountJSONRequestBody = LocalAccountResource
+
+// LocalAccountServiceUpdateLocalAccountJSONRequestBody defines body for LocalAccountServiceUpdateLocalAccount for application/json ContentType.
+type LocalAccountServiceUpdateLocalAccountJSONRequestBody = LocalAccountResource
+
+// NetworkSegmentServiceCreateNetworkSegmentJSONRequestBody defines body for NetworkSegmentServiceCreateNetworkSegment for application/json ContentType.
+type NetworkSegmentServiceCreateNetworkSegmentJSONRequestBody = NetworkSegmentResource
+
+// NetworkSegmentServicePatchNetworkSegmentJSONRequestBody defines body for NetworkSegmentServicePatchNetworkSegment for application/json ContentType.
+type NetworkSegmentServicePatchNetworkSegmentJSONRequestBody = NetworkSegmentResource
+
+// NetworkSegmentServiceUpdateNetworkSegmentJSONRequestBody defines body for NetworkSegmentServiceUpdateNetworkSegment for application/json ContentType.
+type NetworkSegmentServiceUpdateNetworkSegmentJSONRequestBody = NetworkSegmentResource
+
 // OSUpdatePolicyCreateOSUpdatePolicyJSONRequestBody defines body for OSUpdatePolicyCreateOSUpdatePolicy for application/json ContentType.
 type OSUpdatePolicyCreateOSUpdatePolicyJSONRequestBody = OSUpdatePolicy
 
+// OSUpdatePolicyPatchOSUpdatePolicyJSONRequestBody defines body for OSUpdatePolicyPatchOSUpdatePolicy for application/json ContentType.
+type OSUpdatePolicyPatchOSUpdatePolicyJSONRequestBody = OSUpdatePolicy
+
+// OSUpdatePolicyUpdateOSUpdatePolicyJSONRequestBody defines body for OSUpdatePolicyUpdateOSUpdatePolicy for application/json ContentType.
+type OSUpdatePolicyUpdateOSUpdatePolicyJSONRequestBody = OSUpdatePolicy
+
 // OSUpdatePolicyCreateOSUpdatePolicy3JSONRequestBody defines body for OSUpdatePolicyCreateOSUpdatePolicy3 for application/json ContentType.
 type OSUpdatePolicyCreateOSUpdatePolicy3JSONRequestBody = OSUpdatePolicy
 
+// OSUpdatePolicyPatchOSUpdatePolicy3JSONRequestBody defines body for OSUpdatePolicyPatchOSUpdatePolicy3 for application/json ContentType.
+type OSUpdatePolicyPatchOSUpdatePolicy3JSONRequestBody = OSUpdatePolicy
+
+// OSUpdatePolicyUpdateOSUpdatePolicy3JSONRequestBody defines body for OSUpdatePolicyUpdateOSUpdatePolicy3 for application/json ContentType.
+type OSUpdatePolicyUpdateOSUpdatePolicy3JSONRequestBody = OSUpdatePolicy
+
+// OuServiceCreateOuJSONRequestBody defines body for OuServiceCreateOu for application/json ContentType.
+type OuServiceCreateOuJSONRequestBody = OuResource
+
+// OuServicePatchOuJSONRequestBody defines body for OuServicePatchOu for application/json ContentType.
+type OuServicePatchOuJSONRequestBody = OuResource
+
+// OuServiceUpdateOuJSONRequestBody defines body for OuServiceUpdateOu for application/json ContentType.
+type OuServiceUpdateOuJSONRequestBody = OuResource
+
 // ProviderServiceCreateProviderJSONRequestBody defines body for ProviderServiceCreateProvider for application/json ContentType.
 type ProviderServiceCreateProviderJSONRequestBody = ProviderResource
 
+// ProviderServicePatchProviderJSONRequestBody defines body for ProviderServicePatchProvider for application/json ContentType.
+type ProviderServicePatchProviderJSONRequestBody = ProviderResource
+
+// ProviderServiceUpdateProviderJSONRequestBody defines body for ProviderServiceUpdateProvider for application/json ContentType.
+type ProviderServiceUpdateProviderJSONRequestBody = ProviderResource
+
 // RegionServiceCreateRegionJSONRequestBody defines body for RegionServiceCreateRegion for application/json ContentType.
 type RegionServiceCreateRegionJSONRequestBody = RegionResource
 
@@ -7683,6 +12969,15 @@ type RegionServicePatchRegionJSONRequestBody = RegionResource
 // RegionServiceUpdateRegionJSONRequestBody defines body for RegionServiceUpdateRegion for application/json ContentType.
 type RegionServiceUpdateRegionJSONRequestBody = RegionResource
 
+// RemoteAccessServiceCreateRemoteAccessJSONRequestBody defines body for RemoteAccessServiceCreateRemoteAccess for application/json ContentType.
+type RemoteAccessServiceCreateRemoteAccessJSONRequestBody = RemoteAccessResource
+
+// RoleBindingServiceCreateRoleBindingJSONRequestBody defines body for RoleBindingServiceCreateRoleBinding for application/json ContentType.
+type RoleBindingServiceCreateRoleBindingJSONRequestBody = RoleBindingResource
+
+// RoleBindingServiceUpdateRoleBindingJSONRequestBody defines body for RoleBindingServiceUpdateRoleBinding for application/json ContentType.
+type RoleBindingServiceUpdateRoleBindingJSONRequestBody = RoleBindingResource
+
 // ScheduleServiceCreateRepeatedScheduleJSONRequestBody defines body for ScheduleServiceCreateRepeatedSchedule for application/json ContentType.
 type ScheduleServiceCreateRepeatedScheduleJSONRequestBody = RepeatedScheduleResource
 
@@ -7751,3 +13046,267 @@ type WorkloadServicePatchWorkload3JSONRequestBody = WorkloadResource
 
 // WorkloadServiceUpdateWorkload3JSONRequestBody defines body for WorkloadServiceUpdateWorkload3 for application/json ContentType.
 type WorkloadServiceUpdateWorkload3JSONRequestBody = WorkloadResource
+
+// AsWatchResourcesResponse0 returns the union data inside the WatchResourcesResponse as a WatchResourcesResponse0
+func (t WatchResourcesResponse) AsWatchResourcesResponse0() (WatchResourcesResponse0, error) {
+	var body WatchResourcesResponse0
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromWatchResourcesResponse0 overwrites any union data inside the WatchResourcesResponse as the provided WatchResourcesResponse0
+func (t *WatchResourcesResponse) FromWatchResourcesResponse0(v WatchResourcesResponse0) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeWatchResourcesResponse0 performs a merge with any union data inside the WatchResourcesResponse, using the provided WatchResourcesResponse0
+func (t *WatchResourcesResponse) MergeWatchResourcesResponse0(v WatchResourcesResponse0) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsWatchResourcesResponse1 returns the union data inside the WatchResourcesResponse as a WatchResourcesResponse1
+func (t WatchResourcesResponse) AsWatchResourcesResponse1() (WatchResourcesResponse1, error) {
+	var body WatchResourcesResponse1
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromWatchResourcesResponse1 overwrites any union data inside the WatchResourcesResponse as the provided WatchResourcesResponse1
+func (t *WatchResourcesResponse) FromWatchResourcesResponse1(v WatchResourcesResponse1) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeWatchResourcesResponse1 performs a merge with any union data inside the WatchResourcesResponse, using the provided WatchResourcesResponse1
+func (t *WatchResourcesResponse) MergeWatchResourcesResponse1(v WatchResourcesResponse1) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t WatchResourcesResponse) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *WatchResourcesResponse) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
+// AsWatchResourcesResponseEvent0 returns the union data inside the WatchResourcesResponseEvent as a WatchResourcesResponseEvent0
+func (t WatchResourcesResponseEvent) AsWatchResourcesResponseEvent0() (WatchResourcesResponseEvent0, error) {
+	var body WatchResourcesResponseEvent0
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromWatchResourcesResponseEvent0 overwrites any union data inside the WatchResourcesResponseEvent as the provided WatchResourcesResponseEvent0
+func (t *WatchResourcesResponseEvent) FromWatchResourcesResponseEvent0(v WatchResourcesResponseEvent0) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeWatchResourcesResponseEvent0 performs a merge with any union data inside the WatchResourcesResponseEvent, using the provided WatchResourcesResponseEvent0
+func (t *WatchResourcesResponseEvent) MergeWatchResourcesResponseEvent0(v WatchResourcesResponseEvent0) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsWatchResourcesResponseEvent1 returns the union data inside the WatchResourcesResponseEvent as a WatchResourcesResponseEvent1
+func (t WatchResourcesResponseEvent) AsWatchResourcesResponseEvent1() (WatchResourcesResponseEvent1, error) {
+	var body WatchResourcesResponseEvent1
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromWatchResourcesResponseEvent1 overwrites any union data inside the WatchResourcesResponseEvent as the provided WatchResourcesResponseEvent1
+func (t *WatchResourcesResponseEvent) FromWatchResourcesResponseEvent1(v WatchResourcesResponseEvent1) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeWatchResourcesResponseEvent1 performs a merge with any union data inside the WatchResourcesResponseEvent, using the provided WatchResourcesResponseEvent1
+func (t *WatchResourcesResponseEvent) MergeWatchResourcesResponseEvent1(v WatchResourcesResponseEvent1) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsWatchResourcesResponseEvent2 returns the union data inside the WatchResourcesResponseEvent as a WatchResourcesResponseEvent2
+func (t WatchResourcesResponseEvent) AsWatchResourcesResponseEvent2() (WatchResourcesResponseEvent2, error) {
+	var body WatchResourcesResponseEvent2
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromWatchResourcesResponseEvent2 overwrites any union data inside the WatchResourcesResponseEvent as the provided WatchResourcesResponseEvent2
+func (t *WatchResourcesResponseEvent) FromWatchResourcesResponseEvent2(v WatchResourcesResponseEvent2) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeWatchResourcesResponseEvent2 performs a merge with any union data inside the WatchResourcesResponseEvent, using the provided WatchResourcesResponseEvent2
+func (t *WatchResourcesResponseEvent) MergeWatchResourcesResponseEvent2(v WatchResourcesResponseEvent2) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsWatchResourcesResponseEvent3 returns the union data inside the WatchResourcesResponseEvent as a WatchResourcesResponseEvent3
+func (t WatchResourcesResponseEvent) AsWatchResourcesResponseEvent3() (WatchResourcesResponseEvent3, error) {
+	var body WatchResourcesResponseEvent3
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromWatchResourcesResponseEvent3 overwrites any union data inside the WatchResourcesResponseEvent as the provided WatchResourcesResponseEvent3
+func (t *WatchResourcesResponseEvent) FromWatchResourcesResponseEvent3(v WatchResourcesResponseEvent3) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeWatchResourcesResponseEvent3 performs a merge with any union data inside the WatchResourcesResponseEvent, using the provided WatchResourcesResponseEvent3
+func (t *WatchResourcesResponseEvent) MergeWatchResourcesResponseEvent3(v WatchResourcesResponseEvent3) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsWatchResourcesResponseEvent4 returns the union data inside the WatchResourcesResponseEvent as a WatchResourcesResponseEvent4
+func (t WatchResourcesResponseEvent) AsWatchResourcesResponseEvent4() (WatchResourcesResponseEvent4, error) {
+	var body WatchResourcesResponseEvent4
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromWatchResourcesResponseEvent4 overwrites any union data inside the WatchResourcesResponseEvent as the provided WatchResourcesResponseEvent4
+func (t *WatchResourcesResponseEvent) FromWatchResourcesResponseEvent4(v WatchResourcesResponseEvent4) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeWatchResourcesResponseEvent4 performs a merge with any union data inside the WatchResourcesResponseEvent, using the provided WatchResourcesResponseEvent4
+func (t *WatchResourcesResponseEvent) MergeWatchResourcesResponseEvent4(v WatchResourcesResponseEvent4) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t WatchResourcesResponseEvent) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	object := make(map[string]json.RawMessage)
+	if t.union != nil {
+		err = json.Unmarshal(b, &object)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if t.Kind != nil {
+		object["kind"], err = json.Marshal(t.Kind)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'kind': %w", err)
+		}
+	}
+
+	if t.ResourceId != nil {
+		object["resourceId"], err = json.Marshal(t.ResourceId)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'resourceId': %w", err)
+		}
+	}
+
+	if t.ResourceKind != nil {
+		object["resourceKind"], err = json.Marshal(t.ResourceKind)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'resourceKind': %w", err)
+		}
+	}
+	b, err = json.Marshal(object)
+	return b, err
+}
+
+func (t *WatchResourcesResponseEvent) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	if err != nil {
+		return err
+	}
+	object := make(map[string]json.RawMessage)
+	err = json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if raw, found := object["kind"]; found {
+		err = json.Unmarshal(raw, &t.Kind)
+		if err != nil {
+			return fmt.Errorf("error reading 'kind': %w", err)
+		}
+	}
+
+	if raw, found := object["resourceId"]; found {
+		err = json.Unmarshal(raw, &t.ResourceId)
+		if err != nil {
+			return fmt.Errorf("error reading 'resourceId': %w", err)
+		}
+	}
+
+	if raw, found := object["resourceKind"]; found {
+		err = json.Unmarshal(raw, &t.ResourceKind)
+		if err != nil {
+			return fmt.Errorf("error reading 'resourceKind': %w", err)
+		}
+	}
+
+	return err
+}
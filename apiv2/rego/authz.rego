@@ -15,14 +15,24 @@ hasWriteAccess if {
     [read_write_role][_] == role
 }
 
-# Parses the input tenantid as a prefix in the read and read-write roles.
+# The scoped role grants read and write access to the locations of the role
+# bindings of the principal only. It is let through by the gateway, the
+# inventory restricts the request to the scope of the principal.
+hasWriteAccess if {
+    scoped_role := sprintf("%s_im-scoped", [input.tenantid[0]])
+    some role in input["realm_access/roles"] # iteration
+    [scoped_role][_] == role
+}
+
+# Parses the input tenantid as a prefix in the read, read-write and scoped roles.
 # Iterates over the input roles, and for every expected roles,
 # makes sure there is some role matching it.
 # It supports only roles with tenantID prefix.
 hasReadAccess if {
     read_role := sprintf("%s_im-r", [input.tenantid[0]])
     read_write_role := sprintf("%s_im-rw", [input.tenantid[0]])
+    scoped_role := sprintf("%s_im-scoped", [input.tenantid[0]])
     some role in input["realm_access/roles"] # iteration
-    [read_role, read_write_role][_] == role
+    [read_role, read_write_role, scoped_role][_] == role
 }
 
@@ -493,6 +493,7 @@ func DeleteOS(
 		ctx,
 		projectName,
 		osID,
+		nil,
 		AddJWTtoTheHeader, AddProjectIDtoTheHeader,
 	)
 	if err != nil {
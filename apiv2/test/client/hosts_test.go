@@ -47,6 +47,7 @@ func TestHostCustom(t *testing.T) {
 		ctx,
 		projectName,
 		*h1.JSON200.ResourceId,
+		nil,
 		AddJWTtoTheHeader, AddProjectIDtoTheHeader,
 	)
 	require.NoError(t, err)
@@ -92,6 +93,7 @@ func TestHostCustom(t *testing.T) {
 		ctx,
 		projectName,
 		*h1.JSON200.ResourceId,
+		nil,
 		AddJWTtoTheHeader, AddProjectIDtoTheHeader,
 	)
 	require.NoError(t, err)
@@ -125,6 +127,7 @@ func TestHostCustom(t *testing.T) {
 		ctx,
 		projectName,
 		*h1.JSON200.ResourceId,
+		nil,
 		AddJWTtoTheHeader, AddProjectIDtoTheHeader,
 	)
 	require.NoError(t, err)
@@ -152,6 +155,7 @@ func TestHostCustom(t *testing.T) {
 		ctx,
 		projectName,
 		*h1.JSON200.ResourceId,
+		nil,
 		AddJWTtoTheHeader,
 		AddProjectIDtoTheHeader,
 	)
@@ -177,6 +181,7 @@ func TestHostCustom(t *testing.T) {
 		ctx,
 		projectName,
 		*h1.JSON200.ResourceId,
+		nil,
 		AddJWTtoTheHeader,
 		AddProjectIDtoTheHeader,
 	)
@@ -277,6 +282,7 @@ func TestHostSites(t *testing.T) {
 		ctx,
 		projectName,
 		*h1.JSON200.ResourceId,
+		nil,
 		AddJWTtoTheHeader, AddProjectIDtoTheHeader,
 	)
 	require.NoError(t, err)
@@ -303,6 +309,7 @@ func TestHostErrors(t *testing.T) {
 			ctx,
 			projectName,
 			utils.HostUnexistID,
+			nil,
 			AddJWTtoTheHeader, AddProjectIDtoTheHeader,
 		)
 		require.NoError(t, err)
@@ -337,6 +344,7 @@ func TestHostErrors(t *testing.T) {
 			ctx,
 			projectName,
 			utils.HostWrongID,
+			nil,
 			AddJWTtoTheHeader, AddProjectIDtoTheHeader,
 		)
 		require.NoError(t, err)
@@ -742,6 +750,7 @@ func TestHostInvalidate(t *testing.T) {
 		ctx,
 		projectName,
 		*h4.JSON200.ResourceId,
+		nil,
 		AddJWTtoTheHeader, AddProjectIDtoTheHeader,
 	)
 	require.NoError(t, err)
@@ -765,6 +774,7 @@ func TestHostInvalidate(t *testing.T) {
 		ctx,
 		projectName,
 		*h4.JSON200.ResourceId,
+		nil,
 		AddJWTtoTheHeader, AddProjectIDtoTheHeader,
 	)
 
@@ -795,6 +805,7 @@ func loadZTPTest(ctx context.Context, t *testing.T, apiClient *api.ClientWithRes
 		ctx,
 		projectName,
 		*hos.JSON200.ResourceId,
+		nil,
 		AddJWTtoTheHeader, AddProjectIDtoTheHeader,
 	)
 	require.NoError(t, err)
@@ -848,6 +859,7 @@ func TestHostZTPWithPut(t *testing.T) {
 		ctx,
 		projectName,
 		*h4.JSON200.ResourceId,
+		nil,
 		AddJWTtoTheHeader, AddProjectIDtoTheHeader,
 	)
 	require.NoError(t, err)
@@ -882,6 +894,7 @@ func TestHostsSummary(t *testing.T) {
 		ctx,
 		projectName,
 		*h1.JSON200.ResourceId,
+		nil,
 		AddJWTtoTheHeader, AddProjectIDtoTheHeader,
 	)
 
@@ -950,6 +963,7 @@ func TestHostRegister(t *testing.T) {
 		ctx,
 		projectName,
 		*registeredHost1.JSON200.ResourceId,
+		nil,
 		AddJWTtoTheHeader, AddProjectIDtoTheHeader,
 	)
 	require.NoError(t, err)
@@ -974,6 +988,7 @@ func TestHostRegister(t *testing.T) {
 		ctx,
 		projectName,
 		*registeredHost1.JSON200.ResourceId,
+		nil,
 		AddJWTtoTheHeader, AddProjectIDtoTheHeader,
 	)
 	require.NoError(t, err)
@@ -999,6 +1014,7 @@ func TestHostRegister(t *testing.T) {
 		ctx,
 		projectName,
 		*registeredHost1.JSON200.ResourceId,
+		nil,
 		AddJWTtoTheHeader, AddProjectIDtoTheHeader,
 	)
 	require.NoError(t, err)
@@ -1037,6 +1053,7 @@ func TestHostRegister(t *testing.T) {
 		ctx,
 		projectName,
 		*registeredHost2.JSON200.ResourceId,
+		nil,
 		AddJWTtoTheHeader, AddProjectIDtoTheHeader,
 	)
 	require.NoError(t, err)
@@ -1141,6 +1158,7 @@ func TestHostOnboard(t *testing.T) {
 		ctx,
 		projectName,
 		*registeredHost.JSON200.ResourceId,
+		nil,
 		AddJWTtoTheHeader, AddProjectIDtoTheHeader,
 	)
 	require.NoError(t, err)
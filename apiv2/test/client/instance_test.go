@@ -58,6 +58,7 @@ func TestInstance_CreateGetDelete(t *testing.T) {
 		ctx,
 		projectName,
 		*inst1.JSON200.ResourceId,
+		nil,
 		AddJWTtoTheHeader, AddProjectIDtoTheHeader,
 	)
 	require.NoError(t, err)
@@ -69,6 +70,7 @@ func TestInstance_CreateGetDelete(t *testing.T) {
 		ctx,
 		projectName,
 		*inst2.JSON200.ResourceId,
+		nil,
 		AddJWTtoTheHeader, AddProjectIDtoTheHeader,
 	)
 	require.NoError(t, err)
@@ -118,7 +120,7 @@ func TestInstance_Update(t *testing.T) {
 
 	inst1Get, err := apiClient.InstanceServiceGetInstanceWithResponse(ctx,
 		projectName,
-		*inst1.JSON200.ResourceId, AddJWTtoTheHeader, AddProjectIDtoTheHeader)
+		*inst1.JSON200.ResourceId, nil, AddJWTtoTheHeader, AddProjectIDtoTheHeader)
 	require.NoError(t, err)
 	assert.Equal(t, http.StatusOK, inst1Get.StatusCode())
 	assert.Equal(t, newName, *inst1Get.JSON200.Name)
@@ -182,6 +184,7 @@ func TestInstance_Errors(t *testing.T) {
 			ctx,
 			projectName,
 			utils.InstanceUnexistID,
+			nil,
 			AddJWTtoTheHeader, AddProjectIDtoTheHeader,
 		)
 		require.NoError(t, err)
@@ -204,6 +207,7 @@ func TestInstance_Errors(t *testing.T) {
 			ctx,
 			projectName,
 			utils.InstanceWrongID,
+			nil,
 			AddJWTtoTheHeader, AddProjectIDtoTheHeader,
 		)
 		require.NoError(t, err)
@@ -551,6 +555,7 @@ func TestInstanceInvalidate(t *testing.T) {
 		ctx,
 		projectName,
 		*inst1.JSON200.ResourceId,
+		nil,
 		AddJWTtoTheHeader, AddProjectIDtoTheHeader,
 	)
 	require.NoError(t, err)
@@ -580,6 +585,7 @@ func TestInstanceInvalidate(t *testing.T) {
 		ctx,
 		projectName,
 		*inst1.JSON200.ResourceId,
+		nil,
 		AddJWTtoTheHeader, AddProjectIDtoTheHeader,
 	)
 	require.NoError(t, err)
@@ -622,6 +628,7 @@ func TestInstance_CreateWithOSUpdatePolicy(t *testing.T) {
 		ctx,
 		projectName,
 		*inst1.JSON200.ResourceId,
+		nil,
 		AddJWTtoTheHeader, AddProjectIDtoTheHeader,
 	)
 	require.NoError(t, err)
@@ -49,6 +49,7 @@ func TestOS_CreateGetDelete(t *testing.T) {
 		ctx,
 		projectName,
 		*os1.JSON200.ResourceId,
+		nil,
 		AddJWTtoTheHeader, AddProjectIDtoTheHeader,
 	)
 	require.NoError(t, err)
@@ -59,6 +60,7 @@ func TestOS_CreateGetDelete(t *testing.T) {
 		ctx,
 		projectName,
 		*os2.JSON200.ResourceId,
+		nil,
 		AddJWTtoTheHeader, AddProjectIDtoTheHeader,
 	)
 	require.NoError(t, err)
@@ -86,6 +88,7 @@ func TestOS_UpdatePut(t *testing.T) {
 		ctx,
 		projectName,
 		*os1.JSON200.ResourceId,
+		nil,
 		AddJWTtoTheHeader, AddProjectIDtoTheHeader,
 	)
 	require.NoError(t, err)
@@ -115,6 +118,7 @@ func TestOS_UpdatePut(t *testing.T) {
 		ctx,
 		projectName,
 		*os1.JSON200.ResourceId,
+		nil,
 		AddJWTtoTheHeader, AddProjectIDtoTheHeader,
 	)
 	require.NoError(t, err)
@@ -171,6 +175,7 @@ func TestOS_Errors(t *testing.T) {
 			ctx,
 			projectName,
 			utils.OSResourceUnexistID,
+			nil,
 			AddJWTtoTheHeader, AddProjectIDtoTheHeader,
 		)
 		require.NoError(t, err)
@@ -205,6 +210,7 @@ func TestOS_Errors(t *testing.T) {
 			ctx,
 			projectName,
 			utils.OSResourceWrongID,
+			nil,
 			AddJWTtoTheHeader, AddProjectIDtoTheHeader,
 		)
 		require.NoError(t, err)
@@ -298,6 +304,7 @@ func TestOS_CreatewithInstallPackage(t *testing.T) {
 		ctx,
 		projectName,
 		*os.JSON200.ResourceId,
+		nil,
 		AddJWTtoTheHeader, AddProjectIDtoTheHeader,
 	)
 	require.NoError(t, err)
@@ -391,6 +398,7 @@ func TestOS_CreatewithCustom(t *testing.T) {
 		ctx,
 		projectName,
 		*os.JSON200.ResourceId,
+		nil,
 		AddJWTtoTheHeader, AddProjectIDtoTheHeader,
 	)
 	require.NoError(t, err)
@@ -414,6 +422,7 @@ func TestOS_UpdatePatch(t *testing.T) {
 		ctx,
 		projectName,
 		*os1.JSON200.OsResourceID,
+		nil,
 		AddJWTtoTheHeader,
 		AddProjectIDtoTheHeader,
 	)
@@ -446,6 +455,7 @@ func TestOS_UpdatePatch(t *testing.T) {
 		ctx,
 		projectName,
 		*os1.JSON200.OsResourceID,
+		nil,
 		AddJWTtoTheHeader,
 		AddProjectIDtoTheHeader,
 	)
@@ -0,0 +1,65 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"crypto/ed25519"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/auditing"
+)
+
+var (
+	auditLogPath  = flag.String("auditLog", "-", "Path to the exported audit log, - for the standard input")
+	publicKeyPath = flag.String("publicKeyPath", "",
+		"Path to the PEM Ed25519 public key verifying the seals of the audit chains. Seals are not verified if empty")
+	sealInterval = flag.Duration("sealInterval", auditing.DefaultSealInterval,
+		"Interval at which the component seals the audit chains. Older records must be sealed, if seals are verified")
+)
+
+// This program verifies an audit log exported from the logs of a component: it reports the audit records that were
+// modified, removed or inserted, and the seals that are invalid. It exits with status 1 if any is found.
+func main() {
+	flag.Parse()
+
+	var key ed25519.PublicKey
+	if *publicKeyPath != "" {
+		var err error
+		if key, err = auditing.LoadVerificationKey(*publicKeyPath); err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to load the public key: %v\n", err)
+			os.Exit(2)
+		}
+	}
+
+	var in io.Reader = os.Stdin
+	if *auditLogPath != "-" {
+		file, err := os.Open(*auditLogPath)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to open the audit log: %v\n", err)
+			os.Exit(2)
+		}
+		defer file.Close()
+		in = file
+	}
+
+	report, err := auditing.Verify(in, key, *sealInterval)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to read the audit log: %v\n", err)
+		os.Exit(2)
+	}
+	for _, issue := range report.Issues {
+		fmt.Println(issue)
+	}
+	fmt.Printf("%d records in %d chains, %d seals, %d records not sealed yet, %d issues\n",
+		report.Records, report.Chains, report.Seals, report.Unsealed, len(report.Issues))
+	if key == nil {
+		fmt.Println("Seals were not verified, no public key given")
+	}
+	if len(report.Issues) > 0 {
+		os.Exit(1)
+	}
+}
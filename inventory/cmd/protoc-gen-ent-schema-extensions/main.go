@@ -115,8 +115,13 @@ func applyExtensions(schemaContext *schemast.Context, extensions map[string][]*i
 			if si.GetName() != "" {
 				idx = idx.StorageKey(si.GetName())
 			}
-			if si.GetPartialIndexCondition() != "" {
-				idx.Annotations(entsql.IndexWhere(si.GetPartialIndexCondition()))
+			annot := &entsql.IndexAnnotation{
+				Where:   si.GetPartialIndexCondition(),
+				Type:    si.GetType(),
+				OpClass: si.GetOpClass(),
+			}
+			if annot.Where != "" || annot.Type != "" || annot.OpClass != "" {
+				idx.Annotations(annot)
 			}
 			if err := AppendIndex(schemaContext, name, idx); err != nil {
 				log.Fatal().Msgf("cannot append index for %s: %v", name, err)
@@ -309,6 +314,12 @@ func entSQLIndexes(annot schema.Annotation) (ast.Expr, bool, error) {
 	if m.Where != "" {
 		c.Elts = append(c.Elts, structAttr("Where", strLit(m.Where)))
 	}
+	if m.Type != "" {
+		c.Elts = append(c.Elts, structAttr("Type", strLit(m.Type)))
+	}
+	if m.OpClass != "" {
+		c.Elts = append(c.Elts, structAttr("OpClass", strLit(m.OpClass)))
+	}
 	return c, true, nil
 }
 
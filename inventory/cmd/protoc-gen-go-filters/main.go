@@ -46,7 +46,7 @@ var (
 
 	// Generator skips intentionally .proto definitions listed below.
 	// None of them contain inventory resource requiring transpilers generation.
-	excludedProtoPackages = []string{"inventory.v1", "status.v1", "ent", "errors", "infrainv"}
+	excludedProtoPackages = []string{"inventory.v1", "status.v1", "label.v1", "ent", "errors", "infrainv"}
 )
 
 func main() {
@@ -21,6 +21,7 @@ import (
 	_ "github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/runtime"
 	"github.com/open-edge-platform/infra-core/inventory/v2/internal/server"
 	"github.com/open-edge-platform/infra-core/inventory/v2/internal/utils/migrate"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/auditing"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/client"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/flags"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/logging"
@@ -42,18 +43,23 @@ var (
 		migrations.MigrationsDirDescription+
 			"Try './internal/ent/migrate/migrations' when running locally.",
 	)
-	oamservaddr    = flag.String(oam.OamServerAddress, "", oam.OamServerAddressDescription)
-	enableTracing  = flag.Bool(tracing.EnableTracing, false, tracing.EnableTracingDescription)
-	traceURL       = flag.String(tracing.TraceURL, "", tracing.TraceURLDescription)
-	policyBundle   = flag.String(policy.PolicyBundlePath, "/rego/policy_bundle.tar.gz", policy.PolicyBundlePathDescription)
-	insecureGrpc   = flag.Bool(client.InsecureGrpc, true, client.InsecureGrpcDescription)
-	caCertPath     = flag.String(client.CaCertPath, "", client.CaCertPathDescription)
-	tlsCertPath    = flag.String(client.TLSCertPath, "", client.TLSCertPathDescription)
-	tlsKeyPath     = flag.String(client.TLSKeyPath, "", client.TLSKeyPathDescription)
-	enableAuth     = flag.Bool(rbac.EnableAuth, false, rbac.EnableAuthDescription)
-	enableMetrics  = flag.Bool(metrics.EnableMetrics, false, metrics.EnableMetricsDescription)
-	metricsAddress = flag.String(metrics.MetricsAddress, metrics.MetricsAddressDefault, metrics.MetricsAddressDescription)
-	enableAuditing = flag.Bool(flags.EnableAuditing, false, flags.EnableAuditingDescription)
+	oamservaddr           = flag.String(oam.OamServerAddress, "", oam.OamServerAddressDescription)
+	enableTracing         = flag.Bool(tracing.EnableTracing, false, tracing.EnableTracingDescription)
+	traceURL              = flag.String(tracing.TraceURL, "", tracing.TraceURLDescription)
+	policyBundle          = flag.String(policy.PolicyBundlePath, "/rego/policy_bundle.tar.gz", policy.PolicyBundlePathDescription)
+	insecureGrpc          = flag.Bool(client.InsecureGrpc, true, client.InsecureGrpcDescription)
+	caCertPath            = flag.String(client.CaCertPath, "", client.CaCertPathDescription)
+	tlsCertPath           = flag.String(client.TLSCertPath, "", client.TLSCertPathDescription)
+	tlsKeyPath            = flag.String(client.TLSKeyPath, "", client.TLSKeyPathDescription)
+	enableAuth            = flag.Bool(rbac.EnableAuth, false, rbac.EnableAuthDescription)
+	enableMetrics         = flag.Bool(metrics.EnableMetrics, false, metrics.EnableMetricsDescription)
+	metricsAddress        = flag.String(metrics.MetricsAddress, metrics.MetricsAddressDefault, metrics.MetricsAddressDescription)
+	enableAuditing        = flag.Bool(flags.EnableAuditing, false, flags.EnableAuditingDescription)
+	auditSigningKeyPath   = flag.String(flags.AuditSigningKeyPath, "", flags.AuditSigningKeyPathDescription)
+	auditSealInterval     = flag.Duration(flags.AuditSealInterval, auditing.DefaultSealInterval, flags.AuditSealIntervalDescription)
+	auditHeadPath         = flag.String(flags.AuditHeadPath, "", flags.AuditHeadPathDescription)
+	enforceClientIdentity = flag.Bool(flags.EnforceClientIdentity, false, flags.EnforceClientIdentityDescription)
+	validateSecretRefs    = flag.Bool(flags.ValidateSecretRefs, true, flags.ValidateSecretRefsDescription)
 )
 
 var (
@@ -182,14 +188,19 @@ func main() {
 
 func getOpts() server.Options {
 	return server.Options{
-		EnableTracing:  *enableTracing,
-		EnableAuth:     *enableAuth,
-		InsecureGrpc:   *insecureGrpc,
-		EnableMetrics:  *enableMetrics,
-		MetricsAddress: *metricsAddress,
-		CaCertPath:     *caCertPath,
-		TLSCertPath:    *tlsCertPath,
-		TLSKeyPath:     *tlsKeyPath,
-		EnableAuditing: *enableAuditing,
+		EnableTracing:         *enableTracing,
+		EnableAuth:            *enableAuth,
+		InsecureGrpc:          *insecureGrpc,
+		EnableMetrics:         *enableMetrics,
+		MetricsAddress:        *metricsAddress,
+		CaCertPath:            *caCertPath,
+		TLSCertPath:           *tlsCertPath,
+		TLSKeyPath:            *tlsKeyPath,
+		EnableAuditing:        *enableAuditing,
+		AuditSigningKeyPath:   *auditSigningKeyPath,
+		AuditSealInterval:     *auditSealInterval,
+		AuditHeadPath:         *auditHeadPath,
+		EnforceClientIdentity: *enforceClientIdentity,
+		ValidateSecretRefs:    *validateSecretRefs,
 	}
 }
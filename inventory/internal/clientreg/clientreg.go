@@ -22,9 +22,11 @@ import (
 var zlog = logging.GetLogger("InfraInvClientReg")
 
 type ClientInfo struct {
-	Name          string
-	Version       string
-	ClientKind    inv_v1.ClientKind
+	Name       string
+	Version    string
+	ClientKind inv_v1.ClientKind
+	// Identity is the verified identity of the client, see IdentifyClient.
+	Identity      Identity
 	ResourceKinds []inv_v1.ResourceKind
 	Stream        inv_v1.InventoryService_SubscribeEventsServer
 }
@@ -64,7 +66,8 @@ func (cr *ClientReg) RegisterClient(clientInfo ClientInfo) (string, error) {
 	// generate UUID
 	clientUUID := uuid.New().String()
 
-	zlog.InfraSec().Info().Msgf("RegisterClient %s", clientUUID)
+	zlog.InfraAuditEvent().InfraAuditOperation("RegisterClient").InfraAuditUsr(clientInfo.Identity.String()).Info().
+		Msgf("RegisterClient %s as %s", clientUUID, clientInfo.ClientKind)
 	cr.regClients.Store(clientUUID, clientInfo)
 
 	return clientUUID, nil
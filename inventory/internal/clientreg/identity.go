@@ -0,0 +1,146 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package clientreg
+
+import (
+	"context"
+	"crypto/x509"
+	"strings"
+
+	"github.com/grpc-ecosystem/go-grpc-middleware/util/metautils"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+
+	inv_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/inventory/v1"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
+)
+
+const (
+	// ClientKindURIPrefix prefixes the URI SAN naming the client kind of a client certificate, e.g.
+	// urn:infra-inventory:client-kind:CLIENT_KIND_TENANT_CONTROLLER.
+	ClientKindURIPrefix = "urn:infra-inventory:client-kind:"
+	// ClientKindClaimKey is the key of the JWT claim naming the client kind of a service account, i.e. the nested
+	// claim {"infra_inventory": {"client_kind": "CLIENT_KIND_TENANT_CONTROLLER"}}. Nested claims are flattened with a
+	// "/" that is not allowed in gRPC metadata keys, so clients cannot set it without a valid JWT.
+	ClientKindClaimKey = "infra_inventory/client_kind"
+	subjectClaimKey    = "sub"
+
+	IdentitySourceCertificate = "certificate"
+	IdentitySourceJWT         = "jwt"
+	IdentitySourceNone        = "none"
+)
+
+// RegistrationMetrics counts the client registrations by declared client kind, identity source and result.
+var RegistrationMetrics = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Name: "inventory_client_registrations_total",
+	Help: "Client registrations by declared client kind, identity source and result.",
+}, []string{"client_kind", "identity_source", "result"})
+
+// Identity is the identity of a client, verified by the CA of its certificate or the issuer of its JWT.
+type Identity struct {
+	// Name is the common name of the certificate or the subject of the JWT.
+	Name string
+	// Source is where the identity comes from, one of the IdentitySource constants.
+	Source string
+	// ClientKind is the client kind the identity is granted.
+	ClientKind inv_v1.ClientKind
+}
+
+// String returns the identity as written in logs.
+func (id Identity) String() string {
+	if id.Source == IdentitySourceNone {
+		return IdentitySourceNone
+	}
+	return id.Source + ":" + id.Name
+}
+
+// IdentifyClient returns the identity of the client of the call: from the URI SAN of its client certificate if it was
+// verified during the TLS handshake, else from the claim of its JWT. The identity source is IdentitySourceNone if
+// neither names a client kind.
+func IdentifyClient(ctx context.Context) (Identity, error) {
+	if p, ok := peer.FromContext(ctx); ok {
+		if tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo); ok &&
+			len(tlsInfo.State.VerifiedChains) > 0 && len(tlsInfo.State.PeerCertificates) > 0 {
+			id, found, err := identityFromCertificate(tlsInfo.State.PeerCertificates[0])
+			if err != nil || found {
+				return id, err
+			}
+		}
+	}
+
+	md := metautils.ExtractIncoming(ctx)
+	if kind := md.Get(ClientKindClaimKey); kind != "" {
+		clientKind, err := parseClientKind(kind)
+		if err != nil {
+			return Identity{}, err
+		}
+		return Identity{Name: md.Get(subjectClaimKey), Source: IdentitySourceJWT, ClientKind: clientKind}, nil
+	}
+	return Identity{Source: IdentitySourceNone}, nil
+}
+
+func identityFromCertificate(cert *x509.Certificate) (Identity, bool, error) {
+	id := Identity{Name: cert.Subject.CommonName, Source: IdentitySourceCertificate}
+	if id.Name == "" && len(cert.DNSNames) > 0 {
+		id.Name = cert.DNSNames[0]
+	}
+	found := false
+	for _, uri := range cert.URIs {
+		kind, ok := strings.CutPrefix(uri.String(), ClientKindURIPrefix)
+		if !ok {
+			continue
+		}
+		clientKind, err := parseClientKind(kind)
+		if err != nil {
+			return Identity{}, false, err
+		}
+		if found && clientKind != id.ClientKind {
+			zlog.InfraSec().InfraError("certificate of %s names several client kinds", id.Name).Msg("")
+			return Identity{}, false, errors.Errorfc(codes.PermissionDenied,
+				"certificate of %s names several client kinds", id.Name)
+		}
+		id.ClientKind, found = clientKind, true
+	}
+	return id, found, nil
+}
+
+func parseClientKind(kind string) (inv_v1.ClientKind, error) {
+	value, ok := inv_v1.ClientKind_value[kind]
+	if !ok || value == int32(inv_v1.ClientKind_CLIENT_KIND_UNSPECIFIED) {
+		zlog.InfraSec().InfraError("client identity names an invalid client kind: %s", kind).Msg("")
+		return inv_v1.ClientKind_CLIENT_KIND_UNSPECIFIED, errors.Errorfc(codes.PermissionDenied,
+			"client identity names an invalid client kind: %s", kind)
+	}
+	return inv_v1.ClientKind(value), nil
+}
+
+// VerifyClientKind checks the declared client kind against the identity of the client. Mismatches are always
+// rejected; clients without identity are only accepted if enforce is not set.
+func VerifyClientKind(id Identity, declared inv_v1.ClientKind, enforce bool) (err error) {
+	defer func() {
+		result := "accepted"
+		if err != nil {
+			result = "rejected"
+			zlog.InfraAuditEvent().InfraAuditOperation("RegisterClient").InfraAuditUsr(id.String()).
+				InfraAuditError(err).Info().Msgf("Client declaring %s rejected", declared)
+		}
+		RegistrationMetrics.WithLabelValues(declared.String(), id.Source, result).Inc()
+	}()
+	if id.Source == IdentitySourceNone {
+		if enforce {
+			zlog.InfraSec().InfraError("client declaring %s has no verified identity", declared).Msg("")
+			return errors.Errorfc(codes.Unauthenticated, "client declaring %s has no verified identity", declared)
+		}
+		zlog.InfraSec().Warn().Msgf("client declaring %s has no verified identity, accepted", declared)
+		return nil
+	}
+	if id.ClientKind != declared {
+		zlog.InfraSec().InfraError("client %s declares %s but is granted %s", id, declared, id.ClientKind).Msg("")
+		return errors.Errorfc(codes.PermissionDenied,
+			"client %s declares %s but is granted %s", id, declared, id.ClientKind)
+	}
+	return nil
+}
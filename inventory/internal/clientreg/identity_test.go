@@ -0,0 +1,139 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package clientreg_test
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/clientreg"
+	inv_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/inventory/v1"
+)
+
+func peerContext(t *testing.T, verified bool, uris ...string) context.Context {
+	t.Helper()
+	cert := &x509.Certificate{Subject: pkix.Name{CommonName: "tenant-controller"}}
+	for _, uri := range uris {
+		u, err := url.Parse(uri)
+		require.NoError(t, err)
+		cert.URIs = append(cert.URIs, u)
+	}
+	state := tls.ConnectionState{PeerCertificates: []*x509.Certificate{cert}}
+	if verified {
+		state.VerifiedChains = [][]*x509.Certificate{{cert}}
+	}
+	return peer.NewContext(context.Background(), &peer.Peer{AuthInfo: credentials.TLSInfo{State: state}})
+}
+
+//nolint:funlen // length due to test cases
+func TestIdentifyClient(t *testing.T) {
+	tcKindURI := clientreg.ClientKindURIPrefix + inv_v1.ClientKind_CLIENT_KIND_TENANT_CONTROLLER.String()
+	apiKindURI := clientreg.ClientKindURIPrefix + inv_v1.ClientKind_CLIENT_KIND_API.String()
+
+	testcases := map[string]struct {
+		ctx     context.Context
+		source  string
+		name    string
+		kind    inv_v1.ClientKind
+		wantErr bool
+	}{
+		"NoIdentity": {
+			ctx:    context.Background(),
+			source: clientreg.IdentitySourceNone,
+		},
+		"VerifiedCertificate": {
+			ctx:    peerContext(t, true, "spiffe://orch/tenant-controller", tcKindURI),
+			source: clientreg.IdentitySourceCertificate,
+			name:   "tenant-controller",
+			kind:   inv_v1.ClientKind_CLIENT_KIND_TENANT_CONTROLLER,
+		},
+		"UnverifiedCertificate": {
+			ctx:    peerContext(t, false, tcKindURI),
+			source: clientreg.IdentitySourceNone,
+		},
+		"CertificateWithoutClientKind": {
+			ctx:    peerContext(t, true, "spiffe://orch/tenant-controller"),
+			source: clientreg.IdentitySourceNone,
+		},
+		"CertificateWithSeveralClientKinds": {
+			ctx:     peerContext(t, true, tcKindURI, apiKindURI),
+			wantErr: true,
+		},
+		"CertificateWithInvalidClientKind": {
+			ctx:     peerContext(t, true, clientreg.ClientKindURIPrefix+"CLIENT_KIND_UNSPECIFIED"),
+			wantErr: true,
+		},
+		"JWT": {
+			ctx: metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+				clientreg.ClientKindClaimKey, inv_v1.ClientKind_CLIENT_KIND_API.String(), "sub", "apiv2")),
+			source: clientreg.IdentitySourceJWT,
+			name:   "apiv2",
+			kind:   inv_v1.ClientKind_CLIENT_KIND_API,
+		},
+		"JWTWithInvalidClientKind": {
+			ctx: metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+				clientreg.ClientKindClaimKey, "CLIENT_KIND_ROOT")),
+			wantErr: true,
+		},
+	}
+
+	for tcname, tc := range testcases {
+		t.Run(tcname, func(t *testing.T) {
+			id, err := clientreg.IdentifyClient(tc.ctx)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.source, id.Source)
+			assert.Equal(t, tc.name, id.Name)
+			assert.Equal(t, tc.kind, id.ClientKind)
+		})
+	}
+}
+
+func TestVerifyClientKind(t *testing.T) {
+	noIdentity := clientreg.Identity{Source: clientreg.IdentitySourceNone}
+	tcIdentity := clientreg.Identity{
+		Name:       "tenant-controller",
+		Source:     clientreg.IdentitySourceCertificate,
+		ClientKind: inv_v1.ClientKind_CLIENT_KIND_TENANT_CONTROLLER,
+	}
+
+	testcases := map[string]struct {
+		id       clientreg.Identity
+		declared inv_v1.ClientKind
+		enforce  bool
+		valid    bool
+	}{
+		"Match":                  {id: tcIdentity, declared: inv_v1.ClientKind_CLIENT_KIND_TENANT_CONTROLLER, valid: true},
+		"Mismatch":               {id: tcIdentity, declared: inv_v1.ClientKind_CLIENT_KIND_API},
+		"MismatchEnforced":       {id: tcIdentity, declared: inv_v1.ClientKind_CLIENT_KIND_API, enforce: true},
+		"NoIdentity":             {id: noIdentity, declared: inv_v1.ClientKind_CLIENT_KIND_TENANT_CONTROLLER, valid: true},
+		"NoIdentityEnforced":     {id: noIdentity, declared: inv_v1.ClientKind_CLIENT_KIND_API, enforce: true},
+		"MatchEnforced":          {id: tcIdentity, declared: tcIdentity.ClientKind, enforce: true, valid: true},
+		"UnspecifiedDeclaration": {id: tcIdentity, declared: inv_v1.ClientKind_CLIENT_KIND_UNSPECIFIED},
+	}
+
+	for tcname, tc := range testcases {
+		t.Run(tcname, func(t *testing.T) {
+			err := clientreg.VerifyClientKind(tc.id, tc.declared, tc.enforce)
+			if tc.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
@@ -16,6 +16,8 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/customconfigresource"
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/customobjectresource"
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/customtyperesource"
 	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/endpointresource"
 	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/hostgpuresource"
 	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/hostnicresource"
@@ -36,6 +38,8 @@ import (
 	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/regionresource"
 	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/remoteaccessconfiguration"
 	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/repeatedscheduleresource"
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/resourcelabel"
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/rolebindingresource"
 	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/singlescheduleresource"
 	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/siteresource"
 	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/telemetrygroupresource"
@@ -54,6 +58,10 @@ type Client struct {
 	Schema *migrate.Schema
 	// CustomConfigResource is the client for interacting with the CustomConfigResource builders.
 	CustomConfigResource *CustomConfigResourceClient
+	// CustomObjectResource is the client for interacting with the CustomObjectResource builders.
+	CustomObjectResource *CustomObjectResourceClient
+	// CustomTypeResource is the client for interacting with the CustomTypeResource builders.
+	CustomTypeResource *CustomTypeResourceClient
 	// EndpointResource is the client for interacting with the EndpointResource builders.
 	EndpointResource *EndpointResourceClient
 	// HostResource is the client for interacting with the HostResource builders.
@@ -94,6 +102,10 @@ type Client struct {
 	RemoteAccessConfiguration *RemoteAccessConfigurationClient
 	// RepeatedScheduleResource is the client for interacting with the RepeatedScheduleResource builders.
 	RepeatedScheduleResource *RepeatedScheduleResourceClient
+	// ResourceLabel is the client for interacting with the ResourceLabel builders.
+	ResourceLabel *ResourceLabelClient
+	// RoleBindingResource is the client for interacting with the RoleBindingResource builders.
+	RoleBindingResource *RoleBindingResourceClient
 	// SingleScheduleResource is the client for interacting with the SingleScheduleResource builders.
 	SingleScheduleResource *SingleScheduleResourceClient
 	// SiteResource is the client for interacting with the SiteResource builders.
@@ -120,6 +132,8 @@ func NewClient(opts ...Option) *Client {
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
 	c.CustomConfigResource = NewCustomConfigResourceClient(c.config)
+	c.CustomObjectResource = NewCustomObjectResourceClient(c.config)
+	c.CustomTypeResource = NewCustomTypeResourceClient(c.config)
 	c.EndpointResource = NewEndpointResourceClient(c.config)
 	c.HostResource = NewHostResourceClient(c.config)
 	c.HostgpuResource = NewHostgpuResourceClient(c.config)
@@ -140,6 +154,8 @@ func (c *Client) init() {
 	c.RegionResource = NewRegionResourceClient(c.config)
 	c.RemoteAccessConfiguration = NewRemoteAccessConfigurationClient(c.config)
 	c.RepeatedScheduleResource = NewRepeatedScheduleResourceClient(c.config)
+	c.ResourceLabel = NewResourceLabelClient(c.config)
+	c.RoleBindingResource = NewRoleBindingResourceClient(c.config)
 	c.SingleScheduleResource = NewSingleScheduleResourceClient(c.config)
 	c.SiteResource = NewSiteResourceClient(c.config)
 	c.TelemetryGroupResource = NewTelemetryGroupResourceClient(c.config)
@@ -240,6 +256,8 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		ctx:                       ctx,
 		config:                    cfg,
 		CustomConfigResource:      NewCustomConfigResourceClient(cfg),
+		CustomObjectResource:      NewCustomObjectResourceClient(cfg),
+		CustomTypeResource:        NewCustomTypeResourceClient(cfg),
 		EndpointResource:          NewEndpointResourceClient(cfg),
 		HostResource:              NewHostResourceClient(cfg),
 		HostgpuResource:           NewHostgpuResourceClient(cfg),
@@ -260,6 +278,8 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		RegionResource:            NewRegionResourceClient(cfg),
 		RemoteAccessConfiguration: NewRemoteAccessConfigurationClient(cfg),
 		RepeatedScheduleResource:  NewRepeatedScheduleResourceClient(cfg),
+		ResourceLabel:             NewResourceLabelClient(cfg),
+		RoleBindingResource:       NewRoleBindingResourceClient(cfg),
 		SingleScheduleResource:    NewSingleScheduleResourceClient(cfg),
 		SiteResource:              NewSiteResourceClient(cfg),
 		TelemetryGroupResource:    NewTelemetryGroupResourceClient(cfg),
@@ -287,6 +307,8 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		ctx:                       ctx,
 		config:                    cfg,
 		CustomConfigResource:      NewCustomConfigResourceClient(cfg),
+		CustomObjectResource:      NewCustomObjectResourceClient(cfg),
+		CustomTypeResource:        NewCustomTypeResourceClient(cfg),
 		EndpointResource:          NewEndpointResourceClient(cfg),
 		HostResource:              NewHostResourceClient(cfg),
 		HostgpuResource:           NewHostgpuResourceClient(cfg),
@@ -307,6 +329,8 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		RegionResource:            NewRegionResourceClient(cfg),
 		RemoteAccessConfiguration: NewRemoteAccessConfigurationClient(cfg),
 		RepeatedScheduleResource:  NewRepeatedScheduleResourceClient(cfg),
+		ResourceLabel:             NewResourceLabelClient(cfg),
+		RoleBindingResource:       NewRoleBindingResourceClient(cfg),
 		SingleScheduleResource:    NewSingleScheduleResourceClient(cfg),
 		SiteResource:              NewSiteResourceClient(cfg),
 		TelemetryGroupResource:    NewTelemetryGroupResourceClient(cfg),
@@ -343,13 +367,14 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.CustomConfigResource, c.EndpointResource, c.HostResource, c.HostgpuResource,
-		c.HostnicResource, c.HoststorageResource, c.HostusbResource,
-		c.IPAddressResource, c.InstanceResource, c.LocalAccountResource,
-		c.NetlinkResource, c.NetworkSegment, c.OSUpdatePolicy,
-		c.OSUpdatePolicyResource, c.OSUpdateRunResource, c.OperatingSystemResource,
-		c.OuResource, c.ProviderResource, c.RegionResource,
-		c.RemoteAccessConfiguration, c.RepeatedScheduleResource,
+		c.CustomConfigResource, c.CustomObjectResource, c.CustomTypeResource,
+		c.EndpointResource, c.HostResource, c.HostgpuResource, c.HostnicResource,
+		c.HoststorageResource, c.HostusbResource, c.IPAddressResource,
+		c.InstanceResource, c.LocalAccountResource, c.NetlinkResource,
+		c.NetworkSegment, c.OSUpdatePolicy, c.OSUpdatePolicyResource,
+		c.OSUpdateRunResource, c.OperatingSystemResource, c.OuResource,
+		c.ProviderResource, c.RegionResource, c.RemoteAccessConfiguration,
+		c.RepeatedScheduleResource, c.ResourceLabel, c.RoleBindingResource,
 		c.SingleScheduleResource, c.SiteResource, c.TelemetryGroupResource,
 		c.TelemetryProfile, c.Tenant, c.WorkloadMember, c.WorkloadResource,
 	} {
@@ -361,13 +386,14 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.CustomConfigResource, c.EndpointResource, c.HostResource, c.HostgpuResource,
-		c.HostnicResource, c.HoststorageResource, c.HostusbResource,
-		c.IPAddressResource, c.InstanceResource, c.LocalAccountResource,
-		c.NetlinkResource, c.NetworkSegment, c.OSUpdatePolicy,
-		c.OSUpdatePolicyResource, c.OSUpdateRunResource, c.OperatingSystemResource,
-		c.OuResource, c.ProviderResource, c.RegionResource,
-		c.RemoteAccessConfiguration, c.RepeatedScheduleResource,
+		c.CustomConfigResource, c.CustomObjectResource, c.CustomTypeResource,
+		c.EndpointResource, c.HostResource, c.HostgpuResource, c.HostnicResource,
+		c.HoststorageResource, c.HostusbResource, c.IPAddressResource,
+		c.InstanceResource, c.LocalAccountResource, c.NetlinkResource,
+		c.NetworkSegment, c.OSUpdatePolicy, c.OSUpdatePolicyResource,
+		c.OSUpdateRunResource, c.OperatingSystemResource, c.OuResource,
+		c.ProviderResource, c.RegionResource, c.RemoteAccessConfiguration,
+		c.RepeatedScheduleResource, c.ResourceLabel, c.RoleBindingResource,
 		c.SingleScheduleResource, c.SiteResource, c.TelemetryGroupResource,
 		c.TelemetryProfile, c.Tenant, c.WorkloadMember, c.WorkloadResource,
 	} {
@@ -380,6 +406,10 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
 	case *CustomConfigResourceMutation:
 		return c.CustomConfigResource.mutate(ctx, m)
+	case *CustomObjectResourceMutation:
+		return c.CustomObjectResource.mutate(ctx, m)
+	case *CustomTypeResourceMutation:
+		return c.CustomTypeResource.mutate(ctx, m)
 	case *EndpointResourceMutation:
 		return c.EndpointResource.mutate(ctx, m)
 	case *HostResourceMutation:
@@ -420,6 +450,10 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.RemoteAccessConfiguration.mutate(ctx, m)
 	case *RepeatedScheduleResourceMutation:
 		return c.RepeatedScheduleResource.mutate(ctx, m)
+	case *ResourceLabelMutation:
+		return c.ResourceLabel.mutate(ctx, m)
+	case *RoleBindingResourceMutation:
+		return c.RoleBindingResource.mutate(ctx, m)
 	case *SingleScheduleResourceMutation:
 		return c.SingleScheduleResource.mutate(ctx, m)
 	case *SiteResourceMutation:
@@ -588,6 +622,368 @@ func (c *CustomConfigResourceClient) mutate(ctx context.Context, m *CustomConfig
 	}
 }
 
+// CustomObjectResourceClient is a client for the CustomObjectResource schema.
+type CustomObjectResourceClient struct {
+	config
+}
+
+// NewCustomObjectResourceClient returns a client for the CustomObjectResource from the given config.
+func NewCustomObjectResourceClient(c config) *CustomObjectResourceClient {
+	return &CustomObjectResourceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `customobjectresource.Hooks(f(g(h())))`.
+func (c *CustomObjectResourceClient) Use(hooks ...Hook) {
+	c.hooks.CustomObjectResource = append(c.hooks.CustomObjectResource, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `customobjectresource.Intercept(f(g(h())))`.
+func (c *CustomObjectResourceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CustomObjectResource = append(c.inters.CustomObjectResource, interceptors...)
+}
+
+// Create returns a builder for creating a CustomObjectResource entity.
+func (c *CustomObjectResourceClient) Create() *CustomObjectResourceCreate {
+	mutation := newCustomObjectResourceMutation(c.config, OpCreate)
+	return &CustomObjectResourceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of CustomObjectResource entities.
+func (c *CustomObjectResourceClient) CreateBulk(builders ...*CustomObjectResourceCreate) *CustomObjectResourceCreateBulk {
+	return &CustomObjectResourceCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CustomObjectResourceClient) MapCreateBulk(slice any, setFunc func(*CustomObjectResourceCreate, int)) *CustomObjectResourceCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CustomObjectResourceCreateBulk{err: fmt.Errorf("calling to CustomObjectResourceClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CustomObjectResourceCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CustomObjectResourceCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for CustomObjectResource.
+func (c *CustomObjectResourceClient) Update() *CustomObjectResourceUpdate {
+	mutation := newCustomObjectResourceMutation(c.config, OpUpdate)
+	return &CustomObjectResourceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CustomObjectResourceClient) UpdateOne(_m *CustomObjectResource) *CustomObjectResourceUpdateOne {
+	mutation := newCustomObjectResourceMutation(c.config, OpUpdateOne, withCustomObjectResource(_m))
+	return &CustomObjectResourceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CustomObjectResourceClient) UpdateOneID(id int) *CustomObjectResourceUpdateOne {
+	mutation := newCustomObjectResourceMutation(c.config, OpUpdateOne, withCustomObjectResourceID(id))
+	return &CustomObjectResourceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for CustomObjectResource.
+func (c *CustomObjectResourceClient) Delete() *CustomObjectResourceDelete {
+	mutation := newCustomObjectResourceMutation(c.config, OpDelete)
+	return &CustomObjectResourceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CustomObjectResourceClient) DeleteOne(_m *CustomObjectResource) *CustomObjectResourceDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CustomObjectResourceClient) DeleteOneID(id int) *CustomObjectResourceDeleteOne {
+	builder := c.Delete().Where(customobjectresource.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CustomObjectResourceDeleteOne{builder}
+}
+
+// Query returns a query builder for CustomObjectResource.
+func (c *CustomObjectResourceClient) Query() *CustomObjectResourceQuery {
+	return &CustomObjectResourceQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCustomObjectResource},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a CustomObjectResource entity by its id.
+func (c *CustomObjectResourceClient) Get(ctx context.Context, id int) (*CustomObjectResource, error) {
+	return c.Query().Where(customobjectresource.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CustomObjectResourceClient) GetX(ctx context.Context, id int) *CustomObjectResource {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryCustomType queries the custom_type edge of a CustomObjectResource.
+func (c *CustomObjectResourceClient) QueryCustomType(_m *CustomObjectResource) *CustomTypeResourceQuery {
+	query := (&CustomTypeResourceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(customobjectresource.Table, customobjectresource.FieldID, id),
+			sqlgraph.To(customtyperesource.Table, customtyperesource.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, customobjectresource.CustomTypeTable, customobjectresource.CustomTypeColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryHost queries the host edge of a CustomObjectResource.
+func (c *CustomObjectResourceClient) QueryHost(_m *CustomObjectResource) *HostResourceQuery {
+	query := (&HostResourceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(customobjectresource.Table, customobjectresource.FieldID, id),
+			sqlgraph.To(hostresource.Table, hostresource.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, customobjectresource.HostTable, customobjectresource.HostColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySite queries the site edge of a CustomObjectResource.
+func (c *CustomObjectResourceClient) QuerySite(_m *CustomObjectResource) *SiteResourceQuery {
+	query := (&SiteResourceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(customobjectresource.Table, customobjectresource.FieldID, id),
+			sqlgraph.To(siteresource.Table, siteresource.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, customobjectresource.SiteTable, customobjectresource.SiteColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRegion queries the region edge of a CustomObjectResource.
+func (c *CustomObjectResourceClient) QueryRegion(_m *CustomObjectResource) *RegionResourceQuery {
+	query := (&RegionResourceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(customobjectresource.Table, customobjectresource.FieldID, id),
+			sqlgraph.To(regionresource.Table, regionresource.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, customobjectresource.RegionTable, customobjectresource.RegionColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryOu queries the ou edge of a CustomObjectResource.
+func (c *CustomObjectResourceClient) QueryOu(_m *CustomObjectResource) *OuResourceQuery {
+	query := (&OuResourceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(customobjectresource.Table, customobjectresource.FieldID, id),
+			sqlgraph.To(ouresource.Table, ouresource.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, customobjectresource.OuTable, customobjectresource.OuColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryInstance queries the instance edge of a CustomObjectResource.
+func (c *CustomObjectResourceClient) QueryInstance(_m *CustomObjectResource) *InstanceResourceQuery {
+	query := (&InstanceResourceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(customobjectresource.Table, customobjectresource.FieldID, id),
+			sqlgraph.To(instanceresource.Table, instanceresource.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, customobjectresource.InstanceTable, customobjectresource.InstanceColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *CustomObjectResourceClient) Hooks() []Hook {
+	return c.hooks.CustomObjectResource
+}
+
+// Interceptors returns the client interceptors.
+func (c *CustomObjectResourceClient) Interceptors() []Interceptor {
+	return c.inters.CustomObjectResource
+}
+
+func (c *CustomObjectResourceClient) mutate(ctx context.Context, m *CustomObjectResourceMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CustomObjectResourceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CustomObjectResourceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CustomObjectResourceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CustomObjectResourceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown CustomObjectResource mutation op: %q", m.Op())
+	}
+}
+
+// CustomTypeResourceClient is a client for the CustomTypeResource schema.
+type CustomTypeResourceClient struct {
+	config
+}
+
+// NewCustomTypeResourceClient returns a client for the CustomTypeResource from the given config.
+func NewCustomTypeResourceClient(c config) *CustomTypeResourceClient {
+	return &CustomTypeResourceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `customtyperesource.Hooks(f(g(h())))`.
+func (c *CustomTypeResourceClient) Use(hooks ...Hook) {
+	c.hooks.CustomTypeResource = append(c.hooks.CustomTypeResource, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `customtyperesource.Intercept(f(g(h())))`.
+func (c *CustomTypeResourceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CustomTypeResource = append(c.inters.CustomTypeResource, interceptors...)
+}
+
+// Create returns a builder for creating a CustomTypeResource entity.
+func (c *CustomTypeResourceClient) Create() *CustomTypeResourceCreate {
+	mutation := newCustomTypeResourceMutation(c.config, OpCreate)
+	return &CustomTypeResourceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of CustomTypeResource entities.
+func (c *CustomTypeResourceClient) CreateBulk(builders ...*CustomTypeResourceCreate) *CustomTypeResourceCreateBulk {
+	return &CustomTypeResourceCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CustomTypeResourceClient) MapCreateBulk(slice any, setFunc func(*CustomTypeResourceCreate, int)) *CustomTypeResourceCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CustomTypeResourceCreateBulk{err: fmt.Errorf("calling to CustomTypeResourceClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CustomTypeResourceCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CustomTypeResourceCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for CustomTypeResource.
+func (c *CustomTypeResourceClient) Update() *CustomTypeResourceUpdate {
+	mutation := newCustomTypeResourceMutation(c.config, OpUpdate)
+	return &CustomTypeResourceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CustomTypeResourceClient) UpdateOne(_m *CustomTypeResource) *CustomTypeResourceUpdateOne {
+	mutation := newCustomTypeResourceMutation(c.config, OpUpdateOne, withCustomTypeResource(_m))
+	return &CustomTypeResourceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CustomTypeResourceClient) UpdateOneID(id int) *CustomTypeResourceUpdateOne {
+	mutation := newCustomTypeResourceMutation(c.config, OpUpdateOne, withCustomTypeResourceID(id))
+	return &CustomTypeResourceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for CustomTypeResource.
+func (c *CustomTypeResourceClient) Delete() *CustomTypeResourceDelete {
+	mutation := newCustomTypeResourceMutation(c.config, OpDelete)
+	return &CustomTypeResourceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CustomTypeResourceClient) DeleteOne(_m *CustomTypeResource) *CustomTypeResourceDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CustomTypeResourceClient) DeleteOneID(id int) *CustomTypeResourceDeleteOne {
+	builder := c.Delete().Where(customtyperesource.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CustomTypeResourceDeleteOne{builder}
+}
+
+// Query returns a query builder for CustomTypeResource.
+func (c *CustomTypeResourceClient) Query() *CustomTypeResourceQuery {
+	return &CustomTypeResourceQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCustomTypeResource},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a CustomTypeResource entity by its id.
+func (c *CustomTypeResourceClient) Get(ctx context.Context, id int) (*CustomTypeResource, error) {
+	return c.Query().Where(customtyperesource.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CustomTypeResourceClient) GetX(ctx context.Context, id int) *CustomTypeResource {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *CustomTypeResourceClient) Hooks() []Hook {
+	return c.hooks.CustomTypeResource
+}
+
+// Interceptors returns the client interceptors.
+func (c *CustomTypeResourceClient) Interceptors() []Interceptor {
+	return c.inters.CustomTypeResource
+}
+
+func (c *CustomTypeResourceClient) mutate(ctx context.Context, m *CustomTypeResourceMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CustomTypeResourceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CustomTypeResourceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CustomTypeResourceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CustomTypeResourceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown CustomTypeResource mutation op: %q", m.Op())
+	}
+}
+
 // EndpointResourceClient is a client for the EndpointResource schema.
 type EndpointResourceClient struct {
 	config
@@ -3824,6 +4220,320 @@ func (c *RepeatedScheduleResourceClient) mutate(ctx context.Context, m *Repeated
 	}
 }
 
+// ResourceLabelClient is a client for the ResourceLabel schema.
+type ResourceLabelClient struct {
+	config
+}
+
+// NewResourceLabelClient returns a client for the ResourceLabel from the given config.
+func NewResourceLabelClient(c config) *ResourceLabelClient {
+	return &ResourceLabelClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `resourcelabel.Hooks(f(g(h())))`.
+func (c *ResourceLabelClient) Use(hooks ...Hook) {
+	c.hooks.ResourceLabel = append(c.hooks.ResourceLabel, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `resourcelabel.Intercept(f(g(h())))`.
+func (c *ResourceLabelClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ResourceLabel = append(c.inters.ResourceLabel, interceptors...)
+}
+
+// Create returns a builder for creating a ResourceLabel entity.
+func (c *ResourceLabelClient) Create() *ResourceLabelCreate {
+	mutation := newResourceLabelMutation(c.config, OpCreate)
+	return &ResourceLabelCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ResourceLabel entities.
+func (c *ResourceLabelClient) CreateBulk(builders ...*ResourceLabelCreate) *ResourceLabelCreateBulk {
+	return &ResourceLabelCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ResourceLabelClient) MapCreateBulk(slice any, setFunc func(*ResourceLabelCreate, int)) *ResourceLabelCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ResourceLabelCreateBulk{err: fmt.Errorf("calling to ResourceLabelClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ResourceLabelCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ResourceLabelCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ResourceLabel.
+func (c *ResourceLabelClient) Update() *ResourceLabelUpdate {
+	mutation := newResourceLabelMutation(c.config, OpUpdate)
+	return &ResourceLabelUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ResourceLabelClient) UpdateOne(_m *ResourceLabel) *ResourceLabelUpdateOne {
+	mutation := newResourceLabelMutation(c.config, OpUpdateOne, withResourceLabel(_m))
+	return &ResourceLabelUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ResourceLabelClient) UpdateOneID(id int) *ResourceLabelUpdateOne {
+	mutation := newResourceLabelMutation(c.config, OpUpdateOne, withResourceLabelID(id))
+	return &ResourceLabelUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ResourceLabel.
+func (c *ResourceLabelClient) Delete() *ResourceLabelDelete {
+	mutation := newResourceLabelMutation(c.config, OpDelete)
+	return &ResourceLabelDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ResourceLabelClient) DeleteOne(_m *ResourceLabel) *ResourceLabelDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ResourceLabelClient) DeleteOneID(id int) *ResourceLabelDeleteOne {
+	builder := c.Delete().Where(resourcelabel.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ResourceLabelDeleteOne{builder}
+}
+
+// Query returns a query builder for ResourceLabel.
+func (c *ResourceLabelClient) Query() *ResourceLabelQuery {
+	return &ResourceLabelQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeResourceLabel},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ResourceLabel entity by its id.
+func (c *ResourceLabelClient) Get(ctx context.Context, id int) (*ResourceLabel, error) {
+	return c.Query().Where(resourcelabel.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ResourceLabelClient) GetX(ctx context.Context, id int) *ResourceLabel {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ResourceLabelClient) Hooks() []Hook {
+	return c.hooks.ResourceLabel
+}
+
+// Interceptors returns the client interceptors.
+func (c *ResourceLabelClient) Interceptors() []Interceptor {
+	return c.inters.ResourceLabel
+}
+
+func (c *ResourceLabelClient) mutate(ctx context.Context, m *ResourceLabelMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ResourceLabelCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ResourceLabelUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ResourceLabelUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ResourceLabelDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ResourceLabel mutation op: %q", m.Op())
+	}
+}
+
+// RoleBindingResourceClient is a client for the RoleBindingResource schema.
+type RoleBindingResourceClient struct {
+	config
+}
+
+// NewRoleBindingResourceClient returns a client for the RoleBindingResource from the given config.
+func NewRoleBindingResourceClient(c config) *RoleBindingResourceClient {
+	return &RoleBindingResourceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `rolebindingresource.Hooks(f(g(h())))`.
+func (c *RoleBindingResourceClient) Use(hooks ...Hook) {
+	c.hooks.RoleBindingResource = append(c.hooks.RoleBindingResource, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `rolebindingresource.Intercept(f(g(h())))`.
+func (c *RoleBindingResourceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RoleBindingResource = append(c.inters.RoleBindingResource, interceptors...)
+}
+
+// Create returns a builder for creating a RoleBindingResource entity.
+func (c *RoleBindingResourceClient) Create() *RoleBindingResourceCreate {
+	mutation := newRoleBindingResourceMutation(c.config, OpCreate)
+	return &RoleBindingResourceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RoleBindingResource entities.
+func (c *RoleBindingResourceClient) CreateBulk(builders ...*RoleBindingResourceCreate) *RoleBindingResourceCreateBulk {
+	return &RoleBindingResourceCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RoleBindingResourceClient) MapCreateBulk(slice any, setFunc func(*RoleBindingResourceCreate, int)) *RoleBindingResourceCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RoleBindingResourceCreateBulk{err: fmt.Errorf("calling to RoleBindingResourceClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RoleBindingResourceCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RoleBindingResourceCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RoleBindingResource.
+func (c *RoleBindingResourceClient) Update() *RoleBindingResourceUpdate {
+	mutation := newRoleBindingResourceMutation(c.config, OpUpdate)
+	return &RoleBindingResourceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RoleBindingResourceClient) UpdateOne(_m *RoleBindingResource) *RoleBindingResourceUpdateOne {
+	mutation := newRoleBindingResourceMutation(c.config, OpUpdateOne, withRoleBindingResource(_m))
+	return &RoleBindingResourceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RoleBindingResourceClient) UpdateOneID(id int) *RoleBindingResourceUpdateOne {
+	mutation := newRoleBindingResourceMutation(c.config, OpUpdateOne, withRoleBindingResourceID(id))
+	return &RoleBindingResourceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RoleBindingResource.
+func (c *RoleBindingResourceClient) Delete() *RoleBindingResourceDelete {
+	mutation := newRoleBindingResourceMutation(c.config, OpDelete)
+	return &RoleBindingResourceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RoleBindingResourceClient) DeleteOne(_m *RoleBindingResource) *RoleBindingResourceDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RoleBindingResourceClient) DeleteOneID(id int) *RoleBindingResourceDeleteOne {
+	builder := c.Delete().Where(rolebindingresource.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RoleBindingResourceDeleteOne{builder}
+}
+
+// Query returns a query builder for RoleBindingResource.
+func (c *RoleBindingResourceClient) Query() *RoleBindingResourceQuery {
+	return &RoleBindingResourceQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRoleBindingResource},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RoleBindingResource entity by its id.
+func (c *RoleBindingResourceClient) Get(ctx context.Context, id int) (*RoleBindingResource, error) {
+	return c.Query().Where(rolebindingresource.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RoleBindingResourceClient) GetX(ctx context.Context, id int) *RoleBindingResource {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryRegion queries the region edge of a RoleBindingResource.
+func (c *RoleBindingResourceClient) QueryRegion(_m *RoleBindingResource) *RegionResourceQuery {
+	query := (&RegionResourceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(rolebindingresource.Table, rolebindingresource.FieldID, id),
+			sqlgraph.To(regionresource.Table, regionresource.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, rolebindingresource.RegionTable, rolebindingresource.RegionColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySite queries the site edge of a RoleBindingResource.
+func (c *RoleBindingResourceClient) QuerySite(_m *RoleBindingResource) *SiteResourceQuery {
+	query := (&SiteResourceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(rolebindingresource.Table, rolebindingresource.FieldID, id),
+			sqlgraph.To(siteresource.Table, siteresource.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, rolebindingresource.SiteTable, rolebindingresource.SiteColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryOu queries the ou edge of a RoleBindingResource.
+func (c *RoleBindingResourceClient) QueryOu(_m *RoleBindingResource) *OuResourceQuery {
+	query := (&OuResourceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(rolebindingresource.Table, rolebindingresource.FieldID, id),
+			sqlgraph.To(ouresource.Table, ouresource.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, rolebindingresource.OuTable, rolebindingresource.OuColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *RoleBindingResourceClient) Hooks() []Hook {
+	return c.hooks.RoleBindingResource
+}
+
+// Interceptors returns the client interceptors.
+func (c *RoleBindingResourceClient) Interceptors() []Interceptor {
+	return c.inters.RoleBindingResource
+}
+
+func (c *RoleBindingResourceClient) mutate(ctx context.Context, m *RoleBindingResourceMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RoleBindingResourceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RoleBindingResourceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RoleBindingResourceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RoleBindingResourceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown RoleBindingResource mutation op: %q", m.Op())
+	}
+}
+
 // SingleScheduleResourceClient is a client for the SingleScheduleResource schema.
 type SingleScheduleResourceClient struct {
 	config
@@ -4998,24 +5708,26 @@ func (c *WorkloadResourceClient) mutate(ctx context.Context, m *WorkloadResource
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		CustomConfigResource, EndpointResource, HostResource, HostgpuResource,
-		HostnicResource, HoststorageResource, HostusbResource, IPAddressResource,
-		InstanceResource, LocalAccountResource, NetlinkResource, NetworkSegment,
-		OSUpdatePolicy, OSUpdatePolicyResource, OSUpdateRunResource,
-		OperatingSystemResource, OuResource, ProviderResource, RegionResource,
-		RemoteAccessConfiguration, RepeatedScheduleResource, SingleScheduleResource,
-		SiteResource, TelemetryGroupResource, TelemetryProfile, Tenant, WorkloadMember,
-		WorkloadResource []ent.Hook
+		CustomConfigResource, CustomObjectResource, CustomTypeResource,
+		EndpointResource, HostResource, HostgpuResource, HostnicResource,
+		HoststorageResource, HostusbResource, IPAddressResource, InstanceResource,
+		LocalAccountResource, NetlinkResource, NetworkSegment, OSUpdatePolicy,
+		OSUpdatePolicyResource, OSUpdateRunResource, OperatingSystemResource,
+		OuResource, ProviderResource, RegionResource, RemoteAccessConfiguration,
+		RepeatedScheduleResource, ResourceLabel, RoleBindingResource,
+		SingleScheduleResource, SiteResource, TelemetryGroupResource, TelemetryProfile,
+		Tenant, WorkloadMember, WorkloadResource []ent.Hook
 	}
 	inters struct {
-		CustomConfigResource, EndpointResource, HostResource, HostgpuResource,
-		HostnicResource, HoststorageResource, HostusbResource, IPAddressResource,
-		InstanceResource, LocalAccountResource, NetlinkResource, NetworkSegment,
-		OSUpdatePolicy, OSUpdatePolicyResource, OSUpdateRunResource,
-		OperatingSystemResource, OuResource, ProviderResource, RegionResource,
-		RemoteAccessConfiguration, RepeatedScheduleResource, SingleScheduleResource,
-		SiteResource, TelemetryGroupResource, TelemetryProfile, Tenant, WorkloadMember,
-		WorkloadResource []ent.Interceptor
+		CustomConfigResource, CustomObjectResource, CustomTypeResource,
+		EndpointResource, HostResource, HostgpuResource, HostnicResource,
+		HoststorageResource, HostusbResource, IPAddressResource, InstanceResource,
+		LocalAccountResource, NetlinkResource, NetworkSegment, OSUpdatePolicy,
+		OSUpdatePolicyResource, OSUpdateRunResource, OperatingSystemResource,
+		OuResource, ProviderResource, RegionResource, RemoteAccessConfiguration,
+		RepeatedScheduleResource, ResourceLabel, RoleBindingResource,
+		SingleScheduleResource, SiteResource, TelemetryGroupResource, TelemetryProfile,
+		Tenant, WorkloadMember, WorkloadResource []ent.Interceptor
 	}
 )
 
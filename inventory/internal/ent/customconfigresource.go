@@ -21,7 +21,7 @@ type CustomConfigResource struct {
 	// Name holds the value of the "name" field.
 	Name string `json:"name,omitempty"`
 	// Config holds the value of the "config" field.
-	Config string `json:"config,omitempty"`
+	Config string `json:"-"`
 	// Description holds the value of the "description" field.
 	Description string `json:"description,omitempty"`
 	// TenantID holds the value of the "tenant_id" field.
@@ -173,8 +173,7 @@ func (_m *CustomConfigResource) String() string {
 	builder.WriteString("name=")
 	builder.WriteString(_m.Name)
 	builder.WriteString(", ")
-	builder.WriteString("config=")
-	builder.WriteString(_m.Config)
+	builder.WriteString("config=<sensitive>")
 	builder.WriteString(", ")
 	builder.WriteString("description=")
 	builder.WriteString(_m.Description)
@@ -42,6 +42,34 @@ func (_u *CustomConfigResourceUpdate) SetNillableResourceID(v *string) *CustomCo
 	return _u
 }
 
+// SetName sets the "name" field.
+func (_u *CustomConfigResourceUpdate) SetName(v string) *CustomConfigResourceUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *CustomConfigResourceUpdate) SetNillableName(v *string) *CustomConfigResourceUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetConfig sets the "config" field.
+func (_u *CustomConfigResourceUpdate) SetConfig(v string) *CustomConfigResourceUpdate {
+	_u.mutation.SetConfig(v)
+	return _u
+}
+
+// SetNillableConfig sets the "config" field if the given value is not nil.
+func (_u *CustomConfigResourceUpdate) SetNillableConfig(v *string) *CustomConfigResourceUpdate {
+	if v != nil {
+		_u.SetConfig(*v)
+	}
+	return _u
+}
+
 // SetDescription sets the "description" field.
 func (_u *CustomConfigResourceUpdate) SetDescription(v string) *CustomConfigResourceUpdate {
 	_u.mutation.SetDescription(v)
@@ -156,6 +184,12 @@ func (_u *CustomConfigResourceUpdate) sqlSave(ctx context.Context) (_node int, e
 	if value, ok := _u.mutation.ResourceID(); ok {
 		_spec.SetField(customconfigresource.FieldResourceID, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(customconfigresource.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Config(); ok {
+		_spec.SetField(customconfigresource.FieldConfig, field.TypeString, value)
+	}
 	if value, ok := _u.mutation.Description(); ok {
 		_spec.SetField(customconfigresource.FieldDescription, field.TypeString, value)
 	}
@@ -244,6 +278,34 @@ func (_u *CustomConfigResourceUpdateOne) SetNillableResourceID(v *string) *Custo
 	return _u
 }
 
+// SetName sets the "name" field.
+func (_u *CustomConfigResourceUpdateOne) SetName(v string) *CustomConfigResourceUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *CustomConfigResourceUpdateOne) SetNillableName(v *string) *CustomConfigResourceUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetConfig sets the "config" field.
+func (_u *CustomConfigResourceUpdateOne) SetConfig(v string) *CustomConfigResourceUpdateOne {
+	_u.mutation.SetConfig(v)
+	return _u
+}
+
+// SetNillableConfig sets the "config" field if the given value is not nil.
+func (_u *CustomConfigResourceUpdateOne) SetNillableConfig(v *string) *CustomConfigResourceUpdateOne {
+	if v != nil {
+		_u.SetConfig(*v)
+	}
+	return _u
+}
+
 // SetDescription sets the "description" field.
 func (_u *CustomConfigResourceUpdateOne) SetDescription(v string) *CustomConfigResourceUpdateOne {
 	_u.mutation.SetDescription(v)
@@ -388,6 +450,12 @@ func (_u *CustomConfigResourceUpdateOne) sqlSave(ctx context.Context) (_node *Cu
 	if value, ok := _u.mutation.ResourceID(); ok {
 		_spec.SetField(customconfigresource.FieldResourceID, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(customconfigresource.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Config(); ok {
+		_spec.SetField(customconfigresource.FieldConfig, field.TypeString, value)
+	}
 	if value, ok := _u.mutation.Description(); ok {
 		_spec.SetField(customconfigresource.FieldDescription, field.TypeString, value)
 	}
@@ -0,0 +1,342 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/customobjectresource"
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/customtyperesource"
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/hostresource"
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/instanceresource"
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/ouresource"
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/regionresource"
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/siteresource"
+)
+
+// CustomObjectResource is the model entity for the CustomObjectResource schema.
+type CustomObjectResource struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ResourceID holds the value of the "resource_id" field.
+	ResourceID string `json:"resource_id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Spec holds the value of the "spec" field.
+	Spec string `json:"spec,omitempty"`
+	// TenantID holds the value of the "tenant_id" field.
+	TenantID string `json:"tenant_id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt string `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt string `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the CustomObjectResourceQuery when eager-loading is set.
+	Edges                              CustomObjectResourceEdges `json:"edges"`
+	custom_object_resource_custom_type *int
+	custom_object_resource_host        *int
+	custom_object_resource_site        *int
+	custom_object_resource_region      *int
+	custom_object_resource_ou          *int
+	custom_object_resource_instance    *int
+	selectValues                       sql.SelectValues
+}
+
+// CustomObjectResourceEdges holds the relations/edges for other nodes in the graph.
+type CustomObjectResourceEdges struct {
+	// CustomType holds the value of the custom_type edge.
+	CustomType *CustomTypeResource `json:"custom_type,omitempty"`
+	// Host holds the value of the host edge.
+	Host *HostResource `json:"host,omitempty"`
+	// Site holds the value of the site edge.
+	Site *SiteResource `json:"site,omitempty"`
+	// Region holds the value of the region edge.
+	Region *RegionResource `json:"region,omitempty"`
+	// Ou holds the value of the ou edge.
+	Ou *OuResource `json:"ou,omitempty"`
+	// Instance holds the value of the instance edge.
+	Instance *InstanceResource `json:"instance,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [6]bool
+}
+
+// CustomTypeOrErr returns the CustomType value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CustomObjectResourceEdges) CustomTypeOrErr() (*CustomTypeResource, error) {
+	if e.CustomType != nil {
+		return e.CustomType, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: customtyperesource.Label}
+	}
+	return nil, &NotLoadedError{edge: "custom_type"}
+}
+
+// HostOrErr returns the Host value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CustomObjectResourceEdges) HostOrErr() (*HostResource, error) {
+	if e.Host != nil {
+		return e.Host, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: hostresource.Label}
+	}
+	return nil, &NotLoadedError{edge: "host"}
+}
+
+// SiteOrErr returns the Site value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CustomObjectResourceEdges) SiteOrErr() (*SiteResource, error) {
+	if e.Site != nil {
+		return e.Site, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: siteresource.Label}
+	}
+	return nil, &NotLoadedError{edge: "site"}
+}
+
+// RegionOrErr returns the Region value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CustomObjectResourceEdges) RegionOrErr() (*RegionResource, error) {
+	if e.Region != nil {
+		return e.Region, nil
+	} else if e.loadedTypes[3] {
+		return nil, &NotFoundError{label: regionresource.Label}
+	}
+	return nil, &NotLoadedError{edge: "region"}
+}
+
+// OuOrErr returns the Ou value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CustomObjectResourceEdges) OuOrErr() (*OuResource, error) {
+	if e.Ou != nil {
+		return e.Ou, nil
+	} else if e.loadedTypes[4] {
+		return nil, &NotFoundError{label: ouresource.Label}
+	}
+	return nil, &NotLoadedError{edge: "ou"}
+}
+
+// InstanceOrErr returns the Instance value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CustomObjectResourceEdges) InstanceOrErr() (*InstanceResource, error) {
+	if e.Instance != nil {
+		return e.Instance, nil
+	} else if e.loadedTypes[5] {
+		return nil, &NotFoundError{label: instanceresource.Label}
+	}
+	return nil, &NotLoadedError{edge: "instance"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CustomObjectResource) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case customobjectresource.FieldID:
+			values[i] = new(sql.NullInt64)
+		case customobjectresource.FieldResourceID, customobjectresource.FieldName, customobjectresource.FieldSpec, customobjectresource.FieldTenantID, customobjectresource.FieldCreatedAt, customobjectresource.FieldUpdatedAt:
+			values[i] = new(sql.NullString)
+		case customobjectresource.ForeignKeys[0]: // custom_object_resource_custom_type
+			values[i] = new(sql.NullInt64)
+		case customobjectresource.ForeignKeys[1]: // custom_object_resource_host
+			values[i] = new(sql.NullInt64)
+		case customobjectresource.ForeignKeys[2]: // custom_object_resource_site
+			values[i] = new(sql.NullInt64)
+		case customobjectresource.ForeignKeys[3]: // custom_object_resource_region
+			values[i] = new(sql.NullInt64)
+		case customobjectresource.ForeignKeys[4]: // custom_object_resource_ou
+			values[i] = new(sql.NullInt64)
+		case customobjectresource.ForeignKeys[5]: // custom_object_resource_instance
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CustomObjectResource fields.
+func (_m *CustomObjectResource) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case customobjectresource.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case customobjectresource.FieldResourceID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field resource_id", values[i])
+			} else if value.Valid {
+				_m.ResourceID = value.String
+			}
+		case customobjectresource.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case customobjectresource.FieldSpec:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field spec", values[i])
+			} else if value.Valid {
+				_m.Spec = value.String
+			}
+		case customobjectresource.FieldTenantID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = value.String
+			}
+		case customobjectresource.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.String
+			}
+		case customobjectresource.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.String
+			}
+		case customobjectresource.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field custom_object_resource_custom_type", value)
+			} else if value.Valid {
+				_m.custom_object_resource_custom_type = new(int)
+				*_m.custom_object_resource_custom_type = int(value.Int64)
+			}
+		case customobjectresource.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field custom_object_resource_host", value)
+			} else if value.Valid {
+				_m.custom_object_resource_host = new(int)
+				*_m.custom_object_resource_host = int(value.Int64)
+			}
+		case customobjectresource.ForeignKeys[2]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field custom_object_resource_site", value)
+			} else if value.Valid {
+				_m.custom_object_resource_site = new(int)
+				*_m.custom_object_resource_site = int(value.Int64)
+			}
+		case customobjectresource.ForeignKeys[3]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field custom_object_resource_region", value)
+			} else if value.Valid {
+				_m.custom_object_resource_region = new(int)
+				*_m.custom_object_resource_region = int(value.Int64)
+			}
+		case customobjectresource.ForeignKeys[4]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field custom_object_resource_ou", value)
+			} else if value.Valid {
+				_m.custom_object_resource_ou = new(int)
+				*_m.custom_object_resource_ou = int(value.Int64)
+			}
+		case customobjectresource.ForeignKeys[5]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field custom_object_resource_instance", value)
+			} else if value.Valid {
+				_m.custom_object_resource_instance = new(int)
+				*_m.custom_object_resource_instance = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the CustomObjectResource.
+// This includes values selected through modifiers, order, etc.
+func (_m *CustomObjectResource) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryCustomType queries the "custom_type" edge of the CustomObjectResource entity.
+func (_m *CustomObjectResource) QueryCustomType() *CustomTypeResourceQuery {
+	return NewCustomObjectResourceClient(_m.config).QueryCustomType(_m)
+}
+
+// QueryHost queries the "host" edge of the CustomObjectResource entity.
+func (_m *CustomObjectResource) QueryHost() *HostResourceQuery {
+	return NewCustomObjectResourceClient(_m.config).QueryHost(_m)
+}
+
+// QuerySite queries the "site" edge of the CustomObjectResource entity.
+func (_m *CustomObjectResource) QuerySite() *SiteResourceQuery {
+	return NewCustomObjectResourceClient(_m.config).QuerySite(_m)
+}
+
+// QueryRegion queries the "region" edge of the CustomObjectResource entity.
+func (_m *CustomObjectResource) QueryRegion() *RegionResourceQuery {
+	return NewCustomObjectResourceClient(_m.config).QueryRegion(_m)
+}
+
+// QueryOu queries the "ou" edge of the CustomObjectResource entity.
+func (_m *CustomObjectResource) QueryOu() *OuResourceQuery {
+	return NewCustomObjectResourceClient(_m.config).QueryOu(_m)
+}
+
+// QueryInstance queries the "instance" edge of the CustomObjectResource entity.
+func (_m *CustomObjectResource) QueryInstance() *InstanceResourceQuery {
+	return NewCustomObjectResourceClient(_m.config).QueryInstance(_m)
+}
+
+// Update returns a builder for updating this CustomObjectResource.
+// Note that you need to call CustomObjectResource.Unwrap() before calling this method if this CustomObjectResource
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *CustomObjectResource) Update() *CustomObjectResourceUpdateOne {
+	return NewCustomObjectResourceClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the CustomObjectResource entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *CustomObjectResource) Unwrap() *CustomObjectResource {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CustomObjectResource is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *CustomObjectResource) String() string {
+	var builder strings.Builder
+	builder.WriteString("CustomObjectResource(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("resource_id=")
+	builder.WriteString(_m.ResourceID)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("spec=")
+	builder.WriteString(_m.Spec)
+	builder.WriteString(", ")
+	builder.WriteString("tenant_id=")
+	builder.WriteString(_m.TenantID)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt)
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CustomObjectResources is a parsable slice of CustomObjectResource.
+type CustomObjectResources []*CustomObjectResource
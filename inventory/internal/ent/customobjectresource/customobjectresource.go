@@ -0,0 +1,242 @@
+// Code generated by ent, DO NOT EDIT.
+
+package customobjectresource
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the customobjectresource type in the database.
+	Label = "custom_object_resource"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldResourceID holds the string denoting the resource_id field in the database.
+	FieldResourceID = "resource_id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldSpec holds the string denoting the spec field in the database.
+	FieldSpec = "spec"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgeCustomType holds the string denoting the custom_type edge name in mutations.
+	EdgeCustomType = "custom_type"
+	// EdgeHost holds the string denoting the host edge name in mutations.
+	EdgeHost = "host"
+	// EdgeSite holds the string denoting the site edge name in mutations.
+	EdgeSite = "site"
+	// EdgeRegion holds the string denoting the region edge name in mutations.
+	EdgeRegion = "region"
+	// EdgeOu holds the string denoting the ou edge name in mutations.
+	EdgeOu = "ou"
+	// EdgeInstance holds the string denoting the instance edge name in mutations.
+	EdgeInstance = "instance"
+	// Table holds the table name of the customobjectresource in the database.
+	Table = "custom_object_resources"
+	// CustomTypeTable is the table that holds the custom_type relation/edge.
+	CustomTypeTable = "custom_object_resources"
+	// CustomTypeInverseTable is the table name for the CustomTypeResource entity.
+	// It exists in this package in order to avoid circular dependency with the "customtyperesource" package.
+	CustomTypeInverseTable = "custom_type_resources"
+	// CustomTypeColumn is the table column denoting the custom_type relation/edge.
+	CustomTypeColumn = "custom_object_resource_custom_type"
+	// HostTable is the table that holds the host relation/edge.
+	HostTable = "custom_object_resources"
+	// HostInverseTable is the table name for the HostResource entity.
+	// It exists in this package in order to avoid circular dependency with the "hostresource" package.
+	HostInverseTable = "host_resources"
+	// HostColumn is the table column denoting the host relation/edge.
+	HostColumn = "custom_object_resource_host"
+	// SiteTable is the table that holds the site relation/edge.
+	SiteTable = "custom_object_resources"
+	// SiteInverseTable is the table name for the SiteResource entity.
+	// It exists in this package in order to avoid circular dependency with the "siteresource" package.
+	SiteInverseTable = "site_resources"
+	// SiteColumn is the table column denoting the site relation/edge.
+	SiteColumn = "custom_object_resource_site"
+	// RegionTable is the table that holds the region relation/edge.
+	RegionTable = "custom_object_resources"
+	// RegionInverseTable is the table name for the RegionResource entity.
+	// It exists in this package in order to avoid circular dependency with the "regionresource" package.
+	RegionInverseTable = "region_resources"
+	// RegionColumn is the table column denoting the region relation/edge.
+	RegionColumn = "custom_object_resource_region"
+	// OuTable is the table that holds the ou relation/edge.
+	OuTable = "custom_object_resources"
+	// OuInverseTable is the table name for the OuResource entity.
+	// It exists in this package in order to avoid circular dependency with the "ouresource" package.
+	OuInverseTable = "ou_resources"
+	// OuColumn is the table column denoting the ou relation/edge.
+	OuColumn = "custom_object_resource_ou"
+	// InstanceTable is the table that holds the instance relation/edge.
+	InstanceTable = "custom_object_resources"
+	// InstanceInverseTable is the table name for the InstanceResource entity.
+	// It exists in this package in order to avoid circular dependency with the "instanceresource" package.
+	InstanceInverseTable = "instance_resources"
+	// InstanceColumn is the table column denoting the instance relation/edge.
+	InstanceColumn = "custom_object_resource_instance"
+)
+
+// Columns holds all SQL columns for customobjectresource fields.
+var Columns = []string{
+	FieldID,
+	FieldResourceID,
+	FieldName,
+	FieldSpec,
+	FieldTenantID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "custom_object_resources"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"custom_object_resource_custom_type",
+	"custom_object_resource_host",
+	"custom_object_resource_site",
+	"custom_object_resource_region",
+	"custom_object_resource_ou",
+	"custom_object_resource_instance",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// OrderOption defines the ordering options for the CustomObjectResource queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByResourceID orders the results by the resource_id field.
+func ByResourceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResourceID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// BySpec orders the results by the spec field.
+func BySpec(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSpec, opts...).ToFunc()
+}
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByCustomTypeField orders the results by custom_type field.
+func ByCustomTypeField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCustomTypeStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByHostField orders the results by host field.
+func ByHostField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newHostStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// BySiteField orders the results by site field.
+func BySiteField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSiteStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByRegionField orders the results by region field.
+func ByRegionField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRegionStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByOuField orders the results by ou field.
+func ByOuField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOuStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByInstanceField orders the results by instance field.
+func ByInstanceField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newInstanceStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newCustomTypeStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CustomTypeInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, CustomTypeTable, CustomTypeColumn),
+	)
+}
+func newHostStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(HostInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, HostTable, HostColumn),
+	)
+}
+func newSiteStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SiteInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, SiteTable, SiteColumn),
+	)
+}
+func newRegionStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RegionInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, RegionTable, RegionColumn),
+	)
+}
+func newOuStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(OuInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, OuTable, OuColumn),
+	)
+}
+func newInstanceStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(InstanceInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, InstanceTable, InstanceColumn),
+	)
+}
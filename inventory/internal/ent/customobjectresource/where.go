@@ -0,0 +1,647 @@
+// Code generated by ent, DO NOT EDIT.
+
+package customobjectresource
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.FieldLTE(FieldID, id))
+}
+
+// ResourceID applies equality check predicate on the "resource_id" field. It's identical to ResourceIDEQ.
+func ResourceID(v string) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.FieldEQ(FieldResourceID, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.FieldEQ(FieldName, v))
+}
+
+// Spec applies equality check predicate on the "spec" field. It's identical to SpecEQ.
+func Spec(v string) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.FieldEQ(FieldSpec, v))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v string) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.FieldEQ(FieldTenantID, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v string) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v string) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// ResourceIDEQ applies the EQ predicate on the "resource_id" field.
+func ResourceIDEQ(v string) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.FieldEQ(FieldResourceID, v))
+}
+
+// ResourceIDNEQ applies the NEQ predicate on the "resource_id" field.
+func ResourceIDNEQ(v string) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.FieldNEQ(FieldResourceID, v))
+}
+
+// ResourceIDIn applies the In predicate on the "resource_id" field.
+func ResourceIDIn(vs ...string) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.FieldIn(FieldResourceID, vs...))
+}
+
+// ResourceIDNotIn applies the NotIn predicate on the "resource_id" field.
+func ResourceIDNotIn(vs ...string) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.FieldNotIn(FieldResourceID, vs...))
+}
+
+// ResourceIDGT applies the GT predicate on the "resource_id" field.
+func ResourceIDGT(v string) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.FieldGT(FieldResourceID, v))
+}
+
+// ResourceIDGTE applies the GTE predicate on the "resource_id" field.
+func ResourceIDGTE(v string) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.FieldGTE(FieldResourceID, v))
+}
+
+// ResourceIDLT applies the LT predicate on the "resource_id" field.
+func ResourceIDLT(v string) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.FieldLT(FieldResourceID, v))
+}
+
+// ResourceIDLTE applies the LTE predicate on the "resource_id" field.
+func ResourceIDLTE(v string) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.FieldLTE(FieldResourceID, v))
+}
+
+// ResourceIDContains applies the Contains predicate on the "resource_id" field.
+func ResourceIDContains(v string) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.FieldContains(FieldResourceID, v))
+}
+
+// ResourceIDHasPrefix applies the HasPrefix predicate on the "resource_id" field.
+func ResourceIDHasPrefix(v string) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.FieldHasPrefix(FieldResourceID, v))
+}
+
+// ResourceIDHasSuffix applies the HasSuffix predicate on the "resource_id" field.
+func ResourceIDHasSuffix(v string) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.FieldHasSuffix(FieldResourceID, v))
+}
+
+// ResourceIDEqualFold applies the EqualFold predicate on the "resource_id" field.
+func ResourceIDEqualFold(v string) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.FieldEqualFold(FieldResourceID, v))
+}
+
+// ResourceIDContainsFold applies the ContainsFold predicate on the "resource_id" field.
+func ResourceIDContainsFold(v string) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.FieldContainsFold(FieldResourceID, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameIsNil applies the IsNil predicate on the "name" field.
+func NameIsNil() predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.FieldIsNull(FieldName))
+}
+
+// NameNotNil applies the NotNil predicate on the "name" field.
+func NameNotNil() predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.FieldNotNull(FieldName))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.FieldContainsFold(FieldName, v))
+}
+
+// SpecEQ applies the EQ predicate on the "spec" field.
+func SpecEQ(v string) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.FieldEQ(FieldSpec, v))
+}
+
+// SpecNEQ applies the NEQ predicate on the "spec" field.
+func SpecNEQ(v string) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.FieldNEQ(FieldSpec, v))
+}
+
+// SpecIn applies the In predicate on the "spec" field.
+func SpecIn(vs ...string) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.FieldIn(FieldSpec, vs...))
+}
+
+// SpecNotIn applies the NotIn predicate on the "spec" field.
+func SpecNotIn(vs ...string) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.FieldNotIn(FieldSpec, vs...))
+}
+
+// SpecGT applies the GT predicate on the "spec" field.
+func SpecGT(v string) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.FieldGT(FieldSpec, v))
+}
+
+// SpecGTE applies the GTE predicate on the "spec" field.
+func SpecGTE(v string) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.FieldGTE(FieldSpec, v))
+}
+
+// SpecLT applies the LT predicate on the "spec" field.
+func SpecLT(v string) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.FieldLT(FieldSpec, v))
+}
+
+// SpecLTE applies the LTE predicate on the "spec" field.
+func SpecLTE(v string) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.FieldLTE(FieldSpec, v))
+}
+
+// SpecContains applies the Contains predicate on the "spec" field.
+func SpecContains(v string) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.FieldContains(FieldSpec, v))
+}
+
+// SpecHasPrefix applies the HasPrefix predicate on the "spec" field.
+func SpecHasPrefix(v string) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.FieldHasPrefix(FieldSpec, v))
+}
+
+// SpecHasSuffix applies the HasSuffix predicate on the "spec" field.
+func SpecHasSuffix(v string) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.FieldHasSuffix(FieldSpec, v))
+}
+
+// SpecIsNil applies the IsNil predicate on the "spec" field.
+func SpecIsNil() predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.FieldIsNull(FieldSpec))
+}
+
+// SpecNotNil applies the NotNil predicate on the "spec" field.
+func SpecNotNil() predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.FieldNotNull(FieldSpec))
+}
+
+// SpecEqualFold applies the EqualFold predicate on the "spec" field.
+func SpecEqualFold(v string) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.FieldEqualFold(FieldSpec, v))
+}
+
+// SpecContainsFold applies the ContainsFold predicate on the "spec" field.
+func SpecContainsFold(v string) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.FieldContainsFold(FieldSpec, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v string) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v string) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...string) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...string) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v string) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v string) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v string) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v string) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TenantIDContains applies the Contains predicate on the "tenant_id" field.
+func TenantIDContains(v string) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.FieldContains(FieldTenantID, v))
+}
+
+// TenantIDHasPrefix applies the HasPrefix predicate on the "tenant_id" field.
+func TenantIDHasPrefix(v string) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.FieldHasPrefix(FieldTenantID, v))
+}
+
+// TenantIDHasSuffix applies the HasSuffix predicate on the "tenant_id" field.
+func TenantIDHasSuffix(v string) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.FieldHasSuffix(FieldTenantID, v))
+}
+
+// TenantIDEqualFold applies the EqualFold predicate on the "tenant_id" field.
+func TenantIDEqualFold(v string) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.FieldEqualFold(FieldTenantID, v))
+}
+
+// TenantIDContainsFold applies the ContainsFold predicate on the "tenant_id" field.
+func TenantIDContainsFold(v string) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.FieldContainsFold(FieldTenantID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v string) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v string) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...string) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...string) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v string) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v string) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v string) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v string) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// CreatedAtContains applies the Contains predicate on the "created_at" field.
+func CreatedAtContains(v string) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.FieldContains(FieldCreatedAt, v))
+}
+
+// CreatedAtHasPrefix applies the HasPrefix predicate on the "created_at" field.
+func CreatedAtHasPrefix(v string) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.FieldHasPrefix(FieldCreatedAt, v))
+}
+
+// CreatedAtHasSuffix applies the HasSuffix predicate on the "created_at" field.
+func CreatedAtHasSuffix(v string) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.FieldHasSuffix(FieldCreatedAt, v))
+}
+
+// CreatedAtEqualFold applies the EqualFold predicate on the "created_at" field.
+func CreatedAtEqualFold(v string) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.FieldEqualFold(FieldCreatedAt, v))
+}
+
+// CreatedAtContainsFold applies the ContainsFold predicate on the "created_at" field.
+func CreatedAtContainsFold(v string) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.FieldContainsFold(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v string) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v string) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...string) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...string) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v string) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v string) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v string) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v string) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtContains applies the Contains predicate on the "updated_at" field.
+func UpdatedAtContains(v string) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.FieldContains(FieldUpdatedAt, v))
+}
+
+// UpdatedAtHasPrefix applies the HasPrefix predicate on the "updated_at" field.
+func UpdatedAtHasPrefix(v string) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.FieldHasPrefix(FieldUpdatedAt, v))
+}
+
+// UpdatedAtHasSuffix applies the HasSuffix predicate on the "updated_at" field.
+func UpdatedAtHasSuffix(v string) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.FieldHasSuffix(FieldUpdatedAt, v))
+}
+
+// UpdatedAtEqualFold applies the EqualFold predicate on the "updated_at" field.
+func UpdatedAtEqualFold(v string) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.FieldEqualFold(FieldUpdatedAt, v))
+}
+
+// UpdatedAtContainsFold applies the ContainsFold predicate on the "updated_at" field.
+func UpdatedAtContainsFold(v string) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.FieldContainsFold(FieldUpdatedAt, v))
+}
+
+// HasCustomType applies the HasEdge predicate on the "custom_type" edge.
+func HasCustomType() predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, CustomTypeTable, CustomTypeColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCustomTypeWith applies the HasEdge predicate on the "custom_type" edge with a given conditions (other predicates).
+func HasCustomTypeWith(preds ...predicate.CustomTypeResource) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(func(s *sql.Selector) {
+		step := newCustomTypeStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasHost applies the HasEdge predicate on the "host" edge.
+func HasHost() predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, HostTable, HostColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasHostWith applies the HasEdge predicate on the "host" edge with a given conditions (other predicates).
+func HasHostWith(preds ...predicate.HostResource) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(func(s *sql.Selector) {
+		step := newHostStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSite applies the HasEdge predicate on the "site" edge.
+func HasSite() predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, SiteTable, SiteColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSiteWith applies the HasEdge predicate on the "site" edge with a given conditions (other predicates).
+func HasSiteWith(preds ...predicate.SiteResource) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(func(s *sql.Selector) {
+		step := newSiteStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRegion applies the HasEdge predicate on the "region" edge.
+func HasRegion() predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, RegionTable, RegionColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRegionWith applies the HasEdge predicate on the "region" edge with a given conditions (other predicates).
+func HasRegionWith(preds ...predicate.RegionResource) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(func(s *sql.Selector) {
+		step := newRegionStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasOu applies the HasEdge predicate on the "ou" edge.
+func HasOu() predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, OuTable, OuColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOuWith applies the HasEdge predicate on the "ou" edge with a given conditions (other predicates).
+func HasOuWith(preds ...predicate.OuResource) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(func(s *sql.Selector) {
+		step := newOuStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasInstance applies the HasEdge predicate on the "instance" edge.
+func HasInstance() predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, InstanceTable, InstanceColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasInstanceWith applies the HasEdge predicate on the "instance" edge with a given conditions (other predicates).
+func HasInstanceWith(preds ...predicate.InstanceResource) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(func(s *sql.Selector) {
+		step := newInstanceStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.CustomObjectResource) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.CustomObjectResource) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.CustomObjectResource) predicate.CustomObjectResource {
+	return predicate.CustomObjectResource(sql.NotPredicates(p))
+}
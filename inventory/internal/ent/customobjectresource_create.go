@@ -0,0 +1,475 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/customobjectresource"
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/customtyperesource"
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/hostresource"
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/instanceresource"
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/ouresource"
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/regionresource"
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/siteresource"
+)
+
+// CustomObjectResourceCreate is the builder for creating a CustomObjectResource entity.
+type CustomObjectResourceCreate struct {
+	config
+	mutation *CustomObjectResourceMutation
+	hooks    []Hook
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_c *CustomObjectResourceCreate) SetResourceID(v string) *CustomObjectResourceCreate {
+	_c.mutation.SetResourceID(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *CustomObjectResourceCreate) SetName(v string) *CustomObjectResourceCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_c *CustomObjectResourceCreate) SetNillableName(v *string) *CustomObjectResourceCreate {
+	if v != nil {
+		_c.SetName(*v)
+	}
+	return _c
+}
+
+// SetSpec sets the "spec" field.
+func (_c *CustomObjectResourceCreate) SetSpec(v string) *CustomObjectResourceCreate {
+	_c.mutation.SetSpec(v)
+	return _c
+}
+
+// SetNillableSpec sets the "spec" field if the given value is not nil.
+func (_c *CustomObjectResourceCreate) SetNillableSpec(v *string) *CustomObjectResourceCreate {
+	if v != nil {
+		_c.SetSpec(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *CustomObjectResourceCreate) SetTenantID(v string) *CustomObjectResourceCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *CustomObjectResourceCreate) SetCreatedAt(v string) *CustomObjectResourceCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *CustomObjectResourceCreate) SetUpdatedAt(v string) *CustomObjectResourceCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetCustomTypeID sets the "custom_type" edge to the CustomTypeResource entity by ID.
+func (_c *CustomObjectResourceCreate) SetCustomTypeID(id int) *CustomObjectResourceCreate {
+	_c.mutation.SetCustomTypeID(id)
+	return _c
+}
+
+// SetCustomType sets the "custom_type" edge to the CustomTypeResource entity.
+func (_c *CustomObjectResourceCreate) SetCustomType(v *CustomTypeResource) *CustomObjectResourceCreate {
+	return _c.SetCustomTypeID(v.ID)
+}
+
+// SetHostID sets the "host" edge to the HostResource entity by ID.
+func (_c *CustomObjectResourceCreate) SetHostID(id int) *CustomObjectResourceCreate {
+	_c.mutation.SetHostID(id)
+	return _c
+}
+
+// SetNillableHostID sets the "host" edge to the HostResource entity by ID if the given value is not nil.
+func (_c *CustomObjectResourceCreate) SetNillableHostID(id *int) *CustomObjectResourceCreate {
+	if id != nil {
+		_c = _c.SetHostID(*id)
+	}
+	return _c
+}
+
+// SetHost sets the "host" edge to the HostResource entity.
+func (_c *CustomObjectResourceCreate) SetHost(v *HostResource) *CustomObjectResourceCreate {
+	return _c.SetHostID(v.ID)
+}
+
+// SetSiteID sets the "site" edge to the SiteResource entity by ID.
+func (_c *CustomObjectResourceCreate) SetSiteID(id int) *CustomObjectResourceCreate {
+	_c.mutation.SetSiteID(id)
+	return _c
+}
+
+// SetNillableSiteID sets the "site" edge to the SiteResource entity by ID if the given value is not nil.
+func (_c *CustomObjectResourceCreate) SetNillableSiteID(id *int) *CustomObjectResourceCreate {
+	if id != nil {
+		_c = _c.SetSiteID(*id)
+	}
+	return _c
+}
+
+// SetSite sets the "site" edge to the SiteResource entity.
+func (_c *CustomObjectResourceCreate) SetSite(v *SiteResource) *CustomObjectResourceCreate {
+	return _c.SetSiteID(v.ID)
+}
+
+// SetRegionID sets the "region" edge to the RegionResource entity by ID.
+func (_c *CustomObjectResourceCreate) SetRegionID(id int) *CustomObjectResourceCreate {
+	_c.mutation.SetRegionID(id)
+	return _c
+}
+
+// SetNillableRegionID sets the "region" edge to the RegionResource entity by ID if the given value is not nil.
+func (_c *CustomObjectResourceCreate) SetNillableRegionID(id *int) *CustomObjectResourceCreate {
+	if id != nil {
+		_c = _c.SetRegionID(*id)
+	}
+	return _c
+}
+
+// SetRegion sets the "region" edge to the RegionResource entity.
+func (_c *CustomObjectResourceCreate) SetRegion(v *RegionResource) *CustomObjectResourceCreate {
+	return _c.SetRegionID(v.ID)
+}
+
+// SetOuID sets the "ou" edge to the OuResource entity by ID.
+func (_c *CustomObjectResourceCreate) SetOuID(id int) *CustomObjectResourceCreate {
+	_c.mutation.SetOuID(id)
+	return _c
+}
+
+// SetNillableOuID sets the "ou" edge to the OuResource entity by ID if the given value is not nil.
+func (_c *CustomObjectResourceCreate) SetNillableOuID(id *int) *CustomObjectResourceCreate {
+	if id != nil {
+		_c = _c.SetOuID(*id)
+	}
+	return _c
+}
+
+// SetOu sets the "ou" edge to the OuResource entity.
+func (_c *CustomObjectResourceCreate) SetOu(v *OuResource) *CustomObjectResourceCreate {
+	return _c.SetOuID(v.ID)
+}
+
+// SetInstanceID sets the "instance" edge to the InstanceResource entity by ID.
+func (_c *CustomObjectResourceCreate) SetInstanceID(id int) *CustomObjectResourceCreate {
+	_c.mutation.SetInstanceID(id)
+	return _c
+}
+
+// SetNillableInstanceID sets the "instance" edge to the InstanceResource entity by ID if the given value is not nil.
+func (_c *CustomObjectResourceCreate) SetNillableInstanceID(id *int) *CustomObjectResourceCreate {
+	if id != nil {
+		_c = _c.SetInstanceID(*id)
+	}
+	return _c
+}
+
+// SetInstance sets the "instance" edge to the InstanceResource entity.
+func (_c *CustomObjectResourceCreate) SetInstance(v *InstanceResource) *CustomObjectResourceCreate {
+	return _c.SetInstanceID(v.ID)
+}
+
+// Mutation returns the CustomObjectResourceMutation object of the builder.
+func (_c *CustomObjectResourceCreate) Mutation() *CustomObjectResourceMutation {
+	return _c.mutation
+}
+
+// Save creates the CustomObjectResource in the database.
+func (_c *CustomObjectResourceCreate) Save(ctx context.Context) (*CustomObjectResource, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *CustomObjectResourceCreate) SaveX(ctx context.Context) *CustomObjectResource {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CustomObjectResourceCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CustomObjectResourceCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *CustomObjectResourceCreate) check() error {
+	if _, ok := _c.mutation.ResourceID(); !ok {
+		return &ValidationError{Name: "resource_id", err: errors.New(`ent: missing required field "CustomObjectResource.resource_id"`)}
+	}
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "CustomObjectResource.tenant_id"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "CustomObjectResource.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "CustomObjectResource.updated_at"`)}
+	}
+	if len(_c.mutation.CustomTypeIDs()) == 0 {
+		return &ValidationError{Name: "custom_type", err: errors.New(`ent: missing required edge "CustomObjectResource.custom_type"`)}
+	}
+	return nil
+}
+
+func (_c *CustomObjectResourceCreate) sqlSave(ctx context.Context) (*CustomObjectResource, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *CustomObjectResourceCreate) createSpec() (*CustomObjectResource, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CustomObjectResource{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(customobjectresource.Table, sqlgraph.NewFieldSpec(customobjectresource.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.ResourceID(); ok {
+		_spec.SetField(customobjectresource.FieldResourceID, field.TypeString, value)
+		_node.ResourceID = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(customobjectresource.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Spec(); ok {
+		_spec.SetField(customobjectresource.FieldSpec, field.TypeString, value)
+		_node.Spec = value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(customobjectresource.FieldTenantID, field.TypeString, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(customobjectresource.FieldCreatedAt, field.TypeString, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(customobjectresource.FieldUpdatedAt, field.TypeString, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.CustomTypeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   customobjectresource.CustomTypeTable,
+			Columns: []string{customobjectresource.CustomTypeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(customtyperesource.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.custom_object_resource_custom_type = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.HostIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   customobjectresource.HostTable,
+			Columns: []string{customobjectresource.HostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(hostresource.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.custom_object_resource_host = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.SiteIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   customobjectresource.SiteTable,
+			Columns: []string{customobjectresource.SiteColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(siteresource.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.custom_object_resource_site = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.RegionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   customobjectresource.RegionTable,
+			Columns: []string{customobjectresource.RegionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(regionresource.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.custom_object_resource_region = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.OuIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   customobjectresource.OuTable,
+			Columns: []string{customobjectresource.OuColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ouresource.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.custom_object_resource_ou = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.InstanceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   customobjectresource.InstanceTable,
+			Columns: []string{customobjectresource.InstanceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(instanceresource.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.custom_object_resource_instance = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// CustomObjectResourceCreateBulk is the builder for creating many CustomObjectResource entities in bulk.
+type CustomObjectResourceCreateBulk struct {
+	config
+	err      error
+	builders []*CustomObjectResourceCreate
+}
+
+// Save creates the CustomObjectResource entities in the database.
+func (_c *CustomObjectResourceCreateBulk) Save(ctx context.Context) ([]*CustomObjectResource, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*CustomObjectResource, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CustomObjectResourceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *CustomObjectResourceCreateBulk) SaveX(ctx context.Context) []*CustomObjectResource {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CustomObjectResourceCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CustomObjectResourceCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
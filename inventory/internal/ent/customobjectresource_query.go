@@ -0,0 +1,989 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/customobjectresource"
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/customtyperesource"
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/hostresource"
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/instanceresource"
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/ouresource"
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/predicate"
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/regionresource"
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/siteresource"
+)
+
+// CustomObjectResourceQuery is the builder for querying CustomObjectResource entities.
+type CustomObjectResourceQuery struct {
+	config
+	ctx            *QueryContext
+	order          []customobjectresource.OrderOption
+	inters         []Interceptor
+	predicates     []predicate.CustomObjectResource
+	withCustomType *CustomTypeResourceQuery
+	withHost       *HostResourceQuery
+	withSite       *SiteResourceQuery
+	withRegion     *RegionResourceQuery
+	withOu         *OuResourceQuery
+	withInstance   *InstanceResourceQuery
+	withFKs        bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the CustomObjectResourceQuery builder.
+func (_q *CustomObjectResourceQuery) Where(ps ...predicate.CustomObjectResource) *CustomObjectResourceQuery {
+	_q.predicates = append(_q.predicates, ps...)
+	return _q
+}
+
+// Limit the number of records to be returned by this query.
+func (_q *CustomObjectResourceQuery) Limit(limit int) *CustomObjectResourceQuery {
+	_q.ctx.Limit = &limit
+	return _q
+}
+
+// Offset to start from.
+func (_q *CustomObjectResourceQuery) Offset(offset int) *CustomObjectResourceQuery {
+	_q.ctx.Offset = &offset
+	return _q
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (_q *CustomObjectResourceQuery) Unique(unique bool) *CustomObjectResourceQuery {
+	_q.ctx.Unique = &unique
+	return _q
+}
+
+// Order specifies how the records should be ordered.
+func (_q *CustomObjectResourceQuery) Order(o ...customobjectresource.OrderOption) *CustomObjectResourceQuery {
+	_q.order = append(_q.order, o...)
+	return _q
+}
+
+// QueryCustomType chains the current query on the "custom_type" edge.
+func (_q *CustomObjectResourceQuery) QueryCustomType() *CustomTypeResourceQuery {
+	query := (&CustomTypeResourceClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(customobjectresource.Table, customobjectresource.FieldID, selector),
+			sqlgraph.To(customtyperesource.Table, customtyperesource.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, customobjectresource.CustomTypeTable, customobjectresource.CustomTypeColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryHost chains the current query on the "host" edge.
+func (_q *CustomObjectResourceQuery) QueryHost() *HostResourceQuery {
+	query := (&HostResourceClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(customobjectresource.Table, customobjectresource.FieldID, selector),
+			sqlgraph.To(hostresource.Table, hostresource.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, customobjectresource.HostTable, customobjectresource.HostColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySite chains the current query on the "site" edge.
+func (_q *CustomObjectResourceQuery) QuerySite() *SiteResourceQuery {
+	query := (&SiteResourceClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(customobjectresource.Table, customobjectresource.FieldID, selector),
+			sqlgraph.To(siteresource.Table, siteresource.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, customobjectresource.SiteTable, customobjectresource.SiteColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryRegion chains the current query on the "region" edge.
+func (_q *CustomObjectResourceQuery) QueryRegion() *RegionResourceQuery {
+	query := (&RegionResourceClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(customobjectresource.Table, customobjectresource.FieldID, selector),
+			sqlgraph.To(regionresource.Table, regionresource.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, customobjectresource.RegionTable, customobjectresource.RegionColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryOu chains the current query on the "ou" edge.
+func (_q *CustomObjectResourceQuery) QueryOu() *OuResourceQuery {
+	query := (&OuResourceClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(customobjectresource.Table, customobjectresource.FieldID, selector),
+			sqlgraph.To(ouresource.Table, ouresource.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, customobjectresource.OuTable, customobjectresource.OuColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryInstance chains the current query on the "instance" edge.
+func (_q *CustomObjectResourceQuery) QueryInstance() *InstanceResourceQuery {
+	query := (&InstanceResourceClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(customobjectresource.Table, customobjectresource.FieldID, selector),
+			sqlgraph.To(instanceresource.Table, instanceresource.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, customobjectresource.InstanceTable, customobjectresource.InstanceColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first CustomObjectResource entity from the query.
+// Returns a *NotFoundError when no CustomObjectResource was found.
+func (_q *CustomObjectResourceQuery) First(ctx context.Context) (*CustomObjectResource, error) {
+	nodes, err := _q.Limit(1).All(setContextOp(ctx, _q.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{customobjectresource.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (_q *CustomObjectResourceQuery) FirstX(ctx context.Context) *CustomObjectResource {
+	node, err := _q.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first CustomObjectResource ID from the query.
+// Returns a *NotFoundError when no CustomObjectResource ID was found.
+func (_q *CustomObjectResourceQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = _q.Limit(1).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{customobjectresource.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (_q *CustomObjectResourceQuery) FirstIDX(ctx context.Context) int {
+	id, err := _q.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single CustomObjectResource entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one CustomObjectResource entity is found.
+// Returns a *NotFoundError when no CustomObjectResource entities are found.
+func (_q *CustomObjectResourceQuery) Only(ctx context.Context) (*CustomObjectResource, error) {
+	nodes, err := _q.Limit(2).All(setContextOp(ctx, _q.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{customobjectresource.Label}
+	default:
+		return nil, &NotSingularError{customobjectresource.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (_q *CustomObjectResourceQuery) OnlyX(ctx context.Context) *CustomObjectResource {
+	node, err := _q.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only CustomObjectResource ID in the query.
+// Returns a *NotSingularError when more than one CustomObjectResource ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (_q *CustomObjectResourceQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = _q.Limit(2).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{customobjectresource.Label}
+	default:
+		err = &NotSingularError{customobjectresource.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (_q *CustomObjectResourceQuery) OnlyIDX(ctx context.Context) int {
+	id, err := _q.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of CustomObjectResources.
+func (_q *CustomObjectResourceQuery) All(ctx context.Context) ([]*CustomObjectResource, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryAll)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*CustomObjectResource, *CustomObjectResourceQuery]()
+	return withInterceptors[[]*CustomObjectResource](ctx, _q, qr, _q.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (_q *CustomObjectResourceQuery) AllX(ctx context.Context) []*CustomObjectResource {
+	nodes, err := _q.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of CustomObjectResource IDs.
+func (_q *CustomObjectResourceQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if _q.ctx.Unique == nil && _q.path != nil {
+		_q.Unique(true)
+	}
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryIDs)
+	if err = _q.Select(customobjectresource.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (_q *CustomObjectResourceQuery) IDsX(ctx context.Context) []int {
+	ids, err := _q.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (_q *CustomObjectResourceQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryCount)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, _q, querierCount[*CustomObjectResourceQuery](), _q.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (_q *CustomObjectResourceQuery) CountX(ctx context.Context) int {
+	count, err := _q.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (_q *CustomObjectResourceQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryExist)
+	switch _, err := _q.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (_q *CustomObjectResourceQuery) ExistX(ctx context.Context) bool {
+	exist, err := _q.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the CustomObjectResourceQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (_q *CustomObjectResourceQuery) Clone() *CustomObjectResourceQuery {
+	if _q == nil {
+		return nil
+	}
+	return &CustomObjectResourceQuery{
+		config:         _q.config,
+		ctx:            _q.ctx.Clone(),
+		order:          append([]customobjectresource.OrderOption{}, _q.order...),
+		inters:         append([]Interceptor{}, _q.inters...),
+		predicates:     append([]predicate.CustomObjectResource{}, _q.predicates...),
+		withCustomType: _q.withCustomType.Clone(),
+		withHost:       _q.withHost.Clone(),
+		withSite:       _q.withSite.Clone(),
+		withRegion:     _q.withRegion.Clone(),
+		withOu:         _q.withOu.Clone(),
+		withInstance:   _q.withInstance.Clone(),
+		// clone intermediate query.
+		sql:  _q.sql.Clone(),
+		path: _q.path,
+	}
+}
+
+// WithCustomType tells the query-builder to eager-load the nodes that are connected to
+// the "custom_type" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *CustomObjectResourceQuery) WithCustomType(opts ...func(*CustomTypeResourceQuery)) *CustomObjectResourceQuery {
+	query := (&CustomTypeResourceClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withCustomType = query
+	return _q
+}
+
+// WithHost tells the query-builder to eager-load the nodes that are connected to
+// the "host" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *CustomObjectResourceQuery) WithHost(opts ...func(*HostResourceQuery)) *CustomObjectResourceQuery {
+	query := (&HostResourceClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withHost = query
+	return _q
+}
+
+// WithSite tells the query-builder to eager-load the nodes that are connected to
+// the "site" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *CustomObjectResourceQuery) WithSite(opts ...func(*SiteResourceQuery)) *CustomObjectResourceQuery {
+	query := (&SiteResourceClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withSite = query
+	return _q
+}
+
+// WithRegion tells the query-builder to eager-load the nodes that are connected to
+// the "region" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *CustomObjectResourceQuery) WithRegion(opts ...func(*RegionResourceQuery)) *CustomObjectResourceQuery {
+	query := (&RegionResourceClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withRegion = query
+	return _q
+}
+
+// WithOu tells the query-builder to eager-load the nodes that are connected to
+// the "ou" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *CustomObjectResourceQuery) WithOu(opts ...func(*OuResourceQuery)) *CustomObjectResourceQuery {
+	query := (&OuResourceClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withOu = query
+	return _q
+}
+
+// WithInstance tells the query-builder to eager-load the nodes that are connected to
+// the "instance" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *CustomObjectResourceQuery) WithInstance(opts ...func(*InstanceResourceQuery)) *CustomObjectResourceQuery {
+	query := (&InstanceResourceClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withInstance = query
+	return _q
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		ResourceID string `json:"resource_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.CustomObjectResource.Query().
+//		GroupBy(customobjectresource.FieldResourceID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (_q *CustomObjectResourceQuery) GroupBy(field string, fields ...string) *CustomObjectResourceGroupBy {
+	_q.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &CustomObjectResourceGroupBy{build: _q}
+	grbuild.flds = &_q.ctx.Fields
+	grbuild.label = customobjectresource.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		ResourceID string `json:"resource_id,omitempty"`
+//	}
+//
+//	client.CustomObjectResource.Query().
+//		Select(customobjectresource.FieldResourceID).
+//		Scan(ctx, &v)
+func (_q *CustomObjectResourceQuery) Select(fields ...string) *CustomObjectResourceSelect {
+	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
+	sbuild := &CustomObjectResourceSelect{CustomObjectResourceQuery: _q}
+	sbuild.label = customobjectresource.Label
+	sbuild.flds, sbuild.scan = &_q.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a CustomObjectResourceSelect configured with the given aggregations.
+func (_q *CustomObjectResourceQuery) Aggregate(fns ...AggregateFunc) *CustomObjectResourceSelect {
+	return _q.Select().Aggregate(fns...)
+}
+
+func (_q *CustomObjectResourceQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range _q.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, _q); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range _q.ctx.Fields {
+		if !customobjectresource.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if _q.path != nil {
+		prev, err := _q.path(ctx)
+		if err != nil {
+			return err
+		}
+		_q.sql = prev
+	}
+	return nil
+}
+
+func (_q *CustomObjectResourceQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*CustomObjectResource, error) {
+	var (
+		nodes       = []*CustomObjectResource{}
+		withFKs     = _q.withFKs
+		_spec       = _q.querySpec()
+		loadedTypes = [6]bool{
+			_q.withCustomType != nil,
+			_q.withHost != nil,
+			_q.withSite != nil,
+			_q.withRegion != nil,
+			_q.withOu != nil,
+			_q.withInstance != nil,
+		}
+	)
+	if _q.withCustomType != nil || _q.withHost != nil || _q.withSite != nil || _q.withRegion != nil || _q.withOu != nil || _q.withInstance != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, customobjectresource.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*CustomObjectResource).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &CustomObjectResource{config: _q.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, _q.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := _q.withCustomType; query != nil {
+		if err := _q.loadCustomType(ctx, query, nodes, nil,
+			func(n *CustomObjectResource, e *CustomTypeResource) { n.Edges.CustomType = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withHost; query != nil {
+		if err := _q.loadHost(ctx, query, nodes, nil,
+			func(n *CustomObjectResource, e *HostResource) { n.Edges.Host = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withSite; query != nil {
+		if err := _q.loadSite(ctx, query, nodes, nil,
+			func(n *CustomObjectResource, e *SiteResource) { n.Edges.Site = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withRegion; query != nil {
+		if err := _q.loadRegion(ctx, query, nodes, nil,
+			func(n *CustomObjectResource, e *RegionResource) { n.Edges.Region = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withOu; query != nil {
+		if err := _q.loadOu(ctx, query, nodes, nil,
+			func(n *CustomObjectResource, e *OuResource) { n.Edges.Ou = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withInstance; query != nil {
+		if err := _q.loadInstance(ctx, query, nodes, nil,
+			func(n *CustomObjectResource, e *InstanceResource) { n.Edges.Instance = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (_q *CustomObjectResourceQuery) loadCustomType(ctx context.Context, query *CustomTypeResourceQuery, nodes []*CustomObjectResource, init func(*CustomObjectResource), assign func(*CustomObjectResource, *CustomTypeResource)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*CustomObjectResource)
+	for i := range nodes {
+		if nodes[i].custom_object_resource_custom_type == nil {
+			continue
+		}
+		fk := *nodes[i].custom_object_resource_custom_type
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(customtyperesource.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "custom_object_resource_custom_type" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *CustomObjectResourceQuery) loadHost(ctx context.Context, query *HostResourceQuery, nodes []*CustomObjectResource, init func(*CustomObjectResource), assign func(*CustomObjectResource, *HostResource)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*CustomObjectResource)
+	for i := range nodes {
+		if nodes[i].custom_object_resource_host == nil {
+			continue
+		}
+		fk := *nodes[i].custom_object_resource_host
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(hostresource.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "custom_object_resource_host" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *CustomObjectResourceQuery) loadSite(ctx context.Context, query *SiteResourceQuery, nodes []*CustomObjectResource, init func(*CustomObjectResource), assign func(*CustomObjectResource, *SiteResource)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*CustomObjectResource)
+	for i := range nodes {
+		if nodes[i].custom_object_resource_site == nil {
+			continue
+		}
+		fk := *nodes[i].custom_object_resource_site
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(siteresource.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "custom_object_resource_site" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *CustomObjectResourceQuery) loadRegion(ctx context.Context, query *RegionResourceQuery, nodes []*CustomObjectResource, init func(*CustomObjectResource), assign func(*CustomObjectResource, *RegionResource)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*CustomObjectResource)
+	for i := range nodes {
+		if nodes[i].custom_object_resource_region == nil {
+			continue
+		}
+		fk := *nodes[i].custom_object_resource_region
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(regionresource.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "custom_object_resource_region" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *CustomObjectResourceQuery) loadOu(ctx context.Context, query *OuResourceQuery, nodes []*CustomObjectResource, init func(*CustomObjectResource), assign func(*CustomObjectResource, *OuResource)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*CustomObjectResource)
+	for i := range nodes {
+		if nodes[i].custom_object_resource_ou == nil {
+			continue
+		}
+		fk := *nodes[i].custom_object_resource_ou
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(ouresource.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "custom_object_resource_ou" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *CustomObjectResourceQuery) loadInstance(ctx context.Context, query *InstanceResourceQuery, nodes []*CustomObjectResource, init func(*CustomObjectResource), assign func(*CustomObjectResource, *InstanceResource)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*CustomObjectResource)
+	for i := range nodes {
+		if nodes[i].custom_object_resource_instance == nil {
+			continue
+		}
+		fk := *nodes[i].custom_object_resource_instance
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(instanceresource.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "custom_object_resource_instance" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (_q *CustomObjectResourceQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := _q.querySpec()
+	_spec.Node.Columns = _q.ctx.Fields
+	if len(_q.ctx.Fields) > 0 {
+		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, _q.driver, _spec)
+}
+
+func (_q *CustomObjectResourceQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(customobjectresource.Table, customobjectresource.Columns, sqlgraph.NewFieldSpec(customobjectresource.FieldID, field.TypeInt))
+	_spec.From = _q.sql
+	if unique := _q.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if _q.path != nil {
+		_spec.Unique = true
+	}
+	if fields := _q.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, customobjectresource.FieldID)
+		for i := range fields {
+			if fields[i] != customobjectresource.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := _q.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := _q.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (_q *CustomObjectResourceQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(_q.driver.Dialect())
+	t1 := builder.Table(customobjectresource.Table)
+	columns := _q.ctx.Fields
+	if len(columns) == 0 {
+		columns = customobjectresource.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if _q.sql != nil {
+		selector = _q.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if _q.ctx.Unique != nil && *_q.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range _q.predicates {
+		p(selector)
+	}
+	for _, p := range _q.order {
+		p(selector)
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// CustomObjectResourceGroupBy is the group-by builder for CustomObjectResource entities.
+type CustomObjectResourceGroupBy struct {
+	selector
+	build *CustomObjectResourceQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (_g *CustomObjectResourceGroupBy) Aggregate(fns ...AggregateFunc) *CustomObjectResourceGroupBy {
+	_g.fns = append(_g.fns, fns...)
+	return _g
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_g *CustomObjectResourceGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _g.build.ctx, ent.OpQueryGroupBy)
+	if err := _g.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*CustomObjectResourceQuery, *CustomObjectResourceGroupBy](ctx, _g.build, _g, _g.build.inters, v)
+}
+
+func (_g *CustomObjectResourceGroupBy) sqlScan(ctx context.Context, root *CustomObjectResourceQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(_g.fns))
+	for _, fn := range _g.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*_g.flds)+len(_g.fns))
+		for _, f := range *_g.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// CustomObjectResourceSelect is the builder for selecting fields of CustomObjectResource entities.
+type CustomObjectResourceSelect struct {
+	*CustomObjectResourceQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (_s *CustomObjectResourceSelect) Aggregate(fns ...AggregateFunc) *CustomObjectResourceSelect {
+	_s.fns = append(_s.fns, fns...)
+	return _s
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_s *CustomObjectResourceSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _s.ctx, ent.OpQuerySelect)
+	if err := _s.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*CustomObjectResourceQuery, *CustomObjectResourceSelect](ctx, _s.CustomObjectResourceQuery, _s, _s.inters, v)
+}
+
+func (_s *CustomObjectResourceSelect) sqlScan(ctx context.Context, root *CustomObjectResourceQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(_s.fns))
+	for _, fn := range _s.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*_s.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
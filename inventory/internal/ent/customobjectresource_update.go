@@ -0,0 +1,1007 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/customobjectresource"
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/customtyperesource"
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/hostresource"
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/instanceresource"
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/ouresource"
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/predicate"
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/regionresource"
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/siteresource"
+)
+
+// CustomObjectResourceUpdate is the builder for updating CustomObjectResource entities.
+type CustomObjectResourceUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CustomObjectResourceMutation
+}
+
+// Where appends a list predicates to the CustomObjectResourceUpdate builder.
+func (_u *CustomObjectResourceUpdate) Where(ps ...predicate.CustomObjectResource) *CustomObjectResourceUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_u *CustomObjectResourceUpdate) SetResourceID(v string) *CustomObjectResourceUpdate {
+	_u.mutation.SetResourceID(v)
+	return _u
+}
+
+// SetNillableResourceID sets the "resource_id" field if the given value is not nil.
+func (_u *CustomObjectResourceUpdate) SetNillableResourceID(v *string) *CustomObjectResourceUpdate {
+	if v != nil {
+		_u.SetResourceID(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *CustomObjectResourceUpdate) SetName(v string) *CustomObjectResourceUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *CustomObjectResourceUpdate) SetNillableName(v *string) *CustomObjectResourceUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// ClearName clears the value of the "name" field.
+func (_u *CustomObjectResourceUpdate) ClearName() *CustomObjectResourceUpdate {
+	_u.mutation.ClearName()
+	return _u
+}
+
+// SetSpec sets the "spec" field.
+func (_u *CustomObjectResourceUpdate) SetSpec(v string) *CustomObjectResourceUpdate {
+	_u.mutation.SetSpec(v)
+	return _u
+}
+
+// SetNillableSpec sets the "spec" field if the given value is not nil.
+func (_u *CustomObjectResourceUpdate) SetNillableSpec(v *string) *CustomObjectResourceUpdate {
+	if v != nil {
+		_u.SetSpec(*v)
+	}
+	return _u
+}
+
+// ClearSpec clears the value of the "spec" field.
+func (_u *CustomObjectResourceUpdate) ClearSpec() *CustomObjectResourceUpdate {
+	_u.mutation.ClearSpec()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *CustomObjectResourceUpdate) SetUpdatedAt(v string) *CustomObjectResourceUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *CustomObjectResourceUpdate) SetNillableUpdatedAt(v *string) *CustomObjectResourceUpdate {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// SetCustomTypeID sets the "custom_type" edge to the CustomTypeResource entity by ID.
+func (_u *CustomObjectResourceUpdate) SetCustomTypeID(id int) *CustomObjectResourceUpdate {
+	_u.mutation.SetCustomTypeID(id)
+	return _u
+}
+
+// SetCustomType sets the "custom_type" edge to the CustomTypeResource entity.
+func (_u *CustomObjectResourceUpdate) SetCustomType(v *CustomTypeResource) *CustomObjectResourceUpdate {
+	return _u.SetCustomTypeID(v.ID)
+}
+
+// SetHostID sets the "host" edge to the HostResource entity by ID.
+func (_u *CustomObjectResourceUpdate) SetHostID(id int) *CustomObjectResourceUpdate {
+	_u.mutation.SetHostID(id)
+	return _u
+}
+
+// SetNillableHostID sets the "host" edge to the HostResource entity by ID if the given value is not nil.
+func (_u *CustomObjectResourceUpdate) SetNillableHostID(id *int) *CustomObjectResourceUpdate {
+	if id != nil {
+		_u = _u.SetHostID(*id)
+	}
+	return _u
+}
+
+// SetHost sets the "host" edge to the HostResource entity.
+func (_u *CustomObjectResourceUpdate) SetHost(v *HostResource) *CustomObjectResourceUpdate {
+	return _u.SetHostID(v.ID)
+}
+
+// SetSiteID sets the "site" edge to the SiteResource entity by ID.
+func (_u *CustomObjectResourceUpdate) SetSiteID(id int) *CustomObjectResourceUpdate {
+	_u.mutation.SetSiteID(id)
+	return _u
+}
+
+// SetNillableSiteID sets the "site" edge to the SiteResource entity by ID if the given value is not nil.
+func (_u *CustomObjectResourceUpdate) SetNillableSiteID(id *int) *CustomObjectResourceUpdate {
+	if id != nil {
+		_u = _u.SetSiteID(*id)
+	}
+	return _u
+}
+
+// SetSite sets the "site" edge to the SiteResource entity.
+func (_u *CustomObjectResourceUpdate) SetSite(v *SiteResource) *CustomObjectResourceUpdate {
+	return _u.SetSiteID(v.ID)
+}
+
+// SetRegionID sets the "region" edge to the RegionResource entity by ID.
+func (_u *CustomObjectResourceUpdate) SetRegionID(id int) *CustomObjectResourceUpdate {
+	_u.mutation.SetRegionID(id)
+	return _u
+}
+
+// SetNillableRegionID sets the "region" edge to the RegionResource entity by ID if the given value is not nil.
+func (_u *CustomObjectResourceUpdate) SetNillableRegionID(id *int) *CustomObjectResourceUpdate {
+	if id != nil {
+		_u = _u.SetRegionID(*id)
+	}
+	return _u
+}
+
+// SetRegion sets the "region" edge to the RegionResource entity.
+func (_u *CustomObjectResourceUpdate) SetRegion(v *RegionResource) *CustomObjectResourceUpdate {
+	return _u.SetRegionID(v.ID)
+}
+
+// SetOuID sets the "ou" edge to the OuResource entity by ID.
+func (_u *CustomObjectResourceUpdate) SetOuID(id int) *CustomObjectResourceUpdate {
+	_u.mutation.SetOuID(id)
+	return _u
+}
+
+// SetNillableOuID sets the "ou" edge to the OuResource entity by ID if the given value is not nil.
+func (_u *CustomObjectResourceUpdate) SetNillableOuID(id *int) *CustomObjectResourceUpdate {
+	if id != nil {
+		_u = _u.SetOuID(*id)
+	}
+	return _u
+}
+
+// SetOu sets the "ou" edge to the OuResource entity.
+func (_u *CustomObjectResourceUpdate) SetOu(v *OuResource) *CustomObjectResourceUpdate {
+	return _u.SetOuID(v.ID)
+}
+
+// SetInstanceID sets the "instance" edge to the InstanceResource entity by ID.
+func (_u *CustomObjectResourceUpdate) SetInstanceID(id int) *CustomObjectResourceUpdate {
+	_u.mutation.SetInstanceID(id)
+	return _u
+}
+
+// SetNillableInstanceID sets the "instance" edge to the InstanceResource entity by ID if the given value is not nil.
+func (_u *CustomObjectResourceUpdate) SetNillableInstanceID(id *int) *CustomObjectResourceUpdate {
+	if id != nil {
+		_u = _u.SetInstanceID(*id)
+	}
+	return _u
+}
+
+// SetInstance sets the "instance" edge to the InstanceResource entity.
+func (_u *CustomObjectResourceUpdate) SetInstance(v *InstanceResource) *CustomObjectResourceUpdate {
+	return _u.SetInstanceID(v.ID)
+}
+
+// Mutation returns the CustomObjectResourceMutation object of the builder.
+func (_u *CustomObjectResourceUpdate) Mutation() *CustomObjectResourceMutation {
+	return _u.mutation
+}
+
+// ClearCustomType clears the "custom_type" edge to the CustomTypeResource entity.
+func (_u *CustomObjectResourceUpdate) ClearCustomType() *CustomObjectResourceUpdate {
+	_u.mutation.ClearCustomType()
+	return _u
+}
+
+// ClearHost clears the "host" edge to the HostResource entity.
+func (_u *CustomObjectResourceUpdate) ClearHost() *CustomObjectResourceUpdate {
+	_u.mutation.ClearHost()
+	return _u
+}
+
+// ClearSite clears the "site" edge to the SiteResource entity.
+func (_u *CustomObjectResourceUpdate) ClearSite() *CustomObjectResourceUpdate {
+	_u.mutation.ClearSite()
+	return _u
+}
+
+// ClearRegion clears the "region" edge to the RegionResource entity.
+func (_u *CustomObjectResourceUpdate) ClearRegion() *CustomObjectResourceUpdate {
+	_u.mutation.ClearRegion()
+	return _u
+}
+
+// ClearOu clears the "ou" edge to the OuResource entity.
+func (_u *CustomObjectResourceUpdate) ClearOu() *CustomObjectResourceUpdate {
+	_u.mutation.ClearOu()
+	return _u
+}
+
+// ClearInstance clears the "instance" edge to the InstanceResource entity.
+func (_u *CustomObjectResourceUpdate) ClearInstance() *CustomObjectResourceUpdate {
+	_u.mutation.ClearInstance()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *CustomObjectResourceUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CustomObjectResourceUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *CustomObjectResourceUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CustomObjectResourceUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CustomObjectResourceUpdate) check() error {
+	if _u.mutation.CustomTypeCleared() && len(_u.mutation.CustomTypeIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "CustomObjectResource.custom_type"`)
+	}
+	return nil
+}
+
+func (_u *CustomObjectResourceUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(customobjectresource.Table, customobjectresource.Columns, sqlgraph.NewFieldSpec(customobjectresource.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ResourceID(); ok {
+		_spec.SetField(customobjectresource.FieldResourceID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(customobjectresource.FieldName, field.TypeString, value)
+	}
+	if _u.mutation.NameCleared() {
+		_spec.ClearField(customobjectresource.FieldName, field.TypeString)
+	}
+	if value, ok := _u.mutation.Spec(); ok {
+		_spec.SetField(customobjectresource.FieldSpec, field.TypeString, value)
+	}
+	if _u.mutation.SpecCleared() {
+		_spec.ClearField(customobjectresource.FieldSpec, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(customobjectresource.FieldUpdatedAt, field.TypeString, value)
+	}
+	if _u.mutation.CustomTypeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   customobjectresource.CustomTypeTable,
+			Columns: []string{customobjectresource.CustomTypeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(customtyperesource.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CustomTypeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   customobjectresource.CustomTypeTable,
+			Columns: []string{customobjectresource.CustomTypeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(customtyperesource.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.HostCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   customobjectresource.HostTable,
+			Columns: []string{customobjectresource.HostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(hostresource.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.HostIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   customobjectresource.HostTable,
+			Columns: []string{customobjectresource.HostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(hostresource.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SiteCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   customobjectresource.SiteTable,
+			Columns: []string{customobjectresource.SiteColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(siteresource.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SiteIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   customobjectresource.SiteTable,
+			Columns: []string{customobjectresource.SiteColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(siteresource.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RegionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   customobjectresource.RegionTable,
+			Columns: []string{customobjectresource.RegionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(regionresource.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RegionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   customobjectresource.RegionTable,
+			Columns: []string{customobjectresource.RegionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(regionresource.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.OuCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   customobjectresource.OuTable,
+			Columns: []string{customobjectresource.OuColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ouresource.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OuIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   customobjectresource.OuTable,
+			Columns: []string{customobjectresource.OuColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ouresource.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.InstanceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   customobjectresource.InstanceTable,
+			Columns: []string{customobjectresource.InstanceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(instanceresource.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.InstanceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   customobjectresource.InstanceTable,
+			Columns: []string{customobjectresource.InstanceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(instanceresource.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{customobjectresource.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// CustomObjectResourceUpdateOne is the builder for updating a single CustomObjectResource entity.
+type CustomObjectResourceUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CustomObjectResourceMutation
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_u *CustomObjectResourceUpdateOne) SetResourceID(v string) *CustomObjectResourceUpdateOne {
+	_u.mutation.SetResourceID(v)
+	return _u
+}
+
+// SetNillableResourceID sets the "resource_id" field if the given value is not nil.
+func (_u *CustomObjectResourceUpdateOne) SetNillableResourceID(v *string) *CustomObjectResourceUpdateOne {
+	if v != nil {
+		_u.SetResourceID(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *CustomObjectResourceUpdateOne) SetName(v string) *CustomObjectResourceUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *CustomObjectResourceUpdateOne) SetNillableName(v *string) *CustomObjectResourceUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// ClearName clears the value of the "name" field.
+func (_u *CustomObjectResourceUpdateOne) ClearName() *CustomObjectResourceUpdateOne {
+	_u.mutation.ClearName()
+	return _u
+}
+
+// SetSpec sets the "spec" field.
+func (_u *CustomObjectResourceUpdateOne) SetSpec(v string) *CustomObjectResourceUpdateOne {
+	_u.mutation.SetSpec(v)
+	return _u
+}
+
+// SetNillableSpec sets the "spec" field if the given value is not nil.
+func (_u *CustomObjectResourceUpdateOne) SetNillableSpec(v *string) *CustomObjectResourceUpdateOne {
+	if v != nil {
+		_u.SetSpec(*v)
+	}
+	return _u
+}
+
+// ClearSpec clears the value of the "spec" field.
+func (_u *CustomObjectResourceUpdateOne) ClearSpec() *CustomObjectResourceUpdateOne {
+	_u.mutation.ClearSpec()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *CustomObjectResourceUpdateOne) SetUpdatedAt(v string) *CustomObjectResourceUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *CustomObjectResourceUpdateOne) SetNillableUpdatedAt(v *string) *CustomObjectResourceUpdateOne {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// SetCustomTypeID sets the "custom_type" edge to the CustomTypeResource entity by ID.
+func (_u *CustomObjectResourceUpdateOne) SetCustomTypeID(id int) *CustomObjectResourceUpdateOne {
+	_u.mutation.SetCustomTypeID(id)
+	return _u
+}
+
+// SetCustomType sets the "custom_type" edge to the CustomTypeResource entity.
+func (_u *CustomObjectResourceUpdateOne) SetCustomType(v *CustomTypeResource) *CustomObjectResourceUpdateOne {
+	return _u.SetCustomTypeID(v.ID)
+}
+
+// SetHostID sets the "host" edge to the HostResource entity by ID.
+func (_u *CustomObjectResourceUpdateOne) SetHostID(id int) *CustomObjectResourceUpdateOne {
+	_u.mutation.SetHostID(id)
+	return _u
+}
+
+// SetNillableHostID sets the "host" edge to the HostResource entity by ID if the given value is not nil.
+func (_u *CustomObjectResourceUpdateOne) SetNillableHostID(id *int) *CustomObjectResourceUpdateOne {
+	if id != nil {
+		_u = _u.SetHostID(*id)
+	}
+	return _u
+}
+
+// SetHost sets the "host" edge to the HostResource entity.
+func (_u *CustomObjectResourceUpdateOne) SetHost(v *HostResource) *CustomObjectResourceUpdateOne {
+	return _u.SetHostID(v.ID)
+}
+
+// SetSiteID sets the "site" edge to the SiteResource entity by ID.
+func (_u *CustomObjectResourceUpdateOne) SetSiteID(id int) *CustomObjectResourceUpdateOne {
+	_u.mutation.SetSiteID(id)
+	return _u
+}
+
+// SetNillableSiteID sets the "site" edge to the SiteResource entity by ID if the given value is not nil.
+func (_u *CustomObjectResourceUpdateOne) SetNillableSiteID(id *int) *CustomObjectResourceUpdateOne {
+	if id != nil {
+		_u = _u.SetSiteID(*id)
+	}
+	return _u
+}
+
+// SetSite sets the "site" edge to the SiteResource entity.
+func (_u *CustomObjectResourceUpdateOne) SetSite(v *SiteResource) *CustomObjectResourceUpdateOne {
+	return _u.SetSiteID(v.ID)
+}
+
+// SetRegionID sets the "region" edge to the RegionResource entity by ID.
+func (_u *CustomObjectResourceUpdateOne) SetRegionID(id int) *CustomObjectResourceUpdateOne {
+	_u.mutation.SetRegionID(id)
+	return _u
+}
+
+// SetNillableRegionID sets the "region" edge to the RegionResource entity by ID if the given value is not nil.
+func (_u *CustomObjectResourceUpdateOne) SetNillableRegionID(id *int) *CustomObjectResourceUpdateOne {
+	if id != nil {
+		_u = _u.SetRegionID(*id)
+	}
+	return _u
+}
+
+// SetRegion sets the "region" edge to the RegionResource entity.
+func (_u *CustomObjectResourceUpdateOne) SetRegion(v *RegionResource) *CustomObjectResourceUpdateOne {
+	return _u.SetRegionID(v.ID)
+}
+
+// SetOuID sets the "ou" edge to the OuResource entity by ID.
+func (_u *CustomObjectResourceUpdateOne) SetOuID(id int) *CustomObjectResourceUpdateOne {
+	_u.mutation.SetOuID(id)
+	return _u
+}
+
+// SetNillableOuID sets the "ou" edge to the OuResource entity by ID if the given value is not nil.
+func (_u *CustomObjectResourceUpdateOne) SetNillableOuID(id *int) *CustomObjectResourceUpdateOne {
+	if id != nil {
+		_u = _u.SetOuID(*id)
+	}
+	return _u
+}
+
+// SetOu sets the "ou" edge to the OuResource entity.
+func (_u *CustomObjectResourceUpdateOne) SetOu(v *OuResource) *CustomObjectResourceUpdateOne {
+	return _u.SetOuID(v.ID)
+}
+
+// SetInstanceID sets the "instance" edge to the InstanceResource entity by ID.
+func (_u *CustomObjectResourceUpdateOne) SetInstanceID(id int) *CustomObjectResourceUpdateOne {
+	_u.mutation.SetInstanceID(id)
+	return _u
+}
+
+// SetNillableInstanceID sets the "instance" edge to the InstanceResource entity by ID if the given value is not nil.
+func (_u *CustomObjectResourceUpdateOne) SetNillableInstanceID(id *int) *CustomObjectResourceUpdateOne {
+	if id != nil {
+		_u = _u.SetInstanceID(*id)
+	}
+	return _u
+}
+
+// SetInstance sets the "instance" edge to the InstanceResource entity.
+func (_u *CustomObjectResourceUpdateOne) SetInstance(v *InstanceResource) *CustomObjectResourceUpdateOne {
+	return _u.SetInstanceID(v.ID)
+}
+
+// Mutation returns the CustomObjectResourceMutation object of the builder.
+func (_u *CustomObjectResourceUpdateOne) Mutation() *CustomObjectResourceMutation {
+	return _u.mutation
+}
+
+// ClearCustomType clears the "custom_type" edge to the CustomTypeResource entity.
+func (_u *CustomObjectResourceUpdateOne) ClearCustomType() *CustomObjectResourceUpdateOne {
+	_u.mutation.ClearCustomType()
+	return _u
+}
+
+// ClearHost clears the "host" edge to the HostResource entity.
+func (_u *CustomObjectResourceUpdateOne) ClearHost() *CustomObjectResourceUpdateOne {
+	_u.mutation.ClearHost()
+	return _u
+}
+
+// ClearSite clears the "site" edge to the SiteResource entity.
+func (_u *CustomObjectResourceUpdateOne) ClearSite() *CustomObjectResourceUpdateOne {
+	_u.mutation.ClearSite()
+	return _u
+}
+
+// ClearRegion clears the "region" edge to the RegionResource entity.
+func (_u *CustomObjectResourceUpdateOne) ClearRegion() *CustomObjectResourceUpdateOne {
+	_u.mutation.ClearRegion()
+	return _u
+}
+
+// ClearOu clears the "ou" edge to the OuResource entity.
+func (_u *CustomObjectResourceUpdateOne) ClearOu() *CustomObjectResourceUpdateOne {
+	_u.mutation.ClearOu()
+	return _u
+}
+
+// ClearInstance clears the "instance" edge to the InstanceResource entity.
+func (_u *CustomObjectResourceUpdateOne) ClearInstance() *CustomObjectResourceUpdateOne {
+	_u.mutation.ClearInstance()
+	return _u
+}
+
+// Where appends a list predicates to the CustomObjectResourceUpdate builder.
+func (_u *CustomObjectResourceUpdateOne) Where(ps ...predicate.CustomObjectResource) *CustomObjectResourceUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *CustomObjectResourceUpdateOne) Select(field string, fields ...string) *CustomObjectResourceUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated CustomObjectResource entity.
+func (_u *CustomObjectResourceUpdateOne) Save(ctx context.Context) (*CustomObjectResource, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CustomObjectResourceUpdateOne) SaveX(ctx context.Context) *CustomObjectResource {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *CustomObjectResourceUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CustomObjectResourceUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CustomObjectResourceUpdateOne) check() error {
+	if _u.mutation.CustomTypeCleared() && len(_u.mutation.CustomTypeIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "CustomObjectResource.custom_type"`)
+	}
+	return nil
+}
+
+func (_u *CustomObjectResourceUpdateOne) sqlSave(ctx context.Context) (_node *CustomObjectResource, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(customobjectresource.Table, customobjectresource.Columns, sqlgraph.NewFieldSpec(customobjectresource.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CustomObjectResource.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, customobjectresource.FieldID)
+		for _, f := range fields {
+			if !customobjectresource.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != customobjectresource.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ResourceID(); ok {
+		_spec.SetField(customobjectresource.FieldResourceID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(customobjectresource.FieldName, field.TypeString, value)
+	}
+	if _u.mutation.NameCleared() {
+		_spec.ClearField(customobjectresource.FieldName, field.TypeString)
+	}
+	if value, ok := _u.mutation.Spec(); ok {
+		_spec.SetField(customobjectresource.FieldSpec, field.TypeString, value)
+	}
+	if _u.mutation.SpecCleared() {
+		_spec.ClearField(customobjectresource.FieldSpec, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(customobjectresource.FieldUpdatedAt, field.TypeString, value)
+	}
+	if _u.mutation.CustomTypeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   customobjectresource.CustomTypeTable,
+			Columns: []string{customobjectresource.CustomTypeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(customtyperesource.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CustomTypeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   customobjectresource.CustomTypeTable,
+			Columns: []string{customobjectresource.CustomTypeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(customtyperesource.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.HostCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   customobjectresource.HostTable,
+			Columns: []string{customobjectresource.HostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(hostresource.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.HostIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   customobjectresource.HostTable,
+			Columns: []string{customobjectresource.HostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(hostresource.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SiteCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   customobjectresource.SiteTable,
+			Columns: []string{customobjectresource.SiteColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(siteresource.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SiteIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   customobjectresource.SiteTable,
+			Columns: []string{customobjectresource.SiteColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(siteresource.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RegionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   customobjectresource.RegionTable,
+			Columns: []string{customobjectresource.RegionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(regionresource.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RegionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   customobjectresource.RegionTable,
+			Columns: []string{customobjectresource.RegionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(regionresource.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.OuCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   customobjectresource.OuTable,
+			Columns: []string{customobjectresource.OuColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ouresource.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OuIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   customobjectresource.OuTable,
+			Columns: []string{customobjectresource.OuColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ouresource.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.InstanceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   customobjectresource.InstanceTable,
+			Columns: []string{customobjectresource.InstanceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(instanceresource.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.InstanceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   customobjectresource.InstanceTable,
+			Columns: []string{customobjectresource.InstanceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(instanceresource.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &CustomObjectResource{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{customobjectresource.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
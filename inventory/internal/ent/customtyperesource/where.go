@@ -0,0 +1,648 @@
+// Code generated by ent, DO NOT EDIT.
+
+package customtyperesource
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldLTE(FieldID, id))
+}
+
+// ResourceID applies equality check predicate on the "resource_id" field. It's identical to ResourceIDEQ.
+func ResourceID(v string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldEQ(FieldResourceID, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldEQ(FieldName, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldEQ(FieldDescription, v))
+}
+
+// JSONSchema applies equality check predicate on the "json_schema" field. It's identical to JSONSchemaEQ.
+func JSONSchema(v string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldEQ(FieldJSONSchema, v))
+}
+
+// AllowedEdges applies equality check predicate on the "allowed_edges" field. It's identical to AllowedEdgesEQ.
+func AllowedEdges(v string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldEQ(FieldAllowedEdges, v))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldEQ(FieldTenantID, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// ResourceIDEQ applies the EQ predicate on the "resource_id" field.
+func ResourceIDEQ(v string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldEQ(FieldResourceID, v))
+}
+
+// ResourceIDNEQ applies the NEQ predicate on the "resource_id" field.
+func ResourceIDNEQ(v string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldNEQ(FieldResourceID, v))
+}
+
+// ResourceIDIn applies the In predicate on the "resource_id" field.
+func ResourceIDIn(vs ...string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldIn(FieldResourceID, vs...))
+}
+
+// ResourceIDNotIn applies the NotIn predicate on the "resource_id" field.
+func ResourceIDNotIn(vs ...string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldNotIn(FieldResourceID, vs...))
+}
+
+// ResourceIDGT applies the GT predicate on the "resource_id" field.
+func ResourceIDGT(v string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldGT(FieldResourceID, v))
+}
+
+// ResourceIDGTE applies the GTE predicate on the "resource_id" field.
+func ResourceIDGTE(v string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldGTE(FieldResourceID, v))
+}
+
+// ResourceIDLT applies the LT predicate on the "resource_id" field.
+func ResourceIDLT(v string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldLT(FieldResourceID, v))
+}
+
+// ResourceIDLTE applies the LTE predicate on the "resource_id" field.
+func ResourceIDLTE(v string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldLTE(FieldResourceID, v))
+}
+
+// ResourceIDContains applies the Contains predicate on the "resource_id" field.
+func ResourceIDContains(v string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldContains(FieldResourceID, v))
+}
+
+// ResourceIDHasPrefix applies the HasPrefix predicate on the "resource_id" field.
+func ResourceIDHasPrefix(v string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldHasPrefix(FieldResourceID, v))
+}
+
+// ResourceIDHasSuffix applies the HasSuffix predicate on the "resource_id" field.
+func ResourceIDHasSuffix(v string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldHasSuffix(FieldResourceID, v))
+}
+
+// ResourceIDEqualFold applies the EqualFold predicate on the "resource_id" field.
+func ResourceIDEqualFold(v string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldEqualFold(FieldResourceID, v))
+}
+
+// ResourceIDContainsFold applies the ContainsFold predicate on the "resource_id" field.
+func ResourceIDContainsFold(v string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldContainsFold(FieldResourceID, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldContainsFold(FieldName, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// JSONSchemaEQ applies the EQ predicate on the "json_schema" field.
+func JSONSchemaEQ(v string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldEQ(FieldJSONSchema, v))
+}
+
+// JSONSchemaNEQ applies the NEQ predicate on the "json_schema" field.
+func JSONSchemaNEQ(v string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldNEQ(FieldJSONSchema, v))
+}
+
+// JSONSchemaIn applies the In predicate on the "json_schema" field.
+func JSONSchemaIn(vs ...string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldIn(FieldJSONSchema, vs...))
+}
+
+// JSONSchemaNotIn applies the NotIn predicate on the "json_schema" field.
+func JSONSchemaNotIn(vs ...string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldNotIn(FieldJSONSchema, vs...))
+}
+
+// JSONSchemaGT applies the GT predicate on the "json_schema" field.
+func JSONSchemaGT(v string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldGT(FieldJSONSchema, v))
+}
+
+// JSONSchemaGTE applies the GTE predicate on the "json_schema" field.
+func JSONSchemaGTE(v string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldGTE(FieldJSONSchema, v))
+}
+
+// JSONSchemaLT applies the LT predicate on the "json_schema" field.
+func JSONSchemaLT(v string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldLT(FieldJSONSchema, v))
+}
+
+// JSONSchemaLTE applies the LTE predicate on the "json_schema" field.
+func JSONSchemaLTE(v string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldLTE(FieldJSONSchema, v))
+}
+
+// JSONSchemaContains applies the Contains predicate on the "json_schema" field.
+func JSONSchemaContains(v string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldContains(FieldJSONSchema, v))
+}
+
+// JSONSchemaHasPrefix applies the HasPrefix predicate on the "json_schema" field.
+func JSONSchemaHasPrefix(v string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldHasPrefix(FieldJSONSchema, v))
+}
+
+// JSONSchemaHasSuffix applies the HasSuffix predicate on the "json_schema" field.
+func JSONSchemaHasSuffix(v string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldHasSuffix(FieldJSONSchema, v))
+}
+
+// JSONSchemaEqualFold applies the EqualFold predicate on the "json_schema" field.
+func JSONSchemaEqualFold(v string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldEqualFold(FieldJSONSchema, v))
+}
+
+// JSONSchemaContainsFold applies the ContainsFold predicate on the "json_schema" field.
+func JSONSchemaContainsFold(v string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldContainsFold(FieldJSONSchema, v))
+}
+
+// AllowedEdgesEQ applies the EQ predicate on the "allowed_edges" field.
+func AllowedEdgesEQ(v string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldEQ(FieldAllowedEdges, v))
+}
+
+// AllowedEdgesNEQ applies the NEQ predicate on the "allowed_edges" field.
+func AllowedEdgesNEQ(v string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldNEQ(FieldAllowedEdges, v))
+}
+
+// AllowedEdgesIn applies the In predicate on the "allowed_edges" field.
+func AllowedEdgesIn(vs ...string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldIn(FieldAllowedEdges, vs...))
+}
+
+// AllowedEdgesNotIn applies the NotIn predicate on the "allowed_edges" field.
+func AllowedEdgesNotIn(vs ...string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldNotIn(FieldAllowedEdges, vs...))
+}
+
+// AllowedEdgesGT applies the GT predicate on the "allowed_edges" field.
+func AllowedEdgesGT(v string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldGT(FieldAllowedEdges, v))
+}
+
+// AllowedEdgesGTE applies the GTE predicate on the "allowed_edges" field.
+func AllowedEdgesGTE(v string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldGTE(FieldAllowedEdges, v))
+}
+
+// AllowedEdgesLT applies the LT predicate on the "allowed_edges" field.
+func AllowedEdgesLT(v string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldLT(FieldAllowedEdges, v))
+}
+
+// AllowedEdgesLTE applies the LTE predicate on the "allowed_edges" field.
+func AllowedEdgesLTE(v string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldLTE(FieldAllowedEdges, v))
+}
+
+// AllowedEdgesContains applies the Contains predicate on the "allowed_edges" field.
+func AllowedEdgesContains(v string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldContains(FieldAllowedEdges, v))
+}
+
+// AllowedEdgesHasPrefix applies the HasPrefix predicate on the "allowed_edges" field.
+func AllowedEdgesHasPrefix(v string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldHasPrefix(FieldAllowedEdges, v))
+}
+
+// AllowedEdgesHasSuffix applies the HasSuffix predicate on the "allowed_edges" field.
+func AllowedEdgesHasSuffix(v string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldHasSuffix(FieldAllowedEdges, v))
+}
+
+// AllowedEdgesIsNil applies the IsNil predicate on the "allowed_edges" field.
+func AllowedEdgesIsNil() predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldIsNull(FieldAllowedEdges))
+}
+
+// AllowedEdgesNotNil applies the NotNil predicate on the "allowed_edges" field.
+func AllowedEdgesNotNil() predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldNotNull(FieldAllowedEdges))
+}
+
+// AllowedEdgesEqualFold applies the EqualFold predicate on the "allowed_edges" field.
+func AllowedEdgesEqualFold(v string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldEqualFold(FieldAllowedEdges, v))
+}
+
+// AllowedEdgesContainsFold applies the ContainsFold predicate on the "allowed_edges" field.
+func AllowedEdgesContainsFold(v string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldContainsFold(FieldAllowedEdges, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TenantIDContains applies the Contains predicate on the "tenant_id" field.
+func TenantIDContains(v string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldContains(FieldTenantID, v))
+}
+
+// TenantIDHasPrefix applies the HasPrefix predicate on the "tenant_id" field.
+func TenantIDHasPrefix(v string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldHasPrefix(FieldTenantID, v))
+}
+
+// TenantIDHasSuffix applies the HasSuffix predicate on the "tenant_id" field.
+func TenantIDHasSuffix(v string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldHasSuffix(FieldTenantID, v))
+}
+
+// TenantIDEqualFold applies the EqualFold predicate on the "tenant_id" field.
+func TenantIDEqualFold(v string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldEqualFold(FieldTenantID, v))
+}
+
+// TenantIDContainsFold applies the ContainsFold predicate on the "tenant_id" field.
+func TenantIDContainsFold(v string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldContainsFold(FieldTenantID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// CreatedAtContains applies the Contains predicate on the "created_at" field.
+func CreatedAtContains(v string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldContains(FieldCreatedAt, v))
+}
+
+// CreatedAtHasPrefix applies the HasPrefix predicate on the "created_at" field.
+func CreatedAtHasPrefix(v string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldHasPrefix(FieldCreatedAt, v))
+}
+
+// CreatedAtHasSuffix applies the HasSuffix predicate on the "created_at" field.
+func CreatedAtHasSuffix(v string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldHasSuffix(FieldCreatedAt, v))
+}
+
+// CreatedAtEqualFold applies the EqualFold predicate on the "created_at" field.
+func CreatedAtEqualFold(v string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldEqualFold(FieldCreatedAt, v))
+}
+
+// CreatedAtContainsFold applies the ContainsFold predicate on the "created_at" field.
+func CreatedAtContainsFold(v string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldContainsFold(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtContains applies the Contains predicate on the "updated_at" field.
+func UpdatedAtContains(v string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldContains(FieldUpdatedAt, v))
+}
+
+// UpdatedAtHasPrefix applies the HasPrefix predicate on the "updated_at" field.
+func UpdatedAtHasPrefix(v string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldHasPrefix(FieldUpdatedAt, v))
+}
+
+// UpdatedAtHasSuffix applies the HasSuffix predicate on the "updated_at" field.
+func UpdatedAtHasSuffix(v string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldHasSuffix(FieldUpdatedAt, v))
+}
+
+// UpdatedAtEqualFold applies the EqualFold predicate on the "updated_at" field.
+func UpdatedAtEqualFold(v string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldEqualFold(FieldUpdatedAt, v))
+}
+
+// UpdatedAtContainsFold applies the ContainsFold predicate on the "updated_at" field.
+func UpdatedAtContainsFold(v string) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.FieldContainsFold(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.CustomTypeResource) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.CustomTypeResource) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.CustomTypeResource) predicate.CustomTypeResource {
+	return predicate.CustomTypeResource(sql.NotPredicates(p))
+}
@@ -0,0 +1,381 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/customtyperesource"
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/predicate"
+)
+
+// CustomTypeResourceUpdate is the builder for updating CustomTypeResource entities.
+type CustomTypeResourceUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CustomTypeResourceMutation
+}
+
+// Where appends a list predicates to the CustomTypeResourceUpdate builder.
+func (_u *CustomTypeResourceUpdate) Where(ps ...predicate.CustomTypeResource) *CustomTypeResourceUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_u *CustomTypeResourceUpdate) SetResourceID(v string) *CustomTypeResourceUpdate {
+	_u.mutation.SetResourceID(v)
+	return _u
+}
+
+// SetNillableResourceID sets the "resource_id" field if the given value is not nil.
+func (_u *CustomTypeResourceUpdate) SetNillableResourceID(v *string) *CustomTypeResourceUpdate {
+	if v != nil {
+		_u.SetResourceID(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *CustomTypeResourceUpdate) SetDescription(v string) *CustomTypeResourceUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *CustomTypeResourceUpdate) SetNillableDescription(v *string) *CustomTypeResourceUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *CustomTypeResourceUpdate) ClearDescription() *CustomTypeResourceUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetJSONSchema sets the "json_schema" field.
+func (_u *CustomTypeResourceUpdate) SetJSONSchema(v string) *CustomTypeResourceUpdate {
+	_u.mutation.SetJSONSchema(v)
+	return _u
+}
+
+// SetNillableJSONSchema sets the "json_schema" field if the given value is not nil.
+func (_u *CustomTypeResourceUpdate) SetNillableJSONSchema(v *string) *CustomTypeResourceUpdate {
+	if v != nil {
+		_u.SetJSONSchema(*v)
+	}
+	return _u
+}
+
+// SetAllowedEdges sets the "allowed_edges" field.
+func (_u *CustomTypeResourceUpdate) SetAllowedEdges(v string) *CustomTypeResourceUpdate {
+	_u.mutation.SetAllowedEdges(v)
+	return _u
+}
+
+// SetNillableAllowedEdges sets the "allowed_edges" field if the given value is not nil.
+func (_u *CustomTypeResourceUpdate) SetNillableAllowedEdges(v *string) *CustomTypeResourceUpdate {
+	if v != nil {
+		_u.SetAllowedEdges(*v)
+	}
+	return _u
+}
+
+// ClearAllowedEdges clears the value of the "allowed_edges" field.
+func (_u *CustomTypeResourceUpdate) ClearAllowedEdges() *CustomTypeResourceUpdate {
+	_u.mutation.ClearAllowedEdges()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *CustomTypeResourceUpdate) SetUpdatedAt(v string) *CustomTypeResourceUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *CustomTypeResourceUpdate) SetNillableUpdatedAt(v *string) *CustomTypeResourceUpdate {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the CustomTypeResourceMutation object of the builder.
+func (_u *CustomTypeResourceUpdate) Mutation() *CustomTypeResourceMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *CustomTypeResourceUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CustomTypeResourceUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *CustomTypeResourceUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CustomTypeResourceUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *CustomTypeResourceUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(customtyperesource.Table, customtyperesource.Columns, sqlgraph.NewFieldSpec(customtyperesource.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ResourceID(); ok {
+		_spec.SetField(customtyperesource.FieldResourceID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(customtyperesource.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(customtyperesource.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.JSONSchema(); ok {
+		_spec.SetField(customtyperesource.FieldJSONSchema, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AllowedEdges(); ok {
+		_spec.SetField(customtyperesource.FieldAllowedEdges, field.TypeString, value)
+	}
+	if _u.mutation.AllowedEdgesCleared() {
+		_spec.ClearField(customtyperesource.FieldAllowedEdges, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(customtyperesource.FieldUpdatedAt, field.TypeString, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{customtyperesource.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// CustomTypeResourceUpdateOne is the builder for updating a single CustomTypeResource entity.
+type CustomTypeResourceUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CustomTypeResourceMutation
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_u *CustomTypeResourceUpdateOne) SetResourceID(v string) *CustomTypeResourceUpdateOne {
+	_u.mutation.SetResourceID(v)
+	return _u
+}
+
+// SetNillableResourceID sets the "resource_id" field if the given value is not nil.
+func (_u *CustomTypeResourceUpdateOne) SetNillableResourceID(v *string) *CustomTypeResourceUpdateOne {
+	if v != nil {
+		_u.SetResourceID(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *CustomTypeResourceUpdateOne) SetDescription(v string) *CustomTypeResourceUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *CustomTypeResourceUpdateOne) SetNillableDescription(v *string) *CustomTypeResourceUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *CustomTypeResourceUpdateOne) ClearDescription() *CustomTypeResourceUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetJSONSchema sets the "json_schema" field.
+func (_u *CustomTypeResourceUpdateOne) SetJSONSchema(v string) *CustomTypeResourceUpdateOne {
+	_u.mutation.SetJSONSchema(v)
+	return _u
+}
+
+// SetNillableJSONSchema sets the "json_schema" field if the given value is not nil.
+func (_u *CustomTypeResourceUpdateOne) SetNillableJSONSchema(v *string) *CustomTypeResourceUpdateOne {
+	if v != nil {
+		_u.SetJSONSchema(*v)
+	}
+	return _u
+}
+
+// SetAllowedEdges sets the "allowed_edges" field.
+func (_u *CustomTypeResourceUpdateOne) SetAllowedEdges(v string) *CustomTypeResourceUpdateOne {
+	_u.mutation.SetAllowedEdges(v)
+	return _u
+}
+
+// SetNillableAllowedEdges sets the "allowed_edges" field if the given value is not nil.
+func (_u *CustomTypeResourceUpdateOne) SetNillableAllowedEdges(v *string) *CustomTypeResourceUpdateOne {
+	if v != nil {
+		_u.SetAllowedEdges(*v)
+	}
+	return _u
+}
+
+// ClearAllowedEdges clears the value of the "allowed_edges" field.
+func (_u *CustomTypeResourceUpdateOne) ClearAllowedEdges() *CustomTypeResourceUpdateOne {
+	_u.mutation.ClearAllowedEdges()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *CustomTypeResourceUpdateOne) SetUpdatedAt(v string) *CustomTypeResourceUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *CustomTypeResourceUpdateOne) SetNillableUpdatedAt(v *string) *CustomTypeResourceUpdateOne {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the CustomTypeResourceMutation object of the builder.
+func (_u *CustomTypeResourceUpdateOne) Mutation() *CustomTypeResourceMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the CustomTypeResourceUpdate builder.
+func (_u *CustomTypeResourceUpdateOne) Where(ps ...predicate.CustomTypeResource) *CustomTypeResourceUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *CustomTypeResourceUpdateOne) Select(field string, fields ...string) *CustomTypeResourceUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated CustomTypeResource entity.
+func (_u *CustomTypeResourceUpdateOne) Save(ctx context.Context) (*CustomTypeResource, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CustomTypeResourceUpdateOne) SaveX(ctx context.Context) *CustomTypeResource {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *CustomTypeResourceUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CustomTypeResourceUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *CustomTypeResourceUpdateOne) sqlSave(ctx context.Context) (_node *CustomTypeResource, err error) {
+	_spec := sqlgraph.NewUpdateSpec(customtyperesource.Table, customtyperesource.Columns, sqlgraph.NewFieldSpec(customtyperesource.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CustomTypeResource.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, customtyperesource.FieldID)
+		for _, f := range fields {
+			if !customtyperesource.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != customtyperesource.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ResourceID(); ok {
+		_spec.SetField(customtyperesource.FieldResourceID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(customtyperesource.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(customtyperesource.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.JSONSchema(); ok {
+		_spec.SetField(customtyperesource.FieldJSONSchema, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AllowedEdges(); ok {
+		_spec.SetField(customtyperesource.FieldAllowedEdges, field.TypeString, value)
+	}
+	if _u.mutation.AllowedEdgesCleared() {
+		_spec.ClearField(customtyperesource.FieldAllowedEdges, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(customtyperesource.FieldUpdatedAt, field.TypeString, value)
+	}
+	_node = &CustomTypeResource{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{customtyperesource.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
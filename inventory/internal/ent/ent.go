@@ -13,6 +13,8 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/customconfigresource"
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/customobjectresource"
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/customtyperesource"
 	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/endpointresource"
 	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/hostgpuresource"
 	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/hostnicresource"
@@ -33,6 +35,8 @@ import (
 	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/regionresource"
 	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/remoteaccessconfiguration"
 	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/repeatedscheduleresource"
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/resourcelabel"
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/rolebindingresource"
 	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/singlescheduleresource"
 	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/siteresource"
 	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/telemetrygroupresource"
@@ -101,6 +105,8 @@ func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
 			customconfigresource.Table:      customconfigresource.ValidColumn,
+			customobjectresource.Table:      customobjectresource.ValidColumn,
+			customtyperesource.Table:        customtyperesource.ValidColumn,
 			endpointresource.Table:          endpointresource.ValidColumn,
 			hostresource.Table:              hostresource.ValidColumn,
 			hostgpuresource.Table:           hostgpuresource.ValidColumn,
@@ -121,6 +127,8 @@ func checkColumn(t, c string) error {
 			regionresource.Table:            regionresource.ValidColumn,
 			remoteaccessconfiguration.Table: remoteaccessconfiguration.ValidColumn,
 			repeatedscheduleresource.Table:  repeatedscheduleresource.ValidColumn,
+			resourcelabel.Table:             resourcelabel.ValidColumn,
+			rolebindingresource.Table:       rolebindingresource.ValidColumn,
 			singlescheduleresource.Table:    singlescheduleresource.ValidColumn,
 			siteresource.Table:              siteresource.ValidColumn,
 			telemetrygroupresource.Table:    telemetrygroupresource.ValidColumn,
@@ -21,6 +21,30 @@ func (f CustomConfigResourceFunc) Mutate(ctx context.Context, m ent.Mutation) (e
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CustomConfigResourceMutation", m)
 }
 
+// The CustomObjectResourceFunc type is an adapter to allow the use of ordinary
+// function as CustomObjectResource mutator.
+type CustomObjectResourceFunc func(context.Context, *ent.CustomObjectResourceMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CustomObjectResourceFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CustomObjectResourceMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CustomObjectResourceMutation", m)
+}
+
+// The CustomTypeResourceFunc type is an adapter to allow the use of ordinary
+// function as CustomTypeResource mutator.
+type CustomTypeResourceFunc func(context.Context, *ent.CustomTypeResourceMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CustomTypeResourceFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CustomTypeResourceMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CustomTypeResourceMutation", m)
+}
+
 // The EndpointResourceFunc type is an adapter to allow the use of ordinary
 // function as EndpointResource mutator.
 type EndpointResourceFunc func(context.Context, *ent.EndpointResourceMutation) (ent.Value, error)
@@ -261,6 +285,30 @@ func (f RepeatedScheduleResourceFunc) Mutate(ctx context.Context, m ent.Mutation
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RepeatedScheduleResourceMutation", m)
 }
 
+// The ResourceLabelFunc type is an adapter to allow the use of ordinary
+// function as ResourceLabel mutator.
+type ResourceLabelFunc func(context.Context, *ent.ResourceLabelMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ResourceLabelFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ResourceLabelMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ResourceLabelMutation", m)
+}
+
+// The RoleBindingResourceFunc type is an adapter to allow the use of ordinary
+// function as RoleBindingResource mutator.
+type RoleBindingResourceFunc func(context.Context, *ent.RoleBindingResourceMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RoleBindingResourceFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.RoleBindingResourceMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RoleBindingResourceMutation", m)
+}
+
 // The SingleScheduleResourceFunc type is an adapter to allow the use of ordinary
 // function as SingleScheduleResource mutator.
 type SingleScheduleResourceFunc func(context.Context, *ent.SingleScheduleResourceMutation) (ent.Value, error)
@@ -62,7 +62,7 @@ type HostResource struct {
 	// BmcUsername holds the value of the "bmc_username" field.
 	BmcUsername string `json:"bmc_username,omitempty"`
 	// BmcPassword holds the value of the "bmc_password" field.
-	BmcPassword string `json:"bmc_password,omitempty"`
+	BmcPassword string `json:"-"`
 	// PxeMAC holds the value of the "pxe_mac" field.
 	PxeMAC string `json:"pxe_mac,omitempty"`
 	// Hostname holds the value of the "hostname" field.
@@ -76,7 +76,7 @@ type HostResource struct {
 	// BiosVendor holds the value of the "bios_vendor" field.
 	BiosVendor string `json:"bios_vendor,omitempty"`
 	// Metadata holds the value of the "metadata" field.
-	Metadata string `json:"metadata,omitempty"`
+	Metadata string `json:"-"`
 	// DesiredPowerState holds the value of the "desired_power_state" field.
 	DesiredPowerState hostresource.DesiredPowerState `json:"desired_power_state,omitempty"`
 	// CurrentPowerState holds the value of the "current_power_state" field.
@@ -829,8 +829,7 @@ func (_m *HostResource) String() string {
 	builder.WriteString("bmc_username=")
 	builder.WriteString(_m.BmcUsername)
 	builder.WriteString(", ")
-	builder.WriteString("bmc_password=")
-	builder.WriteString(_m.BmcPassword)
+	builder.WriteString("bmc_password=<sensitive>")
 	builder.WriteString(", ")
 	builder.WriteString("pxe_mac=")
 	builder.WriteString(_m.PxeMAC)
@@ -850,8 +849,7 @@ func (_m *HostResource) String() string {
 	builder.WriteString("bios_vendor=")
 	builder.WriteString(_m.BiosVendor)
 	builder.WriteString(", ")
-	builder.WriteString("metadata=")
-	builder.WriteString(_m.Metadata)
+	builder.WriteString("metadata=<sensitive>")
 	builder.WriteString(", ")
 	builder.WriteString("desired_power_state=")
 	builder.WriteString(fmt.Sprintf("%v", _m.DesiredPowerState))
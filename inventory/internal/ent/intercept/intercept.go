@@ -9,6 +9,8 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent"
 	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/customconfigresource"
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/customobjectresource"
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/customtyperesource"
 	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/endpointresource"
 	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/hostgpuresource"
 	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/hostnicresource"
@@ -30,6 +32,8 @@ import (
 	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/regionresource"
 	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/remoteaccessconfiguration"
 	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/repeatedscheduleresource"
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/resourcelabel"
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/rolebindingresource"
 	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/singlescheduleresource"
 	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/siteresource"
 	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/telemetrygroupresource"
@@ -122,6 +126,60 @@ func (f TraverseCustomConfigResource) Traverse(ctx context.Context, q ent.Query)
 	return fmt.Errorf("unexpected query type %T. expect *ent.CustomConfigResourceQuery", q)
 }
 
+// The CustomObjectResourceFunc type is an adapter to allow the use of ordinary function as a Querier.
+type CustomObjectResourceFunc func(context.Context, *ent.CustomObjectResourceQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f CustomObjectResourceFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.CustomObjectResourceQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.CustomObjectResourceQuery", q)
+}
+
+// The TraverseCustomObjectResource type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseCustomObjectResource func(context.Context, *ent.CustomObjectResourceQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseCustomObjectResource) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseCustomObjectResource) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.CustomObjectResourceQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.CustomObjectResourceQuery", q)
+}
+
+// The CustomTypeResourceFunc type is an adapter to allow the use of ordinary function as a Querier.
+type CustomTypeResourceFunc func(context.Context, *ent.CustomTypeResourceQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f CustomTypeResourceFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.CustomTypeResourceQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.CustomTypeResourceQuery", q)
+}
+
+// The TraverseCustomTypeResource type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseCustomTypeResource func(context.Context, *ent.CustomTypeResourceQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseCustomTypeResource) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseCustomTypeResource) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.CustomTypeResourceQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.CustomTypeResourceQuery", q)
+}
+
 // The EndpointResourceFunc type is an adapter to allow the use of ordinary function as a Querier.
 type EndpointResourceFunc func(context.Context, *ent.EndpointResourceQuery) (ent.Value, error)
 
@@ -662,6 +720,60 @@ func (f TraverseRepeatedScheduleResource) Traverse(ctx context.Context, q ent.Qu
 	return fmt.Errorf("unexpected query type %T. expect *ent.RepeatedScheduleResourceQuery", q)
 }
 
+// The ResourceLabelFunc type is an adapter to allow the use of ordinary function as a Querier.
+type ResourceLabelFunc func(context.Context, *ent.ResourceLabelQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f ResourceLabelFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.ResourceLabelQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.ResourceLabelQuery", q)
+}
+
+// The TraverseResourceLabel type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseResourceLabel func(context.Context, *ent.ResourceLabelQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseResourceLabel) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseResourceLabel) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ResourceLabelQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.ResourceLabelQuery", q)
+}
+
+// The RoleBindingResourceFunc type is an adapter to allow the use of ordinary function as a Querier.
+type RoleBindingResourceFunc func(context.Context, *ent.RoleBindingResourceQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f RoleBindingResourceFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.RoleBindingResourceQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.RoleBindingResourceQuery", q)
+}
+
+// The TraverseRoleBindingResource type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseRoleBindingResource func(context.Context, *ent.RoleBindingResourceQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseRoleBindingResource) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseRoleBindingResource) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.RoleBindingResourceQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.RoleBindingResourceQuery", q)
+}
+
 // The SingleScheduleResourceFunc type is an adapter to allow the use of ordinary function as a Querier.
 type SingleScheduleResourceFunc func(context.Context, *ent.SingleScheduleResourceQuery) (ent.Value, error)
 
@@ -856,6 +968,10 @@ func NewQuery(q ent.Query) (Query, error) {
 	switch q := q.(type) {
 	case *ent.CustomConfigResourceQuery:
 		return &query[*ent.CustomConfigResourceQuery, predicate.CustomConfigResource, customconfigresource.OrderOption]{typ: ent.TypeCustomConfigResource, tq: q}, nil
+	case *ent.CustomObjectResourceQuery:
+		return &query[*ent.CustomObjectResourceQuery, predicate.CustomObjectResource, customobjectresource.OrderOption]{typ: ent.TypeCustomObjectResource, tq: q}, nil
+	case *ent.CustomTypeResourceQuery:
+		return &query[*ent.CustomTypeResourceQuery, predicate.CustomTypeResource, customtyperesource.OrderOption]{typ: ent.TypeCustomTypeResource, tq: q}, nil
 	case *ent.EndpointResourceQuery:
 		return &query[*ent.EndpointResourceQuery, predicate.EndpointResource, endpointresource.OrderOption]{typ: ent.TypeEndpointResource, tq: q}, nil
 	case *ent.HostResourceQuery:
@@ -896,6 +1012,10 @@ func NewQuery(q ent.Query) (Query, error) {
 		return &query[*ent.RemoteAccessConfigurationQuery, predicate.RemoteAccessConfiguration, remoteaccessconfiguration.OrderOption]{typ: ent.TypeRemoteAccessConfiguration, tq: q}, nil
 	case *ent.RepeatedScheduleResourceQuery:
 		return &query[*ent.RepeatedScheduleResourceQuery, predicate.RepeatedScheduleResource, repeatedscheduleresource.OrderOption]{typ: ent.TypeRepeatedScheduleResource, tq: q}, nil
+	case *ent.ResourceLabelQuery:
+		return &query[*ent.ResourceLabelQuery, predicate.ResourceLabel, resourcelabel.OrderOption]{typ: ent.TypeResourceLabel, tq: q}, nil
+	case *ent.RoleBindingResourceQuery:
+		return &query[*ent.RoleBindingResourceQuery, predicate.RoleBindingResource, rolebindingresource.OrderOption]{typ: ent.TypeRoleBindingResource, tq: q}, nil
 	case *ent.SingleScheduleResourceQuery:
 		return &query[*ent.SingleScheduleResourceQuery, predicate.SingleScheduleResource, singlescheduleresource.OrderOption]{typ: ent.TypeSingleScheduleResource, tq: q}, nil
 	case *ent.SiteResourceQuery:
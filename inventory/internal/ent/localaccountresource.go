@@ -21,7 +21,7 @@ type LocalAccountResource struct {
 	// Username holds the value of the "username" field.
 	Username string `json:"username,omitempty"`
 	// SSHKey holds the value of the "ssh_key" field.
-	SSHKey string `json:"ssh_key,omitempty"`
+	SSHKey string `json:"-"`
 	// TenantID holds the value of the "tenant_id" field.
 	TenantID string `json:"tenant_id,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
@@ -139,8 +139,7 @@ func (_m *LocalAccountResource) String() string {
 	builder.WriteString("username=")
 	builder.WriteString(_m.Username)
 	builder.WriteString(", ")
-	builder.WriteString("ssh_key=")
-	builder.WriteString(_m.SSHKey)
+	builder.WriteString("ssh_key=<sensitive>")
 	builder.WriteString(", ")
 	builder.WriteString("tenant_id=")
 	builder.WriteString(_m.TenantID)
@@ -41,6 +41,34 @@ func (_u *LocalAccountResourceUpdate) SetNillableResourceID(v *string) *LocalAcc
 	return _u
 }
 
+// SetUsername sets the "username" field.
+func (_u *LocalAccountResourceUpdate) SetUsername(v string) *LocalAccountResourceUpdate {
+	_u.mutation.SetUsername(v)
+	return _u
+}
+
+// SetNillableUsername sets the "username" field if the given value is not nil.
+func (_u *LocalAccountResourceUpdate) SetNillableUsername(v *string) *LocalAccountResourceUpdate {
+	if v != nil {
+		_u.SetUsername(*v)
+	}
+	return _u
+}
+
+// SetSSHKey sets the "ssh_key" field.
+func (_u *LocalAccountResourceUpdate) SetSSHKey(v string) *LocalAccountResourceUpdate {
+	_u.mutation.SetSSHKey(v)
+	return _u
+}
+
+// SetNillableSSHKey sets the "ssh_key" field if the given value is not nil.
+func (_u *LocalAccountResourceUpdate) SetNillableSSHKey(v *string) *LocalAccountResourceUpdate {
+	if v != nil {
+		_u.SetSSHKey(*v)
+	}
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *LocalAccountResourceUpdate) SetUpdatedAt(v string) *LocalAccountResourceUpdate {
 	_u.mutation.SetUpdatedAt(v)
@@ -99,6 +127,12 @@ func (_u *LocalAccountResourceUpdate) sqlSave(ctx context.Context) (_node int, e
 	if value, ok := _u.mutation.ResourceID(); ok {
 		_spec.SetField(localaccountresource.FieldResourceID, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Username(); ok {
+		_spec.SetField(localaccountresource.FieldUsername, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SSHKey(); ok {
+		_spec.SetField(localaccountresource.FieldSSHKey, field.TypeString, value)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(localaccountresource.FieldUpdatedAt, field.TypeString, value)
 	}
@@ -136,6 +170,34 @@ func (_u *LocalAccountResourceUpdateOne) SetNillableResourceID(v *string) *Local
 	return _u
 }
 
+// SetUsername sets the "username" field.
+func (_u *LocalAccountResourceUpdateOne) SetUsername(v string) *LocalAccountResourceUpdateOne {
+	_u.mutation.SetUsername(v)
+	return _u
+}
+
+// SetNillableUsername sets the "username" field if the given value is not nil.
+func (_u *LocalAccountResourceUpdateOne) SetNillableUsername(v *string) *LocalAccountResourceUpdateOne {
+	if v != nil {
+		_u.SetUsername(*v)
+	}
+	return _u
+}
+
+// SetSSHKey sets the "ssh_key" field.
+func (_u *LocalAccountResourceUpdateOne) SetSSHKey(v string) *LocalAccountResourceUpdateOne {
+	_u.mutation.SetSSHKey(v)
+	return _u
+}
+
+// SetNillableSSHKey sets the "ssh_key" field if the given value is not nil.
+func (_u *LocalAccountResourceUpdateOne) SetNillableSSHKey(v *string) *LocalAccountResourceUpdateOne {
+	if v != nil {
+		_u.SetSSHKey(*v)
+	}
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *LocalAccountResourceUpdateOne) SetUpdatedAt(v string) *LocalAccountResourceUpdateOne {
 	_u.mutation.SetUpdatedAt(v)
@@ -224,6 +286,12 @@ func (_u *LocalAccountResourceUpdateOne) sqlSave(ctx context.Context) (_node *Lo
 	if value, ok := _u.mutation.ResourceID(); ok {
 		_spec.SetField(localaccountresource.FieldResourceID, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Username(); ok {
+		_spec.SetField(localaccountresource.FieldUsername, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SSHKey(); ok {
+		_spec.SetField(localaccountresource.FieldSSHKey, field.TypeString, value)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(localaccountresource.FieldUpdatedAt, field.TypeString, value)
 	}
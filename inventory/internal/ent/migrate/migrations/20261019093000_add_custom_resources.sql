@@ -0,0 +1,14 @@
+-- Create "custom_type_resources" table
+CREATE TABLE "custom_type_resources" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "resource_id" character varying NOT NULL, "name" character varying NOT NULL, "description" character varying NULL, "json_schema" character varying NOT NULL, "allowed_edges" character varying NULL, "tenant_id" character varying NOT NULL, "created_at" timestamp NOT NULL, "updated_at" timestamp NOT NULL, PRIMARY KEY ("id"));
+-- Create index "custom_type_resources_resource_id_key" to table: "custom_type_resources"
+CREATE UNIQUE INDEX "custom_type_resources_resource_id_key" ON "custom_type_resources" ("resource_id");
+-- Create index "customtyperesource_name_tenant_id" to table: "custom_type_resources"
+CREATE UNIQUE INDEX "customtyperesource_name_tenant_id" ON "custom_type_resources" ("name", "tenant_id");
+-- Create index "customtyperesource_tenant_id" to table: "custom_type_resources"
+CREATE INDEX "customtyperesource_tenant_id" ON "custom_type_resources" ("tenant_id");
+-- Create "custom_object_resources" table
+CREATE TABLE "custom_object_resources" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "resource_id" character varying NOT NULL, "name" character varying NULL, "spec" character varying NULL, "tenant_id" character varying NOT NULL, "created_at" timestamp NOT NULL, "updated_at" timestamp NOT NULL, "custom_object_resource_custom_type" bigint NOT NULL, "custom_object_resource_host" bigint NULL, "custom_object_resource_site" bigint NULL, "custom_object_resource_region" bigint NULL, "custom_object_resource_ou" bigint NULL, "custom_object_resource_instance" bigint NULL, PRIMARY KEY ("id"), CONSTRAINT "custom_object_resources_custom_type_resources_custom_type" FOREIGN KEY ("custom_object_resource_custom_type") REFERENCES "custom_type_resources" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION, CONSTRAINT "custom_object_resources_host_resources_host" FOREIGN KEY ("custom_object_resource_host") REFERENCES "host_resources" ("id") ON UPDATE NO ACTION ON DELETE SET NULL, CONSTRAINT "custom_object_resources_instance_resources_instance" FOREIGN KEY ("custom_object_resource_instance") REFERENCES "instance_resources" ("id") ON UPDATE NO ACTION ON DELETE SET NULL, CONSTRAINT "custom_object_resources_ou_resources_ou" FOREIGN KEY ("custom_object_resource_ou") REFERENCES "ou_resources" ("id") ON UPDATE NO ACTION ON DELETE SET NULL, CONSTRAINT "custom_object_resources_region_resources_region" FOREIGN KEY ("custom_object_resource_region") REFERENCES "region_resources" ("id") ON UPDATE NO ACTION ON DELETE SET NULL, CONSTRAINT "custom_object_resources_site_resources_site" FOREIGN KEY ("custom_object_resource_site") REFERENCES "site_resources" ("id") ON UPDATE NO ACTION ON DELETE SET NULL);
+-- Create index "custom_object_resources_resource_id_key" to table: "custom_object_resources"
+CREATE UNIQUE INDEX "custom_object_resources_resource_id_key" ON "custom_object_resources" ("resource_id");
+-- Create index "customobjectresource_tenant_id" to table: "custom_object_resources"
+CREATE INDEX "customobjectresource_tenant_id" ON "custom_object_resources" ("tenant_id");
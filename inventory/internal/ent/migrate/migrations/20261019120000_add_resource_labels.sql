@@ -0,0 +1,16 @@
+-- Create "resource_labels" table
+CREATE TABLE "resource_labels" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "resource_id" character varying NOT NULL, "key" character varying NOT NULL, "value" character varying NOT NULL, "tenant_id" character varying NOT NULL, PRIMARY KEY ("id"));
+-- Create index "resourcelabel_resource_id_key" to table: "resource_labels"
+CREATE UNIQUE INDEX "resourcelabel_resource_id_key" ON "resource_labels" ("resource_id", "key");
+-- Create index "resourcelabel_key_value" to table: "resource_labels"
+CREATE INDEX "resourcelabel_key_value" ON "resource_labels" ("key", "value");
+-- Create index "resourcelabel_tenant_id" to table: "resource_labels"
+CREATE INDEX "resourcelabel_tenant_id" ON "resource_labels" ("tenant_id");
+-- Convert the existing metadata of "region_resources" into labels
+INSERT INTO "resource_labels" ("resource_id", "key", "value", "tenant_id") SELECT r."resource_id", m."value"->>'key', COALESCE(m."value"->>'value', ''), r."tenant_id" FROM "region_resources" AS r, jsonb_array_elements(CASE WHEN jsonb_typeof(NULLIF(r."metadata", '')::jsonb) = 'array' THEN r."metadata"::jsonb ELSE '[]'::jsonb END) AS m WHERE m."value"->>'key' IS NOT NULL ON CONFLICT ("resource_id", "key") DO NOTHING;
+-- Convert the existing metadata of "ou_resources" into labels
+INSERT INTO "resource_labels" ("resource_id", "key", "value", "tenant_id") SELECT r."resource_id", m."value"->>'key', COALESCE(m."value"->>'value', ''), r."tenant_id" FROM "ou_resources" AS r, jsonb_array_elements(CASE WHEN jsonb_typeof(NULLIF(r."metadata", '')::jsonb) = 'array' THEN r."metadata"::jsonb ELSE '[]'::jsonb END) AS m WHERE m."value"->>'key' IS NOT NULL ON CONFLICT ("resource_id", "key") DO NOTHING;
+-- Convert the existing metadata of "site_resources" into labels
+INSERT INTO "resource_labels" ("resource_id", "key", "value", "tenant_id") SELECT r."resource_id", m."value"->>'key', COALESCE(m."value"->>'value', ''), r."tenant_id" FROM "site_resources" AS r, jsonb_array_elements(CASE WHEN jsonb_typeof(NULLIF(r."metadata", '')::jsonb) = 'array' THEN r."metadata"::jsonb ELSE '[]'::jsonb END) AS m WHERE m."value"->>'key' IS NOT NULL ON CONFLICT ("resource_id", "key") DO NOTHING;
+-- Convert the existing metadata of "host_resources" into labels
+INSERT INTO "resource_labels" ("resource_id", "key", "value", "tenant_id") SELECT r."resource_id", m."value"->>'key', COALESCE(m."value"->>'value', ''), r."tenant_id" FROM "host_resources" AS r, jsonb_array_elements(CASE WHEN jsonb_typeof(NULLIF(r."metadata", '')::jsonb) = 'array' THEN r."metadata"::jsonb ELSE '[]'::jsonb END) AS m WHERE m."value"->>'key' IS NOT NULL ON CONFLICT ("resource_id", "key") DO NOTHING;
@@ -0,0 +1,28 @@
+-- Enable trigram matching, used by the full-text search of resources
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+-- Create index "hostresource_name_trgm" to table: "host_resources"
+CREATE INDEX "hostresource_name_trgm" ON "host_resources" USING GIN ("name" gin_trgm_ops);
+-- Create index "hostresource_hostname_trgm" to table: "host_resources"
+CREATE INDEX "hostresource_hostname_trgm" ON "host_resources" USING GIN ("hostname" gin_trgm_ops);
+-- Create index "hostresource_serial_number_trgm" to table: "host_resources"
+CREATE INDEX "hostresource_serial_number_trgm" ON "host_resources" USING GIN ("serial_number" gin_trgm_ops);
+-- Create index "hostresource_uuid_trgm" to table: "host_resources"
+CREATE INDEX "hostresource_uuid_trgm" ON "host_resources" USING GIN ("uuid" gin_trgm_ops);
+-- Create index "hostresource_pxe_mac_trgm" to table: "host_resources"
+CREATE INDEX "hostresource_pxe_mac_trgm" ON "host_resources" USING GIN ("pxe_mac" gin_trgm_ops);
+-- Create index "hostresource_bmc_ip_trgm" to table: "host_resources"
+CREATE INDEX "hostresource_bmc_ip_trgm" ON "host_resources" USING GIN ("bmc_ip" gin_trgm_ops);
+-- Create index "hostresource_mgmt_ip_trgm" to table: "host_resources"
+CREATE INDEX "hostresource_mgmt_ip_trgm" ON "host_resources" USING GIN ("mgmt_ip" gin_trgm_ops);
+-- Create index "hostnicresource_device_name_trgm" to table: "hostnic_resources"
+CREATE INDEX "hostnicresource_device_name_trgm" ON "hostnic_resources" USING GIN ("device_name" gin_trgm_ops);
+-- Create index "hostnicresource_mac_addr_trgm" to table: "hostnic_resources"
+CREATE INDEX "hostnicresource_mac_addr_trgm" ON "hostnic_resources" USING GIN ("mac_addr" gin_trgm_ops);
+-- Create index "instanceresource_name_trgm" to table: "instance_resources"
+CREATE INDEX "instanceresource_name_trgm" ON "instance_resources" USING GIN ("name" gin_trgm_ops);
+-- Create index "ipaddressresource_address_trgm" to table: "ip_address_resources"
+CREATE INDEX "ipaddressresource_address_trgm" ON "ip_address_resources" USING GIN ("address" gin_trgm_ops);
+-- Create index "siteresource_name_trgm" to table: "site_resources"
+CREATE INDEX "siteresource_name_trgm" ON "site_resources" USING GIN ("name" gin_trgm_ops);
+-- Create index "siteresource_address_trgm" to table: "site_resources"
+CREATE INDEX "siteresource_address_trgm" ON "site_resources" USING GIN ("address" gin_trgm_ops);
@@ -0,0 +1,8 @@
+-- Create "role_binding_resources" table
+CREATE TABLE "role_binding_resources" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "resource_id" character varying NOT NULL, "subject" character varying NOT NULL, "role" character varying NULL, "tenant_id" character varying NOT NULL, "created_at" timestamp NOT NULL, "updated_at" timestamp NOT NULL, "role_binding_resource_region" bigint NULL, "role_binding_resource_site" bigint NULL, "role_binding_resource_ou" bigint NULL, PRIMARY KEY ("id"), CONSTRAINT "role_binding_resources_ou_resources_ou" FOREIGN KEY ("role_binding_resource_ou") REFERENCES "ou_resources" ("id") ON UPDATE NO ACTION ON DELETE SET NULL, CONSTRAINT "role_binding_resources_region_resources_region" FOREIGN KEY ("role_binding_resource_region") REFERENCES "region_resources" ("id") ON UPDATE NO ACTION ON DELETE SET NULL, CONSTRAINT "role_binding_resources_site_resources_site" FOREIGN KEY ("role_binding_resource_site") REFERENCES "site_resources" ("id") ON UPDATE NO ACTION ON DELETE SET NULL);
+-- Create index "role_binding_resources_resource_id_key" to table: "role_binding_resources"
+CREATE UNIQUE INDEX "role_binding_resources_resource_id_key" ON "role_binding_resources" ("resource_id");
+-- Create index "rolebindingresource_subject_tenant_id" to table: "role_binding_resources"
+CREATE INDEX "rolebindingresource_subject_tenant_id" ON "role_binding_resources" ("subject", "tenant_id");
+-- Create index "rolebindingresource_tenant_id" to table: "role_binding_resources"
+CREATE INDEX "rolebindingresource_tenant_id" ON "role_binding_resources" ("tenant_id");
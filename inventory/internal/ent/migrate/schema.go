@@ -38,6 +38,103 @@ var (
 			},
 		},
 	}
+	// CustomObjectResourcesColumns holds the columns for the "custom_object_resources" table.
+	CustomObjectResourcesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "resource_id", Type: field.TypeString, Unique: true},
+		{Name: "name", Type: field.TypeString, Nullable: true},
+		{Name: "spec", Type: field.TypeString, Nullable: true},
+		{Name: "tenant_id", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeString, SchemaType: map[string]string{"postgres": "TIMESTAMP"}},
+		{Name: "updated_at", Type: field.TypeString, SchemaType: map[string]string{"postgres": "TIMESTAMP"}},
+		{Name: "custom_object_resource_custom_type", Type: field.TypeInt},
+		{Name: "custom_object_resource_host", Type: field.TypeInt, Nullable: true},
+		{Name: "custom_object_resource_site", Type: field.TypeInt, Nullable: true},
+		{Name: "custom_object_resource_region", Type: field.TypeInt, Nullable: true},
+		{Name: "custom_object_resource_ou", Type: field.TypeInt, Nullable: true},
+		{Name: "custom_object_resource_instance", Type: field.TypeInt, Nullable: true},
+	}
+	// CustomObjectResourcesTable holds the schema information for the "custom_object_resources" table.
+	CustomObjectResourcesTable = &schema.Table{
+		Name:       "custom_object_resources",
+		Columns:    CustomObjectResourcesColumns,
+		PrimaryKey: []*schema.Column{CustomObjectResourcesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "custom_object_resources_custom_type_resources_custom_type",
+				Columns:    []*schema.Column{CustomObjectResourcesColumns[7]},
+				RefColumns: []*schema.Column{CustomTypeResourcesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "custom_object_resources_host_resources_host",
+				Columns:    []*schema.Column{CustomObjectResourcesColumns[8]},
+				RefColumns: []*schema.Column{HostResourcesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "custom_object_resources_site_resources_site",
+				Columns:    []*schema.Column{CustomObjectResourcesColumns[9]},
+				RefColumns: []*schema.Column{SiteResourcesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "custom_object_resources_region_resources_region",
+				Columns:    []*schema.Column{CustomObjectResourcesColumns[10]},
+				RefColumns: []*schema.Column{RegionResourcesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "custom_object_resources_ou_resources_ou",
+				Columns:    []*schema.Column{CustomObjectResourcesColumns[11]},
+				RefColumns: []*schema.Column{OuResourcesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "custom_object_resources_instance_resources_instance",
+				Columns:    []*schema.Column{CustomObjectResourcesColumns[12]},
+				RefColumns: []*schema.Column{InstanceResourcesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "customobjectresource_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{CustomObjectResourcesColumns[4]},
+			},
+		},
+	}
+	// CustomTypeResourcesColumns holds the columns for the "custom_type_resources" table.
+	CustomTypeResourcesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "resource_id", Type: field.TypeString, Unique: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "json_schema", Type: field.TypeString},
+		{Name: "allowed_edges", Type: field.TypeString, Nullable: true},
+		{Name: "tenant_id", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeString, SchemaType: map[string]string{"postgres": "TIMESTAMP"}},
+		{Name: "updated_at", Type: field.TypeString, SchemaType: map[string]string{"postgres": "TIMESTAMP"}},
+	}
+	// CustomTypeResourcesTable holds the schema information for the "custom_type_resources" table.
+	CustomTypeResourcesTable = &schema.Table{
+		Name:       "custom_type_resources",
+		Columns:    CustomTypeResourcesColumns,
+		PrimaryKey: []*schema.Column{CustomTypeResourcesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "customtyperesource_name_tenant_id",
+				Unique:  true,
+				Columns: []*schema.Column{CustomTypeResourcesColumns[2], CustomTypeResourcesColumns[6]},
+			},
+			{
+				Name:    "customtyperesource_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{CustomTypeResourcesColumns[6]},
+			},
+		},
+	}
 	// EndpointResourcesColumns holds the columns for the "endpoint_resources" table.
 	EndpointResourcesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -189,6 +286,69 @@ var (
 				Unique:  false,
 				Columns: []*schema.Column{HostResourcesColumns[63]},
 			},
+			{
+				Name:    "hostresource_name_trgm",
+				Unique:  false,
+				Columns: []*schema.Column{HostResourcesColumns[3]},
+				Annotation: &entsql.IndexAnnotation{
+					OpClass: "gin_trgm_ops",
+					Type:    "GIN",
+				},
+			},
+			{
+				Name:    "hostresource_hostname_trgm",
+				Unique:  false,
+				Columns: []*schema.Column{HostResourcesColumns[24]},
+				Annotation: &entsql.IndexAnnotation{
+					OpClass: "gin_trgm_ops",
+					Type:    "GIN",
+				},
+			},
+			{
+				Name:    "hostresource_serial_number_trgm",
+				Unique:  false,
+				Columns: []*schema.Column{HostResourcesColumns[8]},
+				Annotation: &entsql.IndexAnnotation{
+					OpClass: "gin_trgm_ops",
+					Type:    "GIN",
+				},
+			},
+			{
+				Name:    "hostresource_uuid_trgm",
+				Unique:  false,
+				Columns: []*schema.Column{HostResourcesColumns[9]},
+				Annotation: &entsql.IndexAnnotation{
+					OpClass: "gin_trgm_ops",
+					Type:    "GIN",
+				},
+			},
+			{
+				Name:    "hostresource_pxe_mac_trgm",
+				Unique:  false,
+				Columns: []*schema.Column{HostResourcesColumns[23]},
+				Annotation: &entsql.IndexAnnotation{
+					OpClass: "gin_trgm_ops",
+					Type:    "GIN",
+				},
+			},
+			{
+				Name:    "hostresource_bmc_ip_trgm",
+				Unique:  false,
+				Columns: []*schema.Column{HostResourcesColumns[20]},
+				Annotation: &entsql.IndexAnnotation{
+					OpClass: "gin_trgm_ops",
+					Type:    "GIN",
+				},
+			},
+			{
+				Name:    "hostresource_mgmt_ip_trgm",
+				Unique:  false,
+				Columns: []*schema.Column{HostResourcesColumns[18]},
+				Annotation: &entsql.IndexAnnotation{
+					OpClass: "gin_trgm_ops",
+					Type:    "GIN",
+				},
+			},
 		},
 	}
 	// HostgpuResourcesColumns holds the columns for the "hostgpu_resources" table.
@@ -276,6 +436,24 @@ var (
 				Unique:  false,
 				Columns: []*schema.Column{HostnicResourcesColumns[23]},
 			},
+			{
+				Name:    "hostnicresource_device_name_trgm",
+				Unique:  false,
+				Columns: []*schema.Column{HostnicResourcesColumns[4]},
+				Annotation: &entsql.IndexAnnotation{
+					OpClass: "gin_trgm_ops",
+					Type:    "GIN",
+				},
+			},
+			{
+				Name:    "hostnicresource_mac_addr_trgm",
+				Unique:  false,
+				Columns: []*schema.Column{HostnicResourcesColumns[6]},
+				Annotation: &entsql.IndexAnnotation{
+					OpClass: "gin_trgm_ops",
+					Type:    "GIN",
+				},
+			},
 		},
 	}
 	// HoststorageResourcesColumns holds the columns for the "hoststorage_resources" table.
@@ -389,6 +567,15 @@ var (
 				Unique:  false,
 				Columns: []*schema.Column{IPAddressResourcesColumns[8]},
 			},
+			{
+				Name:    "ipaddressresource_address_trgm",
+				Unique:  false,
+				Columns: []*schema.Column{IPAddressResourcesColumns[2]},
+				Annotation: &entsql.IndexAnnotation{
+					OpClass: "gin_trgm_ops",
+					Type:    "GIN",
+				},
+			},
 		},
 	}
 	// InstanceResourcesColumns holds the columns for the "instance_resources" table.
@@ -464,6 +651,15 @@ var (
 				Unique:  false,
 				Columns: []*schema.Column{InstanceResourcesColumns[25]},
 			},
+			{
+				Name:    "instanceresource_name_trgm",
+				Unique:  false,
+				Columns: []*schema.Column{InstanceResourcesColumns[3]},
+				Annotation: &entsql.IndexAnnotation{
+					OpClass: "gin_trgm_ops",
+					Type:    "GIN",
+				},
+			},
 		},
 	}
 	// LocalAccountResourcesColumns holds the columns for the "local_account_resources" table.
@@ -901,6 +1097,88 @@ var (
 			},
 		},
 	}
+	// ResourceLabelsColumns holds the columns for the "resource_labels" table.
+	ResourceLabelsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "resource_id", Type: field.TypeString},
+		{Name: "key", Type: field.TypeString},
+		{Name: "value", Type: field.TypeString},
+		{Name: "tenant_id", Type: field.TypeString},
+	}
+	// ResourceLabelsTable holds the schema information for the "resource_labels" table.
+	ResourceLabelsTable = &schema.Table{
+		Name:       "resource_labels",
+		Columns:    ResourceLabelsColumns,
+		PrimaryKey: []*schema.Column{ResourceLabelsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "resourcelabel_resource_id_key",
+				Unique:  true,
+				Columns: []*schema.Column{ResourceLabelsColumns[1], ResourceLabelsColumns[2]},
+			},
+			{
+				Name:    "resourcelabel_key_value",
+				Unique:  false,
+				Columns: []*schema.Column{ResourceLabelsColumns[2], ResourceLabelsColumns[3]},
+			},
+			{
+				Name:    "resourcelabel_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{ResourceLabelsColumns[4]},
+			},
+		},
+	}
+	// RoleBindingResourcesColumns holds the columns for the "role_binding_resources" table.
+	RoleBindingResourcesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "resource_id", Type: field.TypeString, Unique: true},
+		{Name: "subject", Type: field.TypeString},
+		{Name: "role", Type: field.TypeEnum, Nullable: true, Enums: []string{"SCOPED_ROLE_UNSPECIFIED", "SCOPED_ROLE_READ", "SCOPED_ROLE_READ_WRITE"}},
+		{Name: "tenant_id", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeString, SchemaType: map[string]string{"postgres": "TIMESTAMP"}},
+		{Name: "updated_at", Type: field.TypeString, SchemaType: map[string]string{"postgres": "TIMESTAMP"}},
+		{Name: "role_binding_resource_region", Type: field.TypeInt, Nullable: true},
+		{Name: "role_binding_resource_site", Type: field.TypeInt, Nullable: true},
+		{Name: "role_binding_resource_ou", Type: field.TypeInt, Nullable: true},
+	}
+	// RoleBindingResourcesTable holds the schema information for the "role_binding_resources" table.
+	RoleBindingResourcesTable = &schema.Table{
+		Name:       "role_binding_resources",
+		Columns:    RoleBindingResourcesColumns,
+		PrimaryKey: []*schema.Column{RoleBindingResourcesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "role_binding_resources_region_resources_region",
+				Columns:    []*schema.Column{RoleBindingResourcesColumns[7]},
+				RefColumns: []*schema.Column{RegionResourcesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "role_binding_resources_site_resources_site",
+				Columns:    []*schema.Column{RoleBindingResourcesColumns[8]},
+				RefColumns: []*schema.Column{SiteResourcesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "role_binding_resources_ou_resources_ou",
+				Columns:    []*schema.Column{RoleBindingResourcesColumns[9]},
+				RefColumns: []*schema.Column{OuResourcesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "rolebindingresource_subject_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{RoleBindingResourcesColumns[2], RoleBindingResourcesColumns[4]},
+			},
+			{
+				Name:    "rolebindingresource_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{RoleBindingResourcesColumns[4]},
+			},
+		},
+	}
 	// SingleScheduleResourcesColumns holds the columns for the "single_schedule_resources" table.
 	SingleScheduleResourcesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -1010,6 +1288,24 @@ var (
 				Unique:  false,
 				Columns: []*schema.Column{SiteResourcesColumns[14]},
 			},
+			{
+				Name:    "siteresource_name_trgm",
+				Unique:  false,
+				Columns: []*schema.Column{SiteResourcesColumns[2]},
+				Annotation: &entsql.IndexAnnotation{
+					OpClass: "gin_trgm_ops",
+					Type:    "GIN",
+				},
+			},
+			{
+				Name:    "siteresource_address_trgm",
+				Unique:  false,
+				Columns: []*schema.Column{SiteResourcesColumns[3]},
+				Annotation: &entsql.IndexAnnotation{
+					OpClass: "gin_trgm_ops",
+					Type:    "GIN",
+				},
+			},
 		},
 	}
 	// TelemetryGroupResourcesColumns holds the columns for the "telemetry_group_resources" table.
@@ -1207,6 +1503,8 @@ var (
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		CustomConfigResourcesTable,
+		CustomObjectResourcesTable,
+		CustomTypeResourcesTable,
 		EndpointResourcesTable,
 		HostResourcesTable,
 		HostgpuResourcesTable,
@@ -1227,6 +1525,8 @@ var (
 		RegionResourcesTable,
 		RemoteAccessConfigurationsTable,
 		RepeatedScheduleResourcesTable,
+		ResourceLabelsTable,
+		RoleBindingResourcesTable,
 		SingleScheduleResourcesTable,
 		SiteResourcesTable,
 		TelemetryGroupResourcesTable,
@@ -1239,6 +1539,12 @@ var (
 )
 
 func init() {
+	CustomObjectResourcesTable.ForeignKeys[0].RefTable = CustomTypeResourcesTable
+	CustomObjectResourcesTable.ForeignKeys[1].RefTable = HostResourcesTable
+	CustomObjectResourcesTable.ForeignKeys[2].RefTable = SiteResourcesTable
+	CustomObjectResourcesTable.ForeignKeys[3].RefTable = RegionResourcesTable
+	CustomObjectResourcesTable.ForeignKeys[4].RefTable = OuResourcesTable
+	CustomObjectResourcesTable.ForeignKeys[5].RefTable = InstanceResourcesTable
 	EndpointResourcesTable.ForeignKeys[0].RefTable = HostResourcesTable
 	HostResourcesTable.ForeignKeys[0].RefTable = SiteResourcesTable
 	HostResourcesTable.ForeignKeys[1].RefTable = ProviderResourcesTable
@@ -1266,6 +1572,9 @@ func init() {
 	RepeatedScheduleResourcesTable.ForeignKeys[1].RefTable = HostResourcesTable
 	RepeatedScheduleResourcesTable.ForeignKeys[2].RefTable = WorkloadResourcesTable
 	RepeatedScheduleResourcesTable.ForeignKeys[3].RefTable = RegionResourcesTable
+	RoleBindingResourcesTable.ForeignKeys[0].RefTable = RegionResourcesTable
+	RoleBindingResourcesTable.ForeignKeys[1].RefTable = SiteResourcesTable
+	RoleBindingResourcesTable.ForeignKeys[2].RefTable = OuResourcesTable
 	SingleScheduleResourcesTable.ForeignKeys[0].RefTable = SiteResourcesTable
 	SingleScheduleResourcesTable.ForeignKeys[1].RefTable = HostResourcesTable
 	SingleScheduleResourcesTable.ForeignKeys[2].RefTable = WorkloadResourcesTable
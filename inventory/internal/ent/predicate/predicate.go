@@ -9,6 +9,12 @@ import (
 // CustomConfigResource is the predicate function for customconfigresource builders.
 type CustomConfigResource func(*sql.Selector)
 
+// CustomObjectResource is the predicate function for customobjectresource builders.
+type CustomObjectResource func(*sql.Selector)
+
+// CustomTypeResource is the predicate function for customtyperesource builders.
+type CustomTypeResource func(*sql.Selector)
+
 // EndpointResource is the predicate function for endpointresource builders.
 type EndpointResource func(*sql.Selector)
 
@@ -69,6 +75,12 @@ type RemoteAccessConfiguration func(*sql.Selector)
 // RepeatedScheduleResource is the predicate function for repeatedscheduleresource builders.
 type RepeatedScheduleResource func(*sql.Selector)
 
+// ResourceLabel is the predicate function for resourcelabel builders.
+type ResourceLabel func(*sql.Selector)
+
+// RoleBindingResource is the predicate function for rolebindingresource builders.
+type RoleBindingResource func(*sql.Selector)
+
 // SingleScheduleResource is the predicate function for singlescheduleresource builders.
 type SingleScheduleResource func(*sql.Selector)
 
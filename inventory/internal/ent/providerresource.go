@@ -29,7 +29,7 @@ type ProviderResource struct {
 	// APICredentials holds the value of the "api_credentials" field.
 	APICredentials string `json:"api_credentials,omitempty"`
 	// Config holds the value of the "config" field.
-	Config string `json:"config,omitempty"`
+	Config string `json:"-"`
 	// TenantID holds the value of the "tenant_id" field.
 	TenantID string `json:"tenant_id,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
@@ -183,8 +183,7 @@ func (_m *ProviderResource) String() string {
 	builder.WriteString("api_credentials=")
 	builder.WriteString(_m.APICredentials)
 	builder.WriteString(", ")
-	builder.WriteString("config=")
-	builder.WriteString(_m.Config)
+	builder.WriteString("config=<sensitive>")
 	builder.WriteString(", ")
 	builder.WriteString("tenant_id=")
 	builder.WriteString(_m.TenantID)
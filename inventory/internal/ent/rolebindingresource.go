@@ -0,0 +1,255 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/ouresource"
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/regionresource"
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/rolebindingresource"
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/siteresource"
+)
+
+// RoleBindingResource is the model entity for the RoleBindingResource schema.
+type RoleBindingResource struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ResourceID holds the value of the "resource_id" field.
+	ResourceID string `json:"resource_id,omitempty"`
+	// Subject holds the value of the "subject" field.
+	Subject string `json:"subject,omitempty"`
+	// Role holds the value of the "role" field.
+	Role rolebindingresource.Role `json:"role,omitempty"`
+	// TenantID holds the value of the "tenant_id" field.
+	TenantID string `json:"tenant_id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt string `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt string `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the RoleBindingResourceQuery when eager-loading is set.
+	Edges                        RoleBindingResourceEdges `json:"edges"`
+	role_binding_resource_region *int
+	role_binding_resource_site   *int
+	role_binding_resource_ou     *int
+	selectValues                 sql.SelectValues
+}
+
+// RoleBindingResourceEdges holds the relations/edges for other nodes in the graph.
+type RoleBindingResourceEdges struct {
+	// Region holds the value of the region edge.
+	Region *RegionResource `json:"region,omitempty"`
+	// Site holds the value of the site edge.
+	Site *SiteResource `json:"site,omitempty"`
+	// Ou holds the value of the ou edge.
+	Ou *OuResource `json:"ou,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// RegionOrErr returns the Region value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RoleBindingResourceEdges) RegionOrErr() (*RegionResource, error) {
+	if e.Region != nil {
+		return e.Region, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: regionresource.Label}
+	}
+	return nil, &NotLoadedError{edge: "region"}
+}
+
+// SiteOrErr returns the Site value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RoleBindingResourceEdges) SiteOrErr() (*SiteResource, error) {
+	if e.Site != nil {
+		return e.Site, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: siteresource.Label}
+	}
+	return nil, &NotLoadedError{edge: "site"}
+}
+
+// OuOrErr returns the Ou value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RoleBindingResourceEdges) OuOrErr() (*OuResource, error) {
+	if e.Ou != nil {
+		return e.Ou, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: ouresource.Label}
+	}
+	return nil, &NotLoadedError{edge: "ou"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*RoleBindingResource) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case rolebindingresource.FieldID:
+			values[i] = new(sql.NullInt64)
+		case rolebindingresource.FieldResourceID, rolebindingresource.FieldSubject, rolebindingresource.FieldRole, rolebindingresource.FieldTenantID, rolebindingresource.FieldCreatedAt, rolebindingresource.FieldUpdatedAt:
+			values[i] = new(sql.NullString)
+		case rolebindingresource.ForeignKeys[0]: // role_binding_resource_region
+			values[i] = new(sql.NullInt64)
+		case rolebindingresource.ForeignKeys[1]: // role_binding_resource_site
+			values[i] = new(sql.NullInt64)
+		case rolebindingresource.ForeignKeys[2]: // role_binding_resource_ou
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the RoleBindingResource fields.
+func (_m *RoleBindingResource) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case rolebindingresource.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case rolebindingresource.FieldResourceID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field resource_id", values[i])
+			} else if value.Valid {
+				_m.ResourceID = value.String
+			}
+		case rolebindingresource.FieldSubject:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field subject", values[i])
+			} else if value.Valid {
+				_m.Subject = value.String
+			}
+		case rolebindingresource.FieldRole:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field role", values[i])
+			} else if value.Valid {
+				_m.Role = rolebindingresource.Role(value.String)
+			}
+		case rolebindingresource.FieldTenantID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = value.String
+			}
+		case rolebindingresource.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.String
+			}
+		case rolebindingresource.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.String
+			}
+		case rolebindingresource.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field role_binding_resource_region", value)
+			} else if value.Valid {
+				_m.role_binding_resource_region = new(int)
+				*_m.role_binding_resource_region = int(value.Int64)
+			}
+		case rolebindingresource.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field role_binding_resource_site", value)
+			} else if value.Valid {
+				_m.role_binding_resource_site = new(int)
+				*_m.role_binding_resource_site = int(value.Int64)
+			}
+		case rolebindingresource.ForeignKeys[2]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field role_binding_resource_ou", value)
+			} else if value.Valid {
+				_m.role_binding_resource_ou = new(int)
+				*_m.role_binding_resource_ou = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the RoleBindingResource.
+// This includes values selected through modifiers, order, etc.
+func (_m *RoleBindingResource) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryRegion queries the "region" edge of the RoleBindingResource entity.
+func (_m *RoleBindingResource) QueryRegion() *RegionResourceQuery {
+	return NewRoleBindingResourceClient(_m.config).QueryRegion(_m)
+}
+
+// QuerySite queries the "site" edge of the RoleBindingResource entity.
+func (_m *RoleBindingResource) QuerySite() *SiteResourceQuery {
+	return NewRoleBindingResourceClient(_m.config).QuerySite(_m)
+}
+
+// QueryOu queries the "ou" edge of the RoleBindingResource entity.
+func (_m *RoleBindingResource) QueryOu() *OuResourceQuery {
+	return NewRoleBindingResourceClient(_m.config).QueryOu(_m)
+}
+
+// Update returns a builder for updating this RoleBindingResource.
+// Note that you need to call RoleBindingResource.Unwrap() before calling this method if this RoleBindingResource
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *RoleBindingResource) Update() *RoleBindingResourceUpdateOne {
+	return NewRoleBindingResourceClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the RoleBindingResource entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *RoleBindingResource) Unwrap() *RoleBindingResource {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: RoleBindingResource is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *RoleBindingResource) String() string {
+	var builder strings.Builder
+	builder.WriteString("RoleBindingResource(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("resource_id=")
+	builder.WriteString(_m.ResourceID)
+	builder.WriteString(", ")
+	builder.WriteString("subject=")
+	builder.WriteString(_m.Subject)
+	builder.WriteString(", ")
+	builder.WriteString("role=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Role))
+	builder.WriteString(", ")
+	builder.WriteString("tenant_id=")
+	builder.WriteString(_m.TenantID)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt)
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// RoleBindingResources is a parsable slice of RoleBindingResource.
+type RoleBindingResources []*RoleBindingResource
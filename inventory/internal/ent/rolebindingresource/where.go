@@ -0,0 +1,518 @@
+// Code generated by ent, DO NOT EDIT.
+
+package rolebindingresource
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.RoleBindingResource {
+	return predicate.RoleBindingResource(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.RoleBindingResource {
+	return predicate.RoleBindingResource(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.RoleBindingResource {
+	return predicate.RoleBindingResource(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.RoleBindingResource {
+	return predicate.RoleBindingResource(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.RoleBindingResource {
+	return predicate.RoleBindingResource(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.RoleBindingResource {
+	return predicate.RoleBindingResource(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.RoleBindingResource {
+	return predicate.RoleBindingResource(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.RoleBindingResource {
+	return predicate.RoleBindingResource(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.RoleBindingResource {
+	return predicate.RoleBindingResource(sql.FieldLTE(FieldID, id))
+}
+
+// ResourceID applies equality check predicate on the "resource_id" field. It's identical to ResourceIDEQ.
+func ResourceID(v string) predicate.RoleBindingResource {
+	return predicate.RoleBindingResource(sql.FieldEQ(FieldResourceID, v))
+}
+
+// Subject applies equality check predicate on the "subject" field. It's identical to SubjectEQ.
+func Subject(v string) predicate.RoleBindingResource {
+	return predicate.RoleBindingResource(sql.FieldEQ(FieldSubject, v))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v string) predicate.RoleBindingResource {
+	return predicate.RoleBindingResource(sql.FieldEQ(FieldTenantID, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v string) predicate.RoleBindingResource {
+	return predicate.RoleBindingResource(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v string) predicate.RoleBindingResource {
+	return predicate.RoleBindingResource(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// ResourceIDEQ applies the EQ predicate on the "resource_id" field.
+func ResourceIDEQ(v string) predicate.RoleBindingResource {
+	return predicate.RoleBindingResource(sql.FieldEQ(FieldResourceID, v))
+}
+
+// ResourceIDNEQ applies the NEQ predicate on the "resource_id" field.
+func ResourceIDNEQ(v string) predicate.RoleBindingResource {
+	return predicate.RoleBindingResource(sql.FieldNEQ(FieldResourceID, v))
+}
+
+// ResourceIDIn applies the In predicate on the "resource_id" field.
+func ResourceIDIn(vs ...string) predicate.RoleBindingResource {
+	return predicate.RoleBindingResource(sql.FieldIn(FieldResourceID, vs...))
+}
+
+// ResourceIDNotIn applies the NotIn predicate on the "resource_id" field.
+func ResourceIDNotIn(vs ...string) predicate.RoleBindingResource {
+	return predicate.RoleBindingResource(sql.FieldNotIn(FieldResourceID, vs...))
+}
+
+// ResourceIDGT applies the GT predicate on the "resource_id" field.
+func ResourceIDGT(v string) predicate.RoleBindingResource {
+	return predicate.RoleBindingResource(sql.FieldGT(FieldResourceID, v))
+}
+
+// ResourceIDGTE applies the GTE predicate on the "resource_id" field.
+func ResourceIDGTE(v string) predicate.RoleBindingResource {
+	return predicate.RoleBindingResource(sql.FieldGTE(FieldResourceID, v))
+}
+
+// ResourceIDLT applies the LT predicate on the "resource_id" field.
+func ResourceIDLT(v string) predicate.RoleBindingResource {
+	return predicate.RoleBindingResource(sql.FieldLT(FieldResourceID, v))
+}
+
+// ResourceIDLTE applies the LTE predicate on the "resource_id" field.
+func ResourceIDLTE(v string) predicate.RoleBindingResource {
+	return predicate.RoleBindingResource(sql.FieldLTE(FieldResourceID, v))
+}
+
+// ResourceIDContains applies the Contains predicate on the "resource_id" field.
+func ResourceIDContains(v string) predicate.RoleBindingResource {
+	return predicate.RoleBindingResource(sql.FieldContains(FieldResourceID, v))
+}
+
+// ResourceIDHasPrefix applies the HasPrefix predicate on the "resource_id" field.
+func ResourceIDHasPrefix(v string) predicate.RoleBindingResource {
+	return predicate.RoleBindingResource(sql.FieldHasPrefix(FieldResourceID, v))
+}
+
+// ResourceIDHasSuffix applies the HasSuffix predicate on the "resource_id" field.
+func ResourceIDHasSuffix(v string) predicate.RoleBindingResource {
+	return predicate.RoleBindingResource(sql.FieldHasSuffix(FieldResourceID, v))
+}
+
+// ResourceIDEqualFold applies the EqualFold predicate on the "resource_id" field.
+func ResourceIDEqualFold(v string) predicate.RoleBindingResource {
+	return predicate.RoleBindingResource(sql.FieldEqualFold(FieldResourceID, v))
+}
+
+// ResourceIDContainsFold applies the ContainsFold predicate on the "resource_id" field.
+func ResourceIDContainsFold(v string) predicate.RoleBindingResource {
+	return predicate.RoleBindingResource(sql.FieldContainsFold(FieldResourceID, v))
+}
+
+// SubjectEQ applies the EQ predicate on the "subject" field.
+func SubjectEQ(v string) predicate.RoleBindingResource {
+	return predicate.RoleBindingResource(sql.FieldEQ(FieldSubject, v))
+}
+
+// SubjectNEQ applies the NEQ predicate on the "subject" field.
+func SubjectNEQ(v string) predicate.RoleBindingResource {
+	return predicate.RoleBindingResource(sql.FieldNEQ(FieldSubject, v))
+}
+
+// SubjectIn applies the In predicate on the "subject" field.
+func SubjectIn(vs ...string) predicate.RoleBindingResource {
+	return predicate.RoleBindingResource(sql.FieldIn(FieldSubject, vs...))
+}
+
+// SubjectNotIn applies the NotIn predicate on the "subject" field.
+func SubjectNotIn(vs ...string) predicate.RoleBindingResource {
+	return predicate.RoleBindingResource(sql.FieldNotIn(FieldSubject, vs...))
+}
+
+// SubjectGT applies the GT predicate on the "subject" field.
+func SubjectGT(v string) predicate.RoleBindingResource {
+	return predicate.RoleBindingResource(sql.FieldGT(FieldSubject, v))
+}
+
+// SubjectGTE applies the GTE predicate on the "subject" field.
+func SubjectGTE(v string) predicate.RoleBindingResource {
+	return predicate.RoleBindingResource(sql.FieldGTE(FieldSubject, v))
+}
+
+// SubjectLT applies the LT predicate on the "subject" field.
+func SubjectLT(v string) predicate.RoleBindingResource {
+	return predicate.RoleBindingResource(sql.FieldLT(FieldSubject, v))
+}
+
+// SubjectLTE applies the LTE predicate on the "subject" field.
+func SubjectLTE(v string) predicate.RoleBindingResource {
+	return predicate.RoleBindingResource(sql.FieldLTE(FieldSubject, v))
+}
+
+// SubjectContains applies the Contains predicate on the "subject" field.
+func SubjectContains(v string) predicate.RoleBindingResource {
+	return predicate.RoleBindingResource(sql.FieldContains(FieldSubject, v))
+}
+
+// SubjectHasPrefix applies the HasPrefix predicate on the "subject" field.
+func SubjectHasPrefix(v string) predicate.RoleBindingResource {
+	return predicate.RoleBindingResource(sql.FieldHasPrefix(FieldSubject, v))
+}
+
+// SubjectHasSuffix applies the HasSuffix predicate on the "subject" field.
+func SubjectHasSuffix(v string) predicate.RoleBindingResource {
+	return predicate.RoleBindingResource(sql.FieldHasSuffix(FieldSubject, v))
+}
+
+// SubjectEqualFold applies the EqualFold predicate on the "subject" field.
+func SubjectEqualFold(v string) predicate.RoleBindingResource {
+	return predicate.RoleBindingResource(sql.FieldEqualFold(FieldSubject, v))
+}
+
+// SubjectContainsFold applies the ContainsFold predicate on the "subject" field.
+func SubjectContainsFold(v string) predicate.RoleBindingResource {
+	return predicate.RoleBindingResource(sql.FieldContainsFold(FieldSubject, v))
+}
+
+// RoleEQ applies the EQ predicate on the "role" field.
+func RoleEQ(v Role) predicate.RoleBindingResource {
+	return predicate.RoleBindingResource(sql.FieldEQ(FieldRole, v))
+}
+
+// RoleNEQ applies the NEQ predicate on the "role" field.
+func RoleNEQ(v Role) predicate.RoleBindingResource {
+	return predicate.RoleBindingResource(sql.FieldNEQ(FieldRole, v))
+}
+
+// RoleIn applies the In predicate on the "role" field.
+func RoleIn(vs ...Role) predicate.RoleBindingResource {
+	return predicate.RoleBindingResource(sql.FieldIn(FieldRole, vs...))
+}
+
+// RoleNotIn applies the NotIn predicate on the "role" field.
+func RoleNotIn(vs ...Role) predicate.RoleBindingResource {
+	return predicate.RoleBindingResource(sql.FieldNotIn(FieldRole, vs...))
+}
+
+// RoleIsNil applies the IsNil predicate on the "role" field.
+func RoleIsNil() predicate.RoleBindingResource {
+	return predicate.RoleBindingResource(sql.FieldIsNull(FieldRole))
+}
+
+// RoleNotNil applies the NotNil predicate on the "role" field.
+func RoleNotNil() predicate.RoleBindingResource {
+	return predicate.RoleBindingResource(sql.FieldNotNull(FieldRole))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v string) predicate.RoleBindingResource {
+	return predicate.RoleBindingResource(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v string) predicate.RoleBindingResource {
+	return predicate.RoleBindingResource(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...string) predicate.RoleBindingResource {
+	return predicate.RoleBindingResource(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...string) predicate.RoleBindingResource {
+	return predicate.RoleBindingResource(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v string) predicate.RoleBindingResource {
+	return predicate.RoleBindingResource(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v string) predicate.RoleBindingResource {
+	return predicate.RoleBindingResource(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v string) predicate.RoleBindingResource {
+	return predicate.RoleBindingResource(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v string) predicate.RoleBindingResource {
+	return predicate.RoleBindingResource(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TenantIDContains applies the Contains predicate on the "tenant_id" field.
+func TenantIDContains(v string) predicate.RoleBindingResource {
+	return predicate.RoleBindingResource(sql.FieldContains(FieldTenantID, v))
+}
+
+// TenantIDHasPrefix applies the HasPrefix predicate on the "tenant_id" field.
+func TenantIDHasPrefix(v string) predicate.RoleBindingResource {
+	return predicate.RoleBindingResource(sql.FieldHasPrefix(FieldTenantID, v))
+}
+
+// TenantIDHasSuffix applies the HasSuffix predicate on the "tenant_id" field.
+func TenantIDHasSuffix(v string) predicate.RoleBindingResource {
+	return predicate.RoleBindingResource(sql.FieldHasSuffix(FieldTenantID, v))
+}
+
+// TenantIDEqualFold applies the EqualFold predicate on the "tenant_id" field.
+func TenantIDEqualFold(v string) predicate.RoleBindingResource {
+	return predicate.RoleBindingResource(sql.FieldEqualFold(FieldTenantID, v))
+}
+
+// TenantIDContainsFold applies the ContainsFold predicate on the "tenant_id" field.
+func TenantIDContainsFold(v string) predicate.RoleBindingResource {
+	return predicate.RoleBindingResource(sql.FieldContainsFold(FieldTenantID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v string) predicate.RoleBindingResource {
+	return predicate.RoleBindingResource(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v string) predicate.RoleBindingResource {
+	return predicate.RoleBindingResource(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...string) predicate.RoleBindingResource {
+	return predicate.RoleBindingResource(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...string) predicate.RoleBindingResource {
+	return predicate.RoleBindingResource(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v string) predicate.RoleBindingResource {
+	return predicate.RoleBindingResource(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v string) predicate.RoleBindingResource {
+	return predicate.RoleBindingResource(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v string) predicate.RoleBindingResource {
+	return predicate.RoleBindingResource(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v string) predicate.RoleBindingResource {
+	return predicate.RoleBindingResource(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// CreatedAtContains applies the Contains predicate on the "created_at" field.
+func CreatedAtContains(v string) predicate.RoleBindingResource {
+	return predicate.RoleBindingResource(sql.FieldContains(FieldCreatedAt, v))
+}
+
+// CreatedAtHasPrefix applies the HasPrefix predicate on the "created_at" field.
+func CreatedAtHasPrefix(v string) predicate.RoleBindingResource {
+	return predicate.RoleBindingResource(sql.FieldHasPrefix(FieldCreatedAt, v))
+}
+
+// CreatedAtHasSuffix applies the HasSuffix predicate on the "created_at" field.
+func CreatedAtHasSuffix(v string) predicate.RoleBindingResource {
+	return predicate.RoleBindingResource(sql.FieldHasSuffix(FieldCreatedAt, v))
+}
+
+// CreatedAtEqualFold applies the EqualFold predicate on the "created_at" field.
+func CreatedAtEqualFold(v string) predicate.RoleBindingResource {
+	return predicate.RoleBindingResource(sql.FieldEqualFold(FieldCreatedAt, v))
+}
+
+// CreatedAtContainsFold applies the ContainsFold predicate on the "created_at" field.
+func CreatedAtContainsFold(v string) predicate.RoleBindingResource {
+	return predicate.RoleBindingResource(sql.FieldContainsFold(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v string) predicate.RoleBindingResource {
+	return predicate.RoleBindingResource(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v string) predicate.RoleBindingResource {
+	return predicate.RoleBindingResource(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...string) predicate.RoleBindingResource {
+	return predicate.RoleBindingResource(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...string) predicate.RoleBindingResource {
+	return predicate.RoleBindingResource(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v string) predicate.RoleBindingResource {
+	return predicate.RoleBindingResource(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v string) predicate.RoleBindingResource {
+	return predicate.RoleBindingResource(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v string) predicate.RoleBindingResource {
+	return predicate.RoleBindingResource(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v string) predicate.RoleBindingResource {
+	return predicate.RoleBindingResource(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtContains applies the Contains predicate on the "updated_at" field.
+func UpdatedAtContains(v string) predicate.RoleBindingResource {
+	return predicate.RoleBindingResource(sql.FieldContains(FieldUpdatedAt, v))
+}
+
+// UpdatedAtHasPrefix applies the HasPrefix predicate on the "updated_at" field.
+func UpdatedAtHasPrefix(v string) predicate.RoleBindingResource {
+	return predicate.RoleBindingResource(sql.FieldHasPrefix(FieldUpdatedAt, v))
+}
+
+// UpdatedAtHasSuffix applies the HasSuffix predicate on the "updated_at" field.
+func UpdatedAtHasSuffix(v string) predicate.RoleBindingResource {
+	return predicate.RoleBindingResource(sql.FieldHasSuffix(FieldUpdatedAt, v))
+}
+
+// UpdatedAtEqualFold applies the EqualFold predicate on the "updated_at" field.
+func UpdatedAtEqualFold(v string) predicate.RoleBindingResource {
+	return predicate.RoleBindingResource(sql.FieldEqualFold(FieldUpdatedAt, v))
+}
+
+// UpdatedAtContainsFold applies the ContainsFold predicate on the "updated_at" field.
+func UpdatedAtContainsFold(v string) predicate.RoleBindingResource {
+	return predicate.RoleBindingResource(sql.FieldContainsFold(FieldUpdatedAt, v))
+}
+
+// HasRegion applies the HasEdge predicate on the "region" edge.
+func HasRegion() predicate.RoleBindingResource {
+	return predicate.RoleBindingResource(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, RegionTable, RegionColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRegionWith applies the HasEdge predicate on the "region" edge with a given conditions (other predicates).
+func HasRegionWith(preds ...predicate.RegionResource) predicate.RoleBindingResource {
+	return predicate.RoleBindingResource(func(s *sql.Selector) {
+		step := newRegionStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSite applies the HasEdge predicate on the "site" edge.
+func HasSite() predicate.RoleBindingResource {
+	return predicate.RoleBindingResource(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, SiteTable, SiteColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSiteWith applies the HasEdge predicate on the "site" edge with a given conditions (other predicates).
+func HasSiteWith(preds ...predicate.SiteResource) predicate.RoleBindingResource {
+	return predicate.RoleBindingResource(func(s *sql.Selector) {
+		step := newSiteStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasOu applies the HasEdge predicate on the "ou" edge.
+func HasOu() predicate.RoleBindingResource {
+	return predicate.RoleBindingResource(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, OuTable, OuColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOuWith applies the HasEdge predicate on the "ou" edge with a given conditions (other predicates).
+func HasOuWith(preds ...predicate.OuResource) predicate.RoleBindingResource {
+	return predicate.RoleBindingResource(func(s *sql.Selector) {
+		step := newOuStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.RoleBindingResource) predicate.RoleBindingResource {
+	return predicate.RoleBindingResource(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.RoleBindingResource) predicate.RoleBindingResource {
+	return predicate.RoleBindingResource(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.RoleBindingResource) predicate.RoleBindingResource {
+	return predicate.RoleBindingResource(sql.NotPredicates(p))
+}
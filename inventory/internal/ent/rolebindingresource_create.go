@@ -0,0 +1,369 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/ouresource"
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/regionresource"
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/rolebindingresource"
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/siteresource"
+)
+
+// RoleBindingResourceCreate is the builder for creating a RoleBindingResource entity.
+type RoleBindingResourceCreate struct {
+	config
+	mutation *RoleBindingResourceMutation
+	hooks    []Hook
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_c *RoleBindingResourceCreate) SetResourceID(v string) *RoleBindingResourceCreate {
+	_c.mutation.SetResourceID(v)
+	return _c
+}
+
+// SetSubject sets the "subject" field.
+func (_c *RoleBindingResourceCreate) SetSubject(v string) *RoleBindingResourceCreate {
+	_c.mutation.SetSubject(v)
+	return _c
+}
+
+// SetRole sets the "role" field.
+func (_c *RoleBindingResourceCreate) SetRole(v rolebindingresource.Role) *RoleBindingResourceCreate {
+	_c.mutation.SetRole(v)
+	return _c
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (_c *RoleBindingResourceCreate) SetNillableRole(v *rolebindingresource.Role) *RoleBindingResourceCreate {
+	if v != nil {
+		_c.SetRole(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *RoleBindingResourceCreate) SetTenantID(v string) *RoleBindingResourceCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *RoleBindingResourceCreate) SetCreatedAt(v string) *RoleBindingResourceCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *RoleBindingResourceCreate) SetUpdatedAt(v string) *RoleBindingResourceCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetRegionID sets the "region" edge to the RegionResource entity by ID.
+func (_c *RoleBindingResourceCreate) SetRegionID(id int) *RoleBindingResourceCreate {
+	_c.mutation.SetRegionID(id)
+	return _c
+}
+
+// SetNillableRegionID sets the "region" edge to the RegionResource entity by ID if the given value is not nil.
+func (_c *RoleBindingResourceCreate) SetNillableRegionID(id *int) *RoleBindingResourceCreate {
+	if id != nil {
+		_c = _c.SetRegionID(*id)
+	}
+	return _c
+}
+
+// SetRegion sets the "region" edge to the RegionResource entity.
+func (_c *RoleBindingResourceCreate) SetRegion(v *RegionResource) *RoleBindingResourceCreate {
+	return _c.SetRegionID(v.ID)
+}
+
+// SetSiteID sets the "site" edge to the SiteResource entity by ID.
+func (_c *RoleBindingResourceCreate) SetSiteID(id int) *RoleBindingResourceCreate {
+	_c.mutation.SetSiteID(id)
+	return _c
+}
+
+// SetNillableSiteID sets the "site" edge to the SiteResource entity by ID if the given value is not nil.
+func (_c *RoleBindingResourceCreate) SetNillableSiteID(id *int) *RoleBindingResourceCreate {
+	if id != nil {
+		_c = _c.SetSiteID(*id)
+	}
+	return _c
+}
+
+// SetSite sets the "site" edge to the SiteResource entity.
+func (_c *RoleBindingResourceCreate) SetSite(v *SiteResource) *RoleBindingResourceCreate {
+	return _c.SetSiteID(v.ID)
+}
+
+// SetOuID sets the "ou" edge to the OuResource entity by ID.
+func (_c *RoleBindingResourceCreate) SetOuID(id int) *RoleBindingResourceCreate {
+	_c.mutation.SetOuID(id)
+	return _c
+}
+
+// SetNillableOuID sets the "ou" edge to the OuResource entity by ID if the given value is not nil.
+func (_c *RoleBindingResourceCreate) SetNillableOuID(id *int) *RoleBindingResourceCreate {
+	if id != nil {
+		_c = _c.SetOuID(*id)
+	}
+	return _c
+}
+
+// SetOu sets the "ou" edge to the OuResource entity.
+func (_c *RoleBindingResourceCreate) SetOu(v *OuResource) *RoleBindingResourceCreate {
+	return _c.SetOuID(v.ID)
+}
+
+// Mutation returns the RoleBindingResourceMutation object of the builder.
+func (_c *RoleBindingResourceCreate) Mutation() *RoleBindingResourceMutation {
+	return _c.mutation
+}
+
+// Save creates the RoleBindingResource in the database.
+func (_c *RoleBindingResourceCreate) Save(ctx context.Context) (*RoleBindingResource, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *RoleBindingResourceCreate) SaveX(ctx context.Context) *RoleBindingResource {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RoleBindingResourceCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RoleBindingResourceCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *RoleBindingResourceCreate) check() error {
+	if _, ok := _c.mutation.ResourceID(); !ok {
+		return &ValidationError{Name: "resource_id", err: errors.New(`ent: missing required field "RoleBindingResource.resource_id"`)}
+	}
+	if _, ok := _c.mutation.Subject(); !ok {
+		return &ValidationError{Name: "subject", err: errors.New(`ent: missing required field "RoleBindingResource.subject"`)}
+	}
+	if v, ok := _c.mutation.Role(); ok {
+		if err := rolebindingresource.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "RoleBindingResource.role": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "RoleBindingResource.tenant_id"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "RoleBindingResource.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "RoleBindingResource.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *RoleBindingResourceCreate) sqlSave(ctx context.Context) (*RoleBindingResource, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *RoleBindingResourceCreate) createSpec() (*RoleBindingResource, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RoleBindingResource{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(rolebindingresource.Table, sqlgraph.NewFieldSpec(rolebindingresource.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.ResourceID(); ok {
+		_spec.SetField(rolebindingresource.FieldResourceID, field.TypeString, value)
+		_node.ResourceID = value
+	}
+	if value, ok := _c.mutation.Subject(); ok {
+		_spec.SetField(rolebindingresource.FieldSubject, field.TypeString, value)
+		_node.Subject = value
+	}
+	if value, ok := _c.mutation.Role(); ok {
+		_spec.SetField(rolebindingresource.FieldRole, field.TypeEnum, value)
+		_node.Role = value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(rolebindingresource.FieldTenantID, field.TypeString, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(rolebindingresource.FieldCreatedAt, field.TypeString, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(rolebindingresource.FieldUpdatedAt, field.TypeString, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.RegionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   rolebindingresource.RegionTable,
+			Columns: []string{rolebindingresource.RegionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(regionresource.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.role_binding_resource_region = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.SiteIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   rolebindingresource.SiteTable,
+			Columns: []string{rolebindingresource.SiteColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(siteresource.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.role_binding_resource_site = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.OuIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   rolebindingresource.OuTable,
+			Columns: []string{rolebindingresource.OuColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ouresource.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.role_binding_resource_ou = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// RoleBindingResourceCreateBulk is the builder for creating many RoleBindingResource entities in bulk.
+type RoleBindingResourceCreateBulk struct {
+	config
+	err      error
+	builders []*RoleBindingResourceCreate
+}
+
+// Save creates the RoleBindingResource entities in the database.
+func (_c *RoleBindingResourceCreateBulk) Save(ctx context.Context) ([]*RoleBindingResource, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*RoleBindingResource, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RoleBindingResourceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *RoleBindingResourceCreateBulk) SaveX(ctx context.Context) []*RoleBindingResource {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RoleBindingResourceCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RoleBindingResourceCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,764 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/ouresource"
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/predicate"
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/regionresource"
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/rolebindingresource"
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/siteresource"
+)
+
+// RoleBindingResourceQuery is the builder for querying RoleBindingResource entities.
+type RoleBindingResourceQuery struct {
+	config
+	ctx        *QueryContext
+	order      []rolebindingresource.OrderOption
+	inters     []Interceptor
+	predicates []predicate.RoleBindingResource
+	withRegion *RegionResourceQuery
+	withSite   *SiteResourceQuery
+	withOu     *OuResourceQuery
+	withFKs    bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the RoleBindingResourceQuery builder.
+func (_q *RoleBindingResourceQuery) Where(ps ...predicate.RoleBindingResource) *RoleBindingResourceQuery {
+	_q.predicates = append(_q.predicates, ps...)
+	return _q
+}
+
+// Limit the number of records to be returned by this query.
+func (_q *RoleBindingResourceQuery) Limit(limit int) *RoleBindingResourceQuery {
+	_q.ctx.Limit = &limit
+	return _q
+}
+
+// Offset to start from.
+func (_q *RoleBindingResourceQuery) Offset(offset int) *RoleBindingResourceQuery {
+	_q.ctx.Offset = &offset
+	return _q
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (_q *RoleBindingResourceQuery) Unique(unique bool) *RoleBindingResourceQuery {
+	_q.ctx.Unique = &unique
+	return _q
+}
+
+// Order specifies how the records should be ordered.
+func (_q *RoleBindingResourceQuery) Order(o ...rolebindingresource.OrderOption) *RoleBindingResourceQuery {
+	_q.order = append(_q.order, o...)
+	return _q
+}
+
+// QueryRegion chains the current query on the "region" edge.
+func (_q *RoleBindingResourceQuery) QueryRegion() *RegionResourceQuery {
+	query := (&RegionResourceClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(rolebindingresource.Table, rolebindingresource.FieldID, selector),
+			sqlgraph.To(regionresource.Table, regionresource.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, rolebindingresource.RegionTable, rolebindingresource.RegionColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySite chains the current query on the "site" edge.
+func (_q *RoleBindingResourceQuery) QuerySite() *SiteResourceQuery {
+	query := (&SiteResourceClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(rolebindingresource.Table, rolebindingresource.FieldID, selector),
+			sqlgraph.To(siteresource.Table, siteresource.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, rolebindingresource.SiteTable, rolebindingresource.SiteColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryOu chains the current query on the "ou" edge.
+func (_q *RoleBindingResourceQuery) QueryOu() *OuResourceQuery {
+	query := (&OuResourceClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(rolebindingresource.Table, rolebindingresource.FieldID, selector),
+			sqlgraph.To(ouresource.Table, ouresource.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, rolebindingresource.OuTable, rolebindingresource.OuColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first RoleBindingResource entity from the query.
+// Returns a *NotFoundError when no RoleBindingResource was found.
+func (_q *RoleBindingResourceQuery) First(ctx context.Context) (*RoleBindingResource, error) {
+	nodes, err := _q.Limit(1).All(setContextOp(ctx, _q.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{rolebindingresource.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (_q *RoleBindingResourceQuery) FirstX(ctx context.Context) *RoleBindingResource {
+	node, err := _q.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first RoleBindingResource ID from the query.
+// Returns a *NotFoundError when no RoleBindingResource ID was found.
+func (_q *RoleBindingResourceQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = _q.Limit(1).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{rolebindingresource.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (_q *RoleBindingResourceQuery) FirstIDX(ctx context.Context) int {
+	id, err := _q.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single RoleBindingResource entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one RoleBindingResource entity is found.
+// Returns a *NotFoundError when no RoleBindingResource entities are found.
+func (_q *RoleBindingResourceQuery) Only(ctx context.Context) (*RoleBindingResource, error) {
+	nodes, err := _q.Limit(2).All(setContextOp(ctx, _q.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{rolebindingresource.Label}
+	default:
+		return nil, &NotSingularError{rolebindingresource.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (_q *RoleBindingResourceQuery) OnlyX(ctx context.Context) *RoleBindingResource {
+	node, err := _q.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only RoleBindingResource ID in the query.
+// Returns a *NotSingularError when more than one RoleBindingResource ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (_q *RoleBindingResourceQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = _q.Limit(2).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{rolebindingresource.Label}
+	default:
+		err = &NotSingularError{rolebindingresource.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (_q *RoleBindingResourceQuery) OnlyIDX(ctx context.Context) int {
+	id, err := _q.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of RoleBindingResources.
+func (_q *RoleBindingResourceQuery) All(ctx context.Context) ([]*RoleBindingResource, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryAll)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*RoleBindingResource, *RoleBindingResourceQuery]()
+	return withInterceptors[[]*RoleBindingResource](ctx, _q, qr, _q.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (_q *RoleBindingResourceQuery) AllX(ctx context.Context) []*RoleBindingResource {
+	nodes, err := _q.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of RoleBindingResource IDs.
+func (_q *RoleBindingResourceQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if _q.ctx.Unique == nil && _q.path != nil {
+		_q.Unique(true)
+	}
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryIDs)
+	if err = _q.Select(rolebindingresource.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (_q *RoleBindingResourceQuery) IDsX(ctx context.Context) []int {
+	ids, err := _q.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (_q *RoleBindingResourceQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryCount)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, _q, querierCount[*RoleBindingResourceQuery](), _q.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (_q *RoleBindingResourceQuery) CountX(ctx context.Context) int {
+	count, err := _q.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (_q *RoleBindingResourceQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryExist)
+	switch _, err := _q.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (_q *RoleBindingResourceQuery) ExistX(ctx context.Context) bool {
+	exist, err := _q.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the RoleBindingResourceQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (_q *RoleBindingResourceQuery) Clone() *RoleBindingResourceQuery {
+	if _q == nil {
+		return nil
+	}
+	return &RoleBindingResourceQuery{
+		config:     _q.config,
+		ctx:        _q.ctx.Clone(),
+		order:      append([]rolebindingresource.OrderOption{}, _q.order...),
+		inters:     append([]Interceptor{}, _q.inters...),
+		predicates: append([]predicate.RoleBindingResource{}, _q.predicates...),
+		withRegion: _q.withRegion.Clone(),
+		withSite:   _q.withSite.Clone(),
+		withOu:     _q.withOu.Clone(),
+		// clone intermediate query.
+		sql:  _q.sql.Clone(),
+		path: _q.path,
+	}
+}
+
+// WithRegion tells the query-builder to eager-load the nodes that are connected to
+// the "region" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *RoleBindingResourceQuery) WithRegion(opts ...func(*RegionResourceQuery)) *RoleBindingResourceQuery {
+	query := (&RegionResourceClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withRegion = query
+	return _q
+}
+
+// WithSite tells the query-builder to eager-load the nodes that are connected to
+// the "site" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *RoleBindingResourceQuery) WithSite(opts ...func(*SiteResourceQuery)) *RoleBindingResourceQuery {
+	query := (&SiteResourceClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withSite = query
+	return _q
+}
+
+// WithOu tells the query-builder to eager-load the nodes that are connected to
+// the "ou" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *RoleBindingResourceQuery) WithOu(opts ...func(*OuResourceQuery)) *RoleBindingResourceQuery {
+	query := (&OuResourceClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withOu = query
+	return _q
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		ResourceID string `json:"resource_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.RoleBindingResource.Query().
+//		GroupBy(rolebindingresource.FieldResourceID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (_q *RoleBindingResourceQuery) GroupBy(field string, fields ...string) *RoleBindingResourceGroupBy {
+	_q.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &RoleBindingResourceGroupBy{build: _q}
+	grbuild.flds = &_q.ctx.Fields
+	grbuild.label = rolebindingresource.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		ResourceID string `json:"resource_id,omitempty"`
+//	}
+//
+//	client.RoleBindingResource.Query().
+//		Select(rolebindingresource.FieldResourceID).
+//		Scan(ctx, &v)
+func (_q *RoleBindingResourceQuery) Select(fields ...string) *RoleBindingResourceSelect {
+	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
+	sbuild := &RoleBindingResourceSelect{RoleBindingResourceQuery: _q}
+	sbuild.label = rolebindingresource.Label
+	sbuild.flds, sbuild.scan = &_q.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a RoleBindingResourceSelect configured with the given aggregations.
+func (_q *RoleBindingResourceQuery) Aggregate(fns ...AggregateFunc) *RoleBindingResourceSelect {
+	return _q.Select().Aggregate(fns...)
+}
+
+func (_q *RoleBindingResourceQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range _q.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, _q); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range _q.ctx.Fields {
+		if !rolebindingresource.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if _q.path != nil {
+		prev, err := _q.path(ctx)
+		if err != nil {
+			return err
+		}
+		_q.sql = prev
+	}
+	return nil
+}
+
+func (_q *RoleBindingResourceQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*RoleBindingResource, error) {
+	var (
+		nodes       = []*RoleBindingResource{}
+		withFKs     = _q.withFKs
+		_spec       = _q.querySpec()
+		loadedTypes = [3]bool{
+			_q.withRegion != nil,
+			_q.withSite != nil,
+			_q.withOu != nil,
+		}
+	)
+	if _q.withRegion != nil || _q.withSite != nil || _q.withOu != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, rolebindingresource.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*RoleBindingResource).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &RoleBindingResource{config: _q.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, _q.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := _q.withRegion; query != nil {
+		if err := _q.loadRegion(ctx, query, nodes, nil,
+			func(n *RoleBindingResource, e *RegionResource) { n.Edges.Region = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withSite; query != nil {
+		if err := _q.loadSite(ctx, query, nodes, nil,
+			func(n *RoleBindingResource, e *SiteResource) { n.Edges.Site = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withOu; query != nil {
+		if err := _q.loadOu(ctx, query, nodes, nil,
+			func(n *RoleBindingResource, e *OuResource) { n.Edges.Ou = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (_q *RoleBindingResourceQuery) loadRegion(ctx context.Context, query *RegionResourceQuery, nodes []*RoleBindingResource, init func(*RoleBindingResource), assign func(*RoleBindingResource, *RegionResource)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*RoleBindingResource)
+	for i := range nodes {
+		if nodes[i].role_binding_resource_region == nil {
+			continue
+		}
+		fk := *nodes[i].role_binding_resource_region
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(regionresource.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "role_binding_resource_region" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *RoleBindingResourceQuery) loadSite(ctx context.Context, query *SiteResourceQuery, nodes []*RoleBindingResource, init func(*RoleBindingResource), assign func(*RoleBindingResource, *SiteResource)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*RoleBindingResource)
+	for i := range nodes {
+		if nodes[i].role_binding_resource_site == nil {
+			continue
+		}
+		fk := *nodes[i].role_binding_resource_site
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(siteresource.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "role_binding_resource_site" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *RoleBindingResourceQuery) loadOu(ctx context.Context, query *OuResourceQuery, nodes []*RoleBindingResource, init func(*RoleBindingResource), assign func(*RoleBindingResource, *OuResource)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*RoleBindingResource)
+	for i := range nodes {
+		if nodes[i].role_binding_resource_ou == nil {
+			continue
+		}
+		fk := *nodes[i].role_binding_resource_ou
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(ouresource.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "role_binding_resource_ou" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (_q *RoleBindingResourceQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := _q.querySpec()
+	_spec.Node.Columns = _q.ctx.Fields
+	if len(_q.ctx.Fields) > 0 {
+		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, _q.driver, _spec)
+}
+
+func (_q *RoleBindingResourceQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(rolebindingresource.Table, rolebindingresource.Columns, sqlgraph.NewFieldSpec(rolebindingresource.FieldID, field.TypeInt))
+	_spec.From = _q.sql
+	if unique := _q.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if _q.path != nil {
+		_spec.Unique = true
+	}
+	if fields := _q.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, rolebindingresource.FieldID)
+		for i := range fields {
+			if fields[i] != rolebindingresource.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := _q.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := _q.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (_q *RoleBindingResourceQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(_q.driver.Dialect())
+	t1 := builder.Table(rolebindingresource.Table)
+	columns := _q.ctx.Fields
+	if len(columns) == 0 {
+		columns = rolebindingresource.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if _q.sql != nil {
+		selector = _q.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if _q.ctx.Unique != nil && *_q.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range _q.predicates {
+		p(selector)
+	}
+	for _, p := range _q.order {
+		p(selector)
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// RoleBindingResourceGroupBy is the group-by builder for RoleBindingResource entities.
+type RoleBindingResourceGroupBy struct {
+	selector
+	build *RoleBindingResourceQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (_g *RoleBindingResourceGroupBy) Aggregate(fns ...AggregateFunc) *RoleBindingResourceGroupBy {
+	_g.fns = append(_g.fns, fns...)
+	return _g
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_g *RoleBindingResourceGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _g.build.ctx, ent.OpQueryGroupBy)
+	if err := _g.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*RoleBindingResourceQuery, *RoleBindingResourceGroupBy](ctx, _g.build, _g, _g.build.inters, v)
+}
+
+func (_g *RoleBindingResourceGroupBy) sqlScan(ctx context.Context, root *RoleBindingResourceQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(_g.fns))
+	for _, fn := range _g.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*_g.flds)+len(_g.fns))
+		for _, f := range *_g.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// RoleBindingResourceSelect is the builder for selecting fields of RoleBindingResource entities.
+type RoleBindingResourceSelect struct {
+	*RoleBindingResourceQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (_s *RoleBindingResourceSelect) Aggregate(fns ...AggregateFunc) *RoleBindingResourceSelect {
+	_s.fns = append(_s.fns, fns...)
+	return _s
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_s *RoleBindingResourceSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _s.ctx, ent.OpQuerySelect)
+	if err := _s.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*RoleBindingResourceQuery, *RoleBindingResourceSelect](ctx, _s.RoleBindingResourceQuery, _s, _s.inters, v)
+}
+
+func (_s *RoleBindingResourceSelect) sqlScan(ctx context.Context, root *RoleBindingResourceQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(_s.fns))
+	for _, fn := range _s.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*_s.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,682 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/ouresource"
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/predicate"
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/regionresource"
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/rolebindingresource"
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/siteresource"
+)
+
+// RoleBindingResourceUpdate is the builder for updating RoleBindingResource entities.
+type RoleBindingResourceUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RoleBindingResourceMutation
+}
+
+// Where appends a list predicates to the RoleBindingResourceUpdate builder.
+func (_u *RoleBindingResourceUpdate) Where(ps ...predicate.RoleBindingResource) *RoleBindingResourceUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_u *RoleBindingResourceUpdate) SetResourceID(v string) *RoleBindingResourceUpdate {
+	_u.mutation.SetResourceID(v)
+	return _u
+}
+
+// SetNillableResourceID sets the "resource_id" field if the given value is not nil.
+func (_u *RoleBindingResourceUpdate) SetNillableResourceID(v *string) *RoleBindingResourceUpdate {
+	if v != nil {
+		_u.SetResourceID(*v)
+	}
+	return _u
+}
+
+// SetSubject sets the "subject" field.
+func (_u *RoleBindingResourceUpdate) SetSubject(v string) *RoleBindingResourceUpdate {
+	_u.mutation.SetSubject(v)
+	return _u
+}
+
+// SetNillableSubject sets the "subject" field if the given value is not nil.
+func (_u *RoleBindingResourceUpdate) SetNillableSubject(v *string) *RoleBindingResourceUpdate {
+	if v != nil {
+		_u.SetSubject(*v)
+	}
+	return _u
+}
+
+// SetRole sets the "role" field.
+func (_u *RoleBindingResourceUpdate) SetRole(v rolebindingresource.Role) *RoleBindingResourceUpdate {
+	_u.mutation.SetRole(v)
+	return _u
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (_u *RoleBindingResourceUpdate) SetNillableRole(v *rolebindingresource.Role) *RoleBindingResourceUpdate {
+	if v != nil {
+		_u.SetRole(*v)
+	}
+	return _u
+}
+
+// ClearRole clears the value of the "role" field.
+func (_u *RoleBindingResourceUpdate) ClearRole() *RoleBindingResourceUpdate {
+	_u.mutation.ClearRole()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *RoleBindingResourceUpdate) SetUpdatedAt(v string) *RoleBindingResourceUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *RoleBindingResourceUpdate) SetNillableUpdatedAt(v *string) *RoleBindingResourceUpdate {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// SetRegionID sets the "region" edge to the RegionResource entity by ID.
+func (_u *RoleBindingResourceUpdate) SetRegionID(id int) *RoleBindingResourceUpdate {
+	_u.mutation.SetRegionID(id)
+	return _u
+}
+
+// SetNillableRegionID sets the "region" edge to the RegionResource entity by ID if the given value is not nil.
+func (_u *RoleBindingResourceUpdate) SetNillableRegionID(id *int) *RoleBindingResourceUpdate {
+	if id != nil {
+		_u = _u.SetRegionID(*id)
+	}
+	return _u
+}
+
+// SetRegion sets the "region" edge to the RegionResource entity.
+func (_u *RoleBindingResourceUpdate) SetRegion(v *RegionResource) *RoleBindingResourceUpdate {
+	return _u.SetRegionID(v.ID)
+}
+
+// SetSiteID sets the "site" edge to the SiteResource entity by ID.
+func (_u *RoleBindingResourceUpdate) SetSiteID(id int) *RoleBindingResourceUpdate {
+	_u.mutation.SetSiteID(id)
+	return _u
+}
+
+// SetNillableSiteID sets the "site" edge to the SiteResource entity by ID if the given value is not nil.
+func (_u *RoleBindingResourceUpdate) SetNillableSiteID(id *int) *RoleBindingResourceUpdate {
+	if id != nil {
+		_u = _u.SetSiteID(*id)
+	}
+	return _u
+}
+
+// SetSite sets the "site" edge to the SiteResource entity.
+func (_u *RoleBindingResourceUpdate) SetSite(v *SiteResource) *RoleBindingResourceUpdate {
+	return _u.SetSiteID(v.ID)
+}
+
+// SetOuID sets the "ou" edge to the OuResource entity by ID.
+func (_u *RoleBindingResourceUpdate) SetOuID(id int) *RoleBindingResourceUpdate {
+	_u.mutation.SetOuID(id)
+	return _u
+}
+
+// SetNillableOuID sets the "ou" edge to the OuResource entity by ID if the given value is not nil.
+func (_u *RoleBindingResourceUpdate) SetNillableOuID(id *int) *RoleBindingResourceUpdate {
+	if id != nil {
+		_u = _u.SetOuID(*id)
+	}
+	return _u
+}
+
+// SetOu sets the "ou" edge to the OuResource entity.
+func (_u *RoleBindingResourceUpdate) SetOu(v *OuResource) *RoleBindingResourceUpdate {
+	return _u.SetOuID(v.ID)
+}
+
+// Mutation returns the RoleBindingResourceMutation object of the builder.
+func (_u *RoleBindingResourceUpdate) Mutation() *RoleBindingResourceMutation {
+	return _u.mutation
+}
+
+// ClearRegion clears the "region" edge to the RegionResource entity.
+func (_u *RoleBindingResourceUpdate) ClearRegion() *RoleBindingResourceUpdate {
+	_u.mutation.ClearRegion()
+	return _u
+}
+
+// ClearSite clears the "site" edge to the SiteResource entity.
+func (_u *RoleBindingResourceUpdate) ClearSite() *RoleBindingResourceUpdate {
+	_u.mutation.ClearSite()
+	return _u
+}
+
+// ClearOu clears the "ou" edge to the OuResource entity.
+func (_u *RoleBindingResourceUpdate) ClearOu() *RoleBindingResourceUpdate {
+	_u.mutation.ClearOu()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *RoleBindingResourceUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RoleBindingResourceUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *RoleBindingResourceUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RoleBindingResourceUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RoleBindingResourceUpdate) check() error {
+	if v, ok := _u.mutation.Role(); ok {
+		if err := rolebindingresource.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "RoleBindingResource.role": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *RoleBindingResourceUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(rolebindingresource.Table, rolebindingresource.Columns, sqlgraph.NewFieldSpec(rolebindingresource.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ResourceID(); ok {
+		_spec.SetField(rolebindingresource.FieldResourceID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Subject(); ok {
+		_spec.SetField(rolebindingresource.FieldSubject, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Role(); ok {
+		_spec.SetField(rolebindingresource.FieldRole, field.TypeEnum, value)
+	}
+	if _u.mutation.RoleCleared() {
+		_spec.ClearField(rolebindingresource.FieldRole, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(rolebindingresource.FieldUpdatedAt, field.TypeString, value)
+	}
+	if _u.mutation.RegionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   rolebindingresource.RegionTable,
+			Columns: []string{rolebindingresource.RegionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(regionresource.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RegionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   rolebindingresource.RegionTable,
+			Columns: []string{rolebindingresource.RegionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(regionresource.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SiteCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   rolebindingresource.SiteTable,
+			Columns: []string{rolebindingresource.SiteColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(siteresource.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SiteIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   rolebindingresource.SiteTable,
+			Columns: []string{rolebindingresource.SiteColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(siteresource.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.OuCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   rolebindingresource.OuTable,
+			Columns: []string{rolebindingresource.OuColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ouresource.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OuIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   rolebindingresource.OuTable,
+			Columns: []string{rolebindingresource.OuColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ouresource.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{rolebindingresource.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// RoleBindingResourceUpdateOne is the builder for updating a single RoleBindingResource entity.
+type RoleBindingResourceUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RoleBindingResourceMutation
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_u *RoleBindingResourceUpdateOne) SetResourceID(v string) *RoleBindingResourceUpdateOne {
+	_u.mutation.SetResourceID(v)
+	return _u
+}
+
+// SetNillableResourceID sets the "resource_id" field if the given value is not nil.
+func (_u *RoleBindingResourceUpdateOne) SetNillableResourceID(v *string) *RoleBindingResourceUpdateOne {
+	if v != nil {
+		_u.SetResourceID(*v)
+	}
+	return _u
+}
+
+// SetSubject sets the "subject" field.
+func (_u *RoleBindingResourceUpdateOne) SetSubject(v string) *RoleBindingResourceUpdateOne {
+	_u.mutation.SetSubject(v)
+	return _u
+}
+
+// SetNillableSubject sets the "subject" field if the given value is not nil.
+func (_u *RoleBindingResourceUpdateOne) SetNillableSubject(v *string) *RoleBindingResourceUpdateOne {
+	if v != nil {
+		_u.SetSubject(*v)
+	}
+	return _u
+}
+
+// SetRole sets the "role" field.
+func (_u *RoleBindingResourceUpdateOne) SetRole(v rolebindingresource.Role) *RoleBindingResourceUpdateOne {
+	_u.mutation.SetRole(v)
+	return _u
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (_u *RoleBindingResourceUpdateOne) SetNillableRole(v *rolebindingresource.Role) *RoleBindingResourceUpdateOne {
+	if v != nil {
+		_u.SetRole(*v)
+	}
+	return _u
+}
+
+// ClearRole clears the value of the "role" field.
+func (_u *RoleBindingResourceUpdateOne) ClearRole() *RoleBindingResourceUpdateOne {
+	_u.mutation.ClearRole()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *RoleBindingResourceUpdateOne) SetUpdatedAt(v string) *RoleBindingResourceUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *RoleBindingResourceUpdateOne) SetNillableUpdatedAt(v *string) *RoleBindingResourceUpdateOne {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// SetRegionID sets the "region" edge to the RegionResource entity by ID.
+func (_u *RoleBindingResourceUpdateOne) SetRegionID(id int) *RoleBindingResourceUpdateOne {
+	_u.mutation.SetRegionID(id)
+	return _u
+}
+
+// SetNillableRegionID sets the "region" edge to the RegionResource entity by ID if the given value is not nil.
+func (_u *RoleBindingResourceUpdateOne) SetNillableRegionID(id *int) *RoleBindingResourceUpdateOne {
+	if id != nil {
+		_u = _u.SetRegionID(*id)
+	}
+	return _u
+}
+
+// SetRegion sets the "region" edge to the RegionResource entity.
+func (_u *RoleBindingResourceUpdateOne) SetRegion(v *RegionResource) *RoleBindingResourceUpdateOne {
+	return _u.SetRegionID(v.ID)
+}
+
+// SetSiteID sets the "site" edge to the SiteResource entity by ID.
+func (_u *RoleBindingResourceUpdateOne) SetSiteID(id int) *RoleBindingResourceUpdateOne {
+	_u.mutation.SetSiteID(id)
+	return _u
+}
+
+// SetNillableSiteID sets the "site" edge to the SiteResource entity by ID if the given value is not nil.
+func (_u *RoleBindingResourceUpdateOne) SetNillableSiteID(id *int) *RoleBindingResourceUpdateOne {
+	if id != nil {
+		_u = _u.SetSiteID(*id)
+	}
+	return _u
+}
+
+// SetSite sets the "site" edge to the SiteResource entity.
+func (_u *RoleBindingResourceUpdateOne) SetSite(v *SiteResource) *RoleBindingResourceUpdateOne {
+	return _u.SetSiteID(v.ID)
+}
+
+// SetOuID sets the "ou" edge to the OuResource entity by ID.
+func (_u *RoleBindingResourceUpdateOne) SetOuID(id int) *RoleBindingResourceUpdateOne {
+	_u.mutation.SetOuID(id)
+	return _u
+}
+
+// SetNillableOuID sets the "ou" edge to the OuResource entity by ID if the given value is not nil.
+func (_u *RoleBindingResourceUpdateOne) SetNillableOuID(id *int) *RoleBindingResourceUpdateOne {
+	if id != nil {
+		_u = _u.SetOuID(*id)
+	}
+	return _u
+}
+
+// SetOu sets the "ou" edge to the OuResource entity.
+func (_u *RoleBindingResourceUpdateOne) SetOu(v *OuResource) *RoleBindingResourceUpdateOne {
+	return _u.SetOuID(v.ID)
+}
+
+// Mutation returns the RoleBindingResourceMutation object of the builder.
+func (_u *RoleBindingResourceUpdateOne) Mutation() *RoleBindingResourceMutation {
+	return _u.mutation
+}
+
+// ClearRegion clears the "region" edge to the RegionResource entity.
+func (_u *RoleBindingResourceUpdateOne) ClearRegion() *RoleBindingResourceUpdateOne {
+	_u.mutation.ClearRegion()
+	return _u
+}
+
+// ClearSite clears the "site" edge to the SiteResource entity.
+func (_u *RoleBindingResourceUpdateOne) ClearSite() *RoleBindingResourceUpdateOne {
+	_u.mutation.ClearSite()
+	return _u
+}
+
+// ClearOu clears the "ou" edge to the OuResource entity.
+func (_u *RoleBindingResourceUpdateOne) ClearOu() *RoleBindingResourceUpdateOne {
+	_u.mutation.ClearOu()
+	return _u
+}
+
+// Where appends a list predicates to the RoleBindingResourceUpdate builder.
+func (_u *RoleBindingResourceUpdateOne) Where(ps ...predicate.RoleBindingResource) *RoleBindingResourceUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *RoleBindingResourceUpdateOne) Select(field string, fields ...string) *RoleBindingResourceUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated RoleBindingResource entity.
+func (_u *RoleBindingResourceUpdateOne) Save(ctx context.Context) (*RoleBindingResource, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RoleBindingResourceUpdateOne) SaveX(ctx context.Context) *RoleBindingResource {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *RoleBindingResourceUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RoleBindingResourceUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RoleBindingResourceUpdateOne) check() error {
+	if v, ok := _u.mutation.Role(); ok {
+		if err := rolebindingresource.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "RoleBindingResource.role": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *RoleBindingResourceUpdateOne) sqlSave(ctx context.Context) (_node *RoleBindingResource, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(rolebindingresource.Table, rolebindingresource.Columns, sqlgraph.NewFieldSpec(rolebindingresource.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "RoleBindingResource.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, rolebindingresource.FieldID)
+		for _, f := range fields {
+			if !rolebindingresource.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != rolebindingresource.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ResourceID(); ok {
+		_spec.SetField(rolebindingresource.FieldResourceID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Subject(); ok {
+		_spec.SetField(rolebindingresource.FieldSubject, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Role(); ok {
+		_spec.SetField(rolebindingresource.FieldRole, field.TypeEnum, value)
+	}
+	if _u.mutation.RoleCleared() {
+		_spec.ClearField(rolebindingresource.FieldRole, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(rolebindingresource.FieldUpdatedAt, field.TypeString, value)
+	}
+	if _u.mutation.RegionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   rolebindingresource.RegionTable,
+			Columns: []string{rolebindingresource.RegionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(regionresource.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RegionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   rolebindingresource.RegionTable,
+			Columns: []string{rolebindingresource.RegionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(regionresource.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SiteCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   rolebindingresource.SiteTable,
+			Columns: []string{rolebindingresource.SiteColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(siteresource.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SiteIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   rolebindingresource.SiteTable,
+			Columns: []string{rolebindingresource.SiteColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(siteresource.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.OuCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   rolebindingresource.OuTable,
+			Columns: []string{rolebindingresource.OuColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ouresource.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OuIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   rolebindingresource.OuTable,
+			Columns: []string{rolebindingresource.OuColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ouresource.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &RoleBindingResource{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{rolebindingresource.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
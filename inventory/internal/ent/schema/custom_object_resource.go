@@ -0,0 +1,28 @@
+// File updated by protoc-gen-ent.
+
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+type CustomObjectResource struct {
+	ent.Schema
+}
+
+func (CustomObjectResource) Fields() []ent.Field {
+	return []ent.Field{field.String("resource_id").Unique(), field.String("name").Optional(), field.String("spec").Optional(), field.String("tenant_id").Immutable(), field.String("created_at").Immutable().SchemaType(map[string]string{"postgres": "TIMESTAMP"}), field.String("updated_at").SchemaType(map[string]string{"postgres": "TIMESTAMP"})}
+}
+func (CustomObjectResource) Edges() []ent.Edge {
+	return []ent.Edge{edge.To("custom_type", CustomTypeResource.Type).Required().Unique(), edge.To("host", HostResource.Type).Unique(), edge.To("site", SiteResource.Type).Unique(), edge.To("region", RegionResource.Type).Unique(), edge.To("ou", OuResource.Type).Unique(), edge.To("instance", InstanceResource.Type).Unique()}
+}
+func (CustomObjectResource) Annotations() []schema.Annotation {
+	return nil
+}
+func (CustomObjectResource) Indexes() []ent.Index {
+	return []ent.Index{index.Fields("tenant_id")}
+}
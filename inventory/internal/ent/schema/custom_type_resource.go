@@ -0,0 +1,27 @@
+// File updated by protoc-gen-ent.
+
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+type CustomTypeResource struct {
+	ent.Schema
+}
+
+func (CustomTypeResource) Fields() []ent.Field {
+	return []ent.Field{field.String("resource_id").Unique(), field.String("name").Immutable(), field.String("description").Optional(), field.String("json_schema"), field.String("allowed_edges").Optional(), field.String("tenant_id").Immutable(), field.String("created_at").Immutable().SchemaType(map[string]string{"postgres": "TIMESTAMP"}), field.String("updated_at").SchemaType(map[string]string{"postgres": "TIMESTAMP"})}
+}
+func (CustomTypeResource) Edges() []ent.Edge {
+	return nil
+}
+func (CustomTypeResource) Annotations() []schema.Annotation {
+	return nil
+}
+func (CustomTypeResource) Indexes() []ent.Index {
+	return []ent.Index{index.Fields("name", "tenant_id").Unique(), index.Fields("tenant_id")}
+}
@@ -16,7 +16,7 @@ type HostResource struct {
 }
 
 func (HostResource) Fields() []ent.Field {
-	return []ent.Field{field.String("resource_id").Unique(), field.String("kind").Optional(), field.String("name").Optional(), field.Enum("desired_state").Optional().Values("HOST_STATE_UNSPECIFIED", "HOST_STATE_DELETED", "HOST_STATE_ONBOARDED", "HOST_STATE_UNTRUSTED", "HOST_STATE_REGISTERED"), field.Enum("current_state").Optional().Values("HOST_STATE_UNSPECIFIED", "HOST_STATE_DELETED", "HOST_STATE_ONBOARDED", "HOST_STATE_UNTRUSTED", "HOST_STATE_REGISTERED"), field.String("note").Optional(), field.String("hardware_kind").Optional(), field.String("serial_number").Optional(), field.String("uuid").Optional().Unique(), field.Uint64("memory_bytes").Optional(), field.String("cpu_model").Optional(), field.Uint32("cpu_sockets").Optional(), field.Uint32("cpu_cores").Optional(), field.String("cpu_capabilities").Optional(), field.String("cpu_architecture").Optional(), field.Uint32("cpu_threads").Optional(), field.String("cpu_topology").Optional(), field.String("mgmt_ip").Optional(), field.Enum("bmc_kind").Optional().Values("BAREMETAL_CONTROLLER_KIND_UNSPECIFIED", "BAREMETAL_CONTROLLER_KIND_NONE", "BAREMETAL_CONTROLLER_KIND_IPMI", "BAREMETAL_CONTROLLER_KIND_VPRO", "BAREMETAL_CONTROLLER_KIND_PDU"), field.String("bmc_ip").Optional(), field.String("bmc_username").Optional(), field.String("bmc_password").Optional(), field.String("pxe_mac").Optional(), field.String("hostname").Optional(), field.String("product_name").Optional(), field.String("bios_version").Optional(), field.String("bios_release_date").Optional(), field.String("bios_vendor").Optional(), field.String("metadata").Optional(), field.Enum("desired_power_state").Optional().Values("POWER_STATE_UNSPECIFIED", "POWER_STATE_ON", "POWER_STATE_OFF", "POWER_STATE_SLEEP", "POWER_STATE_HIBERNATE", "POWER_STATE_RESET", "POWER_STATE_POWER_CYCLE", "POWER_STATE_RESET_REPEAT"), field.Enum("current_power_state").Optional().Values("POWER_STATE_UNSPECIFIED", "POWER_STATE_ON", "POWER_STATE_OFF", "POWER_STATE_SLEEP", "POWER_STATE_HIBERNATE", "POWER_STATE_RESET", "POWER_STATE_POWER_CYCLE", "POWER_STATE_RESET_REPEAT"), field.String("power_status").Optional(), field.Enum("power_status_indicator").Optional().Values("STATUS_INDICATION_UNSPECIFIED", "STATUS_INDICATION_ERROR", "STATUS_INDICATION_IN_PROGRESS", "STATUS_INDICATION_IDLE"), field.Uint64("power_status_timestamp").Optional(), field.Enum("power_command_policy").Optional().Values("POWER_COMMAND_POLICY_UNSPECIFIED", "POWER_COMMAND_POLICY_IMMEDIATE", "POWER_COMMAND_POLICY_ORDERED"), field.Uint64("power_on_time").Optional(), field.String("host_status").Optional(), field.Enum("host_status_indicator").Optional().Values("STATUS_INDICATION_UNSPECIFIED", "STATUS_INDICATION_ERROR", "STATUS_INDICATION_IN_PROGRESS", "STATUS_INDICATION_IDLE"), field.Uint64("host_status_timestamp").Optional(), field.String("onboarding_status").Optional(), field.Enum("onboarding_status_indicator").Optional().Values("STATUS_INDICATION_UNSPECIFIED", "STATUS_INDICATION_ERROR", "STATUS_INDICATION_IN_PROGRESS", "STATUS_INDICATION_IDLE"), field.Uint64("onboarding_status_timestamp").Optional(), field.String("registration_status").Optional(), field.Enum("registration_status_indicator").Optional().Values("STATUS_INDICATION_UNSPECIFIED", "STATUS_INDICATION_ERROR", "STATUS_INDICATION_IN_PROGRESS", "STATUS_INDICATION_IDLE"), field.Uint64("registration_status_timestamp").Optional(), field.Enum("amt_sku").Optional().Values("AMT_SKU_UNSPECIFIED", "AMT_SKU_AMT", "AMT_SKU_ISM"), field.Enum("desired_amt_state").Optional().Values("AMT_STATE_UNSPECIFIED", "AMT_STATE_PROVISIONED", "AMT_STATE_UNPROVISIONED", "AMT_STATE_DISCONNECTED"), field.Enum("current_amt_state").Optional().Values("AMT_STATE_UNSPECIFIED", "AMT_STATE_PROVISIONED", "AMT_STATE_UNPROVISIONED", "AMT_STATE_DISCONNECTED"), field.String("amt_status").Optional(), field.Enum("amt_status_indicator").Optional().Values("STATUS_INDICATION_UNSPECIFIED", "STATUS_INDICATION_ERROR", "STATUS_INDICATION_IN_PROGRESS", "STATUS_INDICATION_IDLE"), field.Uint64("amt_status_timestamp").Optional(), field.Uint32("user_lvm_size").Optional(), field.Enum("amt_control_mode").Optional().Values("AMT_CONTROL_MODE_UNSPECIFIED", "AMT_CONTROL_MODE_ACM", "AMT_CONTROL_MODE_CCM"), field.String("amt_dns_suffix").Optional(), field.Enum("kvm_status").Optional().Values("KVM_STATUS_UNSPECIFIED", "KVM_STATUS_ACTIVATED", "KVM_STATUS_DEACTIVATED"), field.Enum("desired_kvm_state").Optional().Values("KVM_STATE_UNSPECIFIED", "KVM_STATE_START", "KVM_STATE_STOP", "KVM_STATE_AWAITING_CONSENT", "KVM_STATE_ERROR", "KVM_STATE_CONSENT_RECEIVED", "KVM_STATE_REDIRECTION_RECEIVED"), field.Enum("current_kvm_state").Optional().Values("KVM_STATE_UNSPECIFIED", "KVM_STATE_START", "KVM_STATE_STOP", "KVM_STATE_AWAITING_CONSENT", "KVM_STATE_ERROR", "KVM_STATE_CONSENT_RECEIVED", "KVM_STATE_REDIRECTION_RECEIVED"), field.String("kvm_session_status").Optional(), field.Enum("sol_status").Optional().Values("SOL_STATUS_UNSPECIFIED", "SOL_STATUS_ACTIVATED", "SOL_STATUS_DEACTIVATED"), field.Enum("desired_sol_state").Optional().Values("SOL_STATE_UNSPECIFIED", "SOL_STATE_START", "SOL_STATE_STOP", "SOL_STATE_AWAITING_CONSENT", "SOL_STATE_ERROR", "SOL_STATE_CONSENT_RECEIVED", "SOL_STATE_REDIRECTION_RECEIVED"), field.Enum("current_sol_state").Optional().Values("SOL_STATE_UNSPECIFIED", "SOL_STATE_START", "SOL_STATE_STOP", "SOL_STATE_AWAITING_CONSENT", "SOL_STATE_ERROR", "SOL_STATE_CONSENT_RECEIVED", "SOL_STATE_REDIRECTION_RECEIVED"), field.String("sol_session_status").Optional(), field.String("tenant_id").Immutable(), field.String("created_at").Immutable().SchemaType(map[string]string{"postgres": "TIMESTAMP"}), field.String("updated_at").SchemaType(map[string]string{"postgres": "TIMESTAMP"})}
+	return []ent.Field{field.String("resource_id").Unique(), field.String("kind").Optional(), field.String("name").Optional(), field.Enum("desired_state").Optional().Values("HOST_STATE_UNSPECIFIED", "HOST_STATE_DELETED", "HOST_STATE_ONBOARDED", "HOST_STATE_UNTRUSTED", "HOST_STATE_REGISTERED"), field.Enum("current_state").Optional().Values("HOST_STATE_UNSPECIFIED", "HOST_STATE_DELETED", "HOST_STATE_ONBOARDED", "HOST_STATE_UNTRUSTED", "HOST_STATE_REGISTERED"), field.String("note").Optional(), field.String("hardware_kind").Optional(), field.String("serial_number").Optional(), field.String("uuid").Optional().Unique(), field.Uint64("memory_bytes").Optional(), field.String("cpu_model").Optional(), field.Uint32("cpu_sockets").Optional(), field.Uint32("cpu_cores").Optional(), field.String("cpu_capabilities").Optional(), field.String("cpu_architecture").Optional(), field.Uint32("cpu_threads").Optional(), field.String("cpu_topology").Optional(), field.String("mgmt_ip").Optional(), field.Enum("bmc_kind").Optional().Values("BAREMETAL_CONTROLLER_KIND_UNSPECIFIED", "BAREMETAL_CONTROLLER_KIND_NONE", "BAREMETAL_CONTROLLER_KIND_IPMI", "BAREMETAL_CONTROLLER_KIND_VPRO", "BAREMETAL_CONTROLLER_KIND_PDU"), field.String("bmc_ip").Optional(), field.String("bmc_username").Optional(), field.String("bmc_password").Optional().Sensitive(), field.String("pxe_mac").Optional(), field.String("hostname").Optional(), field.String("product_name").Optional(), field.String("bios_version").Optional(), field.String("bios_release_date").Optional(), field.String("bios_vendor").Optional(), field.String("metadata").Optional().Sensitive(), field.Enum("desired_power_state").Optional().Values("POWER_STATE_UNSPECIFIED", "POWER_STATE_ON", "POWER_STATE_OFF", "POWER_STATE_SLEEP", "POWER_STATE_HIBERNATE", "POWER_STATE_RESET", "POWER_STATE_POWER_CYCLE", "POWER_STATE_RESET_REPEAT"), field.Enum("current_power_state").Optional().Values("POWER_STATE_UNSPECIFIED", "POWER_STATE_ON", "POWER_STATE_OFF", "POWER_STATE_SLEEP", "POWER_STATE_HIBERNATE", "POWER_STATE_RESET", "POWER_STATE_POWER_CYCLE", "POWER_STATE_RESET_REPEAT"), field.String("power_status").Optional(), field.Enum("power_status_indicator").Optional().Values("STATUS_INDICATION_UNSPECIFIED", "STATUS_INDICATION_ERROR", "STATUS_INDICATION_IN_PROGRESS", "STATUS_INDICATION_IDLE"), field.Uint64("power_status_timestamp").Optional(), field.Enum("power_command_policy").Optional().Values("POWER_COMMAND_POLICY_UNSPECIFIED", "POWER_COMMAND_POLICY_IMMEDIATE", "POWER_COMMAND_POLICY_ORDERED"), field.Uint64("power_on_time").Optional(), field.String("host_status").Optional(), field.Enum("host_status_indicator").Optional().Values("STATUS_INDICATION_UNSPECIFIED", "STATUS_INDICATION_ERROR", "STATUS_INDICATION_IN_PROGRESS", "STATUS_INDICATION_IDLE"), field.Uint64("host_status_timestamp").Optional(), field.String("onboarding_status").Optional(), field.Enum("onboarding_status_indicator").Optional().Values("STATUS_INDICATION_UNSPECIFIED", "STATUS_INDICATION_ERROR", "STATUS_INDICATION_IN_PROGRESS", "STATUS_INDICATION_IDLE"), field.Uint64("onboarding_status_timestamp").Optional(), field.String("registration_status").Optional(), field.Enum("registration_status_indicator").Optional().Values("STATUS_INDICATION_UNSPECIFIED", "STATUS_INDICATION_ERROR", "STATUS_INDICATION_IN_PROGRESS", "STATUS_INDICATION_IDLE"), field.Uint64("registration_status_timestamp").Optional(), field.Enum("amt_sku").Optional().Values("AMT_SKU_UNSPECIFIED", "AMT_SKU_AMT", "AMT_SKU_ISM"), field.Enum("desired_amt_state").Optional().Values("AMT_STATE_UNSPECIFIED", "AMT_STATE_PROVISIONED", "AMT_STATE_UNPROVISIONED", "AMT_STATE_DISCONNECTED"), field.Enum("current_amt_state").Optional().Values("AMT_STATE_UNSPECIFIED", "AMT_STATE_PROVISIONED", "AMT_STATE_UNPROVISIONED", "AMT_STATE_DISCONNECTED"), field.String("amt_status").Optional(), field.Enum("amt_status_indicator").Optional().Values("STATUS_INDICATION_UNSPECIFIED", "STATUS_INDICATION_ERROR", "STATUS_INDICATION_IN_PROGRESS", "STATUS_INDICATION_IDLE"), field.Uint64("amt_status_timestamp").Optional(), field.Uint32("user_lvm_size").Optional(), field.Enum("amt_control_mode").Optional().Values("AMT_CONTROL_MODE_UNSPECIFIED", "AMT_CONTROL_MODE_ACM", "AMT_CONTROL_MODE_CCM"), field.String("amt_dns_suffix").Optional(), field.Enum("kvm_status").Optional().Values("KVM_STATUS_UNSPECIFIED", "KVM_STATUS_ACTIVATED", "KVM_STATUS_DEACTIVATED"), field.Enum("desired_kvm_state").Optional().Values("KVM_STATE_UNSPECIFIED", "KVM_STATE_START", "KVM_STATE_STOP", "KVM_STATE_AWAITING_CONSENT", "KVM_STATE_ERROR", "KVM_STATE_CONSENT_RECEIVED", "KVM_STATE_REDIRECTION_RECEIVED"), field.Enum("current_kvm_state").Optional().Values("KVM_STATE_UNSPECIFIED", "KVM_STATE_START", "KVM_STATE_STOP", "KVM_STATE_AWAITING_CONSENT", "KVM_STATE_ERROR", "KVM_STATE_CONSENT_RECEIVED", "KVM_STATE_REDIRECTION_RECEIVED"), field.String("kvm_session_status").Optional(), field.Enum("sol_status").Optional().Values("SOL_STATUS_UNSPECIFIED", "SOL_STATUS_ACTIVATED", "SOL_STATUS_DEACTIVATED"), field.Enum("desired_sol_state").Optional().Values("SOL_STATE_UNSPECIFIED", "SOL_STATE_START", "SOL_STATE_STOP", "SOL_STATE_AWAITING_CONSENT", "SOL_STATE_ERROR", "SOL_STATE_CONSENT_RECEIVED", "SOL_STATE_REDIRECTION_RECEIVED"), field.Enum("current_sol_state").Optional().Values("SOL_STATE_UNSPECIFIED", "SOL_STATE_START", "SOL_STATE_STOP", "SOL_STATE_AWAITING_CONSENT", "SOL_STATE_ERROR", "SOL_STATE_CONSENT_RECEIVED", "SOL_STATE_REDIRECTION_RECEIVED"), field.String("sol_session_status").Optional(), field.String("tenant_id").Immutable(), field.String("created_at").Immutable().SchemaType(map[string]string{"postgres": "TIMESTAMP"}), field.String("updated_at").SchemaType(map[string]string{"postgres": "TIMESTAMP"})}
 }
 func (HostResource) Edges() []ent.Edge {
 	return []ent.Edge{edge.To("site", SiteResource.Type).Unique(), edge.To("provider", ProviderResource.Type).Unique(), edge.From("host_storages", HoststorageResource.Type).Ref("host"), edge.From("host_nics", HostnicResource.Type).Ref("host"), edge.From("host_usbs", HostusbResource.Type).Ref("host"), edge.From("host_gpus", HostgpuResource.Type).Ref("host"), edge.From("instance", InstanceResource.Type).Ref("host").Unique()}
@@ -25,5 +25,5 @@ func (HostResource) Annotations() []schema.Annotation {
 	return nil
 }
 func (HostResource) Indexes() []ent.Index {
-	return []ent.Index{index.Fields("uuid").Unique().Annotations(entsql.IndexAnnotation{Where: "uuid IS NOT NULL"}), index.Fields("serial_number").Unique().Annotations(entsql.IndexAnnotation{Where: "uuid IS NULL"}), index.Fields("tenant_id")}
+	return []ent.Index{index.Fields("uuid").Unique().Annotations(entsql.IndexAnnotation{Where: "uuid IS NOT NULL"}), index.Fields("serial_number").Unique().Annotations(entsql.IndexAnnotation{Where: "uuid IS NULL"}), index.Fields("tenant_id"), index.Fields("name").StorageKey("hostresource_name_trgm").Annotations(entsql.IndexAnnotation{Type: "GIN", OpClass: "gin_trgm_ops"}), index.Fields("hostname").StorageKey("hostresource_hostname_trgm").Annotations(entsql.IndexAnnotation{Type: "GIN", OpClass: "gin_trgm_ops"}), index.Fields("serial_number").StorageKey("hostresource_serial_number_trgm").Annotations(entsql.IndexAnnotation{Type: "GIN", OpClass: "gin_trgm_ops"}), index.Fields("uuid").StorageKey("hostresource_uuid_trgm").Annotations(entsql.IndexAnnotation{Type: "GIN", OpClass: "gin_trgm_ops"}), index.Fields("pxe_mac").StorageKey("hostresource_pxe_mac_trgm").Annotations(entsql.IndexAnnotation{Type: "GIN", OpClass: "gin_trgm_ops"}), index.Fields("bmc_ip").StorageKey("hostresource_bmc_ip_trgm").Annotations(entsql.IndexAnnotation{Type: "GIN", OpClass: "gin_trgm_ops"}), index.Fields("mgmt_ip").StorageKey("hostresource_mgmt_ip_trgm").Annotations(entsql.IndexAnnotation{Type: "GIN", OpClass: "gin_trgm_ops"})}
 }
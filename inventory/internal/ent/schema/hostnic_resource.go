@@ -4,6 +4,7 @@ package schema
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
@@ -24,5 +25,5 @@ func (HostnicResource) Annotations() []schema.Annotation {
 	return nil
 }
 func (HostnicResource) Indexes() []ent.Index {
-	return []ent.Index{index.Fields("tenant_id")}
+	return []ent.Index{index.Fields("tenant_id"), index.Fields("device_name").StorageKey("hostnicresource_device_name_trgm").Annotations(entsql.IndexAnnotation{Type: "GIN", OpClass: "gin_trgm_ops"}), index.Fields("mac_addr").StorageKey("hostnicresource_mac_addr_trgm").Annotations(entsql.IndexAnnotation{Type: "GIN", OpClass: "gin_trgm_ops"})}
 }
@@ -4,6 +4,7 @@ package schema
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
@@ -24,5 +25,5 @@ func (IPAddressResource) Annotations() []schema.Annotation {
 	return nil
 }
 func (IPAddressResource) Indexes() []ent.Index {
-	return []ent.Index{index.Fields("tenant_id")}
+	return []ent.Index{index.Fields("tenant_id"), index.Fields("address").StorageKey("ipaddressresource_address_trgm").Annotations(entsql.IndexAnnotation{Type: "GIN", OpClass: "gin_trgm_ops"})}
 }
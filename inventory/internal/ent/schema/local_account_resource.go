@@ -14,7 +14,7 @@ type LocalAccountResource struct {
 }
 
 func (LocalAccountResource) Fields() []ent.Field {
-	return []ent.Field{field.String("resource_id").Unique(), field.String("username").Immutable(), field.String("ssh_key").Immutable(), field.String("tenant_id").Immutable(), field.String("created_at").Immutable().SchemaType(map[string]string{"postgres": "TIMESTAMP"}), field.String("updated_at").SchemaType(map[string]string{"postgres": "TIMESTAMP"})}
+	return []ent.Field{field.String("resource_id").Unique(), field.String("username"), field.String("ssh_key").Sensitive(), field.String("tenant_id").Immutable(), field.String("created_at").Immutable().SchemaType(map[string]string{"postgres": "TIMESTAMP"}), field.String("updated_at").SchemaType(map[string]string{"postgres": "TIMESTAMP"})}
 }
 func (LocalAccountResource) Edges() []ent.Edge {
 	return nil
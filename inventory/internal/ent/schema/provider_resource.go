@@ -14,7 +14,7 @@ type ProviderResource struct {
 }
 
 func (ProviderResource) Fields() []ent.Field {
-	return []ent.Field{field.String("resource_id").Unique(), field.Enum("provider_kind").Values("PROVIDER_KIND_UNSPECIFIED", "PROVIDER_KIND_BAREMETAL"), field.Enum("provider_vendor").Optional().Values("PROVIDER_VENDOR_UNSPECIFIED", "PROVIDER_VENDOR_LENOVO_LXCA", "PROVIDER_VENDOR_LENOVO_LOCA"), field.String("name"), field.String("api_endpoint"), field.String("api_credentials").Optional(), field.String("config").Optional(), field.String("tenant_id").Immutable(), field.String("created_at").Immutable().SchemaType(map[string]string{"postgres": "TIMESTAMP"}), field.String("updated_at").SchemaType(map[string]string{"postgres": "TIMESTAMP"})}
+	return []ent.Field{field.String("resource_id").Unique(), field.Enum("provider_kind").Values("PROVIDER_KIND_UNSPECIFIED", "PROVIDER_KIND_BAREMETAL"), field.Enum("provider_vendor").Optional().Values("PROVIDER_VENDOR_UNSPECIFIED", "PROVIDER_VENDOR_LENOVO_LXCA", "PROVIDER_VENDOR_LENOVO_LOCA"), field.String("name"), field.String("api_endpoint"), field.String("api_credentials").Optional(), field.String("config").Optional().Sensitive(), field.String("tenant_id").Immutable(), field.String("created_at").Immutable().SchemaType(map[string]string{"postgres": "TIMESTAMP"}), field.String("updated_at").SchemaType(map[string]string{"postgres": "TIMESTAMP"})}
 }
 func (ProviderResource) Edges() []ent.Edge {
 	return nil
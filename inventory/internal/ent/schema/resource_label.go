@@ -0,0 +1,27 @@
+// File updated by protoc-gen-ent.
+
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+type ResourceLabel struct {
+	ent.Schema
+}
+
+func (ResourceLabel) Fields() []ent.Field {
+	return []ent.Field{field.String("resource_id").Immutable(), field.String("key").Immutable(), field.String("value"), field.String("tenant_id").Immutable()}
+}
+func (ResourceLabel) Edges() []ent.Edge {
+	return nil
+}
+func (ResourceLabel) Annotations() []schema.Annotation {
+	return nil
+}
+func (ResourceLabel) Indexes() []ent.Index {
+	return []ent.Index{index.Fields("resource_id", "key").Unique(), index.Fields("key", "value"), index.Fields("tenant_id")}
+}
@@ -0,0 +1,28 @@
+// File updated by protoc-gen-ent.
+
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+type RoleBindingResource struct {
+	ent.Schema
+}
+
+func (RoleBindingResource) Fields() []ent.Field {
+	return []ent.Field{field.String("resource_id").Unique(), field.String("subject"), field.Enum("role").Optional().Values("SCOPED_ROLE_UNSPECIFIED", "SCOPED_ROLE_READ", "SCOPED_ROLE_READ_WRITE"), field.String("tenant_id").Immutable(), field.String("created_at").Immutable().SchemaType(map[string]string{"postgres": "TIMESTAMP"}), field.String("updated_at").SchemaType(map[string]string{"postgres": "TIMESTAMP"})}
+}
+func (RoleBindingResource) Edges() []ent.Edge {
+	return []ent.Edge{edge.To("region", RegionResource.Type).Unique(), edge.To("site", SiteResource.Type).Unique(), edge.To("ou", OuResource.Type).Unique()}
+}
+func (RoleBindingResource) Annotations() []schema.Annotation {
+	return nil
+}
+func (RoleBindingResource) Indexes() []ent.Index {
+	return []ent.Index{index.Fields("subject", "tenant_id"), index.Fields("tenant_id")}
+}
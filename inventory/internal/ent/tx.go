@@ -16,6 +16,10 @@ type Tx struct {
 	config
 	// CustomConfigResource is the client for interacting with the CustomConfigResource builders.
 	CustomConfigResource *CustomConfigResourceClient
+	// CustomObjectResource is the client for interacting with the CustomObjectResource builders.
+	CustomObjectResource *CustomObjectResourceClient
+	// CustomTypeResource is the client for interacting with the CustomTypeResource builders.
+	CustomTypeResource *CustomTypeResourceClient
 	// EndpointResource is the client for interacting with the EndpointResource builders.
 	EndpointResource *EndpointResourceClient
 	// HostResource is the client for interacting with the HostResource builders.
@@ -56,6 +60,10 @@ type Tx struct {
 	RemoteAccessConfiguration *RemoteAccessConfigurationClient
 	// RepeatedScheduleResource is the client for interacting with the RepeatedScheduleResource builders.
 	RepeatedScheduleResource *RepeatedScheduleResourceClient
+	// ResourceLabel is the client for interacting with the ResourceLabel builders.
+	ResourceLabel *ResourceLabelClient
+	// RoleBindingResource is the client for interacting with the RoleBindingResource builders.
+	RoleBindingResource *RoleBindingResourceClient
 	// SingleScheduleResource is the client for interacting with the SingleScheduleResource builders.
 	SingleScheduleResource *SingleScheduleResourceClient
 	// SiteResource is the client for interacting with the SiteResource builders.
@@ -202,6 +210,8 @@ func (tx *Tx) Client() *Client {
 
 func (tx *Tx) init() {
 	tx.CustomConfigResource = NewCustomConfigResourceClient(tx.config)
+	tx.CustomObjectResource = NewCustomObjectResourceClient(tx.config)
+	tx.CustomTypeResource = NewCustomTypeResourceClient(tx.config)
 	tx.EndpointResource = NewEndpointResourceClient(tx.config)
 	tx.HostResource = NewHostResourceClient(tx.config)
 	tx.HostgpuResource = NewHostgpuResourceClient(tx.config)
@@ -222,6 +232,8 @@ func (tx *Tx) init() {
 	tx.RegionResource = NewRegionResourceClient(tx.config)
 	tx.RemoteAccessConfiguration = NewRemoteAccessConfigurationClient(tx.config)
 	tx.RepeatedScheduleResource = NewRepeatedScheduleResourceClient(tx.config)
+	tx.ResourceLabel = NewResourceLabelClient(tx.config)
+	tx.RoleBindingResource = NewRoleBindingResourceClient(tx.config)
 	tx.SingleScheduleResource = NewSingleScheduleResourceClient(tx.config)
 	tx.SiteResource = NewSiteResourceClient(tx.config)
 	tx.TelemetryGroupResource = NewTelemetryGroupResourceClient(tx.config)
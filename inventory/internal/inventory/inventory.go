@@ -18,6 +18,7 @@ import (
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/logging"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/policy"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/policy/rbac"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/secrets"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/util"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/validator"
 )
@@ -30,7 +31,12 @@ type InventorygRPCServer struct {
 	INVPOLICY            *policy.Policy
 	RBAC                 *rbac.Policy
 	AuthorizationEnabled bool
-	IS                   *store.InvStore
+	// EnforceClientIdentity rejects the clients that have no verified identity, see clientreg.IdentifyClient.
+	EnforceClientIdentity bool
+	// SecretsFactory connects to the secrets service to check that the secrets referenced by the written resources
+	// exist. The references are only checked for syntax and tenant when nil.
+	SecretsFactory func(ctx context.Context) (secrets.SecretsService, error)
+	IS             *store.InvStore
 }
 
 func NewInventoryServer(dbURLWriter, dbURLReader, policyFile string, enableTracing, enableAuth bool) *InventorygRPCServer {
@@ -62,11 +68,14 @@ func NewInventoryServer(dbURLWriter, dbURLReader, policyFile string, enableTraci
 	return &iserv
 }
 
-func (srv *InventorygRPCServer) Authorize(ctx context.Context, request interface{}) error {
+// Authorize authorizes the request and returns the context to serve it with. Principals holding the scoped role are
+// denied by the RBAC policy; their requests are authorized against the locations of their role bindings, and the
+// returned context carries their scope.
+func (srv *InventorygRPCServer) Authorize(ctx context.Context, request interface{}) (context.Context, error) {
 	// ToDo - remove these lines when Authentication is enabled E2E
 	if !srv.AuthorizationEnabled {
 		// if authorization is disabled, just return nil
-		return nil
+		return ctx, nil
 	}
 
 	// Create the input data map from the request context
@@ -76,9 +85,11 @@ func (srv *InventorygRPCServer) Authorize(ctx context.Context, request interface
 	switch req := request.(type) {
 	case *inv_v1.CreateResourceRequest:
 		err = srv.RBAC.Verify(ctxClaims, rbac.CreateKey)
-	case *inv_v1.ListResourcesRequest, *inv_v1.ListInheritedTelemetryProfilesRequest, *inv_v1.GetTreeHierarchyRequest:
+	case *inv_v1.ListResourcesRequest, *inv_v1.ListInheritedTelemetryProfilesRequest, *inv_v1.GetTreeHierarchyRequest,
+		*inv_v1.GetEffectiveTelemetryProfilesRequest, *inv_v1.DiffEffectiveTelemetryProfilesRequest,
+		*inv_v1.GetFleetStatisticsRequest:
 		err = srv.RBAC.Verify(ctxClaims, rbac.ListKey)
-	case *inv_v1.FindResourcesRequest:
+	case *inv_v1.FindResourcesRequest, *inv_v1.SearchResourcesRequest:
 		err = srv.RBAC.Verify(ctxClaims, rbac.FindKey)
 	case *inv_v1.GetResourceRequest:
 		err = srv.RBAC.Verify(ctxClaims, rbac.GetKey)
@@ -90,15 +101,18 @@ func (srv *InventorygRPCServer) Authorize(ctx context.Context, request interface
 		err = srv.RBAC.Verify(ctxClaims, rbac.DeleteKey)
 	default:
 		zlog.InfraSec().InfraError("unspecified request type %v", req).Msg("")
-		return errors.Errorfc(codes.InvalidArgument, "unspecified request type %v", req)
+		return ctx, errors.Errorfc(codes.InvalidArgument, "unspecified request type %v", req)
 	}
 
 	if err != nil {
-		return err
+		if tenantIDs := srv.RBAC.ScopedTenants(ctxClaims); len(tenantIDs) > 0 {
+			return srv.authorizeScoped(ctx, request, ctxClaims.Get(rbac.SubjectClaimKey), tenantIDs)
+		}
+		return ctx, err
 	}
 
 	zlog.Debug().Msgf("Call is authorized")
-	return nil
+	return ctx, nil
 }
 
 func (srv *InventorygRPCServer) Heartbeat(
@@ -120,13 +134,23 @@ func (srv *InventorygRPCServer) SubscribeEvents(
 	in *inv_v1.SubscribeEventsRequest,
 	stream inv_v1.InventoryService_SubscribeEventsServer,
 ) error {
-	zlog.Info().Msgf("SubscribeEvents from client: %v", in)
+	zlog.Info().Msgf("SubscribeEvents from client: %v", logging.Redact(in))
+
+	// The client kind is trusted by the policies, verify it against the identity of the client.
+	identity, err := clientreg.IdentifyClient(stream.Context())
+	if err != nil {
+		return err
+	}
+	if err = clientreg.VerifyClientKind(identity, in.GetClientKind(), srv.EnforceClientIdentity); err != nil {
+		return err
+	}
 
 	// Register the new client.
 	clientUUID, err := srv.CR.RegisterClient(clientreg.ClientInfo{
 		Name:          in.GetName(),
 		Version:       in.GetVersion(),
 		ClientKind:    in.GetClientKind(),
+		Identity:      identity,
 		ResourceKinds: in.GetSubscribedResourceKinds(),
 		Stream:        stream,
 	})
@@ -152,7 +176,7 @@ func (srv *InventorygRPCServer) ChangeSubscribeEvents(
 	in *inv_v1.ChangeSubscribeEventsRequest,
 ) (*inv_v1.ChangeSubscribeEventsResponse, error) {
 	zlog := zlog.TraceCtx(ctx)
-	zlog.Info().Msgf("ChangeSubscribeEvents from client: %v", in)
+	zlog.Info().Msgf("ChangeSubscribeEvents from client: %v", logging.Redact(in))
 	err := srv.CR.UpdateClient(in.GetClientUuid(), in.GetSubscribedResourceKinds())
 	if err != nil {
 		return nil, errors.Wrap(err)
@@ -160,9 +184,7 @@ func (srv *InventorygRPCServer) ChangeSubscribeEvents(
 	return &inv_v1.ChangeSubscribeEventsResponse{}, nil
 }
 
-// CreateResource This function is the main entry point for creating resources in the inventory
-//
-//nolint:gocyclo // high cyclomatic complexity due to the switch and length
+// CreateResource This function is the main entry point for creating resources in the inventory.
 func (srv *InventorygRPCServer) CreateResource(
 	ctx context.Context,
 	in *inv_v1.CreateResourceRequest,
@@ -173,7 +195,7 @@ func (srv *InventorygRPCServer) CreateResource(
 	err := error(nil)
 
 	// authorize call first
-	err = srv.Authorize(ctx, in)
+	ctx, err = srv.Authorize(ctx, in)
 	if err != nil {
 		return nil, err
 	}
@@ -192,96 +214,20 @@ func (srv *InventorygRPCServer) CreateResource(
 		return nil, err
 	}
 
-	var res *inv_v1.Resource
-
-	switch in.GetResource().GetResource().(type) {
-	// location.proto
-	case *inv_v1.Resource_Region:
-		res, err = srv.IS.CreateRegion(ctx, in.GetResource().GetRegion())
-	case *inv_v1.Resource_Site:
-		res, err = srv.IS.CreateSite(ctx, in.GetResource().GetSite())
-
-	// ou.proto
-	case *inv_v1.Resource_Ou:
-		res, err = srv.IS.CreateOu(ctx, in.GetResource().GetOu())
-
-	// instance.proto
-	case *inv_v1.Resource_Instance:
-		res, err = srv.IS.CreateInstance(ctx, in.GetResource().GetInstance())
-
-	// host.proto
-	case *inv_v1.Resource_Host:
-		res, err = srv.IS.CreateHost(ctx, in.GetResource().GetHost())
-
-	case *inv_v1.Resource_Hoststorage:
-		res, err = srv.IS.CreateHoststorage(ctx, in.GetResource().GetHoststorage())
-	case *inv_v1.Resource_Hostnic:
-		res, err = srv.IS.CreateHostnic(ctx, in.GetResource().GetHostnic())
-	case *inv_v1.Resource_Hostusb:
-		res, err = srv.IS.CreateHostusb(ctx, in.GetResource().GetHostusb())
-	case *inv_v1.Resource_Hostgpu:
-		res, err = srv.IS.CreateHostgpu(ctx, in.GetResource().GetHostgpu())
-
-	// network.proto
-	case *inv_v1.Resource_NetworkSegment:
-		res, err = srv.IS.CreateNetworkSegment(ctx, in.GetResource().GetNetworkSegment())
-	case *inv_v1.Resource_Netlink:
-		res, err = srv.IS.CreateNetlink(ctx, in.GetResource().GetNetlink())
-	case *inv_v1.Resource_Endpoint:
-		res, err = srv.IS.CreateEndpoint(ctx, in.GetResource().GetEndpoint())
-	case *inv_v1.Resource_Ipaddress:
-		res, err = srv.IS.CreateIPAddress(ctx, in.GetResource().GetIpaddress())
-
-	// provider.proto
-	case *inv_v1.Resource_Provider:
-		res, err = srv.IS.CreateProvider(ctx, in.GetResource().GetProvider())
-
-	// os.proto
-	case *inv_v1.Resource_Os:
-		res, err = srv.IS.CreateOs(ctx, in.GetResource().GetOs())
-
-	// schedule.proto
-	case *inv_v1.Resource_Singleschedule:
-		res, err = srv.IS.CreateSingleSchedule(ctx, in.GetResource().GetSingleschedule())
-	case *inv_v1.Resource_Repeatedschedule:
-		res, err = srv.IS.CreateRepeatedSchedule(ctx, in.GetResource().GetRepeatedschedule())
-
-	// telemetry.proto
-	case *inv_v1.Resource_TelemetryGroup:
-		res, err = srv.IS.CreateTelemetryGroup(ctx, in.GetResource().GetTelemetryGroup())
-	case *inv_v1.Resource_TelemetryProfile:
-		res, err = srv.IS.CreateTelemetryProfile(ctx, in.GetResource().GetTelemetryProfile())
-
-	// workload.proto
-	case *inv_v1.Resource_Workload:
-		res, err = srv.IS.CreateWorkload(ctx, in.GetResource().GetWorkload())
-	case *inv_v1.Resource_WorkloadMember:
-		res, err = srv.IS.CreateWorkloadMember(ctx, in.GetResource().GetWorkloadMember())
-
-	case *inv_v1.Resource_RemoteAccess:
-		res, err = srv.IS.CreateRemoteAccessConfig(ctx, in.GetResource().GetRemoteAccess())
-
-	case *inv_v1.Resource_Tenant:
-		res, err = srv.IS.CreateTenant(ctx, in.GetResource().GetTenant())
-	// localaccount.proto
-	case *inv_v1.Resource_LocalAccount:
-		res, err = srv.IS.CreateLocalAccount(ctx, in.GetResource().GetLocalAccount())
-
-	case *inv_v1.Resource_OsUpdatePolicy:
-		res, err = srv.IS.CreateOSUpdatePolicy(ctx, in.GetResource().GetOsUpdatePolicy())
-
-	// custom config
-	case *inv_v1.Resource_CustomConfig:
-		res, err = srv.IS.CreateCustomConfig(ctx, in.GetResource().GetCustomConfig())
-
-	case *inv_v1.Resource_OsUpdateRun:
-		res, err = srv.IS.CreateOSUpdateRun(ctx, in.GetResource().GetOsUpdateRun())
-
-	default:
+	handler, ok := store.LookupResourceHandler(util.GetResourceKindFromResource(in.GetResource()))
+	if !ok {
 		zlog.InfraSec().InfraError("unknown Resource Kind: %T", in.Resource).Msg("create resource error")
 		return nil, errors.Errorfc(codes.InvalidArgument, "unknown Resource Kind: %T", in.Resource)
 	}
 
+	if err := srv.checkScopeTargets(ctx, in.GetTenantId(), in.GetResource(), nil); err != nil {
+		return nil, err
+	}
+	if err := srv.checkSecretRefs(ctx, in.GetTenantId(), in.GetResource(), nil); err != nil {
+		return nil, err
+	}
+
+	res, err := handler.Create(srv.IS, ctx, in.GetResource())
 	if err != nil {
 		return nil, err
 	}
@@ -305,7 +251,7 @@ func (srv *InventorygRPCServer) ListResources(
 	zlog.Info().Msgf("ListResources for UUID %v", in.ClientUuid)
 
 	// authorize call first
-	err := srv.Authorize(ctx, in)
+	ctx, err := srv.Authorize(ctx, in)
 	if err != nil {
 		return nil, err
 	}
@@ -343,7 +289,7 @@ func (srv *InventorygRPCServer) FindResources(
 	zlog.Info().Msgf("FindResources for UUID %v", in.ClientUuid)
 
 	// authorize call first
-	err := srv.Authorize(ctx, in)
+	ctx, err := srv.Authorize(ctx, in)
 	if err != nil {
 		return nil, err
 	}
@@ -374,6 +320,52 @@ func (srv *InventorygRPCServer) FindResources(
 	}, nil
 }
 
+func (srv *InventorygRPCServer) SearchResources(
+	ctx context.Context,
+	in *inv_v1.SearchResourcesRequest,
+) (*inv_v1.SearchResourcesResponse, error) {
+	zlog := zlog.TraceCtx(ctx)
+	zlog.Info().Msgf("SearchResources: client_uuid=%v", in.ClientUuid)
+	zlog.Debug().Msgf("SearchResources: request=%v", logging.Redact(in))
+
+	// authorize call first
+	ctx, err := srv.Authorize(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	err = validator.ValidateMessage(in)
+	if err != nil {
+		zlog.InfraSec().InfraErr(err).Send()
+		return nil, errors.Wrap(err)
+	}
+
+	return srv.IS.SearchResources(ctx, in)
+}
+
+func (srv *InventorygRPCServer) GetFleetStatistics(
+	ctx context.Context,
+	in *inv_v1.GetFleetStatisticsRequest,
+) (*inv_v1.GetFleetStatisticsResponse, error) {
+	zlog := zlog.TraceCtx(ctx)
+	zlog.Info().Msgf("GetFleetStatistics: client_uuid=%v", in.ClientUuid)
+	zlog.Debug().Msgf("GetFleetStatistics: request=%v", logging.Redact(in))
+
+	// authorize call first
+	ctx, err := srv.Authorize(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	err = validator.ValidateMessage(in)
+	if err != nil {
+		zlog.InfraSec().InfraErr(err).Send()
+		return nil, errors.Wrap(err)
+	}
+
+	return srv.IS.GetFleetStatistics(ctx, in)
+}
+
 func (srv *InventorygRPCServer) GetResource(
 	ctx context.Context,
 	in *inv_v1.GetResourceRequest,
@@ -384,7 +376,7 @@ func (srv *InventorygRPCServer) GetResource(
 	zlog.Info().Msgf("GetResource %s for UUID %s", in.ResourceId, in.ClientUuid)
 
 	// authorize call first
-	err = srv.Authorize(ctx, in)
+	ctx, err = srv.Authorize(ctx, in)
 	if err != nil {
 		return nil, err
 	}
@@ -394,97 +386,21 @@ func (srv *InventorygRPCServer) GetResource(
 		return nil, err
 	}
 
-	// response (empty, filled in switch below)
-	gresresp := &inv_v1.GetResourceResponse{}
-
-	switch kind {
-	// location.proto
-	case inv_v1.ResourceKind_RESOURCE_KIND_REGION:
-		gresresp.Resource, gresresp.RenderedMetadata, err = srv.IS.GetRegion(ctx, in.ResourceId, in.GetTenantId())
-	case inv_v1.ResourceKind_RESOURCE_KIND_SITE:
-		gresresp.Resource, gresresp.RenderedMetadata, err = srv.IS.GetSite(ctx, in.ResourceId, in.GetTenantId())
-
-	// ou.proto
-	case inv_v1.ResourceKind_RESOURCE_KIND_OU:
-		gresresp.Resource, gresresp.RenderedMetadata, err = srv.IS.GetOu(ctx, in.ResourceId, in.GetTenantId())
-
-	// instance.proto
-	case inv_v1.ResourceKind_RESOURCE_KIND_INSTANCE:
-		gresresp.Resource, err = srv.IS.GetInstance(ctx, in.ResourceId)
-
-	// host.proto
-	case inv_v1.ResourceKind_RESOURCE_KIND_HOST:
-		gresresp.Resource, gresresp.RenderedMetadata, err = srv.IS.GetHost(ctx, in.ResourceId, in.GetTenantId())
-	case inv_v1.ResourceKind_RESOURCE_KIND_HOSTSTORAGE:
-		gresresp.Resource, err = srv.IS.GetHoststorage(ctx, in.ResourceId)
-	case inv_v1.ResourceKind_RESOURCE_KIND_HOSTNIC:
-		gresresp.Resource, err = srv.IS.GetHostnic(ctx, in.ResourceId)
-	case inv_v1.ResourceKind_RESOURCE_KIND_HOSTUSB:
-		gresresp.Resource, err = srv.IS.GetHostusb(ctx, in.ResourceId)
-	case inv_v1.ResourceKind_RESOURCE_KIND_HOSTGPU:
-		gresresp.Resource, err = srv.IS.GetHostgpu(ctx, in.ResourceId)
-
-	// network.proto
-	case inv_v1.ResourceKind_RESOURCE_KIND_NETWORKSEGMENT:
-		gresresp.Resource, err = srv.IS.GetNetworkSegment(ctx, in.ResourceId)
-	case inv_v1.ResourceKind_RESOURCE_KIND_NETLINK:
-		gresresp.Resource, err = srv.IS.GetNetlink(ctx, in.ResourceId)
-	case inv_v1.ResourceKind_RESOURCE_KIND_ENDPOINT:
-		gresresp.Resource, err = srv.IS.GetEndpoint(ctx, in.ResourceId)
-	case inv_v1.ResourceKind_RESOURCE_KIND_IPADDRESS:
-		gresresp.Resource, err = srv.IS.GetIPAddress(ctx, in.ResourceId)
-
-	// provider.proto
-	case inv_v1.ResourceKind_RESOURCE_KIND_PROVIDER:
-		gresresp.Resource, err = srv.IS.GetProvider(ctx, in.ResourceId)
-
-	// os.proto
-	case inv_v1.ResourceKind_RESOURCE_KIND_OS:
-		gresresp.Resource, err = srv.IS.GetOs(ctx, in.ResourceId)
-
-	// schedule.proto
-	case inv_v1.ResourceKind_RESOURCE_KIND_SINGLESCHEDULE:
-		gresresp.Resource, err = srv.IS.GetSingleSchedule(ctx, in.ResourceId)
-	case inv_v1.ResourceKind_RESOURCE_KIND_REPEATEDSCHEDULE:
-		gresresp.Resource, err = srv.IS.GetRepeatedSchedule(ctx, in.ResourceId)
-
-	// telemetry.proto
-	case inv_v1.ResourceKind_RESOURCE_KIND_TELEMETRY_GROUP:
-		gresresp.Resource, err = srv.IS.GetTelemetryGroup(ctx, in.ResourceId)
-	case inv_v1.ResourceKind_RESOURCE_KIND_TELEMETRY_PROFILE:
-		gresresp.Resource, err = srv.IS.GetTelemetryProfile(ctx, in.ResourceId)
-
-	// workload.proto
-	case inv_v1.ResourceKind_RESOURCE_KIND_WORKLOAD:
-		gresresp.Resource, err = srv.IS.GetWorkload(ctx, in.ResourceId)
-	case inv_v1.ResourceKind_RESOURCE_KIND_WORKLOAD_MEMBER:
-		gresresp.Resource, err = srv.IS.GetWorkloadMember(ctx, in.ResourceId)
-
-	case inv_v1.ResourceKind_RESOURCE_KIND_RMT_ACCESS_CONF:
-		gresresp.Resource, err = srv.IS.GetRemoteAccessConfig(ctx, in.ResourceId)
-
-	case inv_v1.ResourceKind_RESOURCE_KIND_TENANT:
-		gresresp.Resource, err = srv.IS.GetTenant(ctx, in.ResourceId)
-
-	// localaccount.proto
-	case inv_v1.ResourceKind_RESOURCE_KIND_LOCALACCOUNT:
-		gresresp.Resource, err = srv.IS.GetLocalAccount(ctx, in.ResourceId)
-
-	case inv_v1.ResourceKind_RESOURCE_KIND_OSUPDATEPOLICY:
-		gresresp.Resource, err = srv.IS.GetOSUpdatePolicy(ctx, in.ResourceId)
-
-	case inv_v1.ResourceKind_RESOURCE_KIND_CUSTOMCONFIG:
-		gresresp.Resource, err = srv.IS.GetCustomConfig(ctx, in.ResourceId)
-
-	case inv_v1.ResourceKind_RESOURCE_KIND_OSUPDATERUN:
-		gresresp.Resource, err = srv.IS.GetOSUpdateRun(ctx, in.ResourceId)
-
-	default:
+	handler, ok := store.LookupResourceHandler(kind)
+	if !ok {
 		zlog.InfraSec().InfraError("unknown Resource Kind: %s", kind).Msg("get resource parse error")
 		return nil, errors.Errorfc(codes.InvalidArgument, "unknown Resource Kind: %s", kind)
 	}
 
-	return gresresp, err
+	gresresp := &inv_v1.GetResourceResponse{}
+	gresresp.Resource, gresresp.RenderedMetadata, err = handler.Get(srv.IS, ctx, in.ResourceId, in.GetTenantId())
+	if err != nil {
+		return nil, err
+	}
+	if err := checkScope(ctx, gresresp.GetResource(), false); err != nil {
+		return nil, err
+	}
+	return gresresp, nil
 }
 
 func (srv *InventorygRPCServer) doUpdateResource(
@@ -492,92 +408,13 @@ func (srv *InventorygRPCServer) doUpdateResource(
 	kind inv_v1.ResourceKind,
 	in *inv_v1.UpdateResourceRequest,
 ) (*inv_v1.Resource, bool, error) {
-	var hardDelete bool
-	var err error
-	var res *inv_v1.Resource
-
-	switch kind {
-	// location.proto
-	case inv_v1.ResourceKind_RESOURCE_KIND_REGION:
-		res, err = srv.IS.UpdateRegion(ctx, in.ResourceId, in.GetResource().GetRegion(), in.GetFieldMask(), in.GetTenantId())
-	case inv_v1.ResourceKind_RESOURCE_KIND_SITE:
-		res, err = srv.IS.UpdateSite(ctx, in.ResourceId, in.GetResource().GetSite(), in.GetFieldMask(), in.GetTenantId())
-
-	// ou.proto
-	case inv_v1.ResourceKind_RESOURCE_KIND_OU:
-		res, err = srv.IS.UpdateOu(ctx, in.ResourceId, in.GetResource().GetOu(), in.GetFieldMask(), in.GetTenantId())
-
-	// network.proto
-	case inv_v1.ResourceKind_RESOURCE_KIND_NETWORKSEGMENT:
-		res, err = srv.IS.UpdateNetworkSegment(ctx, in.ResourceId, in.GetResource().GetNetworkSegment(), in.GetFieldMask())
-	case inv_v1.ResourceKind_RESOURCE_KIND_NETLINK:
-		res, hardDelete, err = srv.IS.UpdateNetlink(ctx, in.ResourceId, in.GetResource().GetNetlink(), in.GetFieldMask())
-	case inv_v1.ResourceKind_RESOURCE_KIND_ENDPOINT:
-		res, err = srv.IS.UpdateEndpoint(ctx, in.ResourceId, in.GetResource().GetEndpoint(), in.GetFieldMask())
-	case inv_v1.ResourceKind_RESOURCE_KIND_IPADDRESS:
-		res, hardDelete, err = srv.IS.UpdateIPAddress(ctx, in.ResourceId, in.GetResource().GetIpaddress(), in.GetFieldMask())
-
-	// host.proto
-	case inv_v1.ResourceKind_RESOURCE_KIND_HOST:
-		res, hardDelete, err = srv.IS.UpdateHost(
-			ctx, in.ResourceId, in.GetResource().GetHost(), in.GetFieldMask(), in.GetTenantId())
-	case inv_v1.ResourceKind_RESOURCE_KIND_HOSTSTORAGE:
-		res, err = srv.IS.UpdateHoststorage(ctx, in.ResourceId, in.GetResource().GetHoststorage(), in.GetFieldMask())
-	case inv_v1.ResourceKind_RESOURCE_KIND_HOSTNIC:
-		res, err = srv.IS.UpdateHostnic(ctx, in.ResourceId, in.GetResource().GetHostnic(), in.GetFieldMask())
-	case inv_v1.ResourceKind_RESOURCE_KIND_HOSTUSB:
-		res, err = srv.IS.UpdateHostusb(ctx, in.ResourceId, in.GetResource().GetHostusb(), in.GetFieldMask())
-	case inv_v1.ResourceKind_RESOURCE_KIND_HOSTGPU:
-		res, err = srv.IS.UpdateHostgpu(ctx, in.ResourceId, in.GetResource().GetHostgpu(), in.GetFieldMask())
-	// instance.proto
-	case inv_v1.ResourceKind_RESOURCE_KIND_INSTANCE:
-		res, hardDelete, err = srv.IS.UpdateInstance(ctx, in.ResourceId, in.GetResource().GetInstance(), in.GetFieldMask())
-
-	// provider.proto
-	case inv_v1.ResourceKind_RESOURCE_KIND_PROVIDER:
-		res, err = srv.IS.UpdateProvider(ctx, in.ResourceId, in.GetResource().GetProvider(), in.GetFieldMask())
-
-	// os.proto
-	case inv_v1.ResourceKind_RESOURCE_KIND_OS:
-		res, err = srv.IS.UpdateOs(ctx, in.ResourceId, in.GetResource().GetOs(), in.GetFieldMask())
-
-	// schedule.proto
-	case inv_v1.ResourceKind_RESOURCE_KIND_SINGLESCHEDULE:
-		res, err = srv.IS.UpdateSingleSchedule(ctx, in.ResourceId, in.GetResource().GetSingleschedule(), in.GetFieldMask())
-	case inv_v1.ResourceKind_RESOURCE_KIND_REPEATEDSCHEDULE:
-		res, err = srv.IS.UpdateRepeatedSchedule(ctx, in.ResourceId, in.GetResource().GetRepeatedschedule(), in.GetFieldMask())
-
-	// telemetry.proto
-	case inv_v1.ResourceKind_RESOURCE_KIND_TELEMETRY_GROUP:
-		res, err = srv.IS.UpdateTelemetryGroup(ctx, in.ResourceId, in.GetResource().GetTelemetryGroup(), in.GetFieldMask())
-	case inv_v1.ResourceKind_RESOURCE_KIND_TELEMETRY_PROFILE:
-		res, err = srv.IS.UpdateTelemetryProfile(ctx, in.ResourceId, in.GetResource().GetTelemetryProfile(), in.GetFieldMask())
-
-	// workload.proto
-	case inv_v1.ResourceKind_RESOURCE_KIND_WORKLOAD:
-		res, hardDelete, err = srv.IS.UpdateWorkload(ctx, in.ResourceId, in.GetResource().GetWorkload(), in.GetFieldMask())
-	case inv_v1.ResourceKind_RESOURCE_KIND_WORKLOAD_MEMBER:
-		res, err = srv.IS.UpdateWorkloadMember(ctx, in.ResourceId, in.GetResource().GetWorkloadMember(), in.GetFieldMask())
-
-	case inv_v1.ResourceKind_RESOURCE_KIND_RMT_ACCESS_CONF:
-		res, hardDelete, err = srv.IS.UpdateRemoteAccessConfig(ctx, in.ResourceId,
-			in.GetResource().GetRemoteAccess(), in.GetFieldMask())
-
-	case inv_v1.ResourceKind_RESOURCE_KIND_TENANT:
-		res, hardDelete, err = srv.IS.UpdateTenant(ctx, in.ResourceId, in.GetResource().GetTenant(), in.GetFieldMask())
-
-	case inv_v1.ResourceKind_RESOURCE_KIND_OSUPDATEPOLICY:
-		res, err = srv.IS.UpdateOSUpdatePolicy(ctx, in.ResourceId, in.GetResource().GetOsUpdatePolicy(), in.GetFieldMask())
-
-	case inv_v1.ResourceKind_RESOURCE_KIND_OSUPDATERUN:
-		res, err = srv.IS.UpdateOSUpdateRun(ctx, in.ResourceId, in.GetResource().GetOsUpdateRun(), in.GetFieldMask())
-
-	default:
+	handler, ok := store.LookupResourceHandler(kind)
+	if !ok || handler.Update == nil {
 		zlog.InfraSec().InfraError("unknown Resource Kind: %s", kind).Msg("update resource parse error")
 		return nil, false, errors.Errorfc(codes.InvalidArgument, "unknown Resource Kind: %s", kind)
 	}
 
-	return res, hardDelete, err
+	return handler.Update(srv.IS, ctx, in.ResourceId, in.GetTenantId(), in.GetResource(), in.GetFieldMask())
 }
 
 func (srv *InventorygRPCServer) UpdateResource(
@@ -589,7 +426,7 @@ func (srv *InventorygRPCServer) UpdateResource(
 	err := error(nil)
 
 	// authorize call first
-	err = srv.Authorize(ctx, in)
+	ctx, err = srv.Authorize(ctx, in)
 	if err != nil {
 		return nil, err
 	}
@@ -630,6 +467,16 @@ func (srv *InventorygRPCServer) UpdateResource(
 		return nil, err
 	}
 
+	if err := srv.checkScopeOfExisting(ctx, kind, in.ResourceId, in.GetTenantId()); err != nil {
+		return nil, err
+	}
+	if err := srv.checkScopeTargets(ctx, in.GetTenantId(), in.GetResource(), in.GetFieldMask().GetPaths()); err != nil {
+		return nil, err
+	}
+	if err := srv.checkSecretRefs(ctx, in.GetTenantId(), in.GetResource(), in.GetFieldMask().GetPaths()); err != nil {
+		return nil, err
+	}
+
 	updatedRes, hardDelete, err := srv.doUpdateResource(ctx, kind, in)
 	if err != nil {
 		return nil, err
@@ -658,102 +505,13 @@ func (srv *InventorygRPCServer) doDeleteResource(
 	kind inv_v1.ResourceKind,
 	in *inv_v1.DeleteResourceRequest,
 ) (*inv_v1.Resource, bool, error) {
-	var res *inv_v1.Resource
-	var softDelete bool
-	var err error
-
-	// These methods have a common interface and this switch statement
-	// can be replaced with a map[string]func(IS *store, param string)
-	switch kind {
-	// location.proto
-	case inv_v1.ResourceKind_RESOURCE_KIND_REGION:
-		res, err = srv.IS.DeleteRegion(ctx, in.ResourceId)
-	case inv_v1.ResourceKind_RESOURCE_KIND_SITE:
-		res, err = srv.IS.DeleteSite(ctx, in.ResourceId)
-
-	// ou.proto
-	case inv_v1.ResourceKind_RESOURCE_KIND_OU:
-		res, err = srv.IS.DeleteOu(ctx, in.ResourceId)
-
-	// network.proto
-	case inv_v1.ResourceKind_RESOURCE_KIND_NETWORKSEGMENT:
-		res, err = srv.IS.DeleteNetworkSegment(ctx, in.ResourceId)
-	case inv_v1.ResourceKind_RESOURCE_KIND_NETLINK:
-		res, softDelete, err = srv.IS.DeleteNetlink(ctx, in.ResourceId)
-	case inv_v1.ResourceKind_RESOURCE_KIND_ENDPOINT:
-		res, err = srv.IS.DeleteEndpoint(ctx, in.ResourceId)
-	case inv_v1.ResourceKind_RESOURCE_KIND_IPADDRESS:
-		res, softDelete, err = srv.IS.DeleteIPAddress(ctx, in.ResourceId)
-
-	// host.proto
-	case inv_v1.ResourceKind_RESOURCE_KIND_HOST:
-		res, softDelete, err = srv.IS.DeleteHost(ctx, in.ResourceId, in.GetTenantId())
-	case inv_v1.ResourceKind_RESOURCE_KIND_HOSTSTORAGE:
-		res, err = srv.IS.DeleteHoststorage(ctx, in.ResourceId)
-	case inv_v1.ResourceKind_RESOURCE_KIND_HOSTNIC:
-		res, err = srv.IS.DeleteHostnic(ctx, in.ResourceId)
-	case inv_v1.ResourceKind_RESOURCE_KIND_HOSTUSB:
-		res, err = srv.IS.DeleteHostusb(ctx, in.ResourceId)
-	case inv_v1.ResourceKind_RESOURCE_KIND_HOSTGPU:
-		res, err = srv.IS.DeleteHostgpu(ctx, in.ResourceId)
-
-	// instance.proto
-	case inv_v1.ResourceKind_RESOURCE_KIND_INSTANCE:
-		res, softDelete, err = srv.IS.DeleteInstance(ctx, in.ResourceId)
-
-	// provider.proto
-	case inv_v1.ResourceKind_RESOURCE_KIND_PROVIDER:
-		res, err = srv.IS.DeleteProvider(ctx, in.ResourceId)
-
-	// os.proto
-	case inv_v1.ResourceKind_RESOURCE_KIND_OS:
-		res, err = srv.IS.DeleteOs(ctx, in.ResourceId)
-
-	// schedule.proto
-	case inv_v1.ResourceKind_RESOURCE_KIND_SINGLESCHEDULE:
-		res, err = srv.IS.DeleteSingleSchedule(ctx, in.ResourceId)
-	case inv_v1.ResourceKind_RESOURCE_KIND_REPEATEDSCHEDULE:
-		res, err = srv.IS.DeleteRepeatedSchedule(ctx, in.ResourceId)
-
-	// telemetry.proto
-	case inv_v1.ResourceKind_RESOURCE_KIND_TELEMETRY_GROUP:
-		res, err = srv.IS.DeleteTelemetryGroup(ctx, in.ResourceId)
-	case inv_v1.ResourceKind_RESOURCE_KIND_TELEMETRY_PROFILE:
-		res, err = srv.IS.DeleteTelemetryProfile(ctx, in.ResourceId)
-
-	// workload.proto
-	case inv_v1.ResourceKind_RESOURCE_KIND_WORKLOAD:
-		res, softDelete, err = srv.IS.DeleteWorkload(ctx, in.ResourceId)
-	case inv_v1.ResourceKind_RESOURCE_KIND_WORKLOAD_MEMBER:
-		res, err = srv.IS.DeleteWorkloadMember(ctx, in.ResourceId)
-
-	// remote access
-	case inv_v1.ResourceKind_RESOURCE_KIND_RMT_ACCESS_CONF:
-		res, err = srv.IS.SoftDeleteRemoteAccessConfig(ctx, in.ResourceId)
-
-	case inv_v1.ResourceKind_RESOURCE_KIND_TENANT:
-		softDelete = true
-		res, err = srv.IS.SoftDeleteTenant(ctx, in.ResourceId)
-
-	// localaccount.proto
-	case inv_v1.ResourceKind_RESOURCE_KIND_LOCALACCOUNT:
-		res, err = srv.IS.DeleteLocalAccount(ctx, in.ResourceId)
-
-	case inv_v1.ResourceKind_RESOURCE_KIND_OSUPDATEPOLICY:
-		res, err = srv.IS.DeleteOSUpdatePolicy(ctx, in.ResourceId)
-
-	case inv_v1.ResourceKind_RESOURCE_KIND_CUSTOMCONFIG:
-		res, err = srv.IS.DeleteCustomConfig(ctx, in.ResourceId)
-
-	case inv_v1.ResourceKind_RESOURCE_KIND_OSUPDATERUN:
-		res, err = srv.IS.DeleteOSUpdateRun(ctx, in.ResourceId)
-
-	default:
+	handler, ok := store.LookupResourceHandler(kind)
+	if !ok {
 		zlog.InfraSec().InfraError("unknown Resource Kind: %s", kind).Msg("delete resource parse error")
-		return nil, softDelete, errors.Errorfc(codes.InvalidArgument, "unknown resource kind: %s", kind)
+		return nil, false, errors.Errorfc(codes.InvalidArgument, "unknown resource kind: %s", kind)
 	}
 
-	return res, softDelete, err
+	return handler.Delete(srv.IS, ctx, in.ResourceId, in.GetTenantId())
 }
 
 func (srv *InventorygRPCServer) DeleteResource(
@@ -761,7 +519,7 @@ func (srv *InventorygRPCServer) DeleteResource(
 	in *inv_v1.DeleteResourceRequest,
 ) (*inv_v1.DeleteResourceResponse, error) {
 	// authorize call first
-	err := srv.Authorize(ctx, in)
+	ctx, err := srv.Authorize(ctx, in)
 	if err != nil {
 		return nil, err
 	}
@@ -785,6 +543,10 @@ func (srv *InventorygRPCServer) DeleteResource(
 		return nil, err
 	}
 
+	if err := srv.checkScopeOfExisting(ctx, kind, in.ResourceId, in.GetTenantId()); err != nil {
+		return nil, err
+	}
+
 	deletedRes, softDelete, err := srv.doDeleteResource(ctx, kind, in)
 	if err != nil {
 		return nil, err
@@ -832,10 +594,10 @@ func (srv *InventorygRPCServer) ListInheritedTelemetryProfiles(
 ) (*inv_v1.ListInheritedTelemetryProfilesResponse, error) {
 	zlog := zlog.TraceCtx(ctx)
 	zlog.Info().Msgf("ListInheritedTelemetryProfiles: client_uuid=%v", in.ClientUuid)
-	zlog.Debug().Msgf("ListInheritedTelemetryProfiles: request=%v", in)
+	zlog.Debug().Msgf("ListInheritedTelemetryProfiles: request=%v", logging.Redact(in))
 
 	// authorize call first
-	err := srv.Authorize(ctx, in)
+	ctx, err := srv.Authorize(ctx, in)
 	if err != nil {
 		return nil, err
 	}
@@ -861,16 +623,63 @@ func (srv *InventorygRPCServer) ListInheritedTelemetryProfiles(
 	}, nil
 }
 
+func (srv *InventorygRPCServer) GetEffectiveTelemetryProfiles(
+	ctx context.Context,
+	in *inv_v1.GetEffectiveTelemetryProfilesRequest,
+) (*inv_v1.GetEffectiveTelemetryProfilesResponse, error) {
+	zlog := zlog.TraceCtx(ctx)
+	zlog.Info().Msgf("GetEffectiveTelemetryProfiles: client_uuid=%v", in.ClientUuid)
+	zlog.Debug().Msgf("GetEffectiveTelemetryProfiles: request=%v", logging.Redact(in))
+
+	// authorize call first
+	ctx, err := srv.Authorize(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	err = validator.ValidateMessage(in)
+	if err != nil {
+		zlog.InfraSec().InfraErr(err).Send()
+		return nil, errors.Wrap(err)
+	}
+
+	return srv.IS.GetEffectiveTelemetryProfiles(ctx, in)
+}
+
+func (srv *InventorygRPCServer) DiffEffectiveTelemetryProfiles(
+	ctx context.Context,
+	in *inv_v1.DiffEffectiveTelemetryProfilesRequest,
+) (*inv_v1.DiffEffectiveTelemetryProfilesResponse, error) {
+	zlog := zlog.TraceCtx(ctx)
+	zlog.Info().Msgf("DiffEffectiveTelemetryProfiles: client_uuid=%v", in.ClientUuid)
+	zlog.Debug().Msgf("DiffEffectiveTelemetryProfiles: request=%v", logging.Redact(in))
+
+	// authorize call first
+	ctx, err := srv.Authorize(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	err = validator.ValidateMessage(in)
+	if err != nil {
+		zlog.InfraSec().InfraErr(err).Send()
+		return nil, errors.Wrap(err)
+	}
+
+	return srv.IS.DiffEffectiveTelemetryProfiles(ctx, in)
+}
+
 func (srv *InventorygRPCServer) GetTreeHierarchy(ctx context.Context, req *inv_v1.GetTreeHierarchyRequest) (
 	*inv_v1.GetTreeHierarchyResponse,
 	error,
 ) {
 	zlog := zlog.TraceCtx(ctx)
 	zlog.Info().Msgf("GetHierarchy: ")
-	zlog.Debug().Msgf("GetHierarchy: request=%v", req)
+	zlog.Debug().Msgf("GetHierarchy: request=%v", logging.Redact(req))
 
 	// authorize call first
-	if err := srv.Authorize(ctx, req); err != nil {
+	ctx, err := srv.Authorize(ctx, req)
+	if err != nil {
 		return nil, err
 	}
 
@@ -891,10 +700,11 @@ func (srv *InventorygRPCServer) GetSitesPerRegion(ctx context.Context, req *inv_
 ) {
 	zlog := zlog.TraceCtx(ctx)
 	zlog.Info().Msgf("GetSitesPerRegion: ")
-	zlog.Debug().Msgf("GetSitesPerRegion: request=%v", req)
+	zlog.Debug().Msgf("GetSitesPerRegion: request=%v", logging.Redact(req))
 
 	// authorize call first
-	if err := srv.Authorize(ctx, req); err != nil {
+	ctx, err := srv.Authorize(ctx, req)
+	if err != nil {
 		return nil, err
 	}
 
@@ -909,49 +719,15 @@ func (srv *InventorygRPCServer) GetSitesPerRegion(ctx context.Context, req *inv_
 	return siterPerRegion, err
 }
 
-type deleteResourcesHandler func(ctx context.Context, tenantID string, enforce bool) (
-	[]*util.Tuple[store.DeletionKind, *inv_v1.Resource], error,
-)
-
-type deleteResourcesHandlerProvider func(*store.InvStore) deleteResourcesHandler
-
-//nolint:lll // oneliners give clean and compact look
-var deleteResourcesHandlers = map[inv_v1.ResourceKind]deleteResourcesHandlerProvider{
-	inv_v1.ResourceKind_RESOURCE_KIND_ENDPOINT:          func(is *store.InvStore) deleteResourcesHandler { return is.DeleteEndpoints },
-	inv_v1.ResourceKind_RESOURCE_KIND_HOST:              func(is *store.InvStore) deleteResourcesHandler { return is.DeleteHosts },
-	inv_v1.ResourceKind_RESOURCE_KIND_HOSTNIC:           func(is *store.InvStore) deleteResourcesHandler { return is.DeleteHostNICs },
-	inv_v1.ResourceKind_RESOURCE_KIND_HOSTGPU:           func(is *store.InvStore) deleteResourcesHandler { return is.DeleteHostGPUs },
-	inv_v1.ResourceKind_RESOURCE_KIND_HOSTSTORAGE:       func(is *store.InvStore) deleteResourcesHandler { return is.DeleteHostStorages },
-	inv_v1.ResourceKind_RESOURCE_KIND_HOSTUSB:           func(is *store.InvStore) deleteResourcesHandler { return is.DeleteHostUSBs },
-	inv_v1.ResourceKind_RESOURCE_KIND_INSTANCE:          func(is *store.InvStore) deleteResourcesHandler { return is.DeleteInstances },
-	inv_v1.ResourceKind_RESOURCE_KIND_IPADDRESS:         func(is *store.InvStore) deleteResourcesHandler { return is.DeleteIPAddresses },
-	inv_v1.ResourceKind_RESOURCE_KIND_NETLINK:           func(is *store.InvStore) deleteResourcesHandler { return is.DeleteNetLinks },
-	inv_v1.ResourceKind_RESOURCE_KIND_NETWORKSEGMENT:    func(is *store.InvStore) deleteResourcesHandler { return is.DeleteNetworkSegments },
-	inv_v1.ResourceKind_RESOURCE_KIND_OS:                func(is *store.InvStore) deleteResourcesHandler { return is.DeleteOSes },
-	inv_v1.ResourceKind_RESOURCE_KIND_OU:                func(is *store.InvStore) deleteResourcesHandler { return is.DeleteOus },
-	inv_v1.ResourceKind_RESOURCE_KIND_PROVIDER:          func(is *store.InvStore) deleteResourcesHandler { return is.DeleteProviders },
-	inv_v1.ResourceKind_RESOURCE_KIND_REGION:            func(is *store.InvStore) deleteResourcesHandler { return is.DeleteRegions },
-	inv_v1.ResourceKind_RESOURCE_KIND_REPEATEDSCHEDULE:  func(is *store.InvStore) deleteResourcesHandler { return is.DeleteRepeatedSchedules },
-	inv_v1.ResourceKind_RESOURCE_KIND_SINGLESCHEDULE:    func(is *store.InvStore) deleteResourcesHandler { return is.DeleteSingleSchedules },
-	inv_v1.ResourceKind_RESOURCE_KIND_SITE:              func(is *store.InvStore) deleteResourcesHandler { return is.DeleteSites },
-	inv_v1.ResourceKind_RESOURCE_KIND_TELEMETRY_GROUP:   func(is *store.InvStore) deleteResourcesHandler { return is.DeleteTelemetryGroups },
-	inv_v1.ResourceKind_RESOURCE_KIND_TELEMETRY_PROFILE: func(is *store.InvStore) deleteResourcesHandler { return is.DeleteTelemetryProfiles },
-	inv_v1.ResourceKind_RESOURCE_KIND_WORKLOAD:          func(is *store.InvStore) deleteResourcesHandler { return is.DeleteWorkloads },
-	inv_v1.ResourceKind_RESOURCE_KIND_WORKLOAD_MEMBER:   func(is *store.InvStore) deleteResourcesHandler { return is.DeleteWorkloadMembers },
-	inv_v1.ResourceKind_RESOURCE_KIND_LOCALACCOUNT:      func(is *store.InvStore) deleteResourcesHandler { return is.DeleteLocalAccounts },
-	inv_v1.ResourceKind_RESOURCE_KIND_OSUPDATEPOLICY:    func(is *store.InvStore) deleteResourcesHandler { return is.DeleteOSUpdatePolicies },
-	inv_v1.ResourceKind_RESOURCE_KIND_CUSTOMCONFIG:      func(is *store.InvStore) deleteResourcesHandler { return is.DeleteCustomConfigs },
-	inv_v1.ResourceKind_RESOURCE_KIND_OSUPDATERUN:       func(is *store.InvStore) deleteResourcesHandler { return is.DeleteOSUpdateRuns },
-}
-
 func (srv *InventorygRPCServer) DeleteAllResources(
 	ctx context.Context, in *inv_v1.DeleteAllResourcesRequest,
 ) (*inv_v1.DeleteAllResourcesResponse, error) {
 	zlog := zlog.TraceCtx(ctx)
-	zlog.Info().Msgf("DeleteAllResources: request=%v", in)
+	zlog.Info().Msgf("DeleteAllResources: request=%v", logging.Redact(in))
 
 	// authorize call first
-	if aerr := srv.Authorize(ctx, in); aerr != nil {
+	ctx, aerr := srv.Authorize(ctx, in)
+	if aerr != nil {
 		return nil, aerr
 	}
 
@@ -972,13 +748,13 @@ func (srv *InventorygRPCServer) DeleteAllResources(
 		return nil, errors.Wrap(verr)
 	}
 
-	handler, ok := deleteResourcesHandlers[in.GetResourceKind()]
-	if !ok {
+	handler, ok := store.LookupResourceHandler(in.GetResourceKind())
+	if !ok || handler.DeleteAll == nil {
 		zlog.InfraSec().InfraError("DeleteAllResources for Resource Kind: %s is not implemented", in.GetResourceKind())
 		return nil, errors.Errorfc(codes.InvalidArgument, "unknown resource kind: %s", in.GetResourceKind())
 	}
 
-	deletionInfo, err := handler(srv.IS)(ctx, in.TenantId, in.Enforce)
+	deletionInfo, err := handler.DeleteAll(srv.IS, ctx, in.TenantId, in.Enforce)
 	if err != nil {
 		return nil, err
 	}
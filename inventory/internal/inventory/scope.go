@@ -0,0 +1,203 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package inventory
+
+import (
+	"context"
+	"slices"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/store"
+	computev1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/compute/v1"
+	inv_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/inventory/v1"
+	locationv1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/location/v1"
+	ouv1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/ou/v1"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/util"
+)
+
+// locatedKinds are the resource kinds principals holding the scoped role can read and write within the locations of
+// their role bindings.
+var locatedKinds = []inv_v1.ResourceKind{
+	inv_v1.ResourceKind_RESOURCE_KIND_REGION,
+	inv_v1.ResourceKind_RESOURCE_KIND_SITE,
+	inv_v1.ResourceKind_RESOURCE_KIND_OU,
+	inv_v1.ResourceKind_RESOURCE_KIND_HOST,
+	inv_v1.ResourceKind_RESOURCE_KIND_INSTANCE,
+}
+
+// unlocatedKinds are the resource kinds principals holding the scoped role can read whatever their role bindings,
+// as they are needed to manage the hosts and instances in scope. All the other kinds are denied.
+var unlocatedKinds = []inv_v1.ResourceKind{
+	inv_v1.ResourceKind_RESOURCE_KIND_OS,
+	inv_v1.ResourceKind_RESOURCE_KIND_OSUPDATEPOLICY,
+	inv_v1.ResourceKind_RESOURCE_KIND_CUSTOMCONFIG,
+	inv_v1.ResourceKind_RESOURCE_KIND_LOCALACCOUNT,
+	inv_v1.ResourceKind_RESOURCE_KIND_TELEMETRY_GROUP,
+	inv_v1.ResourceKind_RESOURCE_KIND_CUSTOMTYPE,
+}
+
+func errOutOfScope(format string, args ...any) error {
+	zlog.InfraSec().InfraError(format, args...).Msg("Authorization failed")
+	return errors.Errorfc(codes.PermissionDenied, format, args...)
+}
+
+// authorizeScoped authorizes the request of a principal holding the scoped role in the given tenants, and returns
+// the context carrying its scope. Only the resource kinds that are located, or readable whatever the location, are
+// allowed; the location of single resources is checked by the handlers, see checkScope.
+func (srv *InventorygRPCServer) authorizeScoped(
+	ctx context.Context, request interface{}, subject string, tenantIDs []string,
+) (context.Context, error) {
+	if subject == "" {
+		return ctx, errOutOfScope("scoped role granted without subject")
+	}
+
+	var kind inv_v1.ResourceKind
+	write := false
+	switch req := request.(type) {
+	case *inv_v1.ListResourcesRequest:
+		kind = util.GetResourceKindFromResource(req.GetFilter().GetResource())
+	case *inv_v1.FindResourcesRequest:
+		kind = util.GetResourceKindFromResource(req.GetFilter().GetResource())
+	case *inv_v1.GetResourceRequest:
+		kind, _ = util.GetResourceKindFromResourceID(req.GetResourceId())
+	case *inv_v1.CreateResourceRequest:
+		kind, write = util.GetResourceKindFromResource(req.GetResource()), true
+	case *inv_v1.UpdateResourceRequest:
+		kind, write = util.GetResourceKindFromResource(req.GetResource()), true
+	case *inv_v1.DeleteResourceRequest:
+		kind, _ = util.GetResourceKindFromResourceID(req.GetResourceId())
+		write = true
+	default:
+		// Aggregations over the whole tenant are not restricted to locations.
+		return ctx, errOutOfScope("%T is not allowed with the scoped role", request)
+	}
+	if req, ok := request.(interface{ GetTenantId() string }); ok && !slices.Contains(tenantIDs, req.GetTenantId()) {
+		return ctx, errOutOfScope("scoped role not granted in tenant %s", req.GetTenantId())
+	}
+	if !slices.Contains(locatedKinds, kind) && (write || !slices.Contains(unlocatedKinds, kind)) {
+		return ctx, errOutOfScope("%s is not allowed with the scoped role", kind)
+	}
+
+	scope, err := srv.IS.GetScope(ctx, subject, tenantIDs)
+	if err != nil {
+		return ctx, err
+	}
+	return store.WithScope(ctx, scope), nil
+}
+
+// checkScope checks that the location of the resource is in the scope of the context, if any, with the read-write
+// role if write is set. Resources that are not located are always in scope.
+func checkScope(ctx context.Context, res *inv_v1.Resource, write bool) error {
+	scope, ok := store.ScopeFromContext(ctx)
+	if !ok {
+		return nil
+	}
+	var location string
+	switch r := res.GetResource().(type) {
+	case *inv_v1.Resource_Host:
+		location = r.Host.GetSite().GetResourceId()
+	case *inv_v1.Resource_Instance:
+		location = r.Instance.GetHost().GetSite().GetResourceId()
+	case *inv_v1.Resource_Site:
+		location = r.Site.GetResourceId()
+	case *inv_v1.Resource_Region:
+		location = r.Region.GetResourceId()
+	case *inv_v1.Resource_Ou:
+		location = r.Ou.GetResourceId()
+	default:
+		return nil
+	}
+	if write && !scope.CanWrite(location) || !scope.CanRead(location) {
+		resourceID, _ := util.GetResourceIDFromResource(res)
+		return errOutOfScope("%s is out of scope", resourceID)
+	}
+	return nil
+}
+
+// checkScopeOfExisting checks that the location of the existing resource is in the scope of the context, if any, with
+// the read-write role.
+func (srv *InventorygRPCServer) checkScopeOfExisting(
+	ctx context.Context, kind inv_v1.ResourceKind, resourceID, tenantID string,
+) error {
+	if _, ok := store.ScopeFromContext(ctx); !ok {
+		return nil
+	}
+	handler, ok := store.LookupResourceHandler(kind)
+	if !ok {
+		zlog.InfraSec().InfraError("unknown Resource Kind: %s", kind).Msg("")
+		return errors.Errorfc(codes.InvalidArgument, "unknown Resource Kind: %s", kind)
+	}
+	res, _, err := handler.Get(srv.IS, ctx, resourceID, tenantID)
+	if err != nil {
+		return err
+	}
+	return checkScope(ctx, res, true)
+}
+
+// checkScopeTargets checks that the locations the resource is created in or moved to, given by its edges in paths
+// (all of them if nil), are in the scope of the context with the read-write role. The host of instances is looked up
+// to get its site.
+func (srv *InventorygRPCServer) checkScopeTargets(
+	ctx context.Context, tenantID string, res *inv_v1.Resource, paths []string,
+) error {
+	scope, ok := store.ScopeFromContext(ctx)
+	if !ok {
+		return nil
+	}
+	needed := func(edge string) bool {
+		return paths == nil || slices.Contains(paths, edge)
+	}
+	var targets []string
+	switch r := res.GetResource().(type) {
+	case *inv_v1.Resource_Host:
+		if needed(computev1.HostResourceEdgeSite) {
+			targets = append(targets, r.Host.GetSite().GetResourceId())
+		}
+	case *inv_v1.Resource_Instance:
+		if needed(computev1.InstanceResourceEdgeHost) {
+			site := ""
+			if hostID := r.Instance.GetHost().GetResourceId(); hostID != "" {
+				host, _, err := srv.IS.GetHost(ctx, hostID, tenantID)
+				if err != nil {
+					return err
+				}
+				site = host.GetHost().GetSite().GetResourceId()
+			}
+			targets = append(targets, site)
+		}
+	case *inv_v1.Resource_Site:
+		// Sites are located in their region and their OU, at least one of them must be set on creation.
+		edges := []string{}
+		if needed(locationv1.SiteResourceEdgeRegion) && (paths != nil || r.Site.GetRegion() != nil) {
+			edges = append(edges, r.Site.GetRegion().GetResourceId())
+		}
+		if needed(locationv1.SiteResourceEdgeOu) && (paths != nil || r.Site.GetOu() != nil) {
+			edges = append(edges, r.Site.GetOu().GetResourceId())
+		}
+		if paths == nil && len(edges) == 0 {
+			edges = append(edges, "")
+		}
+		targets = append(targets, edges...)
+	case *inv_v1.Resource_Region:
+		if needed(locationv1.RegionResourceEdgeParentRegion) {
+			targets = append(targets, r.Region.GetParentRegion().GetResourceId())
+		}
+	case *inv_v1.Resource_Ou:
+		if needed(ouv1.OuResourceEdgeParentOu) {
+			targets = append(targets, r.Ou.GetParentOu().GetResourceId())
+		}
+	default:
+		return nil
+	}
+	for _, target := range targets {
+		// Unset targets are out of scope: they would move the resource out of any location, e.g. clearing the site of
+		// a host.
+		if !scope.CanWrite(target) {
+			return errOutOfScope("target location %q is out of scope", target)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package inventory
+
+import (
+	"context"
+	"slices"
+
+	"google.golang.org/grpc/codes"
+
+	computev1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/compute/v1"
+	inv_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/inventory/v1"
+	providerv1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/provider/v1"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/secrets"
+)
+
+// secretRefs returns the secret references held by the fields of the resource that are written, i.e. all of them
+// when paths is empty, else the ones in paths.
+func secretRefs(res *inv_v1.Resource, paths []string) []string {
+	written := func(field string) bool {
+		return len(paths) == 0 || slices.Contains(paths, field)
+	}
+	var refs []string
+	switch {
+	case res.GetHost() != nil:
+		if password := res.GetHost().GetBmcPassword(); written(computev1.HostResourceFieldBmcPassword) &&
+			secrets.IsRef(password) {
+			refs = append(refs, password)
+		}
+	case res.GetProvider() != nil:
+		if written(providerv1.ProviderResourceFieldConfig) {
+			refs = append(refs, secrets.ConfigRefs(res.GetProvider().GetConfig())...)
+		}
+	}
+	return refs
+}
+
+// checkSecretRefs checks the secret references held by the written fields of the resource: they must be valid and
+// reference secrets of the tenant, and the secrets they reference must exist when the secrets service is configured.
+// The references are stored as they are, resolving them is left to the consumers of the fields.
+func (srv *InventorygRPCServer) checkSecretRefs(
+	ctx context.Context, tenantID string, res *inv_v1.Resource, paths []string,
+) error {
+	var refs []secrets.Ref
+	for _, value := range secretRefs(res, paths) {
+		ref, err := secrets.ParseTenantRef(value, tenantID)
+		if err != nil {
+			return err
+		}
+		refs = append(refs, ref)
+	}
+	if len(refs) == 0 || srv.SecretsFactory == nil {
+		return nil
+	}
+
+	svc, err := srv.SecretsFactory(ctx)
+	if err != nil {
+		zlog.InfraSec().InfraErr(err).Msg("failed to connect to the secrets service")
+		return errors.Errorfc(codes.Unavailable, "unable to check the secret references")
+	}
+	defer svc.Logout(ctx)
+	for _, ref := range refs {
+		if _, err := ref.Resolve(ctx, svc); err != nil {
+			if errors.IsNotFound(err) {
+				zlog.InfraSec().InfraErr(err).Msgf("referenced secret %s not found", ref)
+				return errors.Errorfc(codes.InvalidArgument, "referenced secret %s not found", ref)
+			}
+			return err
+		}
+	}
+	return nil
+}
@@ -4,8 +4,10 @@
 package server
 
 import (
+	"context"
 	"net"
 	"sync"
+	"time"
 
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpc_auth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
@@ -14,6 +16,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
 
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/clientreg"
 	inv_impl "github.com/open-edge-platform/infra-core/inventory/v2/internal/inventory"
 	inv_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/inventory/v1"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/auditing"
@@ -21,6 +24,7 @@ import (
 	inv_errors "github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/logging"
 	metrics "github.com/open-edge-platform/infra-core/inventory/v2/pkg/metrics"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/secrets"
 	"github.com/open-edge-platform/orch-library/go/pkg/grpc/auth"
 )
 
@@ -32,10 +36,20 @@ type Options struct {
 	EnableMetrics  bool
 	MetricsAddress string
 	EnableAuditing bool
-	InsecureGrpc   bool
-	CaCertPath     string
-	TLSCertPath    string
-	TLSKeyPath     string
+	// AuditSigningKeyPath is the key sealing the audit records every AuditSealInterval, if auditing is enabled. The
+	// last seals are persisted to AuditHeadPath, if set, to link the audit chains of successive runs.
+	AuditSigningKeyPath string
+	AuditSealInterval   time.Duration
+	AuditHeadPath       string
+	InsecureGrpc        bool
+	CaCertPath          string
+	TLSCertPath         string
+	TLSKeyPath          string
+	// EnforceClientIdentity rejects the clients without a certificate or JWT naming their client kind.
+	EnforceClientIdentity bool
+	// ValidateSecretRefs checks that the secrets referenced by the written resources exist, when a secrets backend is
+	// configured.
+	ValidateSecretRefs bool
 }
 
 // Metrics server definition, you need to register a gRPC server and start the server to actually serve metrics.
@@ -45,7 +59,8 @@ func invalidSecureConfig(caCertPath, tlsCertPath, tlsKeyPath string) bool {
 	return caCertPath == "" || tlsCertPath == "" || tlsKeyPath == ""
 }
 
-func GetAuthOpts(caCertPath, tlsCertPath, tlsKeyPath string) (grpc.ServerOption, error) {
+// GetAuthOpts returns the TLS credentials of the server, reloading the certificates until ctx is done.
+func GetAuthOpts(ctx context.Context, caCertPath, tlsCertPath, tlsKeyPath string) (grpc.ServerOption, error) {
 	// setting secure gRPC connection
 	if invalidSecureConfig(caCertPath, tlsCertPath, tlsKeyPath) {
 		zlog.InfraSec().Error().Msgf("CaCertPath %s or TlsCerPath %s or TlsKeyPath %s were not provided\n",
@@ -55,7 +70,7 @@ func GetAuthOpts(caCertPath, tlsCertPath, tlsKeyPath string) (grpc.ServerOption,
 			caCertPath, tlsCertPath, tlsKeyPath,
 		)
 	}
-	creds, err := cert.HandleCertPaths(caCertPath, tlsKeyPath, tlsCertPath, true)
+	creds, err := cert.HandleCertPaths(ctx, caCertPath, tlsKeyPath, tlsCertPath, true)
 	if err != nil {
 		zlog.InfraSec().Err(err).Msgf("an error occurred while loading credentials to server %v, %v, %v: %v\n",
 			caCertPath, tlsCertPath, tlsKeyPath, err,
@@ -65,7 +80,7 @@ func GetAuthOpts(caCertPath, tlsCertPath, tlsKeyPath string) (grpc.ServerOption,
 	return grpc.Creds(creds), nil
 }
 
-func GetServerOpts(opts Options) ([]grpc.ServerOption, error) {
+func GetServerOpts(ctx context.Context, opts Options) ([]grpc.ServerOption, error) {
 	var srvOpts []grpc.ServerOption
 	var unaryInter []grpc.UnaryServerInterceptor
 	var streamInter []grpc.StreamServerInterceptor
@@ -79,7 +94,7 @@ func GetServerOpts(opts Options) ([]grpc.ServerOption, error) {
 	}
 
 	if !opts.InsecureGrpc {
-		authOpts, err := GetAuthOpts(opts.CaCertPath, opts.TLSCertPath, opts.TLSKeyPath)
+		authOpts, err := GetAuthOpts(ctx, opts.CaCertPath, opts.TLSCertPath, opts.TLSKeyPath)
 		if err != nil {
 			return nil, err
 		}
@@ -120,7 +135,10 @@ func inventoryGrpcServer(
 	policyBundle string,
 	opts Options,
 ) {
-	srvOpts, err := GetServerOpts(opts)
+	// srvCtx bounds the certificate reloading and the audit sealing, stopped with the server.
+	srvCtx, stopServer := context.WithCancel(context.Background())
+	defer stopServer()
+	srvOpts, err := GetServerOpts(srvCtx, opts)
 	if err != nil {
 		zlog.Fatal().Err(err).Msg("failed to get server opts")
 	}
@@ -128,8 +146,20 @@ func inventoryGrpcServer(
 	gsrv := grpc.NewServer(srvOpts...)
 
 	// register server - inventoryServer
-	inv_v1.RegisterInventoryServiceServer(
-		gsrv, inv_impl.NewInventoryServer(dbURLWriter, dbURLReader, policyBundle, opts.EnableTracing, opts.EnableAuth))
+	invServer := inv_impl.NewInventoryServer(dbURLWriter, dbURLReader, policyBundle, opts.EnableTracing, opts.EnableAuth)
+	invServer.EnforceClientIdentity = opts.EnforceClientIdentity
+	if opts.ValidateSecretRefs && secrets.BackendConfigured() {
+		invServer.SecretsFactory = secrets.SecretServiceFactory
+	}
+	inv_v1.RegisterInventoryServiceServer(gsrv, invServer)
+
+	if opts.EnableAuditing && opts.AuditSigningKeyPath != "" {
+		key, err := auditing.LoadSigningKey(opts.AuditSigningKeyPath)
+		if err != nil {
+			zlog.InfraSec().Fatal().Err(err).Msg("failed to load the audit signing key")
+		}
+		auditing.StartSealing(srvCtx, key, opts.AuditSealInterval, opts.AuditHeadPath)
+	}
 
 	// enable reflection
 	reflection.Register(gsrv)
@@ -138,7 +168,8 @@ func inventoryGrpcServer(
 		// Register metrics
 		srvMetrics.InitializeMetrics(gsrv)
 		// Start metrics exporter server
-		metrics.StartMetricsExporter([]prometheus.Collector{srvMetrics}, metrics.WithListenAddress(opts.MetricsAddress))
+		metrics.StartMetricsExporter([]prometheus.Collector{srvMetrics, clientreg.RegistrationMetrics, cert.ExpiryMetrics},
+			metrics.WithListenAddress(opts.MetricsAddress))
 	}
 
 	// in goroutine signal is ready and then serve
@@ -160,6 +191,7 @@ func inventoryGrpcServer(
 		gsrv.Stop()
 		zlog.Info().Msg("stopping server")
 	}
+	stopServer()
 
 	// exit WaitGroup when done
 	wg.Done()
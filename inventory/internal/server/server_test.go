@@ -4,6 +4,7 @@
 package server_test
 
 import (
+	"context"
 	"flag"
 	"os"
 	"path/filepath"
@@ -107,7 +108,7 @@ func Test_getServerOpts(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			_, err := server.GetServerOpts(tt.args)
+			_, err := server.GetServerOpts(context.Background(), tt.args)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("GetServerOpts() error = %v, wantErr %v", err, tt.wantErr)
 				return
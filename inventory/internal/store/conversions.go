@@ -8,6 +8,7 @@ import (
 
 	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent"
 	computev1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/compute/v1"
+	customresourcev1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/customresource/v1"
 	localaccount_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/localaccount/v1"
 	location_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/location/v1"
 	network_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/network/v1"
@@ -15,6 +16,7 @@ import (
 	ou_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/ou/v1"
 	provider_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/provider/v1"
 	remoteaccessv1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/remoteaccess/v1"
+	rolebindingv1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/rolebinding/v1"
 	schedule_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/schedule/v1"
 	statusv1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/status/v1"
 	telemetry_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/telemetry/v1"
@@ -931,3 +933,86 @@ func entOSUpdateRunResourceToProtoOSUpdateRunResource(osrun *ent.OSUpdateRunReso
 	}
 	return protoOsUpdateRun
 }
+
+func entCustomTypeResourceToProtoCustomTypeResource(
+	customType *ent.CustomTypeResource,
+) *customresourcev1.CustomTypeResource {
+	if customType == nil {
+		return nil
+	}
+	protoCustomType := &customresourcev1.CustomTypeResource{
+		ResourceId:  customType.ResourceID,
+		Name:        customType.Name,
+		Description: customType.Description,
+		JsonSchema:  customType.JSONSchema,
+		TenantId:    customType.TenantID,
+		CreatedAt:   customType.CreatedAt,
+		UpdatedAt:   customType.UpdatedAt,
+	}
+	if customType.AllowedEdges != "" {
+		protoCustomType.AllowedEdges = strings.Split(customType.AllowedEdges, "|")
+	}
+	return protoCustomType
+}
+
+func entCustomObjectResourceToProtoCustomObjectResource(
+	customObject *ent.CustomObjectResource,
+) *customresourcev1.CustomObjectResource {
+	if customObject == nil {
+		return nil
+	}
+	protoCustomObject := &customresourcev1.CustomObjectResource{
+		ResourceId: customObject.ResourceID,
+		Name:       customObject.Name,
+		Spec:       customObject.Spec,
+		TenantId:   customObject.TenantID,
+		CreatedAt:  customObject.CreatedAt,
+		UpdatedAt:  customObject.UpdatedAt,
+	}
+	if customType, qerr := customObject.Edges.CustomTypeOrErr(); qerr == nil {
+		protoCustomObject.CustomType = entCustomTypeResourceToProtoCustomTypeResource(customType)
+	}
+	if host, qerr := customObject.Edges.HostOrErr(); qerr == nil {
+		protoCustomObject.Host = entHostResourceToProtoHostResource(host)
+	}
+	if site, qerr := customObject.Edges.SiteOrErr(); qerr == nil {
+		protoCustomObject.Site = entSiteResourceToProtoSiteResource(site)
+	}
+	if region, qerr := customObject.Edges.RegionOrErr(); qerr == nil {
+		protoCustomObject.Region = entRegionResourceToProtoRegionResource(region)
+	}
+	if ou, qerr := customObject.Edges.OuOrErr(); qerr == nil {
+		protoCustomObject.Ou = entOuResourceToProtoOuResource(ou)
+	}
+	if instance, qerr := customObject.Edges.InstanceOrErr(); qerr == nil {
+		protoCustomObject.Instance = entInstanceResourceToProtoInstanceResource(instance)
+	}
+	return protoCustomObject
+}
+
+func entRoleBindingResourceToProtoRoleBindingResource(
+	roleBinding *ent.RoleBindingResource,
+) *rolebindingv1.RoleBindingResource {
+	if roleBinding == nil {
+		return nil
+	}
+	role := rolebindingv1.ScopedRole_value[roleBinding.Role.String()]
+	protoRoleBinding := &rolebindingv1.RoleBindingResource{
+		ResourceId: roleBinding.ResourceID,
+		Subject:    roleBinding.Subject,
+		Role:       rolebindingv1.ScopedRole(role),
+		TenantId:   roleBinding.TenantID,
+		CreatedAt:  roleBinding.CreatedAt,
+		UpdatedAt:  roleBinding.UpdatedAt,
+	}
+	if region, qerr := roleBinding.Edges.RegionOrErr(); qerr == nil {
+		protoRoleBinding.Region = entRegionResourceToProtoRegionResource(region)
+	}
+	if site, qerr := roleBinding.Edges.SiteOrErr(); qerr == nil {
+		protoRoleBinding.Site = entSiteResourceToProtoSiteResource(site)
+	}
+	if ou, qerr := roleBinding.Edges.OuOrErr(); qerr == nil {
+		protoRoleBinding.Ou = entOuResourceToProtoOuResource(ou)
+	}
+	return protoRoleBinding
+}
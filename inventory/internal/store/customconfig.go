@@ -7,6 +7,7 @@ import (
 	"context"
 
 	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 
 	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent"
 	customconfigs "github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/customconfigresource"
@@ -15,11 +16,25 @@ import (
 	inv_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/inventory/v1"
 	cl "github.com/open-edge-platform/infra-core/inventory/v2/pkg/client"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/logging"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/util"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/util/collections"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/validator"
 )
 
+func init() {
+	RegisterResourceHandler(ResourceHandler{
+		Kind:      inv_v1.ResourceKind_RESOURCE_KIND_CUSTOMCONFIG,
+		Create:    createAs((*InvStore).CreateCustomConfig),
+		Get:       getByID((*InvStore).GetCustomConfig),
+		Update:    updateAs((*InvStore).UpdateCustomConfig),
+		Delete:    deleteByID((*InvStore).DeleteCustomConfig),
+		DeleteAll: (*InvStore).DeleteCustomConfigs,
+		List:      (*InvStore).ListCustomConfigs,
+		Filter:    (*InvStore).FilterCustomConfigs,
+	})
+}
+
 var customConfigResourceCreationValidators = []resourceValidator[*computev1.CustomConfigResource]{
 	protoValidator[*computev1.CustomConfigResource],
 	doNotAcceptResourceID[*computev1.CustomConfigResource],
@@ -35,7 +50,7 @@ func (is *InvStore) CreateCustomConfig(ctx context.Context, in *computev1.Custom
 		return nil, err
 	}
 
-	zlog.Debug().Msgf("CustomConfig Created: %s, %s", res.GetCustomConfig().GetResourceId(), res)
+	zlog.Debug().Msgf("CustomConfig Created: %s, %s", res.GetCustomConfig().GetResourceId(), logging.Redact(res))
 	return res, nil
 }
 
@@ -98,6 +113,61 @@ func (is *InvStore) GetCustomConfig(ctx context.Context, id string) (*inv_v1.Res
 	return &inv_v1.Resource{Resource: &inv_v1.Resource_CustomConfig{CustomConfig: apiResource}}, nil
 }
 
+func (is *InvStore) UpdateCustomConfig(
+	ctx context.Context, id string, in *computev1.CustomConfigResource, fieldmask *fieldmaskpb.FieldMask,
+) (*inv_v1.Resource, error) {
+	zlog.Debug().Msgf("UpdateCustomConfig (%s): %v, fm: %v", id, logging.Redact(in), fieldmask)
+	res, err := ExecuteInTxAndReturnSingle[inv_v1.Resource](is)(ctx,
+		func(ctx context.Context, tx *ent.Tx) (*inv_v1.Resource, error) {
+			entity, err := getCustomConfigQuery(ctx, tx, id)
+			if err != nil {
+				return nil, err
+			}
+
+			// The name identifies the config in the instances using it,
+			// the description and the config itself can instead be fixed at any time.
+			if err := verifyMutableWhileReferenced(ctx, entCustomConfigResourceToProtoCustomConfigResource(entity),
+				in, fieldmask, isCustomConfigReferenced(tx, id), customconfigs.FieldName); err != nil {
+				return nil, err
+			}
+
+			updateBuilder := tx.CustomConfigResource.UpdateOneID(entity.ID)
+			mut := updateBuilder.Mutation()
+
+			if err := buildEntMutate(in, mut, EmptyEnumStateMap, fieldmask.GetPaths()); err != nil {
+				return nil, err
+			}
+
+			if _, err := updateBuilder.Save(ctx); err != nil {
+				return nil, errors.Wrap(err)
+			}
+
+			res, err := getCustomConfigQuery(ctx, tx, id)
+			if err != nil {
+				return nil, err
+			}
+			return util.WrapResource(entCustomConfigResourceToProtoCustomConfigResource(res))
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func isCustomConfigReferenced(tx *ent.Tx, id string) func(context.Context) (bool, error) {
+	return func(ctx context.Context) (bool, error) {
+		referenced, err := tx.InstanceResource.Query().
+			Where(instances.HasCustomConfigWith(customconfigs.ResourceID(id))).
+			Exist(ctx)
+		if err != nil {
+			return false, errors.Wrap(err)
+		}
+		return referenced, nil
+	}
+}
+
 func (is *InvStore) DeleteCustomConfig(ctx context.Context, id string) (*inv_v1.Resource, error) {
 	zlog.Debug().Msgf("DeleteCustomConfig Delete: %s", id)
 
@@ -14,6 +14,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/fieldmaskpb"
 
 	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/customconfigresource"
@@ -455,6 +457,30 @@ func Test_UpdateCustomConfig(t *testing.T) {
 					customconfigresource.FieldName,
 				},
 			},
+			valid: true,
+		},
+		"UpdateCustomConfigConfigAndDescription": {
+			in: &computev1.CustomConfigResource{
+				Description: "Test custom config resource 2",
+				Config:      testCloudInitConfig + "\n",
+			},
+			resourceID: customConfigResID,
+			fieldMask: &fieldmaskpb.FieldMask{
+				Paths: []string{
+					customconfigresource.FieldDescription,
+					customconfigresource.FieldConfig,
+				},
+			},
+			valid: true,
+		},
+		"UpdateCustomConfigEmptyConfig": {
+			in:         &computev1.CustomConfigResource{},
+			resourceID: customConfigResID,
+			fieldMask: &fieldmaskpb.FieldMask{
+				Paths: []string{
+					customconfigresource.FieldConfig,
+				},
+			},
 			valid: false,
 		},
 	}
@@ -480,7 +506,69 @@ func Test_UpdateCustomConfig(t *testing.T) {
 				return
 			}
 			require.NoError(t, err)
-			assert.NotNil(t, upRes)
+			assertSameResource(t, updateresreq, upRes, tc.fieldMask)
+		})
+	}
+}
+
+func Test_UpdateCustomConfig_WhileReferenced(t *testing.T) {
+	os := inv_testing.CreateOs(t)
+	host := inv_testing.CreateHost(t, nil, nil)
+	customConfig := inv_testing.CreateCustomConfig(t,
+		"test-custom-config",
+		"Test custom config resource",
+		testCloudInitConfig,
+	)
+	_ = inv_testing.CreateInstanceWithCustomConfig(t, host, os, []*computev1.CustomConfigResource{customConfig})
+
+	testcases := map[string]struct {
+		in        *computev1.CustomConfigResource
+		fieldMask *fieldmaskpb.FieldMask
+		valid     bool
+	}{
+		"FixConfig": {
+			in:        &computev1.CustomConfigResource{Config: testCloudInitConfig + "\n"},
+			fieldMask: &fieldmaskpb.FieldMask{Paths: []string{customconfigresource.FieldConfig}},
+			valid:     true,
+		},
+		"SameName": {
+			in:        &computev1.CustomConfigResource{Name: "test-custom-config"},
+			fieldMask: &fieldmaskpb.FieldMask{Paths: []string{customconfigresource.FieldName}},
+			valid:     true,
+		},
+		"ChangeName": {
+			in:        &computev1.CustomConfigResource{Name: "test-custom-config-2"},
+			fieldMask: &fieldmaskpb.FieldMask{Paths: []string{customconfigresource.FieldName}},
+			valid:     false,
+		},
+		"FixConfigNilMask": {
+			in:    &computev1.CustomConfigResource{Name: "test-custom-config", Config: testCloudInitConfig + "\n"},
+			valid: true,
+		},
+		"ChangeNameNilMask": {
+			in:    &computev1.CustomConfigResource{Name: "test-custom-config-2", Config: testCloudInitConfig},
+			valid: false,
+		},
+	}
+
+	for tcname, tc := range testcases {
+		t.Run(tcname, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+
+			upRes, err := inv_testing.TestClients[inv_testing.APIClient].Update(
+				ctx,
+				customConfig.GetResourceId(),
+				tc.fieldMask,
+				&inv_v1.Resource{Resource: &inv_v1.Resource_CustomConfig{CustomConfig: tc.in}},
+			)
+			if !tc.valid {
+				require.Error(t, err)
+				assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, customConfig.GetResourceId(), upRes.GetCustomConfig().GetResourceId())
 		})
 	}
 }
@@ -0,0 +1,465 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package store
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/santhosh-tekuri/jsonschema/v6"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent"
+	customobjects "github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/customobjectresource"
+	customresourcev1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/customresource/v1"
+	inv_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/inventory/v1"
+	cl "github.com/open-edge-platform/infra-core/inventory/v2/pkg/client"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/logging"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/util"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/util/collections"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/validator"
+)
+
+func init() {
+	RegisterResourceHandler(ResourceHandler{
+		Kind:      inv_v1.ResourceKind_RESOURCE_KIND_CUSTOMOBJECT,
+		Create:    createAs((*InvStore).CreateCustomObject),
+		Get:       getByID((*InvStore).GetCustomObject),
+		Update:    updateAs((*InvStore).UpdateCustomObject),
+		Delete:    deleteByID((*InvStore).DeleteCustomObject),
+		DeleteAll: (*InvStore).DeleteCustomObjects,
+		List:      (*InvStore).ListCustomObjects,
+		Filter:    (*InvStore).FilterCustomObjects,
+	})
+}
+
+var customObjectResourceCreationValidators = []resourceValidator[*customresourcev1.CustomObjectResource]{
+	protoValidator[*customresourcev1.CustomObjectResource],
+	doNotAcceptResourceID[*customresourcev1.CustomObjectResource],
+	validateCustomObjectHasType,
+}
+
+func validateCustomObjectHasType(in *customresourcev1.CustomObjectResource) error {
+	if in.GetCustomType() == nil {
+		zlog.InfraSec().InfraError("custom_type is required").Msg("")
+		return errors.Errorfc(codes.InvalidArgument, "custom_type is required")
+	}
+	return nil
+}
+
+func (is *InvStore) CreateCustomObject(
+	ctx context.Context, in *customresourcev1.CustomObjectResource,
+) (*inv_v1.Resource, error) {
+	if err := validate(in, customObjectResourceCreationValidators...); err != nil {
+		return nil, err
+	}
+
+	res, err := ExecuteInTxAndReturnSingle[inv_v1.Resource](is)(ctx, customObjectResourceCreator(in))
+	if err != nil {
+		return nil, err
+	}
+
+	zlog.Debug().Msgf("CustomObject Created: %s, %s", res.GetCustomObject().GetResourceId(), logging.Redact(res))
+	return res, nil
+}
+
+func customObjectResourceCreator(in *customresourcev1.CustomObjectResource) func(context.Context, *ent.Tx) (
+	*inv_v1.Resource, error) {
+	return func(ctx context.Context, tx *ent.Tx) (*inv_v1.Resource, error) {
+		id := util.NewInvID(inv_v1.ResourceKind_RESOURCE_KIND_CUSTOMOBJECT)
+		zlog.Debug().Msgf("CreateCustomObject: %s", id)
+
+		newEntity := tx.CustomObjectResource.Create()
+		mut := newEntity.Mutation()
+
+		if err := buildEntMutate(in, mut, EmptyEnumStateMap, nil); err != nil {
+			return nil, err
+		}
+
+		if err := setRelationsForCustomObjectMutIfNeeded(ctx, tx, mut, in, nil); err != nil {
+			return nil, err
+		}
+
+		if err := mut.SetField(customobjects.FieldResourceID, id); err != nil {
+			return nil, errors.Wrap(err)
+		}
+
+		_, err := newEntity.Save(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err)
+		}
+
+		res, err := getCustomObjectQuery(ctx, tx, id)
+		if err != nil {
+			return nil, err
+		}
+		if err := validateCustomObjectBeforeCommit(res); err != nil {
+			return nil, err
+		}
+		return util.WrapResource(entCustomObjectResourceToProtoCustomObjectResource(res))
+	}
+}
+
+// setRelationsForCustomObjectMutIfNeeded sets the edges of the mutation. When paths is nil (creation) all the given
+// edges are set, otherwise only the ones in the fieldmask; edges in the fieldmask but not given are cleared by
+// buildEntMutate.
+func setRelationsForCustomObjectMutIfNeeded(
+	ctx context.Context,
+	tx *ent.Tx,
+	mut *ent.CustomObjectResourceMutation,
+	in *customresourcev1.CustomObjectResource,
+	paths []string,
+) error {
+	needed := func(edge string) bool {
+		return paths == nil || slices.Contains(paths, edge)
+	}
+	client := tx.Client()
+
+	if needed(customobjects.EdgeCustomType) {
+		if in.GetCustomType() == nil {
+			zlog.InfraSec().InfraError("custom_type cannot be cleared").Msg("")
+			return errors.Errorfc(codes.InvalidArgument, "custom_type cannot be cleared")
+		}
+		customTypeID, err := getCustomTypeIDFromResourceID(ctx, client, in.GetCustomType())
+		if err != nil {
+			return err
+		}
+		mut.ResetCustomType()
+		mut.SetCustomTypeID(customTypeID)
+	}
+	if needed(customobjects.EdgeHost) {
+		mut.ResetHost()
+		if err := setEdgeHostIDForMut(ctx, client, mut, in.GetHost()); err != nil {
+			return err
+		}
+	}
+	if needed(customobjects.EdgeSite) {
+		mut.ResetSite()
+		if err := setEdgeSiteIDForMut(ctx, client, mut, in.GetSite()); err != nil {
+			return err
+		}
+	}
+	if needed(customobjects.EdgeRegion) {
+		mut.ResetRegion()
+		if err := setEdgeRegionIDForMut(ctx, client, mut, in.GetRegion()); err != nil {
+			return err
+		}
+	}
+	if needed(customobjects.EdgeOu) {
+		mut.ResetOu()
+		if in.GetOu() != nil {
+			ouID, err := getOuIDFromResourceID(ctx, tx, in.GetOu())
+			if err != nil {
+				return err
+			}
+			mut.SetOuID(ouID)
+		}
+	}
+	if needed(customobjects.EdgeInstance) {
+		mut.ResetInstance()
+		if err := setEdgeInstanceIDForMut(ctx, client, mut, in.GetInstance()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateCustomObjectBeforeCommit checks that the object, loaded with all its edges, conforms to its custom type.
+func validateCustomObjectBeforeCommit(object *ent.CustomObjectResource) error {
+	customType, err := object.Edges.CustomTypeOrErr()
+	if err != nil {
+		zlog.InfraSec().InfraErr(err).Msg("")
+		return errors.Errorfc(codes.InvalidArgument, "custom_type is required")
+	}
+	schema, err := compileCustomTypeSchema(customType.JSONSchema)
+	if err != nil {
+		return err
+	}
+	if err := checkCustomObjectConformance(object, customType, schema); err != nil {
+		zlog.InfraSec().InfraErr(err).Msgf("custom object does not conform to custom type %s", customType.ResourceID)
+		return errors.Errorfc(codes.InvalidArgument, "custom object does not conform to custom type %s: %v",
+			customType.Name, err)
+	}
+	return nil
+}
+
+// checkCustomObjectConformance checks that only the edges allowed by the custom type are set and that the spec
+// validates against the compiled schema of the type. The object must be loaded with its edges.
+func checkCustomObjectConformance(
+	object *ent.CustomObjectResource, customType *ent.CustomTypeResource, schema *jsonschema.Schema,
+) error {
+	var allowedEdges []string
+	if customType.AllowedEdges != "" {
+		allowedEdges = strings.Split(customType.AllowedEdges, "|")
+	}
+	setEdges := []util.Tuple[string, bool]{
+		{A: customobjects.EdgeHost, B: object.Edges.Host != nil},
+		{A: customobjects.EdgeSite, B: object.Edges.Site != nil},
+		{A: customobjects.EdgeRegion, B: object.Edges.Region != nil},
+		{A: customobjects.EdgeOu, B: object.Edges.Ou != nil},
+		{A: customobjects.EdgeInstance, B: object.Edges.Instance != nil},
+	}
+	for _, edge := range setEdges {
+		if edge.B && !slices.Contains(allowedEdges, edge.A) {
+			return fmt.Errorf("edge %s is not allowed", edge.A)
+		}
+	}
+
+	spec := object.Spec
+	if spec == "" {
+		spec = "{}"
+	}
+	doc, err := jsonschema.UnmarshalJSON(strings.NewReader(spec))
+	if err != nil {
+		return fmt.Errorf("spec is not a valid JSON document: %w", err)
+	}
+	return schema.Validate(doc)
+}
+
+func getCustomObjectQuery(ctx context.Context, tx *ent.Tx, resourceID string) (*ent.CustomObjectResource, error) {
+	entity, err := tx.CustomObjectResource.Query().
+		Where(customobjects.ResourceID(resourceID)).
+		WithCustomType().
+		WithHost().
+		WithSite().
+		WithRegion().
+		WithOu().
+		WithInstance().
+		Only(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	return entity, nil
+}
+
+func (is *InvStore) GetCustomObject(ctx context.Context, id string) (*inv_v1.Resource, error) {
+	res, err := ExecuteInRoTxAndReturnSingle[ent.CustomObjectResource](is)(
+		ctx,
+		func(ctx context.Context, tx *ent.Tx) (*ent.CustomObjectResource, error) {
+			return getCustomObjectQuery(ctx, tx, id)
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	apiResource := entCustomObjectResourceToProtoCustomObjectResource(res)
+	if err = validator.ValidateMessage(apiResource); err != nil {
+		zlog.InfraSec().InfraErr(err).Msg("")
+		return nil, errors.Wrap(err)
+	}
+
+	return &inv_v1.Resource{Resource: &inv_v1.Resource_CustomObject{CustomObject: apiResource}}, nil
+}
+
+func (is *InvStore) UpdateCustomObject(
+	ctx context.Context, id string, in *customresourcev1.CustomObjectResource, fieldmask *fieldmaskpb.FieldMask,
+) (*inv_v1.Resource, error) {
+	zlog.Debug().Msgf("UpdateCustomObject (%s): %v, fm: %v", id, logging.Redact(in), fieldmask)
+
+	res, err := ExecuteInTxAndReturnSingle[inv_v1.Resource](is)(ctx,
+		func(ctx context.Context, tx *ent.Tx) (*inv_v1.Resource, error) {
+			entity, err := tx.CustomObjectResource.Query().
+				Select(customobjects.FieldID).
+				Where(customobjects.ResourceID(id)).
+				Only(ctx)
+			if err != nil {
+				return nil, errors.Wrap(err)
+			}
+
+			updateBuilder := tx.CustomObjectResource.UpdateOneID(entity.ID)
+			mut := updateBuilder.Mutation()
+
+			if err := setRelationsForCustomObjectMutIfNeeded(ctx, tx, mut, in, fieldmask.GetPaths()); err != nil {
+				return nil, err
+			}
+
+			if err := buildEntMutate(in, mut, EmptyEnumStateMap, fieldmask.GetPaths()); err != nil {
+				return nil, err
+			}
+
+			if _, err := updateBuilder.Save(ctx); err != nil {
+				return nil, errors.Wrap(err)
+			}
+
+			res, err := getCustomObjectQuery(ctx, tx, id)
+			if err != nil {
+				return nil, err
+			}
+			if err := validateCustomObjectBeforeCommit(res); err != nil {
+				return nil, err
+			}
+			return util.WrapResource(entCustomObjectResourceToProtoCustomObjectResource(res))
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (is *InvStore) DeleteCustomObject(ctx context.Context, id string) (*inv_v1.Resource, error) {
+	// this is a "Hard Delete" as custom objects don't have state to reconcile
+	zlog.Debug().Msgf("DeleteCustomObject Hard Delete: %s", id)
+
+	res, err := ExecuteInTxAndReturnSingle[inv_v1.Resource](is)(ctx, deleteCustomObject(id))
+
+	return res, err
+}
+
+func deleteCustomObject(id string) func(ctx context.Context, tx *ent.Tx) (*inv_v1.Resource, error) {
+	return func(ctx context.Context, tx *ent.Tx) (*inv_v1.Resource, error) {
+		res, err := getCustomObjectQuery(ctx, tx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := tx.CustomObjectResource.DeleteOneID(res.ID).Exec(ctx); err != nil {
+			return nil, errors.Wrap(err)
+		}
+
+		return util.WrapResource(entCustomObjectResourceToProtoCustomObjectResource(res))
+	}
+}
+
+func (is *InvStore) DeleteCustomObjects(
+	ctx context.Context, tenantID string, _ bool,
+) ([]*util.Tuple[DeletionKind, *inv_v1.Resource], error) {
+	var deleted []*util.Tuple[DeletionKind, *inv_v1.Resource]
+	txErr := ExecuteInTx(is)(
+		ctx,
+		func(ctx context.Context, tx *ent.Tx) error {
+			collection, err := tx.CustomObjectResource.Query().
+				Where(customobjects.TenantID(tenantID)).
+				WithCustomType().
+				All(ctx)
+			if err != nil {
+				return err
+			}
+			if _, err := tx.CustomObjectResource.Delete().Where(customobjects.TenantID(tenantID)).Exec(ctx); err != nil {
+				return err
+			}
+			for _, element := range collection {
+				res, err := util.WrapResource(entCustomObjectResourceToProtoCustomObjectResource(element))
+				if err != nil {
+					return err
+				}
+				deleted = append(deleted, util.NewTuple(HARD, res))
+			}
+			return nil
+		})
+	return deleted, txErr
+}
+
+func (is *InvStore) ListCustomObjects(ctx context.Context, filter *inv_v1.ResourceFilter) (
+	[]*inv_v1.GetResourceResponse, int, error,
+) {
+	resources, total, err := ExecuteInRoTxAndReturnDouble[[]*ent.CustomObjectResource, int](is)(
+		ctx,
+		func(ctx context.Context, tx *ent.Tx) (*[]*ent.CustomObjectResource, *int, error) {
+			filtered, total, err := filterCustomObjects(ctx, tx.Client(), filter)
+			if err != nil {
+				return nil, nil, err
+			}
+			return &filtered, &total, err
+		},
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	resps := collections.MapSlice[*ent.CustomObjectResource, *inv_v1.GetResourceResponse](*resources,
+		func(res *ent.CustomObjectResource) *inv_v1.GetResourceResponse {
+			return &inv_v1.GetResourceResponse{
+				Resource: &inv_v1.Resource{
+					Resource: &inv_v1.Resource_CustomObject{
+						CustomObject: entCustomObjectResourceToProtoCustomObjectResource(res),
+					},
+				},
+			}
+		})
+	if err := collections.FirstError[*inv_v1.GetResourceResponse](resps, validateProto[*inv_v1.GetResourceResponse]); err != nil {
+		zlog.InfraSec().InfraErr(err).Msg("")
+		return nil, 0, errors.Wrap(err)
+	}
+
+	return resps, *total, nil
+}
+
+func filterCustomObjects(ctx context.Context, client *ent.Client, filter *inv_v1.ResourceFilter) (
+	[]*ent.CustomObjectResource, int, error,
+) {
+	pred, err := getPredicate(inv_v1.ResourceKind_RESOURCE_KIND_CUSTOMOBJECT, filter.GetFilter())
+	if err != nil {
+		return nil, 0, err
+	}
+
+	orderOpts, err := GetOrderByOptions[customobjects.OrderOption](filter.GetOrderBy(), customobjects.ValidColumn)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	offset, limit, err := getOffsetAndLimit(filter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// perform query - And together all the predicates
+	query := client.CustomObjectResource.Query().
+		WithCustomType().
+		WithHost().
+		WithSite().
+		WithRegion().
+		WithOu().
+		WithInstance().
+		Where(pred).
+		Order(orderOpts...).
+		Offset(offset)
+
+	// Limits number of query results if existent
+	if limit != 0 {
+		query = query.Limit(limit)
+	}
+
+	customObjects, err := query.All(ctx)
+	if err != nil {
+		return nil, 0, errors.Wrap(err)
+	}
+
+	// Count total number of item without applying pagination limits, order, or loading edges.
+	total, err := client.CustomObjectResource.Query().
+		Where(pred).
+		Count(ctx)
+	if err != nil {
+		return nil, 0, errors.Wrap(err)
+	}
+
+	return customObjects, total, nil
+}
+
+func (is *InvStore) FilterCustomObjects(ctx context.Context, filter *inv_v1.ResourceFilter) (
+	[]*cl.ResourceTenantIDCarrier, int, error,
+) {
+	resources, total, err := ExecuteInRoTxAndReturnDouble[[]*ent.CustomObjectResource, int](is)(
+		ctx, func(ctx context.Context, tx *ent.Tx) (*[]*ent.CustomObjectResource, *int, error) {
+			filtered, total, err := filterCustomObjects(ctx, tx.Client(), filter)
+			if err != nil {
+				return nil, nil, err
+			}
+			return &filtered, &total, nil
+		})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	ids := collections.MapSlice[*ent.CustomObjectResource, *cl.ResourceTenantIDCarrier](
+		*resources, func(c *ent.CustomObjectResource) *cl.ResourceTenantIDCarrier {
+			return &cl.ResourceTenantIDCarrier{TenantId: c.TenantID, ResourceId: c.ResourceID}
+		})
+
+	return ids, *total, err
+}
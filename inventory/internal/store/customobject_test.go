@@ -0,0 +1,309 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package store_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/customobjectresource"
+	customresourcev1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/customresource/v1"
+	inv_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/inventory/v1"
+	inv_testing "github.com/open-edge-platform/infra-core/inventory/v2/pkg/testing"
+)
+
+//nolint:funlen // length due to test cases
+func Test_Create_Get_Delete_CustomObject(t *testing.T) {
+	site := inv_testing.CreateSite(t, nil, nil)
+	region := inv_testing.CreateRegion(t, nil)
+	customType := inv_testing.CreateCustomType(t, "rack", testRackSchema, "site")
+
+	testcases := map[string]struct {
+		in    *customresourcev1.CustomObjectResource
+		valid bool
+	}{
+		"CreateGoodCustomObject": {
+			in: &customresourcev1.CustomObjectResource{
+				Name:       "rack-01",
+				CustomType: customType,
+				Spec:       `{"rack": {"units": 42, "vendor": "acme"}}`,
+				Site:       site,
+			},
+			valid: true,
+		},
+		"CreateGoodCustomObjectEmptySpec": {
+			in: &customresourcev1.CustomObjectResource{
+				CustomType: customType,
+			},
+			valid: true,
+		},
+		"CreateBadCustomObjectWithResourceIdSet": {
+			in: &customresourcev1.CustomObjectResource{
+				ResourceId: "customobject-12345678",
+				CustomType: customType,
+			},
+			valid: false,
+		},
+		"CreateBadCustomObjectWithNoType": {
+			in: &customresourcev1.CustomObjectResource{
+				Spec: `{"rack": {"units": 42}}`,
+			},
+			valid: false,
+		},
+		"CreateBadCustomObjectWithInvalidJSON": {
+			in: &customresourcev1.CustomObjectResource{
+				CustomType: customType,
+				Spec:       `{"rack": `,
+			},
+			valid: false,
+		},
+		"CreateBadCustomObjectNotConforming": {
+			in: &customresourcev1.CustomObjectResource{
+				CustomType: customType,
+				Spec:       `{"rack": {"units": "many"}}`,
+			},
+			valid: false,
+		},
+		"CreateBadCustomObjectWithNotAllowedEdge": {
+			in: &customresourcev1.CustomObjectResource{
+				CustomType: customType,
+				Spec:       `{"rack": {"units": 42}}`,
+				Region:     region,
+			},
+			valid: false,
+		},
+	}
+
+	for tcname, tc := range testcases {
+		t.Run(tcname, func(t *testing.T) {
+			createresreq := &inv_v1.Resource{
+				Resource: &inv_v1.Resource_CustomObject{CustomObject: tc.in},
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+
+			// create
+			createResp, err := inv_testing.TestClients[inv_testing.APIClient].Create(ctx, createresreq)
+			customObjectResID := createResp.GetCustomObject().GetResourceId()
+
+			if err != nil {
+				if tc.valid {
+					t.Errorf("CreateCustomObject() failed: %s", err)
+				}
+			} else {
+				// Edges are compared below, by resource ID.
+				assertSameResource(t, createresreq, createResp, &fieldmaskpb.FieldMask{Paths: []string{
+					customobjectresource.FieldName, customobjectresource.FieldSpec,
+				}})
+				if !tc.valid {
+					t.Errorf("CreateCustomObject() succeeded but should have failed")
+				}
+			}
+
+			// only get/delete if valid test and hasn't failed otherwise may segfault
+			if !t.Failed() && tc.valid {
+				getresp, err := inv_testing.TestClients[inv_testing.APIClient].Get(ctx, customObjectResID)
+				require.NoError(t, err, "GetCustomObject() failed")
+				assert.Equal(t, customType.GetResourceId(),
+					getresp.GetResource().GetCustomObject().GetCustomType().GetResourceId())
+				assert.Equal(t, tc.in.GetSite().GetResourceId(),
+					getresp.GetResource().GetCustomObject().GetSite().GetResourceId())
+
+				_, err = inv_testing.TestClients[inv_testing.APIClient].Delete(ctx, customObjectResID)
+				require.NoError(t, err, "DeleteCustomObject() failed")
+			}
+		})
+	}
+}
+
+//nolint:funlen // length due to test cases
+func Test_UpdateCustomObject(t *testing.T) {
+	site := inv_testing.CreateSite(t, nil, nil)
+	customType := inv_testing.CreateCustomType(t, "rack", testRackSchema, "site")
+	customObject := inv_testing.CreateCustomObject(t, customType, `{"rack": {"units": 42}}`)
+
+	testcases := map[string]struct {
+		in        *customresourcev1.CustomObjectResource
+		fieldMask *fieldmaskpb.FieldMask
+		code      codes.Code
+	}{
+		"UpdateSpec": {
+			in:        &customresourcev1.CustomObjectResource{Spec: `{"rack": {"units": 48}}`},
+			fieldMask: &fieldmaskpb.FieldMask{Paths: []string{customobjectresource.FieldSpec}},
+			code:      codes.OK,
+		},
+		"UpdateSpecNotConforming": {
+			in:        &customresourcev1.CustomObjectResource{Spec: `{"rack": {"units": 0}}`},
+			fieldMask: &fieldmaskpb.FieldMask{Paths: []string{customobjectresource.FieldSpec}},
+			code:      codes.InvalidArgument,
+		},
+		"UpdateAllowedEdge": {
+			in:        &customresourcev1.CustomObjectResource{Site: site},
+			fieldMask: &fieldmaskpb.FieldMask{Paths: []string{customobjectresource.EdgeSite}},
+			code:      codes.OK,
+		},
+		"UpdateNotAllowedEdge": {
+			in: &customresourcev1.CustomObjectResource{
+				Region: inv_testing.CreateRegion(t, nil),
+			},
+			fieldMask: &fieldmaskpb.FieldMask{Paths: []string{customobjectresource.EdgeRegion}},
+			code:      codes.InvalidArgument,
+		},
+		"UpdateClearCustomType": {
+			in:        &customresourcev1.CustomObjectResource{},
+			fieldMask: &fieldmaskpb.FieldMask{Paths: []string{customobjectresource.EdgeCustomType}},
+			code:      codes.InvalidArgument,
+		},
+	}
+
+	for tcname, tc := range testcases {
+		t.Run(tcname, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+
+			upRes, err := inv_testing.TestClients[inv_testing.APIClient].Update(
+				ctx,
+				customObject.GetResourceId(),
+				tc.fieldMask,
+				&inv_v1.Resource{Resource: &inv_v1.Resource_CustomObject{CustomObject: tc.in}},
+			)
+			if tc.code != codes.OK {
+				require.Error(t, err, "UpdateCustomObject() succeeded but should have failed")
+				assert.Equal(t, tc.code, status.Code(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.NotNil(t, upRes)
+		})
+	}
+}
+
+//nolint:funlen // length due to test cases
+func Test_FilterCustomObjects(t *testing.T) {
+	site := inv_testing.CreateSite(t, nil, nil)
+	rackType := inv_testing.CreateCustomType(t, "rack", testRackSchema, "site")
+	cameraType := inv_testing.CreateCustomType(t, "camera", `{}`)
+
+	rack1 := inv_testing.CreateCustomObject(t, rackType, `{"rack": {"units": 42, "vendor": "acme", "powered": true}}`,
+		inv_testing.CustomObjectSite(site))
+	rack2 := inv_testing.CreateCustomObject(t, rackType, `{"rack": {"units": 12, "vendor": "other"}}`)
+	camera := inv_testing.CreateCustomObject(t, cameraType, `{"resolutionX": 1920, "rack": {"units": "42"}}`)
+
+	testcases := map[string]struct {
+		filter    string
+		resources []*customresourcev1.CustomObjectResource
+		code      codes.Code
+	}{
+		"NoFilter": {
+			resources: []*customresourcev1.CustomObjectResource{rack1, rack2, camera},
+		},
+		"FilterByType": {
+			filter:    `custom_type.name = "rack"`,
+			resources: []*customresourcev1.CustomObjectResource{rack1, rack2},
+		},
+		"FilterBySite": {
+			filter:    `site.resource_id = "` + site.GetResourceId() + `"`,
+			resources: []*customresourcev1.CustomObjectResource{rack1},
+		},
+		"FilterByNumber": {
+			// The string "42" of the camera does not match.
+			filter:    `spec.rack.units >= 42`,
+			resources: []*customresourcev1.CustomObjectResource{rack1},
+		},
+		"FilterByNumberEquality": {
+			filter:    `spec.rack.units = 12`,
+			resources: []*customresourcev1.CustomObjectResource{rack2},
+		},
+		"FilterByString": {
+			filter:    `spec.rack.vendor = "ACME"`,
+			resources: []*customresourcev1.CustomObjectResource{rack1},
+		},
+		"FilterByBool": {
+			filter:    `spec.rack.powered = true`,
+			resources: []*customresourcev1.CustomObjectResource{rack1},
+		},
+		"FilterByNull": {
+			filter:    `spec.rack.vendor = null`,
+			resources: []*customresourcev1.CustomObjectResource{camera},
+		},
+		"FilterByCaseSensitiveKey": {
+			filter:    `spec.resolutionX > 1000`,
+			resources: []*customresourcev1.CustomObjectResource{camera},
+		},
+		"FilterByKeyNotNormalized": {
+			filter:    `spec.resolution_x > 1000`,
+			resources: []*customresourcev1.CustomObjectResource{},
+		},
+		"FilterCombined": {
+			filter:    `custom_type.name = "rack" AND spec.rack.units < 42`,
+			resources: []*customresourcev1.CustomObjectResource{rack2},
+		},
+		"FilterIntoNonJSONField": {
+			filter: `name.foo = 1`,
+			code:   codes.InvalidArgument,
+		},
+	}
+
+	for tcname, tc := range testcases {
+		t.Run(tcname, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+
+			filter := &inv_v1.ResourceFilter{
+				Resource: &inv_v1.Resource{
+					Resource: &inv_v1.Resource_CustomObject{},
+				},
+				Filter: tc.filter,
+			}
+			findres, err := inv_testing.TestClients[inv_testing.APIClient].Find(ctx, filter)
+			if tc.code != codes.OK {
+				require.Error(t, err)
+				assert.Equal(t, tc.code, status.Code(err))
+				return
+			}
+			require.NoError(t, err)
+
+			resIDs := make([]string, 0, len(tc.resources))
+			for _, res := range tc.resources {
+				resIDs = append(resIDs, res.GetResourceId())
+			}
+			foundIDs := make([]string, 0, len(findres.GetResources()))
+			for _, res := range findres.GetResources() {
+				foundIDs = append(foundIDs, res.GetResourceId())
+			}
+			assert.ElementsMatch(t, resIDs, foundIDs)
+
+			listres, err := inv_testing.TestClients[inv_testing.APIClient].List(ctx, filter)
+			require.NoError(t, err)
+			assert.Len(t, listres.GetResources(), len(tc.resources))
+			for _, res := range listres.GetResources() {
+				assert.NotNil(t, res.GetResource().GetCustomObject().GetCustomType())
+			}
+		})
+	}
+}
+
+func Test_CustomObjectSiteDeletion(t *testing.T) {
+	site := inv_testing.CreateSiteNoCleanup(t, nil, nil)
+	customType := inv_testing.CreateCustomType(t, "rack", testRackSchema, "site")
+	customObject := inv_testing.CreateCustomObject(t, customType, `{"rack": {"units": 42}}`,
+		inv_testing.CustomObjectSite(site))
+
+	inv_testing.DeleteResource(t, site.GetResourceId())
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	getresp, err := inv_testing.TestClients[inv_testing.APIClient].Get(ctx, customObject.GetResourceId())
+	require.NoError(t, err)
+	assert.Nil(t, getresp.GetResource().GetCustomObject().GetSite(), "edge to deleted site should be cleared")
+}
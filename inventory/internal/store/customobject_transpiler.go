@@ -0,0 +1,59 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by protoc-gen-go-filters, DO NOT EDIT.
+
+package store
+
+import (
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/customobjectresource"
+	inv_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/inventory/v1"
+)
+
+func (r *registry) RegisterCustomObjectResource() {
+	r.Register(
+		newResourceTranspiler(
+			inv_v1.ResourceKind_RESOURCE_KIND_CUSTOMOBJECT,
+			customobjectresource.ValidColumn,
+			map[string]edgeHandler{
+				customobjectresource.EdgeCustomType: {
+					func(p sqlPredicate) sqlPredicate { return customobjectresource.HasCustomTypeWith(p) },
+					inv_v1.ResourceKind_RESOURCE_KIND_CUSTOMTYPE,
+				},
+
+				customobjectresource.EdgeHost: {
+					func(p sqlPredicate) sqlPredicate { return customobjectresource.HasHostWith(p) },
+					inv_v1.ResourceKind_RESOURCE_KIND_HOST,
+				},
+
+				customobjectresource.EdgeInstance: {
+					func(p sqlPredicate) sqlPredicate { return customobjectresource.HasInstanceWith(p) },
+					inv_v1.ResourceKind_RESOURCE_KIND_INSTANCE,
+				},
+
+				customobjectresource.EdgeOu: {
+					func(p sqlPredicate) sqlPredicate { return customobjectresource.HasOuWith(p) },
+					inv_v1.ResourceKind_RESOURCE_KIND_OU,
+				},
+
+				customobjectresource.EdgeRegion: {
+					func(p sqlPredicate) sqlPredicate { return customobjectresource.HasRegionWith(p) },
+					inv_v1.ResourceKind_RESOURCE_KIND_REGION,
+				},
+
+				customobjectresource.EdgeSite: {
+					func(p sqlPredicate) sqlPredicate { return customobjectresource.HasSiteWith(p) },
+					inv_v1.ResourceKind_RESOURCE_KIND_SITE,
+				},
+			},
+			map[string]sqlPredicate{
+				customobjectresource.EdgeCustomType: customobjectresource.HasCustomType(),
+				customobjectresource.EdgeHost:       customobjectresource.HasHost(),
+				customobjectresource.EdgeInstance:   customobjectresource.HasInstance(),
+				customobjectresource.EdgeOu:         customobjectresource.HasOu(),
+				customobjectresource.EdgeRegion:     customobjectresource.HasRegion(),
+				customobjectresource.EdgeSite:       customobjectresource.HasSite(),
+			},
+		))
+
+}
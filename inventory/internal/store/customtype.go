@@ -0,0 +1,415 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package store
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/santhosh-tekuri/jsonschema/v6"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent"
+	customobjects "github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/customobjectresource"
+	customtypes "github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/customtyperesource"
+	customresourcev1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/customresource/v1"
+	inv_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/inventory/v1"
+	cl "github.com/open-edge-platform/infra-core/inventory/v2/pkg/client"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/logging"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/util"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/util/collections"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/validator"
+)
+
+func init() {
+	RegisterResourceHandler(ResourceHandler{
+		Kind:      inv_v1.ResourceKind_RESOURCE_KIND_CUSTOMTYPE,
+		Create:    createAs((*InvStore).CreateCustomType),
+		Get:       getByID((*InvStore).GetCustomType),
+		Update:    updateAs((*InvStore).UpdateCustomType),
+		Delete:    deleteByID((*InvStore).DeleteCustomType),
+		DeleteAll: (*InvStore).DeleteCustomTypes,
+		List:      (*InvStore).ListCustomTypes,
+		Filter:    (*InvStore).FilterCustomTypes,
+	})
+}
+
+// customTypeSchemaURL is the location the JSON schema of a custom type is compiled at. The document is added to the
+// compiler in memory, nothing is read from this location.
+const customTypeSchemaURL = "customtype.json"
+
+var customTypeResourceCreationValidators = []resourceValidator[*customresourcev1.CustomTypeResource]{
+	protoValidator[*customresourcev1.CustomTypeResource],
+	doNotAcceptResourceID[*customresourcev1.CustomTypeResource],
+	validateCustomTypeSchema,
+}
+
+// compileCustomTypeSchema compiles the JSON schema of a custom type. References to external documents are not
+// resolved: the schemas are tenant provided and must not make the inventory read local files or reach remote hosts.
+func compileCustomTypeSchema(jsonSchema string) (*jsonschema.Schema, error) {
+	doc, err := jsonschema.UnmarshalJSON(strings.NewReader(jsonSchema))
+	if err != nil {
+		zlog.InfraSec().InfraErr(err).Msg("json_schema is not a valid JSON document")
+		return nil, errors.Errorfc(codes.InvalidArgument, "json_schema is not a valid JSON document: %v", err)
+	}
+	compiler := jsonschema.NewCompiler()
+	compiler.UseLoader(nil)
+	if err := compiler.AddResource(customTypeSchemaURL, doc); err != nil {
+		zlog.InfraSec().InfraErr(err).Msg("")
+		return nil, errors.Errorfc(codes.InvalidArgument, "invalid json_schema: %v", err)
+	}
+	schema, err := compiler.Compile(customTypeSchemaURL)
+	if err != nil {
+		zlog.InfraSec().InfraErr(err).Msg("json_schema is not a valid JSON schema")
+		return nil, errors.Errorfc(codes.InvalidArgument, "json_schema is not a valid JSON schema: %v", err)
+	}
+	return schema, nil
+}
+
+func validateCustomTypeSchema(in *customresourcev1.CustomTypeResource) error {
+	_, err := compileCustomTypeSchema(in.GetJsonSchema())
+	return err
+}
+
+func (is *InvStore) CreateCustomType(
+	ctx context.Context, in *customresourcev1.CustomTypeResource,
+) (*inv_v1.Resource, error) {
+	if err := validate(in, customTypeResourceCreationValidators...); err != nil {
+		return nil, err
+	}
+
+	res, err := ExecuteInTxAndReturnSingle[inv_v1.Resource](is)(ctx, customTypeResourceCreator(in))
+	if err != nil {
+		return nil, err
+	}
+
+	zlog.Debug().Msgf("CustomType Created: %s, %s", res.GetCustomType().GetResourceId(), logging.Redact(res))
+	return res, nil
+}
+
+func customTypeResourceCreator(in *customresourcev1.CustomTypeResource) func(context.Context, *ent.Tx) (
+	*inv_v1.Resource, error) {
+	return func(ctx context.Context, tx *ent.Tx) (*inv_v1.Resource, error) {
+		id := util.NewInvID(inv_v1.ResourceKind_RESOURCE_KIND_CUSTOMTYPE)
+		zlog.Debug().Msgf("CreateCustomType: %s", id)
+
+		newEntity := tx.CustomTypeResource.Create()
+		mut := newEntity.Mutation()
+
+		if err := buildEntMutate(in, mut, EmptyEnumStateMap, nil); err != nil {
+			return nil, err
+		}
+
+		if err := mut.SetField(customtypes.FieldResourceID, id); err != nil {
+			return nil, errors.Wrap(err)
+		}
+
+		_, err := newEntity.Save(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err)
+		}
+
+		res, err := getCustomTypeQuery(ctx, tx, id)
+		if err != nil {
+			return nil, err
+		}
+		return util.WrapResource(entCustomTypeResourceToProtoCustomTypeResource(res))
+	}
+}
+
+func getCustomTypeQuery(ctx context.Context, tx *ent.Tx, resourceID string) (*ent.CustomTypeResource, error) {
+	entity, err := tx.CustomTypeResource.Query().
+		Where(customtypes.ResourceID(resourceID)).
+		Only(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	return entity, nil
+}
+
+func (is *InvStore) GetCustomType(ctx context.Context, id string) (*inv_v1.Resource, error) {
+	res, err := ExecuteInRoTxAndReturnSingle[ent.CustomTypeResource](is)(
+		ctx,
+		func(ctx context.Context, tx *ent.Tx) (*ent.CustomTypeResource, error) {
+			return getCustomTypeQuery(ctx, tx, id)
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	apiResource := entCustomTypeResourceToProtoCustomTypeResource(res)
+	if err = validator.ValidateMessage(apiResource); err != nil {
+		zlog.InfraSec().InfraErr(err).Msg("")
+		return nil, errors.Wrap(err)
+	}
+
+	return &inv_v1.Resource{Resource: &inv_v1.Resource_CustomType{CustomType: apiResource}}, nil
+}
+
+func (is *InvStore) UpdateCustomType(
+	ctx context.Context, id string, in *customresourcev1.CustomTypeResource, fieldmask *fieldmaskpb.FieldMask,
+) (*inv_v1.Resource, error) {
+	zlog.Debug().Msgf("UpdateCustomType (%s): %v, fm: %v", id, logging.Redact(in), fieldmask)
+
+	res, err := ExecuteInTxAndReturnSingle[inv_v1.Resource](is)(ctx,
+		func(ctx context.Context, tx *ent.Tx) (*inv_v1.Resource, error) {
+			entity, err := tx.CustomTypeResource.Query().
+				Select(customtypes.FieldID).
+				Where(customtypes.ResourceID(id)).
+				Only(ctx)
+			if err != nil {
+				return nil, errors.Wrap(err)
+			}
+
+			updateBuilder := tx.CustomTypeResource.UpdateOneID(entity.ID)
+			mut := updateBuilder.Mutation()
+
+			if err := buildEntMutate(in, mut, EmptyEnumStateMap, fieldmask.GetPaths()); err != nil {
+				return nil, err
+			}
+
+			if _, err := updateBuilder.Save(ctx); err != nil {
+				return nil, errors.Wrap(err)
+			}
+
+			res, err := getCustomTypeQuery(ctx, tx, id)
+			if err != nil {
+				return nil, err
+			}
+
+			// Existing objects must keep conforming to their type.
+			if slices.Contains(fieldmask.GetPaths(), customtypes.FieldJSONSchema) ||
+				slices.Contains(fieldmask.GetPaths(), customtypes.FieldAllowedEdges) {
+				if err := validateCustomObjectsOfType(ctx, tx, res); err != nil {
+					return nil, err
+				}
+			}
+			return util.WrapResource(entCustomTypeResourceToProtoCustomTypeResource(res))
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// validateCustomObjectsOfType checks that all the custom objects of the given (updated) type conform to it.
+func validateCustomObjectsOfType(ctx context.Context, tx *ent.Tx, customType *ent.CustomTypeResource) error {
+	schema, err := compileCustomTypeSchema(customType.JSONSchema)
+	if err != nil {
+		return err
+	}
+
+	objects, err := tx.CustomObjectResource.Query().
+		Where(customobjects.HasCustomTypeWith(customtypes.ID(customType.ID))).
+		WithHost().
+		WithSite().
+		WithRegion().
+		WithOu().
+		WithInstance().
+		All(ctx)
+	if err != nil {
+		return errors.Wrap(err)
+	}
+	for _, object := range objects {
+		if err := checkCustomObjectConformance(object, customType, schema); err != nil {
+			zlog.InfraSec().InfraErr(err).Msgf("custom object %s does not conform to the updated custom type %s",
+				object.ResourceID, customType.ResourceID)
+			return errors.Errorfc(codes.FailedPrecondition,
+				"custom object %s does not conform to the updated custom type: %v", object.ResourceID, err)
+		}
+	}
+	return nil
+}
+
+func (is *InvStore) DeleteCustomType(ctx context.Context, id string) (*inv_v1.Resource, error) {
+	zlog.Debug().Msgf("DeleteCustomType Delete: %s", id)
+
+	res, err := ExecuteInTxAndReturnSingle[inv_v1.Resource](is)(ctx, deleteCustomType(id))
+
+	return res, err
+}
+
+func deleteCustomType(id string) func(ctx context.Context, tx *ent.Tx) (*inv_v1.Resource, error) {
+	return func(ctx context.Context, tx *ent.Tx) (*inv_v1.Resource, error) {
+		entity, qerr := tx.CustomTypeResource.Query().
+			Where(customtypes.ResourceID(id)).
+			Only(ctx)
+		if qerr != nil {
+			return nil, errors.Wrap(qerr)
+		}
+
+		// Error is already wrapped
+		if err := verifyCustomTypeStrongRelations(ctx, tx, id); err != nil {
+			return nil, err
+		}
+
+		if err := tx.CustomTypeResource.DeleteOneID(entity.ID).Exec(ctx); err != nil {
+			return nil, errors.Wrap(err)
+		}
+
+		return util.WrapResource(entCustomTypeResourceToProtoCustomTypeResource(entity))
+	}
+}
+
+func verifyCustomTypeStrongRelations(ctx context.Context, tx *ent.Tx, id string) error {
+	exist, err := tx.CustomObjectResource.Query().
+		Where(customobjects.HasCustomTypeWith(customtypes.ResourceID(id))).
+		Exist(ctx)
+	if err != nil {
+		return errors.Wrap(err)
+	}
+
+	if exist {
+		zlog.InfraSec().InfraError("the CustomType has custom objects and cannot be deleted").Msg("")
+		return errors.Errorfc(codes.FailedPrecondition,
+			"the CustomType has custom objects and cannot be deleted")
+	}
+
+	return nil
+}
+
+func (is *InvStore) DeleteCustomTypes(
+	ctx context.Context, tenantID string, _ bool,
+) ([]*util.Tuple[DeletionKind, *inv_v1.Resource], error) {
+	var deleted []*util.Tuple[DeletionKind, *inv_v1.Resource]
+	txErr := ExecuteInTx(is)(
+		ctx,
+		func(ctx context.Context, tx *ent.Tx) error {
+			collection, err := tx.CustomTypeResource.Query().Where(customtypes.TenantID(tenantID)).All(ctx)
+			if err != nil {
+				return err
+			}
+			if _, err := tx.CustomTypeResource.Delete().Where(customtypes.TenantID(tenantID)).Exec(ctx); err != nil {
+				return err
+			}
+			for _, element := range collection {
+				res, err := util.WrapResource(entCustomTypeResourceToProtoCustomTypeResource(element))
+				if err != nil {
+					return err
+				}
+				deleted = append(deleted, util.NewTuple(HARD, res))
+			}
+			return nil
+		})
+	return deleted, txErr
+}
+
+func (is *InvStore) ListCustomTypes(ctx context.Context, filter *inv_v1.ResourceFilter) (
+	[]*inv_v1.GetResourceResponse, int, error,
+) {
+	resources, total, err := ExecuteInRoTxAndReturnDouble[[]*ent.CustomTypeResource, int](is)(
+		ctx,
+		func(ctx context.Context, tx *ent.Tx) (*[]*ent.CustomTypeResource, *int, error) {
+			filtered, total, err := filterCustomTypes(ctx, tx.Client(), filter)
+			if err != nil {
+				return nil, nil, err
+			}
+			return &filtered, &total, err
+		},
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	resps := collections.MapSlice[*ent.CustomTypeResource, *inv_v1.GetResourceResponse](*resources,
+		func(res *ent.CustomTypeResource) *inv_v1.GetResourceResponse {
+			return &inv_v1.GetResourceResponse{
+				Resource: &inv_v1.Resource{
+					Resource: &inv_v1.Resource_CustomType{
+						CustomType: entCustomTypeResourceToProtoCustomTypeResource(res),
+					},
+				},
+			}
+		})
+	if err := collections.FirstError[*inv_v1.GetResourceResponse](resps, validateProto[*inv_v1.GetResourceResponse]); err != nil {
+		zlog.InfraSec().InfraErr(err).Msg("")
+		return nil, 0, errors.Wrap(err)
+	}
+
+	return resps, *total, nil
+}
+
+func filterCustomTypes(ctx context.Context, client *ent.Client, filter *inv_v1.ResourceFilter) (
+	[]*ent.CustomTypeResource, int, error,
+) {
+	pred, err := getPredicate(inv_v1.ResourceKind_RESOURCE_KIND_CUSTOMTYPE, filter.GetFilter())
+	if err != nil {
+		return nil, 0, err
+	}
+
+	orderOpts, err := GetOrderByOptions[customtypes.OrderOption](filter.GetOrderBy(), customtypes.ValidColumn)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	offset, limit, err := getOffsetAndLimit(filter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// perform query - And together all the predicates
+	query := client.CustomTypeResource.Query().
+		Where(pred).
+		Order(orderOpts...).
+		Offset(offset)
+
+	// Limits number of query results if existent
+	if limit != 0 {
+		query = query.Limit(limit)
+	}
+
+	customTypes, err := query.All(ctx)
+	if err != nil {
+		return nil, 0, errors.Wrap(err)
+	}
+
+	// Count total number of item without applying pagination limits, order, or loading edges.
+	total, err := client.CustomTypeResource.Query().
+		Where(pred).
+		Count(ctx)
+	if err != nil {
+		return nil, 0, errors.Wrap(err)
+	}
+
+	return customTypes, total, nil
+}
+
+func (is *InvStore) FilterCustomTypes(ctx context.Context, filter *inv_v1.ResourceFilter) (
+	[]*cl.ResourceTenantIDCarrier, int, error,
+) {
+	resources, total, err := ExecuteInRoTxAndReturnDouble[[]*ent.CustomTypeResource, int](is)(
+		ctx, func(ctx context.Context, tx *ent.Tx) (*[]*ent.CustomTypeResource, *int, error) {
+			filtered, total, err := filterCustomTypes(ctx, tx.Client(), filter)
+			if err != nil {
+				return nil, nil, err
+			}
+			return &filtered, &total, nil
+		})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	ids := collections.MapSlice[*ent.CustomTypeResource, *cl.ResourceTenantIDCarrier](
+		*resources, func(c *ent.CustomTypeResource) *cl.ResourceTenantIDCarrier {
+			return &cl.ResourceTenantIDCarrier{TenantId: c.TenantID, ResourceId: c.ResourceID}
+		})
+
+	return ids, *total, err
+}
+
+func getCustomTypeIDFromResourceID(
+	ctx context.Context, client *ent.Client, customTypeRes *customresourcev1.CustomTypeResource,
+) (int, error) {
+	customType, qerr := client.CustomTypeResource.Query().
+		Where(customtypes.ResourceID(customTypeRes.ResourceId)).
+		Only(ctx)
+	if qerr != nil {
+		return 0, errors.Wrap(qerr)
+	}
+	return customType.ID, nil
+}
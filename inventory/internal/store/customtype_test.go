@@ -0,0 +1,268 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package store_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/customtyperesource"
+	customresourcev1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/customresource/v1"
+	inv_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/inventory/v1"
+	inv_testing "github.com/open-edge-platform/infra-core/inventory/v2/pkg/testing"
+)
+
+var testRackSchema = `{
+  "type": "object",
+  "properties": {
+    "rack": {
+      "type": "object",
+      "properties": {
+        "units": {"type": "integer", "minimum": 1},
+        "vendor": {"type": "string"},
+        "powered": {"type": "boolean"}
+      },
+      "required": ["units"]
+    }
+  }
+}`
+
+//nolint:funlen // length due to test cases
+func Test_Create_Get_Delete_CustomType(t *testing.T) {
+	testcases := map[string]struct {
+		in    *customresourcev1.CustomTypeResource
+		valid bool
+	}{
+		"CreateGoodCustomType": {
+			in: &customresourcev1.CustomTypeResource{
+				Name:         "rack",
+				Description:  "Server rack",
+				JsonSchema:   testRackSchema,
+				AllowedEdges: []string{"site", "region"},
+			},
+			valid: true,
+		},
+		"CreateGoodCustomTypeNoEdges": {
+			in: &customresourcev1.CustomTypeResource{
+				Name:       "camera",
+				JsonSchema: `{}`,
+			},
+			valid: true,
+		},
+		"CreateBadCustomTypeWithResourceIdSet": {
+			in: &customresourcev1.CustomTypeResource{
+				ResourceId: "customtype-12345678",
+				Name:       "rack",
+				JsonSchema: testRackSchema,
+			},
+			valid: false,
+		},
+		"CreateBadCustomTypeWithNoName": {
+			in: &customresourcev1.CustomTypeResource{
+				JsonSchema: testRackSchema,
+			},
+			valid: false,
+		},
+		"CreateBadCustomTypeWithInvalidName": {
+			in: &customresourcev1.CustomTypeResource{
+				Name:       "Rack Type",
+				JsonSchema: testRackSchema,
+			},
+			valid: false,
+		},
+		"CreateBadCustomTypeWithNoSchema": {
+			in: &customresourcev1.CustomTypeResource{
+				Name: "rack",
+			},
+			valid: false,
+		},
+		"CreateBadCustomTypeWithInvalidJSON": {
+			in: &customresourcev1.CustomTypeResource{
+				Name:       "rack",
+				JsonSchema: `{"type": "object"`,
+			},
+			valid: false,
+		},
+		"CreateBadCustomTypeWithInvalidSchema": {
+			in: &customresourcev1.CustomTypeResource{
+				Name:       "rack",
+				JsonSchema: `{"type": 5}`,
+			},
+			valid: false,
+		},
+		"CreateBadCustomTypeWithExternalRef": {
+			in: &customresourcev1.CustomTypeResource{
+				Name:       "rack",
+				JsonSchema: `{"$ref": "file:///etc/passwd"}`,
+			},
+			valid: false,
+		},
+		"CreateBadCustomTypeWithUnknownEdge": {
+			in: &customresourcev1.CustomTypeResource{
+				Name:         "rack",
+				JsonSchema:   testRackSchema,
+				AllowedEdges: []string{"provider"},
+			},
+			valid: false,
+		},
+		"CreateBadCustomTypeWithDuplicatedEdges": {
+			in: &customresourcev1.CustomTypeResource{
+				Name:         "rack",
+				JsonSchema:   testRackSchema,
+				AllowedEdges: []string{"site", "site"},
+			},
+			valid: false,
+		},
+	}
+
+	for tcname, tc := range testcases {
+		t.Run(tcname, func(t *testing.T) {
+			createresreq := &inv_v1.Resource{
+				Resource: &inv_v1.Resource_CustomType{CustomType: tc.in},
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+
+			// create
+			createResp, err := inv_testing.TestClients[inv_testing.APIClient].Create(ctx, createresreq)
+			customTypeResID := createResp.GetCustomType().GetResourceId()
+
+			if err != nil {
+				if tc.valid {
+					t.Errorf("CreateCustomType() failed: %s", err)
+				}
+			} else {
+				tc.in.ResourceId = customTypeResID // Update with created resource ID.
+				tc.in.CreatedAt = createResp.GetCustomType().GetCreatedAt()
+				tc.in.UpdatedAt = createResp.GetCustomType().GetUpdatedAt()
+				assertSameResource(t, createresreq, createResp, nil)
+				if !tc.valid {
+					t.Errorf("CreateCustomType() succeeded but should have failed")
+				}
+			}
+
+			// only get/delete if valid test and hasn't failed otherwise may segfault
+			if !t.Failed() && tc.valid {
+				getresp, err := inv_testing.TestClients[inv_testing.APIClient].Get(ctx, customTypeResID)
+				require.NoError(t, err, "GetCustomType() failed")
+
+				if eq, diff := inv_testing.ProtoEqualOrDiff(tc.in, getresp.GetResource().GetCustomType()); !eq {
+					t.Errorf("GetCustomType() data not equal: %v", diff)
+				}
+
+				_, err = inv_testing.TestClients[inv_testing.APIClient].Delete(ctx, customTypeResID)
+				require.NoError(t, err, "DeleteCustomType() failed")
+			}
+		})
+	}
+}
+
+func Test_Unique_CustomType_On_Create(t *testing.T) {
+	inv_testing.CreateCustomType(t, "rack", testRackSchema)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	_, err := inv_testing.TestClients[inv_testing.APIClient].Create(ctx, &inv_v1.Resource{
+		Resource: &inv_v1.Resource_CustomType{CustomType: &customresourcev1.CustomTypeResource{
+			Name:       "rack",
+			JsonSchema: `{}`,
+		}},
+	})
+	require.Error(t, err, "CreateCustomType() with the same name should fail")
+}
+
+//nolint:funlen // length due to test cases
+func Test_UpdateCustomType(t *testing.T) {
+	site := inv_testing.CreateSite(t, nil, nil)
+	customType := inv_testing.CreateCustomType(t, "rack", testRackSchema, "site", "region")
+	inv_testing.CreateCustomObject(t, customType, `{"rack": {"units": 42}}`, inv_testing.CustomObjectSite(site))
+
+	testcases := map[string]struct {
+		in        *customresourcev1.CustomTypeResource
+		fieldMask *fieldmaskpb.FieldMask
+		code      codes.Code
+	}{
+		"UpdateDescription": {
+			in:        &customresourcev1.CustomTypeResource{Description: "Rack of servers"},
+			fieldMask: &fieldmaskpb.FieldMask{Paths: []string{customtyperesource.FieldDescription}},
+			code:      codes.OK,
+		},
+		"UpdateCompatibleSchema": {
+			in: &customresourcev1.CustomTypeResource{
+				JsonSchema: `{"type": "object", "properties": {"rack": {"type": "object"}}}`,
+			},
+			fieldMask: &fieldmaskpb.FieldMask{Paths: []string{customtyperesource.FieldJSONSchema}},
+			code:      codes.OK,
+		},
+		"UpdateIncompatibleSchema": {
+			in: &customresourcev1.CustomTypeResource{
+				JsonSchema: `{"type": "object", "required": ["camera"]}`,
+			},
+			fieldMask: &fieldmaskpb.FieldMask{Paths: []string{customtyperesource.FieldJSONSchema}},
+			code:      codes.FailedPrecondition,
+		},
+		"UpdateInvalidSchema": {
+			in:        &customresourcev1.CustomTypeResource{JsonSchema: `{"type": 5}`},
+			fieldMask: &fieldmaskpb.FieldMask{Paths: []string{customtyperesource.FieldJSONSchema}},
+			code:      codes.InvalidArgument,
+		},
+		"UpdateRemoveUnusedEdge": {
+			in:        &customresourcev1.CustomTypeResource{AllowedEdges: []string{"site"}},
+			fieldMask: &fieldmaskpb.FieldMask{Paths: []string{customtyperesource.FieldAllowedEdges}},
+			code:      codes.OK,
+		},
+		"UpdateRemoveUsedEdge": {
+			in:        &customresourcev1.CustomTypeResource{AllowedEdges: []string{"region"}},
+			fieldMask: &fieldmaskpb.FieldMask{Paths: []string{customtyperesource.FieldAllowedEdges}},
+			code:      codes.FailedPrecondition,
+		},
+		"UpdateImmutableName": {
+			in:        &customresourcev1.CustomTypeResource{Name: "camera"},
+			fieldMask: &fieldmaskpb.FieldMask{Paths: []string{customtyperesource.FieldName}},
+			code:      codes.InvalidArgument,
+		},
+	}
+
+	for tcname, tc := range testcases {
+		t.Run(tcname, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+
+			upRes, err := inv_testing.TestClients[inv_testing.APIClient].Update(
+				ctx,
+				customType.GetResourceId(),
+				tc.fieldMask,
+				&inv_v1.Resource{Resource: &inv_v1.Resource_CustomType{CustomType: tc.in}},
+			)
+			if tc.code != codes.OK {
+				require.Error(t, err, "UpdateCustomType() succeeded but should have failed")
+				assert.Equal(t, tc.code, status.Code(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.NotNil(t, upRes)
+		})
+	}
+}
+
+func Test_DeleteCustomTypeWithObjects(t *testing.T) {
+	customType := inv_testing.CreateCustomType(t, "rack", testRackSchema)
+	inv_testing.CreateCustomObject(t, customType, `{"rack": {"units": 42}}`)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	_, err := inv_testing.TestClients[inv_testing.APIClient].Delete(ctx, customType.GetResourceId())
+	require.Error(t, err, "DeleteCustomType() should fail while custom objects exist")
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
@@ -0,0 +1,585 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package store
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent"
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/hostresource"
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/instanceresource"
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/regionresource"
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/siteresource"
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/telemetrygroupresource"
+	telemetryprofileres "github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/telemetryprofile"
+	computev1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/compute/v1"
+	inv_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/inventory/v1"
+	location_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/location/v1"
+	telemetry_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/telemetry/v1"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
+)
+
+// effectivetelemetry.go resolves the effective telemetry configuration of a resource, where the most specific
+// telemetry profile of each telemetry group wins (instance > site > region child > region parent).
+
+// telemetryScope is a resource a telemetry profile can be attached to.
+type telemetryScope struct {
+	kind       inv_v1.ResourceKind
+	resourceID string
+}
+
+// getTelemetryProfileScope returns the resource the given telemetry profile is attached to.
+func getTelemetryProfileScope(profile *telemetry_v1.TelemetryProfile) telemetryScope {
+	switch relation := profile.GetRelation().(type) {
+	case *telemetry_v1.TelemetryProfile_Instance:
+		return telemetryScope{inv_v1.ResourceKind_RESOURCE_KIND_INSTANCE, relation.Instance.GetResourceId()}
+	case *telemetry_v1.TelemetryProfile_Site:
+		return telemetryScope{inv_v1.ResourceKind_RESOURCE_KIND_SITE, relation.Site.GetResourceId()}
+	case *telemetry_v1.TelemetryProfile_Region:
+		return telemetryScope{inv_v1.ResourceKind_RESOURCE_KIND_REGION, relation.Region.GetResourceId()}
+	}
+	return telemetryScope{}
+}
+
+// resolveEffectiveTelemetry returns the effective telemetry profiles given the chain of scopes of a resource, ordered
+// from the most specific to the least specific, and the telemetry profiles attached to those scopes.
+// For each telemetry group, only the profiles attached to the most specific scope win, all the others are reported as
+// overridden. Results are ordered by group resource ID and profile resource ID.
+func resolveEffectiveTelemetry(
+	scopes []telemetryScope,
+	profiles []*telemetry_v1.TelemetryProfile,
+) []*inv_v1.EffectiveTelemetryProfile {
+	profilesByScope := make(map[telemetryScope][]*telemetry_v1.TelemetryProfile)
+	for _, profile := range profiles {
+		scope := getTelemetryProfileScope(profile)
+		profilesByScope[scope] = append(profilesByScope[scope], profile)
+	}
+
+	winners := make(map[string][]*inv_v1.EffectiveTelemetryProfile)
+	for depth, scope := range scopes {
+		scopeProfiles := slices.Clone(profilesByScope[scope])
+		slices.SortFunc(scopeProfiles, func(a, b *telemetry_v1.TelemetryProfile) int {
+			return strings.Compare(a.GetResourceId(), b.GetResourceId())
+		})
+		winnersAtDepth := make(map[string]bool)
+		for _, profile := range scopeProfiles {
+			groupID := profile.GetGroup().GetResourceId()
+			groupWinners, found := winners[groupID]
+			if !found || winnersAtDepth[groupID] {
+				winnersAtDepth[groupID] = true
+				winners[groupID] = append(groupWinners, &inv_v1.EffectiveTelemetryProfile{
+					Profile:          profile,
+					SourceResourceId: scope.resourceID,
+					SourceKind:       scope.kind,
+					Depth:            int32(depth), //nolint:gosec // Depth is bounded by the max region depth.
+				})
+				continue
+			}
+			for _, winner := range groupWinners {
+				winner.OverriddenProfileIds = append(winner.OverriddenProfileIds, profile.GetResourceId())
+			}
+		}
+	}
+
+	groupIDs := make([]string, 0, len(winners))
+	for groupID := range winners {
+		groupIDs = append(groupIDs, groupID)
+	}
+	slices.Sort(groupIDs)
+	effective := make([]*inv_v1.EffectiveTelemetryProfile, 0)
+	for _, groupID := range groupIDs {
+		effective = append(effective, winners[groupID]...)
+	}
+	return effective
+}
+
+// getRegionScopes returns the scopes of the given region and of all its ancestors, ordered from the given region to
+// the root. Regions are retrieved level by level, the number of iterations is bounded by the max region depth.
+func getRegionScopes(ctx context.Context, client *ent.Client, tenantID string, regionIDs []int) (
+	map[int][]telemetryScope, error,
+) {
+	regions := make(map[int]*ent.RegionResource)
+	toFetch := slices.Clone(regionIDs)
+	for len(toFetch) > 0 {
+		fetched, err := client.RegionResource.Query().
+			Where(regionresource.IDIn(toFetch...), regionresource.TenantID(tenantID)).
+			WithParentRegion().
+			All(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err)
+		}
+		toFetch = nil
+		for _, region := range fetched {
+			regions[region.ID] = region
+			if parent := region.Edges.ParentRegion; parent != nil {
+				if _, ok := regions[parent.ID]; !ok && !slices.Contains(toFetch, parent.ID) {
+					toFetch = append(toFetch, parent.ID)
+				}
+			}
+		}
+	}
+
+	regionScopes := make(map[int][]telemetryScope, len(regionIDs))
+	for _, regionID := range regionIDs {
+		var scopes []telemetryScope
+		visited := make(map[int]bool)
+		for region, ok := regions[regionID]; ok && !visited[region.ID]; {
+			visited[region.ID] = true
+			scopes = append(scopes, telemetryScope{inv_v1.ResourceKind_RESOURCE_KIND_REGION, region.ResourceID})
+			if region.Edges.ParentRegion == nil {
+				break
+			}
+			region, ok = regions[region.Edges.ParentRegion.ID]
+		}
+		regionScopes[regionID] = scopes
+	}
+	return regionScopes, nil
+}
+
+// getSiteScopes returns the scopes of the given site, followed by the ones of its region hierarchy.
+func getSiteScopes(site *ent.SiteResource, regionScopes map[int][]telemetryScope) []telemetryScope {
+	scopes := []telemetryScope{{inv_v1.ResourceKind_RESOURCE_KIND_SITE, site.ResourceID}}
+	if region := site.Edges.Region; region != nil {
+		scopes = append(scopes, regionScopes[region.ID]...)
+	}
+	return scopes
+}
+
+// getInstancesTelemetryScopes returns, for each of the given instance resource IDs, the chain of scopes from the
+// instance to the root region. Returns NotFound if any of the instances does not exist.
+func getInstancesTelemetryScopes(ctx context.Context, client *ent.Client, tenantID string, instanceIDs []string) (
+	map[string][]telemetryScope, error,
+) {
+	instances, err := client.InstanceResource.Query().
+		Where(instanceresource.ResourceIDIn(instanceIDs...), instanceresource.TenantID(tenantID)).
+		WithHost(func(q *ent.HostResourceQuery) {
+			q.WithSite(func(q *ent.SiteResourceQuery) {
+				q.WithRegion()
+			})
+		}).
+		All(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	if len(instances) != len(instanceIDs) {
+		err = errors.Errorfc(codes.NotFound, "some of the requested instances do not exist")
+		zlog.InfraSec().InfraErr(err).Msgf("requested=%v", instanceIDs)
+		return nil, err
+	}
+
+	var regionIDs []int
+	for _, inst := range instances {
+		if region := getInstanceRegion(inst); region != nil {
+			regionIDs = append(regionIDs, region.ID)
+		}
+	}
+	regionScopes, err := getRegionScopes(ctx, client, tenantID, regionIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	scopes := make(map[string][]telemetryScope, len(instances))
+	for _, inst := range instances {
+		instScopes := []telemetryScope{{inv_v1.ResourceKind_RESOURCE_KIND_INSTANCE, inst.ResourceID}}
+		if host := inst.Edges.Host; host != nil && host.Edges.Site != nil {
+			instScopes = append(instScopes, getSiteScopes(host.Edges.Site, regionScopes)...)
+		}
+		scopes[inst.ResourceID] = instScopes
+	}
+	return scopes, nil
+}
+
+func getInstanceRegion(inst *ent.InstanceResource) *ent.RegionResource {
+	if host := inst.Edges.Host; host != nil && host.Edges.Site != nil {
+		return host.Edges.Site.Edges.Region
+	}
+	return nil
+}
+
+// getTelemetryScopesByInheritBy returns the chain of scopes of the given instance, site or region.
+// Returns an empty chain if the resource does not exist, to mirror the behavior of getInheritedTelemetry.
+func getTelemetryScopesByInheritBy(
+	ctx context.Context,
+	client *ent.Client,
+	inheritBy *inv_v1.ListInheritedTelemetryProfilesRequest_InheritBy,
+	tenantID string,
+) ([]telemetryScope, error) {
+	switch inheritBy.GetId().(type) {
+	case *inv_v1.ListInheritedTelemetryProfilesRequest_InheritBy_InstanceId:
+		scopes, err := getInstancesTelemetryScopes(ctx, client, tenantID, []string{inheritBy.GetInstanceId()})
+		if errors.IsNotFound(err) {
+			return nil, nil
+		}
+		return scopes[inheritBy.GetInstanceId()], err
+	case *inv_v1.ListInheritedTelemetryProfilesRequest_InheritBy_SiteId:
+		site, err := client.SiteResource.Query().
+			Where(siteresource.ResourceID(inheritBy.GetSiteId()), siteresource.TenantID(tenantID)).
+			WithRegion().
+			Only(ctx)
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, errors.Wrap(err)
+		}
+		var regionIDs []int
+		if site.Edges.Region != nil {
+			regionIDs = append(regionIDs, site.Edges.Region.ID)
+		}
+		regionScopes, err := getRegionScopes(ctx, client, tenantID, regionIDs)
+		if err != nil {
+			return nil, err
+		}
+		return getSiteScopes(site, regionScopes), nil
+	case *inv_v1.ListInheritedTelemetryProfilesRequest_InheritBy_RegionId:
+		region, err := client.RegionResource.Query().
+			Where(regionresource.ResourceID(inheritBy.GetRegionId()), regionresource.TenantID(tenantID)).
+			Only(ctx)
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, errors.Wrap(err)
+		}
+		regionScopes, err := getRegionScopes(ctx, client, tenantID, []int{region.ID})
+		if err != nil {
+			return nil, err
+		}
+		return regionScopes[region.ID], nil
+	}
+	return nil, nil
+}
+
+// getScopedTelemetryProfiles returns all the telemetry profiles attached to any of the given scopes.
+func getScopedTelemetryProfiles(ctx context.Context, client *ent.Client, tenantID string, scopes []telemetryScope) (
+	[]*telemetry_v1.TelemetryProfile, error,
+) {
+	var instanceIDs, siteIDs, regionIDs []string
+	for _, scope := range scopes {
+		switch scope.kind {
+		case inv_v1.ResourceKind_RESOURCE_KIND_INSTANCE:
+			instanceIDs = append(instanceIDs, scope.resourceID)
+		case inv_v1.ResourceKind_RESOURCE_KIND_SITE:
+			siteIDs = append(siteIDs, scope.resourceID)
+		case inv_v1.ResourceKind_RESOURCE_KIND_REGION:
+			regionIDs = append(regionIDs, scope.resourceID)
+		default:
+		}
+	}
+	entProfiles, err := client.TelemetryProfile.Query().
+		Where(
+			telemetryprofileres.TenantID(tenantID),
+			telemetryprofileres.Or(
+				telemetryprofileres.HasInstanceWith(instanceresource.ResourceIDIn(instanceIDs...)),
+				telemetryprofileres.HasSiteWith(siteresource.ResourceIDIn(siteIDs...)),
+				telemetryprofileres.HasRegionWith(regionresource.ResourceIDIn(regionIDs...)),
+			),
+		).
+		WithInstance().
+		WithSite().
+		WithRegion().
+		WithGroup().
+		All(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	profiles := make([]*telemetry_v1.TelemetryProfile, 0, len(entProfiles))
+	for _, entProfile := range entProfiles {
+		profiles = append(profiles, entTelemetryProfileToProtoTelemetryProfile(entProfile))
+	}
+	return profiles, nil
+}
+
+// getEffectiveTelemetryProfileIDs returns the resource IDs of the effective telemetry profiles of the given instance,
+// site or region. It is the TELEMETRY_INHERITANCE_MODE_OVERRIDE counterpart of getInheritedTelemetry.
+func getEffectiveTelemetryProfileIDs(
+	ctx context.Context,
+	client *ent.Client,
+	inheritBy *inv_v1.ListInheritedTelemetryProfilesRequest_InheritBy,
+	tenantID string,
+) ([]string, error) {
+	scopes, err := getTelemetryScopesByInheritBy(ctx, client, inheritBy, tenantID)
+	if err != nil || len(scopes) == 0 {
+		return nil, err
+	}
+	profiles, err := getScopedTelemetryProfiles(ctx, client, tenantID, scopes)
+	if err != nil {
+		return nil, err
+	}
+	effective := resolveEffectiveTelemetry(scopes, profiles)
+	profileIDs := make([]string, 0, len(effective))
+	for _, e := range effective {
+		profileIDs = append(profileIDs, e.GetProfile().GetResourceId())
+	}
+	return profileIDs, nil
+}
+
+func (is *InvStore) GetEffectiveTelemetryProfiles(
+	ctx context.Context, in *inv_v1.GetEffectiveTelemetryProfilesRequest,
+) (*inv_v1.GetEffectiveTelemetryProfilesResponse, error) {
+	return ExecuteInRoTxAndReturnSingle[inv_v1.GetEffectiveTelemetryProfilesResponse](is)(
+		ctx,
+		func(ctx context.Context, tx *ent.Tx) (*inv_v1.GetEffectiveTelemetryProfilesResponse, error) {
+			instanceScopes, err := getInstancesTelemetryScopes(ctx, tx.Client(), in.GetTenantId(), in.GetInstanceIds())
+			if err != nil {
+				return nil, err
+			}
+			var allScopes []telemetryScope
+			for _, scopes := range instanceScopes {
+				allScopes = append(allScopes, scopes...)
+			}
+			profiles, err := getScopedTelemetryProfiles(ctx, tx.Client(), in.GetTenantId(), allScopes)
+			if err != nil {
+				return nil, err
+			}
+
+			resp := &inv_v1.GetEffectiveTelemetryProfilesResponse{}
+			for _, instanceID := range in.GetInstanceIds() {
+				resp.Instances = append(resp.Instances, &inv_v1.GetEffectiveTelemetryProfilesResponse_InstanceTelemetry{
+					InstanceId: instanceID,
+					Profiles:   resolveEffectiveTelemetry(instanceScopes[instanceID], profiles),
+				})
+			}
+			return resp, nil
+		},
+	)
+}
+
+// getTelemetryCandidateProfile validates the telemetry profile that would be added in a DiffEffectiveTelemetryProfiles
+// request and returns it with the telemetry group loaded from the store.
+func getTelemetryCandidateProfile(
+	ctx context.Context, client *ent.Client, tenantID string, in *telemetry_v1.TelemetryProfile,
+) (*telemetry_v1.TelemetryProfile, error) {
+	if getTelemetryProfileScope(in).resourceID == "" {
+		err := errors.Errorfc(codes.InvalidArgument,
+			"One of Instance, Site or Region must be set for TelemetryProfile")
+		zlog.InfraSec().InfraErr(err).Msg("")
+		return nil, err
+	}
+	if err := validateTelemetryProfileParameters(in); err != nil {
+		return nil, err
+	}
+	group, err := client.TelemetryGroupResource.Query().
+		Where(
+			telemetrygroupresource.ResourceID(in.GetGroup().GetResourceId()),
+			telemetrygroupresource.TenantID(tenantID),
+		).
+		Only(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	candidate := &telemetry_v1.TelemetryProfile{
+		Kind:            in.GetKind(),
+		MetricsInterval: in.GetMetricsInterval(),
+		LogLevel:        in.GetLogLevel(),
+		Group:           entTelemetryGroupResourceToProtoTelemetryGroupResource(group),
+		TenantId:        tenantID,
+	}
+	if candidate.GetKind() != candidate.GetGroup().GetKind() {
+		err = errors.Errorfc(codes.InvalidArgument,
+			"TelemetryProfile and TelemetryGroupResource should have the same kind, "+
+				"got %v for TelemetryProfile and %v for TelemetryGroupResource",
+			candidate.GetKind(), candidate.GetGroup().GetKind())
+		zlog.InfraSec().InfraErr(err).Msg("")
+		return nil, err
+	}
+	switch scope := getTelemetryProfileScope(in); scope.kind {
+	case inv_v1.ResourceKind_RESOURCE_KIND_INSTANCE:
+		candidate.Relation = &telemetry_v1.TelemetryProfile_Instance{
+			Instance: &computev1.InstanceResource{ResourceId: scope.resourceID},
+		}
+	case inv_v1.ResourceKind_RESOURCE_KIND_SITE:
+		candidate.Relation = &telemetry_v1.TelemetryProfile_Site{
+			Site: &location_v1.SiteResource{ResourceId: scope.resourceID},
+		}
+	default:
+		candidate.Relation = &telemetry_v1.TelemetryProfile_Region{
+			Region: &location_v1.RegionResource{ResourceId: scope.resourceID},
+		}
+	}
+	return candidate, nil
+}
+
+// getTelemetryAffectedInstances returns the resource IDs of the instances whose effective telemetry depends on the
+// telemetry profiles attached to the given scope, ordered by resource ID.
+func getTelemetryAffectedInstances(ctx context.Context, client *ent.Client, tenantID string, scope telemetryScope) (
+	[]string, error,
+) {
+	query := client.InstanceResource.Query().Where(instanceresource.TenantID(tenantID))
+	switch scope.kind {
+	case inv_v1.ResourceKind_RESOURCE_KIND_INSTANCE:
+		query = query.Where(instanceresource.ResourceID(scope.resourceID))
+	case inv_v1.ResourceKind_RESOURCE_KIND_SITE:
+		query = query.Where(instanceresource.HasHostWith(
+			hostresource.HasSiteWith(siteresource.ResourceID(scope.resourceID)),
+		))
+	default:
+		regionIDs, err := getRegionSubtreeIDs(ctx, client, tenantID, scope.resourceID)
+		if err != nil {
+			return nil, err
+		}
+		query = query.Where(instanceresource.HasHostWith(
+			hostresource.HasSiteWith(siteresource.HasRegionWith(regionresource.IDIn(regionIDs...))),
+		))
+	}
+	instanceIDs, err := query.
+		Order(instanceresource.ByResourceID()).
+		Select(instanceresource.FieldResourceID).
+		Strings(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	return instanceIDs, nil
+}
+
+// getRegionSubtreeIDs returns the IDs of the given region and of all its descendants. Regions are retrieved level by
+// level, the number of iterations is bounded by the max region depth.
+func getRegionSubtreeIDs(ctx context.Context, client *ent.Client, tenantID, regionResourceID string) ([]int, error) {
+	rootID, err := client.RegionResource.Query().
+		Where(regionresource.ResourceID(regionResourceID), regionresource.TenantID(tenantID)).
+		OnlyID(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	subtree := []int{rootID}
+	frontier := []int{rootID}
+	for len(frontier) > 0 {
+		children, err := client.RegionResource.Query().
+			Where(
+				regionresource.TenantID(tenantID),
+				regionresource.HasParentRegionWith(regionresource.IDIn(frontier...)),
+				regionresource.IDNotIn(subtree...),
+			).
+			IDs(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err)
+		}
+		subtree = append(subtree, children...)
+		frontier = children
+	}
+	return subtree, nil
+}
+
+// effectiveTelemetryByGroup groups the given effective telemetry profiles by telemetry group resource ID.
+func effectiveTelemetryByGroup(
+	effective []*inv_v1.EffectiveTelemetryProfile,
+) map[string][]*inv_v1.EffectiveTelemetryProfile {
+	byGroup := make(map[string][]*inv_v1.EffectiveTelemetryProfile)
+	for _, e := range effective {
+		groupID := e.GetProfile().GetGroup().GetResourceId()
+		byGroup[groupID] = append(byGroup[groupID], e)
+	}
+	return byGroup
+}
+
+// diffEffectiveTelemetry returns the changes in the effective telemetry of an instance, given its chain of scopes and
+// the telemetry profiles before and after the change. Only changes of the winning profiles are reported.
+func diffEffectiveTelemetry(
+	instanceID string,
+	scopes []telemetryScope,
+	before, after []*telemetry_v1.TelemetryProfile,
+) []*inv_v1.DiffEffectiveTelemetryProfilesResponse_Change {
+	beforeByGroup := effectiveTelemetryByGroup(resolveEffectiveTelemetry(scopes, before))
+	afterByGroup := effectiveTelemetryByGroup(resolveEffectiveTelemetry(scopes, after))
+
+	groupIDs := make([]string, 0, len(beforeByGroup)+len(afterByGroup))
+	for groupID := range beforeByGroup {
+		groupIDs = append(groupIDs, groupID)
+	}
+	for groupID := range afterByGroup {
+		if _, ok := beforeByGroup[groupID]; !ok {
+			groupIDs = append(groupIDs, groupID)
+		}
+	}
+	slices.Sort(groupIDs)
+
+	profileID := func(e *inv_v1.EffectiveTelemetryProfile) string { return e.GetProfile().GetResourceId() }
+	var changes []*inv_v1.DiffEffectiveTelemetryProfilesResponse_Change
+	for _, groupID := range groupIDs {
+		if slices.EqualFunc(beforeByGroup[groupID], afterByGroup[groupID],
+			func(b, a *inv_v1.EffectiveTelemetryProfile) bool { return profileID(b) == profileID(a) }) {
+			continue
+		}
+		changes = append(changes, &inv_v1.DiffEffectiveTelemetryProfilesResponse_Change{
+			InstanceId: instanceID,
+			GroupId:    groupID,
+			Before:     beforeByGroup[groupID],
+			After:      afterByGroup[groupID],
+		})
+	}
+	return changes
+}
+
+func (is *InvStore) DiffEffectiveTelemetryProfiles(
+	ctx context.Context, in *inv_v1.DiffEffectiveTelemetryProfilesRequest,
+) (*inv_v1.DiffEffectiveTelemetryProfilesResponse, error) {
+	return ExecuteInRoTxAndReturnSingle[inv_v1.DiffEffectiveTelemetryProfilesResponse](is)(
+		ctx,
+		func(ctx context.Context, tx *ent.Tx) (*inv_v1.DiffEffectiveTelemetryProfilesResponse, error) {
+			tenantID := in.GetTenantId()
+			var candidate *telemetry_v1.TelemetryProfile
+			var target telemetryScope
+			switch in.GetChange().(type) {
+			case *inv_v1.DiffEffectiveTelemetryProfilesRequest_AddProfile:
+				var err error
+				candidate, err = getTelemetryCandidateProfile(ctx, tx.Client(), tenantID, in.GetAddProfile())
+				if err != nil {
+					return nil, err
+				}
+				target = getTelemetryProfileScope(candidate)
+			case *inv_v1.DiffEffectiveTelemetryProfilesRequest_RemoveProfileId:
+				removed, err := tx.TelemetryProfile.Query().
+					Where(
+						telemetryprofileres.ResourceID(in.GetRemoveProfileId()),
+						telemetryprofileres.TenantID(tenantID),
+					).
+					WithInstance().
+					WithSite().
+					WithRegion().
+					Only(ctx)
+				if err != nil {
+					return nil, errors.Wrap(err)
+				}
+				target = getTelemetryProfileScope(entTelemetryProfileToProtoTelemetryProfile(removed))
+			}
+
+			instanceIDs, err := getTelemetryAffectedInstances(ctx, tx.Client(), tenantID, target)
+			if err != nil {
+				return nil, err
+			}
+			resp := &inv_v1.DiffEffectiveTelemetryProfilesResponse{}
+			if len(instanceIDs) == 0 {
+				return resp, nil
+			}
+			instanceScopes, err := getInstancesTelemetryScopes(ctx, tx.Client(), tenantID, instanceIDs)
+			if err != nil {
+				return nil, err
+			}
+			var allScopes []telemetryScope
+			for _, scopes := range instanceScopes {
+				allScopes = append(allScopes, scopes...)
+			}
+			before, err := getScopedTelemetryProfiles(ctx, tx.Client(), tenantID, allScopes)
+			if err != nil {
+				return nil, err
+			}
+			after := slices.DeleteFunc(slices.Clone(before), func(p *telemetry_v1.TelemetryProfile) bool {
+				return p.GetResourceId() == in.GetRemoveProfileId()
+			})
+			if candidate != nil {
+				after = append(after, candidate)
+			}
+
+			for _, instanceID := range instanceIDs {
+				resp.Changes = append(resp.Changes,
+					diffEffectiveTelemetry(instanceID, instanceScopes[instanceID], before, after)...)
+			}
+			return resp, nil
+		},
+	)
+}
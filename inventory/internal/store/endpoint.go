@@ -18,11 +18,25 @@ import (
 	network_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/network/v1"
 	cl "github.com/open-edge-platform/infra-core/inventory/v2/pkg/client"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/logging"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/util"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/util/collections"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/validator"
 )
 
+func init() {
+	RegisterResourceHandler(ResourceHandler{
+		Kind:      inv_v1.ResourceKind_RESOURCE_KIND_ENDPOINT,
+		Create:    createAs((*InvStore).CreateEndpoint),
+		Get:       getByID((*InvStore).GetEndpoint),
+		Update:    updateAs((*InvStore).UpdateEndpoint),
+		Delete:    deleteByID((*InvStore).DeleteEndpoint),
+		DeleteAll: (*InvStore).DeleteEndpoints,
+		List:      (*InvStore).ListEndpoints,
+		Filter:    (*InvStore).FilterEndpoints,
+	})
+}
+
 var endpointResourceCreationValidators = []resourceValidator[*network_v1.EndpointResource]{
 	protoValidator[*network_v1.EndpointResource],
 	doNotAcceptResourceID[*network_v1.EndpointResource],
@@ -38,7 +52,7 @@ func (is *InvStore) CreateEndpoint(ctx context.Context, in *network_v1.EndpointR
 		return nil, err
 	}
 
-	zlog.Debug().Msgf("Endpoint Created: %s, %s", res.GetEndpoint().GetResourceId(), res)
+	zlog.Debug().Msgf("Endpoint Created: %s, %s", res.GetEndpoint().GetResourceId(), logging.Redact(res))
 	return res, nil
 }
 
@@ -75,7 +89,7 @@ func getEndpointQuery(ctx context.Context, tx *ent.Tx, resourceID string) (*ent.
 func (is *InvStore) UpdateEndpoint(
 	ctx context.Context, id string, in *network_v1.EndpointResource, fm *fieldmaskpb.FieldMask,
 ) (*inv_v1.Resource, error) {
-	zlog.Debug().Msgf("Update (%s): %v, fm: %v", id, in, fm)
+	zlog.Debug().Msgf("Update (%s): %v, fm: %v", id, logging.Redact(in), fm)
 
 	res, err := ExecuteInTxAndReturnSingle[inv_v1.Resource](is)(ctx,
 		func(ctx context.Context, tx *ent.Tx) (*inv_v1.Resource, error) {
@@ -9,6 +9,7 @@ package store
 import (
 	"fmt"
 	"reflect"
+	"regexp"
 	"strings"
 	"sync"
 
@@ -23,6 +24,7 @@ import (
 	"google.golang.org/protobuf/reflect/protoregistry"
 	"google.golang.org/protobuf/types/known/structpb"
 
+	customresourcev1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/customresource/v1"
 	inv_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/inventory/v1"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/util"
@@ -41,6 +43,23 @@ func noopSQLSelectorCreator(_ any) sqlPredicate {
 
 var TypeNull = &expr.Type{TypeKind: &expr.Type_Null{Null: structpb.NullValue_NULL_VALUE}}
 
+// jsonDocumentFields are the string fields holding a JSON document. Their content can be filtered on by selecting
+// into the document, e.g. `spec.rack.units >= 42`.
+var jsonDocumentFields = map[protoreflect.FullName]bool{
+	(&customresourcev1.CustomObjectResource{}).ProtoReflect().Descriptor().
+		Fields().ByName(customresourcev1.CustomObjectResourceFieldSpec).FullName(): true,
+}
+
+// jsonDocumentDepth is the maximum number of keys that can be selected into a JSON document.
+const jsonDocumentDepth = 8
+
+// jsonDocumentKeyRegexp matches the keys that can be selected into a JSON document. Keys end up in the SQL path
+// literal, hence the restricted charset.
+var jsonDocumentKeyRegexp = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+// typeJSONValue is the type of a value selected from a JSON document that cannot be further selected into.
+var typeJSONValue = newTypeProtoMsg((&structpb.Value{}).ProtoReflect().Descriptor().FullName())
+
 type Transpiler struct {
 	ck *expr.CheckedExpr
 	// kind contains the "root" resource kind we're transpiling for. Since we
@@ -106,6 +125,8 @@ func (t *Transpiler) transpileConstExpr(e *expr.Expr) (any, error) {
 		return c.Int64Value, nil
 	case *expr.Constant_Uint64Value:
 		return c.Uint64Value, nil
+	case *expr.Constant_DoubleValue:
+		return c.DoubleValue, nil
 	case *expr.Constant_StringValue:
 		return c.StringValue, nil
 	case *expr.Constant_BoolValue:
@@ -493,13 +514,31 @@ func normalizeIdentNamesSnakeCaseSelectExpr(cursor *filtering.Cursor) {
 	zlog.Trace().Msgf("replaced select expr `%v` with `%v`", cursor.Expr(), c)
 }
 
-func normalizeIdentNamesSnakeCase(cursor *filtering.Cursor) {
-	switch cursor.Expr().GetExprKind().(type) {
-	case *expr.Expr_IdentExpr:
-		normalizeIdentNamesSnakeCaseIdentExpr(cursor)
-	case *expr.Expr_SelectExpr:
-		normalizeIdentNamesSnakeCaseSelectExpr(cursor)
-	default:
+// selectsIntoJSONDocument returns true if the select expression selects keys of a JSON document field.
+func selectsIntoJSONDocument(decls *filtering.Declarations, e *expr.Expr) bool {
+	paths := flattenSelectExpr(e)
+	for i := 1; i < len(paths); i++ {
+		ident, ok := decls.LookupIdent(strings.Join(paths[:i], "."))
+		if ok && ident.GetIdent().GetType().GetMapType() != nil {
+			return true
+		}
+	}
+	return false
+}
+
+func normalizeIdentNamesSnakeCase(decls *filtering.Declarations) filtering.Macro {
+	return func(cursor *filtering.Cursor) {
+		switch cursor.Expr().GetExprKind().(type) {
+		case *expr.Expr_IdentExpr:
+			normalizeIdentNamesSnakeCaseIdentExpr(cursor)
+		case *expr.Expr_SelectExpr:
+			// Keys of JSON documents are matched as given.
+			if selectsIntoJSONDocument(decls, cursor.Expr()) {
+				return
+			}
+			normalizeIdentNamesSnakeCaseSelectExpr(cursor)
+		default:
+		}
 	}
 }
 
@@ -526,7 +565,7 @@ func getPreds(kind inv_v1.ResourceKind, decls *filtering.Declarations, filter st
 
 	// Normalize identifiers to snake_case. While we do add declarations for both casings
 	// for the initial parse stage, the later transpile stages cannot handle camelCase.
-	f, err = filtering.ApplyMacros(f, decls, normalizeIdentNamesSnakeCase)
+	f, err = filtering.ApplyMacros(f, decls, normalizeIdentNamesSnakeCase(decls))
 	if err != nil {
 		return nil, err
 	}
@@ -549,6 +588,50 @@ func newTypeProtoMsg(fullName protoreflect.FullName) *expr.Type {
 	return &expr.Type{TypeKind: &expr.Type_MessageType{MessageType: string(fullName)}}
 }
 
+// typeJSONDocument returns the type of a JSON document that can be selected into up to depth keys.
+func typeJSONDocument(depth int) *expr.Type {
+	if depth == 0 {
+		return typeJSONValue
+	}
+	return filtering.TypeMap(filtering.TypeString, typeJSONDocument(depth-1))
+}
+
+// getJSONDocumentDeclarations declares the comparison functions between the values selected from a JSON document
+// and literals. The document itself can still be compared to strings.
+func getJSONDocumentDeclarations() (opts []filtering.DeclarationOption) {
+	literals := []*util.Tuple[string, *expr.Type]{
+		util.NewTuple("string", filtering.TypeString),
+		util.NewTuple("int", filtering.TypeInt),
+		util.NewTuple("float", filtering.TypeFloat),
+		util.NewTuple("bool", filtering.TypeBool),
+	}
+	functions := []string{
+		filtering.FunctionEquals,
+		filtering.FunctionNotEquals,
+		filtering.FunctionLessThan,
+		filtering.FunctionLessEquals,
+		filtering.FunctionGreaterThan,
+		filtering.FunctionGreaterEquals,
+	}
+	for _, function := range functions {
+		var overloads []*expr.Decl_FunctionDecl_Overload
+		for depth := 0; depth <= jsonDocumentDepth; depth++ {
+			for _, literal := range literals {
+				overloads = append(overloads, filtering.NewFunctionOverload(
+					fmt.Sprintf("%s_json%d_%s", function, depth, literal.A),
+					filtering.TypeBool, typeJSONDocument(depth), literal.B))
+			}
+			if function == filtering.FunctionEquals || function == filtering.FunctionNotEquals {
+				overloads = append(overloads, filtering.NewFunctionOverload(
+					fmt.Sprintf("%s_json%d_null", function, depth),
+					filtering.TypeBool, typeJSONDocument(depth), TypeNull))
+			}
+		}
+		opts = append(opts, filtering.DeclareFunction(function, overloads...))
+	}
+	return opts
+}
+
 //nolint:cyclop // inherently complex
 func getResourceDeclarations(desc protoreflect.MessageDescriptor, prefix string, limit int) (opts []filtering.DeclarationOption) {
 	limit--
@@ -594,9 +677,14 @@ func getResourceDeclarations(desc protoreflect.MessageDescriptor, prefix string,
 			hasOverloads = append(hasOverloads, filtering.NewFunctionOverload(FunctionHas+"_"+string(d.Message().FullName()),
 				filtering.TypeBool, newTypeProtoMsg(d.Message().FullName())))
 		case protoreflect.StringKind:
-			opts = append(opts, filtering.DeclareIdent(prefix+string(d.Name()), filtering.TypeString))
+			fieldType := filtering.TypeString
+			if jsonDocumentFields[d.FullName()] {
+				fieldType = typeJSONDocument(jsonDocumentDepth)
+				opts = append(opts, getJSONDocumentDeclarations()...)
+			}
+			opts = append(opts, filtering.DeclareIdent(prefix+string(d.Name()), fieldType))
 			if d.HasJSONName() && d.JSONName() != string(d.Name()) {
-				opts = append(opts, filtering.DeclareIdent(prefix+d.JSONName(), filtering.TypeString))
+				opts = append(opts, filtering.DeclareIdent(prefix+d.JSONName(), fieldType))
 			}
 		case protoreflect.Uint32Kind, protoreflect.Uint64Kind, protoreflect.Int32Kind, protoreflect.Int64Kind:
 			opts = append(opts, filtering.DeclareIdent(prefix+string(d.Name()), filtering.TypeInt))
@@ -779,6 +867,9 @@ func evaluate(kind inv_v1.ResourceKind, op createSQLPredicateFn, paths []string)
 	}
 
 	head, tail := paths[0], paths[1:] // pop first element
+	if bt.validateColumnFn(head) && len(tail) > 0 {
+		return evaluateJSONDocument(kind, op, head, tail)
+	}
 	if bt.validateColumnFn(head) {
 		return func(value any) sqlPredicate {
 			return func(s *sql.Selector) {
@@ -801,3 +892,41 @@ func evaluate(kind inv_v1.ResourceKind, op createSQLPredicateFn, paths []string)
 		return context.deriveSelectorFn(evaluatedTail(value))
 	}, nil
 }
+
+// evaluateJSONDocument builds the predicate on the value selected by keys from the JSON document held by column.
+func evaluateJSONDocument(
+	kind inv_v1.ResourceKind, op createSQLPredicateFn, column string, keys []string,
+) (createPredicateFn, error) {
+	info, ok := util.LookupResourceKind(kind)
+	if !ok || !jsonDocumentFields[info.MessageName().Append(protoreflect.Name(column))] {
+		return nil, errors.Errorfc(codes.InvalidArgument, "field %v does not hold a JSON document", column)
+	}
+	for _, key := range keys {
+		if !jsonDocumentKeyRegexp.MatchString(key) {
+			return nil, errors.Errorfc(codes.InvalidArgument, "invalid JSON document key %q", key)
+		}
+	}
+
+	return func(value any) sqlPredicate {
+		return func(s *sql.Selector) {
+			s.Where(op(jsonDocumentValueExpr(s.C(column), keys, value), value))
+		}
+	}, nil
+}
+
+// jsonDocumentValueExpr returns the SQL expression selecting keys from the JSON document held by column. The value
+// is converted to the SQL type of the literal it is compared to, and is NULL when the JSON types differ.
+func jsonDocumentValueExpr(column string, keys []string, value any) string {
+	v := fmt.Sprintf("(NULLIF(%s, '')::jsonb #> '{%s}')", column, strings.Join(keys, ","))
+	switch value.(type) {
+	case int64, uint64, float64:
+		return fmt.Sprintf("(CASE WHEN jsonb_typeof(%s) = 'number' THEN (%s #>> '{}')::numeric END)", v, v)
+	case bool:
+		return fmt.Sprintf("(CASE WHEN jsonb_typeof(%s) = 'boolean' THEN (%s #>> '{}')::boolean END)", v, v)
+	case string:
+		return fmt.Sprintf("(CASE WHEN jsonb_typeof(%s) = 'string' THEN (%s #>> '{}') END)", v, v)
+	default:
+		// JSON null and missing keys both compare equal to null.
+		return fmt.Sprintf("(CASE WHEN jsonb_typeof(%s) <> 'null' THEN %s END)", v, v)
+	}
+}
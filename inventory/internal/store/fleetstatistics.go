@@ -0,0 +1,194 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package store
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"google.golang.org/grpc/codes"
+
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent"
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/hostresource"
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/instanceresource"
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/operatingsystemresource"
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/regionresource"
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/siteresource"
+	inv_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/inventory/v1"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
+)
+
+// Aliases of the tables joined to the hosts by the fleet statistics queries.
+const (
+	fleetSiteAlias     = "fleet_site"
+	fleetRegionAlias   = "fleet_region"
+	fleetInstanceAlias = "fleet_instance"
+	fleetOsAlias       = "fleet_os"
+)
+
+// fleetDimensions returns, per dimension, the SQL expression of the value the hosts are grouped by. The expression
+// may refer to the tables it joins to the given selector of the hosts.
+var fleetDimensions = map[inv_v1.FleetStatisticsDimension]func(hosts *sql.Selector) string{
+	inv_v1.FleetStatisticsDimension_FLEET_STATISTICS_DIMENSION_SITE: func(hosts *sql.Selector) string {
+		return joinFleetSite(hosts).C(siteresource.FieldResourceID)
+	},
+	inv_v1.FleetStatisticsDimension_FLEET_STATISTICS_DIMENSION_REGION: func(hosts *sql.Selector) string {
+		site := joinFleetSite(hosts)
+		region := sql.Table(regionresource.Table).As(fleetRegionAlias)
+		hosts.LeftJoin(region).On(site.C(siteresource.RegionColumn), region.C(regionresource.FieldID))
+		return region.C(regionresource.FieldResourceID)
+	},
+	inv_v1.FleetStatisticsDimension_FLEET_STATISTICS_DIMENSION_OS_PROFILE: func(hosts *sql.Selector) string {
+		return joinFleetOs(hosts, joinFleetInstance(hosts, false)).C(operatingsystemresource.FieldProfileName)
+	},
+	inv_v1.FleetStatisticsDimension_FLEET_STATISTICS_DIMENSION_ONBOARDING_STATUS: func(hosts *sql.Selector) string {
+		return hosts.C(hostresource.FieldOnboardingStatusIndicator)
+	},
+	inv_v1.FleetStatisticsDimension_FLEET_STATISTICS_DIMENSION_POWER_STATE: func(hosts *sql.Selector) string {
+		return hosts.C(hostresource.FieldCurrentPowerState)
+	},
+	inv_v1.FleetStatisticsDimension_FLEET_STATISTICS_DIMENSION_AMT_STATE: func(hosts *sql.Selector) string {
+		return hosts.C(hostresource.FieldCurrentAmtState)
+	},
+	inv_v1.FleetStatisticsDimension_FLEET_STATISTICS_DIMENSION_HOST_STATUS: func(hosts *sql.Selector) string {
+		return hosts.C(hostresource.FieldHostStatusIndicator)
+	},
+	inv_v1.FleetStatisticsDimension_FLEET_STATISTICS_DIMENSION_PROVISIONING_STATUS: func(hosts *sql.Selector) string {
+		return joinFleetInstance(hosts, false).C(instanceresource.FieldProvisioningStatusIndicator)
+	},
+	inv_v1.FleetStatisticsDimension_FLEET_STATISTICS_DIMENSION_INSTANCE_OS: func(hosts *sql.Selector) string {
+		return joinFleetOs(hosts, joinFleetInstance(hosts, true)).C(operatingsystemresource.FieldResourceID)
+	},
+	inv_v1.FleetStatisticsDimension_FLEET_STATISTICS_DIMENSION_UPDATE_AVAILABLE: func(hosts *sql.Selector) string {
+		instance := joinFleetInstance(hosts, false)
+		return fmt.Sprintf("CASE WHEN COALESCE(%s, '') <> '' THEN 'true' ELSE 'false' END",
+			instance.C(instanceresource.FieldOsUpdateAvailable))
+	},
+}
+
+func joinFleetSite(hosts *sql.Selector) *sql.SelectTable {
+	site := sql.Table(siteresource.Table).As(fleetSiteAlias)
+	hosts.LeftJoin(site).On(hosts.C(hostresource.SiteColumn), site.C(siteresource.FieldID))
+	return site
+}
+
+// joinFleetInstance joins the instances to the hosts. With inner set, the hosts without instance are left out.
+func joinFleetInstance(hosts *sql.Selector, inner bool) *sql.SelectTable {
+	instance := sql.Table(instanceresource.Table).As(fleetInstanceAlias)
+	if inner {
+		hosts.Join(instance)
+	} else {
+		hosts.LeftJoin(instance)
+	}
+	hosts.On(hosts.C(hostresource.InstanceColumn), instance.C(instanceresource.FieldID))
+	return instance
+}
+
+func joinFleetOs(hosts *sql.Selector, instance *sql.SelectTable) *sql.SelectTable {
+	os := sql.Table(operatingsystemresource.Table).As(fleetOsAlias)
+	hosts.LeftJoin(os).On(instance.C(instanceresource.OsColumn), os.C(operatingsystemresource.FieldID))
+	return os
+}
+
+func (is *InvStore) GetFleetStatistics(
+	ctx context.Context, in *inv_v1.GetFleetStatisticsRequest,
+) (*inv_v1.GetFleetStatisticsResponse, error) {
+	return ExecuteInRoTxAndReturnSingle[inv_v1.GetFleetStatisticsResponse](is)(
+		ctx,
+		func(ctx context.Context, tx *ent.Tx) (*inv_v1.GetFleetStatisticsResponse, error) {
+			return getFleetStatistics(ctx, tx.Client(), in)
+		},
+	)
+}
+
+func getFleetStatistics(
+	ctx context.Context, client *ent.Client, in *inv_v1.GetFleetStatisticsRequest,
+) (*inv_v1.GetFleetStatisticsResponse, error) {
+	pred, err := getPredicate(inv_v1.ResourceKind_RESOURCE_KIND_HOST, in.GetFilter())
+	if err != nil {
+		return nil, err
+	}
+
+	hosts := fleetHostsSelector(in.GetTenantId(), pred)
+	query, args := hosts.Select(sql.Count("*")).Query()
+	rows, err := client.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, logAndSanitizeErrorRawSQLf(err, "error counting hosts")
+	}
+	defer rows.Close()
+	var total int
+	if rows.Next() {
+		if err := rows.Scan(&total); err != nil {
+			return nil, logAndSanitizeErrorRawSQLf(err, "error parsing results while counting hosts")
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, logAndSanitizeErrorRawSQLf(err, "error counting hosts")
+	}
+
+	resp := &inv_v1.GetFleetStatisticsResponse{
+		TotalHosts: uint32(total), //nolint:gosec // Number of hosts.
+	}
+	for _, dimension := range in.GetDimensions() {
+		stats, err := getFleetDimensionStatistics(ctx, client, in.GetTenantId(), pred, dimension)
+		if err != nil {
+			return nil, err
+		}
+		resp.Statistics = append(resp.Statistics, stats)
+	}
+	return resp, nil
+}
+
+// fleetHostsSelector returns the selector of the hosts of the tenant that match the given predicate. Raw queries are
+// not subject to the tenant interceptor of the ent client, hence the explicit tenant condition.
+func fleetHostsSelector(tenantID string, pred sqlPredicate) *sql.Selector {
+	t := sql.Table(hostresource.Table)
+	hosts := sql.Dialect(dialect.Postgres).Select().From(t)
+	hosts.Where(sql.EQ(t.C(hostresource.FieldTenantID), tenantID))
+	pred(hosts)
+	return hosts
+}
+
+func getFleetDimensionStatistics(
+	ctx context.Context, client *ent.Client, tenantID string, pred sqlPredicate,
+	dimension inv_v1.FleetStatisticsDimension,
+) (*inv_v1.GetFleetStatisticsResponse_DimensionStatistics, error) {
+	valueFn, ok := fleetDimensions[dimension]
+	if !ok {
+		return nil, errors.Errorfc(codes.InvalidArgument, "unsupported fleet statistics dimension: %s", dimension)
+	}
+
+	hosts := fleetHostsSelector(tenantID, pred)
+	value := fmt.Sprintf("COALESCE(CAST(%s AS TEXT), '')", valueFn(hosts))
+	query, args := hosts.
+		Select(sql.As(value, "value"), sql.As(sql.Count("*"), "count")).
+		GroupBy(value).
+		OrderBy(sql.Desc("count"), "value").
+		Query()
+	rows, err := client.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, logAndSanitizeErrorRawSQLf(err, fmt.Sprintf("error grouping hosts by %s", dimension))
+	}
+	defer rows.Close()
+
+	stats := &inv_v1.GetFleetStatisticsResponse_DimensionStatistics{Dimension: dimension}
+	for rows.Next() {
+		var bucketValue string
+		var count int
+		if err := rows.Scan(&bucketValue, &count); err != nil {
+			return nil, logAndSanitizeErrorRawSQLf(
+				err, fmt.Sprintf("error parsing results while grouping hosts by %s", dimension))
+		}
+		stats.Buckets = append(stats.Buckets, &inv_v1.GetFleetStatisticsResponse_Bucket{
+			Value: bucketValue,
+			Count: uint32(count), //nolint:gosec // Number of hosts.
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, logAndSanitizeErrorRawSQLf(err, fmt.Sprintf("error grouping hosts by %s", dimension))
+	}
+	return stats, nil
+}
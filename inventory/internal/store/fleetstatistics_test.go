@@ -0,0 +1,160 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package store_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	inv_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/inventory/v1"
+	inv_testing "github.com/open-edge-platform/infra-core/inventory/v2/pkg/testing"
+)
+
+//nolint:funlen // length due to test cases
+func Test_GetFleetStatistics(t *testing.T) {
+	region := inv_testing.CreateRegion(t, nil)
+	site := inv_testing.CreateSite(t, region, nil)
+	otherSite := inv_testing.CreateSite(t, nil, nil)
+	os := inv_testing.CreateOs(t)
+	hostWithInstance := inv_testing.CreateHost(t, site, nil)
+	inv_testing.CreateInstance(t, hostWithInstance, os)
+	inv_testing.CreateHost(t, site, nil)
+	inv_testing.CreateHost(t, otherSite, nil)
+
+	siteFilter := fmt.Sprintf(`site.resource_id = %q`, site.GetResourceId())
+
+	testcases := map[string]struct {
+		in       *inv_v1.GetFleetStatisticsRequest
+		total    uint32
+		expected []*inv_v1.GetFleetStatisticsResponse_DimensionStatistics
+		code     codes.Code
+	}{
+		"TotalOnly": {
+			in:    &inv_v1.GetFleetStatisticsRequest{Filter: siteFilter},
+			total: 2,
+		},
+		"Location": {
+			in: &inv_v1.GetFleetStatisticsRequest{
+				Filter: siteFilter,
+				Dimensions: []inv_v1.FleetStatisticsDimension{
+					inv_v1.FleetStatisticsDimension_FLEET_STATISTICS_DIMENSION_SITE,
+					inv_v1.FleetStatisticsDimension_FLEET_STATISTICS_DIMENSION_REGION,
+				},
+			},
+			total: 2,
+			expected: []*inv_v1.GetFleetStatisticsResponse_DimensionStatistics{
+				{
+					Dimension: inv_v1.FleetStatisticsDimension_FLEET_STATISTICS_DIMENSION_SITE,
+					Buckets: []*inv_v1.GetFleetStatisticsResponse_Bucket{
+						{Value: site.GetResourceId(), Count: 2},
+					},
+				},
+				{
+					Dimension: inv_v1.FleetStatisticsDimension_FLEET_STATISTICS_DIMENSION_REGION,
+					Buckets: []*inv_v1.GetFleetStatisticsResponse_Bucket{
+						{Value: region.GetResourceId(), Count: 2},
+					},
+				},
+			},
+		},
+		"Instances": {
+			in: &inv_v1.GetFleetStatisticsRequest{
+				Filter: siteFilter,
+				Dimensions: []inv_v1.FleetStatisticsDimension{
+					inv_v1.FleetStatisticsDimension_FLEET_STATISTICS_DIMENSION_INSTANCE_OS,
+					inv_v1.FleetStatisticsDimension_FLEET_STATISTICS_DIMENSION_OS_PROFILE,
+					inv_v1.FleetStatisticsDimension_FLEET_STATISTICS_DIMENSION_UPDATE_AVAILABLE,
+				},
+			},
+			total: 2,
+			expected: []*inv_v1.GetFleetStatisticsResponse_DimensionStatistics{
+				{
+					// The host without instance is not accounted for.
+					Dimension: inv_v1.FleetStatisticsDimension_FLEET_STATISTICS_DIMENSION_INSTANCE_OS,
+					Buckets: []*inv_v1.GetFleetStatisticsResponse_Bucket{
+						{Value: os.GetResourceId(), Count: 1},
+					},
+				},
+				{
+					Dimension: inv_v1.FleetStatisticsDimension_FLEET_STATISTICS_DIMENSION_OS_PROFILE,
+					Buckets: []*inv_v1.GetFleetStatisticsResponse_Bucket{
+						{Value: "", Count: 1},
+						{Value: os.GetProfileName(), Count: 1},
+					},
+				},
+				{
+					Dimension: inv_v1.FleetStatisticsDimension_FLEET_STATISTICS_DIMENSION_UPDATE_AVAILABLE,
+					Buckets: []*inv_v1.GetFleetStatisticsResponse_Bucket{
+						{Value: "false", Count: 2},
+					},
+				},
+			},
+		},
+		"NoMatch": {
+			in: &inv_v1.GetFleetStatisticsRequest{
+				Filter: `serial_number = "no-such-serial-number"`,
+				Dimensions: []inv_v1.FleetStatisticsDimension{
+					inv_v1.FleetStatisticsDimension_FLEET_STATISTICS_DIMENSION_POWER_STATE,
+				},
+			},
+			expected: []*inv_v1.GetFleetStatisticsResponse_DimensionStatistics{
+				{Dimension: inv_v1.FleetStatisticsDimension_FLEET_STATISTICS_DIMENSION_POWER_STATE},
+			},
+		},
+		"InvalidFilter": {
+			in:   &inv_v1.GetFleetStatisticsRequest{Filter: `no_such_field = "foo"`},
+			code: codes.InvalidArgument,
+		},
+		"UnspecifiedDimension": {
+			in: &inv_v1.GetFleetStatisticsRequest{
+				Dimensions: []inv_v1.FleetStatisticsDimension{
+					inv_v1.FleetStatisticsDimension_FLEET_STATISTICS_DIMENSION_UNSPECIFIED,
+				},
+			},
+			code: codes.InvalidArgument,
+		},
+		"DuplicatedDimension": {
+			in: &inv_v1.GetFleetStatisticsRequest{
+				Dimensions: []inv_v1.FleetStatisticsDimension{
+					inv_v1.FleetStatisticsDimension_FLEET_STATISTICS_DIMENSION_SITE,
+					inv_v1.FleetStatisticsDimension_FLEET_STATISTICS_DIMENSION_SITE,
+				},
+			},
+			code: codes.InvalidArgument,
+		},
+	}
+
+	for tcname, tc := range testcases {
+		t.Run(tcname, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+
+			resp, err := inv_testing.TestClients[inv_testing.APIClient].GetFleetStatistics(ctx, tc.in)
+			if tc.code != codes.OK {
+				require.Error(t, err)
+				assert.Equal(t, tc.code, status.Code(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.total, resp.GetTotalHosts())
+			require.Len(t, resp.GetStatistics(), len(tc.expected))
+			for i, expected := range tc.expected {
+				stats := resp.GetStatistics()[i]
+				assert.Equal(t, expected.GetDimension(), stats.GetDimension())
+				require.Len(t, stats.GetBuckets(), len(expected.GetBuckets()))
+				for j, bucket := range expected.GetBuckets() {
+					assert.Equal(t, bucket.GetValue(), stats.GetBuckets()[j].GetValue())
+					assert.Equal(t, bucket.GetCount(), stats.GetBuckets()[j].GetCount())
+				}
+			}
+		})
+	}
+}
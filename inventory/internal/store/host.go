@@ -23,12 +23,26 @@ import (
 	statusv1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/status/v1"
 	cl "github.com/open-edge-platform/infra-core/inventory/v2/pkg/client"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/logging"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/secrets"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/util"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/util/collections"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/validator"
 )
 
+func init() {
+	RegisterResourceHandler(ResourceHandler{
+		Kind:      inv_v1.ResourceKind_RESOURCE_KIND_HOST,
+		Create:    createAs((*InvStore).CreateHost),
+		Get:       (*InvStore).GetHost,
+		Update:    updateAsInTenantWithHardDelete((*InvStore).UpdateHost),
+		Delete:    (*InvStore).DeleteHost,
+		DeleteAll: (*InvStore).DeleteHosts,
+		List:      (*InvStore).ListHosts,
+		Filter:    (*InvStore).FilterHosts,
+	})
+}
+
 const (
 	KubeconfigVaultKey = "kubeconfig"
 )
@@ -82,7 +96,7 @@ func (is *InvStore) CreateHost(ctx context.Context, in *computev1.HostResource)
 		return nil, err
 	}
 
-	zlog.Debug().Msgf("Host Created: %s, %s", res.GetHost().GetResourceId(), res)
+	zlog.Debug().Msgf("Host Created: %s, %s", res.GetHost().GetResourceId(), logging.Redact(res))
 	return res, nil
 }
 
@@ -172,6 +186,9 @@ func hostResourceCreator(in *computev1.HostResource) func(context.Context, *ent.
 		if err != nil {
 			return nil, err
 		}
+		if err := syncResourceLabels(ctx, tx.Client(), res.TenantID, res.ResourceID, res.Metadata); err != nil {
+			return nil, err
+		}
 		return util.WrapResource(entHostResourceToProtoHostResource(res))
 	}
 }
@@ -265,7 +282,7 @@ func getHostQuery(ctx context.Context, tx *ent.Tx, tenantID, resourceID string,
 func (is *InvStore) UpdateHost(
 	ctx context.Context, id string, in *computev1.HostResource, fieldmask *fieldmaskpb.FieldMask, tenantID string,
 ) (*inv_v1.Resource, bool, error) {
-	zlog.Debug().Msgf("UpdateHost (%s): %v, fm: %v", id, in, fieldmask)
+	zlog.Debug().Msgf("UpdateHost (%s): %v, fm: %v", id, logging.Redact(in), fieldmask)
 
 	// Special handling for kubeconfig in metadata on UpdateHost:
 	// It will be stored securely in vault, rather than being stored in the Host metadata in DB.
@@ -424,6 +441,13 @@ func hostResourceUpdater(
 			if err != nil {
 				return nil, booleans.Pointer(false), err
 			}
+
+			if writesMetadata(fieldmask, hosts.FieldMetadata) {
+				err = syncResourceLabels(ctx, tx.Client(), updatedHost.TenantID, updatedHost.ResourceID, updatedHost.Metadata)
+				if err != nil {
+					return nil, booleans.Pointer(false), err
+				}
+			}
 		}
 
 		toBeReturned, err := util.WrapResource(entHostResourceToProtoHostResource(updatedHost))
@@ -438,6 +462,9 @@ func hardDeleteHost(ctx context.Context, client *ent.Client, host *ent.HostResou
 		return errors.Errorfc(codes.FailedPrecondition, "the host has a relation with Instance and cannot be deleted")
 	}
 
+	if err := deleteResourceLabels(ctx, client, host.ResourceID); err != nil {
+		return err
+	}
 	// should be nil on success
 	return errors.Wrap(client.HostResource.DeleteOneID(host.ID).Exec(ctx))
 }
@@ -490,6 +517,9 @@ func (is *InvStore) DeleteHosts(
 ) ([]*util.Tuple[DeletionKind, *inv_v1.Resource], error) {
 	deletionStrategies := map[bool]func(ctx context.Context, tx *ent.Tx, tenantID string) (DeletionKind, int, error){
 		true: func(ctx context.Context, tx *ent.Tx, tenantID string) (DeletionKind, int, error) {
+			if err := deleteTenantResourceLabels(ctx, tx.Client(), tenantID, inv_v1.ResourceKind_RESOURCE_KIND_HOST); err != nil {
+				return HARD, 0, err
+			}
 			i, e := tx.HostResource.Delete().Where(hosts.TenantID(tenantID)).Exec(ctx)
 			return HARD, i, e
 		},
@@ -540,7 +570,6 @@ func (is *InvStore) DeleteHosts(
 	return deleted, txErr
 }
 
-//nolint:cyclop // high cyclomatic complexity due to filtering by metadata in go code
 func filterHosts(ctx context.Context, client *ent.Client, filter *inv_v1.ResourceFilter) ([]hostWithInheritedMeta, int, error) {
 	host := filter.GetResource().GetHost()
 
@@ -549,6 +578,21 @@ func filterHosts(ctx context.Context, client *ent.Client, filter *inv_v1.Resourc
 		return nil, 0, err
 	}
 
+	// FIXME: ITEP-774 better define the behavior of host.Metadata with len == 0
+	filterMeta, err := ParseMetadata(host.Metadata)
+	if err != nil {
+		return nil, 0, err
+	}
+	// Metadata set in the filter resource is matched as equality requirements on the labels, including inherited ones.
+	labelPred, err := getLabelSelectorPredicate(
+		inv_v1.ResourceKind_RESOURCE_KIND_HOST, filter.GetLabelSelector(), metadataLabelRequirements(filterMeta)...)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Principals holding the scoped role only get the resources in their scope.
+	scopePred := scopePredicate(ctx, inv_v1.ResourceKind_RESOURCE_KIND_HOST)
+
 	orderOpts, err := GetOrderByOptions[hosts.OrderOption](filter.GetOrderBy(), hosts.ValidColumn)
 	if err != nil {
 		return nil, 0, err
@@ -559,46 +603,49 @@ func filterHosts(ctx context.Context, client *ent.Client, filter *inv_v1.Resourc
 		return nil, 0, err
 	}
 
-	// FIXME: ITEP-774 better define the behavior of host.Metadata with len == 0
-	filterMeta, err := ParseMetadata(host.Metadata)
+	// Count total number of item without applying pagination limits, order, or loading edges.
+	total, err := client.HostResource.Query().
+		Where(pred, labelPred, scopePred).
+		Count(ctx)
 	if err != nil {
 		return nil, 0, err
 	}
-	isMetadataSet := len(filterMeta) != 0
-
-	var total int
 
-	// perform query - And together all the predicates
+	// perform query - And together all the predicates, loading the edges requested by the read mask
 	query := client.HostResource.Query().
-		WithSite().
-		WithProvider().
-		WithHostStorages().
-		WithHostNics().
-		WithHostUsbs().
-		WithHostGpus().
-		WithInstance(func(query *ent.InstanceResourceQuery) {
+		Where(pred, labelPred, scopePred).
+		Order(orderOpts...)
+	mask := filter.GetReadMask()
+	if inReadMask(mask, computev1.HostResourceEdgeSite) {
+		query.WithSite()
+	}
+	if inReadMask(mask, computev1.HostResourceEdgeProvider) {
+		query.WithProvider()
+	}
+	if inReadMask(mask, computev1.HostResourceEdgeHostStorages) {
+		query.WithHostStorages()
+	}
+	if inReadMask(mask, computev1.HostResourceEdgeHostNics) {
+		query.WithHostNics()
+	}
+	if inReadMask(mask, computev1.HostResourceEdgeHostUsbs) {
+		query.WithHostUsbs()
+	}
+	if inReadMask(mask, computev1.HostResourceEdgeHostGpus) {
+		query.WithHostGpus()
+	}
+	if inReadMask(mask, computev1.HostResourceEdgeInstance) {
+		query.WithInstance(func(query *ent.InstanceResourceQuery) {
 			query.WithOs().
 				WithOsUpdatePolicy()
-		}).
-		Where(pred).
-		Order(orderOpts...)
-	// since metadata filter is applied explicitly in go, rather than via ent query, we need to query all resources,
-	// filter them and apply the offset and limit later.
-	if !isMetadataSet {
-		// Count total number of item without applying pagination limits, order, or loading edges.
-		total, err = client.HostResource.Query().
-			Where(pred).
-			Count(ctx)
-		if err != nil {
-			return nil, 0, err
-		}
+		})
+	}
 
-		// Limits number of query results if existent
-		if limit != 0 {
-			query = query.Limit(limit)
-		}
-		query = query.Offset(offset)
+	// Limits number of query results if existent
+	if limit != 0 {
+		query = query.Limit(limit)
 	}
+	query = query.Offset(offset)
 
 	hostList, err := query.All(ctx)
 	if err != nil {
@@ -611,20 +658,6 @@ func filterHosts(ctx context.Context, client *ent.Client, filter *inv_v1.Resourc
 		return nil, 0, err
 	}
 
-	if isMetadataSet {
-		hostList = filterHostsByMetadata(hostIDMap, phyMeta, logiMeta, filterMeta)
-		total = len(hostList)
-		// Apply offset and limit
-		switch {
-		case limit != 0 && offset+limit <= len(hostList):
-			hostList = hostList[offset : offset+limit]
-		case offset <= len(hostList):
-			hostList = hostList[offset:]
-		default:
-			hostList = make([]*ent.HostResource, 0)
-		}
-	}
-
 	hostWithMetaList := collections.MapSlice[*ent.HostResource, hostWithInheritedMeta](
 		hostList,
 		func(resource *ent.HostResource) hostWithInheritedMeta {
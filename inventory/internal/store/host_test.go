@@ -811,12 +811,26 @@ func Test_UpdateHost(t *testing.T) {
 				HostStatusIndicator: statusv1.StatusIndication_STATUS_INDICATION_IDLE,
 			},
 			resourceID: hostResID,
-			clientName: inv_testing.APIClient,
+			clientName: inv_testing.RMClient,
 			fieldMask: &fieldmaskpb.FieldMask{
 				Paths: []string{computev1.HostResourceFieldHostStatusIndicator},
 			},
 			valid: true,
 		},
+		{
+			// The status of the host is set by the RMs only.
+			name: "UpdateHostStatusFromAPI_Fail",
+			in: &computev1.HostResource{
+				HostStatusIndicator: statusv1.StatusIndication_STATUS_INDICATION_IDLE,
+			},
+			resourceID: hostResID,
+			clientName: inv_testing.APIClient,
+			fieldMask: &fieldmaskpb.FieldMask{
+				Paths: []string{computev1.HostResourceFieldHostStatusIndicator},
+			},
+			valid:        false,
+			expErrorCode: codes.PermissionDenied,
+		},
 		{
 			name: "UpdateHost4",
 			in: &computev1.HostResource{
@@ -15,11 +15,25 @@ import (
 	inv_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/inventory/v1"
 	cl "github.com/open-edge-platform/infra-core/inventory/v2/pkg/client"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/logging"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/util"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/util/collections"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/validator"
 )
 
+func init() {
+	RegisterResourceHandler(ResourceHandler{
+		Kind:      inv_v1.ResourceKind_RESOURCE_KIND_HOSTGPU,
+		Create:    createAs((*InvStore).CreateHostgpu),
+		Get:       getByID((*InvStore).GetHostgpu),
+		Update:    updateAs((*InvStore).UpdateHostgpu),
+		Delete:    deleteByID((*InvStore).DeleteHostgpu),
+		DeleteAll: (*InvStore).DeleteHostGPUs,
+		List:      (*InvStore).ListHostgpus,
+		Filter:    (*InvStore).FilterHostgpus,
+	})
+}
+
 var hostgpuResourceCreationValidators = []resourceValidator[*computev1.HostgpuResource]{
 	protoValidator[*computev1.HostgpuResource],
 	doNotAcceptResourceID[*computev1.HostgpuResource],
@@ -35,7 +49,7 @@ func (is *InvStore) CreateHostgpu(ctx context.Context, in *computev1.HostgpuReso
 		return nil, err
 	}
 
-	zlog.Debug().Msgf("HostGpu Created: %s, %s", res.GetHostgpu().GetResourceId(), res)
+	zlog.Debug().Msgf("HostGpu Created: %s, %s", res.GetHostgpu().GetResourceId(), logging.Redact(res))
 	return res, nil
 }
 
@@ -108,7 +122,7 @@ func getHostgpuQuery(ctx context.Context, tx *ent.Tx, resourceID string) (*ent.H
 func (is *InvStore) UpdateHostgpu(
 	ctx context.Context, id string, in *computev1.HostgpuResource, fieldmask *fieldmaskpb.FieldMask,
 ) (*inv_v1.Resource, error) {
-	zlog.Debug().Msgf("UpdateHostgpu (%s): %v, fm: %v", id, in, fieldmask)
+	zlog.Debug().Msgf("UpdateHostgpu (%s): %v, fm: %v", id, logging.Redact(in), fieldmask)
 
 	return ExecuteInTxAndReturnSingle[inv_v1.Resource](is)(ctx,
 		func(ctx context.Context, tx *ent.Tx) (*inv_v1.Resource, error) {
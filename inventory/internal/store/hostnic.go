@@ -16,11 +16,25 @@ import (
 	inv_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/inventory/v1"
 	cl "github.com/open-edge-platform/infra-core/inventory/v2/pkg/client"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/logging"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/util"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/util/collections"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/validator"
 )
 
+func init() {
+	RegisterResourceHandler(ResourceHandler{
+		Kind:      inv_v1.ResourceKind_RESOURCE_KIND_HOSTNIC,
+		Create:    createAs((*InvStore).CreateHostnic),
+		Get:       getByID((*InvStore).GetHostnic),
+		Update:    updateAs((*InvStore).UpdateHostnic),
+		Delete:    deleteByID((*InvStore).DeleteHostnic),
+		DeleteAll: (*InvStore).DeleteHostNICs,
+		List:      (*InvStore).ListHostnics,
+		Filter:    (*InvStore).FilterHostnics,
+	})
+}
+
 var hostnicResourceCreationValidators = []resourceValidator[*computev1.HostnicResource]{
 	protoValidator[*computev1.HostnicResource],
 	doNotAcceptResourceID[*computev1.HostnicResource],
@@ -47,7 +61,7 @@ func (is *InvStore) CreateHostnic(ctx context.Context, in *computev1.HostnicReso
 		return nil, err
 	}
 
-	zlog.Debug().Msgf("HostNic Created: %s, %s", res.GetHostnic().GetResourceId(), res)
+	zlog.Debug().Msgf("HostNic Created: %s, %s", res.GetHostnic().GetResourceId(), logging.Redact(res))
 	return res, nil
 }
 
@@ -119,7 +133,7 @@ func getHostnic(ctx context.Context, tx *ent.Tx, resourceID string) (*ent.Hostni
 func (is *InvStore) UpdateHostnic(
 	ctx context.Context, id string, in *computev1.HostnicResource, fieldmask *fieldmaskpb.FieldMask,
 ) (*inv_v1.Resource, error) {
-	zlog.Debug().Msgf("UpdateHostnic (%s): %v, fm: %v", id, in, fieldmask)
+	zlog.Debug().Msgf("UpdateHostnic (%s): %v, fm: %v", id, logging.Redact(in), fieldmask)
 
 	return ExecuteInTxAndReturnSingle[inv_v1.Resource](is)(ctx,
 		func(ctx context.Context, tx *ent.Tx) (*inv_v1.Resource, error) {
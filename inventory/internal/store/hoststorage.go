@@ -15,11 +15,25 @@ import (
 	inv_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/inventory/v1"
 	cl "github.com/open-edge-platform/infra-core/inventory/v2/pkg/client"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/logging"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/util"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/util/collections"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/validator"
 )
 
+func init() {
+	RegisterResourceHandler(ResourceHandler{
+		Kind:      inv_v1.ResourceKind_RESOURCE_KIND_HOSTSTORAGE,
+		Create:    createAs((*InvStore).CreateHoststorage),
+		Get:       getByID((*InvStore).GetHoststorage),
+		Update:    updateAs((*InvStore).UpdateHoststorage),
+		Delete:    deleteByID((*InvStore).DeleteHoststorage),
+		DeleteAll: (*InvStore).DeleteHostStorages,
+		List:      (*InvStore).ListHoststorage,
+		Filter:    (*InvStore).FilterHoststorage,
+	})
+}
+
 var hoststorageResourceCreationValidators = []resourceValidator[*computev1.HoststorageResource]{
 	protoValidator[*computev1.HoststorageResource],
 	doNotAcceptResourceID[*computev1.HoststorageResource],
@@ -35,7 +49,7 @@ func (is *InvStore) CreateHoststorage(ctx context.Context, in *computev1.Hoststo
 		return nil, err
 	}
 
-	zlog.Debug().Msgf("HostStorage Created: %s, %s", res.GetHoststorage().GetResourceId(), res)
+	zlog.Debug().Msgf("HostStorage Created: %s, %s", res.GetHoststorage().GetResourceId(), logging.Redact(res))
 	return res, nil
 }
 
@@ -107,7 +121,7 @@ func getHoststorageQuery(ctx context.Context, tx *ent.Tx, resourceID string) (*e
 func (is *InvStore) UpdateHoststorage(
 	ctx context.Context, id string, in *computev1.HoststorageResource, fieldmask *fieldmaskpb.FieldMask,
 ) (*inv_v1.Resource, error) {
-	zlog.Debug().Msgf("UpdateHoststorage (%s): %v, fm: %v", id, in, fieldmask)
+	zlog.Debug().Msgf("UpdateHoststorage (%s): %v, fm: %v", id, logging.Redact(in), fieldmask)
 
 	return ExecuteInTxAndReturnSingle[inv_v1.Resource](is)(ctx,
 		func(ctx context.Context, tx *ent.Tx) (*inv_v1.Resource, error) {
@@ -15,11 +15,25 @@ import (
 	inv_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/inventory/v1"
 	cl "github.com/open-edge-platform/infra-core/inventory/v2/pkg/client"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/logging"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/util"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/util/collections"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/validator"
 )
 
+func init() {
+	RegisterResourceHandler(ResourceHandler{
+		Kind:      inv_v1.ResourceKind_RESOURCE_KIND_HOSTUSB,
+		Create:    createAs((*InvStore).CreateHostusb),
+		Get:       getByID((*InvStore).GetHostusb),
+		Update:    updateAs((*InvStore).UpdateHostusb),
+		Delete:    deleteByID((*InvStore).DeleteHostusb),
+		DeleteAll: (*InvStore).DeleteHostUSBs,
+		List:      (*InvStore).ListHostusb,
+		Filter:    (*InvStore).FilterHostusb,
+	})
+}
+
 var hostusbResourceCreationValidators = []resourceValidator[*computev1.HostusbResource]{
 	protoValidator[*computev1.HostusbResource],
 	doNotAcceptResourceID[*computev1.HostusbResource],
@@ -35,7 +49,7 @@ func (is *InvStore) CreateHostusb(ctx context.Context, in *computev1.HostusbReso
 		return nil, err
 	}
 
-	zlog.Debug().Msgf("HostUsb Created: %s, %s", res.GetHostusb().GetResourceId(), res)
+	zlog.Debug().Msgf("HostUsb Created: %s, %s", res.GetHostusb().GetResourceId(), logging.Redact(res))
 	return res, err
 }
 
@@ -107,7 +121,7 @@ func getHostusbQuery(ctx context.Context, tx *ent.Tx, resourceID string) (*ent.H
 func (is *InvStore) UpdateHostusb(
 	ctx context.Context, id string, in *computev1.HostusbResource, fieldmask *fieldmaskpb.FieldMask,
 ) (*inv_v1.Resource, error) {
-	zlog.Debug().Msgf("UpdateHostusb (%s): %v, fm: %v", id, in, fieldmask)
+	zlog.Debug().Msgf("UpdateHostusb (%s): %v, fm: %v", id, logging.Redact(in), fieldmask)
 
 	return ExecuteInTxAndReturnSingle[inv_v1.Resource](is)(ctx,
 		func(ctx context.Context, tx *ent.Tx) (*inv_v1.Resource, error) {
@@ -22,11 +22,25 @@ import (
 	statusv1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/status/v1"
 	cl "github.com/open-edge-platform/infra-core/inventory/v2/pkg/client"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/logging"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/util"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/util/collections"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/validator"
 )
 
+func init() {
+	RegisterResourceHandler(ResourceHandler{
+		Kind:      inv_v1.ResourceKind_RESOURCE_KIND_INSTANCE,
+		Create:    createAs((*InvStore).CreateInstance),
+		Get:       getByID((*InvStore).GetInstance),
+		Update:    updateAsWithHardDelete((*InvStore).UpdateInstance),
+		Delete:    deleteByIDWithSoftDelete((*InvStore).DeleteInstance),
+		DeleteAll: (*InvStore).DeleteInstances,
+		List:      (*InvStore).ListInstances,
+		Filter:    (*InvStore).FilterInstances,
+	})
+}
+
 var instanceResourceCreationValidators = []resourceValidator[*computev1.InstanceResource]{
 	protoValidator[*computev1.InstanceResource],
 	validateInstanceProto,
@@ -93,7 +107,7 @@ func (is *InvStore) CreateInstance(ctx context.Context, in *computev1.InstanceRe
 		return nil, err
 	}
 
-	zlog.Debug().Msgf("Instance Created: %s, %s", res.GetInstance().GetResourceId(), res)
+	zlog.Debug().Msgf("Instance Created: %s, %s", res.GetInstance().GetResourceId(), logging.Redact(res))
 	return res, nil
 }
 
@@ -221,7 +235,7 @@ func (is *InvStore) UpdateInstance(
 		return nil, false, errors.Wrap(err)
 	}
 
-	zlog.Debug().Msgf("UpdateInstance (%s): %v, fm: %v", id, in, fieldmask)
+	zlog.Debug().Msgf("UpdateInstance (%s): %v, fm: %v", id, logging.Redact(in), fieldmask)
 
 	res, hardDelete, err := ExecuteInTxAndReturnDouble[inv_v1.Resource, bool](is)(ctx,
 		func(ctx context.Context, tx *ent.Tx) (*inv_v1.Resource, *bool, error) {
@@ -449,6 +463,9 @@ func filterInstances(ctx context.Context, client *ent.Client, filter *inv_v1.Res
 		return nil, 0, err
 	}
 
+	// Principals holding the scoped role only get the resources in their scope.
+	scopePred := scopePredicate(ctx, inv_v1.ResourceKind_RESOURCE_KIND_INSTANCE)
+
 	orderOpts, err := GetOrderByOptions[instanceresource.OrderOption](filter.GetOrderBy(), instanceresource.ValidColumn)
 	if err != nil {
 		return nil, 0, err
@@ -459,22 +476,35 @@ func filterInstances(ctx context.Context, client *ent.Client, filter *inv_v1.Res
 		return nil, 0, err
 	}
 
-	// perform query - And together all the predicates with eager loading
+	// perform query - And together all the predicates with eager loading of the edges requested by the read mask
 	query := client.Debug().InstanceResource.Query().
-		WithHost(func(q *ent.HostResourceQuery) {
+		Where(pred, scopePred).
+		Order(orderOpts...).
+		Offset(offset)
+	mask := filter.GetReadMask()
+	if inReadMask(mask, computev1.InstanceResourceEdgeHost) {
+		query.WithHost(func(q *ent.HostResourceQuery) {
 			q.WithSite()     // Populate the site of each host
 			q.WithProvider() // Populate the provider of each host
-		}).
-		WithOs().
-		WithWorkloadMembers(func(q *ent.WorkloadMemberQuery) {
+		})
+	}
+	if inReadMask(mask, computev1.InstanceResourceEdgeOs) {
+		query.WithOs()
+	}
+	if inReadMask(mask, computev1.InstanceResourceEdgeWorkloadMembers) {
+		query.WithWorkloadMembers(func(q *ent.WorkloadMemberQuery) {
 			q.WithWorkload() // Populate the workload of each member
-		}).
-		WithProvider().
-		WithLocalaccount().
-		WithOsUpdatePolicy().
-		Where(pred).
-		Order(orderOpts...).
-		Offset(offset)
+		})
+	}
+	if inReadMask(mask, computev1.InstanceResourceEdgeProvider) {
+		query.WithProvider()
+	}
+	if inReadMask(mask, computev1.InstanceResourceEdgeLocalaccount) {
+		query.WithLocalaccount()
+	}
+	if inReadMask(mask, computev1.InstanceResourceEdgeOsUpdatePolicy) {
+		query.WithOsUpdatePolicy()
+	}
 
 	// Limits number of query results if existent
 	if limit != 0 {
@@ -488,7 +518,7 @@ func filterInstances(ctx context.Context, client *ent.Client, filter *inv_v1.Res
 
 	// Count total number of item without applying pagination limits, order, or loading edges.
 	total, err := client.InstanceResource.Query().
-		Where(pred).
+		Where(pred, scopePred).
 		Count(ctx)
 	if err != nil {
 		return nil, 0, errors.Wrap(err)
@@ -18,11 +18,25 @@ import (
 	network_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/network/v1"
 	cl "github.com/open-edge-platform/infra-core/inventory/v2/pkg/client"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/logging"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/util"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/util/collections"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/validator"
 )
 
+func init() {
+	RegisterResourceHandler(ResourceHandler{
+		Kind:      inv_v1.ResourceKind_RESOURCE_KIND_IPADDRESS,
+		Create:    createAs((*InvStore).CreateIPAddress),
+		Get:       getByID((*InvStore).GetIPAddress),
+		Update:    updateAsWithHardDelete((*InvStore).UpdateIPAddress),
+		Delete:    deleteByIDWithSoftDelete((*InvStore).DeleteIPAddress),
+		DeleteAll: (*InvStore).DeleteIPAddresses,
+		List:      (*InvStore).ListIPAddress,
+		Filter:    (*InvStore).FilterIPAddress,
+	})
+}
+
 // ip_address.go  store logic for ipaddress
 
 var ipAddressResourceCreationValidators = []resourceValidator[*network_v1.IPAddressResource]{
@@ -58,7 +72,7 @@ func (is *InvStore) CreateIPAddress(ctx context.Context, in *network_v1.IPAddres
 		return nil, err
 	}
 
-	zlog.Debug().Msgf("IpAddress Created: %s, %s", res.GetIpaddress().GetResourceId(), res)
+	zlog.Debug().Msgf("IpAddress Created: %s, %s", res.GetIpaddress().GetResourceId(), logging.Redact(res))
 
 	return res, nil
 }
@@ -149,7 +163,7 @@ func (is *InvStore) UpdateIPAddress(
 		return nil, false, err
 	}
 
-	zlog.Debug().Msgf("UpdateIPAddress (%s): %v, fm: %v", id, in, fieldmask)
+	zlog.Debug().Msgf("UpdateIPAddress (%s): %v, fm: %v", id, logging.Redact(in), fieldmask)
 
 	res, hardDelete, err := ExecuteInTxAndReturnDouble[inv_v1.Resource, bool](is)(ctx,
 		func(ctx context.Context, tx *ent.Tx) (*inv_v1.Resource, *bool, error) {
@@ -0,0 +1,71 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package store
+
+import (
+	"context"
+	"slices"
+
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent"
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/resourcelabel"
+	inv_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/inventory/v1"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/util"
+)
+
+// Labels are the normalized form of the metadata of hosts, sites, regions and OUs. The metadata field remains the
+// source of truth exposed through the API, the resource_labels table is rewritten from it on every write of the
+// metadata and is only used to evaluate label selectors in the database.
+
+// writesMetadata tells whether an update with the field mask writes the metadata, the field named metadataField: when
+// the mask holds it, or when the mask is empty as all the fields set in the resource are then written.
+func writesMetadata(fieldmask *fieldmaskpb.FieldMask, metadataField string) bool {
+	return len(fieldmask.GetPaths()) == 0 || slices.Contains(fieldmask.GetPaths(), metadataField)
+}
+
+// syncResourceLabels replaces the labels of the given resource with the key/value pairs of its metadata.
+func syncResourceLabels(ctx context.Context, client *ent.Client, tenantID, resourceID, metadata string) error {
+	metaMap, err := ParseMetadata(metadata)
+	if err != nil {
+		return err
+	}
+	if err := deleteResourceLabels(ctx, client, resourceID); err != nil {
+		return err
+	}
+	if len(metaMap) == 0 {
+		return nil
+	}
+	builders := make([]*ent.ResourceLabelCreate, 0, len(metaMap))
+	for key, value := range metaMap {
+		builders = append(builders, client.ResourceLabel.Create().
+			SetResourceID(resourceID).
+			SetKey(key).
+			SetValue(value).
+			SetTenantID(tenantID))
+	}
+	if _, err := client.ResourceLabel.CreateBulk(builders...).Save(ctx); err != nil {
+		zlog.InfraSec().InfraErr(err).Msgf("failed to store labels of %s", resourceID)
+		return errors.Wrap(err)
+	}
+	return nil
+}
+
+// deleteResourceLabels removes all the labels of the given resource.
+func deleteResourceLabels(ctx context.Context, client *ent.Client, resourceID string) error {
+	_, err := client.ResourceLabel.Delete().Where(resourcelabel.ResourceID(resourceID)).Exec(ctx)
+	return errors.Wrap(err)
+}
+
+// deleteTenantResourceLabels removes the labels of all the resources of the given kind owned by the tenant.
+func deleteTenantResourceLabels(ctx context.Context, client *ent.Client, tenantID string, kind inv_v1.ResourceKind) error {
+	_, err := client.ResourceLabel.Delete().
+		Where(
+			resourcelabel.TenantID(tenantID),
+			resourcelabel.ResourceIDHasPrefix(string(util.ResourceKindToPrefix(kind))+"-"),
+		).
+		Exec(ctx)
+	return errors.Wrap(err)
+}
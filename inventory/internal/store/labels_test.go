@@ -0,0 +1,313 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package store_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/hostresource"
+	computev1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/compute/v1"
+	inv_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/inventory/v1"
+	location_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/location/v1"
+	ou_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/ou/v1"
+	inv_testing "github.com/open-edge-platform/infra-core/inventory/v2/pkg/testing"
+)
+
+func findResourceIDs(t *testing.T, filter *inv_v1.ResourceFilter) ([]string, error) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	findRes, err := inv_testing.TestClients[inv_testing.APIClient].Find(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(findRes.GetResources()))
+	for _, res := range findRes.GetResources() {
+		ids = append(ids, res.GetResourceId())
+	}
+	return ids, nil
+}
+
+//nolint:funlen // length due to test cases
+func Test_LabelSelector_Host(t *testing.T) {
+	region1 := inv_testing.CreateRegionWithMeta(t, metaR1, nil)
+	region2 := inv_testing.CreateRegionWithMeta(t, metaR2, region1)
+	ou1 := inv_testing.CreateOuWithMeta(t, metaO1, nil)
+
+	siteOnlyPhy := inv_testing.CreateSite(t, region2, nil)
+	siteOnlyLogi := inv_testing.CreateSite(t, nil, ou1)
+	siteBoth := inv_testing.CreateSiteWithMeta(t, metaO5, region1, ou1)
+
+	h1 := inv_testing.CreateHost(t, siteOnlyPhy, nil)
+	h2 := inv_testing.CreateHost(t, siteOnlyLogi, nil)
+	h3 := inv_testing.CreateHost(t, siteBoth, nil)
+	h4 := inv_testing.CreateHostWithMetadata(t, metaHost1, nil, nil, true)
+
+	testcases := map[string]struct {
+		selector string
+		expected []string
+		code     codes.Code
+	}{
+		"Equality": {
+			// Standalone metadata of the host.
+			selector: "key4-test=host_key4-test",
+			expected: []string{h4.GetResourceId()},
+		},
+		"EqualityDoubleEquals": {
+			selector: "key4-test==host_key4-test",
+			expected: []string{h4.GetResourceId()},
+		},
+		"EqualityInheritedClosestRegion": {
+			selector: "key1-test=region_key1_lvl2-test",
+			expected: []string{h1.GetResourceId()},
+		},
+		"EqualityInheritedOverridden": {
+			// Overridden by the closer region2 for h1, and by the site for h3.
+			selector: "key1-test=region_key1_lvl1-test",
+			expected: []string{},
+		},
+		"EqualityInheritedSite": {
+			selector: "key1-test=ou_key1_lvl4-test",
+			expected: []string{h3.GetResourceId()},
+		},
+		"EqualityInheritedLogical": {
+			selector: "key3-test=ou_key3_lvl1-test",
+			expected: []string{h2.GetResourceId()},
+		},
+		"EqualityPhysicalWinsOverLogical": {
+			selector: "key2-test=region_key2_lvl1-test",
+			expected: []string{h3.GetResourceId()},
+		},
+		"NotEquals": {
+			// Hosts without the key match as well.
+			selector: "key4-test!=region_key4_lvl2-test",
+			expected: []string{h2.GetResourceId(), h3.GetResourceId(), h4.GetResourceId()},
+		},
+		"In": {
+			selector: "key1-test in (region_key1_lvl2-test, ou_key1_lvl1-test)",
+			expected: []string{h1.GetResourceId(), h2.GetResourceId()},
+		},
+		"NotIn": {
+			selector: "key1-test notin (region_key1_lvl2-test,ou_key1_lvl1-test)",
+			expected: []string{h3.GetResourceId(), h4.GetResourceId()},
+		},
+		"Exists": {
+			selector: "key4-test",
+			expected: []string{h1.GetResourceId(), h4.GetResourceId()},
+		},
+		"DoesNotExist": {
+			selector: "!key4-test",
+			expected: []string{h2.GetResourceId(), h3.GetResourceId()},
+		},
+		"Combined": {
+			selector: "key1-test, key3-test in (region_key3_lvl1-test), !key4-test",
+			expected: []string{h3.GetResourceId()},
+		},
+		"InvalidUnbalanced": {
+			selector: "key1-test in (a",
+			code:     codes.InvalidArgument,
+		},
+		"InvalidOperator": {
+			selector: "key1-test > 1",
+			code:     codes.InvalidArgument,
+		},
+		"InvalidEmptyRequirement": {
+			selector: "key1-test=a,",
+			code:     codes.InvalidArgument,
+		},
+		"InvalidKey": {
+			selector: "Key1=a",
+			code:     codes.InvalidArgument,
+		},
+	}
+
+	for tcname, tc := range testcases {
+		t.Run(tcname, func(t *testing.T) {
+			ids, err := findResourceIDs(t, &inv_v1.ResourceFilter{
+				Resource:      &inv_v1.Resource{Resource: &inv_v1.Resource_Host{}},
+				LabelSelector: tc.selector,
+			})
+			if tc.code != codes.OK {
+				require.Error(t, err)
+				assert.Equal(t, tc.code, status.Code(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.ElementsMatch(t, tc.expected, ids)
+		})
+	}
+}
+
+func Test_LabelSelector_Site_Region_Ou(t *testing.T) {
+	region1 := inv_testing.CreateRegionWithMeta(t, metaR1, nil)
+	region2 := inv_testing.CreateRegionWithMeta(t, metaR2, region1)
+	ou1 := inv_testing.CreateOuWithMeta(t, metaO1, nil)
+	ou2 := inv_testing.CreateOuWithMeta(t, metaO2, ou1)
+	site := inv_testing.CreateSiteWithMeta(t, metaO5, region2, ou2)
+
+	testcases := map[string]struct {
+		resource *inv_v1.Resource
+		selector string
+		expected []string
+	}{
+		"Site": {
+			resource: &inv_v1.Resource{Resource: &inv_v1.Resource_Site{}},
+			selector: "key1-test=ou_key1_lvl4-test,key3-test=region_key3_lvl1-test,key4-test=region_key4_lvl2-test",
+			expected: []string{site.GetResourceId()},
+		},
+		"Region": {
+			resource: &inv_v1.Resource{Resource: &inv_v1.Resource_Region{}},
+			selector: "key3-test=region_key3_lvl1-test",
+			expected: []string{region1.GetResourceId(), region2.GetResourceId()},
+		},
+		"RegionOverridden": {
+			resource: &inv_v1.Resource{Resource: &inv_v1.Resource_Region{}},
+			selector: "key2-test=region_key2_lvl1-test",
+			expected: []string{region1.GetResourceId()},
+		},
+		"Ou": {
+			resource: &inv_v1.Resource{Resource: &inv_v1.Resource_Ou{}},
+			selector: "key4-test",
+			expected: []string{ou2.GetResourceId()},
+		},
+	}
+
+	for tcname, tc := range testcases {
+		t.Run(tcname, func(t *testing.T) {
+			ids, err := findResourceIDs(t, &inv_v1.ResourceFilter{Resource: tc.resource, LabelSelector: tc.selector})
+			require.NoError(t, err)
+			assert.ElementsMatch(t, tc.expected, ids)
+		})
+	}
+
+	_, err := findResourceIDs(t, &inv_v1.ResourceFilter{
+		Resource:      &inv_v1.Resource{Resource: &inv_v1.Resource_Instance{}},
+		LabelSelector: "key1-test",
+	})
+	require.Error(t, err, "label selectors are not supported for instances")
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func Test_LabelSelector_FollowsMetadataUpdates(t *testing.T) {
+	region := inv_testing.CreateRegionWithMeta(t, metaR5, nil)
+	site := inv_testing.CreateSite(t, region, nil)
+	host := inv_testing.CreateHostWithMetadata(t, metaHost2, site, nil, true)
+
+	hostFilter := func(selector string) *inv_v1.ResourceFilter {
+		return &inv_v1.ResourceFilter{
+			Resource:      &inv_v1.Resource{Resource: &inv_v1.Resource_Host{}},
+			LabelSelector: selector,
+		}
+	}
+
+	ids, err := findResourceIDs(t, hostFilter("key3-test=host_key3_mod-test"))
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{host.GetResourceId()}, ids)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	// Updating the host metadata replaces its labels, dropping key1 uncovers the inherited one.
+	_, err = inv_testing.TestClients[inv_testing.APIClient].Update(
+		ctx,
+		host.GetResourceId(),
+		&fieldmaskpb.FieldMask{Paths: []string{hostresource.FieldMetadata}},
+		&inv_v1.Resource{Resource: &inv_v1.Resource_Host{Host: &computev1.HostResource{
+			Metadata: `[{"key":"key3-test","value":"host_key3-test"}]`,
+		}}},
+	)
+	require.NoError(t, err)
+
+	ids, err = findResourceIDs(t, hostFilter("key3-test=host_key3_mod-test"))
+	require.NoError(t, err)
+	assert.Empty(t, ids)
+	ids, err = findResourceIDs(t, hostFilter("key3-test=host_key3-test,key1-test=region_key1_lvl4-test,!key2-test"))
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{host.GetResourceId()}, ids)
+
+	// Updating the region metadata is reflected on the inherited labels of the host.
+	_, err = inv_testing.TestClients[inv_testing.APIClient].Update(
+		ctx,
+		region.GetResourceId(),
+		&fieldmaskpb.FieldMask{Paths: []string{"metadata"}},
+		&inv_v1.Resource{Resource: &inv_v1.Resource_Region{Region: &location_v1.RegionResource{
+			Metadata: `[{"key":"key5-test","value":"region_key5-test"}]`,
+		}}},
+	)
+	require.NoError(t, err)
+
+	ids, err = findResourceIDs(t, hostFilter("key5-test=region_key5-test,!key1-test"))
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{host.GetResourceId()}, ids)
+
+	// Clearing the OU metadata removes its labels.
+	ou := inv_testing.CreateOuWithMeta(t, metaO5, nil)
+	_, err = inv_testing.TestClients[inv_testing.APIClient].Update(
+		ctx,
+		ou.GetResourceId(),
+		&fieldmaskpb.FieldMask{Paths: []string{"metadata"}},
+		&inv_v1.Resource{Resource: &inv_v1.Resource_Ou{Ou: &ou_v1.OuResource{}}},
+	)
+	require.NoError(t, err)
+
+	ids, err = findResourceIDs(t, &inv_v1.ResourceFilter{
+		Resource:      &inv_v1.Resource{Resource: &inv_v1.Resource_Ou{}},
+		LabelSelector: "key1-test",
+	})
+	require.NoError(t, err)
+	assert.Empty(t, ids)
+}
+
+func Test_LabelSelector_FollowsMasklessMetadataUpdates(t *testing.T) {
+	region := inv_testing.CreateRegionWithMeta(t, metaR5, nil)
+	site := inv_testing.CreateSiteWithMeta(t, metaO5, nil, nil)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	// Updates without a field mask, or with an empty one, write all the fields set, the metadata included.
+	_, err := inv_testing.TestClients[inv_testing.APIClient].Update(
+		ctx,
+		site.GetResourceId(),
+		nil,
+		&inv_v1.Resource{Resource: &inv_v1.Resource_Site{Site: &location_v1.SiteResource{
+			Name:     "site-maskless",
+			Metadata: `[{"key":"key6-test","value":"site_key6-test"}]`,
+		}}},
+	)
+	require.NoError(t, err)
+	_, err = inv_testing.TestClients[inv_testing.APIClient].Update(
+		ctx,
+		region.GetResourceId(),
+		&fieldmaskpb.FieldMask{},
+		&inv_v1.Resource{Resource: &inv_v1.Resource_Region{Region: &location_v1.RegionResource{
+			Name:     "region-maskless",
+			Metadata: `[{"key":"key6-test","value":"region_key6-test"}]`,
+		}}},
+	)
+	require.NoError(t, err)
+
+	ids, err := findResourceIDs(t, &inv_v1.ResourceFilter{
+		Resource:      &inv_v1.Resource{Resource: &inv_v1.Resource_Site{}},
+		LabelSelector: "key6-test=site_key6-test,!key1-test",
+	})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{site.GetResourceId()}, ids)
+
+	ids, err = findResourceIDs(t, &inv_v1.ResourceFilter{
+		Resource:      &inv_v1.Resource{Resource: &inv_v1.Resource_Region{}},
+		LabelSelector: "key6-test=region_key6-test,!key1-test",
+	})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{region.GetResourceId()}, ids)
+}
@@ -0,0 +1,303 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package store
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"entgo.io/ent/dialect/sql"
+	"google.golang.org/grpc/codes"
+
+	inv_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/inventory/v1"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
+)
+
+// Label selectors follow the syntax of the Kubernetes label selectors, see ResourceFilter.label_selector.
+// Each requirement is evaluated against the effective value of its key: the value of the label on the resource itself
+// or, if unset, the one inherited from the closest ancestor. Physical ancestors (site, regions) take precedence over
+// logical ones (OUs), consistently with the rendered metadata.
+
+type labelOperator int
+
+const (
+	labelOpEquals labelOperator = iota
+	labelOpNotEquals
+	labelOpIn
+	labelOpNotIn
+	labelOpExists
+	labelOpDoesNotExist
+)
+
+type labelRequirement struct {
+	key      string
+	operator labelOperator
+	values   []string
+}
+
+var (
+	labelSetRequirementRegexp      = regexp.MustCompile(`^([^\s!=(),]+)\s+(in|notin)\s*\(([^()]*)\)$`)
+	labelEqualityRequirementRegexp = regexp.MustCompile(`^([^\s!=(),]+)\s*(==|=|!=)\s*([^\s!=(),]*)$`)
+	labelExistsRequirementRegexp   = regexp.MustCompile(`^(!?)\s*([^\s!=(),]+)$`)
+)
+
+// parseLabelSelector parses the given selector into its requirements. Returns an InvalidArgument error if the selector
+// is malformed or uses keys or values that are not valid metadata.
+func parseLabelSelector(selector string) ([]labelRequirement, error) {
+	rawRequirements, err := splitLabelSelector(selector)
+	if err != nil {
+		return nil, err
+	}
+	requirements := make([]labelRequirement, 0, len(rawRequirements))
+	for _, raw := range rawRequirements {
+		req, err := parseLabelRequirement(raw)
+		if err != nil {
+			return nil, err
+		}
+		requirements = append(requirements, req)
+	}
+	return requirements, nil
+}
+
+// splitLabelSelector splits the selector on the commas that are not part of a set of values.
+func splitLabelSelector(selector string) ([]string, error) {
+	var parts []string
+	depth, start := 0, 0
+	for i, c := range selector {
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				parts = append(parts, strings.TrimSpace(selector[start:i]))
+				start = i + 1
+			}
+		}
+		if depth < 0 || depth > 1 {
+			return nil, errors.Errorfc(codes.InvalidArgument, "invalid label selector %q: unbalanced parentheses", selector)
+		}
+	}
+	if depth != 0 {
+		return nil, errors.Errorfc(codes.InvalidArgument, "invalid label selector %q: unbalanced parentheses", selector)
+	}
+	return append(parts, strings.TrimSpace(selector[start:])), nil
+}
+
+func parseLabelRequirement(raw string) (labelRequirement, error) {
+	var req labelRequirement
+	if m := labelSetRequirementRegexp.FindStringSubmatch(raw); m != nil {
+		req.key = m[1]
+		req.operator = labelOpIn
+		if m[2] == "notin" {
+			req.operator = labelOpNotIn
+		}
+		for _, v := range strings.Split(m[3], ",") {
+			req.values = append(req.values, strings.TrimSpace(v))
+		}
+	} else if m := labelEqualityRequirementRegexp.FindStringSubmatch(raw); m != nil {
+		req.key = m[1]
+		req.operator = labelOpEquals
+		if m[2] == "!=" {
+			req.operator = labelOpNotEquals
+		}
+		req.values = []string{m[3]}
+	} else if m := labelExistsRequirementRegexp.FindStringSubmatch(raw); m != nil {
+		req.key = m[2]
+		req.operator = labelOpExists
+		if m[1] == "!" {
+			req.operator = labelOpDoesNotExist
+		}
+	} else {
+		zlog.InfraSec().InfraError("invalid label selector requirement %q", raw).Msg("")
+		return req, errors.Errorfc(codes.InvalidArgument, "invalid label selector requirement %q", raw)
+	}
+
+	if err := validateKeyValue(labelRequirementAsMetadata(req)); err != nil {
+		zlog.InfraSec().InfraErr(err).Msgf("invalid label selector requirement %q", raw)
+		return req, errors.Errorfc(codes.InvalidArgument, "invalid label selector requirement %q", raw)
+	}
+	return req, nil
+}
+
+// labelRequirementAsMetadata returns the key and values of the requirement as metadata, for validation purposes.
+func labelRequirementAsMetadata(req labelRequirement) []Metadata {
+	if len(req.values) == 0 {
+		return []Metadata{{Key: req.key}}
+	}
+	meta := make([]Metadata, 0, len(req.values))
+	for _, v := range req.values {
+		meta = append(meta, Metadata{Key: req.key, Value: v})
+	}
+	return meta
+}
+
+// metadataLabelRequirements returns the equality requirements matching all the given metadata.
+func metadataLabelRequirements(metaMap map[string]string) []labelRequirement {
+	keys := make([]string, 0, len(metaMap))
+	for k := range metaMap {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	requirements := make([]labelRequirement, 0, len(keys))
+	for _, k := range keys {
+		requirements = append(requirements, labelRequirement{key: k, operator: labelOpEquals, values: []string{metaMap[k]}})
+	}
+	return requirements
+}
+
+// labelScope writes an SQL expression evaluating to the value of the label with the given key on one level of the
+// hierarchy of a resource, or NULL if unset.
+type labelScope func(b *sql.Builder, key string)
+
+// ownLabelScope returns the label of the resource whose resource ID is held by the given column.
+func ownLabelScope(resourceIDColumn string) labelScope {
+	return func(b *sql.Builder, key string) {
+		b.WriteString(`(SELECT l."value" FROM "resource_labels" AS l WHERE l."resource_id" = ` + resourceIDColumn +
+			` AND l."key" = `).Arg(key).WriteString(")")
+	}
+}
+
+// siteLabelScope returns the label of the site whose ID is held by the given column.
+func siteLabelScope(siteIDColumn string) labelScope {
+	return func(b *sql.Builder, key string) {
+		b.WriteString(`(SELECT l."value" FROM "site_resources" AS s JOIN "resource_labels" AS l ` +
+			`ON l."resource_id" = s."resource_id" WHERE s."id" = ` + siteIDColumn + ` AND l."key" = `).
+			Arg(key).WriteString(")")
+	}
+}
+
+// chainLabelScope returns the label of the closest resource of the given self-referencing table, walking the
+// parents up from the resource whose ID is given by startExpr.
+func chainLabelScope(table, parentColumn, startExpr string) labelScope {
+	return func(b *sql.Builder, key string) {
+		b.WriteString(fmt.Sprintf(`(WITH RECURSIVE chain AS (`+
+			`SELECT a."id", a."resource_id", a.%[2]q AS parent_id, 0 AS depth FROM %[1]q AS a WHERE a."id" = %[3]s `+
+			`UNION ALL `+
+			`SELECT r."id", r."resource_id", r.%[2]q AS parent_id, c.depth+1 AS depth `+
+			`FROM %[1]q AS r JOIN chain AS c ON c.parent_id = r."id") `+
+			`SELECT l."value" FROM chain AS c JOIN "resource_labels" AS l ON l."resource_id" = c."resource_id" `+
+			`WHERE l."key" = `, table, parentColumn, startExpr)).
+			Arg(key).WriteString(" ORDER BY c.depth LIMIT 1)")
+	}
+}
+
+// labelScopes lists, per supported resource kind, the levels of the hierarchy labels are inherited from, in order
+// of precedence.
+var labelScopes = map[inv_v1.ResourceKind]func(s *sql.Selector) []labelScope{
+	inv_v1.ResourceKind_RESOURCE_KIND_HOST: func(s *sql.Selector) []labelScope {
+		siteColumn := s.C("host_resource_site")
+		return []labelScope{
+			ownLabelScope(s.C("resource_id")),
+			siteLabelScope(siteColumn),
+			chainLabelScope("region_resources", "region_resource_parent_region",
+				`(SELECT hs."site_resource_region" FROM "site_resources" AS hs WHERE hs."id" = `+siteColumn+`)`),
+			chainLabelScope("ou_resources", "ou_resource_parent_ou",
+				`(SELECT hs."site_resource_ou" FROM "site_resources" AS hs WHERE hs."id" = `+siteColumn+`)`),
+		}
+	},
+	inv_v1.ResourceKind_RESOURCE_KIND_SITE: func(s *sql.Selector) []labelScope {
+		return []labelScope{
+			ownLabelScope(s.C("resource_id")),
+			chainLabelScope("region_resources", "region_resource_parent_region", s.C("site_resource_region")),
+			chainLabelScope("ou_resources", "ou_resource_parent_ou", s.C("site_resource_ou")),
+		}
+	},
+	inv_v1.ResourceKind_RESOURCE_KIND_REGION: func(s *sql.Selector) []labelScope {
+		return []labelScope{
+			chainLabelScope("region_resources", "region_resource_parent_region", s.C("id")),
+		}
+	},
+	inv_v1.ResourceKind_RESOURCE_KIND_OU: func(s *sql.Selector) []labelScope {
+		return []labelScope{
+			chainLabelScope("ou_resources", "ou_resource_parent_ou", s.C("id")),
+		}
+	},
+}
+
+// validateLabelSelectorKind returns an InvalidArgument error if a label selector is given for a resource kind that
+// does not support labels.
+func validateLabelSelectorKind(kind inv_v1.ResourceKind, selector string) error {
+	if selector == "" {
+		return nil
+	}
+	if _, ok := labelScopes[kind]; !ok {
+		zlog.InfraSec().InfraError("label selectors are not supported for %s", kind).Msg("")
+		return errors.Errorfc(codes.InvalidArgument, "label selectors are not supported for %s", kind)
+	}
+	return nil
+}
+
+// getLabelSelectorPredicate returns the predicate matching the resources of the given kind that satisfy both the
+// selector and the additional requirements.
+func getLabelSelectorPredicate(
+	kind inv_v1.ResourceKind, selector string, requirements ...labelRequirement,
+) (func(*sql.Selector), error) {
+	if err := validateLabelSelectorKind(kind, selector); err != nil {
+		return nil, err
+	}
+	if selector != "" {
+		parsed, err := parseLabelSelector(selector)
+		if err != nil {
+			return nil, err
+		}
+		requirements = append(requirements, parsed...)
+	}
+	if len(requirements) == 0 {
+		return noopSelector, nil
+	}
+	getScopes := labelScopes[kind]
+	return func(s *sql.Selector) {
+		scopes := getScopes(s)
+		preds := make([]*sql.Predicate, 0, len(requirements))
+		for _, req := range requirements {
+			preds = append(preds, labelRequirementPredicate(req, scopes))
+		}
+		s.Where(sql.And(preds...))
+	}, nil
+}
+
+func labelRequirementPredicate(req labelRequirement, scopes []labelScope) *sql.Predicate {
+	return sql.P(func(b *sql.Builder) {
+		effectiveValue := func() {
+			b.WriteString("COALESCE(")
+			for i, scope := range scopes {
+				if i > 0 {
+					b.Comma()
+				}
+				scope(b, req.key)
+			}
+			b.WriteString(")")
+		}
+		values := make([]any, 0, len(req.values))
+		for _, v := range req.values {
+			values = append(values, v)
+		}
+		switch req.operator {
+		case labelOpEquals:
+			effectiveValue()
+			b.WriteString(" = ").Arg(values[0])
+		case labelOpNotEquals:
+			effectiveValue()
+			b.WriteString(" IS DISTINCT FROM ").Arg(values[0])
+		case labelOpIn:
+			effectiveValue()
+			b.WriteString(" IN (").Args(values...).WriteString(")")
+		case labelOpNotIn:
+			// A missing label is not in any set.
+			b.WriteString("COALESCE(")
+			effectiveValue()
+			b.WriteString(" NOT IN (").Args(values...).WriteString("), TRUE)")
+		case labelOpExists:
+			effectiveValue()
+			b.WriteString(" IS NOT NULL")
+		case labelOpDoesNotExist:
+			effectiveValue()
+			b.WriteString(" IS NULL")
+		}
+	})
+}
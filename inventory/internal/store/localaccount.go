@@ -7,6 +7,7 @@ import (
 	"context"
 
 	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 
 	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent"
 	instances "github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/instanceresource"
@@ -15,11 +16,25 @@ import (
 	localaccount_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/localaccount/v1"
 	cl "github.com/open-edge-platform/infra-core/inventory/v2/pkg/client"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/logging"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/util"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/util/collections"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/validator"
 )
 
+func init() {
+	RegisterResourceHandler(ResourceHandler{
+		Kind:      inv_v1.ResourceKind_RESOURCE_KIND_LOCALACCOUNT,
+		Create:    createAs((*InvStore).CreateLocalAccount),
+		Get:       getByID((*InvStore).GetLocalAccount),
+		Update:    updateAs((*InvStore).UpdateLocalAccount),
+		Delete:    deleteByID((*InvStore).DeleteLocalAccount),
+		DeleteAll: (*InvStore).DeleteLocalAccounts,
+		List:      (*InvStore).ListLocalAccounts,
+		Filter:    (*InvStore).FilterLocalAccounts,
+	})
+}
+
 var localAccountResourceCreationValidators = []resourceValidator[*localaccount_v1.LocalAccountResource]{
 	protoValidator[*localaccount_v1.LocalAccountResource],
 	doNotAcceptResourceID[*localaccount_v1.LocalAccountResource],
@@ -35,7 +50,7 @@ func (is *InvStore) CreateLocalAccount(ctx context.Context, in *localaccount_v1.
 		return nil, err
 	}
 
-	zlog.Debug().Msgf("LocalAccount Created: %s, %s", res.GetLocalAccount().GetResourceId(), res)
+	zlog.Debug().Msgf("LocalAccount Created: %s, %s", res.GetLocalAccount().GetResourceId(), logging.Redact(res))
 	return res, nil
 }
 
@@ -98,6 +113,61 @@ func (is *InvStore) GetLocalAccount(ctx context.Context, id string) (*inv_v1.Res
 	return &inv_v1.Resource{Resource: &inv_v1.Resource_LocalAccount{LocalAccount: apiResource}}, nil
 }
 
+func (is *InvStore) UpdateLocalAccount(
+	ctx context.Context, id string, in *localaccount_v1.LocalAccountResource, fieldmask *fieldmaskpb.FieldMask,
+) (*inv_v1.Resource, error) {
+	zlog.Debug().Msgf("UpdateLocalAccount (%s): %v, fm: %v", id, logging.Redact(in), fieldmask)
+	res, err := ExecuteInTxAndReturnSingle[inv_v1.Resource](is)(ctx,
+		func(ctx context.Context, tx *ent.Tx) (*inv_v1.Resource, error) {
+			entity, err := getLocalAccountQuery(ctx, tx, id)
+			if err != nil {
+				return nil, err
+			}
+
+			// The username is provisioned on the instances using the local account,
+			// the SSH key can instead be rotated at any time.
+			if err := verifyMutableWhileReferenced(ctx, entLocalAccountResourceToProtoLocalAccountResource(entity),
+				in, fieldmask, isLocalAccountReferenced(tx, id), localaccounts.FieldUsername); err != nil {
+				return nil, err
+			}
+
+			updateBuilder := tx.LocalAccountResource.UpdateOneID(entity.ID)
+			mut := updateBuilder.Mutation()
+
+			if err := buildEntMutate(in, mut, EmptyEnumStateMap, fieldmask.GetPaths()); err != nil {
+				return nil, err
+			}
+
+			if _, err := updateBuilder.Save(ctx); err != nil {
+				return nil, errors.Wrap(err)
+			}
+
+			res, err := getLocalAccountQuery(ctx, tx, id)
+			if err != nil {
+				return nil, err
+			}
+			return util.WrapResource(entLocalAccountResourceToProtoLocalAccountResource(res))
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func isLocalAccountReferenced(tx *ent.Tx, id string) func(context.Context) (bool, error) {
+	return func(ctx context.Context) (bool, error) {
+		referenced, err := tx.InstanceResource.Query().
+			Where(instances.HasLocalaccountWith(localaccounts.ResourceID(id))).
+			Exist(ctx)
+		if err != nil {
+			return false, errors.Wrap(err)
+		}
+		return referenced, nil
+	}
+}
+
 func (is *InvStore) DeleteLocalAccount(ctx context.Context, id string) (*inv_v1.Resource, error) {
 	zlog.Debug().Msgf("DeleteLocalAccount Delete: %s", id)
 
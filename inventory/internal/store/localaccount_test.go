@@ -14,6 +14,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/fieldmaskpb"
 
 	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/localaccountresource"
@@ -449,7 +451,7 @@ func Test_UpdateLocalAccount(t *testing.T) {
 					localaccountresource.FieldUsername,
 				},
 			},
-			valid: false,
+			valid: true,
 		},
 		"UpdateLocalAccountSshKey": {
 			in: &localaccount_v1.LocalAccountResource{
@@ -463,7 +465,7 @@ func Test_UpdateLocalAccount(t *testing.T) {
 					localaccountresource.FieldSSHKey,
 				},
 			},
-			valid: false,
+			valid: true,
 		},
 		"UpdateLocalAccountUsernameAndSshKey": {
 			in: &localaccount_v1.LocalAccountResource{
@@ -478,7 +480,7 @@ func Test_UpdateLocalAccount(t *testing.T) {
 					localaccountresource.FieldSSHKey,
 				},
 			},
-			valid: false,
+			valid: true,
 		},
 	}
 
@@ -502,6 +504,83 @@ func Test_UpdateLocalAccount(t *testing.T) {
 				assert.Nil(t, upRes)
 				return
 			}
+			require.NoError(t, err)
+			assertSameResource(t, updateresreq, upRes, tc.fieldMask)
+		})
+	}
+}
+
+func Test_UpdateLocalAccount_WhileReferenced(t *testing.T) {
+	os := inv_testing.CreateOs(t)
+	host := inv_testing.CreateHost(t, nil, nil)
+	localAccount := inv_testing.CreateLocalAccount(t,
+		"test-user",
+		"ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAILtu+7Pdtj6ihyFynecnd+155AdxqvHhMRxvxdcQ8/D/ test-user1@example.com",
+	)
+	_ = inv_testing.CreateInstanceWithLocalAccount(t, host, os, localAccount)
+
+	testcases := map[string]struct {
+		in        *localaccount_v1.LocalAccountResource
+		fieldMask *fieldmaskpb.FieldMask
+		valid     bool
+	}{
+		"RotateSshKey": {
+			in: &localaccount_v1.LocalAccountResource{
+				SshKey: "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAILtu+7Pdtj6ihyFynecnd+155AdxqvHhMRxvxdcQ8/D/ " +
+					"test-user2@example.com",
+			},
+			fieldMask: &fieldmaskpb.FieldMask{Paths: []string{localaccountresource.FieldSSHKey}},
+			valid:     true,
+		},
+		"SameUsername": {
+			in:        &localaccount_v1.LocalAccountResource{Username: "test-user"},
+			fieldMask: &fieldmaskpb.FieldMask{Paths: []string{localaccountresource.FieldUsername}},
+			valid:     true,
+		},
+		"ChangeUsername": {
+			in:        &localaccount_v1.LocalAccountResource{Username: "test-user2"},
+			fieldMask: &fieldmaskpb.FieldMask{Paths: []string{localaccountresource.FieldUsername}},
+			valid:     false,
+		},
+		"SameUsernameNilMask": {
+			in: &localaccount_v1.LocalAccountResource{
+				Username: "test-user",
+				SshKey:   localAccount.GetSshKey(),
+			},
+			valid: true,
+		},
+		"ChangeUsernameNilMask": {
+			in: &localaccount_v1.LocalAccountResource{
+				Username: "test-user2",
+				SshKey:   localAccount.GetSshKey(),
+			},
+			valid: false,
+		},
+		"ChangeUsernameEmptyMask": {
+			in:        &localaccount_v1.LocalAccountResource{Username: "test-user2"},
+			fieldMask: &fieldmaskpb.FieldMask{},
+			valid:     false,
+		},
+	}
+
+	for tcname, tc := range testcases {
+		t.Run(tcname, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+
+			upRes, err := inv_testing.TestClients[inv_testing.APIClient].Update(
+				ctx,
+				localAccount.GetResourceId(),
+				tc.fieldMask,
+				&inv_v1.Resource{Resource: &inv_v1.Resource_LocalAccount{LocalAccount: tc.in}},
+			)
+			if !tc.valid {
+				require.Error(t, err)
+				assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, localAccount.GetResourceId(), upRes.GetLocalAccount().GetResourceId())
 		})
 	}
 }
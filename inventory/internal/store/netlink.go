@@ -18,11 +18,25 @@ import (
 	network_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/network/v1"
 	cl "github.com/open-edge-platform/infra-core/inventory/v2/pkg/client"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/logging"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/util"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/util/collections"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/validator"
 )
 
+func init() {
+	RegisterResourceHandler(ResourceHandler{
+		Kind:      inv_v1.ResourceKind_RESOURCE_KIND_NETLINK,
+		Create:    createAs((*InvStore).CreateNetlink),
+		Get:       getByID((*InvStore).GetNetlink),
+		Update:    updateAsWithHardDelete((*InvStore).UpdateNetlink),
+		Delete:    deleteByIDWithSoftDelete((*InvStore).DeleteNetlink),
+		DeleteAll: (*InvStore).DeleteNetLinks,
+		List:      (*InvStore).ListNetlinks,
+		Filter:    (*InvStore).FilterNetlinks,
+	})
+}
+
 var netlinkCreationValidators = []resourceValidator[*network_v1.NetlinkResource]{
 	protoValidator[*network_v1.NetlinkResource],
 	doNotAcceptResourceID[*network_v1.NetlinkResource],
@@ -53,7 +67,7 @@ func (is *InvStore) CreateNetlink(ctx context.Context, in *network_v1.NetlinkRes
 		return nil, err
 	}
 
-	zlog.Debug().Msgf("Netlink Created: %s, %s", res.GetNetlink().GetResourceId(), res)
+	zlog.Debug().Msgf("Netlink Created: %s, %s", res.GetNetlink().GetResourceId(), logging.Redact(res))
 	return res, nil
 }
 
@@ -142,7 +156,7 @@ func getNetlinkQuery(ctx context.Context, tx *ent.Tx, resourceID string) (*ent.N
 func (is *InvStore) UpdateNetlink(
 	ctx context.Context, id string, in *network_v1.NetlinkResource, fieldmask *fieldmaskpb.FieldMask,
 ) (*inv_v1.Resource, bool, error) {
-	zlog.Debug().Msgf("UpdateNetlink (%s): %v, fm: %v", id, in, fieldmask)
+	zlog.Debug().Msgf("UpdateNetlink (%s): %v, fm: %v", id, logging.Redact(in), fieldmask)
 
 	res, hardDelete, err := ExecuteInTxAndReturnDouble[inv_v1.Resource, bool](is)(ctx,
 		func(ctx context.Context, tx *ent.Tx) (*inv_v1.Resource, *bool, error) {
@@ -16,11 +16,25 @@ import (
 	network_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/network/v1"
 	cl "github.com/open-edge-platform/infra-core/inventory/v2/pkg/client"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/logging"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/util"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/util/collections"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/validator"
 )
 
+func init() {
+	RegisterResourceHandler(ResourceHandler{
+		Kind:      inv_v1.ResourceKind_RESOURCE_KIND_NETWORKSEGMENT,
+		Create:    createAs((*InvStore).CreateNetworkSegment),
+		Get:       getByID((*InvStore).GetNetworkSegment),
+		Update:    updateAs((*InvStore).UpdateNetworkSegment),
+		Delete:    deleteByID((*InvStore).DeleteNetworkSegment),
+		DeleteAll: (*InvStore).DeleteNetworkSegments,
+		List:      (*InvStore).ListNetworkSegments,
+		Filter:    (*InvStore).FilterNetworkSegments,
+	})
+}
+
 var networkSegmentCreationValidators = []resourceValidator[*network_v1.NetworkSegment]{
 	protoValidator[*network_v1.NetworkSegment],
 	doNotAcceptResourceID[*network_v1.NetworkSegment],
@@ -36,7 +50,7 @@ func (is *InvStore) CreateNetworkSegment(ctx context.Context, in *network_v1.Net
 		return nil, err
 	}
 
-	zlog.Debug().Msgf("NetworkSegment Created: %s, %s", res.GetNetworkSegment().GetResourceId(), res)
+	zlog.Debug().Msgf("NetworkSegment Created: %s, %s", res.GetNetworkSegment().GetResourceId(), logging.Redact(res))
 	return res, nil
 }
 
@@ -200,7 +214,7 @@ func (is *InvStore) UpdateNetworkSegment(
 		return nil, errors.Wrap(err)
 	}
 
-	zlog.Debug().Msgf("UpdateNetworkSegment (%s): %v, fm: %v", id, in, fieldmask)
+	zlog.Debug().Msgf("UpdateNetworkSegment (%s): %v, fm: %v", id, logging.Redact(in), fieldmask)
 
 	return ExecuteInTxAndReturnSingle[inv_v1.Resource](is)(ctx,
 		func(ctx context.Context, tx *ent.Tx) (*inv_v1.Resource, error) {
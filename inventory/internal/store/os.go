@@ -17,11 +17,25 @@ import (
 	os_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/os/v1"
 	cl "github.com/open-edge-platform/infra-core/inventory/v2/pkg/client"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/logging"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/util"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/util/collections"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/validator"
 )
 
+func init() {
+	RegisterResourceHandler(ResourceHandler{
+		Kind:      inv_v1.ResourceKind_RESOURCE_KIND_OS,
+		Create:    createAs((*InvStore).CreateOs),
+		Get:       getByID((*InvStore).GetOs),
+		Update:    updateAs((*InvStore).UpdateOs),
+		Delete:    deleteByID((*InvStore).DeleteOs),
+		DeleteAll: (*InvStore).DeleteOSes,
+		List:      (*InvStore).ListOss,
+		Filter:    (*InvStore).FilterOss,
+	})
+}
+
 var osResourceCreationValidators = []resourceValidator[*os_v1.OperatingSystemResource]{
 	protoValidator[*os_v1.OperatingSystemResource],
 	validateOsProto,
@@ -64,7 +78,7 @@ func (is *InvStore) CreateOs(ctx context.Context, in *os_v1.OperatingSystemResou
 		return nil, err
 	}
 
-	zlog.Debug().Msgf("OS Created: %s, %s", res.GetOs().GetResourceId(), res)
+	zlog.Debug().Msgf("OS Created: %s, %s", res.GetOs().GetResourceId(), logging.Redact(res))
 	return res, nil
 }
 
@@ -133,7 +147,7 @@ func (is *InvStore) UpdateOs(
 	in *os_v1.OperatingSystemResource,
 	fieldmask *fieldmaskpb.FieldMask,
 ) (*inv_v1.Resource, error) {
-	zlog.Debug().Msgf("UpdateOs (%s): %v, fm: %v", id, in, fieldmask)
+	zlog.Debug().Msgf("UpdateOs (%s): %v, fm: %v", id, logging.Redact(in), fieldmask)
 	res, err := ExecuteInTxAndReturnSingle[inv_v1.Resource](is)(ctx,
 		func(ctx context.Context, tx *ent.Tx) (*inv_v1.Resource, error) {
 			entity, err := tx.OperatingSystemResource.Query().
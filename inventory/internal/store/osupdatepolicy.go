@@ -18,11 +18,25 @@ import (
 	inv_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/inventory/v1"
 	cl "github.com/open-edge-platform/infra-core/inventory/v2/pkg/client"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/logging"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/util"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/util/collections"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/validator"
 )
 
+func init() {
+	RegisterResourceHandler(ResourceHandler{
+		Kind:      inv_v1.ResourceKind_RESOURCE_KIND_OSUPDATEPOLICY,
+		Create:    createAs((*InvStore).CreateOSUpdatePolicy),
+		Get:       getByID((*InvStore).GetOSUpdatePolicy),
+		Update:    updateAs((*InvStore).UpdateOSUpdatePolicy),
+		Delete:    deleteByID((*InvStore).DeleteOSUpdatePolicy),
+		DeleteAll: (*InvStore).DeleteOSUpdatePolicies,
+		List:      (*InvStore).ListOSUpdatePolicies,
+		Filter:    (*InvStore).FilterOSUpdatePolicies,
+	})
+}
+
 var osUpPolicyResourceCreationValidators = []resourceValidator[*compute_v1.OSUpdatePolicyResource]{
 	protoValidator[*compute_v1.OSUpdatePolicyResource],
 	validateOSUpdatePolicyProto,
@@ -87,7 +101,7 @@ func (is *InvStore) CreateOSUpdatePolicy(ctx context.Context, in *compute_v1.OSU
 		return nil, err
 	}
 
-	zlog.Debug().Msgf("OS Update Policy Created: %s, %s", res.GetOsUpdatePolicy().GetResourceId(), res)
+	zlog.Debug().Msgf("OS Update Policy Created: %s, %s", res.GetOsUpdatePolicy().GetResourceId(), logging.Redact(res))
 	return res, nil
 }
 
@@ -162,7 +176,7 @@ func (is *InvStore) UpdateOSUpdatePolicy(
 	in *compute_v1.OSUpdatePolicyResource,
 	fieldmask *fieldmaskpb.FieldMask,
 ) (*inv_v1.Resource, error) {
-	zlog.Debug().Msgf("UpdateosUpdatePolicy (%s): %v, fm: %v", id, in, fieldmask)
+	zlog.Debug().Msgf("UpdateosUpdatePolicy (%s): %v, fm: %v", id, logging.Redact(in), fieldmask)
 	res, err := ExecuteInTxAndReturnSingle[inv_v1.Resource](is)(ctx,
 		func(ctx context.Context, tx *ent.Tx) (*inv_v1.Resource, error) {
 			entity, err := tx.OSUpdatePolicyResource.Query().
@@ -18,11 +18,25 @@ import (
 	status_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/status/v1"
 	cl "github.com/open-edge-platform/infra-core/inventory/v2/pkg/client"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/logging"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/util"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/util/collections"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/validator"
 )
 
+func init() {
+	RegisterResourceHandler(ResourceHandler{
+		Kind:      inv_v1.ResourceKind_RESOURCE_KIND_OSUPDATERUN,
+		Create:    createAs((*InvStore).CreateOSUpdateRun),
+		Get:       getByID((*InvStore).GetOSUpdateRun),
+		Update:    updateAs((*InvStore).UpdateOSUpdateRun),
+		Delete:    deleteByID((*InvStore).DeleteOSUpdateRun),
+		DeleteAll: (*InvStore).DeleteOSUpdateRuns,
+		List:      (*InvStore).ListOSUpdateRuns,
+		Filter:    (*InvStore).FilterOSUpdateRuns,
+	})
+}
+
 var osUpRunResourceCreationValidators = []resourceValidator[*compute_v1.OSUpdateRunResource]{
 	protoValidator[*compute_v1.OSUpdateRunResource],
 	doNotAcceptResourceID[*compute_v1.OSUpdateRunResource],
@@ -49,7 +63,7 @@ func (is *InvStore) CreateOSUpdateRun(ctx context.Context, in *compute_v1.OSUpda
 		return nil, err
 	}
 
-	zlog.Debug().Msgf("OS Update Run Created: %s, %s", res.GetOsUpdateRun().GetResourceId(), res)
+	zlog.Debug().Msgf("OS Update Run Created: %s, %s", res.GetOsUpdateRun().GetResourceId(), logging.Redact(res))
 	return res, nil
 }
 
@@ -128,7 +142,7 @@ func (is *InvStore) UpdateOSUpdateRun(
 	in *compute_v1.OSUpdateRunResource,
 	fieldmask *fieldmaskpb.FieldMask,
 ) (*inv_v1.Resource, error) {
-	zlog.Debug().Msgf("UpdateosUpdateRun (%s): %v, fm: %v", id, in, fieldmask)
+	zlog.Debug().Msgf("UpdateosUpdateRun (%s): %v, fm: %v", id, logging.Redact(in), fieldmask)
 	res, err := ExecuteInTxAndReturnSingle[inv_v1.Resource](is)(ctx,
 		func(ctx context.Context, tx *ent.Tx) (*inv_v1.Resource, error) {
 			entity, err := tx.OSUpdateRunResource.Query().
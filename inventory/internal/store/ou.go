@@ -17,11 +17,25 @@ import (
 	ou_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/ou/v1"
 	cl "github.com/open-edge-platform/infra-core/inventory/v2/pkg/client"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/logging"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/util"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/util/collections"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/validator"
 )
 
+func init() {
+	RegisterResourceHandler(ResourceHandler{
+		Kind:      inv_v1.ResourceKind_RESOURCE_KIND_OU,
+		Create:    createAs((*InvStore).CreateOu),
+		Get:       (*InvStore).GetOu,
+		Update:    updateAsInTenant((*InvStore).UpdateOu),
+		Delete:    deleteByID((*InvStore).DeleteOu),
+		DeleteAll: (*InvStore).DeleteOus,
+		List:      (*InvStore).ListOus,
+		Filter:    (*InvStore).FilterOus,
+	})
+}
+
 var ouResourceCreationValidators = []resourceValidator[*ou_v1.OuResource]{
 	protoValidator[*ou_v1.OuResource],
 	doNotAcceptResourceID[*ou_v1.OuResource],
@@ -159,7 +173,7 @@ func (is *InvStore) CreateOu(ctx context.Context, in *ou_v1.OuResource) (*inv_v1
 		return nil, err
 	}
 
-	zlog.Debug().Msgf("OU Created: %s, %s", res.GetOu().GetResourceId(), res)
+	zlog.Debug().Msgf("OU Created: %s, %s", res.GetOu().GetResourceId(), logging.Redact(res))
 
 	return res, nil
 }
@@ -202,6 +216,9 @@ func ouResourceCreator(in *ou_v1.OuResource) func(context.Context, *ent.Tx) (
 		if err != nil {
 			return nil, err
 		}
+		if err := syncResourceLabels(ctx, tx.Client(), res.TenantID, res.ResourceID, res.Metadata); err != nil {
+			return nil, err
+		}
 		return util.WrapResource(entOuResourceToProtoOuResource(res))
 	}
 }
@@ -257,7 +274,7 @@ func getOuQuery(ctx context.Context, tx *ent.Tx, tenantID, resourceID string, lo
 func (is *InvStore) UpdateOu(
 	ctx context.Context, id string, in *ou_v1.OuResource, fieldmask *fieldmaskpb.FieldMask, tenantID string,
 ) (*inv_v1.Resource, error) {
-	zlog.Debug().Msgf("UpdateOu (%s): %v, fm: %v", id, in, fieldmask)
+	zlog.Debug().Msgf("UpdateOu (%s): %v, fm: %v", id, logging.Redact(in), fieldmask)
 
 	res, err := ExecuteInTxAndReturnSingle[inv_v1.Resource](is)(
 		ctx,
@@ -299,6 +316,11 @@ func (is *InvStore) UpdateOu(
 			if err != nil {
 				return nil, err
 			}
+			if writesMetadata(fieldmask, ouresource.FieldMetadata) {
+				if err := syncResourceLabels(ctx, tx.Client(), res.TenantID, res.ResourceID, res.Metadata); err != nil {
+					return nil, err
+				}
+			}
 			return util.WrapResource(entOuResourceToProtoOuResource(res))
 		})
 	if err != nil {
@@ -352,6 +374,9 @@ func deleteOu(id string) func(ctx context.Context, tx *ent.Tx) (*inv_v1.Resource
 				"the ou has relations with site and cannot be deleted")
 		}
 
+		if err := deleteResourceLabels(ctx, tx.Client(), entity.ResourceID); err != nil {
+			return nil, err
+		}
 		err = tx.OuResource.DeleteOneID(entity.ID).Exec(ctx)
 		if err != nil {
 			return nil, errors.Wrap(err)
@@ -372,6 +397,9 @@ func (is *InvStore) DeleteOus(
 			if err != nil {
 				return err
 			}
+			if err := deleteTenantResourceLabels(ctx, tx.Client(), tenantID, inv_v1.ResourceKind_RESOURCE_KIND_OU); err != nil {
+				return err
+			}
 			if _, err := tx.OuResource.Delete().Where(ouresource.TenantID(tenantID)).Exec(ctx); err != nil {
 				return err
 			}
@@ -395,6 +423,14 @@ func filterOus(ctx context.Context, client *ent.Client, filter *inv_v1.ResourceF
 		return nil, 0, err
 	}
 
+	labelPred, err := getLabelSelectorPredicate(inv_v1.ResourceKind_RESOURCE_KIND_OU, filter.GetLabelSelector())
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Principals holding the scoped role only get the resources in their scope.
+	scopePred := scopePredicate(ctx, inv_v1.ResourceKind_RESOURCE_KIND_OU)
+
 	orderOpts, err := GetOrderByOptions[ouresource.OrderOption](filter.GetOrderBy(), ouresource.ValidColumn)
 	if err != nil {
 		return nil, 0, err
@@ -408,7 +444,7 @@ func filterOus(ctx context.Context, client *ent.Client, filter *inv_v1.ResourceF
 	// perform query - And together all the predicates
 	query := client.OuResource.Query().
 		WithParentOu().
-		Where(pred).
+		Where(pred, labelPred, scopePred).
 		Order(orderOpts...).
 		Offset(offset)
 
@@ -424,7 +460,7 @@ func filterOus(ctx context.Context, client *ent.Client, filter *inv_v1.ResourceF
 
 	// Count total number of item without applying pagination limits, order, or loading edges.
 	total, err := client.OuResource.Query().
-		Where(pred).
+		Where(pred, labelPred, scopePred).
 		Count(ctx)
 	if err != nil {
 		return nil, 0, errors.Wrap(err)
@@ -18,11 +18,25 @@ import (
 	provider_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/provider/v1"
 	cl "github.com/open-edge-platform/infra-core/inventory/v2/pkg/client"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/logging"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/util"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/util/collections"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/validator"
 )
 
+func init() {
+	RegisterResourceHandler(ResourceHandler{
+		Kind:      inv_v1.ResourceKind_RESOURCE_KIND_PROVIDER,
+		Create:    createAs((*InvStore).CreateProvider),
+		Get:       getByID((*InvStore).GetProvider),
+		Update:    updateAs((*InvStore).UpdateProvider),
+		Delete:    deleteByID((*InvStore).DeleteProvider),
+		DeleteAll: (*InvStore).DeleteProviders,
+		List:      (*InvStore).ListProviders,
+		Filter:    (*InvStore).FilterProviders,
+	})
+}
+
 var providerResourceCreationValidators = []resourceValidator[*provider_v1.ProviderResource]{
 	protoValidator[*provider_v1.ProviderResource],
 	doNotAcceptResourceID[*provider_v1.ProviderResource],
@@ -53,7 +67,7 @@ func (is *InvStore) CreateProvider(ctx context.Context, in *provider_v1.Provider
 		return nil, err
 	}
 
-	zlog.Debug().Msgf("Provider Created: %s, %s", res.GetProvider().GetResourceId(), res)
+	zlog.Debug().Msgf("Provider Created: %s, %s", res.GetProvider().GetResourceId(), logging.Redact(res))
 	return res, nil
 }
 
@@ -119,15 +133,20 @@ func getProviderQuery(ctx context.Context, tx *ent.Tx, resourceID string) (*ent.
 func (is *InvStore) UpdateProvider(
 	ctx context.Context, id string, in *provider_v1.ProviderResource, fieldmask *fieldmaskpb.FieldMask,
 ) (*inv_v1.Resource, error) {
-	zlog.Debug().Msgf("UpdateProvider (%s): %v, fm: %v", id, in, fieldmask)
+	zlog.Debug().Msgf("UpdateProvider (%s): %v, fm: %v", id, logging.Redact(in), fieldmask)
 	res, err := ExecuteInTxAndReturnSingle[inv_v1.Resource](is)(ctx,
 		func(ctx context.Context, tx *ent.Tx) (*inv_v1.Resource, error) {
-			entity, err := tx.ProviderResource.Query().
-				Select(providers.FieldID).
-				Where(providers.ResourceID(id)).
-				Only(ctx)
+			entity, err := getProviderQuery(ctx, tx, id)
 			if err != nil {
-				return nil, errors.Wrap(err)
+				return nil, err
+			}
+
+			// Endpoint, credentials and config can be rotated, but hosts, instances and sites
+			// using the provider rely on its kind and vendor.
+			if err := verifyMutableWhileReferenced(ctx, entProviderResourceToProtoProviderResource(entity), in,
+				fieldmask, isProviderReferenced(tx, id), providers.FieldProviderKind, providers.FieldProviderVendor,
+			); err != nil {
+				return nil, err
 			}
 
 			updateBuilder := tx.ProviderResource.UpdateOneID(entity.ID)
@@ -214,6 +233,25 @@ func (is *InvStore) DeleteProviders(
 	return deleted, txErr
 }
 
+func isProviderReferenced(tx *ent.Tx, id string) func(context.Context) (bool, error) {
+	return func(ctx context.Context) (bool, error) {
+		for _, exist := range []func(context.Context) (bool, error){
+			tx.HostResource.Query().Where(hosts.HasProviderWith(providers.ResourceID(id))).Exist,
+			tx.InstanceResource.Query().Where(instances.HasProviderWith(providers.ResourceID(id))).Exist,
+			tx.SiteResource.Query().Where(sites.HasProviderWith(providers.ResourceID(id))).Exist,
+		} {
+			referenced, err := exist(ctx)
+			if err != nil {
+				return false, errors.Wrap(err)
+			}
+			if referenced {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+}
+
 func verifyStrongRelations(ctx context.Context, tx *ent.Tx, id string) error {
 	// FIXME: this could be solved with a back-reference to hosts in the schema. Not done due to protobuf circular dep.
 	// Query any child host
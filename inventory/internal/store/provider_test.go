@@ -608,6 +608,90 @@ func Test_UpdateProvider(t *testing.T) {
 	}
 }
 
+func Test_UpdateProvider_WhileReferenced(t *testing.T) {
+	provider := inv_testing.CreateProviderWithArgs(
+		t,
+		"Test Provider 3",
+		"192.168.201.3/discovery",
+		[]string{"test", "test"},
+		provider_v1.ProviderKind_PROVIDER_KIND_BAREMETAL,
+		provider_v1.ProviderVendor_PROVIDER_VENDOR_LENOVO_LOCA,
+	)
+	inv_testing.CreateHost(t, nil, provider)
+
+	testcases := map[string]struct {
+		in        *provider_v1.ProviderResource
+		fieldMask *fieldmaskpb.FieldMask
+		valid     bool
+	}{
+		"RotateCredentials": {
+			in: &provider_v1.ProviderResource{
+				ApiEndpoint:    "192.168.201.4/discovery",
+				ApiCredentials: []string{"rotated"},
+			},
+			fieldMask: &fieldmaskpb.FieldMask{
+				Paths: []string{providerresource.FieldAPIEndpoint, providerresource.FieldAPICredentials},
+			},
+			valid: true,
+		},
+		"SameVendor": {
+			in: &provider_v1.ProviderResource{
+				ProviderVendor: provider_v1.ProviderVendor_PROVIDER_VENDOR_LENOVO_LOCA,
+			},
+			fieldMask: &fieldmaskpb.FieldMask{Paths: []string{providerresource.FieldProviderVendor}},
+			valid:     true,
+		},
+		"ChangeVendor": {
+			in: &provider_v1.ProviderResource{
+				ProviderVendor: provider_v1.ProviderVendor_PROVIDER_VENDOR_LENOVO_LXCA,
+			},
+			fieldMask: &fieldmaskpb.FieldMask{Paths: []string{providerresource.FieldProviderVendor}},
+			valid:     false,
+		},
+		"SameKindAndVendorNilMask": {
+			in: &provider_v1.ProviderResource{
+				ProviderKind:   provider_v1.ProviderKind_PROVIDER_KIND_BAREMETAL,
+				ProviderVendor: provider_v1.ProviderVendor_PROVIDER_VENDOR_LENOVO_LOCA,
+				Name:           "Test Provider 3",
+				ApiEndpoint:    "192.168.201.5/discovery",
+				ApiCredentials: []string{"test", "test"},
+			},
+			valid: true,
+		},
+		"ChangeVendorNilMask": {
+			in: &provider_v1.ProviderResource{
+				ProviderKind:   provider_v1.ProviderKind_PROVIDER_KIND_BAREMETAL,
+				ProviderVendor: provider_v1.ProviderVendor_PROVIDER_VENDOR_LENOVO_LXCA,
+				Name:           "Test Provider 3",
+				ApiEndpoint:    "192.168.201.3/discovery",
+				ApiCredentials: []string{"test", "test"},
+			},
+			valid: false,
+		},
+	}
+	for tcname, tc := range testcases {
+		t.Run(tcname, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+
+			updateresreq := &inv_v1.Resource{Resource: &inv_v1.Resource_Provider{Provider: tc.in}}
+			upRes, err := inv_testing.TestClients[inv_testing.APIClient].Update(
+				ctx, provider.GetResourceId(), tc.fieldMask, updateresreq)
+			if !tc.valid {
+				require.Error(t, err)
+				assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+				return
+			}
+			require.NoError(t, err)
+			if tc.fieldMask == nil {
+				assert.Equal(t, provider.GetResourceId(), upRes.GetProvider().GetResourceId())
+				return
+			}
+			assertSameResource(t, updateresreq, upRes, tc.fieldMask)
+		})
+	}
+}
+
 func Test_StrongRelations_On_Delete_Provider(t *testing.T) {
 	t.Run("Provider_Host", func(t *testing.T) {
 		provider := inv_testing.CreateProvider(t, "Test Provider 1")
@@ -0,0 +1,55 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package store
+
+import (
+	"slices"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	inv_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/inventory/v1"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
+)
+
+// readMaskKinds are the resource kinds whose edges are loaded according to the read mask, see
+// ResourceFilter.read_mask.
+var readMaskKinds = map[inv_v1.ResourceKind]struct{}{
+	inv_v1.ResourceKind_RESOURCE_KIND_HOST:     {},
+	inv_v1.ResourceKind_RESOURCE_KIND_INSTANCE: {},
+	inv_v1.ResourceKind_RESOURCE_KIND_SITE:     {},
+}
+
+// validateReadMask checks that the read mask of the filter is supported for its resource kind, and that its paths are
+// top-level fields of the resource.
+func validateReadMask(kind inv_v1.ResourceKind, filter *inv_v1.ResourceFilter) error {
+	if filter.GetReadMask() == nil {
+		return nil
+	}
+	if _, ok := readMaskKinds[kind]; !ok {
+		zlog.InfraSec().InfraError("read masks are not supported for %s", kind).Msg("")
+		return errors.Errorfc(codes.InvalidArgument, "read masks are not supported for %s", kind)
+	}
+	// The descriptor of the resource is taken from the oneof field, which is set even if the resource itself is not.
+	resource := filter.GetResource().ProtoReflect()
+	field := resource.WhichOneof(resource.Descriptor().Oneofs().ByName("resource"))
+	if field == nil {
+		return errors.Errorfc(codes.InvalidArgument, "read mask given without resource kind")
+	}
+	fields := field.Message().Fields()
+	for _, path := range filter.GetReadMask().GetPaths() {
+		if fields.ByName(protoreflect.Name(path)) == nil {
+			zlog.InfraSec().InfraError("invalid read mask path %s for %s", path, kind).Msg("")
+			return errors.Errorfc(codes.InvalidArgument, "invalid read mask path %s for %s", path, kind)
+		}
+	}
+	return nil
+}
+
+// inReadMask reports whether the given field is requested by the read mask. All the fields are requested when the
+// mask is unset.
+func inReadMask(mask *fieldmaskpb.FieldMask, field string) bool {
+	return mask == nil || slices.Contains(mask.GetPaths(), field)
+}
@@ -0,0 +1,98 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package store_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	computev1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/compute/v1"
+	inv_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/inventory/v1"
+	location_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/location/v1"
+	inv_testing "github.com/open-edge-platform/infra-core/inventory/v2/pkg/testing"
+)
+
+func Test_ReadMask_Host(t *testing.T) {
+	region := inv_testing.CreateRegion(t, nil)
+	site := inv_testing.CreateSite(t, region, nil)
+	host := inv_testing.CreateHost(t, site, nil)
+	inv_testing.CreateHostNic(t, host)
+	inv_testing.CreateInstance(t, host, inv_testing.CreateOs(t))
+
+	listHost := func(t *testing.T, mask *fieldmaskpb.FieldMask) *computev1.HostResource {
+		t.Helper()
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		listRes, err := inv_testing.TestClients[inv_testing.APIClient].List(ctx, &inv_v1.ResourceFilter{
+			Resource: &inv_v1.Resource{Resource: &inv_v1.Resource_Host{}},
+			Filter:   `resource_id = "` + host.GetResourceId() + `"`,
+			ReadMask: mask,
+		})
+		require.NoError(t, err)
+		require.Len(t, listRes.GetResources(), 1)
+		return listRes.GetResources()[0].GetResource().GetHost()
+	}
+
+	t.Run("Unset", func(t *testing.T) {
+		res := listHost(t, nil)
+		assert.Equal(t, site.GetResourceId(), res.GetSite().GetResourceId())
+		assert.Equal(t, region.GetResourceId(), res.GetSite().GetRegion().GetResourceId())
+		assert.Len(t, res.GetHostNics(), 1)
+		assert.NotNil(t, res.GetInstance())
+	})
+	t.Run("Site", func(t *testing.T) {
+		res := listHost(t, &fieldmaskpb.FieldMask{Paths: []string{"site"}})
+		assert.Equal(t, site.GetResourceId(), res.GetSite().GetResourceId())
+		assert.Empty(t, res.GetHostNics())
+		assert.Nil(t, res.GetInstance())
+		// Plain fields are always returned.
+		assert.Equal(t, host.GetResourceId(), res.GetResourceId())
+		assert.Equal(t, host.GetUuid(), res.GetUuid())
+	})
+	t.Run("Empty", func(t *testing.T) {
+		res := listHost(t, &fieldmaskpb.FieldMask{})
+		assert.Nil(t, res.GetSite())
+		assert.Empty(t, res.GetHostNics())
+		assert.Nil(t, res.GetInstance())
+	})
+}
+
+func Test_ReadMask_Invalid(t *testing.T) {
+	testcases := map[string]struct {
+		resource *inv_v1.Resource
+		paths    []string
+	}{
+		"UnknownPath": {
+			resource: &inv_v1.Resource{Resource: &inv_v1.Resource_Host{}},
+			paths:    []string{"site", "unknown"},
+		},
+		"NestedPath": {
+			resource: &inv_v1.Resource{Resource: &inv_v1.Resource_Site{}},
+			paths:    []string{"region.parent_region"},
+		},
+		"UnsupportedKind": {
+			resource: &inv_v1.Resource{Resource: &inv_v1.Resource_Region{Region: &location_v1.RegionResource{}}},
+			paths:    []string{"parent_region"},
+		},
+	}
+	for tcname, tc := range testcases {
+		t.Run(tcname, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+			_, err := inv_testing.TestClients[inv_testing.APIClient].List(ctx, &inv_v1.ResourceFilter{
+				Resource: tc.resource,
+				ReadMask: &fieldmaskpb.FieldMask{Paths: tc.paths},
+			})
+			require.Error(t, err)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+	}
+}
@@ -19,11 +19,25 @@ import (
 	location_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/location/v1"
 	cl "github.com/open-edge-platform/infra-core/inventory/v2/pkg/client"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/logging"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/util"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/util/collections"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/validator"
 )
 
+func init() {
+	RegisterResourceHandler(ResourceHandler{
+		Kind:      inv_v1.ResourceKind_RESOURCE_KIND_REGION,
+		Create:    createAs((*InvStore).CreateRegion),
+		Get:       (*InvStore).GetRegion,
+		Update:    updateAsInTenant((*InvStore).UpdateRegion),
+		Delete:    deleteByID((*InvStore).DeleteRegion),
+		DeleteAll: (*InvStore).DeleteRegions,
+		List:      (*InvStore).ListRegions,
+		Filter:    (*InvStore).FilterRegions,
+	})
+}
+
 var regionResourceCreationValidators = []resourceValidator[*location_v1.RegionResource]{
 	protoValidator[*location_v1.RegionResource],
 	doNotAcceptResourceID[*location_v1.RegionResource],
@@ -169,7 +183,7 @@ func (is *InvStore) CreateRegion(ctx context.Context, in *location_v1.RegionReso
 		return nil, err
 	}
 
-	zlog.Debug().Msgf("Region Created: %s, %s", res.GetRegion().GetResourceId(), res)
+	zlog.Debug().Msgf("Region Created: %s, %s", res.GetRegion().GetResourceId(), logging.Redact(res))
 	return res, nil
 }
 
@@ -206,6 +220,9 @@ func regionResourceCreator(in *location_v1.RegionResource) func(context.Context,
 		if err != nil {
 			return nil, err
 		}
+		if err := syncResourceLabels(ctx, tx.Client(), res.TenantID, res.ResourceID, res.Metadata); err != nil {
+			return nil, err
+		}
 
 		err = checkNestingLimit(ctx, tx, res.ID)
 		if err != nil {
@@ -267,7 +284,7 @@ func getRegionQuery(ctx context.Context, tx *ent.Tx, tenantID, resourceID string
 func (is *InvStore) UpdateRegion(
 	ctx context.Context, id string, in *location_v1.RegionResource, fm *fieldmaskpb.FieldMask, tenantID string,
 ) (*inv_v1.Resource, error) {
-	zlog.Debug().Msgf("Update (%s): %v, fm: %v", id, in, fm)
+	zlog.Debug().Msgf("Update (%s): %v, fm: %v", id, logging.Redact(in), fm)
 
 	res, err := ExecuteInTxAndReturnSingle[inv_v1.Resource](is)(ctx,
 		func(ctx context.Context, tx *ent.Tx) (*inv_v1.Resource, error) {
@@ -310,6 +327,11 @@ func (is *InvStore) UpdateRegion(
 			if err != nil {
 				return nil, err
 			}
+			if writesMetadata(fm, regions.FieldMetadata) {
+				if err := syncResourceLabels(ctx, tx.Client(), res.TenantID, res.ResourceID, res.Metadata); err != nil {
+					return nil, err
+				}
+			}
 
 			return util.WrapResource(entRegionResourceToProtoRegionResource(res))
 		},
@@ -364,6 +386,9 @@ func deleteRegion(id string) func(ctx context.Context, tx *ent.Tx) (*inv_v1.Reso
 				"the region has relations with site and cannot be deleted")
 		}
 
+		if err := deleteResourceLabels(ctx, tx.Client(), entity.ResourceID); err != nil {
+			return nil, err
+		}
 		err = tx.RegionResource.DeleteOneID(entity.ID).Exec(ctx)
 		if err != nil {
 			return nil, errors.Wrap(err)
@@ -384,6 +409,9 @@ func (is *InvStore) DeleteRegions(
 			if err != nil {
 				return err
 			}
+			if err := deleteTenantResourceLabels(ctx, tx.Client(), tenantID, inv_v1.ResourceKind_RESOURCE_KIND_REGION); err != nil {
+				return err
+			}
 			if _, err := tx.RegionResource.Delete().Where(regions.TenantID(tenantID)).Exec(ctx); err != nil {
 				return err
 			}
@@ -407,6 +435,14 @@ func filterRegion(ctx context.Context, client *ent.Client, filter *inv_v1.Resour
 		return nil, 0, err
 	}
 
+	labelPred, err := getLabelSelectorPredicate(inv_v1.ResourceKind_RESOURCE_KIND_REGION, filter.GetLabelSelector())
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Principals holding the scoped role only get the resources in their scope.
+	scopePred := scopePredicate(ctx, inv_v1.ResourceKind_RESOURCE_KIND_REGION)
+
 	orderOpts, err := GetOrderByOptions[regions.OrderOption](filter.GetOrderBy(), regions.ValidColumn)
 	if err != nil {
 		return nil, 0, err
@@ -420,7 +456,7 @@ func filterRegion(ctx context.Context, client *ent.Client, filter *inv_v1.Resour
 	// perform query - And together all the predicates
 	query := client.RegionResource.Query().
 		WithParentRegion().
-		Where(pred).
+		Where(pred, labelPred, scopePred).
 		Order(orderOpts...).
 		Offset(offset)
 
@@ -436,7 +472,7 @@ func filterRegion(ctx context.Context, client *ent.Client, filter *inv_v1.Resour
 
 	// Count total number of item without applying pagination limits, order, or loading edges.
 	total, err := client.RegionResource.Query().
-		Where(pred).
+		Where(pred, labelPred, scopePred).
 		Count(ctx)
 	if err != nil {
 		return nil, 0, errors.Wrap(err)
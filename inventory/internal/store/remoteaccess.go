@@ -19,11 +19,24 @@ import (
 	statusv1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/status/v1"
 	cl "github.com/open-edge-platform/infra-core/inventory/v2/pkg/client"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/logging"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/util"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/util/collections"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/validator"
 )
 
+func init() {
+	RegisterResourceHandler(ResourceHandler{
+		Kind:   inv_v1.ResourceKind_RESOURCE_KIND_RMT_ACCESS_CONF,
+		Create: createAs((*InvStore).CreateRemoteAccessConfig),
+		Get:    getByID((*InvStore).GetRemoteAccessConfig),
+		Update: updateAsWithHardDelete((*InvStore).UpdateRemoteAccessConfig),
+		Delete: deleteByID((*InvStore).SoftDeleteRemoteAccessConfig),
+		List:   (*InvStore).ListRemoteAccessConfig,
+		Filter: (*InvStore).FilterRemoteAccessConfig,
+	})
+}
+
 const (
 	inTimeOfRemoteAccess  = time.Minute * 10
 	maxTimeOfRemoteAccess = time.Hour * 24
@@ -60,7 +73,7 @@ func (is *InvStore) CreateRemoteAccessConfig(ctx context.Context,
 		return nil, err
 	}
 
-	zlog.Debug().Msgf("Remote Access Config Created: %s, %s", res.GetRemoteAccess().GetResourceId(), res)
+	zlog.Debug().Msgf("Remote Access Config Created: %s, %s", res.GetRemoteAccess().GetResourceId(), logging.Redact(res))
 	return res, nil
 }
 
@@ -70,7 +83,7 @@ func (is *InvStore) UpdateRemoteAccessConfig(
 	in *remoteaccessv1.RemoteAccessConfiguration,
 	fm *fieldmaskpb.FieldMask,
 ) (*inv_v1.Resource, bool, error) {
-	zlog.Debug().Msgf("Update (%s): %v, fm: %v", id, in, fm)
+	zlog.Debug().Msgf("Update (%s): %v, fm: %v", id, logging.Redact(in), fm)
 
 	updated, isHardRemoval, err := ExecuteInTxAndReturnDouble[inv_v1.Resource, bool](is)(
 		ctx,
@@ -258,7 +271,7 @@ func remoteAccessConfigurationCreator(in *remoteaccessv1.RemoteAccessConfigurati
 		if err != nil {
 			return nil, err
 		}
-		zlog.Debug().Msgf("Remote Access Config Created: %s, %s", res.ResourceID, res)
+		zlog.Debug().Msgf("Remote Access Config Created: %s, %s", res.ResourceID, logging.Redact(res))
 		return util.WrapResource(entRemoteAccessConfigurationToProto(res))
 	}
 }
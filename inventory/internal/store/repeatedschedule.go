@@ -20,11 +20,25 @@ import (
 	schedule_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/schedule/v1"
 	cl "github.com/open-edge-platform/infra-core/inventory/v2/pkg/client"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/logging"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/util"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/util/collections"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/validator"
 )
 
+func init() {
+	RegisterResourceHandler(ResourceHandler{
+		Kind:      inv_v1.ResourceKind_RESOURCE_KIND_REPEATEDSCHEDULE,
+		Create:    createAs((*InvStore).CreateRepeatedSchedule),
+		Get:       getByID((*InvStore).GetRepeatedSchedule),
+		Update:    updateAs((*InvStore).UpdateRepeatedSchedule),
+		Delete:    deleteByID((*InvStore).DeleteRepeatedSchedule),
+		DeleteAll: (*InvStore).DeleteRepeatedSchedules,
+		List:      (*InvStore).ListRepeatedSchedules,
+		Filter:    (*InvStore).FilterRepeatedSchedules,
+	})
+}
+
 var repeatedScheduleCreationValidators = []resourceValidator[*schedule_v1.RepeatedScheduleResource]{
 	protoValidator[*schedule_v1.RepeatedScheduleResource],
 	validateRScheduledInput,
@@ -55,7 +69,7 @@ func (is *InvStore) CreateRepeatedSchedule(
 		return nil, err
 	}
 
-	zlog.Debug().Msgf("RepeatedSchedule Created: %s, %s", res.GetRepeatedschedule().GetResourceId(), res)
+	zlog.Debug().Msgf("RepeatedSchedule Created: %s, %s", res.GetRepeatedschedule().GetResourceId(), logging.Redact(res))
 
 	return res, nil
 }
@@ -152,7 +166,7 @@ func (is *InvStore) UpdateRepeatedSchedule(
 	if err := validateRScheduledInput(in); err != nil {
 		return nil, err
 	}
-	zlog.Debug().Msgf("UpdateRepeatedSchedule (%s): %v, fm: %v", id, in, fieldmask)
+	zlog.Debug().Msgf("UpdateRepeatedSchedule (%s): %v, fm: %v", id, logging.Redact(in), fieldmask)
 
 	res, err := ExecuteInTxAndReturnSingle[inv_v1.Resource](is)(ctx,
 		func(ctx context.Context, tx *ent.Tx) (*inv_v1.Resource, error) {
@@ -0,0 +1,207 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package store
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	inv_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/inventory/v1"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/client"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/util"
+)
+
+type (
+	CreateResourceFunc func(is *InvStore, ctx context.Context, in *inv_v1.Resource) (*inv_v1.Resource, error)
+	// GetResourceFunc returns the resource and its rendered metadata, if the kind supports metadata inheritance.
+	GetResourceFunc func(is *InvStore, ctx context.Context, id, tenantID string) (
+		*inv_v1.Resource, *inv_v1.GetResourceResponse_ResourceMetadata, error,
+	)
+	// UpdateResourceFunc returns the updated resource and whether the update resulted in a hard delete.
+	UpdateResourceFunc func(
+		is *InvStore, ctx context.Context, id, tenantID string, in *inv_v1.Resource, fm *fieldmaskpb.FieldMask,
+	) (*inv_v1.Resource, bool, error)
+	// DeleteResourceFunc returns the deleted resource and whether it was only marked for deletion (soft delete).
+	DeleteResourceFunc  func(is *InvStore, ctx context.Context, id, tenantID string) (*inv_v1.Resource, bool, error)
+	DeleteResourcesFunc func(is *InvStore, ctx context.Context, tenantID string, enforce bool) (
+		[]*util.Tuple[DeletionKind, *inv_v1.Resource], error,
+	)
+	ListResourcesFunc func(is *InvStore, ctx context.Context, filter *inv_v1.ResourceFilter) (
+		[]*inv_v1.GetResourceResponse, int, error,
+	)
+	FilterResourcesFunc func(is *InvStore, ctx context.Context, filter *inv_v1.ResourceFilter) (
+		[]*client.ResourceTenantIDCarrier, int, error,
+	)
+)
+
+// ResourceHandler groups the store operations of a resource kind. The kind metadata (prefix, tenant
+// scoping, two-phase deletion) must be registered in util via util.RegisterResourceKind.
+// Update and DeleteAll are optional, a nil function means the operation is not supported by the kind.
+type ResourceHandler struct {
+	Kind      inv_v1.ResourceKind
+	Create    CreateResourceFunc
+	Get       GetResourceFunc
+	Update    UpdateResourceFunc
+	Delete    DeleteResourceFunc
+	DeleteAll DeleteResourcesFunc
+	List      ListResourcesFunc
+	Filter    FilterResourcesFunc
+}
+
+var (
+	resourceHandlersMu sync.RWMutex
+	resourceHandlers   = make(map[inv_v1.ResourceKind]*ResourceHandler)
+)
+
+// RegisterResourceHandler registers the store operations of a resource kind. It panics if the kind is
+// registered twice or if its metadata is unknown to util, both being programming errors.
+func RegisterResourceHandler(handler ResourceHandler) {
+	if _, ok := util.LookupResourceKind(handler.Kind); !ok {
+		panic(fmt.Sprintf("resource kind %s has no registered metadata", handler.Kind))
+	}
+	resourceHandlersMu.Lock()
+	defer resourceHandlersMu.Unlock()
+	if _, ok := resourceHandlers[handler.Kind]; ok {
+		panic(fmt.Sprintf("resource handler for %s registered twice", handler.Kind))
+	}
+	resourceHandlers[handler.Kind] = &handler
+}
+
+// LookupResourceHandler returns the store operations registered for the given kind.
+func LookupResourceHandler(kind inv_v1.ResourceKind) (*ResourceHandler, bool) {
+	resourceHandlersMu.RLock()
+	defer resourceHandlersMu.RUnlock()
+	handler, ok := resourceHandlers[kind]
+	return handler, ok
+}
+
+// RegisteredResourceHandlers returns all the registered handlers, sorted by kind.
+func RegisteredResourceHandlers() []*ResourceHandler {
+	resourceHandlersMu.RLock()
+	defer resourceHandlersMu.RUnlock()
+	handlers := make([]*ResourceHandler, 0, len(resourceHandlers))
+	for _, handler := range resourceHandlers {
+		handlers = append(handlers, handler)
+	}
+	sort.Slice(handlers, func(i, j int) bool { return handlers[i].Kind < handlers[j].Kind })
+	return handlers
+}
+
+// The adapters below lift the kind-specific store methods to the generic signatures of ResourceHandler.
+
+// unwrapAs returns the concrete resource set in the given Resource, failing if it is of another kind.
+func unwrapAs[T proto.Message](in *inv_v1.Resource) (T, error) {
+	var zero T
+	set, err := util.GetSetResource(in)
+	if err != nil {
+		return zero, err
+	}
+	msg, ok := set.(T)
+	if !ok {
+		zlog.InfraSec().InfraError("unexpected Resource type: %T", in.GetResource()).Msg("")
+		return zero, errors.Errorfc(codes.InvalidArgument, "unexpected Resource type: %T", in.GetResource())
+	}
+	return msg, nil
+}
+
+func createAs[T proto.Message](
+	create func(*InvStore, context.Context, T) (*inv_v1.Resource, error),
+) CreateResourceFunc {
+	return func(is *InvStore, ctx context.Context, in *inv_v1.Resource) (*inv_v1.Resource, error) {
+		msg, err := unwrapAs[T](in)
+		if err != nil {
+			return nil, err
+		}
+		return create(is, ctx, msg)
+	}
+}
+
+func getByID(get func(*InvStore, context.Context, string) (*inv_v1.Resource, error)) GetResourceFunc {
+	return func(is *InvStore, ctx context.Context, id, _ string) (
+		*inv_v1.Resource, *inv_v1.GetResourceResponse_ResourceMetadata, error,
+	) {
+		res, err := get(is, ctx, id)
+		return res, nil, err
+	}
+}
+
+func updateAs[T proto.Message](
+	update func(*InvStore, context.Context, string, T, *fieldmaskpb.FieldMask) (*inv_v1.Resource, error),
+) UpdateResourceFunc {
+	return func(
+		is *InvStore, ctx context.Context, id, _ string, in *inv_v1.Resource, fm *fieldmaskpb.FieldMask,
+	) (*inv_v1.Resource, bool, error) {
+		msg, err := unwrapAs[T](in)
+		if err != nil {
+			return nil, false, err
+		}
+		res, err := update(is, ctx, id, msg, fm)
+		return res, false, err
+	}
+}
+
+func updateAsWithHardDelete[T proto.Message](
+	update func(*InvStore, context.Context, string, T, *fieldmaskpb.FieldMask) (*inv_v1.Resource, bool, error),
+) UpdateResourceFunc {
+	return func(
+		is *InvStore, ctx context.Context, id, _ string, in *inv_v1.Resource, fm *fieldmaskpb.FieldMask,
+	) (*inv_v1.Resource, bool, error) {
+		msg, err := unwrapAs[T](in)
+		if err != nil {
+			return nil, false, err
+		}
+		return update(is, ctx, id, msg, fm)
+	}
+}
+
+func updateAsInTenant[T proto.Message](
+	update func(*InvStore, context.Context, string, T, *fieldmaskpb.FieldMask, string) (*inv_v1.Resource, error),
+) UpdateResourceFunc {
+	return func(
+		is *InvStore, ctx context.Context, id, tenantID string, in *inv_v1.Resource, fm *fieldmaskpb.FieldMask,
+	) (*inv_v1.Resource, bool, error) {
+		msg, err := unwrapAs[T](in)
+		if err != nil {
+			return nil, false, err
+		}
+		res, err := update(is, ctx, id, msg, fm, tenantID)
+		return res, false, err
+	}
+}
+
+func updateAsInTenantWithHardDelete[T proto.Message](
+	update func(*InvStore, context.Context, string, T, *fieldmaskpb.FieldMask, string) (*inv_v1.Resource, bool, error),
+) UpdateResourceFunc {
+	return func(
+		is *InvStore, ctx context.Context, id, tenantID string, in *inv_v1.Resource, fm *fieldmaskpb.FieldMask,
+	) (*inv_v1.Resource, bool, error) {
+		msg, err := unwrapAs[T](in)
+		if err != nil {
+			return nil, false, err
+		}
+		return update(is, ctx, id, msg, fm, tenantID)
+	}
+}
+
+func deleteByID(del func(*InvStore, context.Context, string) (*inv_v1.Resource, error)) DeleteResourceFunc {
+	return func(is *InvStore, ctx context.Context, id, _ string) (*inv_v1.Resource, bool, error) {
+		res, err := del(is, ctx, id)
+		return res, false, err
+	}
+}
+
+func deleteByIDWithSoftDelete(
+	del func(*InvStore, context.Context, string) (*inv_v1.Resource, bool, error),
+) DeleteResourceFunc {
+	return func(is *InvStore, ctx context.Context, id, _ string) (*inv_v1.Resource, bool, error) {
+		return del(is, ctx, id)
+	}
+}
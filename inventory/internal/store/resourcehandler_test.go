@@ -0,0 +1,696 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package store_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/store"
+	computev1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/compute/v1"
+	customresourcev1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/customresource/v1"
+	inv_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/inventory/v1"
+	localaccount_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/localaccount/v1"
+	location_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/location/v1"
+	network_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/network/v1"
+	osv1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/os/v1"
+	ou_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/ou/v1"
+	provider_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/provider/v1"
+	remoteaccessv1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/remoteaccess/v1"
+	rolebindingv1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/rolebinding/v1"
+	schedule_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/schedule/v1"
+	statusv1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/status/v1"
+	telemetry_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/telemetry/v1"
+	tenantv1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/tenant/v1"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/client"
+	inv_testing "github.com/open-edge-platform/infra-core/inventory/v2/pkg/testing"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/util"
+)
+
+// conformanceCase describes the round-trip of a resource kind through the generic endpoints.
+type conformanceCase struct {
+	// client writes and reads the resource, the API client if unset.
+	client inv_testing.ClientType
+	// build returns the resource to create, its dependencies are created with a cleanup.
+	build func(t *testing.T, dao *inv_testing.InvResourceDAO, tenantID string) *inv_v1.Resource
+	// update holds the values written by the update, paths being its field mask.
+	update *inv_v1.Resource
+	paths  []string
+	// rmDeleteOnly resources are only removed by their resource manager, Delete is not supported.
+	rmDeleteOnly bool
+}
+
+//nolint:funlen // table of the registered resource kinds
+func conformanceCases() map[inv_v1.ResourceKind]conformanceCase {
+	now := uint64(time.Now().Unix()) //nolint:gosec // no overflow for a few billion years
+	return map[inv_v1.ResourceKind]conformanceCase{
+		inv_v1.ResourceKind_RESOURCE_KIND_REGION: {
+			build: func(_ *testing.T, _ *inv_testing.InvResourceDAO, tenantID string) *inv_v1.Resource {
+				return &inv_v1.Resource{Resource: &inv_v1.Resource_Region{Region: &location_v1.RegionResource{
+					Name: "conformance", RegionKind: "test region", TenantId: tenantID,
+				}}}
+			},
+			update: &inv_v1.Resource{Resource: &inv_v1.Resource_Region{Region: &location_v1.RegionResource{
+				Name: "updated",
+			}}},
+			paths: []string{"name"},
+		},
+		inv_v1.ResourceKind_RESOURCE_KIND_SITE: {
+			build: func(_ *testing.T, _ *inv_testing.InvResourceDAO, tenantID string) *inv_v1.Resource {
+				return &inv_v1.Resource{Resource: &inv_v1.Resource_Site{Site: &location_v1.SiteResource{
+					Name: "conformance", TenantId: tenantID,
+				}}}
+			},
+			update: &inv_v1.Resource{Resource: &inv_v1.Resource_Site{Site: &location_v1.SiteResource{
+				Name: "updated",
+			}}},
+			paths: []string{"name"},
+		},
+		inv_v1.ResourceKind_RESOURCE_KIND_OU: {
+			build: func(_ *testing.T, _ *inv_testing.InvResourceDAO, tenantID string) *inv_v1.Resource {
+				return &inv_v1.Resource{Resource: &inv_v1.Resource_Ou{Ou: &ou_v1.OuResource{
+					Name: "conformance", OuKind: "test BU", TenantId: tenantID,
+				}}}
+			},
+			update: &inv_v1.Resource{Resource: &inv_v1.Resource_Ou{Ou: &ou_v1.OuResource{
+				Name: "updated",
+			}}},
+			paths: []string{"name"},
+		},
+		inv_v1.ResourceKind_RESOURCE_KIND_INSTANCE: {
+			build: func(t *testing.T, dao *inv_testing.InvResourceDAO, tenantID string) *inv_v1.Resource {
+				t.Helper()
+				return &inv_v1.Resource{Resource: &inv_v1.Resource_Instance{Instance: &computev1.InstanceResource{
+					Kind:         computev1.InstanceKind_INSTANCE_KIND_METAL,
+					Name:         "conformance",
+					DesiredState: computev1.InstanceState_INSTANCE_STATE_RUNNING,
+					Host:         dao.CreateHost(t, tenantID),
+					Os:           dao.CreateOs(t, tenantID),
+					TenantId:     tenantID,
+				}}}
+			},
+			update: &inv_v1.Resource{Resource: &inv_v1.Resource_Instance{Instance: &computev1.InstanceResource{
+				Name: "updated",
+			}}},
+			paths: []string{"name"},
+		},
+		inv_v1.ResourceKind_RESOURCE_KIND_HOST: {
+			build: func(_ *testing.T, _ *inv_testing.InvResourceDAO, tenantID string) *inv_v1.Resource {
+				return &inv_v1.Resource{Resource: &inv_v1.Resource_Host{Host: &computev1.HostResource{
+					Name:         "conformance",
+					DesiredState: computev1.HostState_HOST_STATE_ONBOARDED,
+					Uuid:         uuid.NewString(),
+					SerialNumber: "12345678",
+					TenantId:     tenantID,
+				}}}
+			},
+			update: &inv_v1.Resource{Resource: &inv_v1.Resource_Host{Host: &computev1.HostResource{
+				Name: "updated",
+			}}},
+			paths: []string{"name"},
+		},
+		inv_v1.ResourceKind_RESOURCE_KIND_HOSTSTORAGE: {
+			client: inv_testing.RMClient,
+			build: func(t *testing.T, dao *inv_testing.InvResourceDAO, tenantID string) *inv_v1.Resource {
+				t.Helper()
+				return &inv_v1.Resource{Resource: &inv_v1.Resource_Hoststorage{Hoststorage: &computev1.HoststorageResource{
+					Host: dao.CreateHost(t, tenantID), CapacityBytes: 1000 * util.Gigabyte, TenantId: tenantID,
+				}}}
+			},
+			update: &inv_v1.Resource{Resource: &inv_v1.Resource_Hoststorage{Hoststorage: &computev1.HoststorageResource{
+				DeviceName: "sdb",
+			}}},
+			paths: []string{"device_name"},
+		},
+		inv_v1.ResourceKind_RESOURCE_KIND_HOSTNIC: {
+			client: inv_testing.RMClient,
+			build: func(t *testing.T, dao *inv_testing.InvResourceDAO, tenantID string) *inv_v1.Resource {
+				t.Helper()
+				return &inv_v1.Resource{Resource: &inv_v1.Resource_Hostnic{Hostnic: &computev1.HostnicResource{
+					Host: dao.CreateHost(t, tenantID), TenantId: tenantID,
+				}}}
+			},
+			update: &inv_v1.Resource{Resource: &inv_v1.Resource_Hostnic{Hostnic: &computev1.HostnicResource{
+				DeviceName: "eth1",
+			}}},
+			paths: []string{"device_name"},
+		},
+		inv_v1.ResourceKind_RESOURCE_KIND_HOSTUSB: {
+			client: inv_testing.RMClient,
+			build: func(t *testing.T, dao *inv_testing.InvResourceDAO, tenantID string) *inv_v1.Resource {
+				t.Helper()
+				return &inv_v1.Resource{Resource: &inv_v1.Resource_Hostusb{Hostusb: &computev1.HostusbResource{
+					Host: dao.CreateHost(t, tenantID), TenantId: tenantID,
+				}}}
+			},
+			update: &inv_v1.Resource{Resource: &inv_v1.Resource_Hostusb{Hostusb: &computev1.HostusbResource{
+				DeviceName: "usb1",
+			}}},
+			paths: []string{"device_name"},
+		},
+		inv_v1.ResourceKind_RESOURCE_KIND_HOSTGPU: {
+			client: inv_testing.RMClient,
+			build: func(t *testing.T, dao *inv_testing.InvResourceDAO, tenantID string) *inv_v1.Resource {
+				t.Helper()
+				return &inv_v1.Resource{Resource: &inv_v1.Resource_Hostgpu{Hostgpu: &computev1.HostgpuResource{
+					DeviceName: "Test GPU", PciId: "00:00.1", Host: dao.CreateHost(t, tenantID), TenantId: tenantID,
+				}}}
+			},
+			update: &inv_v1.Resource{Resource: &inv_v1.Resource_Hostgpu{Hostgpu: &computev1.HostgpuResource{
+				DeviceName: "Updated GPU",
+			}}},
+			paths: []string{"device_name"},
+		},
+		inv_v1.ResourceKind_RESOURCE_KIND_WORKLOAD: {
+			build: func(_ *testing.T, _ *inv_testing.InvResourceDAO, tenantID string) *inv_v1.Resource {
+				// Cluster workloads are removed right away, DHCP ones go through the two-phase deletion.
+				return &inv_v1.Resource{Resource: &inv_v1.Resource_Workload{Workload: &computev1.WorkloadResource{
+					Kind:         computev1.WorkloadKind_WORKLOAD_KIND_DHCP,
+					Name:         "conformance",
+					DesiredState: computev1.WorkloadState_WORKLOAD_STATE_PROVISIONED,
+					ExternalId:   uuid.NewString(),
+					TenantId:     tenantID,
+				}}}
+			},
+			update: &inv_v1.Resource{Resource: &inv_v1.Resource_Workload{Workload: &computev1.WorkloadResource{
+				Name: "updated",
+			}}},
+			paths: []string{"name"},
+		},
+		inv_v1.ResourceKind_RESOURCE_KIND_WORKLOAD_MEMBER: {
+			build: func(t *testing.T, dao *inv_testing.InvResourceDAO, tenantID string) *inv_v1.Resource {
+				t.Helper()
+				instance := dao.CreateInstance(t, tenantID, dao.CreateHost(t, tenantID), dao.CreateOs(t, tenantID))
+				return &inv_v1.Resource{Resource: &inv_v1.Resource_WorkloadMember{WorkloadMember: &computev1.WorkloadMember{
+					Kind:     computev1.WorkloadMemberKind_WORKLOAD_MEMBER_KIND_CLUSTER_NODE,
+					Workload: dao.CreateWorkload(t, tenantID),
+					Instance: instance,
+					TenantId: tenantID,
+				}}}
+			},
+			update: &inv_v1.Resource{Resource: &inv_v1.Resource_WorkloadMember{WorkloadMember: &computev1.WorkloadMember{
+				Kind: computev1.WorkloadMemberKind_WORKLOAD_MEMBER_KIND_CLUSTER_NODE,
+			}}},
+			paths: []string{"kind"},
+		},
+		inv_v1.ResourceKind_RESOURCE_KIND_OSUPDATEPOLICY: {
+			build: func(_ *testing.T, _ *inv_testing.InvResourceDAO, tenantID string) *inv_v1.Resource {
+				return &inv_v1.Resource{Resource: &inv_v1.Resource_OsUpdatePolicy{OsUpdatePolicy: &computev1.OSUpdatePolicyResource{
+					Name:         inv_testing.GenerateRandomOsUpdatePolicyName(),
+					UpdatePolicy: computev1.UpdatePolicy_UPDATE_POLICY_LATEST,
+					TenantId:     tenantID,
+				}}}
+			},
+			update: &inv_v1.Resource{Resource: &inv_v1.Resource_OsUpdatePolicy{OsUpdatePolicy: &computev1.OSUpdatePolicyResource{
+				Description: "updated",
+			}}},
+			paths: []string{"description"},
+		},
+		inv_v1.ResourceKind_RESOURCE_KIND_CUSTOMCONFIG: {
+			build: func(_ *testing.T, _ *inv_testing.InvResourceDAO, tenantID string) *inv_v1.Resource {
+				return &inv_v1.Resource{Resource: &inv_v1.Resource_CustomConfig{CustomConfig: &computev1.CustomConfigResource{
+					Name: "conformance", Config: testCloudInitConfig, TenantId: tenantID,
+				}}}
+			},
+			update: &inv_v1.Resource{Resource: &inv_v1.Resource_CustomConfig{CustomConfig: &computev1.CustomConfigResource{
+				Description: "updated",
+			}}},
+			paths: []string{"description"},
+		},
+		inv_v1.ResourceKind_RESOURCE_KIND_OSUPDATERUN: {
+			build: func(t *testing.T, dao *inv_testing.InvResourceDAO, tenantID string) *inv_v1.Resource {
+				t.Helper()
+				instance := dao.CreateInstance(t, tenantID, dao.CreateHost(t, tenantID), dao.CreateOs(t, tenantID))
+				return &inv_v1.Resource{Resource: &inv_v1.Resource_OsUpdateRun{OsUpdateRun: &computev1.OSUpdateRunResource{
+					Name:            "conformance",
+					AppliedPolicy:   dao.CreateOSUpdatePolicy(t, tenantID),
+					Instance:        instance,
+					StartTime:       now,
+					StatusIndicator: statusv1.StatusIndication_STATUS_INDICATION_IN_PROGRESS,
+					StatusTimestamp: now,
+					TenantId:        tenantID,
+				}}}
+			},
+			update: &inv_v1.Resource{Resource: &inv_v1.Resource_OsUpdateRun{OsUpdateRun: &computev1.OSUpdateRunResource{
+				Description: "updated",
+			}}},
+			paths: []string{"description"},
+		},
+		inv_v1.ResourceKind_RESOURCE_KIND_NETWORKSEGMENT: {
+			build: func(t *testing.T, dao *inv_testing.InvResourceDAO, tenantID string) *inv_v1.Resource {
+				t.Helper()
+				return &inv_v1.Resource{Resource: &inv_v1.Resource_NetworkSegment{NetworkSegment: &network_v1.NetworkSegment{
+					Name: "conformance", Site: dao.CreateSite(t, tenantID), VlanId: 10, TenantId: tenantID,
+				}}}
+			},
+			update: &inv_v1.Resource{Resource: &inv_v1.Resource_NetworkSegment{NetworkSegment: &network_v1.NetworkSegment{
+				Name: "updated",
+			}}},
+			paths: []string{"name"},
+		},
+		inv_v1.ResourceKind_RESOURCE_KIND_NETLINK: {
+			build: func(_ *testing.T, _ *inv_testing.InvResourceDAO, tenantID string) *inv_v1.Resource {
+				return &inv_v1.Resource{Resource: &inv_v1.Resource_Netlink{Netlink: &network_v1.NetlinkResource{
+					DesiredState: network_v1.NetlinkState_NETLINK_STATE_ONLINE, TenantId: tenantID,
+				}}}
+			},
+			update: &inv_v1.Resource{Resource: &inv_v1.Resource_Netlink{Netlink: &network_v1.NetlinkResource{
+				Name: "updated",
+			}}},
+			paths: []string{"name"},
+		},
+		inv_v1.ResourceKind_RESOURCE_KIND_ENDPOINT: {
+			build: func(t *testing.T, dao *inv_testing.InvResourceDAO, tenantID string) *inv_v1.Resource {
+				t.Helper()
+				return &inv_v1.Resource{Resource: &inv_v1.Resource_Endpoint{Endpoint: &network_v1.EndpointResource{
+					Name: "conformance", Host: dao.CreateHost(t, tenantID), TenantId: tenantID,
+				}}}
+			},
+			update: &inv_v1.Resource{Resource: &inv_v1.Resource_Endpoint{Endpoint: &network_v1.EndpointResource{
+				Name: "updated",
+			}}},
+			paths: []string{"name"},
+		},
+		inv_v1.ResourceKind_RESOURCE_KIND_IPADDRESS: {
+			client: inv_testing.RMClient,
+			build: func(t *testing.T, dao *inv_testing.InvResourceDAO, tenantID string) *inv_v1.Resource {
+				t.Helper()
+				return &inv_v1.Resource{Resource: &inv_v1.Resource_Ipaddress{Ipaddress: &network_v1.IPAddressResource{
+					Address:      "192.168.0.1/24",
+					CurrentState: network_v1.IPAddressState_IP_ADDRESS_STATE_CONFIGURED,
+					ConfigMethod: network_v1.IPAddressConfigMethod_IP_ADDRESS_CONFIG_METHOD_DYNAMIC,
+					Nic:          dao.CreateHostNic(t, tenantID, dao.CreateHost(t, tenantID)),
+					TenantId:     tenantID,
+				}}}
+			},
+			update: &inv_v1.Resource{Resource: &inv_v1.Resource_Ipaddress{Ipaddress: &network_v1.IPAddressResource{
+				StatusDetail: "updated",
+			}}},
+			paths:        []string{"status_detail"},
+			rmDeleteOnly: true,
+		},
+		inv_v1.ResourceKind_RESOURCE_KIND_PROVIDER: {
+			build: func(_ *testing.T, _ *inv_testing.InvResourceDAO, tenantID string) *inv_v1.Resource {
+				return &inv_v1.Resource{Resource: &inv_v1.Resource_Provider{Provider: &provider_v1.ProviderResource{
+					ProviderVendor: provider_v1.ProviderVendor_PROVIDER_VENDOR_LENOVO_LXCA,
+					Name:           "conformance",
+					ApiEndpoint:    "https://192.168.201.3/discovery",
+					ApiCredentials: []string{"test", "test"},
+					TenantId:       tenantID,
+				}}}
+			},
+			update: &inv_v1.Resource{Resource: &inv_v1.Resource_Provider{Provider: &provider_v1.ProviderResource{
+				ApiEndpoint: "https://192.168.201.4/discovery",
+			}}},
+			paths: []string{"api_endpoint"},
+		},
+		inv_v1.ResourceKind_RESOURCE_KIND_OS: {
+			build: func(_ *testing.T, _ *inv_testing.InvResourceDAO, tenantID string) *inv_v1.Resource {
+				return &inv_v1.Resource{Resource: &inv_v1.Resource_Os{Os: &osv1.OperatingSystemResource{
+					Name:           inv_testing.GenerateRandomOsResourceName(),
+					ImageUrl:       "Repo URL Test",
+					ProfileName:    inv_testing.GenerateRandomProfileName(),
+					ProfileVersion: "1.0.0",
+					Sha256:         inv_testing.GenerateRandomSha256(),
+					OsType:         osv1.OsType_OS_TYPE_MUTABLE,
+					OsProvider:     osv1.OsProviderKind_OS_PROVIDER_KIND_INFRA,
+					TenantId:       tenantID,
+				}}}
+			},
+			update: &inv_v1.Resource{Resource: &inv_v1.Resource_Os{Os: &osv1.OperatingSystemResource{
+				Description: "updated",
+			}}},
+			paths: []string{"description"},
+		},
+		inv_v1.ResourceKind_RESOURCE_KIND_SINGLESCHEDULE: {
+			build: func(_ *testing.T, _ *inv_testing.InvResourceDAO, tenantID string) *inv_v1.Resource {
+				return &inv_v1.Resource{Resource: &inv_v1.Resource_Singleschedule{
+					Singleschedule: &schedule_v1.SingleScheduleResource{
+						Name:           "conformance",
+						ScheduleStatus: schedule_v1.ScheduleStatus_SCHEDULE_STATUS_MAINTENANCE,
+						StartSeconds:   now + 3600,
+						EndSeconds:     now + 7200,
+						TenantId:       tenantID,
+					},
+				}}
+			},
+			update: &inv_v1.Resource{Resource: &inv_v1.Resource_Singleschedule{
+				Singleschedule: &schedule_v1.SingleScheduleResource{
+					Name: "updated",
+				},
+			}},
+			paths: []string{"name"},
+		},
+		inv_v1.ResourceKind_RESOURCE_KIND_REPEATEDSCHEDULE: {
+			build: func(_ *testing.T, _ *inv_testing.InvResourceDAO, tenantID string) *inv_v1.Resource {
+				return &inv_v1.Resource{Resource: &inv_v1.Resource_Repeatedschedule{
+					Repeatedschedule: &schedule_v1.RepeatedScheduleResource{
+						Name:            "conformance",
+						ScheduleStatus:  schedule_v1.ScheduleStatus_SCHEDULE_STATUS_MAINTENANCE,
+						DurationSeconds: 100,
+						CronMinutes:     "3",
+						CronHours:       "4",
+						CronDayMonth:    "5",
+						CronMonth:       "6",
+						CronDayWeek:     "0",
+						TenantId:        tenantID,
+					},
+				}}
+			},
+			update: &inv_v1.Resource{Resource: &inv_v1.Resource_Repeatedschedule{
+				Repeatedschedule: &schedule_v1.RepeatedScheduleResource{Name: "updated"},
+			}},
+			paths: []string{"name"},
+		},
+		inv_v1.ResourceKind_RESOURCE_KIND_TELEMETRY_GROUP: {
+			build: func(_ *testing.T, _ *inv_testing.InvResourceDAO, tenantID string) *inv_v1.Resource {
+				return &inv_v1.Resource{Resource: &inv_v1.Resource_TelemetryGroup{
+					TelemetryGroup: &telemetry_v1.TelemetryGroupResource{
+						Name:          "conformance",
+						Kind:          telemetry_v1.TelemetryResourceKind_TELEMETRY_RESOURCE_KIND_METRICS,
+						CollectorKind: telemetry_v1.CollectorKind_COLLECTOR_KIND_HOST,
+						Groups:        []string{"cpu", "memory"},
+						TenantId:      tenantID,
+					},
+				}}
+			},
+			update: &inv_v1.Resource{Resource: &inv_v1.Resource_TelemetryGroup{
+				TelemetryGroup: &telemetry_v1.TelemetryGroupResource{
+					Name: "updated",
+				},
+			}},
+			paths: []string{"name"},
+		},
+		inv_v1.ResourceKind_RESOURCE_KIND_TELEMETRY_PROFILE: {
+			build: func(t *testing.T, dao *inv_testing.InvResourceDAO, tenantID string) *inv_v1.Resource {
+				t.Helper()
+				return &inv_v1.Resource{Resource: &inv_v1.Resource_TelemetryProfile{
+					TelemetryProfile: &telemetry_v1.TelemetryProfile{
+						Kind:            telemetry_v1.TelemetryResourceKind_TELEMETRY_RESOURCE_KIND_METRICS,
+						Group:           dao.CreateTelemetryGroupMetrics(t, tenantID, true),
+						Relation:        &telemetry_v1.TelemetryProfile_Region{Region: dao.CreateRegion(t, tenantID)},
+						MetricsInterval: 300,
+						TenantId:        tenantID,
+					},
+				}}
+			},
+			update: &inv_v1.Resource{Resource: &inv_v1.Resource_TelemetryProfile{
+				TelemetryProfile: &telemetry_v1.TelemetryProfile{
+					MetricsInterval: 60,
+				},
+			}},
+			paths: []string{"metrics_interval"},
+		},
+		inv_v1.ResourceKind_RESOURCE_KIND_RMT_ACCESS_CONF: {
+			build: func(t *testing.T, dao *inv_testing.InvResourceDAO, tenantID string) *inv_v1.Resource {
+				t.Helper()
+				instance := dao.CreateInstance(t, tenantID, dao.CreateHost(t, tenantID), dao.CreateOs(t, tenantID))
+				return &inv_v1.Resource{Resource: &inv_v1.Resource_RemoteAccess{
+					RemoteAccess: &remoteaccessv1.RemoteAccessConfiguration{
+						DesiredState:        remoteaccessv1.RemoteAccessState_REMOTE_ACCESS_STATE_ENABLED,
+						Instance:            instance,
+						ExpirationTimestamp: now + 601,
+						TenantId:            tenantID,
+					},
+				}}
+			},
+			update: &inv_v1.Resource{Resource: &inv_v1.Resource_RemoteAccess{
+				RemoteAccess: &remoteaccessv1.RemoteAccessConfiguration{
+					User: "updated",
+				},
+			}},
+			paths: []string{"user"},
+		},
+		inv_v1.ResourceKind_RESOURCE_KIND_TENANT: {
+			client: inv_testing.TCClient,
+			build: func(_ *testing.T, _ *inv_testing.InvResourceDAO, tenantID string) *inv_v1.Resource {
+				return &inv_v1.Resource{Resource: &inv_v1.Resource_Tenant{Tenant: &tenantv1.Tenant{
+					TenantId: tenantID,
+				}}}
+			},
+			update: &inv_v1.Resource{Resource: &inv_v1.Resource_Tenant{Tenant: &tenantv1.Tenant{
+				WatcherOsmanager: true,
+			}}},
+			paths: []string{"watcher_osmanager"},
+		},
+		inv_v1.ResourceKind_RESOURCE_KIND_LOCALACCOUNT: {
+			build: func(_ *testing.T, _ *inv_testing.InvResourceDAO, tenantID string) *inv_v1.Resource {
+				return &inv_v1.Resource{Resource: &inv_v1.Resource_LocalAccount{LocalAccount: &localaccount_v1.LocalAccountResource{
+					Username: "test-user",
+					SshKey: "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAILtu+7Pdtj6ihyFynecnd+155AdxqvHhMRxvxdcQ8/D/" +
+						" test-user@example.com",
+					TenantId: tenantID,
+				}}}
+			},
+			update: &inv_v1.Resource{Resource: &inv_v1.Resource_LocalAccount{LocalAccount: &localaccount_v1.LocalAccountResource{
+				Username: "updated-user",
+			}}},
+			paths: []string{"username"},
+		},
+		inv_v1.ResourceKind_RESOURCE_KIND_CUSTOMTYPE: {
+			build: func(_ *testing.T, _ *inv_testing.InvResourceDAO, tenantID string) *inv_v1.Resource {
+				return &inv_v1.Resource{Resource: &inv_v1.Resource_CustomType{CustomType: &customresourcev1.CustomTypeResource{
+					Name: "rack", JsonSchema: testRackSchema, TenantId: tenantID,
+				}}}
+			},
+			update: &inv_v1.Resource{Resource: &inv_v1.Resource_CustomType{CustomType: &customresourcev1.CustomTypeResource{
+				Description: "updated",
+			}}},
+			paths: []string{"description"},
+		},
+		inv_v1.ResourceKind_RESOURCE_KIND_CUSTOMOBJECT: {
+			build: func(t *testing.T, dao *inv_testing.InvResourceDAO, tenantID string) *inv_v1.Resource {
+				t.Helper()
+				return &inv_v1.Resource{Resource: &inv_v1.Resource_CustomObject{
+					CustomObject: &customresourcev1.CustomObjectResource{
+						Name:       "conformance",
+						CustomType: dao.CreateCustomType(t, tenantID, "rack", testRackSchema, "site"),
+						Spec:       `{"rack": {"units": 42}}`,
+						TenantId:   tenantID,
+					},
+				}}
+			},
+			update: &inv_v1.Resource{Resource: &inv_v1.Resource_CustomObject{
+				CustomObject: &customresourcev1.CustomObjectResource{
+					Name: "updated",
+				},
+			}},
+			paths: []string{"name"},
+		},
+		inv_v1.ResourceKind_RESOURCE_KIND_ROLEBINDING: {
+			build: func(t *testing.T, dao *inv_testing.InvResourceDAO, tenantID string) *inv_v1.Resource {
+				t.Helper()
+				return &inv_v1.Resource{Resource: &inv_v1.Resource_RoleBinding{RoleBinding: &rolebindingv1.RoleBindingResource{
+					Subject:  "alice",
+					Role:     rolebindingv1.ScopedRole_SCOPED_ROLE_READ,
+					Region:   dao.CreateRegion(t, tenantID),
+					TenantId: tenantID,
+				}}}
+			},
+			update: &inv_v1.Resource{Resource: &inv_v1.Resource_RoleBinding{RoleBinding: &rolebindingv1.RoleBindingResource{
+				Subject: "bob",
+			}}},
+			paths: []string{"subject"},
+		},
+	}
+}
+
+// Test_ResourceHandlerConformance drives every registered resource kind through a create, get, update, list/find
+// and delete round-trip of the generic endpoints, including the two-phase deletion. Any new kind must add a case.
+//
+//nolint:funlen // round-trip of the generic endpoints
+func Test_ResourceHandlerConformance(t *testing.T) {
+	dao := inv_testing.NewInvResourceDAOOrFail(t)
+	clients := map[inv_testing.ClientType]client.TenantAwareInventoryClient{
+		inv_testing.APIClient: dao.GetAPIClient(),
+		inv_testing.RMClient:  dao.GetRMClient(),
+		inv_testing.TCClient:  dao.GetTCClient(),
+	}
+	cases := conformanceCases()
+
+	assert.Len(t, store.RegisteredResourceHandlers(), len(util.RegisteredResourceKinds()),
+		"every registered resource kind must have a resource handler")
+
+	for _, info := range util.RegisteredResourceKinds() {
+		t.Run(info.Kind.String(), func(t *testing.T) {
+			handler, ok := store.LookupResourceHandler(info.Kind)
+			require.Truef(t, ok, "%s has no resource handler", info.Kind)
+			assert.Equal(t, info.Kind, handler.Kind)
+			assert.NotNil(t, handler.Create)
+			assert.NotNil(t, handler.Get)
+			assert.NotNil(t, handler.Delete)
+			assert.NotNil(t, handler.List)
+			assert.NotNil(t, handler.Filter)
+
+			tc, ok := cases[info.Kind]
+			require.Truef(t, ok, "%s has no conformance case", info.Kind)
+			ct := tc.client
+			if ct == "" {
+				ct = inv_testing.APIClient
+			}
+			invClient := clients[ct]
+			// The resource manager completes the two-phase deletion, the tenant controller for tenants.
+			reconciler := clients[inv_testing.RMClient]
+			if info.Kind == inv_v1.ResourceKind_RESOURCE_KIND_TENANT {
+				reconciler = clients[inv_testing.TCClient]
+			}
+			tenantID := uuid.NewString()
+
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			_, err := invClient.Get(ctx, tenantID, util.NewInvID(info.Kind))
+			require.Error(t, err)
+			assert.Equal(t, codes.NotFound, status.Code(err))
+
+			// Create
+			created, err := invClient.Create(ctx, tenantID, tc.build(t, dao, tenantID))
+			require.NoError(t, err)
+			id := inv_testing.GetResourceIDOrFail(t, created)
+			kind, err := util.GetResourceKindFromResourceID(id)
+			require.NoError(t, err)
+			assert.Equal(t, info.Kind, kind)
+			t.Cleanup(func() { purgeResource(tenantID, id, info, clients[inv_testing.APIClient], reconciler) })
+
+			// Get
+			got, err := invClient.Get(ctx, tenantID, id)
+			require.NoError(t, err)
+			assert.Equal(t, id, inv_testing.GetResourceIDOrFail(t, got.GetResource()))
+
+			// Update, only the fields in the mask are written.
+			_, err = invClient.Update(ctx, tenantID, id, &fieldmaskpb.FieldMask{Paths: tc.paths},
+				proto.Clone(tc.update).(*inv_v1.Resource))
+			require.NoError(t, err)
+			got, err = invClient.Get(ctx, tenantID, id)
+			require.NoError(t, err)
+			assertFieldsEqual(t, tc.update, got.GetResource(), tc.paths)
+
+			// List and Find
+			res, err := util.GetResourceFromKind(info.Kind)
+			require.NoError(t, err)
+			filter := &inv_v1.ResourceFilter{Resource: res, Filter: fmt.Sprintf(`resource_id = %q`, id)}
+			listed, err := invClient.List(ctx, filter)
+			require.NoError(t, err)
+			require.Len(t, listed.GetResources(), 1)
+			assert.Equal(t, id, inv_testing.GetResourceIDOrFail(t, listed.GetResources()[0].GetResource()))
+			found, err := invClient.Find(ctx, filter)
+			require.NoError(t, err)
+			require.Len(t, found.GetResources(), 1)
+			assert.Equal(t, id, found.GetResources()[0].GetResourceId())
+			assert.Equal(t, tenantID, found.GetResources()[0].GetTenantId())
+
+			// Delete
+			if tc.rmDeleteOnly {
+				_, err = invClient.Delete(ctx, tenantID, id)
+				require.Error(t, err)
+				assert.Equal(t, codes.Unimplemented, status.Code(err))
+			} else {
+				_, err = invClient.Delete(ctx, tenantID, id)
+				require.NoError(t, err)
+			}
+			if info.TwoPhaseDelete {
+				// Only marked for deletion, until the current state is reconciled.
+				got, err = invClient.Get(ctx, tenantID, id)
+				require.NoError(t, err)
+				if !tc.rmDeleteOnly {
+					assertDeletedState(t, got.GetResource(), "desired_state")
+				}
+				deleted, err := deletedCurrentState(info.Kind)
+				require.NoError(t, err)
+				_, err = reconciler.Update(ctx, tenantID, id, &fieldmaskpb.FieldMask{Paths: []string{"current_state"}}, deleted)
+				require.NoError(t, err)
+			}
+			_, err = invClient.Get(ctx, tenantID, id)
+			require.Error(t, err)
+			assert.Equal(t, codes.NotFound, status.Code(err))
+		})
+	}
+}
+
+// assertFieldsEqual checks that the given fields hold the same values in both resources.
+func assertFieldsEqual(t *testing.T, want, got *inv_v1.Resource, paths []string) {
+	t.Helper()
+	wantMsg, err := util.GetSetResource(want)
+	require.NoError(t, err)
+	gotMsg, err := util.GetSetResource(got)
+	require.NoError(t, err)
+	for _, path := range paths {
+		fd := wantMsg.ProtoReflect().Descriptor().Fields().ByName(protoreflect.Name(path))
+		require.NotNilf(t, fd, "unknown field %s", path)
+		assert.Equalf(t, wantMsg.ProtoReflect().Get(fd).Interface(), gotMsg.ProtoReflect().Get(fd).Interface(),
+			"field %s not updated", path)
+	}
+}
+
+// deletedState returns the DELETED value of the state enum of the field.
+func deletedState(fd protoreflect.FieldDescriptor) (protoreflect.EnumNumber, bool) {
+	if fd == nil || fd.Enum() == nil {
+		return 0, false
+	}
+	values := fd.Enum().Values()
+	for i := 0; i < values.Len(); i++ {
+		if strings.HasSuffix(string(values.Get(i).Name()), "_STATE_DELETED") {
+			return values.Get(i).Number(), true
+		}
+	}
+	return 0, false
+}
+
+func assertDeletedState(t *testing.T, res *inv_v1.Resource, field protoreflect.Name) {
+	t.Helper()
+	msg, err := util.GetSetResource(res)
+	require.NoError(t, err)
+	fd := msg.ProtoReflect().Descriptor().Fields().ByName(field)
+	deleted, ok := deletedState(fd)
+	require.Truef(t, ok, "%s has no %s state", msg.ProtoReflect().Descriptor().FullName(), field)
+	assert.Equal(t, deleted, msg.ProtoReflect().Get(fd).Enum())
+}
+
+// deletedCurrentState returns a resource of the kind with its current state set to DELETED.
+func deletedCurrentState(kind inv_v1.ResourceKind) (*inv_v1.Resource, error) {
+	res, err := util.GetResourceFromKind(kind)
+	if err != nil {
+		return nil, err
+	}
+	msg, err := util.GetSetResource(res)
+	if err != nil {
+		return nil, err
+	}
+	fd := msg.ProtoReflect().Descriptor().Fields().ByName("current_state")
+	deleted, ok := deletedState(fd)
+	if !ok {
+		return nil, fmt.Errorf("%s has no current_state", kind)
+	}
+	msg.ProtoReflect().Set(fd, protoreflect.ValueOfEnum(deleted))
+	return res, nil
+}
+
+// purgeResource removes a resource left over by a failed round-trip, so that its dependencies can be cleaned up.
+func purgeResource(
+	tenantID, id string, info *util.ResourceKindInfo, apiClient, reconciler client.TenantAwareInventoryClient,
+) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if _, err := apiClient.Get(ctx, tenantID, id); status.Code(err) == codes.NotFound {
+		return
+	}
+	//nolint:errcheck // best effort, the resource may already be marked for deletion
+	apiClient.Delete(ctx, tenantID, id)
+	if res, err := deletedCurrentState(info.Kind); err == nil && info.TwoPhaseDelete {
+		//nolint:errcheck // best effort
+		reconciler.Update(ctx, tenantID, id, &fieldmaskpb.FieldMask{Paths: []string{"current_state"}}, res)
+	}
+}
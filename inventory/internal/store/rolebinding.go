@@ -0,0 +1,404 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package store
+
+import (
+	"context"
+	"slices"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent"
+	rolebindings "github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/rolebindingresource"
+	inv_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/inventory/v1"
+	rolebindingv1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/rolebinding/v1"
+	cl "github.com/open-edge-platform/infra-core/inventory/v2/pkg/client"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/logging"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/util"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/util/collections"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/validator"
+)
+
+func init() {
+	RegisterResourceHandler(ResourceHandler{
+		Kind:      inv_v1.ResourceKind_RESOURCE_KIND_ROLEBINDING,
+		Create:    createAs((*InvStore).CreateRoleBinding),
+		Get:       getByID((*InvStore).GetRoleBinding),
+		Update:    updateAs((*InvStore).UpdateRoleBinding),
+		Delete:    deleteByID((*InvStore).DeleteRoleBinding),
+		DeleteAll: (*InvStore).DeleteRoleBindings,
+		List:      (*InvStore).ListRoleBindings,
+		Filter:    (*InvStore).FilterRoleBindings,
+	})
+}
+
+var roleBindingResourceCreationValidators = []resourceValidator[*rolebindingv1.RoleBindingResource]{
+	protoValidator[*rolebindingv1.RoleBindingResource],
+	doNotAcceptResourceID[*rolebindingv1.RoleBindingResource],
+	validateRoleBindingRole,
+}
+
+func validateRoleBindingRole(in *rolebindingv1.RoleBindingResource) error {
+	if in.GetRole() == rolebindingv1.ScopedRole_SCOPED_ROLE_UNSPECIFIED {
+		zlog.InfraSec().InfraError("role is required").Msg("")
+		return errors.Errorfc(codes.InvalidArgument, "role is required")
+	}
+	return nil
+}
+
+func (is *InvStore) CreateRoleBinding(
+	ctx context.Context, in *rolebindingv1.RoleBindingResource,
+) (*inv_v1.Resource, error) {
+	if err := validate(in, roleBindingResourceCreationValidators...); err != nil {
+		return nil, err
+	}
+
+	res, err := ExecuteInTxAndReturnSingle[inv_v1.Resource](is)(ctx, roleBindingResourceCreator(in))
+	if err != nil {
+		return nil, err
+	}
+
+	zlog.Debug().Msgf("RoleBinding Created: %s, %s", res.GetRoleBinding().GetResourceId(), logging.Redact(res))
+	return res, nil
+}
+
+func roleBindingResourceCreator(in *rolebindingv1.RoleBindingResource) func(context.Context, *ent.Tx) (
+	*inv_v1.Resource, error) {
+	return func(ctx context.Context, tx *ent.Tx) (*inv_v1.Resource, error) {
+		id := util.NewInvID(inv_v1.ResourceKind_RESOURCE_KIND_ROLEBINDING)
+		zlog.Debug().Msgf("CreateRoleBinding: %s", id)
+
+		newEntity := tx.RoleBindingResource.Create()
+		mut := newEntity.Mutation()
+
+		if err := buildEntMutate(in, mut, EmptyEnumStateMap, nil); err != nil {
+			return nil, err
+		}
+
+		if err := setRelationsForRoleBindingMutIfNeeded(ctx, tx, mut, in, nil); err != nil {
+			return nil, err
+		}
+
+		if err := mut.SetField(rolebindings.FieldResourceID, id); err != nil {
+			return nil, errors.Wrap(err)
+		}
+
+		_, err := newEntity.Save(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err)
+		}
+
+		res, err := getRoleBindingQuery(ctx, tx, id)
+		if err != nil {
+			return nil, err
+		}
+		if err := validateRoleBindingBeforeCommit(res); err != nil {
+			return nil, err
+		}
+		return util.WrapResource(entRoleBindingResourceToProtoRoleBindingResource(res))
+	}
+}
+
+// setRelationsForRoleBindingMutIfNeeded sets the scope edges of the mutation. When paths is nil (creation) all the
+// given edges are set, otherwise only the ones in the fieldmask.
+func setRelationsForRoleBindingMutIfNeeded(
+	ctx context.Context,
+	tx *ent.Tx,
+	mut *ent.RoleBindingResourceMutation,
+	in *rolebindingv1.RoleBindingResource,
+	paths []string,
+) error {
+	needed := func(edge string) bool {
+		return paths == nil || slices.Contains(paths, edge)
+	}
+	client := tx.Client()
+
+	if needed(rolebindings.EdgeRegion) {
+		mut.ResetRegion()
+		if err := setEdgeRegionIDForMut(ctx, client, mut, in.GetRegion()); err != nil {
+			return err
+		}
+	}
+	if needed(rolebindings.EdgeSite) {
+		mut.ResetSite()
+		if err := setEdgeSiteIDForMut(ctx, client, mut, in.GetSite()); err != nil {
+			return err
+		}
+	}
+	if needed(rolebindings.EdgeOu) {
+		mut.ResetOu()
+		if in.GetOu() != nil {
+			ouID, err := getOuIDFromResourceID(ctx, tx, in.GetOu())
+			if err != nil {
+				return err
+			}
+			mut.SetOuID(ouID)
+		}
+	}
+	return nil
+}
+
+// validateRoleBindingBeforeCommit checks that the binding, loaded with its edges, has a subject, a role and exactly one
+// scope. The subject is not required by the proto validation, which also applies to the resources of the filters.
+func validateRoleBindingBeforeCommit(roleBinding *ent.RoleBindingResource) error {
+	if roleBinding.Subject == "" {
+		zlog.InfraSec().InfraError("subject is required").Msg("")
+		return errors.Errorfc(codes.InvalidArgument, "subject is required")
+	}
+	if roleBinding.Role == "" || roleBinding.Role == rolebindings.RoleSCOPED_ROLE_UNSPECIFIED {
+		zlog.InfraSec().InfraError("role is required").Msg("")
+		return errors.Errorfc(codes.InvalidArgument, "role is required")
+	}
+	scopes := 0
+	for _, set := range []bool{
+		roleBinding.Edges.Region != nil, roleBinding.Edges.Site != nil, roleBinding.Edges.Ou != nil,
+	} {
+		if set {
+			scopes++
+		}
+	}
+	if scopes != 1 {
+		zlog.InfraSec().InfraError("exactly one of region, site or ou must be set, got %d", scopes).Msg("")
+		return errors.Errorfc(codes.InvalidArgument, "exactly one of region, site or ou must be set, got %d", scopes)
+	}
+	return nil
+}
+
+func getRoleBindingQuery(ctx context.Context, tx *ent.Tx, resourceID string) (*ent.RoleBindingResource, error) {
+	entity, err := tx.RoleBindingResource.Query().
+		Where(rolebindings.ResourceID(resourceID)).
+		WithRegion().
+		WithSite().
+		WithOu().
+		Only(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	return entity, nil
+}
+
+func (is *InvStore) GetRoleBinding(ctx context.Context, id string) (*inv_v1.Resource, error) {
+	res, err := ExecuteInRoTxAndReturnSingle[ent.RoleBindingResource](is)(
+		ctx,
+		func(ctx context.Context, tx *ent.Tx) (*ent.RoleBindingResource, error) {
+			return getRoleBindingQuery(ctx, tx, id)
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	apiResource := entRoleBindingResourceToProtoRoleBindingResource(res)
+	if err = validator.ValidateMessage(apiResource); err != nil {
+		zlog.InfraSec().InfraErr(err).Msg("")
+		return nil, errors.Wrap(err)
+	}
+
+	return &inv_v1.Resource{Resource: &inv_v1.Resource_RoleBinding{RoleBinding: apiResource}}, nil
+}
+
+func (is *InvStore) UpdateRoleBinding(
+	ctx context.Context, id string, in *rolebindingv1.RoleBindingResource, fieldmask *fieldmaskpb.FieldMask,
+) (*inv_v1.Resource, error) {
+	zlog.Debug().Msgf("UpdateRoleBinding (%s): %v, fm: %v", id, logging.Redact(in), fieldmask)
+
+	res, err := ExecuteInTxAndReturnSingle[inv_v1.Resource](is)(ctx,
+		func(ctx context.Context, tx *ent.Tx) (*inv_v1.Resource, error) {
+			entity, err := tx.RoleBindingResource.Query().
+				Select(rolebindings.FieldID).
+				Where(rolebindings.ResourceID(id)).
+				Only(ctx)
+			if err != nil {
+				return nil, errors.Wrap(err)
+			}
+
+			updateBuilder := tx.RoleBindingResource.UpdateOneID(entity.ID)
+			mut := updateBuilder.Mutation()
+
+			if err := setRelationsForRoleBindingMutIfNeeded(ctx, tx, mut, in, fieldmask.GetPaths()); err != nil {
+				return nil, err
+			}
+
+			if err := buildEntMutate(in, mut, EmptyEnumStateMap, fieldmask.GetPaths()); err != nil {
+				return nil, err
+			}
+
+			if _, err := updateBuilder.Save(ctx); err != nil {
+				return nil, errors.Wrap(err)
+			}
+
+			res, err := getRoleBindingQuery(ctx, tx, id)
+			if err != nil {
+				return nil, err
+			}
+			if err := validateRoleBindingBeforeCommit(res); err != nil {
+				return nil, err
+			}
+			return util.WrapResource(entRoleBindingResourceToProtoRoleBindingResource(res))
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (is *InvStore) DeleteRoleBinding(ctx context.Context, id string) (*inv_v1.Resource, error) {
+	// this is a "Hard Delete" as role bindings don't have state to reconcile
+	zlog.Debug().Msgf("DeleteRoleBinding Hard Delete: %s", id)
+
+	res, err := ExecuteInTxAndReturnSingle[inv_v1.Resource](is)(ctx, deleteRoleBinding(id))
+
+	return res, err
+}
+
+func deleteRoleBinding(id string) func(ctx context.Context, tx *ent.Tx) (*inv_v1.Resource, error) {
+	return func(ctx context.Context, tx *ent.Tx) (*inv_v1.Resource, error) {
+		res, err := getRoleBindingQuery(ctx, tx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := tx.RoleBindingResource.DeleteOneID(res.ID).Exec(ctx); err != nil {
+			return nil, errors.Wrap(err)
+		}
+
+		return util.WrapResource(entRoleBindingResourceToProtoRoleBindingResource(res))
+	}
+}
+
+func (is *InvStore) DeleteRoleBindings(
+	ctx context.Context, tenantID string, _ bool,
+) ([]*util.Tuple[DeletionKind, *inv_v1.Resource], error) {
+	var deleted []*util.Tuple[DeletionKind, *inv_v1.Resource]
+	txErr := ExecuteInTx(is)(
+		ctx,
+		func(ctx context.Context, tx *ent.Tx) error {
+			collection, err := tx.RoleBindingResource.Query().
+				Where(rolebindings.TenantID(tenantID)).
+				All(ctx)
+			if err != nil {
+				return err
+			}
+			if _, err := tx.RoleBindingResource.Delete().Where(rolebindings.TenantID(tenantID)).Exec(ctx); err != nil {
+				return err
+			}
+			for _, element := range collection {
+				res, err := util.WrapResource(entRoleBindingResourceToProtoRoleBindingResource(element))
+				if err != nil {
+					return err
+				}
+				deleted = append(deleted, util.NewTuple(HARD, res))
+			}
+			return nil
+		})
+	return deleted, txErr
+}
+
+func (is *InvStore) ListRoleBindings(ctx context.Context, filter *inv_v1.ResourceFilter) (
+	[]*inv_v1.GetResourceResponse, int, error,
+) {
+	resources, total, err := ExecuteInRoTxAndReturnDouble[[]*ent.RoleBindingResource, int](is)(
+		ctx,
+		func(ctx context.Context, tx *ent.Tx) (*[]*ent.RoleBindingResource, *int, error) {
+			filtered, total, err := filterRoleBindings(ctx, tx.Client(), filter)
+			if err != nil {
+				return nil, nil, err
+			}
+			return &filtered, &total, err
+		},
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	resps := collections.MapSlice[*ent.RoleBindingResource, *inv_v1.GetResourceResponse](*resources,
+		func(res *ent.RoleBindingResource) *inv_v1.GetResourceResponse {
+			return &inv_v1.GetResourceResponse{
+				Resource: &inv_v1.Resource{
+					Resource: &inv_v1.Resource_RoleBinding{
+						RoleBinding: entRoleBindingResourceToProtoRoleBindingResource(res),
+					},
+				},
+			}
+		})
+	if err := collections.FirstError[*inv_v1.GetResourceResponse](resps, validateProto[*inv_v1.GetResourceResponse]); err != nil {
+		zlog.InfraSec().InfraErr(err).Msg("")
+		return nil, 0, errors.Wrap(err)
+	}
+
+	return resps, *total, nil
+}
+
+func filterRoleBindings(ctx context.Context, client *ent.Client, filter *inv_v1.ResourceFilter) (
+	[]*ent.RoleBindingResource, int, error,
+) {
+	pred, err := getPredicate(inv_v1.ResourceKind_RESOURCE_KIND_ROLEBINDING, filter.GetFilter())
+	if err != nil {
+		return nil, 0, err
+	}
+
+	orderOpts, err := GetOrderByOptions[rolebindings.OrderOption](filter.GetOrderBy(), rolebindings.ValidColumn)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	offset, limit, err := getOffsetAndLimit(filter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// perform query - And together all the predicates
+	query := client.RoleBindingResource.Query().
+		WithRegion().
+		WithSite().
+		WithOu().
+		Where(pred).
+		Order(orderOpts...).
+		Offset(offset)
+
+	// Limits number of query results if existent
+	if limit != 0 {
+		query = query.Limit(limit)
+	}
+
+	roleBindings, err := query.All(ctx)
+	if err != nil {
+		return nil, 0, errors.Wrap(err)
+	}
+
+	// Count total number of item without applying pagination limits, order, or loading edges.
+	total, err := client.RoleBindingResource.Query().
+		Where(pred).
+		Count(ctx)
+	if err != nil {
+		return nil, 0, errors.Wrap(err)
+	}
+
+	return roleBindings, total, nil
+}
+
+func (is *InvStore) FilterRoleBindings(ctx context.Context, filter *inv_v1.ResourceFilter) (
+	[]*cl.ResourceTenantIDCarrier, int, error,
+) {
+	resources, total, err := ExecuteInRoTxAndReturnDouble[[]*ent.RoleBindingResource, int](is)(
+		ctx, func(ctx context.Context, tx *ent.Tx) (*[]*ent.RoleBindingResource, *int, error) {
+			filtered, total, err := filterRoleBindings(ctx, tx.Client(), filter)
+			if err != nil {
+				return nil, nil, err
+			}
+			return &filtered, &total, nil
+		})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	ids := collections.MapSlice[*ent.RoleBindingResource, *cl.ResourceTenantIDCarrier](
+		*resources, func(c *ent.RoleBindingResource) *cl.ResourceTenantIDCarrier {
+			return &cl.ResourceTenantIDCarrier{TenantId: c.TenantID, ResourceId: c.ResourceID}
+		})
+
+	return ids, *total, err
+}
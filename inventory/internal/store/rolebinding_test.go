@@ -0,0 +1,228 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package store_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/store"
+	inv_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/inventory/v1"
+	location_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/location/v1"
+	ou_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/ou/v1"
+	rolebindingv1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/rolebinding/v1"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/client"
+	inv_testing "github.com/open-edge-platform/infra-core/inventory/v2/pkg/testing"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/util"
+)
+
+//nolint:funlen // length due to test cases
+func Test_Create_Get_Delete_RoleBinding(t *testing.T) {
+	region := inv_testing.CreateRegion(t, nil)
+	site := inv_testing.CreateSite(t, region, nil)
+
+	testcases := map[string]struct {
+		in    *rolebindingv1.RoleBindingResource
+		valid bool
+	}{
+		"CreateGoodRoleBindingOnRegion": {
+			in: &rolebindingv1.RoleBindingResource{
+				Subject: "alice",
+				Role:    rolebindingv1.ScopedRole_SCOPED_ROLE_READ,
+				Region:  region,
+			},
+			valid: true,
+		},
+		"CreateGoodRoleBindingOnSite": {
+			in: &rolebindingv1.RoleBindingResource{
+				Subject: "alice",
+				Role:    rolebindingv1.ScopedRole_SCOPED_ROLE_READ_WRITE,
+				Site:    site,
+			},
+			valid: true,
+		},
+		"CreateBadRoleBindingWithResourceIdSet": {
+			in: &rolebindingv1.RoleBindingResource{
+				ResourceId: "rolebinding-12345678",
+				Subject:    "alice",
+				Role:       rolebindingv1.ScopedRole_SCOPED_ROLE_READ,
+				Site:       site,
+			},
+			valid: false,
+		},
+		"CreateBadRoleBindingWithNoSubject": {
+			in: &rolebindingv1.RoleBindingResource{
+				Role: rolebindingv1.ScopedRole_SCOPED_ROLE_READ,
+				Site: site,
+			},
+			valid: false,
+		},
+		"CreateBadRoleBindingWithNoRole": {
+			in: &rolebindingv1.RoleBindingResource{
+				Subject: "alice",
+				Site:    site,
+			},
+			valid: false,
+		},
+		"CreateBadRoleBindingWithNoScope": {
+			in: &rolebindingv1.RoleBindingResource{
+				Subject: "alice",
+				Role:    rolebindingv1.ScopedRole_SCOPED_ROLE_READ,
+			},
+			valid: false,
+		},
+		"CreateBadRoleBindingWithTwoScopes": {
+			in: &rolebindingv1.RoleBindingResource{
+				Subject: "alice",
+				Role:    rolebindingv1.ScopedRole_SCOPED_ROLE_READ,
+				Region:  region,
+				Site:    site,
+			},
+			valid: false,
+		},
+	}
+
+	for tcname, tc := range testcases {
+		t.Run(tcname, func(t *testing.T) {
+			createresreq := &inv_v1.Resource{
+				Resource: &inv_v1.Resource_RoleBinding{RoleBinding: tc.in},
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+
+			// create
+			createResp, err := inv_testing.TestClients[inv_testing.APIClient].Create(ctx, createresreq)
+			roleBindingResID := createResp.GetRoleBinding().GetResourceId()
+
+			if err != nil {
+				if tc.valid {
+					t.Errorf("CreateRoleBinding() failed: %s", err)
+				}
+			} else if !tc.valid {
+				t.Errorf("CreateRoleBinding() succeeded but should have failed")
+			}
+
+			// only get/delete if valid test and hasn't failed otherwise may segfault
+			if !t.Failed() && tc.valid {
+				getresp, err := inv_testing.TestClients[inv_testing.APIClient].Get(ctx, roleBindingResID)
+				require.NoError(t, err, "GetRoleBinding() failed")
+				roleBinding := getresp.GetResource().GetRoleBinding()
+				assert.Equal(t, tc.in.GetSubject(), roleBinding.GetSubject())
+				assert.Equal(t, tc.in.GetRole(), roleBinding.GetRole())
+				assert.Equal(t, tc.in.GetRegion().GetResourceId(), roleBinding.GetRegion().GetResourceId())
+				assert.Equal(t, tc.in.GetSite().GetResourceId(), roleBinding.GetSite().GetResourceId())
+
+				listresp, err := inv_testing.TestClients[inv_testing.APIClient].List(ctx, &inv_v1.ResourceFilter{
+					Resource: &inv_v1.Resource{Resource: &inv_v1.Resource_RoleBinding{}},
+					Filter:   fmt.Sprintf("%s = %q", rolebindingv1.RoleBindingResourceFieldSubject, tc.in.GetSubject()),
+				})
+				require.NoError(t, err, "ListRoleBindings() failed")
+				assert.Len(t, listresp.GetResources(), 1)
+
+				_, err = inv_testing.TestClients[inv_testing.APIClient].Delete(ctx, roleBindingResID)
+				require.NoError(t, err, "DeleteRoleBinding() failed")
+			}
+		})
+	}
+}
+
+//nolint:funlen // length due to the hierarchy setup
+func Test_GetScope(t *testing.T) {
+	// region1 > region2 > site1, region1 > site2, ou1 > ou2 > site3, site4 unbound.
+	region1 := inv_testing.CreateRegion(t, nil)
+	region2 := inv_testing.CreateRegion(t, region1)
+	site1 := inv_testing.CreateSite(t, region2, nil)
+	site2 := inv_testing.CreateSite(t, region1, nil)
+	ou1 := inv_testing.CreateOu(t, nil)
+	ou2 := inv_testing.CreateOu(t, ou1)
+	site3 := inv_testing.CreateSite(t, nil, ou2)
+	site4 := inv_testing.CreateSite(t, nil, nil)
+	host1 := inv_testing.CreateHost(t, site1, nil)
+	inv_testing.CreateHost(t, site4, nil)
+
+	inv_testing.CreateRoleBinding(t, "alice", rolebindingv1.ScopedRole_SCOPED_ROLE_READ,
+		inv_testing.RoleBindingRegion(region1))
+	inv_testing.CreateRoleBinding(t, "alice", rolebindingv1.ScopedRole_SCOPED_ROLE_READ_WRITE,
+		inv_testing.RoleBindingSite(site1))
+	inv_testing.CreateRoleBinding(t, "alice", rolebindingv1.ScopedRole_SCOPED_ROLE_READ,
+		inv_testing.RoleBindingOu(ou2))
+	inv_testing.CreateRoleBinding(t, "bob", rolebindingv1.ScopedRole_SCOPED_ROLE_READ_WRITE,
+		inv_testing.RoleBindingSite(site4))
+
+	dbURL := util.GetDBURL(util.LookupDBTestEnv())
+	invstore := store.NewStore(dbURL, dbURL)
+	defer func() {
+		err := invstore.CloseEntClient()
+		assert.NoError(t, err)
+	}()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	scope, err := invstore.GetScope(ctx, "alice", []string{client.FakeTenantID})
+	require.NoError(t, err)
+
+	testcases := map[string]struct {
+		resourceID string
+		read       bool
+		write      bool
+	}{
+		"BoundRegion":     {resourceID: region1.GetResourceId(), read: true},
+		"ChildRegion":     {resourceID: region2.GetResourceId(), read: true},
+		"BoundSite":       {resourceID: site1.GetResourceId(), read: true, write: true},
+		"SiteUnderRegion": {resourceID: site2.GetResourceId(), read: true},
+		"ParentOu":        {resourceID: ou1.GetResourceId()},
+		"BoundOu":         {resourceID: ou2.GetResourceId(), read: true},
+		"SiteUnderOu":     {resourceID: site3.GetResourceId(), read: true},
+		"OtherSubject":    {resourceID: site4.GetResourceId()},
+	}
+	for tcname, tc := range testcases {
+		t.Run(tcname, func(t *testing.T) {
+			assert.Equal(t, tc.read, scope.CanRead(tc.resourceID))
+			assert.Equal(t, tc.write, scope.CanWrite(tc.resourceID))
+		})
+	}
+
+	t.Run("OtherTenant", func(t *testing.T) {
+		otherScope, err := invstore.GetScope(ctx, "alice", []string{tenantIDOne})
+		require.NoError(t, err)
+		assert.False(t, otherScope.CanRead(site1.GetResourceId()))
+	})
+
+	t.Run("ListHosts", func(t *testing.T) {
+		resources, total, err := invstore.ListResources(store.WithScope(ctx, scope), &inv_v1.ResourceFilter{
+			Resource: &inv_v1.Resource{Resource: &inv_v1.Resource_Host{}},
+		})
+		require.NoError(t, err)
+		require.Equal(t, 1, total)
+		assert.Equal(t, host1.GetResourceId(), resources[0].GetResource().GetHost().GetResourceId())
+	})
+
+	t.Run("ListSites", func(t *testing.T) {
+		resources, _, err := invstore.ListResources(store.WithScope(ctx, scope), &inv_v1.ResourceFilter{
+			Resource: &inv_v1.Resource{Resource: &inv_v1.Resource_Site{Site: &location_v1.SiteResource{}}},
+		})
+		require.NoError(t, err)
+		siteIDs := make([]string, 0, len(resources))
+		for _, res := range resources {
+			siteIDs = append(siteIDs, res.GetResource().GetSite().GetResourceId())
+		}
+		assert.ElementsMatch(t,
+			[]string{site1.GetResourceId(), site2.GetResourceId(), site3.GetResourceId()}, siteIDs)
+	})
+
+	t.Run("ListOus", func(t *testing.T) {
+		resources, _, err := invstore.ListResources(store.WithScope(ctx, scope), &inv_v1.ResourceFilter{
+			Resource: &inv_v1.Resource{Resource: &inv_v1.Resource_Ou{Ou: &ou_v1.OuResource{}}},
+		})
+		require.NoError(t, err)
+		require.Len(t, resources, 1)
+		assert.Equal(t, ou2.GetResourceId(), resources[0].GetResource().GetOu().GetResourceId())
+	})
+}
@@ -0,0 +1,181 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package store
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent"
+	hosts "github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/hostresource"
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/instanceresource"
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/ouresource"
+	regions "github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/regionresource"
+	sites "github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/siteresource"
+	inv_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/inventory/v1"
+	rolebindingv1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/rolebinding/v1"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/util"
+)
+
+// Scope is the set of locations a principal holding the scoped role has access to, resolved from its role bindings:
+// the bound regions, OUs and sites and everything below them.
+type Scope struct {
+	// locations maps the kind and resource ID of the locations in scope to the role granted on them.
+	locations map[inv_v1.ResourceKind]map[string]rolebindingv1.ScopedRole
+}
+
+// NewScope returns an empty scope, granting access to no location.
+func NewScope() *Scope {
+	return &Scope{locations: map[inv_v1.ResourceKind]map[string]rolebindingv1.ScopedRole{}}
+}
+
+// Grant adds the location to the scope with the given role, keeping the highest role if already in scope.
+func (s *Scope) Grant(resourceID string, role rolebindingv1.ScopedRole) {
+	kind, err := util.GetResourceKindFromResourceID(resourceID)
+	if err != nil {
+		return
+	}
+	if s.locations[kind] == nil {
+		s.locations[kind] = map[string]rolebindingv1.ScopedRole{}
+	}
+	if role > s.locations[kind][resourceID] {
+		s.locations[kind][resourceID] = role
+	}
+}
+
+// CanRead reports whether the location, given by its resource ID, is in scope.
+func (s *Scope) CanRead(resourceID string) bool {
+	return s.role(resourceID) >= rolebindingv1.ScopedRole_SCOPED_ROLE_READ
+}
+
+// CanWrite reports whether the location, given by its resource ID, is in scope with the read-write role.
+func (s *Scope) CanWrite(resourceID string) bool {
+	return s.role(resourceID) >= rolebindingv1.ScopedRole_SCOPED_ROLE_READ_WRITE
+}
+
+func (s *Scope) role(resourceID string) rolebindingv1.ScopedRole {
+	kind, err := util.GetResourceKindFromResourceID(resourceID)
+	if err != nil {
+		return rolebindingv1.ScopedRole_SCOPED_ROLE_UNSPECIFIED
+	}
+	return s.locations[kind][resourceID]
+}
+
+// resourceIDs returns the resource IDs of the locations of the given kind in scope.
+func (s *Scope) resourceIDs(kind inv_v1.ResourceKind) []string {
+	ids := make([]string, 0, len(s.locations[kind]))
+	for id := range s.locations[kind] {
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+type scopeContextKey struct{}
+
+// WithScope returns a context restricting the store to the locations of the scope: lists and finds of hosts,
+// instances, sites, regions and OUs only return the resources in scope.
+func WithScope(ctx context.Context, scope *Scope) context.Context {
+	return context.WithValue(ctx, scopeContextKey{}, scope)
+}
+
+// ScopeFromContext returns the scope set by WithScope, if any.
+func ScopeFromContext(ctx context.Context) (*Scope, bool) {
+	scope, ok := ctx.Value(scopeContextKey{}).(*Scope)
+	return scope, ok
+}
+
+// scopePredicate returns the predicate restricting the resources of the given kind to the scope of the context, a
+// no-op when the context is not scoped.
+func scopePredicate(ctx context.Context, kind inv_v1.ResourceKind) func(*sql.Selector) {
+	scope, ok := ScopeFromContext(ctx)
+	if !ok {
+		return noopSelector
+	}
+	siteIDs := scope.resourceIDs(inv_v1.ResourceKind_RESOURCE_KIND_SITE)
+	switch kind {
+	case inv_v1.ResourceKind_RESOURCE_KIND_HOST:
+		return hosts.HasSiteWith(sites.ResourceIDIn(siteIDs...))
+	case inv_v1.ResourceKind_RESOURCE_KIND_INSTANCE:
+		return instanceresource.HasHostWith(hosts.HasSiteWith(sites.ResourceIDIn(siteIDs...)))
+	case inv_v1.ResourceKind_RESOURCE_KIND_SITE:
+		return sites.ResourceIDIn(siteIDs...)
+	case inv_v1.ResourceKind_RESOURCE_KIND_REGION:
+		return regions.ResourceIDIn(scope.resourceIDs(inv_v1.ResourceKind_RESOURCE_KIND_REGION)...)
+	case inv_v1.ResourceKind_RESOURCE_KIND_OU:
+		return ouresource.ResourceIDIn(scope.resourceIDs(inv_v1.ResourceKind_RESOURCE_KIND_OU)...)
+	default:
+		// The other kinds are not located, access to them is decided by the caller.
+		return noopSelector
+	}
+}
+
+// GetScope resolves the scope granted to the subject by its role bindings in the given tenants. Bindings whose
+// location was deleted grant nothing.
+func (is *InvStore) GetScope(ctx context.Context, subject string, tenantIDs []string) (*Scope, error) {
+	scope := NewScope()
+	if len(tenantIDs) == 0 {
+		return scope, nil
+	}
+	// The first arg is always the subject.
+	args := []interface{}{subject}
+	tenantArgs, placeholders := getSQLPlaceholdersAndArgs(tenantIDs, 1)
+	args = append(args, tenantArgs...)
+	// The query walks the region and OU hierarchies down from the bound regions and OUs, then collects the sites
+	// directly bound or below any of the regions and OUs in scope. Each location is returned with every role granted
+	// on it, the highest one is kept by Grant.
+	query := `
+	WITH RECURSIVE bindings AS (
+		SELECT role, role_binding_resource_region AS region_id, role_binding_resource_site AS site_id,
+			role_binding_resource_ou AS ou_id
+		FROM role_binding_resources
+		WHERE subject=$1 AND tenant_id IN (` + placeholders + `) AND role IS NOT NULL
+	), scoped_regions AS (
+		SELECT region_id AS id, role FROM bindings WHERE region_id IS NOT NULL
+		UNION
+		SELECT r.ID AS id, sr.role AS role
+		FROM region_resources AS r JOIN scoped_regions AS sr ON r.region_resource_parent_region=sr.id
+	), scoped_ous AS (
+		SELECT ou_id AS id, role FROM bindings WHERE ou_id IS NOT NULL
+		UNION
+		SELECT o.ID AS id, so.role AS role
+		FROM ou_resources AS o JOIN scoped_ous AS so ON o.ou_resource_parent_ou=so.id
+	), scoped_sites AS (
+		SELECT site_id AS id, role FROM bindings WHERE site_id IS NOT NULL
+		UNION
+		SELECT s.ID AS id, sr.role AS role
+		FROM site_resources AS s JOIN scoped_regions AS sr ON s.site_resource_region=sr.id
+		UNION
+		SELECT s.ID AS id, so.role AS role
+		FROM site_resources AS s JOIN scoped_ous AS so ON s.site_resource_ou=so.id
+	)
+	SELECT r.resource_id, sr.role FROM scoped_regions AS sr JOIN region_resources AS r ON r.ID=sr.id
+	UNION ALL
+	SELECT o.resource_id, so.role FROM scoped_ous AS so JOIN ou_resources AS o ON o.ID=so.id
+	UNION ALL
+	SELECT s.resource_id, ss.role FROM scoped_sites AS ss JOIN site_resources AS s ON s.ID=ss.id`
+
+	err := ExecuteInRoTx(is)(ctx, func(ctx context.Context, tx *ent.Tx) error {
+		rows, err := tx.Client().QueryContext(ctx, query, args...)
+		if err != nil {
+			return logAndSanitizeErrorRawSQLf(err, "failed to resolve the scope of the role bindings")
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var resourceID, role string
+			if err := rows.Scan(&resourceID, &role); err != nil {
+				return logAndSanitizeErrorRawSQLf(err, "failed to parse the scope of the role bindings")
+			}
+			scope.Grant(resourceID, rolebindingv1.ScopedRole(rolebindingv1.ScopedRole_value[role]))
+		}
+		if err := rows.Err(); err != nil {
+			return logAndSanitizeErrorRawSQLf(err, "failed to parse the scope of the role bindings")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return scope, nil
+}
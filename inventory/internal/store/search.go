@@ -0,0 +1,158 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package store
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent"
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/hostnicresource"
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/hostresource"
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/instanceresource"
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/ipaddressresource"
+	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/siteresource"
+	inv_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/inventory/v1"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/util"
+)
+
+// defaultSearchLimit is the number of hits returned by SearchResources when the request does not set a limit.
+const defaultSearchLimit = 20
+
+// searchableKinds lists the resource kinds covered by SearchResources, in the order their fields are searched.
+var searchableKinds = []inv_v1.ResourceKind{
+	inv_v1.ResourceKind_RESOURCE_KIND_HOST,
+	inv_v1.ResourceKind_RESOURCE_KIND_HOSTNIC,
+	inv_v1.ResourceKind_RESOURCE_KIND_IPADDRESS,
+	inv_v1.ResourceKind_RESOURCE_KIND_SITE,
+	inv_v1.ResourceKind_RESOURCE_KIND_INSTANCE,
+}
+
+// searchableFields lists, per searchable resource kind, its table and the fields matched against the query. Every
+// field must be backed by a trigram index, see the schema extensions of the resources.
+var searchableFields = map[inv_v1.ResourceKind]struct {
+	table  string
+	fields []string
+}{
+	inv_v1.ResourceKind_RESOURCE_KIND_HOST: {
+		table: hostresource.Table,
+		fields: []string{
+			hostresource.FieldName,
+			hostresource.FieldHostname,
+			hostresource.FieldSerialNumber,
+			hostresource.FieldUUID,
+			hostresource.FieldPxeMAC,
+			hostresource.FieldBmcIP,
+			hostresource.FieldMgmtIP,
+		},
+	},
+	inv_v1.ResourceKind_RESOURCE_KIND_HOSTNIC: {
+		table:  hostnicresource.Table,
+		fields: []string{hostnicresource.FieldDeviceName, hostnicresource.FieldMACAddr},
+	},
+	inv_v1.ResourceKind_RESOURCE_KIND_IPADDRESS: {
+		table:  ipaddressresource.Table,
+		fields: []string{ipaddressresource.FieldAddress},
+	},
+	inv_v1.ResourceKind_RESOURCE_KIND_SITE: {
+		table:  siteresource.Table,
+		fields: []string{siteresource.FieldName, siteresource.FieldAddress},
+	},
+	inv_v1.ResourceKind_RESOURCE_KIND_INSTANCE: {
+		table:  instanceresource.Table,
+		fields: []string{instanceresource.FieldName},
+	},
+}
+
+// likeEscaper escapes the wildcards of the LIKE patterns.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+func (is *InvStore) SearchResources(
+	ctx context.Context, in *inv_v1.SearchResourcesRequest,
+) (*inv_v1.SearchResourcesResponse, error) {
+	return ExecuteInRoTxAndReturnSingle[inv_v1.SearchResourcesResponse](is)(
+		ctx,
+		func(ctx context.Context, tx *ent.Tx) (*inv_v1.SearchResourcesResponse, error) {
+			return searchResources(ctx, tx.Client(), in)
+		},
+	)
+}
+
+func searchResources(
+	ctx context.Context, client *ent.Client, in *inv_v1.SearchResourcesRequest,
+) (*inv_v1.SearchResourcesResponse, error) {
+	kinds := in.GetKinds()
+	if len(kinds) == 0 {
+		kinds = searchableKinds
+	}
+	limit := int(in.GetLimit())
+	if limit == 0 {
+		limit = defaultSearchLimit
+	}
+
+	query, args := buildSearchResourcesQuery(in.GetTenantId(), in.GetQuery(), kinds, limit)
+	rows, err := client.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, logAndSanitizeErrorRawSQLf(err, "error searching resources")
+	}
+	defer rows.Close()
+
+	resp := &inv_v1.SearchResourcesResponse{}
+	for rows.Next() {
+		var resourceID, field, value string
+		var score float64
+		if err := rows.Scan(&resourceID, &field, &value, &score); err != nil {
+			return nil, logAndSanitizeErrorRawSQLf(err, "error parsing results while searching resources")
+		}
+		kind, err := util.GetResourceKindFromResourceID(resourceID)
+		if err != nil {
+			zlog.InfraSec().Err(err).Msgf("this error should never happen")
+			return nil, err
+		}
+		resp.Hits = append(resp.Hits, &inv_v1.SearchResourcesResponse_Hit{
+			ResourceKind: kind,
+			ResourceId:   resourceID,
+			Field:        field,
+			Value:        value,
+			Score:        float32(score),
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, logAndSanitizeErrorRawSQLf(err, "error searching resources")
+	}
+	return resp, nil
+}
+
+// buildSearchResourcesQuery returns the query searching the fields of the given kinds, and its args.
+// Each field is matched if it contains the query or if a word of it is similar to the query, so that both partial
+// identifiers (serial numbers, MAC and IP addresses) and names with typos are found through the trigram indexes.
+// Exact matches score 1, fields containing the query score above 0.5 and fields that are only similar score at most
+// 0.5; the best field of each resource is returned, ordered by decreasing score.
+func buildSearchResourcesQuery(tenantID, query string, kinds []inv_v1.ResourceKind, limit int) (string, []any) {
+	// $1: tenant ID, $2: query, $3: LIKE pattern, $4: limit.
+	args := []any{tenantID, query, "%" + likeEscaper.Replace(query) + "%", limit}
+	branchFormat := `SELECT resource_id, '%[2]s' AS field, %[2]q AS value, ` +
+		`CASE WHEN lower(%[2]q) = lower($2) THEN 1 ` +
+		`WHEN %[2]q ILIKE $3 THEN LEAST(0.5 + word_similarity($2, %[2]q) / 2, 0.99) ` +
+		`ELSE word_similarity($2, %[2]q) / 2 END AS score ` +
+		`FROM %[1]q WHERE tenant_id = $1 AND (%[2]q ILIKE $3 OR %[2]q %%> $2)`
+
+	branches := make([]string, 0)
+	for _, kind := range kinds {
+		searchable, ok := searchableFields[kind]
+		if !ok {
+			continue
+		}
+		for _, field := range searchable.fields {
+			branches = append(branches, fmt.Sprintf(branchFormat, searchable.table, field))
+		}
+	}
+	finalQuery := `SELECT resource_id, field, value, score FROM (` +
+		`SELECT DISTINCT ON (resource_id) resource_id, field, value, score FROM (` +
+		strings.Join(branches, " UNION ALL ") +
+		`) AS hits ORDER BY resource_id, score DESC, field` +
+		`) AS best ORDER BY score DESC, resource_id LIMIT $4`
+	return finalQuery, args
+}
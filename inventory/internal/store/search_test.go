@@ -0,0 +1,170 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package store_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	inv_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/inventory/v1"
+	inv_testing "github.com/open-edge-platform/infra-core/inventory/v2/pkg/testing"
+)
+
+//nolint:funlen // length due to test cases
+func Test_SearchResources(t *testing.T) {
+	site := inv_testing.CreateSiteWithArgs(t, "Search Lab Portland", 0, 0, "", nil, nil, nil)
+	host := inv_testing.CreateHostWithArgs(
+		t, "search-edge-node-042", "4f2c7a1e-93b5-4d8e-a6f0-1c2b3d4e5f60", "SRCH7F3A9C21", "", site, nil, true)
+	nic := inv_testing.CreateHostNic(t, host)
+	ipAddress := inv_testing.CreateIPAddress(t, nic, true)
+
+	testcases := map[string]struct {
+		in       *inv_v1.SearchResourcesRequest
+		expected *inv_v1.SearchResourcesResponse_Hit
+		absent   []string
+		code     codes.Code
+	}{
+		"ExactSerialNumber": {
+			in: &inv_v1.SearchResourcesRequest{Query: "srch7f3a9c21"},
+			expected: &inv_v1.SearchResourcesResponse_Hit{
+				ResourceKind: inv_v1.ResourceKind_RESOURCE_KIND_HOST,
+				ResourceId:   host.GetResourceId(),
+				Field:        "serial_number",
+				Value:        "SRCH7F3A9C21",
+				Score:        1,
+			},
+		},
+		"PartialUUID": {
+			in: &inv_v1.SearchResourcesRequest{Query: "93b5-4d8e-a6f0"},
+			expected: &inv_v1.SearchResourcesResponse_Hit{
+				ResourceKind: inv_v1.ResourceKind_RESOURCE_KIND_HOST,
+				ResourceId:   host.GetResourceId(),
+				Field:        "uuid",
+				Value:        host.GetUuid(),
+			},
+		},
+		"HostnameWithTypo": {
+			in: &inv_v1.SearchResourcesRequest{Query: "serch-edge-node-042"},
+			expected: &inv_v1.SearchResourcesResponse_Hit{
+				ResourceKind: inv_v1.ResourceKind_RESOURCE_KIND_HOST,
+				ResourceId:   host.GetResourceId(),
+				Field:        "hostname",
+				Value:        "search-edge-node-042",
+			},
+		},
+		"PartialSiteName": {
+			in: &inv_v1.SearchResourcesRequest{Query: "lab portl"},
+			expected: &inv_v1.SearchResourcesResponse_Hit{
+				ResourceKind: inv_v1.ResourceKind_RESOURCE_KIND_SITE,
+				ResourceId:   site.GetResourceId(),
+				Field:        "name",
+				Value:        "Search Lab Portland",
+			},
+		},
+		"IPAddress": {
+			in: &inv_v1.SearchResourcesRequest{
+				Query: ipAddress.GetAddress(),
+				Kinds: []inv_v1.ResourceKind{inv_v1.ResourceKind_RESOURCE_KIND_IPADDRESS},
+			},
+			expected: &inv_v1.SearchResourcesResponse_Hit{
+				ResourceKind: inv_v1.ResourceKind_RESOURCE_KIND_IPADDRESS,
+				ResourceId:   ipAddress.GetResourceId(),
+				Field:        "address",
+				Value:        ipAddress.GetAddress(),
+				Score:        1,
+			},
+		},
+		"FilteredKinds": {
+			// Both the host and the site match, only the site is searched.
+			in: &inv_v1.SearchResourcesRequest{
+				Query: "search",
+				Kinds: []inv_v1.ResourceKind{inv_v1.ResourceKind_RESOURCE_KIND_SITE},
+			},
+			expected: &inv_v1.SearchResourcesResponse_Hit{
+				ResourceKind: inv_v1.ResourceKind_RESOURCE_KIND_SITE,
+				ResourceId:   site.GetResourceId(),
+				Field:        "name",
+				Value:        "Search Lab Portland",
+			},
+			absent: []string{host.GetResourceId()},
+		},
+		"WildcardsAreLiterals": {
+			in:     &inv_v1.SearchResourcesRequest{Query: "S%21"},
+			absent: []string{host.GetResourceId()},
+		},
+		"QueryTooShort": {
+			in:   &inv_v1.SearchResourcesRequest{Query: "sr"},
+			code: codes.InvalidArgument,
+		},
+		"UnsupportedKind": {
+			in: &inv_v1.SearchResourcesRequest{
+				Query: "search",
+				Kinds: []inv_v1.ResourceKind{inv_v1.ResourceKind_RESOURCE_KIND_OS},
+			},
+			code: codes.InvalidArgument,
+		},
+		"LimitTooHigh": {
+			in:   &inv_v1.SearchResourcesRequest{Query: "search", Limit: 101},
+			code: codes.InvalidArgument,
+		},
+	}
+
+	for tcname, tc := range testcases {
+		t.Run(tcname, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+
+			resp, err := inv_testing.TestClients[inv_testing.APIClient].SearchResources(ctx, tc.in)
+			if tc.code != codes.OK {
+				require.Error(t, err)
+				assert.Equal(t, tc.code, status.Code(err))
+				return
+			}
+			require.NoError(t, err)
+
+			seen := make(map[string]bool)
+			for i, hit := range resp.GetHits() {
+				assert.False(t, seen[hit.GetResourceId()], "resources must be hit at most once")
+				seen[hit.GetResourceId()] = true
+				if i > 0 {
+					assert.LessOrEqual(t, hit.GetScore(), resp.GetHits()[i-1].GetScore(), "hits must be ranked")
+				}
+			}
+			for _, resID := range tc.absent {
+				assert.False(t, seen[resID], "unexpected hit for %s", resID)
+			}
+			if tc.expected == nil {
+				return
+			}
+			var hit *inv_v1.SearchResourcesResponse_Hit
+			for _, h := range resp.GetHits() {
+				if h.GetResourceId() == tc.expected.GetResourceId() {
+					hit = h
+				}
+			}
+			require.NotNil(t, hit, "missing hit for %s", tc.expected.GetResourceId())
+			assert.Equal(t, tc.expected.GetResourceKind(), hit.GetResourceKind())
+			assert.Equal(t, tc.expected.GetField(), hit.GetField())
+			assert.Equal(t, tc.expected.GetValue(), hit.GetValue())
+			if tc.expected.GetScore() != 0 {
+				assert.InDelta(t, tc.expected.GetScore(), hit.GetScore(), 0.0001)
+			} else {
+				assert.Less(t, hit.GetScore(), float32(1))
+			}
+		})
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	resp, err := inv_testing.TestClients[inv_testing.APIClient].SearchResources(
+		ctx, &inv_v1.SearchResourcesRequest{Query: "search", Limit: 1})
+	require.NoError(t, err)
+	assert.Len(t, resp.GetHits(), 1)
+}
@@ -21,11 +21,25 @@ import (
 	schedule_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/schedule/v1"
 	cl "github.com/open-edge-platform/infra-core/inventory/v2/pkg/client"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/logging"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/util"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/util/collections"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/validator"
 )
 
+func init() {
+	RegisterResourceHandler(ResourceHandler{
+		Kind:      inv_v1.ResourceKind_RESOURCE_KIND_SINGLESCHEDULE,
+		Create:    createAs((*InvStore).CreateSingleSchedule),
+		Get:       getByID((*InvStore).GetSingleSchedule),
+		Update:    updateAs((*InvStore).UpdateSingleSchedule),
+		Delete:    deleteByID((*InvStore).DeleteSingleSchedule),
+		DeleteAll: (*InvStore).DeleteSingleSchedules,
+		List:      (*InvStore).ListSingleSchedules,
+		Filter:    (*InvStore).FilterSingleSchedules,
+	})
+}
+
 var singleScheduleCreationValidators = []resourceValidator[*schedule_v1.SingleScheduleResource]{
 	protoValidator[*schedule_v1.SingleScheduleResource],
 	validateSScheduledInput,
@@ -60,7 +74,7 @@ func (is *InvStore) CreateSingleSchedule(ctx context.Context, in *schedule_v1.Si
 		return nil, err
 	}
 
-	zlog.Debug().Msgf("SingleSchedule Created: %s, %s", res.GetSingleschedule().GetResourceId(), res)
+	zlog.Debug().Msgf("SingleSchedule Created: %s, %s", res.GetSingleschedule().GetResourceId(), logging.Redact(res))
 	return res, nil
 }
 
@@ -166,7 +180,7 @@ func (is *InvStore) UpdateSingleSchedule(
 		return nil, errors.Errorfc(codes.InvalidArgument, "Scheduled start time cannot be in the past")
 	}
 
-	zlog.Debug().Msgf("UpdateSingleSchedule (%s): %v, fm: %v", id, in, fieldmask)
+	zlog.Debug().Msgf("UpdateSingleSchedule (%s): %v, fm: %v", id, logging.Redact(in), fieldmask)
 
 	res, err := ExecuteInTxAndReturnSingle[inv_v1.Resource](is)(ctx,
 		func(ctx context.Context, tx *ent.Tx) (*inv_v1.Resource, error) {
@@ -20,11 +20,25 @@ import (
 	location_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/location/v1"
 	cl "github.com/open-edge-platform/infra-core/inventory/v2/pkg/client"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/logging"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/util"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/util/collections"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/validator"
 )
 
+func init() {
+	RegisterResourceHandler(ResourceHandler{
+		Kind:      inv_v1.ResourceKind_RESOURCE_KIND_SITE,
+		Create:    createAs((*InvStore).CreateSite),
+		Get:       (*InvStore).GetSite,
+		Update:    updateAsInTenant((*InvStore).UpdateSite),
+		Delete:    deleteByID((*InvStore).DeleteSite),
+		DeleteAll: (*InvStore).DeleteSites,
+		List:      (*InvStore).ListSites,
+		Filter:    (*InvStore).FilterSites,
+	})
+}
+
 var siteResourceCreationValidators = []resourceValidator[*location_v1.SiteResource]{
 	protoValidator[*location_v1.SiteResource],
 	doNotAcceptResourceID[*location_v1.SiteResource],
@@ -40,7 +54,7 @@ func (is *InvStore) CreateSite(ctx context.Context, in *location_v1.SiteResource
 		return nil, err
 	}
 
-	zlog.Debug().Msgf("Site Created: %s, %s", res.GetSite().GetResourceId(), res)
+	zlog.Debug().Msgf("Site Created: %s, %s", res.GetSite().GetResourceId(), logging.Redact(res))
 
 	return res, nil
 }
@@ -91,6 +105,9 @@ func siteResourceCreator(in *location_v1.SiteResource) func(context.Context, *en
 		if err != nil {
 			return nil, err
 		}
+		if err := syncResourceLabels(ctx, tx.Client(), res.TenantID, res.ResourceID, res.Metadata); err != nil {
+			return nil, err
+		}
 		return util.WrapResource(entSiteResourceToProtoSiteResource(res))
 	}
 }
@@ -149,7 +166,7 @@ func getSiteQuery(ctx context.Context, tx *ent.Tx, tenantID, resourceID string,
 func (is *InvStore) UpdateSite(
 	ctx context.Context, id string, in *location_v1.SiteResource, fieldmask *fieldmaskpb.FieldMask, tenantID string,
 ) (*inv_v1.Resource, error) {
-	zlog.Debug().Msgf("UpdateSite (%s): %v, fm: %v", id, in, fieldmask)
+	zlog.Debug().Msgf("UpdateSite (%s): %v, fm: %v", id, logging.Redact(in), fieldmask)
 
 	res, err := ExecuteInTxAndReturnSingle[inv_v1.Resource](is)(ctx,
 		func(ctx context.Context, tx *ent.Tx) (*inv_v1.Resource, error) {
@@ -185,6 +202,11 @@ func (is *InvStore) UpdateSite(
 			if err != nil {
 				return nil, err
 			}
+			if writesMetadata(fieldmask, sites.FieldMetadata) {
+				if err := syncResourceLabels(ctx, tx.Client(), res.TenantID, res.ResourceID, res.Metadata); err != nil {
+					return nil, err
+				}
+			}
 			return util.WrapResource(entSiteResourceToProtoSiteResource(res))
 		},
 	)
@@ -228,6 +250,9 @@ func deleteSite(id string) func(ctx context.Context, tx *ent.Tx) (*inv_v1.Resour
 				"the site has relations with host and cannot be deleted")
 		}
 
+		if err := deleteResourceLabels(ctx, tx.Client(), entity.ResourceID); err != nil {
+			return nil, err
+		}
 		if err := tx.SiteResource.DeleteOneID(entity.ID).Exec(ctx); err != nil {
 			return nil, errors.Wrap(err)
 		}
@@ -247,6 +272,9 @@ func (is *InvStore) DeleteSites(
 			if err != nil {
 				return err
 			}
+			if err := deleteTenantResourceLabels(ctx, tx.Client(), tenantID, inv_v1.ResourceKind_RESOURCE_KIND_SITE); err != nil {
+				return err
+			}
 			if _, err := tx.SiteResource.Delete().Where(sites.TenantID(tenantID)).Exec(ctx); err != nil {
 				return err
 			}
@@ -270,6 +298,14 @@ func filterSites(ctx context.Context, client *ent.Client, filter *inv_v1.Resourc
 		return nil, 0, err
 	}
 
+	labelPred, err := getLabelSelectorPredicate(inv_v1.ResourceKind_RESOURCE_KIND_SITE, filter.GetLabelSelector())
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Principals holding the scoped role only get the resources in their scope.
+	scopePred := scopePredicate(ctx, inv_v1.ResourceKind_RESOURCE_KIND_SITE)
+
 	orderOpts, err := GetOrderByOptions[sites.OrderOption](filter.GetOrderBy(), sites.ValidColumn)
 	if err != nil {
 		return nil, 0, err
@@ -280,14 +316,21 @@ func filterSites(ctx context.Context, client *ent.Client, filter *inv_v1.Resourc
 		return nil, 0, err
 	}
 
-	// perform query - And together all the predicates
+	// perform query - And together all the predicates, loading the edges requested by the read mask
 	query := client.SiteResource.Query().
-		WithRegion().
-		WithOu().
-		WithProvider().
-		Where(pred).
+		Where(pred, labelPred, scopePred).
 		Order(orderOpts...).
 		Offset(offset)
+	mask := filter.GetReadMask()
+	if inReadMask(mask, location_v1.SiteResourceEdgeRegion) {
+		query.WithRegion()
+	}
+	if inReadMask(mask, location_v1.SiteResourceEdgeOu) {
+		query.WithOu()
+	}
+	if inReadMask(mask, location_v1.SiteResourceEdgeProvider) {
+		query.WithProvider()
+	}
 
 	// Limits number of query results if existent
 	if limit != 0 {
@@ -301,7 +344,7 @@ func filterSites(ctx context.Context, client *ent.Client, filter *inv_v1.Resourc
 
 	// Count total number of item without applying pagination limits, order, or loading edges.
 	total, err := client.SiteResource.Query().
-		Where(pred).
+		Where(pred, labelPred, scopePred).
 		Count(ctx)
 	if err != nil {
 		return nil, 0, errors.Wrap(err)
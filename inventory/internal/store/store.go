@@ -162,6 +162,10 @@ func setEdgeRegionIDForMut(
 		mut.SetTargetRegionID(regID)
 	case *ent.SiteResourceMutation:
 		mut.SetRegionID(regID)
+	case *ent.CustomObjectResourceMutation:
+		mut.SetRegionID(regID)
+	case *ent.RoleBindingResourceMutation:
+		mut.SetRegionID(regID)
 	default:
 		zlog.InfraSec().InfraError("unknown mutation kind: %T", mut).Msg("")
 		return errors.Errorfc(codes.InvalidArgument, "unknown mutation kind: %T", mut)
@@ -188,6 +192,10 @@ func setEdgeSiteIDForMut(ctx context.Context, client *ent.Client, mut ent.Mutati
 		mut.SetTargetSiteID(siteID)
 	case *ent.TelemetryProfileMutation:
 		mut.SetSiteID(siteID)
+	case *ent.CustomObjectResourceMutation:
+		mut.SetSiteID(siteID)
+	case *ent.RoleBindingResourceMutation:
+		mut.SetSiteID(siteID)
 	default:
 		zlog.InfraSec().InfraError("unknown mutation kind: %T", mut).Msg("")
 		return errors.Errorfc(codes.InvalidArgument, "unknown mutation kind: %T", mut)
@@ -298,6 +306,8 @@ func setEdgeHostIDForMut(ctx context.Context, client *ent.Client, mut ent.Mutati
 		mut.SetTargetHostID(hostID)
 	case *ent.InstanceResourceMutation:
 		mut.SetHostID(hostID)
+	case *ent.CustomObjectResourceMutation:
+		mut.SetHostID(hostID)
 	default:
 		zlog.InfraSec().InfraError("unknown mutation kind: %T", mut).Msg("")
 		return errors.Errorfc(codes.InvalidArgument, "unknown mutation kind: %T", mut)
@@ -15,11 +15,25 @@ import (
 	telemetry_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/telemetry/v1"
 	cl "github.com/open-edge-platform/infra-core/inventory/v2/pkg/client"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/logging"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/util"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/util/collections"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/validator"
 )
 
+func init() {
+	RegisterResourceHandler(ResourceHandler{
+		Kind:      inv_v1.ResourceKind_RESOURCE_KIND_TELEMETRY_GROUP,
+		Create:    createAs((*InvStore).CreateTelemetryGroup),
+		Get:       getByID((*InvStore).GetTelemetryGroup),
+		Update:    updateAs((*InvStore).UpdateTelemetryGroup),
+		Delete:    deleteByID((*InvStore).DeleteTelemetryGroup),
+		DeleteAll: (*InvStore).DeleteTelemetryGroups,
+		List:      (*InvStore).ListTelemetryGroup,
+		Filter:    (*InvStore).FilterTelemetryGroup,
+	})
+}
+
 // telemetrygroup.go  store information for TelemetryResource objects
 
 var telemetryGroupCreationValidators = []resourceValidator[*telemetry_v1.TelemetryGroupResource]{
@@ -53,7 +67,7 @@ func (is *InvStore) CreateTelemetryGroup(
 		return nil, err
 	}
 
-	zlog.Debug().Msgf("Telemetry Group Created: %s, %s", res.GetTelemetryGroup().GetResourceId(), res)
+	zlog.Debug().Msgf("Telemetry Group Created: %s, %s", res.GetTelemetryGroup().GetResourceId(), logging.Redact(res))
 	return res, nil
 }
 
@@ -122,7 +136,7 @@ func getTelemetryGroup(ctx context.Context, tx *ent.Tx, resourceID string) (*ent
 func (is *InvStore) UpdateTelemetryGroup(
 	ctx context.Context, id string, in *telemetry_v1.TelemetryGroupResource, fieldmask *fieldmaskpb.FieldMask,
 ) (*inv_v1.Resource, error) {
-	zlog.Debug().Msgf("UpdateTelemetryGroup (%s): %v, fm: %v", id, in, fieldmask)
+	zlog.Debug().Msgf("UpdateTelemetryGroup (%s): %v, fm: %v", id, logging.Redact(in), fieldmask)
 
 	res, err := ExecuteInTxAndReturnSingle[inv_v1.Resource](is)(ctx,
 		func(ctx context.Context, tx *ent.Tx) (*inv_v1.Resource, error) {
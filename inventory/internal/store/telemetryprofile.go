@@ -19,11 +19,25 @@ import (
 	telemetry_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/telemetry/v1"
 	cl "github.com/open-edge-platform/infra-core/inventory/v2/pkg/client"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/logging"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/util"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/util/collections"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/validator"
 )
 
+func init() {
+	RegisterResourceHandler(ResourceHandler{
+		Kind:      inv_v1.ResourceKind_RESOURCE_KIND_TELEMETRY_PROFILE,
+		Create:    createAs((*InvStore).CreateTelemetryProfile),
+		Get:       getByID((*InvStore).GetTelemetryProfile),
+		Update:    updateAs((*InvStore).UpdateTelemetryProfile),
+		Delete:    deleteByID((*InvStore).DeleteTelemetryProfile),
+		DeleteAll: (*InvStore).DeleteTelemetryProfiles,
+		List:      (*InvStore).ListTelemetryProfile,
+		Filter:    (*InvStore).FilterTelemetryProfile,
+	})
+}
+
 // telemetryprofile.go  store information for TelemetryProfile objects
 
 var telemetryProfileCreationValidators = []resourceValidator[*telemetry_v1.TelemetryProfile]{
@@ -58,7 +72,7 @@ func (is *InvStore) CreateTelemetryProfile(
 		return nil, err
 	}
 
-	zlog.Debug().Msgf("Telemetry profile created: %s, %s", res.GetTelemetryProfile().GetResourceId(), res)
+	zlog.Debug().Msgf("Telemetry profile created: %s, %s", res.GetTelemetryProfile().GetResourceId(), logging.Redact(res))
 	return res, nil
 }
 
@@ -146,7 +160,7 @@ func getTelemetryProfileQuery(ctx context.Context, tx *ent.Tx, resourceID string
 func (is *InvStore) UpdateTelemetryProfile(
 	ctx context.Context, id string, in *telemetry_v1.TelemetryProfile, fieldmask *fieldmaskpb.FieldMask,
 ) (*inv_v1.Resource, error) {
-	zlog.Debug().Msgf("UpdateTelemetryProfile (%s): %v, fm: %v", id, in, fieldmask)
+	zlog.Debug().Msgf("UpdateTelemetryProfile (%s): %v, fm: %v", id, logging.Redact(in), fieldmask)
 
 	res, err := ExecuteInTxAndReturnSingle[inv_v1.Resource](is)(ctx,
 		func(ctx context.Context, tx *ent.Tx) (*inv_v1.Resource, error) {
@@ -479,13 +493,27 @@ func (is *InvStore) ListInheritedTelemetryProfile(
 		func(ctx context.Context, tx *ent.Tx) (*[]*ent.TelemetryProfile, *int, error) {
 			zeroInt := 0
 
-			telemetryProfilesIDs, err := getInheritedTelemetry(ctx, tx.Client(), in.GetInheritBy(), in.GetTenantId())
-			if err != nil {
-				return nil, &zeroInt, err
+			var profilesPred predicate.TelemetryProfile
+			var numProfiles int
+			if in.GetMode() == inv_v1.TelemetryInheritanceMode_TELEMETRY_INHERITANCE_MODE_OVERRIDE {
+				effectiveProfileIDs, err := getEffectiveTelemetryProfileIDs(
+					ctx, tx.Client(), in.GetInheritBy(), in.GetTenantId())
+				if err != nil {
+					return nil, &zeroInt, err
+				}
+				profilesPred = telemetryprofileres.ResourceIDIn(effectiveProfileIDs...)
+				numProfiles = len(effectiveProfileIDs)
+			} else {
+				telemetryProfilesIDs, err := getInheritedTelemetry(ctx, tx.Client(), in.GetInheritBy(), in.GetTenantId())
+				if err != nil {
+					return nil, &zeroInt, err
+				}
+				profilesPred = telemetryprofileres.IDIn(telemetryProfilesIDs...)
+				numProfiles = len(telemetryProfilesIDs)
 			}
 
 			// Shortcut to avoid wrong query below
-			if len(telemetryProfilesIDs) == 0 {
+			if numProfiles == 0 {
 				zlog.Debug().Msgf("no inherited telemetry profiles: request=%v", in)
 				profilesToBeReturned := make([]*ent.TelemetryProfile, 0)
 				return &profilesToBeReturned, &zeroInt, nil
@@ -499,7 +527,7 @@ func (is *InvStore) ListInheritedTelemetryProfile(
 
 			// Preds will filter on both the IDs for the telemetry profiles we are interested into and the filter provided
 			preds := []predicate.TelemetryProfile{
-				telemetryprofileres.IDIn(telemetryProfilesIDs...),
+				profilesPred,
 			}
 			pred, err := getPredicate(inv_v1.ResourceKind_RESOURCE_KIND_TELEMETRY_PROFILE, filter.GetFilter())
 			if err != nil {
@@ -1197,6 +1197,225 @@ func Test_ListInheritedTelemetryProfilesPaginate(t *testing.T) {
 	}
 }
 
+func Test_GetEffectiveTelemetryProfiles(t *testing.T) {
+	region1 := inv_testing.CreateRegion(t, nil)
+	region2 := inv_testing.CreateRegion(t, region1)
+	site1 := inv_testing.CreateSite(t, region2, nil)
+	h1 := inv_testing.CreateHost(t, site1, nil)
+	h2 := inv_testing.CreateHost(t, site1, nil)
+	h3 := inv_testing.CreateHost(t, nil, nil)
+	os := inv_testing.CreateOs(t)
+	inst1 := inv_testing.CreateInstance(t, h1, os)
+	inst2 := inv_testing.CreateInstance(t, h2, os)
+	inst3 := inv_testing.CreateInstance(t, h3, os)
+
+	metricsGroup := inv_testing.CreateTelemetryGroupMetrics(t, true)
+	logsGroup := inv_testing.CreateTelemetryGroupLogs(t, true)
+
+	metricsPerRegion1 := inv_testing.CreateTelemetryProfile(t, nil, nil, region1, metricsGroup, true)
+	metricsPerRegion2 := inv_testing.CreateTelemetryProfile(t, nil, nil, region2, metricsGroup, true)
+	metricsPerSite1 := inv_testing.CreateTelemetryProfile(t, nil, site1, nil, metricsGroup, true)
+	logsPerRegion1 := inv_testing.CreateTelemetryProfile(t, nil, nil, region1, logsGroup, true)
+	logsPerInstance2 := inv_testing.CreateTelemetryProfile(t, inst2, nil, nil, logsGroup, true)
+
+	type expectedProfile struct {
+		profileID  string
+		sourceID   string
+		sourceKind inv_v1.ResourceKind
+		depth      int32
+		overridden []string
+	}
+	testcases := map[string]struct {
+		instanceIDs []string
+		expected    map[string]map[string]expectedProfile
+		valid       bool
+	}{
+		"Instances": {
+			instanceIDs: []string{inst1.ResourceId, inst2.ResourceId, inst3.ResourceId},
+			expected: map[string]map[string]expectedProfile{
+				inst1.ResourceId: {
+					metricsGroup.ResourceId: {
+						metricsPerSite1.ResourceId, site1.ResourceId, inv_v1.ResourceKind_RESOURCE_KIND_SITE, 1,
+						[]string{metricsPerRegion2.ResourceId, metricsPerRegion1.ResourceId},
+					},
+					logsGroup.ResourceId: {
+						logsPerRegion1.ResourceId, region1.ResourceId, inv_v1.ResourceKind_RESOURCE_KIND_REGION, 3, nil,
+					},
+				},
+				inst2.ResourceId: {
+					metricsGroup.ResourceId: {
+						metricsPerSite1.ResourceId, site1.ResourceId, inv_v1.ResourceKind_RESOURCE_KIND_SITE, 1,
+						[]string{metricsPerRegion2.ResourceId, metricsPerRegion1.ResourceId},
+					},
+					logsGroup.ResourceId: {
+						logsPerInstance2.ResourceId, inst2.ResourceId, inv_v1.ResourceKind_RESOURCE_KIND_INSTANCE, 0,
+						[]string{logsPerRegion1.ResourceId},
+					},
+				},
+				inst3.ResourceId: {},
+			},
+			valid: true,
+		},
+		"NotFoundInstance": {
+			instanceIDs: []string{inst1.ResourceId, "inst-12345678"},
+			valid:       false,
+		},
+		"NoInstances": {
+			instanceIDs: []string{},
+			valid:       false,
+		},
+	}
+	for tcname, tc := range testcases {
+		t.Run(tcname, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+
+			resp, err := inv_testing.TestClients[inv_testing.APIClient].GetEffectiveTelemetryProfiles(
+				ctx, &inv_v1.GetEffectiveTelemetryProfilesRequest{InstanceIds: tc.instanceIDs})
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, resp.GetInstances(), len(tc.instanceIDs))
+			for i, instTelemetry := range resp.GetInstances() {
+				assert.Equal(t, tc.instanceIDs[i], instTelemetry.GetInstanceId())
+				expected := tc.expected[instTelemetry.GetInstanceId()]
+				require.Len(t, instTelemetry.GetProfiles(), len(expected))
+				for _, effective := range instTelemetry.GetProfiles() {
+					exp, ok := expected[effective.GetProfile().GetGroup().GetResourceId()]
+					require.True(t, ok)
+					assert.Equal(t, exp.profileID, effective.GetProfile().GetResourceId())
+					assert.Equal(t, exp.sourceID, effective.GetSourceResourceId())
+					assert.Equal(t, exp.sourceKind, effective.GetSourceKind())
+					assert.Equal(t, exp.depth, effective.GetDepth())
+					assert.Equal(t, exp.overridden, effective.GetOverriddenProfileIds())
+				}
+			}
+		})
+	}
+}
+
+func Test_DiffEffectiveTelemetryProfiles(t *testing.T) {
+	region1 := inv_testing.CreateRegion(t, nil)
+	region2 := inv_testing.CreateRegion(t, region1)
+	site1 := inv_testing.CreateSite(t, region2, nil)
+	h1 := inv_testing.CreateHost(t, site1, nil)
+	h2 := inv_testing.CreateHost(t, site1, nil)
+	os := inv_testing.CreateOs(t)
+	inst1 := inv_testing.CreateInstance(t, h1, os)
+	inst2 := inv_testing.CreateInstance(t, h2, os)
+
+	metricsGroup := inv_testing.CreateTelemetryGroupMetrics(t, true)
+
+	metricsPerRegion2 := inv_testing.CreateTelemetryProfile(t, nil, nil, region2, metricsGroup, true)
+	metricsPerSite1 := inv_testing.CreateTelemetryProfile(t, nil, site1, nil, metricsGroup, true)
+
+	type expectedChange struct {
+		instanceID string
+		before     string
+		after      string
+	}
+	testcases := map[string]struct {
+		request *inv_v1.DiffEffectiveTelemetryProfilesRequest
+		changes []expectedChange
+		valid   bool
+	}{
+		"RemoveSiteProfile": {
+			request: &inv_v1.DiffEffectiveTelemetryProfilesRequest{
+				Change: &inv_v1.DiffEffectiveTelemetryProfilesRequest_RemoveProfileId{
+					RemoveProfileId: metricsPerSite1.ResourceId,
+				},
+			},
+			changes: []expectedChange{
+				{inst1.ResourceId, metricsPerSite1.ResourceId, metricsPerRegion2.ResourceId},
+				{inst2.ResourceId, metricsPerSite1.ResourceId, metricsPerRegion2.ResourceId},
+			},
+			valid: true,
+		},
+		"RemoveOverriddenProfile": {
+			request: &inv_v1.DiffEffectiveTelemetryProfilesRequest{
+				Change: &inv_v1.DiffEffectiveTelemetryProfilesRequest_RemoveProfileId{
+					RemoveProfileId: metricsPerRegion2.ResourceId,
+				},
+			},
+			valid: true,
+		},
+		"AddInstanceProfile": {
+			request: &inv_v1.DiffEffectiveTelemetryProfilesRequest{
+				Change: &inv_v1.DiffEffectiveTelemetryProfilesRequest_AddProfile{
+					AddProfile: &telemetry_v1.TelemetryProfile{
+						Relation:        &telemetry_v1.TelemetryProfile_Instance{Instance: inst1},
+						Kind:            telemetry_v1.TelemetryResourceKind_TELEMETRY_RESOURCE_KIND_METRICS,
+						MetricsInterval: 5,
+						Group:           metricsGroup,
+					},
+				},
+			},
+			changes: []expectedChange{
+				{inst1.ResourceId, metricsPerSite1.ResourceId, ""},
+			},
+			valid: true,
+		},
+		"AddRegionProfile": {
+			request: &inv_v1.DiffEffectiveTelemetryProfilesRequest{
+				Change: &inv_v1.DiffEffectiveTelemetryProfilesRequest_AddProfile{
+					AddProfile: &telemetry_v1.TelemetryProfile{
+						Relation:        &telemetry_v1.TelemetryProfile_Region{Region: region1},
+						Kind:            telemetry_v1.TelemetryResourceKind_TELEMETRY_RESOURCE_KIND_METRICS,
+						MetricsInterval: 5,
+						Group:           metricsGroup,
+					},
+				},
+			},
+			valid: true,
+		},
+		"AddProfileKindMismatch": {
+			request: &inv_v1.DiffEffectiveTelemetryProfilesRequest{
+				Change: &inv_v1.DiffEffectiveTelemetryProfilesRequest_AddProfile{
+					AddProfile: &telemetry_v1.TelemetryProfile{
+						Relation: &telemetry_v1.TelemetryProfile_Region{Region: region1},
+						Kind:     telemetry_v1.TelemetryResourceKind_TELEMETRY_RESOURCE_KIND_LOGS,
+						LogLevel: telemetry_v1.SeverityLevel_SEVERITY_LEVEL_INFO,
+						Group:    metricsGroup,
+					},
+				},
+			},
+			valid: false,
+		},
+		"RemoveNotFoundProfile": {
+			request: &inv_v1.DiffEffectiveTelemetryProfilesRequest{
+				Change: &inv_v1.DiffEffectiveTelemetryProfilesRequest_RemoveProfileId{
+					RemoveProfileId: "telemetryprofile-12345678",
+				},
+			},
+			valid: false,
+		},
+	}
+	for tcname, tc := range testcases {
+		t.Run(tcname, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+
+			resp, err := inv_testing.TestClients[inv_testing.APIClient].DiffEffectiveTelemetryProfiles(ctx, tc.request)
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, resp.GetChanges(), len(tc.changes))
+			for i, change := range resp.GetChanges() {
+				assert.Equal(t, tc.changes[i].instanceID, change.GetInstanceId())
+				assert.Equal(t, metricsGroup.ResourceId, change.GetGroupId())
+				require.Len(t, change.GetBefore(), 1)
+				require.Len(t, change.GetAfter(), 1)
+				assert.Equal(t, tc.changes[i].before, change.GetBefore()[0].GetProfile().GetResourceId())
+				assert.Equal(t, tc.changes[i].after, change.GetAfter()[0].GetProfile().GetResourceId())
+			}
+		})
+	}
+}
+
 func Test_TelemetryProfileEnumStatusMap(t *testing.T) {
 	v, err := store.TelemetryProfileEnumStatusMap("invalid_input",
 		int32(telemetry_v1.TelemetryResourceKind_TELEMETRY_RESOURCE_KIND_METRICS))
@@ -17,11 +17,28 @@ import (
 	tenantv1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/tenant/v1"
 	cl "github.com/open-edge-platform/infra-core/inventory/v2/pkg/client"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/logging"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/util"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/util/collections"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/validator"
 )
 
+func init() {
+	RegisterResourceHandler(ResourceHandler{
+		Kind:   inv_v1.ResourceKind_RESOURCE_KIND_TENANT,
+		Create: createAs((*InvStore).CreateTenant),
+		Get:    getByID((*InvStore).GetTenant),
+		Update: updateAsWithHardDelete((*InvStore).UpdateTenant),
+		// Tenants are always soft-deleted, the tenant controller completes the removal.
+		Delete: func(is *InvStore, ctx context.Context, id, _ string) (*inv_v1.Resource, bool, error) {
+			res, err := is.SoftDeleteTenant(ctx, id)
+			return res, true, err
+		},
+		List:   (*InvStore).ListTenants,
+		Filter: (*InvStore).FilterTenants,
+	})
+}
+
 var validators = []resourceValidator[*tenantv1.Tenant]{
 	protoValidator[*tenantv1.Tenant],
 	doNotAcceptResourceID[*tenantv1.Tenant],
@@ -37,7 +54,7 @@ func (is *InvStore) CreateTenant(ctx context.Context, in *tenantv1.Tenant) (*inv
 		return nil, err
 	}
 
-	zlog.Debug().Msgf("Tenant Created: %s, %s", res.GetTenant().GetResourceId(), res)
+	zlog.Debug().Msgf("Tenant Created: %s, %s", res.GetTenant().GetResourceId(), logging.Redact(res))
 	return res, nil
 }
 
@@ -123,7 +140,7 @@ func (is *InvStore) UpdateTenant(
 	in *tenantv1.Tenant,
 	fm *fieldmaskpb.FieldMask,
 ) (*inv_v1.Resource, bool, error) {
-	zlog.Debug().Msgf("Update (%s): %v, fm: %v", id, in, fm)
+	zlog.Debug().Msgf("Update (%s): %v, fm: %v", id, logging.Redact(in), fm)
 
 	updated, isHardRemoval, err := ExecuteInTxAndReturnDouble[inv_v1.Resource, bool](is)(
 		ctx,
@@ -247,7 +264,7 @@ func tenantCreator(in *tenantv1.Tenant) func(context.Context, *ent.Tx) (*inv_v1.
 		if err != nil {
 			return nil, err
 		}
-		zlog.Debug().Msgf("Tenant Created: %s, %s", res.ResourceID, res)
+		zlog.Debug().Msgf("Tenant Created: %s, %s", res.ResourceID, logging.Redact(res))
 		return util.WrapResource(entTenantToProto(res))
 	}
 }
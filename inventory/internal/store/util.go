@@ -10,38 +10,12 @@ import (
 	"strings"
 
 	"entgo.io/ent"
+	entsql "entgo.io/ent/dialect/sql"
 	"github.com/goccy/go-json"
 	"github.com/iancoleman/strcase"
 	"google.golang.org/grpc/codes"
 
 	internal_ent "github.com/open-edge-platform/infra-core/inventory/v2/internal/ent"
-	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/customconfigresource"
-	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/endpointresource"
-	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/hostgpuresource"
-	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/hostnicresource"
-	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/hostresource"
-	hoststorage "github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/hoststorageresource"
-	hostusb "github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/hostusbresource"
-	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/instanceresource"
-	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/ipaddressresource"
-	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/localaccountresource"
-	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/netlinkresource"
-	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/networksegment"
-	oss "github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/operatingsystemresource"
-	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/osupdatepolicyresource"
-	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/osupdaterunresource"
-	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/ouresource"
-	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/providerresource"
-	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/regionresource"
-	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/remoteaccessconfiguration"
-	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/repeatedscheduleresource"
-	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/singlescheduleresource"
-	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/siteresource"
-	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/telemetrygroupresource"
-	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/telemetryprofile"
-	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/tenant"
-	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/workloadmember"
-	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/workloadresource"
 	inv_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/inventory/v1"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/util"
@@ -266,34 +240,10 @@ func getOffsetAndLimit(filter *inv_v1.ResourceFilter) (offset, limit int, err er
 	return offset, limit, err
 }
 
+// OrderOption is satisfied by the OrderOption type of every ent schema, all of them being
+// selector modifiers. New resource kinds are supported without listing them here.
 type OrderOption interface {
-	endpointresource.OrderOption |
-		hostgpuresource.OrderOption |
-		hostnicresource.OrderOption |
-		hostresource.OrderOption |
-		hoststorage.OrderOption |
-		hostusb.OrderOption |
-		instanceresource.OrderOption |
-		ipaddressresource.OrderOption |
-		netlinkresource.OrderOption |
-		networksegment.OrderOption |
-		oss.OrderOption |
-		ouresource.OrderOption |
-		providerresource.OrderOption |
-		regionresource.OrderOption |
-		repeatedscheduleresource.OrderOption |
-		singlescheduleresource.OrderOption |
-		siteresource.OrderOption |
-		workloadresource.OrderOption |
-		workloadmember.OrderOption |
-		telemetryprofile.OrderOption |
-		telemetrygroupresource.OrderOption |
-		remoteaccessconfiguration.OrderOption |
-		tenant.OrderOption |
-		localaccountresource.OrderOption |
-		osupdatepolicyresource.OrderOption |
-		customconfigresource.OrderOption |
-		osupdaterunresource.OrderOption
+	~func(*entsql.Selector)
 }
 
 // GetOrderByOptions takes an AIP-132 compliant orderBy string and returns the
@@ -41,39 +41,6 @@ type inheritedMeta struct {
 	logical  map[string]string
 }
 
-// Filter hosts by the given wantedMeta based on both standalone metadata
-// and inherited metadata coming from both logical and physical hierarchy.
-func filterHostsByMetadata(
-	hostList map[int]*ent.HostResource,
-	phyMeta map[int]map[string]string,
-	logiMeta map[int]map[string]string,
-	wantedMeta map[string]string,
-) []*ent.HostResource {
-	filteredHost := make([]*ent.HostResource, 0, len(hostList))
-	for id, host := range hostList {
-		stdMeta, err := ParseMetadata(host.Metadata)
-		if err != nil {
-			continue
-		}
-		// Any match on standalone or rendered metadata (both from logical or physical hierarchy).
-		if matchMetadata(stdMeta, wantedMeta) ||
-			matchMetadata(phyMeta[id], wantedMeta) ||
-			matchMetadata(logiMeta[id], wantedMeta) {
-			filteredHost = append(filteredHost, host)
-		}
-	}
-	return filteredHost
-}
-
-func matchMetadata(metadata, wantedMeta map[string]string) bool {
-	for key, val := range wantedMeta {
-		if metadata[key] != val {
-			return false
-		}
-	}
-	return true
-}
-
 func getHostIDToHostMap(hosts []*ent.HostResource) map[int]*ent.HostResource {
 	// Preallocate the map site
 	hostIDs := make(map[int]*ent.HostResource, len(hosts))
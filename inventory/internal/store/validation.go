@@ -4,8 +4,13 @@
 package store
 
 import (
+	"context"
+	"slices"
+
 	"google.golang.org/grpc/codes"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/validator"
@@ -50,3 +55,47 @@ func doNotAcceptResourceID[T invResource](in T) error {
 	}
 	return nil
 }
+
+// verifyMutableWhileReferenced fails the update of a resource when any of the given fields is changed
+// while the resource is referenced by other resources. The fields written are the ones in the fieldmask,
+// or all the populated fields of the input when the fieldmask is empty, as done by buildEntMutate.
+// Written fields that keep their current value are not considered as changed, so a full replace of a
+// referenced resource is still accepted.
+func verifyMutableWhileReferenced(
+	ctx context.Context,
+	current, in proto.Message,
+	fieldmask *fieldmaskpb.FieldMask,
+	isReferenced func(context.Context) (bool, error),
+	fields ...string,
+) error {
+	currentMsg := current.ProtoReflect()
+	inMsg := in.ProtoReflect()
+	var changed []string
+	for _, field := range fields {
+		fd := currentMsg.Descriptor().Fields().ByName(protoreflect.Name(field))
+		if len(fieldmask.GetPaths()) == 0 {
+			if fd != nil && !inMsg.Has(fd) {
+				continue
+			}
+		} else if !slices.Contains(fieldmask.GetPaths(), field) {
+			continue
+		}
+		if fd == nil || !currentMsg.Get(fd).Equal(inMsg.Get(fd)) {
+			changed = append(changed, field)
+		}
+	}
+	if len(changed) == 0 {
+		return nil
+	}
+
+	referenced, err := isReferenced(ctx)
+	if err != nil {
+		return err
+	}
+	if referenced {
+		zlog.InfraSec().InfraError("fields %v cannot be updated while the resource is in use", changed).Msg("")
+		return errors.Errorfc(codes.FailedPrecondition,
+			"fields %v cannot be updated while the resource is in use", changed)
+	}
+	return nil
+}
@@ -17,11 +17,25 @@ import (
 	inv_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/inventory/v1"
 	cl "github.com/open-edge-platform/infra-core/inventory/v2/pkg/client"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/logging"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/util"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/util/collections"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/validator"
 )
 
+func init() {
+	RegisterResourceHandler(ResourceHandler{
+		Kind:      inv_v1.ResourceKind_RESOURCE_KIND_WORKLOAD,
+		Create:    createAs((*InvStore).CreateWorkload),
+		Get:       getByID((*InvStore).GetWorkload),
+		Update:    updateAsWithHardDelete((*InvStore).UpdateWorkload),
+		Delete:    deleteByIDWithSoftDelete((*InvStore).DeleteWorkload),
+		DeleteAll: (*InvStore).DeleteWorkloads,
+		List:      (*InvStore).ListWorkload,
+		Filter:    (*InvStore).FilterWorkload,
+	})
+}
+
 // workload.go  store information for workload
 
 var workloadResourceCreationValidators = []resourceValidator[*computev1.WorkloadResource]{
@@ -54,7 +68,7 @@ func (is *InvStore) CreateWorkload(ctx context.Context, in *computev1.WorkloadRe
 		return nil, err
 	}
 
-	zlog.Debug().Msgf("Workload Created: %s, %s", res.GetWorkload().GetResourceId(), res)
+	zlog.Debug().Msgf("Workload Created: %s, %s", res.GetWorkload().GetResourceId(), logging.Redact(res))
 	return res, nil
 }
 
@@ -127,7 +141,7 @@ func getWorkloadQuery(ctx context.Context, tx *ent.Tx, resourceID string, nested
 func (is *InvStore) UpdateWorkload(
 	ctx context.Context, id string, in *computev1.WorkloadResource, fieldmask *fieldmaskpb.FieldMask,
 ) (*inv_v1.Resource, bool, error) {
-	zlog.Debug().Msgf("UpdateWorkload (%s): %v, fm: %v", id, in, fieldmask)
+	zlog.Debug().Msgf("UpdateWorkload (%s): %v, fm: %v", id, logging.Redact(in), fieldmask)
 
 	res, hardDelete, err := ExecuteInTxAndReturnDouble[inv_v1.Resource, bool](is)(ctx,
 		func(ctx context.Context, tx *ent.Tx) (*inv_v1.Resource, *bool, error) {
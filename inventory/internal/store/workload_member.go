@@ -17,11 +17,25 @@ import (
 	inv_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/inventory/v1"
 	cl "github.com/open-edge-platform/infra-core/inventory/v2/pkg/client"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/logging"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/util"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/util/collections"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/validator"
 )
 
+func init() {
+	RegisterResourceHandler(ResourceHandler{
+		Kind:      inv_v1.ResourceKind_RESOURCE_KIND_WORKLOAD_MEMBER,
+		Create:    createAs((*InvStore).CreateWorkloadMember),
+		Get:       getByID((*InvStore).GetWorkloadMember),
+		Update:    updateAs((*InvStore).UpdateWorkloadMember),
+		Delete:    deleteByID((*InvStore).DeleteWorkloadMember),
+		DeleteAll: (*InvStore).DeleteWorkloadMembers,
+		List:      (*InvStore).ListWorkloadMember,
+		Filter:    (*InvStore).FilterWorkloadMember,
+	})
+}
+
 // workload_member.go  store information for workload member
 
 var workloadMemberCreationValidators = []resourceValidator[*computev1.WorkloadMember]{
@@ -68,7 +82,7 @@ func (is *InvStore) CreateWorkloadMember(ctx context.Context, in *computev1.Work
 		return nil, err
 	}
 
-	zlog.Debug().Msgf("WorkloadMember Created: %s, %s", res.GetWorkloadMember().GetResourceId(), res)
+	zlog.Debug().Msgf("WorkloadMember Created: %s, %s", res.GetWorkloadMember().GetResourceId(), logging.Redact(res))
 	return res, nil
 }
 
@@ -148,7 +162,7 @@ func getWorkloadMemberQuery(ctx context.Context, tx *ent.Tx, resourceID string)
 func (is *InvStore) UpdateWorkloadMember(
 	ctx context.Context, id string, in *computev1.WorkloadMember, fieldmask *fieldmaskpb.FieldMask,
 ) (*inv_v1.Resource, error) {
-	zlog.Debug().Msgf("UpdateWorkloadMember (%s): %v, fm: %v", id, in, fieldmask)
+	zlog.Debug().Msgf("UpdateWorkloadMember (%s): %v, fm: %v", id, logging.Redact(in), fieldmask)
 
 	res, err := ExecuteInTxAndReturnSingle[inv_v1.Resource](is)(ctx,
 		func(ctx context.Context, tx *ent.Tx) (*inv_v1.Resource, error) {
@@ -0,0 +1,484 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.30.0
+// 	protoc        (unknown)
+// source: customresource/v1/customresource.proto
+
+package customresourcev1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	_ "entgo.io/contrib/entproto/cmd/protoc-gen-ent/options/ent"
+	v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/compute/v1"
+	v11 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/location/v1"
+	v12 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/ou/v1"
+	_ "github.com/open-edge-platform/infra-core/inventory/v2/pkg/infrainv"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// A tenant-defined resource type. Custom objects of this type hold a JSON document (spec) that must conform to the
+// JSON schema of the type, and may be linked to the built-in resources listed in allowed_edges.
+type CustomTypeResource struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// resource identifier
+	ResourceId string `protobuf:"bytes,1,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	// Name of the type, e.g. "rack" or "pos-terminal". Unique within a tenant.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Human-readable description of the type.
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// JSON schema (draft 2020-12 unless stated otherwise by $schema) the spec of the custom objects must conform to.
+	// References to external documents are not resolved. Updating the schema fails if existing objects do not
+	// conform to the new schema.
+	JsonSchema string `protobuf:"bytes,4,opt,name=json_schema,json=jsonSchema,proto3" json:"json_schema,omitempty"`
+	// Edges that custom objects of this type may set, given as the name of the edge field of CustomObjectResource.
+	// Removing an edge fails if existing objects use it.
+	AllowedEdges []string `protobuf:"bytes,5,rep,name=allowed_edges,json=allowedEdges,proto3" json:"allowed_edges,omitempty"`
+	// Tenant Identifier.
+	TenantId string `protobuf:"bytes,100,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	// Creation timestamp
+	CreatedAt string `protobuf:"bytes,200,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt string `protobuf:"bytes,201,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // Update timestamp
+}
+
+func (x *CustomTypeResource) Reset() {
+	*x = CustomTypeResource{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_customresource_v1_customresource_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CustomTypeResource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomTypeResource) ProtoMessage() {}
+
+func (x *CustomTypeResource) ProtoReflect() protoreflect.Message {
+	mi := &file_customresource_v1_customresource_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomTypeResource.ProtoReflect.Descriptor instead.
+func (*CustomTypeResource) Descriptor() ([]byte, []int) {
+	return file_customresource_v1_customresource_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CustomTypeResource) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
+	}
+	return ""
+}
+
+func (x *CustomTypeResource) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CustomTypeResource) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CustomTypeResource) GetJsonSchema() string {
+	if x != nil {
+		return x.JsonSchema
+	}
+	return ""
+}
+
+func (x *CustomTypeResource) GetAllowedEdges() []string {
+	if x != nil {
+		return x.AllowedEdges
+	}
+	return nil
+}
+
+func (x *CustomTypeResource) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *CustomTypeResource) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *CustomTypeResource) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+// An instance of a tenant-defined resource type.
+type CustomObjectResource struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// resource identifier
+	ResourceId string `protobuf:"bytes,1,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	// user-provided, human-readable name of the object
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Type of the object, required.
+	CustomType *CustomTypeResource `protobuf:"bytes,3,opt,name=custom_type,json=customType,proto3" json:"custom_type,omitempty"`
+	// JSON document conforming to the JSON schema of the custom type. An empty spec is validated as `{}`.
+	// Fields of the document can be filtered on: `spec.rack.units >= 42`.
+	Spec string `protobuf:"bytes,4,opt,name=spec,proto3" json:"spec,omitempty"`
+	// Edges to built-in resources, only the ones allowed by the custom type can be set.
+	Host     *v1.HostResource     `protobuf:"bytes,10,opt,name=host,proto3" json:"host,omitempty"`
+	Site     *v11.SiteResource    `protobuf:"bytes,11,opt,name=site,proto3" json:"site,omitempty"`
+	Region   *v11.RegionResource  `protobuf:"bytes,12,opt,name=region,proto3" json:"region,omitempty"`
+	Ou       *v12.OuResource      `protobuf:"bytes,13,opt,name=ou,proto3" json:"ou,omitempty"`
+	Instance *v1.InstanceResource `protobuf:"bytes,14,opt,name=instance,proto3" json:"instance,omitempty"`
+	// Tenant Identifier.
+	TenantId string `protobuf:"bytes,100,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	// Creation timestamp
+	CreatedAt string `protobuf:"bytes,200,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt string `protobuf:"bytes,201,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // Update timestamp
+}
+
+func (x *CustomObjectResource) Reset() {
+	*x = CustomObjectResource{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_customresource_v1_customresource_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CustomObjectResource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomObjectResource) ProtoMessage() {}
+
+func (x *CustomObjectResource) ProtoReflect() protoreflect.Message {
+	mi := &file_customresource_v1_customresource_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomObjectResource.ProtoReflect.Descriptor instead.
+func (*CustomObjectResource) Descriptor() ([]byte, []int) {
+	return file_customresource_v1_customresource_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CustomObjectResource) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
+	}
+	return ""
+}
+
+func (x *CustomObjectResource) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CustomObjectResource) GetCustomType() *CustomTypeResource {
+	if x != nil {
+		return x.CustomType
+	}
+	return nil
+}
+
+func (x *CustomObjectResource) GetSpec() string {
+	if x != nil {
+		return x.Spec
+	}
+	return ""
+}
+
+func (x *CustomObjectResource) GetHost() *v1.HostResource {
+	if x != nil {
+		return x.Host
+	}
+	return nil
+}
+
+func (x *CustomObjectResource) GetSite() *v11.SiteResource {
+	if x != nil {
+		return x.Site
+	}
+	return nil
+}
+
+func (x *CustomObjectResource) GetRegion() *v11.RegionResource {
+	if x != nil {
+		return x.Region
+	}
+	return nil
+}
+
+func (x *CustomObjectResource) GetOu() *v12.OuResource {
+	if x != nil {
+		return x.Ou
+	}
+	return nil
+}
+
+func (x *CustomObjectResource) GetInstance() *v1.InstanceResource {
+	if x != nil {
+		return x.Instance
+	}
+	return nil
+}
+
+func (x *CustomObjectResource) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *CustomObjectResource) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *CustomObjectResource) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+var File_customresource_v1_customresource_proto protoreflect.FileDescriptor
+
+var file_customresource_v1_customresource_proto_rawDesc = []byte{
+	0x0a, 0x26, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x2f, 0x76, 0x31, 0x2f, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x11, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d,
+	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x1a, 0x1b, 0x62, 0x75, 0x66,
+	0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74,
+	0x65, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x0e, 0x65, 0x6e, 0x74, 0x2f, 0x6f, 0x70, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x17, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x69, 0x6e, 0x76, 0x2f, 0x69, 0x6e, 0x66,
+	0x72, 0x61, 0x69, 0x6e, 0x76, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1a, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0e, 0x6f, 0x75, 0x2f, 0x76, 0x31, 0x2f, 0x6f,
+	0x75, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc3, 0x04, 0x0a, 0x12, 0x43, 0x75, 0x73, 0x74,
+	0x6f, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x4b,
+	0x0a, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x2a, 0xba, 0x48, 0x21, 0xd8, 0x01, 0x01, 0x72, 0x1c, 0x28, 0x13, 0x32,
+	0x18, 0x5e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x74, 0x79, 0x70, 0x65, 0x2d, 0x5b, 0x30, 0x2d,
+	0x39, 0x61, 0x2d, 0x66, 0x5d, 0x7b, 0x38, 0x7d, 0x24, 0xba, 0xa6, 0x49, 0x02, 0x18, 0x01, 0x52,
+	0x0a, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x12, 0x3b, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x27, 0xba, 0x48, 0x1c, 0x72, 0x1a,
+	0x28, 0x3f, 0x32, 0x16, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x5d, 0x5b, 0x61, 0x2d, 0x7a, 0x30, 0x2d,
+	0x39, 0x2d, 0x5d, 0x7b, 0x30, 0x2c, 0x36, 0x32, 0x7d, 0x24, 0xba, 0xa6, 0x49, 0x04, 0x08, 0x00,
+	0x28, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xba,
+	0x48, 0x05, 0x72, 0x03, 0x28, 0x80, 0x08, 0xba, 0xa6, 0x49, 0x02, 0x08, 0x01, 0x52, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x0b, 0x6a, 0x73,
+	0x6f, 0x6e, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x0f, 0xba, 0x48, 0x06, 0x72, 0x04, 0x28, 0x80, 0x80, 0x04, 0xba, 0xa6, 0x49, 0x02, 0x08, 0x00,
+	0x52, 0x0a, 0x6a, 0x73, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x59, 0x0a, 0x0d,
+	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x65, 0x64, 0x67, 0x65, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x09, 0x42, 0x34, 0xba, 0x48, 0x2b, 0x92, 0x01, 0x28, 0x18, 0x01, 0x22, 0x24, 0x72,
+	0x22, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x52, 0x04, 0x73, 0x69, 0x74, 0x65, 0x52, 0x06, 0x72,
+	0x65, 0x67, 0x69, 0x6f, 0x6e, 0x52, 0x02, 0x6f, 0x75, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0xba, 0xa6, 0x49, 0x02, 0x08, 0x01, 0x52, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
+	0x65, 0x64, 0x45, 0x64, 0x67, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x09, 0x74, 0x65, 0x6e, 0x61, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x42, 0x15, 0xba, 0x48, 0x0a, 0xd8,
+	0x01, 0x01, 0x72, 0x05, 0x28, 0x24, 0xb0, 0x01, 0x01, 0xba, 0xa6, 0x49, 0x04, 0x08, 0x00, 0x28,
+	0x01, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x3f, 0x0a, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0xc8, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x1f, 0xba, 0xa6, 0x49, 0x1b, 0x08, 0x00, 0x28, 0x01, 0x4a, 0x15, 0x0a, 0x08, 0x70, 0x6f,
+	0x73, 0x74, 0x67, 0x72, 0x65, 0x73, 0x12, 0x09, 0x54, 0x49, 0x4d, 0x45, 0x53, 0x54, 0x41, 0x4d,
+	0x50, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3f, 0x0a, 0x0a,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0xc9, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x1f, 0xba, 0xa6, 0x49, 0x1b, 0x08, 0x00, 0x28, 0x00, 0x4a, 0x15, 0x0a, 0x08, 0x70,
+	0x6f, 0x73, 0x74, 0x67, 0x72, 0x65, 0x73, 0x12, 0x09, 0x54, 0x49, 0x4d, 0x45, 0x53, 0x54, 0x41,
+	0x4d, 0x50, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x3a, 0x2e, 0xb2,
+	0xf9, 0x03, 0x24, 0x0a, 0x13, 0x12, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x09, 0x74, 0x65, 0x6e,
+	0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x0a, 0x0d, 0x12, 0x09, 0x74, 0x65, 0x6e, 0x61,
+	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x00, 0xba, 0xa6, 0x49, 0x02, 0x08, 0x01, 0x22, 0xe8, 0x05,
+	0x0a, 0x14, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x4d, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2c, 0xba, 0x48, 0x23,
+	0xd8, 0x01, 0x01, 0x72, 0x1e, 0x28, 0x15, 0x32, 0x1a, 0x5e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d,
+	0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2d, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x66, 0x5d, 0x7b,
+	0x38, 0x7d, 0x24, 0xba, 0xa6, 0x49, 0x02, 0x18, 0x01, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x0e, 0xba, 0x48, 0x05, 0x72, 0x03, 0x28, 0x80, 0x02, 0xba, 0xa6, 0x49,
+	0x02, 0x08, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x50, 0x0a, 0x0b, 0x63, 0x75, 0x73,
+	0x74, 0x6f, 0x6d, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25,
+	0x2e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x08, 0xc2, 0xa6, 0x49, 0x04, 0x08, 0x01, 0x18, 0x01, 0x52,
+	0x0a, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x12, 0x23, 0x0a, 0x04, 0x73,
+	0x70, 0x65, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0f, 0xba, 0x48, 0x06, 0x72, 0x04,
+	0x28, 0x80, 0x80, 0x04, 0xba, 0xa6, 0x49, 0x02, 0x08, 0x01, 0x52, 0x04, 0x73, 0x70, 0x65, 0x63,
+	0x12, 0x34, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x06, 0xc2, 0xa6, 0x49, 0x02, 0x08, 0x01,
+	0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x04, 0x73, 0x69, 0x74, 0x65, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42,
+	0x06, 0xc2, 0xa6, 0x49, 0x02, 0x08, 0x01, 0x52, 0x04, 0x73, 0x69, 0x74, 0x65, 0x12, 0x3b, 0x0a,
+	0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x67, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x06, 0xc2, 0xa6, 0x49, 0x02,
+	0x08, 0x01, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x02, 0x6f, 0x75,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6f, 0x75, 0x2e, 0x76, 0x31, 0x2e, 0x4f,
+	0x75, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x06, 0xc2, 0xa6, 0x49, 0x02, 0x08,
+	0x01, 0x52, 0x02, 0x6f, 0x75, 0x12, 0x40, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x06, 0xc2, 0xa6, 0x49, 0x02, 0x08, 0x01, 0x52, 0x08, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x32, 0x0a, 0x09, 0x74, 0x65, 0x6e, 0x61, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x42, 0x15, 0xba, 0x48, 0x0a, 0xd8,
+	0x01, 0x01, 0x72, 0x05, 0x28, 0x24, 0xb0, 0x01, 0x01, 0xba, 0xa6, 0x49, 0x04, 0x08, 0x00, 0x28,
+	0x01, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x3f, 0x0a, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0xc8, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x1f, 0xba, 0xa6, 0x49, 0x1b, 0x08, 0x00, 0x28, 0x01, 0x4a, 0x15, 0x0a, 0x08, 0x70, 0x6f,
+	0x73, 0x74, 0x67, 0x72, 0x65, 0x73, 0x12, 0x09, 0x54, 0x49, 0x4d, 0x45, 0x53, 0x54, 0x41, 0x4d,
+	0x50, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3f, 0x0a, 0x0a,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0xc9, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x1f, 0xba, 0xa6, 0x49, 0x1b, 0x08, 0x00, 0x28, 0x00, 0x4a, 0x15, 0x0a, 0x08, 0x70,
+	0x6f, 0x73, 0x74, 0x67, 0x72, 0x65, 0x73, 0x12, 0x09, 0x54, 0x49, 0x4d, 0x45, 0x53, 0x54, 0x41,
+	0x4d, 0x50, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x3a, 0x19, 0xb2,
+	0xf9, 0x03, 0x0f, 0x0a, 0x0d, 0x12, 0x09, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x00, 0xba, 0xa6, 0x49, 0x02, 0x08, 0x01, 0x42, 0x62, 0x5a, 0x60, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x2d, 0x65, 0x64, 0x67, 0x65,
+	0x2d, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2f, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x2d,
+	0x63, 0x6f, 0x72, 0x65, 0x2f, 0x69, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x2f, 0x76,
+	0x32, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d,
+	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x63, 0x75, 0x73, 0x74,
+	0x6f, 0x6d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_customresource_v1_customresource_proto_rawDescOnce sync.Once
+	file_customresource_v1_customresource_proto_rawDescData = file_customresource_v1_customresource_proto_rawDesc
+)
+
+func file_customresource_v1_customresource_proto_rawDescGZIP() []byte {
+	file_customresource_v1_customresource_proto_rawDescOnce.Do(func() {
+		file_customresource_v1_customresource_proto_rawDescData = protoimpl.X.CompressGZIP(file_customresource_v1_customresource_proto_rawDescData)
+	})
+	return file_customresource_v1_customresource_proto_rawDescData
+}
+
+var file_customresource_v1_customresource_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_customresource_v1_customresource_proto_goTypes = []interface{}{
+	(*CustomTypeResource)(nil),   // 0: customresource.v1.CustomTypeResource
+	(*CustomObjectResource)(nil), // 1: customresource.v1.CustomObjectResource
+	(*v1.HostResource)(nil),      // 2: compute.v1.HostResource
+	(*v11.SiteResource)(nil),     // 3: location.v1.SiteResource
+	(*v11.RegionResource)(nil),   // 4: location.v1.RegionResource
+	(*v12.OuResource)(nil),       // 5: ou.v1.OuResource
+	(*v1.InstanceResource)(nil),  // 6: compute.v1.InstanceResource
+}
+var file_customresource_v1_customresource_proto_depIdxs = []int32{
+	0, // 0: customresource.v1.CustomObjectResource.custom_type:type_name -> customresource.v1.CustomTypeResource
+	2, // 1: customresource.v1.CustomObjectResource.host:type_name -> compute.v1.HostResource
+	3, // 2: customresource.v1.CustomObjectResource.site:type_name -> location.v1.SiteResource
+	4, // 3: customresource.v1.CustomObjectResource.region:type_name -> location.v1.RegionResource
+	5, // 4: customresource.v1.CustomObjectResource.ou:type_name -> ou.v1.OuResource
+	6, // 5: customresource.v1.CustomObjectResource.instance:type_name -> compute.v1.InstanceResource
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_customresource_v1_customresource_proto_init() }
+func file_customresource_v1_customresource_proto_init() {
+	if File_customresource_v1_customresource_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_customresource_v1_customresource_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CustomTypeResource); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_customresource_v1_customresource_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CustomObjectResource); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_customresource_v1_customresource_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_customresource_v1_customresource_proto_goTypes,
+		DependencyIndexes: file_customresource_v1_customresource_proto_depIdxs,
+		MessageInfos:      file_customresource_v1_customresource_proto_msgTypes,
+	}.Build()
+	File_customresource_v1_customresource_proto = out.File
+	file_customresource_v1_customresource_proto_rawDesc = nil
+	file_customresource_v1_customresource_proto_goTypes = nil
+	file_customresource_v1_customresource_proto_depIdxs = nil
+}
@@ -0,0 +1,31 @@
+// Code generated by protoc-gen-go-const. DO NOT EDIT.
+
+// source: customresource/v1/customresource.proto
+
+package customresourcev1
+
+const (
+	// Fields and Edges constants for "CustomTypeResource"
+	CustomTypeResourceFieldResourceId   = "resource_id"
+	CustomTypeResourceFieldName         = "name"
+	CustomTypeResourceFieldDescription  = "description"
+	CustomTypeResourceFieldJsonSchema   = "json_schema"
+	CustomTypeResourceFieldAllowedEdges = "allowed_edges"
+	CustomTypeResourceFieldTenantId     = "tenant_id"
+	CustomTypeResourceFieldCreatedAt    = "created_at"
+	CustomTypeResourceFieldUpdatedAt    = "updated_at"
+
+	// Fields and Edges constants for "CustomObjectResource"
+	CustomObjectResourceFieldResourceId = "resource_id"
+	CustomObjectResourceFieldName       = "name"
+	CustomObjectResourceEdgeCustomType  = "custom_type"
+	CustomObjectResourceFieldSpec       = "spec"
+	CustomObjectResourceEdgeHost        = "host"
+	CustomObjectResourceEdgeSite        = "site"
+	CustomObjectResourceEdgeRegion      = "region"
+	CustomObjectResourceEdgeOu          = "ou"
+	CustomObjectResourceEdgeInstance    = "instance"
+	CustomObjectResourceFieldTenantId   = "tenant_id"
+	CustomObjectResourceFieldCreatedAt  = "created_at"
+	CustomObjectResourceFieldUpdatedAt  = "updated_at"
+)
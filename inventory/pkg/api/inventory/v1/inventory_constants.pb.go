@@ -56,13 +56,18 @@ const (
 	ResourceEdgeOsUpdatePolicy   = "os_update_policy"
 	ResourceEdgeCustomConfig     = "custom_config"
 	ResourceEdgeOsUpdateRun      = "os_update_run"
+	ResourceEdgeCustomType       = "custom_type"
+	ResourceEdgeCustomObject     = "custom_object"
+	ResourceEdgeRoleBinding      = "role_binding"
 
 	// Fields and Edges constants for "ResourceFilter"
-	ResourceFilterEdgeResource = "resource"
-	ResourceFilterFieldLimit   = "limit"
-	ResourceFilterFieldOffset  = "offset"
-	ResourceFilterFieldFilter  = "filter"
-	ResourceFilterFieldOrderBy = "order_by"
+	ResourceFilterEdgeResource       = "resource"
+	ResourceFilterFieldLimit         = "limit"
+	ResourceFilterFieldOffset        = "offset"
+	ResourceFilterFieldFilter        = "filter"
+	ResourceFilterFieldOrderBy       = "order_by"
+	ResourceFilterFieldLabelSelector = "label_selector"
+	ResourceFilterEdgeReadMask       = "read_mask"
 
 	// Fields and Edges constants for "FindResourcesRequest"
 	FindResourcesRequestFieldClientUuid = "client_uuid"
@@ -73,6 +78,16 @@ const (
 	FindResourcesResponseFieldHasNext       = "has_next"
 	FindResourcesResponseFieldTotalElements = "total_elements"
 
+	// Fields and Edges constants for "SearchResourcesRequest"
+	SearchResourcesRequestFieldClientUuid = "client_uuid"
+	SearchResourcesRequestFieldQuery      = "query"
+	SearchResourcesRequestFieldKinds      = "kinds"
+	SearchResourcesRequestFieldLimit      = "limit"
+	SearchResourcesRequestFieldTenantId   = "tenant_id"
+
+	// Fields and Edges constants for "SearchResourcesResponse"
+	SearchResourcesResponseEdgeHits = "hits"
+
 	// Fields and Edges constants for "ListResourcesRequest"
 	ListResourcesRequestFieldClientUuid = "client_uuid"
 	ListResourcesRequestEdgeFilter      = "filter"
@@ -109,12 +124,37 @@ const (
 	ListInheritedTelemetryProfilesRequestFieldClientUuid = "client_uuid"
 	ListInheritedTelemetryProfilesRequestEdgeInheritBy   = "inherit_by"
 	ListInheritedTelemetryProfilesRequestEdgeFilter      = "filter"
+	ListInheritedTelemetryProfilesRequestFieldMode       = "mode"
 	ListInheritedTelemetryProfilesRequestFieldTenantId   = "tenant_id"
 
 	// Fields and Edges constants for "ListInheritedTelemetryProfilesResponse"
 	ListInheritedTelemetryProfilesResponseEdgeTelemetryProfiles = "telemetry_profiles"
 	ListInheritedTelemetryProfilesResponseFieldTotalElements    = "total_elements"
 
+	// Fields and Edges constants for "EffectiveTelemetryProfile"
+	EffectiveTelemetryProfileEdgeProfile               = "profile"
+	EffectiveTelemetryProfileFieldSourceResourceId     = "source_resource_id"
+	EffectiveTelemetryProfileFieldSourceKind           = "source_kind"
+	EffectiveTelemetryProfileFieldDepth                = "depth"
+	EffectiveTelemetryProfileFieldOverriddenProfileIds = "overridden_profile_ids"
+
+	// Fields and Edges constants for "GetEffectiveTelemetryProfilesRequest"
+	GetEffectiveTelemetryProfilesRequestFieldClientUuid  = "client_uuid"
+	GetEffectiveTelemetryProfilesRequestFieldInstanceIds = "instance_ids"
+	GetEffectiveTelemetryProfilesRequestFieldTenantId    = "tenant_id"
+
+	// Fields and Edges constants for "GetEffectiveTelemetryProfilesResponse"
+	GetEffectiveTelemetryProfilesResponseEdgeInstances = "instances"
+
+	// Fields and Edges constants for "DiffEffectiveTelemetryProfilesRequest"
+	DiffEffectiveTelemetryProfilesRequestFieldClientUuid      = "client_uuid"
+	DiffEffectiveTelemetryProfilesRequestEdgeAddProfile       = "add_profile"
+	DiffEffectiveTelemetryProfilesRequestFieldRemoveProfileId = "remove_profile_id"
+	DiffEffectiveTelemetryProfilesRequestFieldTenantId        = "tenant_id"
+
+	// Fields and Edges constants for "DiffEffectiveTelemetryProfilesResponse"
+	DiffEffectiveTelemetryProfilesResponseEdgeChanges = "changes"
+
 	// Fields and Edges constants for "GetTreeHierarchyRequest"
 	GetTreeHierarchyRequestFieldClientUuid = "client_uuid"
 	GetTreeHierarchyRequestFieldFilter     = "filter"
@@ -132,6 +172,16 @@ const (
 	// Fields and Edges constants for "GetSitesPerRegionResponse"
 	GetSitesPerRegionResponseEdgeRegions = "regions"
 
+	// Fields and Edges constants for "GetFleetStatisticsRequest"
+	GetFleetStatisticsRequestFieldClientUuid = "client_uuid"
+	GetFleetStatisticsRequestFieldDimensions = "dimensions"
+	GetFleetStatisticsRequestFieldFilter     = "filter"
+	GetFleetStatisticsRequestFieldTenantId   = "tenant_id"
+
+	// Fields and Edges constants for "GetFleetStatisticsResponse"
+	GetFleetStatisticsResponseFieldTotalHosts = "total_hosts"
+	GetFleetStatisticsResponseEdgeStatistics  = "statistics"
+
 	// Fields and Edges constants for "DeleteAllResourcesRequest"
 	DeleteAllResourcesRequestFieldClientUuid   = "client_uuid"
 	DeleteAllResourcesRequestFieldResourceKind = "resource_kind"
@@ -33,6 +33,9 @@ type InventoryServiceClient interface {
 	CreateResource(ctx context.Context, in *CreateResourceRequest, opts ...grpc.CallOption) (*Resource, error)
 	// Find resource IDs given criteria.
 	FindResources(ctx context.Context, in *FindResourcesRequest, opts ...grpc.CallOption) (*FindResourcesResponse, error)
+	// Full-text search of the resources of the tenant, returning the hits ranked by relevance.
+	// See SearchResourcesRequest for the searchable resource kinds and fields.
+	SearchResources(ctx context.Context, in *SearchResourcesRequest, opts ...grpc.CallOption) (*SearchResourcesResponse, error)
 	// Get information about a single resource given resource ID.
 	GetResource(ctx context.Context, in *GetResourceRequest, opts ...grpc.CallOption) (*GetResourceResponse, error)
 	// Update a resource with a given ID, returning the updated resource.
@@ -46,6 +49,12 @@ type InventoryServiceClient interface {
 	ListResources(ctx context.Context, in *ListResourcesRequest, opts ...grpc.CallOption) (*ListResourcesResponse, error)
 	// Custom RPC for Telemetry: Lists the inherited telemetry given a site, instance or region ID.
 	ListInheritedTelemetryProfiles(ctx context.Context, in *ListInheritedTelemetryProfilesRequest, opts ...grpc.CallOption) (*ListInheritedTelemetryProfilesResponse, error)
+	// Custom RPC for Telemetry: Returns the effective telemetry profiles of the given instances, resolved with
+	// TELEMETRY_INHERITANCE_MODE_OVERRIDE semantics. Every returned profile carries its provenance.
+	GetEffectiveTelemetryProfiles(ctx context.Context, in *GetEffectiveTelemetryProfilesRequest, opts ...grpc.CallOption) (*GetEffectiveTelemetryProfilesResponse, error)
+	// Custom RPC for Telemetry: Returns the changes in the effective telemetry profiles of the affected instances if the
+	// given telemetry profile was added or removed. Nothing is persisted.
+	DiffEffectiveTelemetryProfiles(ctx context.Context, in *DiffEffectiveTelemetryProfilesRequest, opts ...grpc.CallOption) (*DiffEffectiveTelemetryProfilesResponse, error)
 	// Returns the upstream tree hierarchy given the resource ID in the request.
 	// The response contains a list of adjacent nodes, from which the tree can be reconstructed.
 	GetTreeHierarchy(ctx context.Context, in *GetTreeHierarchyRequest, opts ...grpc.CallOption) (*GetTreeHierarchyResponse, error)
@@ -54,6 +63,9 @@ type InventoryServiceClient interface {
 	// The sites under a region account for all the sites under its child regions recursively, respecting the max-depth
 	// of parent relationships among regions.
 	GetSitesPerRegion(ctx context.Context, in *GetSitesPerRegionRequest, opts ...grpc.CallOption) (*GetSitesPerRegionResponse, error)
+	// Returns statistics of the hosts of the tenant: the number of hosts, optionally restricted by a filter, and their
+	// breakdown along the requested dimensions. Each dimension is computed with a single grouped query.
+	GetFleetStatistics(ctx context.Context, in *GetFleetStatisticsRequest, opts ...grpc.CallOption) (*GetFleetStatisticsResponse, error)
 	// Deletes all resources of given kind for tenant.
 	DeleteAllResources(ctx context.Context, in *DeleteAllResourcesRequest, opts ...grpc.CallOption) (*DeleteAllResourcesResponse, error)
 	// Custom RPC to establish clients heartbeat and subscription verification.
@@ -127,6 +139,15 @@ func (c *inventoryServiceClient) FindResources(ctx context.Context, in *FindReso
 	return out, nil
 }
 
+func (c *inventoryServiceClient) SearchResources(ctx context.Context, in *SearchResourcesRequest, opts ...grpc.CallOption) (*SearchResourcesResponse, error) {
+	out := new(SearchResourcesResponse)
+	err := c.cc.Invoke(ctx, "/inventory.v1.InventoryService/SearchResources", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *inventoryServiceClient) GetResource(ctx context.Context, in *GetResourceRequest, opts ...grpc.CallOption) (*GetResourceResponse, error) {
 	out := new(GetResourceResponse)
 	err := c.cc.Invoke(ctx, "/inventory.v1.InventoryService/GetResource", in, out, opts...)
@@ -172,6 +193,24 @@ func (c *inventoryServiceClient) ListInheritedTelemetryProfiles(ctx context.Cont
 	return out, nil
 }
 
+func (c *inventoryServiceClient) GetEffectiveTelemetryProfiles(ctx context.Context, in *GetEffectiveTelemetryProfilesRequest, opts ...grpc.CallOption) (*GetEffectiveTelemetryProfilesResponse, error) {
+	out := new(GetEffectiveTelemetryProfilesResponse)
+	err := c.cc.Invoke(ctx, "/inventory.v1.InventoryService/GetEffectiveTelemetryProfiles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) DiffEffectiveTelemetryProfiles(ctx context.Context, in *DiffEffectiveTelemetryProfilesRequest, opts ...grpc.CallOption) (*DiffEffectiveTelemetryProfilesResponse, error) {
+	out := new(DiffEffectiveTelemetryProfilesResponse)
+	err := c.cc.Invoke(ctx, "/inventory.v1.InventoryService/DiffEffectiveTelemetryProfiles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *inventoryServiceClient) GetTreeHierarchy(ctx context.Context, in *GetTreeHierarchyRequest, opts ...grpc.CallOption) (*GetTreeHierarchyResponse, error) {
 	out := new(GetTreeHierarchyResponse)
 	err := c.cc.Invoke(ctx, "/inventory.v1.InventoryService/GetTreeHierarchy", in, out, opts...)
@@ -190,6 +229,15 @@ func (c *inventoryServiceClient) GetSitesPerRegion(ctx context.Context, in *GetS
 	return out, nil
 }
 
+func (c *inventoryServiceClient) GetFleetStatistics(ctx context.Context, in *GetFleetStatisticsRequest, opts ...grpc.CallOption) (*GetFleetStatisticsResponse, error) {
+	out := new(GetFleetStatisticsResponse)
+	err := c.cc.Invoke(ctx, "/inventory.v1.InventoryService/GetFleetStatistics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *inventoryServiceClient) DeleteAllResources(ctx context.Context, in *DeleteAllResourcesRequest, opts ...grpc.CallOption) (*DeleteAllResourcesResponse, error) {
 	out := new(DeleteAllResourcesResponse)
 	err := c.cc.Invoke(ctx, "/inventory.v1.InventoryService/DeleteAllResources", in, out, opts...)
@@ -223,6 +271,9 @@ type InventoryServiceServer interface {
 	CreateResource(context.Context, *CreateResourceRequest) (*Resource, error)
 	// Find resource IDs given criteria.
 	FindResources(context.Context, *FindResourcesRequest) (*FindResourcesResponse, error)
+	// Full-text search of the resources of the tenant, returning the hits ranked by relevance.
+	// See SearchResourcesRequest for the searchable resource kinds and fields.
+	SearchResources(context.Context, *SearchResourcesRequest) (*SearchResourcesResponse, error)
 	// Get information about a single resource given resource ID.
 	GetResource(context.Context, *GetResourceRequest) (*GetResourceResponse, error)
 	// Update a resource with a given ID, returning the updated resource.
@@ -236,6 +287,12 @@ type InventoryServiceServer interface {
 	ListResources(context.Context, *ListResourcesRequest) (*ListResourcesResponse, error)
 	// Custom RPC for Telemetry: Lists the inherited telemetry given a site, instance or region ID.
 	ListInheritedTelemetryProfiles(context.Context, *ListInheritedTelemetryProfilesRequest) (*ListInheritedTelemetryProfilesResponse, error)
+	// Custom RPC for Telemetry: Returns the effective telemetry profiles of the given instances, resolved with
+	// TELEMETRY_INHERITANCE_MODE_OVERRIDE semantics. Every returned profile carries its provenance.
+	GetEffectiveTelemetryProfiles(context.Context, *GetEffectiveTelemetryProfilesRequest) (*GetEffectiveTelemetryProfilesResponse, error)
+	// Custom RPC for Telemetry: Returns the changes in the effective telemetry profiles of the affected instances if the
+	// given telemetry profile was added or removed. Nothing is persisted.
+	DiffEffectiveTelemetryProfiles(context.Context, *DiffEffectiveTelemetryProfilesRequest) (*DiffEffectiveTelemetryProfilesResponse, error)
 	// Returns the upstream tree hierarchy given the resource ID in the request.
 	// The response contains a list of adjacent nodes, from which the tree can be reconstructed.
 	GetTreeHierarchy(context.Context, *GetTreeHierarchyRequest) (*GetTreeHierarchyResponse, error)
@@ -244,6 +301,9 @@ type InventoryServiceServer interface {
 	// The sites under a region account for all the sites under its child regions recursively, respecting the max-depth
 	// of parent relationships among regions.
 	GetSitesPerRegion(context.Context, *GetSitesPerRegionRequest) (*GetSitesPerRegionResponse, error)
+	// Returns statistics of the hosts of the tenant: the number of hosts, optionally restricted by a filter, and their
+	// breakdown along the requested dimensions. Each dimension is computed with a single grouped query.
+	GetFleetStatistics(context.Context, *GetFleetStatisticsRequest) (*GetFleetStatisticsResponse, error)
 	// Deletes all resources of given kind for tenant.
 	DeleteAllResources(context.Context, *DeleteAllResourcesRequest) (*DeleteAllResourcesResponse, error)
 	// Custom RPC to establish clients heartbeat and subscription verification.
@@ -266,6 +326,9 @@ func (UnimplementedInventoryServiceServer) CreateResource(context.Context, *Crea
 func (UnimplementedInventoryServiceServer) FindResources(context.Context, *FindResourcesRequest) (*FindResourcesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FindResources not implemented")
 }
+func (UnimplementedInventoryServiceServer) SearchResources(context.Context, *SearchResourcesRequest) (*SearchResourcesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchResources not implemented")
+}
 func (UnimplementedInventoryServiceServer) GetResource(context.Context, *GetResourceRequest) (*GetResourceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetResource not implemented")
 }
@@ -281,12 +344,21 @@ func (UnimplementedInventoryServiceServer) ListResources(context.Context, *ListR
 func (UnimplementedInventoryServiceServer) ListInheritedTelemetryProfiles(context.Context, *ListInheritedTelemetryProfilesRequest) (*ListInheritedTelemetryProfilesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListInheritedTelemetryProfiles not implemented")
 }
+func (UnimplementedInventoryServiceServer) GetEffectiveTelemetryProfiles(context.Context, *GetEffectiveTelemetryProfilesRequest) (*GetEffectiveTelemetryProfilesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEffectiveTelemetryProfiles not implemented")
+}
+func (UnimplementedInventoryServiceServer) DiffEffectiveTelemetryProfiles(context.Context, *DiffEffectiveTelemetryProfilesRequest) (*DiffEffectiveTelemetryProfilesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffEffectiveTelemetryProfiles not implemented")
+}
 func (UnimplementedInventoryServiceServer) GetTreeHierarchy(context.Context, *GetTreeHierarchyRequest) (*GetTreeHierarchyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTreeHierarchy not implemented")
 }
 func (UnimplementedInventoryServiceServer) GetSitesPerRegion(context.Context, *GetSitesPerRegionRequest) (*GetSitesPerRegionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSitesPerRegion not implemented")
 }
+func (UnimplementedInventoryServiceServer) GetFleetStatistics(context.Context, *GetFleetStatisticsRequest) (*GetFleetStatisticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFleetStatistics not implemented")
+}
 func (UnimplementedInventoryServiceServer) DeleteAllResources(context.Context, *DeleteAllResourcesRequest) (*DeleteAllResourcesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteAllResources not implemented")
 }
@@ -380,6 +452,24 @@ func _InventoryService_FindResources_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _InventoryService_SearchResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchResourcesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).SearchResources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inventory.v1.InventoryService/SearchResources",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).SearchResources(ctx, req.(*SearchResourcesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _InventoryService_GetResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetResourceRequest)
 	if err := dec(in); err != nil {
@@ -470,6 +560,42 @@ func _InventoryService_ListInheritedTelemetryProfiles_Handler(srv interface{}, c
 	return interceptor(ctx, in, info, handler)
 }
 
+func _InventoryService_GetEffectiveTelemetryProfiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEffectiveTelemetryProfilesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).GetEffectiveTelemetryProfiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inventory.v1.InventoryService/GetEffectiveTelemetryProfiles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).GetEffectiveTelemetryProfiles(ctx, req.(*GetEffectiveTelemetryProfilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_DiffEffectiveTelemetryProfiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffEffectiveTelemetryProfilesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).DiffEffectiveTelemetryProfiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inventory.v1.InventoryService/DiffEffectiveTelemetryProfiles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).DiffEffectiveTelemetryProfiles(ctx, req.(*DiffEffectiveTelemetryProfilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _InventoryService_GetTreeHierarchy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetTreeHierarchyRequest)
 	if err := dec(in); err != nil {
@@ -506,6 +632,24 @@ func _InventoryService_GetSitesPerRegion_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _InventoryService_GetFleetStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFleetStatisticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).GetFleetStatistics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inventory.v1.InventoryService/GetFleetStatistics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).GetFleetStatistics(ctx, req.(*GetFleetStatisticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _InventoryService_DeleteAllResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteAllResourcesRequest)
 	if err := dec(in); err != nil {
@@ -561,6 +705,10 @@ var InventoryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "FindResources",
 			Handler:    _InventoryService_FindResources_Handler,
 		},
+		{
+			MethodName: "SearchResources",
+			Handler:    _InventoryService_SearchResources_Handler,
+		},
 		{
 			MethodName: "GetResource",
 			Handler:    _InventoryService_GetResource_Handler,
@@ -581,6 +729,14 @@ var InventoryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListInheritedTelemetryProfiles",
 			Handler:    _InventoryService_ListInheritedTelemetryProfiles_Handler,
 		},
+		{
+			MethodName: "GetEffectiveTelemetryProfiles",
+			Handler:    _InventoryService_GetEffectiveTelemetryProfiles_Handler,
+		},
+		{
+			MethodName: "DiffEffectiveTelemetryProfiles",
+			Handler:    _InventoryService_DiffEffectiveTelemetryProfiles_Handler,
+		},
 		{
 			MethodName: "GetTreeHierarchy",
 			Handler:    _InventoryService_GetTreeHierarchy_Handler,
@@ -589,6 +745,10 @@ var InventoryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetSitesPerRegion",
 			Handler:    _InventoryService_GetSitesPerRegion_Handler,
 		},
+		{
+			MethodName: "GetFleetStatistics",
+			Handler:    _InventoryService_GetFleetStatistics_Handler,
+		},
 		{
 			MethodName: "DeleteAllResources",
 			Handler:    _InventoryService_DeleteAllResources_Handler,
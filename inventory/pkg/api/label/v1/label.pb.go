@@ -0,0 +1,197 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.30.0
+// 	protoc        (unknown)
+// source: label/v1/label.proto
+
+package labelv1
+
+import (
+	_ "entgo.io/contrib/entproto/cmd/protoc-gen-ent/options/ent"
+	_ "github.com/open-edge-platform/infra-core/inventory/v2/pkg/infrainv"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// A single key/value label of a resource. Labels are derived from the metadata of the Host, Site, Region and OU
+// resources and kept in sync by the inventory on every write; they are not exposed through the Inventory API and only
+// exist to efficiently evaluate label selectors and inherited labels in the database.
+type ResourceLabel struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Resource ID of the labeled resource.
+	ResourceId string `protobuf:"bytes,1,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	// Label key.
+	Key string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// Label value.
+	Value string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	// Tenant Identifier.
+	TenantId string `protobuf:"bytes,100,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+}
+
+func (x *ResourceLabel) Reset() {
+	*x = ResourceLabel{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_label_v1_label_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResourceLabel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceLabel) ProtoMessage() {}
+
+func (x *ResourceLabel) ProtoReflect() protoreflect.Message {
+	mi := &file_label_v1_label_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceLabel.ProtoReflect.Descriptor instead.
+func (*ResourceLabel) Descriptor() ([]byte, []int) {
+	return file_label_v1_label_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ResourceLabel) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
+	}
+	return ""
+}
+
+func (x *ResourceLabel) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ResourceLabel) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *ResourceLabel) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+var File_label_v1_label_proto protoreflect.FileDescriptor
+
+var file_label_v1_label_proto_rawDesc = []byte{
+	0x0a, 0x14, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x2e, 0x76, 0x31,
+	0x1a, 0x0e, 0x65, 0x6e, 0x74, 0x2f, 0x6f, 0x70, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x17, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x69, 0x6e, 0x76, 0x2f, 0x69, 0x6e, 0x66, 0x72, 0x61,
+	0x69, 0x6e, 0x76, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xdc, 0x01, 0x0a, 0x0d, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x29, 0x0a, 0x0b, 0x72,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x08, 0xba, 0xa6, 0x49, 0x04, 0x08, 0x00, 0x28, 0x01, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0xa6, 0x49, 0x04, 0x08, 0x00, 0x28, 0x01, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x1c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x06, 0xba, 0xa6, 0x49, 0x02, 0x08, 0x00, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x25, 0x0a, 0x09, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x64, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0xa6, 0x49, 0x04, 0x08, 0x00, 0x28, 0x01, 0x52, 0x08, 0x74,
+	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x3a, 0x3f, 0xb2, 0xf9, 0x03, 0x35, 0x0a, 0x14, 0x12,
+	0x0b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x12, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x0a, 0x0e, 0x12, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x00, 0x0a, 0x0d, 0x12, 0x09, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x00, 0xba, 0xa6, 0x49, 0x02, 0x08, 0x01, 0x42, 0x50, 0x5a, 0x4e, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x2d, 0x65, 0x64, 0x67, 0x65,
+	0x2d, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2f, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x2d,
+	0x63, 0x6f, 0x72, 0x65, 0x2f, 0x69, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x2f, 0x76,
+	0x32, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x2f,
+	0x76, 0x31, 0x3b, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_label_v1_label_proto_rawDescOnce sync.Once
+	file_label_v1_label_proto_rawDescData = file_label_v1_label_proto_rawDesc
+)
+
+func file_label_v1_label_proto_rawDescGZIP() []byte {
+	file_label_v1_label_proto_rawDescOnce.Do(func() {
+		file_label_v1_label_proto_rawDescData = protoimpl.X.CompressGZIP(file_label_v1_label_proto_rawDescData)
+	})
+	return file_label_v1_label_proto_rawDescData
+}
+
+var file_label_v1_label_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_label_v1_label_proto_goTypes = []interface{}{
+	(*ResourceLabel)(nil), // 0: label.v1.ResourceLabel
+}
+var file_label_v1_label_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_label_v1_label_proto_init() }
+func file_label_v1_label_proto_init() {
+	if File_label_v1_label_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_label_v1_label_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResourceLabel); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_label_v1_label_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_label_v1_label_proto_goTypes,
+		DependencyIndexes: file_label_v1_label_proto_depIdxs,
+		MessageInfos:      file_label_v1_label_proto_msgTypes,
+	}.Build()
+	File_label_v1_label_proto = out.File
+	file_label_v1_label_proto_rawDesc = nil
+	file_label_v1_label_proto_goTypes = nil
+	file_label_v1_label_proto_depIdxs = nil
+}
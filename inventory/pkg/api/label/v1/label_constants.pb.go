@@ -0,0 +1,13 @@
+// Code generated by protoc-gen-go-const. DO NOT EDIT.
+
+// source: label/v1/label.proto
+
+package labelv1
+
+const (
+	// Fields and Edges constants for "ResourceLabel"
+	ResourceLabelFieldResourceId = "resource_id"
+	ResourceLabelFieldKey        = "key"
+	ResourceLabelFieldValue      = "value"
+	ResourceLabelFieldTenantId   = "tenant_id"
+)
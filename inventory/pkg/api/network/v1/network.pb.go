@@ -806,7 +806,7 @@ var file_network_v1_network_proto_rawDesc = []byte{
 	0x18, 0xc9, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1f, 0xba, 0xa6, 0x49, 0x1b, 0x08, 0x00, 0x28,
 	0x00, 0x4a, 0x15, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x74, 0x67, 0x72, 0x65, 0x73, 0x12, 0x09, 0x54,
 	0x49, 0x4d, 0x45, 0x53, 0x54, 0x41, 0x4d, 0x50, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x64, 0x41, 0x74, 0x3a, 0x06, 0xba, 0xa6, 0x49, 0x02, 0x08, 0x01, 0x22, 0xd4, 0x05, 0x0a, 0x11,
+	0x64, 0x41, 0x74, 0x3a, 0x06, 0xba, 0xa6, 0x49, 0x02, 0x08, 0x01, 0x22, 0x94, 0x06, 0x0a, 0x11,
 	0x49, 0x50, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
 	0x65, 0x12, 0x47, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x26, 0xba, 0x48, 0x1d, 0xd8, 0x01, 0x01, 0x72, 0x18,
@@ -850,8 +850,12 @@ var file_network_v1_network_proto_rawDesc = []byte{
 	0x64, 0x5f, 0x61, 0x74, 0x18, 0xc9, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1f, 0xba, 0xa6, 0x49,
 	0x1b, 0x08, 0x00, 0x28, 0x00, 0x4a, 0x15, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x74, 0x67, 0x72, 0x65,
 	0x73, 0x12, 0x09, 0x54, 0x49, 0x4d, 0x45, 0x53, 0x54, 0x41, 0x4d, 0x50, 0x52, 0x09, 0x75, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x3a, 0x19, 0xb2, 0xf9, 0x03, 0x0f, 0x0a, 0x0d, 0x12,
-	0x09, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x00, 0xba, 0xa6, 0x49, 0x02,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x3a, 0x59, 0xb2, 0xf9, 0x03, 0x4f, 0x0a, 0x0d, 0x12,
+	0x09, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x00, 0x0a, 0x3e, 0x0a, 0x1e,
+	0x69, 0x70, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x72, 0x67, 0x6d, 0x12, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x00, 0x2a, 0x03, 0x47, 0x49, 0x4e, 0x32, 0x0c,
+	0x67, 0x69, 0x6e, 0x5f, 0x74, 0x72, 0x67, 0x6d, 0x5f, 0x6f, 0x70, 0x73, 0xba, 0xa6, 0x49, 0x02,
 	0x08, 0x01, 0x2a, 0x96, 0x01, 0x0a, 0x0c, 0x4e, 0x65, 0x74, 0x6c, 0x69, 0x6e, 0x6b, 0x53, 0x74,
 	0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x19, 0x4e, 0x45, 0x54, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x53,
 	0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
@@ -0,0 +1,341 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.30.0
+// 	protoc        (unknown)
+// source: rolebinding/v1/rolebinding.proto
+
+package rolebindingv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	_ "entgo.io/contrib/entproto/cmd/protoc-gen-ent/options/ent"
+	v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/location/v1"
+	v11 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/ou/v1"
+	_ "github.com/open-edge-platform/infra-core/inventory/v2/pkg/infrainv"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// The role granted by a RoleBindingResource within its scope.
+type ScopedRole int32
+
+const (
+	ScopedRole_SCOPED_ROLE_UNSPECIFIED ScopedRole = 0
+	// Read access to the resources in scope.
+	ScopedRole_SCOPED_ROLE_READ ScopedRole = 1
+	// Read and write access to the resources in scope.
+	ScopedRole_SCOPED_ROLE_READ_WRITE ScopedRole = 2
+)
+
+// Enum value maps for ScopedRole.
+var (
+	ScopedRole_name = map[int32]string{
+		0: "SCOPED_ROLE_UNSPECIFIED",
+		1: "SCOPED_ROLE_READ",
+		2: "SCOPED_ROLE_READ_WRITE",
+	}
+	ScopedRole_value = map[string]int32{
+		"SCOPED_ROLE_UNSPECIFIED": 0,
+		"SCOPED_ROLE_READ":        1,
+		"SCOPED_ROLE_READ_WRITE":  2,
+	}
+)
+
+func (x ScopedRole) Enum() *ScopedRole {
+	p := new(ScopedRole)
+	*p = x
+	return p
+}
+
+func (x ScopedRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ScopedRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_rolebinding_v1_rolebinding_proto_enumTypes[0].Descriptor()
+}
+
+func (ScopedRole) Type() protoreflect.EnumType {
+	return &file_rolebinding_v1_rolebinding_proto_enumTypes[0]
+}
+
+func (x ScopedRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ScopedRole.Descriptor instead.
+func (ScopedRole) EnumDescriptor() ([]byte, []int) {
+	return file_rolebinding_v1_rolebinding_proto_rawDescGZIP(), []int{0}
+}
+
+// Binds a principal to a role on a subtree of the location hierarchy: a region, a site or an OU, with everything below
+// it. Principals holding the scoped role of a project (`<project>_im-scoped`) only have access to the locations of
+// their bindings and to the hosts and instances located there; they can read the resources that are not located,
+// such as OSes, but not modify them.
+type RoleBindingResource struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// resource identifier
+	ResourceId string `protobuf:"bytes,1,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	// The principal the role is granted to, matched against the subject (`sub`) claim of its JWT, required.
+	Subject string `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	// The role granted in the scope, required.
+	Role ScopedRole `protobuf:"varint,3,opt,name=role,proto3,enum=rolebinding.v1.ScopedRole" json:"role,omitempty"`
+	// The scope of the binding, exactly one must be set.
+	Region *v1.RegionResource `protobuf:"bytes,10,opt,name=region,proto3" json:"region,omitempty"`
+	Site   *v1.SiteResource   `protobuf:"bytes,11,opt,name=site,proto3" json:"site,omitempty"`
+	Ou     *v11.OuResource    `protobuf:"bytes,12,opt,name=ou,proto3" json:"ou,omitempty"`
+	// Tenant Identifier.
+	TenantId string `protobuf:"bytes,100,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	// Creation timestamp
+	CreatedAt string `protobuf:"bytes,200,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt string `protobuf:"bytes,201,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // Update timestamp
+}
+
+func (x *RoleBindingResource) Reset() {
+	*x = RoleBindingResource{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rolebinding_v1_rolebinding_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RoleBindingResource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleBindingResource) ProtoMessage() {}
+
+func (x *RoleBindingResource) ProtoReflect() protoreflect.Message {
+	mi := &file_rolebinding_v1_rolebinding_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleBindingResource.ProtoReflect.Descriptor instead.
+func (*RoleBindingResource) Descriptor() ([]byte, []int) {
+	return file_rolebinding_v1_rolebinding_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RoleBindingResource) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
+	}
+	return ""
+}
+
+func (x *RoleBindingResource) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *RoleBindingResource) GetRole() ScopedRole {
+	if x != nil {
+		return x.Role
+	}
+	return ScopedRole_SCOPED_ROLE_UNSPECIFIED
+}
+
+func (x *RoleBindingResource) GetRegion() *v1.RegionResource {
+	if x != nil {
+		return x.Region
+	}
+	return nil
+}
+
+func (x *RoleBindingResource) GetSite() *v1.SiteResource {
+	if x != nil {
+		return x.Site
+	}
+	return nil
+}
+
+func (x *RoleBindingResource) GetOu() *v11.OuResource {
+	if x != nil {
+		return x.Ou
+	}
+	return nil
+}
+
+func (x *RoleBindingResource) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *RoleBindingResource) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *RoleBindingResource) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+var File_rolebinding_v1_rolebinding_proto protoreflect.FileDescriptor
+
+var file_rolebinding_v1_rolebinding_proto_rawDesc = []byte{
+	0x0a, 0x20, 0x72, 0x6f, 0x6c, 0x65, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x31,
+	0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x0e, 0x72, 0x6f, 0x6c, 0x65, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x2e,
+	0x76, 0x31, 0x1a, 0x1b, 0x62, 0x75, 0x66, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x0e, 0x65, 0x6e, 0x74, 0x2f, 0x6f, 0x70, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x17, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x69, 0x6e, 0x76, 0x2f, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x69,
+	0x6e, 0x76, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1a, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0e, 0x6f, 0x75, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x75, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd8, 0x04, 0x0a, 0x13, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x69, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x4c, 0x0a, 0x0b,
+	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x2b, 0xba, 0x48, 0x22, 0xd8, 0x01, 0x01, 0x72, 0x1d, 0x28, 0x14, 0x32, 0x19, 0x5e,
+	0x72, 0x6f, 0x6c, 0x65, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x2d, 0x5b, 0x30, 0x2d, 0x39,
+	0x61, 0x2d, 0x66, 0x5d, 0x7b, 0x38, 0x7d, 0x24, 0xba, 0xa6, 0x49, 0x02, 0x18, 0x01, 0x52, 0x0a,
+	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x07, 0x73, 0x75,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0xba, 0x48, 0x08,
+	0xd8, 0x01, 0x01, 0x72, 0x03, 0x28, 0x80, 0x02, 0xba, 0xa6, 0x49, 0x02, 0x08, 0x00, 0x52, 0x07,
+	0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x3e, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x72, 0x6f, 0x6c, 0x65, 0x62, 0x69, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x64, 0x52, 0x6f, 0x6c,
+	0x65, 0x42, 0x0e, 0xba, 0x48, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0xba, 0xa6, 0x49, 0x02, 0x08,
+	0x01, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x3b, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f,
+	0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x42, 0x06, 0xc2, 0xa6, 0x49, 0x02, 0x08, 0x01, 0x52, 0x06, 0x72, 0x65,
+	0x67, 0x69, 0x6f, 0x6e, 0x12, 0x35, 0x0a, 0x04, 0x73, 0x69, 0x74, 0x65, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x06, 0xc2,
+	0xa6, 0x49, 0x02, 0x08, 0x01, 0x52, 0x04, 0x73, 0x69, 0x74, 0x65, 0x12, 0x29, 0x0a, 0x02, 0x6f,
+	0x75, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6f, 0x75, 0x2e, 0x76, 0x31, 0x2e,
+	0x4f, 0x75, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x06, 0xc2, 0xa6, 0x49, 0x02,
+	0x08, 0x01, 0x52, 0x02, 0x6f, 0x75, 0x12, 0x32, 0x0a, 0x09, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x42, 0x15, 0xba, 0x48, 0x0a, 0xd8, 0x01,
+	0x01, 0x72, 0x05, 0x28, 0x24, 0xb0, 0x01, 0x01, 0xba, 0xa6, 0x49, 0x04, 0x08, 0x00, 0x28, 0x01,
+	0x52, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x3f, 0x0a, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0xc8, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x1f, 0xba, 0xa6, 0x49, 0x1b, 0x08, 0x00, 0x28, 0x01, 0x4a, 0x15, 0x0a, 0x08, 0x70, 0x6f, 0x73,
+	0x74, 0x67, 0x72, 0x65, 0x73, 0x12, 0x09, 0x54, 0x49, 0x4d, 0x45, 0x53, 0x54, 0x41, 0x4d, 0x50,
+	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3f, 0x0a, 0x0a, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0xc9, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x1f, 0xba, 0xa6, 0x49, 0x1b, 0x08, 0x00, 0x28, 0x00, 0x4a, 0x15, 0x0a, 0x08, 0x70, 0x6f,
+	0x73, 0x74, 0x67, 0x72, 0x65, 0x73, 0x12, 0x09, 0x54, 0x49, 0x4d, 0x45, 0x53, 0x54, 0x41, 0x4d,
+	0x50, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x3a, 0x31, 0xb2, 0xf9,
+	0x03, 0x27, 0x0a, 0x16, 0x12, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x09, 0x74,
+	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x00, 0x0a, 0x0d, 0x12, 0x09, 0x74, 0x65,
+	0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x00, 0xba, 0xa6, 0x49, 0x02, 0x08, 0x01, 0x2a,
+	0x5b, 0x0a, 0x0a, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x64, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x1b, 0x0a,
+	0x17, 0x53, 0x43, 0x4f, 0x50, 0x45, 0x44, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x53, 0x43,
+	0x4f, 0x50, 0x45, 0x44, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0x01,
+	0x12, 0x1a, 0x0a, 0x16, 0x53, 0x43, 0x4f, 0x50, 0x45, 0x44, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f,
+	0x52, 0x45, 0x41, 0x44, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0x02, 0x42, 0x5c, 0x5a, 0x5a,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x2d,
+	0x65, 0x64, 0x67, 0x65, 0x2d, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2f, 0x69, 0x6e,
+	0x66, 0x72, 0x61, 0x2d, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x69, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f,
+	0x72, 0x79, 0x2f, 0x76, 0x32, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x6f,
+	0x6c, 0x65, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x31, 0x3b, 0x72, 0x6f, 0x6c,
+	0x65, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_rolebinding_v1_rolebinding_proto_rawDescOnce sync.Once
+	file_rolebinding_v1_rolebinding_proto_rawDescData = file_rolebinding_v1_rolebinding_proto_rawDesc
+)
+
+func file_rolebinding_v1_rolebinding_proto_rawDescGZIP() []byte {
+	file_rolebinding_v1_rolebinding_proto_rawDescOnce.Do(func() {
+		file_rolebinding_v1_rolebinding_proto_rawDescData = protoimpl.X.CompressGZIP(file_rolebinding_v1_rolebinding_proto_rawDescData)
+	})
+	return file_rolebinding_v1_rolebinding_proto_rawDescData
+}
+
+var file_rolebinding_v1_rolebinding_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_rolebinding_v1_rolebinding_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_rolebinding_v1_rolebinding_proto_goTypes = []interface{}{
+	(ScopedRole)(0),             // 0: rolebinding.v1.ScopedRole
+	(*RoleBindingResource)(nil), // 1: rolebinding.v1.RoleBindingResource
+	(*v1.RegionResource)(nil),   // 2: location.v1.RegionResource
+	(*v1.SiteResource)(nil),     // 3: location.v1.SiteResource
+	(*v11.OuResource)(nil),      // 4: ou.v1.OuResource
+}
+var file_rolebinding_v1_rolebinding_proto_depIdxs = []int32{
+	0, // 0: rolebinding.v1.RoleBindingResource.role:type_name -> rolebinding.v1.ScopedRole
+	2, // 1: rolebinding.v1.RoleBindingResource.region:type_name -> location.v1.RegionResource
+	3, // 2: rolebinding.v1.RoleBindingResource.site:type_name -> location.v1.SiteResource
+	4, // 3: rolebinding.v1.RoleBindingResource.ou:type_name -> ou.v1.OuResource
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_rolebinding_v1_rolebinding_proto_init() }
+func file_rolebinding_v1_rolebinding_proto_init() {
+	if File_rolebinding_v1_rolebinding_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_rolebinding_v1_rolebinding_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoleBindingResource); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rolebinding_v1_rolebinding_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rolebinding_v1_rolebinding_proto_goTypes,
+		DependencyIndexes: file_rolebinding_v1_rolebinding_proto_depIdxs,
+		EnumInfos:         file_rolebinding_v1_rolebinding_proto_enumTypes,
+		MessageInfos:      file_rolebinding_v1_rolebinding_proto_msgTypes,
+	}.Build()
+	File_rolebinding_v1_rolebinding_proto = out.File
+	file_rolebinding_v1_rolebinding_proto_rawDesc = nil
+	file_rolebinding_v1_rolebinding_proto_goTypes = nil
+	file_rolebinding_v1_rolebinding_proto_depIdxs = nil
+}
@@ -0,0 +1,18 @@
+// Code generated by protoc-gen-go-const. DO NOT EDIT.
+
+// source: rolebinding/v1/rolebinding.proto
+
+package rolebindingv1
+
+const (
+	// Fields and Edges constants for "RoleBindingResource"
+	RoleBindingResourceFieldResourceId = "resource_id"
+	RoleBindingResourceFieldSubject    = "subject"
+	RoleBindingResourceFieldRole       = "role"
+	RoleBindingResourceEdgeRegion      = "region"
+	RoleBindingResourceEdgeSite        = "site"
+	RoleBindingResourceEdgeOu          = "ou"
+	RoleBindingResourceFieldTenantId   = "tenant_id"
+	RoleBindingResourceFieldCreatedAt  = "created_at"
+	RoleBindingResourceFieldUpdatedAt  = "updated_at"
+)
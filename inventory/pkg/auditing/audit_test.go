@@ -5,6 +5,7 @@ package auditing_test
 
 import (
 	"bytes"
+	"context"
 	"flag"
 	"fmt"
 	"net/http"
@@ -16,7 +17,12 @@ import (
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 
+	computev1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/compute/v1"
+	inv_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/inventory/v1"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/auditing"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/logging"
 )
@@ -92,6 +98,33 @@ func TestAuditingMiddleware(t *testing.T) {
 	}
 }
 
+func TestAuditingRedaction(t *testing.T) {
+	buf := new(bytes.Buffer)
+	auditing.SetupLogger(logging.GetLoggerWithCustomWriter("Audit", &logging.CustomWriter{Buf: buf}))
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		auditing.AUTHORIZATIONLOWER, "Bearer token", auditing.NAMELOWER, "testname"))
+	host := &computev1.HostResource{ResourceId: "host-12345678", BmcPassword: "bmcsecret"}
+	req := &inv_v1.UpdateResourceRequest{
+		ResourceId: host.GetResourceId(),
+		Resource:   &inv_v1.Resource{Resource: &inv_v1.Resource_Host{Host: host}},
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: "/inventory.v1.InventoryService/UpdateResource"}
+	_, err := auditing.GrpcInterceptor(ctx, req, info, func(context.Context, any) (any, error) {
+		return host, nil
+	})
+	require.NoError(t, err)
+
+	capturedOutput := buf.String()
+	assert.Contains(t, capturedOutput, "host-12345678")
+	assert.Contains(t, capturedOutput, "testname")
+	assert.Equal(t, 4, strings.Count(capturedOutput, logging.REDACTED))
+	assert.NotContains(t, capturedOutput, "bmcsecret")
+	assert.NotContains(t, capturedOutput, "Bearer token")
+	// The request and response handled are not altered.
+	assert.Equal(t, "bmcsecret", host.GetBmcPassword())
+}
+
 // To create a request with an authorization header.
 func createRequestWithAuthHeader(authScheme, authToken, body string) *http.Request {
 	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
@@ -5,6 +5,7 @@ package auditing
 
 import (
 	"context"
+	"fmt"
 	"strings"
 
 	"github.com/golang-jwt/jwt/v5"
@@ -15,6 +16,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/logging"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/tenant"
 	"github.com/open-edge-platform/orch-library/go/pkg/auth"
 	grpcauth "github.com/open-edge-platform/orch-library/go/pkg/grpc/auth"
 )
@@ -44,7 +46,7 @@ func GrpcInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServe
 	resp, err := handler(ctx, req)
 
 	// Log the response and any error
-	createPostRequestAuditLog(ctx, info.FullMethod, resp, err)
+	createPostRequestAuditLog(ctx, info.FullMethod, req, resp, err)
 
 	return resp, err
 }
@@ -54,42 +56,81 @@ func RestEchoMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
 	return func(c echo.Context) error {
 		// TODO worth considering data when POST/PATCH
 		usr, email := extractUserAndEmailfromJWT(c)
-		zlog.InfraAuditEvent().InfraAuditOperation(c.Request().Method).InfraAuditPath(c.Request().URL.String()).
-			InfraAuditUsr(usr).InfraAuditEmail(email).Info().
-			Msgf("Northbound API Operation")
+		tenantID, _ := tenant.GetTenantIDFromContext(c.Request().Context())
+		chains.log(zlog, Record{
+			Tenant:    tenantID,
+			Operation: c.Request().Method,
+			Path:      c.Request().URL.String(),
+			User:      usr,
+			Email:     email,
+			Message:   "Northbound API Operation",
+		})
 		return next(c)
 	}
 }
 
+// tenantOf returns the tenant of a gRPC call, from its context or else its request.
+func tenantOf(ctx context.Context, req interface{}) string {
+	if tenantID, ok := tenant.GetTenantIDFromContext(ctx); ok {
+		return tenantID
+	}
+	if req, ok := req.(interface{ GetTenantId() string }); ok {
+		return req.GetTenantId()
+	}
+	return ""
+}
+
+// redactMetadata returns a copy of the metadata of a call with its credentials redacted.
+func redactMetadata(md metadata.MD) metadata.MD {
+	redacted := md.Copy()
+	if len(redacted.Get(AUTHORIZATIONLOWER)) > 0 {
+		redacted.Set(AUTHORIZATIONLOWER, logging.REDACTED)
+	}
+	return redacted
+}
+
 // createPreRequestAuditLog generate an audit log with user and operation done based on the existing gRPC call.
 func createPreRequestAuditLog(ctx context.Context, fullMethod string, req interface{}, message string) {
 	md, existing := metadata.FromIncomingContext(ctx)
 	user := getValueFromMetadata(existing, md, NAME)
 	email := getValueFromMetadata(existing, md, EMAIL)
-	zlog.InfraAuditEvent().InfraAuditOperation(fullMethod).InfraAuditUsr(strings.Join(user, ",")).
-		InfraAuditEmail(strings.Join(email, ",")).InfraAuditRequest(req).Info().Msgf(message+" %v", md)
+	chains.log(zlog, Record{
+		Tenant:    tenantOf(ctx, req),
+		Operation: fullMethod,
+		User:      strings.Join(user, ","),
+		Email:     strings.Join(email, ","),
+		Request:   fmt.Sprintf("%v", logging.Redact(req)),
+		Message:   fmt.Sprintf(message+" %v", redactMetadata(md)),
+	})
 }
 
 // createPostRequestAuditLog generate an audit log with user and operation done based on result of the gRPC call.
-func createPostRequestAuditLog(ctx context.Context, fullMethod string, resp interface{}, err error) {
+func createPostRequestAuditLog(ctx context.Context, fullMethod string, req, resp interface{}, err error) {
 	md, existing := metadata.FromIncomingContext(ctx)
 	user := getValueFromMetadata(existing, md, NAME)
 	email := getValueFromMetadata(existing, md, EMAIL)
 
+	rec := Record{
+		Tenant:    tenantOf(ctx, req),
+		Operation: fullMethod,
+		User:      strings.Join(user, ","),
+		Email:     strings.Join(email, ","),
+		Response:  fmt.Sprintf("%v", logging.Redact(resp)),
+		Message:   fmt.Sprintf("Operation result %v", redactMetadata(md)),
+	}
 	if err != nil {
 		st, _ := status.FromError(err)
-		zlog.InfraAuditEvent().InfraAuditOperation(fullMethod).InfraAuditUsr(strings.Join(user, ",")).
-			InfraAuditEmail(strings.Join(email, ",")).InfraAuditResponse(resp).InfraAuditError(err).
-			InfraAuditStatus(st.String()).Info().Msgf("Operation result %v", md)
-	} else {
-		zlog.InfraAuditEvent().InfraAuditOperation(fullMethod).InfraAuditUsr(strings.Join(user, ",")).
-			InfraAuditEmail(strings.Join(email, ",")).InfraAuditResponse(resp).Info().Msgf("Operation result %v", md)
+		rec.Error = err.Error()
+		rec.Status = st.String()
 	}
+	chains.log(zlog, rec)
 }
 
 func SetupLogger(logger logging.InfraLogger) {
 	// Set up zerolog with the custom writer
 	zlog = logger
+	// The records logged to the new logger start new chains.
+	chains = newAuditChains()
 }
 
 // TODO ITEP-2566 when moving before authentication we can't use the context
@@ -0,0 +1,314 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package auditing
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/logging"
+)
+
+const (
+	// AUDITSEAL identifies the seals of the audit chains in the logs.
+	AUDITSEAL = "auditseal"
+
+	DefaultSealInterval = time.Minute
+
+	chainIDBytes = 8
+	keyIDBytes   = 8
+)
+
+// Record is the content of an audit record that its hash covers. The JSON names of its fields are the ones of the
+// log entry of the record, which allows verifying exported logs. Each record includes the hash of the previous
+// record of its chain, the records of a tenant logged by a process forming a chain. The first record of a chain
+// links to the last seal of the previous chain of its tenant, if persisted by the previous process: it holds the
+// previous chain and the hash of its last sealed record.
+type Record struct {
+	// Chain identifies the process that logged the record, as each process chains the records it logs.
+	Chain     string `json:"audit_chain"`
+	Tenant    string `json:"audit_tenant"`
+	Seq       uint64 `json:"audit_seq"`
+	Time      string `json:"audit_time"`
+	Operation string `json:"operation,omitempty"`
+	Path      string `json:"path,omitempty"`
+	User      string `json:"user,omitempty"`
+	Email     string `json:"email,omitempty"`
+	Request   string `json:"request,omitempty"`
+	Response  string `json:"response,omitempty"`
+	Error     string `json:"error,omitempty"`
+	Status    string `json:"status,omitempty"`
+	Message   string `json:"message"`
+	PrevChain string `json:"audit_prev_chain,omitempty"`
+	PrevHash  string `json:"audit_prev_hash"`
+}
+
+// Hash returns the hash of the record, hex encoded.
+func (r *Record) Hash() string {
+	encoded, err := json.Marshal(r)
+	if err != nil {
+		// Records only hold strings and integers.
+		panic(err)
+	}
+	sum := sha256.Sum256(encoded)
+	return hex.EncodeToString(sum[:])
+}
+
+// sealPayload returns what the seal of the head of a chain signs.
+func sealPayload(chain, tenant string, seq uint64, hash string) []byte {
+	return []byte(fmt.Sprintf("%s\n%s\n%d\n%s", chain, tenant, seq, hash))
+}
+
+// KeyID identifies the key sealing the audit chains, to tell which public key verifies the seals.
+func KeyID(key ed25519.PublicKey) string {
+	sum := sha256.Sum256(key)
+	return hex.EncodeToString(sum[:keyIDBytes])
+}
+
+type chainHead struct {
+	seq    uint64
+	hash   string
+	sealed uint64
+	// prevChain is the previous chain of the tenant, linked by the first record of the chain.
+	prevChain string
+}
+
+// sealedHead is the last seal of a chain, persisted to link the chains of successive processes.
+type sealedHead struct {
+	Chain     string `json:"chain"`
+	Seq       uint64 `json:"seq"`
+	Hash      string `json:"hash"`
+	Signature string `json:"signature"`
+}
+
+// auditChains chains the records logged by this process.
+type auditChains struct {
+	mu sync.Mutex
+	id string
+	// heads are the last records of the chains, by tenant.
+	heads map[string]*chainHead
+	// seals are the last seals of the chains of this process or, for the tenants without records yet, of the
+	// previous processes, by tenant. They are persisted to headPath if set.
+	seals    map[string]sealedHead
+	headPath string
+}
+
+var chains = newAuditChains()
+
+func newAuditChains() *auditChains {
+	id := make([]byte, chainIDBytes)
+	if _, err := rand.Read(id); err != nil {
+		panic(err)
+	}
+	return &auditChains{
+		id:    hex.EncodeToString(id),
+		heads: make(map[string]*chainHead),
+		seals: make(map[string]sealedHead),
+	}
+}
+
+// loadHeads loads the last seals of the previous chains persisted at path, so that the chains of this process link
+// to them. The seals that the key did not sign are ignored, and so is the file if it does not exist yet.
+func (c *auditChains) loadHeads(path string, key ed25519.PublicKey) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.headPath = path
+	content, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err)
+	}
+	var seals map[string]sealedHead
+	if err := json.Unmarshal(content, &seals); err != nil {
+		return errors.Wrap(err)
+	}
+	for tenant, seal := range seals {
+		signature, err := base64.StdEncoding.DecodeString(seal.Signature)
+		if err != nil || !ed25519.Verify(key, sealPayload(seal.Chain, tenant, seal.Seq, seal.Hash), signature) {
+			zlog.InfraSec().InfraError("invalid persisted seal of chain %s, tenant %q", seal.Chain, tenant).Msg("")
+			continue
+		}
+		if _, ok := c.heads[tenant]; !ok {
+			c.seals[tenant] = seal
+		}
+	}
+	return nil
+}
+
+// saveHeads persists the last seals of the chains, replacing the file atomically.
+func (c *auditChains) saveHeads() error {
+	content, err := json.Marshal(c.seals)
+	if err != nil {
+		return errors.Wrap(err)
+	}
+	tmp := c.headPath + ".tmp"
+	if err := os.WriteFile(tmp, content, 0o600); err != nil {
+		return errors.Wrap(err)
+	}
+	return errors.Wrap(os.Rename(tmp, c.headPath))
+}
+
+// log chains the record to the previous record of its tenant and logs it. The chain is locked while logging, so
+// that the records are logged in the order of the chain.
+func (c *auditChains) log(logger logging.InfraLogger, rec Record) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	head, ok := c.heads[rec.Tenant]
+	if !ok {
+		head = &chainHead{}
+		if seal, ok := c.seals[rec.Tenant]; ok {
+			head.prevChain, head.hash = seal.Chain, seal.Hash
+		}
+		c.heads[rec.Tenant] = head
+	}
+	rec.Chain = c.id
+	rec.Seq = head.seq + 1
+	if head.seq == 0 {
+		rec.PrevChain = head.prevChain
+	}
+	rec.PrevHash = head.hash
+	rec.Time = time.Now().UTC().Format(time.RFC3339Nano)
+	hash := rec.Hash()
+
+	event := logger.InfraAuditEvent().Info()
+	for _, field := range []struct{ name, value string }{
+		{logging.OPERATION, rec.Operation},
+		{logging.PATH, rec.Path},
+		{logging.USER, rec.User},
+		{logging.EMAIL, rec.Email},
+		{logging.REQUEST, rec.Request},
+		{logging.RESPONSE, rec.Response},
+		{logging.ERROR, rec.Error},
+		{logging.STATUS, rec.Status},
+	} {
+		if field.value != "" {
+			event = event.Str(field.name, field.value)
+		}
+	}
+	if rec.PrevChain != "" {
+		event = event.Str("audit_prev_chain", rec.PrevChain)
+	}
+	event.Str("audit_chain", rec.Chain).Str("audit_tenant", rec.Tenant).Uint64("audit_seq", rec.Seq).
+		Str("audit_time", rec.Time).Str("audit_prev_hash", rec.PrevHash).Str("audit_hash", hash).Msg(rec.Message)
+
+	head.seq, head.hash = rec.Seq, hash
+}
+
+// seal signs the heads of the chains that changed since they were last sealed, logs the seals, and persists them if
+// a head path is set. The seals carry their time, which tells the verifiers which records should be sealed.
+func (c *auditChains) seal(logger logging.InfraLogger, key ed25519.PrivateKey) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	keyID := KeyID(key.Public().(ed25519.PublicKey))
+	now := time.Now().UTC().Format(time.RFC3339Nano)
+	changed := false
+	for tenant, head := range c.heads {
+		if head.sealed == head.seq {
+			continue
+		}
+		signature := base64.StdEncoding.EncodeToString(ed25519.Sign(key, sealPayload(c.id, tenant, head.seq, head.hash)))
+		logger.Info().Str(logging.EVENT, AUDITSEAL).Str("audit_chain", c.id).Str("audit_tenant", tenant).
+			Uint64("audit_seq", head.seq).Str("audit_time", now).Str("audit_hash", head.hash).
+			Str("audit_key_id", keyID).Str("audit_signature", signature).Msg("Audit chain sealed")
+		head.sealed = head.seq
+		c.seals[tenant] = sealedHead{Chain: c.id, Seq: head.seq, Hash: head.hash, Signature: signature}
+		changed = true
+	}
+	if changed && c.headPath != "" {
+		if err := c.saveHeads(); err != nil {
+			zlog.InfraSec().InfraErr(err).Msgf("failed to persist the audit chain seals to %s", c.headPath)
+		}
+	}
+}
+
+// StartSealing seals the audit chains of this process with the key at the given interval, until ctx is done, when
+// they are sealed a last time. The last seals are persisted to headPath, if not empty, for the chains of the next
+// process to link to them; the chains of this process link to the seals persisted by the previous one. Call it
+// before logging audit records.
+func StartSealing(ctx context.Context, key ed25519.PrivateKey, interval time.Duration, headPath string) {
+	if interval <= 0 {
+		interval = DefaultSealInterval
+	}
+	zlog.InfraSec().Info().Msgf("Sealing audit chains every %s with key %s",
+		interval, KeyID(key.Public().(ed25519.PublicKey)))
+	if headPath != "" {
+		if err := chains.loadHeads(headPath, key.Public().(ed25519.PublicKey)); err != nil {
+			zlog.InfraSec().InfraErr(err).Msgf("failed to load the audit chain seals from %s, chains are not linked",
+				headPath)
+		}
+	}
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				chains.seal(zlog, key)
+			case <-ctx.Done():
+				chains.seal(zlog, key)
+				return
+			}
+		}
+	}()
+}
+
+// LoadSigningKey loads the Ed25519 private key sealing the audit chains from a PKCS #8 PEM file.
+func LoadSigningKey(path string) (ed25519.PrivateKey, error) {
+	block, err := readPEM(path)
+	if err != nil {
+		return nil, err
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	edKey, ok := key.(ed25519.PrivateKey)
+	if !ok {
+		return nil, errors.Errorf("audit signing key %s is not an Ed25519 key", path)
+	}
+	return edKey, nil
+}
+
+// LoadVerificationKey loads the Ed25519 public key verifying the seals of the audit chains from a PKIX PEM file.
+func LoadVerificationKey(path string) (ed25519.PublicKey, error) {
+	block, err := readPEM(path)
+	if err != nil {
+		return nil, err
+	}
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	edKey, ok := key.(ed25519.PublicKey)
+	if !ok {
+		return nil, errors.Errorf("audit verification key %s is not an Ed25519 key", path)
+	}
+	return edKey, nil
+}
+
+func readPEM(path string) (*pem.Block, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	block, _ := pem.Decode(content)
+	if block == nil {
+		return nil, errors.Errorf("no PEM data found in %s", path)
+	}
+	return block, nil
+}
@@ -0,0 +1,167 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package auditing_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/auditing"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/logging"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/tenant"
+)
+
+const (
+	tenant1 = "11111111-1111-1111-1111-111111111111"
+	tenant2 = "22222222-2222-2222-2222-222222222222"
+)
+
+// syncBuffer is a buffer safe for the concurrent writes of the sealing.
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+// writeSealedAuditLog logs audit records of several tenants, seals them with key, and returns the log.
+func writeSealedAuditLog(t *testing.T, key ed25519.PrivateKey) string {
+	t.Helper()
+	buf := &syncBuffer{}
+	auditing.SetupLogger(logging.InfraLogger{
+		Logger: zerolog.New(buf).With().Timestamp().Str(logging.COMPONENT, logging.AUDIT).Logger(),
+	})
+
+	info := &grpc.UnaryServerInfo{FullMethod: "/inventory.v1.InventoryService/GetResource"}
+	for _, tenantID := range []string{tenant1, tenant2, tenant1} {
+		ctx := tenant.AddTenantIDToContext(context.Background(), tenantID)
+		_, err := auditing.GrpcInterceptor(ctx, "request", info, func(context.Context, any) (any, error) {
+			return "response", nil
+		})
+		require.NoError(t, err)
+	}
+	_, err := auditing.GrpcInterceptor(context.Background(), "request", info, func(context.Context, any) (any, error) {
+		return nil, status.Error(codes.NotFound, "not found")
+	})
+	require.Error(t, err)
+	req := httptest.NewRequest(http.MethodGet, "/", http.NoBody)
+	require.NoError(t, auditing.RestEchoMiddleware(func(echo.Context) error { return nil })(
+		echo.New().NewContext(req, httptest.NewRecorder())))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	auditing.StartSealing(ctx, key, time.Hour, "")
+	cancel()
+	assert.Eventually(t, func() bool {
+		return strings.Count(buf.String(), auditing.AUDITSEAL) == 3
+	}, time.Second, 10*time.Millisecond)
+	return buf.String()
+}
+
+func verify(t *testing.T, log string, key ed25519.PublicKey) *auditing.Report {
+	t.Helper()
+	report, err := auditing.Verify(strings.NewReader(log), key, time.Hour)
+	require.NoError(t, err)
+	return report
+}
+
+func TestAuditChain(t *testing.T) {
+	pub, key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	log := writeSealedAuditLog(t, key)
+
+	report := verify(t, log, pub)
+	assert.Empty(t, report.Issues)
+	assert.Equal(t, 9, report.Records)
+	assert.Equal(t, 3, report.Chains)
+	assert.Equal(t, 3, report.Seals)
+	assert.Zero(t, report.Unsealed)
+	assert.Contains(t, log, `"audit_tenant":"`+tenant1+`","audit_seq":4`)
+
+	// The seals are only verified with the right key.
+	otherPub, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	assert.Len(t, verify(t, log, otherPub).Issues, 3)
+
+	lines := strings.Split(strings.TrimSpace(log), "\n")
+	t.Run("Modified", func(t *testing.T) {
+		modified := append([]string{}, lines...)
+		modified[1] = strings.Replace(modified[1], `"response":"response"`, `"response":"forged"`, 1)
+		report := verify(t, strings.Join(modified, "\n"), pub)
+		require.Len(t, report.Issues, 1)
+		assert.Equal(t, 2, report.Issues[0].Line)
+		assert.Contains(t, report.Issues[0].Detail, "modified")
+	})
+	t.Run("Removed", func(t *testing.T) {
+		removed := append(append([]string{}, lines[:1]...), lines[2:]...)
+		report := verify(t, strings.Join(removed, "\n"), pub)
+		require.NotEmpty(t, report.Issues)
+		assert.Contains(t, report.Issues[0].Detail, "records 2 to 2 are missing")
+	})
+	t.Run("Truncated", func(t *testing.T) {
+		// The records logged last are removed, but their seals remain.
+		var truncated []string
+		for _, line := range lines {
+			if !strings.Contains(line, `"audit_seq":4`) || strings.Contains(line, auditing.AUDITSEAL) {
+				truncated = append(truncated, line)
+			}
+		}
+		report := verify(t, strings.Join(truncated, "\n"), pub)
+		require.Len(t, report.Issues, 1)
+		assert.Contains(t, report.Issues[0].Detail, "sealed record is missing")
+	})
+	t.Run("SealsRemoved", func(t *testing.T) {
+		var unsealed []string
+		for _, line := range lines {
+			if !strings.Contains(line, auditing.AUDITSEAL) {
+				unsealed = append(unsealed, line)
+			}
+		}
+		// The records are recent compared to the seal interval, their seals may not be logged yet.
+		report := verify(t, strings.Join(unsealed, "\n"), pub)
+		assert.Empty(t, report.Issues)
+		assert.Equal(t, 9, report.Unsealed)
+
+		report, err := auditing.Verify(strings.NewReader(strings.Join(unsealed, "\n")), pub, 0)
+		require.NoError(t, err)
+		require.NotEmpty(t, report.Issues)
+		for _, issue := range report.Issues {
+			assert.Contains(t, issue.Detail, "chain is not sealed")
+		}
+		// Without a key, the seals are not required.
+		report, err = auditing.Verify(strings.NewReader(strings.Join(unsealed, "\n")), nil, 0)
+		require.NoError(t, err)
+		assert.Empty(t, report.Issues)
+	})
+	t.Run("Unchained", func(t *testing.T) {
+		report := verify(t, `{"level":"info","event":"auditmessage","operation":"GET"}`+"\nnot json", pub)
+		require.Len(t, report.Issues, 1)
+		assert.Contains(t, report.Issues[0].Detail, "not chained")
+	})
+}
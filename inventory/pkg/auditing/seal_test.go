@@ -0,0 +1,94 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+//nolint:testpackage // testing the chains of successive processes
+package auditing
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/logging"
+)
+
+// logRun logs and seals the records of a process persisting its seals to headPath, and returns its chain.
+func logRun(t *testing.T, buf *bytes.Buffer, headPath string, key ed25519.PrivateKey) string {
+	t.Helper()
+	logger := logging.InfraLogger{Logger: zerolog.New(buf)}
+	c := newAuditChains()
+	require.NoError(t, c.loadHeads(headPath, key.Public().(ed25519.PublicKey)))
+	for range 2 {
+		c.log(logger, Record{Tenant: "tenant", Operation: "GetResource", Message: "call"})
+	}
+	c.seal(logger, key)
+	return c.id
+}
+
+func TestLinkedChains(t *testing.T) {
+	pub, key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	t.Run("Linked", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		headPath := filepath.Join(t.TempDir(), "heads.json")
+		logRun(t, buf, headPath, key)
+		second := logRun(t, buf, headPath, key)
+		logRun(t, buf, headPath, key)
+
+		report, err := Verify(strings.NewReader(buf.String()), pub, time.Hour)
+		require.NoError(t, err)
+		assert.Empty(t, report.Issues)
+		assert.Equal(t, 3, report.Chains)
+
+		// Removing the records of a run breaks the link of the next one.
+		var removed []string
+		for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+			if !strings.Contains(line, second) || strings.Contains(line, `"audit_prev_chain":"`+second) {
+				removed = append(removed, line)
+			}
+		}
+		report, err = Verify(strings.NewReader(strings.Join(removed, "\n")), pub, time.Hour)
+		require.NoError(t, err)
+		require.Len(t, report.Issues, 1)
+		assert.Contains(t, report.Issues[0].Detail, "previous chain "+second+" is missing")
+	})
+	t.Run("HeadsLost", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		headPath := filepath.Join(t.TempDir(), "heads.json")
+		first := logRun(t, buf, headPath, key)
+		require.NoError(t, os.Remove(headPath))
+		logRun(t, buf, headPath, key)
+
+		report, err := Verify(strings.NewReader(buf.String()), pub, time.Hour)
+		require.NoError(t, err)
+		require.Len(t, report.Issues, 1)
+		assert.Contains(t, report.Issues[0].Detail, "does not link to the previous chain "+first)
+
+		// The links are only required when the seals are verified.
+		report, err = Verify(strings.NewReader(buf.String()), nil, time.Hour)
+		require.NoError(t, err)
+		assert.Empty(t, report.Issues)
+	})
+	t.Run("HeadsForged", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		headPath := filepath.Join(t.TempDir(), "heads.json")
+		logRun(t, buf, headPath, key)
+		_, otherKey, err := ed25519.GenerateKey(rand.Reader)
+		require.NoError(t, err)
+		// Seals signed by another key are ignored, the next run does not link to them.
+		logRun(t, &bytes.Buffer{}, headPath, otherKey)
+		c := newAuditChains()
+		require.NoError(t, c.loadHeads(headPath, pub))
+		assert.Empty(t, c.seals)
+	})
+}
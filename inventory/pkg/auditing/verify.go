@@ -0,0 +1,236 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package auditing
+
+import (
+	"bufio"
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/logging"
+)
+
+// maxLogLineSize bounds the size of the log entries, which include the requests and responses of the calls.
+const maxLogLineSize = 16 * 1024 * 1024
+
+// Issue is a problem found in an audit log, on the given line.
+type Issue struct {
+	Line   int
+	Chain  string
+	Tenant string
+	Seq    uint64
+	Detail string
+}
+
+func (i Issue) String() string {
+	return fmt.Sprintf("line %d: chain %s, tenant %q, record %d: %s", i.Line, i.Chain, i.Tenant, i.Seq, i.Detail)
+}
+
+// Report is the outcome of the verification of an audit log.
+type Report struct {
+	Records int
+	Seals   int
+	Chains  int
+	// Unsealed is the number of records that no seal covers yet. Removing such records at the end of their chain
+	// cannot be detected.
+	Unsealed int
+	Issues   []Issue
+}
+
+// logEntry is an entry of an audit log, either an audit record or a seal.
+type logEntry struct {
+	Event string `json:"event"`
+	Record
+	Hash      string `json:"audit_hash"`
+	Signature string `json:"audit_signature"`
+}
+
+type verifiedChain struct {
+	chain, tenant string
+	seq           uint64
+	hash          string
+	sealed        uint64
+	sealedHash    string
+	// pending are the records of the chain not sealed yet, by sequence number.
+	pending map[uint64]pendingRecord
+}
+
+type pendingRecord struct {
+	line int
+	hash string
+	time time.Time
+}
+
+// Verify walks an audit log exported from the logs of a component, and reports the records that were modified,
+// removed or inserted. The chains of the records are checked, and the seals are verified with key, if not nil.
+// The entries of the log that are not audit records nor seals are ignored.
+//
+// When the seals are verified, the records must also be sealed within sealInterval, the interval at which the
+// component seals them: the records older than the last record or seal of the log by more than sealInterval and not
+// sealed are reported, and so are the chains of a tenant that do not link to the previous chain of the tenant.
+func Verify(r io.Reader, key ed25519.PublicKey, sealInterval time.Duration) (*Report, error) {
+	report := &Report{}
+	chains := make(map[string]*verifiedChain)
+	var ordered []*verifiedChain
+	// lastChains are the last chains of the tenants, in the order of the log.
+	lastChains := make(map[string]string)
+	var latest time.Time
+	issue := func(line int, entry *logEntry, format string, args ...any) {
+		report.Issues = append(report.Issues, Issue{
+			Line: line, Chain: entry.Chain, Tenant: entry.Tenant, Seq: entry.Seq, Detail: fmt.Sprintf(format, args...),
+		})
+	}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), maxLogLineSize)
+	for line := 1; scanner.Scan(); line++ {
+		var entry logEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			continue
+		}
+		if t, err := time.Parse(time.RFC3339Nano, entry.Time); err == nil && t.After(latest) {
+			latest = t
+		}
+		switch entry.Event {
+		case logging.AUDITMESSAGE:
+			report.Records++
+			if entry.Chain == "" {
+				issue(line, &entry, "record is not chained")
+				continue
+			}
+			id := entry.Chain + "/" + entry.Tenant
+			c, ok := chains[id]
+			if !ok {
+				c = &verifiedChain{chain: entry.Chain, tenant: entry.Tenant, pending: make(map[uint64]pendingRecord)}
+				chains[id] = c
+				ordered = append(ordered, c)
+				linkChain(line, &entry, c, chains, lastChains[entry.Tenant], key != nil, issue)
+				lastChains[entry.Tenant] = entry.Chain
+			}
+			verifyRecord(line, &entry, c, issue)
+		case AUDITSEAL:
+			report.Seals++
+			c, ok := chains[entry.Chain+"/"+entry.Tenant]
+			if !ok {
+				issue(line, &entry, "seal of a chain without records")
+				continue
+			}
+			verifySeal(line, &entry, c, key, issue)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.Wrap(err)
+	}
+
+	report.Chains = len(chains)
+	for _, c := range ordered {
+		if c.seq > c.sealed {
+			report.Unsealed += int(c.seq - c.sealed)
+		}
+		if key != nil {
+			verifySealed(c, latest.Add(-sealInterval), issue)
+		}
+	}
+	return report, nil
+}
+
+// linkChain checks that the first record of a chain links to the last seal of the previous chain of its tenant. The
+// chains are only required to be linked when the seals are verified, as the links are persisted with the seals.
+func linkChain(
+	line int, entry *logEntry, c *verifiedChain, chains map[string]*verifiedChain, prevChain string, sealsVerified bool,
+	issue func(int, *logEntry, string, ...any),
+) {
+	if entry.PrevChain == "" {
+		if prevChain != "" && sealsVerified {
+			issue(line, entry, "chain does not link to the previous chain %s of the tenant", prevChain)
+		}
+		return
+	}
+	// The first record is checked against the link, not against the start of the chain.
+	c.hash = entry.PrevHash
+	prev, ok := chains[entry.PrevChain+"/"+entry.Tenant]
+	switch {
+	case !ok:
+		issue(line, entry, "previous chain %s is missing", entry.PrevChain)
+	case prev.sealed == 0 || prev.sealedHash != entry.PrevHash:
+		issue(line, entry, "chain does not link to the last seal of the previous chain %s", entry.PrevChain)
+	}
+}
+
+// verifySealed reports the records of the chain logged before the given time and not sealed.
+func verifySealed(c *verifiedChain, before time.Time, issue func(int, *logEntry, string, ...any)) {
+	var first, last uint64
+	line := 0
+	for seq := c.sealed + 1; seq <= c.seq; seq++ {
+		rec, ok := c.pending[seq]
+		if !ok || !rec.time.Before(before) {
+			continue
+		}
+		if first == 0 {
+			first, line = seq, rec.line
+		}
+		last = seq
+	}
+	if first == 0 {
+		return
+	}
+	entry := &logEntry{Record: Record{Chain: c.chain, Tenant: c.tenant, Seq: first}}
+	if c.sealed == 0 {
+		issue(line, entry, "chain is not sealed, records %d to %d are older than the seal interval", first, last)
+		return
+	}
+	issue(line, entry, "records %d to %d were not sealed within the seal interval", first, last)
+}
+
+func verifyRecord(
+	line int, entry *logEntry, c *verifiedChain, issue func(int, *logEntry, string, ...any),
+) {
+	if hash := entry.Record.Hash(); hash != entry.Hash {
+		issue(line, entry, "record was modified, its hash does not match")
+	}
+	switch {
+	case entry.Seq <= c.seq:
+		issue(line, entry, "record is duplicated or out of order, after record %d", c.seq)
+		return
+	case entry.Seq > c.seq+1:
+		issue(line, entry, "records %d to %d are missing", c.seq+1, entry.Seq-1)
+	case entry.PrevHash != c.hash:
+		issue(line, entry, "record does not follow record %d, the previous hash does not match", c.seq)
+	}
+	c.seq, c.hash = entry.Seq, entry.Hash
+	recordTime, _ := time.Parse(time.RFC3339Nano, entry.Time)
+	c.pending[entry.Seq] = pendingRecord{line: line, hash: entry.Hash, time: recordTime}
+}
+
+func verifySeal(
+	line int, entry *logEntry, c *verifiedChain, key ed25519.PublicKey, issue func(int, *logEntry, string, ...any),
+) {
+	rec, ok := c.pending[entry.Seq]
+	switch {
+	case !ok:
+		issue(line, entry, "sealed record is missing")
+		return
+	case rec.hash != entry.Hash:
+		issue(line, entry, "sealed record was modified, its hash does not match the seal")
+		return
+	}
+	if key != nil {
+		signature, err := base64.StdEncoding.DecodeString(entry.Signature)
+		if err != nil || !ed25519.Verify(key, sealPayload(entry.Chain, entry.Tenant, entry.Seq, entry.Hash), signature) {
+			issue(line, entry, "seal signature is invalid for key %s", KeyID(key))
+			return
+		}
+	}
+	c.sealed, c.sealedHash = entry.Seq, entry.Hash
+	for seq := range c.pending {
+		if seq <= entry.Seq {
+			delete(c.pending, seq)
+		}
+	}
+}
@@ -4,7 +4,7 @@
 package cert
 
 import (
-	"crypto/tls"
+	"context"
 	"crypto/x509"
 	"os"
 
@@ -16,35 +16,21 @@ import (
 
 var zlog = logging.GetLogger("InfraCert")
 
-// HandleCertPaths creates credentials for gRPC dial options using paths of ca, key, cert files.
+// HandleCertPaths creates credentials for gRPC dial options using paths of ca, key, cert files. The files are
+// reloaded when they change until the context is done, see Reloader.
 func HandleCertPaths(
+	ctx context.Context,
 	caPath string,
 	keyPath string,
 	certPath string,
 	insecure bool,
 ) (credentials.TransportCredentials, error) {
-	var cert tls.Certificate
-	var err error
-
-	cert, err = tls.LoadX509KeyPair(certPath, keyPath)
-	if err != nil {
-		return nil, errors.Wrap(err)
-	}
-
-	var clientCAs *x509.CertPool
-
-	clientCAs, err = GetCertPool(caPath)
+	reloader, err := NewReloader(caPath, keyPath, certPath)
 	if err != nil {
 		return nil, err
 	}
-
-	tlsConfig := &tls.Config{
-		Certificates:       []tls.Certificate{cert},
-		ClientCAs:          clientCAs,
-		InsecureSkipVerify: insecure,
-	}
-
-	return credentials.NewTLS(tlsConfig), nil
+	go reloader.Run(ctx)
+	return reloader.TransportCredentials(insecure), nil
 }
 
 // GetCertPool loads the Certificate Authority from the given path.
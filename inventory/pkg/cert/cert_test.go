@@ -4,6 +4,7 @@
 package cert_test
 
 import (
+	"context"
 	"flag"
 	"os"
 	"testing"
@@ -27,7 +28,7 @@ func TestMain(m *testing.M) {
 }
 
 func Test_InvalidHandleCertPathsAndPools(t *testing.T) {
-	_, err := cert.HandleCertPaths("", "", "", false)
+	_, err := cert.HandleCertPaths(context.Background(), "", "", "", false)
 	assert.Error(t, err)
 
 	_, err = cert.GetCertPool("")
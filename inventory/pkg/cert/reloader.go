@@ -0,0 +1,283 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cert
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"sync/atomic"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
+)
+
+const (
+	DefaultReloadInterval = 30 * time.Second
+	DefaultCAOverlap      = time.Hour
+)
+
+// ExpiryMetrics exposes the expiry of the certificates and CAs loaded by the reloaders, by file path and subject.
+var ExpiryMetrics = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "tls_certificate_expiry_timestamp_seconds",
+	Help: "Expiry of the loaded TLS certificates and CAs, as a Unix timestamp.",
+}, []string{"path", "subject"})
+
+// Reloader holds the key pair and the CA bundle loaded from files, and reloads them when the files change so that
+// rotating certificates needs no restart: new TLS handshakes use the current key pair and CA bundle. The CAs removed
+// from the bundle are still trusted during the CA overlap, so that the peers holding certificates issued by the
+// previous CA keep working until they are rotated too.
+type Reloader struct {
+	caPath, keyPath, certPath string
+	interval                  time.Duration
+	caOverlap                 time.Duration
+	state                     atomic.Pointer[reloaderState]
+}
+
+type reloaderState struct {
+	cert   *tls.Certificate
+	cas    []trustedCA
+	pool   *x509.CertPool
+	digest [sha256.Size]byte
+}
+
+type trustedCA struct {
+	cert *x509.Certificate
+	// removedAt is when the CA was removed from the bundle, zero while it is in.
+	removedAt time.Time
+}
+
+type ReloaderOption func(*Reloader)
+
+// WithReloadInterval sets how often the files are checked for changes.
+func WithReloadInterval(interval time.Duration) ReloaderOption {
+	return func(r *Reloader) {
+		r.interval = interval
+	}
+}
+
+// WithCAOverlap sets how long the CAs removed from the bundle are still trusted.
+func WithCAOverlap(overlap time.Duration) ReloaderOption {
+	return func(r *Reloader) {
+		r.caOverlap = overlap
+	}
+}
+
+// NewReloader returns a reloader of the key pair and CA bundle at the given paths, loading them once. Call Run to
+// reload them on changes.
+func NewReloader(caPath, keyPath, certPath string, options ...ReloaderOption) (*Reloader, error) {
+	r := &Reloader{
+		caPath:    caPath,
+		keyPath:   keyPath,
+		certPath:  certPath,
+		interval:  DefaultReloadInterval,
+		caOverlap: DefaultCAOverlap,
+	}
+	for _, opt := range options {
+		opt(r)
+	}
+	if err := r.Reload(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Run reloads the files every reload interval until the context is done. Failed reloads are logged and the current
+// key pair and CA bundle are kept.
+func (r *Reloader) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := r.Reload(); err != nil {
+				zlog.InfraSec().InfraErr(err).Msgf("failed to reload certificates, keeping the current ones")
+			}
+		}
+	}
+}
+
+// Reload loads the files again if they changed since the last load.
+func (r *Reloader) Reload() error {
+	caPEM, err := os.ReadFile(r.caPath)
+	if err != nil {
+		return errors.Wrap(err)
+	}
+	keyPEM, err := os.ReadFile(r.keyPath)
+	if err != nil {
+		return errors.Wrap(err)
+	}
+	certPEM, err := os.ReadFile(r.certPath)
+	if err != nil {
+		return errors.Wrap(err)
+	}
+	digest := sha256.Sum256(bytes.Join([][]byte{caPEM, keyPEM, certPEM}, []byte{0}))
+	current := r.state.Load()
+	if current != nil && current.digest == digest && !r.hasExpiredCAs(current) {
+		return nil
+	}
+
+	cert, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		return errors.Wrap(err)
+	}
+	bundle, err := parseCertificates(caPEM)
+	if err != nil {
+		return err
+	}
+	if len(bundle) == 0 {
+		zlog.InfraSec().InfraError("failed to append CA certificate from %s", r.caPath).Msg("")
+		return errors.Errorf("failed to append CA certificate from %s", r.caPath)
+	}
+
+	state := &reloaderState{cert: &cert, pool: x509.NewCertPool(), digest: digest}
+	for _, ca := range bundle {
+		state.cas = append(state.cas, trustedCA{cert: ca})
+	}
+	if current != nil {
+		state.cas = append(state.cas, r.retainedCAs(current, bundle)...)
+	}
+	for _, ca := range state.cas {
+		state.pool.AddCert(ca.cert)
+	}
+	r.state.Store(state)
+	r.updateMetrics(state)
+	if current != nil {
+		zlog.InfraSec().Info().Msgf("Reloaded certificates from %s, %s, %s", r.caPath, r.keyPath, r.certPath)
+	}
+	return nil
+}
+
+// retainedCAs returns the CAs of the current state that are no longer in the bundle but still in their overlap.
+func (r *Reloader) retainedCAs(current *reloaderState, bundle []*x509.Certificate) []trustedCA {
+	now := time.Now()
+	var retained []trustedCA
+	for _, ca := range current.cas {
+		inBundle := false
+		for _, newCA := range bundle {
+			if ca.cert.Equal(newCA) {
+				inBundle = true
+				break
+			}
+		}
+		if inBundle {
+			continue
+		}
+		if ca.removedAt.IsZero() {
+			ca.removedAt = now
+			zlog.InfraSec().Info().Msgf("CA %s removed from %s, trusted for %s", ca.cert.Subject, r.caPath, r.caOverlap)
+		}
+		if now.Sub(ca.removedAt) < r.caOverlap {
+			retained = append(retained, ca)
+		}
+	}
+	return retained
+}
+
+func (r *Reloader) hasExpiredCAs(state *reloaderState) bool {
+	for _, ca := range state.cas {
+		if !ca.removedAt.IsZero() && time.Since(ca.removedAt) >= r.caOverlap {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Reloader) updateMetrics(state *reloaderState) {
+	ExpiryMetrics.DeletePartialMatch(prometheus.Labels{"path": r.certPath})
+	ExpiryMetrics.DeletePartialMatch(prometheus.Labels{"path": r.caPath})
+	if state.cert.Leaf != nil {
+		ExpiryMetrics.WithLabelValues(r.certPath, state.cert.Leaf.Subject.String()).
+			Set(float64(state.cert.Leaf.NotAfter.Unix()))
+	}
+	for _, ca := range state.cas {
+		ExpiryMetrics.WithLabelValues(r.caPath, ca.cert.Subject.String()).Set(float64(ca.cert.NotAfter.Unix()))
+	}
+}
+
+func parseCertificates(data []byte) ([]*x509.Certificate, error) {
+	var certs []*x509.Certificate
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			return certs, nil
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, errors.Wrap(err)
+		}
+		certs = append(certs, cert)
+	}
+}
+
+// Certificate returns the current key pair.
+func (r *Reloader) Certificate() *tls.Certificate {
+	return r.state.Load().cert
+}
+
+// CertPool returns the current CAs, including the ones in their overlap.
+func (r *Reloader) CertPool() *x509.CertPool {
+	return r.state.Load().pool
+}
+
+// TLSConfig returns a TLS configuration using the current key pair and CAs for every handshake, for both clients and
+// servers. Servers verify the client certificates against the CAs when given. Clients verify the server certificate
+// against the CAs unless insecure is set.
+func (r *Reloader) TLSConfig(insecure bool) *tls.Config {
+	getCertificate := func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+		return r.Certificate(), nil
+	}
+	config := &tls.Config{
+		GetCertificate: getCertificate,
+		GetClientCertificate: func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			return r.Certificate(), nil
+		},
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			return &tls.Config{
+				GetCertificate: getCertificate,
+				ClientCAs:      r.CertPool(),
+				ClientAuth:     tls.VerifyClientCertIfGiven,
+			}, nil
+		},
+		// The server certificate is verified by VerifyConnection against the current CAs, the static RootCAs would
+		// not follow their rotation.
+		InsecureSkipVerify: true, //nolint:gosec // verified by VerifyConnection
+	}
+	if !insecure {
+		config.VerifyConnection = func(cs tls.ConnectionState) error {
+			if len(cs.PeerCertificates) == 0 {
+				return errors.Errorf("no server certificate")
+			}
+			intermediates := x509.NewCertPool()
+			for _, cert := range cs.PeerCertificates[1:] {
+				intermediates.AddCert(cert)
+			}
+			_, err := cs.PeerCertificates[0].Verify(x509.VerifyOptions{
+				DNSName:       cs.ServerName,
+				Roots:         r.CertPool(),
+				Intermediates: intermediates,
+			})
+			return err
+		}
+	}
+	return config
+}
+
+// TransportCredentials returns the gRPC credentials of TLSConfig.
+func (r *Reloader) TransportCredentials(insecure bool) credentials.TransportCredentials {
+	return credentials.NewTLS(r.TLSConfig(insecure))
+}
@@ -0,0 +1,192 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cert_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/cert"
+)
+
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  []byte
+}
+
+func newTestCA(t *testing.T, name string) *testCA {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	caCert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return &testCA{cert: caCert, key: key, pem: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})}
+}
+
+// issue returns the PEM of a key pair for localhost issued by the CA.
+func (ca *testCA) issue(t *testing.T, name string) (certPEM, keyPEM []byte) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		DNSNames:     []string{"localhost"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &key.PublicKey, ca.key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
+
+type certFiles struct {
+	caPath, keyPath, certPath string
+}
+
+func writeCertFiles(t *testing.T, files certFiles, caPEM, certPEM, keyPEM []byte) {
+	t.Helper()
+	require.NoError(t, os.WriteFile(files.caPath, caPEM, 0o600))
+	require.NoError(t, os.WriteFile(files.certPath, certPEM, 0o600))
+	require.NoError(t, os.WriteFile(files.keyPath, keyPEM, 0o600))
+}
+
+func newCertFiles(t *testing.T) certFiles {
+	t.Helper()
+	dir := t.TempDir()
+	return certFiles{
+		caPath:   filepath.Join(dir, "ca-cert.pem"),
+		keyPath:  filepath.Join(dir, "key.pem"),
+		certPath: filepath.Join(dir, "cert.pem"),
+	}
+}
+
+// handshake runs a TLS handshake between a client and a server using the given reloaders.
+func handshake(t *testing.T, client, server *cert.Reloader) error {
+	t.Helper()
+	clientConn, serverConn := net.Pipe()
+	defer clientConn.Close()
+	defer serverConn.Close()
+
+	clientConfig := client.TLSConfig(false)
+	clientConfig.ServerName = "localhost"
+	serverErr := make(chan error, 1)
+	go func() {
+		serverErr <- tls.Server(serverConn, server.TLSConfig(true)).Handshake()
+	}()
+	clientErr := tls.Client(clientConn, clientConfig).Handshake()
+	if clientErr != nil {
+		serverConn.Close()
+		<-serverErr
+		return clientErr
+	}
+	return <-serverErr
+}
+
+func TestReloader_Rotation(t *testing.T) {
+	ca := newTestCA(t, "ca-1")
+	files := newCertFiles(t)
+	certPEM, keyPEM := ca.issue(t, "server-1")
+	writeCertFiles(t, files, ca.pem, certPEM, keyPEM)
+
+	reloader, err := cert.NewReloader(files.caPath, files.keyPath, files.certPath)
+	require.NoError(t, err)
+	assert.Equal(t, "server-1", reloader.Certificate().Leaf.Subject.CommonName)
+
+	// Unchanged files are not reloaded.
+	current := reloader.Certificate()
+	require.NoError(t, reloader.Reload())
+	assert.Same(t, current, reloader.Certificate())
+
+	certPEM, keyPEM = ca.issue(t, "server-2")
+	writeCertFiles(t, files, ca.pem, certPEM, keyPEM)
+	require.NoError(t, reloader.Reload())
+	assert.Equal(t, "server-2", reloader.Certificate().Leaf.Subject.CommonName)
+
+	// Invalid files are rejected and the current key pair is kept.
+	require.NoError(t, os.WriteFile(files.keyPath, []byte("invalid"), 0o600))
+	require.Error(t, reloader.Reload())
+	assert.Equal(t, "server-2", reloader.Certificate().Leaf.Subject.CommonName)
+}
+
+func TestReloader_CAOverlap(t *testing.T) {
+	oldCA, newCA := newTestCA(t, "ca-old"), newTestCA(t, "ca-new")
+
+	serverFiles := newCertFiles(t)
+	certPEM, keyPEM := oldCA.issue(t, "server")
+	writeCertFiles(t, serverFiles, oldCA.pem, certPEM, keyPEM)
+	server, err := cert.NewReloader(serverFiles.caPath, serverFiles.keyPath, serverFiles.certPath)
+	require.NoError(t, err)
+
+	clientFiles := newCertFiles(t)
+	certPEM, keyPEM = oldCA.issue(t, "client")
+	writeCertFiles(t, clientFiles, oldCA.pem, certPEM, keyPEM)
+	client, err := cert.NewReloader(clientFiles.caPath, clientFiles.keyPath, clientFiles.certPath,
+		cert.WithCAOverlap(0))
+	require.NoError(t, err)
+	require.NoError(t, handshake(t, client, server))
+
+	// The server is rotated to the new CA first: the client does not trust it yet.
+	certPEM, keyPEM = newCA.issue(t, "server")
+	writeCertFiles(t, serverFiles, newCA.pem, certPEM, keyPEM)
+	require.NoError(t, server.Reload())
+	require.Error(t, handshake(t, client, server))
+
+	// The client bundle now holds both CAs during the rotation.
+	require.NoError(t, os.WriteFile(clientFiles.caPath, append(append([]byte{}, oldCA.pem...), newCA.pem...), 0o600))
+	require.NoError(t, client.Reload())
+	require.NoError(t, handshake(t, client, server))
+
+	// Without overlap, the old CA is no longer trusted once removed from the bundle.
+	require.NoError(t, os.WriteFile(clientFiles.caPath, newCA.pem, 0o600))
+	require.NoError(t, client.Reload())
+	_, err = oldCA.cert.Verify(x509.VerifyOptions{Roots: client.CertPool()})
+	assert.Error(t, err)
+}
+
+func TestReloader_RetainsRemovedCA(t *testing.T) {
+	oldCA, newCA := newTestCA(t, "ca-old"), newTestCA(t, "ca-new")
+	files := newCertFiles(t)
+	certPEM, keyPEM := oldCA.issue(t, "server")
+	writeCertFiles(t, files, oldCA.pem, certPEM, keyPEM)
+	reloader, err := cert.NewReloader(files.caPath, files.keyPath, files.certPath)
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(files.caPath, newCA.pem, 0o600))
+	require.NoError(t, reloader.Reload())
+	for _, ca := range []*testCA{oldCA, newCA} {
+		_, err = ca.cert.Verify(x509.VerifyOptions{Roots: reloader.CertPool()})
+		assert.NoError(t, err, "CA %s must be trusted during the overlap", ca.cert.Subject)
+	}
+}
@@ -10,6 +10,7 @@ import (
 	computev1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/compute/v1"
 	inv_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/inventory/v1"
 	inv_errors "github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/logging"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/util"
 )
 
@@ -179,7 +180,7 @@ func (c *InventoryCache) InvalidateCacheByEvent(evKind inv_v1.SubscribeEventsRes
 	tenantID, resourceID, err := util.GetResourceKeyFromResource(resource)
 	if err != nil {
 		// Error here should never happen
-		zlog.Err(err).Msgf("This error should never happen: resource=%v", resource)
+		zlog.Err(err).Msgf("This error should never happen: resource=%v", logging.Redact(resource))
 		return
 	}
 
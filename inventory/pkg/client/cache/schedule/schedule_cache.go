@@ -99,7 +99,7 @@ func (c *cache) invalidate(key cacheKey) {
 }
 
 func (c *cache) store(key cacheKey, resource *inv_v1.Resource) {
-	logC.Debug().Msgf("store(%s, %v)", key, resource)
+	logC.Debug().Msgf("store(%s, %v)", key, logging.Redact(resource))
 	c.lock.Lock()
 	defer c.lock.Unlock()
 	c.schedulesByCacheKey[key] = resource
@@ -143,6 +143,22 @@ type TenantAwareInventoryClient interface {
 		orderBy string,
 		limit, offset uint32,
 	) (*inv_v1.ListInheritedTelemetryProfilesResponse, error)
+	// GetEffectiveTelemetryProfiles returns the effective telemetry profiles of the requested instances, where the
+	// most specific profile of each telemetry group wins. Every profile carries the resource it comes from.
+	GetEffectiveTelemetryProfiles(
+		context.Context, *inv_v1.GetEffectiveTelemetryProfilesRequest,
+	) (*inv_v1.GetEffectiveTelemetryProfilesResponse, error)
+	// DiffEffectiveTelemetryProfiles returns how the effective telemetry profiles of the affected instances would change
+	// if the given telemetry profile was added or removed.
+	DiffEffectiveTelemetryProfiles(
+		context.Context, *inv_v1.DiffEffectiveTelemetryProfilesRequest,
+	) (*inv_v1.DiffEffectiveTelemetryProfilesResponse, error)
+	// SearchResources returns the resources whose text fields (names, serial numbers, MAC and IP addresses...) match
+	// the query of the request, ranked by relevance.
+	SearchResources(context.Context, *inv_v1.SearchResourcesRequest) (*inv_v1.SearchResourcesResponse, error)
+	// GetFleetStatistics returns the number of hosts matching the filter of the request, broken down along the
+	// requested dimensions.
+	GetFleetStatistics(context.Context, *inv_v1.GetFleetStatisticsRequest) (*inv_v1.GetFleetStatisticsResponse, error)
 	GetHostByUUID(ctx context.Context, tenantID string, uuid string) (*computev1.HostResource, error)
 	GetTreeHierarchy(context.Context, *inv_v1.GetTreeHierarchyRequest) ([]*inv_v1.GetTreeHierarchyResponse_TreeNode, error)
 	GetSitesPerRegion(context.Context, *inv_v1.GetSitesPerRegionRequest) (*inv_v1.GetSitesPerRegionResponse, error)
@@ -381,8 +397,9 @@ func (client *inventoryClient) contextDoneHandler() {
 	zlog.Info().Err(err).Msg("stopping inventory client")
 }
 
-// connect creates a gRPC connection to a server.
+// connect creates a gRPC connection to a server. The certificates are reloaded until ctx is done.
 func connect(
+	ctx context.Context,
 	address string,
 	caPath, certPath, keyPath string,
 	insec bool,
@@ -404,7 +421,7 @@ func connect(
 			return nil, err
 		}
 		// setting secure gRPC connection
-		creds, err := cert.HandleCertPaths(caPath, keyPath, certPath, true)
+		creds, err := cert.HandleCertPaths(ctx, caPath, keyPath, certPath, true)
 		if err != nil {
 			zlog.Fatal().Err(err).Msgf("an error occurred while loading credentials to server %v, %v, %v: %v\n",
 				caPath, certPath, keyPath, err,
@@ -549,8 +566,12 @@ func NewTenantAwareInventoryClient(
 		}, cfg.DialOptions...)
 	}
 
+	// The stream context also bounds the certificate reloading of the connection, stopped by Close.
+	streamCtx, streamCancel := context.WithCancel(ctx)
+
 	// ToDo remove insec option as default connect mode
 	conn, err := connect(
+		streamCtx,
 		cfg.Address,
 		cfg.SecurityCfg.CaPath,
 		cfg.SecurityCfg.CertPath,
@@ -558,6 +579,7 @@ func NewTenantAwareInventoryClient(
 		cfg.SecurityCfg.Insecure,
 		cfg.DialOptions...)
 	if err != nil {
+		streamCancel()
 		return nil, err
 	}
 
@@ -565,11 +587,12 @@ func NewTenantAwareInventoryClient(
 	zlog.Debug().Msgf("Created inventory client to address: %s", cfg.Address)
 
 	cl := &inventoryClient{
-		cfg:        &cfg,
-		connection: conn,
-		invAPI:     invSvcClient,
+		cfg:          &cfg,
+		connection:   conn,
+		invAPI:       invSvcClient,
+		streamCtx:    streamCtx,
+		streamCancel: streamCancel,
 	}
-	cl.streamCtx, cl.streamCancel = context.WithCancel(ctx)
 
 	// initialize cache.
 	cl.newInventoryCache()
@@ -577,7 +600,8 @@ func NewTenantAwareInventoryClient(
 	// registering client and obtaining UUID
 	err = cl.register()
 	if err != nil {
-		// stream is already cancel. Close the connection only
+		// Stop the certificate reloading and close the connection.
+		cl.streamCancel()
 		cl.connection.Close()
 		return nil, err
 	}
@@ -902,7 +926,7 @@ func (client *inventoryClient) Create(
 	tenantID string,
 	res *inv_v1.Resource,
 ) (*inv_v1.Resource, error) {
-	zlog.Debug().Msgf("Create inventory resource: tenantID: %s, resource: %s", tenantID, res.Resource)
+	zlog.Debug().Msgf("Create inventory resource: tenantID: %s, resource: %s", tenantID, logging.Redact(res.Resource))
 
 	if err := client.clientIsRegistered(); err != nil {
 		return nil, err
@@ -945,7 +969,7 @@ func (client *inventoryClient) Update(
 	fieldmask *fieldmaskpb.FieldMask,
 	res *inv_v1.Resource,
 ) (*inv_v1.Resource, error) {
-	zlog.Debug().Msgf("Update inventory resource: tenantID: %s,  %s", tenantID, res.Resource)
+	zlog.Debug().Msgf("Update inventory resource: tenantID: %s,  %s", tenantID, logging.Redact(res.Resource))
 
 	if err := client.clientIsRegistered(); err != nil {
 		return nil, err
@@ -1144,6 +1168,86 @@ func (client *inventoryClient) ListInheritedTelemetryProfiles(
 	return obj, nil
 }
 
+func (client *inventoryClient) GetEffectiveTelemetryProfiles(
+	ctx context.Context, request *inv_v1.GetEffectiveTelemetryProfilesRequest,
+) (*inv_v1.GetEffectiveTelemetryProfilesResponse, error) {
+	zlog := zlog.TraceCtx(ctx)
+	zlog.Debug().Msgf("GetEffectiveTelemetryProfiles: request=%v", request)
+
+	if err := client.clientIsRegistered(); err != nil {
+		zlog.Debug().Err(err).Msg("on GetEffectiveTelemetryProfiles")
+		return nil, err
+	}
+	// Populate the client UUID
+	request.ClientUuid = client.clientUUID
+	resp, err := client.invAPI.GetEffectiveTelemetryProfiles(ctx, request)
+	if err != nil {
+		zlog.Debug().Err(err).Msg("on GetEffectiveTelemetryProfiles")
+		return nil, inv_errors.Wrap(err)
+	}
+	return resp, nil
+}
+
+func (client *inventoryClient) DiffEffectiveTelemetryProfiles(
+	ctx context.Context, request *inv_v1.DiffEffectiveTelemetryProfilesRequest,
+) (*inv_v1.DiffEffectiveTelemetryProfilesResponse, error) {
+	zlog := zlog.TraceCtx(ctx)
+	zlog.Debug().Msgf("DiffEffectiveTelemetryProfiles: request=%v", request)
+
+	if err := client.clientIsRegistered(); err != nil {
+		zlog.Debug().Err(err).Msg("on DiffEffectiveTelemetryProfiles")
+		return nil, err
+	}
+	// Populate the client UUID
+	request.ClientUuid = client.clientUUID
+	resp, err := client.invAPI.DiffEffectiveTelemetryProfiles(ctx, request)
+	if err != nil {
+		zlog.Debug().Err(err).Msg("on DiffEffectiveTelemetryProfiles")
+		return nil, inv_errors.Wrap(err)
+	}
+	return resp, nil
+}
+
+func (client *inventoryClient) SearchResources(
+	ctx context.Context, request *inv_v1.SearchResourcesRequest,
+) (*inv_v1.SearchResourcesResponse, error) {
+	zlog := zlog.TraceCtx(ctx)
+	zlog.Debug().Msgf("SearchResources: request=%v", request)
+
+	if err := client.clientIsRegistered(); err != nil {
+		zlog.Debug().Err(err).Msg("on SearchResources")
+		return nil, err
+	}
+	// Populate the client UUID
+	request.ClientUuid = client.clientUUID
+	resp, err := client.invAPI.SearchResources(ctx, request)
+	if err != nil {
+		zlog.Debug().Err(err).Msg("on SearchResources")
+		return nil, inv_errors.Wrap(err)
+	}
+	return resp, nil
+}
+
+func (client *inventoryClient) GetFleetStatistics(
+	ctx context.Context, request *inv_v1.GetFleetStatisticsRequest,
+) (*inv_v1.GetFleetStatisticsResponse, error) {
+	zlog := zlog.TraceCtx(ctx)
+	zlog.Debug().Msgf("GetFleetStatistics: request=%v", request)
+
+	if err := client.clientIsRegistered(); err != nil {
+		zlog.Debug().Err(err).Msg("on GetFleetStatistics")
+		return nil, err
+	}
+	// Populate the client UUID
+	request.ClientUuid = client.clientUUID
+	resp, err := client.invAPI.GetFleetStatistics(ctx, request)
+	if err != nil {
+		zlog.Debug().Err(err).Msg("on GetFleetStatistics")
+		return nil, inv_errors.Wrap(err)
+	}
+	return resp, nil
+}
+
 func (client *inventoryClient) GetHostByUUID(ctx context.Context, tenantID, uuid string) (*computev1.HostResource, error) {
 	zlog := zlog.TraceCtx(ctx)
 	zlog.Info().Msgf("GetHostByUUID: tenantID=%s, uuid=%v", tenantID, uuid)
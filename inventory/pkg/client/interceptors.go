@@ -75,6 +75,14 @@ func TenantContextExtractingInterceptor() grpc.UnaryClientInterceptor {
 			cfgFilterTenantID(tenantID, v.GetFilter())
 		case *inv_v1.ListInheritedTelemetryProfilesRequest:
 			v.TenantId = tenantID
+		case *inv_v1.GetEffectiveTelemetryProfilesRequest:
+			v.TenantId = tenantID
+		case *inv_v1.DiffEffectiveTelemetryProfilesRequest:
+			v.TenantId = tenantID
+		case *inv_v1.SearchResourcesRequest:
+			v.TenantId = tenantID
+		case *inv_v1.GetFleetStatisticsRequest:
+			v.TenantId = tenantID
 		case *inv_v1.GetResourceRequest:
 			v.TenantId = tenantID
 		case *inv_v1.GetTreeHierarchyRequest:
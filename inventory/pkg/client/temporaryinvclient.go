@@ -8,12 +8,12 @@ import (
 	"fmt"
 	"reflect"
 
-	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/fieldmaskpb"
 
 	computev1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/compute/v1"
 	inv_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/inventory/v1"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/client/cache"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/util"
 )
 
 var FakeTenantID = "00000000-0000-0000-0000-000000000000"
@@ -61,6 +61,22 @@ type InventoryClient interface {
 		orderBy string,
 		limit, offset uint32,
 	) (*inv_v1.ListInheritedTelemetryProfilesResponse, error)
+	// GetEffectiveTelemetryProfiles returns the effective telemetry profiles of the requested instances, where the
+	// most specific profile of each telemetry group wins. Every profile carries the resource it comes from.
+	GetEffectiveTelemetryProfiles(
+		context.Context, *inv_v1.GetEffectiveTelemetryProfilesRequest,
+	) (*inv_v1.GetEffectiveTelemetryProfilesResponse, error)
+	// DiffEffectiveTelemetryProfiles returns how the effective telemetry profiles of the affected instances would change
+	// if the given telemetry profile was added or removed.
+	DiffEffectiveTelemetryProfiles(
+		context.Context, *inv_v1.DiffEffectiveTelemetryProfilesRequest,
+	) (*inv_v1.DiffEffectiveTelemetryProfilesResponse, error)
+	// SearchResources returns the resources whose text fields (names, serial numbers, MAC and IP addresses...) match
+	// the query of the request, ranked by relevance.
+	SearchResources(context.Context, *inv_v1.SearchResourcesRequest) (*inv_v1.SearchResourcesResponse, error)
+	// GetFleetStatistics returns the number of hosts matching the filter of the request, broken down along the
+	// requested dimensions.
+	GetFleetStatistics(context.Context, *inv_v1.GetFleetStatisticsRequest) (*inv_v1.GetFleetStatisticsResponse, error)
 	GetHostByUUID(ctx context.Context, uuid string) (*computev1.HostResource, error)
 	GetTreeHierarchy(context.Context, *inv_v1.GetTreeHierarchyRequest) ([]*inv_v1.GetTreeHierarchyResponse_TreeNode, error)
 	GetSitesPerRegion(context.Context, *inv_v1.GetSitesPerRegionRequest) (*inv_v1.GetSitesPerRegionResponse, error)
@@ -158,6 +174,38 @@ func (t *temporaryInventoryClient) ListInheritedTelemetryProfiles(
 	return t.ic.ListInheritedTelemetryProfiles(ctx, FakeTenantID, inheritBy, filter, orderBy, limit, offset)
 }
 
+func (t *temporaryInventoryClient) GetEffectiveTelemetryProfiles(
+	ctx context.Context,
+	request *inv_v1.GetEffectiveTelemetryProfilesRequest,
+) (*inv_v1.GetEffectiveTelemetryProfilesResponse, error) {
+	request.TenantId = FakeTenantID
+	return t.ic.GetEffectiveTelemetryProfiles(ctx, request)
+}
+
+func (t *temporaryInventoryClient) DiffEffectiveTelemetryProfiles(
+	ctx context.Context,
+	request *inv_v1.DiffEffectiveTelemetryProfilesRequest,
+) (*inv_v1.DiffEffectiveTelemetryProfilesResponse, error) {
+	request.TenantId = FakeTenantID
+	return t.ic.DiffEffectiveTelemetryProfiles(ctx, request)
+}
+
+func (t *temporaryInventoryClient) SearchResources(
+	ctx context.Context,
+	request *inv_v1.SearchResourcesRequest,
+) (*inv_v1.SearchResourcesResponse, error) {
+	request.TenantId = FakeTenantID
+	return t.ic.SearchResources(ctx, request)
+}
+
+func (t *temporaryInventoryClient) GetFleetStatistics(
+	ctx context.Context,
+	request *inv_v1.GetFleetStatisticsRequest,
+) (*inv_v1.GetFleetStatisticsResponse, error) {
+	request.TenantId = FakeTenantID
+	return t.ic.GetFleetStatistics(ctx, request)
+}
+
 func (t *temporaryInventoryClient) GetHostByUUID(ctx context.Context, uuid string) (*computev1.HostResource, error) {
 	return t.ic.GetHostByUUID(ctx, FakeTenantID, uuid)
 }
@@ -192,64 +240,10 @@ func (t *temporaryInventoryClient) TestGetClientCacheUUID() *cache.InventoryCach
 
 // setTenantID sets tenantID for any requested resource
 // TODO: code below is temporary, ot allows inventory clients.
-//
-//nolint:funlen,cyclop // this code is temporary
 func setTenantID(resource *inv_v1.Resource, tenantID string) error {
-	var message proto.Message
-	switch resource.GetResource().(type) {
-	case *inv_v1.Resource_Region:
-		message = resource.GetRegion()
-	case *inv_v1.Resource_Site:
-		message = resource.GetSite()
-	case *inv_v1.Resource_Ou:
-		message = resource.GetOu()
-	case *inv_v1.Resource_Instance:
-		message = resource.GetInstance()
-	case *inv_v1.Resource_Host:
-		message = resource.GetHost()
-	case *inv_v1.Resource_Hoststorage:
-		message = resource.GetHoststorage()
-	case *inv_v1.Resource_Hostnic:
-		message = resource.GetHostnic()
-	case *inv_v1.Resource_Hostusb:
-		message = resource.GetHostusb()
-	case *inv_v1.Resource_Hostgpu:
-		message = resource.GetHostgpu()
-	case *inv_v1.Resource_NetworkSegment:
-		message = resource.GetNetworkSegment()
-	case *inv_v1.Resource_Netlink:
-		message = resource.GetNetlink()
-	case *inv_v1.Resource_Endpoint:
-		message = resource.GetEndpoint()
-	case *inv_v1.Resource_Ipaddress:
-		message = resource.GetIpaddress()
-	case *inv_v1.Resource_Provider:
-		message = resource.GetProvider()
-	case *inv_v1.Resource_Os:
-		message = resource.GetOs()
-	case *inv_v1.Resource_Singleschedule:
-		message = resource.GetSingleschedule()
-	case *inv_v1.Resource_Repeatedschedule:
-		message = resource.GetRepeatedschedule()
-	case *inv_v1.Resource_TelemetryGroup:
-		message = resource.GetTelemetryGroup()
-	case *inv_v1.Resource_TelemetryProfile:
-		message = resource.GetTelemetryProfile()
-	case *inv_v1.Resource_Workload:
-		message = resource.GetWorkload()
-	case *inv_v1.Resource_WorkloadMember:
-		message = resource.GetWorkloadMember()
-	case *inv_v1.Resource_RemoteAccess:
-		message = resource.GetRemoteAccess()
-	case *inv_v1.Resource_LocalAccount:
-		message = resource.GetLocalAccount()
-	case *inv_v1.Resource_OsUpdatePolicy:
-		message = resource.GetOsUpdatePolicy()
-	case *inv_v1.Resource_CustomConfig:
-		message = resource.GetCustomConfig()
-	case *inv_v1.Resource_OsUpdateRun:
-		message = resource.GetOsUpdateRun()
-	default:
+	message, err := util.GetSetResource(resource)
+	// Tenants carry their own ID as tenant ID, they can't be created on behalf of another tenant.
+	if err != nil || util.GetResourceKindFromResource(resource) == inv_v1.ResourceKind_RESOURCE_KIND_TENANT {
 		return fmt.Errorf("unknown resource type: %v", resource.GetResource())
 	}
 
@@ -9,8 +9,24 @@ const (
 	ServerAddress            = "serverAddress"
 	ServerAddressDescription = "The endpoint address of this component to serve on. " +
 		"It should have the following format <IP address>:<port>."
-	EnableAuditing            = "enableAuditing"
-	EnableAuditingDescription = "Flag to enable audit logs for API calls."
+	EnableAuditing                   = "enableAuditing"
+	EnableAuditingDescription        = "Flag to enable audit logs for API calls."
+	EnforceClientIdentity            = "enforceClientIdentity"
+	EnforceClientIdentityDescription = "Flag to reject the clients without a client certificate or JWT naming " +
+		"their client kind. Clients declaring a client kind other than the one of their identity are always rejected."
+	AuditSigningKeyPath            = "auditSigningKeyPath"
+	AuditSigningKeyPathDescription = "Path to the PEM Ed25519 private key sealing the audit records. " +
+		"The audit records are chained but not sealed if empty."
+	AuditSealInterval            = "auditSealInterval"
+	AuditSealIntervalDescription = "Interval at which the audit records are sealed."
+	AuditHeadPath                = "auditHeadPath"
+	AuditHeadPathDescription     = "Path to the file persisting the last seals of the audit chains, so that the " +
+		"chains of the next run link to them. It must survive restarts. The chains of successive runs are not " +
+		"linked if empty."
+	ValidateSecretRefs            = "validateSecretRefs"
+	ValidateSecretRefsDescription = "Flag to check that the secrets referenced by the resources (secret://path#key) " +
+		"exist in the secrets backend when the resources are written, if a backend is configured. " +
+		"The references are only checked for syntax and tenant otherwise."
 )
 
 var FlagDisableCredentialsManagement = flag.Bool("disableCredentialsManagement", false,
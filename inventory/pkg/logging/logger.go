@@ -199,23 +199,25 @@ func (l *InfraCtxLogger) InfraAuditPath(path string) *InfraCtxLogger {
 }
 
 // InfraAuditRequest is a logging decorator for InfraLogger intended to be used for auditing related logs.
+// The sensitive fields of the request are redacted.
 func (l *InfraLogger) InfraAuditRequest(req interface{}) *InfraLogger {
-	return &InfraLogger{l.With().Str(REQUEST, fmt.Sprintf("%v", req)).Logger()}
+	return &InfraLogger{l.With().Str(REQUEST, fmt.Sprintf("%v", Redact(req))).Logger()}
 }
 
 // InfraAuditRequest is a logging decorator InfraCtxLogger intended to be used for auditing related logs.
 func (l *InfraCtxLogger) InfraAuditRequest(req interface{}) *InfraCtxLogger {
-	return &InfraCtxLogger{l.With().Str(REQUEST, fmt.Sprintf("%v", req)).Logger()}
+	return &InfraCtxLogger{l.With().Str(REQUEST, fmt.Sprintf("%v", Redact(req))).Logger()}
 }
 
 // InfraAuditResponse is a logging decorator for InfraLogger intended to be used for auditing related logs.
+// The sensitive fields of the response are redacted.
 func (l *InfraLogger) InfraAuditResponse(resp interface{}) *InfraLogger {
-	return &InfraLogger{l.With().Str(RESPONSE, fmt.Sprintf("%v", resp)).Logger()}
+	return &InfraLogger{l.With().Str(RESPONSE, fmt.Sprintf("%v", Redact(resp))).Logger()}
 }
 
 // InfraAuditResponse is a logging decorator InfraCtxLogger intended to be used for auditing related logs.
 func (l *InfraCtxLogger) InfraAuditResponse(resp interface{}) *InfraCtxLogger {
-	return &InfraCtxLogger{l.With().Str(RESPONSE, fmt.Sprintf("%v", resp)).Logger()}
+	return &InfraCtxLogger{l.With().Str(RESPONSE, fmt.Sprintf("%v", Redact(resp))).Logger()}
 }
 
 // InfraAuditError is a logging decorator InfraCtxLogger intended to be used for auditing related logs.
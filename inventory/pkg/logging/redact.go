@@ -0,0 +1,122 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package logging
+
+import (
+	"sync"
+
+	"entgo.io/contrib/entproto/cmd/protoc-gen-ent/options/ent"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// REDACTED replaces the values of the sensitive fields in the logs, audit records and traces.
+const REDACTED = "[REDACTED]"
+
+// sensitiveTypes caches whether the messages of a type can hold sensitive fields, by message full name.
+var sensitiveTypes sync.Map
+
+// IsSensitive tells whether the field is marked with the (ent.field).sensitive option.
+func IsSensitive(fd protoreflect.FieldDescriptor) bool {
+	opts, ok := proto.GetExtension(fd.Options(), ent.E_Field).(*ent.Field)
+	return ok && opts.GetSensitive()
+}
+
+// Redact returns a copy of v with the values of its sensitive fields, and the ones of the messages it holds, masked
+// with REDACTED, if v is a protobuf message. Other values are returned as is. Redact is meant to wrap what is logged,
+// such as Msgf("Host Created: %s", logging.Redact(host)).
+func Redact(v interface{}) interface{} {
+	m, ok := v.(proto.Message)
+	if !ok || !m.ProtoReflect().IsValid() || !holdsSensitive(m.ProtoReflect().Descriptor()) {
+		return v
+	}
+	redacted := proto.Clone(m)
+	redact(redacted.ProtoReflect())
+	return redacted
+}
+
+// holdsSensitive tells whether the messages of the type, or the ones they can hold, have sensitive fields.
+func holdsSensitive(md protoreflect.MessageDescriptor) bool {
+	if cached, ok := sensitiveTypes.Load(md.FullName()); ok {
+		return cached.(bool)
+	}
+	sensitive := reachesSensitive(md, make(map[protoreflect.FullName]bool))
+	sensitiveTypes.Store(md.FullName(), sensitive)
+	return sensitive
+}
+
+// reachesSensitive walks the message types reachable from md, the resources referencing each other.
+func reachesSensitive(md protoreflect.MessageDescriptor, seen map[protoreflect.FullName]bool) bool {
+	if seen[md.FullName()] {
+		return false
+	}
+	seen[md.FullName()] = true
+	fields := md.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if IsSensitive(fd) {
+			return true
+		}
+		if fd.IsMap() {
+			fd = fd.MapValue()
+		}
+		if fd.Message() != nil && reachesSensitive(fd.Message(), seen) {
+			return true
+		}
+	}
+	return false
+}
+
+// redact masks in place the sensitive fields of m and of the messages it holds.
+func redact(m protoreflect.Message) {
+	var populated []protoreflect.FieldDescriptor
+	m.Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		populated = append(populated, fd)
+		return true
+	})
+	for _, fd := range populated {
+		switch {
+		case IsSensitive(fd):
+			mask(m, fd)
+		case fd.IsMap():
+			if fd.MapValue().Message() != nil {
+				m.Get(fd).Map().Range(func(_ protoreflect.MapKey, v protoreflect.Value) bool {
+					redact(v.Message())
+					return true
+				})
+			}
+		case fd.IsList():
+			if fd.Message() != nil {
+				list := m.Get(fd).List()
+				for i := 0; i < list.Len(); i++ {
+					redact(list.Get(i).Message())
+				}
+			}
+		case fd.Message() != nil:
+			redact(m.Mutable(fd).Message())
+		}
+	}
+}
+
+// mask replaces the value of a sensitive field with REDACTED, or clears it when it cannot hold a string.
+func mask(m protoreflect.Message, fd protoreflect.FieldDescriptor) {
+	var masked protoreflect.Value
+	switch fd.Kind() {
+	case protoreflect.StringKind:
+		masked = protoreflect.ValueOfString(REDACTED)
+	case protoreflect.BytesKind:
+		masked = protoreflect.ValueOfBytes([]byte(REDACTED))
+	default:
+		m.Clear(fd)
+		return
+	}
+	if fd.IsList() {
+		list := m.Mutable(fd).List()
+		for i := 0; i < list.Len(); i++ {
+			list.Set(i, masked)
+		}
+		return
+	}
+	m.Set(fd, masked)
+}
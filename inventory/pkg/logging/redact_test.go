@@ -0,0 +1,51 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package logging_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+
+	computev1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/compute/v1"
+	inv_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/inventory/v1"
+	locationv1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/location/v1"
+	providerv1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/provider/v1"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/logging"
+)
+
+func TestRedact(t *testing.T) {
+	host := &computev1.HostResource{
+		ResourceId:  "host-12345678",
+		BmcUsername: "admin",
+		BmcPassword: "password",
+		Metadata:    `[{"key":"kubeconfig","value":"secret"}]`,
+		Provider:    &providerv1.ProviderResource{Name: "loca", Config: `{"password":"secret"}`},
+	}
+	req := &inv_v1.CreateResourceRequest{
+		Resource: &inv_v1.Resource{Resource: &inv_v1.Resource_Instance{Instance: &computev1.InstanceResource{
+			Host: host,
+		}}},
+	}
+	original := proto.Clone(req)
+
+	redacted := fmt.Sprintf("%v", logging.Redact(req))
+	assert.NotContains(t, redacted, "password\"")
+	assert.NotContains(t, redacted, "secret")
+	assert.Contains(t, redacted, logging.REDACTED)
+	assert.Contains(t, redacted, "bmc_username:\"admin\"")
+	assert.Contains(t, redacted, "host-12345678")
+	// The message logged is not altered.
+	assert.True(t, proto.Equal(original, req))
+
+	// The messages without sensitive fields, and other values, are returned as is.
+	region := &locationv1.RegionResource{Name: "region"}
+	assert.Same(t, region, logging.Redact(region))
+	assert.Equal(t, "request", logging.Redact("request"))
+	assert.Nil(t, logging.Redact(nil))
+	var nilHost *computev1.HostResource
+	assert.Equal(t, nilHost, logging.Redact(nilHost))
+}
@@ -116,6 +116,26 @@ func (mr *MockInventoryServiceClientMockRecorder) DeleteResource(arg0, arg1 inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteResource", reflect.TypeOf((*MockInventoryServiceClient)(nil).DeleteResource), varargs...)
 }
 
+// DiffEffectiveTelemetryProfiles mocks base method.
+func (m *MockInventoryServiceClient) DiffEffectiveTelemetryProfiles(arg0 context.Context, arg1 *inventoryv1.DiffEffectiveTelemetryProfilesRequest, arg2 ...grpc.CallOption) (*inventoryv1.DiffEffectiveTelemetryProfilesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DiffEffectiveTelemetryProfiles", varargs...)
+	ret0, _ := ret[0].(*inventoryv1.DiffEffectiveTelemetryProfilesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DiffEffectiveTelemetryProfiles indicates an expected call of DiffEffectiveTelemetryProfiles.
+func (mr *MockInventoryServiceClientMockRecorder) DiffEffectiveTelemetryProfiles(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiffEffectiveTelemetryProfiles", reflect.TypeOf((*MockInventoryServiceClient)(nil).DiffEffectiveTelemetryProfiles), varargs...)
+}
+
 // FindResources mocks base method.
 func (m *MockInventoryServiceClient) FindResources(arg0 context.Context, arg1 *inventoryv1.FindResourcesRequest, arg2 ...grpc.CallOption) (*inventoryv1.FindResourcesResponse, error) {
 	m.ctrl.T.Helper()
@@ -136,6 +156,46 @@ func (mr *MockInventoryServiceClientMockRecorder) FindResources(arg0, arg1 inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindResources", reflect.TypeOf((*MockInventoryServiceClient)(nil).FindResources), varargs...)
 }
 
+// GetEffectiveTelemetryProfiles mocks base method.
+func (m *MockInventoryServiceClient) GetEffectiveTelemetryProfiles(arg0 context.Context, arg1 *inventoryv1.GetEffectiveTelemetryProfilesRequest, arg2 ...grpc.CallOption) (*inventoryv1.GetEffectiveTelemetryProfilesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetEffectiveTelemetryProfiles", varargs...)
+	ret0, _ := ret[0].(*inventoryv1.GetEffectiveTelemetryProfilesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEffectiveTelemetryProfiles indicates an expected call of GetEffectiveTelemetryProfiles.
+func (mr *MockInventoryServiceClientMockRecorder) GetEffectiveTelemetryProfiles(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEffectiveTelemetryProfiles", reflect.TypeOf((*MockInventoryServiceClient)(nil).GetEffectiveTelemetryProfiles), varargs...)
+}
+
+// GetFleetStatistics mocks base method.
+func (m *MockInventoryServiceClient) GetFleetStatistics(arg0 context.Context, arg1 *inventoryv1.GetFleetStatisticsRequest, arg2 ...grpc.CallOption) (*inventoryv1.GetFleetStatisticsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetFleetStatistics", varargs...)
+	ret0, _ := ret[0].(*inventoryv1.GetFleetStatisticsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFleetStatistics indicates an expected call of GetFleetStatistics.
+func (mr *MockInventoryServiceClientMockRecorder) GetFleetStatistics(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFleetStatistics", reflect.TypeOf((*MockInventoryServiceClient)(nil).GetFleetStatistics), varargs...)
+}
+
 // GetResource mocks base method.
 func (m *MockInventoryServiceClient) GetResource(arg0 context.Context, arg1 *inventoryv1.GetResourceRequest, arg2 ...grpc.CallOption) (*inventoryv1.GetResourceResponse, error) {
 	m.ctrl.T.Helper()
@@ -256,6 +316,26 @@ func (mr *MockInventoryServiceClientMockRecorder) ListResources(arg0, arg1 inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListResources", reflect.TypeOf((*MockInventoryServiceClient)(nil).ListResources), varargs...)
 }
 
+// SearchResources mocks base method.
+func (m *MockInventoryServiceClient) SearchResources(arg0 context.Context, arg1 *inventoryv1.SearchResourcesRequest, arg2 ...grpc.CallOption) (*inventoryv1.SearchResourcesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SearchResources", varargs...)
+	ret0, _ := ret[0].(*inventoryv1.SearchResourcesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchResources indicates an expected call of SearchResources.
+func (mr *MockInventoryServiceClientMockRecorder) SearchResources(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchResources", reflect.TypeOf((*MockInventoryServiceClient)(nil).SearchResources), varargs...)
+}
+
 // SubscribeEvents mocks base method.
 func (m *MockInventoryServiceClient) SubscribeEvents(arg0 context.Context, arg1 *inventoryv1.SubscribeEventsRequest, arg2 ...grpc.CallOption) (inventoryv1.InventoryService_SubscribeEventsClient, error) {
 	m.ctrl.T.Helper()
@@ -379,6 +459,21 @@ func (mr *MockInventoryServiceServerMockRecorder) DeleteResource(arg0, arg1 inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteResource", reflect.TypeOf((*MockInventoryServiceServer)(nil).DeleteResource), arg0, arg1)
 }
 
+// DiffEffectiveTelemetryProfiles mocks base method.
+func (m *MockInventoryServiceServer) DiffEffectiveTelemetryProfiles(arg0 context.Context, arg1 *inventoryv1.DiffEffectiveTelemetryProfilesRequest) (*inventoryv1.DiffEffectiveTelemetryProfilesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DiffEffectiveTelemetryProfiles", arg0, arg1)
+	ret0, _ := ret[0].(*inventoryv1.DiffEffectiveTelemetryProfilesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DiffEffectiveTelemetryProfiles indicates an expected call of DiffEffectiveTelemetryProfiles.
+func (mr *MockInventoryServiceServerMockRecorder) DiffEffectiveTelemetryProfiles(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiffEffectiveTelemetryProfiles", reflect.TypeOf((*MockInventoryServiceServer)(nil).DiffEffectiveTelemetryProfiles), arg0, arg1)
+}
+
 // FindResources mocks base method.
 func (m *MockInventoryServiceServer) FindResources(arg0 context.Context, arg1 *inventoryv1.FindResourcesRequest) (*inventoryv1.FindResourcesResponse, error) {
 	m.ctrl.T.Helper()
@@ -394,6 +489,36 @@ func (mr *MockInventoryServiceServerMockRecorder) FindResources(arg0, arg1 inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindResources", reflect.TypeOf((*MockInventoryServiceServer)(nil).FindResources), arg0, arg1)
 }
 
+// GetEffectiveTelemetryProfiles mocks base method.
+func (m *MockInventoryServiceServer) GetEffectiveTelemetryProfiles(arg0 context.Context, arg1 *inventoryv1.GetEffectiveTelemetryProfilesRequest) (*inventoryv1.GetEffectiveTelemetryProfilesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEffectiveTelemetryProfiles", arg0, arg1)
+	ret0, _ := ret[0].(*inventoryv1.GetEffectiveTelemetryProfilesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEffectiveTelemetryProfiles indicates an expected call of GetEffectiveTelemetryProfiles.
+func (mr *MockInventoryServiceServerMockRecorder) GetEffectiveTelemetryProfiles(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEffectiveTelemetryProfiles", reflect.TypeOf((*MockInventoryServiceServer)(nil).GetEffectiveTelemetryProfiles), arg0, arg1)
+}
+
+// GetFleetStatistics mocks base method.
+func (m *MockInventoryServiceServer) GetFleetStatistics(arg0 context.Context, arg1 *inventoryv1.GetFleetStatisticsRequest) (*inventoryv1.GetFleetStatisticsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFleetStatistics", arg0, arg1)
+	ret0, _ := ret[0].(*inventoryv1.GetFleetStatisticsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFleetStatistics indicates an expected call of GetFleetStatistics.
+func (mr *MockInventoryServiceServerMockRecorder) GetFleetStatistics(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFleetStatistics", reflect.TypeOf((*MockInventoryServiceServer)(nil).GetFleetStatistics), arg0, arg1)
+}
+
 // GetResource mocks base method.
 func (m *MockInventoryServiceServer) GetResource(arg0 context.Context, arg1 *inventoryv1.GetResourceRequest) (*inventoryv1.GetResourceResponse, error) {
 	m.ctrl.T.Helper()
@@ -484,6 +609,21 @@ func (mr *MockInventoryServiceServerMockRecorder) ListResources(arg0, arg1 inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListResources", reflect.TypeOf((*MockInventoryServiceServer)(nil).ListResources), arg0, arg1)
 }
 
+// SearchResources mocks base method.
+func (m *MockInventoryServiceServer) SearchResources(arg0 context.Context, arg1 *inventoryv1.SearchResourcesRequest) (*inventoryv1.SearchResourcesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchResources", arg0, arg1)
+	ret0, _ := ret[0].(*inventoryv1.SearchResourcesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchResources indicates an expected call of SearchResources.
+func (mr *MockInventoryServiceServerMockRecorder) SearchResources(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchResources", reflect.TypeOf((*MockInventoryServiceServer)(nil).SearchResources), arg0, arg1)
+}
+
 // SubscribeEvents mocks base method.
 func (m *MockInventoryServiceServer) SubscribeEvents(arg0 *inventoryv1.SubscribeEventsRequest, arg1 inventoryv1.InventoryService_SubscribeEventsServer) error {
 	m.ctrl.T.Helper()
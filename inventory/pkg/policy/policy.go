@@ -6,12 +6,14 @@ package policy
 import (
 	"context"
 	"encoding/json"
+	"strings"
 
 	"github.com/open-policy-agent/opa/v1/rego"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 
 	inv_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/inventory/v1"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
@@ -26,6 +28,8 @@ const (
 	methodKey                   = "Method"
 	desiredStateKey             = "DesiredState"
 	currentStateKey             = "CurrentState"
+	fieldMaskKey                = "FieldMask"
+	writtenFieldsKey            = "WrittenFields"
 	desiredStateFN              = "desired_state"
 	currentStateFN              = "current_state"
 	PolicyBundlePath            = "policyBundlePath"
@@ -75,6 +79,42 @@ func setField(toVerify map[string]interface{}, resMessage proto.Message, fieldNa
 	return nil
 }
 
+// setWrittenFields exposes the fields written by a create or update to the policies: the field mask of the update,
+// and WrittenFields, the written fields by name with their values. These are the fields in the field mask, or all the
+// fields set in the resource when the mask is missing or empty, as the update then writes them. They are written
+// whether or not their values differ from the stored ones.
+func setWrittenFields(
+	toVerify map[string]interface{}, resMessage proto.Message, fieldMask *fieldmaskpb.FieldMask,
+) error {
+	resJSONbytes, err := protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true}.Marshal(resMessage)
+	if err != nil {
+		log.InfraSec().InfraErr(err).Msg("error marshaling resource to JSON bytes")
+		return errors.Errorfc(codes.InvalidArgument, "error marshaling resource to JSON bytes")
+	}
+	values := make(map[string]interface{})
+	if err := json.Unmarshal(resJSONbytes, &values); err != nil {
+		log.InfraSec().InfraErr(err).Msg("error while unmarshaling resource JSON bytes to JSON")
+		return errors.Errorfc(codes.InvalidArgument, "error while unmarshaling resource JSON bytes to JSON")
+	}
+
+	written := make(map[string]interface{})
+	if len(fieldMask.GetPaths()) == 0 {
+		resMessage.ProtoReflect().Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+			written[string(fd.Name())] = values[string(fd.Name())]
+			return true
+		})
+	} else {
+		toVerify[fieldMaskKey] = fieldMask.GetPaths()
+		for _, path := range fieldMask.GetPaths() {
+			// Nested paths write the field they start with.
+			field, _, _ := strings.Cut(path, ".")
+			written[field] = values[field]
+		}
+	}
+	toVerify[writtenFieldsKey] = written
+	return nil
+}
+
 func buildInputMap(inMsg interface{}) (map[string]interface{}, error) {
 	toVer := make(map[string]interface{})
 
@@ -87,6 +127,7 @@ func buildInputMap(inMsg interface{}) (map[string]interface{}, error) {
 		return nil, err
 	}
 	toVer[methodKey] = method
+	updateReq, _ := inMsg.(*inv_v1.UpdateResourceRequest)
 
 	// Create or Update
 	if resource != nil {
@@ -104,6 +145,11 @@ func buildInputMap(inMsg interface{}) (map[string]interface{}, error) {
 		if err != nil {
 			return nil, err
 		}
+
+		err = setWrittenFields(toVer, resMessage, updateReq.GetFieldMask())
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	inMsgJSONbytes, err = protojson.Marshal(reqMessage)
@@ -11,6 +11,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/suite"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 
 	computev1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/compute/v1"
 	inv_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/inventory/v1"
@@ -1939,6 +1940,129 @@ func TestPolicyVerifyUpdate(t *testing.T) { // table-driven test
 	}
 }
 
+func TestPolicyVerifyRMOnlyFields(t *testing.T) {
+	hostStatus := &inv_v1.Resource{
+		Resource: &inv_v1.Resource_Host{
+			Host: &computev1.HostResource{Name: "host", HostStatus: "Running"},
+		},
+	}
+	newHostStatus := &inv_v1.Resource{
+		Resource: &inv_v1.Resource_Host{
+			Host: &computev1.HostResource{SerialNumber: "12345678", OnboardingStatus: "Onboarded"},
+		},
+	}
+	instanceStatus := &inv_v1.Resource{
+		Resource: &inv_v1.Resource_Instance{
+			Instance: &computev1.InstanceResource{Name: "instance", ProvisioningStatus: "Provisioned"},
+		},
+	}
+	testCases := map[string]struct {
+		cliendKind inv_v1.ClientKind
+		resource   *inv_v1.Resource
+		fieldMask  *fieldmaskpb.FieldMask
+		create     bool
+		valid      bool
+	}{
+		"Test_ClientRM_Update_HostStatus_Success": {
+			cliendKind: inv_v1.ClientKind_CLIENT_KIND_RESOURCE_MANAGER,
+			resource:   hostStatus,
+			fieldMask:  &fieldmaskpb.FieldMask{Paths: []string{computev1.HostResourceFieldHostStatus}},
+			valid:      true,
+		},
+		"Test_ClientAPI_Update_HostStatus_Fail": {
+			cliendKind: inv_v1.ClientKind_CLIENT_KIND_API,
+			resource:   hostStatus,
+			fieldMask:  &fieldmaskpb.FieldMask{Paths: []string{computev1.HostResourceFieldHostStatus}},
+			valid:      false,
+		},
+		"Test_ClientAPI_Reset_OnboardingStatus_Fail": {
+			cliendKind: inv_v1.ClientKind_CLIENT_KIND_API,
+			resource:   hostStatus,
+			fieldMask: &fieldmaskpb.FieldMask{
+				Paths: []string{computev1.HostResourceFieldOnboardingStatusIndicator},
+			},
+			valid: false,
+		},
+		"Test_ClientTC_Update_PowerStatus_Fail": {
+			cliendKind: inv_v1.ClientKind_CLIENT_KIND_TENANT_CONTROLLER,
+			resource:   hostStatus,
+			fieldMask:  &fieldmaskpb.FieldMask{Paths: []string{computev1.HostResourceFieldPowerStatus}},
+			valid:      false,
+		},
+		"Test_ClientAPI_Update_HostName_Success": {
+			// Only the fields in the field mask are written.
+			cliendKind: inv_v1.ClientKind_CLIENT_KIND_API,
+			resource:   hostStatus,
+			fieldMask:  &fieldmaskpb.FieldMask{Paths: []string{computev1.HostResourceFieldName}},
+			valid:      true,
+		},
+		"Test_ClientAPI_Update_HostStatus_NoFieldMask_Fail": {
+			cliendKind: inv_v1.ClientKind_CLIENT_KIND_API,
+			resource:   hostStatus,
+			valid:      false,
+		},
+		"Test_ClientAPI_Update_HostStatus_EmptyFieldMask_Fail": {
+			// An empty field mask writes all the fields set in the resource.
+			cliendKind: inv_v1.ClientKind_CLIENT_KIND_API,
+			resource:   hostStatus,
+			fieldMask:  &fieldmaskpb.FieldMask{},
+			valid:      false,
+		},
+		"Test_ClientAPI_Create_HostStatus_Fail": {
+			cliendKind: inv_v1.ClientKind_CLIENT_KIND_API,
+			resource:   newHostStatus,
+			create:     true,
+			valid:      false,
+		},
+		"Test_ClientRM_Create_HostStatus_Success": {
+			cliendKind: inv_v1.ClientKind_CLIENT_KIND_RESOURCE_MANAGER,
+			resource:   newHostStatus,
+			create:     true,
+			valid:      true,
+		},
+		"Test_ClientRM_Update_ProvisioningStatus_Success": {
+			cliendKind: inv_v1.ClientKind_CLIENT_KIND_RESOURCE_MANAGER,
+			resource:   instanceStatus,
+			fieldMask: &fieldmaskpb.FieldMask{
+				Paths: []string{computev1.InstanceResourceFieldProvisioningStatus},
+			},
+			valid: true,
+		},
+		"Test_ClientAPI_Update_ProvisioningStatus_Fail": {
+			cliendKind: inv_v1.ClientKind_CLIENT_KIND_API,
+			resource:   instanceStatus,
+			fieldMask: &fieldmaskpb.FieldMask{
+				Paths: []string{computev1.InstanceResourceFieldProvisioningStatus},
+			},
+			valid: false,
+		},
+	}
+
+	pol, err := loadPolicyBundle(bundlePath)
+	if err != nil {
+		t.Errorf("new policy instance create error %s", err.Error())
+	}
+
+	for testName, testCase := range testCases {
+		t.Run(testName, func(t *testing.T) {
+			var req interface{} = &inv_v1.UpdateResourceRequest{
+				Resource:   testCase.resource,
+				ResourceId: hostID,
+				FieldMask:  testCase.fieldMask,
+			}
+			if testCase.create {
+				req = &inv_v1.CreateResourceRequest{Resource: testCase.resource}
+			}
+			polVerifyErr := pol.Verify(testCase.cliendKind.String(), req)
+			if testCase.valid && polVerifyErr != nil {
+				t.Errorf("policy verification error %s", polVerifyErr.Error())
+			} else if !testCase.valid && polVerifyErr == nil {
+				t.Errorf("policy verification should have errored for test case %s", testName)
+			}
+		})
+	}
+}
+
 func TestVerifyDelete(t *testing.T) {
 	testCases := map[string]struct {
 		cliendKind inv_v1.ClientKind
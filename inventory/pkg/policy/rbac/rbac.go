@@ -33,12 +33,15 @@ const (
 	CreateKey             = "Create"
 	RegisterKey           = "Register"
 	UpdateKey             = "Update"
+	// SubjectClaimKey is the claim identifying the principal, matched against the subject of role bindings.
+	SubjectClaimKey = "sub"
 )
 
 var (
 	zlog  = logging.GetLogger("infra-rbac")
 	woKey = "write-only"
 	roKey = "read-only"
+	scKey = "scoped"
 )
 
 type Policy struct {
@@ -72,9 +75,47 @@ func New(ruleDir string) (*Policy, error) {
 	}
 	policies.queries[roKey] = &roQuery
 
+	scQuery, err := rego.New(
+		rego.Query("data.authz.scopedTenants"),
+		rego.Load([]string{ruleDir}, nil), // loads all files within directory
+	).PrepareForEval(ctx)
+	if err != nil {
+		zlog.InfraSec().InfraErr(err).Msgf("can't load scoped query")
+		return nil, errors.Wrap(err)
+	}
+	policies.queries[scKey] = &scQuery
+
 	return &policies, nil
 }
 
+// ScopedTenants returns the tenants in which the claims grant the scoped role. Principals holding it have no access
+// through Verify, their access is restricted to the locations of their role bindings in these tenants.
+func (p *Policy) ScopedTenants(ctxClaims metautils.NiceMD) []string {
+	query, ok := p.queries[scKey]
+	if !ok {
+		return nil
+	}
+	result, err := query.Eval(context.Background(), rego.EvalInput(ctxClaims))
+	if err != nil {
+		zlog.InfraSec().InfraErr(err).Msg("can't evaluate scoped query")
+		return nil
+	}
+	if len(result) == 0 || len(result[0].Expressions) == 0 {
+		return nil
+	}
+	values, ok := result[0].Expressions[0].Value.([]interface{})
+	if !ok {
+		return nil
+	}
+	tenants := make([]string, 0, len(values))
+	for _, value := range values {
+		if tenant, ok := value.(string); ok {
+			tenants = append(tenants, tenant)
+		}
+	}
+	return tenants
+}
+
 func (p *Policy) Verify(ctxClaims metautils.NiceMD, operation string) error {
 	allowed := false
 
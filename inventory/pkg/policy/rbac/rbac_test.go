@@ -430,6 +430,20 @@ func TestAuthorizeOPA(t *testing.T) {
 				rbac.PostKey, rbac.PatchKey, rbac.CreateKey, rbac.DeleteKey, rbac.PutKey,
 			},
 		},
+		{
+			name: "scoped role UUID",
+			md: func() metautils.NiceMD {
+				roIMNiceMdNBI := metautils.ExtractIncoming(ctx)
+				roIMNiceMdNBI.Add("realm_access/roles", "00000000-0000-0000-0000-000000000000_im-scoped")
+				return roIMNiceMdNBI
+			}(),
+			valid:      false,
+			expErrCode: codes.PermissionDenied,
+			methods: []string{
+				rbac.GetKey, rbac.ListKey, rbac.FindKey,
+				rbac.PostKey, rbac.PatchKey, rbac.CreateKey, rbac.DeleteKey, rbac.PutKey,
+			},
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -446,6 +460,39 @@ func TestAuthorizeOPA(t *testing.T) {
 	}
 }
 
+func TestScopedTenants(t *testing.T) {
+	p, err := loadPolicyBundle(regoPath)
+	require.NoError(t, err)
+
+	tenant1 := "00000000-0000-0000-0000-000000000001"
+	tenant2 := "00000000-0000-0000-0000-000000000002"
+	testCases := map[string]struct {
+		roles   []string
+		tenants []string
+	}{
+		"NoRoles": {},
+		"UnscopedRoles": {
+			roles: []string{tenant1 + "_im-rw", tenant2 + "_im-r", "im-scoped"},
+		},
+		"ScopedRoles": {
+			roles:   []string{tenant1 + "_im-scoped", tenant2 + "_im-r", tenant2 + "_im-scoped"},
+			tenants: []string{tenant1, tenant2},
+		},
+		"WrongUUID": {
+			roles: []string{"00000000-0000-0000--000000000000_im-scoped"},
+		},
+	}
+	for tcname, tc := range testCases {
+		t.Run(tcname, func(t *testing.T) {
+			md := metautils.ExtractIncoming(context.TODO())
+			for _, role := range tc.roles {
+				md.Add("realm_access/roles", role)
+			}
+			assert.ElementsMatch(t, tc.tenants, p.ScopedTenants(md))
+		})
+	}
+}
+
 func TestAuthorizeOPAError(t *testing.T) {
 	_, err := loadPolicyBundle("")
 	require.Error(t, err)
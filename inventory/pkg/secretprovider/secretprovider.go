@@ -76,7 +76,7 @@ func (ss *secretProvider) Init(ctx context.Context, secretNames []string) error
 
 		dataMap, ok := credentials["data"].(map[string]interface{})
 		if !ok {
-			err = inv_errors.Errorf("Cannot read credentials data from Vault secret")
+			err = inv_errors.Errorf("Cannot read credentials data from secret %s", secretName)
 			zlog.InfraSec().Err(err).Msg("")
 			return err
 		}
@@ -84,7 +84,7 @@ func (ss *secretProvider) Init(ctx context.Context, secretNames []string) error
 		for secretKey, secretValue := range dataMap {
 			secret, ok := secretValue.(string)
 			if !ok {
-				err = inv_errors.Errorf("Wrong format of %v read from Vault, expected string, got %T", secretKey, secretValue)
+				err = inv_errors.Errorf("Wrong format of %v read from secret, expected string, got %T", secretKey, secretValue)
 				zlog.InfraSec().Err(err).Msg("")
 				return err
 			}
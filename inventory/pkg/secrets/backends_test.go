@@ -0,0 +1,279 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+//nolint:testpackage // testing internal functions
+package secrets
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/mocks"
+)
+
+func secretWithData(data map[string]interface{}) map[string]interface{} {
+	return map[string]interface{}{"data": data}
+}
+
+// testBackend checks the semantics shared by all the backends.
+func testBackend(t *testing.T, svc SecretsService) {
+	t.Helper()
+	ctx := context.Background()
+
+	_, err := svc.ReadSecret(ctx, "kubeconfig/host-12345678")
+	assert.True(t, errors.IsNotFound(err))
+
+	_, err = svc.WriteSecret(ctx, "kubeconfig/host-12345678", secretWithData(map[string]interface{}{"kc": "v1"}))
+	require.NoError(t, err)
+	_, err = svc.WriteSecret(ctx, "kubeconfig/host-12345678", secretWithData(map[string]interface{}{"kc": "v2"}))
+	require.NoError(t, err)
+	secret, err := svc.ReadSecret(ctx, "kubeconfig/host-12345678")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"kc": "v2"}, secret["data"])
+
+	_, err = svc.WriteSecret(ctx, "kubeconfig/host-12345678", map[string]interface{}{"kc": "v3"})
+	assert.True(t, errors.IsInvalidArgument(err))
+	svc.Logout(ctx)
+}
+
+func TestFileService(t *testing.T) {
+	key := make([]byte, fileKeySize)
+	path := filepath.Join(t.TempDir(), "secrets.enc")
+	svc, err := newFileServiceWithKey(path, key)
+	require.NoError(t, err)
+	testBackend(t, svc)
+
+	// The secrets are encrypted at rest, and only readable with the key.
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.NotContains(t, string(content), "kubeconfig")
+
+	otherKey := make([]byte, fileKeySize)
+	otherKey[0] = 1
+	other, err := newFileServiceWithKey(path, otherKey)
+	require.NoError(t, err)
+	_, err = other.ReadSecret(context.Background(), "kubeconfig/host-12345678")
+	assert.Error(t, err)
+	assert.False(t, errors.IsNotFound(err))
+}
+
+// fakeKubernetesAPI serves the Secrets API of a namespace from memory.
+type fakeKubernetesAPI struct {
+	mu      sync.Mutex
+	secrets map[string]kubernetesSecret
+}
+
+func (f *fakeKubernetesAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if r.Header.Get("Authorization") != "Bearer token" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	name := strings.TrimPrefix(r.URL.Path, "/api/v1/namespaces/infra/secrets")
+	name = strings.TrimPrefix(name, "/")
+	if r.Method == http.MethodGet {
+		secret, ok := f.secrets[name]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(secret)
+		return
+	}
+
+	body, _ := io.ReadAll(r.Body)
+	var secret kubernetesSecret
+	if err := json.Unmarshal(body, &secret); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	if _, ok := f.secrets[secret.Metadata.Name]; ok && r.Method == http.MethodPost {
+		w.WriteHeader(http.StatusConflict)
+		return
+	}
+	secret.Data = make(map[string][]byte)
+	for key, value := range secret.StringData {
+		secret.Data[key] = []byte(value)
+	}
+	secret.StringData = nil
+	secret.Metadata.CreationTimestamp = time.Now().UTC().Format(time.RFC3339)
+	f.secrets[secret.Metadata.Name] = secret
+	w.WriteHeader(http.StatusCreated)
+	_ = json.NewEncoder(w).Encode(secret)
+}
+
+func TestKubernetesService(t *testing.T) {
+	api := &fakeKubernetesAPI{secrets: make(map[string]kubernetesSecret)}
+	server := httptest.NewServer(api)
+	defer server.Close()
+	tokenPath := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(tokenPath, []byte("token\n"), 0o600))
+
+	svc := &kubernetesService{client: server.Client(), apiURL: server.URL, tokenPath: tokenPath, namespace: "infra"}
+	testBackend(t, svc)
+	name, err := kubernetesSecretName("kubeconfig/host-12345678")
+	require.NoError(t, err)
+	require.Contains(t, api.secrets, name)
+	assert.Equal(t, "kubeconfig/host-12345678", api.secrets[name].Metadata.Annotations[kubernetesPathAnnotation])
+
+	_, err = svc.WriteSecret(context.Background(), "numbers", secretWithData(map[string]interface{}{"n": 1}))
+	assert.True(t, errors.IsInvalidArgument(err))
+	_, err = svc.WriteSecret(context.Background(), "", secretWithData(map[string]interface{}{"n": "1"}))
+	assert.True(t, errors.IsInvalidArgument(err))
+	_, err = svc.ReadSecret(context.Background(), "")
+	assert.True(t, errors.IsInvalidArgument(err))
+
+	// The Secrets storing another secret are neither read nor replaced.
+	other := api.secrets[name]
+	other.Metadata.Annotations = map[string]string{kubernetesPathAnnotation: "kubeconfig/host-87654321"}
+	api.secrets[name] = other
+	_, err = svc.ReadSecret(context.Background(), "kubeconfig/host-12345678")
+	assert.True(t, errors.IsInvalidArgument(err))
+	_, err = svc.WriteSecret(context.Background(), "kubeconfig/host-12345678",
+		secretWithData(map[string]interface{}{"kubeconfig": "config"}))
+	assert.True(t, errors.IsInvalidArgument(err))
+	assert.Equal(t, "kubeconfig/host-87654321", api.secrets[name].Metadata.Annotations[kubernetesPathAnnotation])
+
+	require.NoError(t, os.WriteFile(tokenPath, []byte("expired"), 0o600))
+	_, err = svc.ReadSecret(context.Background(), "kubeconfig/host-12345678")
+	assert.Error(t, err)
+	assert.False(t, errors.IsNotFound(err))
+}
+
+func TestKubernetesSecretName(t *testing.T) {
+	for path, prefix := range map[string]string{
+		"kubeconfig/host-12345678":       "kubeconfig.host-12345678-",
+		"host-manager-m2m-client-secret": "host-manager-m2m-client-secret-",
+		"/LOCA_credentials/":             "loca-credentials-",
+	} {
+		name, err := kubernetesSecretName(path)
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(name, prefix), name)
+		assert.Len(t, name, len(prefix)+kubernetesNameHashLength)
+	}
+
+	// Paths differing only by the characters replaced in names are stored in different Secrets.
+	names := make(map[string]bool)
+	for _, path := range []string{"loca/credentials", "loca.credentials", "LOCA/credentials", "loca_credentials",
+		"loca-credentials", "/loca/credentials"} {
+		name, err := kubernetesSecretName(path)
+		require.NoError(t, err)
+		assert.False(t, names[name], path)
+		names[name] = true
+	}
+
+	name, err := kubernetesSecretName(strings.Repeat("a", 300))
+	require.NoError(t, err)
+	assert.Len(t, name, kubernetesMaxNameLength)
+	name, err = kubernetesSecretName("___")
+	require.NoError(t, err)
+	assert.Len(t, name, kubernetesNameHashLength)
+	_, err = kubernetesSecretName("")
+	assert.True(t, errors.IsInvalidArgument(err))
+}
+
+func TestCachingService(t *testing.T) {
+	ctx := context.Background()
+	backend := mocks.NewMockSecretsService(gomock.NewController(t))
+	connections := 0
+	newBackend := func(context.Context) (SecretsService, error) {
+		connections++
+		return backend, nil
+	}
+	cache := newSecretCache()
+	secret := secretWithData(map[string]interface{}{"password": "admin"})
+
+	backend.EXPECT().ReadSecret(gomock.Any(), "bmc").DoAndReturn(
+		func(context.Context, string) (map[string]interface{}, error) {
+			return secretWithData(map[string]interface{}{"password": "admin"}), nil
+		}).Times(2)
+	backend.EXPECT().WriteSecret(gomock.Any(), "bmc", gomock.Any()).Return(nil, nil)
+	backend.EXPECT().Logout(gomock.Any()).Times(2)
+
+	svc := newCachingService(cache, time.Hour, newBackend)
+	read, err := svc.ReadSecret(ctx, "bmc")
+	require.NoError(t, err)
+	assert.Equal(t, secret, read)
+	// Altering the secret read does not alter the cache.
+	read["data"].(map[string]interface{})["password"] = "changed"
+	svc.Logout(ctx)
+
+	// Other services share the cache, and only connect to the backend on misses.
+	svc = newCachingService(cache, time.Hour, newBackend)
+	read, err = svc.ReadSecret(ctx, "bmc")
+	require.NoError(t, err)
+	assert.Equal(t, secret, read)
+	svc.Logout(ctx)
+	assert.Equal(t, 1, connections)
+
+	// Writes invalidate the cache.
+	_, err = svc.WriteSecret(ctx, "bmc", secret)
+	require.NoError(t, err)
+	_, err = svc.ReadSecret(ctx, "bmc")
+	require.NoError(t, err)
+	svc.Logout(ctx)
+	assert.Equal(t, 2, connections)
+}
+
+func TestCachingService_Expiry(t *testing.T) {
+	ctx := context.Background()
+	backend := mocks.NewMockSecretsService(gomock.NewController(t))
+	backend.EXPECT().ReadSecret(gomock.Any(), "bmc").Return(secretWithData(nil), nil).Times(2)
+	svc := newCachingService(newSecretCache(), time.Millisecond, func(context.Context) (SecretsService, error) {
+		return backend, nil
+	})
+
+	_, err := svc.ReadSecret(ctx, "bmc")
+	require.NoError(t, err)
+	time.Sleep(5 * time.Millisecond)
+	_, err = svc.ReadSecret(ctx, "bmc")
+	require.NoError(t, err)
+}
+
+func TestNewSecretsService(t *testing.T) {
+	ctx := context.Background()
+	t.Setenv(EnvNameSecretsBackend, "unknown")
+	_, err := NewSecretsService(ctx)
+	assert.True(t, errors.IsInvalidArgument(err))
+
+	t.Setenv(EnvNameSecretsBackend, BackendFile)
+	t.Setenv(EnvNameSecretsFilePath, filepath.Join(t.TempDir(), "secrets.enc"))
+	_, err = NewSecretsService(ctx)
+	assert.True(t, errors.IsInvalidArgument(err), "the file backend requires a key")
+
+	t.Setenv(EnvNameSecretsFileKey, base64.StdEncoding.EncodeToString(make([]byte, fileKeySize)))
+	svc, err := NewSecretsService(ctx)
+	require.NoError(t, err)
+	assert.IsType(t, &fileService{}, svc)
+
+	t.Setenv(EnvNameSecretsCacheTTL, "1m")
+	svc, err = NewSecretsService(ctx)
+	require.NoError(t, err)
+	assert.IsType(t, &cachingService{}, svc)
+
+	t.Setenv(EnvNameSecretsCacheTTL, "soon")
+	_, err = NewSecretsService(ctx)
+	assert.True(t, errors.IsInvalidArgument(err))
+
+	t.Setenv(EnvNameSecretsCacheTTL, "")
+	t.Setenv(EnvNameSecretsBackend, BackendKubernetes)
+	t.Setenv("KUBERNETES_SERVICE_HOST", "")
+	_, err = NewSecretsService(ctx)
+	assert.Error(t, err)
+}
@@ -0,0 +1,128 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package secrets
+
+import (
+	"context"
+	"maps"
+	"sync"
+	"time"
+)
+
+// sharedCache is the cache of the services returned by NewSecretsService, so that it outlives them.
+var sharedCache = newSecretCache()
+
+type cachedSecret struct {
+	secret  map[string]interface{}
+	expires time.Time
+}
+
+// secretCache caches the secrets read, by path.
+type secretCache struct {
+	mu      sync.Mutex
+	secrets map[string]cachedSecret
+}
+
+func newSecretCache() *secretCache {
+	return &secretCache{secrets: make(map[string]cachedSecret)}
+}
+
+func (c *secretCache) get(path string) (map[string]interface{}, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	cached, ok := c.secrets[path]
+	if !ok || time.Now().After(cached.expires) {
+		delete(c.secrets, path)
+		return nil, false
+	}
+	return cloneSecret(cached.secret), true
+}
+
+func (c *secretCache) set(path string, secret map[string]interface{}, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.secrets[path] = cachedSecret{secret: cloneSecret(secret), expires: time.Now().Add(ttl)}
+}
+
+func (c *secretCache) invalidate(path string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.secrets, path)
+}
+
+// cloneSecret copies the secret and its data, so that the callers cannot alter the cached secrets.
+func cloneSecret(secret map[string]interface{}) map[string]interface{} {
+	clone := maps.Clone(secret)
+	if data, ok := clone["data"].(map[string]interface{}); ok {
+		clone["data"] = maps.Clone(data)
+	}
+	return clone
+}
+
+// cachingService serves the secrets from the cache while they are fresh, and from the backend otherwise. The
+// backend is only connected to on cache misses and writes, which avoids logging in to Vault for every read.
+type cachingService struct {
+	cache      *secretCache
+	ttl        time.Duration
+	newBackend func(ctx context.Context) (SecretsService, error)
+
+	mu      sync.Mutex
+	backend SecretsService
+}
+
+func newCachingService(
+	cache *secretCache, ttl time.Duration, newBackend func(ctx context.Context) (SecretsService, error),
+) SecretsService {
+	return &cachingService{cache: cache, ttl: ttl, newBackend: newBackend}
+}
+
+func (s *cachingService) getBackend(ctx context.Context) (SecretsService, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.backend == nil {
+		backend, err := s.newBackend(ctx)
+		if err != nil {
+			return nil, err
+		}
+		s.backend = backend
+	}
+	return s.backend, nil
+}
+
+func (s *cachingService) ReadSecret(ctx context.Context, path string) (map[string]interface{}, error) {
+	if secret, ok := s.cache.get(path); ok {
+		return secret, nil
+	}
+	backend, err := s.getBackend(ctx)
+	if err != nil {
+		return nil, err
+	}
+	secret, err := backend.ReadSecret(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	s.cache.set(path, secret, s.ttl)
+	return secret, nil
+}
+
+func (s *cachingService) WriteSecret(ctx context.Context, path string, secret map[string]interface{}) (
+	map[string]interface{}, error,
+) {
+	backend, err := s.getBackend(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// The secret is read from the backend next time, whether the write succeeded or not.
+	defer s.cache.invalidate(path)
+	return backend.WriteSecret(ctx, path, secret)
+}
+
+func (s *cachingService) Logout(ctx context.Context) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.backend != nil {
+		s.backend.Logout(ctx)
+		s.backend = nil
+	}
+}
@@ -0,0 +1,155 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package secrets
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
+)
+
+const (
+	// EnvNameSecretsFilePath is the file storing the secrets of the file backend.
+	EnvNameSecretsFilePath = "SECRETS_FILE_PATH"
+	// EnvNameSecretsFileKey is the base64 encoded AES-256 key encrypting the file of the file backend.
+	EnvNameSecretsFileKey = "SECRETS_FILE_KEY"
+
+	DefaultSecretsFilePath = "secrets.enc"
+
+	fileKeySize = 32
+)
+
+// fileMu serializes the accesses to the secrets files of this process.
+var fileMu sync.Mutex
+
+// fileService stores the secrets in a local file encrypted with AES-GCM, for development and CI where no Vault or
+// Kubernetes cluster is available. The file is shared by the processes using it, but is not meant for concurrent
+// writes from several processes.
+type fileService struct {
+	path string
+	aead cipher.AEAD
+}
+
+func newFileService(_ context.Context) (SecretsService, error) {
+	path := os.Getenv(EnvNameSecretsFilePath)
+	if path == "" {
+		zlog.InfraSec().Warn().Msgf("%s env variable is not set, using default value", EnvNameSecretsFilePath)
+		path = DefaultSecretsFilePath
+	}
+	key, err := base64.StdEncoding.DecodeString(os.Getenv(EnvNameSecretsFileKey))
+	if err != nil || len(key) != fileKeySize {
+		zlog.InfraSec().InfraError("%s must be a base64 encoded %d bytes key", EnvNameSecretsFileKey, fileKeySize).Msg("")
+		return nil, errors.Errorfc(codes.InvalidArgument, "%s must be a base64 encoded %d bytes key",
+			EnvNameSecretsFileKey, fileKeySize)
+	}
+	return newFileServiceWithKey(path, key)
+}
+
+func newFileServiceWithKey(path string, key []byte) (*fileService, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	return &fileService{path: path, aead: aead}, nil
+}
+
+// load decrypts the secrets of the file, by path. A missing file holds no secrets.
+func (f *fileService) load() (map[string]map[string]interface{}, error) {
+	secrets := make(map[string]map[string]interface{})
+	sealed, err := os.ReadFile(f.path)
+	if os.IsNotExist(err) {
+		return secrets, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	nonceSize := f.aead.NonceSize()
+	if len(sealed) < nonceSize {
+		return nil, errors.Errorf("secrets file %s is truncated", f.path)
+	}
+	plain, err := f.aead.Open(nil, sealed[:nonceSize], sealed[nonceSize:], nil)
+	if err != nil {
+		return nil, errors.Errorf("failed to decrypt secrets file %s, is the key right?", f.path)
+	}
+	if err := json.Unmarshal(plain, &secrets); err != nil {
+		return nil, err
+	}
+	return secrets, nil
+}
+
+// save encrypts the secrets to the file, replacing it atomically.
+func (f *fileService) save(secrets map[string]map[string]interface{}) error {
+	plain, err := json.Marshal(secrets)
+	if err != nil {
+		return err
+	}
+	nonce := make([]byte, f.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(f.path), filepath.Base(f.path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(f.aead.Seal(nonce, nonce, plain, nil)); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), f.path)
+}
+
+func (f *fileService) ReadSecret(_ context.Context, path string) (map[string]interface{}, error) {
+	fileMu.Lock()
+	defer fileMu.Unlock()
+	secrets, err := f.load()
+	if err != nil {
+		return nil, errBackendUnavailable("read", path, err)
+	}
+	data, ok := secrets[path]
+	if !ok {
+		return nil, errSecretNotFound(path)
+	}
+	return map[string]interface{}{"data": data}, nil
+}
+
+func (f *fileService) WriteSecret(_ context.Context, path string, secret map[string]interface{}) (
+	map[string]interface{}, error,
+) {
+	data, err := secretData(path, secret)
+	if err != nil {
+		return nil, err
+	}
+	fileMu.Lock()
+	defer fileMu.Unlock()
+	secrets, err := f.load()
+	if err != nil {
+		return nil, errBackendUnavailable("write", path, err)
+	}
+	secrets[path] = data
+	if err := f.save(secrets); err != nil {
+		return nil, errBackendUnavailable("write", path, err)
+	}
+	return map[string]interface{}{"created_time": time.Now().UTC().Format(time.RFC3339Nano)}, nil
+}
+
+func (f *fileService) Logout(_ context.Context) {}
@@ -0,0 +1,264 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package secrets
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
+)
+
+const (
+	// EnvNameSecretsKubernetesNamespace is the namespace of the Secrets of the Kubernetes backend, the namespace of
+	// the service account of the pod by default.
+	EnvNameSecretsKubernetesNamespace = "SECRETS_KUBERNETES_NAMESPACE"
+
+	// kubernetesPathAnnotation records the path of the secret stored in a Secret.
+	kubernetesPathAnnotation = "secrets.edge-orchestrator.intel.com/path"
+	kubernetesMaxNameLength  = 253
+	// kubernetesNameHashLength is the length of the hash of the path suffixing the names of the Secrets.
+	kubernetesNameHashLength = 16
+)
+
+// kubernetesServiceAccountDir holds the credentials of the service account of the pod.
+var kubernetesServiceAccountDir = "/var/run/secrets/kubernetes.io/serviceaccount"
+
+var kubernetesInvalidNameChars = regexp.MustCompile(`[^a-z0-9.-]+`)
+
+// kubernetesService stores the secrets in Kubernetes Secrets of a namespace, authenticating with the service account
+// of the pod. The secret at path a/b is stored in the Secret named a.b-<hash of a/b>, see kubernetesSecretName.
+type kubernetesService struct {
+	client    *http.Client
+	apiURL    string
+	tokenPath string
+	namespace string
+}
+
+func newKubernetesService(_ context.Context) (SecretsService, error) {
+	host, port := os.Getenv("KUBERNETES_SERVICE_HOST"), os.Getenv("KUBERNETES_SERVICE_PORT")
+	if host == "" || port == "" {
+		zlog.InfraSec().InfraError("not running in a Kubernetes cluster").Msg("")
+		return nil, errors.Errorfc(codes.Unavailable, "Kubernetes secrets backend is only available in a cluster")
+	}
+	caCert, err := os.ReadFile(filepath.Join(kubernetesServiceAccountDir, "ca.crt"))
+	if err != nil {
+		zlog.InfraSec().Err(err).Msg("Failed to read the CA of the Kubernetes API server")
+		return nil, errors.Errorfc(codes.Unavailable, "Failed to read the CA of the Kubernetes API server")
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(caCert) {
+		return nil, errors.Errorfc(codes.Unavailable, "Invalid CA of the Kubernetes API server")
+	}
+
+	namespace := os.Getenv(EnvNameSecretsKubernetesNamespace)
+	if namespace == "" {
+		ns, err := os.ReadFile(filepath.Join(kubernetesServiceAccountDir, "namespace"))
+		if err != nil {
+			zlog.InfraSec().Err(err).Msg("Failed to read the namespace of the service account")
+			return nil, errors.Errorfc(codes.Unavailable, "Failed to read the namespace of the service account")
+		}
+		namespace = strings.TrimSpace(string(ns))
+	}
+
+	client := &http.Client{
+		Timeout: DefaultTimeout,
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12},
+		},
+	}
+	return &kubernetesService{
+		client:    client,
+		apiURL:    "https://" + net.JoinHostPort(host, port),
+		tokenPath: filepath.Join(kubernetesServiceAccountDir, "token"),
+		namespace: namespace,
+	}, nil
+}
+
+// kubernetesSecretName returns the name of the Secret storing the secret at path: the path lowercased, with its
+// slashes replaced by dots and the other characters invalid in names by dashes, suffixed by a hash of the path so that
+// paths differing only by these characters are stored in different Secrets.
+func kubernetesSecretName(path string) (string, error) {
+	if path == "" {
+		zlog.InfraSec().InfraError("empty secret path").Msg("")
+		return "", errors.Errorfc(codes.InvalidArgument, "empty secret path")
+	}
+	sum := sha256.Sum256([]byte(path))
+	hash := hex.EncodeToString(sum[:])[:kubernetesNameHashLength]
+
+	prefix := strings.ReplaceAll(strings.ToLower(path), "/", ".")
+	prefix = strings.Trim(kubernetesInvalidNameChars.ReplaceAllString(prefix, "-"), ".-")
+	if maxLength := kubernetesMaxNameLength - len(hash) - 1; len(prefix) > maxLength {
+		prefix = strings.TrimRight(prefix[:maxLength], ".-")
+	}
+	if prefix == "" {
+		return hash, nil
+	}
+	return prefix + "-" + hash, nil
+}
+
+type kubernetesSecret struct {
+	APIVersion string            `json:"apiVersion"`
+	Kind       string            `json:"kind"`
+	Metadata   kubernetesMeta    `json:"metadata"`
+	Type       string            `json:"type,omitempty"`
+	Data       map[string][]byte `json:"data,omitempty"`
+	StringData map[string]string `json:"stringData,omitempty"`
+}
+
+type kubernetesMeta struct {
+	Name              string            `json:"name"`
+	Namespace         string            `json:"namespace,omitempty"`
+	Annotations       map[string]string `json:"annotations,omitempty"`
+	CreationTimestamp string            `json:"creationTimestamp,omitempty"`
+}
+
+// do sends a request to the Kubernetes API, and returns the status code and body of the response.
+func (k *kubernetesService) do(ctx context.Context, method, name string, body any) (int, []byte, error) {
+	token, err := os.ReadFile(k.tokenPath)
+	if err != nil {
+		return 0, nil, err
+	}
+	u := fmt.Sprintf("%s/api/v1/namespaces/%s/secrets", k.apiURL, url.PathEscape(k.namespace))
+	if name != "" {
+		u += "/" + url.PathEscape(name)
+	}
+	var reqBody io.Reader
+	if body != nil {
+		encoded, err := json.Marshal(body)
+		if err != nil {
+			return 0, nil, err
+		}
+		reqBody = bytes.NewReader(encoded)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, u, reqBody)
+	if err != nil {
+		return 0, nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+strings.TrimSpace(string(token)))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	resp, err := k.client.Do(req)
+	if err != nil {
+		return 0, nil, err
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	return resp.StatusCode, respBody, err
+}
+
+// getSecret returns the Secret storing the secret at path. The Secrets recording another path are rejected.
+func (k *kubernetesService) getSecret(ctx context.Context, name, path string) (*kubernetesSecret, error) {
+	code, body, err := k.do(ctx, http.MethodGet, name, nil)
+	switch {
+	case err != nil:
+		return nil, errBackendUnavailable("read", path, err)
+	case code == http.StatusNotFound:
+		return nil, errSecretNotFound(path)
+	case code != http.StatusOK:
+		return nil, errBackendUnavailable("read", path, fmt.Errorf("unexpected status %d: %s", code, body))
+	}
+
+	var secret kubernetesSecret
+	if err := json.Unmarshal(body, &secret); err != nil {
+		return nil, errBackendUnavailable("read", path, err)
+	}
+	if stored := secret.Metadata.Annotations[kubernetesPathAnnotation]; stored != path {
+		zlog.InfraSec().InfraError("Secret %s stores secret %q, not %s", name, stored, path).Msg("")
+		return nil, errors.Errorfc(codes.InvalidArgument, "Secret %s does not store secret %s", name, path)
+	}
+	return &secret, nil
+}
+
+func (k *kubernetesService) ReadSecret(ctx context.Context, path string) (map[string]interface{}, error) {
+	name, err := kubernetesSecretName(path)
+	if err != nil {
+		return nil, err
+	}
+	secret, err := k.getSecret(ctx, name, path)
+	if err != nil {
+		return nil, err
+	}
+	data := make(map[string]interface{}, len(secret.Data))
+	for key, value := range secret.Data {
+		data[key] = string(value)
+	}
+	return map[string]interface{}{
+		"data":     data,
+		"metadata": map[string]interface{}{"created_time": secret.Metadata.CreationTimestamp},
+	}, nil
+}
+
+// WriteSecret creates or replaces the Secret storing the secret. Kubernetes Secrets only hold strings.
+func (k *kubernetesService) WriteSecret(ctx context.Context, path string, secret map[string]interface{}) (
+	map[string]interface{}, error,
+) {
+	data, err := secretData(path, secret)
+	if err != nil {
+		return nil, err
+	}
+	stringData := make(map[string]string, len(data))
+	for key, value := range data {
+		s, ok := value.(string)
+		if !ok {
+			zlog.InfraSec().InfraError("value of %s in secret %s is not a string", key, path).Msg("")
+			return nil, errors.Errorfc(codes.InvalidArgument, "value of %s in secret %s is not a string", key, path)
+		}
+		stringData[key] = s
+	}
+
+	name, err := kubernetesSecretName(path)
+	if err != nil {
+		return nil, err
+	}
+	object := kubernetesSecret{
+		APIVersion: "v1",
+		Kind:       "Secret",
+		Metadata: kubernetesMeta{
+			Name:        name,
+			Namespace:   k.namespace,
+			Annotations: map[string]string{kubernetesPathAnnotation: path},
+		},
+		Type:       "Opaque",
+		StringData: stringData,
+	}
+	code, body, err := k.do(ctx, http.MethodPost, "", object)
+	if err == nil && code == http.StatusConflict {
+		// Only replace the Secrets storing this secret.
+		if _, err := k.getSecret(ctx, name, path); err != nil {
+			return nil, err
+		}
+		code, body, err = k.do(ctx, http.MethodPut, name, object)
+	}
+	if err != nil {
+		return nil, errBackendUnavailable("write", path, err)
+	}
+	if code != http.StatusOK && code != http.StatusCreated {
+		return nil, errBackendUnavailable("write", path, fmt.Errorf("unexpected status %d: %s", code, body))
+	}
+
+	var written kubernetesSecret
+	if err := json.Unmarshal(body, &written); err != nil {
+		return nil, errBackendUnavailable("write", path, err)
+	}
+	return map[string]interface{}{"created_time": written.Metadata.CreationTimestamp}, nil
+}
+
+func (k *kubernetesService) Logout(_ context.Context) {}
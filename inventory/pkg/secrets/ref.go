@@ -0,0 +1,134 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
+)
+
+// RefScheme prefixes the secret references, which take the form secret://path#key: the key of the secret stored
+// under path in the secrets service. Fields holding credentials accept references in place of the raw credentials,
+// and the consumers of these fields resolve them on demand, see ResolveRef. The resources of a tenant can only
+// reference the secrets of the tenant, stored under tenants/<tenant_id>/.
+const RefScheme = "secret://"
+
+const tenantsPathPrefix = "tenants/"
+
+var (
+	// The segments of the paths cannot start with a dot, to keep the references within the secrets engine.
+	refPathRegex = regexp.MustCompile(`^[a-zA-Z0-9_-][a-zA-Z0-9_.-]*(/[a-zA-Z0-9_-][a-zA-Z0-9_.-]*)*$`)
+	refKeyRegex  = regexp.MustCompile(`^[a-zA-Z0-9_.-]+$`)
+)
+
+// Ref is a reference to a key of a secret.
+type Ref struct {
+	Path string
+	Key  string
+}
+
+func (r Ref) String() string {
+	return fmt.Sprintf("%s%s#%s", RefScheme, r.Path, r.Key)
+}
+
+// IsRef returns whether the value is a secret reference, valid or not.
+func IsRef(value string) bool {
+	return strings.HasPrefix(value, RefScheme)
+}
+
+// ParseRef parses a secret reference, i.e. secret://path#key.
+func ParseRef(value string) (Ref, error) {
+	path, key, found := strings.Cut(strings.TrimPrefix(value, RefScheme), "#")
+	if !IsRef(value) || !found || !refPathRegex.MatchString(path) || !refKeyRegex.MatchString(key) {
+		zlog.InfraSec().InfraError("invalid secret reference").Msg("")
+		return Ref{}, errors.Errorfc(codes.InvalidArgument, "invalid secret reference, expected %spath#key", RefScheme)
+	}
+	return Ref{Path: path, Key: key}, nil
+}
+
+// TenantPathPrefix returns the prefix of the paths of the secrets the resources of the tenant can reference.
+func TenantPathPrefix(tenantID string) string {
+	return tenantsPathPrefix + tenantID + "/"
+}
+
+// ParseTenantRef parses a secret reference like ParseRef, and checks that it references a secret of the given
+// tenant.
+func ParseTenantRef(value, tenantID string) (Ref, error) {
+	ref, err := ParseRef(value)
+	if err != nil {
+		return Ref{}, err
+	}
+	if tenantID == "" || !strings.HasPrefix(ref.Path, TenantPathPrefix(tenantID)) {
+		zlog.InfraSec().InfraError("secret reference %s outside of tenant %s", ref, tenantID).Msg("")
+		return Ref{}, errors.Errorfc(codes.InvalidArgument,
+			"invalid secret reference, expected %s%spath#key", RefScheme, TenantPathPrefix(tenantID))
+	}
+	return ref, nil
+}
+
+// Resolve reads the secret referenced by r from the secrets service, and returns the value of its key.
+func (r Ref) Resolve(ctx context.Context, svc SecretsService) (string, error) {
+	secret, err := svc.ReadSecret(ctx, r.Path)
+	if err != nil {
+		return "", err
+	}
+	// Secrets of the KV version 2 engine nest their data next to their metadata.
+	if data, ok := secret["data"].(map[string]interface{}); ok {
+		secret = data
+	}
+	value, ok := secret[r.Key].(string)
+	if !ok {
+		return "", errors.Errorfc(codes.NotFound, "key %s not found in secret %s", r.Key, r.Path)
+	}
+	return value, nil
+}
+
+// ResolveRef returns the value referenced by the given field value of a resource of the tenant if it is a secret
+// reference, else the value itself, which allows consumers to handle both raw credentials and references.
+func ResolveRef(ctx context.Context, svc SecretsService, tenantID, value string) (string, error) {
+	if !IsRef(value) {
+		return value, nil
+	}
+	ref, err := ParseTenantRef(value, tenantID)
+	if err != nil {
+		return "", err
+	}
+	return ref.Resolve(ctx, svc)
+}
+
+// ConfigRefs returns the secret references among the string values of a JSON configuration, at any depth. The
+// configurations that are not JSON objects have no references.
+func ConfigRefs(config string) []string {
+	var parsed interface{}
+	if err := json.Unmarshal([]byte(config), &parsed); err != nil {
+		return nil
+	}
+	var refs []string
+	var walk func(v interface{})
+	walk = func(v interface{}) {
+		switch v := v.(type) {
+		case string:
+			if IsRef(v) {
+				refs = append(refs, v)
+			}
+		case map[string]interface{}:
+			for _, item := range v {
+				walk(item)
+			}
+		case []interface{}:
+			for _, item := range v {
+				walk(item)
+			}
+		}
+	}
+	walk(parsed)
+	return refs
+}
@@ -0,0 +1,95 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package secrets_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/mocks"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/secrets"
+)
+
+func TestParseRef(t *testing.T) {
+	ref, err := secrets.ParseRef("secret://bmc/host-12345678#password")
+	require.NoError(t, err)
+	assert.Equal(t, secrets.Ref{Path: "bmc/host-12345678", Key: "password"}, ref)
+	assert.Equal(t, "secret://bmc/host-12345678#password", ref.String())
+
+	for _, invalid := range []string{
+		"bmc/host#password",
+		"secret://bmc/host",
+		"secret://#password",
+		"secret://bmc/host#",
+		"secret:///bmc#password",
+		"secret://bmc/../other#password",
+		"secret://bmc host#password",
+	} {
+		_, err := secrets.ParseRef(invalid)
+		assert.True(t, errors.IsInvalidArgument(err), invalid)
+	}
+}
+
+func TestParseTenantRef(t *testing.T) {
+	ref, err := secrets.ParseTenantRef("secret://tenants/tenant-1/bmc/host-12345678#password", "tenant-1")
+	require.NoError(t, err)
+	assert.Equal(t, secrets.Ref{Path: "tenants/tenant-1/bmc/host-12345678", Key: "password"}, ref)
+
+	for _, invalid := range []string{
+		"secret://bmc/host-12345678#password",
+		"secret://tenants/tenant-2/bmc#password",
+		"secret://tenants/tenant-10/bmc#password",
+		"secret://tenants/tenant-1#password",
+		"secret://other/tenants/tenant-1/bmc#password",
+	} {
+		_, err := secrets.ParseTenantRef(invalid, "tenant-1")
+		assert.True(t, errors.IsInvalidArgument(err), invalid)
+	}
+	_, err = secrets.ParseTenantRef("secret://tenants//bmc#password", "")
+	assert.True(t, errors.IsInvalidArgument(err))
+}
+
+func TestResolveRef(t *testing.T) {
+	ctx := context.Background()
+	svc := mocks.NewMockSecretsService(gomock.NewController(t))
+	svc.EXPECT().ReadSecret(gomock.Any(), "tenants/tenant-1/bmc").Return(map[string]interface{}{
+		"data":     map[string]interface{}{"password": "admin"},
+		"metadata": map[string]interface{}{"version": 1},
+	}, nil).Times(2)
+	svc.EXPECT().ReadSecret(gomock.Any(), "tenants/tenant-1/missing").
+		Return(nil, errors.Errorfc(codes.NotFound, "not found"))
+
+	value, err := secrets.ResolveRef(ctx, svc, "tenant-1", "secret://tenants/tenant-1/bmc#password")
+	require.NoError(t, err)
+	assert.Equal(t, "admin", value)
+
+	// Raw values are returned as they are.
+	value, err = secrets.ResolveRef(ctx, svc, "tenant-1", "admin")
+	require.NoError(t, err)
+	assert.Equal(t, "admin", value)
+
+	_, err = secrets.ResolveRef(ctx, svc, "tenant-1", "secret://tenants/tenant-1/bmc#username")
+	assert.True(t, errors.IsNotFound(err))
+	_, err = secrets.ResolveRef(ctx, svc, "tenant-1", "secret://tenants/tenant-1/missing#password")
+	assert.True(t, errors.IsNotFound(err))
+	_, err = secrets.ResolveRef(ctx, svc, "tenant-1", "secret://invalid")
+	assert.Error(t, err)
+	// The secrets of other tenants are not read.
+	_, err = secrets.ResolveRef(ctx, svc, "tenant-2", "secret://tenants/tenant-1/bmc#password")
+	assert.True(t, errors.IsInvalidArgument(err))
+}
+
+func TestConfigRefs(t *testing.T) {
+	assert.ElementsMatch(t, []string{"secret://loca#password", "secret://loca#token"}, secrets.ConfigRefs(
+		`{"user": "admin", "password": "secret://loca#password", "nested": [{"token": "secret://loca#token"}]}`))
+	assert.Empty(t, secrets.ConfigRefs(`{"password": "admin"}`))
+	assert.Empty(t, secrets.ConfigRefs("secret://loca#password"))
+	assert.Empty(t, secrets.ConfigRefs(""))
+}
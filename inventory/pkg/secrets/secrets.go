@@ -3,7 +3,27 @@
 
 package secrets
 
-import "context"
+import (
+	"context"
+	"os"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
+)
+
+const (
+	// EnvNameSecretsBackend selects the backend storing the secrets, Vault by default.
+	EnvNameSecretsBackend = "SECRETS_BACKEND"
+	// EnvNameSecretsCacheTTL enables caching the secrets read for the given duration, e.g. 5m. The secrets are read
+	// from the backend again once expired. Caching is disabled by default.
+	EnvNameSecretsCacheTTL = "SECRETS_CACHE_TTL"
+
+	BackendVault      = "vault"
+	BackendKubernetes = "kubernetes"
+	BackendFile       = "file"
+)
 
 //go:generate mockgen -package mocks -destination=../mocks/secrets_mock.go . SecretsService
 //nolint:revive // keep SecretsService name
@@ -18,4 +38,65 @@ type SecretsService interface {
 	Logout(ctx context.Context)
 }
 
-var SecretServiceFactory = newVaultService
+// All the backends store the secrets the way the KV version 2 engine of Vault does: the secrets written and read
+// nest their key-value pairs under "data". They fail with NotFound when the secret does not exist, InvalidArgument
+// when the secret is malformed, and Unavailable when the backend cannot be reached.
+var backends = map[string]func(ctx context.Context) (SecretsService, error){
+	BackendVault:      newVaultService,
+	BackendKubernetes: newKubernetesService,
+	BackendFile:       newFileService,
+}
+
+var SecretServiceFactory = NewSecretsService
+
+// BackendConfigured returns whether a secrets backend is configured, i.e. SECRETS_BACKEND or, for the default Vault
+// backend, VAULT_URL is set.
+func BackendConfigured() bool {
+	return os.Getenv(EnvNameSecretsBackend) != "" || os.Getenv(EnvNameVaultURL) != ""
+}
+
+// NewSecretsService returns the service of the backend selected by the SECRETS_BACKEND env variable, caching the
+// secrets read if SECRETS_CACHE_TTL is set. The cache is shared by all the services returned.
+func NewSecretsService(ctx context.Context) (SecretsService, error) {
+	backend := os.Getenv(EnvNameSecretsBackend)
+	if backend == "" {
+		backend = BackendVault
+	}
+	newBackend, ok := backends[backend]
+	if !ok {
+		zlog.InfraSec().InfraError("unknown secrets backend %s", backend).Msg("")
+		return nil, errors.Errorfc(codes.InvalidArgument, "unknown secrets backend %s", backend)
+	}
+
+	ttl := time.Duration(0)
+	if value := os.Getenv(EnvNameSecretsCacheTTL); value != "" {
+		var err error
+		if ttl, err = time.ParseDuration(value); err != nil || ttl < 0 {
+			zlog.InfraSec().InfraError("invalid %s: %s", EnvNameSecretsCacheTTL, value).Msg("")
+			return nil, errors.Errorfc(codes.InvalidArgument, "invalid %s: %s", EnvNameSecretsCacheTTL, value)
+		}
+	}
+	if ttl == 0 {
+		return newBackend(ctx)
+	}
+	return newCachingService(sharedCache, ttl, newBackend), nil
+}
+
+// secretData returns the key-value pairs of a secret to be written.
+func secretData(path string, secret map[string]interface{}) (map[string]interface{}, error) {
+	data, ok := secret["data"].(map[string]interface{})
+	if !ok {
+		zlog.InfraSec().InfraError("secret %s has no data", path).Msg("")
+		return nil, errors.Errorfc(codes.InvalidArgument, "secret %s has no data", path)
+	}
+	return data, nil
+}
+
+func errSecretNotFound(path string) error {
+	return errors.Errorfc(codes.NotFound, "Secret %s not found", path)
+}
+
+func errBackendUnavailable(op, path string, err error) error {
+	zlog.InfraSec().Err(err).Msgf("Failed to %s secret %s", op, path)
+	return errors.Errorfc(codes.Unavailable, "Failed to %s secret %s", op, path)
+}
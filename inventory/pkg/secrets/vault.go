@@ -10,7 +10,6 @@ import (
 
 	vault "github.com/hashicorp/vault/api"
 	"github.com/hashicorp/vault/api/auth/kubernetes"
-	"google.golang.org/grpc/codes"
 
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/logging"
@@ -143,16 +142,14 @@ func (v *vaultService) login(ctx context.Context, vaultURL, vaultRole string) er
 func (v *vaultService) ReadSecret(ctx context.Context, secretName string) (map[string]interface{}, error) {
 	secret, err := v.vaultClient.Read(ctx, vaultSecretBaseURL+secretName)
 	if err != nil {
-		returnErr := errors.Errorf("Failed to read secret from Vault")
-		zlog.InfraSec().Err(err).Msg("")
-		return nil, returnErr
+		return nil, errBackendUnavailable("read", secretName, err)
 	}
 
 	// There are scenarios in which secret will be nil, even if there is no error.
 	// For example, this can happen in the case of 204 No Content response.
 	// See: https://github.com/hashicorp/vault/issues/18836
 	if secret == nil {
-		return nil, errors.Errorfc(codes.NotFound, "Secret %s not found", secretName)
+		return nil, errSecretNotFound(secretName)
 	}
 
 	return secret.Data, nil
@@ -163,9 +160,7 @@ func (v *vaultService) WriteSecret(ctx context.Context, secretName string, data
 ) {
 	secret, err := v.vaultClient.Write(ctx, vaultSecretBaseURL+secretName, data)
 	if err != nil {
-		returnErr := errors.Errorf("Failed to write secret in Vault")
-		zlog.InfraSec().Err(err).Msg("")
-		return nil, returnErr
+		return nil, errBackendUnavailable("write", secretName, err)
 	}
 
 	// There are scenarios in which secret will be nil, even if there is no error.
@@ -5,11 +5,13 @@ package invtesting
 
 import (
 	compute_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/compute/v1"
+	customresource_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/customresource/v1"
 	location_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/location/v1"
 	network_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/network/v1"
 	osv1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/os/v1"
 	ou_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/ou/v1"
 	provider_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/provider/v1"
+	rolebinding_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/rolebinding/v1"
 	schedule_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/schedule/v1"
 	status_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/status/v1"
 	telemetry_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/telemetry/v1"
@@ -516,3 +518,39 @@ func OSUpdateRunEndTime(endTime uint64) Opt[compute_v1.OSUpdateRunResource] {
 		our.EndTime = endTime
 	}
 }
+
+func CustomObjectName(name string) Opt[customresource_v1.CustomObjectResource] {
+	return func(o *customresource_v1.CustomObjectResource) {
+		o.Name = name
+	}
+}
+
+func CustomObjectSite(site *location_v1.SiteResource) Opt[customresource_v1.CustomObjectResource] {
+	return func(o *customresource_v1.CustomObjectResource) {
+		o.Site = site
+	}
+}
+
+func CustomObjectHost(host *compute_v1.HostResource) Opt[customresource_v1.CustomObjectResource] {
+	return func(o *customresource_v1.CustomObjectResource) {
+		o.Host = host
+	}
+}
+
+func RoleBindingRegion(region *location_v1.RegionResource) Opt[rolebinding_v1.RoleBindingResource] {
+	return func(rb *rolebinding_v1.RoleBindingResource) {
+		rb.Region = region
+	}
+}
+
+func RoleBindingSite(site *location_v1.SiteResource) Opt[rolebinding_v1.RoleBindingResource] {
+	return func(rb *rolebinding_v1.RoleBindingResource) {
+		rb.Site = site
+	}
+}
+
+func RoleBindingOu(ou *ou_v1.OuResource) Opt[rolebinding_v1.RoleBindingResource] {
+	return func(rb *rolebinding_v1.RoleBindingResource) {
+		rb.Ou = ou
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	computev1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/compute/v1"
+	customresourcev1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/customresource/v1"
 	localaccount_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/localaccount/v1"
 	location_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/location/v1"
 	networkv1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/network/v1"
@@ -17,6 +18,7 @@ import (
 	ouv1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/ou/v1"
 	provider_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/provider/v1"
 	v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/remoteaccess/v1"
+	rolebindingv1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/rolebinding/v1"
 	schedulev1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/schedule/v1"
 	telemetryv1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/telemetry/v1"
 	tenantv1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/tenant/v1"
@@ -493,6 +495,31 @@ func CreateCustomConfigNoCleanup(tb testing.TB, name, description, config string
 	return getInvResourceDAO().CreateCustomConfigNoCleanup(tb, client.FakeTenantID, name, description, config)
 }
 
+// CreateCustomType - creates a custom type with the given JSON schema and allowed edges.
+func CreateCustomType(
+	tb testing.TB, name, jsonSchema string, allowedEdges ...string,
+) *customresourcev1.CustomTypeResource {
+	tb.Helper()
+	return getInvResourceDAO().CreateCustomType(tb, client.FakeTenantID, name, jsonSchema, allowedEdges...)
+}
+
+// CreateCustomObject - creates a custom object of the given type.
+func CreateCustomObject(
+	tb testing.TB, customType *customresourcev1.CustomTypeResource, spec string,
+	opts ...Opt[customresourcev1.CustomObjectResource],
+) *customresourcev1.CustomObjectResource {
+	tb.Helper()
+	return getInvResourceDAO().CreateCustomObject(tb, client.FakeTenantID, customType, spec, opts...)
+}
+
+// CreateRoleBinding - creates a role binding of the subject, its scope is set through the opts.
+func CreateRoleBinding(
+	tb testing.TB, subject string, role rolebindingv1.ScopedRole, opts ...Opt[rolebindingv1.RoleBindingResource],
+) *rolebindingv1.RoleBindingResource {
+	tb.Helper()
+	return getInvResourceDAO().CreateRoleBinding(tb, client.FakeTenantID, subject, role, opts...)
+}
+
 // CreateLocalAccount - creates local account. Note this helper is not really meant to be used for the
 // test of LocalAccountResource, but they are typically leveraged in case of wider
 // tests involving long chain of relations that are not usually fulfilled by the eager loading.
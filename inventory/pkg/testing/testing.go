@@ -253,6 +253,16 @@ func clearDB(ctx context.Context, dbURL string) error { //nolint:cyclop,funlen /
 	if _, err := c.OSUpdateRunResource.Delete().Exec(ctx); err != nil {
 		return err
 	}
+	// Custom objects must be cleared before their custom type and the resources they are linked to
+	if _, err := c.CustomObjectResource.Delete().Exec(ctx); err != nil {
+		return err
+	}
+	if _, err := c.CustomTypeResource.Delete().Exec(ctx); err != nil {
+		return err
+	}
+	if _, err := c.RoleBindingResource.Delete().Exec(ctx); err != nil {
+		return err
+	}
 	if _, err := c.WorkloadMember.Delete().Exec(ctx); err != nil {
 		return err
 	}
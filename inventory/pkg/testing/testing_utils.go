@@ -24,6 +24,7 @@ import (
 	netlinks "github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/netlinkresource"
 	"github.com/open-edge-platform/infra-core/inventory/v2/internal/ent/workloadresource"
 	computev1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/compute/v1"
+	customresourcev1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/customresource/v1"
 	inv_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/inventory/v1"
 	localaccount_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/localaccount/v1"
 	location_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/location/v1"
@@ -32,6 +33,7 @@ import (
 	ou_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/ou/v1"
 	provider_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/provider/v1"
 	remoteaccessv1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/remoteaccess/v1"
+	rolebindingv1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/rolebinding/v1"
 	schedule_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/schedule/v1"
 	telemetry_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/telemetry/v1"
 	tenantv1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/tenant/v1"
@@ -1552,6 +1554,89 @@ func (c *InvResourceDAO) createCustomConfig(
 	return cconfigResp
 }
 
+// CreateCustomType creates a custom type with the given JSON schema and allowed edges.
+func (c *InvResourceDAO) CreateCustomType(
+	tb testing.TB, tenantID, name, jsonSchema string, allowedEdges ...string,
+) *customresourcev1.CustomTypeResource {
+	tb.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	customType := &customresourcev1.CustomTypeResource{
+		Name:         name,
+		JsonSchema:   jsonSchema,
+		AllowedEdges: allowedEdges,
+		TenantId:     tenantID,
+	}
+
+	resp, err := c.apiClient.Create(
+		ctx,
+		tenantID,
+		&inv_v1.Resource{Resource: &inv_v1.Resource_CustomType{CustomType: customType}},
+	)
+	require.NoError(tb, err)
+	customTypeResp := resp.GetCustomType()
+	tb.Cleanup(func() { c.DeleteResource(tb, tenantID, customTypeResp.ResourceId) })
+
+	return customTypeResp
+}
+
+// CreateCustomObject creates a custom object of the given type, edges can be set through the opts.
+func (c *InvResourceDAO) CreateCustomObject(
+	tb testing.TB, tenantID string, customType *customresourcev1.CustomTypeResource, spec string,
+	opts ...Opt[customresourcev1.CustomObjectResource],
+) *customresourcev1.CustomObjectResource {
+	tb.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	customObject := &customresourcev1.CustomObjectResource{
+		CustomType: customType,
+		Spec:       spec,
+		TenantId:   tenantID,
+	}
+	collections.ForEach(opts, func(opt Opt[customresourcev1.CustomObjectResource]) { opt(customObject) })
+
+	resp, err := c.apiClient.Create(
+		ctx,
+		tenantID,
+		&inv_v1.Resource{Resource: &inv_v1.Resource_CustomObject{CustomObject: customObject}},
+	)
+	require.NoError(tb, err)
+	customObjectResp := resp.GetCustomObject()
+	tb.Cleanup(func() { c.DeleteResource(tb, tenantID, customObjectResp.ResourceId) })
+
+	return customObjectResp
+}
+
+// CreateRoleBinding creates a role binding of the subject, its scope is set through the opts.
+func (c *InvResourceDAO) CreateRoleBinding(
+	tb testing.TB, tenantID, subject string, role rolebindingv1.ScopedRole,
+	opts ...Opt[rolebindingv1.RoleBindingResource],
+) *rolebindingv1.RoleBindingResource {
+	tb.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	roleBinding := &rolebindingv1.RoleBindingResource{
+		Subject:  subject,
+		Role:     role,
+		TenantId: tenantID,
+	}
+	collections.ForEach(opts, func(opt Opt[rolebindingv1.RoleBindingResource]) { opt(roleBinding) })
+
+	resp, err := c.apiClient.Create(
+		ctx,
+		tenantID,
+		&inv_v1.Resource{Resource: &inv_v1.Resource_RoleBinding{RoleBinding: roleBinding}},
+	)
+	require.NoError(tb, err)
+	roleBindingResp := resp.GetRoleBinding()
+	tb.Cleanup(func() { c.DeleteResource(tb, tenantID, roleBindingResp.ResourceId) })
+
+	return roleBindingResp
+}
+
 // Create LocalAccount.
 func (c *InvResourceDAO) createLocalAccount(tb testing.TB,
 	tenantID, username, sshKey string,
@@ -0,0 +1,324 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+//nolint:revive // Package name "util" is appropriate for general utilities
+package util
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	compute_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/compute/v1"
+	customresourcev1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/customresource/v1"
+	inv_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/inventory/v1"
+	localaccountv1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/localaccount/v1"
+	location_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/location/v1"
+	network_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/network/v1"
+	os_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/os/v1"
+	ou_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/ou/v1"
+	provider_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/provider/v1"
+	remoteaccessv1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/remoteaccess/v1"
+	rolebindingv1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/rolebinding/v1"
+	schedule_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/schedule/v1"
+	telemetry_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/telemetry/v1"
+	tenantv1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/tenant/v1"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
+)
+
+// resourceOneofName is the name of the oneof in inv_v1.Resource carrying the concrete resource.
+const resourceOneofName = "resource"
+
+// ResourceKindInfo describes a resource kind known to the inventory. Every kind is registered once
+// via RegisterResourceKind, all the kind-related helpers of this package are driven by this metadata.
+type ResourceKindInfo struct {
+	Kind   inv_v1.ResourceKind
+	Prefix ResourcePrefix
+	// Message is any instance (a typed nil is fine) of the concrete resource message. It must be
+	// one of the fields of the "resource" oneof in inv_v1.Resource.
+	Message proto.Message
+	// TenantScoped resources carry a tenant ID and are only visible within their tenant.
+	TenantScoped bool
+	// TwoPhaseDelete resources are first marked for deletion (desired state DELETED), the
+	// removal is completed once the owning resource manager reconciled the current state.
+	TwoPhaseDelete bool
+
+	field protoreflect.FieldDescriptor
+}
+
+// MessageName returns the full name of the resource message.
+func (i *ResourceKindInfo) MessageName() protoreflect.FullName {
+	return i.Message.ProtoReflect().Descriptor().FullName()
+}
+
+// NewResource returns an inv_v1.Resource with this kind set to an empty (nil) resource message.
+func (i *ResourceKindInfo) NewResource() *inv_v1.Resource {
+	res := &inv_v1.Resource{}
+	res.ProtoReflect().Set(i.field, protoreflect.ValueOfMessage(i.Message.ProtoReflect().Type().Zero()))
+	return res
+}
+
+type resourceKindRegistry struct {
+	mu        sync.RWMutex
+	byKind    map[inv_v1.ResourceKind]*ResourceKindInfo
+	byPrefix  map[ResourcePrefix]*ResourceKindInfo
+	byMessage map[protoreflect.FullName]*ResourceKindInfo
+	byField   map[protoreflect.FieldNumber]*ResourceKindInfo
+}
+
+var resourceKinds = &resourceKindRegistry{
+	byKind:    make(map[inv_v1.ResourceKind]*ResourceKindInfo),
+	byPrefix:  make(map[ResourcePrefix]*ResourceKindInfo),
+	byMessage: make(map[protoreflect.FullName]*ResourceKindInfo),
+	byField:   make(map[protoreflect.FieldNumber]*ResourceKindInfo),
+}
+
+// RegisterResourceKind registers the metadata of a resource kind. It panics if the kind, the prefix or
+// the message are already registered, or if the message is not part of the inv_v1.Resource oneof:
+// these are programming errors that must be caught at init time.
+func RegisterResourceKind(info ResourceKindInfo) {
+	if info.Kind == inv_v1.ResourceKind_RESOURCE_KIND_UNSPECIFIED || info.Prefix == "" || info.Message == nil {
+		panic(fmt.Sprintf("incomplete resource kind registration: %+v", info))
+	}
+	desc := info.Message.ProtoReflect().Descriptor()
+	oneof := (&inv_v1.Resource{}).ProtoReflect().Descriptor().Oneofs().ByName(resourceOneofName)
+	for i := 0; i < oneof.Fields().Len(); i++ {
+		if fd := oneof.Fields().Get(i); fd.Message() != nil && fd.Message().FullName() == desc.FullName() {
+			info.field = fd
+			break
+		}
+	}
+	if info.field == nil {
+		panic(fmt.Sprintf("%s is not part of the Resource oneof", desc.FullName()))
+	}
+
+	resourceKinds.mu.Lock()
+	defer resourceKinds.mu.Unlock()
+	if _, ok := resourceKinds.byKind[info.Kind]; ok {
+		panic(fmt.Sprintf("resource kind %s registered twice", info.Kind))
+	}
+	if _, ok := resourceKinds.byPrefix[info.Prefix]; ok {
+		panic(fmt.Sprintf("resource prefix %s registered twice", info.Prefix))
+	}
+	if _, ok := resourceKinds.byMessage[desc.FullName()]; ok {
+		panic(fmt.Sprintf("resource message %s registered twice", desc.FullName()))
+	}
+	resourceKinds.byKind[info.Kind] = &info
+	resourceKinds.byPrefix[info.Prefix] = &info
+	resourceKinds.byMessage[desc.FullName()] = &info
+	resourceKinds.byField[info.field.Number()] = &info
+}
+
+// LookupResourceKind returns the metadata registered for the given kind.
+func LookupResourceKind(kind inv_v1.ResourceKind) (*ResourceKindInfo, bool) {
+	resourceKinds.mu.RLock()
+	defer resourceKinds.mu.RUnlock()
+	info, ok := resourceKinds.byKind[kind]
+	return info, ok
+}
+
+// RegisteredResourceKinds returns the metadata of all the registered kinds, sorted by kind.
+func RegisteredResourceKinds() []*ResourceKindInfo {
+	resourceKinds.mu.RLock()
+	defer resourceKinds.mu.RUnlock()
+	infos := make([]*ResourceKindInfo, 0, len(resourceKinds.byKind))
+	for _, info := range resourceKinds.byKind {
+		infos = append(infos, info)
+	}
+	sort.Slice(infos, func(i, j int) bool { return infos[i].Kind < infos[j].Kind })
+	return infos
+}
+
+func lookupResourceKindByPrefix(prefix ResourcePrefix) (*ResourceKindInfo, bool) {
+	resourceKinds.mu.RLock()
+	defer resourceKinds.mu.RUnlock()
+	info, ok := resourceKinds.byPrefix[prefix]
+	return info, ok
+}
+
+func lookupResourceKindByMessage(message proto.Message) (*ResourceKindInfo, bool) {
+	if message == nil {
+		return nil, false
+	}
+	resourceKinds.mu.RLock()
+	defer resourceKinds.mu.RUnlock()
+	info, ok := resourceKinds.byMessage[message.ProtoReflect().Descriptor().FullName()]
+	return info, ok
+}
+
+// lookupResourceKindByResource returns the metadata of the kind set in the given Resource together
+// with the (possibly nil) concrete resource message.
+func lookupResourceKindByResource(resource *inv_v1.Resource) (*ResourceKindInfo, proto.Message, bool) {
+	if resource == nil {
+		return nil, nil, false
+	}
+	msg := resource.ProtoReflect()
+	fd := msg.WhichOneof(msg.Descriptor().Oneofs().ByName(resourceOneofName))
+	if fd == nil {
+		return nil, nil, false
+	}
+	resourceKinds.mu.RLock()
+	info, ok := resourceKinds.byField[fd.Number()]
+	resourceKinds.mu.RUnlock()
+	if !ok {
+		return nil, nil, false
+	}
+	return info, msg.Get(fd).Message().Interface(), true
+}
+
+// wrapResourceMessage sets the given message in the oneof of a new inv_v1.Resource.
+func wrapResourceMessage(message proto.Message) (*inv_v1.Resource, error) {
+	info, ok := lookupResourceKindByMessage(message)
+	if !ok {
+		zlog.InfraSec().InfraError("unknown Resource type: %T", message).Msg("")
+		return nil, errors.Errorfc(codes.InvalidArgument, "unknown Resource type: %T", message)
+	}
+	res := &inv_v1.Resource{}
+	res.ProtoReflect().Set(info.field, protoreflect.ValueOfMessage(message.ProtoReflect()))
+	return res, nil
+}
+
+//nolint:funlen // table of the built-in resource kinds
+func init() {
+	for _, info := range []ResourceKindInfo{
+		// location.proto
+		{
+			Kind: inv_v1.ResourceKind_RESOURCE_KIND_REGION, Prefix: ResourcePrefixRegion,
+			Message: (*location_v1.RegionResource)(nil), TenantScoped: true,
+		},
+		{
+			Kind: inv_v1.ResourceKind_RESOURCE_KIND_SITE, Prefix: ResourcePrefixSite,
+			Message: (*location_v1.SiteResource)(nil), TenantScoped: true,
+		},
+		// ou.proto
+		{
+			Kind: inv_v1.ResourceKind_RESOURCE_KIND_OU, Prefix: ResourcePrefixOu,
+			Message: (*ou_v1.OuResource)(nil), TenantScoped: true,
+		},
+		// compute.proto
+		{
+			Kind: inv_v1.ResourceKind_RESOURCE_KIND_INSTANCE, Prefix: ResourcePrefixInstance,
+			Message: (*compute_v1.InstanceResource)(nil), TenantScoped: true, TwoPhaseDelete: true,
+		},
+		{
+			Kind: inv_v1.ResourceKind_RESOURCE_KIND_HOST, Prefix: ResourcePrefixHost,
+			Message: (*compute_v1.HostResource)(nil), TenantScoped: true, TwoPhaseDelete: true,
+		},
+		{
+			Kind: inv_v1.ResourceKind_RESOURCE_KIND_HOSTSTORAGE, Prefix: ResourcePrefixHoststorage,
+			Message: (*compute_v1.HoststorageResource)(nil), TenantScoped: true,
+		},
+		{
+			Kind: inv_v1.ResourceKind_RESOURCE_KIND_HOSTNIC, Prefix: ResourcePrefixHostnic,
+			Message: (*compute_v1.HostnicResource)(nil), TenantScoped: true,
+		},
+		{
+			Kind: inv_v1.ResourceKind_RESOURCE_KIND_HOSTUSB, Prefix: ResourcePrefixHostusb,
+			Message: (*compute_v1.HostusbResource)(nil), TenantScoped: true,
+		},
+		{
+			Kind: inv_v1.ResourceKind_RESOURCE_KIND_HOSTGPU, Prefix: ResourcePrefixHostgpu,
+			Message: (*compute_v1.HostgpuResource)(nil), TenantScoped: true,
+		},
+		{
+			Kind: inv_v1.ResourceKind_RESOURCE_KIND_WORKLOAD, Prefix: ResourcePrefixWorkload,
+			Message: (*compute_v1.WorkloadResource)(nil), TenantScoped: true, TwoPhaseDelete: true,
+		},
+		{
+			Kind: inv_v1.ResourceKind_RESOURCE_KIND_WORKLOAD_MEMBER, Prefix: ResourcePrefixWorkloadMember,
+			Message: (*compute_v1.WorkloadMember)(nil), TenantScoped: true,
+		},
+		{
+			Kind: inv_v1.ResourceKind_RESOURCE_KIND_OSUPDATEPOLICY, Prefix: ResourcePrefixOsUpdatePolicy,
+			Message: (*compute_v1.OSUpdatePolicyResource)(nil), TenantScoped: true,
+		},
+		{
+			Kind: inv_v1.ResourceKind_RESOURCE_KIND_CUSTOMCONFIG, Prefix: ResourcePrefixCustomConfig,
+			Message: (*compute_v1.CustomConfigResource)(nil), TenantScoped: true,
+		},
+		{
+			Kind: inv_v1.ResourceKind_RESOURCE_KIND_OSUPDATERUN, Prefix: ResourcePrefixOsUpdateRun,
+			Message: (*compute_v1.OSUpdateRunResource)(nil), TenantScoped: true,
+		},
+		// network.proto
+		{
+			Kind: inv_v1.ResourceKind_RESOURCE_KIND_NETWORKSEGMENT, Prefix: ResourcePrefixNetworkSegment,
+			Message: (*network_v1.NetworkSegment)(nil), TenantScoped: true,
+		},
+		{
+			Kind: inv_v1.ResourceKind_RESOURCE_KIND_NETLINK, Prefix: ResourcePrefixNetlink,
+			Message: (*network_v1.NetlinkResource)(nil), TenantScoped: true, TwoPhaseDelete: true,
+		},
+		{
+			Kind: inv_v1.ResourceKind_RESOURCE_KIND_ENDPOINT, Prefix: ResourcePrefixEndpoint,
+			Message: (*network_v1.EndpointResource)(nil), TenantScoped: true,
+		},
+		{
+			Kind: inv_v1.ResourceKind_RESOURCE_KIND_IPADDRESS, Prefix: ResourcePrefixIPAddress,
+			Message: (*network_v1.IPAddressResource)(nil), TenantScoped: true, TwoPhaseDelete: true,
+		},
+		// provider.proto
+		{
+			Kind: inv_v1.ResourceKind_RESOURCE_KIND_PROVIDER, Prefix: ResourcePrefixProvider,
+			Message: (*provider_v1.ProviderResource)(nil), TenantScoped: true,
+		},
+		// os.proto
+		{
+			Kind: inv_v1.ResourceKind_RESOURCE_KIND_OS, Prefix: ResourcePrefixOs,
+			Message: (*os_v1.OperatingSystemResource)(nil), TenantScoped: true,
+		},
+		// schedule.proto
+		{
+			Kind: inv_v1.ResourceKind_RESOURCE_KIND_SINGLESCHEDULE, Prefix: ResourcePrefixSingleSchedule,
+			Message: (*schedule_v1.SingleScheduleResource)(nil), TenantScoped: true,
+		},
+		{
+			Kind: inv_v1.ResourceKind_RESOURCE_KIND_REPEATEDSCHEDULE, Prefix: ResourcePrefixRepeatedSchedule,
+			Message: (*schedule_v1.RepeatedScheduleResource)(nil), TenantScoped: true,
+		},
+		// telemetry.proto
+		{
+			Kind: inv_v1.ResourceKind_RESOURCE_KIND_TELEMETRY_GROUP, Prefix: ResourcePrefixTelemetryGroup,
+			Message: (*telemetry_v1.TelemetryGroupResource)(nil), TenantScoped: true,
+		},
+		{
+			Kind: inv_v1.ResourceKind_RESOURCE_KIND_TELEMETRY_PROFILE, Prefix: ResourcePrefixTelemetryProfile,
+			Message: (*telemetry_v1.TelemetryProfile)(nil), TenantScoped: true,
+		},
+		// remoteaccess.proto
+		{
+			Kind: inv_v1.ResourceKind_RESOURCE_KIND_RMT_ACCESS_CONF, Prefix: ResourcePrefixRemoteAccessConf,
+			Message: (*remoteaccessv1.RemoteAccessConfiguration)(nil), TenantScoped: true, TwoPhaseDelete: true,
+		},
+		// tenant.proto
+		{
+			Kind: inv_v1.ResourceKind_RESOURCE_KIND_TENANT, Prefix: ResourcePrefixTenant,
+			Message: (*tenantv1.Tenant)(nil), TenantScoped: true, TwoPhaseDelete: true,
+		},
+		// localaccount.proto
+		{
+			Kind: inv_v1.ResourceKind_RESOURCE_KIND_LOCALACCOUNT, Prefix: ResourcePrefixLocalAccount,
+			Message: (*localaccountv1.LocalAccountResource)(nil), TenantScoped: true,
+		},
+		// customresource.proto
+		{
+			Kind: inv_v1.ResourceKind_RESOURCE_KIND_CUSTOMTYPE, Prefix: ResourcePrefixCustomType,
+			Message: (*customresourcev1.CustomTypeResource)(nil), TenantScoped: true,
+		},
+		{
+			Kind: inv_v1.ResourceKind_RESOURCE_KIND_CUSTOMOBJECT, Prefix: ResourcePrefixCustomObject,
+			Message: (*customresourcev1.CustomObjectResource)(nil), TenantScoped: true,
+		},
+		// rolebinding.proto
+		{
+			Kind: inv_v1.ResourceKind_RESOURCE_KIND_ROLEBINDING, Prefix: ResourcePrefixRoleBinding,
+			Message: (*rolebindingv1.RoleBindingResource)(nil), TenantScoped: true,
+		},
+	} {
+		RegisterResourceKind(info)
+	}
+}
@@ -0,0 +1,108 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package util_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	computev1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/compute/v1"
+	inv_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/inventory/v1"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/util"
+)
+
+// TestResourceKindConformance checks that every registered resource kind is consistently handled by
+// the kind-related helpers. Any new kind must pass it.
+func TestResourceKindConformance(t *testing.T) {
+	for _, val := range inv_v1.ResourceKind_value {
+		kind := inv_v1.ResourceKind(val)
+		if kind == inv_v1.ResourceKind_RESOURCE_KIND_UNSPECIFIED {
+			continue
+		}
+		_, ok := util.LookupResourceKind(kind)
+		assert.Truef(t, ok, "%s is not registered", kind)
+	}
+
+	prefixRegexp := regexp.MustCompile("^[[:lower:]]+$")
+	for _, info := range util.RegisteredResourceKinds() {
+		t.Run(info.Kind.String(), func(t *testing.T) {
+			assert.Regexp(t, prefixRegexp, string(info.Prefix))
+			assert.Equal(t, info.Prefix, util.ResourceKindToPrefix(info.Kind))
+			assert.Equal(t, info.Kind, util.PrefixToResourceKind(info.Prefix))
+
+			kind, err := util.GetResourceKindFromResourceID(util.NewInvID(info.Kind))
+			require.NoError(t, err)
+			assert.Equal(t, info.Kind, kind)
+
+			res, err := util.GetResourceFromKind(info.Kind)
+			require.NoError(t, err)
+			assert.Equal(t, info.Kind, util.GetResourceKindFromResource(res))
+
+			kind, err = util.GetResourceKindFromMessage(info.Message)
+			require.NoError(t, err)
+			assert.Equal(t, info.Kind, kind)
+
+			// Wrap/unwrap round trip with a populated message.
+			msg := info.Message.ProtoReflect().Type().New().Interface()
+			idField := msg.ProtoReflect().Descriptor().Fields().ByName("resource_id")
+			require.NotNilf(t, idField, "%s has no resource_id", info.MessageName())
+			resID := util.NewInvID(info.Kind)
+			msg.ProtoReflect().Set(idField, protoreflect.ValueOfString(resID))
+
+			wrapped, err := util.WrapResource(msg)
+			require.NoError(t, err)
+			assert.Equal(t, info.Kind, util.GetResourceKindFromResource(wrapped))
+			unwrapped, err := util.UnwrapResource[proto.Message](wrapped)
+			require.NoError(t, err)
+			assert.True(t, proto.Equal(msg, unwrapped))
+			set, err := util.GetSetResource(wrapped)
+			require.NoError(t, err)
+			assert.True(t, proto.Equal(msg, set))
+			gotID, err := util.GetResourceIDFromResource(wrapped)
+			require.NoError(t, err)
+			assert.Equal(t, resID, gotID)
+
+			if info.TenantScoped {
+				assert.NotNilf(t, msg.ProtoReflect().Descriptor().Fields().ByName("tenant_id"),
+					"%s is tenant scoped but has no tenant_id", info.MessageName())
+				_, gotID, err = util.GetResourceKeyFromResource(wrapped)
+				require.NoError(t, err)
+				assert.Equal(t, resID, gotID)
+			}
+		})
+	}
+}
+
+func TestRegisterResourceKind_Invalid(t *testing.T) {
+	testCases := map[string]util.ResourceKindInfo{
+		"Unspecified": {
+			Prefix:  "foo",
+			Message: (*computev1.HostResource)(nil),
+		},
+		"MissingMessage": {
+			Kind:   inv_v1.ResourceKind_RESOURCE_KIND_HOST,
+			Prefix: "foo",
+		},
+		"DuplicatedKind": {
+			Kind:    inv_v1.ResourceKind_RESOURCE_KIND_HOST,
+			Prefix:  "foo",
+			Message: (*computev1.HostResource)(nil),
+		},
+		"NotAResource": {
+			Kind:    inv_v1.ResourceKind_RESOURCE_KIND_HOST,
+			Prefix:  "foo",
+			Message: (*inv_v1.ResourceFilter)(nil),
+		},
+	}
+	for tcName, tc := range testCases {
+		t.Run(tcName, func(t *testing.T) {
+			assert.Panics(t, func() { util.RegisterResourceKind(tc) })
+		})
+	}
+}
@@ -21,18 +21,7 @@ import (
 	"google.golang.org/protobuf/reflect/protoreflect"
 	"google.golang.org/protobuf/types/known/fieldmaskpb"
 
-	compute_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/compute/v1"
 	inv_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/inventory/v1"
-	localaccountv1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/localaccount/v1"
-	location_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/location/v1"
-	network_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/network/v1"
-	os_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/os/v1"
-	ou_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/ou/v1"
-	provider_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/provider/v1"
-	remoteaccessv1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/remoteaccess/v1"
-	schedule_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/schedule/v1"
-	telemetry_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/telemetry/v1"
-	tenantv1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/tenant/v1"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/logging"
 )
@@ -82,186 +71,47 @@ const (
 	ResourcePrefixOsUpdatePolicy   ResourcePrefix = "osupdatepolicy"
 	ResourcePrefixCustomConfig     ResourcePrefix = "customconfig"
 	ResourcePrefixOsUpdateRun      ResourcePrefix = "osupdaterun"
+	ResourcePrefixCustomType       ResourcePrefix = "customtype"
+	ResourcePrefixCustomObject     ResourcePrefix = "customobject"
+	ResourcePrefixRoleBinding      ResourcePrefix = "rolebinding"
 )
 
 func ResourceKindToPrefix(kind inv_v1.ResourceKind) ResourcePrefix {
-	mapResourceKindToPrefix := map[inv_v1.ResourceKind]ResourcePrefix{
-		inv_v1.ResourceKind_RESOURCE_KIND_INSTANCE:          ResourcePrefixInstance,
-		inv_v1.ResourceKind_RESOURCE_KIND_HOST:              ResourcePrefixHost,
-		inv_v1.ResourceKind_RESOURCE_KIND_HOSTSTORAGE:       ResourcePrefixHoststorage,
-		inv_v1.ResourceKind_RESOURCE_KIND_HOSTNIC:           ResourcePrefixHostnic,
-		inv_v1.ResourceKind_RESOURCE_KIND_HOSTUSB:           ResourcePrefixHostusb,
-		inv_v1.ResourceKind_RESOURCE_KIND_HOSTGPU:           ResourcePrefixHostgpu,
-		inv_v1.ResourceKind_RESOURCE_KIND_NETWORKSEGMENT:    ResourcePrefixNetworkSegment,
-		inv_v1.ResourceKind_RESOURCE_KIND_NETLINK:           ResourcePrefixNetlink,
-		inv_v1.ResourceKind_RESOURCE_KIND_ENDPOINT:          ResourcePrefixEndpoint,
-		inv_v1.ResourceKind_RESOURCE_KIND_SITE:              ResourcePrefixSite,
-		inv_v1.ResourceKind_RESOURCE_KIND_REGION:            ResourcePrefixRegion,
-		inv_v1.ResourceKind_RESOURCE_KIND_OU:                ResourcePrefixOu,
-		inv_v1.ResourceKind_RESOURCE_KIND_PROVIDER:          ResourcePrefixProvider,
-		inv_v1.ResourceKind_RESOURCE_KIND_OS:                ResourcePrefixOs,
-		inv_v1.ResourceKind_RESOURCE_KIND_SINGLESCHEDULE:    ResourcePrefixSingleSchedule,
-		inv_v1.ResourceKind_RESOURCE_KIND_REPEATEDSCHEDULE:  ResourcePrefixRepeatedSchedule,
-		inv_v1.ResourceKind_RESOURCE_KIND_TELEMETRY_GROUP:   ResourcePrefixTelemetryGroup,
-		inv_v1.ResourceKind_RESOURCE_KIND_TELEMETRY_PROFILE: ResourcePrefixTelemetryProfile,
-		inv_v1.ResourceKind_RESOURCE_KIND_WORKLOAD:          ResourcePrefixWorkload,
-		inv_v1.ResourceKind_RESOURCE_KIND_WORKLOAD_MEMBER:   ResourcePrefixWorkloadMember,
-		inv_v1.ResourceKind_RESOURCE_KIND_IPADDRESS:         ResourcePrefixIPAddress,
-		inv_v1.ResourceKind_RESOURCE_KIND_RMT_ACCESS_CONF:   ResourcePrefixRemoteAccessConf,
-		inv_v1.ResourceKind_RESOURCE_KIND_TENANT:            ResourcePrefixTenant,
-		inv_v1.ResourceKind_RESOURCE_KIND_LOCALACCOUNT:      ResourcePrefixLocalAccount,
-		inv_v1.ResourceKind_RESOURCE_KIND_OSUPDATEPOLICY:    ResourcePrefixOsUpdatePolicy,
-		inv_v1.ResourceKind_RESOURCE_KIND_CUSTOMCONFIG:      ResourcePrefixCustomConfig,
-		inv_v1.ResourceKind_RESOURCE_KIND_OSUPDATERUN:       ResourcePrefixOsUpdateRun,
-	}
-
-	prefix, ok := mapResourceKindToPrefix[kind]
+	info, ok := LookupResourceKind(kind)
 	if !ok {
 		zlog.InfraSec().InfraError("Unable to map resource kind %d", kind).Msg("")
 		return ResourcePrefixUnspecified
 	}
-	return prefix
+	return info.Prefix
 }
 
 // GetResourceKindFromResource returns the actual resource kind set in the given Resource.
-//
-//nolint:cyclop,funlen // high cyclomatic complexity due to the switch and length due to the switch
 func GetResourceKindFromResource(resource *inv_v1.Resource) inv_v1.ResourceKind {
-	switch resource.GetResource().(type) {
-	case *inv_v1.Resource_Instance:
-		return inv_v1.ResourceKind_RESOURCE_KIND_INSTANCE
-	case *inv_v1.Resource_Host:
-		return inv_v1.ResourceKind_RESOURCE_KIND_HOST
-	case *inv_v1.Resource_Hoststorage:
-		return inv_v1.ResourceKind_RESOURCE_KIND_HOSTSTORAGE
-	case *inv_v1.Resource_Hostnic:
-		return inv_v1.ResourceKind_RESOURCE_KIND_HOSTNIC
-	case *inv_v1.Resource_Hostusb:
-		return inv_v1.ResourceKind_RESOURCE_KIND_HOSTUSB
-	case *inv_v1.Resource_Hostgpu:
-		return inv_v1.ResourceKind_RESOURCE_KIND_HOSTGPU
-	case *inv_v1.Resource_NetworkSegment:
-		return inv_v1.ResourceKind_RESOURCE_KIND_NETWORKSEGMENT
-	case *inv_v1.Resource_Netlink:
-		return inv_v1.ResourceKind_RESOURCE_KIND_NETLINK
-	case *inv_v1.Resource_Endpoint:
-		return inv_v1.ResourceKind_RESOURCE_KIND_ENDPOINT
-	case *inv_v1.Resource_Site:
-		return inv_v1.ResourceKind_RESOURCE_KIND_SITE
-	case *inv_v1.Resource_Region:
-		return inv_v1.ResourceKind_RESOURCE_KIND_REGION
-	case *inv_v1.Resource_Ou:
-		return inv_v1.ResourceKind_RESOURCE_KIND_OU
-	case *inv_v1.Resource_Provider:
-		return inv_v1.ResourceKind_RESOURCE_KIND_PROVIDER
-	case *inv_v1.Resource_Os:
-		return inv_v1.ResourceKind_RESOURCE_KIND_OS
-	case *inv_v1.Resource_Singleschedule:
-		return inv_v1.ResourceKind_RESOURCE_KIND_SINGLESCHEDULE
-	case *inv_v1.Resource_Repeatedschedule:
-		return inv_v1.ResourceKind_RESOURCE_KIND_REPEATEDSCHEDULE
-	case *inv_v1.Resource_TelemetryGroup:
-		return inv_v1.ResourceKind_RESOURCE_KIND_TELEMETRY_GROUP
-	case *inv_v1.Resource_TelemetryProfile:
-		return inv_v1.ResourceKind_RESOURCE_KIND_TELEMETRY_PROFILE
-	case *inv_v1.Resource_Workload:
-		return inv_v1.ResourceKind_RESOURCE_KIND_WORKLOAD
-	case *inv_v1.Resource_WorkloadMember:
-		return inv_v1.ResourceKind_RESOURCE_KIND_WORKLOAD_MEMBER
-	case *inv_v1.Resource_Ipaddress:
-		return inv_v1.ResourceKind_RESOURCE_KIND_IPADDRESS
-	case *inv_v1.Resource_RemoteAccess:
-		return inv_v1.ResourceKind_RESOURCE_KIND_RMT_ACCESS_CONF
-	case *inv_v1.Resource_Tenant:
-		return inv_v1.ResourceKind_RESOURCE_KIND_TENANT
-	case *inv_v1.Resource_LocalAccount:
-		return inv_v1.ResourceKind_RESOURCE_KIND_LOCALACCOUNT
-	case *inv_v1.Resource_OsUpdatePolicy:
-		return inv_v1.ResourceKind_RESOURCE_KIND_OSUPDATEPOLICY
-	case *inv_v1.Resource_CustomConfig:
-		return inv_v1.ResourceKind_RESOURCE_KIND_CUSTOMCONFIG
-	case *inv_v1.Resource_OsUpdateRun:
-		return inv_v1.ResourceKind_RESOURCE_KIND_OSUPDATERUN
+	info, _, ok := lookupResourceKindByResource(resource)
+	if !ok {
+		zlog.InfraSec().InfraError("Unable to map resource to its prefix: %s", resource).Msg("")
+		return inv_v1.ResourceKind_RESOURCE_KIND_UNSPECIFIED
 	}
-	zlog.InfraSec().InfraError("Unable to map resource to its prefix: %s", resource).Msg("")
-	return inv_v1.ResourceKind_RESOURCE_KIND_UNSPECIFIED
+	return info.Kind
 }
 
 func PrefixToResourceKind(prefix ResourcePrefix) inv_v1.ResourceKind {
-	mapPrefixToResourceKind := map[ResourcePrefix]inv_v1.ResourceKind{
-		ResourcePrefixInstance:         inv_v1.ResourceKind_RESOURCE_KIND_INSTANCE,
-		ResourcePrefixHost:             inv_v1.ResourceKind_RESOURCE_KIND_HOST,
-		ResourcePrefixHoststorage:      inv_v1.ResourceKind_RESOURCE_KIND_HOSTSTORAGE,
-		ResourcePrefixHostnic:          inv_v1.ResourceKind_RESOURCE_KIND_HOSTNIC,
-		ResourcePrefixHostusb:          inv_v1.ResourceKind_RESOURCE_KIND_HOSTUSB,
-		ResourcePrefixHostgpu:          inv_v1.ResourceKind_RESOURCE_KIND_HOSTGPU,
-		ResourcePrefixNetworkSegment:   inv_v1.ResourceKind_RESOURCE_KIND_NETWORKSEGMENT,
-		ResourcePrefixNetlink:          inv_v1.ResourceKind_RESOURCE_KIND_NETLINK,
-		ResourcePrefixEndpoint:         inv_v1.ResourceKind_RESOURCE_KIND_ENDPOINT,
-		ResourcePrefixSite:             inv_v1.ResourceKind_RESOURCE_KIND_SITE,
-		ResourcePrefixRegion:           inv_v1.ResourceKind_RESOURCE_KIND_REGION,
-		ResourcePrefixOu:               inv_v1.ResourceKind_RESOURCE_KIND_OU,
-		ResourcePrefixProvider:         inv_v1.ResourceKind_RESOURCE_KIND_PROVIDER,
-		ResourcePrefixOs:               inv_v1.ResourceKind_RESOURCE_KIND_OS,
-		ResourcePrefixSingleSchedule:   inv_v1.ResourceKind_RESOURCE_KIND_SINGLESCHEDULE,
-		ResourcePrefixRepeatedSchedule: inv_v1.ResourceKind_RESOURCE_KIND_REPEATEDSCHEDULE,
-		ResourcePrefixTelemetryGroup:   inv_v1.ResourceKind_RESOURCE_KIND_TELEMETRY_GROUP,
-		ResourcePrefixTelemetryProfile: inv_v1.ResourceKind_RESOURCE_KIND_TELEMETRY_PROFILE,
-		ResourcePrefixWorkload:         inv_v1.ResourceKind_RESOURCE_KIND_WORKLOAD,
-		ResourcePrefixWorkloadMember:   inv_v1.ResourceKind_RESOURCE_KIND_WORKLOAD_MEMBER,
-		ResourcePrefixIPAddress:        inv_v1.ResourceKind_RESOURCE_KIND_IPADDRESS,
-		ResourcePrefixRemoteAccessConf: inv_v1.ResourceKind_RESOURCE_KIND_RMT_ACCESS_CONF,
-		ResourcePrefixLocalAccount:     inv_v1.ResourceKind_RESOURCE_KIND_LOCALACCOUNT,
-		ResourcePrefixOsUpdatePolicy:   inv_v1.ResourceKind_RESOURCE_KIND_OSUPDATEPOLICY,
-		ResourcePrefixTenant:           inv_v1.ResourceKind_RESOURCE_KIND_TENANT,
-		ResourcePrefixCustomConfig:     inv_v1.ResourceKind_RESOURCE_KIND_CUSTOMCONFIG,
-		ResourcePrefixOsUpdateRun:      inv_v1.ResourceKind_RESOURCE_KIND_OSUPDATERUN,
-	}
-
-	resourceKind, ok := mapPrefixToResourceKind[prefix]
+	info, ok := lookupResourceKindByPrefix(prefix)
 	if !ok {
 		zlog.InfraSec().InfraError("Unable to map resource prefix %s", prefix).Msg("")
 		return inv_v1.ResourceKind_RESOURCE_KIND_UNSPECIFIED
 	}
-	return resourceKind
+	return info.Kind
 }
 
 func stringToPrefix(s string) (ResourcePrefix, error) {
-	mapStringToPrefix := map[string]ResourcePrefix{
-		string(ResourcePrefixUnspecified):      ResourcePrefixUnspecified,
-		string(ResourcePrefixInstance):         ResourcePrefixInstance,
-		string(ResourcePrefixHost):             ResourcePrefixHost,
-		string(ResourcePrefixHoststorage):      ResourcePrefixHoststorage,
-		string(ResourcePrefixHostnic):          ResourcePrefixHostnic,
-		string(ResourcePrefixHostusb):          ResourcePrefixHostusb,
-		string(ResourcePrefixHostgpu):          ResourcePrefixHostgpu,
-		string(ResourcePrefixNetworkSegment):   ResourcePrefixNetworkSegment,
-		string(ResourcePrefixNetlink):          ResourcePrefixNetlink,
-		string(ResourcePrefixEndpoint):         ResourcePrefixEndpoint,
-		string(ResourcePrefixRegion):           ResourcePrefixRegion,
-		string(ResourcePrefixSite):             ResourcePrefixSite,
-		string(ResourcePrefixOu):               ResourcePrefixOu,
-		string(ResourcePrefixProject):          ResourcePrefixProject,
-		string(ResourcePrefixUser):             ResourcePrefixUser,
-		string(ResourcePrefixProvider):         ResourcePrefixProvider,
-		string(ResourcePrefixOs):               ResourcePrefixOs,
-		string(ResourcePrefixSingleSchedule):   ResourcePrefixSingleSchedule,
-		string(ResourcePrefixRepeatedSchedule): ResourcePrefixRepeatedSchedule,
-		string(ResourcePrefixTelemetryGroup):   ResourcePrefixTelemetryGroup,
-		string(ResourcePrefixTelemetryProfile): ResourcePrefixTelemetryProfile,
-		string(ResourcePrefixWorkload):         ResourcePrefixWorkload,
-		string(ResourcePrefixWorkloadMember):   ResourcePrefixWorkloadMember,
-		string(ResourcePrefixIPAddress):        ResourcePrefixIPAddress,
-		string(ResourcePrefixRemoteAccessConf): ResourcePrefixRemoteAccessConf,
-		string(ResourcePrefixLocalAccount):     ResourcePrefixLocalAccount,
-		string(ResourcePrefixTenant):           ResourcePrefixTenant,
-		string(ResourcePrefixOsUpdatePolicy):   ResourcePrefixOsUpdatePolicy,
-		string(ResourcePrefixCustomConfig):     ResourcePrefixCustomConfig,
-		string(ResourcePrefixOsUpdateRun):      ResourcePrefixOsUpdateRun,
+	prefix := ResourcePrefix(s)
+	switch prefix {
+	// Prefixes of IDs that are known but not owned by the inventory.
+	case ResourcePrefixUnspecified, ResourcePrefixProject, ResourcePrefixUser:
+		return prefix, nil
 	}
-
-	prefix, ok := mapStringToPrefix[s]
-	if !ok {
+	if _, ok := lookupResourceKindByPrefix(prefix); !ok {
 		zlog.InfraSec().InfraError("%s does not match any known ResourcePrefix", s).Msg("")
 		return ResourcePrefixUnspecified, errors.Errorfc(codes.InvalidArgument,
 			"%s does not match any known ResourcePrefix",
@@ -288,144 +138,24 @@ func GetResourceKindFromResourceID(resID string) (inv_v1.ResourceKind, error) {
 }
 
 // GetResourceIDFromResource extracts the resource ID from a wrapped resource.
-//
-//nolint:cyclop,funlen // high cyclomatic complexity due to the switch and length due to the switch
 func GetResourceIDFromResource(resource *inv_v1.Resource) (string, error) {
-	switch resource.GetResource().(type) {
-	case *inv_v1.Resource_Region:
-		return resource.GetRegion().GetResourceId(), nil
-	case *inv_v1.Resource_Site:
-		return resource.GetSite().GetResourceId(), nil
-	case *inv_v1.Resource_Ou:
-		return resource.GetOu().GetResourceId(), nil
-	case *inv_v1.Resource_Instance:
-		return resource.GetInstance().GetResourceId(), nil
-	case *inv_v1.Resource_Host:
-		return resource.GetHost().GetResourceId(), nil
-	case *inv_v1.Resource_Hoststorage:
-		return resource.GetHoststorage().GetResourceId(), nil
-	case *inv_v1.Resource_Hostnic:
-		return resource.GetHostnic().GetResourceId(), nil
-	case *inv_v1.Resource_Hostusb:
-		return resource.GetHostusb().GetResourceId(), nil
-	case *inv_v1.Resource_Hostgpu:
-		return resource.GetHostgpu().GetResourceId(), nil
-	case *inv_v1.Resource_NetworkSegment:
-		return resource.GetNetworkSegment().GetResourceId(), nil
-	case *inv_v1.Resource_Netlink:
-		return resource.GetNetlink().GetResourceId(), nil
-	case *inv_v1.Resource_Endpoint:
-		return resource.GetEndpoint().GetResourceId(), nil
-	case *inv_v1.Resource_Ipaddress:
-		return resource.GetIpaddress().GetResourceId(), nil
-	case *inv_v1.Resource_Provider:
-		return resource.GetProvider().GetResourceId(), nil
-	case *inv_v1.Resource_Os:
-		return resource.GetOs().GetResourceId(), nil
-	case *inv_v1.Resource_Singleschedule:
-		return resource.GetSingleschedule().GetResourceId(), nil
-	case *inv_v1.Resource_Repeatedschedule:
-		return resource.GetRepeatedschedule().GetResourceId(), nil
-	case *inv_v1.Resource_TelemetryGroup:
-		return resource.GetTelemetryGroup().GetResourceId(), nil
-	case *inv_v1.Resource_TelemetryProfile:
-		return resource.GetTelemetryProfile().GetResourceId(), nil
-	case *inv_v1.Resource_Workload:
-		return resource.GetWorkload().GetResourceId(), nil
-	case *inv_v1.Resource_WorkloadMember:
-		return resource.GetWorkloadMember().GetResourceId(), nil
-	case *inv_v1.Resource_RemoteAccess:
-		return resource.GetRemoteAccess().GetResourceId(), nil
-	case *inv_v1.Resource_Tenant:
-		return resource.GetTenant().GetResourceId(), nil
-	case *inv_v1.Resource_LocalAccount:
-		return resource.GetLocalAccount().GetResourceId(), nil
-	case *inv_v1.Resource_OsUpdatePolicy:
-		return resource.GetOsUpdatePolicy().GetResourceId(), nil
-	case *inv_v1.Resource_CustomConfig:
-		return resource.GetCustomConfig().GetResourceId(), nil
-	case *inv_v1.Resource_OsUpdateRun:
-		return resource.GetOsUpdateRun().GetResourceId(), nil
-	default:
-		zlog.InfraSec().InfraError("unknown Resource type: %T", resource.GetResource()).Msg("")
-		return "", errors.Errorfc(codes.InvalidArgument, "unknown Resource type: %T", resource.GetResource())
+	internalRes, err := getResourceProtoMessage(resource)
+	if err != nil {
+		return "", err
+	}
+	carrier, ok := internalRes.(resourceIDCarrier)
+	if !ok {
+		// This error should never happen
+		err = errors.Errorfc(codes.InvalidArgument, "unknown Resource type: %T", resource.GetResource())
+		zlog.InfraSec().InfraErr(err).Msg("")
+		return "", err
 	}
+	return carrier.GetResourceId(), nil
 }
 
 // WrapResource takes a resource and returns it in the generic form.
-//
-//nolint:cyclop,funlen // high cyclomatic complexity and length due to the switch
 func WrapResource(resource proto.Message) (*inv_v1.Resource, error) {
-	wrap := &inv_v1.Resource{}
-	switch r := resource.(type) {
-	case *location_v1.RegionResource:
-		wrap.Resource = &inv_v1.Resource_Region{Region: r}
-	case *location_v1.SiteResource:
-		wrap.Resource = &inv_v1.Resource_Site{Site: r}
-	case *ou_v1.OuResource:
-		wrap.Resource = &inv_v1.Resource_Ou{Ou: r}
-	case *compute_v1.InstanceResource:
-		wrap.Resource = &inv_v1.Resource_Instance{Instance: r}
-	case *compute_v1.HostResource:
-		wrap.Resource = &inv_v1.Resource_Host{Host: r}
-	case *compute_v1.HoststorageResource:
-		wrap.Resource = &inv_v1.Resource_Hoststorage{Hoststorage: r}
-	case *compute_v1.HostnicResource:
-		wrap.Resource = &inv_v1.Resource_Hostnic{Hostnic: r}
-	case *compute_v1.HostusbResource:
-		wrap.Resource = &inv_v1.Resource_Hostusb{Hostusb: r}
-	case *compute_v1.HostgpuResource:
-		wrap.Resource = &inv_v1.Resource_Hostgpu{Hostgpu: r}
-	case *compute_v1.WorkloadResource:
-		wrap.Resource = &inv_v1.Resource_Workload{Workload: r}
-	case *compute_v1.WorkloadMember:
-		wrap.Resource = &inv_v1.Resource_WorkloadMember{WorkloadMember: r}
-	case *network_v1.NetworkSegment:
-		wrap.Resource = &inv_v1.Resource_NetworkSegment{NetworkSegment: r}
-	case *network_v1.NetlinkResource:
-		wrap.Resource = &inv_v1.Resource_Netlink{Netlink: r}
-	case *network_v1.EndpointResource:
-		wrap.Resource = &inv_v1.Resource_Endpoint{Endpoint: r}
-	case *network_v1.IPAddressResource:
-		wrap.Resource = &inv_v1.Resource_Ipaddress{Ipaddress: r}
-	case *provider_v1.ProviderResource:
-		wrap.Resource = &inv_v1.Resource_Provider{Provider: r}
-	case *os_v1.OperatingSystemResource:
-		wrap.Resource = &inv_v1.Resource_Os{Os: r}
-	case *schedule_v1.SingleScheduleResource:
-		wrap.Resource = &inv_v1.Resource_Singleschedule{Singleschedule: r}
-	case *schedule_v1.RepeatedScheduleResource:
-		wrap.Resource = &inv_v1.Resource_Repeatedschedule{Repeatedschedule: r}
-	case *telemetry_v1.TelemetryGroupResource:
-		wrap.Resource = &inv_v1.Resource_TelemetryGroup{TelemetryGroup: r}
-	case *telemetry_v1.TelemetryProfile:
-		wrap.Resource = &inv_v1.Resource_TelemetryProfile{TelemetryProfile: r}
-	case *remoteaccessv1.RemoteAccessConfiguration:
-		wrap.Resource = &inv_v1.Resource_RemoteAccess{
-			RemoteAccess: r,
-		}
-	case *tenantv1.Tenant:
-		wrap.Resource = &inv_v1.Resource_Tenant{
-			Tenant: r,
-		}
-	case *localaccountv1.LocalAccountResource:
-		wrap.Resource = &inv_v1.Resource_LocalAccount{
-			LocalAccount: r,
-		}
-	case *compute_v1.OSUpdatePolicyResource:
-		wrap.Resource = &inv_v1.Resource_OsUpdatePolicy{OsUpdatePolicy: r}
-	case *compute_v1.CustomConfigResource:
-		wrap.Resource = &inv_v1.Resource_CustomConfig{
-			CustomConfig: r,
-		}
-	case *compute_v1.OSUpdateRunResource:
-		wrap.Resource = &inv_v1.Resource_OsUpdateRun{OsUpdateRun: r}
-	default:
-		zlog.InfraSec().InfraError("unknown Resource type: %T", resource).Msg("")
-		return nil, errors.Errorfc(codes.InvalidArgument, "unknown Resource type: %T", resource)
-	}
-
-	return wrap, nil
+	return wrapResourceMessage(resource)
 }
 
 // UnwrapResource returns the underlying resource given a generic Resource.
@@ -450,46 +180,19 @@ func UnwrapResource[T proto.Message](resource *inv_v1.Resource) (T, error) {
 }
 
 func GetResourceKindFromMessage(message proto.Message) (inv_v1.ResourceKind, error) {
-	mapStringToPrefix := map[string]inv_v1.ResourceKind{
-		"unspecified":              inv_v1.ResourceKind_RESOURCE_KIND_UNSPECIFIED,
-		"InstanceResource":         inv_v1.ResourceKind_RESOURCE_KIND_INSTANCE,
-		"HostResource":             inv_v1.ResourceKind_RESOURCE_KIND_HOST,
-		"HoststorageResource":      inv_v1.ResourceKind_RESOURCE_KIND_HOSTSTORAGE,
-		"HostnicResource":          inv_v1.ResourceKind_RESOURCE_KIND_HOSTNIC,
-		"HostusbResource":          inv_v1.ResourceKind_RESOURCE_KIND_HOSTUSB,
-		"HostgpuResource":          inv_v1.ResourceKind_RESOURCE_KIND_HOSTGPU,
-		"NetworkSegment":           inv_v1.ResourceKind_RESOURCE_KIND_NETWORKSEGMENT,
-		"NetlinkResource":          inv_v1.ResourceKind_RESOURCE_KIND_NETLINK,
-		"EndpointResource":         inv_v1.ResourceKind_RESOURCE_KIND_ENDPOINT,
-		"RegionResource":           inv_v1.ResourceKind_RESOURCE_KIND_REGION,
-		"SiteResource":             inv_v1.ResourceKind_RESOURCE_KIND_SITE,
-		"OuResource":               inv_v1.ResourceKind_RESOURCE_KIND_OU,
-		"ProviderResource":         inv_v1.ResourceKind_RESOURCE_KIND_PROVIDER,
-		"OperatingSystemResource":  inv_v1.ResourceKind_RESOURCE_KIND_OS,
-		"SingleScheduleResource":   inv_v1.ResourceKind_RESOURCE_KIND_SINGLESCHEDULE,
-		"RepeatedScheduleResource": inv_v1.ResourceKind_RESOURCE_KIND_REPEATEDSCHEDULE,
-		"TelemetryGroupResource":   inv_v1.ResourceKind_RESOURCE_KIND_TELEMETRY_GROUP,
-		"TelemetryProfile":         inv_v1.ResourceKind_RESOURCE_KIND_TELEMETRY_PROFILE,
-		"WorkloadResource":         inv_v1.ResourceKind_RESOURCE_KIND_WORKLOAD,
-		"WorkloadMember":           inv_v1.ResourceKind_RESOURCE_KIND_WORKLOAD_MEMBER,
-		"IPAddressResource":        inv_v1.ResourceKind_RESOURCE_KIND_IPADDRESS,
-		"RemoteAccessConfResource": inv_v1.ResourceKind_RESOURCE_KIND_RMT_ACCESS_CONF,
-		"LocalAccountResource":     inv_v1.ResourceKind_RESOURCE_KIND_LOCALACCOUNT,
-		"CustomConfigResource":     inv_v1.ResourceKind_RESOURCE_KIND_CUSTOMCONFIG,
-		string(proto.MessageName(&tenantv1.Tenant{}).Name()):                   inv_v1.ResourceKind_RESOURCE_KIND_TENANT,
-		string(proto.MessageName(&compute_v1.OSUpdatePolicyResource{}).Name()): inv_v1.ResourceKind_RESOURCE_KIND_OSUPDATEPOLICY,
-		string(proto.MessageName(&compute_v1.OSUpdateRunResource{}).Name()):    inv_v1.ResourceKind_RESOURCE_KIND_OSUPDATERUN,
-	}
-	resname := string(proto.MessageName(message).Name())
-	kind, ok := mapStringToPrefix[resname]
+	info, ok := lookupResourceKindByMessage(message)
 	if !ok {
+		var resname protoreflect.Name
+		if message != nil {
+			resname = proto.MessageName(message).Name()
+		}
 		zlog.InfraSec().InfraError("%s does not match any known Resource", resname).Msg("")
 		return inv_v1.ResourceKind_RESOURCE_KIND_UNSPECIFIED, errors.Errorfc(codes.InvalidArgument,
 			"%s does not match any known Resource",
 			resname,
 		)
 	}
-	return kind, nil
+	return info.Kind, nil
 }
 
 func NewInvID(kind inv_v1.ResourceKind) string {
@@ -812,48 +515,8 @@ func GetDBURL(env *DBEnv) string {
 // GetResourceFromKind Get a Resource with the given Resource kind set. Useful when filtering without any specified filter
 // to get all resources of a given kind.
 func GetResourceFromKind(resourceType inv_v1.ResourceKind) (*inv_v1.Resource, error) {
-	invResMap := map[inv_v1.ResourceKind]*inv_v1.Resource{
-		inv_v1.ResourceKind_RESOURCE_KIND_REGION: {Resource: &inv_v1.Resource_Region{}},
-		inv_v1.ResourceKind_RESOURCE_KIND_SITE:   {Resource: &inv_v1.Resource_Site{}},
-
-		inv_v1.ResourceKind_RESOURCE_KIND_OU: {Resource: &inv_v1.Resource_Ou{}},
-
-		inv_v1.ResourceKind_RESOURCE_KIND_INSTANCE: {Resource: &inv_v1.Resource_Instance{}},
-
-		inv_v1.ResourceKind_RESOURCE_KIND_HOST:        {Resource: &inv_v1.Resource_Host{}},
-		inv_v1.ResourceKind_RESOURCE_KIND_HOSTSTORAGE: {Resource: &inv_v1.Resource_Hoststorage{}},
-		inv_v1.ResourceKind_RESOURCE_KIND_HOSTNIC:     {Resource: &inv_v1.Resource_Hostnic{}},
-		inv_v1.ResourceKind_RESOURCE_KIND_HOSTUSB:     {Resource: &inv_v1.Resource_Hostusb{}},
-		inv_v1.ResourceKind_RESOURCE_KIND_HOSTGPU:     {Resource: &inv_v1.Resource_Hostgpu{}},
-
-		inv_v1.ResourceKind_RESOURCE_KIND_NETWORKSEGMENT: {Resource: &inv_v1.Resource_NetworkSegment{}},
-		inv_v1.ResourceKind_RESOURCE_KIND_NETLINK:        {Resource: &inv_v1.Resource_Netlink{}},
-		inv_v1.ResourceKind_RESOURCE_KIND_ENDPOINT:       {Resource: &inv_v1.Resource_Endpoint{}},
-		inv_v1.ResourceKind_RESOURCE_KIND_IPADDRESS:      {Resource: &inv_v1.Resource_Ipaddress{}},
-
-		inv_v1.ResourceKind_RESOURCE_KIND_PROVIDER: {Resource: &inv_v1.Resource_Provider{}},
-
-		inv_v1.ResourceKind_RESOURCE_KIND_OS: {Resource: &inv_v1.Resource_Os{}},
-
-		inv_v1.ResourceKind_RESOURCE_KIND_SINGLESCHEDULE:   {Resource: &inv_v1.Resource_Singleschedule{}},
-		inv_v1.ResourceKind_RESOURCE_KIND_REPEATEDSCHEDULE: {Resource: &inv_v1.Resource_Repeatedschedule{}},
-
-		inv_v1.ResourceKind_RESOURCE_KIND_TELEMETRY_GROUP:   {Resource: &inv_v1.Resource_TelemetryGroup{}},
-		inv_v1.ResourceKind_RESOURCE_KIND_TELEMETRY_PROFILE: {Resource: &inv_v1.Resource_TelemetryProfile{}},
-
-		inv_v1.ResourceKind_RESOURCE_KIND_WORKLOAD:        {Resource: &inv_v1.Resource_Workload{}},
-		inv_v1.ResourceKind_RESOURCE_KIND_WORKLOAD_MEMBER: {Resource: &inv_v1.Resource_WorkloadMember{}},
-
-		inv_v1.ResourceKind_RESOURCE_KIND_RMT_ACCESS_CONF: {Resource: &inv_v1.Resource_RemoteAccess{}},
-		inv_v1.ResourceKind_RESOURCE_KIND_TENANT:          {Resource: &inv_v1.Resource_Tenant{}},
-		inv_v1.ResourceKind_RESOURCE_KIND_LOCALACCOUNT:    {Resource: &inv_v1.Resource_LocalAccount{}},
-
-		inv_v1.ResourceKind_RESOURCE_KIND_OSUPDATEPOLICY: {Resource: &inv_v1.Resource_OsUpdatePolicy{}},
-		inv_v1.ResourceKind_RESOURCE_KIND_CUSTOMCONFIG:   {Resource: &inv_v1.Resource_CustomConfig{}},
-		inv_v1.ResourceKind_RESOURCE_KIND_OSUPDATERUN:    {Resource: &inv_v1.Resource_OsUpdateRun{}},
-	}
-	if res, ok := invResMap[resourceType]; ok {
-		return res, nil
+	if info, ok := LookupResourceKind(resourceType); ok {
+		return info.NewResource(), nil
 	}
 	err := errors.Errorfc(codes.InvalidArgument, "unsupported resource kind %s", resourceType)
 	zlog.InfraSec().InfraErr(err).Msg("")
@@ -862,167 +525,24 @@ func GetResourceFromKind(resourceType inv_v1.ResourceKind) (*inv_v1.Resource, er
 
 // GetSetResource returns the set resource as proto message.
 func GetSetResource(resource *inv_v1.Resource) (proto.Message, error) {
-	kind := GetResourceKindFromResource(resource)
-	var resProto proto.Message
-
-	kindToResource := map[inv_v1.ResourceKind]func(*inv_v1.Resource) proto.Message{
-		inv_v1.ResourceKind_RESOURCE_KIND_REGION: func(r *inv_v1.Resource) proto.Message { return r.GetRegion() },
-		inv_v1.ResourceKind_RESOURCE_KIND_SITE:   func(r *inv_v1.Resource) proto.Message { return r.GetSite() },
-
-		inv_v1.ResourceKind_RESOURCE_KIND_OU: func(r *inv_v1.Resource) proto.Message { return r.GetOu() },
-
-		inv_v1.ResourceKind_RESOURCE_KIND_INSTANCE: func(r *inv_v1.Resource) proto.Message { return r.GetInstance() },
-
-		inv_v1.ResourceKind_RESOURCE_KIND_HOST: func(r *inv_v1.Resource) proto.Message {
-			return r.GetHost()
-		},
-		inv_v1.ResourceKind_RESOURCE_KIND_HOSTSTORAGE: func(r *inv_v1.Resource) proto.Message {
-			return r.GetHoststorage()
-		},
-		inv_v1.ResourceKind_RESOURCE_KIND_HOSTNIC: func(r *inv_v1.Resource) proto.Message {
-			return r.GetHostnic()
-		},
-		inv_v1.ResourceKind_RESOURCE_KIND_HOSTUSB: func(r *inv_v1.Resource) proto.Message {
-			return r.GetHostusb()
-		},
-		inv_v1.ResourceKind_RESOURCE_KIND_HOSTGPU: func(r *inv_v1.Resource) proto.Message {
-			return r.GetHostgpu()
-		},
-
-		inv_v1.ResourceKind_RESOURCE_KIND_NETWORKSEGMENT: func(r *inv_v1.Resource) proto.Message {
-			return r.GetNetworkSegment()
-		},
-		inv_v1.ResourceKind_RESOURCE_KIND_NETLINK: func(r *inv_v1.Resource) proto.Message {
-			return r.GetNetlink()
-		},
-		inv_v1.ResourceKind_RESOURCE_KIND_ENDPOINT: func(r *inv_v1.Resource) proto.Message {
-			return r.GetEndpoint()
-		},
-		inv_v1.ResourceKind_RESOURCE_KIND_IPADDRESS: func(r *inv_v1.Resource) proto.Message {
-			return r.GetIpaddress()
-		},
-
-		inv_v1.ResourceKind_RESOURCE_KIND_PROVIDER: func(r *inv_v1.Resource) proto.Message { return r.GetProvider() },
-
-		inv_v1.ResourceKind_RESOURCE_KIND_OS: func(r *inv_v1.Resource) proto.Message { return r.GetOs() },
-
-		inv_v1.ResourceKind_RESOURCE_KIND_SINGLESCHEDULE: func(r *inv_v1.Resource) proto.Message {
-			return r.GetSingleschedule()
-		},
-		inv_v1.ResourceKind_RESOURCE_KIND_REPEATEDSCHEDULE: func(r *inv_v1.Resource) proto.Message {
-			return r.GetRepeatedschedule()
-		},
-
-		inv_v1.ResourceKind_RESOURCE_KIND_TELEMETRY_GROUP: func(r *inv_v1.Resource) proto.Message {
-			return r.GetTelemetryGroup()
-		},
-		inv_v1.ResourceKind_RESOURCE_KIND_TELEMETRY_PROFILE: func(r *inv_v1.Resource) proto.Message {
-			return r.GetTelemetryProfile()
-		},
-
-		inv_v1.ResourceKind_RESOURCE_KIND_WORKLOAD: func(r *inv_v1.Resource) proto.Message {
-			return r.GetWorkload()
-		},
-		inv_v1.ResourceKind_RESOURCE_KIND_WORKLOAD_MEMBER: func(r *inv_v1.Resource) proto.Message {
-			return r.GetWorkloadMember()
-		},
-
-		inv_v1.ResourceKind_RESOURCE_KIND_RMT_ACCESS_CONF: func(r *inv_v1.Resource) proto.Message {
-			return r.GetRemoteAccess()
-		},
-		inv_v1.ResourceKind_RESOURCE_KIND_TENANT: func(r *inv_v1.Resource) proto.Message {
-			return r.GetTenant()
-		},
-		inv_v1.ResourceKind_RESOURCE_KIND_LOCALACCOUNT: func(r *inv_v1.Resource) proto.Message {
-			return r.GetLocalAccount()
-		},
-
-		inv_v1.ResourceKind_RESOURCE_KIND_OSUPDATEPOLICY: func(r *inv_v1.Resource) proto.Message {
-			return r.GetOsUpdatePolicy()
-		},
-		inv_v1.ResourceKind_RESOURCE_KIND_CUSTOMCONFIG: func(r *inv_v1.Resource) proto.Message {
-			return r.GetCustomConfig()
-		},
-		inv_v1.ResourceKind_RESOURCE_KIND_OSUPDATERUN: func(r *inv_v1.Resource) proto.Message {
-			return r.GetOsUpdateRun()
-		},
-	}
-	convert, ok := kindToResource[kind]
+	_, message, ok := lookupResourceKindByResource(resource)
 	if !ok {
-		err := errors.Errorfc(codes.InvalidArgument, "unsupported resource kind %s", kind)
+		err := errors.Errorfc(codes.InvalidArgument, "unsupported resource kind %s",
+			inv_v1.ResourceKind_RESOURCE_KIND_UNSPECIFIED)
 		zlog.InfraSec().InfraErr(err).Msg("")
 		return nil, err
 	}
-	resProto = convert(resource)
-	return resProto, nil
+	return message, nil
 }
 
 // getResourceProtoMessage returns "oneof" the proto message given
 // the generic resource message provided as input.
-//
-//nolint:cyclop,funlen // high cyclomatic complexity and length due to the switch
 func getResourceProtoMessage(resource *inv_v1.Resource) (proto.Message, error) {
-	var message proto.Message
-	switch resource.GetResource().(type) {
-	case *inv_v1.Resource_Region:
-		message = resource.GetRegion()
-	case *inv_v1.Resource_Site:
-		message = resource.GetSite()
-	case *inv_v1.Resource_Ou:
-		message = resource.GetOu()
-	case *inv_v1.Resource_Instance:
-		message = resource.GetInstance()
-	case *inv_v1.Resource_Host:
-		message = resource.GetHost()
-	case *inv_v1.Resource_Hoststorage:
-		message = resource.GetHoststorage()
-	case *inv_v1.Resource_Hostnic:
-		message = resource.GetHostnic()
-	case *inv_v1.Resource_Hostusb:
-		message = resource.GetHostusb()
-	case *inv_v1.Resource_Hostgpu:
-		message = resource.GetHostgpu()
-	case *inv_v1.Resource_NetworkSegment:
-		message = resource.GetNetworkSegment()
-	case *inv_v1.Resource_Netlink:
-		message = resource.GetNetlink()
-	case *inv_v1.Resource_Endpoint:
-		message = resource.GetEndpoint()
-	case *inv_v1.Resource_Ipaddress:
-		message = resource.GetIpaddress()
-	case *inv_v1.Resource_Provider:
-		message = resource.GetProvider()
-	case *inv_v1.Resource_Os:
-		message = resource.GetOs()
-	case *inv_v1.Resource_Singleschedule:
-		message = resource.GetSingleschedule()
-	case *inv_v1.Resource_Repeatedschedule:
-		message = resource.GetRepeatedschedule()
-	case *inv_v1.Resource_TelemetryGroup:
-		message = resource.GetTelemetryGroup()
-	case *inv_v1.Resource_TelemetryProfile:
-		message = resource.GetTelemetryProfile()
-	case *inv_v1.Resource_Workload:
-		message = resource.GetWorkload()
-	case *inv_v1.Resource_WorkloadMember:
-		message = resource.GetWorkloadMember()
-	case *inv_v1.Resource_RemoteAccess:
-		message = resource.GetRemoteAccess()
-	case *inv_v1.Resource_Tenant:
-		message = resource.GetTenant()
-	case *inv_v1.Resource_LocalAccount:
-		message = resource.GetLocalAccount()
-	case *inv_v1.Resource_OsUpdatePolicy:
-		message = resource.GetOsUpdatePolicy()
-	case *inv_v1.Resource_CustomConfig:
-		message = resource.GetCustomConfig()
-	case *inv_v1.Resource_OsUpdateRun:
-		message = resource.GetOsUpdateRun()
-	default:
+	_, message, ok := lookupResourceKindByResource(resource)
+	if !ok {
 		zlog.InfraSec().InfraError("unknown Resource type: %T", resource.GetResource()).Msg("")
 		return nil, errors.Errorfc(codes.InvalidArgument, "unknown Resource type: %T", resource.GetResource())
 	}
-
 	return message, nil
 }
 
@@ -1073,21 +593,30 @@ func ConvertInventoryUUIDToLenovoUUID(uuidStr string) string {
 	return strings.ToUpper(strings.ReplaceAll(uuidStr, "-", ""))
 }
 
+type resourceIDCarrier interface {
+	GetResourceId() string
+}
+
 type resourceKeyCarrier interface {
 	GetTenantId() string
-	GetResourceId() string
+	resourceIDCarrier
 }
 
 func GetResourceKeyFromResource(resource *inv_v1.Resource) (tenantID, resourceID string, err error) {
-	internalRes, err := getResourceProtoMessage(resource)
-	if err != nil {
-		return "", "", err
+	info, internalRes, ok := lookupResourceKindByResource(resource)
+	if !ok {
+		zlog.InfraSec().InfraError("unknown Resource type: %T", resource.GetResource()).Msg("")
+		return "", "", errors.Errorfc(codes.InvalidArgument, "unknown Resource type: %T", resource.GetResource())
+	}
+	if !info.TenantScoped {
+		resourceID, err = GetResourceIDFromResource(resource)
+		return "", resourceID, err
 	}
 	carrier, ok := internalRes.(resourceKeyCarrier)
 	if !ok {
 		// This error should never happen
 		err = errors.Errorfc(codes.InvalidArgument, "Resource does not implement resourceKeyCarrier interface")
-		zlog.Err(err).Msgf("This error should never happen: resource=%v", resource)
+		zlog.Err(err).Msgf("This error should never happen: resource=%v", logging.Redact(resource))
 		return "", "", err
 	}
 	return carrier.GetTenantId(), carrier.GetResourceId(), nil
@@ -8,6 +8,7 @@ import (
 	"google.golang.org/protobuf/proto"
 
 	computev1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/compute/v1"
+	customresourcev1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/customresource/v1"
 	inventoryv1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/inventory/v1"
 	localaccountv1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/localaccount/v1"
 	locationv1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/location/v1"
@@ -16,6 +17,7 @@ import (
 	ouv1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/ou/v1"
 	providerv1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/provider/v1"
 	remoteaccessv1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/remoteaccess/v1"
+	rolebindingv1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/rolebinding/v1"
 	schedulev1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/schedule/v1"
 	telemetryv1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/telemetry/v1"
 	tenantv1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/tenant/v1"
@@ -48,8 +50,12 @@ var invMessages = []proto.Message{
 	&inventoryv1.DeleteResourceResponse{},
 	&inventoryv1.ListResourcesRequest{},
 	&inventoryv1.ListResourcesResponse{},
+	&inventoryv1.SearchResourcesRequest{},
+	&inventoryv1.GetFleetStatisticsRequest{},
 	&inventoryv1.ListInheritedTelemetryProfilesRequest{},
 	&inventoryv1.ListInheritedTelemetryProfilesResponse{},
+	&inventoryv1.GetEffectiveTelemetryProfilesRequest{},
+	&inventoryv1.DiffEffectiveTelemetryProfilesRequest{},
 	&inventoryv1.DeleteAllResourcesRequest{},
 
 	// Inventory resources
@@ -76,6 +82,9 @@ var invMessages = []proto.Message{
 	&telemetryv1.TelemetryProfile{},
 	&remoteaccessv1.RemoteAccessConfiguration{},
 	&localaccountv1.LocalAccountResource{},
+	&customresourcev1.CustomTypeResource{},
+	&customresourcev1.CustomObjectResource{},
+	&rolebindingv1.RoleBindingResource{},
 	&tenantv1.Tenant{},
 }
 
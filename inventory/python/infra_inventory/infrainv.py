@@ -18,3 +18,5 @@ class Index(betterproto.Message):
     fields: List[str] = betterproto.string_field(2)
     unique: bool = betterproto.bool_field(3)
     partial_index_condition: str = betterproto.string_field(4)
+    type: str = betterproto.string_field(5)
+    op_class: str = betterproto.string_field(6)
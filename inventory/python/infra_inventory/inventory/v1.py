@@ -8,6 +8,7 @@ import betterproto
 import grpclib
 
 from .compute import v1
+from .customresource import v1
 from .google import protobuf
 from .localaccount import v1
 from .location import v1
@@ -16,6 +17,7 @@ from .os import v1
 from .ou import v1
 from .provider import v1
 from .remoteaccess import v1
+from .rolebinding import v1
 from .schedule import v1
 from .telemetry import v1
 from .tenant import v1
@@ -57,6 +59,15 @@ class ResourceKind(betterproto.Enum):
     RESOURCE_KIND_OSUPDATEPOLICY = 180
     RESOURCE_KIND_CUSTOMCONFIG = 190
     RESOURCE_KIND_OSUPDATERUN = 200
+    RESOURCE_KIND_CUSTOMTYPE = 210
+    RESOURCE_KIND_CUSTOMOBJECT = 211
+    RESOURCE_KIND_ROLEBINDING = 220
+
+
+class TelemetryInheritanceMode(betterproto.Enum):
+    TELEMETRY_INHERITANCE_MODE_UNSPECIFIED = 0
+    TELEMETRY_INHERITANCE_MODE_ALL = 1
+    TELEMETRY_INHERITANCE_MODE_OVERRIDE = 2
 
 
 class SubscribeEventsResponseEventKind(betterproto.Enum):
@@ -169,6 +180,15 @@ class Resource(betterproto.Message):
     os_update_run: v1.OSUpdateRunResource = betterproto.message_field(
         200, group="resource"
     )
+    custom_type: v1.CustomTypeResource = betterproto.message_field(
+        210, group="resource"
+    )
+    custom_object: v1.CustomObjectResource = betterproto.message_field(
+        211, group="resource"
+    )
+    role_binding: v1.RoleBindingResource = betterproto.message_field(
+        220, group="resource"
+    )
 
 
 @dataclass
@@ -210,7 +230,11 @@ class ResourceFilter(betterproto.Message):
     # `name = "foo"` and `name = "FOO"` are equivalent.  - String equality
     # comparisons are fuzzy. `name = "abc"` will match `abc`, `abcd` and
     # `123abc`.  - String equality comparisons may contain one or multiple
-    # wildcards `*` which match any number of characters.
+    # wildcards `*` which match any number of characters.  - Fields holding a
+    # JSON document, such as the `spec` of custom objects, can be filtered on by
+    # selecting into the document: `spec.rack.units >= 42`. Keys are matched as
+    # given, without casing normalization. Comparisons only match values of the
+    # same JSON type as the literal, i.e. `spec.units > 4` ignores string values.
     filter: str = betterproto.string_field(4)
     # Optional, comma-seperated list of fields that specify the sorting order of
     # the requested resources. By default, resources are returned in
@@ -219,6 +243,20 @@ class ResourceFilter(betterproto.Message):
     # https://google.aip.dev/132 for details. Additional limitations: Ordering on
     # nested fields, such as `foo.bar` is not supported.
     order_by: str = betterproto.string_field(5)
+    # Optional label selector, only supported for Host, Site, Region and OU
+    # resources. Labels are the key/value pairs of the resource metadata,
+    # including the metadata inherited from the physical and logical hierarchy. On
+    # overlapping keys the closest resource in the hierarchy wins, and the
+    # physical hierarchy wins over the logical one. The selector is a
+    # comma-separated list of requirements that must all be satisfied, with the
+    # same syntax as Kubernetes label selectors:  - Equality: `key=value`,
+    # `key==value`, `key!=value`. `!=` also matches resources without the key.  -
+    # Set-based: `key in (value1,value2)`, `key notin (value1,value2)`. `notin`
+    # also matches resources without the key.  - Existence: `key` matches
+    # resources that have the key, `!key` the ones that do not. Calls with an
+    # invalid selector will fail with `INVALID_ARGUMENT`. Combined with `filter`
+    # using AND.
+    label_selector: str = betterproto.string_field(6)
 
 
 @dataclass
@@ -247,6 +285,54 @@ class FindResourcesResponseResourceTenantIDCarrier(betterproto.Message):
     resource_id: str = betterproto.string_field(2)
 
 
+@dataclass
+class SearchResourcesRequest(betterproto.Message):
+    client_uuid: str = betterproto.string_field(1)
+    # Text to search for, such as a serial number, a MAC or IP address, a
+    # hostname or part of a name. Matching is case-insensitive: a field matches
+    # if it contains the query, or if it is similar enough to it according to
+    # the trigram word similarity of PostgreSQL, which tolerates typos. The
+    # searched fields are:
+    #  - Host: name, hostname, serial_number, uuid, pxe_mac, bmc_ip, mgmt_ip.
+    #  - Hostnic: device_name, mac_addr.
+    #  - IPAddress: address.
+    #  - Site: name, address.
+    #  - Instance: name.
+    query: str = betterproto.string_field(2)
+    # Resource kinds to search, all the searchable kinds if empty.
+    kinds: List["ResourceKind"] = betterproto.enum_field(3)
+    # Maximum number of hits to return, defaults to 20.
+    limit: int = betterproto.uint32_field(4)
+    # Definition of tenant_id can be seen as redundant since it could be
+    # provided as part of nested filter. Extracting tenant information from
+    # nested structs could be expensive. Tenant related requests handling
+    # strategy has been created based on convention assuming that tenant is
+    # available on top level of requests, this approach comes with clarity of
+    # implementation.
+    tenant_id: str = betterproto.string_field(100)
+
+
+@dataclass
+class SearchResourcesResponse(betterproto.Message):
+    # Hits ordered by decreasing score, at most one per resource.
+    hits: List["SearchResourcesResponseHit"] = betterproto.message_field(1)
+
+
+@dataclass
+class SearchResourcesResponseHit(betterproto.Message):
+    resource_kind: "ResourceKind" = betterproto.enum_field(1)
+    resource_id: str = betterproto.string_field(2)
+    # Name of the field of the resource that best matches the query, e.g.
+    # serial_number.
+    field: str = betterproto.string_field(3)
+    # Value of the matching field.
+    value: str = betterproto.string_field(4)
+    # Relevance of the hit, between 0 and 1. Case-insensitive exact matches
+    # score 1, fields containing the query score above 0.5 and fields that are
+    # only similar to it score at most 0.5.
+    score: float = betterproto.float_field(5)
+
+
 @dataclass
 class ListResourcesRequest(betterproto.Message):
     client_uuid: str = betterproto.string_field(1)
@@ -332,6 +418,9 @@ class ListInheritedTelemetryProfilesRequest(betterproto.Message):
     # ONLY the new `AIP-160`-style filter, so filter.fieldmask and
     # filter.resource are not supported
     filter: "ResourceFilter" = betterproto.message_field(15)
+    # How the inherited telemetry profiles are resolved, defaults to
+    # TELEMETRY_INHERITANCE_MODE_ALL.
+    mode: "TelemetryInheritanceMode" = betterproto.enum_field(16)
     # Definition of tenant_id can be seen as redundant since tenant_id is also
     # defined in the nested resource. Extracting tenant information from nested
     # structs could be expensive. Tenant related requests handling strategy has
@@ -359,6 +448,92 @@ class ListInheritedTelemetryProfilesResponse(betterproto.Message):
     total_elements: int = betterproto.int32_field(10)
 
 
+@dataclass
+class EffectiveTelemetryProfile(betterproto.Message):
+    """
+    A telemetry profile that is part of the effective telemetry configuration
+    of an instance.
+    """
+
+    # The effective telemetry profile.
+    profile: v1.TelemetryProfile = betterproto.message_field(1)
+    # Resource ID of the instance, site or region the profile is attached to.
+    source_resource_id: str = betterproto.string_field(2)
+    # Kind of the resource the profile is attached to.
+    source_kind: "ResourceKind" = betterproto.enum_field(3)
+    # Distance in the hierarchy between the instance and the source resource: 0
+    # for the instance, 1 for its site, 2 for the region of the site and so on.
+    depth: int = betterproto.int32_field(4)
+    # Resource IDs of the less specific telemetry profiles of the same group that
+    # have been overridden by this profile.
+    overridden_profile_ids: List[str] = betterproto.string_field(5)
+
+
+@dataclass
+class GetEffectiveTelemetryProfilesRequest(betterproto.Message):
+    client_uuid: str = betterproto.string_field(1)
+    # List of instance resource IDs to resolve the effective telemetry for.
+    instance_ids: List[str] = betterproto.string_field(10)
+    # Definition of tenant_id can be seen as redundant since it could be provided
+    # as part of nested filter. Extracting tenant information from nested structs
+    # could be expensive. Tenant related requests handling strategy has been
+    # created based on convention assuming that tenant is available on top level
+    # of requests, this approach comes with clarity of implementation.
+    tenant_id: str = betterproto.string_field(100)
+
+
+@dataclass
+class GetEffectiveTelemetryProfilesResponse(betterproto.Message):
+    # Effective telemetry of each instance, in the same order of the request.
+    instances: List[
+        "GetEffectiveTelemetryProfilesResponseInstanceTelemetry"
+    ] = betterproto.message_field(1)
+
+
+@dataclass
+class GetEffectiveTelemetryProfilesResponseInstanceTelemetry(betterproto.Message):
+    instance_id: str = betterproto.string_field(1)
+    # Effective telemetry profiles, ordered by group resource ID and depth.
+    profiles: List["EffectiveTelemetryProfile"] = betterproto.message_field(2)
+
+
+@dataclass
+class DiffEffectiveTelemetryProfilesRequest(betterproto.Message):
+    client_uuid: str = betterproto.string_field(1)
+    # A telemetry profile that would be added, only the relation, group, kind,
+    # metrics_interval and log_level fields are considered.
+    add_profile: v1.TelemetryProfile = betterproto.message_field(10, group="change")
+    # Resource ID of an existing telemetry profile that would be removed.
+    remove_profile_id: str = betterproto.string_field(11, group="change")
+    # Definition of tenant_id can be seen as redundant since tenant_id is also
+    # defined in the nested resource. Extracting tenant information from nested
+    # structs could be expensive. Tenant related requests handling strategy has
+    # been created based on convention assuming that tenant is available on top
+    # level of requests, this approach comes with clarity of implementation.
+    tenant_id: str = betterproto.string_field(100)
+
+
+@dataclass
+class DiffEffectiveTelemetryProfilesResponse(betterproto.Message):
+    # Changes ordered by instance and group resource IDs, instances whose
+    # effective telemetry does not change are not reported.
+    changes: List["DiffEffectiveTelemetryProfilesResponseChange"] = (
+        betterproto.message_field(1)
+    )
+
+
+@dataclass
+class DiffEffectiveTelemetryProfilesResponseChange(betterproto.Message):
+    instance_id: str = betterproto.string_field(1)
+    group_id: str = betterproto.string_field(2)
+    # Effective profiles of the group before the change, empty if the group was
+    # not configured.
+    before: List["EffectiveTelemetryProfile"] = betterproto.message_field(3)
+    # Effective profiles of the group after the change, empty if the group would
+    # not be configured anymore.
+    after: List["EffectiveTelemetryProfile"] = betterproto.message_field(4)
+
+
 @dataclass
 class GetTreeHierarchyRequest(betterproto.Message):
     client_uuid: str = betterproto.string_field(1)
@@ -542,6 +717,34 @@ class InventoryServiceStub(betterproto.ServiceStub):
             FindResourcesResponse,
         )
 
+    async def search_resources(
+        self,
+        *,
+        client_uuid: str = "",
+        query: str = "",
+        kinds: List["ResourceKind"] = [],
+        limit: int = 0,
+        tenant_id: str = "",
+    ) -> SearchResourcesResponse:
+        """
+        Full-text search of the resources of the tenant, returning the hits
+        ranked by relevance. See SearchResourcesRequest for the searchable
+        resource kinds and fields.
+        """
+
+        request = SearchResourcesRequest()
+        request.client_uuid = client_uuid
+        request.query = query
+        request.kinds = kinds
+        request.limit = limit
+        request.tenant_id = tenant_id
+
+        return await self._unary_unary(
+            "/inventory.v1.InventoryService/SearchResources",
+            request,
+            SearchResourcesResponse,
+        )
+
     async def get_resource(
         self, *, client_uuid: str = "", resource_id: str = "", tenant_id: str = ""
     ) -> GetResourceResponse:
@@ -630,6 +833,7 @@ class InventoryServiceStub(betterproto.ServiceStub):
         client_uuid: str = "",
         inherit_by: Optional["ListInheritedTelemetryProfilesRequestInheritBy"] = None,
         filter: Optional["ResourceFilter"] = None,
+        mode: "TelemetryInheritanceMode" = 0,
         tenant_id: str = "",
     ) -> ListInheritedTelemetryProfilesResponse:
         """
@@ -643,6 +847,7 @@ class InventoryServiceStub(betterproto.ServiceStub):
             request.inherit_by = inherit_by
         if filter is not None:
             request.filter = filter
+        request.mode = mode
         request.tenant_id = tenant_id
 
         return await self._unary_unary(
@@ -651,6 +856,53 @@ class InventoryServiceStub(betterproto.ServiceStub):
             ListInheritedTelemetryProfilesResponse,
         )
 
+    async def get_effective_telemetry_profiles(
+        self, *, client_uuid: str = "", instance_ids: List[str] = [], tenant_id: str = ""
+    ) -> GetEffectiveTelemetryProfilesResponse:
+        """
+        Custom RPC for Telemetry: Returns the effective telemetry profiles of
+        the given instances, resolved with TELEMETRY_INHERITANCE_MODE_OVERRIDE
+        semantics. Every returned profile carries its provenance.
+        """
+
+        request = GetEffectiveTelemetryProfilesRequest()
+        request.client_uuid = client_uuid
+        request.instance_ids = instance_ids
+        request.tenant_id = tenant_id
+
+        return await self._unary_unary(
+            "/inventory.v1.InventoryService/GetEffectiveTelemetryProfiles",
+            request,
+            GetEffectiveTelemetryProfilesResponse,
+        )
+
+    async def diff_effective_telemetry_profiles(
+        self,
+        *,
+        client_uuid: str = "",
+        add_profile: Optional[v1.TelemetryProfile] = None,
+        remove_profile_id: str = "",
+        tenant_id: str = "",
+    ) -> DiffEffectiveTelemetryProfilesResponse:
+        """
+        Custom RPC for Telemetry: Returns the changes in the effective
+        telemetry profiles of the affected instances if the given telemetry
+        profile was added or removed. Nothing is persisted.
+        """
+
+        request = DiffEffectiveTelemetryProfilesRequest()
+        request.client_uuid = client_uuid
+        if add_profile is not None:
+            request.add_profile = add_profile
+        request.remove_profile_id = remove_profile_id
+        request.tenant_id = tenant_id
+
+        return await self._unary_unary(
+            "/inventory.v1.InventoryService/DiffEffectiveTelemetryProfiles",
+            request,
+            DiffEffectiveTelemetryProfilesResponse,
+        )
+
     async def get_tree_hierarchy(
         self,
         *,
@@ -18,3 +18,13 @@ hasReadAccess if {
         # - with MT: [PROJECT_UUID]_en-agent-rw, [PROJECT_UUID]_en-ob, [PROJECT_UUID]_im-r or [PROJECT_UUID]_im-rw
    regex.match("^([0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}_)en-agent-rw$|^([0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}_)en-ob$|^([0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}_)im-r$|^([0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}_)im-rw$", role)
 }
+
+# Tenants in which the scoped role is granted. The access of the principal is then restricted to the regions, sites
+# and OUs of its role bindings in the tenant.
+scopedTenants contains tenant if {
+    some role in input["realm_access/roles"] # iteration
+    # We expect:
+    # - with MT: [PROJECT_UUID]_im-scoped
+    regex.match("^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}_im-scoped$", role)
+    tenant := trim_suffix(role, "_im-scoped")
+}
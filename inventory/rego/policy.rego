@@ -113,6 +113,35 @@ deny if {
 	input.ClientKind == "CLIENT_KIND_RESOURCE_MANAGER"
 }
 
+# Fields of the resources set by the resource managers only, see the "Set by RMs only" fields in compute.proto.
+# Client kinds do not tell the resource managers apart, the fields set by a given RM only are set by any RM here.
+rmOnlyFields := {
+	"host": {
+		"host_status", "host_status_indicator", "host_status_timestamp",
+		"onboarding_status", "onboarding_status_indicator", "onboarding_status_timestamp",
+		"registration_status", "registration_status_indicator", "registration_status_timestamp",
+		"power_status", "power_status_indicator", "power_status_timestamp", "power_on_time",
+		"amt_status", "amt_status_indicator", "amt_status_timestamp",
+	},
+	"instance": {
+		"instance_status", "instance_status_indicator", "instance_status_timestamp",
+		"provisioning_status", "provisioning_status_indicator", "provisioning_status_timestamp",
+		"update_status", "update_status_indicator", "update_status_timestamp",
+		"trusted_attestation_status", "trusted_attestation_status_indicator", "trusted_attestation_status_timestamp",
+	},
+}
+
+# deny writes to the fields set by the resource managers only via other clients. WrittenFields holds the fields
+# written by the request: the ones in its field mask for updates, or all the fields set in the resource when the mask
+# is missing or empty. They are denied even if the request writes the value already stored.
+deny if {
+	some kind, fields in rmOnlyFields
+	input.resource[kind]
+	some field in object.keys(input.WrittenFields)
+	field in fields
+	input.ClientKind != "CLIENT_KIND_RESOURCE_MANAGER"
+}
+
 # deny if TC client tries to create a resource that is not a tenant, provider or telemetryGroup
 deny if {
     not input.resource.tenant
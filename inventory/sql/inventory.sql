@@ -1,3 +1,4 @@
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
 CREATE TABLE "tenants" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "resource_id" character varying NOT NULL, "current_state" character varying NULL, "desired_state" character varying NOT NULL, "watcher_osmanager" boolean NULL, "tenant_id" character varying NOT NULL, "created_at" timestamp NOT NULL, "updated_at" timestamp NOT NULL, PRIMARY KEY ("id"));
 CREATE UNIQUE INDEX "tenants_resource_id_key" ON "tenants" ("resource_id");
 CREATE UNIQUE INDEX "tenants_tenant_id_key" ON "tenants" ("tenant_id");
@@ -90,3 +91,31 @@ CREATE UNIQUE INDEX "telemetry_profiles_resource_id_key" ON "telemetry_profiles"
 CREATE INDEX "telemetryprofile_tenant_id" ON "telemetry_profiles" ("tenant_id");
 CREATE TABLE "workload_members" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "resource_id" character varying NOT NULL, "kind" character varying NOT NULL, "tenant_id" character varying NOT NULL, "created_at" timestamp NOT NULL, "updated_at" timestamp NOT NULL, "workload_member_workload" bigint NOT NULL, "workload_member_instance" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "workload_members_workload_resources_workload" FOREIGN KEY ("workload_member_workload") REFERENCES "workload_resources" ("id") ON DELETE NO ACTION, CONSTRAINT "workload_members_instance_resources_instance" FOREIGN KEY ("workload_member_instance") REFERENCES "instance_resources" ("id") ON DELETE NO ACTION);
 CREATE UNIQUE INDEX "workload_members_resource_id_key" ON "workload_members" ("resource_id");
+CREATE TABLE "custom_type_resources" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "resource_id" character varying NOT NULL, "name" character varying NOT NULL, "description" character varying NULL, "json_schema" character varying NOT NULL, "allowed_edges" character varying NULL, "tenant_id" character varying NOT NULL, "created_at" timestamp NOT NULL, "updated_at" timestamp NOT NULL, PRIMARY KEY ("id"));
+CREATE UNIQUE INDEX "custom_type_resources_resource_id_key" ON "custom_type_resources" ("resource_id");
+CREATE UNIQUE INDEX "customtyperesource_name_tenant_id" ON "custom_type_resources" ("name", "tenant_id");
+CREATE INDEX "customtyperesource_tenant_id" ON "custom_type_resources" ("tenant_id");
+CREATE TABLE "custom_object_resources" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "resource_id" character varying NOT NULL, "name" character varying NULL, "spec" character varying NULL, "tenant_id" character varying NOT NULL, "created_at" timestamp NOT NULL, "updated_at" timestamp NOT NULL, "custom_object_resource_custom_type" bigint NOT NULL, "custom_object_resource_host" bigint NULL, "custom_object_resource_site" bigint NULL, "custom_object_resource_region" bigint NULL, "custom_object_resource_ou" bigint NULL, "custom_object_resource_instance" bigint NULL, PRIMARY KEY ("id"), CONSTRAINT "custom_object_resources_custom_type_resources_custom_type" FOREIGN KEY ("custom_object_resource_custom_type") REFERENCES "custom_type_resources" ("id") ON DELETE NO ACTION, CONSTRAINT "custom_object_resources_host_resources_host" FOREIGN KEY ("custom_object_resource_host") REFERENCES "host_resources" ("id") ON DELETE SET NULL, CONSTRAINT "custom_object_resources_instance_resources_instance" FOREIGN KEY ("custom_object_resource_instance") REFERENCES "instance_resources" ("id") ON DELETE SET NULL, CONSTRAINT "custom_object_resources_ou_resources_ou" FOREIGN KEY ("custom_object_resource_ou") REFERENCES "ou_resources" ("id") ON DELETE SET NULL, CONSTRAINT "custom_object_resources_region_resources_region" FOREIGN KEY ("custom_object_resource_region") REFERENCES "region_resources" ("id") ON DELETE SET NULL, CONSTRAINT "custom_object_resources_site_resources_site" FOREIGN KEY ("custom_object_resource_site") REFERENCES "site_resources" ("id") ON DELETE SET NULL);
+CREATE UNIQUE INDEX "custom_object_resources_resource_id_key" ON "custom_object_resources" ("resource_id");
+CREATE INDEX "customobjectresource_tenant_id" ON "custom_object_resources" ("tenant_id");
+CREATE TABLE "resource_labels" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "resource_id" character varying NOT NULL, "key" character varying NOT NULL, "value" character varying NOT NULL, "tenant_id" character varying NOT NULL, PRIMARY KEY ("id"));
+CREATE UNIQUE INDEX "resourcelabel_resource_id_key" ON "resource_labels" ("resource_id", "key");
+CREATE INDEX "resourcelabel_key_value" ON "resource_labels" ("key", "value");
+CREATE INDEX "resourcelabel_tenant_id" ON "resource_labels" ("tenant_id");
+CREATE INDEX "hostresource_name_trgm" ON "host_resources" USING GIN ("name" gin_trgm_ops);
+CREATE INDEX "hostresource_hostname_trgm" ON "host_resources" USING GIN ("hostname" gin_trgm_ops);
+CREATE INDEX "hostresource_serial_number_trgm" ON "host_resources" USING GIN ("serial_number" gin_trgm_ops);
+CREATE INDEX "hostresource_uuid_trgm" ON "host_resources" USING GIN ("uuid" gin_trgm_ops);
+CREATE INDEX "hostresource_pxe_mac_trgm" ON "host_resources" USING GIN ("pxe_mac" gin_trgm_ops);
+CREATE INDEX "hostresource_bmc_ip_trgm" ON "host_resources" USING GIN ("bmc_ip" gin_trgm_ops);
+CREATE INDEX "hostresource_mgmt_ip_trgm" ON "host_resources" USING GIN ("mgmt_ip" gin_trgm_ops);
+CREATE INDEX "hostnicresource_device_name_trgm" ON "hostnic_resources" USING GIN ("device_name" gin_trgm_ops);
+CREATE INDEX "hostnicresource_mac_addr_trgm" ON "hostnic_resources" USING GIN ("mac_addr" gin_trgm_ops);
+CREATE INDEX "instanceresource_name_trgm" ON "instance_resources" USING GIN ("name" gin_trgm_ops);
+CREATE INDEX "ipaddressresource_address_trgm" ON "ip_address_resources" USING GIN ("address" gin_trgm_ops);
+CREATE INDEX "siteresource_name_trgm" ON "site_resources" USING GIN ("name" gin_trgm_ops);
+CREATE INDEX "siteresource_address_trgm" ON "site_resources" USING GIN ("address" gin_trgm_ops);
+CREATE TABLE "role_binding_resources" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "resource_id" character varying NOT NULL, "subject" character varying NOT NULL, "role" character varying NULL, "tenant_id" character varying NOT NULL, "created_at" timestamp NOT NULL, "updated_at" timestamp NOT NULL, "role_binding_resource_region" bigint NULL, "role_binding_resource_site" bigint NULL, "role_binding_resource_ou" bigint NULL, PRIMARY KEY ("id"), CONSTRAINT "role_binding_resources_ou_resources_ou" FOREIGN KEY ("role_binding_resource_ou") REFERENCES "ou_resources" ("id") ON DELETE SET NULL, CONSTRAINT "role_binding_resources_region_resources_region" FOREIGN KEY ("role_binding_resource_region") REFERENCES "region_resources" ("id") ON DELETE SET NULL, CONSTRAINT "role_binding_resources_site_resources_site" FOREIGN KEY ("role_binding_resource_site") REFERENCES "site_resources" ("id") ON DELETE SET NULL);
+CREATE UNIQUE INDEX "role_binding_resources_resource_id_key" ON "role_binding_resources" ("resource_id");
+CREATE INDEX "rolebindingresource_subject_tenant_id" ON "role_binding_resources" ("subject", "tenant_id");
+CREATE INDEX "rolebindingresource_tenant_id" ON "role_binding_resources" ("tenant_id");